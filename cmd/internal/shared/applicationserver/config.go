@@ -21,8 +21,13 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/gatewaygeolocation"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
 )
 
 // DefaultWebhookTemplatesConfig is the default configuration for the Webhook templates.
@@ -75,8 +80,32 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		Config: packages.Config{
 			Workers: 16,
 		},
+		Storage: storage.Config{
+			Dialect:         "postgres",
+			Retention:       24 * time.Hour,
+			CleanupInterval: time.Hour,
+		},
+		FUOTA: fuota.Config{
+			StartDelay: time.Hour,
+			Interval:   time.Minute,
+		},
+		Geolocation: gatewaygeolocation.Config{
+			MinGateways: 3,
+		},
 	},
 	Formatters: applicationserver.FormattersConfig{
 		MaxParameterLength: 40960,
+		JavaScript: applicationserver.JavaScriptFormatterConfig{
+			Timeout:         scripting.DefaultOptions.Timeout,
+			StackDepthLimit: scripting.DefaultOptions.StackDepthLimit,
+			AllocationLimit: scripting.DefaultOptions.AllocationLimit,
+			Quota: applicationserver.JavaScriptQuotaConfig{
+				Interval: time.Minute,
+			},
+		},
+		GRPCService: grpcservice.Config{
+			Timeout:  grpcservice.DefaultTimeout,
+			PoolSize: grpcservice.DefaultPoolSize,
+		},
 	},
 }
@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
+	udpupstream "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/udp"
 )
 
 // DefaultGatewayServerConfig is the default configuration for the GatewayServer.
@@ -38,6 +39,22 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		UpdateGatewayJitter:   packetbroker.DefaultUpdateGatewayJitter,
 		OnlineTTLMargin:       packetbroker.DefaultOnlineTTLMargin,
 	},
+	StatsHistory: gatewayserver.StatsHistoryConfig{
+		SampleInterval:      time.Minute,
+		SampleRetention:     48 * time.Hour,
+		DownsampleInterval:  time.Hour,
+		DownsampleRetention: 30 * 24 * time.Hour,
+	},
+	Alerts: gatewayserver.AlertsConfig{
+		DisconnectThreshold:    10 * time.Minute,
+		UplinkSilenceThreshold: time.Hour,
+	},
+	UDPUpstream: gatewayserver.UDPUpstreamConfig{
+		KeepaliveInterval: udpupstream.DefaultKeepaliveInterval,
+	},
+	Capture: gatewayserver.CaptureConfig{
+		MaxDuration: time.Hour,
+	},
 	UDP: gatewayserver.UDPConfig{
 		Config: udp.DefaultConfig,
 		Listeners: map[string]string{
@@ -56,6 +73,12 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1882", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8882", shared.DefaultPublicHost),
 	},
+	MQTTJSON: config.MQTT{
+		Listen:           ":1889",
+		ListenTLS:        ":8889",
+		PublicAddress:    fmt.Sprintf("%s:1889", shared.DefaultPublicHost),
+		PublicTLSAddress: fmt.Sprintf("%s:8889", shared.DefaultPublicHost),
+	},
 	BasicStation: gatewayserver.BasicStationConfig{
 		Config:                 ws.DefaultConfig,
 		MaxValidRoundTripDelay: 10 * time.Second,
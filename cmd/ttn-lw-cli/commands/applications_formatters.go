@@ -0,0 +1,127 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	stdio "io"
+	"os"
+	"reflect"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errPayloadFormatterTestFailed = errors.DefineAborted("payload_formatter_test_failed", "{failed} of {total} payload formatter test cases failed")
+
+var setTestPayloadFormatterFlags = func() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	util.AddField(flagSet, "formatter", reflect.TypeOf(ttnpb.PayloadFormatter(0)), false)
+	flagSet.AddFlagSet(util.FieldFlags(&ttnpb.EndDeviceVersionIdentifiers{}, "version_ids"))
+	return flagSet
+}()
+
+var (
+	applicationsFormattersCommand = &cobra.Command{
+		Use:     "formatters",
+		Aliases: []string{"formatter"},
+		Short:   "Application payload formatter commands",
+	}
+	applicationsFormattersTestCommand = &cobra.Command{
+		Use:   "test [application-id] [device-id]",
+		Short: "Test a payload formatter with test cases",
+		Long: `Test a payload formatter with test cases
+
+The test cases are read from the cases file or from stdin, as a stream of
+PayloadFormatterTestCase messages. Each test case decodes the given payload as
+uplink (or downlink) message and compares the decoded payload, warnings and
+errors with the expectations.
+
+The command fails if any of the test cases fails, which makes it suitable to
+run in continuous integration before deploying payload formatter changes.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			req := &ttnpb.TestPayloadFormatterRequest{
+				EndDeviceIds: devID,
+			}
+			if err := util.SetFields(req, setTestPayloadFormatterFlags); err != nil {
+				return err
+			}
+			parameter, err := getDataBytes("formatter-parameter", cmd.Flags())
+			switch {
+			case err == nil:
+				req.Parameter = string(parameter)
+			case !errors.IsInvalidArgument(err):
+				return err
+			}
+
+			inputDecoder := inputDecoder
+			if reader, err := getDataReader("cases", cmd.Flags()); err == nil {
+				if inputDecoder, err = getInputDecoder(reader); err != nil {
+					return err
+				}
+			} else if inputDecoder == nil {
+				return err
+			}
+			for {
+				var tc ttnpb.PayloadFormatterTestCase
+				if _, err := inputDecoder.Decode(&tc); err != nil {
+					if err == stdio.EOF {
+						break
+					}
+					return err
+				}
+				req.Cases = append(req.Cases, &tc)
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAppAsClient(as).TestPayloadFormatter(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			if err := io.Write(os.Stdout, config.OutputFormat, res); err != nil {
+				return err
+			}
+			if res.Failed > 0 {
+				return errPayloadFormatterTestFailed.WithAttributes(
+					"failed", res.Failed,
+					"total", len(res.Results),
+				)
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	applicationsFormattersTestCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsFormattersTestCommand.Flags().AddFlagSet(setTestPayloadFormatterFlags)
+	applicationsFormattersTestCommand.Flags().AddFlagSet(dataFlags("formatter-parameter", "payload formatter parameter"))
+	applicationsFormattersTestCommand.Flags().AddFlagSet(dataFlags("cases", "payload formatter test cases"))
+	applicationsFormattersCommand.AddCommand(applicationsFormattersTestCommand)
+	applicationsCommand.AddCommand(applicationsFormattersCommand)
+}
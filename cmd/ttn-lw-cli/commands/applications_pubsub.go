@@ -34,6 +34,8 @@ var (
 	setApplicationPubSubFlags            = util.FieldFlags(&ttnpb.ApplicationPubSub{})
 	natsProviderApplicationPubSubFlags   = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats"))
 	mqttProviderApplicationPubSubFlags   = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt"))
+	amqpProviderApplicationPubSubFlags   = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_AMQPProvider{}, "amqp"))
+	kafkaProviderApplicationPubSubFlags  = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka"))
 	awsiotProviderApplicationPubSubFlags = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider{}, "aws_iot"))
 	awsiotDefaultIntegrationPubSubFlags  = util.HideFlagSet(util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider_DefaultIntegration{}, "aws_iot", "deployment", "default"))
 
@@ -58,6 +60,12 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-ca", "")))
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-client-cert", "")))
 	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("mqtt.tls-client-key", "")))
+	flagSet.Bool("amqp", false, "use the AMQP provider")
+	util.HideFlag(flagSet, "amqp")
+	flagSet.AddFlagSet(amqpProviderApplicationPubSubFlags)
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	util.HideFlag(flagSet, "kafka")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
 	flagSet.Bool("aws-iot", false, "use the AWS IoT provider")
 	util.HideFlag(flagSet, "aws-iot")
 	flagSet.AddFlagSet(awsiotProviderApplicationPubSubFlags)
@@ -274,6 +282,38 @@ var (
 				}
 			}
 
+			if amqp, _ := cmd.Flags().GetBool("amqp"); amqp {
+				if pubsub.GetAMQP() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_AMQP{
+						AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), amqpProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetAMQP(), amqpProviderApplicationPubSubFlags, "amqp"); err != nil {
+					return err
+				}
+			}
+
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetKafka(), kafkaProviderApplicationPubSubFlags, "kafka"); err != nil {
+					return err
+				}
+			}
+
 			if awsiot, _ := cmd.Flags().GetBool("aws-iot"); awsiot {
 				if pubsub.GetAWSIoT() == nil {
 					paths = append(paths, "provider")
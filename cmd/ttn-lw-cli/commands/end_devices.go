@@ -47,6 +47,7 @@ var (
 	endDeviceFlattenPaths    = []string{"provisioning_data"}
 	endDevicePictureFlags    = &pflag.FlagSet{}
 	endDeviceLocationFlags   = util.FieldFlags(&ttnpb.Location{}, "location")
+	simulateADRFlags         = util.FieldFlags(&ttnpb.MACSettings{}, "mac_settings")
 
 	selectAllEndDeviceFlags = util.SelectAllFlagSet("end devices")
 )
@@ -1199,6 +1200,90 @@ This command may take end device identifiers from stdin.`,
 			return err
 		},
 	}
+	endDevicesSimulateADRCommand = &cobra.Command{
+		Use:   "adr-simulate [application-id] [device-id]",
+		Short: "Simulate ADR on the recent uplinks of an end device",
+		Long: `Simulate ADR on the recent uplinks of an end device
+
+The recent uplinks of the end device are replayed with the current MAC
+settings of the end device, overridden with the MAC settings given as flags.
+The simulation does not modify the end device.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.SimulateADRRequest{
+				EndDeviceIdentifiers: *devID,
+			}
+			for _, path := range util.UpdateFieldMask(cmd.Flags(), simulateADRFlags) {
+				req.FieldMask.Paths = append(req.FieldMask.Paths, strings.TrimPrefix(path, "mac_settings."))
+			}
+			if err = util.SetFields(req, simulateADRFlags); err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).SimulateADR(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesDownlinkAttemptsCommand = &cobra.Command{
+		Use:   "downlink-attempts [application-id] [device-id]",
+		Short: "Get the recent downlink scheduling attempts of an end device",
+		Long: `Get the recent downlink scheduling attempts of an end device
+
+The attempts are listed most recent first, with the candidate downlink paths
+and the errors returned by the Gateway Servers.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			limit, _ := cmd.Flags().GetUint32("limit")
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).GetDownlinkSchedulingAttempts(ctx, &ttnpb.GetDownlinkSchedulingAttemptsRequest{
+				EndDeviceIdentifiers: *devID,
+				Limit:                limit,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesAirtimeUsageCommand = &cobra.Command{
+		Use:   "airtime-usage [application-id] [device-id]",
+		Short: "Get the airtime usage of an end device on the current UTC day",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns).GetAirtimeUsage(ctx, &ttnpb.GetAirtimeUsageRequest{
+				EndDeviceIdentifiers: *devID,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -1299,6 +1384,14 @@ func init() {
 	endDevicesCommand.AddCommand(endDevicesGenerateQRCommand)
 	endDevicesExternalJSCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCommand.AddCommand(endDevicesExternalJSCommand)
+	endDevicesSimulateADRCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesSimulateADRCommand.Flags().AddFlagSet(simulateADRFlags)
+	endDevicesCommand.AddCommand(endDevicesSimulateADRCommand)
+	endDevicesDownlinkAttemptsCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesDownlinkAttemptsCommand.Flags().Uint32("limit", 0, "maximum number of attempts to return (0 is all)")
+	endDevicesCommand.AddCommand(endDevicesDownlinkAttemptsCommand)
+	endDevicesAirtimeUsageCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesCommand.AddCommand(endDevicesAirtimeUsageCommand)
 
 	endDevicesCommand.AddCommand(applicationsDownlinkCommand)
 
@@ -36,7 +36,7 @@ var (
 	setGatewayAntennaFlags = util.FieldFlags(&ttnpb.GatewayAntenna{}, "antenna")
 	selectAllGatewayFlags  = util.SelectAllFlagSet("gateway")
 
-	gatewayFlattenPaths = []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key"}
+	gatewayFlattenPaths = []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key", "udp_secret"}
 )
 
 func gatewayIDFlags() *pflag.FlagSet {
@@ -249,7 +249,7 @@ var (
 			if err != nil {
 				return err
 			}
-			paths := util.UpdateFieldMask(cmd.Flags(), setGatewayFlags, attributesFlags())
+			paths := util.UpdateFieldMask(cmd.Flags(), setGatewayFlags, attributesFlags(), uplinkFiltersFlags())
 			paths = append(paths, ttnpb.FlattenPaths(paths, gatewayFlattenPaths)...)
 
 			collaborator := getCollaborator(cmd.Flags())
@@ -284,6 +284,9 @@ var (
 			}
 
 			gateway.Attributes = mergeAttributes(gateway.Attributes, cmd.Flags())
+			if err = setUplinkFilters(&gateway, cmd.Flags()); err != nil {
+				return err
+			}
 			if gtwID != nil {
 				if gtwID.GatewayId != "" {
 					gateway.GatewayId = gtwID.GatewayId
@@ -328,7 +331,7 @@ var (
 			if err != nil {
 				return err
 			}
-			paths := util.UpdateFieldMask(cmd.Flags(), setGatewayFlags, attributesFlags())
+			paths := util.UpdateFieldMask(cmd.Flags(), setGatewayFlags, attributesFlags(), uplinkFiltersFlags())
 			antennaPaths := util.UpdateFieldMask(cmd.Flags(), setGatewayAntennaFlags)
 			paths = append(paths, ttnpb.FlattenPaths(paths, gatewayFlattenPaths)...)
 
@@ -345,6 +348,9 @@ var (
 				return err
 			}
 			gateway.Attributes = mergeAttributes(gateway.Attributes, cmd.Flags())
+			if err = setUplinkFilters(&gateway, cmd.Flags()); err != nil {
+				return err
+			}
 			gateway.GatewayIdentifiers = *gtwID
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
@@ -537,6 +543,7 @@ func init() {
 	gatewaysCreateCommand.Flags().AddFlagSet(setGatewayFlags)
 	gatewaysCreateCommand.Flags().AddFlagSet(setGatewayAntennaFlags)
 	gatewaysCreateCommand.Flags().AddFlagSet(attributesFlags())
+	gatewaysCreateCommand.Flags().AddFlagSet(uplinkFiltersFlags())
 	gatewaysCreateCommand.Flags().Bool("defaults", true, "configure gateway with defaults")
 	gatewaysCommand.AddCommand(gatewaysCreateCommand)
 	gatewaysSetCommand.Flags().AddFlagSet(gatewayIDFlags())
@@ -546,6 +553,7 @@ func init() {
 	gatewaysSetCommand.Flags().Bool("antenna.remove", false, "remove an antenna")
 	gatewaysSetCommand.Flags().AddFlagSet(setGatewayAntennaFlags)
 	gatewaysSetCommand.Flags().AddFlagSet(attributesFlags())
+	gatewaysSetCommand.Flags().AddFlagSet(uplinkFiltersFlags())
 	gatewaysCommand.AddCommand(gatewaysSetCommand)
 	gatewaysDeleteCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
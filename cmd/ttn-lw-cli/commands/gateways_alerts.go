@@ -0,0 +1,60 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var gatewaysListAlerts = &cobra.Command{
+	Use:     "list-alerts [gateway-id]",
+	Aliases: []string{"alerts"},
+	Short:   "List the health alerts of a gateway (Gateway Server only)",
+	Long: `List the health alerts of a gateway (Gateway Server only)
+
+Alerts are raised when the gateway disconnects, stops forwarding uplink
+messages, stops sending status messages or has high round-trip times.
+The thresholds can be configured in the alert settings of the gateway.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		includeResolved, _ := cmd.Flags().GetBool("include-resolved")
+
+		gs, err := dialGatewayServerForGateway(gtwID)
+		if err != nil {
+			return err
+		}
+		res, err := ttnpb.NewGsClient(gs).ListGatewayAlerts(ctx, &ttnpb.ListGatewayAlertsRequest{
+			GatewayIdentifiers: *gtwID,
+			IncludeResolved:    includeResolved,
+		})
+		if err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, res)
+	},
+}
+
+func init() {
+	gatewaysListAlerts.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysListAlerts.Flags().Bool("include-resolved", false, "include recently resolved alerts")
+	gatewaysCommand.AddCommand(gatewaysListAlerts)
+}
@@ -0,0 +1,177 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	stdio "io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoCaptureID   = errors.DefineInvalidArgument("no_capture_id", "no capture ID set")
+	errCaptureFormat = errors.DefineInvalidArgument("capture_format", "invalid capture format `{format}`")
+)
+
+var captureFormats = map[string]ttnpb.GatewayCaptureFormat{
+	"json-lines": ttnpb.CAPTURE_FORMAT_JSON_LINES,
+	"pcapng":     ttnpb.CAPTURE_FORMAT_PCAPNG,
+}
+
+var (
+	gatewaysCaptureCommand = &cobra.Command{
+		Use:   "capture",
+		Short: "Capture gateway traffic (Gateway Server only)",
+		Long: `Capture gateway traffic (Gateway Server only)
+
+A capture records the raw traffic and the messages of a gateway connection
+for a limited duration. The gateway must be connected to the Gateway Server.
+The capture can be downloaded as JSON lines or as PCAPNG.`,
+	}
+	gatewaysCaptureStartCommand = &cobra.Command{
+		Use:     "start [gateway-id]",
+		Short:   "Start a capture of gateway traffic",
+		Example: `  ttn-lw-cli gateways capture start my-gateway --duration 10m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.StartGatewayCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			if cmd.Flags().Changed("duration") {
+				duration, _ := cmd.Flags().GetDuration("duration")
+				req.Duration = &duration
+			}
+
+			gs, err := dialGatewayServerForGateway(gtwID)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StartGatewayCapture(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCaptureStopCommand = &cobra.Command{
+		Use:     "stop [gateway-id]",
+		Short:   "Stop the active capture of gateway traffic",
+		Example: `  ttn-lw-cli gateways capture stop my-gateway`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gs, err := dialGatewayServerForGateway(gtwID)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StopGatewayCapture(ctx, &ttnpb.StopGatewayCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCaptureDownloadCommand = &cobra.Command{
+		Use:   "download [gateway-id] [capture-id]",
+		Short: "Download a capture of gateway traffic",
+		Long: `Download a capture of gateway traffic
+
+The capture is written to stdout, or to the output file if set. In the PCAPNG
+format, the packets use link type LINKTYPE_USER0 (147), and the packet data
+starts with one byte of the record type.`,
+		Example: `  ttn-lw-cli gateways capture download my-gateway 01f8mechzx6a2kf7v5k2n0w0yd --format pcapng --output-file capture.pcapng`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var captureID string
+			if len(args) > 1 {
+				captureID, args = args[1], args[:1]
+			} else {
+				captureID, _ = cmd.Flags().GetString("capture-id")
+			}
+			if captureID == "" {
+				return errNoCaptureID.New()
+			}
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			formatName, _ := cmd.Flags().GetString("format")
+			format, ok := captureFormats[formatName]
+			if !ok {
+				return errCaptureFormat.WithAttributes("format", formatName)
+			}
+
+			var w stdio.Writer = os.Stdout
+			if outputFile, _ := cmd.Flags().GetString("output-file"); outputFile != "" {
+				f, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			gs, err := dialGatewayServerForGateway(gtwID)
+			if err != nil {
+				return err
+			}
+			stream, err := ttnpb.NewGsClient(gs).DownloadGatewayCapture(ctx, &ttnpb.DownloadGatewayCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+				CaptureID:          captureID,
+				Format:             format,
+			})
+			if err != nil {
+				return err
+			}
+			for {
+				data, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, stdio.EOF) {
+						return nil
+					}
+					return err
+				}
+				if _, err := w.Write(data.Data); err != nil {
+					return err
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	gatewaysCaptureStartCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureStartCommand.Flags().Duration("duration", 0, "duration after which the capture is stopped (default 5m)")
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureStartCommand)
+	gatewaysCaptureStopCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureStopCommand)
+	gatewaysCaptureDownloadCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureDownloadCommand.Flags().String("capture-id", "", "capture ID")
+	gatewaysCaptureDownloadCommand.Flags().String("format", "json-lines", "format of the capture (json-lines, pcapng)")
+	gatewaysCaptureDownloadCommand.Flags().String("output-file", "", "file to write the capture to")
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureDownloadCommand)
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+}
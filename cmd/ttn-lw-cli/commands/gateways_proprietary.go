@@ -0,0 +1,84 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	proprietaryDownlinkRequestFlags = util.FieldFlags(&ttnpb.TxRequest{}, "request")
+
+	errNoRawPayload = errors.DefineInvalidArgument("no_raw_payload", "no raw payload set")
+)
+
+var gatewaysProprietaryDownlinkCommand = &cobra.Command{
+	Use:   "proprietary-downlink [gateway-id]",
+	Short: "Schedule a proprietary downlink message on a gateway",
+	Long: `Schedule a proprietary downlink message on a gateway
+
+The raw payload is the PHYPayload in hex, of which the MType must be
+proprietary. If no downlink path is set, the first antenna of the gateway is
+used.`,
+	Example: `  ttn-lw-cli gateways proprietary-downlink my-gateway --raw-payload E0010203 \
+    --request.class CLASS_C --request.rx1-frequency 869525000 --request.rx1-data-rate-index 0`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		rawPayloadHex, _ := cmd.Flags().GetString("raw-payload")
+		if rawPayloadHex == "" {
+			return errNoRawPayload.New()
+		}
+		rawPayload, err := hex.DecodeString(strings.TrimPrefix(rawPayloadHex, "0x"))
+		if err != nil {
+			return err
+		}
+		req := &ttnpb.ScheduleProprietaryDownlinkRequest{
+			GatewayIdentifiers: *gtwID,
+			RawPayload:         rawPayload,
+			Request:            &ttnpb.TxRequest{},
+		}
+		if err := util.SetFields(req, proprietaryDownlinkRequestFlags); err != nil {
+			return err
+		}
+
+		gs, err := dialGatewayServerForGateway(gtwID)
+		if err != nil {
+			return err
+		}
+		res, err := ttnpb.NewGsClient(gs).ScheduleProprietaryDownlink(ctx, req)
+		if err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, res)
+	},
+}
+
+func init() {
+	gatewaysProprietaryDownlinkCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysProprietaryDownlinkCommand.Flags().String("raw-payload", "", "PHYPayload of the downlink message (hex)")
+	gatewaysProprietaryDownlinkCommand.Flags().AddFlagSet(proprietaryDownlinkRequestFlags)
+	gatewaysCommand.AddCommand(gatewaysProprietaryDownlinkCommand)
+}
@@ -0,0 +1,153 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	stdio "io"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var errNoGatewayCommand = errors.DefineInvalidArgument("no_gateway_command", "no command set")
+
+// dialGatewayServerForGateway dials the Gateway Server after checking that the gateway is connected to it.
+func dialGatewayServerForGateway(gtwID *ttnpb.GatewayIdentifiers) (*grpc.ClientConn, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := ttnpb.NewGatewayRegistryClient(is).Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIdentifiers: *gtwID,
+		FieldMask:          types.FieldMask{Paths: []string{"gateway_server_address"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if gsMismatch := compareServerAddressGateway(gateway, config); gsMismatch {
+		return nil, errAddressMismatchGateway.New()
+	}
+	return api.Dial(ctx, config.GatewayServerGRPCAddress)
+}
+
+var (
+	gatewaysRunCommandCommand = &cobra.Command{
+		Use:   "run-command [gateway-id]",
+		Short: "Run a command on a gateway",
+		Long: `Run a command on a gateway
+
+The gateway must be connected to the Gateway Server with a protocol that
+supports remote control, such as LoRa Basics Station LNS.`,
+		Example: `  ttn-lw-cli gateways run-command my-gateway --command /sbin/reboot`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			command, _ := cmd.Flags().GetString("command")
+			if command == "" {
+				return errNoGatewayCommand.New()
+			}
+			arguments, _ := cmd.Flags().GetStringSlice("arguments")
+
+			gs, err := dialGatewayServerForGateway(gtwID)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGsClient(gs).RunGatewayCommand(ctx, &ttnpb.RunGatewayCommandRequest{
+				GatewayIdentifiers: *gtwID,
+				Command:            command,
+				Arguments:          arguments,
+			})
+			return err
+		},
+	}
+	gatewaysRemoteShellCommand = &cobra.Command{
+		Use:   "remote-shell [gateway-id]",
+		Short: "Open a remote shell on a gateway",
+		Long: `Open a remote shell on a gateway
+
+The input is written to the shell once the session is open, and the output of
+the shell is written to stdout until the timeout expires. Include "exit" in the
+input to end the shell on the gateway.
+
+The gateway must be connected to the Gateway Server with a protocol that
+supports remote control, such as LoRa Basics Station LNS.`,
+		Example: `  ttn-lw-cli gateways remote-shell my-gateway --input "logread | tail -n 50" --timeout 10s`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GatewayRemoteShellRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			req.User, _ = cmd.Flags().GetString("user")
+			req.Term, _ = cmd.Flags().GetString("term")
+			if input, _ := cmd.Flags().GetString("input"); input != "" {
+				req.Input = []byte(input + "\n")
+			} else if r, err := getDataReader("input", cmd.Flags()); err == nil {
+				if req.Input, err = ioutil.ReadAll(r); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("timeout") {
+				timeout, _ := cmd.Flags().GetDuration("timeout")
+				req.Timeout = &timeout
+			}
+
+			gs, err := dialGatewayServerForGateway(gtwID)
+			if err != nil {
+				return err
+			}
+			stream, err := ttnpb.NewGsClient(gs).GatewayRemoteShell(ctx, req)
+			if err != nil {
+				return err
+			}
+			for {
+				out, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, stdio.EOF) {
+						return nil
+					}
+					return err
+				}
+				if _, err := os.Stdout.Write(out.Data); err != nil {
+					return err
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	gatewaysRunCommandCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysRunCommandCommand.Flags().String("command", "", "command to run")
+	gatewaysRunCommandCommand.Flags().StringSlice("arguments", nil, "arguments of the command")
+	gatewaysCommand.AddCommand(gatewaysRunCommandCommand)
+	gatewaysRemoteShellCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysRemoteShellCommand.Flags().String("user", "", "user to run the shell as")
+	gatewaysRemoteShellCommand.Flags().String("term", "", "terminal type of the shell")
+	gatewaysRemoteShellCommand.Flags().String("input", "", "input line to write to the shell")
+	gatewaysRemoteShellCommand.Flags().AddFlagSet(dataFlags("input", "input to write to the shell"))
+	gatewaysRemoteShellCommand.Flags().Duration("timeout", 0, "duration after which the session is closed (default 1m)")
+	gatewaysCommand.AddCommand(gatewaysRemoteShellCommand)
+}
@@ -0,0 +1,137 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var statsHistoryCSVHeader = []string{
+	"time",
+	"samples",
+	"uplink_count",
+	"downlink_count",
+	"rtt_median_ms",
+	"rtt_p90_ms",
+	"rtt_max_ms",
+	"rtt_count",
+	"max_downlink_utilization",
+	"last_status_received_at",
+}
+
+func formatMilliseconds(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', 3, 64)
+}
+
+// statsHistoryCSVRecord returns the CSV record of the sample. Empty values mean that there is no data.
+// The downlink utilization is the highest utilization of all sub bands, as fraction of the sub band limit.
+func statsHistoryCSVRecord(sample *ttnpb.GatewayConnectionStatsSample) []string {
+	record := make([]string, len(statsHistoryCSVHeader))
+	record[0] = sample.Time.UTC().Format(time.RFC3339)
+	record[1] = strconv.FormatUint(uint64(sample.Samples), 10)
+	record[2] = strconv.FormatUint(sample.UplinkCount, 10)
+	record[3] = strconv.FormatUint(sample.DownlinkCount, 10)
+	if rtt := sample.RoundTripTimes; rtt != nil {
+		record[4] = formatMilliseconds(rtt.Median)
+		record[5] = formatMilliseconds(rtt.P90)
+		record[6] = formatMilliseconds(rtt.Max)
+		record[7] = strconv.FormatUint(uint64(rtt.Count), 10)
+	}
+	if len(sample.SubBands) > 0 {
+		var max float64
+		for _, sb := range sample.SubBands {
+			if sb.DownlinkUtilizationLimit == 0 {
+				continue
+			}
+			if u := float64(sb.DownlinkUtilization / sb.DownlinkUtilizationLimit); u > max {
+				max = u
+			}
+		}
+		record[8] = strconv.FormatFloat(max, 'f', 4, 64)
+	}
+	if sample.LastStatusReceivedAt != nil {
+		record[9] = sample.LastStatusReceivedAt.UTC().Format(time.RFC3339)
+	}
+	return record
+}
+
+var gatewaysConnectionStatsHistory = &cobra.Command{
+	Use:     "get-connection-stats-history [gateway-id]",
+	Aliases: []string{"connection-stats-history", "cnx-stats-history", "stats-history"},
+	Short:   "Get the connection stats history of a gateway as CSV (Gateway Server only)",
+	Long: `Get the connection stats history of a gateway as CSV (Gateway Server only)
+
+The history contains the sampled connection stats of the gateway, aggregated
+to the requested resolution. By default, the last 24 hours are returned in the
+finest stored resolution. Intervals in which the gateway was not connected
+have no record. The number of samples per record can be used to compute
+availability.`,
+	Example: `  ttn-lw-cli gateways get-connection-stats-history my-gateway --from-utc "2021-06-01 00:00:00" --resolution 1h > history.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		req := &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+			GatewayIdentifiers: *gtwID,
+		}
+		if req.From, err = getTimestampFlags(cmd.Flags(), "from"); err != nil {
+			return err
+		}
+		if req.To, err = getTimestampFlags(cmd.Flags(), "to"); err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("resolution") {
+			resolution, _ := cmd.Flags().GetDuration("resolution")
+			req.Resolution = &resolution
+		}
+
+		gs, err := dialGatewayServerForGateway(gtwID)
+		if err != nil {
+			return err
+		}
+		res, err := ttnpb.NewGsClient(gs).GetGatewayConnectionStatsHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		logger.WithField("resolution", res.Resolution).Debug("Received connection stats history")
+
+		w := csv.NewWriter(os.Stdout)
+		if err := w.Write(statsHistoryCSVHeader); err != nil {
+			return err
+		}
+		for _, sample := range res.Samples {
+			if err := w.Write(statsHistoryCSVRecord(sample)); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	},
+}
+
+func init() {
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("from", "start of the time range"))
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("to", "end of the time range"))
+	gatewaysConnectionStatsHistory.Flags().Duration("resolution", 0, "resolution of the samples")
+	gatewaysCommand.AddCommand(gatewaysConnectionStatsHistory)
+}
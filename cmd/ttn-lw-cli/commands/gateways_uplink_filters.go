@@ -0,0 +1,110 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	ttntypes "go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errJoinEUIRange = errors.DefineInvalidArgument("join_eui_range", "invalid JoinEUI range `{range}`")
+
+func uplinkFiltersFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("uplink_filters.allow_dev_addr_prefixes", nil, "DevAddr prefixes of data uplink messages that are forwarded (for example 26000000/7)")
+	flagSet.StringSlice("uplink_filters.deny_dev_addr_prefixes", nil, "DevAddr prefixes of data uplink messages that are dropped (for example 26000000/7)")
+	flagSet.StringSlice("uplink_filters.allow_join_eui_ranges", nil, "JoinEUI ranges of join-request messages that are forwarded (for example 70B3D57ED0000000-70B3D57ED0FFFFFF)")
+	flagSet.StringSlice("uplink_filters.deny_join_eui_ranges", nil, "JoinEUI ranges of join-request messages that are dropped (for example 70B3D57ED0000000-70B3D57ED0FFFFFF)")
+	return flagSet
+}
+
+func parseDevAddrPrefixes(values []string) ([]*ttnpb.DevAddrPrefix, error) {
+	res := make([]*ttnpb.DevAddrPrefix, 0, len(values))
+	for _, value := range values {
+		var prefix ttntypes.DevAddrPrefix
+		if err := prefix.UnmarshalText([]byte(value)); err != nil {
+			return nil, err
+		}
+		res = append(res, &ttnpb.DevAddrPrefix{
+			DevAddr: &prefix.DevAddr,
+			Length:  uint32(prefix.Length),
+		})
+	}
+	return res, nil
+}
+
+func parseJoinEUIRanges(values []string) ([]*ttnpb.GatewayUplinkFilters_JoinEUIRange, error) {
+	res := make([]*ttnpb.GatewayUplinkFilters_JoinEUIRange, 0, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "-", 2)
+		if len(parts) != 2 {
+			return nil, errJoinEUIRange.WithAttributes("range", value)
+		}
+		var r ttnpb.GatewayUplinkFilters_JoinEUIRange
+		if err := r.From.UnmarshalText([]byte(parts[0])); err != nil {
+			return nil, errJoinEUIRange.WithCause(err).WithAttributes("range", value)
+		}
+		if err := r.To.UnmarshalText([]byte(parts[1])); err != nil {
+			return nil, errJoinEUIRange.WithCause(err).WithAttributes("range", value)
+		}
+		res = append(res, &r)
+	}
+	return res, nil
+}
+
+// setUplinkFilters sets the DevAddr prefixes and JoinEUI ranges of the uplink filters from the flags.
+func setUplinkFilters(gateway *ttnpb.Gateway, flagSet *pflag.FlagSet) (err error) {
+	filters := gateway.UplinkFilters
+	if filters == nil {
+		filters = &ttnpb.GatewayUplinkFilters{}
+	}
+	for _, devAddrPrefixes := range []struct {
+		name string
+		dst  *[]*ttnpb.DevAddrPrefix
+	}{
+		{"uplink_filters.allow_dev_addr_prefixes", &filters.AllowDevAddrPrefixes},
+		{"uplink_filters.deny_dev_addr_prefixes", &filters.DenyDevAddrPrefixes},
+	} {
+		if !flagSet.Changed(devAddrPrefixes.name) {
+			continue
+		}
+		values, _ := flagSet.GetStringSlice(devAddrPrefixes.name)
+		if *devAddrPrefixes.dst, err = parseDevAddrPrefixes(values); err != nil {
+			return err
+		}
+		gateway.UplinkFilters = filters
+	}
+	for _, joinEUIRanges := range []struct {
+		name string
+		dst  *[]*ttnpb.GatewayUplinkFilters_JoinEUIRange
+	}{
+		{"uplink_filters.allow_join_eui_ranges", &filters.AllowJoinEUIRanges},
+		{"uplink_filters.deny_join_eui_ranges", &filters.DenyJoinEUIRanges},
+	} {
+		if !flagSet.Changed(joinEUIRanges.name) {
+			continue
+		}
+		values, _ := flagSet.GetStringSlice(joinEUIRanges.name)
+		if *joinEUIRanges.dst, err = parseJoinEUIRanges(values); err != nil {
+			return err
+		}
+		gateway.UplinkFilters = filters
+	}
+	return nil
+}
@@ -108,3 +108,65 @@ func getStoredUpRequest(flags *pflag.FlagSet) (*ttnpb.GetStoredApplicationUpRequ
 	}
 	return req, nil
 }
+
+func getStoredUpCountFlags() *pflag.FlagSet {
+	flags := &pflag.FlagSet{}
+
+	flags.Uint32("f-port", 0, "count upstream messages with specific FPort")
+	flags.AddFlagSet(timestampFlags("after", "count upstream messages after specified timestamp"))
+	flags.AddFlagSet(timestampFlags("before", "count upstream messages before specified timestamp"))
+	flags.Duration("last", 0, "count upstream messages in the last hours or minutes")
+
+	types := make([]string, 0, len(ttnpb.StoredApplicationUpTypes))
+	for k := range ttnpb.StoredApplicationUpTypes {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	flags.String("type", "", fmt.Sprintf("message type (allowed values: %s)", strings.Join(types, ", ")))
+
+	return flags
+}
+
+func getStoredUpCountRequest(flags *pflag.FlagSet) (*ttnpb.GetStoredApplicationUpCountRequest, error) {
+	var err error
+	req := &ttnpb.GetStoredApplicationUpCountRequest{}
+
+	if flags.Changed("last") && (hasTimestampFlags(flags, "after") || hasTimestampFlags(flags, "before")) {
+		return nil, fmt.Errorf("--last cannot be used with --after or --before flags")
+	}
+	after, err := getTimestampFlags(flags, "after")
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		if req.After, err = pbtypes.TimestampProto(*after); err != nil {
+			return nil, err
+		}
+	}
+	before, err := getTimestampFlags(flags, "before")
+	if err != nil {
+		return nil, err
+	}
+	if before != nil {
+		if req.Before, err = pbtypes.TimestampProto(*before); err != nil {
+			return nil, err
+		}
+	}
+
+	if flags.Changed("last") {
+		d, err := flags.GetDuration("last")
+		if err != nil {
+			return nil, err
+		}
+		req.Last = pbtypes.DurationProto(d)
+	}
+	req.Type, _ = flags.GetString("type")
+
+	if flags.Changed("f-port") {
+		fport, _ := flags.GetUint32("f-port")
+		req.FPort = &pbtypes.UInt32Value{
+			Value: fport,
+		}
+	}
+	return req, nil
+}
@@ -26,7 +26,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	asiofuota "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota"
+	asiofuotaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
+	asiostorage "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
@@ -236,6 +239,21 @@ var startCommand = &cobra.Command{
 					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats")),
 				}
 			}
+			if config.GS.StatsHistory.SampleInterval > 0 {
+				config.GS.StatsHistory.Store = &gsredis.GatewayConnectionStatsHistory{
+					Redis: redis.New(config.Redis.WithNamespace("gs", "connstats", "history")),
+					Tiers: []gsredis.HistoryTier{
+						{
+							Resolution: config.GS.StatsHistory.SampleInterval,
+							Retention:  config.GS.StatsHistory.SampleRetention,
+						},
+						{
+							Resolution: config.GS.StatsHistory.DownsampleInterval,
+							Retention:  config.GS.StatsHistory.DownsampleRetention,
+						},
+					},
+				}
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -284,6 +302,15 @@ var startCommand = &cobra.Command{
 			config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
 				Redis: redis.New(config.Redis.WithNamespace("ns", "scheduled-downlinks")),
 			}
+			config.NS.Relays = &nsredis.RelayRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "relays")),
+			}
+			config.NS.AirtimeBudget.Registry = &nsredis.AirtimeRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "airtime")),
+			}
+			config.NS.DownlinkAttempts.Registry = &nsredis.DownlinkAttemptRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "downlink-attempts")),
+			}
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
@@ -312,6 +339,20 @@ var startCommand = &cobra.Command{
 			config.AS.Packages.Registry = &asioapredis.ApplicationPackagesRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages")),
 			}
+			config.AS.Packages.FUOTA.Registry = &asiofuotaredis.SessionRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("as", "io", "fuota")),
+			}
+			config.AS.Packages.FUOTA.Devices = &asiofuota.ClusterDeviceRegistry{
+				Cluster: c,
+			}
+			if config.AS.Packages.Storage.DatabaseURI != "" {
+				storage, err := asiostorage.OpenSQL(ctx, config.AS.Packages.Storage.Dialect, config.AS.Packages.Storage.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				defer storage.Close()
+				config.AS.Packages.Storage.Storage = storage
+			}
 			if config.AS.Webhooks.Target != "" {
 				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{
 					Redis: redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
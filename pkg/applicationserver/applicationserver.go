@@ -30,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/amqp"  // The AMQP integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka" // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"  // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"  // The NATS integration provider
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -44,6 +46,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -118,6 +121,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
+	jsOptions, err := conf.Formatters.JavaScript.Options()
+	if err != nil {
+		return nil, err
+	}
+
 	as = &ApplicationServer{
 		Component:      c,
 		ctx:            ctx,
@@ -126,7 +134,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		deviceRegistry: wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
 		appUpsRegistry: conf.UplinkStorage.Registry,
 		formatters: messageprocessors.MapPayloadProcessor{
-			ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
+			ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.NewWithOptions(jsOptions),
 			ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
 		},
 		clusterDistributor: distribution.NewPubSubDistributor(ctx, c, conf.Distribution.Timeout, conf.Distribution.PubSub),
@@ -136,6 +144,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		endDeviceFetcher:   conf.EndDeviceFetcher.Fetcher,
 	}
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(as.formatters, as)
+	as.formatters[ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE] = grpcservice.New(ctx, conf.Formatters.GRPCService, func(ctx context.Context) (*tls.Config, error) {
+		return c.GetTLSClientConfig(ctx)
+	})
 
 	if as.endDeviceFetcher == nil {
 		as.endDeviceFetcher = &NoopEndDeviceFetcher{}
@@ -23,14 +23,20 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/gatewaygeolocation"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -67,7 +73,47 @@ type EndDeviceFetcherCircuitBreakerConfig struct {
 }
 
 type FormattersConfig struct {
-	MaxParameterLength int `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	MaxParameterLength int                       `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	JavaScript         JavaScriptFormatterConfig `name:"javascript" description:"JavaScript payload formatter configuration"`
+	GRPCService        grpcservice.Config        `name:"grpc-service" description:"gRPC service payload formatter configuration"`
+}
+
+// JavaScriptFormatterConfig defines the resource limits of the JavaScript payload formatter.
+type JavaScriptFormatterConfig struct {
+	Timeout         time.Duration         `name:"timeout" description:"Maximum execution time of a single run"`
+	StackDepthLimit int                   `name:"stack-depth-limit" description:"Maximum call stack depth of a single run"`
+	AllocationLimit int                   `name:"allocation-limit" description:"Maximum number of array elements, buffer bytes or string characters allocated by a single operation (0 is unlimited)"`
+	Quota           JavaScriptQuotaConfig `name:"quota" description:"Cumulative execution time quota per application"`
+}
+
+// JavaScriptQuotaConfig defines the cumulative execution time quota of JavaScript payload formatters per application.
+type JavaScriptQuotaConfig struct {
+	ExecutionTime time.Duration `name:"execution-time" description:"Execution time per application per interval (0 is unlimited)"`
+	Interval      time.Duration `name:"interval" description:"Interval after which the execution time quota resets"`
+}
+
+var errInvalidQuotaInterval = errors.DefineInvalidArgument("invalid_quota_interval", "invalid JavaScript quota interval `{interval}`")
+
+// Options returns the scripting options of the JavaScript payload formatter.
+func (c JavaScriptFormatterConfig) Options() (scripting.Options, error) {
+	opts := scripting.Options{
+		Timeout:         c.Timeout,
+		StackDepthLimit: c.StackDepthLimit,
+		AllocationLimit: c.AllocationLimit,
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = scripting.DefaultOptions.Timeout
+	}
+	if opts.StackDepthLimit <= 0 {
+		opts.StackDepthLimit = scripting.DefaultOptions.StackDepthLimit
+	}
+	if c.Quota.ExecutionTime > 0 {
+		if c.Quota.Interval <= 0 {
+			return scripting.Options{}, errInvalidQuotaInterval.WithAttributes("interval", c.Quota.Interval)
+		}
+		opts.Quota = scripting.NewExecutionTimeQuota(c.Quota.ExecutionTime, c.Quota.Interval)
+	}
+	return opts, nil
 }
 
 // Config represents the ApplicationServer configuration.
@@ -151,6 +197,12 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 	if status, ok := c.Providers["awsiot"]; ok {
 		providers.AWSIoT = toStatus(status)
 	}
+	if status, ok := c.Providers["amqp"]; ok {
+		providers.AMQP = toStatus(status)
+	}
+	if status, ok := c.Providers["kafka"]; ok {
+		providers.Kafka = toStatus(status)
+	}
 	return &ttnpb.AsConfiguration_PubSub{
 		Providers: providers,
 	}
@@ -159,7 +211,10 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 // ApplicationPackagesConfig contains application packages associations configuration.
 type ApplicationPackagesConfig struct {
 	packages.Config `name:",squash"`
-	Registry        packages.Registry `name:"-"`
+	Registry        packages.Registry         `name:"-"`
+	Storage         storage.Config            `name:"storage" description:"Storage integration configuration"`
+	FUOTA           fuota.Config              `name:"fuota" description:"FUOTA package configuration"`
+	Geolocation     gatewaygeolocation.Config `name:"gateway-geolocation" description:"Gateway geolocation package configuration"`
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
@@ -228,6 +283,22 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Cloud Geolocation v3 package handler
 	handlers[loracloudgeolocationv3.PackageName] = loracloudgeolocationv3.New(server, c.Registry)
 
+	// Initialize the gateway geolocation package handler
+	handlers[gatewaygeolocation.PackageName] = gatewaygeolocation.New(server, c.Registry, c.Geolocation)
+
+	// Initialize Application Layer Clock Synchronization v1 package handler
+	handlers[alcsyncv1.PackageName] = alcsyncv1.New(server, c.Registry)
+
+	// Initialize the storage integration package handler
+	if c.Storage.Storage != nil {
+		handlers[storage.PackageName] = storage.New(ctx, server, c.Storage)
+	}
+
+	// Initialize the FUOTA package handler
+	if c.FUOTA.Registry != nil && c.FUOTA.Devices != nil {
+		handlers[fuota.PackageName] = fuota.New(ctx, server, c.Registry, c.FUOTA)
+	}
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers)
 }
 
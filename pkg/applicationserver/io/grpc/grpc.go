@@ -16,6 +16,8 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
@@ -267,3 +269,102 @@ func (as *impl) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkReq
 		Downlink: req.Downlink,
 	}, nil
 }
+
+func (as *impl) TestPayloadFormatter(ctx context.Context, req *ttnpb.TestPayloadFormatterRequest) (*ttnpb.TestPayloadFormatterResponse, error) {
+	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.TestPayloadFormatterResponse{
+		Results: make([]*ttnpb.PayloadFormatterTestResult, 0, len(req.Cases)),
+	}
+	for _, tc := range req.Cases {
+		result, err := as.testPayloadFormatter(ctx, req, tc)
+		if err != nil {
+			return nil, err
+		}
+		if result.Passed {
+			res.Passed++
+		} else {
+			res.Failed++
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// testPayloadFormatter decodes the payload of the given test case and compares the outcome with the expectations.
+// An error is only returned if the test harness itself failed, i.e. when the context is done.
+func (as *impl) testPayloadFormatter(ctx context.Context, req *ttnpb.TestPayloadFormatterRequest, tc *ttnpb.PayloadFormatterTestCase) (*ttnpb.PayloadFormatterTestResult, error) {
+	var (
+		decoded  *pbtypes.Struct
+		warnings []string
+		err      error
+	)
+	if tc.Downlink {
+		down := &ttnpb.ApplicationDownlink{
+			FPort:      tc.FPort,
+			FRMPayload: tc.FRMPayload,
+		}
+		err = as.processor.DecodeDownlink(ctx, *req.EndDeviceIds, req.VersionIds, down, req.Formatter, req.Parameter)
+		decoded, warnings = down.DecodedPayload, down.DecodedPayloadWarnings
+	} else {
+		up := &ttnpb.ApplicationUplink{
+			FPort:      tc.FPort,
+			FRMPayload: tc.FRMPayload,
+		}
+		err = as.processor.DecodeUplink(ctx, *req.EndDeviceIds, req.VersionIds, up, req.Formatter, req.Parameter)
+		decoded, warnings = up.DecodedPayload, up.DecodedPayloadWarnings
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+
+	res := &ttnpb.PayloadFormatterTestResult{
+		Name: tc.Name,
+	}
+	if err != nil {
+		if ttnErr, ok := errors.From(err); ok {
+			res.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+		}
+		if len(tc.ExpectedErrors) == 0 {
+			res.Failures = append(res.Failures, fmt.Sprintf("unexpected error: %v", err))
+		}
+		var messages []string
+		for cause := err; cause != nil; cause = errors.Cause(cause) {
+			messages = append(messages, cause.Error())
+		}
+		message := strings.Join(messages, ": ")
+		for _, expected := range tc.ExpectedErrors {
+			if !strings.Contains(message, expected) {
+				res.Failures = append(res.Failures, fmt.Sprintf("expected error `%s`, got `%s`", expected, message))
+			}
+		}
+		res.Passed = len(res.Failures) == 0
+		return res, nil
+	}
+
+	res.DecodedPayload, res.Warnings = decoded, warnings
+	if len(tc.ExpectedErrors) > 0 {
+		res.Failures = append(res.Failures, fmt.Sprintf("expected errors `%s`, got none", strings.Join(tc.ExpectedErrors, "`, `")))
+	}
+	if tc.ExpectedDecodedPayload != nil && !tc.ExpectedDecodedPayload.Equal(decoded) {
+		res.Failures = append(res.Failures, "decoded payload does not match expected decoded payload")
+	}
+	if !stringSlicesEqual(tc.ExpectedWarnings, warnings) {
+		res.Failures = append(res.Failures, fmt.Sprintf("expected warnings `%s`, got `%s`", strings.Join(tc.ExpectedWarnings, "`, `"), strings.Join(warnings, "`, `")))
+	}
+	res.Passed = len(res.Failures) == 0
+	return res, nil
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
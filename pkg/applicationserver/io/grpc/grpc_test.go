@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -656,3 +657,136 @@ func TestMessageProcessors(t *testing.T) {
 		}
 	}
 }
+
+func TestTestPayloadFormatter(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	is, isAddr := startMockIS(ctx)
+	is.add(ctx, registeredApplicationID, registeredApplicationKey)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	as := mock.NewServer(c)
+	srv := New(as, WithPayloadProcessor(&messageprocessors.MapPayloadProcessor{
+		ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
+	}))
+	c.RegisterGRPC(&mockRegisterer{ctx, srv})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	client := ttnpb.NewAppAsClient(c.LoopbackConn())
+
+	creds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     registeredApplicationKey,
+		AllowInsecure: true,
+	})
+
+	script := `
+function decodeUplink(input) {
+	if (input.bytes.length === 0) {
+		return { errors: ["empty payload"] };
+	}
+	var warnings = [];
+	if (input.bytes[0] > 100) {
+		warnings.push("value out of range");
+	}
+	return { data: { value: input.bytes[0] }, warnings: warnings };
+}
+
+function decodeDownlink(input) {
+	return { data: { state: input.bytes[0] === 1 ? "on" : "off" } };
+}
+`
+	valueStruct := func(k string, v *pbtypes.Value) *pbtypes.Struct {
+		return &pbtypes.Struct{Fields: map[string]*pbtypes.Value{k: v}}
+	}
+	numberValue := func(v float64) *pbtypes.Value {
+		return &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: v}}
+	}
+
+	resp, err := client.TestPayloadFormatter(ctx, &ttnpb.TestPayloadFormatterRequest{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			DeviceId:               "foobar",
+		},
+		Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+		Parameter: script,
+		Cases: []*ttnpb.PayloadFormatterTestCase{
+			{
+				Name:                   "uplink",
+				FPort:                  1,
+				FRMPayload:             []byte{42},
+				ExpectedDecodedPayload: valueStruct("value", numberValue(42)),
+			},
+			{
+				Name:                   "uplink warning",
+				FPort:                  1,
+				FRMPayload:             []byte{200},
+				ExpectedDecodedPayload: valueStruct("value", numberValue(200)),
+				ExpectedWarnings:       []string{"value out of range"},
+			},
+			{
+				Name:           "uplink error",
+				FPort:          1,
+				ExpectedErrors: []string{"empty payload"},
+			},
+			{
+				Name:                   "downlink",
+				Downlink:               true,
+				FPort:                  1,
+				FRMPayload:             []byte{1},
+				ExpectedDecodedPayload: valueStruct("state", &pbtypes.Value{Kind: &pbtypes.Value_StringValue{StringValue: "on"}}),
+			},
+			{
+				Name:                   "uplink mismatch",
+				FPort:                  1,
+				FRMPayload:             []byte{43},
+				ExpectedDecodedPayload: valueStruct("value", numberValue(42)),
+			},
+			{
+				Name:           "uplink unexpected success",
+				FPort:          1,
+				FRMPayload:     []byte{42},
+				ExpectedErrors: []string{"empty payload"},
+			},
+			{
+				Name:  "uplink unexpected error",
+				FPort: 1,
+			},
+		},
+	}, creds)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(resp.Passed, should.Equal, 4)
+	a.So(resp.Failed, should.Equal, 3)
+	if !a.So(resp.Results, should.HaveLength, 7) {
+		t.FailNow()
+	}
+	for i, passed := range []bool{true, true, true, true, false, false, false} {
+		a.So(resp.Results[i].Passed, should.Equal, passed)
+		if passed {
+			a.So(resp.Results[i].Failures, should.BeEmpty)
+		} else {
+			a.So(resp.Results[i].Failures, should.HaveLength, 1)
+		}
+	}
+	a.So(resp.Results[0].DecodedPayload, should.Resemble, valueStruct("value", numberValue(42)))
+	a.So(resp.Results[1].Warnings, should.Resemble, []string{"value out of range"})
+	a.So(resp.Results[2].Error, should.NotBeNil)
+	a.So(resp.Results[4].DecodedPayload, should.Resemble, valueStruct("value", numberValue(43)))
+	a.So(resp.Results[6].Error, should.NotBeNil)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"encoding/binary"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Application Layer Clock Synchronization (TS003) commands.
+const (
+	packageVersion    = 0x00
+	appTime           = 0x01
+	appTimePeriodic   = 0x02
+	forceDeviceResync = 0x03
+
+	packageVersionAnsLen  = 2
+	appTimeReqLen         = 5
+	appTimePeriodicAnsLen = 5
+
+	maxPeriodicity     = 0xf
+	maxNbTransmissions = 0x7
+)
+
+var errInvalidCommand = errors.DefineInvalidArgument("invalid_command", "invalid command `{cid}`")
+
+// appTimeRequest is an AppTimeReq command of an end device.
+type appTimeRequest struct {
+	DeviceTime  uint32
+	Token       byte
+	AnsRequired bool
+}
+
+// periodicityAnswer is a DeviceAppTimePeriodicityAns command of an end device.
+type periodicityAnswer struct {
+	NotSupported bool
+	DeviceTime   uint32
+}
+
+// commands contains the commands of an uplink message.
+type commands struct {
+	AppTimeRequests     []appTimeRequest
+	PeriodicityAnswers  []periodicityAnswer
+	PackageVersionFound bool
+}
+
+// parseCommands parses the Application Layer Clock Synchronization commands in the payload.
+func parseCommands(b []byte) (*commands, error) {
+	var res commands
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case packageVersion:
+			if len(b) < packageVersionAnsLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid)
+			}
+			res.PackageVersionFound = true
+			b = b[packageVersionAnsLen:]
+		case appTime:
+			if len(b) < appTimeReqLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid)
+			}
+			res.AppTimeRequests = append(res.AppTimeRequests, appTimeRequest{
+				DeviceTime:  binary.LittleEndian.Uint32(b[0:4]),
+				Token:       b[4] & 0xf,
+				AnsRequired: b[4]&0x10 != 0,
+			})
+			b = b[appTimeReqLen:]
+		case appTimePeriodic:
+			if len(b) < appTimePeriodicAnsLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid)
+			}
+			res.PeriodicityAnswers = append(res.PeriodicityAnswers, periodicityAnswer{
+				NotSupported: b[0]&0x1 != 0,
+				DeviceTime:   binary.LittleEndian.Uint32(b[1:5]),
+			})
+			b = b[appTimePeriodicAnsLen:]
+		default:
+			return nil, errInvalidCommand.WithAttributes("cid", cid)
+		}
+	}
+	return &res, nil
+}
+
+// correction returns the correction of the device time, in seconds since the GPS epoch, for an uplink message
+// transmitted at the given time.
+func correction(deviceTime uint32, transmittedAt time.Time) int32 {
+	return int32(uint32(gpstime.ToGPS(transmittedAt)/time.Second) - deviceTime)
+}
+
+// appTimeAns returns the AppTimeAns command with the given correction and token.
+func appTimeAns(correction int32, token byte) []byte {
+	b := make([]byte, 6)
+	b[0] = appTime
+	binary.LittleEndian.PutUint32(b[1:5], uint32(correction))
+	b[5] = token & 0xf
+	return b
+}
+
+// AppTimeAnswers returns the AppTimeAns commands that answer the AppTimeReq commands in the given uplink message.
+// No answer is returned to end devices that do not require one and whose clock is correct.
+// This is used by application packages that answer clock synchronization requests themselves.
+func AppTimeAnswers(msg *ttnpb.ApplicationUplink) ([][]byte, error) {
+	cmds, err := parseCommands(msg.FRMPayload)
+	if err != nil {
+		return nil, err
+	}
+	transmittedAt := transmissionTime(msg)
+	var res [][]byte
+	for _, req := range cmds.AppTimeRequests {
+		c := correction(req.DeviceTime, transmittedAt)
+		if c == 0 && !req.AnsRequired {
+			continue
+		}
+		res = append(res, appTimeAns(c, req.Token))
+	}
+	return res, nil
+}
+
+// periodicityReq returns the DeviceAppTimePeriodicityReq command. The end device synchronizes its clock every
+// 128*2^period seconds.
+func periodicityReq(period uint32) []byte {
+	return []byte{appTimePeriodic, byte(period & maxPeriodicity)}
+}
+
+// forceResyncReq returns the ForceDeviceResyncReq command. The end device sends the given number of AppTimeReq
+// commands.
+func forceResyncReq(nbTransmissions uint32) []byte {
+	return []byte{forceDeviceResync, byte(nbTransmissions & maxNbTransmissions)}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestParseCommands(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Expected *commands
+		Error    bool
+	}{
+		{
+			Name:     "PackageVersion",
+			Payload:  []byte{0x00, 0x01, 0x01},
+			Expected: &commands{PackageVersionFound: true},
+		},
+		{
+			Name:    "AppTimeReq",
+			Payload: []byte{0x01, 0x64, 0x00, 0x00, 0x00, 0x13},
+			Expected: &commands{
+				AppTimeRequests: []appTimeRequest{
+					{DeviceTime: 100, Token: 3, AnsRequired: true},
+				},
+			},
+		},
+		{
+			Name:    "PeriodicityAnsAndAppTimeReq",
+			Payload: []byte{0x02, 0x01, 0x64, 0x00, 0x00, 0x00, 0x01, 0xc8, 0x00, 0x00, 0x00, 0x02},
+			Expected: &commands{
+				AppTimeRequests: []appTimeRequest{
+					{DeviceTime: 200, Token: 2},
+				},
+				PeriodicityAnswers: []periodicityAnswer{
+					{NotSupported: true, DeviceTime: 100},
+				},
+			},
+		},
+		{
+			Name:    "Truncated",
+			Payload: []byte{0x01, 0x64, 0x00},
+			Error:   true,
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x7f},
+			Error:   true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := parseCommands(tc.Payload)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	a := assertions.New(t)
+
+	// 1980-01-06T00:01:40Z is 100 seconds after the GPS epoch.
+	transmittedAt := time.Date(1980, time.January, 6, 0, 1, 40, 0, time.UTC)
+	a.So(correction(90, transmittedAt), should.Equal, 10)
+	a.So(correction(110, transmittedAt), should.Equal, -10)
+
+	a.So(appTimeAns(-10, 3), should.Resemble, []byte{0x01, 0xf6, 0xff, 0xff, 0xff, 0x03})
+	a.So(periodicityReq(4), should.Resemble, []byte{0x02, 0x04})
+	a.So(forceResyncReq(3), should.Resemble, []byte{0x03, 0x03})
+
+	// The request of the end device with the correct clock is not answered.
+	answers, err := AppTimeAnswers(&ttnpb.ApplicationUplink{
+		FRMPayload: []byte{0x01, 0x5a, 0x00, 0x00, 0x00, 0x03, 0x01, 0x64, 0x00, 0x00, 0x00, 0x04},
+		ReceivedAt: transmittedAt,
+	})
+	a.So(err, should.BeNil)
+	a.So(answers, should.Resemble, [][]byte{{0x01, 0x0a, 0x00, 0x00, 0x00, 0x03}})
+}
@@ -0,0 +1,111 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// packageData contains the package configuration.
+// The threshold is taken from the default association and the association of the end device. The periodicity and
+// forced resynchronization are requests to the end device, which are only taken from the association of the end
+// device and removed from the association once the request is queued.
+type packageData struct {
+	threshold   time.Duration
+	periodicity *uint32
+	forceResync *uint32
+}
+
+const (
+	thresholdField   = "threshold"
+	periodicityField = "periodicity"
+	forceResyncField = "force_resync"
+
+	defaultThreshold = 4 * time.Second
+)
+
+var (
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineCorruption("invalid_field_value", "field `{field}` has the invalid value `{value}`")
+)
+
+func numberField(st *types.Struct, field string, max float64) (*float64, error) {
+	value, ok := st.GetFields()[field]
+	if !ok {
+		return nil, nil
+	}
+	numberValue, ok := value.GetKind().(*types.Value_NumberValue)
+	if !ok {
+		return nil, errInvalidFieldType.WithAttributes(
+			"field", field,
+			"type", fmt.Sprintf("%T", value.GetKind()),
+		)
+	}
+	if n := numberValue.NumberValue; n < 0 || n > max {
+		return nil, errInvalidFieldValue.WithAttributes(
+			"field", field,
+			"value", n,
+		)
+	}
+	return &numberValue.NumberValue, nil
+}
+
+func (d *packageData) fromStruct(st *types.Struct, requests bool) error {
+	threshold, err := numberField(st, thresholdField, float64(1<<31))
+	if err != nil {
+		return err
+	}
+	if threshold != nil {
+		d.threshold = time.Duration(*threshold * float64(time.Second))
+	}
+	if !requests {
+		return nil
+	}
+	periodicity, err := numberField(st, periodicityField, maxPeriodicity)
+	if err != nil {
+		return err
+	}
+	if periodicity != nil {
+		v := uint32(*periodicity)
+		d.periodicity = &v
+	}
+	forceResync, err := numberField(st, forceResyncField, maxNbTransmissions)
+	if err != nil {
+		return err
+	}
+	if forceResync != nil && *forceResync > 0 {
+		v := uint32(*forceResync)
+		d.forceResync = &v
+	}
+	return nil
+}
+
+// mergePackageData merges the data of the default association and the association of the end device.
+func mergePackageData(defaultData, associationData *types.Struct) (*packageData, error) {
+	merged := &packageData{
+		threshold: defaultThreshold,
+	}
+	if err := merged.fromStruct(defaultData, false); err != nil {
+		return nil, err
+	}
+	if err := merged.fromStruct(associationData, true); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
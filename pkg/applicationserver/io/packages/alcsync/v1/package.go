@@ -0,0 +1,199 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alcsyncv1 implements the LoRaWAN Application Layer Clock Synchronization (TS003) application package.
+package alcsyncv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName defines the package name.
+const PackageName = "alcsync-v1"
+
+// ClockSyncPackage is the Application Layer Clock Synchronization application package.
+type ClockSyncPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var (
+	errNoAssociation         = errors.DefineInternal("no_association", "no association available")
+	errPeriodicityNotSupport = errors.DefineFailedPrecondition("periodicity_not_supported", "end device does not support periodic clock synchronization")
+)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/alcsync/v1")
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:alcsyncv1:%s", events.NewCorrelationID()))...)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	var (
+		defaultData, associationData *types.Struct
+		fPort                        uint32
+	)
+	if def != nil {
+		defaultData, fPort = def.Data, def.FPort
+	}
+	if assoc != nil {
+		associationData, fPort = assoc.Data, assoc.FPort
+	}
+	data, err := mergePackageData(defaultData, associationData)
+	if err != nil {
+		return err
+	}
+
+	var downlinks []*ttnpb.ApplicationDownlink
+	if msg.FPort == fPort {
+		cmds, err := parseCommands(msg.FRMPayload)
+		if err != nil {
+			return err
+		}
+		transmittedAt := transmissionTime(msg)
+		for _, req := range cmds.AppTimeRequests {
+			c := correction(req.DeviceTime, transmittedAt)
+			p.checkCorrection(ctx, up.EndDeviceIdentifiers, c, data.threshold)
+			if c == 0 && !req.AnsRequired {
+				continue
+			}
+			downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+				FPort:      fPort,
+				FRMPayload: appTimeAns(c, req.Token),
+			})
+		}
+		for _, ans := range cmds.PeriodicityAnswers {
+			if ans.NotSupported {
+				registerPackageFail(ctx, up.EndDeviceIdentifiers, errPeriodicityNotSupport.New())
+				continue
+			}
+			p.checkCorrection(ctx, up.EndDeviceIdentifiers, correction(ans.DeviceTime, transmittedAt), data.threshold)
+		}
+	}
+
+	var requests []byte
+	if data.periodicity != nil {
+		requests = append(requests, periodicityReq(*data.periodicity)...)
+	}
+	if data.forceResync != nil {
+		requests = append(requests, forceResyncReq(*data.forceResync)...)
+	}
+	if len(requests) > 0 {
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FRMPayload: requests,
+		})
+	}
+	if len(downlinks) == 0 {
+		return nil
+	}
+	if err := p.server.DownlinkQueuePush(ctx, up.EndDeviceIdentifiers, downlinks); err != nil {
+		return err
+	}
+	if len(requests) > 0 {
+		return p.clearRequests(ctx, assoc.ApplicationPackageAssociationIdentifiers)
+	}
+	return nil
+}
+
+// checkCorrection publishes an event if the clock correction exceeds the threshold.
+func (p *ClockSyncPackage) checkCorrection(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, correction int32, threshold time.Duration) {
+	c := time.Duration(correction) * time.Second
+	abs := c
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs == 0 || abs < threshold {
+		return
+	}
+	log.FromContext(ctx).WithField("correction", c).Debug("Correct end device clock")
+	registerCorrection(ctx, ids, c)
+}
+
+// clearRequests removes the periodicity and forced resynchronization requests from the association data.
+func (p *ClockSyncPackage) clearRequests(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers) error {
+	_, err := p.registry.SetAssociation(ctx, ids, []string{"data"}, func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+		if assoc == nil || assoc.Data == nil {
+			return assoc, nil, nil
+		}
+		delete(assoc.Data.Fields, periodicityField)
+		delete(assoc.Data.Fields, forceResyncField)
+		return assoc, []string{"data"}, nil
+	})
+	return err
+}
+
+// transmissionTime returns the time at which the uplink message was transmitted. The timestamp of the gateways is
+// preferred over the time at which the Network Server received the message.
+func transmissionTime(msg *ttnpb.ApplicationUplink) time.Time {
+	if msg.Settings.Time != nil {
+		return *msg.Settings.Time
+	}
+	var res *time.Time
+	for _, md := range msg.RxMetadata {
+		if md.Time != nil && (res == nil || md.Time.Before(*res)) {
+			res = md.Time
+		}
+	}
+	if res != nil {
+		return *res
+	}
+	return msg.ReceivedAt
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 202,
+	}
+}
+
+// New instantiates the Application Layer Clock Synchronization package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &ClockSyncPackage{
+		server:   server,
+		registry: registry,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockRegistry struct {
+	packages.Registry
+	association *ttnpb.ApplicationPackageAssociation
+}
+
+func (r *mockRegistry) SetAssociation(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers, gets []string, f func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error)) (*ttnpb.ApplicationPackageAssociation, error) {
+	assoc, _, err := f(r.association)
+	if err != nil {
+		return nil, err
+	}
+	r.association = assoc
+	return assoc, nil
+}
+
+func numberValue(n float64) *pbtypes.Value {
+	return &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: n}}
+}
+
+func TestHandleUp(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	as := mock.NewServer(c)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:               "test-dev",
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		ApplicationPackageAssociationIdentifiers: ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIdentifiers: ids,
+			FPort:                202,
+		},
+		PackageName: alcsyncv1.PackageName,
+		Data: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"periodicity":  numberValue(4),
+				"force_resync": numberValue(2),
+			},
+		},
+	}
+	registry := &mockRegistry{association: assoc}
+	handler := alcsyncv1.New(as, registry)
+
+	// 1980-01-06T00:01:40Z is 100 seconds after the GPS epoch.
+	transmittedAt := time.Date(1980, time.January, 6, 0, 1, 40, 0, time.UTC)
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      202,
+				FRMPayload: []byte{0x01, 0x5a, 0x00, 0x00, 0x00, 0x03},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{Time: &transmittedAt},
+				},
+				ReceivedAt: transmittedAt.Add(time.Second),
+			},
+		},
+	}
+	a.So(handler.HandleUp(ctx, nil, assoc, up), should.BeNil)
+
+	downlinks, err := as.DownlinkQueueList(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(downlinks, should.HaveLength, 2)
+	a.So(downlinks[0].FPort, should.Equal, 202)
+	a.So(downlinks[0].FRMPayload, should.Resemble, []byte{0x01, 0x0a, 0x00, 0x00, 0x00, 0x03})
+	a.So(downlinks[1].FRMPayload, should.Resemble, []byte{0x02, 0x04, 0x03, 0x02})
+
+	// The requests are removed from the association once they are queued.
+	a.So(registry.association.Data.Fields, should.BeEmpty)
+
+	// The end device clock is correct and no answer is required.
+	up.GetUplinkMessage().FRMPayload = []byte{0x01, 0x64, 0x00, 0x00, 0x00, 0x04}
+	a.So(handler.HandleUp(ctx, nil, registry.association, up), should.BeNil)
+	downlinks, err = as.DownlinkQueueList(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(downlinks, should.HaveLength, 2)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"context"
+
+	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// clockSyncFPort is the FPort of Application Layer Clock Synchronization (TS003) commands.
+const clockSyncFPort = 202
+
+// handleClockSync answers the clock synchronization requests in the uplink message. The requests are not answered if
+// the end device is associated with the Application Layer Clock Synchronization package on the same FPort, so that
+// the end device does not receive the answers twice.
+func (p *FUOTAPackage) handleClockSync(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationUplink) error {
+	handled, err := p.clockSyncAssociated(ctx, ids)
+	if err != nil || handled {
+		return err
+	}
+	answers, err := alcsyncv1.AppTimeAnswers(msg)
+	if err != nil || len(answers) == 0 {
+		return err
+	}
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(answers))
+	for _, ans := range answers {
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      clockSyncFPort,
+			FRMPayload: ans,
+		})
+	}
+	return p.server.DownlinkQueuePush(ctx, ids, downlinks)
+}
+
+// clockSyncAssociated returns whether the end device is associated with the Application Layer Clock Synchronization
+// package on the clock synchronization FPort, either directly or by a default association of its application.
+func (p *FUOTAPackage) clockSyncAssociated(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (bool, error) {
+	paths := []string{"ids", "package_name"}
+	associations, err := p.registry.ListAssociations(ctx, ids, paths)
+	if err != nil {
+		return false, err
+	}
+	for _, assoc := range associations {
+		if assoc.PackageName == alcsyncv1.PackageName {
+			return assoc.FPort == clockSyncFPort, nil
+		}
+	}
+	defaults, err := p.registry.ListDefaultAssociations(ctx, ids.ApplicationIdentifiers, paths)
+	if err != nil {
+		return false, err
+	}
+	for _, def := range defaults {
+		if def.PackageName == alcsyncv1.PackageName {
+			return def.FPort == clockSyncFPort, nil
+		}
+	}
+	return false, nil
+}
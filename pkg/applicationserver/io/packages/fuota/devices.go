@@ -0,0 +1,213 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+)
+
+// DeviceRegistry manages the multicast end devices of FUOTA sessions.
+type DeviceRegistry interface {
+	// GenerateDevAddr returns a device address for a new multicast end device.
+	GenerateDevAddr(ctx context.Context) (types.DevAddr, error)
+	// CreateMulticast creates the multicast end device. The frequency plan, LoRaWAN versions and server addresses
+	// are copied from the template end device.
+	CreateMulticast(ctx context.Context, template ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) error
+	// Delete deletes the end device.
+	Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error
+}
+
+// Cluster provides cluster operations.
+type Cluster interface {
+	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (*grpc.ClientConn, error)
+	AllowInsecureForCredentials() bool
+}
+
+// ClusterDeviceRegistry is a DeviceRegistry that manages the end devices in the Identity Server, Network Server
+// and Application Server of the cluster, with the credentials of the caller.
+type ClusterDeviceRegistry struct {
+	Cluster
+}
+
+var _ DeviceRegistry = (*ClusterDeviceRegistry)(nil)
+
+var errNoDevAddr = errors.DefineUnavailable("no_dev_addr", "no device address generated")
+
+func (r *ClusterDeviceRegistry) conn(ctx context.Context, role ttnpb.ClusterRole, ids *ttnpb.EndDeviceIdentifiers) (*grpc.ClientConn, grpc.CallOption, error) {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, r.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, nil, err
+	}
+	var entityIDs cluster.EntityIdentifiers
+	if ids != nil {
+		entityIDs = ids
+	}
+	cc, err := r.GetPeerConn(ctx, role, entityIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cc, callOpt, nil
+}
+
+// GenerateDevAddr implements DeviceRegistry.
+func (r *ClusterDeviceRegistry) GenerateDevAddr(ctx context.Context) (types.DevAddr, error) {
+	cc, callOpt, err := r.conn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	if err != nil {
+		return types.DevAddr{}, err
+	}
+	res, err := ttnpb.NewNsClient(cc).GenerateDevAddr(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return types.DevAddr{}, err
+	}
+	if res.DevAddr == nil {
+		return types.DevAddr{}, errNoDevAddr.New()
+	}
+	return *res.DevAddr, nil
+}
+
+// CreateMulticast implements DeviceRegistry.
+func (r *ClusterDeviceRegistry) CreateMulticast(ctx context.Context, template ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) (err error) {
+	isConn, isCallOpt, err := r.conn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, &template)
+	if err != nil {
+		return err
+	}
+	nsConn, nsCallOpt, err := r.conn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, &template)
+	if err != nil {
+		return err
+	}
+	asConn, asCallOpt, err := r.conn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, &template)
+	if err != nil {
+		return err
+	}
+
+	isTemplate, err := ttnpb.NewEndDeviceRegistryClient(isConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: template,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"application_server_address",
+				"network_server_address",
+			},
+		},
+	}, isCallOpt)
+	if err != nil {
+		return err
+	}
+	nsTemplate, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: template,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"frequency_plan_id",
+				"lorawan_phy_version",
+				"lorawan_version",
+			},
+		},
+	}, nsCallOpt)
+	if err != nil {
+		return err
+	}
+
+	dev.NetworkServerAddress = isTemplate.NetworkServerAddress
+	dev.ApplicationServerAddress = isTemplate.ApplicationServerAddress
+	dev.FrequencyPlanID = nsTemplate.FrequencyPlanID
+	dev.LoRaWANVersion = nsTemplate.LoRaWANVersion
+	dev.LoRaWANPHYVersion = nsTemplate.LoRaWANPHYVersion
+	dev.Multicast = true
+	dev.SupportsClassC = true
+	dev.SupportsJoin = false
+
+	if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: *dev,
+	}, isCallOpt); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if deleteErr := r.Delete(ctx, dev.EndDeviceIdentifiers); deleteErr != nil {
+			log.FromContext(ctx).WithError(deleteErr).Warn("Failed to delete multicast end device")
+		}
+	}()
+	if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"frequency_plan_id",
+				"lorawan_phy_version",
+				"lorawan_version",
+				"mac_settings.rx2_data_rate_index",
+				"mac_settings.rx2_frequency",
+				"multicast",
+				"session.dev_addr",
+				"session.keys.f_nwk_s_int_key",
+				"session.keys.nwk_s_enc_key",
+				"session.keys.s_nwk_s_int_key",
+				"supports_class_c",
+				"supports_join",
+			},
+		},
+	}, nsCallOpt); err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"session.dev_addr",
+				"session.keys.app_s_key",
+			},
+		},
+	}, asCallOpt); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete implements DeviceRegistry.
+// The end device is deleted from the Application Server, Network Server and Identity Server, in that order.
+// End devices that are not found are ignored.
+func (r *ClusterDeviceRegistry) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	for _, role := range []ttnpb.ClusterRole{
+		ttnpb.ClusterRole_APPLICATION_SERVER,
+		ttnpb.ClusterRole_NETWORK_SERVER,
+		ttnpb.ClusterRole_ENTITY_REGISTRY,
+	} {
+		cc, callOpt, err := r.conn(ctx, role, &ids)
+		if err != nil {
+			return err
+		}
+		switch role {
+		case ttnpb.ClusterRole_APPLICATION_SERVER:
+			_, err = ttnpb.NewAsEndDeviceRegistryClient(cc).Delete(ctx, &ids, callOpt)
+		case ttnpb.ClusterRole_NETWORK_SERVER:
+			_, err = ttnpb.NewNsEndDeviceRegistryClient(cc).Delete(ctx, &ids, callOpt)
+		default:
+			_, err = ttnpb.NewEndDeviceRegistryClient(cc).Delete(ctx, &ids, callOpt)
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Fragmented Data Block Transport (TS004) commands.
+const (
+	fragmentationFPort = 201
+
+	fragPackageVersion    = 0x00
+	fragSessionStatus     = 0x01
+	fragSessionSetup      = 0x02
+	fragSessionDelete     = 0x03
+	fragDataFragment      = 0x08
+	fragPackageVersionLen = 2
+	fragSessionStatusLen  = 4
+
+	// maxFragments is the maximum number of fragments of a fragmentation session, which is limited by the 14 bit
+	// fragment counter.
+	maxFragments = 1<<14 - 1
+)
+
+var errTooManyFragments = errors.DefineInvalidArgument("too_many_fragments", "data block requires `{fragments}` fragments, which exceeds the maximum of `{max}`")
+
+// fragmentCount returns the number of uncoded fragments and the padding of the data block.
+func fragmentCount(size, fragmentSize int) (fragments, padding int) {
+	fragments = (size + fragmentSize - 1) / fragmentSize
+	return fragments, fragments*fragmentSize - size
+}
+
+// fragmentationSessionSetupReq returns the FragSessionSetupReq command.
+func fragmentationSessionSetupReq(fragIndex, groupID uint32, fragments, fragmentSize, padding int, descriptor uint32) []byte {
+	b := make([]byte, 11)
+	b[0] = fragSessionSetup
+	b[1] = byte(fragIndex&0x3)<<4 | byte(1<<(groupID&0x3))
+	binary.LittleEndian.PutUint16(b[2:4], uint16(fragments))
+	b[4] = byte(fragmentSize)
+	b[5] = 0 // Fragmentation matrix 0 and no block acknowledgement delay.
+	b[6] = byte(padding)
+	binary.LittleEndian.PutUint32(b[7:11], descriptor)
+	return b
+}
+
+// fragmentationSessionStatusReq returns the FragSessionStatusReq command.
+// The Participants bit is set, such that all end devices answer, including those that received all fragments.
+func fragmentationSessionStatusReq(fragIndex uint32) []byte {
+	return []byte{fragSessionStatus, byte(fragIndex&0x3)<<1 | 0x1}
+}
+
+// prbs23 is the pseudo random binary sequence generator of the fragmentation matrix.
+func prbs23(x uint32) uint32 {
+	b0 := x & 1
+	b1 := (x & 32) >> 5
+	return x>>1 + (b0^b1)<<22
+}
+
+func isPowerOfTwo(n int) bool {
+	return n&(n-1) == 0
+}
+
+// matrixLine returns the line of the fragmentation matrix of the coded fragment n (starting at 1) for m uncoded
+// fragments. The coded fragment is the XOR of the uncoded fragments for which the line is set.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+	mm := 0
+	if isPowerOfTwo(m) {
+		mm = 1
+	}
+	x := uint32(1 + 1001*n)
+	for i := 0; i < m/2; i++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+mm))
+		}
+		line[r] = true
+	}
+	return line
+}
+
+// fragmentData splits the data block in fragments of the given size, followed by the given number of redundant
+// coded fragments. The last uncoded fragment is padded with zeroes.
+func fragmentData(data []byte, fragmentSize, redundancy int) ([][]byte, error) {
+	m, _ := fragmentCount(len(data), fragmentSize)
+	if m+redundancy > maxFragments {
+		return nil, errTooManyFragments.WithAttributes("fragments", m+redundancy, "max", maxFragments)
+	}
+	fragments := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		fragment := make([]byte, fragmentSize)
+		copy(fragment, data[i*fragmentSize:])
+		fragments = append(fragments, fragment)
+	}
+	for n := 1; n <= redundancy; n++ {
+		fragment := make([]byte, fragmentSize)
+		for i, set := range matrixLine(n, m) {
+			if !set {
+				continue
+			}
+			for j := range fragment {
+				fragment[j] ^= fragments[i][j]
+			}
+		}
+		fragments = append(fragments, fragment)
+	}
+	return fragments, nil
+}
+
+// dataFragment returns the DataFragment command of the fragment n (starting at 1).
+func dataFragment(fragIndex uint32, n int, fragment []byte) []byte {
+	b := make([]byte, 3, 3+len(fragment))
+	b[0] = fragDataFragment
+	binary.LittleEndian.PutUint16(b[1:3], uint16(fragIndex&0x3)<<14|uint16(n&maxFragments))
+	return append(b, fragment...)
+}
+
+// fragmentationAnswer is an answer of an end device to a Fragmented Data Block Transport request.
+type fragmentationAnswer struct {
+	CID       byte
+	FragIndex uint32
+	Received  uint32
+	Missing   uint32
+	Error     string
+}
+
+// parseFragmentationAnswers parses the Fragmented Data Block Transport answers in the payload.
+func parseFragmentationAnswers(b []byte) ([]fragmentationAnswer, error) {
+	var res []fragmentationAnswer
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case fragPackageVersion:
+			if len(b) < fragPackageVersionLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", fragmentationFPort)
+			}
+			b = b[fragPackageVersionLen:]
+		case fragSessionStatus:
+			if len(b) < fragSessionStatusLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", fragmentationFPort)
+			}
+			receivedAndIndex := binary.LittleEndian.Uint16(b[0:2])
+			ans := fragmentationAnswer{
+				CID:       cid,
+				FragIndex: uint32(receivedAndIndex >> 14),
+				Received:  uint32(receivedAndIndex & maxFragments),
+				Missing:   uint32(b[2]),
+			}
+			if b[3]&0x1 != 0 {
+				ans.Error = "not enough memory"
+			}
+			res = append(res, ans)
+			b = b[fragSessionStatusLen:]
+		case fragSessionSetup, fragSessionDelete:
+			if len(b) < 1 {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", fragmentationFPort)
+			}
+			status := b[0]
+			ans := fragmentationAnswer{
+				CID:       cid,
+				FragIndex: uint32(status >> 6),
+			}
+			if cid == fragSessionDelete {
+				ans.FragIndex = uint32(status & 0x3)
+			}
+			switch {
+			case cid == fragSessionDelete:
+				if status&0x4 != 0 {
+					ans.Error = "fragmentation session does not exist"
+				}
+			case status&0x1 != 0:
+				ans.Error = "encoding not supported"
+			case status&0x2 != 0:
+				ans.Error = "not enough memory"
+			case status&0x4 != 0:
+				ans.Error = "fragmentation session index not supported"
+			case status&0x8 != 0:
+				ans.Error = "wrong descriptor"
+			}
+			res = append(res, ans)
+			b = b[1:]
+		default:
+			return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", fragmentationFPort)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFragmentData(t *testing.T) {
+	a := assertions.New(t)
+
+	data := []byte("The Things Stack firmware update over the air")
+	fragments, err := fragmentData(data, 8, 4)
+	a.So(err, should.BeNil)
+	m, padding := fragmentCount(len(data), 8)
+	a.So(m, should.Equal, 6)
+	a.So(padding, should.Equal, 3)
+	a.So(fragments, should.HaveLength, m+4)
+
+	var reassembled []byte
+	for _, fragment := range fragments[:m] {
+		a.So(fragment, should.HaveLength, 8)
+		reassembled = append(reassembled, fragment...)
+	}
+	a.So(reassembled[:len(data)], should.Resemble, data)
+	a.So(reassembled[len(data):], should.Resemble, make([]byte, padding))
+
+	for n, coded := range fragments[m:] {
+		expected := make([]byte, 8)
+		for i, set := range matrixLine(n+1, m) {
+			if !set {
+				continue
+			}
+			for j := range expected {
+				expected[j] ^= fragments[i][j]
+			}
+		}
+		a.So(coded, should.Resemble, expected)
+	}
+
+	_, err = fragmentData(make([]byte, maxFragments+1), 1, 0)
+	a.So(err, should.NotBeNil)
+}
+
+func TestFragmentationCommands(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(fragmentationSessionSetupReq(1, 2, 300, 50, 10, 0x04030201), should.Resemble, []byte{
+		0x02, 0x14, 0x2c, 0x01, 0x32, 0x00, 0x0a, 0x01, 0x02, 0x03, 0x04,
+	})
+	a.So(fragmentationSessionStatusReq(2), should.Resemble, []byte{0x01, 0x05})
+	a.So(dataFragment(1, 3, []byte{0xaa, 0xbb}), should.Resemble, []byte{0x08, 0x03, 0x40, 0xaa, 0xbb})
+}
+
+func TestParseFragmentationAnswers(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Expected []fragmentationAnswer
+		Error    bool
+	}{
+		{
+			Name:    "PackageVersion",
+			Payload: []byte{0x00, 0x03, 0x01},
+		},
+		{
+			Name:    "SessionSetup",
+			Payload: []byte{0x02, 0x40},
+			Expected: []fragmentationAnswer{
+				{CID: fragSessionSetup, FragIndex: 1},
+			},
+		},
+		{
+			Name:    "SessionSetupError",
+			Payload: []byte{0x02, 0x02},
+			Expected: []fragmentationAnswer{
+				{CID: fragSessionSetup, Error: "not enough memory"},
+			},
+		},
+		{
+			Name:    "SessionStatus",
+			Payload: []byte{0x01, 0x2c, 0x81, 0x00, 0x00, 0x03, 0x41},
+			Expected: []fragmentationAnswer{
+				{CID: fragSessionStatus, FragIndex: 2, Received: 300},
+				{CID: fragSessionDelete, FragIndex: 1},
+			},
+		},
+		{
+			Name:    "Truncated",
+			Payload: []byte{0x01, 0x2c, 0x81},
+			Error:   true,
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x7f},
+			Error:   true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			answers, err := parseFragmentationAnswers(tc.Payload)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(answers, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errNoData               = errors.DefineInvalidArgument("no_data", "no data")
+	errSessionAlreadyExists = errors.DefineAlreadyExists("session_already_exists", "FUOTA session `{session_id}` already exists")
+	errSessionNotFound      = errors.DefineNotFound("session_not_found", "FUOTA session `{session_id}` not found")
+	errNoAssociation        = errors.DefineFailedPrecondition("no_association", "end device `{device_id}` is not associated with the FUOTA package")
+	errNoGenAppKey          = errors.DefineInvalidArgument("no_gen_app_key", "no GenAppKey for end device `{device_id}`")
+)
+
+// appendImplicitSessionGetPaths appends implicit ttnpb.FUOTASession get paths to paths.
+func appendImplicitSessionGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 1+len(paths)),
+		"ids",
+	), paths...)
+}
+
+// requireAssociation returns an error if the end device is not associated with the package, either directly or
+// through the default association of the application.
+func (p *FUOTAPackage) requireAssociation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	defaults, err := p.registry.ListDefaultAssociations(ctx, ids.ApplicationIdentifiers, []string{"package_name"})
+	if err != nil {
+		return err
+	}
+	for _, def := range defaults {
+		if def.PackageName == PackageName {
+			return nil
+		}
+	}
+	associations, err := p.registry.ListAssociations(ctx, ids, []string{"package_name"})
+	if err != nil {
+		return err
+	}
+	for _, assoc := range associations {
+		if assoc.PackageName == PackageName {
+			return nil
+		}
+	}
+	return errNoAssociation.WithAttributes("device_id", ids.DeviceId)
+}
+
+// Create implements ttnpb.ApplicationFUOTAServer.
+func (p *FUOTAPackage) Create(ctx context.Context, req *ttnpb.CreateFUOTASessionRequest) (*ttnpb.FUOTASession, error) {
+	ids := req.FUOTASession.FUOTASessionIdentifiers
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	session := req.FUOTASession
+	if len(session.Data) == 0 {
+		return nil, errNoData.New()
+	}
+	if session.StartAt == nil {
+		startAt := time.Now().Add(p.startDelay).UTC()
+		session.StartAt = &startAt
+	}
+	fragments, padding := fragmentCount(len(session.Data), int(session.FragmentSize))
+	if total := fragments + int(session.Redundancy); total > maxFragments {
+		return nil, errTooManyFragments.WithAttributes(
+			"fragments", total,
+			"max", maxFragments,
+		)
+	}
+	genAppKeys := make(map[string]types.AES128Key, len(session.Members))
+	for _, member := range session.Members {
+		if err := p.requireAssociation(ctx, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ids.ApplicationIdentifiers,
+			DeviceId:               member.DeviceId,
+		}); err != nil {
+			return nil, err
+		}
+		key := req.GenAppKeys[member.DeviceId].GetKey()
+		if key == nil {
+			return nil, errNoGenAppKey.WithAttributes("device_id", member.DeviceId)
+		}
+		genAppKeys[member.DeviceId] = *key
+	}
+
+	mcAddr, err := p.devices.GenerateDevAddr(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var mcKey types.AES128Key
+	copy(mcKey[:], random.Bytes(len(mcKey)))
+	keys := deriveMulticastKeys(mcKey, mcAddr)
+
+	session.State = ttnpb.FUOTASession_SETUP
+	for _, member := range session.Members {
+		*member = ttnpb.FUOTASession_Member{DeviceId: member.DeviceId}
+	}
+	sets := make([]string, 0, len(ttnpb.FUOTASessionFieldPathsTopLevel))
+	for _, path := range ttnpb.FUOTASessionFieldPathsTopLevel {
+		if path != "created_at" && path != "updated_at" {
+			sets = append(sets, path)
+		}
+	}
+	created, err := p.sessions.Set(ctx, ids, nil, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if stored != nil {
+			return nil, nil, errSessionAlreadyExists.WithAttributes("session_id", ids.SessionID)
+		}
+		return &session, sets, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	multicastIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceId:               session.MulticastDeviceId,
+		DevAddr:                &mcAddr,
+	}
+	if err := p.devices.CreateMulticast(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceId:               session.Members[0].DeviceId,
+	}, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: multicastIDs,
+		Name:                 fmt.Sprintf("FUOTA session %s", ids.SessionID),
+		MACSettings: &ttnpb.MACSettings{
+			Rx2DataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(session.DataRateIndex)},
+			Rx2Frequency:     &ttnpb.FrequencyValue{Value: session.Frequency},
+		},
+		Session: &ttnpb.Session{
+			DevAddr: mcAddr,
+			SessionKeys: ttnpb.SessionKeys{
+				AppSKey:     &ttnpb.KeyEnvelope{Key: &keys.AppSKey},
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &keys.NwkSKey},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{Key: &keys.NwkSKey},
+				NwkSEncKey:  &ttnpb.KeyEnvelope{Key: &keys.NwkSKey},
+			},
+		},
+	}); err != nil {
+		if _, deleteErr := p.sessions.Set(ctx, ids, nil, func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+			return nil, nil, nil
+		}); deleteErr != nil {
+			log.FromContext(ctx).WithError(deleteErr).Warn("Failed to delete FUOTA session")
+		}
+		return nil, err
+	}
+
+	totalFragments := uint32(fragments) + session.Redundancy
+	for _, member := range session.Members {
+		memberIDs := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ids.ApplicationIdentifiers,
+			DeviceId:               member.DeviceId,
+		}
+		encKey := encryptMulticastKey(genAppKeys[member.DeviceId], mcKey)
+		if err := p.server.DownlinkQueuePush(ctx, memberIDs, []*ttnpb.ApplicationDownlink{
+			{
+				FPort:      multicastFPort,
+				FRMPayload: multicastGroupSetupReq(session.MulticastGroupId, mcAddr, encKey, 0, totalFragments),
+			},
+			{
+				FPort:      fragmentationFPort,
+				FRMPayload: fragmentationSessionSetupReq(session.FragmentationIndex, session.MulticastGroupId, fragments, int(session.FragmentSize), padding, session.DataDescriptor),
+			},
+			{
+				FPort:      multicastFPort,
+				FRMPayload: multicastClassCSessionReq(session.MulticastGroupId, *session.StartAt, session.SessionTimeout, session.Frequency, session.DataRateIndex),
+			},
+		}); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", member.DeviceId).Warn("Failed to queue FUOTA session setup")
+			registerPackageFail(ctx, memberIDs, err)
+		}
+	}
+	events.Publish(evtCreateSession.NewWithIdentifiersAndData(ctx, &ids.ApplicationIdentifiers, ids))
+	return created, nil
+}
+
+// Get implements ttnpb.ApplicationFUOTAServer.
+func (p *FUOTAPackage) Get(ctx context.Context, req *ttnpb.GetFUOTASessionRequest) (*ttnpb.FUOTASession, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	return p.sessions.Get(ctx, req.FUOTASessionIdentifiers, appendImplicitSessionGetPaths(req.FieldMask.Paths...))
+}
+
+// List implements ttnpb.ApplicationFUOTAServer.
+func (p *FUOTAPackage) List(ctx context.Context, req *ttnpb.ListFUOTASessionsRequest) (*ttnpb.FUOTASessions, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	sessions, err := p.sessions.List(ctx, req.ApplicationIdentifiers, appendImplicitSessionGetPaths(req.FieldMask.Paths...))
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.FUOTASessions{
+		Sessions: sessions,
+	}, nil
+}
+
+// Delete implements ttnpb.ApplicationFUOTAServer.
+func (p *FUOTAPackage) Delete(ctx context.Context, ids *ttnpb.FUOTASessionIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	session, err := p.sessions.Get(ctx, *ids, []string{"ids", "multicast_device_id"})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errSessionNotFound.WithCause(err).WithAttributes("session_id", ids.SessionID)
+		}
+		return nil, err
+	}
+	if err := p.devices.Delete(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceId:               session.MulticastDeviceId,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := p.sessions.Set(ctx, *ids, nil, func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		return nil, nil, nil
+	}); err != nil {
+		return nil, err
+	}
+	events.Publish(evtDeleteSession.NewWithIdentifiersAndData(ctx, &ids.ApplicationIdentifiers, *ids))
+	return ttnpb.Empty, nil
+}
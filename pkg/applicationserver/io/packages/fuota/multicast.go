@@ -0,0 +1,197 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+	"math/bits"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Remote Multicast Setup (TS005) commands.
+const (
+	multicastFPort = 200
+
+	mcPackageVersion     = 0x00
+	mcGroupStatus        = 0x01
+	mcGroupSetup         = 0x02
+	mcGroupDelete        = 0x03
+	mcClassCSession      = 0x04
+	mcClassBSession      = 0x05
+	mcPackageVersionLen  = 2
+	mcGroupSetupAnsLen   = 1
+	mcClassCSessionError = 0x1c
+)
+
+var errInvalidCommand = errors.DefineInvalidArgument("invalid_command", "invalid command `{cid}` on FPort `{f_port}`")
+
+// multicastKeys contains the keys of a multicast group.
+type multicastKeys struct {
+	AppSKey types.AES128Key
+	NwkSKey types.AES128Key
+}
+
+func encryptBlock(key types.AES128Key, block [16]byte) [16]byte {
+	cipher, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err) // The key length is always valid.
+	}
+	var res [16]byte
+	cipher.Encrypt(res[:], block[:])
+	return res
+}
+
+func decryptBlock(key types.AES128Key, block [16]byte) [16]byte {
+	cipher, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err) // The key length is always valid.
+	}
+	var res [16]byte
+	cipher.Decrypt(res[:], block[:])
+	return res
+}
+
+// deriveMulticastKeys derives the multicast session keys from the multicast group key and address.
+func deriveMulticastKeys(mcKey types.AES128Key, mcAddr types.DevAddr) multicastKeys {
+	var block [16]byte
+	addr := mcAddr.MarshalNumber()
+	binary.LittleEndian.PutUint32(block[1:5], addr)
+	block[0] = 0x01
+	appSKey := encryptBlock(mcKey, block)
+	block[0] = 0x02
+	nwkSKey := encryptBlock(mcKey, block)
+	return multicastKeys{
+		AppSKey: types.AES128Key(appSKey),
+		NwkSKey: types.AES128Key(nwkSKey),
+	}
+}
+
+// encryptMulticastKey encrypts the multicast group key for the end device with the given GenAppKey.
+// The end device derives the McKEKey from its GenAppKey and encrypts the received key to obtain the multicast group key.
+func encryptMulticastKey(genAppKey, mcKey types.AES128Key) types.AES128Key {
+	var block [16]byte
+	mcRootKey := encryptBlock(genAppKey, block)
+	mcKEKey := encryptBlock(types.AES128Key(mcRootKey), block)
+	return types.AES128Key(decryptBlock(types.AES128Key(mcKEKey), [16]byte(mcKey)))
+}
+
+// multicastGroupSetupReq returns the McGroupSetupReq command.
+func multicastGroupSetupReq(groupID uint32, mcAddr types.DevAddr, mcKeyEncrypted types.AES128Key, minFCnt, maxFCnt uint32) []byte {
+	b := make([]byte, 30)
+	b[0] = mcGroupSetup
+	b[1] = byte(groupID & 0x3)
+	binary.LittleEndian.PutUint32(b[2:6], mcAddr.MarshalNumber())
+	copy(b[6:22], mcKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], minFCnt)
+	binary.LittleEndian.PutUint32(b[26:30], maxFCnt)
+	return b
+}
+
+// multicastClassCSessionReq returns the McClassCSessionReq command.
+// The session timeout is the duration of the session as 2^timeout seconds.
+func multicastClassCSessionReq(groupID uint32, start time.Time, timeout uint32, frequency uint64, dataRateIndex uint32) []byte {
+	b := make([]byte, 11)
+	b[0] = mcClassCSession
+	b[1] = byte(groupID & 0x3)
+	binary.LittleEndian.PutUint32(b[2:6], uint32(gpstime.ToGPS(start)/time.Second))
+	b[6] = byte(timeout & 0xf)
+	freq := uint32(frequency / 100)
+	b[7], b[8], b[9] = byte(freq), byte(freq>>8), byte(freq>>16)
+	b[10] = byte(dataRateIndex)
+	return b
+}
+
+// multicastAnswer is an answer of an end device to a Remote Multicast Setup request.
+type multicastAnswer struct {
+	CID     byte
+	GroupID uint32
+	Error   string
+}
+
+// parseMulticastAnswers parses the Remote Multicast Setup answers in the payload.
+func parseMulticastAnswers(b []byte) ([]multicastAnswer, error) {
+	var res []multicastAnswer
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case mcPackageVersion:
+			if len(b) < mcPackageVersionLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			b = b[mcPackageVersionLen:]
+		case mcGroupStatus:
+			if len(b) < 1 {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			// The status is followed by 5 bytes per multicast group that is set in the AnsGroupMask (bits 0-3).
+			n := 1 + 5*bits.OnesCount8(b[0]&0xf)
+			if len(b) < n {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			b = b[n:]
+		case mcGroupSetup, mcGroupDelete:
+			if len(b) < mcGroupSetupAnsLen {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			ans := multicastAnswer{
+				CID:     cid,
+				GroupID: uint32(b[0] & 0x3),
+			}
+			if b[0]&0x4 != 0 {
+				if cid == mcGroupSetup {
+					ans.Error = "multicast group ID not supported"
+				} else {
+					ans.Error = "multicast group undefined"
+				}
+			}
+			res = append(res, ans)
+			b = b[1:]
+		case mcClassCSession, mcClassBSession:
+			if len(b) < 1 {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			ans := multicastAnswer{
+				CID:     cid,
+				GroupID: uint32(b[0] & 0x3),
+			}
+			n := 1
+			switch status := b[0]; {
+			case status&mcClassCSessionError == 0:
+				// The answer contains the time to start if the session is accepted.
+				n += 3
+			case status&0x10 != 0:
+				ans.Error = "multicast group undefined"
+			case status&0x08 != 0:
+				ans.Error = "frequency not supported"
+			default:
+				ans.Error = "data rate not supported"
+			}
+			if len(b) < n {
+				return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+			}
+			res = append(res, ans)
+			b = b[n:]
+		default:
+			return nil, errInvalidCommand.WithAttributes("cid", cid, "f_port", multicastFPort)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMulticastKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	genAppKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	mcKey := types.AES128Key{0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70, 0x80, 0x90, 0xa0, 0xb0, 0xc0, 0xd0, 0xe0, 0xf0, 0x00}
+
+	// The end device decrypts the multicast group key by encrypting it with the McKEKey.
+	encrypted := encryptMulticastKey(genAppKey, mcKey)
+	a.So(encrypted, should.NotResemble, mcKey)
+	mcRootKey := encryptBlock(genAppKey, [16]byte{})
+	mcKEKey := encryptBlock(types.AES128Key(mcRootKey), [16]byte{})
+	a.So(types.AES128Key(encryptBlock(types.AES128Key(mcKEKey), [16]byte(encrypted))), should.Resemble, mcKey)
+
+	keys := deriveMulticastKeys(mcKey, types.DevAddr{0x01, 0x02, 0x03, 0x04})
+	a.So(keys.AppSKey, should.NotResemble, keys.NwkSKey)
+	a.So(keys.AppSKey, should.Resemble, types.AES128Key(encryptBlock(mcKey, [16]byte{0x01, 0x04, 0x03, 0x02, 0x01})))
+	a.So(keys.NwkSKey, should.Resemble, types.AES128Key(encryptBlock(mcKey, [16]byte{0x02, 0x04, 0x03, 0x02, 0x01})))
+}
+
+func TestMulticastCommands(t *testing.T) {
+	a := assertions.New(t)
+
+	req := multicastGroupSetupReq(1, types.DevAddr{0x01, 0x02, 0x03, 0x04}, types.AES128Key{0xaa}, 0, 100)
+	a.So(req, should.HaveLength, 30)
+	a.So(req[:7], should.Resemble, []byte{0x02, 0x01, 0x04, 0x03, 0x02, 0x01, 0xaa})
+	a.So(req[22:], should.Resemble, []byte{0x00, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00})
+
+	// 1980-01-06T00:01:40Z is 100 seconds after the GPS epoch.
+	start := time.Date(1980, time.January, 6, 0, 1, 40, 0, time.UTC)
+	a.So(multicastClassCSessionReq(2, start, 8, 869525000, 3), should.Resemble, []byte{
+		0x04, 0x02, 0x64, 0x00, 0x00, 0x00, 0x08, 0xd2, 0xad, 0x84, 0x03,
+	})
+}
+
+func TestParseMulticastAnswers(t *testing.T) {
+	a := assertions.New(t)
+
+	answers, err := parseMulticastAnswers([]byte{0x00, 0x01, 0x01, 0x02, 0x01, 0x02, 0x06})
+	a.So(err, should.BeNil)
+	a.So(answers, should.Resemble, []multicastAnswer{
+		{CID: mcGroupSetup, GroupID: 1},
+		{CID: mcGroupSetup, GroupID: 2, Error: "multicast group ID not supported"},
+	})
+
+	// McGroupStatusAns with 3 groups in total, of which only group 1 is in the AnsGroupMask.
+	answers, err = parseMulticastAnswers([]byte{0x01, 0x32, 0x01, 0x04, 0x03, 0x02, 0x01, 0x02, 0x01})
+	a.So(err, should.BeNil)
+	a.So(answers, should.Resemble, []multicastAnswer{
+		{CID: mcGroupSetup, GroupID: 1},
+	})
+
+	_, err = parseMulticastAnswers([]byte{0x02})
+	a.So(err, should.NotBeNil)
+	_, err = parseMulticastAnswers([]byte{0x7f})
+	a.So(err, should.NotBeNil)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var withIdentifiersOption = events.WithDataType(&ttnpb.FUOTASessionIdentifiers{
+	ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+		ApplicationId: "application-id",
+	},
+	SessionID: "session-id",
+})
+
+var withMemberOption = events.WithDataType(&ttnpb.FUOTASession_Member{
+	DeviceId:            "device-id",
+	MulticastGroupSetup: true,
+	FragmentsReceived:   10,
+	FragmentsMissing:    2,
+})
+
+var (
+	evtCreateSession = events.Define(
+		"as.packages.fuota.session.create", "create FUOTA session",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_WRITE),
+		withIdentifiersOption,
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteSession = events.Define(
+		"as.packages.fuota.session.delete", "delete FUOTA session",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_WRITE),
+		withIdentifiersOption,
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtTransmitSession = events.Define(
+		"as.packages.fuota.session.transmit", "transmit FUOTA session fragments",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		withIdentifiersOption,
+	)
+	evtFinishSession = events.Define(
+		"as.packages.fuota.session.finish", "finish FUOTA session",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		withIdentifiersOption,
+	)
+	evtUpdateMember = events.Define(
+		"as.packages.fuota.member.update", "update FUOTA session member status",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		withMemberOption,
+	)
+	evtPackageFail = events.Define(
+		"as.packages.fuota.fail", "fail to process upstream message",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+)
+
+func registerPackageFail(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, err error) {
+	events.Publish(evtPackageFail.NewWithIdentifiersAndData(ctx, &ids, err))
+}
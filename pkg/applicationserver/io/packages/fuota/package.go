@@ -0,0 +1,352 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fuota implements the firmware update over the air (FUOTA) application package. The package distributes
+// data blocks to multicast groups of end devices, using Remote Multicast Setup (TS005), Fragmented Data Block
+// Transport (TS004) and Application Layer Clock Synchronization (TS003).
+package fuota
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName defines the package name.
+const PackageName = "fuota"
+
+// Config is the configuration of the FUOTA package.
+type Config struct {
+	Registry   SessionRegistry `name:"-"`
+	Devices    DeviceRegistry  `name:"-"`
+	StartDelay time.Duration   `name:"start-delay" description:"Delay between the creation and the start of FUOTA sessions without start time"`
+	Interval   time.Duration   `name:"interval" description:"Interval between checks for FUOTA sessions to transmit"`
+}
+
+// FUOTAPackage is the FUOTA application package.
+type FUOTAPackage struct {
+	ctx        context.Context
+	server     io.Server
+	registry   packages.Registry
+	sessions   SessionRegistry
+	devices    DeviceRegistry
+	startDelay time.Duration
+}
+
+// New instantiates the FUOTA package.
+// A task is started that transmits the fragments of the FUOTA sessions to their multicast groups when the sessions
+// start.
+func New(ctx context.Context, server io.Server, registry packages.Registry, conf Config) packages.ApplicationPackageHandler {
+	p := &FUOTAPackage{
+		ctx:        ctx,
+		server:     server,
+		registry:   registry,
+		sessions:   conf.Registry,
+		devices:    conf.Devices,
+		startDelay: conf.StartDelay,
+	}
+	interval := conf.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	server.StartTask(&component.TaskConfig{
+		Context: ctx,
+		ID:      "fuota_transmit",
+		Func: func(ctx context.Context) error {
+			return p.runTransmit(ctx, interval)
+		},
+		Restart: component.TaskRestartOnFailure,
+		Backoff: component.DefaultTaskBackoffConfig,
+	})
+	return p
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *FUOTAPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: multicastFPort,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *FUOTAPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationFUOTAServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *FUOTAPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationFUOTAHandler(p.ctx, s, conn)
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+// The answers of the members of the FUOTA sessions update the status of the members, and the clock synchronization
+// requests of the end devices are answered.
+func (p *FUOTAPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	if def == nil && assoc == nil {
+		return nil
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fuota")
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+	switch msg.FPort {
+	case clockSyncFPort:
+		return p.handleClockSync(ctx, up.EndDeviceIdentifiers, msg)
+	case multicastFPort:
+		answers, err := parseMulticastAnswers(msg.FRMPayload)
+		if err != nil {
+			return err
+		}
+		return p.updateMember(ctx, up.EndDeviceIdentifiers, func(session *ttnpb.FUOTASession, member *ttnpb.FUOTASession_Member) (updated bool) {
+			for _, ans := range answers {
+				if ans.GroupID != session.MulticastGroupId {
+					continue
+				}
+				switch {
+				case ans.Error != "":
+					member.Error = ans.Error
+				case ans.CID == mcGroupSetup:
+					member.MulticastGroupSetup = true
+				case ans.CID == mcClassCSession:
+					member.MulticastSessionSetup = true
+				default:
+					continue
+				}
+				updated = true
+			}
+			return updated
+		})
+	case fragmentationFPort:
+		answers, err := parseFragmentationAnswers(msg.FRMPayload)
+		if err != nil {
+			return err
+		}
+		return p.updateMember(ctx, up.EndDeviceIdentifiers, func(session *ttnpb.FUOTASession, member *ttnpb.FUOTASession_Member) (updated bool) {
+			for _, ans := range answers {
+				if ans.FragIndex != session.FragmentationIndex {
+					continue
+				}
+				switch {
+				case ans.CID == fragSessionStatus:
+					member.FragmentsReceived = ans.Received
+					member.FragmentsMissing = ans.Missing
+					member.Completed = ans.Error == "" && ans.Received > 0 && ans.Missing == 0
+					if ans.Error != "" {
+						member.Error = ans.Error
+					}
+				case ans.Error != "":
+					member.Error = ans.Error
+				case ans.CID == fragSessionSetup:
+					member.FragmentationSetup = true
+				default:
+					continue
+				}
+				updated = true
+			}
+			return updated
+		})
+	default:
+		return nil
+	}
+}
+
+// updateMember updates the member status of the end device in the FUOTA sessions of its application.
+// The session is finished when all members completed the reconstruction of the data block.
+func (p *FUOTAPackage) updateMember(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.FUOTASession, *ttnpb.FUOTASession_Member) bool) error {
+	sessions, err := p.sessions.List(ctx, ids.ApplicationIdentifiers, []string{"members"})
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if findMember(session, ids.DeviceId) == nil {
+			continue
+		}
+		var (
+			updated  *ttnpb.FUOTASession_Member
+			finished bool
+		)
+		_, err := p.sessions.Set(ctx, session.FUOTASessionIdentifiers, []string{
+			"fragmentation_index",
+			"members",
+			"multicast_group_id",
+			"state",
+		}, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+			if stored == nil {
+				return nil, nil, nil
+			}
+			member := findMember(stored, ids.DeviceId)
+			if member == nil || !f(stored, member) {
+				return stored, nil, nil
+			}
+			updated = member
+			sets := []string{"members"}
+			if stored.State == ttnpb.FUOTASession_TRANSMITTING && allMembersCompleted(stored) {
+				stored.State = ttnpb.FUOTASession_FINISHED
+				sets = append(sets, "state")
+				finished = true
+			}
+			return stored, sets, nil
+		})
+		if err != nil {
+			return err
+		}
+		if updated != nil {
+			events.Publish(evtUpdateMember.NewWithIdentifiersAndData(ctx, &ids, updated))
+		}
+		if finished {
+			events.Publish(evtFinishSession.NewWithIdentifiersAndData(ctx, &ids.ApplicationIdentifiers, session.FUOTASessionIdentifiers))
+		}
+	}
+	return nil
+}
+
+func findMember(session *ttnpb.FUOTASession, deviceID string) *ttnpb.FUOTASession_Member {
+	for _, member := range session.Members {
+		if member.DeviceId == deviceID {
+			return member
+		}
+	}
+	return nil
+}
+
+func allMembersCompleted(session *ttnpb.FUOTASession) bool {
+	for _, member := range session.Members {
+		if !member.Completed {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FUOTAPackage) runTransmit(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.transmitDue(ctx, time.Now().Add(interval))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+type dueSession struct {
+	ctx context.Context
+	ids ttnpb.FUOTASessionIdentifiers
+}
+
+// transmitDue transmits the fragments of the FUOTA sessions that start before the given time.
+func (p *FUOTAPackage) transmitDue(ctx context.Context, before time.Time) {
+	var due []dueSession
+	if err := p.sessions.Range(ctx, []string{"ids", "start_at", "state"}, func(ctx context.Context, _ ttnpb.ApplicationIdentifiers, session *ttnpb.FUOTASession) bool {
+		if session.State == ttnpb.FUOTASession_SETUP && session.StartAt != nil && session.StartAt.Before(before) {
+			due = append(due, dueSession{ctx: ctx, ids: session.FUOTASessionIdentifiers})
+		}
+		return true
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to range FUOTA sessions")
+		return
+	}
+	for _, s := range due {
+		if err := p.transmit(s.ctx, s.ids); err != nil {
+			log.FromContext(s.ctx).WithError(err).WithField("session_id", s.ids.SessionID).Warn("Failed to transmit FUOTA session")
+		}
+	}
+}
+
+// transmit queues the fragments of the FUOTA session to the multicast end device and requests the fragmentation
+// session status of the members.
+// The session is claimed by moving it to the TRANSMITTING state, and moved back to the SETUP state if the fragments
+// cannot be queued, such that the transmission is retried.
+func (p *FUOTAPackage) transmit(ctx context.Context, ids ttnpb.FUOTASessionIdentifiers) error {
+	var transmit bool
+	session, err := p.sessions.Set(ctx, ids, ttnpb.FUOTASessionFieldPathsTopLevel, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if stored == nil || stored.State != ttnpb.FUOTASession_SETUP {
+			return stored, nil, nil
+		}
+		stored.State = ttnpb.FUOTASession_TRANSMITTING
+		transmit = true
+		return stored, []string{"state"}, nil
+	})
+	if err != nil || !transmit {
+		return err
+	}
+	if err := p.queueFragments(ctx, session); err != nil {
+		if _, resetErr := p.sessions.Set(ctx, ids, []string{"state"}, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+			if stored == nil || stored.State != ttnpb.FUOTASession_TRANSMITTING {
+				return stored, nil, nil
+			}
+			stored.State = ttnpb.FUOTASession_SETUP
+			return stored, []string{"state"}, nil
+		}); resetErr != nil {
+			log.FromContext(ctx).WithError(resetErr).WithField("session_id", ids.SessionID).Warn("Failed to reset FUOTA session state")
+		}
+		return err
+	}
+	events.Publish(evtTransmitSession.NewWithIdentifiersAndData(ctx, &ids.ApplicationIdentifiers, ids))
+	for _, member := range session.Members {
+		memberIDs := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ids.ApplicationIdentifiers,
+			DeviceId:               member.DeviceId,
+		}
+		if err := p.server.DownlinkQueuePush(ctx, memberIDs, []*ttnpb.ApplicationDownlink{{
+			FPort:      fragmentationFPort,
+			FRMPayload: fragmentationSessionStatusReq(session.FragmentationIndex),
+		}}); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", member.DeviceId).Warn("Failed to request fragmentation session status")
+		}
+	}
+	return nil
+}
+
+// queueFragments queues the fragments of the data block of the FUOTA session to the multicast end device.
+func (p *FUOTAPackage) queueFragments(ctx context.Context, session *ttnpb.FUOTASession) error {
+	fragments, err := fragmentData(session.Data, int(session.FragmentSize), int(session.Redundancy))
+	if err != nil {
+		return err
+	}
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(fragments))
+	for i, fragment := range fragments {
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fragmentationFPort,
+			FRMPayload: dataFragment(session.FragmentationIndex, i+1, fragment),
+			ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+				Gateways: session.Gateways,
+			},
+		})
+	}
+	if session.StartAt.After(time.Now()) {
+		downlinks[0].ClassBC.AbsoluteTime = session.StartAt
+	}
+	return p.server.DownlinkQueuePush(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: session.ApplicationIdentifiers,
+		DeviceId:               session.MulticastDeviceId,
+	}, downlinks)
+}
@@ -0,0 +1,266 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitSessionGetPaths appends implicit ttnpb.FUOTASession get paths to paths.
+func appendImplicitSessionGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+	), paths...)
+}
+
+func applySessionFieldMask(dst, src *ttnpb.FUOTASession, paths ...string) (*ttnpb.FUOTASession, error) {
+	if dst == nil {
+		dst = &ttnpb.FUOTASession{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// SessionRegistry is a Redis FUOTA session registry.
+type SessionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *SessionRegistry) allKey(ctx context.Context) string {
+	return r.Redis.Key("all")
+}
+
+func (r *SessionRegistry) appKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *SessionRegistry) uidKey(appUID, id string) string {
+	return r.Redis.Key("uid", appUID, id)
+}
+
+func (r *SessionRegistry) makeUIDKeyFunc(appUID string) func(id string) string {
+	return func(id string) string {
+		return r.uidKey(appUID, id)
+	}
+}
+
+// Get implements fuota.SessionRegistry.
+func (r SessionRegistry) Get(ctx context.Context, ids ttnpb.FUOTASessionIdentifiers, paths []string) (*ttnpb.FUOTASession, error) {
+	pb := &ttnpb.FUOTASession{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(unique.ID(ctx, ids.ApplicationIdentifiers), ids.SessionID)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applySessionFieldMask(nil, pb, appendImplicitSessionGetPaths(paths...)...)
+}
+
+var errApplicationUID = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+
+// Range implements fuota.SessionRegistry.
+func (r SessionRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.FUOTASession) bool) error {
+	uids, err := r.Redis.SMembers(ctx, r.allKey(ctx)).Result()
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		appUID, sessionID := fuota.SplitSessionUID(uid)
+		ctx, err := unique.WithContext(ctx, appUID)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", appUID, "session_id", sessionID)
+		}
+		ids, err := unique.ToApplicationID(appUID)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", appUID, "session_id", sessionID)
+		}
+		pb := &ttnpb.FUOTASession{}
+		if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(appUID, sessionID)).ScanProto(pb); err != nil {
+			return err
+		}
+		pb, err = applySessionFieldMask(nil, pb, paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, ids, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// List implements fuota.SessionRegistry.
+func (r SessionRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.FUOTASession, error) {
+	var pbs []*ttnpb.FUOTASession
+	appUID := unique.ID(ctx, ids)
+	err := ttnredis.FindProtos(ctx, r.Redis, r.appKey(appUID), r.makeUIDKeyFunc(appUID)).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.FUOTASession{}
+		return pb, func() (bool, error) {
+			pb, err := applySessionFieldMask(nil, pb, appendImplicitSessionGetPaths(paths...)...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements fuota.SessionRegistry.
+func (r SessionRegistry) Set(ctx context.Context, ids ttnpb.FUOTASessionIdentifiers, gets []string, f func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error)) (*ttnpb.FUOTASession, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
+	ik := r.uidKey(appUID, ids.SessionID)
+
+	var pb *ttnpb.FUOTASession
+	err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		cmd := ttnredis.GetProto(ctx, tx, ik)
+		stored := &ttnpb.FUOTASession{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		gets = appendImplicitSessionGetPaths(gets...)
+
+		var err error
+		if stored != nil {
+			pb = &ttnpb.FUOTASession{}
+			if err := cmd.ScanProto(pb); err != nil {
+				return err
+			}
+			pb, err = applySessionFieldMask(nil, pb, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applySessionFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, ik)
+				p.SRem(ctx, r.appKey(appUID), stored.SessionID)
+				p.SRem(ctx, r.allKey(ctx), fuota.SessionUID(appUID, stored.SessionID))
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.FUOTASession{}
+			}
+
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(append(sets[:0:0], sets...),
+				"updated_at",
+			)
+
+			updated := &ttnpb.FUOTASession{}
+			if stored == nil {
+				if err := ttnpb.RequireFields(sets,
+					"ids.application_ids",
+					"ids.session_id",
+				); err != nil {
+					return errInvalidFieldmask.WithCause(err)
+				}
+
+				pb.CreatedAt = pb.UpdatedAt
+				sets = append(sets, "created_at")
+
+				updated, err = applySessionFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+				if updated.ApplicationId != ids.ApplicationId || updated.SessionID != ids.SessionID {
+					return errInvalidIdentifiers.New()
+				}
+			} else {
+				if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationId != stored.ApplicationId {
+					return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+				}
+				if ttnpb.HasAnyField(sets, "ids.session_id") && pb.SessionID != stored.SessionID {
+					return errReadOnlyField.WithAttributes("field", "ids.session_id")
+				}
+				if err := cmd.ScanProto(updated); err != nil {
+					return err
+				}
+				updated, err = applySessionFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+			}
+			if err := updated.ValidateFields(sets...); err != nil {
+				return err
+			}
+
+			pipelined = func(p redis.Pipeliner) error {
+				if _, err := ttnredis.SetProto(ctx, p, ik, updated, 0); err != nil {
+					return err
+				}
+				p.SAdd(ctx, r.appKey(appUID), updated.SessionID)
+				p.SAdd(ctx, r.allKey(ctx), fuota.SessionUID(appUID, updated.SessionID))
+				return nil
+			}
+
+			pb, err = applySessionFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		if err != nil {
+			return err
+		}
+		return nil
+	}, ik)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
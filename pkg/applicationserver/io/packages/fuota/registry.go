@@ -0,0 +1,52 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuota
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// SessionRegistry is a registry for FUOTA sessions.
+type SessionRegistry interface {
+	// Get returns the FUOTA session by its identifiers.
+	Get(ctx context.Context, ids ttnpb.FUOTASessionIdentifiers, paths []string) (*ttnpb.FUOTASession, error)
+	// Range ranges over the FUOTA sessions and calls the callback function, until false is returned.
+	Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.FUOTASession) bool) error
+	// List returns all FUOTA sessions of the application.
+	List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.FUOTASession, error)
+	// Set creates, updates or deletes the FUOTA session by its identifiers.
+	Set(ctx context.Context, ids ttnpb.FUOTASessionIdentifiers, paths []string, f func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error)) (*ttnpb.FUOTASession, error)
+}
+
+var uniqueIDPattern = regexp.MustCompile("(.*)\\:(.*)")
+
+// SessionUID generates an unique ID based on an application unique ID and a FUOTA session ID.
+func SessionUID(appUID, id string) string {
+	return fmt.Sprintf("%s:%s", appUID, id)
+}
+
+// SplitSessionUID parses a unique ID generated by `SessionUID` and returns the application unique ID and the
+// FUOTA session ID.
+func SplitSessionUID(uid string) (string, string) {
+	matches := uniqueIDPattern.FindStringSubmatch(uid)
+	if len(matches) != 3 {
+		panic(fmt.Sprintf("invalid uniqueID `%s` with matches %v", uid, matches))
+	}
+	return matches[1], matches[2]
+}
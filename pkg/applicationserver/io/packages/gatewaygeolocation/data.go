@@ -0,0 +1,78 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewaygeolocation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// packageData contains the package configuration.
+// The minimum number of gateways is taken from the configuration, the default association and the association of the
+// end device, where the latter takes precedence.
+type packageData struct {
+	minGateways int
+}
+
+const minGatewaysField = "min_gateways"
+
+var (
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineCorruption("invalid_field_value", "field `{field}` has the invalid value `{value}`")
+)
+
+func (d *packageData) fromStruct(st *types.Struct) error {
+	value, ok := st.GetFields()[minGatewaysField]
+	if !ok {
+		return nil
+	}
+	numberValue, ok := value.GetKind().(*types.Value_NumberValue)
+	if !ok {
+		return errInvalidFieldType.WithAttributes(
+			"field", minGatewaysField,
+			"type", fmt.Sprintf("%T", value.GetKind()),
+		)
+	}
+	if n := numberValue.NumberValue; n < 1 || n > math.MaxInt32 || n != math.Trunc(n) {
+		return errInvalidFieldValue.WithAttributes(
+			"field", minGatewaysField,
+			"value", n,
+		)
+	}
+	d.minGateways = int(numberValue.NumberValue)
+	return nil
+}
+
+// mergePackageData merges the configuration with the data of the default association and the association of the
+// end device.
+func mergePackageData(conf Config, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (*packageData, error) {
+	merged := &packageData{
+		minGateways: conf.MinGateways,
+	}
+	if merged.minGateways < 1 {
+		merged.minGateways = 1
+	}
+	if err := merged.fromStruct(def.GetData()); err != nil {
+		return nil, err
+	}
+	if err := merged.fromStruct(assoc.GetData()); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
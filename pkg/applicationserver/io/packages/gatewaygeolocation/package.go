@@ -0,0 +1,126 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gatewaygeolocation implements an application package which solves the location of end devices from the
+// metadata of the gateways that received their uplink messages, without relying on external services.
+package gatewaygeolocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName defines the package name.
+const PackageName = "gateway-geolocation"
+
+// Config is the configuration of the gateway geolocation package.
+type Config struct {
+	MinGateways int `name:"min-gateways" description:"Minimum number of gateways with a known location to solve the location of an end device"`
+}
+
+// GeolocationPackage is the gateway geolocation application package.
+type GeolocationPackage struct {
+	server   io.Server
+	registry packages.Registry
+	config   Config
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+// The location is solved from the gateways which received the uplink message and have a known location. Metadata of
+// uplink messages forwarded by a relay is ignored.
+func (p *GeolocationPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/gatewaygeolocation")
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:gatewaygeolocation:%s", events.NewCorrelationID()))...)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIdentifiers, err)
+		}
+	}()
+
+	data, err := mergePackageData(p.config, def, assoc)
+	if err != nil {
+		return err
+	}
+	obs := observations(msg.RxMetadata)
+	if len(obs) < data.minGateways {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"gateway_count", len(obs),
+			"min_gateways", data.minGateways,
+		)).Debug("Not enough gateways with a known location to solve location")
+		return nil
+	}
+	loc, algorithm := solve(obs)
+	return p.sendLocationSolved(ctx, up.EndDeviceIdentifiers, loc, algorithm)
+}
+
+func (p *GeolocationPackage) sendLocationSolved(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, loc *ttnpb.Location, algorithm string) error {
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:           timePtr(time.Now().UTC()),
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  fmt.Sprintf("%v-%s", PackageName, algorithm),
+				Location: *loc,
+			},
+		},
+	})
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: PackageName,
+	}
+}
+
+// New instantiates the gateway geolocation package.
+func New(server io.Server, registry packages.Registry, conf Config) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:   server,
+		registry: registry,
+		config:   conf,
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,117 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewaygeolocation_test
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/gatewaygeolocation"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHandleUp(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	as := mock.NewServer(c)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	sub, err := as.Subscribe(ctx, "test", nil, false)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:               "test-dev",
+	}
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		PackageName: gatewaygeolocation.PackageName,
+	}
+	handler := gatewaygeolocation.New(as, nil, gatewaygeolocation.Config{MinGateways: 3})
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "gtw1"},
+						Location:           &ttnpb.Location{Latitude: 52.00, Longitude: 4.00},
+						RSSI:               -90,
+					},
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "gtw2"},
+						Location:           &ttnpb.Location{Latitude: 52.02, Longitude: 4.00},
+						RSSI:               -90,
+					},
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "gtw3"},
+						RSSI:               -80,
+					},
+				},
+			},
+		},
+	}
+
+	// Two gateways with a known location are not enough.
+	a.So(handler.HandleUp(ctx, def, nil, up), should.BeNil)
+	select {
+	case up := <-sub.Up():
+		t.Fatalf("Unexpected upstream message: %v", up)
+	case <-time.After(test.Delay):
+	}
+
+	// The association lowers the minimum number of gateways.
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		ApplicationPackageAssociationIdentifiers: ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIdentifiers: ids,
+		},
+		PackageName: gatewaygeolocation.PackageName,
+		Data: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"min_gateways": {Kind: &pbtypes.Value_NumberValue{NumberValue: 2}},
+			},
+		},
+	}
+	a.So(handler.HandleUp(ctx, def, assoc, up), should.BeNil)
+	select {
+	case up := <-sub.Up():
+		a.So(up.EndDeviceIdentifiers, should.Resemble, ids)
+		solved := up.GetLocationSolved()
+		if !a.So(solved, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(solved.Service, should.Equal, "gateway-geolocation-rssi")
+		a.So(solved.Source, should.Equal, ttnpb.SOURCE_LORA_RSSI_GEOLOCATION)
+		a.So(solved.Latitude, should.AlmostEqual, 52.01, 1e-6)
+		a.So(solved.Longitude, should.AlmostEqual, 4.00, 1e-6)
+		a.So(solved.Accuracy, should.BeGreaterThanOrEqualTo, 1112)
+	case <-time.After(test.Delay):
+		t.Fatal("Timed out waiting for solved location")
+	}
+
+	// Invalid association data is reported.
+	assoc.Data.Fields["min_gateways"] = &pbtypes.Value{Kind: &pbtypes.Value_StringValue{StringValue: "2"}}
+	a.So(handler.HandleUp(ctx, def, assoc, up), should.NotBeNil)
+}
@@ -0,0 +1,337 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewaygeolocation
+
+import (
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	earthRadius  = 6371008.8   // metres
+	speedOfLight = 299792458.0 // metres per second
+
+	// pathLossExponent is the exponent of the log-distance path loss model, which is used to weigh the gateways by
+	// their estimated distance to the end device.
+	pathLossExponent = 2.7
+	// minRSSIAccuracy is the minimum accuracy of a location solved by RSSI (metres).
+	minRSSIAccuracy = 100.0
+
+	// fineTimestampAccuracy is the assumed accuracy of fine timestamps (nanoseconds).
+	fineTimestampAccuracy = 50.0
+	// minTDOAGateways is the minimum number of gateways with fine timestamps to solve a location by TDOA.
+	minTDOAGateways = 3
+	// maxTDOAIterations is the maximum number of iterations of the TDOA solver.
+	maxTDOAIterations = 32
+	// tdoaConvergence is the step size below which the TDOA solver is considered converged (metres).
+	tdoaConvergence = 0.01
+	// maxGatewayDistance is the maximum distance between a TDOA solution and the nearest gateway (metres).
+	// Solutions beyond this distance are considered diverged.
+	maxGatewayDistance = 50000.0
+)
+
+// Algorithms used to solve the location.
+const (
+	algorithmRSSI = "rssi"
+	algorithmTDOA = "tdoa"
+)
+
+// observation is the reception of an uplink message by a gateway with a known location.
+type observation struct {
+	location      ttnpb.Location
+	rssi          float64
+	fineTimestamp uint64
+}
+
+// observationKey returns the key which identifies the gateway that received the uplink message.
+// Gateways of other networks are identified through their Packet Broker metadata.
+func observationKey(md *ttnpb.RxMetadata) string {
+	pb := md.PacketBroker
+	if pb == nil {
+		return md.GatewayId
+	}
+	key := md.GatewayId + "/" + pb.ForwarderNetId.String() + "/" + pb.ForwarderTenantId + "/" + pb.ForwarderClusterId
+	switch {
+	case pb.ForwarderGatewayId != nil:
+		return key + "/" + pb.ForwarderGatewayId.Value
+	case pb.ForwarderGatewayEui != nil:
+		return key + "/" + pb.ForwarderGatewayEui.String()
+	}
+	return key
+}
+
+// isRelayed reports whether the uplink message was forwarded by a relay. The metadata of relayed uplink messages
+// describes the reception of the relay, not of the end device.
+func isRelayed(md *ttnpb.RxMetadata) bool {
+	_, ok := md.GetAdvanced().GetFields()["relay"]
+	return ok
+}
+
+// observations returns the observations of the gateways with a known location, one per gateway.
+// If a gateway received the uplink message on multiple antennas, the reception with a fine timestamp and the
+// strongest signal is used.
+func observations(mds []*ttnpb.RxMetadata) []observation {
+	var (
+		keys []string
+		obs  = make(map[string]observation)
+	)
+	for _, md := range mds {
+		loc := md.GetLocation()
+		if loc == nil || loc.Latitude == 0 && loc.Longitude == 0 || isRelayed(md) {
+			continue
+		}
+		o := observation{
+			location:      *loc,
+			rssi:          float64(md.RSSI),
+			fineTimestamp: md.FineTimestamp,
+		}
+		if md.SignalRSSI != nil {
+			o.rssi = float64(md.SignalRSSI.Value)
+		}
+		key := observationKey(md)
+		existing, ok := obs[key]
+		switch {
+		case !ok:
+			keys = append(keys, key)
+		case (existing.fineTimestamp > 0) != (o.fineTimestamp > 0):
+			if existing.fineTimestamp > 0 {
+				continue
+			}
+		case existing.rssi >= o.rssi:
+			continue
+		}
+		obs[key] = o
+	}
+	res := make([]observation, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, obs[key])
+	}
+	// The strongest gateway is the reference of the TDOA solver.
+	sort.SliceStable(res, func(i, j int) bool { return res[i].rssi > res[j].rssi })
+	return res
+}
+
+// frame is a local tangent plane, in which coordinates are expressed in metres east and north of the origin.
+// The equirectangular projection is accurate for the distances over which LoRa uplink messages are received.
+type frame struct {
+	latitude, longitude float64
+	cosLatitude         float64
+}
+
+func newFrame(obs []observation) frame {
+	var f frame
+	for _, o := range obs {
+		f.latitude += o.location.Latitude
+		f.longitude += wrapLongitude(o.location.Longitude - obs[0].location.Longitude)
+	}
+	f.latitude /= float64(len(obs))
+	f.longitude = wrapLongitude(obs[0].location.Longitude + f.longitude/float64(len(obs)))
+	f.cosLatitude = math.Cos(f.latitude * math.Pi / 180)
+	return f
+}
+
+// wrapLongitude wraps the longitude difference d to [-180, 180).
+func wrapLongitude(d float64) float64 {
+	return math.Mod(math.Mod(d+180, 360)+360, 360) - 180
+}
+
+func (f frame) project(loc ttnpb.Location) (x, y float64) {
+	x = wrapLongitude(loc.Longitude-f.longitude) * math.Pi / 180 * earthRadius * f.cosLatitude
+	y = (loc.Latitude - f.latitude) * math.Pi / 180 * earthRadius
+	return x, y
+}
+
+func (f frame) location(x, y float64) ttnpb.Location {
+	return ttnpb.Location{
+		Latitude:  f.latitude + y/earthRadius*180/math.Pi,
+		Longitude: wrapLongitude(f.longitude + x/(earthRadius*f.cosLatitude)*180/math.Pi),
+	}
+}
+
+// accuracy converts the confidence radius r to the accuracy of a location.
+func accuracy(r float64) int32 {
+	if r >= math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(math.Ceil(r))
+}
+
+// solveRSSI solves the location as the centroid of the gateways, weighted by the inverse of their distance to the
+// end device as estimated by the log-distance path loss model. The confidence radius is the weighted root mean
+// square distance of the gateways to the centroid.
+func solveRSSI(obs []observation) *ttnpb.Location {
+	f := newFrame(obs)
+	maxRSSI := math.Inf(-1)
+	for _, o := range obs {
+		maxRSSI = math.Max(maxRSSI, o.rssi)
+	}
+	var (
+		weights     = make([]float64, len(obs))
+		sum, cx, cy float64
+	)
+	for i, o := range obs {
+		// The weights are relative to the strongest gateway, so the transmission power cancels out.
+		w := math.Pow(10, (o.rssi-maxRSSI)/(10*pathLossExponent))
+		x, y := f.project(o.location)
+		weights[i] = w
+		sum += w
+		cx += w * x
+		cy += w * y
+	}
+	cx, cy = cx/sum, cy/sum
+	var variance float64
+	for i, o := range obs {
+		x, y := f.project(o.location)
+		variance += weights[i] * ((x-cx)*(x-cx) + (y-cy)*(y-cy))
+	}
+	loc := f.location(cx, cy)
+	loc.Accuracy = accuracy(math.Max(math.Sqrt(variance/sum), minRSSIAccuracy))
+	loc.Source = ttnpb.SOURCE_LORA_RSSI_GEOLOCATION
+	return &loc
+}
+
+// solveTDOA solves the location by multilateration of the time differences of arrival, using the gateways with
+// fine timestamps. The solver starts at seed and returns false if there are not enough gateways with fine timestamps
+// or if the solution does not converge. The confidence radius is derived from the geometry of the gateways and the
+// residuals of the time differences of arrival.
+func solveTDOA(obs []observation, seed *ttnpb.Location) (*ttnpb.Location, bool) {
+	var tdoa []observation
+	for _, o := range obs {
+		if o.fineTimestamp > 0 {
+			tdoa = append(tdoa, o)
+		}
+	}
+	n := len(tdoa)
+	if n < minTDOAGateways {
+		return nil, false
+	}
+	f := newFrame(tdoa)
+	var (
+		gx, gy = make([]float64, n), make([]float64, n)
+		ranges = make([]float64, n)
+	)
+	for i, o := range tdoa {
+		gx[i], gy[i] = f.project(o.location)
+		// Fine timestamps are relative to the last GPS pulse per second, so the difference is taken modulo a second.
+		dt := (int64(o.fineTimestamp)-int64(tdoa[0].fineTimestamp))%1e9 + 1e9 + 5e8
+		ranges[i] = float64(dt%1e9-5e8) * speedOfLight / 1e9
+	}
+
+	x, y := f.project(*seed)
+	var b float64
+	for i := range tdoa {
+		b += ranges[i] - math.Hypot(x-gx[i], y-gy[i])
+	}
+	b /= float64(n)
+
+	var (
+		jtj       [3][3]float64
+		rss       float64
+		converged bool
+	)
+	for it := 0; it < maxTDOAIterations && !converged; it++ {
+		var jtr [3]float64
+		jtj, rss = [3][3]float64{}, 0
+		for i := range tdoa {
+			dx, dy := x-gx[i], y-gy[i]
+			d := math.Max(math.Hypot(dx, dy), 1)
+			r := d + b - ranges[i]
+			j := [3]float64{dx / d, dy / d, 1}
+			for k := 0; k < 3; k++ {
+				for l := 0; l < 3; l++ {
+					jtj[k][l] += j[k] * j[l]
+				}
+				jtr[k] += j[k] * r
+			}
+			rss += r * r
+		}
+		step, ok := solve3(jtj, jtr)
+		if !ok {
+			return nil, false
+		}
+		x, y, b = x-step[0], y-step[1], b-step[2]
+		converged = math.Hypot(step[0], step[1]) < tdoaConvergence
+	}
+	if !converged || math.IsNaN(x) || math.IsNaN(y) {
+		return nil, false
+	}
+	nearest := math.Inf(1)
+	for i := range tdoa {
+		nearest = math.Min(nearest, math.Hypot(x-gx[i], y-gy[i]))
+	}
+	if nearest > maxGatewayDistance {
+		return nil, false
+	}
+
+	sigma := fineTimestampAccuracy * speedOfLight / 1e9
+	if n > 3 {
+		sigma = math.Max(sigma, math.Sqrt(rss/float64(n-3)))
+	}
+	cx, okX := solve3(jtj, [3]float64{1, 0, 0})
+	cy, okY := solve3(jtj, [3]float64{0, 1, 0})
+	if !okX || !okY {
+		return nil, false
+	}
+	loc := f.location(x, y)
+	loc.Accuracy = accuracy(sigma * math.Sqrt(math.Abs(cx[0])+math.Abs(cy[1])))
+	loc.Source = ttnpb.SOURCE_LORA_TDOA_GEOLOCATION
+	return &loc, true
+}
+
+// solve3 solves the linear system a·x = b by Gaussian elimination with partial pivoting.
+// It returns false if a is singular.
+func solve3(a [3][3]float64, b [3]float64) ([3]float64, bool) {
+	for c := 0; c < 3; c++ {
+		p := c
+		for r := c + 1; r < 3; r++ {
+			if math.Abs(a[r][c]) > math.Abs(a[p][c]) {
+				p = r
+			}
+		}
+		if math.Abs(a[p][c]) < 1e-12 {
+			return [3]float64{}, false
+		}
+		a[c], a[p] = a[p], a[c]
+		b[c], b[p] = b[p], b[c]
+		for r := c + 1; r < 3; r++ {
+			m := a[r][c] / a[c][c]
+			for k := c; k < 3; k++ {
+				a[r][k] -= m * a[c][k]
+			}
+			b[r] -= m * b[c]
+		}
+	}
+	var x [3]float64
+	for r := 2; r >= 0; r-- {
+		s := b[r]
+		for k := r + 1; k < 3; k++ {
+			s -= a[r][k] * x[k]
+		}
+		x[r] = s / a[r][r]
+	}
+	return x, true
+}
+
+// solve solves the location of the end device from the observations, and returns the algorithm that is used.
+// TDOA is preferred over RSSI if enough gateways provide fine timestamps.
+func solve(obs []observation) (*ttnpb.Location, string) {
+	loc := solveRSSI(obs)
+	if tdoa, ok := solveTDOA(obs, loc); ok {
+		return tdoa, algorithmTDOA
+	}
+	return loc, algorithmRSSI
+}
@@ -0,0 +1,155 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewaygeolocation
+
+import (
+	"math"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testGatewayLocations = []ttnpb.Location{
+	{Latitude: 52.00, Longitude: 4.00},
+	{Latitude: 52.05, Longitude: 4.10},
+	{Latitude: 51.97, Longitude: 4.12},
+	{Latitude: 52.03, Longitude: 3.95},
+}
+
+// testRxMetadata returns the metadata of the test gateways for an uplink message transmitted at the given location.
+// The fine timestamps are derived from the distance to the gateways, and wrap around the GPS pulse per second.
+func testRxMetadata(dev ttnpb.Location) []*ttnpb.RxMetadata {
+	f := frame{latitude: dev.Latitude, longitude: dev.Longitude, cosLatitude: math.Cos(dev.Latitude * math.Pi / 180)}
+	mds := make([]*ttnpb.RxMetadata, 0, len(testGatewayLocations))
+	for i, loc := range testGatewayLocations {
+		loc := loc
+		d := math.Hypot(f.project(loc))
+		mds = append(mds, &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: string(rune('a' + i))},
+			Location:           &loc,
+			RSSI:               float32(-30 - 10*pathLossExponent*math.Log10(d)),
+			FineTimestamp:      uint64(999990000+int64(d/speedOfLight*1e9)) % 1e9,
+		})
+	}
+	return mds
+}
+
+func distance(a, b ttnpb.Location) float64 {
+	f := frame{latitude: a.Latitude, longitude: a.Longitude, cosLatitude: math.Cos(a.Latitude * math.Pi / 180)}
+	return math.Hypot(f.project(b))
+}
+
+func TestObservations(t *testing.T) {
+	a := assertions.New(t)
+
+	mds := testRxMetadata(ttnpb.Location{Latitude: 52.01, Longitude: 4.05})
+	mds = append(mds,
+		// Second antenna of gateway a without fine timestamp.
+		&ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "a"},
+			AntennaIndex:       1,
+			Location:           mds[0].Location,
+			RSSI:               -20,
+		},
+		// Gateway without location.
+		&ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "e"},
+			RSSI:               -20,
+		},
+		// Relayed uplink message.
+		&ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "f"},
+			Location:           &ttnpb.Location{Latitude: 52.01, Longitude: 4.05},
+			RSSI:               -20,
+			Advanced: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"relay": {Kind: &pbtypes.Value_StructValue{StructValue: &pbtypes.Struct{}}},
+				},
+			},
+		},
+	)
+	obs := observations(mds)
+	if !a.So(obs, should.HaveLength, 4) {
+		t.FailNow()
+	}
+	for i := 1; i < len(obs); i++ {
+		a.So(obs[i-1].rssi, should.BeGreaterThanOrEqualTo, obs[i].rssi)
+	}
+	for _, o := range obs {
+		a.So(o.fineTimestamp, should.NotEqual, 0)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	dev := ttnpb.Location{Latitude: 52.01, Longitude: 4.05}
+	for _, tc := range []struct {
+		Name      string
+		Metadata  func([]*ttnpb.RxMetadata) []*ttnpb.RxMetadata
+		Algorithm string
+		Source    ttnpb.LocationSource
+		MaxError  float64
+	}{
+		{
+			Name:      "TDOA",
+			Metadata:  func(mds []*ttnpb.RxMetadata) []*ttnpb.RxMetadata { return mds },
+			Algorithm: algorithmTDOA,
+			Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:  5,
+		},
+		{
+			Name:      "TDOA/Three gateways",
+			Metadata:  func(mds []*ttnpb.RxMetadata) []*ttnpb.RxMetadata { return mds[:3] },
+			Algorithm: algorithmTDOA,
+			Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:  5,
+		},
+		{
+			Name: "RSSI/No fine timestamps",
+			Metadata: func(mds []*ttnpb.RxMetadata) []*ttnpb.RxMetadata {
+				mds[1].FineTimestamp, mds[2].FineTimestamp = 0, 0
+				return mds
+			},
+			Algorithm: algorithmRSSI,
+			Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			MaxError:  2000,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			loc, algorithm := solve(observations(tc.Metadata(testRxMetadata(dev))))
+			a.So(algorithm, should.Equal, tc.Algorithm)
+			a.So(loc.Source, should.Equal, tc.Source)
+			d := distance(dev, *loc)
+			a.So(d, should.BeLessThan, tc.MaxError)
+			a.So(float64(loc.Accuracy), should.BeGreaterThanOrEqualTo, d)
+		})
+	}
+}
+
+func TestSolve3(t *testing.T) {
+	a := assertions.New(t)
+
+	x, ok := solve3([3][3]float64{{0, 2, 1}, {1, 0, 0}, {3, 1, 2}}, [3]float64{5, 1, 11})
+	a.So(ok, should.BeTrue)
+	for i, v := range [3]float64{1, 1, 3} {
+		a.So(x[i], should.AlmostEqual, v, 1e-9)
+	}
+
+	_, ok = solve3([3][3]float64{{1, 2, 3}, {2, 4, 6}, {0, 0, 1}}, [3]float64{1, 2, 3})
+	a.So(ok, should.BeFalse)
+}
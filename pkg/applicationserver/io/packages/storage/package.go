@@ -0,0 +1,201 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the storage integration application package, which persists application upstream
+// messages of the associated end devices and serves them through the ApplicationUpStorage service.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName defines the package name.
+const PackageName = "storage-integration"
+
+// Config is the configuration of the storage integration.
+type Config struct {
+	Storage         Storage       `name:"-"`
+	Dialect         string        `name:"dialect" description:"Database dialect of the storage integration (postgres, sqlite3)"`
+	DatabaseURI     string        `name:"database-uri" description:"Database connection URI of the storage integration"`
+	Retention       time.Duration `name:"retention" description:"Retention period of stored upstream messages (0 to keep forever)"`
+	CleanupInterval time.Duration `name:"cleanup-interval" description:"Interval between deletions of expired upstream messages"`
+}
+
+// StoragePackage is the storage integration application package.
+type StoragePackage struct {
+	ctx     context.Context
+	server  io.Server
+	storage Storage
+}
+
+var _ io.UplinkStorage = (*StoragePackage)(nil)
+
+// New instantiates the storage integration package.
+// If the retention period is set, a task is started that periodically deletes the expired upstream messages.
+func New(ctx context.Context, server io.Server, conf Config) packages.ApplicationPackageHandler {
+	p := &StoragePackage{
+		ctx:     ctx,
+		server:  server,
+		storage: conf.Storage,
+	}
+	if conf.Retention > 0 {
+		interval := conf.CleanupInterval
+		if interval <= 0 {
+			interval = time.Hour
+		}
+		server.StartTask(&component.TaskConfig{
+			Context: ctx,
+			ID:      "storage_integration_cleanup",
+			Func: func(ctx context.Context) error {
+				return p.runCleanup(ctx, conf.Retention, interval)
+			},
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+	}
+	return p
+}
+
+func (p *StoragePackage) runCleanup(ctx context.Context, retention, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := p.storage.DeleteBefore(ctx, time.Now().Add(-retention))
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete expired upstream messages")
+		} else if n > 0 {
+			log.FromContext(ctx).WithField("count", n).Debug("Deleted expired upstream messages")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: PackageName,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationUpStorageServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *StoragePackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationUpStorageHandler(p.ctx, s, conn)
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+// The upstream message is stored if either the end device or its application is associated with the package.
+func (p *StoragePackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	if def == nil && assoc == nil {
+		return nil
+	}
+	if Type(up) == "" {
+		return nil
+	}
+	if err := p.storage.Store(ctx, up); err != nil {
+		registerStoreFail(ctx, up.EndDeviceIdentifiers, err)
+		return err
+	}
+	return nil
+}
+
+// GetStoredApplicationUp implements ttnpb.ApplicationUpStorageServer.
+func (p *StoragePackage) GetStoredApplicationUp(req *ttnpb.GetStoredApplicationUpRequest, srv ttnpb.ApplicationUpStorage_GetStoredApplicationUpServer) error {
+	ctx := srv.Context()
+	filter, err := FilterFromRequest(req, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := rights.RequireApplication(ctx, ttnpb.ApplicationIdentifiers{ApplicationId: filter.ApplicationID}, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	paths := req.FieldMask.GetPaths()
+	var sendErr error
+	if err := p.storage.Range(ctx, filter, func(ctx context.Context, up *ttnpb.ApplicationUp) bool {
+		if len(paths) > 0 {
+			res := &ttnpb.ApplicationUp{}
+			if sendErr = res.SetFields(up, paths...); sendErr != nil {
+				return false
+			}
+			up = res
+		}
+		sendErr = srv.Send(up)
+		return sendErr == nil
+	}); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// GetStoredApplicationUpCount implements ttnpb.ApplicationUpStorageServer.
+func (p *StoragePackage) GetStoredApplicationUpCount(ctx context.Context, req *ttnpb.GetStoredApplicationUpCountRequest) (*ttnpb.GetStoredApplicationUpCountResponse, error) {
+	filter, err := FilterFromCountRequest(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := rights.RequireApplication(ctx, ttnpb.ApplicationIdentifiers{ApplicationId: filter.ApplicationID}, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	count, err := p.storage.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GetStoredApplicationUpCountResponse{
+		Count: count,
+	}, nil
+}
+
+// RangeUplinks implements io.UplinkStorage.
+// The uplink messages are ranged from the most recent to the oldest.
+func (p *StoragePackage) RangeUplinks(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(ctx context.Context, up *ttnpb.ApplicationUplink) bool) error {
+	filter := Filter{
+		ApplicationID: ids.ApplicationId,
+		DeviceID:      ids.DeviceId,
+		Type:          "uplink_message",
+		Order:         OrderReceivedAtDesc,
+	}
+	var setErr error
+	if err := p.storage.Range(ctx, filter, func(ctx context.Context, up *ttnpb.ApplicationUp) bool {
+		uplink := up.GetUplinkMessage()
+		if len(paths) > 0 {
+			res := &ttnpb.ApplicationUplink{}
+			if setErr = res.SetFields(uplink, paths...); setErr != nil {
+				return false
+			}
+			uplink = res
+		}
+		return f(ctx, uplink)
+	}); err != nil {
+		return err
+	}
+	return setErr
+}
@@ -0,0 +1,178 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+type mockGetStoredApplicationUpServer struct {
+	grpc.ServerStream
+	ctx context.Context
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *mockGetStoredApplicationUpServer) Context() context.Context { return s.ctx }
+
+func (s *mockGetStoredApplicationUpServer) Send(up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+func TestPackage(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	as := mock.NewServer(c)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	p := New(ctx, as, Config{Storage: newSQLStorage(t)}).(*StoragePackage)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appIDs,
+		DeviceId:               "foo-dev",
+	}
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		PackageName: PackageName,
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		PackageName: PackageName,
+	}
+
+	start := time.Unix(1600000000, 0).UTC()
+	ups := []*ttnpb.ApplicationUp{
+		makeUplink("foo-app", "foo-dev", start, 1),
+		makeJoinAccept("foo-app", "foo-dev", start.Add(time.Minute)),
+		makeUplink("foo-app", "foo-dev", start.Add(2*time.Minute), 2),
+	}
+
+	// Upstream messages are not stored if the package is not associated.
+	a.So(p.HandleUp(ctx, nil, nil, ups[0]), should.BeNil)
+	// Upstream messages of unsupported types are not stored.
+	a.So(p.HandleUp(ctx, def, nil, &ttnpb.ApplicationUp{EndDeviceIdentifiers: devIDs}), should.BeNil)
+
+	a.So(p.HandleUp(ctx, def, nil, ups[0]), should.BeNil)
+	a.So(p.HandleUp(ctx, nil, assoc, ups[1]), should.BeNil)
+	a.So(p.HandleUp(ctx, def, assoc, ups[2]), should.BeNil)
+
+	authorizedCtx := rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	})
+	unauthorizedCtx := rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO),
+		},
+	})
+
+	t.Run("GetStoredApplicationUp", func(t *testing.T) {
+		a := assertions.New(t)
+
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			ApplicationIds: &appIDs,
+		}, &mockGetStoredApplicationUpServer{ctx: unauthorizedCtx})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		srv := &mockGetStoredApplicationUpServer{ctx: authorizedCtx}
+		err = p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: &devIDs,
+			Order:        "-received_at",
+		}, srv)
+		a.So(err, should.BeNil)
+		a.So(srv.ups, should.Resemble, []*ttnpb.ApplicationUp{ups[2], ups[1], ups[0]})
+
+		srv = &mockGetStoredApplicationUpServer{ctx: authorizedCtx}
+		err = p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			ApplicationIds: &appIDs,
+			Type:           "uplink_message",
+			Limit:          &pbtypes.UInt32Value{Value: 1},
+			FieldMask:      &pbtypes.FieldMask{Paths: []string{"end_device_ids", "up.uplink_message.f_port"}},
+		}, srv)
+		a.So(err, should.BeNil)
+		a.So(srv.ups, should.Resemble, []*ttnpb.ApplicationUp{
+			{
+				EndDeviceIdentifiers: devIDs,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort: 1,
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("GetStoredApplicationUpCount", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := p.GetStoredApplicationUpCount(unauthorizedCtx, &ttnpb.GetStoredApplicationUpCountRequest{
+			ApplicationIds: &appIDs,
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = p.GetStoredApplicationUpCount(authorizedCtx, &ttnpb.GetStoredApplicationUpCountRequest{})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		res, err := p.GetStoredApplicationUpCount(authorizedCtx, &ttnpb.GetStoredApplicationUpCountRequest{
+			ApplicationIds: &appIDs,
+		})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, &ttnpb.GetStoredApplicationUpCountResponse{
+			Count: map[string]uint32{"foo-dev": 3},
+		})
+
+		afterPB, _ := pbtypes.TimestampProto(start)
+		res, err = p.GetStoredApplicationUpCount(authorizedCtx, &ttnpb.GetStoredApplicationUpCountRequest{
+			EndDeviceIds: &devIDs,
+			Type:         "uplink_message",
+			After:        afterPB,
+		})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, &ttnpb.GetStoredApplicationUpCountResponse{
+			Count: map[string]uint32{"foo-dev": 1},
+		})
+	})
+
+	t.Run("RangeUplinks", func(t *testing.T) {
+		a := assertions.New(t)
+
+		var fPorts []uint32
+		err := p.RangeUplinks(ctx, devIDs, []string{"f_port"}, func(_ context.Context, up *ttnpb.ApplicationUplink) bool {
+			a.So(up.FRMPayload, should.BeNil)
+			fPorts = append(fPorts, up.FPort)
+			return true
+		})
+		a.So(err, should.BeNil)
+		a.So(fPorts, should.Resemble, []uint32{2, 1})
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres" // Postgres database driver.
+	_ "github.com/jinzhu/gorm/dialects/sqlite"   // SQLite database driver.
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// applicationUp is the SQL model of a stored upstream message.
+type applicationUp struct {
+	ID            uint64    `gorm:"primary_key;AUTO_INCREMENT"`
+	ApplicationID string    `gorm:"type:VARCHAR(36);not null;index:application_up_application_index"`
+	DeviceID      string    `gorm:"type:VARCHAR(36);not null;index:application_up_device_index"`
+	Type          string    `gorm:"type:VARCHAR(32);not null"`
+	FPort         *uint32   `gorm:"type:INTEGER"`
+	ReceivedAt    time.Time `gorm:"not null;index:application_up_received_at_index"`
+	Data          []byte    `gorm:"not null"`
+}
+
+// TableName implements gorm.tabler.
+func (applicationUp) TableName() string { return "application_ups" }
+
+var (
+	errDatabase       = errors.DefineUnavailable("database", "database error")
+	errUnknownDialect = errors.DefineInvalidArgument("unknown_dialect", "unknown database dialect `{dialect}`")
+	errDecodeUp       = errors.DefineCorruption("decode_up", "decode stored upstream message")
+)
+
+// SQLStorage is a Storage backed by a SQL database.
+type SQLStorage struct {
+	DB *gorm.DB
+}
+
+// OpenSQL opens a SQL database with the given dialect (postgres or sqlite3) and URI, and migrates the schema.
+func OpenSQL(ctx context.Context, dialect, uri string) (*SQLStorage, error) {
+	switch dialect {
+	case "postgres", "sqlite3":
+	default:
+		return nil, errUnknownDialect.WithAttributes("dialect", dialect)
+	}
+	db, err := gorm.Open(dialect, uri)
+	if err != nil {
+		return nil, errDatabase.WithCause(err)
+	}
+	if err := db.AutoMigrate(&applicationUp{}).Error; err != nil {
+		db.Close()
+		return nil, errDatabase.WithCause(err)
+	}
+	return &SQLStorage{DB: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *SQLStorage) Close() error {
+	return s.DB.Close()
+}
+
+func (s *SQLStorage) query(filter Filter) *gorm.DB {
+	query := s.DB.Model(&applicationUp{}).Where("application_id = ?", filter.ApplicationID)
+	if filter.DeviceID != "" {
+		query = query.Where("device_id = ?", filter.DeviceID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.FPort != nil {
+		query = query.Where("f_port = ?", *filter.FPort)
+	}
+	if filter.After != nil {
+		query = query.Where("received_at > ?", filter.After.UTC())
+	}
+	if filter.Before != nil {
+		query = query.Where("received_at < ?", filter.Before.UTC())
+	}
+	return query
+}
+
+// Store implements Storage.
+func (s *SQLStorage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	defer trace.StartRegion(ctx, "store application up").End()
+
+	data, err := up.Marshal()
+	if err != nil {
+		return err
+	}
+	model := &applicationUp{
+		ApplicationID: up.ApplicationId,
+		DeviceID:      up.DeviceId,
+		Type:          Type(up),
+		Data:          data,
+	}
+	if up.ReceivedAt != nil {
+		model.ReceivedAt = up.ReceivedAt.UTC()
+	} else {
+		model.ReceivedAt = time.Now().UTC()
+	}
+	if msg := up.GetUplinkMessage(); msg != nil {
+		fPort := msg.FPort
+		model.FPort = &fPort
+	}
+	if err := s.DB.Create(model).Error; err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Range implements Storage.
+func (s *SQLStorage) Range(ctx context.Context, filter Filter, f func(context.Context, *ttnpb.ApplicationUp) bool) error {
+	defer trace.StartRegion(ctx, "range application ups").End()
+
+	query := s.query(filter).Select("data")
+	switch filter.Order {
+	case OrderReceivedAtDesc:
+		query = query.Order("received_at DESC, id DESC")
+	default:
+		query = query.Order("received_at, id")
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	rows, err := query.Rows()
+	if err != nil {
+		return errDatabase.WithCause(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return errDatabase.WithCause(err)
+		}
+		up := &ttnpb.ApplicationUp{}
+		if err := up.Unmarshal(data); err != nil {
+			return errDecodeUp.WithCause(err)
+		}
+		if !f(ctx, up) {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Count implements Storage.
+func (s *SQLStorage) Count(ctx context.Context, filter Filter) (map[string]uint32, error) {
+	defer trace.StartRegion(ctx, "count application ups").End()
+
+	rows, err := s.query(filter).Select("device_id, COUNT(*)").Group("device_id").Rows()
+	if err != nil {
+		return nil, errDatabase.WithCause(err)
+	}
+	defer rows.Close()
+	res := make(map[string]uint32)
+	for rows.Next() {
+		var (
+			deviceID string
+			count    uint32
+		)
+		if err := rows.Scan(&deviceID, &count); err != nil {
+			return nil, errDatabase.WithCause(err)
+		}
+		res[deviceID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errDatabase.WithCause(err)
+	}
+	return res, nil
+}
+
+// DeleteBefore implements Storage.
+func (s *SQLStorage) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	defer trace.StartRegion(ctx, "delete application ups").End()
+
+	res := s.DB.Where("received_at < ?", t.UTC()).Delete(&applicationUp{})
+	if res.Error != nil {
+		return 0, errDatabase.WithCause(res.Error)
+	}
+	return res.RowsAffected, nil
+}
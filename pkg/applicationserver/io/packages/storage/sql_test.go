@@ -0,0 +1,212 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func newSQLStorage(t *testing.T) *SQLStorage {
+	s, err := OpenSQL(test.Context(), "sqlite3", filepath.Join(t.TempDir(), "storage.db"))
+	if err != nil {
+		t.Fatalf("Failed to open SQL storage: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func makeUplink(appID, devID string, receivedAt time.Time, fPort uint32) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+			DeviceId:               devID,
+		},
+		ReceivedAt: &receivedAt,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FRMPayload: []byte{byte(fPort)},
+			},
+		},
+	}
+}
+
+func makeJoinAccept(appID, devID string, receivedAt time.Time) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+			DeviceId:               devID,
+		},
+		ReceivedAt: &receivedAt,
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				SessionKeyId: []byte{0x01},
+			},
+		},
+	}
+}
+
+func rangeAll(ctx context.Context, s Storage, filter Filter) ([]*ttnpb.ApplicationUp, error) {
+	var ups []*ttnpb.ApplicationUp
+	err := s.Range(ctx, filter, func(_ context.Context, up *ttnpb.ApplicationUp) bool {
+		ups = append(ups, up)
+		return true
+	})
+	return ups, err
+}
+
+func TestOpenSQL(t *testing.T) {
+	a, ctx := test.New(t)
+	_, err := OpenSQL(ctx, "mysql", "")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestSQLStorage(t *testing.T) {
+	a, ctx := test.New(t)
+	s := newSQLStorage(t)
+
+	start := time.Unix(1600000000, 0).UTC()
+	ups := []*ttnpb.ApplicationUp{
+		makeUplink("foo-app", "foo-dev", start, 1),
+		makeUplink("foo-app", "foo-dev", start.Add(time.Minute), 2),
+		makeUplink("foo-app", "bar-dev", start.Add(2*time.Minute), 1),
+		makeJoinAccept("foo-app", "bar-dev", start.Add(3*time.Minute)),
+		makeUplink("bar-app", "foo-dev", start.Add(4*time.Minute), 1),
+	}
+	for _, up := range ups {
+		if !a.So(s.Store(ctx, up), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name     string
+			Filter   Filter
+			Expected []*ttnpb.ApplicationUp
+		}{
+			{
+				Name:     "Application",
+				Filter:   Filter{ApplicationID: "foo-app"},
+				Expected: ups[:4],
+			},
+			{
+				Name: "ApplicationDescendingWithLimit",
+				Filter: Filter{
+					ApplicationID: "foo-app",
+					Order:         OrderReceivedAtDesc,
+					Limit:         2,
+				},
+				Expected: []*ttnpb.ApplicationUp{ups[3], ups[2]},
+			},
+			{
+				Name: "EndDeviceFPort",
+				Filter: Filter{
+					ApplicationID: "foo-app",
+					DeviceID:      "foo-dev",
+					Type:          "uplink_message",
+					FPort:         func(v uint32) *uint32 { return &v }(2),
+				},
+				Expected: []*ttnpb.ApplicationUp{ups[1]},
+			},
+			{
+				Name: "Type",
+				Filter: Filter{
+					ApplicationID: "foo-app",
+					Type:          "join_accept",
+				},
+				Expected: []*ttnpb.ApplicationUp{ups[3]},
+			},
+			{
+				Name: "TimeRange",
+				Filter: Filter{
+					ApplicationID: "foo-app",
+					After:         func(t time.Time) *time.Time { return &t }(start.Add(30 * time.Second)),
+					Before:        func(t time.Time) *time.Time { return &t }(start.Add(150 * time.Second)),
+				},
+				Expected: ups[1:3],
+			},
+			{
+				Name:   "UnknownApplication",
+				Filter: Filter{ApplicationID: "baz-app"},
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				res, err := rangeAll(ctx, s, tc.Filter)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(res, should.Resemble, tc.Expected)
+			})
+		}
+	})
+
+	t.Run("RangeStop", func(t *testing.T) {
+		a := assertions.New(t)
+		var calls int
+		err := s.Range(ctx, Filter{ApplicationID: "foo-app"}, func(context.Context, *ttnpb.ApplicationUp) bool {
+			calls++
+			return false
+		})
+		a.So(err, should.BeNil)
+		a.So(calls, should.Equal, 1)
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		a := assertions.New(t)
+		count, err := s.Count(ctx, Filter{ApplicationID: "foo-app"})
+		a.So(err, should.BeNil)
+		a.So(count, should.Resemble, map[string]uint32{
+			"foo-dev": 2,
+			"bar-dev": 2,
+		})
+
+		count, err = s.Count(ctx, Filter{ApplicationID: "foo-app", Type: "join_accept"})
+		a.So(err, should.BeNil)
+		a.So(count, should.Resemble, map[string]uint32{
+			"bar-dev": 1,
+		})
+
+		count, err = s.Count(ctx, Filter{ApplicationID: "baz-app"})
+		a.So(err, should.BeNil)
+		a.So(count, should.BeEmpty)
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		a := assertions.New(t)
+		n, err := s.DeleteBefore(ctx, start.Add(90*time.Second))
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 2)
+
+		res, err := rangeAll(ctx, s, Filter{ApplicationID: "foo-app"})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, ups[2:4])
+
+		res, err = rangeAll(ctx, s, Filter{ApplicationID: "bar-app"})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, ups[4:])
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Storage is a persistent store of application upstream messages.
+type Storage interface {
+	// Store stores the upstream message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range ranges the stored upstream messages that match the filter and calls the callback function,
+	// until false is returned.
+	Range(ctx context.Context, filter Filter, f func(context.Context, *ttnpb.ApplicationUp) bool) error
+	// Count returns the number of stored upstream messages that match the filter, by end device ID.
+	Count(ctx context.Context, filter Filter) (map[string]uint32, error)
+	// DeleteBefore deletes the upstream messages that have been received before the given time.
+	// It returns the number of deleted messages.
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+// Order is the order of the stored upstream messages.
+type Order string
+
+const (
+	// OrderDefault orders upstream messages by received_at ascending.
+	OrderDefault Order = ""
+	// OrderReceivedAt orders upstream messages by received_at ascending.
+	OrderReceivedAt Order = "received_at"
+	// OrderReceivedAtDesc orders upstream messages by received_at descending.
+	OrderReceivedAtDesc Order = "-received_at"
+)
+
+// Filter selects stored upstream messages.
+type Filter struct {
+	// ApplicationID is the application ID of the upstream messages. It is always set.
+	ApplicationID string
+	// DeviceID is the end device ID of the upstream messages. If empty, all end devices of the application match.
+	DeviceID string
+	// Type is the upstream message type. If empty, all types match.
+	Type string
+	// FPort is the FPort of uplink messages. If nil, all FPorts match.
+	FPort *uint32
+	// After is the lower bound (exclusive) of the received_at timestamp.
+	After *time.Time
+	// Before is the upper bound (exclusive) of the received_at timestamp.
+	Before *time.Time
+	// Limit is the maximum number of upstream messages. If zero, there is no limit.
+	Limit uint32
+	// Order is the order of the upstream messages.
+	Order Order
+}
+
+var (
+	errNoIdentifiers       = errors.DefineInvalidArgument("no_identifiers", "no application or end device identifiers")
+	errMultipleIdentifiers = errors.DefineInvalidArgument("multiple_identifiers", "only one of application or end device identifiers may be set")
+	errTimeRange           = errors.DefineInvalidArgument("time_range", "`last` cannot be used in conjunction with `after` or `before`")
+	errInvalidTimestamp    = errors.DefineInvalidArgument("invalid_timestamp", "invalid timestamp")
+	errInvalidDuration     = errors.DefineInvalidArgument("invalid_duration", "invalid duration")
+)
+
+// FilterFromRequest returns the Filter that matches the given request.
+// The given time is used as reference for the `last` parameter.
+func FilterFromRequest(req *ttnpb.GetStoredApplicationUpRequest, now time.Time) (Filter, error) {
+	var filter Filter
+	switch {
+	case req.ApplicationIds != nil && req.EndDeviceIds != nil:
+		return Filter{}, errMultipleIdentifiers.New()
+	case req.ApplicationIds != nil:
+		filter.ApplicationID = req.ApplicationIds.ApplicationId
+	case req.EndDeviceIds != nil:
+		filter.ApplicationID = req.EndDeviceIds.ApplicationId
+		filter.DeviceID = req.EndDeviceIds.DeviceId
+	default:
+		return Filter{}, errNoIdentifiers.New()
+	}
+	filter.Type = req.Type
+	filter.Order = Order(req.Order)
+	if req.FPort != nil {
+		fPort := req.FPort.Value
+		filter.FPort = &fPort
+	}
+	if req.Limit != nil {
+		filter.Limit = req.Limit.Value
+	}
+	if req.Last != nil {
+		if req.After != nil || req.Before != nil {
+			return Filter{}, errTimeRange.New()
+		}
+		last, err := pbtypes.DurationFromProto(req.Last)
+		if err != nil {
+			return Filter{}, errInvalidDuration.WithCause(err)
+		}
+		after := now.Add(-last)
+		filter.After = &after
+		return filter, nil
+	}
+	if req.After != nil {
+		after, err := pbtypes.TimestampFromProto(req.After)
+		if err != nil {
+			return Filter{}, errInvalidTimestamp.WithCause(err)
+		}
+		filter.After = &after
+	}
+	if req.Before != nil {
+		before, err := pbtypes.TimestampFromProto(req.Before)
+		if err != nil {
+			return Filter{}, errInvalidTimestamp.WithCause(err)
+		}
+		filter.Before = &before
+	}
+	return filter, nil
+}
+
+// FilterFromCountRequest returns the Filter that matches the given count request.
+// The given time is used as reference for the `last` parameter.
+func FilterFromCountRequest(req *ttnpb.GetStoredApplicationUpCountRequest, now time.Time) (Filter, error) {
+	return FilterFromRequest(&ttnpb.GetStoredApplicationUpRequest{
+		ApplicationIds: req.ApplicationIds,
+		EndDeviceIds:   req.EndDeviceIds,
+		Type:           req.Type,
+		After:          req.After,
+		Before:         req.Before,
+		FPort:          req.FPort,
+		Last:           req.Last,
+	}, now)
+}
+
+// Type returns the type of the upstream message, as used in Filter.
+func Type(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	case *ttnpb.ApplicationUp_ServiceData:
+		return "service_data"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFilterFromRequest(t *testing.T) {
+	now := time.Unix(1600000000, 0).UTC()
+	after := now.Add(-2 * time.Hour)
+	before := now.Add(-time.Hour)
+	afterPB, _ := pbtypes.TimestampProto(after)
+	beforePB, _ := pbtypes.TimestampProto(before)
+	fPort := uint32(42)
+	lastAfter := now.Add(-10 * time.Minute)
+
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.GetStoredApplicationUpRequest
+		Filter         Filter
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NoIdentifiers",
+			Request:        &ttnpb.GetStoredApplicationUpRequest{},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "BothIdentifiers",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+				EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+					DeviceId:               "foo-dev",
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "LastWithAfter",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+				After:          afterPB,
+				Last:           pbtypes.DurationProto(10 * time.Minute),
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Application",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+				Type:           "uplink_message",
+				FPort:          &pbtypes.UInt32Value{Value: 42},
+				Limit:          &pbtypes.UInt32Value{Value: 10},
+				After:          afterPB,
+				Before:         beforePB,
+				Order:          "-received_at",
+			},
+			Filter: Filter{
+				ApplicationID: "foo-app",
+				Type:          "uplink_message",
+				FPort:         &fPort,
+				Limit:         10,
+				After:         &after,
+				Before:        &before,
+				Order:         OrderReceivedAtDesc,
+			},
+		},
+		{
+			Name: "EndDeviceLast",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+					DeviceId:               "foo-dev",
+				},
+				Last: pbtypes.DurationProto(10 * time.Minute),
+			},
+			Filter: Filter{
+				ApplicationID: "foo-app",
+				DeviceID:      "foo-dev",
+				After:         &lastAfter,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			filter, err := FilterFromRequest(tc.Request, now)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(filter, should.Resemble, tc.Filter)
+		})
+	}
+}
+
+func TestType(t *testing.T) {
+	a := assertions.New(t)
+	a.So(Type(&ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{}},
+	}), should.Equal, "uplink_message")
+	a.So(Type(&ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{}},
+	}), should.Equal, "downlink_queue_invalidated")
+	a.So(Type(&ttnpb.ApplicationUp{}), should.BeEmpty)
+	for _, up := range []*ttnpb.ApplicationUp{
+		{Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{}}},
+		{Up: &ttnpb.ApplicationUp_LocationSolved{LocationSolved: &ttnpb.ApplicationLocation{}}},
+		{Up: &ttnpb.ApplicationUp_ServiceData{ServiceData: &ttnpb.ApplicationServiceData{}}},
+	} {
+		_, ok := ttnpb.StoredApplicationUpTypes[Type(up)]
+		a.So(ok, should.BeTrue)
+	}
+}
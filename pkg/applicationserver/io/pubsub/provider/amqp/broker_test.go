@@ -0,0 +1,208 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	amqp_client "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/amqp"
+)
+
+// broker is an in-memory AMQP broker that supports topic exchanges only.
+type broker struct {
+	mu        sync.Mutex
+	exchanges map[string]struct{}
+	queues    map[string]chan amqp_client.Delivery
+	bindings  []binding
+	lastQueue int
+	lastTag   uint64
+}
+
+type binding struct {
+	exchange string
+	key      string
+	queue    string
+}
+
+func newBroker() *broker {
+	return &broker{
+		exchanges: map[string]struct{}{
+			amqp.DefaultExchange: {},
+		},
+		queues: make(map[string]chan amqp_client.Delivery),
+	}
+}
+
+// Channel implements amqp.Connection.
+func (b *broker) Channel() (amqp.Channel, error) {
+	return &channel{broker: b}, nil
+}
+
+// Close implements amqp.Connection.
+func (b *broker) Close() error {
+	return nil
+}
+
+// matchRoutingKey matches the routing key to the binding pattern.
+// The pattern words are separated by dots, where `*` matches exactly one word and `#` matches zero or more words.
+func matchRoutingKey(pattern, key []string) bool {
+	switch {
+	case len(pattern) == 0:
+		return len(key) == 0
+	case pattern[0] == "#":
+		for i := 0; i <= len(key); i++ {
+			if matchRoutingKey(pattern[1:], key[i:]) {
+				return true
+			}
+		}
+		return false
+	case len(key) == 0:
+		return false
+	case pattern[0] == "*" || pattern[0] == key[0]:
+		return matchRoutingKey(pattern[1:], key[1:])
+	default:
+		return false
+	}
+}
+
+func (b *broker) route(exchange, key string, msg amqp_client.Publishing) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.exchanges[exchange]; !ok {
+		return amqp_client.ErrClosed
+	}
+	for _, bnd := range b.bindings {
+		if bnd.exchange != exchange || !matchRoutingKey(strings.Split(bnd.key, "."), strings.Split(key, ".")) {
+			continue
+		}
+		b.lastTag++
+		select {
+		case b.queues[bnd.queue] <- amqp_client.Delivery{
+			Headers:     msg.Headers,
+			Body:        msg.Body,
+			DeliveryTag: b.lastTag,
+			Exchange:    exchange,
+			RoutingKey:  key,
+		}:
+		default:
+		}
+	}
+	return nil
+}
+
+type channel struct {
+	broker   *broker
+	mu       sync.Mutex
+	confirms chan amqp_client.Confirmation
+	seq      uint64
+}
+
+// ExchangeDeclare implements amqp.Channel.
+func (c *channel) ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp_client.Table) error {
+	if kind != amqp_client.ExchangeTopic {
+		return amqp_client.ErrCommandInvalid
+	}
+	c.broker.mu.Lock()
+	c.broker.exchanges[name] = struct{}{}
+	c.broker.mu.Unlock()
+	return nil
+}
+
+// QueueDeclare implements amqp.Channel.
+func (c *channel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp_client.Table) (amqp_client.Queue, error) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	if name == "" {
+		c.broker.lastQueue++
+		name = fmt.Sprintf("amq.gen-%d", c.broker.lastQueue)
+	}
+	if _, ok := c.broker.queues[name]; !ok {
+		c.broker.queues[name] = make(chan amqp_client.Delivery, 16)
+	}
+	return amqp_client.Queue{Name: name}, nil
+}
+
+// QueueBind implements amqp.Channel.
+func (c *channel) QueueBind(name, key, exchange string, noWait bool, args amqp_client.Table) error {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	if _, ok := c.broker.exchanges[exchange]; !ok {
+		return amqp_client.ErrClosed
+	}
+	c.broker.bindings = append(c.broker.bindings, binding{
+		exchange: exchange,
+		key:      key,
+		queue:    name,
+	})
+	return nil
+}
+
+// Confirm implements amqp.Channel.
+func (c *channel) Confirm(noWait bool) error {
+	return nil
+}
+
+// NotifyPublish implements amqp.Channel.
+func (c *channel) NotifyPublish(confirm chan amqp_client.Confirmation) chan amqp_client.Confirmation {
+	c.mu.Lock()
+	c.confirms = confirm
+	c.mu.Unlock()
+	return confirm
+}
+
+// Publish implements amqp.Channel.
+func (c *channel) Publish(exchange, key string, mandatory, immediate bool, msg amqp_client.Publishing) error {
+	if err := c.broker.route(exchange, key, msg); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.confirms != nil {
+		c.seq++
+		go func(confirms chan amqp_client.Confirmation, tag uint64) {
+			confirms <- amqp_client.Confirmation{DeliveryTag: tag, Ack: true}
+		}(c.confirms, c.seq)
+	}
+	return nil
+}
+
+// Consume implements amqp.Channel.
+func (c *channel) Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp_client.Table) (<-chan amqp_client.Delivery, error) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	deliveries, ok := c.broker.queues[queue]
+	if !ok {
+		return nil, amqp_client.ErrClosed
+	}
+	return deliveries, nil
+}
+
+// Ack implements amqp.Channel.
+func (c *channel) Ack(tag uint64, multiple bool) error {
+	return nil
+}
+
+// Nack implements amqp.Channel.
+func (c *channel) Nack(tag uint64, multiple, requeue bool) error {
+	return nil
+}
+
+// Close implements amqp.Channel.
+func (c *channel) Close() error {
+	return nil
+}
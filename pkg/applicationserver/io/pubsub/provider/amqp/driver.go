@@ -0,0 +1,393 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"sync"
+
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// Channel is an AMQP channel.
+// It is implemented by *amqp.Channel.
+type Channel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Confirm(noWait bool) error
+	NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Ack(tag uint64, multiple bool) error
+	Nack(tag uint64, multiple, requeue bool) error
+	Close() error
+}
+
+// Connection is an AMQP connection.
+type Connection interface {
+	// Channel opens a new channel on the connection.
+	Channel() (Channel, error)
+	// Close closes the connection and its channels.
+	Close() error
+}
+
+var (
+	errNilConnection = errors.DefineInvalidArgument("nil_connection", "connection is nil")
+	errOpenChannel   = errors.Define("open_channel", "open AMQP channel")
+	errConfirmMode   = errors.Define("confirm_mode", "put AMQP channel in confirm mode")
+	errChannelClosed = errors.DefineUnavailable("channel_closed", "AMQP channel closed")
+	errPublish       = errors.Define("publish", "publish to AMQP exchange `{exchange}` with routing key `{routing_key}`")
+	errPublishNacked = errors.DefineAborted("publish_nacked", "AMQP server did not confirm the message")
+)
+
+type topic struct {
+	mu       sync.Mutex
+	ch       Channel
+	confirms chan amqp.Confirmation
+	seq      uint64
+	exchange string
+	key      string
+}
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given exchange with the given routing key.
+// The channel of the topic is put in confirm mode, and messages are only considered sent once they are confirmed
+// by the server.
+func OpenTopic(conn Connection, exchange, routingKey string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(conn, exchange, routingKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(conn Connection, exchange, routingKey string) (driver.Topic, error) {
+	if conn == nil {
+		return nil, errNilConnection.New()
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, errOpenChannel.WithCause(err)
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, errConfirmMode.WithCause(err)
+	}
+	dt := &topic{
+		ch:       ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, 1)),
+		exchange: exchange,
+		key:      routingKey,
+	}
+	return dt, nil
+}
+
+// SendBatch implements driver.Topic.
+// The messages are published one by one, and each publication waits for the server confirmation.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pub := amqp.Publishing{
+			Headers:      encodeHeaders(msg.Metadata),
+			DeliveryMode: amqp.Persistent,
+			Body:         msg.Body,
+		}
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**amqp.Publishing)
+				if !ok {
+					return false
+				}
+				*p = &pub
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if err := t.ch.Publish(t.exchange, t.key, false, false, pub); err != nil {
+			return errPublish.WithAttributes(
+				"exchange", t.exchange,
+				"routing_key", t.key,
+			).WithCause(err)
+		}
+		t.seq++
+		if err := t.waitConfirm(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitConfirm waits for the confirmation of the last published message.
+// Confirmations of messages for which the wait has been canceled previously are discarded.
+func (t *topic) waitConfirm(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case c, ok := <-t.confirms:
+			if !ok {
+				return errChannelClosed.New()
+			}
+			if c.DeliveryTag < t.seq {
+				continue
+			}
+			if !c.Ack {
+				return errPublishNacked.New()
+			}
+			return nil
+		}
+	}
+}
+
+func encodeHeaders(metadata map[string]string) amqp.Table {
+	if len(metadata) == 0 {
+		return nil
+	}
+	headers := make(amqp.Table, len(metadata))
+	for k, v := range metadata {
+		headers[k] = v
+	}
+	return headers
+}
+
+func decodeMessage(d amqp.Delivery) *driver.Message {
+	var metadata map[string]string
+	for k, v := range d.Headers {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string, len(d.Headers))
+		}
+		metadata[k] = s
+	}
+	return &driver.Message{
+		Body:     d.Body,
+		Metadata: metadata,
+		AckID:    d.DeliveryTag,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(*amqp.Delivery)
+			if !ok {
+				return false
+			}
+			*p = d
+			return true
+		},
+	}
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	c, ok := i.(*Channel)
+	if !ok {
+		return false
+	}
+	*c = t.ch
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (t *topic) Close() error {
+	return t.ch.Close()
+}
+
+type subscription struct {
+	ch         Channel
+	deliveries <-chan amqp.Delivery
+}
+
+// OpenSubscription returns a *pubsub.Subscription that consumes the messages that are routed by the given exchange
+// with the given routing key.
+// The messages are consumed from an exclusive queue that is deleted when the subscription is closed.
+func OpenSubscription(conn Connection, exchange, routingKey string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(conn, exchange, routingKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+var (
+	errDeclareQueue = errors.Define("declare_queue", "declare AMQP queue")
+	errBindQueue    = errors.Define("bind_queue", "bind AMQP queue to exchange `{exchange}` with routing key `{routing_key}`")
+	errConsume      = errors.Define("consume", "consume from AMQP queue `{queue}`")
+)
+
+func openDriverSubscription(conn Connection, exchange, routingKey string) (ds driver.Subscription, err error) {
+	if conn == nil {
+		return nil, errNilConnection.New()
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, errOpenChannel.WithCause(err)
+	}
+	defer func() {
+		if err != nil {
+			ch.Close()
+		}
+	}()
+	queue, err := ch.QueueDeclare("", false, true, true, false, nil)
+	if err != nil {
+		return nil, errDeclareQueue.WithCause(err)
+	}
+	if err := ch.QueueBind(queue.Name, routingKey, exchange, false, nil); err != nil {
+		return nil, errBindQueue.WithAttributes(
+			"exchange", exchange,
+			"routing_key", routingKey,
+		).WithCause(err)
+	}
+	deliveries, err := ch.Consume(queue.Name, "", false, true, false, false, nil)
+	if err != nil {
+		return nil, errConsume.WithAttributes("queue", queue.Name).WithCause(err)
+	}
+	return &subscription{
+		ch:         ch,
+		deliveries: deliveries,
+	}, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+// Like the MQTT driver, we always return one message at a time.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case d, ok := <-s.deliveries:
+		if !ok {
+			return nil, errChannelClosed.New()
+		}
+		return []*driver.Message{decodeMessage(d)}, nil
+	}
+}
+
+// SendAcks implements driver.Subscription.
+func (s *subscription) SendAcks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		if err := s.ch.Ack(id.(uint64), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return true }
+
+// SendNacks implements driver.Subscription.
+// Nacked messages are requeued.
+func (s *subscription) SendNacks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		if err := s.ch.Nack(id.(uint64), false, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	c, ok := i.(*Channel)
+	if !ok {
+		return false
+	}
+	*c = s.ch
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	return s.ch.Close()
+}
+
+func errorAs(err error, i interface{}) bool {
+	p, ok := i.(**amqp.Error)
+	if !ok {
+		return false
+	}
+	amqpErr, ok := errors.Cause(err).(*amqp.Error)
+	if !ok {
+		return false
+	}
+	*p = amqpErr
+	return true
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch {
+	case err == nil:
+		return gcerrors.OK
+	case err == context.Canceled:
+		return gcerrors.Canceled
+	case err == context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	case errors.Resemble(err, errNilConnection), errors.Resemble(err, errChannelClosed):
+		return gcerrors.NotFound
+	case errors.Resemble(err, errPublishNacked):
+		return gcerrors.FailedPrecondition
+	}
+	if amqpErr, ok := errors.Cause(err).(*amqp.Error); ok {
+		switch amqpErr.Code {
+		case amqp.NotFound:
+			return gcerrors.NotFound
+		case amqp.AccessRefused:
+			return gcerrors.PermissionDenied
+		case amqp.PreconditionFailed:
+			return gcerrors.FailedPrecondition
+		case amqp.ResourceLocked:
+			return gcerrors.ResourceExhausted
+		}
+	}
+	return gcerrors.Unknown
+}
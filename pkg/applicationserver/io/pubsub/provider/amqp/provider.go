@@ -0,0 +1,194 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amqp implements the AMQP 0-9-1 provider using the amqp driver.
+package amqp
+
+import (
+	"context"
+
+	"github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+// DefaultExchange is the exchange used when no exchange is configured.
+const DefaultExchange = "amq.topic"
+
+type impl struct {
+}
+
+type amqpConnection struct {
+	*amqp.Connection
+}
+
+// Channel implements Connection.
+func (c amqpConnection) Channel() (Channel, error) {
+	ch, err := c.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+type connection struct {
+	Connection
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	return c.Close()
+}
+
+// OpenConnection implements provider.Provider using the amqp package.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (*provider.Connection, error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AMQP)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+
+	conn, err := amqp.Dial(settings.AMQP.ServerURL)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := OpenConnection(ctx, amqpConnection{conn}, settings.AMQP.GetExchange(), target)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return pc, nil
+}
+
+var errDeclareExchange = errors.Define("declare_exchange", "declare AMQP exchange `{exchange}`")
+
+// OpenConnection opens the pub/sub topics and subscriptions on the given AMQP connection.
+// If exchange is empty, DefaultExchange is used. Otherwise, a durable topic exchange is declared.
+// The routing keys are the topics of the messages, prefixed by the base topic.
+// The returned connection closes the given AMQP connection on shutdown.
+func OpenConnection(ctx context.Context, conn Connection, exchange string, topics provider.Topics) (pc *provider.Connection, err error) {
+	if exchange == "" {
+		exchange = DefaultExchange
+	} else {
+		ch, err := conn.Channel()
+		if err != nil {
+			return nil, errOpenChannel.WithCause(err)
+		}
+		err = ch.ExchangeDeclare(exchange, amqp.ExchangeTopic, true, false, false, false, nil)
+		ch.Close()
+		if err != nil {
+			return nil, errDeclareExchange.WithAttributes("exchange", exchange).WithCause(err)
+		}
+	}
+
+	pc = &provider.Connection{
+		ProviderConnection: &connection{
+			Connection: conn,
+		},
+	}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			conn,
+			exchange,
+			provider.CombineTopics(topics.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if *s.subscription, err = OpenSubscription(
+			conn,
+			exchange,
+			provider.CombineTopics(topics.GetBaseTopic(), s.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AMQP{}, impl{})
+}
@@ -0,0 +1,272 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	amqp_client "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/amqp"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+type allEnabled struct{}
+
+// Enabled implements provider.Enabler.
+func (e *allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_AMQP{
+			AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{
+				ServerURL: "amqp://invalid.local:5672",
+				Exchange:  "ttn",
+			},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		DownlinkQueueInvalidated: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.invalidated",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+		ServiceData: &ttnpb.ApplicationPubSub_Message{
+			Topic: "service.data",
+		},
+	}
+
+	impl, err := provider.GetProvider(pb)
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - invalid server.
+	{
+		conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	b := newBroker()
+
+	// Valid attributes - connection established.
+	{
+		conn, err := amqp.OpenConnection(ctx, b, pb.GetAMQP().GetExchange(), pb)
+		a.So(conn, should.NotBeNil)
+		a.So(err, should.BeNil)
+		defer conn.Shutdown(ctx)
+
+		client, err := b.Channel()
+		a.So(err, should.BeNil)
+		defer client.Close()
+
+		t.Run("Downstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name          string
+				routingKey    string
+				subscription  *pubsub.Subscription
+				expectMessage bool
+			}{
+				{
+					name:          "ValidPush",
+					routingKey:    "app1.ps1.downlink.push",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: true,
+				},
+				{
+					name:          "ValidReplace",
+					routingKey:    "app1.ps1.downlink.replace",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: true,
+				},
+				{
+					name:          "InvalidPush",
+					routingKey:    "foo.bar",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: false,
+				},
+				{
+					name:          "InvalidReplace",
+					routingKey:    "bar.foo",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: false,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					err := client.Publish("ttn", tc.routingKey, false, false, amqp_client.Publishing{
+						Headers: amqp_client.Table{
+							"foo": "bar",
+						},
+						Body: []byte("foobar"),
+					})
+					a.So(err, should.BeNil)
+
+					ctx, cancel := context.WithTimeout(ctx, timeout)
+					defer cancel()
+
+					msg, err := tc.subscription.Receive(ctx)
+					if tc.expectMessage {
+						a.So(err, should.BeNil)
+						if a.So(msg, should.NotBeNil) {
+							a.So(msg.Body, should.Resemble, []byte("foobar"))
+							a.So(msg.Metadata, should.Resemble, map[string]string{
+								"foo": "bar",
+							})
+						}
+					} else if err == nil {
+						t.Fatal("Unexpected message received")
+					}
+					if msg != nil {
+						msg.Ack()
+					}
+				})
+			}
+		})
+		t.Run("Upstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name       string
+				routingKey string
+				topic      *pubsub.Topic
+			}{
+				{
+					name:       "ValidUplink",
+					routingKey: "app1.ps1.uplink.message",
+					topic:      conn.Topics.UplinkMessage,
+				},
+				{
+					name:       "ValidJoinAccept",
+					routingKey: "app1.ps1.join.accept",
+					topic:      conn.Topics.JoinAccept,
+				},
+				{
+					name:       "ValidDownlinkAck",
+					routingKey: "app1.ps1.downlink.ack",
+					topic:      conn.Topics.DownlinkAck,
+				},
+				{
+					name:       "ValidDownlinkNack",
+					routingKey: "app1.ps1.downlink.nack",
+					topic:      conn.Topics.DownlinkNack,
+				},
+				{
+					name:       "ValidDownlinkSent",
+					routingKey: "app1.ps1.downlink.sent",
+					topic:      conn.Topics.DownlinkSent,
+				},
+				{
+					name:       "ValidDownlinkFailed",
+					routingKey: "app1.ps1.downlink.failed",
+					topic:      conn.Topics.DownlinkFailed,
+				},
+				{
+					name:       "ValidDownlinkQueued",
+					routingKey: "app1.ps1.downlink.queued",
+					topic:      conn.Topics.DownlinkQueued,
+				},
+				{
+					name:       "ValidDownlinkQueueInvalidated",
+					routingKey: "app1.ps1.downlink.invalidated",
+					topic:      conn.Topics.DownlinkQueueInvalidated,
+				},
+				{
+					name:       "ValidLocationSolved",
+					routingKey: "app1.ps1.location.solved",
+					topic:      conn.Topics.LocationSolved,
+				},
+				{
+					name:       "ValidServiceData",
+					routingKey: "app1.ps1.service.data",
+					topic:      conn.Topics.ServiceData,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					queue, err := client.QueueDeclare("", false, true, true, false, nil)
+					a.So(err, should.BeNil)
+					err = client.QueueBind(queue.Name, tc.routingKey, "ttn", false, nil)
+					a.So(err, should.BeNil)
+					deliveries, err := client.Consume(queue.Name, "", true, true, false, false, nil)
+					a.So(err, should.BeNil)
+
+					ctx, cancel := context.WithTimeout(ctx, timeout)
+					defer cancel()
+
+					err = tc.topic.Send(ctx, &pubsub.Message{
+						Body: []byte("foobar"),
+						Metadata: map[string]string{
+							"foo": "bar",
+						},
+					})
+					a.So(err, should.BeNil)
+
+					select {
+					case <-time.After(timeout):
+						t.Fatal("Expected message never arrived")
+					case d := <-deliveries:
+						a.So(d.Body, should.Resemble, []byte("foobar"))
+						a.So(d.Headers, should.Resemble, amqp_client.Table{
+							"foo": "bar",
+						})
+					}
+				})
+			}
+		})
+	}
+}
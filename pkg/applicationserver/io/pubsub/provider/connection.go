@@ -79,6 +79,13 @@ type ProviderConnection interface {
 	Shutdowner
 }
 
+// EndDeviceKeyer is a ProviderConnection that keys the upstream messages by end device.
+// The end device ID of each upstream message is passed to the topics in the message metadata.
+type EndDeviceKeyer interface {
+	// EndDeviceKeyMetadata returns the metadata entry name that contains the end device ID.
+	EndDeviceKeyMetadata() string
+}
+
 // Connection is a wrapper that wraps the topics and subscriptions with a ProviderConnection.
 type Connection struct {
 	Topics             UplinkTopics
@@ -0,0 +1,214 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka"
+)
+
+// broker is an in-memory Kafka broker with single partition topics.
+type broker struct {
+	mu       sync.Mutex
+	topics   map[string]chan *sarama.ConsumerMessage
+	offset   int64
+	produced chan *sarama.ProducerMessage
+	marked   chan *sarama.ConsumerMessage
+	groups   []string
+}
+
+func newBroker() *broker {
+	return &broker{
+		topics:   make(map[string]chan *sarama.ConsumerMessage),
+		produced: make(chan *sarama.ProducerMessage, 16),
+		marked:   make(chan *sarama.ConsumerMessage, 16),
+	}
+}
+
+// topic returns the message channel of the topic with the given name.
+func (b *broker) topic(name string) chan *sarama.ConsumerMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.topics[name]
+	if !ok {
+		ch = make(chan *sarama.ConsumerMessage, 16)
+		b.topics[name] = ch
+	}
+	return ch
+}
+
+// SyncProducer implements kafka.Client.
+func (b *broker) SyncProducer() (sarama.SyncProducer, error) {
+	return &producer{broker: b}, nil
+}
+
+// ConsumerGroup implements kafka.Client.
+func (b *broker) ConsumerGroup(groupID string) (sarama.ConsumerGroup, error) {
+	b.mu.Lock()
+	b.groups = append(b.groups, groupID)
+	b.mu.Unlock()
+	return &consumerGroup{broker: b}, nil
+}
+
+// Close implements kafka.Client.
+func (b *broker) Close() error {
+	return nil
+}
+
+var _ kafka.Client = (*broker)(nil)
+
+type producer struct {
+	broker *broker
+}
+
+// SendMessage implements sarama.SyncProducer.
+func (p *producer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.broker.mu.Lock()
+	p.broker.offset++
+	offset := p.broker.offset
+	p.broker.mu.Unlock()
+	p.broker.produced <- msg
+	return 0, offset, nil
+}
+
+// SendMessages implements sarama.SyncProducer.
+func (p *producer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		if _, _, err := p.SendMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements sarama.SyncProducer.
+func (p *producer) Close() error {
+	return nil
+}
+
+type consumerGroup struct {
+	broker *broker
+}
+
+// Consume implements sarama.ConsumerGroup.
+// The session claims the single partition of each topic.
+func (g *consumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	s := &session{
+		ctx:    ctx,
+		broker: g.broker,
+		topics: topics,
+	}
+	if err := handler.Setup(s); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, len(topics))
+	for _, topic := range topics {
+		wg.Add(1)
+		go func(topic string) {
+			defer wg.Done()
+			errs <- handler.ConsumeClaim(s, &claim{
+				topic:    topic,
+				messages: g.broker.topic(topic),
+			})
+		}(topic)
+	}
+	wg.Wait()
+	close(errs)
+	var err error
+	for claimErr := range errs {
+		if err == nil {
+			err = claimErr
+		}
+	}
+	if cleanupErr := handler.Cleanup(s); err == nil {
+		err = cleanupErr
+	}
+	if err == nil {
+		<-ctx.Done()
+	}
+	return err
+}
+
+// Errors implements sarama.ConsumerGroup.
+func (g *consumerGroup) Errors() <-chan error {
+	return nil
+}
+
+// Close implements sarama.ConsumerGroup.
+func (g *consumerGroup) Close() error {
+	return nil
+}
+
+type session struct {
+	ctx    context.Context
+	broker *broker
+	topics []string
+}
+
+// Claims implements sarama.ConsumerGroupSession.
+func (s *session) Claims() map[string][]int32 {
+	claims := make(map[string][]int32, len(s.topics))
+	for _, topic := range s.topics {
+		claims[topic] = []int32{0}
+	}
+	return claims
+}
+
+// MemberID implements sarama.ConsumerGroupSession.
+func (s *session) MemberID() string { return "member" }
+
+// GenerationID implements sarama.ConsumerGroupSession.
+func (s *session) GenerationID() int32 { return 1 }
+
+// MarkOffset implements sarama.ConsumerGroupSession.
+func (s *session) MarkOffset(string, int32, int64, string) {}
+
+// Commit implements sarama.ConsumerGroupSession.
+func (s *session) Commit() {}
+
+// ResetOffset implements sarama.ConsumerGroupSession.
+func (s *session) ResetOffset(string, int32, int64, string) {}
+
+// MarkMessage implements sarama.ConsumerGroupSession.
+func (s *session) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.broker.marked <- msg
+}
+
+// Context implements sarama.ConsumerGroupSession.
+func (s *session) Context() context.Context { return s.ctx }
+
+type claim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+}
+
+// Topic implements sarama.ConsumerGroupClaim.
+func (c *claim) Topic() string { return c.topic }
+
+// Partition implements sarama.ConsumerGroupClaim.
+func (c *claim) Partition() int32 { return 0 }
+
+// InitialOffset implements sarama.ConsumerGroupClaim.
+func (c *claim) InitialOffset() int64 { return sarama.OffsetNewest }
+
+// HighWaterMarkOffset implements sarama.ConsumerGroupClaim.
+func (c *claim) HighWaterMarkOffset() int64 { return 0 }
+
+// Messages implements sarama.ConsumerGroupClaim.
+func (c *claim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
@@ -0,0 +1,403 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// KeyMetadata is the message metadata entry that contains the Kafka message key.
+// The other metadata entries are passed as Kafka record headers.
+const KeyMetadata = "kafka_key"
+
+// Client is a Kafka client.
+type Client interface {
+	// SyncProducer returns a new producer that produces messages synchronously.
+	SyncProducer() (sarama.SyncProducer, error)
+	// ConsumerGroup returns a new consumer group with the given group ID.
+	ConsumerGroup(groupID string) (sarama.ConsumerGroup, error)
+	// Close closes the client.
+	Close() error
+}
+
+var (
+	errNilProducer        = errors.DefineInvalidArgument("nil_producer", "producer is nil")
+	errNilClient          = errors.DefineInvalidArgument("nil_client", "client is nil")
+	errProduce            = errors.Define("produce", "produce to Kafka topic `{topic}`")
+	errConsumerGroup      = errors.Define("consumer_group", "create Kafka consumer group `{group}`")
+	errConsume            = errors.Define("consume", "consume from Kafka topics `{topics}`")
+	errDuplicateTopic     = errors.DefineInvalidArgument("duplicate_topic", "duplicate Kafka topic `{topic}`")
+	errSubscriptionClosed = errors.DefineUnavailable("subscription_closed", "Kafka subscription closed")
+)
+
+type topic struct {
+	producer sarama.SyncProducer
+	name     string
+}
+
+// OpenTopic returns a *pubsub.Topic that produces messages to the given Kafka topic.
+// The message key is taken from the KeyMetadata entry of the message metadata, if present.
+// The producer is not closed when the topic is shut down.
+func OpenTopic(producer sarama.SyncProducer, name string) (*pubsub.Topic, error) {
+	if producer == nil {
+		return nil, errNilProducer.New()
+	}
+	return pubsub.NewTopic(&topic{
+		producer: producer,
+		name:     name,
+	}, nil), nil
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pms := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		pm := encodeMessage(t.name, msg)
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**sarama.ProducerMessage)
+				if !ok {
+					return false
+				}
+				*p = pm
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		pms = append(pms, pm)
+	}
+	if err := t.producer.SendMessages(pms); err != nil {
+		return errProduce.WithAttributes("topic", t.name).WithCause(err)
+	}
+	return nil
+}
+
+func encodeMessage(topic string, msg *driver.Message) *sarama.ProducerMessage {
+	pm := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(msg.Body),
+	}
+	for k, v := range msg.Metadata {
+		if k == KeyMetadata {
+			pm.Key = sarama.StringEncoder(v)
+			continue
+		}
+		pm.Headers = append(pm.Headers, sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(v),
+		})
+	}
+	return pm
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(*sarama.SyncProducer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error {
+	return nil
+}
+
+type ackID struct {
+	session sarama.ConsumerGroupSession
+	message *sarama.ConsumerMessage
+}
+
+// consumer consumes the topics of multiple subscriptions as a single member of a consumer group.
+type consumer struct {
+	group         sarama.ConsumerGroup
+	subscriptions map[string]*subscription
+	cancel        context.CancelFunc
+	done          chan struct{}
+	err           error
+
+	mu   sync.Mutex
+	open int
+}
+
+type subscription struct {
+	consumer *consumer
+	messages chan *driver.Message
+	closed   chan struct{}
+	once     sync.Once
+}
+
+// OpenSubscriptions returns a *pubsub.Subscription for each of the given Kafka topics.
+// The topics are consumed by a single member of the given consumer group, which is shared by the subscriptions.
+// Consumed messages are marked when they are acknowledged, and their offsets are committed periodically.
+// The consumer group is closed when all subscriptions are closed.
+func OpenSubscriptions(client Client, groupID string, names ...string) ([]*pubsub.Subscription, error) {
+	dss, err := openDriverSubscriptions(client, groupID, names...)
+	if err != nil {
+		return nil, err
+	}
+	subs := make([]*pubsub.Subscription, 0, len(dss))
+	for _, ds := range dss {
+		subs = append(subs, pubsub.NewSubscription(ds, nil, nil))
+	}
+	return subs, nil
+}
+
+func openDriverSubscriptions(client Client, groupID string, names ...string) ([]driver.Subscription, error) {
+	if client == nil {
+		return nil, errNilClient.New()
+	}
+	c := &consumer{
+		subscriptions: make(map[string]*subscription, len(names)),
+		done:          make(chan struct{}),
+		open:          len(names),
+	}
+	dss := make([]driver.Subscription, 0, len(names))
+	for _, name := range names {
+		if _, ok := c.subscriptions[name]; ok {
+			return nil, errDuplicateTopic.WithAttributes("topic", name)
+		}
+		s := &subscription{
+			consumer: c,
+			messages: make(chan *driver.Message),
+			closed:   make(chan struct{}),
+		}
+		c.subscriptions[name] = s
+		dss = append(dss, s)
+	}
+	if len(names) == 0 {
+		return dss, nil
+	}
+	group, err := client.ConsumerGroup(groupID)
+	if err != nil {
+		return nil, errConsumerGroup.WithAttributes("group", groupID).WithCause(err)
+	}
+	c.group = group
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go c.consume(ctx, names)
+	return dss, nil
+}
+
+// consume joins the consumer group and consumes the claimed partitions of the topics.
+// The consumer group session ends when the group rebalances, after which the group is joined again.
+func (c *consumer) consume(ctx context.Context, names []string) {
+	defer close(c.done)
+	for {
+		if err := c.group.Consume(ctx, names, c); err != nil {
+			c.err = errConsume.WithAttributes("topics", strings.Join(names, ", ")).WithCause(err)
+			return
+		}
+		if err := ctx.Err(); err != nil {
+			c.err = err
+			return
+		}
+	}
+}
+
+// close releases a subscription of the consumer. The consumer group is closed with the last subscription.
+func (c *consumer) close() error {
+	c.mu.Lock()
+	c.open--
+	last := c.open == 0
+	c.mu.Unlock()
+	if !last {
+		return nil
+	}
+	c.cancel()
+	<-c.done
+	return c.group.Close()
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (*consumer) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (*consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+// The messages are passed to the subscription of the claimed topic. Messages of closed subscriptions are not
+// marked, such that they are consumed again when the subscription is opened again.
+func (c *consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	s, ok := c.subscriptions[claim.Topic()]
+	if !ok {
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.closed:
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-s.closed:
+				return nil
+			case s.messages <- decodeMessage(session, msg):
+			}
+		}
+	}
+}
+
+// ReceiveBatch implements driver.Subscription.
+// Like the MQTT driver, we always return one message at a time.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg := <-s.messages:
+		return []*driver.Message{msg}, nil
+	case <-s.closed:
+		return nil, errSubscriptionClosed.New()
+	case <-s.consumer.done:
+		if err := s.consumer.err; err != nil && err != context.Canceled {
+			return nil, err
+		}
+		return nil, errSubscriptionClosed.New()
+	}
+}
+
+// SendAcks implements driver.Subscription.
+func (*subscription) SendAcks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		id := id.(ackID)
+		id.session.MarkMessage(id.message, "")
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+// Kafka does not support negative acknowledgements.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error {
+	panic("unreachable")
+}
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	p, ok := i.(*sarama.ConsumerGroup)
+	if !ok {
+		return false
+	}
+	*p = s.consumer.group
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() (err error) {
+	s.once.Do(func() {
+		close(s.closed)
+		err = s.consumer.close()
+	})
+	return err
+}
+
+func errorAs(err error, i interface{}) bool {
+	switch p := i.(type) {
+	case *sarama.KError:
+		kErr, ok := errors.Cause(err).(sarama.KError)
+		if !ok {
+			return false
+		}
+		*p = kErr
+		return true
+	case *sarama.ProducerErrors:
+		pErrs, ok := errors.Cause(err).(sarama.ProducerErrors)
+		if !ok {
+			return false
+		}
+		*p = pErrs
+		return true
+	default:
+		return false
+	}
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch {
+	case err == nil:
+		return gcerrors.OK
+	case err == context.Canceled:
+		return gcerrors.Canceled
+	case err == context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	case errors.Resemble(err, errNilProducer), errors.Resemble(err, errNilClient),
+		errors.Resemble(err, errSubscriptionClosed):
+		return gcerrors.NotFound
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrOutOfBrokers, sarama.ErrClosedClient, sarama.ErrNotConnected:
+		return gcerrors.NotFound
+	case sarama.ErrUnknownTopicOrPartition:
+		return gcerrors.NotFound
+	case sarama.ErrTopicAuthorizationFailed, sarama.ErrGroupAuthorizationFailed, sarama.ErrClusterAuthorizationFailed:
+		return gcerrors.PermissionDenied
+	case sarama.ErrMessageSizeTooLarge:
+		return gcerrors.ResourceExhausted
+	}
+	return gcerrors.Unknown
+}
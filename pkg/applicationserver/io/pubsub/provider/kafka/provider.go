@@ -0,0 +1,217 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the sarama client.
+package kafka
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+type impl struct {
+}
+
+type saramaClient struct {
+	sarama.Client
+}
+
+// SyncProducer implements Client.
+func (c saramaClient) SyncProducer() (sarama.SyncProducer, error) {
+	return sarama.NewSyncProducerFromClient(c.Client)
+}
+
+// ConsumerGroup implements Client.
+func (c saramaClient) ConsumerGroup(groupID string) (sarama.ConsumerGroup, error) {
+	return sarama.NewConsumerGroupFromClient(groupID, c.Client)
+}
+
+type connection struct {
+	Client
+	producer sarama.SyncProducer
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	var err error
+	if c.producer != nil {
+		err = c.producer.Close()
+	}
+	if closeErr := c.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// EndDeviceKeyMetadata implements provider.EndDeviceKeyer.
+func (*connection) EndDeviceKeyMetadata() string {
+	return KeyMetadata
+}
+
+// newConfig returns the client configuration.
+// The producer is idempotent and waits for all in-sync replicas to acknowledge the messages, such that the
+// messages of each end device are produced exactly once and in order.
+func newConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.ClientID = "ttn-lw-application-server"
+	config.Version = sarama.V1_0_0_0
+	config.Net.MaxOpenRequests = 1
+	config.Producer.Idempotent = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	return config
+}
+
+// OpenConnection implements provider.Provider using the sarama client.
+func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (*provider.Connection, error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+
+	client, err := sarama.NewClient(settings.Kafka.Brokers, newConfig())
+	if err != nil {
+		return nil, err
+	}
+	pc, err := OpenConnection(ctx, saramaClient{client}, settings.Kafka.ConsumerGroup, target)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return pc, nil
+}
+
+var errProducer = errors.Define("producer", "create Kafka producer")
+
+// OpenConnection opens the pub/sub topics and subscriptions using the given Kafka client.
+// The topic names are the topics of the messages, prefixed by the base topic.
+// The downlink subscriptions are consumed by a single member of the given consumer group.
+// The returned connection closes the given client on shutdown.
+func OpenConnection(ctx context.Context, client Client, groupID string, topics provider.Topics) (pc *provider.Connection, err error) {
+	producer, err := client.SyncProducer()
+	if err != nil {
+		return nil, errProducer.WithCause(err)
+	}
+	pc = &provider.Connection{
+		ProviderConnection: &connection{
+			Client:   client,
+			producer: producer,
+		},
+	}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &pc.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			producer,
+			provider.CombineTopics(topics.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		subscriptions []**pubsub.Subscription
+		names         []string
+	)
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		subscriptions = append(subscriptions, s.subscription)
+		names = append(names, provider.CombineTopics(topics.GetBaseTopic(), s.message.GetTopic()))
+	}
+	subs, err := OpenSubscriptions(client, groupID, names...)
+	if err != nil {
+		return nil, err
+	}
+	for i, sub := range subs {
+		*subscriptions[i] = sub
+	}
+	return pc, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
@@ -0,0 +1,289 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+type allEnabled struct{}
+
+// Enabled implements provider.Enabler.
+func (e *allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:       []string{"invalid.local:9092"},
+				ConsumerGroup: "ttn",
+			},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		DownlinkQueueInvalidated: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.invalidated",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+		ServiceData: &ttnpb.ApplicationPubSub_Message{
+			Topic: "service.data",
+		},
+	}
+
+	impl, err := provider.GetProvider(pb)
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - invalid brokers.
+	{
+		conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	b := newBroker()
+
+	// Valid attributes - connection established.
+	{
+		conn, err := kafka.OpenConnection(ctx, b, pb.GetKafka().GetConsumerGroup(), pb)
+		a.So(conn, should.NotBeNil)
+		a.So(err, should.BeNil)
+		defer conn.Shutdown(ctx)
+
+		// The push and replace subscriptions share a single consumer group.
+		a.So(b.groups, should.Resemble, []string{"ttn"})
+
+		keyer, ok := conn.ProviderConnection.(provider.EndDeviceKeyer)
+		if a.So(ok, should.BeTrue) {
+			a.So(keyer.EndDeviceKeyMetadata(), should.Equal, kafka.KeyMetadata)
+		}
+
+		t.Run("Downstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name          string
+				topic         string
+				subscription  *pubsub.Subscription
+				expectMessage bool
+			}{
+				{
+					name:          "ValidPush",
+					topic:         "app1.ps1.downlink.push",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: true,
+				},
+				{
+					name:          "ValidReplace",
+					topic:         "app1.ps1.downlink.replace",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: true,
+				},
+				{
+					name:          "InvalidPush",
+					topic:         "foo.bar",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: false,
+				},
+				{
+					name:          "InvalidReplace",
+					topic:         "bar.foo",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: false,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					sent := &sarama.ConsumerMessage{
+						Topic: tc.topic,
+						Key:   []byte("dev1"),
+						Value: []byte("foobar"),
+						Headers: []*sarama.RecordHeader{
+							{
+								Key:   []byte("foo"),
+								Value: []byte("bar"),
+							},
+						},
+					}
+					b.topic(tc.topic) <- sent
+
+					ctx, cancel := context.WithTimeout(ctx, timeout)
+					defer cancel()
+
+					msg, err := tc.subscription.Receive(ctx)
+					if !tc.expectMessage {
+						if err == nil {
+							t.Fatal("Unexpected message received")
+						}
+						return
+					}
+					a.So(err, should.BeNil)
+					if !a.So(msg, should.NotBeNil) {
+						t.FailNow()
+					}
+					a.So(msg.Body, should.Resemble, []byte("foobar"))
+					a.So(msg.Metadata, should.Resemble, map[string]string{
+						"foo":             "bar",
+						kafka.KeyMetadata: "dev1",
+					})
+					msg.Ack()
+
+					select {
+					case <-time.After(timeout):
+						t.Fatal("Expected message to be marked")
+					case marked := <-b.marked:
+						a.So(marked, should.Equal, sent)
+					}
+				})
+			}
+		})
+		t.Run("Upstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name        string
+				topic       string
+				pubsubTopic *pubsub.Topic
+			}{
+				{
+					name:        "ValidUplink",
+					topic:       "app1.ps1.uplink.message",
+					pubsubTopic: conn.Topics.UplinkMessage,
+				},
+				{
+					name:        "ValidJoinAccept",
+					topic:       "app1.ps1.join.accept",
+					pubsubTopic: conn.Topics.JoinAccept,
+				},
+				{
+					name:        "ValidDownlinkAck",
+					topic:       "app1.ps1.downlink.ack",
+					pubsubTopic: conn.Topics.DownlinkAck,
+				},
+				{
+					name:        "ValidDownlinkNack",
+					topic:       "app1.ps1.downlink.nack",
+					pubsubTopic: conn.Topics.DownlinkNack,
+				},
+				{
+					name:        "ValidDownlinkSent",
+					topic:       "app1.ps1.downlink.sent",
+					pubsubTopic: conn.Topics.DownlinkSent,
+				},
+				{
+					name:        "ValidDownlinkFailed",
+					topic:       "app1.ps1.downlink.failed",
+					pubsubTopic: conn.Topics.DownlinkFailed,
+				},
+				{
+					name:        "ValidDownlinkQueued",
+					topic:       "app1.ps1.downlink.queued",
+					pubsubTopic: conn.Topics.DownlinkQueued,
+				},
+				{
+					name:        "ValidDownlinkQueueInvalidated",
+					topic:       "app1.ps1.downlink.invalidated",
+					pubsubTopic: conn.Topics.DownlinkQueueInvalidated,
+				},
+				{
+					name:        "ValidLocationSolved",
+					topic:       "app1.ps1.location.solved",
+					pubsubTopic: conn.Topics.LocationSolved,
+				},
+				{
+					name:        "ValidServiceData",
+					topic:       "app1.ps1.service.data",
+					pubsubTopic: conn.Topics.ServiceData,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(ctx, timeout)
+					defer cancel()
+
+					err := tc.pubsubTopic.Send(ctx, &pubsub.Message{
+						Body: []byte("foobar"),
+						Metadata: map[string]string{
+							"foo":             "bar",
+							kafka.KeyMetadata: "dev1",
+						},
+					})
+					a.So(err, should.BeNil)
+
+					select {
+					case <-time.After(timeout):
+						t.Fatal("Expected message never arrived")
+					case msg := <-b.produced:
+						a.So(msg.Topic, should.Equal, tc.topic)
+						a.So(msg.Key, should.Equal, sarama.StringEncoder("dev1"))
+						a.So(msg.Value, should.Resemble, sarama.ByteEncoder("foobar"))
+						a.So(msg.Headers, should.Resemble, []sarama.RecordHeader{
+							{
+								Key:   []byte("foo"),
+								Value: []byte("bar"),
+							},
+						})
+					}
+				})
+			}
+		})
+	}
+}
@@ -182,6 +182,8 @@ func init() {
 	for _, p := range []ttnpb.ApplicationPubSub_Provider{
 		&ttnpb.ApplicationPubSub_NATS{},
 		&ttnpb.ApplicationPubSub_MQTT{},
+		&ttnpb.ApplicationPubSub_AMQP{},
+		&ttnpb.ApplicationPubSub_Kafka{},
 	} {
 		provider.RegisterProvider(p, impl)
 	}
@@ -107,7 +107,7 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler
 		}
 		if *t.topic, err = natspubsub.OpenTopic(
 			conn,
-			combineSubjects(target.GetBaseTopic(), t.message.GetTopic()),
+			provider.CombineTopics(target.GetBaseTopic(), t.message.GetTopic()),
 			&natspubsub.TopicOptions{},
 		); err != nil {
 			conn.Close()
@@ -132,7 +132,7 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler
 		}
 		if *s.subscription, err = natspubsub.OpenSubscription(
 			conn,
-			combineSubjects(target.GetBaseTopic(), s.message.GetTopic()),
+			provider.CombineTopics(target.GetBaseTopic(), s.message.GetTopic()),
 			&natspubsub.SubscriptionOptions{},
 		); err != nil {
 			conn.Close()
@@ -1,4 +1,4 @@
-// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,14 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package nats
+package provider
 
 import (
 	"fmt"
 	"strings"
 )
 
-func combineSubjects(s1, s2 string) string {
+// CombineTopics combines the dot separated topics s1 and s2.
+// Leading and trailing dots of both topics are ignored.
+func CombineTopics(s1, s2 string) string {
 	s1 = strings.Trim(s1, ".")
 	s2 = strings.Trim(s2, ".")
 	if s1 == "" {
@@ -1,4 +1,4 @@
-// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,69 +12,70 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package nats
+package provider_test
 
 import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
-func TestCombineSubjects(t *testing.T) {
+func TestCombineTopics(t *testing.T) {
 	a := assertions.New(t)
 
 	for _, tc := range []struct {
 		name     string
-		subject1 string
-		subject2 string
+		topic1   string
+		topic2   string
 		expected string
 	}{
 		{
-			name:     "EmptySubject1",
-			subject1: "",
-			subject2: "bar.bar2",
+			name:     "EmptyTopic1",
+			topic1:   "",
+			topic2:   "bar.bar2",
 			expected: "bar.bar2",
 		},
 		{
-			name:     "EmptySubject2",
-			subject1: "foo.foo2",
-			subject2: "",
+			name:     "EmptyTopic2",
+			topic1:   "foo.foo2",
+			topic2:   "",
 			expected: "foo.foo2",
 		},
 		{
 			name:     "BothProvided",
-			subject1: "foo.foo2",
-			subject2: "bar.bar2",
+			topic1:   "foo.foo2",
+			topic2:   "bar.bar2",
 			expected: "foo.foo2.bar.bar2",
 		},
 		{
 			name:     "NoneProvided",
-			subject1: "",
-			subject2: "",
+			topic1:   "",
+			topic2:   "",
 			expected: "",
 		},
 		{
 			name:     "Trailing1",
-			subject1: "foo.",
-			subject2: "",
+			topic1:   "foo.",
+			topic2:   "",
 			expected: "foo",
 		},
 		{
 			name:     "Trailing2",
-			subject1: "foo.",
-			subject2: ".bar",
+			topic1:   "foo.",
+			topic2:   ".bar",
 			expected: "foo.bar",
 		},
 		{
 			name:     "Trailing3",
-			subject1: ".foo.test.",
-			subject2: ".bar.",
+			topic1:   ".foo.test.",
+			topic2:   ".bar.",
 			expected: "foo.test.bar",
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			a.So(combineSubjects(tc.subject1, tc.subject2), should.Equal, tc.expected)
+			a.So(CombineTopics(tc.topic1, tc.topic2), should.Equal, tc.expected)
 		})
 	}
 }
@@ -59,6 +59,10 @@ func providerTypeFromString(ctx context.Context, s string) (reflect.Type, error)
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_MQTT{}), nil
 	case "nats":
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_NATS{}), nil
+	case "amqp":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_AMQP{}), nil
+	case "kafka":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Kafka{}), nil
 	default:
 		log.FromContext(ctx).WithField("provider", s).Warn("Unknown PubSub provider specified")
 		return nil, nil
@@ -156,10 +156,15 @@ func (i *integration) handleUp(ctx context.Context) {
 				logger.WithError(err).Warn("Failed to marshal upstream message")
 				continue
 			}
-			err = topic.Send(ctx, &pubsub.Message{
+			msg := &pubsub.Message{
 				Body: buf,
-			})
-			if err != nil {
+			}
+			if keyer, ok := i.conn.ProviderConnection.(provider.EndDeviceKeyer); ok {
+				msg.Metadata = map[string]string{
+					keyer.EndDeviceKeyMetadata(): up.ApplicationUp.EndDeviceIdentifiers.DeviceId,
+				}
+			}
+			if err := topic.Send(ctx, msg); err != nil {
 				logger.WithError(err).Warn("Failed to publish upstream message")
 				i.cancel(err)
 				return
@@ -98,3 +98,13 @@ func DeriveJSIntKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 func DeriveJSEncKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 	return deriveDeviceKey(key, 0x05, devEUI)
 }
+
+// DeriveRootWorSKey derives the relay Root Wake On Radio Session Key from the NwkSEncKey (LoRaWAN 1.1) or NwkSKey
+// (LoRaWAN 1.0.x) of the end device.
+func DeriveRootWorSKey(nwkSEncKey types.AES128Key) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = 0x01
+	block, _ := aes.NewCipher(nwkSEncKey[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
@@ -45,6 +45,9 @@ func TestKeyDerivation(t *testing.T) {
 	nwkSEncKey := DeriveNwkSEncKey(key, jn, joinEUI, dn)
 	a.So(nwkSEncKey, should.Equal, types.AES128Key{0xCE, 0x07, 0xA0, 0x09, 0xA3, 0x97, 0x0A, 0xC0, 0x51, 0x9A, 0x09, 0x9E, 0xD5, 0x3E, 0x55, 0x0B})
 
+	rootWorSKey := DeriveRootWorSKey(nwkSEncKey)
+	a.So(rootWorSKey, should.Equal, types.AES128Key{0xEE, 0x91, 0xDC, 0x1A, 0x66, 0x66, 0xC0, 0x6E, 0x82, 0x77, 0xDE, 0x6D, 0xB4, 0xDB, 0x94, 0x5F})
+
 	appSKey = DeriveLegacyAppSKey(key, jn, nid, dn)
 	a.So(appSKey, should.Equal, types.AES128Key{0x8C, 0x1E, 0x05, 0x43, 0xA2, 0x29, 0x08, 0x8D, 0xE6, 0xF8, 0x4E, 0x74, 0xBB, 0x46, 0xBD, 0x62})
 
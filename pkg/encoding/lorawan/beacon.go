@@ -0,0 +1,113 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// BeaconLayout is the layout of a Class B beacon frame.
+// The beacon frame consists of RFU1 | Time | CRC | GwSpecific | RFU2 | CRC.
+type BeaconLayout struct {
+	// RFU1 is the number of reserved bytes before the time field.
+	RFU1 int
+	// RFU2 is the number of reserved bytes after the gateway specific field.
+	RFU2 int
+}
+
+// TimeOffset returns the offset of the time field.
+func (l BeaconLayout) TimeOffset() int { return l.RFU1 }
+
+// InfoDescOffset returns the offset of the InfoDesc field of the gateway specific field.
+func (l BeaconLayout) InfoDescOffset() int { return l.RFU1 + 6 }
+
+// Length returns the length of the beacon frame.
+func (l BeaconLayout) Length() int { return l.RFU1 + 6 + 7 + l.RFU2 + 2 }
+
+// BeaconLayoutFromDataRate returns the beacon frame layout for the given beacon data rate.
+func BeaconLayoutFromDataRate(dr ttnpb.DataRate) (BeaconLayout, error) {
+	lora := dr.GetLoRa()
+	if lora == nil {
+		return BeaconLayout{}, errUnknownField.WithAttributes("lorawan_field", "beacon_data_rate")
+	}
+	switch lora.SpreadingFactor {
+	case 8:
+		return BeaconLayout{RFU1: 1, RFU2: 3}, nil
+	case 9:
+		return BeaconLayout{RFU1: 2, RFU2: 0}, nil
+	case 10:
+		return BeaconLayout{RFU1: 3, RFU2: 1}, nil
+	case 12:
+		return BeaconLayout{RFU1: 5, RFU2: 3}, nil
+	default:
+		return BeaconLayout{}, errUnknownField.WithAttributes("lorawan_field", "beacon_spreading_factor")
+	}
+}
+
+// beaconCRC computes the CRC-16 of the beacon fields, using the CCITT polynomial 0x1021 with initial value 0.
+func beaconCRC(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// appendBeaconCoordinate appends the coordinate v, scaled by max, as a 24-bit little endian signed integer.
+func appendBeaconCoordinate(dst []byte, v, max float64) []byte {
+	c := int32(v / max * (1 << 23))
+	if c > 0x7fffff {
+		c = 0x7fffff
+	} else if c < -0x800000 {
+		c = -0x800000
+	}
+	return append(dst, byte(c), byte(c>>8), byte(c>>16))
+}
+
+// AppendBeacon appends the Class B beacon frame with the given layout to dst.
+// The time is the beacon time since GPS epoch. The gateway specific field contains the GPS coordinates of the
+// antenna in loc, if any.
+func AppendBeacon(dst []byte, layout BeaconLayout, t time.Duration, loc *ttnpb.Location) []byte {
+	start := len(dst)
+	dst = append(dst, make([]byte, layout.RFU1)...)
+	dst = append(dst, make([]byte, 4)...)
+	binary.LittleEndian.PutUint32(dst[start+layout.TimeOffset():], uint32(int64(t/time.Second)&math.MaxUint32))
+	crc := beaconCRC(dst[start:])
+	dst = append(dst, byte(crc), byte(crc>>8))
+
+	gwSpecific := len(dst)
+	// InfoDesc 0 indicates the GPS coordinates of the gateway's first antenna.
+	dst = append(dst, 0)
+	var lat, lng float64
+	if loc != nil {
+		lat, lng = loc.Latitude, loc.Longitude
+	}
+	dst = appendBeaconCoordinate(dst, lat, 90)
+	dst = appendBeaconCoordinate(dst, lng, 180)
+	dst = append(dst, make([]byte, layout.RFU2)...)
+	crc = beaconCRC(dst[gwSpecific:])
+	return append(dst, byte(crc), byte(crc>>8))
+}
@@ -0,0 +1,98 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBeaconLayoutFromDataRate(t *testing.T) {
+	for _, tc := range []struct {
+		SpreadingFactor uint32
+		Layout          BeaconLayout
+		Length          int
+		ErrorAssertion  func(error) bool
+	}{
+		{
+			SpreadingFactor: 8,
+			Layout:          BeaconLayout{RFU1: 1, RFU2: 3},
+			Length:          19,
+		},
+		{
+			SpreadingFactor: 9,
+			Layout:          BeaconLayout{RFU1: 2, RFU2: 0},
+			Length:          17,
+		},
+		{
+			SpreadingFactor: 10,
+			Layout:          BeaconLayout{RFU1: 3, RFU2: 1},
+			Length:          19,
+		},
+		{
+			SpreadingFactor: 12,
+			Layout:          BeaconLayout{RFU1: 5, RFU2: 3},
+			Length:          23,
+		},
+		{
+			SpreadingFactor: 7,
+			ErrorAssertion:  func(err error) bool { return err != nil },
+		},
+	} {
+		layout, err := BeaconLayoutFromDataRate(ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: tc.SpreadingFactor,
+					Bandwidth:       125000,
+				},
+			},
+		})
+		a := assertions.New(t)
+		if tc.ErrorAssertion != nil {
+			a.So(tc.ErrorAssertion(err), should.BeTrue)
+			continue
+		}
+		a.So(err, should.BeNil)
+		a.So(layout, should.Resemble, tc.Layout)
+		a.So(layout.Length(), should.Equal, tc.Length)
+	}
+}
+
+func TestAppendBeacon(t *testing.T) {
+	a := assertions.New(t)
+
+	b := AppendBeacon(nil, BeaconLayout{RFU1: 2}, 1234567936*time.Second, &ttnpb.Location{
+		Latitude:  52.3,
+		Longitude: 4.9,
+	})
+	a.So(b, should.Resemble, []byte{
+		0x00, 0x00, // RFU1
+		0x00, 0x03, 0x96, 0x49, // Time
+		0xf0, 0x32, // CRC
+		0x00,             // InfoDesc
+		0xd9, 0x61, 0x4a, // Latitude
+		0x04, 0x7c, 0x03, // Longitude
+		0x7b, 0xf8, // CRC
+	})
+
+	b = AppendBeacon(nil, BeaconLayout{RFU1: 5, RFU2: 3}, 1234567936*time.Second, nil)
+	a.So(len(b), should.Equal, 23)
+	a.So(b[5:9], should.Resemble, []byte{0x00, 0x03, 0x96, 0x49})
+}
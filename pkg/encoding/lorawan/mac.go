@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/byteutil"
 )
 
@@ -38,6 +39,9 @@ type MACCommandDescriptor struct {
 	UplinkLength uint16
 	// DownlinkLength is length of downlink payload.
 	DownlinkLength uint16
+	// VariableDownlinkLength indicates that the downlink payload extends to the end of the frame.
+	// DownlinkLength is then the maximum length of the downlink payload.
+	VariableDownlinkLength bool
 	// AppendUplink appends uplink payload of cmd to b.
 	AppendUplink func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error)
 	// UnmarshalUplink unmarshals uplink payload b into cmd.
@@ -904,6 +908,385 @@ var DefaultMACCommands = MACCommandSpec{
 			return nil
 		}),
 	},
+
+	ttnpb.CID_RELAY_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfAns()
+			var v byte
+			if pld.SecondChannelFrequencyAck {
+				v |= 1
+			}
+			if pld.SecondChannelAckOffsetAck {
+				v |= 1 << 1
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= 1 << 2
+			}
+			if pld.SecondChannelIndexAck {
+				v |= 1 << 3
+			}
+			if pld.DefaultChannelIndexAck {
+				v |= 1 << 4
+			}
+			if pld.CadPeriodicityAck {
+				v |= 1 << 5
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayConfAns_{
+				RelayConfAns: &ttnpb.MACCommand_RelayConfAns{
+					SecondChannelFrequencyAck:     b[0]&1 == 1,
+					SecondChannelAckOffsetAck:     (b[0]>>1)&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>2)&1 == 1,
+					SecondChannelIndexAck:         (b[0]>>3)&1 == 1,
+					DefaultChannelIndexAck:        (b[0]>>4)&1 == 1,
+					CadPeriodicityAck:             (b[0]>>5)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 5,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfReq()
+			if pld.CadPeriodicity > 5 {
+				return nil, errExpectedLowerOrEqual("CADPeriodicity", 5)(pld.CadPeriodicity)
+			}
+			if pld.DefaultChannelIndex > 1 {
+				return nil, errExpectedLowerOrEqual("DefaultChannelIndex", 1)(pld.DefaultChannelIndex)
+			}
+			v := uint16(boolToByte(pld.Enabled))<<13 | uint16(pld.CadPeriodicity)<<10 | uint16(pld.DefaultChannelIndex)<<8
+			v, freq, err := appendRelaySecondChannel(v, pld.SecondChannel)
+			if err != nil {
+				return nil, err
+			}
+			b = byteutil.AppendUint16(b, v, 2)
+			b = byteutil.AppendUint64(b, freq, 3)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfReq", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			v := uint16(byteutil.ParseUint32(b[0:2]))
+			cmd.Payload = &ttnpb.MACCommand_RelayConfReq_{
+				RelayConfReq: &ttnpb.MACCommand_RelayConfReq{
+					Enabled:             (v>>13)&1 == 1,
+					CadPeriodicity:      uint32((v >> 10) & 0x7),
+					DefaultChannelIndex: uint32((v >> 8) & 0x3),
+					SecondChannel:       parseRelaySecondChannel(v, b[2:5]),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_END_DEVICE_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayEndDeviceConfAns()
+			var v byte
+			if pld.SecondChannelFrequencyAck {
+				v |= 1
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= 1 << 1
+			}
+			if pld.SecondChannelIndexAck {
+				v |= 1 << 2
+			}
+			if pld.BackoffAck {
+				v |= 1 << 3
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "RelayEndDeviceConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfAns_{
+				RelayEndDeviceConfAns: &ttnpb.MACCommand_RelayEndDeviceConfAns{
+					SecondChannelFrequencyAck:     b[0]&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>1)&1 == 1,
+					SecondChannelIndexAck:         (b[0]>>2)&1 == 1,
+					BackoffAck:                    (b[0]>>3)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 6,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayEndDeviceConfReq()
+			if pld.Mode > 3 {
+				return nil, errExpectedLowerOrEqual("Mode", 3)(pld.Mode)
+			}
+			if pld.SmartEnableLevel > 3 {
+				return nil, errExpectedLowerOrEqual("SmartEnableLevel", 3)(pld.SmartEnableLevel)
+			}
+			if pld.Backoff > 63 {
+				return nil, errExpectedLowerOrEqual("Backoff", 63)(pld.Backoff)
+			}
+			v, freq, err := appendRelaySecondChannel(uint16(pld.Backoff)<<9, pld.SecondChannel)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, byte(pld.Mode)<<5|byte(pld.SmartEnableLevel))
+			b = byteutil.AppendUint16(b, v, 2)
+			b = byteutil.AppendUint64(b, freq, 3)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "RelayEndDeviceConfReq", 6, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			v := uint16(byteutil.ParseUint32(b[1:3]))
+			cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfReq_{
+				RelayEndDeviceConfReq: &ttnpb.MACCommand_RelayEndDeviceConfReq{
+					Mode:             uint32((b[0] >> 5) & 0x3),
+					SmartEnableLevel: uint32(b[0] & 0x3),
+					Backoff:          uint32((v >> 9) & 0x3f),
+					SecondChannel:    parseRelaySecondChannel(v, b[3:6]),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_FILTER_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListAns()
+			var v byte
+			if pld.FilterListActionAck {
+				v |= 1
+			}
+			if pld.FilterListLengthAck {
+				v |= 1 << 1
+			}
+			if pld.CombinedRulesAck {
+				v |= 1 << 2
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_FILTER_LIST, "RelayFilterListAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListAns_{
+				RelayFilterListAns: &ttnpb.MACCommand_RelayFilterListAns{
+					FilterListActionAck: b[0]&1 == 1,
+					FilterListLengthAck: (b[0]>>1)&1 == 1,
+					CombinedRulesAck:    (b[0]>>2)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		// The length of the JoinEUI and DevEUI prefix is variable, so the payload extends to the end of the frame.
+		DownlinkLength:         1 + RelayFilterListEUIMaxLength,
+		VariableDownlinkLength: true,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListReq()
+			if pld.Index > 15 {
+				return nil, errExpectedLowerOrEqual("Index", 15)(pld.Index)
+			}
+			if pld.Action > 2 {
+				return nil, errExpectedLowerOrEqual("Action", 2)(pld.Action)
+			}
+			if n := len(pld.RawJoinEuiDevEui); n < 1 || n > RelayFilterListEUIMaxLength {
+				return nil, errExpectedBetween("length of RawJoinEUIDevEUI", 1, RelayFilterListEUIMaxLength)(n)
+			}
+			b = append(b, byte(pld.Action)<<4|byte(pld.Index))
+			b = append(b, pld.RawJoinEuiDevEui...)
+			return b, nil
+		},
+		UnmarshalDownlink: func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			if n := len(b); n < 2 || n > 1+RelayFilterListEUIMaxLength {
+				return errExpectedLengthEncodedBound("RelayFilterListReq", 2, 1+RelayFilterListEUIMaxLength)(n)
+			}
+			cmd.CID = ttnpb.CID_RELAY_FILTER_LIST
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListReq_{
+				RelayFilterListReq: &ttnpb.MACCommand_RelayFilterListReq{
+					Index:            uint32(b[0] & 0xf),
+					Action:           uint32((b[0] >> 4) & 0x3),
+					RawJoinEuiDevEui: append([]byte(nil), b[1:]...),
+				},
+			}
+			return nil
+		},
+	},
+
+	ttnpb.CID_RELAY_UPDATE_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		AppendUplink: func(phy band.Band, b []byte, _ ttnpb.MACCommand) ([]byte, error) {
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "RelayUpdateUplinkListAns", 0, nil),
+
+		DownlinkLength: 26,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayUpdateUplinkListReq()
+			if pld.Index > 15 {
+				return nil, errExpectedLowerOrEqual("Index", 15)(pld.Index)
+			}
+			if pld.UplinkLimitBucketSize > 3 {
+				return nil, errExpectedLowerOrEqual("UplinkLimitBucketSize", 3)(pld.UplinkLimitBucketSize)
+			}
+			if pld.UplinkLimitReloadRate > 63 {
+				return nil, errExpectedLowerOrEqual("UplinkLimitReloadRate", 63)(pld.UplinkLimitReloadRate)
+			}
+			// The RootWorSKey is only unwrapped right before the command is encoded.
+			key := pld.RootWorSKey.GetKey()
+			if key == nil {
+				return nil, errMissing("RootWorSKey")
+			}
+			b = append(b, byte(pld.Index), byte(pld.UplinkLimitBucketSize)<<6|byte(pld.UplinkLimitReloadRate))
+			b = appendReverse(b, pld.DevAddr[:]...)
+			b = byteutil.AppendUint32(b, pld.WFCnt, 4)
+			b = append(b, key[:]...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "RelayUpdateUplinkListReq", 26, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			var key types.AES128Key
+			copy(key[:], b[10:26])
+			req := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				Index:                 uint32(b[0] & 0xf),
+				UplinkLimitBucketSize: uint32((b[1] >> 6) & 0x3),
+				UplinkLimitReloadRate: uint32(b[1] & 0x3f),
+				WFCnt:                 byteutil.ParseUint32(b[6:10]),
+				RootWorSKey: &ttnpb.KeyEnvelope{
+					Key: &key,
+				},
+			}
+			copyReverse(req.DevAddr[:], b[2:6])
+			cmd.Payload = &ttnpb.MACCommand_RelayUpdateUplinkListReq_{
+				RelayUpdateUplinkListReq: req,
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CTRL_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 5,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListAns()
+			b = append(b, boolToByte(pld.IndexAck))
+			b = byteutil.AppendUint32(b, pld.WFCnt, 4)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "RelayCtrlUplinkListAns", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListAns_{
+				RelayCtrlUplinkListAns: &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+					IndexAck: b[0]&1 == 1,
+					WFCnt:    byteutil.ParseUint32(b[1:5]),
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 1,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListReq()
+			if pld.Index > 15 {
+				return nil, errExpectedLowerOrEqual("Index", 15)(pld.Index)
+			}
+			if pld.Action > 1 {
+				return nil, errExpectedLowerOrEqual("Action", 1)(pld.Action)
+			}
+			b = append(b, byte(pld.Action)<<4|byte(pld.Index))
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "RelayCtrlUplinkListReq", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListReq_{
+				RelayCtrlUplinkListReq: &ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					Index:  uint32(b[0] & 0xf),
+					Action: uint32((b[0] >> 4) & 0xf),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		AppendUplink: func(phy band.Band, b []byte, _ ttnpb.MACCommand) ([]byte, error) {
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "RelayConfigureFwdLimitAns", 0, nil),
+
+		DownlinkLength: 5,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfigureFwdLimitReq()
+			if pld.ResetLimitCounter > 3 {
+				return nil, errExpectedLowerOrEqual("ResetLimitCounter", 3)(pld.ResetLimitCounter)
+			}
+			var v uint64
+			var err error
+			for i, l := range []struct {
+				name   string
+				limits *ttnpb.RelayForwardLimits
+			}{
+				{name: "Overall", limits: pld.OverallLimits},
+				{name: "GlobalUplink", limits: pld.GlobalUplinkLimits},
+				{name: "Notify", limits: pld.NotifyLimits},
+				{name: "JoinRequest", limits: pld.JoinRequestLimits},
+			} {
+				if v, err = appendRelayForwardLimits(v, i, l.name, l.limits); err != nil {
+					return nil, err
+				}
+			}
+			v |= uint64(pld.ResetLimitCounter) << 36
+			b = byteutil.AppendUint64(b, v, 5)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "RelayConfigureFwdLimitReq", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			v := byteutil.ParseUint64(b[0:5])
+			cmd.Payload = &ttnpb.MACCommand_RelayConfigureFwdLimitReq_{
+				RelayConfigureFwdLimitReq: &ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+					ResetLimitCounter:  uint32((v >> 36) & 0x3),
+					OverallLimits:      parseRelayForwardLimits(v, 0),
+					GlobalUplinkLimits: parseRelayForwardLimits(v, 1),
+					NotifyLimits:       parseRelayForwardLimits(v, 2),
+					JoinRequestLimits:  parseRelayForwardLimits(v, 3),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE: &MACCommandDescriptor{
+		InitiatedByDevice: true,
+
+		UplinkLength: 6,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayNotifyNewEndDeviceReq()
+			if pld.SNR < -20 || pld.SNR > 11 {
+				return nil, errExpectedBetween("SNR", -20, 11)(pld.SNR)
+			}
+			if pld.RSSI < -127 || pld.RSSI > 0 {
+				return nil, errExpectedBetween("RSSI", -127, 0)(pld.RSSI)
+			}
+			b = appendRelayPower(b, pld.SNR, pld.RSSI)
+			b = appendReverse(b, pld.DevAddr[:]...)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE, "RelayNotifyNewEndDeviceReq", 6, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			snr, rssi := parseRelayPower(b[0:2])
+			req := &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				SNR:  snr,
+				RSSI: rssi,
+			}
+			copyReverse(req.DevAddr[:], b[2:6])
+			cmd.Payload = &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq_{
+				RelayNotifyNewEndDeviceReq: req,
+			}
+			return nil
+		}),
+	},
 }
 
 var (
@@ -949,9 +1332,15 @@ func (spec MACCommandSpec) read(phy band.Band, r io.Reader, isUplink bool, cmd *
 		return errNoUnmarshaler.WithAttributes("cid", fmt.Sprintf("0x%X", int32(ret.CID)))
 	}
 
-	if n == 0 {
+	switch {
+	case !isUplink && desc.VariableDownlinkLength:
+		b, err = ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+	case n == 0:
 		b = nil
-	} else {
+	default:
 		b = make([]byte, n)
 		_, err = r.Read(b)
 		if err != nil {
@@ -25,6 +25,7 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -371,6 +372,159 @@ func TestLoRaWANEncodingMAC(t *testing.T) {
 			[]byte{0x20, 0x02},
 			false,
 		},
+		{
+			"RelayConfReq",
+			&ttnpb.MACCommand_RelayConfReq{
+				Enabled:             true,
+				CadPeriodicity:      2,
+				DefaultChannelIndex: 1,
+				SecondChannel: &ttnpb.RelaySecondChannel{
+					Frequency:     869100000,
+					AckOffset:     3,
+					DataRateIndex: ttnpb.DATA_RATE_5,
+				},
+			},
+			[]byte{0x40, 0xab, 0x29, 0x38, 0x9d, 0x84},
+			false,
+		},
+		{
+			"RelayConfAns",
+			&ttnpb.MACCommand_RelayConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				DefaultChannelIndexAck:        true,
+				CadPeriodicityAck:             true,
+			},
+			[]byte{0x40, 0x35},
+			true,
+		},
+		{
+			"RelayEndDeviceConfReq",
+			&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Mode:             RelayModeDynamic,
+				SmartEnableLevel: 1,
+				Backoff:          4,
+			},
+			[]byte{0x41, 0x41, 0x00, 0x08, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayEndDeviceConfAns",
+			&ttnpb.MACCommand_RelayEndDeviceConfAns{
+				SecondChannelFrequencyAck: true,
+				BackoffAck:                true,
+			},
+			[]byte{0x41, 0x09},
+			true,
+		},
+		{
+			"RelayFilterListReq",
+			&ttnpb.MACCommand_RelayFilterListReq{
+				Index:  2,
+				Action: RelayFilterForward,
+				RawJoinEuiDevEui: []byte{
+					0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+					0x18, 0x17, 0x16, 0x15, 0x14, 0x13, 0x12, 0x11,
+				},
+			},
+			[]byte{
+				0x42, 0x12,
+				0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+				0x18, 0x17, 0x16, 0x15, 0x14, 0x13, 0x12, 0x11,
+			},
+			false,
+		},
+		{
+			"RelayFilterListReq/JoinEUI prefix",
+			&ttnpb.MACCommand_RelayFilterListReq{
+				Index:            15,
+				Action:           RelayFilterDrop,
+				RawJoinEuiDevEui: []byte{0x08, 0x07, 0x06},
+			},
+			[]byte{0x42, 0x2f, 0x08, 0x07, 0x06},
+			false,
+		},
+		{
+			"RelayFilterListAns",
+			&ttnpb.MACCommand_RelayFilterListAns{
+				FilterListActionAck: true,
+				CombinedRulesAck:    true,
+			},
+			[]byte{0x42, 0x05},
+			true,
+		},
+		{
+			"RelayUpdateUplinkListReq",
+			&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				Index:                 3,
+				UplinkLimitBucketSize: 1,
+				UplinkLimitReloadRate: 5,
+				DevAddr:               types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				WFCnt:                 1,
+				RootWorSKey: &ttnpb.KeyEnvelope{
+					Key: &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				},
+			},
+			[]byte{
+				0x43, 0x03, 0x45,
+				0x04, 0x03, 0x02, 0x01,
+				0x01, 0x00, 0x00, 0x00,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+			},
+			false,
+		},
+		{
+			"RelayUpdateUplinkListAns",
+			ttnpb.CID_RELAY_UPDATE_UPLINK_LIST,
+			[]byte{0x43},
+			true,
+		},
+		{
+			"RelayCtrlUplinkListReq",
+			&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				Index:  3,
+				Action: RelayCtrlUplinkListRemove,
+			},
+			[]byte{0x44, 0x13},
+			false,
+		},
+		{
+			"RelayCtrlUplinkListAns",
+			&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+				IndexAck: true,
+				WFCnt:    0x42,
+			},
+			[]byte{0x44, 0x01, 0x42, 0x00, 0x00, 0x00},
+			true,
+		},
+		{
+			"RelayConfigureFwdLimitReq",
+			&ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+				ResetLimitCounter:  1,
+				OverallLimits:      &ttnpb.RelayForwardLimits{BucketSize: 3, ReloadRate: 1},
+				GlobalUplinkLimits: &ttnpb.RelayForwardLimits{BucketSize: 1, ReloadRate: 2},
+				NotifyLimits:       &ttnpb.RelayForwardLimits{BucketSize: 0, ReloadRate: 3},
+				JoinRequestLimits:  &ttnpb.RelayForwardLimits{BucketSize: 2, ReloadRate: 4},
+			},
+			[]byte{0x45, 0x01, 0xc1, 0x80, 0x70, 0x18},
+			false,
+		},
+		{
+			"RelayConfigureFwdLimitAns",
+			ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT,
+			[]byte{0x45},
+			true,
+		},
+		{
+			"RelayNotifyNewEndDeviceReq",
+			&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				SNR:     5,
+				RSSI:    -100,
+			},
+			[]byte{0x46, 0x99, 0x0c, 0x04, 0x03, 0x02, 0x01},
+			true,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
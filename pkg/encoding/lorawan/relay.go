@@ -0,0 +1,185 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/byteutil"
+)
+
+// RelayForwardPort is the FPort on which relays and the Network Server exchange forwarded frames.
+const RelayForwardPort = 226
+
+// relayFrequencyStep is the step of the frequencies in relay payloads in Hz.
+const relayFrequencyStep = 100
+
+// RelayUplinkListLength is the number of entries in the trusted uplink list of a relay.
+const RelayUplinkListLength = 16
+
+const (
+	// RelayModeDisabled disables the relay mode of the end device.
+	RelayModeDisabled uint32 = iota
+	// RelayModeEnabled enables the relay mode of the end device.
+	RelayModeEnabled
+	// RelayModeDynamic lets the end device use the relay when the uplinks are not acknowledged.
+	RelayModeDynamic
+	// RelayModeEndDeviceControlled lets the end device decide whether to use the relay.
+	RelayModeEndDeviceControlled
+)
+
+const (
+	// RelayFilterNoRule removes the join-request filter.
+	RelayFilterNoRule uint32 = iota
+	// RelayFilterForward forwards the matching join-requests.
+	RelayFilterForward
+	// RelayFilterDrop drops the matching join-requests.
+	RelayFilterDrop
+)
+
+const (
+	// RelayCtrlUplinkListReadWFCnt reads the wake on radio frame counter of the entry.
+	RelayCtrlUplinkListReadWFCnt uint32 = iota
+	// RelayCtrlUplinkListRemove removes the entry from the trusted uplink list.
+	RelayCtrlUplinkListRemove
+)
+
+// RelayFilterListEUIMaxLength is the maximum length of the JoinEUI and DevEUI prefix of a join-request filter.
+const RelayFilterListEUIMaxLength = 16
+
+func appendRelaySecondChannel(v uint16, ch *ttnpb.RelaySecondChannel) (uint16, uint64, error) {
+	if ch == nil {
+		return v, 0, nil
+	}
+	if ch.Frequency < 100000 || ch.Frequency > byteutil.MaxUint24*relayFrequencyStep {
+		return 0, 0, errExpectedBetween("SecondChannelFrequency", 100000, byteutil.MaxUint24*relayFrequencyStep)(ch.Frequency)
+	}
+	if ch.DataRateIndex > 15 {
+		return 0, 0, errExpectedLowerOrEqual("SecondChannelDataRateIndex", 15)(ch.DataRateIndex)
+	}
+	if ch.AckOffset > 7 {
+		return 0, 0, errExpectedLowerOrEqual("SecondChannelAckOffset", 7)(ch.AckOffset)
+	}
+	v |= 1<<7 | uint16(ch.DataRateIndex)<<3 | uint16(ch.AckOffset)
+	return v, ch.Frequency / relayFrequencyStep, nil
+}
+
+func parseRelaySecondChannel(v uint16, b []byte) *ttnpb.RelaySecondChannel {
+	if v>>7&1 == 0 {
+		return nil
+	}
+	return &ttnpb.RelaySecondChannel{
+		Frequency:     byteutil.ParseUint64(b[0:3]) * relayFrequencyStep,
+		DataRateIndex: ttnpb.DataRateIndex(v >> 3 & 0xf),
+		AckOffset:     uint32(v & 0x7),
+	}
+}
+
+func appendRelayForwardLimits(v uint64, i int, name string, l *ttnpb.RelayForwardLimits) (uint64, error) {
+	if l == nil {
+		// A reload rate of 127 disables the limit.
+		return v | 0x7f<<(7*i), nil
+	}
+	if l.BucketSize > 3 {
+		return 0, errExpectedLowerOrEqual(name+"BucketSize", 3)(l.BucketSize)
+	}
+	if l.ReloadRate > 127 {
+		return 0, errExpectedLowerOrEqual(name+"ReloadRate", 127)(l.ReloadRate)
+	}
+	return v | uint64(l.ReloadRate)<<(7*i) | uint64(l.BucketSize)<<(28+2*i), nil
+}
+
+func parseRelayForwardLimits(v uint64, i int) *ttnpb.RelayForwardLimits {
+	return &ttnpb.RelayForwardLimits{
+		BucketSize: uint32(v >> (28 + 2*i) & 0x3),
+		ReloadRate: uint32(v >> (7 * i) & 0x7f),
+	}
+}
+
+func appendRelayPower(dst []byte, snr, rssi int32) []byte {
+	if snr < -20 {
+		snr = -20
+	} else if snr > 11 {
+		snr = 11
+	}
+	rssi = -rssi
+	if rssi < 0 {
+		rssi = 0
+	} else if rssi > 127 {
+		rssi = 127
+	}
+	return byteutil.AppendUint16(dst, uint16(rssi)<<5|uint16(snr+20), 2)
+}
+
+func parseRelayPower(b []byte) (snr, rssi int32) {
+	v := byteutil.ParseUint32(b[:2])
+	return int32(v&0x1f) - 20, -int32(v >> 5 & 0x7f)
+}
+
+// RelayAnswerAccepted returns whether all the acknowledgments are set in the answer to a relay configuration
+// request. Answers without acknowledgments are always accepted.
+func RelayAnswerAccepted(cmd *ttnpb.MACCommand) bool {
+	switch pld := cmd.GetPayload().(type) {
+	case *ttnpb.MACCommand_RelayConfAns_:
+		ans := pld.RelayConfAns
+		return ans.SecondChannelFrequencyAck && ans.SecondChannelAckOffsetAck && ans.SecondChannelDataRateIndexAck &&
+			ans.SecondChannelIndexAck && ans.DefaultChannelIndexAck && ans.CadPeriodicityAck
+	case *ttnpb.MACCommand_RelayEndDeviceConfAns_:
+		ans := pld.RelayEndDeviceConfAns
+		return ans.SecondChannelFrequencyAck && ans.SecondChannelDataRateIndexAck && ans.SecondChannelIndexAck &&
+			ans.BackoffAck
+	case *ttnpb.MACCommand_RelayFilterListAns_:
+		ans := pld.RelayFilterListAns
+		return ans.FilterListActionAck && ans.FilterListLengthAck && ans.CombinedRulesAck
+	case *ttnpb.MACCommand_RelayCtrlUplinkListAns_:
+		return pld.RelayCtrlUplinkListAns.IndexAck
+	default:
+		return true
+	}
+}
+
+// RelayUplinkMetadata is the metadata of an uplink forwarded by a relay.
+type RelayUplinkMetadata struct {
+	DataRateIndex ttnpb.DataRateIndex
+	SNR           int32
+	RSSI          int32
+	WORChannel    uint8
+	Frequency     uint64
+}
+
+// AppendRelayForwardUplink appends the forwarded uplink with metadata md and PHYPayload phyPayload to dst.
+func AppendRelayForwardUplink(dst []byte, md RelayUplinkMetadata, phyPayload []byte) []byte {
+	power := appendRelayPower(make([]byte, 0, 2), md.SNR, md.RSSI)
+	v := uint32(md.WORChannel&0x3)<<16 | byteutil.ParseUint32(power)<<4 | uint32(md.DataRateIndex&0xf)
+	dst = byteutil.AppendUint32(dst, v, 3)
+	dst = byteutil.AppendUint64(dst, md.Frequency/relayFrequencyStep, 3)
+	return append(dst, phyPayload...)
+}
+
+// UnmarshalRelayForwardUplink unmarshals the forwarded uplink b into md and returns the forwarded PHYPayload.
+func UnmarshalRelayForwardUplink(b []byte, md *RelayUplinkMetadata) ([]byte, error) {
+	if len(b) < 7 {
+		return nil, errExpectedLengthHigherOrEqual("ForwardUplink", 7)(len(b))
+	}
+	v := byteutil.ParseUint32(b[:3])
+	snr, rssi := parseRelayPower(byteutil.AppendUint32(nil, v>>4, 2))
+	*md = RelayUplinkMetadata{
+		DataRateIndex: ttnpb.DataRateIndex(v & 0xf),
+		SNR:           snr,
+		RSSI:          rssi,
+		WORChannel:    uint8(v >> 16 & 0x3),
+		Frequency:     byteutil.ParseUint64(b[3:6]) * relayFrequencyStep,
+	}
+	return b[6:], nil
+}
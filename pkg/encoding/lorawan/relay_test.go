@@ -0,0 +1,107 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRelayForwardUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	md := RelayUplinkMetadata{
+		DataRateIndex: ttnpb.DATA_RATE_3,
+		SNR:           -5,
+		RSSI:          -80,
+		WORChannel:    1,
+		Frequency:     868100000,
+	}
+	b := AppendRelayForwardUplink(nil, md, []byte{0x40, 0x01, 0x02})
+	a.So(b, should.Resemble, []byte{
+		0xf3, 0xa0, 0x01, // Metadata
+		0x28, 0x76, 0x84, // Frequency
+		0x40, 0x01, 0x02, // PHYPayload
+	})
+
+	var decoded RelayUplinkMetadata
+	phyPayload, err := UnmarshalRelayForwardUplink(b, &decoded)
+	if a.So(err, should.BeNil) {
+		a.So(decoded, should.Resemble, md)
+		a.So(phyPayload, should.Resemble, []byte{0x40, 0x01, 0x02})
+	}
+
+	_, err = UnmarshalRelayForwardUplink(b[:6], &decoded)
+	a.So(err, should.NotBeNil)
+}
+
+func TestRelayAnswerAccepted(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(RelayAnswerAccepted((&ttnpb.MACCommand_RelayConfAns{
+		SecondChannelFrequencyAck:     true,
+		SecondChannelAckOffsetAck:     true,
+		SecondChannelDataRateIndexAck: true,
+		SecondChannelIndexAck:         true,
+		DefaultChannelIndexAck:        true,
+		CadPeriodicityAck:             true,
+	}).MACCommand()), should.BeTrue)
+	a.So(RelayAnswerAccepted((&ttnpb.MACCommand_RelayFilterListAns{
+		FilterListActionAck: true,
+		FilterListLengthAck: true,
+	}).MACCommand()), should.BeFalse)
+	a.So(RelayAnswerAccepted((&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+		WFCnt: 0x42,
+	}).MACCommand()), should.BeFalse)
+	a.So(RelayAnswerAccepted(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST.MACCommand()), should.BeTrue)
+}
+
+func TestRelayMACCommands(t *testing.T) {
+	a := assertions.New(t)
+	phy := test.Must(test.Must(band.GetByID(band.EU_863_870)).(band.Band).Version(ttnpb.PHY_V1_1_REV_B)).(band.Band)
+
+	// Limits which are not set are disabled.
+	b, err := DefaultMACCommands.AppendDownlink(phy, nil, *(&ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+		OverallLimits: &ttnpb.RelayForwardLimits{BucketSize: 1, ReloadRate: 10},
+	}).MACCommand())
+	if a.So(err, should.BeNil) {
+		a.So(b, should.Resemble, []byte{0x45, 0x8a, 0xff, 0xff, 0x1f, 0x00})
+	}
+
+	for _, raw := range [][]byte{nil, make([]byte, RelayFilterListEUIMaxLength+1)} {
+		_, err = DefaultMACCommands.AppendDownlink(phy, nil, *(&ttnpb.MACCommand_RelayFilterListReq{
+			RawJoinEuiDevEui: raw,
+		}).MACCommand())
+		a.So(err, should.NotBeNil)
+	}
+	cmd := &ttnpb.MACCommand{}
+	a.So(DefaultMACCommands.ReadDownlink(phy, bytes.NewReader([]byte{0x42, 0x12}), cmd), should.NotBeNil)
+
+	// The RootWorSKey must be unwrapped before the command is encoded.
+	_, err = DefaultMACCommands.AppendDownlink(phy, nil, *(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+		RootWorSKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: []byte{0x01, 0x02},
+			KekLabel:     "test",
+		},
+	}).MACCommand())
+	a.So(err, should.NotBeNil)
+}
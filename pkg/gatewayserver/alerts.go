@@ -0,0 +1,306 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	// statusSilenceIntervals is the number of expected status intervals without status message after which an alert
+	// is raised.
+	statusSilenceIntervals = 3
+	// maxResolvedAlerts is the number of resolved alerts that is retained per gateway.
+	maxResolvedAlerts = 10
+	// highRoundTripTimeResolvePercentage is the percentage of the maximum round-trip time below which the high
+	// round-trip time alert is resolved. This avoids raising and resolving the alert repeatedly when the round-trip
+	// times are close to the maximum.
+	highRoundTripTimeResolvePercentage = 80
+	// maxDisconnectMonitorDuration is the time after the disconnect threshold is exceeded after which a disconnected
+	// gateway is no longer monitored.
+	maxDisconnectMonitorDuration = 24 * time.Hour
+)
+
+// alertThresholds are the effective thresholds of the alerts of a gateway. Zero thresholds disable the alert.
+type alertThresholds struct {
+	disconnect     time.Duration
+	uplinkSilence  time.Duration
+	statusInterval time.Duration
+	maxRTT         time.Duration
+}
+
+// thresholds returns the alert thresholds of the gateway with the given settings.
+// Thresholds that are not set in the settings fall back to the configured defaults.
+func (c AlertsConfig) thresholds(settings *ttnpb.GatewayAlertSettings) alertThresholds {
+	res := alertThresholds{
+		disconnect:     c.DisconnectThreshold,
+		uplinkSilence:  c.UplinkSilenceThreshold,
+		statusInterval: c.StatusInterval,
+		maxRTT:         c.MaxRoundTripTime,
+	}
+	if settings == nil {
+		return res
+	}
+	if settings.Disabled {
+		return alertThresholds{}
+	}
+	for _, override := range []struct {
+		dst *time.Duration
+		src *time.Duration
+	}{
+		{&res.disconnect, settings.DisconnectThreshold},
+		{&res.uplinkSilence, settings.UplinkSilenceThreshold},
+		{&res.statusInterval, settings.StatusInterval},
+		{&res.maxRTT, settings.MaxRoundTripTime},
+	} {
+		if override.src != nil {
+			*override.dst = *override.src
+		}
+	}
+	return res
+}
+
+// gatewayAlerts contains the active and recently resolved alerts of a gateway.
+// The alerts are retained while the gateway is monitored.
+type gatewayAlerts struct {
+	mu       sync.Mutex
+	monitors int
+	evicted  bool
+	active   map[ttnpb.GatewayAlertType]*ttnpb.GatewayAlert
+	resolved []*ttnpb.GatewayAlert
+}
+
+// acquireGatewayAlerts returns the alerts of the gateway and registers a monitor of the gateway.
+// The caller must release the alerts with releaseGatewayAlerts when it stops monitoring the gateway.
+func (gs *GatewayServer) acquireGatewayAlerts(ctx context.Context, ids ttnpb.GatewayIdentifiers) *gatewayAlerts {
+	uid := unique.ID(ctx, ids)
+	for {
+		val, _ := gs.alerts.LoadOrStore(uid, &gatewayAlerts{
+			active: make(map[ttnpb.GatewayAlertType]*ttnpb.GatewayAlert),
+		})
+		alerts := val.(*gatewayAlerts)
+		alerts.mu.Lock()
+		if alerts.evicted {
+			// The alerts were evicted after they were loaded; load the alerts again.
+			alerts.mu.Unlock()
+			continue
+		}
+		alerts.monitors++
+		alerts.mu.Unlock()
+		return alerts
+	}
+}
+
+// releaseGatewayAlerts unregisters a monitor of the gateway. The alerts are evicted when the gateway is no longer
+// monitored.
+func (gs *GatewayServer) releaseGatewayAlerts(ctx context.Context, ids ttnpb.GatewayIdentifiers, alerts *gatewayAlerts) {
+	alerts.mu.Lock()
+	defer alerts.mu.Unlock()
+	alerts.monitors--
+	if alerts.monitors > 0 {
+		return
+	}
+	alerts.evicted = true
+	gs.alerts.Delete(unique.ID(ctx, ids))
+}
+
+// raise raises an alert of the given type, if it is not active already.
+func (alerts *gatewayAlerts) raise(ctx context.Context, ids ttnpb.GatewayIdentifiers, alertType ttnpb.GatewayAlertType, threshold, value time.Duration, now time.Time) {
+	alerts.mu.Lock()
+	if _, ok := alerts.active[alertType]; ok {
+		alerts.mu.Unlock()
+		return
+	}
+	alert := &ttnpb.GatewayAlert{
+		GatewayIdentifiers: ids,
+		Type:               alertType,
+		RaisedAt:           now,
+		Threshold:          threshold,
+		Value:              value,
+	}
+	alerts.active[alertType] = alert
+	alerts.mu.Unlock()
+
+	log.FromContext(ctx).WithFields(log.Fields(
+		"alert", alertType,
+		"threshold", threshold,
+		"value", value,
+	)).Info("Raise gateway alert")
+	registerRaiseGatewayAlert(ctx, alert)
+}
+
+// resolve resolves the active alert of the given type, if any.
+func (alerts *gatewayAlerts) resolve(ctx context.Context, ids ttnpb.GatewayIdentifiers, alertType ttnpb.GatewayAlertType, now time.Time) {
+	alerts.mu.Lock()
+	active, ok := alerts.active[alertType]
+	if !ok {
+		alerts.mu.Unlock()
+		return
+	}
+	delete(alerts.active, alertType)
+	alert := *active
+	alert.ResolvedAt = &now
+	alerts.resolved = append(alerts.resolved, &alert)
+	if n := len(alerts.resolved); n > maxResolvedAlerts {
+		alerts.resolved = append(alerts.resolved[:0], alerts.resolved[n-maxResolvedAlerts:]...)
+	}
+	alerts.mu.Unlock()
+
+	log.FromContext(ctx).WithField("alert", alertType).Info("Resolve gateway alert")
+	registerResolveGatewayAlert(ctx, &alert)
+}
+
+// check raises the alert of the given type if the value exceeds the threshold, and resolves it if the value does not
+// exceed the resolve threshold. Zero thresholds disable the alert.
+func (alerts *gatewayAlerts) check(ctx context.Context, ids ttnpb.GatewayIdentifiers, alertType ttnpb.GatewayAlertType, threshold, resolveThreshold, value time.Duration, now time.Time) {
+	if threshold > 0 && value > threshold {
+		alerts.raise(ctx, ids, alertType, threshold, value, now)
+		return
+	}
+	if threshold <= 0 || value <= resolveThreshold {
+		alerts.resolve(ctx, ids, alertType, now)
+	}
+}
+
+// checkConnection checks the health of the gateway connection.
+func (alerts *gatewayAlerts) checkConnection(ctx context.Context, conn connectionEntry, thresholds alertThresholds, now time.Time) {
+	ids := conn.Gateway().GatewayIdentifiers
+
+	lastUplink := conn.ConnectTime()
+	if _, t, ok := conn.UpStats(); ok {
+		lastUplink = t
+	}
+	alerts.check(ctx, ids, ttnpb.GATEWAY_ALERT_UPLINK_SILENCE, thresholds.uplinkSilence, thresholds.uplinkSilence, now.Sub(lastUplink), now)
+
+	lastStatus := conn.ConnectTime()
+	if _, t, ok := conn.StatusStats(); ok {
+		lastStatus = t
+	}
+	statusSilence := statusSilenceIntervals * thresholds.statusInterval
+	alerts.check(ctx, ids, ttnpb.GATEWAY_ALERT_STATUS_SILENCE, statusSilence, statusSilence, now.Sub(lastStatus), now)
+
+	var p90 time.Duration
+	if _, _, _, np, count := conn.RTTStats(90, now); count > 0 {
+		p90 = np
+	}
+	resolveRTT := thresholds.maxRTT * highRoundTripTimeResolvePercentage / 100
+	alerts.check(ctx, ids, ttnpb.GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME, thresholds.maxRTT, resolveRTT, p90, now)
+}
+
+// isConnected returns whether the gateway is connected to this Gateway Server, or to another Gateway Server since the
+// given time.
+func (gs *GatewayServer) isConnected(ctx context.Context, ids ttnpb.GatewayIdentifiers, since time.Time) bool {
+	if _, ok := gs.connections.Load(unique.ID(ctx, ids)); ok {
+		return true
+	}
+	if gs.statsRegistry == nil {
+		return false
+	}
+	stats, err := gs.statsRegistry.Get(ctx, ids)
+	if err != nil {
+		return false
+	}
+	return stats.ConnectedAt != nil && stats.ConnectedAt.After(since)
+}
+
+// monitorConnection checks the health of the gateway connection until it is disconnected, and then monitors whether
+// the gateway reconnects in time. The alerts are released when the gateway is no longer monitored.
+func (gs *GatewayServer) monitorConnection(conn connectionEntry, alerts *gatewayAlerts) {
+	ctx := conn.Context()
+	ids := conn.Gateway().GatewayIdentifiers
+	thresholds := gs.config.Alerts.thresholds(conn.Gateway().AlertSettings)
+	defer gs.releaseGatewayAlerts(ctx, ids, alerts)
+
+	ticker := time.NewTicker(gs.config.Alerts.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			ctx = gs.FromRequestContext(ctx)
+			disconnectedAt := time.Now()
+			for _, alertType := range []ttnpb.GatewayAlertType{
+				ttnpb.GATEWAY_ALERT_UPLINK_SILENCE,
+				ttnpb.GATEWAY_ALERT_STATUS_SILENCE,
+				ttnpb.GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME,
+			} {
+				alerts.resolve(ctx, ids, alertType, disconnectedAt)
+			}
+			if thresholds.disconnect > 0 {
+				gs.monitorDisconnect(ctx, alerts, ids, thresholds.disconnect, disconnectedAt, ticker)
+			}
+			return
+		case now := <-ticker.C:
+			alerts.checkConnection(ctx, conn, thresholds, now)
+		}
+	}
+}
+
+// monitorDisconnect raises an alert when the gateway does not reconnect within the threshold, and resolves it when
+// the gateway reconnects to any Gateway Server. The gateway is monitored for at most maxDisconnectMonitorDuration
+// after the threshold is exceeded.
+func (gs *GatewayServer) monitorDisconnect(ctx context.Context, alerts *gatewayAlerts, ids ttnpb.GatewayIdentifiers, threshold time.Duration, disconnectedAt time.Time, ticker *time.Ticker) {
+	deadline := disconnectedAt.Add(threshold + maxDisconnectMonitorDuration)
+	for {
+		select {
+		case <-gs.Context().Done():
+			return
+		case now := <-ticker.C:
+			if gs.isConnected(ctx, ids, disconnectedAt) {
+				alerts.resolve(ctx, ids, ttnpb.GATEWAY_ALERT_DISCONNECTED, now)
+				return
+			}
+			if now.After(deadline) {
+				log.FromContext(ctx).Debug("Stop monitoring disconnected gateway")
+				return
+			}
+			if value := now.Sub(disconnectedAt); value > threshold {
+				alerts.raise(ctx, ids, ttnpb.GATEWAY_ALERT_DISCONNECTED, threshold, value, now)
+			}
+		}
+	}
+}
+
+// ListGatewayAlerts returns the active and optionally the recently resolved alerts of the gateway, ordered by the time
+// they were raised.
+func (gs *GatewayServer) ListGatewayAlerts(ctx context.Context, req *ttnpb.ListGatewayAlertsRequest) (*ttnpb.GatewayAlerts, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GatewayAlerts{}
+	val, ok := gs.alerts.Load(unique.ID(ctx, req.GatewayIdentifiers))
+	if !ok {
+		return res, nil
+	}
+	alerts := val.(*gatewayAlerts)
+	alerts.mu.Lock()
+	for _, alert := range alerts.active {
+		res.Alerts = append(res.Alerts, alert)
+	}
+	if req.IncludeResolved {
+		res.Alerts = append(res.Alerts, alerts.resolved...)
+	}
+	alerts.mu.Unlock()
+	sort.Slice(res.Alerts, func(i, j int) bool {
+		return res.Alerts[i].RaisedAt.Before(res.Alerts[j].RaisedAt)
+	})
+	return res, nil
+}
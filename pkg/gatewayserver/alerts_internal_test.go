@@ -0,0 +1,51 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayAlertsHysteresis(t *testing.T) {
+	a, ctx := test.New(t)
+
+	alerts := &gatewayAlerts{
+		active: make(map[ttnpb.GatewayAlertType]*ttnpb.GatewayAlert),
+	}
+	ids := ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+	now := time.Now()
+	for _, step := range []struct {
+		value  time.Duration
+		active bool
+	}{
+		{value: 50 * time.Millisecond},
+		{value: 120 * time.Millisecond, active: true},
+		// The alert is not resolved until the value drops to the resolve threshold.
+		{value: 90 * time.Millisecond, active: true},
+		{value: 80 * time.Millisecond},
+		// The alert is not raised again until the value exceeds the threshold.
+		{value: 90 * time.Millisecond},
+	} {
+		alerts.check(ctx, ids, ttnpb.GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME, 100*time.Millisecond, 80*time.Millisecond, step.value, now)
+		_, active := alerts.active[ttnpb.GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME]
+		a.So(active, should.Equal, step.active)
+	}
+	a.So(alerts.resolved, should.HaveLength, 1)
+}
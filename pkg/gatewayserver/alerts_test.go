@@ -0,0 +1,165 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	er "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/entityregistry/is"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestGatewayAlerts(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	is, isAddr := startMockIS(ctx)
+	_, nsAddr := mock.StartNS(ctx)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":9191",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		Alerts: gatewayserver.AlertsConfig{
+			CheckInterval:          timeout,
+			DisconnectThreshold:    2 * timeout,
+			UplinkSilenceThreshold: 4 * timeout,
+		},
+	}, gatewayserver.WithRegistry(er.New(c)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: registeredGatewayID,
+		Eui:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+	rightsCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		},
+	})
+	listAlerts := func(includeResolved bool) []*ttnpb.GatewayAlert {
+		res, err := gs.ListGatewayAlerts(rightsCtx, &ttnpb.ListGatewayAlertsRequest{
+			GatewayIdentifiers: ids,
+			IncludeResolved:    includeResolved,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return res.Alerts
+	}
+
+	conn, err := grpc.Dial(":9191", append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	linkCtx, cancelLink := context.WithCancel(ctx)
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayId,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The gateway does not forward uplink messages.
+	time.Sleep(6 * timeout)
+	if alerts := listAlerts(false); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].Type, should.Equal, ttnpb.GATEWAY_ALERT_UPLINK_SILENCE)
+		a.So(alerts[0].Threshold, should.Equal, 4*timeout)
+		a.So(alerts[0].Value, should.BeGreaterThan, 4*timeout)
+		a.So(alerts[0].ResolvedAt, should.BeNil)
+	}
+
+	// The alert is resolved when the gateway forwards an uplink message.
+	if !a.So(link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{
+			{
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								SpreadingFactor: 7,
+								Bandwidth:       125000,
+							},
+						},
+					},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+					Timestamp:  100,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ids,
+						Timestamp:          100,
+					},
+				},
+				RawPayload: randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+			},
+		},
+	}), should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(2 * timeout)
+	a.So(listAlerts(false), should.BeEmpty)
+	if alerts := listAlerts(true); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].ResolvedAt, should.NotBeNil)
+	}
+
+	// The gateway does not reconnect within the disconnect threshold.
+	cancelLink()
+	time.Sleep(5 * timeout)
+	if alerts := listAlerts(false); a.So(alerts, should.HaveLength, 1) {
+		a.So(alerts[0].Type, should.Equal, ttnpb.GATEWAY_ALERT_DISCONNECTED)
+		a.So(alerts[0].Threshold, should.Equal, 2*timeout)
+	}
+	a.So(listAlerts(true), should.HaveLength, 2)
+}
@@ -0,0 +1,96 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture implements storage and conversion of gateway traffic captures.
+package capture
+
+import (
+	"bufio"
+	stdio "io"
+	"path"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// ContentType is the content type of stored captures.
+const ContentType = "application/x-ndjson"
+
+// maxRecordSize is the maximum size of a JSON encoded capture record.
+const maxRecordSize = 1 << 20
+
+// Key returns the blob key of the capture with the given identifier of the gateway with the given unique identifier.
+func Key(gatewayUID, captureID string) string {
+	return path.Join("captures", gatewayUID, captureID+".jsonl")
+}
+
+// Write writes the records of the capture as JSON lines to w until the capture stops.
+// Records that are buffered when the capture stops are written too.
+// Write returns the number of records written.
+func Write(w stdio.Writer, capture *io.Capture) (int, error) {
+	n := 0
+	write := func(rec *ttnpb.GatewayCaptureRecord) error {
+		buf, err := jsonpb.TTN().Marshal(rec)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(buf, '\n')); err != nil {
+			return err
+		}
+		n++
+		return nil
+	}
+	for {
+		select {
+		case rec := <-capture.Records():
+			if err := write(rec); err != nil {
+				return n, err
+			}
+		case <-capture.Context().Done():
+			for {
+				select {
+				case rec := <-capture.Records():
+					if err := write(rec); err != nil {
+						return n, err
+					}
+				default:
+					return n, nil
+				}
+			}
+		}
+	}
+}
+
+var errRecord = errors.DefineCorruption("record", "invalid capture record on line `{line}`")
+
+// ReadRecords reads the JSON lines of capture records from r and calls f for each record.
+func ReadRecords(r stdio.Reader, f func(*ttnpb.GatewayCaptureRecord) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1<<12), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := &ttnpb.GatewayCaptureRecord{}
+		if err := jsonpb.TTN().Unmarshal(scanner.Bytes(), rec); err != nil {
+			return errRecord.WithAttributes("line", line).WithCause(err)
+		}
+		if err := f(rec); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
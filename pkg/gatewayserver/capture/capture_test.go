@@ -0,0 +1,181 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestKey(t *testing.T) {
+	assertions.New(t).So(capture.Key("foo-gateway", "01f8mechzx"), should.Equal, "captures/foo-gateway/01f8mechzx.jsonl")
+}
+
+func TestWriteRead(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "foo-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Disconnect(nil)
+
+	c, err := conn.StartCapture("test-capture", time.Hour)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conn.CaptureRawUp([]byte(`{"rxpk":[]}`))
+	a.So(conn.HandleTxAck(&ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_TOO_LATE}), should.BeNil)
+	conn.CaptureRawDown([]byte(`{"txpk":{}}`))
+	// Records that are buffered when the capture stops are written.
+	c.Stop()
+
+	var buf bytes.Buffer
+	n, err := capture.Write(&buf, c)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(n, should.Equal, 3)
+
+	var records []*ttnpb.GatewayCaptureRecord
+	a.So(capture.ReadRecords(&buf, func(rec *ttnpb.GatewayCaptureRecord) error {
+		records = append(records, rec)
+		return nil
+	}), should.BeNil)
+	if a.So(records, should.HaveLength, 3) {
+		a.So(records[0].RawUp, should.Resemble, []byte(`{"rxpk":[]}`))
+		a.So(records[1].TxAcknowledgment.GetResult(), should.Equal, ttnpb.TxAcknowledgment_TOO_LATE)
+		a.So(records[2].RawDown, should.Resemble, []byte(`{"txpk":{}}`))
+	}
+
+	err = capture.ReadRecords(bytes.NewBufferString("{}\nnot json\n"), func(*ttnpb.GatewayCaptureRecord) error { return nil })
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+}
+
+func TestConvertPCAPNG(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Unix(1600000000, 123456000)
+	var jsonl bytes.Buffer
+	for _, rec := range []*ttnpb.GatewayCaptureRecord{
+		{
+			Time:  now,
+			RawUp: []byte{0x01, 0x02, 0x03},
+		},
+		{
+			Time: now.Add(time.Second),
+			UplinkMessage: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0xe0, 0x01},
+			},
+		},
+		{
+			Time: now.Add(2 * time.Second),
+			DownlinkMessage: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0xe0, 0x02, 0x03, 0x04, 0x05},
+			},
+		},
+	} {
+		b, err := jsonpb.TTN().Marshal(rec)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		jsonl.Write(append(b, '\n'))
+	}
+
+	var out bytes.Buffer
+	if !a.So(capture.ConvertPCAPNG(&out, &jsonl, "foo-gateway"), should.BeNil) {
+		t.FailNow()
+	}
+
+	type block struct {
+		typ  uint32
+		body []byte
+	}
+	var blocks []block
+	b := out.Bytes()
+	for len(b) > 0 {
+		if !a.So(len(b), should.BeGreaterThanOrEqualTo, 12) {
+			t.FailNow()
+		}
+		typ, total := binary.LittleEndian.Uint32(b[0:]), binary.LittleEndian.Uint32(b[4:])
+		if !a.So(total%4, should.Equal, 0) || !a.So(int(total), should.BeLessThanOrEqualTo, len(b)) {
+			t.FailNow()
+		}
+		a.So(binary.LittleEndian.Uint32(b[total-4:]), should.Equal, total)
+		blocks = append(blocks, block{typ: typ, body: b[8 : total-4]})
+		b = b[total:]
+	}
+	if !a.So(blocks, should.HaveLength, 5) {
+		t.FailNow()
+	}
+
+	// Section header block.
+	a.So(blocks[0].typ, should.Equal, 0x0a0d0d0a)
+	a.So(binary.LittleEndian.Uint32(blocks[0].body[0:]), should.Equal, 0x1a2b3c4d)
+	a.So(binary.LittleEndian.Uint16(blocks[0].body[4:]), should.Equal, 1)
+
+	// Interface description block.
+	a.So(blocks[1].typ, should.Equal, 1)
+	a.So(binary.LittleEndian.Uint16(blocks[1].body[0:]), should.Equal, capture.LinkType)
+	a.So(bytes.Contains(blocks[1].body, []byte("foo-gateway")), should.BeTrue)
+
+	// Enhanced packet blocks.
+	for i, tc := range []struct {
+		Time time.Time
+		Data []byte
+	}{
+		{
+			Time: now,
+			Data: []byte{byte(capture.RecordTypeRawUp), 0x01, 0x02, 0x03},
+		},
+		{
+			Time: now.Add(time.Second),
+			Data: []byte{byte(capture.RecordTypeUplinkMessage), 0xe0, 0x01},
+		},
+		{
+			Time: now.Add(2 * time.Second),
+			Data: []byte{byte(capture.RecordTypeDownlinkMessage), 0xe0, 0x02, 0x03, 0x04, 0x05},
+		},
+	} {
+		body := blocks[2+i].body
+		a.So(blocks[2+i].typ, should.Equal, 6)
+		ts := uint64(binary.LittleEndian.Uint32(body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(body[8:]))
+		a.So(ts, should.Equal, uint64(tc.Time.UnixNano()/1e3))
+		length := binary.LittleEndian.Uint32(body[12:])
+		a.So(binary.LittleEndian.Uint32(body[16:]), should.Equal, length)
+		a.So(body[20:20+length], should.Resemble, tc.Data)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	stdio "io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// LinkType is the PCAPNG link type of captured gateway traffic.
+// This is LINKTYPE_USER0, which is reserved for private use.
+const LinkType = 147
+
+// RecordType is the type of a capture record in the packet data of a PCAPNG enhanced packet block.
+// The packet data starts with one byte of the record type, followed by the record data.
+type RecordType byte
+
+const (
+	// RecordTypeRawUp is raw data received from the gateway by the frontend.
+	RecordTypeRawUp RecordType = iota + 1
+	// RecordTypeRawDown is raw data sent to the gateway by the frontend.
+	RecordTypeRawDown
+	// RecordTypeUplinkMessage is the PHYPayload of an uplink message.
+	// The comment of the block contains the uplink message as JSON.
+	RecordTypeUplinkMessage
+	// RecordTypeDownlinkMessage is the PHYPayload of a downlink message.
+	// The comment of the block contains the downlink message as JSON.
+	RecordTypeDownlinkMessage
+	// RecordTypeGatewayStatus is a gateway status as JSON.
+	RecordTypeGatewayStatus
+	// RecordTypeTxAcknowledgment is a transmission acknowledgment as JSON.
+	RecordTypeTxAcknowledgment
+)
+
+const (
+	pcapngSectionHeaderBlock        = 0x0a0d0d0a
+	pcapngInterfaceDescriptionBlock = 0x00000001
+	pcapngEnhancedPacketBlock       = 0x00000006
+
+	pcapngByteOrderMagic = 0x1a2b3c4d
+
+	pcapngOptionEnd     = 0
+	pcapngOptionComment = 1
+	pcapngOptionIfName  = 2
+	pcapngOptionFlags   = 2
+	pcapngOptionUserApp = 4
+
+	pcapngFlagInbound  = 1
+	pcapngFlagOutbound = 2
+)
+
+// pcapngWriter writes PCAPNG blocks in little endian byte order.
+type pcapngWriter struct {
+	w stdio.Writer
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
+
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = append(b, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint16(b[len(b)-4:], code)
+	binary.LittleEndian.PutUint16(b[len(b)-2:], uint16(len(value)))
+	b = append(b, value...)
+	return append(b, make([]byte, pad4(len(value))-len(value))...)
+}
+
+func appendEndOfOptions(b []byte) []byte {
+	return appendOption(b, pcapngOptionEnd, nil)
+}
+
+func (w *pcapngWriter) writeBlock(typ uint32, body []byte) error {
+	total := uint32(12 + len(body))
+	buf := make([]byte, 0, total)
+	buf = append(buf, make([]byte, 8)...)
+	binary.LittleEndian.PutUint32(buf[0:], typ)
+	binary.LittleEndian.PutUint32(buf[4:], total)
+	buf = append(buf, body...)
+	buf = append(buf, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(buf[len(buf)-4:], total)
+	_, err := w.w.Write(buf)
+	return err
+}
+
+func (w *pcapngWriter) writeSectionHeader() error {
+	body := make([]byte, 16)
+	binary.LittleEndian.PutUint32(body[0:], pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:], 1)
+	binary.LittleEndian.PutUint16(body[6:], 0)
+	binary.LittleEndian.PutUint64(body[8:], ^uint64(0)) // Unspecified section length.
+	body = appendOption(body, pcapngOptionUserApp, []byte("The Things Stack"))
+	body = appendEndOfOptions(body)
+	return w.writeBlock(pcapngSectionHeaderBlock, body)
+}
+
+func (w *pcapngWriter) writeInterfaceDescription(name string) error {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body[0:], LinkType)
+	binary.LittleEndian.PutUint32(body[4:], 0) // No snapshot length limit.
+	if name != "" {
+		body = appendOption(body, pcapngOptionIfName, []byte(name))
+	}
+	body = appendEndOfOptions(body)
+	return w.writeBlock(pcapngInterfaceDescriptionBlock, body)
+}
+
+func (w *pcapngWriter) writeEnhancedPacket(rec *ttnpb.GatewayCaptureRecord) error {
+	var (
+		typ     RecordType
+		data    []byte
+		comment []byte
+		flags   uint32
+		err     error
+	)
+	switch {
+	case rec.RawUp != nil:
+		typ, data, flags = RecordTypeRawUp, rec.RawUp, pcapngFlagInbound
+	case rec.RawDown != nil:
+		typ, data, flags = RecordTypeRawDown, rec.RawDown, pcapngFlagOutbound
+	case rec.UplinkMessage != nil:
+		typ, data, flags = RecordTypeUplinkMessage, rec.UplinkMessage.RawPayload, pcapngFlagInbound
+		comment, err = jsonpb.TTN().Marshal(rec.UplinkMessage)
+	case rec.DownlinkMessage != nil:
+		typ, data, flags = RecordTypeDownlinkMessage, rec.DownlinkMessage.RawPayload, pcapngFlagOutbound
+		comment, err = jsonpb.TTN().Marshal(rec.DownlinkMessage)
+	case rec.GatewayStatus != nil:
+		typ, flags = RecordTypeGatewayStatus, pcapngFlagInbound
+		data, err = jsonpb.TTN().Marshal(rec.GatewayStatus)
+	case rec.TxAcknowledgment != nil:
+		typ, flags = RecordTypeTxAcknowledgment, pcapngFlagInbound
+		data, err = jsonpb.TTN().Marshal(rec.TxAcknowledgment)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	packet := append([]byte{byte(typ)}, data...)
+	ts := uint64(rec.Time.UnixNano() / 1e3)
+	body := make([]byte, 20, 20+pad4(len(packet)))
+	binary.LittleEndian.PutUint32(body[0:], 0) // Interface ID.
+	binary.LittleEndian.PutUint32(body[4:], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(ts))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(packet)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(len(packet)))
+	body = append(body, packet...)
+	body = append(body, make([]byte, pad4(len(packet))-len(packet))...)
+	if len(comment) > 0 && len(comment) <= 0xffff {
+		body = appendOption(body, pcapngOptionComment, comment)
+	}
+	flagsValue := make([]byte, 4)
+	binary.LittleEndian.PutUint32(flagsValue, flags)
+	body = appendOption(body, pcapngOptionFlags, flagsValue)
+	body = appendEndOfOptions(body)
+	return w.writeBlock(pcapngEnhancedPacketBlock, body)
+}
+
+// ConvertPCAPNG converts the JSON lines of capture records read from r to PCAPNG written to w.
+// The name is used as the name of the interface, typically the unique identifier of the gateway.
+// The timestamps of the packets have microsecond resolution.
+func ConvertPCAPNG(w stdio.Writer, r stdio.Reader, name string) error {
+	pw := &pcapngWriter{w: w}
+	if err := pw.writeSectionHeader(); err != nil {
+		return err
+	}
+	if err := pw.writeInterfaceDescription(name); err != nil {
+		return err
+	}
+	return ReadRecords(r, pw.writeEnhancedPacket)
+}
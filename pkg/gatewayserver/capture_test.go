@@ -0,0 +1,228 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	er "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/entityregistry/is"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+type mockDownloadGatewayCaptureServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (s *mockDownloadGatewayCaptureServer) Context() context.Context { return s.ctx }
+
+func (s *mockDownloadGatewayCaptureServer) Send(msg *ttnpb.GatewayCaptureData) error {
+	_, err := s.data.Write(msg.Data)
+	return err
+}
+
+func TestCapture(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	is, isAddr := startMockIS(ctx)
+	_, nsAddr := mock.StartNS(ctx)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":9189",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+			Blob: config.BlobConfig{
+				Provider: "local",
+				Local: config.BlobConfigLocal{
+					Directory: t.TempDir(),
+				},
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		Capture: gatewayserver.CaptureConfig{
+			Bucket:      "captures",
+			MaxDuration: time.Hour,
+		},
+	}, gatewayserver.WithRegistry(er.New(c)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: registeredGatewayID,
+		Eui:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+	rightsCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		},
+	})
+
+	// The gateway must be connected to start a capture.
+	_, err = gs.StartGatewayCapture(rightsCtx, &ttnpb.StartGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+	})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	conn, err := grpc.Dial(":9189", append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(ctx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayId,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout) // Wait for the gateway to be connected.
+
+	tooLong := 2 * time.Hour
+	_, err = gs.StartGatewayCapture(rightsCtx, &ttnpb.StartGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+		Duration:           &tooLong,
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = gs.StopGatewayCapture(rightsCtx, &ttnpb.StopGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+	})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	started, err := gs.StartGatewayCapture(rightsCtx, &ttnpb.StartGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(started.CaptureID, should.NotBeEmpty)
+	a.So(started.StopsAt, should.Equal, started.StartedAt.Add(5*time.Minute))
+	_, err = gs.StartGatewayCapture(rightsCtx, &ttnpb.StartGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+	})
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	up := &ttnpb.UplinkMessage{
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 7,
+						Bandwidth:       125000,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  868100000,
+			Timestamp:  100,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          100,
+			},
+		},
+		RawPayload: randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+	}
+	if !a.So(link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{up},
+	}), should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout) // Wait for the uplink message to be handled.
+
+	stopped, err := gs.StopGatewayCapture(rightsCtx, &ttnpb.StopGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stopped.CaptureID, should.Equal, started.CaptureID)
+
+	// Download as JSON lines.
+	stream := &mockDownloadGatewayCaptureServer{ctx: rightsCtx}
+	if !a.So(gs.DownloadGatewayCapture(&ttnpb.DownloadGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+		CaptureID:          started.CaptureID,
+	}, stream), should.BeNil) {
+		t.FailNow()
+	}
+	var records []*ttnpb.GatewayCaptureRecord
+	a.So(capture.ReadRecords(&stream.data, func(rec *ttnpb.GatewayCaptureRecord) error {
+		records = append(records, rec)
+		return nil
+	}), should.BeNil)
+	if a.So(records, should.HaveLength, 1) {
+		a.So(records[0].UplinkMessage.GetRawPayload(), should.Resemble, up.RawPayload)
+	}
+
+	// Download as PCAPNG.
+	stream = &mockDownloadGatewayCaptureServer{ctx: rightsCtx}
+	if !a.So(gs.DownloadGatewayCapture(&ttnpb.DownloadGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+		CaptureID:          started.CaptureID,
+		Format:             ttnpb.CAPTURE_FORMAT_PCAPNG,
+	}, stream), should.BeNil) {
+		t.FailNow()
+	}
+	if a.So(stream.data.Len(), should.BeGreaterThan, 8) {
+		a.So(binary.LittleEndian.Uint32(stream.data.Bytes()), should.Equal, 0x0a0d0d0a)
+	}
+
+	err = gs.DownloadGatewayCapture(&ttnpb.DownloadGatewayCaptureRequest{
+		GatewayIdentifiers: ids,
+		CaptureID:          "01f8mechzxunknown",
+	}, &mockDownloadGatewayCaptureServer{ctx: rightsCtx})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
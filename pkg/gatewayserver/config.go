@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -36,6 +37,7 @@ type BasicStationConfig struct {
 	FallbackFrequencyPlanID string        `name:"fallback-frequency-plan-id" description:"Fallback frequency plan ID for non-registered gateways"`
 	Listen                  string        `name:"listen" description:"Address for the Basic Station frontend to listen on"`
 	ListenTLS               string        `name:"listen-tls" description:"Address for the Basic Station frontend to listen on (with TLS)"`
+	TransmitBeacons         bool          `name:"transmit-beacons" description:"Configure gateways to transmit Class B beacons"`
 }
 
 // PacketBrokerConfig configures the Packet Broker upstream.
@@ -45,20 +47,85 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// ProprietaryConfig configures the upstream of proprietary uplink messages.
+type ProprietaryConfig struct {
+	Target  string            `name:"target" description:"URL to forward proprietary uplink messages to"`
+	Headers map[string]string `name:"headers" description:"HTTP headers to set on the requests to the target"`
+}
+
+// UDPUpstreamConfig configures the upstreams that forward traffic with the Semtech UDP packet forwarder protocol.
+// These upstreams are configured in the forward map with the name `udp:<host>:<port>`.
+type UDPUpstreamConfig struct {
+	KeepaliveInterval time.Duration `name:"keepalive-interval" description:"Interval of PULL_DATA keepalive messages"`
+}
+
+// CaptureConfig configures gateway traffic captures.
+type CaptureConfig struct {
+	Bucket      string        `name:"bucket" description:"Bucket to store gateway traffic captures in; captures are disabled if empty"`
+	MaxDuration time.Duration `name:"max-duration" description:"Maximum duration of a gateway traffic capture"`
+}
+
+// StatsHistoryConfig configures the history of gateway connection stats.
+type StatsHistoryConfig struct {
+	Store GatewayConnectionStatsHistory `name:"-"`
+
+	SampleInterval      time.Duration `name:"sample-interval" description:"Interval at which the connection stats of connected gateways are sampled; stats history is disabled if zero"`
+	SampleRetention     time.Duration `name:"sample-retention" description:"Retention of the samples"`
+	DownsampleInterval  time.Duration `name:"downsample-interval" description:"Interval to which the samples are downsampled"`
+	DownsampleRetention time.Duration `name:"downsample-retention" description:"Retention of the downsampled samples"`
+}
+
+var errStatsHistoryConfig = errors.DefineInvalidArgument("stats_history_config", "stats history `{field}` must be positive")
+
+// Validate returns an error if an interval or retention is not positive.
+func (c StatsHistoryConfig) Validate() error {
+	for _, v := range []struct {
+		field string
+		value time.Duration
+	}{
+		{"sample-interval", c.SampleInterval},
+		{"sample-retention", c.SampleRetention},
+		{"downsample-interval", c.DownsampleInterval},
+		{"downsample-retention", c.DownsampleRetention},
+	} {
+		if v.value <= 0 {
+			return errStatsHistoryConfig.WithAttributes("field", v.field)
+		}
+	}
+	return nil
+}
+
+// AlertsConfig configures the alerts about the health of gateway connections.
+// The thresholds are the defaults for gateways that do not override them in their alert settings.
+type AlertsConfig struct {
+	CheckInterval          time.Duration `name:"check-interval" description:"Interval at which the health of gateway connections is checked; alerts are disabled if zero"`
+	DisconnectThreshold    time.Duration `name:"disconnect-threshold" description:"Time after a disconnect after which an alert is raised if the gateway did not reconnect (0 is disabled)"`
+	UplinkSilenceThreshold time.Duration `name:"uplink-silence-threshold" description:"Time without uplink messages after which an alert is raised (0 is disabled)"`
+	StatusInterval         time.Duration `name:"status-interval" description:"Expected interval of gateway status messages (0 is disabled)"`
+	MaxRoundTripTime       time.Duration `name:"max-round-trip-time" description:"Maximum 90th percentile of the round-trip times (0 is disabled)"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
+	ReserveBeacons            bool `name:"reserve-beacons" description:"Reserve the Class B beacon periods in the downlink scheduler of gateways that are time synchronized with GPS"`
 
-	Stats GatewayConnectionStatsRegistry `name:"-"`
+	Stats        GatewayConnectionStatsRegistry `name:"-"`
+	StatsHistory StatsHistoryConfig             `name:"stats-history" description:"Gateway connection stats history configuration"`
+	Alerts       AlertsConfig                   `name:"alerts" description:"Gateway health alerts configuration"`
 
 	UpdateGatewayLocationDebounceTime time.Duration `name:"update-gateway-location-debounce-time" description:"Debounce time for gateway location updates from status messages"`
 	UpdateConnectionStatsDebounceTime time.Duration `name:"update-connection-stats-debounce-time" description:"Time before repeated refresh of the gateway connection stats"`
 
 	Forward      map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 	PacketBroker PacketBrokerConfig  `name:"packetbroker" description:"Packet Broker upstream configuration"`
+	Proprietary  ProprietaryConfig   `name:"proprietary" description:"Proprietary uplink messages upstream configuration"`
+	UDPUpstream  UDPUpstreamConfig   `name:"udp-upstream" description:"Semtech UDP packet forwarder upstream configuration"`
+	Capture      CaptureConfig       `name:"capture" description:"Gateway traffic capture configuration"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
+	MQTTJSON     config.MQTT        `name:"mqtt-json"`
 	UDP          UDPConfig          `name:"udp"`
 	BasicStation BasicStationConfig `name:"basic-station"`
 }
@@ -16,8 +16,10 @@ package gatewayserver_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
@@ -54,3 +56,22 @@ func TestConfig(t *testing.T) {
 		a.So(err, should.NotBeNil)
 	}
 }
+
+func TestStatsHistoryConfig(t *testing.T) {
+	a := assertions.New(t)
+
+	conf := gatewayserver.StatsHistoryConfig{
+		SampleInterval:      time.Minute,
+		SampleRetention:     48 * time.Hour,
+		DownsampleInterval:  time.Hour,
+		DownsampleRetention: 30 * 24 * time.Hour,
+	}
+	a.So(conf.Validate(), should.BeNil)
+
+	conf.SampleInterval = 0
+	a.So(errors.IsInvalidArgument(conf.Validate()), should.BeTrue)
+
+	conf.SampleInterval = time.Minute
+	conf.DownsampleRetention = 0
+	a.So(errors.IsInvalidArgument(conf.Validate()), should.BeTrue)
+}
@@ -50,6 +50,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/proprietary"
+	udpupstream "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -78,8 +80,11 @@ type GatewayServer struct {
 	upstreamHandlers map[string]upstream.Handler
 
 	connections sync.Map // string to connectionEntry
+	captures    sync.Map // string to chan struct{}
+	alerts      sync.Map // string to *gatewayAlerts
 
 	statsRegistry                     GatewayConnectionStatsRegistry
+	statsHistory                      GatewayConnectionStatsHistory
 	updateConnectionStatsDebounceTime time.Duration
 }
 
@@ -108,6 +113,10 @@ var (
 	errInvalidUpstreamName = errors.DefineInvalidArgument("invalid_upstream_name", "upstream `{name}` is invalid")
 )
 
+// udpUpstreamPrefix is the prefix of the names of upstreams in the forward map that forward traffic with the Semtech
+// UDP packet forwarder protocol to the address after the prefix.
+const udpUpstreamPrefix = "udp:"
+
 // New returns new *GatewayServer.
 func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServer, err error) {
 	forward, err := conf.ForwardDevAddrPrefixes()
@@ -117,6 +126,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	if len(forward) == 0 {
 		forward[""] = []types.DevAddrPrefix{{}}
 	}
+	if conf.StatsHistory.Store != nil {
+		if err := conf.StatsHistory.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	gs = &GatewayServer{
 		Component:                         c,
@@ -126,6 +140,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		forward:                           forward,
 		upstreamHandlers:                  make(map[string]upstream.Handler),
 		statsRegistry:                     conf.Stats,
+		statsHistory:                      conf.StatsHistory.Store,
 		updateConnectionStatsDebounceTime: conf.UpdateConnectionStatsDebounceTime,
 		entityRegistry:                    is.New(c),
 	}
@@ -154,13 +169,35 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 				OnlineTTLMargin: conf.PacketBroker.OnlineTTLMargin,
 			})
 		default:
-			return nil, errInvalidUpstreamName.WithAttributes("name", name)
+			if !strings.HasPrefix(name, udpUpstreamPrefix) {
+				return nil, errInvalidUpstreamName.WithAttributes("name", name)
+			}
+			handler = udpupstream.NewHandler(gs.Context(), udpupstream.Config{
+				Address:           strings.TrimPrefix(name, udpUpstreamPrefix),
+				KeepaliveInterval: conf.UDPUpstream.KeepaliveInterval,
+				DevAddrPrefixes:   prefix,
+			})
 		}
 		if err := handler.Setup(gs.Context()); err != nil {
 			return nil, errSetupUpstream.WithCause(err).WithAttributes("name", name)
 		}
 		gs.upstreamHandlers[name] = handler
 	}
+	if conf.Proprietary.Target != "" {
+		httpClient, err := c.HTTPClient(gs.Context())
+		if err != nil {
+			return nil, err
+		}
+		handler := proprietary.NewHandler(gs.Context(), proprietary.Config{
+			Target:     conf.Proprietary.Target,
+			Headers:    conf.Proprietary.Headers,
+			HTTPClient: httpClient,
+		})
+		if err := handler.Setup(gs.Context()); err != nil {
+			return nil, errSetupUpstream.WithCause(err).WithAttributes("name", "proprietary")
+		}
+		gs.upstreamHandlers["proprietary"] = handler
+	}
 
 	// Register gRPC services.
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayserver"))
@@ -208,6 +245,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			Format: mqtt.NewProtobufV2(gs.ctx),
 			Config: conf.MQTTV2,
 		},
+		{
+			Format: mqtt.NewJSON(gs.ctx),
+			Config: conf.MQTTJSON,
+		},
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
@@ -259,7 +300,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	}{
 		{
 			Name:      "basicstation",
-			Formatter: lbslns.NewFormatter(conf.BasicStation.MaxValidRoundTripDelay),
+			Formatter: lbslns.NewFormatter(conf.BasicStation.MaxValidRoundTripDelay, conf.BasicStation.TransmitBeacons),
 			listenerConfig: listenerConfig{
 				fallbackFreqPlanID: conf.BasicStation.FallbackFrequencyPlanID,
 				listen:             conf.BasicStation.Listen,
@@ -441,6 +482,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		GatewayIdentifiers: ids,
 		FieldMask: pbtypes.FieldMask{
 			Paths: []string{
+				"alert_settings",
 				"antennas",
 				"downlink_path_constraint",
 				"enforce_duty_cycle",
@@ -452,6 +494,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 				"schedule_downlink_late",
 				"status_public",
 				"update_location_from_status",
+				"uplink_filters",
 			},
 		},
 	})
@@ -485,6 +528,9 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	if err != nil {
 		return nil, err
 	}
+	if gs.config.ReserveBeacons {
+		conn.ReserveBeacons()
+	}
 	wg := &sync.WaitGroup{}
 	// The tasks will always start once the entry is stored.
 	// As such, we must ensure any new connection waits for
@@ -514,6 +560,14 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	if gs.statsRegistry != nil {
 		go gs.updateConnStats(connEntry)
 	}
+	if gs.statsHistory != nil {
+		go gs.sampleConnStats(connEntry)
+	}
+	if gs.config.Alerts.CheckInterval > 0 {
+		alerts := gs.acquireGatewayAlerts(ctx, ids)
+		alerts.resolve(ctx, ids, ttnpb.GATEWAY_ALERT_DISCONNECTED, conn.ConnectTime())
+		go gs.monitorConnection(connEntry, alerts)
+	}
 	if gtw.UpdateLocationFromStatus {
 		go gs.handleLocationUpdates(connEntry)
 	}
@@ -556,6 +610,7 @@ var (
 type upstreamHost struct {
 	name          string
 	handler       upstream.Handler
+	proprietary   upstream.ProprietaryHandler
 	handlers      int32
 	handleWg      sync.WaitGroup
 	handleCh      chan upstreamItem
@@ -567,6 +622,11 @@ type upstreamItem struct {
 	val interface{}
 }
 
+// isProprietary returns whether the PHYPayload has MType proprietary.
+func isProprietary(rawPayload []byte) bool {
+	return len(rawPayload) > 0 && ttnpb.MType(rawPayload[0]>>5) == ttnpb.MType_PROPRIETARY
+}
+
 func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 	var (
 		ctx      = conn.Context()
@@ -615,6 +675,24 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 						logger.Debug("Drop message")
 						registerDropUplink(ctx, gtw, msg.UplinkMessage, host.name, err)
 					}
+					handled := func(ids ttnpb.EndDeviceIdentifiers, err error) {
+						switch codes.Code(errors.Code(err)) {
+						case codes.Canceled, codes.DeadlineExceeded,
+							codes.Unknown, codes.Internal,
+							codes.Unimplemented, codes.Unavailable:
+							drop(ids, errHostHandle.WithCause(err).WithAttributes("host", host.name))
+						default:
+							registerForwardUplink(ctx, gtw, msg.UplinkMessage, host.name)
+						}
+					}
+					// Proprietary uplink messages are only forwarded to proprietary hosts, which handle nothing else.
+					if isProprietary(msg.RawPayload) != (host.proprietary != nil) {
+						break
+					}
+					if host.proprietary != nil {
+						handled(ttnpb.EndDeviceIdentifiers{}, host.proprietary.HandleProprietaryUplink(ctx, gtw.GatewayIdentifiers, msg))
+						break
+					}
 					ids, err := lorawan.GetUplinkMessageIdentifiers(msg.RawPayload)
 					if err != nil {
 						drop(ttnpb.EndDeviceIdentifiers{}, err)
@@ -635,21 +713,20 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 					if !pass {
 						break
 					}
-					switch err := host.handler.HandleUplink(ctx, gtw.GatewayIdentifiers, ids, msg); codes.Code(errors.Code(err)) {
-					case codes.Canceled, codes.DeadlineExceeded,
-						codes.Unknown, codes.Internal,
-						codes.Unimplemented, codes.Unavailable:
-						drop(ids, errHostHandle.WithCause(err).WithAttributes("host", host.name))
-					default:
-						registerForwardUplink(ctx, gtw, msg.UplinkMessage, host.name)
-					}
+					handled(ids, host.handler.HandleUplink(ctx, gtw.GatewayIdentifiers, ids, msg))
 				case *ttnpb.GatewayStatus:
+					if host.proprietary != nil {
+						break
+					}
 					if err := host.handler.HandleStatus(ctx, gtw.GatewayIdentifiers, msg); err != nil {
 						registerDropStatus(ctx, gtw, msg, host.name, err)
 					} else {
 						registerForwardStatus(ctx, gtw, msg, host.name)
 					}
 				case *ttnpb.TxAcknowledgment:
+					if host.proprietary != nil {
+						break
+					}
 					if err := host.handler.HandleTxAck(ctx, gtw.GatewayIdentifiers, msg); err != nil {
 						logger.WithField("host", host.name).WithError(err).Debug("Drop Tx acknowledgment")
 					}
@@ -666,6 +743,7 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			handleCh:      make(chan upstreamItem),
 			correlationID: fmt.Sprintf("gs:up:host:%s", events.NewCorrelationID()),
 		}
+		host.proprietary, _ = handler.(upstream.ProprietaryHandler)
 		hosts = append(hosts, host)
 		defer host.handleWg.Wait()
 	}
@@ -691,6 +769,15 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			}
 			val = msg
 			registerReceiveUplink(ctx, gtw, msg.UplinkMessage, protocol)
+			if err := filterUplink(gtw.UplinkFilters, msg.UplinkMessage); err != nil {
+				dryRun := gtw.UplinkFilters.DryRun
+				log.FromContext(ctx).WithError(err).WithField("dry_run", dryRun).Debug("Filter uplink message")
+				conn.HandleFilteredUp(dryRun)
+				registerFilterUplink(ctx, gtw, msg.UplinkMessage, err, dryRun)
+				if !dryRun {
+					continue
+				}
+			}
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
 			val = msg
@@ -867,6 +954,21 @@ func (gs *GatewayServer) handleLocationUpdates(conn connectionEntry) {
 	}
 }
 
+// GetUDPAuthentication gets the UDP authentication mode and secret by the gateway identifiers.
+// Unregistered gateways do not use UDP authentication.
+func (gs *GatewayServer) GetUDPAuthentication(ctx context.Context, ids ttnpb.GatewayIdentifiers) (ttnpb.GatewayUDPAuthenticationMode, []byte, error) {
+	gtw, err := gs.entityRegistry.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIdentifiers: ids,
+		FieldMask:          pbtypes.FieldMask{Paths: []string{"udp_authentication_mode", "udp_secret"}},
+	})
+	if errors.IsNotFound(err) {
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, nil
+	} else if err != nil {
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, err
+	}
+	return gtw.UDPAuthenticationMode, gtw.GetUDPSecret().GetValue(), nil
+}
+
 // GetFrequencyPlans gets the frequency plans by the gateway identifiers.
 func (gs *GatewayServer) GetFrequencyPlans(ctx context.Context, ids ttnpb.GatewayIdentifiers) (map[string]*frequencyplans.FrequencyPlan, error) {
 	gtw, err := gs.entityRegistry.Get(ctx, &ttnpb.GetGatewayRequest{
@@ -16,8 +16,15 @@ package gatewayserver
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/mohae/deepcopy"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
@@ -48,3 +55,172 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	stats, _ := val.(connectionEntry).Stats()
 	return stats, nil
 }
+
+// RunGatewayCommand runs a command on a connected gateway.
+func (gs *GatewayServer) RunGatewayCommand(ctx context.Context, req *ttnpb.RunGatewayCommandRequest) (*pbtypes.Empty, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_REMOTE_CONTROL); err != nil {
+		return nil, err
+	}
+
+	conn, ok := gs.GetConnection(ctx, req.GatewayIdentifiers)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIdentifiers))
+	}
+	if err := conn.RunCommand(req.Command, req.Arguments...); err != nil {
+		return nil, err
+	}
+	events.Publish(evtRunGatewayCommand.NewWithIdentifiersAndData(ctx, &req.GatewayIdentifiers, req))
+	return ttnpb.Empty, nil
+}
+
+const (
+	defaultRemoteShellTimeout = time.Minute
+	maxRemoteShellTimeout     = time.Hour
+)
+
+var errRemoteShellTimeout = errors.DefineInvalidArgument("remote_shell_timeout", "remote shell timeout must be at most `{max}`")
+
+// GatewayRemoteShell opens a remote shell session on a connected gateway, writes the input and streams the output.
+func (gs *GatewayServer) GatewayRemoteShell(req *ttnpb.GatewayRemoteShellRequest, stream ttnpb.Gs_GatewayRemoteShellServer) error {
+	ctx := stream.Context()
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_REMOTE_CONTROL); err != nil {
+		return err
+	}
+	timeout := defaultRemoteShellTimeout
+	if req.Timeout != nil && *req.Timeout > 0 {
+		if *req.Timeout > maxRemoteShellTimeout {
+			return errRemoteShellTimeout.WithAttributes("max", maxRemoteShellTimeout)
+		}
+		timeout = *req.Timeout
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	conn, ok := gs.GetConnection(ctx, req.GatewayIdentifiers)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	shell, err := conn.OpenRemoteShell(req.User, req.Term)
+	if err != nil {
+		return err
+	}
+	events.Publish(evtOpenRemoteShell.NewWithIdentifiersAndData(ctx, &req.GatewayIdentifiers, req))
+	defer func() {
+		shell.Close()
+		events.Publish(evtCloseRemoteShell.NewWithIdentifiersAndData(ctx, &req.GatewayIdentifiers, nil))
+	}()
+
+	if len(req.Input) > 0 {
+		if err := shell.Write(req.Input); err != nil {
+			return err
+		}
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-shell.Context().Done():
+			return errNotConnected.WithAttributes("gateway_uid", uid)
+		case <-timer.C:
+			return nil
+		case data := <-shell.Output():
+			if err := stream.Send(&ttnpb.GatewayRemoteShellOutput{
+				Data: data,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var (
+	errNotProprietary      = errors.DefineInvalidArgument("not_proprietary", "downlink message is not proprietary")
+	errDownlinkPathGateway = errors.DefineInvalidArgument("downlink_path_gateway", "downlink path is not of gateway `{gateway_uid}`")
+)
+
+// ScheduleProprietaryDownlink schedules a proprietary downlink message on a connected gateway.
+// The downlink paths are tried in order until the downlink message is scheduled.
+func (gs *GatewayServer) ScheduleProprietaryDownlink(ctx context.Context, req *ttnpb.ScheduleProprietaryDownlinkRequest) (*ttnpb.ScheduleDownlinkResponse, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if !isProprietary(req.RawPayload) {
+		return nil, errNotProprietary.New()
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	paths := req.Request.DownlinkPaths
+	if len(paths) == 0 {
+		paths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_Fixed{
+					Fixed: &ttnpb.GatewayAntennaIdentifiers{
+						GatewayIdentifiers: req.GatewayIdentifiers,
+					},
+				},
+			},
+		}
+	}
+	for _, path := range paths {
+		var ids ttnpb.GatewayIdentifiers
+		switch p := path.Path.(type) {
+		case *ttnpb.DownlinkPath_Fixed:
+			ids = p.Fixed.GatewayIdentifiers
+		case *ttnpb.DownlinkPath_UplinkToken:
+			token, err := io.ParseUplinkToken(p.UplinkToken)
+			if err != nil {
+				return nil, errUplinkToken.WithCause(err)
+			}
+			ids = token.GatewayIdentifiers
+		default:
+			panic(fmt.Sprintf("proto: unexpected type %T in oneof", path.Path))
+		}
+		if unique.ID(ctx, ids) != uid {
+			return nil, errDownlinkPathGateway.WithAttributes("gateway_uid", uid)
+		}
+	}
+
+	conn, ok := gs.GetConnection(ctx, req.GatewayIdentifiers)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	request := *req.Request
+	request.DownlinkPaths = nil // Do not leak the downlink paths to the gateway.
+	ctx = events.ContextWithCorrelationID(ctx, events.CorrelationIDsFromContext(conn.Context())...)
+	down := &ttnpb.DownlinkMessage{
+		RawPayload: req.RawPayload,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &request,
+		},
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+	}
+
+	var pathErrs []errors.ErrorDetails
+	logger := log.FromContext(ctx)
+	for _, path := range paths {
+		connDown := deepcopy.Copy(down).(*ttnpb.DownlinkMessage) // Let the connection own the DownlinkMessage.
+		rx1, rx2, delay, err := conn.ScheduleDown(path, connDown)
+		if err != nil {
+			logger.WithField("gateway_uid", uid).WithError(err).Debug("Failed to schedule on path")
+			pathErrs = append(pathErrs, errSchedulePath.WithCause(err).WithAttributes("gateway_uid", uid))
+			continue
+		}
+		registerSendDownlink(ctx, conn.Gateway(), down, conn.Frontend().Protocol())
+		return &ttnpb.ScheduleDownlinkResponse{
+			Delay:        delay,
+			DownlinkPath: path,
+			Rx1:          rx1,
+			Rx2:          rx2,
+		}, nil
+	}
+
+	protoErrs := make([]*ttnpb.ErrorDetails, 0, len(pathErrs))
+	for _, pathErr := range pathErrs {
+		protoErrs = append(protoErrs, ttnpb.ErrorDetailsToProto(pathErr))
+	}
+	return nil, errSchedule.WithDetails(&ttnpb.ScheduleDownlinkErrorDetails{
+		PathErrors: protoErrs,
+	})
+}
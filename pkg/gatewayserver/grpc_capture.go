@@ -0,0 +1,233 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	stdio "io"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	defaultCaptureDuration    = 5 * time.Minute
+	defaultMaxCaptureDuration = time.Hour
+
+	captureDataChunkSize = 1 << 15
+)
+
+var (
+	errCaptureNotConfigured = errors.DefineFailedPrecondition("capture_not_configured", "gateway traffic capture is not configured")
+	errCaptureDuration      = errors.DefineInvalidArgument("capture_duration", "capture duration must be at most `{max}`")
+	errNoActiveCapture      = errors.DefineNotFound("no_active_capture", "no active capture on gateway `{gateway_uid}`")
+	errCaptureNotFound      = errors.DefineNotFound("capture_not_found", "capture `{capture_id}` not found")
+)
+
+func (gs *GatewayServer) captureBucket(ctx context.Context) (*blob.Bucket, error) {
+	if gs.config.Capture.Bucket == "" {
+		return nil, errCaptureNotConfigured.New()
+	}
+	return gs.GetBaseConfig(ctx).Blob.Bucket(ctx, gs.config.Capture.Bucket)
+}
+
+// StartGatewayCapture starts a capture of the traffic of a connected gateway.
+// The capture records are stored in the configured bucket until the capture stops.
+func (gs *GatewayServer) StartGatewayCapture(ctx context.Context, req *ttnpb.StartGatewayCaptureRequest) (*ttnpb.GatewayCapture, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	maxDuration := gs.config.Capture.MaxDuration
+	if maxDuration == 0 {
+		maxDuration = defaultMaxCaptureDuration
+	}
+	duration := defaultCaptureDuration
+	if req.Duration != nil && *req.Duration > 0 {
+		if *req.Duration > maxDuration {
+			return nil, errCaptureDuration.WithAttributes("max", maxDuration)
+		}
+		duration = *req.Duration
+	} else if duration > maxDuration {
+		duration = maxDuration
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	conn, ok := gs.GetConnection(ctx, req.GatewayIdentifiers)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	bucket, err := gs.captureBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+	if err != nil {
+		bucket.Close()
+		return nil, err
+	}
+	captureID := strings.ToLower(id.String())
+
+	// The writer outlives the request; it is bound to the Gateway Server context and canceled to abort the upload.
+	writerCtx, cancelWriter := context.WithCancel(gs.ctx)
+	w, err := bucket.NewWriter(writerCtx, capture.Key(uid, captureID), ttnblob.WriterOptions(capture.ContentType))
+	if err != nil {
+		cancelWriter()
+		bucket.Close()
+		return nil, err
+	}
+	c, err := conn.StartCapture(captureID, duration)
+	if err != nil {
+		cancelWriter()
+		w.Close()
+		bucket.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	gs.captures.Store(captureID, done)
+	go func() {
+		defer func() {
+			cancelWriter()
+			bucket.Close()
+			gs.captures.Delete(captureID)
+			close(done)
+		}()
+		logger := log.FromContext(gs.ctx).WithFields(log.Fields(
+			"gateway_uid", uid,
+			"capture_id", captureID,
+		))
+		n, err := capture.Write(w, c)
+		if err != nil {
+			cancelWriter()
+			w.Close()
+			logger.WithError(err).Warn("Failed to write capture")
+			return
+		}
+		if err := w.Close(); err != nil {
+			logger.WithError(err).Warn("Failed to store capture")
+			return
+		}
+		logger.WithFields(log.Fields(
+			"records", n,
+			"dropped", c.Dropped(),
+		)).Info("Stored capture")
+	}()
+
+	res := &ttnpb.GatewayCapture{
+		GatewayIdentifiers: req.GatewayIdentifiers,
+		CaptureID:          captureID,
+		StartedAt:          c.StartedAt(),
+		StopsAt:            c.StopsAt(),
+	}
+	events.Publish(evtStartCapture.NewWithIdentifiersAndData(ctx, &req.GatewayIdentifiers, res))
+	return res, nil
+}
+
+// StopGatewayCapture stops the active capture of the traffic of a connected gateway.
+// This method returns when the capture is stored.
+func (gs *GatewayServer) StopGatewayCapture(ctx context.Context, req *ttnpb.StopGatewayCaptureRequest) (*ttnpb.GatewayCapture, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	conn, ok := gs.GetConnection(ctx, req.GatewayIdentifiers)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	c, ok := conn.ActiveCapture()
+	if !ok {
+		return nil, errNoActiveCapture.WithAttributes("gateway_uid", uid)
+	}
+	c.Stop()
+	if done, ok := gs.captures.Load(c.ID()); ok {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-done.(chan struct{}):
+		}
+	}
+
+	res := &ttnpb.GatewayCapture{
+		GatewayIdentifiers: req.GatewayIdentifiers,
+		CaptureID:          c.ID(),
+		StartedAt:          c.StartedAt(),
+		StopsAt:            time.Now(),
+	}
+	events.Publish(evtStopCapture.NewWithIdentifiersAndData(ctx, &req.GatewayIdentifiers, res))
+	return res, nil
+}
+
+// captureDataWriter sends the written data as capture data chunks on the stream.
+type captureDataWriter struct {
+	stream ttnpb.Gs_DownloadGatewayCaptureServer
+}
+
+// Write implements io.Writer.
+func (w captureDataWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&ttnpb.GatewayCaptureData{
+		Data: p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// DownloadGatewayCapture streams a stored capture of the traffic of a gateway in the requested format.
+// The gateway does not need to be connected.
+func (gs *GatewayServer) DownloadGatewayCapture(req *ttnpb.DownloadGatewayCaptureRequest, stream ttnpb.Gs_DownloadGatewayCaptureServer) error {
+	ctx := stream.Context()
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return err
+	}
+	bucket, err := gs.captureBucket(ctx)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	r, err := bucket.NewReader(ctx, capture.Key(uid, req.CaptureID), nil)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return errCaptureNotFound.WithAttributes("capture_id", req.CaptureID)
+		}
+		return err
+	}
+	defer r.Close()
+
+	w := bufio.NewWriterSize(captureDataWriter{stream: stream}, captureDataChunkSize)
+	switch req.Format {
+	case ttnpb.CAPTURE_FORMAT_PCAPNG:
+		err = capture.ConvertPCAPNG(w, r, uid)
+	default:
+		_, err = stdio.Copy(w, r)
+	}
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errNoBeacon = errors.DefineFailedPrecondition("no_beacon", "band `{band_id}` does not define beacons")
+
+// ReserveBeacons reserves the Class B beacon periods in the scheduler of the gateway.
+// Beacon periods are only reserved when the gateway is time synchronized with GPS.
+func (c *Connection) ReserveBeacons() {
+	c.scheduler.ReserveBeacons()
+}
+
+// NextBeacon returns the beacon time as duration since GPS epoch of the first beacon after the given server time,
+// and the server time at which the beacon starts.
+// This method returns false if the gateway is not time synchronized with GPS.
+func (c *Connection) NextBeacon(after time.Time) (time.Duration, time.Time, bool) {
+	return c.scheduler.NextBeacon(after)
+}
+
+// ScheduleBeacon schedules the Class B beacon at the given beacon time as duration since GPS epoch.
+// The beacon is transmitted with the beacon settings of the band. The gateway specific field of the beacon contains the
+// location of the first antenna of the gateway.
+// This method returns the beacon frame and the Tx settings.
+func (c *Connection) ScheduleBeacon(beacon time.Duration) ([]byte, *ttnpb.TxSettings, error) {
+	phy, err := band.GetByID(c.bandID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if phy.Beacon.ComputeFrequency == nil {
+		return nil, nil, errNoBeacon.WithAttributes("band_id", c.bandID)
+	}
+	dr, ok := phy.DataRates[phy.Beacon.DataRateIndex]
+	if !ok {
+		return nil, nil, errDataRate.WithAttributes("index", phy.Beacon.DataRateIndex)
+	}
+	layout, err := lorawan.BeaconLayoutFromDataRate(dr.Rate)
+	if err != nil {
+		return nil, nil, err
+	}
+	var loc *ttnpb.Location
+	if len(c.gateway.Antennas) > 0 {
+		loc = c.gateway.Antennas[0].Location
+	}
+	payload := lorawan.AppendBeacon(make([]byte, 0, layout.Length()), layout, beacon, loc)
+
+	frequency := phy.Beacon.ComputeFrequency(float64(beacon / time.Second))
+	t := gpstime.Parse(beacon)
+	settings := &ttnpb.TxSettings{
+		DataRate:      dr.Rate,
+		DataRateIndex: phy.Beacon.DataRateIndex,
+		CodingRate:    phy.Beacon.CodingRate,
+		Frequency:     frequency,
+		Time:          &t,
+		Downlink: &ttnpb.TxSettings_Downlink{
+			TxPower:            c.txPower(&phy, c.gatewayPrimaryFP, frequency, 0),
+			InvertPolarization: phy.Beacon.InvertedPolarity,
+		},
+	}
+	em, err := c.scheduler.ScheduleBeacon(c.ctx, scheduling.Options{
+		PayloadSize: len(payload),
+		TxSettings:  *settings,
+		RTTs:        c.rtts,
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	settings.Timestamp = uint32(time.Duration(em.Starts()) / time.Microsecond)
+	return payload, settings, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestScheduleBeacon(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "foo-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+		Antennas: []ttnpb.GatewayAntenna{
+			{
+				Location: &ttnpb.Location{
+					Latitude:  52.3,
+					Longitude: 4.9,
+				},
+			},
+		},
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Disconnect(nil)
+
+	// Without time synchronization with GPS, there is no next beacon.
+	_, _, ok := conn.NextBeacon(time.Now())
+	a.So(ok, should.BeFalse)
+
+	now := time.Now()
+	err = conn.HandleUp(&ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings: ttnpb.TxSettings{
+			Timestamp: 100,
+			Time:      &now,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: conn.Gateway().GatewayIdentifiers,
+				Timestamp:          100,
+				Time:               &now,
+			},
+		},
+		ReceivedAt: now,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	after := now.Add(time.Second)
+	beacon, at, ok := conn.NextBeacon(after)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(beacon%scheduling.BeaconPeriod, should.Equal, 0)
+	a.So(at.After(after), should.BeTrue)
+	a.So(at.Sub(after), should.BeLessThanOrEqualTo, scheduling.BeaconPeriod)
+
+	payload, settings, err := conn.ScheduleBeacon(beacon)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(payload, should.HaveLength, 17)
+	a.So(settings.DataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+	a.So(settings.Frequency, should.Equal, 869525000)
+	a.So(*settings.Time, should.Equal, gpstime.Parse(beacon))
+	a.So(settings.Downlink.InvertPolarization, should.BeFalse)
+
+	// The beacon cannot be scheduled twice.
+	_, _, err = conn.ScheduleBeacon(beacon)
+	a.So(err, should.NotBeNil)
+}
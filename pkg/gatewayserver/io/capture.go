@@ -0,0 +1,162 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const captureBufferSize = 1 << 10
+
+// Capture is a traffic capture session on a gateway connection.
+// The records of the capture are written to the records channel until the capture context is done.
+// Records are dropped if the records channel is full.
+type Capture struct {
+	id        string
+	startedAt time.Time
+	stopsAt   time.Time
+	recordCh  chan *ttnpb.GatewayCaptureRecord
+	dropped   uint64
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+}
+
+// ID returns the capture identifier.
+func (c *Capture) ID() string { return c.id }
+
+// StartedAt returns the time when the capture started.
+func (c *Capture) StartedAt() time.Time { return c.startedAt }
+
+// StopsAt returns the time when the capture stops.
+func (c *Capture) StopsAt() time.Time { return c.stopsAt }
+
+// Context returns the capture context. The context is done when the capture stops.
+func (c *Capture) Context() context.Context { return c.ctx }
+
+// Records returns the channel of the capture records.
+func (c *Capture) Records() <-chan *ttnpb.GatewayCaptureRecord { return c.recordCh }
+
+// Dropped returns the number of records that were dropped because the records channel was full.
+func (c *Capture) Dropped() uint64 { return atomic.LoadUint64(&c.dropped) }
+
+// Stop stops the capture.
+func (c *Capture) Stop() { c.cancelCtx() }
+
+func (c *Capture) record(rec *ttnpb.GatewayCaptureRecord) {
+	if c.ctx.Err() != nil {
+		return
+	}
+	select {
+	case c.recordCh <- rec:
+	default:
+		atomic.AddUint64(&c.dropped, 1)
+	}
+}
+
+var errCaptureActive = errors.DefineAlreadyExists("capture_active", "capture `{capture_id}` is active")
+
+// StartCapture starts a traffic capture session on the connection with the given identifier and duration.
+// The capture stops when the duration expires, when the connection is closed or when the capture is stopped.
+// Only one capture can be active per connection.
+func (c *Connection) StartCapture(id string, d time.Duration) (*Capture, error) {
+	c.captureMu.Lock()
+	defer c.captureMu.Unlock()
+	if capture, ok := c.ActiveCapture(); ok {
+		return nil, errCaptureActive.WithAttributes("capture_id", capture.id)
+	}
+	startedAt := time.Now()
+	ctx, cancelCtx := context.WithDeadline(c.ctx, startedAt.Add(d))
+	capture := &Capture{
+		id:        id,
+		startedAt: startedAt,
+		stopsAt:   startedAt.Add(d),
+		recordCh:  make(chan *ttnpb.GatewayCaptureRecord, captureBufferSize),
+		ctx:       ctx,
+		cancelCtx: cancelCtx,
+	}
+	c.capture.Store(capture)
+	return capture, nil
+}
+
+// ActiveCapture returns the active traffic capture session, if any.
+func (c *Connection) ActiveCapture() (*Capture, bool) {
+	capture, ok := c.capture.Load().(*Capture)
+	if !ok || capture.ctx.Err() != nil {
+		return nil, false
+	}
+	return capture, true
+}
+
+// CaptureRawUp records the raw bytes received from the gateway by the frontend, if a capture is active.
+func (c *Connection) CaptureRawUp(raw []byte) {
+	if capture, ok := c.ActiveCapture(); ok {
+		capture.record(&ttnpb.GatewayCaptureRecord{
+			Time:  time.Now(),
+			RawUp: append([]byte(nil), raw...),
+		})
+	}
+}
+
+// CaptureRawDown records the raw bytes sent to the gateway by the frontend, if a capture is active.
+func (c *Connection) CaptureRawDown(raw []byte) {
+	if capture, ok := c.ActiveCapture(); ok {
+		capture.record(&ttnpb.GatewayCaptureRecord{
+			Time:    time.Now(),
+			RawDown: append([]byte(nil), raw...),
+		})
+	}
+}
+
+// captureMessage records a copy of the message handled by the connection, if a capture is active.
+func (c *Connection) captureMessage(f func(*ttnpb.GatewayCaptureRecord)) {
+	if capture, ok := c.ActiveCapture(); ok {
+		rec := &ttnpb.GatewayCaptureRecord{
+			Time: time.Now(),
+		}
+		f(rec)
+		capture.record(rec)
+	}
+}
+
+func (c *Connection) captureUp(up *ttnpb.UplinkMessage) {
+	c.captureMessage(func(rec *ttnpb.GatewayCaptureRecord) {
+		rec.UplinkMessage = deepcopy.Copy(up).(*ttnpb.UplinkMessage)
+	})
+}
+
+func (c *Connection) captureStatus(status *ttnpb.GatewayStatus) {
+	c.captureMessage(func(rec *ttnpb.GatewayCaptureRecord) {
+		rec.GatewayStatus = deepcopy.Copy(status).(*ttnpb.GatewayStatus)
+	})
+}
+
+func (c *Connection) captureTxAck(ack *ttnpb.TxAcknowledgment) {
+	c.captureMessage(func(rec *ttnpb.GatewayCaptureRecord) {
+		rec.TxAcknowledgment = deepcopy.Copy(ack).(*ttnpb.TxAcknowledgment)
+	})
+}
+
+func (c *Connection) captureDown(down *ttnpb.DownlinkMessage) {
+	c.captureMessage(func(rec *ttnpb.GatewayCaptureRecord) {
+		rec.DownlinkMessage = deepcopy.Copy(down).(*ttnpb.DownlinkMessage)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCapture(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "foo-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Disconnect(nil)
+
+	// Without an active capture, nothing is recorded.
+	_, ok := conn.ActiveCapture()
+	a.So(ok, should.BeFalse)
+	conn.CaptureRawUp([]byte{0x01})
+
+	capture, err := conn.StartCapture("test-capture", time.Hour)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(capture.ID(), should.Equal, "test-capture")
+	a.So(capture.StopsAt(), should.Equal, capture.StartedAt().Add(time.Hour))
+	active, ok := conn.ActiveCapture()
+	a.So(ok, should.BeTrue)
+	a.So(active, should.Equal, capture)
+
+	_, err = conn.StartCapture("other-capture", time.Hour)
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	raw := []byte{0x02, 0x03}
+	conn.CaptureRawUp(raw)
+	raw[0] = 0xff // The raw bytes are copied.
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings: ttnpb.TxSettings{
+			Timestamp: 100,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: conn.Gateway().GatewayIdentifiers,
+				Timestamp:          100,
+			},
+		},
+		ReceivedAt: time.Now(),
+	}
+	a.So(conn.HandleUp(up), should.BeNil)
+	a.So(conn.HandleStatus(&ttnpb.GatewayStatus{Time: time.Now()}), should.BeNil)
+	a.So(conn.HandleTxAck(&ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_SUCCESS}), should.BeNil)
+	conn.CaptureRawDown([]byte{0x04})
+
+	expectRecord := func() *ttnpb.GatewayCaptureRecord {
+		select {
+		case rec := <-capture.Records():
+			a.So(rec.Time, should.HappenWithin, time.Minute, time.Now())
+			return rec
+		case <-time.After(timeout):
+			t.Fatal("Expected capture record")
+			return nil
+		}
+	}
+	a.So(expectRecord().RawUp, should.Resemble, []byte{0x02, 0x03})
+	upRec := expectRecord().UplinkMessage
+	if a.So(upRec, should.NotBeNil) {
+		a.So(upRec.RawPayload, should.Resemble, up.RawPayload)
+		// The uplink message is recorded as handled by the frontend, before the uplink token is added.
+		a.So(upRec.RxMetadata[0].UplinkToken, should.BeEmpty)
+	}
+	a.So(expectRecord().GatewayStatus, should.NotBeNil)
+	a.So(expectRecord().TxAcknowledgment.GetResult(), should.Equal, ttnpb.TxAcknowledgment_SUCCESS)
+	a.So(expectRecord().RawDown, should.Resemble, []byte{0x04})
+
+	capture.Stop()
+	a.So(capture.Context().Err(), should.NotBeNil)
+	_, ok = conn.ActiveCapture()
+	a.So(ok, should.BeFalse)
+	conn.CaptureRawUp([]byte{0x05})
+	select {
+	case rec := <-capture.Records():
+		t.Fatalf("Unexpected capture record %v", rec)
+	default:
+	}
+	a.So(capture.Dropped(), should.BeZeroValue)
+
+	// A new capture can be started after the previous capture stopped.
+	capture, err = conn.StartCapture("other-capture", time.Hour)
+	if a.So(err, should.BeNil) {
+		capture.Stop()
+	}
+}
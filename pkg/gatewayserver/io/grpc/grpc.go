@@ -74,6 +74,7 @@ func New(server io.Server, opts ...Option) ttnpb.GtwGsServer {
 
 func (*impl) Protocol() string            { return "grpc" }
 func (*impl) SupportsDownlinkClaim() bool { return false }
+func (*impl) SupportsRemoteControl() bool { return false }
 
 var errConnect = errors.Define("connect", "failed to connect gateway `{gateway_uid}`")
 
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,6 +47,8 @@ type Frontend interface {
 	Protocol() string
 	// SupportsDownlinkClaim returns true if the frontend can itself claim downlinks.
 	SupportsDownlinkClaim() bool
+	// SupportsRemoteControl returns true if the frontend can run commands and remote shells on the gateway.
+	SupportsRemoteControl() bool
 }
 
 // Server represents the Gateway Server to gateway frontends.
@@ -60,6 +63,8 @@ type Server interface {
 	Connect(ctx context.Context, frontend Frontend, ids ttnpb.GatewayIdentifiers) (*Connection, error)
 	// GetFrequencyPlans gets the frequency plans by the gateway identifiers.
 	GetFrequencyPlans(ctx context.Context, ids ttnpb.GatewayIdentifiers) (map[string]*frequencyplans.FrequencyPlan, error)
+	// GetUDPAuthentication gets the UDP authentication mode and secret by the gateway identifiers.
+	GetUDPAuthentication(ctx context.Context, ids ttnpb.GatewayIdentifiers) (ttnpb.GatewayUDPAuthenticationMode, []byte, error)
 	// ClaimDownlink claims the downlink path for the given gateway.
 	ClaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error
 	// UnclaimDownlink releases the claim of the downlink path for the given gateway.
@@ -76,7 +81,9 @@ type Server interface {
 type Connection struct {
 	// Align for sync/atomic.
 	uplinks,
-	downlinks uint64
+	downlinks,
+	filteredUplinks,
+	dryRunFilteredUplinks uint64
 	connectTime,
 	lastStatusTime,
 	lastUplinkTime,
@@ -99,6 +106,13 @@ type Connection struct {
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
+	remoteCh chan *RemoteMessage
+
+	shellsMu sync.Mutex
+	shells   map[int]*RemoteShell
+
+	captureMu sync.Mutex
+	capture   atomic.Value
 
 	statsChangedCh chan struct{}
 	locCh          chan struct{}
@@ -172,6 +186,8 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		downCh:           make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:         make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:          make(chan *ttnpb.TxAcknowledgment, bufferSize),
+		remoteCh:         make(chan *RemoteMessage, bufferSize),
+		shells:           make(map[int]*RemoteShell),
 		locCh:            make(chan struct{}, 1),
 		connectTime:      time.Now().UnixNano(),
 
@@ -200,6 +216,7 @@ const consecutiveRepeatUpEventsInterval = time.Minute
 
 // HandleUp updates the uplink stats and sends the message to the upstream channel.
 func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
+	c.captureUp(up)
 	if c.discardRepeatedUplink(up) {
 		return nil
 	}
@@ -264,8 +281,20 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	return nil
 }
 
+// HandleFilteredUp records an uplink message that did not pass the uplink filters of the gateway.
+// In dry-run mode, the uplink message is forwarded anyway.
+func (c *Connection) HandleFilteredUp(dryRun bool) {
+	if dryRun {
+		atomic.AddUint64(&c.dryRunFilteredUplinks, 1)
+	} else {
+		atomic.AddUint64(&c.filteredUplinks, 1)
+	}
+	c.notifyStatsChanged()
+}
+
 // HandleStatus updates the status stats and sends the status to the status channel.
 func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
+	c.captureStatus(status)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -288,6 +317,7 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 
 // HandleTxAck sends the acknowledgment to the status channel.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	c.captureTxAck(ack)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -345,6 +375,7 @@ func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ttnpb.Gateway
 
 // SendDown sends the downlink message directly on the downlink channel.
 func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
+	c.captureDown(msg)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -450,28 +481,15 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 				"data_rate_index", rx.dataRateIndex,
 			)
 		}
-		eirp := phy.DefaultMaxEIRP
-		if sb, ok := phy.FindSubBand(rx.frequency); ok {
-			eirp = sb.MaxEIRP
-		}
-		if fp.MaxEIRP != nil {
-			eirp = *fp.MaxEIRP
-		}
-		if sb, ok := fp.FindSubBand(rx.frequency); ok && sb.MaxEIRP != nil {
-			eirp = *sb.MaxEIRP
-		}
 		settings := ttnpb.TxSettings{
 			DataRate:      dr.Rate,
 			DataRateIndex: rx.dataRateIndex,
 			Frequency:     rx.frequency,
 			Downlink: &ttnpb.TxSettings_Downlink{
-				TxPower:      eirp,
+				TxPower:      c.txPower(&phy, fp, rx.frequency, ids.AntennaIndex),
 				AntennaIndex: ids.AntennaIndex,
 			},
 		}
-		if int(ids.AntennaIndex) < len(c.gateway.Antennas) {
-			settings.Downlink.TxPower -= c.gateway.Antennas[ids.AntennaIndex].Gain
-		}
 		if lora := dr.Rate.GetLoRa(); lora != nil {
 			settings.CodingRate = phy.LoRaCodingRate
 			settings.Downlink.InvertPolarization = true
@@ -555,6 +573,25 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 	return
 }
 
+// txPower returns the transmission power for the given frequency and antenna, which is the maximum EIRP of the band or
+// frequency plan minus the antenna gain.
+func (c *Connection) txPower(phy *band.Band, fp *frequencyplans.FrequencyPlan, frequency uint64, antennaIndex uint32) float32 {
+	eirp := phy.DefaultMaxEIRP
+	if sb, ok := phy.FindSubBand(frequency); ok {
+		eirp = sb.MaxEIRP
+	}
+	if fp.MaxEIRP != nil {
+		eirp = *fp.MaxEIRP
+	}
+	if sb, ok := fp.FindSubBand(frequency); ok && sb.MaxEIRP != nil {
+		eirp = *sb.MaxEIRP
+	}
+	if int(antennaIndex) < len(c.gateway.Antennas) {
+		eirp -= c.gateway.Antennas[antennaIndex].Gain
+	}
+	return eirp
+}
+
 // Status returns the status channel.
 func (c *Connection) Status() <-chan *ttnpb.GatewayStatus {
 	return c.statusCh
@@ -605,6 +642,12 @@ func (c *Connection) UpStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// FilteredUpStats returns the number of uplink messages that were dropped by the uplink filters, and the number of
+// uplink messages that did not pass the uplink filters in dry-run mode.
+func (c *Connection) FilteredUpStats() (filtered, dryRunFiltered uint64) {
+	return atomic.LoadUint64(&c.filteredUplinks), atomic.LoadUint64(&c.dryRunFilteredUplinks)
+}
+
 // DownStats returns the downstream statistics.
 func (c *Connection) DownStats() (total uint64, t time.Time, ok bool) {
 	total = atomic.LoadUint64(&c.downlinks)
@@ -639,6 +682,11 @@ func (c *Connection) Stats() (*ttnpb.GatewayConnectionStats, []string) {
 		stats.UplinkCount = count
 		paths = append(paths, "last_uplink_received_at", "uplink_count")
 	}
+	if filtered, dryRunFiltered := c.FilteredUpStats(); filtered > 0 || dryRunFiltered > 0 {
+		stats.FilteredUplinkCount = filtered
+		stats.DryRunFilteredUplinkCount = dryRunFiltered
+		paths = append(paths, "filtered_uplink_count", "dry_run_filtered_uplink_count")
+	}
 	if count, t, ok := c.DownStats(); ok {
 		stats.LastDownlinkReceivedAt = &t
 		stats.DownlinkCount = count
@@ -28,10 +28,12 @@ type Frontend struct {
 	Status chan *ttnpb.GatewayStatus
 	TxAck  chan *ttnpb.TxAcknowledgment
 	Down   chan *ttnpb.DownlinkMessage
+	Remote chan *io.RemoteMessage
 }
 
 func (*Frontend) Protocol() string            { return "mock" }
 func (*Frontend) SupportsDownlinkClaim() bool { return true }
+func (*Frontend) SupportsRemoteControl() bool { return true }
 
 // ConnectFrontend connects a new mock front-end to the given server.
 // The gateway time starts at Unix epoch.
@@ -41,6 +43,7 @@ func ConnectFrontend(ctx context.Context, ids ttnpb.GatewayIdentifiers, server i
 		Status: make(chan *ttnpb.GatewayStatus, 1),
 		TxAck:  make(chan *ttnpb.TxAcknowledgment, 1),
 		Down:   make(chan *ttnpb.DownlinkMessage, 1),
+		Remote: make(chan *io.RemoteMessage, 1),
 	}
 	conn, err := server.Connect(ctx, f, ids)
 	if err != nil {
@@ -71,6 +74,8 @@ func ConnectFrontend(ctx context.Context, ids ttnpb.GatewayIdentifiers, server i
 				return
 			case down := <-conn.Down():
 				f.Down <- down
+			case msg := <-conn.Remote():
+				f.Remote <- msg
 			}
 		}
 	}()
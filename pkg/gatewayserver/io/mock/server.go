@@ -115,6 +115,15 @@ func (s *server) GetFrequencyPlans(ctx context.Context, ids ttnpb.GatewayIdentif
 	return fps, nil
 }
 
+// GetUDPAuthentication implements io.Server.
+func (s *server) GetUDPAuthentication(ctx context.Context, ids ttnpb.GatewayIdentifiers) (ttnpb.GatewayUDPAuthenticationMode, []byte, error) {
+	gtw, ok := s.gateways[unique.ID(ctx, ids)]
+	if !ok {
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, nil
+	}
+	return gtw.UDPAuthenticationMode, gtw.GetUDPSecret().GetValue(), nil
+}
+
 // ClaimDownlink implements io.Server.
 func (s *server) ClaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error {
 	s.downlinkClaims.Store(unique.ID(ctx, ids), true)
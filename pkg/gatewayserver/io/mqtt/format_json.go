@@ -0,0 +1,87 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+type protojson struct {
+	topics.Layout
+}
+
+func (protojson) FromDownlink(down *ttnpb.DownlinkMessage, _ ttnpb.GatewayIdentifiers) ([]byte, error) {
+	gwDown := &ttnpb.GatewayDown{
+		DownlinkMessage: down,
+	}
+	return jsonpb.TTN().Marshal(gwDown)
+}
+
+func (protojson) ToUplink(message []byte, ids ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	uplink := &ttnpb.UplinkMessage{}
+	if err := jsonpb.TTN().Unmarshal(message, uplink); err != nil {
+		return nil, err
+	}
+	for _, md := range uplink.RxMetadata {
+		if md != nil && md.GatewayIdentifiers.IsZero() {
+			md.GatewayIdentifiers = ids
+		}
+	}
+	return uplink, nil
+}
+
+func (protojson) ToStatus(message []byte, _ ttnpb.GatewayIdentifiers) (*ttnpb.GatewayStatus, error) {
+	status := &ttnpb.GatewayStatus{}
+	if err := jsonpb.TTN().Unmarshal(message, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (protojson) ToTxAck(message []byte, _ ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error) {
+	ack := &ttnpb.TxAcknowledgment{}
+	if err := jsonpb.TTN().Unmarshal(message, ack); err != nil {
+		return nil, err
+	}
+	return ack, nil
+}
+
+// NewJSON returns a format that uses JSON marshaling and unmarshaling of the Protocol Buffers messages, for gateways
+// that cannot use Protocol Buffers. The messages use the JSON encoding of the API, with the field names as defined in
+// the Protocol Buffers definitions. The topics and the messages, documented in api/api.md, are:
+//
+//	json/{gateway-uid}/up        (publish)   ttn.lorawan.v3.UplinkMessage
+//	json/{gateway-uid}/status    (publish)   ttn.lorawan.v3.GatewayStatus
+//	json/{gateway-uid}/down/ack  (publish)   ttn.lorawan.v3.TxAcknowledgment
+//	json/{gateway-uid}/down      (subscribe) ttn.lorawan.v3.GatewayDown
+//
+// Bytes fields are base64 encoded, 64-bit integer fields are strings, timestamps are RFC 3339 strings and durations
+// are strings with the "s" suffix. Enum fields are numbers in downlink messages, and numbers or names in messages
+// published by the gateway. The gateway identifiers of the uplink message metadata default to the identifiers of the
+// connected gateway.
+//
+// Examples of each message are in testdata/json: up.json, status.json, down_ack.json and down.json. The examples are
+// verified against the JSON marshaling of the messages. The Tx settings of a downlink message are in the scheduled
+// field, with the transmission power and polarization in scheduled.downlink. The Tx acknowledgment refers to the
+// correlation IDs of the downlink message.
+func NewJSON(ctx context.Context) Format {
+	return &protojson{
+		Layout: topics.NewJSON(ctx),
+	}
+}
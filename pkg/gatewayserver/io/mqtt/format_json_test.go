@@ -0,0 +1,288 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestJSONUplink(t *testing.T) {
+	a := assertions.New(t)
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: "gateway-id",
+	}
+	format := mqtt.NewJSON(test.Context())
+
+	up, err := format.ToUplink([]byte(`{
+		"raw_payload": "QCkuASaAAAAByFaF53Iu+vzmwQ==",
+		"settings": {
+			"data_rate": {"lora": {"bandwidth": 125000, "spreading_factor": 7}},
+			"coding_rate": "4/5",
+			"frequency": "868100000",
+			"timestamp": 2463457000
+		},
+		"rx_metadata": [{
+			"timestamp": 2463457000,
+			"rssi": -42,
+			"channel_rssi": -42,
+			"snr": 9.5
+		}, {
+			"gateway_ids": {"gateway_id": "other-gateway-id"},
+			"timestamp": 2463457000,
+			"rssi": -100,
+			"snr": -2
+		}]
+	}`), ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.RawPayload, should.Resemble, []byte{
+		0x40, 0x29, 0x2e, 0x01, 0x26, 0x80, 0x00, 0x00, 0x01, 0xc8, 0x56, 0x85, 0xe7, 0x72, 0x2e, 0xfa, 0xfc, 0xe6, 0xc1,
+	})
+	a.So(up.Settings.Frequency, should.Equal, 868100000)
+	a.So(up.Settings.DataRate.GetLoRa(), should.Resemble, &ttnpb.LoRaDataRate{Bandwidth: 125000, SpreadingFactor: 7})
+	if a.So(up.RxMetadata, should.HaveLength, 2) {
+		a.So(up.RxMetadata[0].GatewayIdentifiers, should.Resemble, ids)
+		a.So(up.RxMetadata[0].SNR, should.Equal, 9.5)
+		a.So(up.RxMetadata[1].GatewayIdentifiers.GatewayId, should.Equal, "other-gateway-id")
+	}
+
+	_, err = format.ToUplink([]byte(`{"raw_payload": 42}`), ids)
+	a.So(err, should.NotBeNil)
+}
+
+func TestJSONStatus(t *testing.T) {
+	a := assertions.New(t)
+	format := mqtt.NewJSON(test.Context())
+
+	status, err := format.ToStatus([]byte(`{
+		"time": "2021-06-01T12:00:00Z",
+		"versions": {"firmware": "1.0.0"},
+		"ip": ["192.168.1.2"],
+		"metrics": {"rxok": 10}
+	}`), ttnpb.GatewayIdentifiers{GatewayId: "gateway-id"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(status.Time.Unix(), should.Equal, 1622548800)
+	a.So(status.Versions, should.Resemble, map[string]string{"firmware": "1.0.0"})
+	a.So(status.IP, should.Resemble, []string{"192.168.1.2"})
+	a.So(status.Metrics, should.Resemble, map[string]float32{"rxok": 10})
+}
+
+func TestJSONTxAck(t *testing.T) {
+	a := assertions.New(t)
+	format := mqtt.NewJSON(test.Context())
+
+	for _, msg := range []string{
+		`{"correlation_ids": ["gs:uplink:test"], "result": "TOO_LATE"}`,
+		`{"correlation_ids": ["gs:uplink:test"], "result": 2}`,
+	} {
+		ack, err := format.ToTxAck([]byte(msg), ttnpb.GatewayIdentifiers{GatewayId: "gateway-id"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ack.CorrelationIDs, should.Resemble, []string{"gs:uplink:test"})
+		a.So(ack.Result, should.Equal, ttnpb.TxAcknowledgment_TOO_LATE)
+	}
+}
+
+func TestJSONDownlink(t *testing.T) {
+	a := assertions.New(t)
+	format := mqtt.NewJSON(test.Context())
+
+	down := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x70, 0x61, 0x61, 0x4a, 0x00, 0x02, 0x00, 0x01},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 12,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower: 27,
+				},
+				Timestamp: 12000,
+			},
+		},
+		CorrelationIDs: []string{"gs:downlink:test"},
+	}
+	buf, err := format.FromDownlink(down, ttnpb.GatewayIdentifiers{GatewayId: "gateway-id"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	gwDown := &ttnpb.GatewayDown{}
+	if !a.So(jsonpb.TTN().Unmarshal(buf, gwDown), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(gwDown.DownlinkMessage, should.Resemble, down)
+}
+
+// jsonContains returns whether actual contains all values of expected.
+func jsonContains(expected, actual interface{}) bool {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range expected {
+			if !jsonContains(v, actual[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(actual) != len(expected) {
+			return false
+		}
+		for i, v := range expected {
+			if !jsonContains(v, actual[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// TestJSONExamples verifies the examples in testdata/json against the JSON marshaling of the messages.
+func TestJSONExamples(t *testing.T) {
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: "gateway-id",
+	}
+	format := mqtt.NewJSON(test.Context())
+
+	readExample := func(t *testing.T, name string) ([]byte, interface{}) {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", "json", name))
+		if err != nil {
+			t.Fatalf("Failed to read example: %s", err)
+		}
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatalf("Failed to unmarshal example: %s", err)
+		}
+		return b, v
+	}
+
+	// Messages published by the gateway are marshaled with enum names and default values, so that the examples may
+	// use enum names and leave out the fields that are set by the Gateway Server.
+	marshaler := &jsonpb.GoGoJSONPb{
+		OrigName:     true,
+		EmitDefaults: true,
+	}
+	for _, tc := range []struct {
+		Name    string
+		Example string
+		Parse   func([]byte) (interface{}, error)
+	}{
+		{
+			Name:    "Uplink",
+			Example: "up.json",
+			Parse: func(b []byte) (interface{}, error) {
+				return format.ToUplink(b, ids)
+			},
+		},
+		{
+			Name:    "Status",
+			Example: "status.json",
+			Parse: func(b []byte) (interface{}, error) {
+				return format.ToStatus(b, ids)
+			},
+		},
+		{
+			Name:    "TxAck",
+			Example: "down_ack.json",
+			Parse: func(b []byte) (interface{}, error) {
+				return format.ToTxAck(b, ids)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			b, expected := readExample(t, tc.Example)
+			msg, err := tc.Parse(b)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			buf, err := marshaler.Marshal(msg)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var actual interface{}
+			if !a.So(json.Unmarshal(buf, &actual), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(jsonContains(expected, actual), should.BeTrue)
+		})
+	}
+
+	t.Run("Downlink", func(t *testing.T) {
+		a := assertions.New(t)
+		_, expected := readExample(t, "down.json")
+		buf, err := format.FromDownlink(&ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x60, 0x29, 0x2e, 0x01, 0x26, 0x00, 0x01, 0x00, 0x01, 0xf1, 0x29},
+			Settings: &ttnpb.DownlinkMessage_Scheduled{
+				Scheduled: &ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								Bandwidth:       125000,
+								SpreadingFactor: 7,
+							},
+						},
+					},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+					Timestamp:  2468457000,
+					Downlink: &ttnpb.TxSettings_Downlink{
+						TxPower:            16.15,
+						InvertPolarization: true,
+					},
+				},
+			},
+			CorrelationIDs: []string{"gs:uplink:01F8ZQ9X5S0000000000000000"},
+		}, ids)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var actual interface{}
+		if !a.So(json.Unmarshal(buf, &actual), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(actual, should.Resemble, expected)
+	})
+}
@@ -113,6 +113,7 @@ type connection struct {
 
 func (*connection) Protocol() string            { return "mqtt" }
 func (*connection) SupportsDownlinkClaim() bool { return false }
+func (*connection) SupportsRemoteControl() bool { return false }
 
 func (c *connection) setup(ctx context.Context) (err error) {
 	ctx = auth.NewContextWithInterface(ctx, c)
@@ -173,6 +174,7 @@ func (c *connection) setup(ctx context.Context) (err error) {
 					continue
 				}
 				logger.Info("Publish downlink message")
+				c.io.CaptureRawDown(buf)
 				topicParts := c.format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GatewayIdentifiers))
 				c.session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
@@ -317,6 +319,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		c.io.Disconnect(err)
 		return
 	}
+	c.io.CaptureRawUp(pkt.Message)
 
 	switch {
 	case c.format.IsBirthTopic(pkt.TopicParts):
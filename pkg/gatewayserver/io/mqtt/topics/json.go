@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+)
+
+const topicJSON = "json"
+
+type jsonLayout struct{}
+
+func (j *jsonLayout) BirthTopic(uid string) []string {
+	return nil
+}
+
+func (j *jsonLayout) IsBirthTopic(path []string) bool {
+	return false
+}
+
+func (j *jsonLayout) LastWillTopic(uid string) []string {
+	return nil
+}
+
+func (j *jsonLayout) IsLastWillTopic(path []string) bool {
+	return false
+}
+
+func (j *jsonLayout) UplinkTopic(uid string) []string {
+	return j.createTopic(uid, []string{"up"})
+}
+
+func (j *jsonLayout) IsUplinkTopic(path []string) bool {
+	return len(path) == 3 && path[0] == topicJSON && path[2] == "up"
+}
+
+func (j *jsonLayout) StatusTopic(uid string) []string {
+	return j.createTopic(uid, []string{"status"})
+}
+
+func (j *jsonLayout) IsStatusTopic(path []string) bool {
+	return len(path) == 3 && path[0] == topicJSON && path[2] == "status"
+}
+
+func (j *jsonLayout) TxAckTopic(uid string) []string {
+	return j.createTopic(uid, []string{"down", "ack"})
+}
+
+func (j *jsonLayout) IsTxAckTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicJSON && path[2] == "down" && path[3] == "ack"
+}
+
+func (j *jsonLayout) DownlinkTopic(uid string) []string {
+	return j.createTopic(uid, []string{"down"})
+}
+
+func (j *jsonLayout) createTopic(uid string, path []string) []string {
+	inTopicIdentifier := uid
+	return append([]string{topicJSON, inTopicIdentifier}, path...)
+}
+
+// NewJSON returns the topic layout of the JSON format.
+// The topics are json/{gateway-uid}/up, json/{gateway-uid}/status, json/{gateway-uid}/down and
+// json/{gateway-uid}/down/ack.
+func NewJSON(ctx context.Context) Layout {
+	return &jsonLayout{}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestJSONTopics(t *testing.T) {
+	ctx := test.Context()
+	layout := topics.NewJSON(ctx)
+	uid := unique.ID(ctx, ttnpb.GatewayIdentifiers{GatewayId: gatewayID})
+	for _, tc := range []struct {
+		UID      string
+		Func     func(string) []string
+		Expected []string
+		Is       func([]string) bool
+		IsNot    []func([]string) bool
+	}{
+		{
+			UID:      uid,
+			Func:     layout.UplinkTopic,
+			Expected: []string{"json", uid, "up"},
+			Is:       layout.IsUplinkTopic,
+			IsNot:    []func([]string) bool{layout.IsStatusTopic, layout.IsTxAckTopic},
+		},
+		{
+			UID:      uid,
+			Func:     layout.StatusTopic,
+			Expected: []string{"json", uid, "status"},
+			Is:       layout.IsStatusTopic,
+			IsNot:    []func([]string) bool{layout.IsUplinkTopic, layout.IsTxAckTopic},
+		},
+		{
+			UID:      uid,
+			Func:     layout.TxAckTopic,
+			Expected: []string{"json", uid, "down", "ack"},
+			Is:       layout.IsTxAckTopic,
+			IsNot:    []func([]string) bool{layout.IsUplinkTopic, layout.IsStatusTopic},
+		},
+	} {
+		t.Run(topic.Join(tc.Expected), func(t *testing.T) {
+			a := assertions.New(t)
+			actual := tc.Func(tc.UID)
+			a.So(actual, should.Resemble, tc.Expected)
+			a.So(tc.Is(actual), should.BeTrue)
+			for _, isNot := range tc.IsNot {
+				a.So(isNot(actual), should.BeFalse)
+			}
+		})
+	}
+
+	// Topics of the default layout are not topics of the JSON layout.
+	v3 := topics.New(ctx)
+	a := assertions.New(t)
+	a.So(layout.IsUplinkTopic(v3.UplinkTopic(uid)), should.BeFalse)
+	a.So(layout.IsStatusTopic(v3.StatusTopic(uid)), should.BeFalse)
+	a.So(layout.IsTxAckTopic(v3.TxAckTopic(uid)), should.BeFalse)
+}
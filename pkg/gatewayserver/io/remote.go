@@ -0,0 +1,209 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// maxRemoteShells is the maximum number of concurrent remote shell sessions per gateway connection.
+	maxRemoteShells = 4
+
+	remoteShellOutputBufferSize = 1 << 6
+)
+
+// RemoteCommand is a command to run on the gateway.
+type RemoteCommand struct {
+	Command   string
+	Arguments []string
+}
+
+// RemoteMessage is a remote control message for the gateway.
+// Either Command or Shell is set.
+type RemoteMessage struct {
+	// Command is the command to run.
+	Command *RemoteCommand
+	// Shell is the remote shell session that the message is for.
+	// If ShellStart is set, the session must be started.
+	// If ShellStop is set, the session must be stopped.
+	// Otherwise, ShellInput must be written to the session.
+	Shell      *RemoteShell
+	ShellStart bool
+	ShellStop  bool
+	ShellInput []byte
+}
+
+// RemoteShell is a remote shell session on the gateway.
+type RemoteShell struct {
+	conn     *Connection
+	index    int
+	user     string
+	term     string
+	outputCh chan []byte
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+	closeOnce sync.Once
+}
+
+// Index returns the index of the session.
+func (s *RemoteShell) Index() int { return s.index }
+
+// User returns the user that runs the shell.
+func (s *RemoteShell) User() string { return s.user }
+
+// Term returns the terminal type of the shell.
+func (s *RemoteShell) Term() string { return s.term }
+
+// Context returns the session context. The context is done when the session is closed.
+func (s *RemoteShell) Context() context.Context { return s.ctx }
+
+// Output returns the channel of the shell output.
+func (s *RemoteShell) Output() <-chan []byte { return s.outputCh }
+
+// Write writes the given input to the shell.
+func (s *RemoteShell) Write(input []byte) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	return s.conn.sendRemote(&RemoteMessage{
+		Shell:      s,
+		ShellInput: input,
+	})
+}
+
+// Close stops the session on the gateway and releases the session index.
+func (s *RemoteShell) Close() {
+	s.closeOnce.Do(func() {
+		s.cancelCtx()
+		s.conn.shellsMu.Lock()
+		delete(s.conn.shells, s.index)
+		s.conn.shellsMu.Unlock()
+		s.conn.sendRemote(&RemoteMessage{
+			Shell:     s,
+			ShellStop: true,
+		})
+	})
+}
+
+var (
+	errRemoteControlNotSupported = errors.DefineFailedPrecondition(
+		"remote_control_not_supported",
+		"remote control is not supported by the `{protocol}` frontend",
+	)
+	errTooManyRemoteShells = errors.DefineResourceExhausted(
+		"too_many_remote_shells",
+		"too many remote shell sessions",
+	)
+	errRemoteShellNotFound = errors.DefineNotFound(
+		"remote_shell_not_found",
+		"remote shell session `{index}` not found",
+	)
+)
+
+func (c *Connection) sendRemote(msg *RemoteMessage) error {
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.remoteCh <- msg:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// RunCommand sends the command to run to the gateway.
+func (c *Connection) RunCommand(command string, arguments ...string) error {
+	if !c.frontend.SupportsRemoteControl() {
+		return errRemoteControlNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	return c.sendRemote(&RemoteMessage{
+		Command: &RemoteCommand{
+			Command:   command,
+			Arguments: arguments,
+		},
+	})
+}
+
+// OpenRemoteShell starts a remote shell session on the gateway.
+// The user and terminal type are optional. The caller must close the session.
+func (c *Connection) OpenRemoteShell(user, term string) (*RemoteShell, error) {
+	if !c.frontend.SupportsRemoteControl() {
+		return nil, errRemoteControlNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	c.shellsMu.Lock()
+	index := -1
+	for i := 0; i < maxRemoteShells; i++ {
+		if _, ok := c.shells[i]; !ok {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		c.shellsMu.Unlock()
+		return nil, errTooManyRemoteShells.New()
+	}
+	ctx, cancelCtx := context.WithCancel(c.ctx)
+	s := &RemoteShell{
+		conn:      c,
+		index:     index,
+		user:      user,
+		term:      term,
+		outputCh:  make(chan []byte, remoteShellOutputBufferSize),
+		ctx:       ctx,
+		cancelCtx: cancelCtx,
+	}
+	c.shells[index] = s
+	c.shellsMu.Unlock()
+
+	if err := c.sendRemote(&RemoteMessage{
+		Shell:      s,
+		ShellStart: true,
+	}); err != nil {
+		cancelCtx()
+		c.shellsMu.Lock()
+		delete(c.shells, index)
+		c.shellsMu.Unlock()
+		return nil, err
+	}
+	return s, nil
+}
+
+// HandleRemoteShellOutput sends the output of the remote shell session with the given index to the session.
+func (c *Connection) HandleRemoteShellOutput(index int, output []byte) error {
+	c.shellsMu.Lock()
+	s, ok := c.shells[index]
+	c.shellsMu.Unlock()
+	if !ok {
+		return errRemoteShellNotFound.WithAttributes("index", index)
+	}
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.outputCh <- output:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// Remote returns the remote control channel.
+func (c *Connection) Remote() <-chan *RemoteMessage {
+	return c.remoteCh
+}
@@ -0,0 +1,127 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRemoteControl(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayId: "foo-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	})
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	expectRemote := func() *io.RemoteMessage {
+		select {
+		case msg := <-frontend.Remote:
+			return msg
+		case <-time.After(timeout):
+			t.Fatal("Expected remote control message")
+			return nil
+		}
+	}
+
+	// Run a command.
+	a.So(conn.RunCommand("reboot", "now"), should.BeNil)
+	msg := expectRemote()
+	a.So(msg.Command, should.Resemble, &io.RemoteCommand{
+		Command:   "reboot",
+		Arguments: []string{"now"},
+	})
+
+	// Open remote shell sessions until no session index is available.
+	shells := make([]*io.RemoteShell, 0, 4)
+	for i := 0; ; i++ {
+		shell, err := conn.OpenRemoteShell("root", "xterm")
+		if err != nil {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			break
+		}
+		a.So(shell.Index(), should.Equal, i)
+		msg := expectRemote()
+		a.So(msg.Shell, should.Equal, shell)
+		a.So(msg.ShellStart, should.BeTrue)
+		shells = append(shells, shell)
+	}
+	if !a.So(shells, should.NotBeEmpty) {
+		t.FailNow()
+	}
+
+	// Write input and receive output.
+	shell := shells[0]
+	a.So(shell.Write([]byte("uptime\n")), should.BeNil)
+	msg = expectRemote()
+	a.So(msg.Shell, should.Equal, shell)
+	a.So(msg.ShellInput, should.Resemble, []byte("uptime\n"))
+
+	a.So(conn.HandleRemoteShellOutput(shell.Index(), []byte("up 42 days")), should.BeNil)
+	select {
+	case output := <-shell.Output():
+		a.So(output, should.Resemble, []byte("up 42 days"))
+	case <-time.After(timeout):
+		t.Fatal("Expected remote shell output")
+	}
+
+	// Close the session; the session index becomes available again.
+	shell.Close()
+	msg = expectRemote()
+	a.So(msg.Shell, should.Equal, shell)
+	a.So(msg.ShellStop, should.BeTrue)
+	a.So(shell.Context().Err(), should.NotBeNil)
+	a.So(errors.IsNotFound(conn.HandleRemoteShellOutput(shell.Index(), []byte("up 42 days"))), should.BeTrue)
+
+	reopened, err := conn.OpenRemoteShell("", "")
+	if a.So(err, should.BeNil) {
+		a.So(reopened.Index(), should.Equal, shell.Index())
+		expectRemote()
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+)
+
+const (
+	// beaconScheduleAhead is the time in advance to the beacon time to schedule the beacon and send it to the gateway.
+	beaconScheduleAhead = 2 * time.Second
+	// beaconSyncRetryInterval is the interval to check whether the gateway is time synchronized with GPS.
+	beaconSyncRetryInterval = 10 * time.Second
+	// beaconPreamble is the preamble length of the beacon (symbols).
+	beaconPreamble = 10
+)
+
+// nextBeacon returns the next beacon time as duration since GPS epoch, and the duration to wait before writing the
+// beacon to the gateway.
+// This method returns false if the gateway is not time synchronized with GPS.
+func (s *srv) nextBeacon(state *state) (time.Duration, time.Duration, bool) {
+	beacon, at, ok := state.io.NextBeacon(time.Now().Add(beaconScheduleAhead))
+	if !ok {
+		return 0, 0, false
+	}
+	return beacon, time.Until(at.Add(-beaconScheduleAhead)), true
+}
+
+// writeBeacon schedules the beacon at the given beacon time and writes it to the gateway.
+func (s *srv) writeBeacon(ctx context.Context, state *state, beacon time.Duration) {
+	logger := log.FromContext(ctx).WithField("beacon_time", beacon)
+	payload, settings, err := state.io.ScheduleBeacon(beacon)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to schedule beacon")
+		return
+	}
+	tx, err := encoding.FromDownlinkMessage(&ttnpb.DownlinkMessage{
+		RawPayload: payload,
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: settings,
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal beacon")
+		return
+	}
+	// Beacons are transmitted in implicit header mode without CRC, as the beacon frame contains its own CRCs.
+	tx.NHdr = true
+	tx.NCRC = true
+	tx.Prea = beaconPreamble
+	downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
+	// The token is not associated with a downlink message, so that the Tx acknowledgment is not correlated.
+	token := state.tokens.Next(nil, time.Now())
+	packet := encoding.Packet{
+		GatewayAddr:     &downlinkPath.addr,
+		ProtocolVersion: downlinkPath.version,
+		PacketType:      encoding.PullResp,
+		Token:           [2]byte{byte(token >> 8), byte(token)},
+		Data: &encoding.Data{
+			TxPacket: tx,
+		},
+	}
+	if err := s.write(state.io, packet); err != nil {
+		logger.WithError(err).Warn("Failed to write beacon")
+		return
+	}
+	logger.Debug("Wrote beacon")
+}
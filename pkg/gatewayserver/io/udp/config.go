@@ -24,6 +24,15 @@ type RateLimitingConfig struct {
 	Threshold time.Duration `name:"threshold" description:"Filter packet if timestamp is not newer than the older timestamps of the previous messages by this threshold"`
 }
 
+// AuthenticationConfig contains configuration settings for the packet authentication
+// capabilities of the UDP gateway frontend firewall.
+type AuthenticationConfig struct {
+	Enable   bool          `name:"enable" description:"Enable authentication of packets of gateways with UDP authentication"`
+	CacheTTL time.Duration `name:"cache-ttl" description:"Time to cache the UDP authentication mode and secret of gateways"`
+	// TimestampWindow is the maximum difference between the authentication timestamp of packets and the server time.
+	TimestampWindow time.Duration `name:"timestamp-window" description:"Maximum difference between the authentication timestamp of packets and the server time"`
+}
+
 // Config contains configuration settings for the UDP gateway frontend.
 // Use DefaultConfig for recommended settings.
 type Config struct {
@@ -49,6 +58,11 @@ type Config struct {
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
 	RateLimiting RateLimitingConfig `name:"rate-limiting"`
+	// Authentication is the configuration for the authentication firewall capabilities.
+	Authentication AuthenticationConfig `name:"authentication"`
+	// TransmitBeacons defines whether the Gateway Server transmits Class B beacons via gateways that are time
+	// synchronized with GPS.
+	TransmitBeacons bool `name:"transmit-beacons" description:"Transmit Class B beacons via gateways that are time synchronized with GPS"`
 }
 
 // DefaultConfig contains the default configuration.
@@ -65,4 +79,8 @@ var DefaultConfig = Config{
 		Messages:  10,
 		Threshold: 10 * time.Millisecond,
 	},
+	Authentication: AuthenticationConfig{
+		CacheTTL:        1 * time.Minute,
+		TimestampWindow: 1 * time.Minute,
+	},
 }
@@ -0,0 +1,202 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// AuthenticationKeys provides the UDP authentication settings of gateways.
+type AuthenticationKeys interface {
+	// GetUDPAuthentication returns the UDP authentication mode and secret of the gateway with the given EUI.
+	GetUDPAuthentication(ctx context.Context, eui types.EUI64) (ttnpb.GatewayUDPAuthenticationMode, []byte, error)
+}
+
+type authenticationEntry struct {
+	mode    ttnpb.GatewayUDPAuthenticationMode
+	key     []byte
+	expires time.Time
+}
+
+// lastTimestamp is the last accepted authentication timestamp of a gateway.
+type lastTimestamp struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+type authenticatingFirewall struct {
+	ctx context.Context
+	f   Firewall
+
+	keys            AuthenticationKeys
+	cacheTTL        time.Duration
+	timestampWindow time.Duration
+	m               sync.Map // types.EUI64 to authenticationEntry
+	timestamps      sync.Map // types.EUI64 to *lastTimestamp
+}
+
+// NewAuthenticatingFirewall returns a Firewall that verifies the authentication tag of packets, depending on the UDP
+// authentication mode of the gateway. The UDP authentication settings of gateways are cached for the given duration.
+// Authenticated packets are rejected if their authentication timestamp differs more than the given window from the
+// current time, or if it is not after the authentication timestamp of the last accepted packet of the gateway.
+func NewAuthenticatingFirewall(ctx context.Context, firewall Firewall, keys AuthenticationKeys, cacheTTL, timestampWindow time.Duration) Firewall {
+	f := &authenticatingFirewall{
+		ctx:             ctx,
+		f:               firewall,
+		keys:            keys,
+		cacheTTL:        cacheTTL,
+		timestampWindow: timestampWindow,
+	}
+	gcInterval := cacheTTL
+	if timestampWindow > 0 && (gcInterval <= 0 || timestampWindow < gcInterval) {
+		gcInterval = timestampWindow
+	}
+	if gcInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(gcInterval)
+			for {
+				select {
+				case <-ctx.Done():
+					ticker.Stop()
+					return
+				case <-ticker.C:
+					f.gc()
+				}
+			}
+		}()
+	}
+	return f
+}
+
+var (
+	errNoUDPSecret              = errors.DefineFailedPrecondition("no_udp_secret", "gateway has no UDP secret")
+	errNoAuthenticationTag      = errors.DefineUnauthenticated("no_authentication_tag", "packet has no authentication tag")
+	errInvalidAuthenticationTag = errors.DefineUnauthenticated("invalid_authentication_tag", "invalid authentication tag")
+	errAuthenticationTimestamp  = errors.DefineUnauthenticated("authentication_timestamp", "authentication timestamp `{timestamp}` is not within the accepted window")
+	errReplayedPacket           = errors.DefineUnauthenticated("replayed_packet", "authentication timestamp `{timestamp}` is not after the last accepted timestamp `{last_timestamp}`")
+)
+
+func (f *authenticatingFirewall) get(eui types.EUI64) (authenticationEntry, error) {
+	now := time.Now()
+	if val, ok := f.m.Load(eui); ok {
+		if entry := val.(authenticationEntry); entry.expires.After(now) {
+			return entry, nil
+		}
+	}
+	mode, key, err := f.keys.GetUDPAuthentication(f.ctx, eui)
+	if err != nil {
+		return authenticationEntry{}, err
+	}
+	entry := authenticationEntry{
+		mode:    mode,
+		key:     key,
+		expires: now.Add(f.cacheTTL),
+	}
+	f.m.Store(eui, entry)
+	return entry, nil
+}
+
+func (f *authenticatingFirewall) verify(packet encoding.Packet) error {
+	entry, err := f.get(*packet.GatewayEUI)
+	if err != nil {
+		return err
+	}
+	switch entry.mode {
+	case ttnpb.UDP_AUTHENTICATION_DISABLED:
+		return nil
+	case ttnpb.UDP_AUTHENTICATION_OPTIONAL:
+		if packet.AuthenticationTag == nil {
+			registerUnauthenticatedPacket(f.ctx)
+			return nil
+		}
+	case ttnpb.UDP_AUTHENTICATION_REQUIRED:
+		if packet.AuthenticationTag == nil {
+			return errNoAuthenticationTag.New()
+		}
+	}
+	if len(entry.key) == 0 {
+		return errNoUDPSecret.New()
+	}
+	if !packet.VerifyAuthenticationTag(entry.key) {
+		return errInvalidAuthenticationTag.New()
+	}
+	return f.verifyTimestamp(*packet.GatewayEUI, packet.AuthenticationTimestamp, time.Now())
+}
+
+// verifyTimestamp verifies that the authentication timestamp is within the window of the current time and after the
+// last accepted authentication timestamp of the gateway. If so, the authentication timestamp becomes the last accepted
+// authentication timestamp.
+func (f *authenticatingFirewall) verifyTimestamp(eui types.EUI64, t, now time.Time) error {
+	if d := now.Sub(t); d > f.timestampWindow || d < -f.timestampWindow {
+		return errAuthenticationTimestamp.WithAttributes("timestamp", t)
+	}
+	val, _ := f.timestamps.LoadOrStore(eui, &lastTimestamp{})
+	last := val.(*lastTimestamp)
+	last.mu.Lock()
+	defer last.mu.Unlock()
+	if !t.After(last.t) {
+		return errReplayedPacket.WithAttributes(
+			"timestamp", t,
+			"last_timestamp", last.t,
+		)
+	}
+	last.t = t
+	return nil
+}
+
+func (f *authenticatingFirewall) Filter(packet encoding.Packet) error {
+	if packet.GatewayEUI == nil {
+		return errNoEUI.New()
+	}
+	if err := f.verify(packet); err != nil {
+		registerRejectPacket(f.ctx, err)
+		return err
+	}
+
+	// Continue filtering
+	if f.f != nil {
+		return f.f.Filter(packet)
+	}
+	return nil
+}
+
+func (f *authenticatingFirewall) gc() {
+	now := time.Now()
+	f.m.Range(func(k, val interface{}) bool {
+		if val.(authenticationEntry).expires.Before(now) {
+			f.m.Delete(k)
+		}
+		return true
+	})
+	// Packets with timestamps before the window are rejected, so the last accepted timestamps before the window are no
+	// longer needed to detect replayed packets.
+	f.timestamps.Range(func(k, val interface{}) bool {
+		last := val.(*lastTimestamp)
+		last.mu.Lock()
+		expired := now.Sub(last.t) > f.timestampWindow
+		last.mu.Unlock()
+		if expired {
+			f.timestamps.Delete(k)
+		}
+		return true
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockAuthenticationKeys map[types.EUI64]struct {
+	mode ttnpb.GatewayUDPAuthenticationMode
+	key  []byte
+}
+
+func (m mockAuthenticationKeys) GetUDPAuthentication(_ context.Context, eui types.EUI64) (ttnpb.GatewayUDPAuthenticationMode, []byte, error) {
+	entry, ok := m[eui]
+	if !ok {
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, nil
+	}
+	return entry.mode, entry.key, nil
+}
+
+func TestAuthenticatingFirewall(t *testing.T) {
+	ctx := test.Context()
+
+	disabledEUI := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	optionalEUI := types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	requiredEUI := types.EUI64{0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03}
+	noSecretEUI := types.EUI64{0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04}
+	key := []byte("my very secret key")
+	keys := mockAuthenticationKeys{
+		optionalEUI: {mode: ttnpb.UDP_AUTHENTICATION_OPTIONAL, key: key},
+		requiredEUI: {mode: ttnpb.UDP_AUTHENTICATION_REQUIRED, key: key},
+		noSecretEUI: {mode: ttnpb.UDP_AUTHENTICATION_REQUIRED},
+	}
+	v := NewAuthenticatingFirewall(ctx, nil, keys, test.Delay, time.Minute)
+
+	addr := &net.UDPAddr{
+		IP:   []byte{0x01, 0x01, 0x01, 0x01},
+		Port: 1,
+	}
+	packetAt := func(t *testing.T, eui types.EUI64, key []byte, timestamp time.Time) encoding.Packet {
+		b, err := encoding.Packet{
+			ProtocolVersion: encoding.Version2,
+			Token:           [2]byte{0x01, 0x02},
+			PacketType:      encoding.PullData,
+			GatewayEUI:      &eui,
+		}.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Failed to marshal packet: %v", err)
+		}
+		if key != nil {
+			b = encoding.Authenticate(b, key, timestamp)
+		}
+		p := encoding.Packet{GatewayAddr: addr}
+		if err := p.UnmarshalBinary(b); err != nil {
+			t.Fatalf("Failed to unmarshal packet: %v", err)
+		}
+		return p
+	}
+	packet := func(t *testing.T, eui types.EUI64, key []byte) encoding.Packet {
+		return packetAt(t, eui, key, time.Now())
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		EUI        types.EUI64
+		Key        []byte
+		ErrorCheck func(error) bool
+	}{
+		{
+			Name:       "Disabled/Unauthenticated",
+			EUI:        disabledEUI,
+			ErrorCheck: isNoError,
+		},
+		{
+			Name:       "Disabled/Authenticated",
+			EUI:        disabledEUI,
+			Key:        []byte("my other secret key"),
+			ErrorCheck: isNoError,
+		},
+		{
+			Name:       "Optional/Unauthenticated",
+			EUI:        optionalEUI,
+			ErrorCheck: isNoError,
+		},
+		{
+			Name:       "Optional/Authenticated",
+			EUI:        optionalEUI,
+			Key:        key,
+			ErrorCheck: isNoError,
+		},
+		{
+			Name:       "Optional/InvalidKey",
+			EUI:        optionalEUI,
+			Key:        []byte("my other secret key"),
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "Required/Unauthenticated",
+			EUI:        requiredEUI,
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "Required/Authenticated",
+			EUI:        requiredEUI,
+			Key:        key,
+			ErrorCheck: isNoError,
+		},
+		{
+			Name:       "Required/InvalidKey",
+			EUI:        requiredEUI,
+			Key:        []byte("my other secret key"),
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "Required/NoSecret",
+			EUI:        noSecretEUI,
+			Key:        key,
+			ErrorCheck: errors.IsFailedPrecondition,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := v.Filter(packet(t, tc.EUI, tc.Key))
+			a.So(tc.ErrorCheck(err), should.BeTrue)
+		})
+	}
+
+	t.Run("Replay", func(t *testing.T) {
+		a := assertions.New(t)
+		p := packetAt(t, requiredEUI, key, time.Now().Add(time.Second))
+		a.So(v.Filter(p), should.BeNil)
+		a.So(errors.IsUnauthenticated(v.Filter(p)), should.BeTrue)
+
+		// Packets with older timestamps are rejected, packets with newer timestamps are accepted.
+		a.So(errors.IsUnauthenticated(v.Filter(packetAt(t, requiredEUI, key, p.AuthenticationTimestamp.Add(-time.Millisecond)))), should.BeTrue)
+		a.So(v.Filter(packetAt(t, requiredEUI, key, p.AuthenticationTimestamp.Add(time.Millisecond))), should.BeNil)
+	})
+
+	t.Run("StaleTimestamp", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(errors.IsUnauthenticated(v.Filter(packetAt(t, optionalEUI, key, time.Now().Add(-2*time.Minute)))), should.BeTrue)
+		a.So(errors.IsUnauthenticated(v.Filter(packetAt(t, optionalEUI, key, time.Now().Add(2*time.Minute)))), should.BeTrue)
+	})
+
+	t.Run("NoEUI", func(t *testing.T) {
+		a := assertions.New(t)
+		err := v.Filter(encoding.Packet{GatewayAddr: addr, PacketType: encoding.PullData})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const unknown = "unknown"
+
+type authenticationMetrics struct {
+	rejectedPackets        *metrics.ContextualCounterVec
+	unauthenticatedPackets *metrics.ContextualCounterVec
+}
+
+// Describe implements prometheus.Collector.
+func (m *authenticationMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.rejectedPackets.Describe(ch)
+	m.unauthenticatedPackets.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *authenticationMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.rejectedPackets.Collect(ch)
+	m.unauthenticatedPackets.Collect(ch)
+}
+
+var udpMetrics = &authenticationMetrics{
+	rejectedPackets: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "gs",
+			Name:      "udp_packet_rejected_total",
+			Help:      "Total number of UDP packets rejected by authentication",
+		},
+		[]string{"error"},
+	),
+	unauthenticatedPackets: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "gs",
+			Name:      "udp_packet_unauthenticated_total",
+			Help:      "Total number of UDP packets without authentication tag accepted from gateways with optional UDP authentication",
+		},
+		[]string{},
+	),
+}
+
+func registerRejectPacket(ctx context.Context, err error) {
+	errName := unknown
+	if ttnErr, ok := errors.From(err); ok {
+		errName = ttnErr.FullName()
+	}
+	udpMetrics.rejectedPackets.WithLabelValues(ctx, errName).Inc()
+}
+
+func registerUnauthenticatedPacket(ctx context.Context) {
+	udpMetrics.unauthenticatedPackets.WithLabelValues(ctx).Inc()
+}
+
+func init() {
+	metrics.MustRegister(udpMetrics)
+}
@@ -43,15 +43,22 @@ type srv struct {
 
 	server      io.Server
 	conn        *net.UDPConn
-	packetCh    chan encoding.Packet
+	packetCh    chan rawPacket
 	connections sync.Map
 	firewall    Firewall
 
 	limitLogs ratelimit.Interface
 }
 
+// rawPacket is a packet with the raw bytes as received from the gateway.
+type rawPacket struct {
+	encoding.Packet
+	raw []byte
+}
+
 func (*srv) Protocol() string            { return "udp" }
 func (*srv) SupportsDownlinkClaim() bool { return true }
+func (*srv) SupportsRemoteControl() bool { return false }
 
 var (
 	errUDPFrontendRecovered      = errors.DefineInternal("udp_frontend_recovered", "internal server error")
@@ -78,11 +85,15 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Conf
 		config:   config,
 		server:   server,
 		conn:     conn,
-		packetCh: make(chan encoding.Packet, config.PacketBuffer),
+		packetCh: make(chan rawPacket, config.PacketBuffer),
 		firewall: firewall,
 
 		limitLogs: limitLogs,
 	}
+	if config.Authentication.Enable {
+		// Authenticate packets first, so that unauthenticated packets do not affect the other firewalls.
+		s.firewall = NewAuthenticatingFirewall(ctx, firewall, s, config.Authentication.CacheTTL, config.Authentication.TimestampWindow)
+	}
 	go s.gc()
 	go func() {
 		<-ctx.Done()
@@ -143,7 +154,7 @@ func (s *srv) read() (err error) {
 		}
 
 		select {
-		case s.packetCh <- packet:
+		case s.packetCh <- rawPacket{Packet: packet, raw: packetBuf}:
 		default:
 			log.FromContext(ctx).Warn("Packet handlers busy, dropping packet")
 		}
@@ -157,7 +168,8 @@ func (s *srv) handlePackets() {
 		case <-s.ctx.Done():
 			return
 
-		case packet := <-s.packetCh:
+		case raw := <-s.packetCh:
+			packet := raw.Packet
 			eui := *packet.GatewayEUI
 			ctx := log.NewContextWithField(s.ctx, "gateway_eui", eui)
 			logger := log.FromContext(ctx)
@@ -184,6 +196,7 @@ func (s *srv) handlePackets() {
 				logger.WithError(err).Warn("Failed to connect")
 				break
 			}
+			cs.io.CaptureRawUp(raw.raw)
 
 			if err := s.handleUp(cs.io.Context(), cs, packet); err != nil {
 				logger.WithError(err).Warn("Failed to handle upstream packet")
@@ -192,6 +205,18 @@ func (s *srv) handlePackets() {
 	}
 }
 
+// GetUDPAuthentication implements AuthenticationKeys.
+func (s *srv) GetUDPAuthentication(ctx context.Context, eui types.EUI64) (ttnpb.GatewayUDPAuthenticationMode, []byte, error) {
+	ctx, ids, err := s.server.FillGatewayContext(ctx, ttnpb.GatewayIdentifiers{Eui: &eui})
+	if errors.IsNotFound(err) {
+		// Unregistered gateways do not use UDP authentication.
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, nil
+	} else if err != nil {
+		return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, err
+	}
+	return s.server.GetUDPAuthentication(ctx, ids)
+}
+
 var errConnectionNotReady = errors.DefineUnavailable("connection_not_ready", "connection is not ready")
 
 func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
@@ -373,6 +398,16 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 	}()
 	healthCheck := time.NewTicker(s.config.DownlinkPathExpires / 2)
 	defer healthCheck.Stop()
+	var (
+		beaconCh      <-chan time.Time
+		beaconTimer   *time.Timer
+		pendingBeacon time.Duration
+	)
+	if s.config.TransmitBeacons {
+		beaconTimer = time.NewTimer(0)
+		defer beaconTimer.Stop()
+		beaconCh = beaconTimer.C
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -400,7 +435,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				logger.Debug("Write downlink message")
 				token := state.tokens.Next(down, time.Now())
 				packet.Token = [2]byte{byte(token >> 8), byte(token)}
-				if err := s.write(packet); err != nil {
+				if err := s.write(state.io, packet); err != nil {
 					logger.WithError(err).Warn("Failed to write downlink message")
 					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
 				}
@@ -423,6 +458,18 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 			d := time.Until(serverTime.Add(-s.config.ScheduleLateTime))
 			logger.WithField("duration", d).Debug("Wait to schedule downlink message late")
 			time.AfterFunc(d, write)
+		case <-beaconCh:
+			if pendingBeacon != 0 {
+				s.writeBeacon(ctx, state, pendingBeacon)
+				pendingBeacon = 0
+			}
+			beacon, d, ok := s.nextBeacon(state)
+			if !ok {
+				beaconTimer.Reset(beaconSyncRetryInterval)
+				break
+			}
+			pendingBeacon = beacon
+			beaconTimer.Reset(d)
 		case <-healthCheck.C:
 			lastSeenPull := time.Unix(0, atomic.LoadInt64(&state.lastSeenPull))
 			if time.Since(lastSeenPull) > s.config.DownlinkPathExpires {
@@ -433,11 +480,13 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 	}
 }
 
-func (s *srv) write(packet encoding.Packet) error {
+// write writes the packet to the gateway and records the raw bytes if a capture is active on the connection.
+func (s *srv) write(conn *io.Connection, packet encoding.Packet) error {
 	buf, err := packet.MarshalBinary()
 	if err != nil {
 		return err
 	}
+	conn.CaptureRawDown(buf)
 	_, err = s.conn.WriteToUDP(buf, packet.GatewayAddr)
 	return err
 }
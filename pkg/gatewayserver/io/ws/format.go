@@ -47,4 +47,9 @@ type Formatter interface {
 	HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIdentifiers, conn *io.Connection, receivedAt time.Time) ([]byte, error)
 	// FromDownlink generates a downlink byte stream that can be sent over the WS connection.
 	FromDownlink(ctx context.Context, uid string, down ttnpb.DownlinkMessage, concentratorTime scheduling.ConcentratorTime, dlTime time.Time) ([]byte, error)
+	// FromRemote generates a remote control byte stream that can be sent over the WS connection.
+	// This function returns true if the byte stream must be sent as binary message.
+	FromRemote(ctx context.Context, msg io.RemoteMessage) ([]byte, bool, error)
+	// HandleBinaryUp handles binary upstream messages from web socket based gateways.
+	HandleBinaryUp(ctx context.Context, raw []byte, conn *io.Connection) error
 }
@@ -56,6 +56,10 @@ func (srv mockServer) GetFrequencyPlans(ctx context.Context, ids ttnpb.GatewayId
 	return nil, nil
 }
 
+func (srv mockServer) GetUDPAuthentication(ctx context.Context, ids ttnpb.GatewayIdentifiers) (ttnpb.GatewayUDPAuthenticationMode, []byte, error) {
+	return ttnpb.UDP_AUTHENTICATION_DISABLED, nil, nil
+}
+
 func (srv mockServer) ClaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error {
 	return nil
 }
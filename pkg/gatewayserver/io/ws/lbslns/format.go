@@ -35,13 +35,16 @@ type State struct {
 
 type lbsLNS struct {
 	maxRoundTripDelay time.Duration
+	transmitBeacons   bool
 	tokens            io.DownlinkTokens
 }
 
 // NewFormatter returns a new LoRa Basic Station LNS formatter.
-func NewFormatter(maxRoundTripDelay time.Duration) ws.Formatter {
+// If transmitBeacons is true, the router configuration enables Class B beaconing by the gateway.
+func NewFormatter(maxRoundTripDelay time.Duration, transmitBeacons bool) ws.Formatter {
 	return &lbsLNS{
 		maxRoundTripDelay: maxRoundTripDelay,
+		transmitBeacons:   transmitBeacons,
 	}
 }
 
@@ -0,0 +1,137 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+// RemoteCommand is the command to run sent to the LoRa Basics Station.
+type RemoteCommand struct {
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cmd RemoteCommand) MarshalJSON() ([]byte, error) {
+	type Alias RemoteCommand
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteCommand,
+		Alias: Alias(cmd),
+	})
+}
+
+// RemoteShell starts or stops a remote shell session on the LoRa Basics Station.
+// If neither Start nor Stop is set, the LoRa Basics Station reports the state of the sessions.
+type RemoteShell struct {
+	User  string `json:"user,omitempty"`
+	Term  string `json:"term,omitempty"`
+	Start *int   `json:"start,omitempty"`
+	Stop  *int   `json:"stop,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (sh RemoteShell) MarshalJSON() ([]byte, error) {
+	type Alias RemoteShell
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteShell,
+		Alias: Alias(sh),
+	})
+}
+
+// RemoteShellSessionState is the state of a remote shell session reported by the LoRa Basics Station.
+type RemoteShellSessionState struct {
+	User    string `json:"user"`
+	Started bool   `json:"started"`
+	Age     int64  `json:"age"`
+	PID     int64  `json:"pid"`
+}
+
+// RemoteShellState is the state of the remote shell sessions reported by the LoRa Basics Station.
+// The index of the session state is the session index.
+type RemoteShellState struct {
+	Sessions []RemoteShellSessionState `json:"rmtsh"`
+}
+
+var errRemoteShellData = errors.DefineInvalidArgument("remote_shell_data", "invalid remote shell data")
+
+// FromRemote implements Formatter.
+// The input of remote shell sessions is sent as binary message, of which the first byte is the session index.
+func (f *lbsLNS) FromRemote(ctx context.Context, msg io.RemoteMessage) ([]byte, bool, error) {
+	switch {
+	case msg.Command != nil:
+		b, err := json.Marshal(RemoteCommand{
+			Command:   msg.Command.Command,
+			Arguments: msg.Command.Arguments,
+		})
+		return b, false, err
+	case msg.Shell != nil && msg.ShellStart:
+		index := msg.Shell.Index()
+		b, err := json.Marshal(RemoteShell{
+			User:  msg.Shell.User(),
+			Term:  msg.Shell.Term(),
+			Start: &index,
+		})
+		return b, false, err
+	case msg.Shell != nil && msg.ShellStop:
+		index := msg.Shell.Index()
+		b, err := json.Marshal(RemoteShell{
+			Stop: &index,
+		})
+		return b, false, err
+	case msg.Shell != nil:
+		return append([]byte{byte(msg.Shell.Index())}, msg.ShellInput...), true, nil
+	default:
+		return nil, false, errNotSupported.New()
+	}
+}
+
+// HandleBinaryUp implements Formatter.
+// Binary messages contain the output of remote shell sessions, of which the first byte is the session index.
+func (f *lbsLNS) HandleBinaryUp(ctx context.Context, raw []byte, conn *io.Connection) error {
+	if len(raw) < 1 {
+		return errRemoteShellData.New()
+	}
+	return conn.HandleRemoteShellOutput(int(raw[0]), raw[1:])
+}
+
+func handleRemoteShellState(ctx context.Context, raw []byte) error {
+	var state RemoteShellState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx)
+	for i, session := range state.Sessions {
+		logger.WithFields(log.Fields(
+			"index", i,
+			"user", session.User,
+			"started", session.Started,
+			"age", session.Age,
+			"pid", session.PID,
+		)).Debug("Received remote shell session state")
+	}
+	return nil
+}
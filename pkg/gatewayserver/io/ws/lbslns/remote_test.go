@@ -0,0 +1,114 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRemote(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	var lbsLNS lbsLNS
+
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Disconnect(nil)
+
+	for _, tc := range []struct {
+		Name   string
+		Send   func() error
+		Raw    []byte
+		Binary bool
+	}{
+		{
+			Name: "Command",
+			Send: func() error {
+				return conn.RunCommand("/sbin/reboot", "-f")
+			},
+			Raw: []byte(`{"msgtype":"runcmd","command":"/sbin/reboot","arguments":["-f"]}`),
+		},
+		{
+			Name: "ShellStart",
+			Send: func() error {
+				_, err := conn.OpenRemoteShell("root", "xterm")
+				return err
+			},
+			Raw: []byte(`{"msgtype":"rmtsh","user":"root","term":"xterm","start":0}`),
+		},
+		{
+			Name: "ShellInput",
+			Send: func() error {
+				shell, err := conn.OpenRemoteShell("", "")
+				if err != nil {
+					return err
+				}
+				<-conn.Remote()
+				return shell.Write([]byte("ls\n"))
+			},
+			Raw:    []byte{0x01, 'l', 's', '\n'},
+			Binary: true,
+		},
+		{
+			Name: "ShellStop",
+			Send: func() error {
+				shell, err := conn.OpenRemoteShell("", "")
+				if err != nil {
+					return err
+				}
+				<-conn.Remote()
+				shell.Close()
+				return nil
+			},
+			Raw: []byte(`{"msgtype":"rmtsh","stop":2}`),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			if !a.So(tc.Send(), should.BeNil) {
+				t.FailNow()
+			}
+			msg := <-conn.Remote()
+			raw, binary, err := lbsLNS.FromRemote(ctx, *msg)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(raw), should.Equal, string(tc.Raw))
+			a.So(binary, should.Equal, tc.Binary)
+		})
+	}
+
+	shell, err := conn.OpenRemoteShell("", "")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(lbsLNS.HandleBinaryUp(ctx, append([]byte{byte(shell.Index())}, "hello"...), conn), should.BeNil)
+	a.So(<-shell.Output(), should.Resemble, []byte("hello"))
+	a.So(lbsLNS.HandleBinaryUp(ctx, nil, conn), should.NotBeNil)
+}
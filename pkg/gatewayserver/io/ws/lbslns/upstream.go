@@ -37,6 +37,7 @@ import (
 var (
 	errJoinRequestMessage = errors.Define("join_request_message", "invalid join-request message received")
 	errUplinkDataFrame    = errors.Define("uplink_data_Frame", "invalid uplink data frame received")
+	errProprietaryFrame   = errors.Define("proprietary_data_frame", "invalid proprietary data frame received")
 	errUplinkMessage      = errors.Define("uplink_message", "invalid uplink message received")
 )
 
@@ -106,6 +107,26 @@ func (updf UplinkDataFrame) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// ProprietaryDataFrame is the proprietary uplink message of the LoRa Basics Station protocol.
+// The FRMPayload contains the entire frame, including the MHDR.
+type ProprietaryDataFrame struct {
+	FRMPayload string  `json:"FRMPayload"`
+	RefTime    float64 `json:"RefTime"`
+	RadioMetaData
+}
+
+// MarshalJSON implements json.Marshaler.
+func (propdf ProprietaryDataFrame) MarshalJSON() ([]byte, error) {
+	type Alias ProprietaryDataFrame
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeUpstreamProprietaryDataFrame,
+		Alias: Alias(propdf),
+	})
+}
+
 // TxConfirmation is the LoRaWAN Join Request message from the BasicStation.
 type TxConfirmation struct {
 	Diid    int64            `json:"diid"`
@@ -406,6 +427,67 @@ func (updf *UplinkDataFrame) FromUplinkMessage(up *ttnpb.UplinkMessage, bandID s
 	return nil
 }
 
+// toUplinkMessage extracts fields from the LoRa Basics Station Proprietary Data Frame "propdf" message and converts them into an UplinkMessage.
+func (propdf *ProprietaryDataFrame) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
+	var up ttnpb.UplinkMessage
+	up.ReceivedAt = receivedAt
+
+	rawPayload, err := hex.DecodeString(propdf.FRMPayload)
+	if err != nil {
+		return nil, errProprietaryFrame.WithCause(err)
+	}
+	if len(rawPayload) == 0 {
+		return nil, errProprietaryFrame.New()
+	}
+	var parsedMHDR ttnpb.MHDR
+	if err := lorawan.UnmarshalMHDR(rawPayload[:1], &parsedMHDR); err != nil {
+		return nil, errProprietaryFrame.WithCause(err)
+	}
+	if parsedMHDR.MType != ttnpb.MType_PROPRIETARY {
+		return nil, errProprietaryFrame.New()
+	}
+	up.RawPayload = rawPayload
+
+	timestamp := uint32(propdf.RadioMetaData.UpInfo.XTime & 0xFFFFFFFF)
+
+	var rxTime *time.Time
+	sec, nsec := math.Modf(propdf.RadioMetaData.UpInfo.RxTime)
+	if sec != 0 {
+		val := time.Unix(int64(sec), int64(nsec*(1e9)))
+		rxTime = &val
+	}
+
+	rxMetadata := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ids,
+		Time:               rxTime,
+		Timestamp:          timestamp,
+		RSSI:               propdf.RadioMetaData.UpInfo.RSSI,
+		ChannelRSSI:        propdf.RadioMetaData.UpInfo.RSSI,
+		SNR:                propdf.RadioMetaData.UpInfo.SNR,
+		AntennaIndex:       uint32(propdf.RadioMetaData.UpInfo.RCtx),
+	}
+	up.RxMetadata = append(up.RxMetadata, rxMetadata)
+
+	dataRate, isLora, err := util.GetDataRateFromIndex(bandID, propdf.RadioMetaData.DataRate)
+	if err != nil {
+		return nil, errProprietaryFrame.WithCause(err)
+	}
+
+	var codingRate string
+	if isLora {
+		codingRate = "4/5"
+	}
+
+	up.Settings = ttnpb.TxSettings{
+		Frequency:  propdf.RadioMetaData.Frequency,
+		DataRate:   dataRate,
+		CodingRate: codingRate,
+		Timestamp:  timestamp,
+		Time:       rxTime,
+	}
+	return &up, nil
+}
+
 // ToTxAck converts the LoRa Basics Station TxConfirmation message to ttnpb.TxAcknowledgment
 func (conf TxConfirmation) ToTxAck(ctx context.Context, tokens io.DownlinkTokens, receivedAt time.Time) *ttnpb.TxAcknowledgment {
 	var txAck ttnpb.TxAcknowledgment
@@ -447,7 +529,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 
 	switch typ {
 	case TypeUpstreamVersion:
-		ctx, msg, stat, err := f.GetRouterConfig(ctx, raw, conn.BandID(), conn.FrequencyPlans(), receivedAt)
+		ctx, msg, stat, err := f.GetRouterConfig(ctx, raw, conn, receivedAt)
 		logger = log.FromContext(ctx)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to generate router configuration")
@@ -541,7 +623,39 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 		}
 		recordTime(recordRTT, txConf.RefTime, txConf.XTime, receivedAt)
 
-	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell, TypeUpstreamTimeSync:
+	case TypeUpstreamRemoteShell:
+		if err := handleRemoteShellState(ctx, raw); err != nil {
+			logger.WithError(err).Debug("Failed to parse remote shell state")
+			return nil, err
+		}
+
+	case TypeUpstreamProprietaryDataFrame:
+		var propdf ProprietaryDataFrame
+		if err := json.Unmarshal(raw, &propdf); err != nil {
+			return nil, err
+		}
+		if propdf.UpInfo.XTime == 0 {
+			logger.Warn("Received proprietary uplink without xtime, drop message")
+			return nil, nil
+		}
+		up, err := propdf.toUplinkMessage(ids, conn.BandID(), receivedAt)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to parse proprietary uplink message")
+			return nil, err
+		}
+		if err := conn.HandleUp(up); err != nil {
+			logger.WithError(err).Warn("Failed to handle upstream message")
+			return nil, err
+		}
+		session := ws.SessionFromContext(ctx)
+		session.DataMu.Lock()
+		session.Data = State{
+			ID: int32(propdf.UpInfo.XTime >> 48),
+		}
+		session.DataMu.Unlock()
+		recordTime(false, propdf.RefTime, propdf.UpInfo.XTime, receivedAt)
+
+	case TypeUpstreamTimeSync:
 		logger.WithField("message_type", typ).Debug("Message type not implemented")
 
 	default:
@@ -445,6 +445,90 @@ func TestUplinkDataFrame(t *testing.T) {
 	}
 }
 
+func TestProprietaryDataFrame(t *testing.T) {
+	gtwID := ttnpb.GatewayIdentifiers{
+		GatewayId: "eui-1122334455667788",
+		Eui:       &types.EUI64{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88},
+	}
+
+	for _, tc := range []struct {
+		Name                  string
+		ProprietaryDataFrame  ProprietaryDataFrame
+		ExpectedUplinkMessage ttnpb.UplinkMessage
+		ErrorAssertion        func(err error) bool
+	}{
+		{
+			Name:                 "Empty",
+			ProprietaryDataFrame: ProprietaryDataFrame{},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errProprietaryFrame)
+			},
+		},
+		{
+			Name: "NotProprietary",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload: "40112233",
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errProprietaryFrame)
+			},
+		},
+		{
+			Name: "ValidFrame",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload: "E0010203",
+				RadioMetaData: RadioMetaData{
+					DataRate:  1,
+					Frequency: 868300000,
+					UpInfo: UpInfo{
+						RxTime: 1548059982,
+						XTime:  12666373963464220,
+						RSSI:   89,
+						SNR:    9.25,
+					},
+				},
+			},
+			ExpectedUplinkMessage: ttnpb.UplinkMessage{
+				RawPayload: []byte{0xE0, 0x01, 0x02, 0x03},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: gtwID,
+						Time:               &[]time.Time{time.Unix(1548059982, 0)}[0],
+						Timestamp:          (uint32)(12666373963464220 & 0xFFFFFFFF),
+						RSSI:               89,
+						ChannelRSSI:        89,
+						SNR:                9.25,
+					},
+				},
+				Settings: ttnpb.TxSettings{
+					Timestamp:  (uint32)(12666373963464220 & 0xFFFFFFFF),
+					Time:       &[]time.Time{time.Unix(1548059982, 0)}[0],
+					CodingRate: "4/5",
+					Frequency:  868300000,
+					DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 11,
+						Bandwidth:       125000,
+					}}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			msg, err := tc.ProprietaryDataFrame.toUplinkMessage(gtwID, "EU_863_870", time.Time{})
+			if err != nil {
+				if tc.ErrorAssertion == nil || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if tc.ErrorAssertion != nil {
+				t.Fatalf("Expected error")
+			} else {
+				a.So(*msg, should.Resemble, tc.ExpectedUplinkMessage)
+			}
+		})
+	}
+}
+
 func TestFromUplinkDataFrame(t *testing.T) {
 	gtwID := ttnpb.GatewayIdentifiers{
 		GatewayId: "eui-1122334455667788",
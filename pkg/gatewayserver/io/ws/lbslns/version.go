@@ -22,7 +22,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
-	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	pfconfig "go.thethings.network/lorawan-stack/v3/pkg/pfconfig/lbslns"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -64,15 +64,24 @@ func (v Version) IsProduction() bool {
 }
 
 // GetRouterConfig gets router config for the particular version message.
-func (f *lbsLNS) GetRouterConfig(ctx context.Context, msg []byte, bandID string, fps map[string]*frequencyplans.FrequencyPlan, receivedAt time.Time) (context.Context, []byte, *ttnpb.GatewayStatus, error) {
+// If beaconing is configured, the beacon periods are reserved in the scheduler of the gateway connection, as the
+// gateway transmits the beacons itself.
+func (f *lbsLNS) GetRouterConfig(ctx context.Context, msg []byte, conn *io.Connection, receivedAt time.Time) (context.Context, []byte, *ttnpb.GatewayStatus, error) {
 	var version Version
 	if err := json.Unmarshal(msg, &version); err != nil {
 		return nil, nil, nil, err
 	}
-	cfg, err := pfconfig.GetRouterConfig(bandID, fps, version.IsProduction(), time.Now())
+	cfg, err := pfconfig.GetRouterConfig(conn.BandID(), conn.FrequencyPlans(), version.IsProduction(), time.Now())
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if f.transmitBeacons {
+		cfg.Beaconing, err = pfconfig.GetBeaconing(conn.BandID())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		conn.ReserveBeacons()
+	}
 	routerCfg, err := cfg.MarshalJSON()
 	if err != nil {
 		return nil, nil, nil, err
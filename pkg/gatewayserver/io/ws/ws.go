@@ -59,6 +59,7 @@ type srv struct {
 
 func (s *srv) Protocol() string            { return "ws" }
 func (s *srv) SupportsDownlinkClaim() bool { return false }
+func (s *srv) SupportsRemoteControl() bool { return true }
 
 // New creates a new WebSocket frontend.
 func New(ctx context.Context, server io.Server, formatter Formatter, cfg Config) *echo.Echo {
@@ -279,6 +280,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				}
 
 				logger.Info("Send downlink message")
+				conn.CaptureRawDown(dnmsg)
 				wsWriteMu.Lock()
 				err = ws.WriteMessage(websocket.TextMessage, dnmsg)
 				wsWriteMu.Unlock()
@@ -287,6 +289,26 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 					conn.Disconnect(err)
 					return
 				}
+			case msg := <-conn.Remote():
+				sessionCtx := NewContextWithSession(ctx, &session)
+				raw, binary, err := s.formatter.FromRemote(sessionCtx, *msg)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote control message")
+					continue
+				}
+				messageType := websocket.TextMessage
+				if binary {
+					messageType = websocket.BinaryMessage
+				}
+				logger.Debug("Send remote control message")
+				wsWriteMu.Lock()
+				err = ws.WriteMessage(messageType, raw)
+				wsWriteMu.Unlock()
+				if err != nil {
+					logger.WithError(err).Warn("Failed to send remote control message")
+					conn.Disconnect(err)
+					return
+				}
 			}
 		}
 	}()
@@ -298,18 +320,29 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 			conn.Disconnect(err)
 			return err
 		}
-		_, data, err := ws.ReadMessage()
+		messageType, data, err := ws.ReadMessage()
 		if err != nil {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		// Binary messages contain remote shell output, which is not captured.
+		if messageType == websocket.TextMessage {
+			conn.CaptureRawUp(data)
+		}
 		sessionCtx := NewContextWithSession(ctx, &session)
+		if messageType == websocket.BinaryMessage {
+			if err := s.formatter.HandleBinaryUp(sessionCtx, data, conn); err != nil {
+				logger.WithError(err).Debug("Failed to handle binary message")
+			}
+			continue
+		}
 		downstream, err := s.formatter.HandleUp(sessionCtx, data, ids, conn, time.Now())
 		if err != nil {
 			return err
 		}
 		if downstream != nil {
 			logger.Info("Send downstream message")
+			conn.CaptureRawDown(downstream)
 			wsWriteMu.Lock()
 			err = ws.WriteMessage(websocket.TextMessage, downstream)
 			wsWriteMu.Unlock()
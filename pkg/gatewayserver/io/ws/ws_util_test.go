@@ -69,7 +69,7 @@ func withServer(t *testing.T, wsConfig ws.Config, rateLimitConf config.RateLimit
 	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
 	gs := mock.NewServer(c)
 
-	bsWebServer := ws.New(ctx, gs, lbslns.NewFormatter(maxValidRoundTripDelay), wsConfig)
+	bsWebServer := ws.New(ctx, gs, lbslns.NewFormatter(maxValidRoundTripDelay, false), wsConfig)
 	lis, err := net.Listen("tcp", serverAddress)
 	if err != nil {
 		t.FailNow()
@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -41,6 +42,16 @@ var (
 			ttnpb.RIGHT_GATEWAY_STATUS_READ,
 		),
 	)
+	evtRaiseGatewayAlert = events.Define(
+		"gs.gateway.alert.raise", "raise gateway alert",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+	evtResolveGatewayAlert = events.Define(
+		"gs.gateway.alert.resolve", "resolve gateway alert",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
 	evtReceiveStatus = events.Define(
 		"gs.status.receive", "receive gateway status",
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
@@ -66,6 +77,11 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
 		events.WithErrorDataType(),
 	)
+	evtFilterUp = events.Define(
+		"gs.up.filter", "filter uplink message",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
 	evtForwardUp = events.Define(
 		"gs.up.forward", "forward uplink message",
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
@@ -89,6 +105,40 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
 		events.WithDataType(ttnpb.TxAcknowledgment_COLLISION_PACKET),
 	)
+	evtRunGatewayCommand = events.Define(
+		"gs.gateway.remote.command", "run command on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_CONTROL),
+		events.WithDataType(&ttnpb.RunGatewayCommandRequest{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtOpenRemoteShell = events.Define(
+		"gs.gateway.remote.shell.open", "open remote shell on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_CONTROL),
+		events.WithDataType(&ttnpb.GatewayRemoteShellRequest{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtCloseRemoteShell = events.Define(
+		"gs.gateway.remote.shell.close", "close remote shell on gateway",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_REMOTE_CONTROL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStartCapture = events.Define(
+		"gs.gateway.capture.start", "start capture of gateway traffic",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.GatewayCapture{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStopCapture = events.Define(
+		"gs.gateway.capture.stop", "stop capture of gateway traffic",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.GatewayCapture{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 )
 
 const (
@@ -172,6 +222,14 @@ var gsMetrics = &messageMetrics{
 		},
 		[]string{host, "error"},
 	),
+	uplinkFiltered: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_filtered_total",
+			Help:      "Total number of uplinks that did not pass the gateway uplink filters",
+		},
+		[]string{"error", "dry_run"},
+	),
 	uplinkFailed: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -220,6 +278,7 @@ type messageMetrics struct {
 	uplinkReceived      *metrics.ContextualCounterVec
 	uplinkForwarded     *metrics.ContextualCounterVec
 	uplinkDropped       *metrics.ContextualCounterVec
+	uplinkFiltered      *metrics.ContextualCounterVec
 	uplinkFailed        *metrics.ContextualCounterVec
 	downlinkSent        *metrics.ContextualCounterVec
 	downlinkTxSucceeded *metrics.ContextualCounterVec
@@ -236,6 +295,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkReceived.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
+	m.uplinkFiltered.Describe(ch)
 	m.uplinkFailed.Describe(ch)
 	m.downlinkSent.Describe(ch)
 	m.downlinkTxSucceeded.Describe(ch)
@@ -252,6 +312,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkReceived.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
+	m.uplinkFiltered.Collect(ch)
 	m.uplinkFailed.Collect(ch)
 	m.downlinkSent.Collect(ch)
 	m.downlinkTxSucceeded.Collect(ch)
@@ -268,6 +329,14 @@ func registerGatewayDisconnect(ctx context.Context, ids ttnpb.GatewayIdentifiers
 	gsMetrics.gatewaysConnected.WithLabelValues(ctx, protocol).Dec()
 }
 
+func registerRaiseGatewayAlert(ctx context.Context, alert *ttnpb.GatewayAlert) {
+	events.Publish(evtRaiseGatewayAlert.NewWithIdentifiersAndData(ctx, &alert.GatewayIdentifiers, alert))
+}
+
+func registerResolveGatewayAlert(ctx context.Context, alert *ttnpb.GatewayAlert) {
+	events.Publish(evtResolveGatewayAlert.NewWithIdentifiersAndData(ctx, &alert.GatewayIdentifiers, alert))
+}
+
 func registerUpstreamHandlerStart(ctx context.Context, host string) {
 	gsMetrics.upstreamHandlers.WithLabelValues(ctx, host).Inc()
 }
@@ -318,6 +387,17 @@ func registerDropUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.Upli
 	}
 }
 
+func registerFilterUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.UplinkMessage, err error, dryRun bool) {
+	if !dryRun {
+		events.Publish(evtFilterUp.NewWithIdentifiersAndData(ctx, gtw, err))
+	}
+	errName := unknown
+	if ttnErr, ok := errors.From(err); ok {
+		errName = ttnErr.FullName()
+	}
+	gsMetrics.uplinkFiltered.WithLabelValues(ctx, errName, strconv.FormatBool(dryRun)).Inc()
+}
+
 func registerFailUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.UplinkMessage, host string) {
 	events.Publish(evtFailUp.NewWithIdentifiersAndData(ctx, gtw, nil))
 	gsMetrics.uplinkFailed.WithLabelValues(ctx, host).Inc()
@@ -0,0 +1,263 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	er "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/entityregistry/is"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestProprietary(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bodyCh := make(chan []byte, 1)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodyCh <- body
+	}))
+	defer target.Close()
+
+	is, isAddr := startMockIS(ctx)
+	ns, nsAddr := mock.StartNS(ctx)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":9188",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		Proprietary: gatewayserver.ProprietaryConfig{
+			Target: target.URL,
+		},
+	}, gatewayserver.WithRegistry(er.New(c)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: registeredGatewayID,
+		Eui:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+
+	conn, err := grpc.Dial(":9188", append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(ctx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayId,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout) // Wait for the gateway to be connected.
+
+	settings := ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				},
+			},
+		},
+		CodingRate: "4/5",
+		Frequency:  868100000,
+	}
+	proprietaryUp := &ttnpb.UplinkMessage{
+		Settings: settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          100,
+				RSSI:               -42,
+				ChannelRSSI:        -42,
+				SNR:                7,
+			},
+		},
+		RawPayload: []byte{0xe0, 0x01, 0x02, 0x03},
+	}
+	proprietaryUp.Settings.Timestamp = 100
+	dataUp := &ttnpb.UplinkMessage{
+		Settings: settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          200,
+				RSSI:               -69,
+				ChannelRSSI:        -69,
+				SNR:                11,
+			},
+		},
+		RawPayload: randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+	}
+	dataUp.Settings.Timestamp = 200
+	if !a.So(link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{proprietaryUp, dataUp},
+	}), should.BeNil) {
+		t.FailNow()
+	}
+
+	// The proprietary uplink message is forwarded to the target only.
+	select {
+	case body := <-bodyCh:
+		var msg ttnpb.GatewayUplinkMessage
+		if a.So(jsonpb.TTN().Unmarshal(body, &msg), should.BeNil) {
+			a.So(msg.RawPayload, should.Resemble, proprietaryUp.RawPayload)
+			if a.So(msg.RxMetadata, should.HaveLength, 1) {
+				a.So(msg.RxMetadata[0].UplinkToken, should.NotBeEmpty)
+				a.So(msg.RxMetadata[0].RSSI, should.Equal, proprietaryUp.RxMetadata[0].RSSI)
+			}
+		}
+	case <-time.After(timeout):
+		t.Fatal("Expected proprietary uplink message timeout")
+	}
+	select {
+	case msg := <-ns.Up():
+		a.So(msg.RawPayload, should.Resemble, dataUp.RawPayload)
+	case <-time.After(timeout):
+		t.Fatal("Expected uplink message timeout")
+	}
+	select {
+	case msg := <-ns.Up():
+		t.Fatalf("Unexpected uplink message %v", msg)
+	case body := <-bodyCh:
+		t.Fatalf("Unexpected proprietary uplink message %s", body)
+	case <-time.After(timeout):
+	}
+
+	rightsCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_TRAFFIC_DOWN_WRITE),
+		},
+	})
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.ScheduleProprietaryDownlinkRequest
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NotProprietary",
+			Request: &ttnpb.ScheduleProprietaryDownlinkRequest{
+				GatewayIdentifiers: ids,
+				RawPayload:         randomDownDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_C,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     869525000,
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "OtherGateway",
+			Request: &ttnpb.ScheduleProprietaryDownlinkRequest{
+				GatewayIdentifiers: ids,
+				RawPayload:         []byte{0xe0, 0x04, 0x05, 0x06},
+				Request: &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_C,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_Fixed{
+								Fixed: &ttnpb.GatewayAntennaIdentifiers{
+									GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+										GatewayId: "other-gateway",
+									},
+								},
+							},
+						},
+					},
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     869525000,
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "ValidClassC",
+			Request: &ttnpb.ScheduleProprietaryDownlinkRequest{
+				GatewayIdentifiers: ids,
+				RawPayload:         []byte{0xe0, 0x04, 0x05, 0x06},
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_C,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     869525000,
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			res, err := gs.ScheduleProprietaryDownlink(rightsCtx, tc.Request)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.DownlinkPath.GetFixed().GatewayIdentifiers, should.Resemble, ids)
+			down, err := link.Recv()
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(down.DownlinkMessage.RawPayload, should.Resemble, tc.Request.RawPayload)
+			a.So(down.DownlinkMessage.GetScheduled(), should.NotBeNil)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// HistoryTier defines the resolution and the retention of a tier of gateway connection stats samples.
+type HistoryTier struct {
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+// GatewayConnectionStatsHistory implements the GatewayConnectionStatsHistory interface.
+// Samples are merged per interval of the tier resolution, and stored in a sorted set per tier.
+type GatewayConnectionStatsHistory struct {
+	Redis *ttnredis.Client
+	// Tiers are the tiers to store samples in, ordered from the finest to the coarsest resolution.
+	Tiers []HistoryTier
+}
+
+func (r *GatewayConnectionStatsHistory) key(uid string, tier HistoryTier) string {
+	return r.Redis.Key("uid", uid, tier.Resolution.String())
+}
+
+// tier returns the tier to read samples from. This is the coarsest tier that retains samples from the given time,
+// with at most the requested resolution. If the requested resolution is zero, the finest tier is returned.
+func (r *GatewayConnectionStatsHistory) tier(now, from time.Time, resolution time.Duration) HistoryTier {
+	var candidates []HistoryTier
+	for _, tier := range r.Tiers {
+		if !from.Before(now.Add(-tier.Retention)) {
+			candidates = append(candidates, tier)
+		}
+	}
+	if len(candidates) == 0 {
+		return r.Tiers[len(r.Tiers)-1]
+	}
+	res := candidates[0]
+	for _, tier := range candidates[1:] {
+		if tier.Resolution <= resolution {
+			res = tier
+		}
+	}
+	return res
+}
+
+func scoreString(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// Add merges the sample in the intervals of each tier.
+func (r *GatewayConnectionStatsHistory) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, sample *ttnpb.GatewayConnectionStatsSample) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "add gateway connection stats sample").End()
+
+	now := time.Now()
+	for _, tier := range r.Tiers {
+		start := sample.Time.Truncate(tier.Resolution)
+		expired := now.Add(-tier.Retention)
+		if start.Before(expired) {
+			continue
+		}
+		k := r.key(uid, tier)
+		score := scoreString(start)
+		err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+			merged := &ttnpb.GatewayConnectionStatsSample{}
+			ss, err := tx.ZRangeByScore(ctx, k, &redis.ZRangeBy{Min: score, Max: score}).Result()
+			if err != nil {
+				return err
+			}
+			for _, s := range ss {
+				pb := &ttnpb.GatewayConnectionStatsSample{}
+				if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+					return err
+				}
+				mergeSample(merged, pb)
+			}
+			mergeSample(merged, sample)
+			merged.Time = start
+			s, err := ttnredis.MarshalProto(merged)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+				p.ZRemRangeByScore(ctx, k, score, score)
+				p.ZAdd(ctx, k, &redis.Z{
+					Score:  float64(start.Unix()),
+					Member: s,
+				})
+				p.ZRemRangeByScore(ctx, k, "-inf", "("+scoreString(expired))
+				p.Expire(ctx, k, tier.Retention)
+				return nil
+			})
+			return err
+		}, k)
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+	}
+	return nil
+}
+
+// Range returns the samples in the given time range from the most suitable tier, downsampled to the requested resolution.
+// The resolution is rounded up to a multiple of the tier resolution.
+func (r *GatewayConnectionStatsHistory) Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time, resolution time.Duration) ([]*ttnpb.GatewayConnectionStatsSample, time.Duration, error) {
+	if len(r.Tiers) == 0 {
+		return nil, resolution, nil
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "range gateway connection stats samples").End()
+
+	tier := r.tier(time.Now(), from, resolution)
+	if resolution < tier.Resolution {
+		resolution = tier.Resolution
+	} else if rem := resolution % tier.Resolution; rem != 0 {
+		resolution += tier.Resolution - rem
+	}
+
+	ss, err := r.Redis.ZRangeByScore(ctx, r.key(uid, tier), &redis.ZRangeBy{
+		Min: scoreString(from.Truncate(resolution)),
+		Max: scoreString(to),
+	}).Result()
+	if err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	var samples []*ttnpb.GatewayConnectionStatsSample
+	for _, s := range ss {
+		pb := &ttnpb.GatewayConnectionStatsSample{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, 0, ttnredis.ConvertError(err)
+		}
+		start := pb.Time.Truncate(resolution)
+		if n := len(samples); n > 0 && samples[n-1].Time.Equal(start) {
+			mergeSample(samples[n-1], pb)
+			continue
+		}
+		merged := &ttnpb.GatewayConnectionStatsSample{Time: start}
+		mergeSample(merged, pb)
+		samples = append(samples, merged)
+	}
+	return samples, resolution, nil
+}
+
+// mergeSample merges src into dst. Counts are summed, the median and 90th percentile round-trip times are weighted by
+// the number of round-trip times, the highest sub band utilization is retained and the latest status is retained.
+// The time of dst is not changed.
+func mergeSample(dst, src *ttnpb.GatewayConnectionStatsSample) {
+	dst.Samples += src.Samples
+	dst.UplinkCount += src.UplinkCount
+	dst.DownlinkCount += src.DownlinkCount
+
+	if rtt := src.RoundTripTimes; rtt != nil {
+		if dst.RoundTripTimes == nil {
+			dst.RoundTripTimes = &ttnpb.GatewayConnectionStatsSample_RoundTripTimes{}
+		}
+		dstRTT := dst.RoundTripTimes
+		if total := time.Duration(dstRTT.Count + rtt.Count); total > 0 {
+			dstCount, srcCount := time.Duration(dstRTT.Count), time.Duration(rtt.Count)
+			dstRTT.Median = (dstRTT.Median*dstCount + rtt.Median*srcCount) / total
+			dstRTT.P90 = (dstRTT.P90*dstCount + rtt.P90*srcCount) / total
+		}
+		if rtt.Max > dstRTT.Max {
+			dstRTT.Max = rtt.Max
+		}
+		dstRTT.Count += rtt.Count
+	}
+
+nextSubBand:
+	for _, sb := range src.SubBands {
+		for _, dstSB := range dst.SubBands {
+			if dstSB.MinFrequency != sb.MinFrequency || dstSB.MaxFrequency != sb.MaxFrequency {
+				continue
+			}
+			if sb.DownlinkUtilization > dstSB.DownlinkUtilization {
+				dstSB.DownlinkUtilization = sb.DownlinkUtilization
+			}
+			dstSB.DownlinkUtilizationLimit = sb.DownlinkUtilizationLimit
+			continue nextSubBand
+		}
+		dstSB := *sb
+		dst.SubBands = append(dst.SubBands, &dstSB)
+	}
+
+	if src.LastStatusReceivedAt != nil && (dst.LastStatusReceivedAt == nil || src.LastStatusReceivedAt.After(*dst.LastStatusReceivedAt)) {
+		dst.LastStatus, dst.LastStatusReceivedAt = src.LastStatus, src.LastStatusReceivedAt
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMergeSample(t *testing.T) {
+	a := assertions.New(t)
+
+	statusTime := time.Unix(1600000000, 0)
+	laterStatusTime := statusTime.Add(time.Minute)
+	dst := &ttnpb.GatewayConnectionStatsSample{
+		Time:          time.Unix(1600000000, 0),
+		Samples:       1,
+		UplinkCount:   10,
+		DownlinkCount: 2,
+		RoundTripTimes: &ttnpb.GatewayConnectionStatsSample_RoundTripTimes{
+			Median: 10 * time.Millisecond,
+			P90:    20 * time.Millisecond,
+			Max:    30 * time.Millisecond,
+			Count:  1,
+		},
+		SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             865000000,
+				DownlinkUtilizationLimit: 0.001,
+				DownlinkUtilization:      0.0005,
+			},
+		},
+		LastStatus:           &ttnpb.GatewayStatus{Time: statusTime},
+		LastStatusReceivedAt: &statusTime,
+	}
+	mergeSample(dst, &ttnpb.GatewayConnectionStatsSample{
+		Time:          time.Unix(1600000060, 0),
+		Samples:       1,
+		UplinkCount:   5,
+		DownlinkCount: 1,
+		RoundTripTimes: &ttnpb.GatewayConnectionStatsSample_RoundTripTimes{
+			Median: 40 * time.Millisecond,
+			P90:    50 * time.Millisecond,
+			Max:    60 * time.Millisecond,
+			Count:  2,
+		},
+		SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             865000000,
+				DownlinkUtilizationLimit: 0.001,
+				DownlinkUtilization:      0.0002,
+			},
+			{
+				MinFrequency:             869400000,
+				MaxFrequency:             869650000,
+				DownlinkUtilizationLimit: 0.1,
+				DownlinkUtilization:      0.05,
+			},
+		},
+		LastStatus:           &ttnpb.GatewayStatus{Time: laterStatusTime},
+		LastStatusReceivedAt: &laterStatusTime,
+	})
+	a.So(dst, should.Resemble, &ttnpb.GatewayConnectionStatsSample{
+		Time:          time.Unix(1600000000, 0),
+		Samples:       2,
+		UplinkCount:   15,
+		DownlinkCount: 3,
+		RoundTripTimes: &ttnpb.GatewayConnectionStatsSample_RoundTripTimes{
+			Median: 30 * time.Millisecond,
+			P90:    40 * time.Millisecond,
+			Max:    60 * time.Millisecond,
+			Count:  3,
+		},
+		SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             865000000,
+				DownlinkUtilizationLimit: 0.001,
+				DownlinkUtilization:      0.0005,
+			},
+			{
+				MinFrequency:             869400000,
+				MaxFrequency:             869650000,
+				DownlinkUtilizationLimit: 0.1,
+				DownlinkUtilization:      0.05,
+			},
+		},
+		LastStatus:           &ttnpb.GatewayStatus{Time: laterStatusTime},
+		LastStatusReceivedAt: &laterStatusTime,
+	})
+}
+
+func TestHistoryTier(t *testing.T) {
+	history := &GatewayConnectionStatsHistory{
+		Tiers: []HistoryTier{
+			{Resolution: time.Minute, Retention: 48 * time.Hour},
+			{Resolution: time.Hour, Retention: 30 * 24 * time.Hour},
+		},
+	}
+	now := time.Now()
+	for _, tc := range []struct {
+		Name       string
+		From       time.Time
+		Resolution time.Duration
+		Expected   time.Duration
+	}{
+		{
+			Name:     "RecentDefaultResolution",
+			From:     now.Add(-time.Hour),
+			Expected: time.Minute,
+		},
+		{
+			Name:       "RecentCoarseResolution",
+			From:       now.Add(-time.Hour),
+			Resolution: 2 * time.Hour,
+			Expected:   time.Hour,
+		},
+		{
+			Name:       "RecentFineResolution",
+			From:       now.Add(-time.Hour),
+			Resolution: 5 * time.Minute,
+			Expected:   time.Minute,
+		},
+		{
+			Name:     "OldDefaultResolution",
+			From:     now.Add(-7 * 24 * time.Hour),
+			Expected: time.Hour,
+		},
+		{
+			Name:     "Expired",
+			From:     now.Add(-90 * 24 * time.Hour),
+			Expected: time.Hour,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(history.tier(now, tc.From, tc.Resolution).Resolution, should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestHistory(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: "gtw1",
+	}
+	history := &GatewayConnectionStatsHistory{
+		Redis: cl,
+		Tiers: []HistoryTier{
+			{Resolution: time.Minute, Retention: 48 * time.Hour},
+			{Resolution: time.Hour, Retention: 30 * 24 * time.Hour},
+		},
+	}
+
+	start := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	for i := 0; i < 90; i++ {
+		if !a.So(history.Add(ctx, ids, &ttnpb.GatewayConnectionStatsSample{
+			Time:        start.Add(time.Duration(i) * time.Minute),
+			Samples:     1,
+			UplinkCount: 1,
+		}), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	// A partial sample in the same interval is merged.
+	if !a.So(history.Add(ctx, ids, &ttnpb.GatewayConnectionStatsSample{
+		Time:        start.Add(30 * time.Second),
+		Samples:     1,
+		UplinkCount: 1,
+	}), should.BeNil) {
+		t.FailNow()
+	}
+
+	samples, resolution, err := history.Range(ctx, ids, start, start.Add(2*time.Hour), 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(resolution, should.Equal, time.Minute)
+	if a.So(samples, should.HaveLength, 90) {
+		a.So(samples[0].Time, should.Equal, start)
+		a.So(samples[0].Samples, should.Equal, 2)
+		a.So(samples[0].UplinkCount, should.Equal, 2)
+	}
+
+	samples, resolution, err = history.Range(ctx, ids, start, start.Add(2*time.Hour), 25*time.Minute)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(resolution, should.Equal, 25*time.Minute)
+	var total uint64
+	for _, s := range samples {
+		a.So(s.Time, should.Equal, s.Time.Truncate(25*time.Minute))
+		total += s.UplinkCount
+	}
+	a.So(total, should.Equal, 91)
+
+	samples, resolution, err = history.Range(ctx, ids, start, start.Add(2*time.Hour), 90*time.Minute)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	// The samples are read from the hourly tier, and the resolution is rounded up to a multiple of an hour.
+	a.So(resolution, should.Equal, 2*time.Hour)
+	var count uint32
+	for _, s := range samples {
+		a.So(s.Time, should.Equal, s.Time.Truncate(2*time.Hour))
+		count += s.Samples
+	}
+	a.So(count, should.Equal, 91)
+}
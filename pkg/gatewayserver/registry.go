@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -28,6 +29,15 @@ type GatewayConnectionStatsRegistry interface {
 	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats, paths []string) error
 }
 
+// GatewayConnectionStatsHistory stores and aggregates samples of gateway connection stats.
+type GatewayConnectionStatsHistory interface {
+	// Add adds a sample of connection stats of a gateway. Samples in the same interval are merged.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, sample *ttnpb.GatewayConnectionStatsSample) error
+	// Range returns the samples of connection stats of a gateway in the given time range, ordered by time.
+	// The samples are aggregated to at least the requested resolution. The actual resolution is returned.
+	Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time, resolution time.Duration) ([]*ttnpb.GatewayConnectionStatsSample, time.Duration, error)
+}
+
 // EntityRegistry abstracts the Identity server gateway functions.
 type EntityRegistry interface {
 	// AssertGatewayRights checks whether the gateway authentication (provied in the context) contains the required rights.
@@ -0,0 +1,137 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+)
+
+const (
+	// BeaconPeriod is the period of Class B beacons. Beacons are transmitted at multiples of the period since GPS epoch.
+	BeaconPeriod = 128 * time.Second
+	// BeaconReserved is the time after the start of the beacon period that is reserved for the beacon transmission.
+	BeaconReserved = 2*time.Second + 120*time.Millisecond
+	// BeaconGuard is the time before the start of the beacon period in which no emission can start.
+	BeaconGuard = 3 * time.Second
+)
+
+var errBeaconConflict = errors.DefineAlreadyExists("beacon_conflict", "scheduling conflict with beacon at GPS time `{beacon_time}`")
+
+// ReserveBeacons enables reserving the beacon periods when the clock is synchronized with the gateway time.
+// Emissions that start in the beacon guard or overlap with the beacon reserved time are refused by ScheduleAt and
+// shifted by ScheduleAnytime.
+func (s *Scheduler) ReserveBeacons() {
+	s.mu.Lock()
+	s.reserveBeacons = true
+	s.mu.Unlock()
+}
+
+// beaconConflict returns whether the given emission conflicts with a beacon, and the concentrator time at which the
+// beacon reserved time of the conflicting beacon ends.
+// This method assumes that the mutex is held.
+func (s *Scheduler) beaconConflict(em Emission) (time.Duration, ConcentratorTime, bool) {
+	if !s.reserveBeacons {
+		return 0, 0, false
+	}
+	gatewayTime, ok := s.clock.ToGatewayTime(em.Starts())
+	if !ok {
+		return 0, 0, false
+	}
+	starts := gpstime.ToGPS(gatewayTime)
+	ends := starts + time.Duration(em.EndsWithOffAir(s.timeOffAir)-em.Starts())
+	for beacon := starts / BeaconPeriod * BeaconPeriod; beacon-BeaconGuard < ends; beacon += BeaconPeriod {
+		if starts < beacon+BeaconReserved && (starts >= beacon-BeaconGuard || ends > beacon) {
+			return beacon, em.Starts() + ConcentratorTime(beacon+BeaconReserved-starts), true
+		}
+	}
+	return 0, 0, false
+}
+
+// NextBeacon returns the beacon time as duration since GPS epoch of the first beacon after the given server time,
+// and the server time at which the beacon starts.
+// This method returns false if the clock is not synchronized with the gateway time.
+func (s *Scheduler) NextBeacon(after time.Time) (time.Duration, time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.clock.IsSynced() {
+		return 0, time.Time{}, false
+	}
+	concentratorTime, ok := s.clock.FromServerTime(after)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	gatewayTime, ok := s.clock.ToGatewayTime(concentratorTime)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	gps := gpstime.ToGPS(gatewayTime)
+	beacon := (gps/BeaconPeriod + 1) * BeaconPeriod
+	return beacon, s.clock.ToServerTime(concentratorTime + ConcentratorTime(beacon-gps)), true
+}
+
+// ScheduleBeacon attempts to schedule the beacon with the given Tx settings. The time in the Tx settings is the gateway
+// time at which the beacon starts.
+// Once a beacon is scheduled, the scheduler reserves the beacon periods, see ReserveBeacons.
+func (s *Scheduler) ScheduleBeacon(ctx context.Context, opts Options) (Emission, error) {
+	defer trace.StartRegion(ctx, "schedule beacon").End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.clock.IsSynced() {
+		return Emission{}, errNoClockSync.New()
+	}
+	if opts.Time == nil {
+		return Emission{}, errNoAbsoluteGatewayTime.New()
+	}
+	starts, ok := s.clock.FromGatewayTime(*opts.Time)
+	if !ok {
+		return Emission{}, errNoAbsoluteGatewayTime.New()
+	}
+	minScheduleTime := ScheduleTimeShort
+	if opts.RTTs != nil {
+		if _, _, _, np, n := opts.RTTs.Stats(scheduleLateRTTPercentile, s.timeSource.Now()); n >= scheduleMinRTTCount {
+			minScheduleTime = np/2 + QueueDelay
+		}
+	}
+	if now, ok := s.clock.FromServerTime(s.timeSource.Now()); ok {
+		if delta := time.Duration(starts - now); delta < minScheduleTime {
+			return Emission{}, errTooLate.WithAttributes("delta", delta)
+		}
+	}
+	sb, err := s.findSubBand(opts.Frequency)
+	if err != nil {
+		return Emission{}, err
+	}
+	em, err := s.newEmission(opts.PayloadSize, opts.TxSettings, starts)
+	if err != nil {
+		return Emission{}, err
+	}
+	for _, other := range s.emissions {
+		if em.OverlapsWithOffAir(other, s.timeOffAir) {
+			return Emission{}, errConflict.New()
+		}
+	}
+	if err := sb.Schedule(em, opts.Priority); err != nil {
+		return Emission{}, err
+	}
+	s.emissions = s.emissions.Insert(em)
+	s.reserveBeacons = true
+	return em, nil
+}
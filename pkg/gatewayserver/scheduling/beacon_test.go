@@ -0,0 +1,181 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func newBeaconTestScheduler(t *testing.T) (*scheduling.Scheduler, time.Time) {
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	serverTime := time.Unix(1000, 0)
+	scheduler, err := scheduling.NewScheduler(test.Context(), fps, false, nil, &mockTimeSource{
+		Time: serverTime,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create scheduler: %v", err)
+	}
+	// The concentrator time 0 is the start of a beacon period.
+	scheduler.SyncWithGatewayAbsolute(0, serverTime, gpstime.Parse(1000*scheduling.BeaconPeriod))
+	return scheduler, serverTime
+}
+
+var beaconTestSettings = ttnpb.TxSettings{
+	DataRate: ttnpb.DataRate{
+		Modulation: &ttnpb.DataRate_LoRa{
+			LoRa: &ttnpb.LoRaDataRate{
+				Bandwidth:       125000,
+				SpreadingFactor: 7,
+			},
+		},
+	},
+	CodingRate: "4/5",
+	Frequency:  869525000,
+}
+
+func TestScheduleAtWithBeacons(t *testing.T) {
+	scheduler, _ := newBeaconTestScheduler(t)
+	scheduler.ReserveBeacons()
+
+	for _, tc := range []struct {
+		Starts        time.Duration
+		ExpectedError *errors.Definition
+	}{
+		{
+			Starts: 10 * time.Second,
+		},
+		{
+			Starts: 124 * time.Second,
+		},
+		{
+			Starts:        126 * time.Second,
+			ExpectedError: &scheduling.ErrBeaconConflict,
+		},
+		{
+			Starts:        127*time.Second + 950*time.Millisecond,
+			ExpectedError: &scheduling.ErrBeaconConflict,
+		},
+		{
+			Starts:        129 * time.Second,
+			ExpectedError: &scheduling.ErrBeaconConflict,
+		},
+		{
+			Starts: 131 * time.Second,
+		},
+	} {
+		t.Run(fmt.Sprintf("%v", tc.Starts), func(t *testing.T) {
+			a := assertions.New(t)
+			settings := beaconTestSettings
+			settings.Timestamp = uint32(tc.Starts / time.Microsecond)
+			em, err := scheduler.ScheduleAt(test.Context(), scheduling.Options{
+				PayloadSize: 10,
+				TxSettings:  settings,
+				Priority:    ttnpb.TxSchedulePriority_NORMAL,
+			})
+			if tc.ExpectedError != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, *tc.ExpectedError)
+				return
+			}
+			if a.So(err, should.BeNil) {
+				a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(tc.Starts))
+			}
+		})
+	}
+}
+
+func TestScheduleAnytimeWithBeacons(t *testing.T) {
+	a := assertions.New(t)
+	scheduler, _ := newBeaconTestScheduler(t)
+	scheduler.ReserveBeacons()
+
+	settings := beaconTestSettings
+	settings.Timestamp = uint32(126 * time.Second / time.Microsecond)
+	em, err := scheduler.ScheduleAnytime(test.Context(), scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(scheduling.BeaconPeriod+scheduling.BeaconReserved))
+	}
+}
+
+func TestScheduleBeacon(t *testing.T) {
+	a := assertions.New(t)
+	scheduler, serverTime := newBeaconTestScheduler(t)
+
+	// Without beacon reservation, emissions during the beacon period are allowed.
+	settings := beaconTestSettings
+	settings.Timestamp = uint32(129 * time.Second / time.Microsecond)
+	_, err := scheduler.ScheduleAt(test.Context(), scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+
+	beacon, beaconServerTime, ok := scheduler.NextBeacon(serverTime.Add(200 * time.Second))
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(beacon, should.Equal, 1002*scheduling.BeaconPeriod)
+	a.So(beaconServerTime, should.Equal, serverTime.Add(2*scheduling.BeaconPeriod))
+
+	beaconSettings := ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: 9,
+				},
+			},
+		},
+		CodingRate: "4/5",
+		Frequency:  869525000,
+		Time:       timePtr(gpstime.Parse(beacon)),
+	}
+	em, err := scheduler.ScheduleBeacon(test.Context(), scheduling.Options{
+		PayloadSize: 17,
+		TxSettings:  beaconSettings,
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(2*scheduling.BeaconPeriod))
+
+	// Once a beacon is scheduled, the beacon periods are reserved.
+	settings.Timestamp = uint32((3*scheduling.BeaconPeriod + time.Second) / time.Microsecond)
+	_, err = scheduler.ScheduleAt(test.Context(), scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrBeaconConflict)
+}
@@ -116,6 +116,15 @@ func (c *RolloverClock) FromGatewayTime(gateway time.Time) (ConcentratorTime, bo
 	return c.absolute + ConcentratorTime(gateway.Sub(*c.gateway)), true
 }
 
+// ToGatewayTime returns an indication of the gateway time at the given concentrator time.
+// This method returns false if the clock is not synchronized with the gateway time.
+func (c *RolloverClock) ToGatewayTime(t ConcentratorTime) (time.Time, bool) {
+	if c.gateway == nil {
+		return time.Time{}, false
+	}
+	return c.gateway.Add(time.Duration(t - c.absolute)), true
+}
+
 // FromTimestampTime implements Clock.
 func (c *RolloverClock) FromTimestampTime(timestamp uint32) ConcentratorTime {
 	passed := int64(timestamp) - int64(c.relative)
@@ -189,6 +189,7 @@ type Scheduler struct {
 	mu                   sync.RWMutex
 	emissions            Emissions
 	scheduleAnytimeDelay time.Duration
+	reserveBeacons       bool
 }
 
 var errSubBandNotFound = errors.DefineFailedPrecondition("sub_band_not_found", "sub-band not found for frequency `{frequency}` Hz")
@@ -280,6 +281,7 @@ type Options struct {
 
 // ScheduleAt attempts to schedule the given Tx settings with the given priority.
 // If there are round-trip times available, the nth percentile (n = scheduleLateRTTPercentile) value will be used instead of ScheduleTimeShort.
+// If the beacon periods are reserved, emissions that conflict with a beacon are refused.
 func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, error) {
 	defer trace.StartRegion(ctx, "schedule transmission").End()
 
@@ -342,6 +344,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, err
 			return Emission{}, errConflict.New()
 		}
 	}
+	if beacon, _, ok := s.beaconConflict(em); ok {
+		return Emission{}, errBeaconConflict.WithAttributes("beacon_time", int64(beacon/time.Second))
+	}
 	if err := sb.Schedule(em, opts.Priority); err != nil {
 		return Emission{}, err
 	}
@@ -352,6 +357,7 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (Emission, err
 // ScheduleAnytime attempts to schedule the given Tx settings with the given priority from the time in the settings.
 // If there are round-trip times available, the maximum value will be used instead of ScheduleTimeShort.
 // This method returns the emission.
+// If the beacon periods are reserved, emissions that conflict with a beacon are shifted after the beacon reserved time.
 //
 // The scheduler does not support immediate scheduling, i.e. sending a message to the gateway that should be transmitted
 // immediately. The reason for this is that this scheduler cannot determine conflicts or enforce duty-cycle when the
@@ -398,7 +404,7 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 		return Emission{}, err
 	}
 	i := 0
-	next := func() ConcentratorTime {
+	nextAvailable := func() ConcentratorTime {
 		if len(s.emissions) == 0 {
 			// No emissions; schedule at the requested time.
 			return em.t
@@ -427,6 +433,18 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (Emission
 		}
 		return em.t
 	}
+	next := func() ConcentratorTime {
+		t := nextAvailable()
+		for {
+			// Shift the emission after the beacon reserved time when it conflicts with a beacon.
+			_, after, ok := s.beaconConflict(NewEmission(t, em.d))
+			if !ok {
+				return t
+			}
+			em.t = after
+			t = nextAvailable()
+		}
+	}
 	em, err = sb.ScheduleAnytime(em.d, next, opts.Priority)
 	if err != nil {
 		return Emission{}, err
@@ -19,4 +19,6 @@ var (
 	ErrDwellTime = errDwellTime
 	ErrTooLate   = errTooLate
 	ErrDutyCycle = errDutyCycle
+
+	ErrBeaconConflict = errBeaconConflict
 )
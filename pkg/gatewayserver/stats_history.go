@@ -0,0 +1,143 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const defaultStatsHistoryRange = 24 * time.Hour
+
+var (
+	errStatsHistoryNotConfigured = errors.DefineFailedPrecondition("stats_history_not_configured", "gateway connection stats history is not configured")
+	errStatsHistoryRange         = errors.DefineInvalidArgument("stats_history_range", "time range end `{to}` is before start `{from}`")
+)
+
+// connStatsSampler takes samples of the connection stats of a gateway connection.
+type connStatsSampler struct {
+	conn *io.Connection
+
+	start      time.Time
+	uplinks    uint64
+	downlinks  uint64
+	lastStatus time.Time
+}
+
+func newConnStatsSampler(conn *io.Connection) *connStatsSampler {
+	return &connStatsSampler{
+		conn:  conn,
+		start: conn.ConnectTime(),
+	}
+}
+
+// sample returns the sample of the interval since the previous sample.
+func (s *connStatsSampler) sample(now time.Time) *ttnpb.GatewayConnectionStatsSample {
+	sample := &ttnpb.GatewayConnectionStatsSample{
+		Time:    s.start,
+		Samples: 1,
+	}
+	if total, _, ok := s.conn.UpStats(); ok {
+		sample.UplinkCount, s.uplinks = total-s.uplinks, total
+	}
+	if total, _, ok := s.conn.DownStats(); ok {
+		sample.DownlinkCount, s.downlinks = total-s.downlinks, total
+	}
+	if _, max, median, p90, count := s.conn.RTTStats(90, now); count > 0 {
+		sample.RoundTripTimes = &ttnpb.GatewayConnectionStatsSample_RoundTripTimes{
+			Median: median,
+			P90:    p90,
+			Max:    max,
+			Count:  uint32(count),
+		}
+	}
+	if stats, _ := s.conn.Stats(); len(stats.SubBands) > 0 {
+		sample.SubBands = stats.SubBands
+	}
+	if status, t, ok := s.conn.StatusStats(); ok && t.After(s.lastStatus) {
+		sample.LastStatus, sample.LastStatusReceivedAt = status, &t
+		s.lastStatus = t
+	}
+	s.start = now
+	return sample
+}
+
+func (gs *GatewayServer) sampleConnStats(conn connectionEntry) {
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+
+	ids := conn.Connection.Gateway().GatewayIdentifiers
+	sampler := newConnStatsSampler(conn.Connection)
+	ticker := time.NewTicker(gs.config.StatsHistory.SampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// Store the partial sample of the last interval.
+			if err := gs.statsHistory.Add(gs.FromRequestContext(ctx), ids, sampler.sample(time.Now())); err != nil {
+				logger.WithError(err).Warn("Failed to add connection stats sample")
+			}
+			return
+		case now := <-ticker.C:
+			if err := gs.statsHistory.Add(ctx, ids, sampler.sample(now)); err != nil {
+				logger.WithError(err).Warn("Failed to add connection stats sample")
+			}
+		}
+	}
+}
+
+// GetGatewayConnectionStatsHistory returns the history of sampled connection stats of a gateway.
+// The gateway does not need to be connected.
+func (gs *GatewayServer) GetGatewayConnectionStatsHistory(ctx context.Context, req *ttnpb.GetGatewayConnectionStatsHistoryRequest) (*ttnpb.GatewayConnectionStatsHistory, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if gs.statsHistory == nil {
+		return nil, errStatsHistoryNotConfigured.New()
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = *req.To
+	}
+	from := to.Add(-defaultStatsHistoryRange)
+	if req.From != nil {
+		from = *req.From
+	}
+	if to.Before(from) {
+		return nil, errStatsHistoryRange.WithAttributes(
+			"from", from,
+			"to", to,
+		)
+	}
+	var resolution time.Duration
+	if req.Resolution != nil {
+		resolution = *req.Resolution
+	}
+
+	samples, resolution, err := gs.statsHistory.Range(ctx, req.GatewayIdentifiers, from, to, resolution)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayConnectionStatsHistory{
+		Resolution: resolution,
+		Samples:    samples,
+	}, nil
+}
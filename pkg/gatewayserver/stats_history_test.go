@@ -0,0 +1,204 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	er "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/entityregistry/is"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+type mockStatsHistory struct {
+	mu      sync.Mutex
+	samples []*ttnpb.GatewayConnectionStatsSample
+}
+
+func (h *mockStatsHistory) Add(_ context.Context, _ ttnpb.GatewayIdentifiers, sample *ttnpb.GatewayConnectionStatsSample) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.samples = append(h.samples, sample)
+	return nil
+}
+
+func (h *mockStatsHistory) Range(_ context.Context, _ ttnpb.GatewayIdentifiers, from, to time.Time, resolution time.Duration) ([]*ttnpb.GatewayConnectionStatsSample, time.Duration, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var res []*ttnpb.GatewayConnectionStatsSample
+	for _, s := range h.samples {
+		if !s.Time.Before(from) && !s.Time.After(to) {
+			res = append(res, s)
+		}
+	}
+	return res, resolution, nil
+}
+
+func (h *mockStatsHistory) uplinkCount() (total uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, s := range h.samples {
+		total += s.UplinkCount
+	}
+	return total
+}
+
+func TestConnectionStatsHistory(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	is, isAddr := startMockIS(ctx)
+	_, nsAddr := mock.StartNS(ctx)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":9190",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	history := &mockStatsHistory{}
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		StatsHistory: gatewayserver.StatsHistoryConfig{
+			Store:               history,
+			SampleInterval:      timeout,
+			SampleRetention:     time.Hour,
+			DownsampleInterval:  time.Hour,
+			DownsampleRetention: 24 * time.Hour,
+		},
+	}, gatewayserver.WithRegistry(er.New(c)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: registeredGatewayID,
+		Eui:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+	rightsCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		},
+	})
+
+	conn, err := grpc.Dial(":9190", append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	linkCtx, cancelLink := context.WithCancel(ctx)
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.GatewayId,
+		AuthType:      "Bearer",
+		AuthValue:     registeredGatewayKey,
+		AllowInsecure: true,
+	}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout) // Wait for the gateway to be connected.
+
+	for i := 0; i < 3; i++ {
+		if !a.So(link.Send(&ttnpb.GatewayUp{
+			UplinkMessages: []*ttnpb.UplinkMessage{
+				{
+					Settings: ttnpb.TxSettings{
+						DataRate: ttnpb.DataRate{
+							Modulation: &ttnpb.DataRate_LoRa{
+								LoRa: &ttnpb.LoRaDataRate{
+									SpreadingFactor: 7,
+									Bandwidth:       125000,
+								},
+							},
+						},
+						CodingRate: "4/5",
+						Frequency:  868100000,
+						Timestamp:  uint32(100 + i),
+					},
+					RxMetadata: []*ttnpb.RxMetadata{
+						{
+							GatewayIdentifiers: ids,
+							Timestamp:          uint32(100 + i),
+						},
+					},
+					RawPayload: randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6),
+				},
+			},
+		}), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	time.Sleep(2 * timeout) // Wait for the uplink messages to be sampled.
+	a.So(history.uplinkCount(), should.Equal, 3)
+
+	// The partial sample of the last interval is added on disconnect.
+	cancelLink()
+	time.Sleep(timeout)
+	history.mu.Lock()
+	samples := len(history.samples)
+	history.mu.Unlock()
+	a.So(samples, should.BeGreaterThanOrEqualTo, 2)
+
+	from, to := time.Now().Add(time.Minute), time.Now()
+	_, err = gs.GetGatewayConnectionStatsHistory(rightsCtx, &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+		GatewayIdentifiers: ids,
+		From:               &from,
+		To:                 &to,
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	res, err := gs.GetGatewayConnectionStatsHistory(rightsCtx, &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+		GatewayIdentifiers: ids,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Samples, should.HaveLength, samples)
+	var uplinks uint64
+	for _, s := range res.Samples {
+		a.So(s.Samples, should.Equal, 1)
+		uplinks += s.UplinkCount
+	}
+	a.So(uplinks, should.Equal, 3)
+}
@@ -0,0 +1,120 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errFilterFrequency = errors.Define("filter_frequency", "frequency `{frequency}` is not allowed")
+	errFilterSNR       = errors.Define("filter_snr", "SNR `{snr}` is below the minimum `{min_snr}`")
+	errFilterRSSI      = errors.Define("filter_rssi", "RSSI `{rssi}` is below the minimum `{min_rssi}`")
+	errFilterDevAddr   = errors.Define("filter_dev_addr", "DevAddr `{dev_addr}` is not allowed")
+	errFilterJoinEUI   = errors.Define("filter_join_eui", "JoinEUI `{join_eui}` is not allowed")
+)
+
+func matchDevAddrPrefixes(devAddr types.DevAddr, prefixes []*ttnpb.DevAddrPrefix) bool {
+	for _, prefix := range prefixes {
+		if prefix.DevAddr == nil {
+			continue
+		}
+		if devAddr.HasPrefix(types.DevAddrPrefix{
+			DevAddr: *prefix.DevAddr,
+			Length:  uint8(prefix.Length),
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchJoinEUIRanges(joinEUI types.EUI64, ranges []*ttnpb.GatewayUplinkFilters_JoinEUIRange) bool {
+	n := joinEUI.MarshalNumber()
+	for _, r := range ranges {
+		if n >= r.From.MarshalNumber() && n <= r.To.MarshalNumber() {
+			return true
+		}
+	}
+	return false
+}
+
+// filterUplink returns an error if the uplink message does not pass the uplink filters.
+// The SNR and RSSI filters apply to the antenna with the best signal. The DevAddr filters apply to data uplink
+// messages and the JoinEUI filters apply to join-request and rejoin-request messages.
+func filterUplink(filters *ttnpb.GatewayUplinkFilters, msg *ttnpb.UplinkMessage) error {
+	if filters == nil {
+		return nil
+	}
+
+	if len(filters.Frequencies) > 0 {
+		var pass bool
+		for _, frequency := range filters.Frequencies {
+			if frequency == msg.Settings.Frequency {
+				pass = true
+				break
+			}
+		}
+		if !pass {
+			return errFilterFrequency.WithAttributes("frequency", msg.Settings.Frequency)
+		}
+	}
+
+	if len(msg.RxMetadata) > 0 {
+		snr, rssi := msg.RxMetadata[0].SNR, msg.RxMetadata[0].RSSI
+		for _, md := range msg.RxMetadata[1:] {
+			if md.SNR > snr {
+				snr = md.SNR
+			}
+			if md.RSSI > rssi {
+				rssi = md.RSSI
+			}
+		}
+		if min := filters.MinSNR; min != nil && snr < min.Value {
+			return errFilterSNR.WithAttributes(
+				"snr", snr,
+				"min_snr", min.Value,
+			)
+		}
+		if min := filters.MinRSSI; min != nil && rssi < min.Value {
+			return errFilterRSSI.WithAttributes(
+				"rssi", rssi,
+				"min_rssi", min.Value,
+			)
+		}
+	}
+
+	ids, err := lorawan.GetUplinkMessageIdentifiers(msg.RawPayload)
+	if err != nil {
+		// Uplink messages without identifiers are handled by the upstream handlers.
+		return nil
+	}
+	switch {
+	case ids.DevAddr != nil:
+		if matchDevAddrPrefixes(*ids.DevAddr, filters.DenyDevAddrPrefixes) ||
+			len(filters.AllowDevAddrPrefixes) > 0 && !matchDevAddrPrefixes(*ids.DevAddr, filters.AllowDevAddrPrefixes) {
+			return errFilterDevAddr.WithAttributes("dev_addr", *ids.DevAddr)
+		}
+	case ids.JoinEui != nil:
+		if matchJoinEUIRanges(*ids.JoinEui, filters.DenyJoinEUIRanges) ||
+			len(filters.AllowJoinEUIRanges) > 0 && !matchJoinEUIRanges(*ids.JoinEui, filters.AllowJoinEUIRanges) {
+			return errFilterJoinEUI.WithAttributes("join_eui", *ids.JoinEui)
+		}
+	}
+	return nil
+}
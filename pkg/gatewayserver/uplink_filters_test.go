@@ -0,0 +1,222 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	er "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/entityregistry/is"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestUplinkFilters(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	is, isAddr := startMockIS(ctx)
+	ns, nsAddr := mock.StartNS(ctx)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":9192",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{}, gatewayserver.WithRegistry(er.New(c)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayId: registeredGatewayID,
+		Eui:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false, false)
+	filters := &ttnpb.GatewayUplinkFilters{
+		AllowDevAddrPrefixes: []*ttnpb.DevAddrPrefix{
+			{DevAddr: &types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 7},
+		},
+		DenyJoinEUIRanges: []*ttnpb.GatewayUplinkFilters_JoinEUIRange{
+			{
+				From: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+				To:   types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0xff},
+			},
+		},
+		MinSNR:      &pbtypes.FloatValue{Value: -10},
+		Frequencies: []uint64{868100000, 868300000, 868500000},
+	}
+	is.gateways[unique.ID(ctx, ids)].UplinkFilters = filters
+
+	conn, err := grpc.Dial(":9192", append(rpcclient.DefaultDialOptions(ctx), grpc.WithInsecure(), grpc.WithBlock())...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+
+	rightsCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		},
+	})
+
+	var timestamp uint32
+	uplink := func(rawPayload []byte, frequency uint64, snr float32) *ttnpb.UplinkMessage {
+		timestamp += 1000
+		return &ttnpb.UplinkMessage{
+			Settings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 7,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  frequency,
+				Timestamp:  timestamp,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ids,
+					Timestamp:          timestamp,
+					RSSI:               -69,
+					ChannelRSSI:        -69,
+					SNR:                snr,
+				},
+			},
+			RawPayload: rawPayload,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name   string
+		DryRun bool
+	}{
+		{
+			Name: "Enforce",
+		},
+		{
+			Name:   "DryRun",
+			DryRun: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			filters.DryRun = tc.DryRun
+
+			linkCtx, cancelLink := context.WithCancel(ctx)
+			defer cancelLink()
+			link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, grpc.PerRPCCredentials(rpcmetadata.MD{
+				ID:            ids.GatewayId,
+				AuthType:      "Bearer",
+				AuthValue:     registeredGatewayKey,
+				AllowInsecure: true,
+			}))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			time.Sleep(timeout) // Wait for the gateway to be connected.
+
+			pass := []*ttnpb.UplinkMessage{
+				uplink(randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6), 868100000, 7),
+				uplink(randomJoinRequestPayload(types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}), 868300000, 7),
+			}
+			filter := []*ttnpb.UplinkMessage{
+				uplink(randomUpDataPayload(types.DevAddr{0x01, 0x02, 0x03, 0x04}, 1, 6), 868100000, 7),
+				uplink(randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6), 868100000, -15),
+				uplink(randomUpDataPayload(types.DevAddr{0x26, 0x01, 0xff, 0xff}, 1, 6), 867100000, 7),
+				uplink(randomJoinRequestPayload(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}, types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}), 868500000, 7),
+			}
+			if !a.So(link.Send(&ttnpb.GatewayUp{
+				UplinkMessages: append(append([]*ttnpb.UplinkMessage{}, pass...), filter...),
+			}), should.BeNil) {
+				t.FailNow()
+			}
+
+			expected := make(map[string]bool)
+			for _, up := range pass {
+				expected[string(up.RawPayload)] = true
+			}
+			if tc.DryRun {
+				for _, up := range filter {
+					expected[string(up.RawPayload)] = true
+				}
+			}
+			for len(expected) > 0 {
+				select {
+				case msg := <-ns.Up():
+					if !a.So(expected[string(msg.RawPayload)], should.BeTrue) {
+						t.FailNow()
+					}
+					delete(expected, string(msg.RawPayload))
+				case <-time.After(timeout):
+					t.Fatalf("Expected %d more uplink messages", len(expected))
+				}
+			}
+			select {
+			case msg := <-ns.Up():
+				t.Fatalf("Unexpected uplink message %v", msg)
+			case <-time.After(timeout):
+			}
+
+			stats, err := gs.GetGatewayConnectionStats(rightsCtx, &ids)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(stats.UplinkCount, should.Equal, len(pass)+len(filter))
+			if tc.DryRun {
+				a.So(stats.FilteredUplinkCount, should.Equal, 0)
+				a.So(stats.DryRunFilteredUplinkCount, should.Equal, len(filter))
+			} else {
+				a.So(stats.FilteredUplinkCount, should.Equal, len(filter))
+				a.So(stats.DryRunFilteredUplinkCount, should.Equal, 0)
+			}
+
+			cancelLink()
+			time.Sleep(timeout) // Wait for the gateway to be disconnected.
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proprietary forwards proprietary uplink messages to an HTTP target through the upstream.ProprietaryHandler interface.
+package proprietary
+
+import (
+	"bytes"
+	"context"
+	stdio "io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+const forwardTimeout = 5 * time.Second
+
+// Config configures the Handler.
+type Config struct {
+	// Target is the URL to which the proprietary uplink messages are posted.
+	Target string
+	// Headers are the HTTP headers that are set on each request.
+	Headers    map[string]string
+	HTTPClient *http.Client
+}
+
+// Handler is the upstream handler.
+type Handler struct {
+	ctx context.Context
+	Config
+}
+
+// NewHandler returns a new upstream handler.
+func NewHandler(ctx context.Context, config Config) *Handler {
+	return &Handler{
+		ctx:    ctx,
+		Config: config,
+	}
+}
+
+// DevAddrPrefixes implements upstream.Handler.
+func (h *Handler) DevAddrPrefixes() []types.DevAddrPrefix {
+	return nil
+}
+
+var errTarget = errors.DefineInvalidArgument("target", "invalid target `{target}`")
+
+// Setup implements upstream.Handler.
+func (h *Handler) Setup(context.Context) error {
+	target, err := url.Parse(h.Target)
+	if err != nil {
+		return errTarget.WithCause(err).WithAttributes("target", h.Target)
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return errTarget.WithAttributes("target", h.Target)
+	}
+	if h.HTTPClient == nil {
+		h.HTTPClient = http.DefaultClient
+	}
+	return nil
+}
+
+// ConnectGateway implements upstream.Handler.
+func (h *Handler) ConnectGateway(context.Context, ttnpb.GatewayIdentifiers, *io.Connection) error {
+	return nil
+}
+
+// HandleUplink implements upstream.Handler.
+// LoRaWAN uplink messages are not handled.
+func (h *Handler) HandleUplink(context.Context, ttnpb.GatewayIdentifiers, ttnpb.EndDeviceIdentifiers, *ttnpb.GatewayUplinkMessage) error {
+	return nil
+}
+
+var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
+
+// HandleProprietaryUplink implements upstream.ProprietaryHandler.
+// The gateway uplink message is posted as JSON to the target.
+func (h *Handler) HandleProprietaryUplink(ctx context.Context, _ ttnpb.GatewayIdentifiers, msg *ttnpb.GatewayUplinkMessage) error {
+	buf, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, forwardTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.Target, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	for key, value := range h.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := h.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		stdio.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+	return errRequest.WithAttributes("code", res.StatusCode)
+}
+
+// HandleStatus implements upstream.Handler.
+func (h *Handler) HandleStatus(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.GatewayStatus) error {
+	return nil
+}
+
+// HandleTxAck implements upstream.Handler.
+func (h *Handler) HandleTxAck(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.TxAcknowledgment) error {
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proprietary_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/proprietary"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestProprietaryHandler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+
+	reqCh := make(chan *http.Request, 1)
+	bodyCh := make(chan []byte, 1)
+	statusCh := make(chan int, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reqCh <- r
+		bodyCh <- body
+		w.WriteHeader(<-statusCh)
+	}))
+	defer srv.Close()
+
+	a.So(errors.IsInvalidArgument(NewHandler(ctx, Config{Target: "ftp://localhost"}).Setup(ctx)), should.BeTrue)
+
+	var h upstream.ProprietaryHandler = NewHandler(ctx, Config{
+		Target: srv.URL,
+		Headers: map[string]string{
+			"Authorization": "Bearer secret",
+		},
+	})
+	if !a.So(h.Setup(ctx), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(h.DevAddrPrefixes(), should.BeEmpty)
+
+	msg := &ttnpb.GatewayUplinkMessage{
+		BandID: band.EU_863_870,
+		UplinkMessage: &ttnpb.UplinkMessage{
+			RawPayload: []byte{0xE0, 0x01, 0x02, 0x03},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIdentifiers: gtwIDs,
+				Timestamp:          42,
+				RSSI:               -42,
+				SNR:                7.5,
+			}},
+			Settings: ttnpb.TxSettings{
+				Frequency: 868100000,
+			},
+		},
+	}
+	statusCh <- http.StatusOK
+	if !a.So(h.HandleProprietaryUplink(ctx, gtwIDs, msg), should.BeNil) {
+		t.FailNow()
+	}
+	req := <-reqCh
+	a.So(req.Method, should.Equal, http.MethodPost)
+	a.So(req.Header.Get("Authorization"), should.Equal, "Bearer secret")
+	a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+	var received ttnpb.GatewayUplinkMessage
+	if a.So(jsonpb.TTN().Unmarshal(<-bodyCh, &received), should.BeNil) {
+		a.So(received, should.Resemble, *msg)
+	}
+
+	statusCh <- http.StatusInternalServerError
+	err := h.HandleProprietaryUplink(ctx, gtwIDs, msg)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	<-reqCh
+	<-bodyCh
+}
@@ -0,0 +1,536 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package udp implements an upstream.Handler that forwards gateway traffic to a network server using the Semtech UDP
+// packet forwarder protocol.
+package udp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	// DefaultKeepaliveInterval is the default interval of PULL_DATA keepalive messages.
+	DefaultKeepaliveInterval = 10 * time.Second
+
+	// maxRecentUplinks is the number of recent uplink messages per gateway that are used to match PULL_RESP
+	// timestamps with uplink tokens.
+	maxRecentUplinks = 16
+	// maxRxDelay is the maximum delay between an uplink message and a class A downlink message.
+	maxRxDelay = 15 * time.Second
+	// maxPacketSize is the maximum size of a UDP packet.
+	maxPacketSize = 65507
+	// maxTxAckWait is the maximum time that a scheduled downlink message waits for the TX_ACK of the gateway.
+	maxTxAckWait = 2 * time.Minute
+)
+
+var (
+	errResolveAddress = errors.DefineInvalidArgument("resolve_address", "resolve address `{address}`")
+	errNoSession      = errors.DefineUnavailable("no_session", "no UDP session for gateway `{gateway_uid}`")
+	errNoUplink       = errors.DefineNotFound("no_uplink", "no uplink message found for downlink timestamp `{timestamp}`")
+	errDataRate       = errors.DefineInvalidArgument("data_rate", "data rate `{data_rate}` not found in band `{band_id}`")
+)
+
+// Config configures the Handler.
+type Config struct {
+	// Address is the host and port of the network server.
+	Address string
+	// KeepaliveInterval is the interval of PULL_DATA keepalive messages.
+	KeepaliveInterval time.Duration
+	DevAddrPrefixes   []types.DevAddrPrefix
+}
+
+// Handler is the upstream handler that forwards gateway traffic with the Semtech UDP packet forwarder protocol.
+// Each connected gateway has its own UDP socket, so that the network server can address downlink messages to the
+// gateway.
+type Handler struct {
+	ctx context.Context
+	Config
+
+	addr     *net.UDPAddr
+	sessions sync.Map // string to *session
+}
+
+// NewHandler returns a new upstream handler.
+func NewHandler(ctx context.Context, config Config) *Handler {
+	if config.KeepaliveInterval <= 0 {
+		config.KeepaliveInterval = DefaultKeepaliveInterval
+	}
+	return &Handler{
+		ctx:    log.NewContextWithField(ctx, "namespace", "gatewayserver/upstream/udp"),
+		Config: config,
+	}
+}
+
+// DevAddrPrefixes implements upstream.Handler.
+func (h *Handler) DevAddrPrefixes() []types.DevAddrPrefix {
+	return h.Config.DevAddrPrefixes
+}
+
+// Setup implements upstream.Handler.
+func (h *Handler) Setup(context.Context) error {
+	addr, err := net.ResolveUDPAddr("udp", h.Address)
+	if err != nil {
+		return errResolveAddress.WithCause(err).WithAttributes("address", h.Address)
+	}
+	h.addr = addr
+	return nil
+}
+
+type pendingTxAck struct {
+	token     [2]byte
+	scheduled time.Time
+}
+
+type recentUplink struct {
+	timestamp   uint32
+	uplinkToken []byte
+}
+
+// session is the UDP session of a gateway with the network server.
+type session struct {
+	ids     ttnpb.GatewayIdentifiers
+	conn    *io.Connection
+	udpConn *net.UDPConn
+
+	token      uint32
+	pushes     uint32
+	pushAcks   uint32
+	downlinks  uint32
+	txPackets  uint32
+	uplinkMu   sync.Mutex
+	uplinks    [maxRecentUplinks]recentUplink
+	uplinkNext int
+
+	txAckMu sync.Mutex
+	txAcks  map[string]pendingTxAck // correlation ID to pending TX_ACK
+}
+
+func (s *session) nextToken() (token [2]byte) {
+	t := atomic.AddUint32(&s.token, 1)
+	token[0], token[1] = byte(t), byte(t>>8)
+	return token
+}
+
+func (s *session) write(packet encoding.Packet) error {
+	packet.ProtocolVersion = encoding.Version2
+	packet.GatewayEUI = s.ids.Eui
+	buf, err := packet.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = s.udpConn.Write(buf)
+	return err
+}
+
+// addTxAck registers a pending TX_ACK for the downlink message with the given correlation ID.
+// Pending TX_ACKs for which the gateway never reported a result are dropped after maxTxAckWait.
+func (s *session) addTxAck(correlationID string, token [2]byte, now time.Time) {
+	s.txAckMu.Lock()
+	defer s.txAckMu.Unlock()
+	for id, pending := range s.txAcks {
+		if now.Sub(pending.scheduled) > maxTxAckWait {
+			delete(s.txAcks, id)
+		}
+	}
+	s.txAcks[correlationID] = pendingTxAck{
+		token:     token,
+		scheduled: now,
+	}
+}
+
+// takeTxAck removes and returns the pending TX_ACK that matches any of the given correlation IDs.
+func (s *session) takeTxAck(correlationIDs []string) (pendingTxAck, bool) {
+	s.txAckMu.Lock()
+	defer s.txAckMu.Unlock()
+	for _, id := range correlationIDs {
+		if pending, ok := s.txAcks[id]; ok {
+			delete(s.txAcks, id)
+			return pending, true
+		}
+	}
+	return pendingTxAck{}, false
+}
+
+func (s *session) writeTxAck(token [2]byte, txErr encoding.TxError) error {
+	return s.write(encoding.Packet{
+		Token:      token,
+		PacketType: encoding.TxAck,
+		Data: &encoding.Data{
+			TxPacketAck: &encoding.TxPacketAck{
+				Error: txErr,
+			},
+		},
+	})
+}
+
+func (s *session) push(data *encoding.Data) error {
+	atomic.AddUint32(&s.pushes, 1)
+	return s.write(encoding.Packet{
+		Token:      s.nextToken(),
+		PacketType: encoding.PushData,
+		Data:       data,
+	})
+}
+
+func (s *session) addUplink(timestamp uint32, uplinkToken []byte) {
+	s.uplinkMu.Lock()
+	s.uplinks[s.uplinkNext] = recentUplink{
+		timestamp:   timestamp,
+		uplinkToken: uplinkToken,
+	}
+	s.uplinkNext = (s.uplinkNext + 1) % maxRecentUplinks
+	s.uplinkMu.Unlock()
+}
+
+// findUplink returns the uplink token and the receive delay of the most recent uplink message that precedes the given
+// downlink timestamp by a whole number of seconds.
+func (s *session) findUplink(timestamp uint32) ([]byte, ttnpb.RxDelay, bool) {
+	s.uplinkMu.Lock()
+	defer s.uplinkMu.Unlock()
+	for i := 1; i <= maxRecentUplinks; i++ {
+		up := s.uplinks[(s.uplinkNext-i+maxRecentUplinks)%maxRecentUplinks]
+		if up.uplinkToken == nil {
+			continue
+		}
+		delta := time.Duration(timestamp-up.timestamp) * time.Microsecond
+		if delta < time.Second || delta > maxRxDelay || delta%time.Second != 0 {
+			continue
+		}
+		return up.uplinkToken, ttnpb.RxDelay(delta / time.Second), true
+	}
+	return nil, 0, false
+}
+
+// ConnectGateway implements upstream.Handler.
+// The gateway is identified by its EUI in the UDP session with the network server. Gateways without EUI are not
+// connected.
+func (h *Handler) ConnectGateway(ctx context.Context, ids ttnpb.GatewayIdentifiers, conn *io.Connection) error {
+	logger := log.FromContext(ctx)
+	if ids.Eui == nil {
+		logger.Warn("Gateway has no EUI, not connecting to UDP upstream")
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	udpConn, err := net.DialUDP("udp", nil, h.addr)
+	if err != nil {
+		return err
+	}
+	defer udpConn.Close()
+
+	s := &session{
+		ids:     ids,
+		conn:    conn,
+		udpConn: udpConn,
+		txAcks:  make(map[string]pendingTxAck),
+	}
+	uid := unique.ID(ctx, ids)
+	h.sessions.Store(uid, s)
+	defer h.sessions.Delete(uid)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.handlePackets(ctx, s)
+	}()
+
+	ticker := time.NewTicker(h.KeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.write(encoding.Packet{
+			Token:      s.nextToken(),
+			PacketType: encoding.PullData,
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to send PULL_DATA")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errCh:
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) handlePackets(ctx context.Context, s *session) error {
+	logger := log.FromContext(ctx)
+	go func() {
+		<-ctx.Done()
+		s.udpConn.Close()
+	}()
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, err := s.udpConn.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		var packet encoding.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal packet")
+			continue
+		}
+		switch packet.PacketType {
+		case encoding.PushAck:
+			atomic.AddUint32(&s.pushAcks, 1)
+		case encoding.PullAck:
+		case encoding.PullResp:
+			if packet.Data == nil || packet.Data.TxPacket == nil {
+				logger.Debug("Received PULL_RESP without packet")
+				continue
+			}
+			atomic.AddUint32(&s.downlinks, 1)
+			// If the downlink message is scheduled, the TX_ACK is sent when the gateway acknowledges the transmission.
+			if txErr := h.scheduleDown(ctx, s, packet.Token, packet.Data.TxPacket); txErr != encoding.TxErrNone {
+				if err := s.writeTxAck(packet.Token, txErr); err != nil {
+					logger.WithError(err).Warn("Failed to send TX_ACK")
+				}
+			}
+		default:
+			logger.WithField("packet_type", packet.PacketType).Debug("Received unexpected packet")
+		}
+	}
+}
+
+// txErrors maps the names of scheduling errors to errors in the TX_ACK.
+var txErrors = map[string]encoding.TxError{
+	"conflict":                 encoding.TxErrCollisionPacket,
+	"duty_cycle":               encoding.TxErrCollisionPacket,
+	"too_late":                 encoding.TxErrTooLate,
+	"no_uplink":                encoding.TxErrTooLate,
+	"no_clock_sync":            encoding.TxErrGPSUnlocked,
+	"no_absolute_gateway_time": encoding.TxErrGPSUnlocked,
+	"no_gps_sync":              encoding.TxErrGPSUnlocked,
+	"sub_band_not_found":       encoding.TxErrTxFreq,
+	"data_rate":                encoding.TxErrTxFreq,
+}
+
+func txError(err error) encoding.TxError {
+	var details []*ttnpb.ErrorDetails
+	if ttnErr, ok := errors.From(err); ok {
+		for _, d := range ttnErr.Details() {
+			if scheduleDetails, ok := d.(*ttnpb.ScheduleDownlinkErrorDetails); ok {
+				details = append(details, scheduleDetails.PathErrors...)
+			}
+		}
+		if txErr, ok := txErrors[ttnErr.Name()]; ok {
+			return txErr
+		}
+	}
+	for _, d := range details {
+		for ; d != nil; d = d.Cause {
+			if txErr, ok := txErrors[d.Name]; ok {
+				return txErr
+			}
+		}
+	}
+	// The packet forwarder protocol has no generic error.
+	return encoding.TxErrCollisionPacket
+}
+
+// scheduleDown schedules the Tx packet of a PULL_RESP on the gateway connection and returns the error to report in
+// the TX_ACK. If the packet is scheduled, the TX_ACK with the given token is pending until the gateway acknowledges the
+// transmission; see HandleTxAck. Packets with a concentrator timestamp are scheduled as class A downlink message in reply to the uplink
+// message with that timestamp minus the receive delay. Packets with an absolute time or that are sent immediately are
+// scheduled as class C downlink message.
+func (h *Handler) scheduleDown(ctx context.Context, s *session, token [2]byte, tx *encoding.TxPacket) encoding.TxError {
+	logger := log.FromContext(ctx)
+	msg, err := toDownlinkMessage(s, tx)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to convert downlink message")
+		return txError(err)
+	}
+	correlationID := fmt.Sprintf("gs:udp_upstream:%s", events.NewCorrelationID())
+	msg.CorrelationIDs = append(msg.CorrelationIDs, correlationID)
+	// Register the pending TX_ACK before scheduling, as the gateway may acknowledge the transmission immediately.
+	s.addTxAck(correlationID, token, time.Now())
+	if _, _, _, err := s.conn.ScheduleDown(msg.GetRequest().DownlinkPaths[0], msg); err != nil {
+		s.takeTxAck([]string{correlationID})
+		logger.WithError(err).Debug("Failed to schedule downlink message")
+		return txError(err)
+	}
+	logger.Debug("Scheduled downlink message")
+	return encoding.TxErrNone
+}
+
+func toDownlinkMessage(s *session, tx *encoding.TxPacket) (*ttnpb.DownlinkMessage, error) {
+	msg, err := encoding.ToDownlinkMessage(tx)
+	if err != nil {
+		return nil, err
+	}
+	scheduled := msg.GetScheduled()
+	phy, err := band.GetByID(s.conn.BandID())
+	if err != nil {
+		return nil, err
+	}
+	// Downlink-only data rates are at higher indexes than uplink data rates, so the highest index is used.
+	drIndex, found := ttnpb.DataRateIndex(0), false
+	for i, dr := range phy.DataRates {
+		if dr.Rate.Equal(scheduled.DataRate) && (!found || i > drIndex) {
+			drIndex, found = i, true
+		}
+	}
+	if !found {
+		return nil, errDataRate.WithAttributes(
+			"data_rate", scheduled.DataRate,
+			"band_id", phy.ID,
+		)
+	}
+
+	req := &ttnpb.TxRequest{
+		Rx1DataRateIndex: drIndex,
+		Rx1Frequency:     scheduled.Frequency,
+		Priority:         ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if fpIDs := s.conn.Gateway().FrequencyPlanIDs; len(fpIDs) > 0 {
+		req.FrequencyPlanID = fpIDs[0]
+	}
+	switch {
+	case tx.Imme || scheduled.Time != nil:
+		req.Class = ttnpb.CLASS_C
+		req.AbsoluteTime = scheduled.Time
+		req.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_Fixed{
+					Fixed: &ttnpb.GatewayAntennaIdentifiers{
+						GatewayIdentifiers: s.ids,
+					},
+				},
+			},
+		}
+	default:
+		uplinkToken, rxDelay, ok := s.findUplink(tx.Tmst)
+		if !ok {
+			return nil, errNoUplink.WithAttributes("timestamp", tx.Tmst)
+		}
+		req.Class = ttnpb.CLASS_A
+		req.Rx1Delay = rxDelay
+		req.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: uplinkToken,
+				},
+			},
+		}
+	}
+	return &ttnpb.DownlinkMessage{
+		RawPayload: msg.RawPayload,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: req,
+		},
+	}, nil
+}
+
+func (h *Handler) session(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*session, error) {
+	uid := unique.ID(ctx, ids)
+	val, ok := h.sessions.Load(uid)
+	if !ok {
+		return nil, errNoSession.WithAttributes("gateway_uid", uid)
+	}
+	return val.(*session), nil
+}
+
+// HandleUplink implements upstream.Handler.
+func (h *Handler) HandleUplink(ctx context.Context, gtwIDs ttnpb.GatewayIdentifiers, _ ttnpb.EndDeviceIdentifiers, msg *ttnpb.GatewayUplinkMessage) error {
+	s, err := h.session(ctx, gtwIDs)
+	if err != nil {
+		return err
+	}
+	rxs, _, _ := encoding.FromGatewayUp(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{msg.UplinkMessage},
+	})
+	if len(rxs) == 0 {
+		return nil
+	}
+	md := msg.RxMetadata[0]
+	rx := rxs[0]
+	// The Gateway Server only handles uplink messages with a valid CRC.
+	rx.Stat = 1
+	if md.Time != nil {
+		t := encoding.CompactTime(*md.Time)
+		rx.Time = &t
+	}
+	if md.UplinkToken != nil {
+		s.addUplink(md.Timestamp, md.UplinkToken)
+	}
+	return s.push(&encoding.Data{
+		RxPacket: rxs,
+	})
+}
+
+// HandleStatus implements upstream.Handler.
+func (h *Handler) HandleStatus(ctx context.Context, ids ttnpb.GatewayIdentifiers, status *ttnpb.GatewayStatus) error {
+	s, err := h.session(ctx, ids)
+	if err != nil {
+		return err
+	}
+	_, stat, _ := encoding.FromGatewayUp(&ttnpb.GatewayUp{
+		GatewayStatus: status,
+	})
+	if total, _, ok := s.conn.UpStats(); ok {
+		stat.RxNb = uint32(total)
+		stat.RxOk = uint32(total)
+		stat.RxFW = uint32(total)
+	}
+	if pushes := atomic.LoadUint32(&s.pushes); pushes > 0 {
+		stat.ACKR = 100 * float64(atomic.LoadUint32(&s.pushAcks)) / float64(pushes)
+	}
+	stat.DWNb = atomic.LoadUint32(&s.downlinks)
+	stat.TxNb = atomic.LoadUint32(&s.txPackets)
+	return s.push(&encoding.Data{
+		Stat: stat,
+	})
+}
+
+// HandleTxAck implements upstream.Handler.
+// The TX_ACK is reported to the network server with the result of the gateway, if the acknowledged downlink message
+// was received from the network server.
+func (h *Handler) HandleTxAck(ctx context.Context, ids ttnpb.GatewayIdentifiers, ack *ttnpb.TxAcknowledgment) error {
+	s, err := h.session(ctx, ids)
+	if err != nil {
+		return err
+	}
+	correlationIDs := ack.GetDownlinkMessage().GetCorrelationIDs()
+	if len(correlationIDs) == 0 {
+		correlationIDs = ack.CorrelationIDs
+	}
+	pending, ok := s.takeTxAck(correlationIDs)
+	if !ok {
+		return nil
+	}
+	_, _, txAck := encoding.FromGatewayUp(&ttnpb.GatewayUp{
+		TxAcknowledgment: ack,
+	})
+	if txAck.Error == encoding.TxErrNone {
+		atomic.AddUint32(&s.txPackets, 1)
+	}
+	return s.writeTxAck(pending.token, txAck.Error)
+}
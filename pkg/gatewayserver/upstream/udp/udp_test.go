@@ -0,0 +1,278 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/datarate"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 5) * test.Delay
+
+func TestUDPHandler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	gtwIDs := ttnpb.GatewayIdentifiers{
+		GatewayId: "test-gateway",
+		Eui:       &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01},
+	}
+
+	lns, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lns.Close()
+
+	var gtwAddr *net.UDPAddr
+	read := func(t *testing.T, expected encoding.PacketType) encoding.Packet {
+		buf := make([]byte, 65507)
+		lns.SetReadDeadline(time.Now().Add(timeout))
+		n, addr, err := lns.ReadFromUDP(buf)
+		if err != nil {
+			t.Fatalf("Failed to read packet: %v", err)
+		}
+		gtwAddr = addr
+		var packet encoding.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			t.Fatalf("Failed to unmarshal packet: %v", err)
+		}
+		if packet.PacketType != expected {
+			t.Fatalf("Expected packet type %v but got %v", expected, packet.PacketType)
+		}
+		return packet
+	}
+	write := func(t *testing.T, packet encoding.Packet) {
+		packet.ProtocolVersion = encoding.Version2
+		buf, err := packet.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Failed to marshal packet: %v", err)
+		}
+		if _, err := lns.WriteToUDP(buf, gtwAddr); err != nil {
+			t.Fatalf("Failed to write packet: %v", err)
+		}
+	}
+
+	a.So(errors.IsInvalidArgument(NewHandler(ctx, Config{Address: "localhost:invalid"}).Setup(ctx)), should.BeTrue)
+
+	h := NewHandler(ctx, Config{
+		Address: lns.LocalAddr().String(),
+	})
+	var _ upstream.Handler = h
+	if !a.So(h.Setup(ctx), should.BeNil) {
+		t.FailNow()
+	}
+
+	// Without a connected gateway, there is no session.
+	err = h.HandleUplink(ctx, gtwIDs, ttnpb.EndDeviceIdentifiers{}, &ttnpb.GatewayUplinkMessage{
+		UplinkMessage: &ttnpb.UplinkMessage{},
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, &ttnpb.Gateway{
+		GatewayIdentifiers: gtwIDs,
+		FrequencyPlanID:    "EU_863_870",
+		FrequencyPlanIDs:   []string{"EU_863_870"},
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), true, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Disconnect(nil)
+
+	connCtx, cancelConn := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.ConnectGateway(connCtx, gtwIDs, conn)
+	}()
+
+	pull := read(t, encoding.PullData)
+	a.So(pull.GatewayEUI, should.Resemble, gtwIDs.Eui)
+	write(t, encoding.Packet{
+		Token:      pull.Token,
+		PacketType: encoding.PullAck,
+	})
+
+	// Uplink messages are forwarded with PUSH_DATA.
+	if err := conn.HandleUp(&ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 7,
+				Bandwidth:       125000,
+			}}},
+			CodingRate: "4/5",
+			Frequency:  868100000,
+			Timestamp:  1000000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: gtwIDs,
+				Timestamp:          1000000,
+				RSSI:               -42,
+				SNR:                7.5,
+			},
+		},
+		ReceivedAt: time.Now(),
+	}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var msg *ttnpb.GatewayUplinkMessage
+	select {
+	case msg = <-conn.Up():
+	case <-time.After(timeout):
+		t.Fatal("Expected uplink message")
+	}
+	if !a.So(h.HandleUplink(ctx, gtwIDs, ttnpb.EndDeviceIdentifiers{}, msg), should.BeNil) {
+		t.FailNow()
+	}
+	push := read(t, encoding.PushData)
+	a.So(push.GatewayEUI, should.Resemble, gtwIDs.Eui)
+	if a.So(push.Data, should.NotBeNil) && a.So(push.Data.RxPacket, should.HaveLength, 1) {
+		rx := push.Data.RxPacket[0]
+		a.So(rx.Tmst, should.Equal, 1000000)
+		a.So(rx.Freq, should.Equal, 868.1)
+		a.So(rx.Stat, should.Equal, 1)
+		a.So(rx.RSSI, should.Equal, -42)
+		a.So(rx.LSNR, should.Equal, 7.5)
+	}
+	write(t, encoding.Packet{
+		Token:      push.Token,
+		PacketType: encoding.PushAck,
+	})
+
+	// Class A downlink messages are scheduled in reply to the uplink message with the matching timestamp.
+	write(t, encoding.Packet{
+		Token:      [2]byte{0x01, 0x02},
+		PacketType: encoding.PullResp,
+		Data: &encoding.Data{
+			TxPacket: &encoding.TxPacket{
+				Tmst: 1000000 + 5000000,
+				Freq: 868.1,
+				Powe: 14,
+				Modu: "LORA",
+				DatR: datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				}}}},
+				CodR: "4/5",
+				IPol: true,
+				Size: 4,
+				Data: "YAECAw==",
+			},
+		},
+	})
+	var down *ttnpb.DownlinkMessage
+	select {
+	case down = <-conn.Down():
+		a.So(down.RawPayload, should.Resemble, []byte{0x60, 0x01, 0x02, 0x03})
+		scheduled := down.GetScheduled()
+		if a.So(scheduled, should.NotBeNil) {
+			a.So(scheduled.Frequency, should.Equal, 868100000)
+			a.So(scheduled.Timestamp, should.Equal, 6000000)
+		}
+	case <-time.After(timeout):
+		t.Fatal("Expected downlink message")
+	}
+
+	// The TX_ACK is sent when the gateway acknowledges the transmission.
+	if !a.So(h.HandleTxAck(ctx, gtwIDs, &ttnpb.TxAcknowledgment{
+		CorrelationIDs:  []string{"gs:conn:test"},
+		Result:          ttnpb.TxAcknowledgment_SUCCESS,
+		DownlinkMessage: down,
+	}), should.BeNil) {
+		t.FailNow()
+	}
+	ack := read(t, encoding.TxAck)
+	a.So(ack.Token, should.Equal, [2]byte{0x01, 0x02})
+	if a.So(ack.Data, should.NotBeNil) && a.So(ack.Data.TxPacketAck, should.NotBeNil) {
+		a.So(ack.Data.TxPacketAck.Error, should.Equal, encoding.TxErrNone)
+	}
+	// Acknowledgments of downlink messages that are not pending are not reported.
+	a.So(h.HandleTxAck(ctx, gtwIDs, &ttnpb.TxAcknowledgment{
+		Result:          ttnpb.TxAcknowledgment_SUCCESS,
+		DownlinkMessage: down,
+	}), should.BeNil)
+
+	// Downlink messages that do not match an uplink message are rejected.
+	write(t, encoding.Packet{
+		Token:      [2]byte{0x03, 0x04},
+		PacketType: encoding.PullResp,
+		Data: &encoding.Data{
+			TxPacket: &encoding.TxPacket{
+				Tmst: 1000000 + 1500000,
+				Freq: 868.1,
+				Powe: 14,
+				Modu: "LORA",
+				DatR: datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				}}}},
+				CodR: "4/5",
+				IPol: true,
+				Size: 4,
+				Data: "YAECAw==",
+			},
+		},
+	})
+	ack = read(t, encoding.TxAck)
+	a.So(ack.Token, should.Equal, [2]byte{0x03, 0x04})
+	if a.So(ack.Data, should.NotBeNil) && a.So(ack.Data.TxPacketAck, should.NotBeNil) {
+		a.So(ack.Data.TxPacketAck.Error, should.Equal, encoding.TxErrTooLate)
+	}
+
+	// Status messages are forwarded with PUSH_DATA, including the forwarding statistics.
+	if !a.So(h.HandleStatus(ctx, gtwIDs, &ttnpb.GatewayStatus{
+		Time: time.Unix(1600000000, 0).UTC(),
+	}), should.BeNil) {
+		t.FailNow()
+	}
+	push = read(t, encoding.PushData)
+	if a.So(push.Data, should.NotBeNil) && a.So(push.Data.Stat, should.NotBeNil) {
+		stat := push.Data.Stat
+		a.So(time.Time(stat.Time), should.Equal, time.Unix(1600000000, 0).UTC())
+		a.So(stat.RxNb, should.Equal, 1)
+		a.So(stat.RxFW, should.Equal, 1)
+		a.So(stat.ACKR, should.Equal, 100)
+		a.So(stat.DWNb, should.Equal, 2)
+		a.So(stat.TxNb, should.Equal, 1)
+	}
+
+	cancelConn()
+	select {
+	case err := <-errCh:
+		a.So(errors.IsCanceled(err), should.BeTrue)
+	case <-time.After(timeout):
+		t.Fatal("Expected gateway to disconnect")
+	}
+}
@@ -37,3 +37,13 @@ type Handler interface {
 	// HandleTxAck handles ttnpb.TxAcknowledgment.
 	HandleTxAck(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.TxAcknowledgment) error
 }
+
+// ProprietaryHandler represents the upstream handler that handles proprietary uplink messages.
+// Proprietary uplink messages are only forwarded to handlers that implement this interface, and these handlers do not
+// receive other uplink messages, gateway status messages or Tx acknowledgments.
+type ProprietaryHandler interface {
+	Handler
+	// HandleProprietaryUplink handles ttnpb.GatewayUplinkMessage with MType proprietary.
+	// It must not mutate the gateway uplink message.
+	HandleProprietaryUplink(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.GatewayUplinkMessage) error
+}
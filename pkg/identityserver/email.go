@@ -158,3 +158,60 @@ func (is *IdentityServer) SendContactsEmail(ctx context.Context, ids ttnpb.IDStr
 	}
 	return nil
 }
+
+// SendCollaboratorsEmail sends an email to the users that collaborate on the given entity with the given rights,
+// either directly or through an organization.
+func (is *IdentityServer) SendCollaboratorsEmail(ctx context.Context, ids *ttnpb.EntityIdentifiers, required []ttnpb.Right, makeMessage func(emails.Data) email.MessageData) error {
+	var users []*ttnpb.User
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		membershipStore := is.getMembershipStore(ctx, db)
+		members, err := membershipStore.FindMembers(ctx, ids)
+		if err != nil {
+			return err
+		}
+		userIDs := make(map[string]*ttnpb.UserIdentifiers)
+		for member, rights := range members {
+			if !rights.Implied().IncludesAll(required...) {
+				continue
+			}
+			if usrIDs := member.GetUserIds(); usrIDs != nil {
+				userIDs[usrIDs.GetUserId()] = usrIDs
+				continue
+			}
+			orgMembers, err := membershipStore.FindMembers(ctx, member.GetEntityIdentifiers())
+			if err != nil {
+				return err
+			}
+			for orgMember, orgRights := range orgMembers {
+				if usrIDs := orgMember.GetUserIds(); usrIDs != nil && orgRights.Implied().IncludesAll(required...) {
+					userIDs[usrIDs.GetUserId()] = usrIDs
+				}
+			}
+		}
+		if len(userIDs) == 0 {
+			return nil
+		}
+		findIDs := make([]*ttnpb.UserIdentifiers, 0, len(userIDs))
+		for _, usrIDs := range userIDs {
+			findIDs = append(findIDs, usrIDs)
+		}
+		users, err = store.GetUserStore(db).FindUsers(ctx, findIDs, &types.FieldMask{
+			Paths: []string{"name", "primary_email_address"},
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, usr := range users {
+		err = is.SendEmail(ctx, func(data emails.Data) email.MessageData {
+			data.SetUser(usr)
+			data.SetEntity(ids)
+			return makeMessage(data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
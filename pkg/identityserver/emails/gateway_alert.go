@@ -0,0 +1,86 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import (
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// GatewayAlert is the email that is sent when an alert about the health of a gateway is raised or resolved.
+type GatewayAlert struct {
+	Data
+	Alert *ttnpb.GatewayAlert
+}
+
+// Resolved returns whether the alert is resolved.
+func (g GatewayAlert) Resolved() bool {
+	return g.Alert.ResolvedAt != nil
+}
+
+// Description returns the description of the alert.
+func (g GatewayAlert) Description() string {
+	switch g.Alert.Type {
+	case ttnpb.GATEWAY_ALERT_DISCONNECTED:
+		return fmt.Sprintf("has been disconnected for more than %s", g.Alert.Threshold)
+	case ttnpb.GATEWAY_ALERT_UPLINK_SILENCE:
+		return fmt.Sprintf("did not forward uplink messages for more than %s", g.Alert.Threshold)
+	case ttnpb.GATEWAY_ALERT_STATUS_SILENCE:
+		return fmt.Sprintf("did not send status messages for more than %s", g.Alert.Threshold)
+	case ttnpb.GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME:
+		return fmt.Sprintf("has round-trip times of more than %s", g.Alert.Threshold)
+	default:
+		return g.Alert.Type.String()
+	}
+}
+
+// ConsoleURL returns the URL to the gateway in the Console.
+func (g GatewayAlert) ConsoleURL() string {
+	return fmt.Sprintf("%s/gateways/%s", g.Network.ConsoleURL, g.Entity.ID)
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (GatewayAlert) TemplateName() string { return "gateway_alert" }
+
+const gatewayAlertSubject = `{{ if .Resolved }}Resolved: {{ end }}Your gateway {{ .Entity.ID }} {{ .Description }}`
+
+const gatewayAlertText = `Dear {{ .User.Name }},
+
+{{ if .Resolved -}}
+The alert about your gateway "{{ .Entity.ID }}" on {{ .Network.Name }} that was raised at {{ .Alert.RaisedAt.Format "2006-01-02 15:04:05 MST" }} has been resolved at {{ .Alert.ResolvedAt.Format "2006-01-02 15:04:05 MST" }}.
+
+The gateway {{ .Description }}.
+{{- else -}}
+Your gateway "{{ .Entity.ID }}" on {{ .Network.Name }} {{ .Description }}.
+
+The alert was raised at {{ .Alert.RaisedAt.Format "2006-01-02 15:04:05 MST" }}. You will receive another email when the alert is resolved.
+{{- end }}
+
+You can go to {{ .ConsoleURL }} to view the gateway in the Console.
+
+If you prefer to use the command-line interface, you can run the following command to list the alerts of this gateway:
+
+ttn-lw-cli gateways list-alerts {{ .Entity.ID }} --include-resolved
+
+You can change the alert settings of the gateway with the following command:
+
+ttn-lw-cli gateways set {{ .Entity.ID }} --alert-settings.disabled
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (GatewayAlert) DefaultTemplates() (subject, html, text string) {
+	return gatewayAlertSubject, "", gatewayAlertText
+}
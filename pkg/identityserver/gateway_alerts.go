@@ -0,0 +1,94 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// gatewayAlertEventNames are the names of the events that the Gateway Server publishes about gateway alerts.
+var gatewayAlertEventNames = []string{
+	"gs.gateway.alert.raise",
+	"gs.gateway.alert.resolve",
+}
+
+// gatewayAlertNotificationTTL is the time for which a claimed gateway alert notification is remembered.
+const gatewayAlertNotificationTTL = 24 * time.Hour
+
+// subscribeGatewayAlerts subscribes to gateway alert events, and notifies the collaborators of the gateway by email.
+func (is *IdentityServer) subscribeGatewayAlerts() error {
+	return events.Subscribe(is.Context(), gatewayAlertEventNames, nil, events.HandlerFunc(func(evt events.Event) {
+		alert, ok := evt.Data().(*ttnpb.GatewayAlert)
+		if !ok {
+			return
+		}
+		go is.notifyGatewayAlert(evt.Name(), alert)
+	}))
+}
+
+// claimGatewayAlertNotification returns whether this Identity Server instance sends the notification of the gateway
+// alert event. All instances receive the event; if Redis is configured, only the first instance that claims the
+// notification sends it.
+func (is *IdentityServer) claimGatewayAlertNotification(ctx context.Context, name string, alert *ttnpb.GatewayAlert) (bool, error) {
+	if is.redis == nil {
+		return true, nil
+	}
+	k := is.redis.Key(
+		"gateway-alert",
+		unique.ID(ctx, alert.GatewayIdentifiers),
+		name,
+		alert.Type.String(),
+		strconv.FormatInt(alert.RaisedAt.UnixNano(), 10),
+	)
+	ok, err := is.redis.SetNX(ctx, k, 1, gatewayAlertNotificationTTL).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return ok, nil
+}
+
+func (is *IdentityServer) notifyGatewayAlert(name string, alert *ttnpb.GatewayAlert) {
+	ctx := log.NewContextWithFields(is.Context(), log.Fields(
+		"gateway_uid", alert.GatewayIdentifiers.IDString(),
+		"alert", alert.Type,
+	))
+	claimed, err := is.claimGatewayAlertNotification(ctx, name, alert)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to claim gateway alert notification")
+		return
+	}
+	if !claimed {
+		return
+	}
+	err = is.SendCollaboratorsEmail(ctx, alert.GetEntityIdentifiers(), []ttnpb.Right{ttnpb.RIGHT_GATEWAY_STATUS_READ}, func(data emails.Data) email.MessageData {
+		return &emails.GatewayAlert{
+			Data:  data,
+			Alert: alert,
+		}
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Could not send gateway alert email")
+	}
+}
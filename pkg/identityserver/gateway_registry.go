@@ -20,6 +20,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -122,6 +123,20 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 		req.TargetCUPSKey.KeyID = is.config.Gateways.EncryptionKeyID
 	}
 
+	if req.UDPSecret != nil {
+		value := req.UDPSecret.Value
+		if is.config.Gateways.EncryptionKeyID != "" {
+			value, err = is.KeyVault.Encrypt(ctx, req.UDPSecret.Value, is.config.Gateways.EncryptionKeyID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			log.FromContext(ctx).Warn("No encryption key defined, store UDP Secret in plaintext")
+		}
+		req.UDPSecret.Value = value
+		req.UDPSecret.KeyID = is.config.Gateways.EncryptionKeyID
+	}
+
 	if req.ClaimAuthenticationCode != nil {
 		if err := validateClaimAuthenticationCode(*req.ClaimAuthenticationCode); err == nil {
 			value := req.ClaimAuthenticationCode.Secret.Value
@@ -207,6 +222,13 @@ func (is *IdentityServer) getGateway(ctx context.Context, req *ttnpb.GetGatewayR
 		}
 	}
 
+	// The Gateway Server reads the UDP secret with cluster authentication to verify UDP packets.
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_secret") && clusterauth.Authorized(ctx) != nil {
+		if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_READ_SECRETS); err != nil {
+			return nil, err
+		}
+	}
+
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).GetGateway(ctx, &req.GatewayIdentifiers, &req.FieldMask)
 		if err != nil {
@@ -271,6 +293,20 @@ func (is *IdentityServer) getGateway(ctx context.Context, req *ttnpb.GetGatewayR
 		gtw.TargetCUPSKey.KeyID = is.config.Gateways.EncryptionKeyID
 	}
 
+	if gtw.UDPSecret != nil {
+		value := gtw.UDPSecret.Value
+		if gtw.UDPSecret.KeyID != "" {
+			value, err = is.KeyVault.Decrypt(ctx, gtw.UDPSecret.Value, gtw.UDPSecret.KeyID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			log.FromContext(ctx).Warn("No encryption key defined, return stored UDP Secret value")
+		}
+		gtw.UDPSecret.Value = value
+		gtw.UDPSecret.KeyID = is.config.Gateways.EncryptionKeyID
+	}
+
 	return gtw, nil
 }
 
@@ -409,6 +445,25 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 			}
 		}
 
+		if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_secret") {
+			if rights.RequireGateway(ctx, gtw.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_READ_SECRETS) != nil {
+				gtws.Gateways[i].UDPSecret = nil
+			} else if gtws.Gateways[i].UDPSecret != nil {
+				value := gtws.Gateways[i].UDPSecret.Value
+				if gtws.Gateways[i].UDPSecret.KeyID != "" {
+					value, err = is.KeyVault.Decrypt(ctx, gtws.Gateways[i].UDPSecret.Value, gtws.Gateways[i].UDPSecret.KeyID)
+					if err != nil {
+						return nil, err
+					}
+				} else {
+					logger := log.FromContext(ctx)
+					logger.Warn("No encryption key defined, return stored UDP Secret value")
+				}
+				gtws.Gateways[i].UDPSecret.Value = value
+				gtws.Gateways[i].UDPSecret.KeyID = is.config.Gateways.EncryptionKeyID
+			}
+		}
+
 		if ttnpb.HasAnyField(req.FieldMask.Paths, "claim_authentication_code") {
 			if rights.RequireGateway(ctx, gtw.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_READ_SECRETS) != nil {
 				gtws.Gateways[i].ClaimAuthenticationCode = nil
@@ -497,6 +552,25 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 		}
 	}
 
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_secret") {
+		if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
+			return nil, err
+		} else if req.UDPSecret != nil {
+			value := req.UDPSecret.Value
+			if is.config.Gateways.EncryptionKeyID != "" {
+				value, err = is.KeyVault.Encrypt(ctx, req.UDPSecret.Value, is.config.Gateways.EncryptionKeyID)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				logger := log.FromContext(ctx)
+				logger.Warn("No encryption key defined, store UDP Secret in plaintext")
+			}
+			req.UDPSecret.Value = value
+			req.UDPSecret.KeyID = is.config.Gateways.EncryptionKeyID
+		}
+	}
+
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "claim_authentication_code") {
 		if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
 			return nil, err
@@ -555,6 +555,15 @@ func TestGatewaysSecrets(t *testing.T) {
 			a.So(errors.IsPermissionDenied(err), should.BeTrue)
 		}
 
+		got, err = reg.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIdentifiers: created.GatewayIdentifiers,
+			FieldMask:          ptypes.FieldMask{Paths: []string{"udp_secret"}},
+		}, credsWithoutRights)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
 		updated, err := reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
 			Gateway: ttnpb.Gateway{
 				GatewayIdentifiers: created.GatewayIdentifiers,
@@ -566,8 +575,12 @@ func TestGatewaysSecrets(t *testing.T) {
 				TargetCUPSKey: &ttnpb.Secret{
 					Value: []byte("my new secret value"),
 				},
+				UDPSecret: &ttnpb.Secret{
+					Value: []byte("my new secret value"),
+				},
+				UDPAuthenticationMode: ttnpb.UDP_AUTHENTICATION_REQUIRED,
 			},
-			FieldMask: ptypes.FieldMask{Paths: []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key", "target_cups_uri"}},
+			FieldMask: ptypes.FieldMask{Paths: []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key", "target_cups_uri", "udp_secret", "udp_authentication_mode"}},
 		}, creds)
 
 		a.So(err, should.BeNil)
@@ -575,10 +588,11 @@ func TestGatewaysSecrets(t *testing.T) {
 		a.So(updated.LBSLNSSecret, should.NotBeNil)
 		a.So(updated.ClaimAuthenticationCode, should.NotBeNil)
 		a.So(updated.TargetCUPSKey, should.NotBeNil)
+		a.So(updated.UDPSecret, should.NotBeNil)
 
 		got, err = reg.Get(ctx, &ttnpb.GetGatewayRequest{
 			GatewayIdentifiers: created.GatewayIdentifiers,
-			FieldMask:          ptypes.FieldMask{Paths: []string{"name", "lbs_lns_secret", "claim_authentication_code", "target_cups_key", "target_cups_uri"}},
+			FieldMask:          ptypes.FieldMask{Paths: []string{"name", "lbs_lns_secret", "claim_authentication_code", "target_cups_key", "target_cups_uri", "udp_secret", "udp_authentication_mode"}},
 		}, creds)
 
 		a.So(err, should.BeNil)
@@ -597,7 +611,10 @@ func TestGatewaysSecrets(t *testing.T) {
 				a.So(got.TargetCUPSKey.Value, should.Resemble, []byte("my new secret value"))
 			}
 			a.So(got.TargetCUPSURI, should.Equal, otherTargetCUPSURI)
-
+			if a.So(got.UDPSecret, should.NotBeNil) {
+				a.So(got.UDPSecret.Value, should.Resemble, []byte("my new secret value"))
+			}
+			a.So(got.UDPAuthenticationMode, should.Equal, ttnpb.UDP_AUTHENTICATION_REQUIRED)
 		}
 
 		for _, collaborator := range []*ttnpb.OrganizationOrUserIdentifiers{userID.OrganizationOrUserIdentifiers()} {
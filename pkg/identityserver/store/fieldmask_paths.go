@@ -17,6 +17,12 @@ package store
 const (
 	// NOTE: please keep this sorted
 	adminField                          = "admin"
+	alertSettingsField                  = "alert_settings"
+	alertsDisabledField                 = "alert_settings.disabled"
+	alertDisconnectThresholdField       = "alert_settings.disconnect_threshold"
+	alertMaxRoundTripTimeField          = "alert_settings.max_round_trip_time"
+	alertStatusIntervalField            = "alert_settings.status_interval"
+	alertUplinkSilenceThresholdField    = "alert_settings.uplink_silence_threshold"
 	antennasField                       = "antennas"
 	applicationServerAddressField       = "application_server_address"
 	attributesField                     = "attributes"
@@ -65,7 +71,10 @@ const (
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
+	udpAuthenticationModeField          = "udp_authentication_mode"
+	udpSecretField                      = "udp_secret"
 	updateChannelField                  = "update_channel"
 	updateLocationFromStatusField       = "update_location_from_status"
+	uplinkFiltersField                  = "uplink_filters"
 	versionIDsField                     = "version_ids"
 )
@@ -75,6 +75,17 @@ type Gateway struct {
 	TargetCUPSKey []byte `gorm:"type:BYTEA"`
 
 	RequireAuthenticatedConnection bool
+
+	AlertsDisabled              bool `gorm:"default:false not null"`
+	AlertDisconnectThreshold    *int64
+	AlertUplinkSilenceThreshold *int64
+	AlertStatusInterval         *int64
+	AlertMaxRoundTripTime       *int64
+
+	UplinkFilters []byte `gorm:"type:BYTEA"`
+
+	UDPSecret             []byte `gorm:"type:BYTEA;column:udp_secret"`
+	UDPAuthenticationMode int    `gorm:"column:udp_authentication_mode;default:0 not null"`
 }
 
 func init() {
@@ -83,6 +94,90 @@ func init() {
 
 var secretFieldSeparator = []byte(":")
 
+func durationPtr(d *int64) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	res := time.Duration(*d)
+	return &res
+}
+
+func int64Ptr(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	res := int64(*d)
+	return &res
+}
+
+func (gtw *Gateway) alertSettingsToPB() *ttnpb.GatewayAlertSettings {
+	if !gtw.AlertsDisabled && gtw.AlertDisconnectThreshold == nil && gtw.AlertUplinkSilenceThreshold == nil &&
+		gtw.AlertStatusInterval == nil && gtw.AlertMaxRoundTripTime == nil {
+		return nil
+	}
+	return &ttnpb.GatewayAlertSettings{
+		Disabled:               gtw.AlertsDisabled,
+		DisconnectThreshold:    durationPtr(gtw.AlertDisconnectThreshold),
+		UplinkSilenceThreshold: durationPtr(gtw.AlertUplinkSilenceThreshold),
+		StatusInterval:         durationPtr(gtw.AlertStatusInterval),
+		MaxRoundTripTime:       durationPtr(gtw.AlertMaxRoundTripTime),
+	}
+}
+
+func (gtw *Gateway) uplinkFiltersToPB() (*ttnpb.GatewayUplinkFilters, error) {
+	if len(gtw.UplinkFilters) == 0 {
+		return nil, nil
+	}
+	pb := &ttnpb.GatewayUplinkFilters{}
+	if err := pb.Unmarshal(gtw.UplinkFilters); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// setUplinkFilters sets the uplink filters from the gateway proto. If paths are given, only these fields of the
+// uplink filters are set.
+func (gtw *Gateway) setUplinkFilters(pb *ttnpb.Gateway, paths ...string) error {
+	filters := pb.GetUplinkFilters()
+	if len(paths) > 0 {
+		merged, err := gtw.uplinkFiltersToPB()
+		if err != nil {
+			return err
+		}
+		if merged == nil {
+			merged = &ttnpb.GatewayUplinkFilters{}
+		}
+		if err := merged.SetFields(filters, paths...); err != nil {
+			return err
+		}
+		filters = merged
+	}
+	if filters == nil {
+		gtw.UplinkFilters = nil
+		return nil
+	}
+	b, err := filters.Marshal()
+	if err != nil {
+		return err
+	}
+	gtw.UplinkFilters = b
+	return nil
+}
+
+// uplinkFiltersPaths returns whether the field mask contains uplink filter paths, and the paths of the uplink filters
+// that are set. No paths are returned if the uplink filters are set as a whole.
+func uplinkFiltersPaths(fieldMask *pbtypes.FieldMask) (found bool, paths []string) {
+	for _, path := range fieldMask.Paths {
+		switch {
+		case path == uplinkFiltersField:
+			return true, nil
+		case strings.HasPrefix(path, uplinkFiltersField+"."):
+			found, paths = true, append(paths, strings.TrimPrefix(path, uplinkFiltersField+"."))
+		}
+	}
+	return found, paths
+}
+
 // functions to set fields from the gateway model into the gateway proto.
 var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	"ids.eui":        func(pb *ttnpb.Gateway, gtw *Gateway) { pb.Eui = gtw.GatewayEUI.toPB() },
@@ -141,6 +236,20 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 			pb.LBSLNSSecret = nil
 		}
 	},
+	udpSecretField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		blocks := bytes.SplitN(gtw.UDPSecret, secretFieldSeparator, 2)
+		if len(blocks) == 2 {
+			pb.UDPSecret = &ttnpb.Secret{
+				KeyID: string(blocks[0]),
+				Value: blocks[1],
+			}
+		} else {
+			pb.UDPSecret = nil
+		}
+	},
+	udpAuthenticationModeField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		pb.UDPAuthenticationMode = ttnpb.GatewayUDPAuthenticationMode(gtw.UDPAuthenticationMode)
+	},
 	claimAuthenticationCodeField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		blocks := bytes.SplitN(gtw.ClaimAuthenticationCodeSecret, secretFieldSeparator, 2)
 		var secret *ttnpb.Secret
@@ -171,6 +280,12 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	requireAuthenticatedConnectionField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		pb.RequireAuthenticatedConnection = gtw.RequireAuthenticatedConnection
 	},
+	alertSettingsField:               func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
+	alertsDisabledField:              func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
+	alertDisconnectThresholdField:    func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
+	alertUplinkSilenceThresholdField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
+	alertStatusIntervalField:         func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
+	alertMaxRoundTripTimeField:       func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AlertSettings = gtw.alertSettingsToPB() },
 }
 
 // functions to set fields from the gateway proto into the gateway model.
@@ -231,6 +346,20 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 			gtw.LBSLNSSecret = nil
 		}
 	},
+	udpSecretField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		if pb.UDPSecret != nil {
+			var secretBuffer bytes.Buffer
+			secretBuffer.WriteString(pb.UDPSecret.KeyID)
+			secretBuffer.Write(secretFieldSeparator)
+			secretBuffer.Write(pb.UDPSecret.Value)
+			gtw.UDPSecret = secretBuffer.Bytes()
+		} else {
+			gtw.UDPSecret = nil
+		}
+	},
+	udpAuthenticationModeField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.UDPAuthenticationMode = int(pb.UDPAuthenticationMode)
+	},
 	claimAuthenticationCodeField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		// This allows the setting of individual fields while retaining values of other fields.
 		if pb.ClaimAuthenticationCode != nil {
@@ -264,6 +393,35 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 	requireAuthenticatedConnectionField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		gtw.RequireAuthenticatedConnection = pb.RequireAuthenticatedConnection
 	},
+	alertSettingsField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		settings := pb.GetAlertSettings()
+		gtw.AlertsDisabled = settings.GetDisabled()
+		gtw.AlertDisconnectThreshold = int64Ptr(settings.GetDisconnectThreshold())
+		gtw.AlertUplinkSilenceThreshold = int64Ptr(settings.GetUplinkSilenceThreshold())
+		gtw.AlertStatusInterval = int64Ptr(settings.GetStatusInterval())
+		gtw.AlertMaxRoundTripTime = int64Ptr(settings.GetMaxRoundTripTime())
+	},
+	alertsDisabledField: func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.AlertsDisabled = pb.GetAlertSettings().GetDisabled() },
+	alertDisconnectThresholdField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.AlertDisconnectThreshold = int64Ptr(pb.GetAlertSettings().GetDisconnectThreshold())
+	},
+	alertUplinkSilenceThresholdField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.AlertUplinkSilenceThreshold = int64Ptr(pb.GetAlertSettings().GetUplinkSilenceThreshold())
+	},
+	alertStatusIntervalField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.AlertStatusInterval = int64Ptr(pb.GetAlertSettings().GetStatusInterval())
+	},
+	alertMaxRoundTripTimeField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.AlertMaxRoundTripTime = int64Ptr(pb.GetAlertSettings().GetMaxRoundTripTime())
+	},
+}
+
+func init() {
+	// The uplink filters are stored in a single column, so that setting a field of the uplink filters sets that field
+	// of the stored uplink filters. They are converted in toPB and fromPB, as their conversion can fail.
+	for _, path := range ttnpb.GatewayUplinkFiltersFieldPathsTopLevel {
+		gatewayColumnNames[uplinkFiltersField+"."+path] = []string{uplinkFiltersField}
+	}
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -276,7 +434,7 @@ func init() {
 			paths = append(paths, path)
 		}
 	}
-	defaultGatewayFieldMask.Paths = paths
+	defaultGatewayFieldMask.Paths = append(paths, uplinkFiltersField)
 }
 
 // fieldmask path to column name in gateways table.
@@ -308,9 +466,18 @@ var gatewayColumnNames = map[string][]string{
 	updateLocationFromStatusField:       {updateLocationFromStatusField},
 	versionIDsField:                     {"brand_id", "model_id", "hardware_version", "firmware_version"},
 	requireAuthenticatedConnectionField: {requireAuthenticatedConnectionField},
+	alertSettingsField:                  {"alerts_disabled", "alert_disconnect_threshold", "alert_uplink_silence_threshold", "alert_status_interval", "alert_max_round_trip_time"},
+	alertsDisabledField:                 {"alerts_disabled"},
+	alertDisconnectThresholdField:       {"alert_disconnect_threshold"},
+	alertUplinkSilenceThresholdField:    {"alert_uplink_silence_threshold"},
+	alertStatusIntervalField:            {"alert_status_interval"},
+	alertMaxRoundTripTimeField:          {"alert_max_round_trip_time"},
+	uplinkFiltersField:                  {uplinkFiltersField},
+	udpSecretField:                      {udpSecretField},
+	udpAuthenticationModeField:          {udpAuthenticationModeField},
 }
 
-func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) {
+func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) error {
 	pb.GatewayIdentifiers.GatewayId = gtw.GatewayID
 	pb.GatewayIdentifiers.Eui = gtw.GatewayEUI.toPB() // Always present.
 	pb.CreatedAt = cleanTime(gtw.CreatedAt)
@@ -324,9 +491,17 @@ func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) {
 			setter(pb, &gtw)
 		}
 	}
+	if found, _ := uplinkFiltersPaths(fieldMask); found {
+		filters, err := gtw.uplinkFiltersToPB()
+		if err != nil {
+			return err
+		}
+		pb.UplinkFilters = filters
+	}
+	return nil
 }
 
-func (gtw *Gateway) fromPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) (columns []string) {
+func (gtw *Gateway) fromPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) (columns []string, err error) {
 	if fieldMask == nil || len(fieldMask.Paths) == 0 {
 		fieldMask = defaultGatewayFieldMask
 	}
@@ -339,5 +514,11 @@ func (gtw *Gateway) fromPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) (col
 			continue
 		}
 	}
-	return
+	if found, paths := uplinkFiltersPaths(fieldMask); found {
+		if err := gtw.setUplinkFilters(pb, paths...); err != nil {
+			return nil, err
+		}
+		columns = append(columns, uplinkFiltersField)
+	}
+	return columns, nil
 }
@@ -70,12 +70,16 @@ func (s *gatewayStore) CreateGateway(ctx context.Context, gtw *ttnpb.Gateway) (*
 	gtwModel := Gateway{
 		GatewayID: gtw.GatewayId, // The ID is not mutated by fromPB.
 	}
-	gtwModel.fromPB(gtw, nil)
+	if _, err := gtwModel.fromPB(gtw, nil); err != nil {
+		return nil, err
+	}
 	if err := s.createEntity(ctx, &gtwModel); err != nil {
 		return nil, err
 	}
 	var gtwProto ttnpb.Gateway
-	gtwModel.toPB(&gtwProto, nil)
+	if err := gtwModel.toPB(&gtwProto, nil); err != nil {
+		return nil, err
+	}
 	return &gtwProto, nil
 }
 
@@ -101,7 +105,9 @@ func (s *gatewayStore) FindGateways(ctx context.Context, ids []*ttnpb.GatewayIde
 	gtwProtos := make([]*ttnpb.Gateway, len(gtwModels))
 	for i, gtwModel := range gtwModels {
 		gtwProto := &ttnpb.Gateway{}
-		gtwModel.toPB(gtwProto, fieldMask)
+		if err := gtwModel.toPB(gtwProto, fieldMask); err != nil {
+			return nil, err
+		}
 		gtwProtos[i] = gtwProto
 	}
 	return gtwProtos, nil
@@ -122,7 +128,9 @@ func (s *gatewayStore) GetGateway(ctx context.Context, id *ttnpb.GatewayIdentifi
 		return nil, err
 	}
 	gtwProto := &ttnpb.Gateway{}
-	gtwModel.toPB(gtwProto, fieldMask)
+	if err := gtwModel.toPB(gtwProto, fieldMask); err != nil {
+		return nil, err
+	}
 	return gtwProto, nil
 }
 
@@ -141,7 +149,10 @@ func (s *gatewayStore) UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fi
 		return nil, err
 	}
 	oldAttributes, oldAntennas := gtwModel.Attributes, gtwModel.Antennas
-	columns := gtwModel.fromPB(gtw, fieldMask)
+	columns, err := gtwModel.fromPB(gtw, fieldMask)
+	if err != nil {
+		return nil, err
+	}
 	if err = s.updateEntity(ctx, &gtwModel, columns...); err != nil {
 		return nil, err
 	}
@@ -156,7 +167,9 @@ func (s *gatewayStore) UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fi
 		}
 	}
 	updated = &ttnpb.Gateway{}
-	gtwModel.toPB(updated, fieldMask)
+	if err = gtwModel.toPB(updated, fieldMask); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -69,6 +69,19 @@ func TestGatewayStore(t *testing.T) {
 			},
 		}
 
+		uplinkSilenceThreshold, maxRoundTripTime := 30*time.Minute, time.Duration(0)
+		alertSettings := &ttnpb.GatewayAlertSettings{
+			UplinkSilenceThreshold: &uplinkSilenceThreshold,
+			MaxRoundTripTime:       &maxRoundTripTime,
+		}
+		uplinkFilters := &ttnpb.GatewayUplinkFilters{
+			AllowDevAddrPrefixes: []*ttnpb.DevAddrPrefix{
+				{DevAddr: &types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 7},
+			},
+			MinSNR:      &pbtypes.FloatValue{Value: -10},
+			Frequencies: []uint64{868100000, 868300000, 868500000},
+		}
+
 		created, err := store.CreateGateway(ctx, &ttnpb.Gateway{
 			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
 				GatewayId: "foo",
@@ -90,6 +103,10 @@ func TestGatewayStore(t *testing.T) {
 			ClaimAuthenticationCode:  &gtwClaimAuthCode,
 			TargetCUPSURI:            targetCUPSURI,
 			TargetCUPSKey:            secret,
+			AlertSettings:            alertSettings,
+			UplinkFilters:            uplinkFilters,
+			UDPSecret:                secret,
+			UDPAuthenticationMode:    ttnpb.UDP_AUTHENTICATION_OPTIONAL,
 		})
 
 		a.So(err, should.BeNil)
@@ -112,6 +129,10 @@ func TestGatewayStore(t *testing.T) {
 			a.So(created.TargetCUPSURI, should.Equal, targetCUPSURI)
 			a.So(created.TargetCUPSKey, should.NotBeNil)
 			a.So(created.TargetCUPSKey, should.Resemble, secret)
+			a.So(created.AlertSettings, should.Resemble, alertSettings)
+			a.So(created.UplinkFilters, should.Resemble, uplinkFilters)
+			a.So(created.UDPSecret, should.Resemble, secret)
+			a.So(created.UDPAuthenticationMode, should.Equal, ttnpb.UDP_AUTHENTICATION_OPTIONAL)
 		}
 
 		got, err := store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "foo"}, &pbtypes.FieldMask{Paths: []string{"name", "attributes", "lbs_lns_secret", "claim_authentication_code", "target_cups_uri", "target_cups_key"}})
@@ -167,7 +188,12 @@ func TestGatewayStore(t *testing.T) {
 			ClaimAuthenticationCode:  &otherGtwClaimAuthCode,
 			TargetCUPSURI:            otherTargetCUPSURI,
 			TargetCUPSKey:            otherSecret,
-		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret", "claim_authentication_code", "target_cups_uri", "target_cups_key"}})
+			UplinkFilters: &ttnpb.GatewayUplinkFilters{
+				DryRun: true,
+			},
+			UDPSecret:             otherSecret,
+			UDPAuthenticationMode: ttnpb.UDP_AUTHENTICATION_REQUIRED,
+		}, &pbtypes.FieldMask{Paths: []string{"description", "attributes", "antennas", "schedule_anytime_delay", "update_location_from_status", "lbs_lns_secret", "claim_authentication_code", "target_cups_uri", "target_cups_key", "uplink_filters.dry_run", "udp_secret", "udp_authentication_mode"}})
 
 		a.So(err, should.BeNil)
 		if a.So(updated, should.NotBeNil) {
@@ -187,6 +213,13 @@ func TestGatewayStore(t *testing.T) {
 			a.So(updated.ClaimAuthenticationCode.Secret, should.Resemble, otherGtwClaimAuthCode.Secret)
 			a.So(updated.TargetCUPSKey, should.Resemble, otherSecret)
 			a.So(updated.TargetCUPSURI, should.Resemble, otherTargetCUPSURI)
+			if a.So(updated.UplinkFilters, should.NotBeNil) {
+				a.So(updated.UplinkFilters.DryRun, should.BeTrue)
+				a.So(updated.UplinkFilters.AllowDevAddrPrefixes, should.Resemble, uplinkFilters.AllowDevAddrPrefixes)
+				a.So(updated.UplinkFilters.Frequencies, should.Resemble, uplinkFilters.Frequencies)
+			}
+			a.So(updated.UDPSecret, should.Resemble, otherSecret)
+			a.So(updated.UDPAuthenticationMode, should.Equal, ttnpb.UDP_AUTHENTICATION_REQUIRED)
 		}
 
 		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "foo"}, nil)
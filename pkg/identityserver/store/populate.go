@@ -63,6 +63,9 @@ func NewPopulator(size int, seed int64) *Populator {
 		if gateway.TargetCUPSKey != nil {
 			gateway.TargetCUPSKey.KeyID = ""
 		}
+		if gateway.UDPSecret != nil {
+			gateway.UDPSecret.KeyID = ""
+		}
 
 		p.Gateways = append(p.Gateways, gateway)
 		p.APIKeys[gatewayID] = append(
@@ -293,22 +293,78 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 		fNwkSIntKey = interopAns.NwkSKey
 	}
 
-	sessionKeyID := []byte(interopAns.SessionKeyID)
-	if len(sessionKeyID) == 0 {
-		log.FromContext(ctx).Debug("Interop join-accept does not contain session key ID, generate random ID")
-		id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+	sessionKeyID, err := sessionKeyIDOrGenerate(ctx, interopAns.SessionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.JoinResponse{
+		RawPayload: interopAns.PHYPayload,
+		SessionKeys: ttnpb.SessionKeys{
+			SessionKeyID: sessionKeyID,
+			FNwkSIntKey:  (*ttnpb.KeyEnvelope)(fNwkSIntKey),
+			SNwkSIntKey:  (*ttnpb.KeyEnvelope)(interopAns.SNwkSIntKey),
+			NwkSEncKey:   (*ttnpb.KeyEnvelope)(interopAns.NwkSEncKey),
+			AppSKey:      (*ttnpb.KeyEnvelope)(interopAns.AppSKey),
+		},
+		Lifetime: time.Duration(interopAns.Lifetime) * time.Second,
+	}, nil
+}
+
+// HandleRejoinRequest performs Rejoin request according to LoRaWAN Backend Interfaces specification.
+// As type 0 and 2 rejoin-requests do not contain the JoinEUI, the JoinEUI of the device is passed separately.
+func (cl joinServerHTTPClient) HandleRejoinRequest(ctx context.Context, netID types.NetID, joinEUI types.EUI64, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	pld := req.Payload.GetRejoinRequestPayload()
+	if pld == nil {
+		return nil, ErrMalformedMessage.New()
+	}
+
+	dlSettings, err := lorawan.MarshalDLSettings(req.DownlinkSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfList []byte
+	if req.CFList != nil {
+		cfList, err = lorawan.MarshalCFList(*req.CFList)
 		if err != nil {
-			return nil, errGenerateSessionKeyID.New()
+			return nil, err
 		}
-		sessionKeyID = make([]byte, 0, len(generatedSessionKeyIDPrefix)+len(id))
-		sessionKeyID = append(sessionKeyID, generatedSessionKeyIDPrefix...)
-		sessionKeyID = append(sessionKeyID, id[:]...)
+	}
+
+	interopAns := &RejoinAns{}
+	if err := cl.exchange(ctx, joinEUI, jsRPCPaths.rejoin, &RejoinReq{
+		NsJsMessageHeader: NsJsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: cl.Protocol.BackendInterfacesVersion(),
+				MessageType:     MessageTypeRejoinReq,
+			},
+			SenderID:   NetID(netID),
+			ReceiverID: EUI64(joinEUI),
+			SenderNSID: NetID(netID),
+		},
+		MACVersion: MACVersion(req.SelectedMACVersion),
+		PHYPayload: Buffer(req.RawPayload),
+		DevEUI:     EUI64(pld.DevEui),
+		DevAddr:    DevAddr(req.DevAddr),
+		DLSettings: Buffer(dlSettings),
+		RxDelay:    req.RxDelay,
+		CFList:     Buffer(cfList),
+	}, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+
+	sessionKeyID, err := sessionKeyIDOrGenerate(ctx, interopAns.SessionKeyID)
+	if err != nil {
+		return nil, err
 	}
 	return &ttnpb.JoinResponse{
 		RawPayload: interopAns.PHYPayload,
 		SessionKeys: ttnpb.SessionKeys{
 			SessionKeyID: sessionKeyID,
-			FNwkSIntKey:  (*ttnpb.KeyEnvelope)(fNwkSIntKey),
+			FNwkSIntKey:  (*ttnpb.KeyEnvelope)(interopAns.FNwkSIntKey),
 			SNwkSIntKey:  (*ttnpb.KeyEnvelope)(interopAns.SNwkSIntKey),
 			NwkSEncKey:   (*ttnpb.KeyEnvelope)(interopAns.NwkSEncKey),
 			AppSKey:      (*ttnpb.KeyEnvelope)(interopAns.AppSKey),
@@ -317,6 +373,22 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	}, nil
 }
 
+// sessionKeyIDOrGenerate returns the session key ID received from the Join Server, or generates a random one if the
+// Join Server did not provide it.
+func sessionKeyIDOrGenerate(ctx context.Context, received Buffer) ([]byte, error) {
+	if len(received) > 0 {
+		return []byte(received), nil
+	}
+	log.FromContext(ctx).Debug("Interop join-accept does not contain session key ID, generate random ID")
+	id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+	if err != nil {
+		return nil, errGenerateSessionKeyID.New()
+	}
+	sessionKeyID := make([]byte, 0, len(generatedSessionKeyIDPrefix)+len(id))
+	sessionKeyID = append(sessionKeyID, generatedSessionKeyIDPrefix...)
+	return append(sessionKeyID, id[:]...), nil
+}
+
 // GeneratedSessionKeyID returns whether the session key ID is generated locally and not by the Join Server.
 func GeneratedSessionKeyID(id []byte) bool {
 	return bytes.HasPrefix(id, generatedSessionKeyIDPrefix)
@@ -337,6 +409,7 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequest(ctx context.Context, netID types.NetID, joinEUI types.EUI64, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
 }
 
@@ -491,3 +564,12 @@ func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+// HandleRejoinRequest performs Rejoin request to Join Server associated with joinEUI.
+func (cl Client) HandleRejoinRequest(ctx context.Context, netID types.NetID, joinEUI types.EUI64, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	js, ok := cl.joinServer(joinEUI)
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return js.HandleRejoinRequest(ctx, netID, joinEUI, req)
+}
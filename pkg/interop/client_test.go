@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -1363,3 +1364,136 @@ paths:
 		})
 	}
 }
+
+func TestHandleRejoinRequest(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	srv := newTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := assertions.New(t)
+		a.So(r.Method, should.Equal, http.MethodPost)
+		a.So(r.URL.Path, should.Equal, "/test-rejoin-path")
+
+		b, err := ioutil.ReadAll(r.Body)
+		a.So(err, should.BeNil)
+		a.So(string(b), should.Equal, `{"ProtocolVersion":"1.1","TransactionID":0,"MessageType":"RejoinReq","SenderID":"42FFFF","ReceiverID":"70B3D57ED0000000","SenderNSID":"42FFFF","MACVersion":"1.1","PHYPayload":"C000FFFF420807060504030201010001020304","DevEUI":"0102030405060708","DevAddr":"01020304","DLSettings":"80","RxDelay":5,"CFList":""}
+`)
+		a.So(r.Body.Close(), should.BeNil)
+
+		_, err = w.Write([]byte(`{
+  "ProtocolVersion": "1.1",
+  "TransactionID": 0,
+  "MessageType": "RejoinAns",
+  "SenderID": "70B3D57ED0000000",
+  "ReceiverID": "42FFFF",
+  "PHYPayload": "204D675073BB4153B23653EFA82C1F3A49E19C2A8696C9A34BF492674779E4BEFA",
+  "Result": {
+    "ResultCode": "Success"
+  },
+  "Lifetime": 3600,
+  "FNwkSIntKey": {
+    "KEKLabel": "ns:000000",
+    "AESKey": "EB56FE6681999F25D548CFEDD4A6528B331BB5ADE1CAF17F"
+  },
+  "AppSKey": {
+    "KEKLabel": "as:010042",
+    "AESKey": "2A195CC93CA54AD82CFB36C83D91450F3D2D523556F13E69"
+  },
+  "SessionKeyID": "016BFA7BAD4756346A674981E75CDBDC"
+}`))
+		a.So(err, should.BeNil)
+	}))
+	defer srv.Close()
+
+	host := strings.Split(test.Must(url.Parse(srv.URL)).(*url.URL).Host, ":")
+	if len(host) != 2 {
+		t.Fatalf("Invalid server host: %s", host)
+	}
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-js-interop-test")).(string)
+	defer os.RemoveAll(confDir)
+
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(`join-servers:
+   - file: test-js.yml
+     join-euis:
+        - 70b3d57ed0000000/40`,
+	), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-js.yml"), []byte(fmt.Sprintf(`fqdn: %s
+port: %s
+protocol: BI1.1
+paths:
+   rejoin: test-rejoin-path
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host[0],
+		host[1],
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	)), 0644))
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+		HTTPClient:           http.DefaultClient,
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+
+	req := &ttnpb.JoinRequest{
+		SelectedMACVersion: ttnpb.MAC_V1_1,
+		DevAddr:            types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		RxDelay:            ttnpb.RX_DELAY_5,
+		DownlinkSettings: ttnpb.DLSettings{
+			OptNeg: true,
+		},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_REJOIN_REQUEST,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_RejoinRequestPayload{
+				RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+					RejoinType: ttnpb.RejoinRequestType_CONTEXT,
+					NetID:      types.NetID{0x42, 0xff, 0xff},
+					DevEui:     types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+					RejoinCnt:  1,
+				},
+			},
+		},
+		RawPayload: []byte{0xc0, 0x00, 0xff, 0xff, 0x42, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+	}
+
+	// Type 0 rejoin-requests do not contain the JoinEUI, so a request with an unknown JoinEUI cannot be routed.
+	_, err = cl.HandleRejoinRequest(ctx, types.NetID{0x42, 0xff, 0xff}, types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}, req)
+	a.So(err, should.NotBeNil)
+
+	res, err := cl.HandleRejoinRequest(ctx, types.NetID{0x42, 0xff, 0xff}, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, req)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Received unexpected error: %v", errors.Stack(err))
+	}
+	a.So(res, should.Resemble, &ttnpb.JoinResponse{
+		RawPayload: []byte{0x20, 0x4d, 0x67, 0x50, 0x73, 0xbb, 0x41, 0x53, 0xb2, 0x36, 0x53, 0xef, 0xa8, 0x2c, 0x1f, 0x3a, 0x49, 0xe1, 0x9c, 0x2a, 0x86, 0x96, 0xc9, 0xa3, 0x4b, 0xf4, 0x92, 0x67, 0x47, 0x79, 0xe4, 0xbe, 0xfa},
+		SessionKeys: ttnpb.SessionKeys{
+			SessionKeyID: []byte{0x01, 0x6b, 0xfa, 0x7b, 0xad, 0x47, 0x56, 0x34, 0x6a, 0x67, 0x49, 0x81, 0xe7, 0x5c, 0xdb, 0xdc},
+			FNwkSIntKey: &ttnpb.KeyEnvelope{
+				KEKLabel:     "ns:000000",
+				EncryptedKey: []byte{0xeb, 0x56, 0xfe, 0x66, 0x81, 0x99, 0x9f, 0x25, 0xd5, 0x48, 0xcf, 0xed, 0xd4, 0xa6, 0x52, 0x8b, 0x33, 0x1b, 0xb5, 0xad, 0xe1, 0xca, 0xf1, 0x7f},
+			},
+			AppSKey: &ttnpb.KeyEnvelope{
+				KEKLabel:     "as:010042",
+				EncryptedKey: []byte{0x2a, 0x19, 0x5c, 0xc9, 0x3c, 0xa5, 0x4a, 0xd8, 0x2c, 0xfb, 0x36, 0xc8, 0x3d, 0x91, 0x45, 0x0f, 0x3d, 0x2d, 0x52, 0x35, 0x56, 0xf1, 0x3e, 0x69},
+			},
+		},
+		Lifetime: time.Hour,
+	})
+}
@@ -189,6 +189,31 @@ type JoinAns struct {
 	SessionKeyID Buffer       `json:",omitempty"`
 }
 
+// RejoinReq is a rejoin-request message.
+type RejoinReq struct {
+	NsJsMessageHeader
+	MACVersion MACVersion
+	PHYPayload Buffer
+	DevEUI     EUI64
+	DevAddr    DevAddr
+	DLSettings Buffer
+	RxDelay    ttnpb.RxDelay
+	CFList     Buffer
+}
+
+// RejoinAns is an answer to a RejoinReq message.
+type RejoinAns struct {
+	JsNsMessageHeader
+	PHYPayload   Buffer
+	Result       Result
+	Lifetime     uint32
+	SNwkSIntKey  *KeyEnvelope `json:",omitempty"`
+	FNwkSIntKey  *KeyEnvelope `json:",omitempty"`
+	NwkSEncKey   *KeyEnvelope `json:",omitempty"`
+	AppSKey      *KeyEnvelope `json:",omitempty"`
+	SessionKeyID Buffer       `json:",omitempty"`
+}
+
 // AppSKeyReq is a AppSKey request message.
 type AppSKeyReq struct {
 	AsJsMessageHeader
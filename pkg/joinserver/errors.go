@@ -47,6 +47,7 @@ var (
 	errNoNwkKey                       = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoPayload                      = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errNoRootKeys                     = errors.DefineCorruption("no_root_keys", "no root keys specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errPayloadLengthMismatch          = errors.DefineInvalidArgument("payload_length", "expected length of payload to be equal to 23 got {length}")
@@ -56,6 +57,7 @@ var (
 	errProvisioning                   = errors.DefineAborted("provisioning", "provisioning failed")
 	errRegistryOperation              = errors.Define("registry_operation", "registry operation failed")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
+	errRJCountReplay                  = errors.DefineInvalidArgument("rj_count_replay", "RJcount `{rj_count}` is not higher than last RJcount `{last_rj_count}`")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.Define("unknown_join_eui", "JoinEUI specified is not known")
 	errUnsupportedLoRaWANMajorVersion = errors.DefineInvalidArgument("lorawan_major_version", "unsupported LoRaWAN major version: `{major}`")
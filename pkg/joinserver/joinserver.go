@@ -273,25 +273,62 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	if req.Payload.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.Major)
 	}
-	if req.Payload.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest.New()
+	var (
+		joinEUI, devEUI types.EUI64
+		devNonce        types.DevNonce
+		joinReqType     byte = 0xff
+		rejoinPld       *ttnpb.RejoinRequestPayload
+	)
+	switch req.Payload.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld := req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest.New()
+		}
+		joinEUI, devEUI, devNonce = pld.JoinEui, pld.DevEui, pld.DevNonce
+
+	case ttnpb.MType_REJOIN_REQUEST:
+		if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
+		}
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest.New()
+		}
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinRequestType_SESSION:
+			joinEUI = rejoinPld.JoinEui
+		default:
+			// NOTE: Type 0 and 2 rejoin-requests do not contain the JoinEUI, so it is provided by the Network Server,
+			// which also checked the MIC and RJcount0.
+			if req.JoinEui == nil {
+				return nil, errNoJoinEUI.New()
+			}
+			if !rejoinPld.NetID.Equal(req.NetID) {
+				return nil, errNetIDMismatch.WithAttributes("net_id", rejoinPld.NetID)
+			}
+			joinEUI = *req.JoinEui
+		}
+		devEUI = rejoinPld.DevEui
+		// NOTE: Rejoin-accepts use the RJcount of the rejoin-request instead of the DevNonce.
+		devNonce = types.DevNonce{byte(rejoinPld.RejoinCnt >> 8), byte(rejoinPld.RejoinCnt)}
+		joinReqType = byte(rejoinPld.RejoinType)
+
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
 	}
-	if pld.DevEui.IsZero() {
+	if devEUI.IsZero() {
 		return nil, errNoDevEUI.New()
 	}
 	logger = logger.WithFields(log.Fields(
-		"join_eui", pld.JoinEui,
-		"dev_eui", pld.DevEui,
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
 	))
 
 	var match bool
 	for _, p := range js.euiPrefixes {
-		if p.Matches(pld.JoinEui) {
+		if p.Matches(joinEUI) {
 			match = true
 			break
 		}
@@ -301,13 +338,14 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	}
 
 	var handled bool
-	dev, err := js.devices.SetByEUI(ctx, pld.JoinEui, pld.DevEui,
+	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
 		[]string{
 			"application_server_address",
 			"application_server_id",
 			"application_server_kek_label",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"last_rj_count_1",
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
@@ -360,8 +398,11 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if req.SelectedMACVersion.IncrementDevNonce() {
+			dn := uint32(binary.BigEndian.Uint16(devNonce[:]))
+			switch {
+			case rejoinPld != nil:
+				// NOTE: Rejoin-requests do not contain a DevNonce. RJcount1 is checked after the MIC is verified.
+			case req.SelectedMACVersion.IncrementDevNonce():
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						return nil, nil, errDevNonceTooSmall.New()
@@ -369,7 +410,7 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				}
 				dev.LastDevNonce = dn
 				paths = append(paths, "last_dev_nonce")
-			} else {
+			default:
 				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
 				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
 					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
@@ -470,26 +511,55 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch.New()
+			switch {
+			case rejoinPld == nil:
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+
+			case rejoinPld.RejoinType == ttnpb.RejoinRequestType_SESSION:
+				nwkKey, err := networkCryptoService.GetNwkKey(ctx, cryptoDev)
+				if err != nil {
+					return nil, nil, errNoNwkKey.WithCause(err)
+				}
+				reqMIC, err := crypto.ComputeRejoinRequestMIC(crypto.DeriveJSIntKey(*nwkKey, devEUI), req.RawPayload[:20])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[20:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+				if rejoinPld.RejoinCnt <= dev.LastRJCount1 {
+					return nil, nil, errRJCountReplay.WithAttributes(
+						"rj_count", rejoinPld.RejoinCnt,
+						"last_rj_count", dev.LastRJCount1,
+					)
+				}
+				dev.LastRJCount1 = rejoinPld.RejoinCnt
+				paths = append(paths, "last_rj_count_1")
 			}
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, 0xff, pld.DevNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, joinReqType, devNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			var enc []byte
+			if rejoinPld == nil {
+				enc, err = networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			} else {
+				enc, err = networkCryptoService.EncryptRejoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			}
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
-			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveNwkSKeys.WithCause(err)
 			}
-			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveAppSKey.WithCause(err)
 			}
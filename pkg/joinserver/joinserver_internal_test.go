@@ -33,6 +33,7 @@ var (
 	ErrNoSNwkSIntKey       = errNoSNwkSIntKey
 	ErrRegistryOperation   = errRegistryOperation
 	ErrReuseDevNonce       = errReuseDevNonce
+	ErrRJCountReplay       = errRJCountReplay
 )
 
 func KeyToBytes(key types.AES128Key) []byte { return key[:] }
@@ -2087,6 +2087,284 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestHandleRejoin(t *testing.T) {
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	jsIntKey := crypto.DeriveJSIntKey(nwkKey, devEUI)
+	jsEncKey := crypto.DeriveJSEncKey(nwkKey, devEUI)
+
+	makeRejoinRequest := func(typ ttnpb.RejoinRequestType, rjCount uint16) []byte {
+		b := []byte{
+			/* MHDR */
+			0xc0,
+			/* RejoinType */
+			byte(typ),
+		}
+		if typ == ttnpb.RejoinRequestType_SESSION {
+			/* JoinEUI */
+			b = append(b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42)
+		} else {
+			/* NetID */
+			b = append(b, 0xff, 0xff, 0x42)
+		}
+		b = append(b,
+			/* DevEUI */
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/* RJcount */
+			byte(rjCount), byte(rjCount>>8),
+		)
+		if typ != ttnpb.RejoinRequestType_SESSION {
+			// NOTE: The MIC of type 0 and 2 rejoin-requests is checked by the Network Server.
+			return append(b, 0x00, 0x00, 0x00, 0x00)
+		}
+		mic := test.Must(crypto.ComputeRejoinRequestMIC(jsIntKey, b)).([4]byte)
+		return append(b, mic[:]...)
+	}
+	makeRejoinAccept := func(typ ttnpb.RejoinRequestType, rjCount uint16) []byte {
+		b := []byte{
+			/* MHDR */
+			0x20,
+			/* JoinNonce */
+			0x01, 0x00, 0x00,
+			/* NetID */
+			0xff, 0xff, 0x42,
+			/* DevAddr */
+			0xff, 0xff, 0xff, 0x42,
+			/* DLSettings */
+			0xff,
+			/* RxDelay */
+			0x05,
+		}
+		mic := test.Must(crypto.ComputeJoinAcceptMIC(jsIntKey, byte(typ), joinEUI, types.DevNonce{byte(rjCount >> 8), byte(rjCount)}, b)).([4]byte)
+		return append([]byte{b[0]}, mustEncryptJoinAccept(jsEncKey, append(b[1:], mic[:]...))...)
+	}
+	makeSessionKeys := func(rjCount uint16) ttnpb.SessionKeys {
+		jn := types.JoinNonce{0x00, 0x00, 0x01}
+		dn := types.DevNonce{byte(rjCount >> 8), byte(rjCount)}
+		return ttnpb.SessionKeys{
+			AppSKey: &ttnpb.KeyEnvelope{
+				Key: KeyPtr(crypto.DeriveAppSKey(appKey, jn, joinEUI, dn)),
+			},
+			SNwkSIntKey: &ttnpb.KeyEnvelope{
+				Key: KeyPtr(crypto.DeriveSNwkSIntKey(nwkKey, jn, joinEUI, dn)),
+			},
+			FNwkSIntKey: &ttnpb.KeyEnvelope{
+				Key: KeyPtr(crypto.DeriveFNwkSIntKey(nwkKey, jn, joinEUI, dn)),
+			},
+			NwkSEncKey: &ttnpb.KeyEnvelope{
+				Key: KeyPtr(crypto.DeriveNwkSEncKey(nwkKey, jn, joinEUI, dn)),
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name string
+
+		LastRJCount1 uint32
+		MACVersion   ttnpb.MACVersion
+		RejoinType   ttnpb.RejoinRequestType
+		RJCount      uint16
+		JoinEUI      *types.EUI64
+		RawPayload   func([]byte) []byte
+
+		NextLastRJCount1 uint32
+		ErrorAssertion   func(error) bool
+	}{
+		{
+			Name:       "Type 0",
+			MACVersion: ttnpb.MAC_V1_1,
+			RejoinType: ttnpb.RejoinRequestType_CONTEXT,
+			RJCount:    1,
+			JoinEUI:    &joinEUI,
+		},
+		{
+			Name:             "Type 1",
+			LastRJCount1:     2,
+			MACVersion:       ttnpb.MAC_V1_1,
+			RejoinType:       ttnpb.RejoinRequestType_SESSION,
+			RJCount:          3,
+			NextLastRJCount1: 3,
+		},
+		{
+			Name:       "Type 2",
+			MACVersion: ttnpb.MAC_V1_1,
+			RejoinType: ttnpb.RejoinRequestType_KEYS,
+			RJCount:    0x42,
+			JoinEUI:    &joinEUI,
+		},
+		{
+			Name:           "Type 0/no JoinEUI",
+			MACVersion:     ttnpb.MAC_V1_1,
+			RejoinType:     ttnpb.RejoinRequestType_CONTEXT,
+			RJCount:        1,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Type 0/LoRaWAN 1.0.3",
+			MACVersion:     ttnpb.MAC_V1_0_3,
+			RejoinType:     ttnpb.RejoinRequestType_CONTEXT,
+			RJCount:        1,
+			JoinEUI:        &joinEUI,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Type 1/RJcount1 replay",
+			LastRJCount1:   3,
+			MACVersion:     ttnpb.MAC_V1_1,
+			RejoinType:     ttnpb.RejoinRequestType_SESSION,
+			RJCount:        3,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:       "Type 1/MIC mismatch",
+			MACVersion: ttnpb.MAC_V1_1,
+			RejoinType: ttnpb.RejoinRequestType_SESSION,
+			RJCount:    1,
+			RawPayload: func(b []byte) []byte {
+				b[len(b)-1] ^= 0xff
+				return b
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ctx = clusterauth.NewContext(ctx, nil)
+
+				redisClient, flush := test.NewRedis(ctx, "joinserver_test")
+				defer flush()
+				defer redisClient.Close()
+				devReg := &redis.DeviceRegistry{Redis: redisClient}
+				keyReg := &redis.KeyRegistry{Redis: redisClient}
+				aasReg, aasRegCloseFn := NewRedisApplicationActivationSettingRegistry(ctx)
+				defer aasRegCloseFn()
+
+				c := componenttest.NewComponent(t, &component.Config{})
+				js := test.Must(New(
+					c,
+					&Config{
+						ApplicationActivationSettings: aasReg,
+						Devices:                       devReg,
+						Keys:                          keyReg,
+						JoinEUIPrefixes:               joinEUIPrefixes,
+					},
+				)).(*JoinServer)
+				componenttest.StartComponent(t, c)
+
+				_, err := devReg.SetByID(ctx, ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}, "test-dev",
+					[]string{
+						"last_rj_count_1",
+					},
+					func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+						if !a.So(stored, should.BeNil) {
+							t.Fatal("Registry is not empty")
+						}
+						return &ttnpb.EndDevice{
+							EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+								DevEui:                 &devEUI,
+								JoinEui:                &joinEUI,
+								ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+								DeviceId:               "test-dev",
+							},
+							RootKeys: &ttnpb.RootKeys{
+								AppKey: &ttnpb.KeyEnvelope{
+									Key: &appKey,
+								},
+								NwkKey: &ttnpb.KeyEnvelope{
+									Key: &nwkKey,
+								},
+							},
+							LastRJCount1:         tc.LastRJCount1,
+							LoRaWANVersion:       ttnpb.MAC_V1_1,
+							NetworkServerAddress: nsAddr,
+						}, []string{
+							"ids.application_ids",
+							"ids.dev_eui",
+							"ids.device_id",
+							"ids.join_eui",
+							"last_rj_count_1",
+							"lorawan_version",
+							"network_server_address",
+							"root_keys",
+						}, nil
+					},
+				)
+				if !a.So(err, should.BeNil) {
+					t.Fatalf("Failed to create device: %s", err)
+				}
+
+				rawPayload := makeRejoinRequest(tc.RejoinType, tc.RJCount)
+				if tc.RawPayload != nil {
+					rawPayload = tc.RawPayload(rawPayload)
+				}
+				res, err := js.HandleJoin(ctx, &ttnpb.JoinRequest{
+					SelectedMACVersion: tc.MACVersion,
+					RawPayload:         rawPayload,
+					DevAddr:            types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					NetID:              types.NetID{0x42, 0xff, 0xff},
+					DownlinkSettings: ttnpb.DLSettings{
+						OptNeg:      true,
+						Rx1DROffset: 0x7,
+						Rx2DR:       0xf,
+					},
+					RxDelay: ttnpb.RX_DELAY_5,
+					JoinEui: tc.JoinEUI,
+				})
+				if tc.ErrorAssertion != nil {
+					if !a.So(err, should.BeError) || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+						t.Fatalf("Received an unexpected error: %s", err)
+					}
+					a.So(res, should.BeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(res.SessionKeyID, should.NotBeEmpty)
+				expectedKeys := makeSessionKeys(tc.RJCount)
+				expectedKeys.SessionKeyID = res.SessionKeyID
+				a.So(res, should.Resemble, &ttnpb.JoinResponse{
+					RawPayload:  makeRejoinAccept(tc.RejoinType, tc.RJCount),
+					SessionKeys: expectedKeys,
+				})
+
+				ret, err := devReg.GetByEUI(ctx, joinEUI, devEUI, []string{
+					"last_dev_nonce",
+					"last_join_nonce",
+					"last_rj_count_1",
+					"session",
+				})
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(ret.LastDevNonce, should.BeZeroValue)
+				a.So(ret.LastJoinNonce, should.Equal, uint32(1))
+				a.So(ret.LastRJCount1, should.Equal, tc.NextLastRJCount1)
+				if !a.So(ret.Session, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(ret.Session.DevAddr, should.Equal, types.DevAddr{0x42, 0xff, 0xff, 0xff})
+				a.So(ret.Session.SessionKeys, should.Resemble, res.SessionKeys)
+
+				if tc.RejoinType == ttnpb.RejoinRequestType_SESSION {
+					res, err = js.HandleJoin(ctx, &ttnpb.JoinRequest{
+						SelectedMACVersion: tc.MACVersion,
+						RawPayload:         rawPayload,
+						DevAddr:            types.DevAddr{0x42, 0xff, 0xff, 0xff},
+						NetID:              types.NetID{0x42, 0xff, 0xff},
+						RxDelay:            ttnpb.RX_DELAY_5,
+					})
+					a.So(err, should.HaveSameErrorDefinitionAs, ErrRJCountReplay)
+					a.So(res, should.BeNil)
+				}
+			},
+		})
+	}
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	errTest := errors.New("test")
 
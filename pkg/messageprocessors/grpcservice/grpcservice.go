@@ -0,0 +1,386 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcservice contains the payload formatter message processors that delegate to an external gRPC service.
+package grpcservice
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"runtime/trace"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// insecureScheme is the parameter prefix that disables transport security.
+	insecureScheme = "grpc://"
+	// secureScheme is the optional parameter prefix that enables transport security.
+	secureScheme = "grpcs://"
+
+	// DefaultTimeout is the default timeout of a call to the payload formatter service.
+	DefaultTimeout = 5 * time.Second
+	// DefaultPoolSize is the default number of connections kept open to payload formatter services.
+	DefaultPoolSize = 64
+)
+
+// Config is the configuration of the gRPC service payload formatter.
+type Config struct {
+	Timeout      time.Duration `name:"timeout" description:"Timeout of calls to gRPC service payload formatters"`
+	PoolSize     int           `name:"pool-size" description:"Maximum number of connections to gRPC service payload formatters"`
+	AllowedHosts []string      `name:"allowed-hosts" description:"Host names and IP networks (CIDR) of gRPC service payload formatters that are allowed in addition to public IP addresses"`
+}
+
+// TLSConfigFunc returns the TLS configuration used to connect to payload formatter services.
+type TLSConfigFunc func(context.Context) (*tls.Config, error)
+
+type target struct {
+	address string
+	host    string
+	tls     bool
+}
+
+type host struct {
+	ctx             context.Context
+	timeout         time.Duration
+	tlsConfig       TLSConfigFunc
+	allowedHosts    map[string]struct{}
+	allowedNetworks []*net.IPNet
+
+	connsMu sync.Mutex
+	conns   gcache.Cache
+}
+
+// New creates a new PayloadEncodeDecoder that calls the MessageProcessor gRPC service at the address
+// given by the formatter parameter.
+// Only public IP addresses and the configured allowed hosts and networks are dialed, and no client certificates
+// are presented to the service.
+// Connections are pooled per target and closed when the given context is done.
+func New(ctx context.Context, conf Config, tlsConfig TLSConfigFunc) messageprocessors.PayloadEncodeDecoder {
+	h := &host{
+		ctx:          ctx,
+		timeout:      conf.Timeout,
+		tlsConfig:    tlsConfig,
+		allowedHosts: make(map[string]struct{}),
+	}
+	for _, allowed := range conf.AllowedHosts {
+		if _, network, err := net.ParseCIDR(allowed); err == nil {
+			h.allowedNetworks = append(h.allowedNetworks, network)
+			continue
+		}
+		h.allowedHosts[strings.ToLower(allowed)] = struct{}{}
+	}
+	if h.timeout <= 0 {
+		h.timeout = DefaultTimeout
+	}
+	size := conf.PoolSize
+	if size <= 0 {
+		size = DefaultPoolSize
+	}
+	h.conns = gcache.New(size).LRU().EvictedFunc(func(_, v interface{}) {
+		// Calls on the evicted connection may still be in flight; they are bound by the call timeout.
+		conn := v.(*grpc.ClientConn)
+		time.AfterFunc(h.timeout, func() { conn.Close() })
+	}).Build()
+	go func() {
+		<-ctx.Done()
+		h.connsMu.Lock()
+		defer h.connsMu.Unlock()
+		for _, v := range h.conns.GetALL(false) {
+			v.(*grpc.ClientConn).Close()
+		}
+		h.conns.Purge()
+	}()
+	return h
+}
+
+var errParameter = errors.DefineInvalidArgument("parameter", "invalid gRPC service formatter parameter `{parameter}`")
+
+func parseTarget(parameter string) (target, error) {
+	t := target{
+		address: strings.TrimSpace(parameter),
+		tls:     true,
+	}
+	switch {
+	case strings.HasPrefix(t.address, insecureScheme):
+		t.address, t.tls = strings.TrimPrefix(t.address, insecureScheme), false
+	case strings.HasPrefix(t.address, secureScheme):
+		t.address = strings.TrimPrefix(t.address, secureScheme)
+	}
+	host, port, err := net.SplitHostPort(t.address)
+	if err != nil || host == "" || port == "" {
+		return target{}, errParameter.WithAttributes("parameter", parameter)
+	}
+	t.host = strings.ToLower(host)
+	return t, nil
+}
+
+// privateNetworks are the IP networks that are not publicly routable.
+var privateNetworks = func() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range []string{
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"fc00::/7",
+	} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedIP returns whether the given IP address may be dialed.
+func (h *host) allowedIP(ip net.IP) bool {
+	if containsIP(h.allowedNetworks, ip) {
+		return true
+	}
+	return ip.IsGlobalUnicast() && !containsIP(privateNetworks, ip)
+}
+
+var errAddressNotAllowed = errors.DefinePermissionDenied("address_not_allowed", "gRPC service formatter address `{address}` is not allowed")
+
+// dialer returns the dialer for the given target.
+// Unless the host of the target is explicitly allowed, the IP address of each connection is verified after
+// name resolution, so that host names cannot resolve to addresses that are not allowed.
+func (h *host) dialer(t target) func(context.Context, string) (net.Conn, error) {
+	d := &net.Dialer{}
+	if _, ok := h.allowedHosts[t.host]; !ok {
+		d.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !h.allowedIP(ip) {
+				return errAddressNotAllowed.WithAttributes("address", address)
+			}
+			return nil
+		}
+	}
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		return d.DialContext(ctx, "tcp", address)
+	}
+}
+
+// verifyTarget returns an error if the target is an IP address that is not allowed.
+// Host names are verified by the dialer when connecting.
+func (h *host) verifyTarget(t target) error {
+	if _, ok := h.allowedHosts[t.host]; ok {
+		return nil
+	}
+	if ip := net.ParseIP(t.host); ip != nil && !h.allowedIP(ip) {
+		return errAddressNotAllowed.WithAttributes("address", t.address)
+	}
+	return nil
+}
+
+var errContextDone = errors.DefineUnavailable("context_done", "gRPC service formatter is shut down")
+
+func (h *host) conn(ctx context.Context, parameter string) (*grpc.ClientConn, error) {
+	t, err := parseTarget(parameter)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.verifyTarget(t); err != nil {
+		return nil, err
+	}
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	if err := h.ctx.Err(); err != nil {
+		return nil, errContextDone.WithCause(err)
+	}
+	if v, err := h.conns.Get(t); err == nil {
+		return v.(*grpc.ClientConn), nil
+	}
+	opts := append(rpcclient.DefaultDialOptions(h.ctx), grpc.WithContextDialer(h.dialer(t)))
+	if t.tls {
+		tlsConfig, err := h.tlsConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Payload formatter services are not part of the cluster; do not present the cluster client certificates.
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.Certificates = nil
+		tlsConfig.GetClientCertificate = nil
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.DialContext(h.ctx, t.address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.conns.Set(t, conn); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to pool gRPC service formatter connection")
+	}
+	return conn, nil
+}
+
+var errService = errors.DefineAborted("service", "gRPC service formatter `{address}` failed")
+
+// serviceWarning returns the warning for the given error returned by the service.
+// It returns false if the service could not be reached or did not respond in time.
+func serviceWarning(err error) (string, bool) {
+	if errors.IsUnavailable(err) || errors.IsDeadlineExceeded(err) || errors.IsCanceled(err) {
+		return "", false
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnErr.FormatMessage(ttnErr.PublicAttributes()), true
+	}
+	return err.Error(), true
+}
+
+func (h *host) client(ctx context.Context, parameter string) (ttnpb.MessageProcessorClient, error) {
+	conn, err := h.conn(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewMessageProcessorClient(conn), nil
+}
+
+func versionIDs(version *ttnpb.EndDeviceVersionIdentifiers) ttnpb.EndDeviceVersionIdentifiers {
+	if version == nil {
+		return ttnpb.EndDeviceVersionIdentifiers{}
+	}
+	return *version
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the service at the given address.
+// Errors returned by the service are included in the DecodedPayloadWarnings of the message. As the message cannot
+// be sent without payload, the message still fails.
+func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	if msg.DecodedPayload == nil {
+		return nil
+	}
+	client, err := h.client(ctx, parameter)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := client.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            parameter,
+	})
+	if err != nil {
+		if warning, ok := serviceWarning(err); ok {
+			msg.DecodedPayloadWarnings = []string{warning}
+		}
+		return errService.WithAttributes("address", parameter).WithCause(err)
+	}
+
+	msg.FRMPayload = res.FRMPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	if res.FPort != 0 {
+		msg.FPort = res.FPort
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+// Errors returned by the service are included in the DecodedPayloadWarnings of the message.
+func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	client, err := h.client(ctx, parameter)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := client.DecodeUplink(ctx, &ttnpb.DecodeUplinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            parameter,
+	})
+	if err != nil {
+		warning, ok := serviceWarning(err)
+		if !ok {
+			return errService.WithAttributes("address", parameter).WithCause(err)
+		}
+		msg.DecodedPayload, msg.DecodedPayloadWarnings = nil, []string{warning}
+		return nil
+	}
+
+	msg.DecodedPayload = res.DecodedPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	return nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+// Errors returned by the service are included in the DecodedPayloadWarnings of the message.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	client, err := h.client(ctx, parameter)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := client.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            parameter,
+	})
+	if err != nil {
+		warning, ok := serviceWarning(err)
+		if !ok {
+			return errService.WithAttributes("address", parameter).WithCause(err)
+		}
+		msg.DecodedPayload, msg.DecodedPayloadWarnings = nil, []string{warning}
+		return nil
+	}
+
+	msg.DecodedPayload = res.DecodedPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockService is a mock ttnpb.MessageProcessorServer that reverses payloads.
+type mockService struct {
+	ttnpb.UnimplementedMessageProcessorServer
+
+	delay time.Duration
+}
+
+func (s *mockService) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	time.Sleep(s.delay)
+	value := req.Message.DecodedPayload.Fields["value"].GetStringValue()
+	return &ttnpb.ApplicationDownlink{
+		FPort:                  42,
+		FRMPayload:             []byte(value),
+		DecodedPayloadWarnings: []string{fmt.Sprintf("encoded for %s", req.DeviceId)},
+	}, nil
+}
+
+func (s *mockService) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkMessageRequest) (*ttnpb.ApplicationUplink, error) {
+	time.Sleep(s.delay)
+	if len(req.Message.FRMPayload) == 0 {
+		return nil, status.Error(codes.Unimplemented, "not supported")
+	}
+	return &ttnpb.ApplicationUplink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"value": {Kind: &pbtypes.Value_StringValue{StringValue: string(req.Message.FRMPayload)}},
+				"brand": {Kind: &pbtypes.Value_StringValue{StringValue: req.EndDeviceVersionIDs.BrandID}},
+			},
+		},
+		DecodedPayloadWarnings: []string{"low battery"},
+	}, nil
+}
+
+func (s *mockService) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	time.Sleep(s.delay)
+	return &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"value": {Kind: &pbtypes.Value_StringValue{StringValue: string(req.Message.FRMPayload)}},
+			},
+		},
+	}, nil
+}
+
+func startService(t *testing.T, svc ttnpb.MessageProcessorServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	ttnpb.RegisterMessageProcessorServer(srv, svc)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func noTLS(context.Context) (*tls.Config, error) {
+	panic("TLS configuration requested")
+}
+
+func TestGRPCService(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	conf := grpcservice.Config{
+		AllowedHosts: []string{"127.0.0.0/8"},
+	}
+	parameter := "grpc://" + startService(t, &mockService{})
+	host := grpcservice.New(ctx, conf, noTLS)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID: "foo-brand",
+	}
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"value": {Kind: &pbtypes.Value_StringValue{StringValue: "hello"}},
+				},
+			},
+		}
+		err := host.EncodeDownlink(ctx, ids, version, msg, parameter)
+		a.So(err, should.BeNil)
+		a.So(msg.FRMPayload, should.Resemble, []byte("hello"))
+		a.So(msg.FPort, should.Equal, 42)
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"encoded for foo-device"})
+	})
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      1,
+			FRMPayload: []byte("hello"),
+		}
+		err := host.DecodeUplink(ctx, ids, version, msg, parameter)
+		a.So(err, should.BeNil)
+		a.So(msg.DecodedPayload.Fields["value"].GetStringValue(), should.Equal, "hello")
+		a.So(msg.DecodedPayload.Fields["brand"].GetStringValue(), should.Equal, "foo-brand")
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"low battery"})
+
+		// Service errors are included in the warnings.
+		msg = &ttnpb.ApplicationUplink{
+			FPort: 1,
+		}
+		err = host.DecodeUplink(ctx, ids, nil, msg, parameter)
+		a.So(err, should.BeNil)
+		a.So(msg.DecodedPayload, should.BeNil)
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"not supported"})
+	})
+
+	t.Run("DecodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			FPort:      1,
+			FRMPayload: []byte("hello"),
+		}
+		err := host.DecodeDownlink(ctx, ids, version, msg, parameter)
+		a.So(err, should.BeNil)
+		a.So(msg.DecodedPayload.Fields["value"].GetStringValue(), should.Equal, "hello")
+		a.So(msg.DecodedPayloadWarnings, should.BeEmpty)
+	})
+
+	t.Run("InvalidParameter", func(t *testing.T) {
+		a := assertions.New(t)
+		for _, parameter := range []string{
+			"",
+			"localhost",
+			"grpc://",
+			"grpcs://:1234",
+		} {
+			err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{}, parameter)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		a := assertions.New(t)
+		parameter := "grpc://" + startService(t, &mockService{delay: 2 * test.Delay})
+		host := grpcservice.New(ctx, grpcservice.Config{
+			Timeout:      test.Delay,
+			AllowedHosts: conf.AllowedHosts,
+		}, noTLS)
+		err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x01}}, parameter)
+		a.So(errors.IsAborted(err), should.BeTrue)
+		a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		a := assertions.New(t)
+		errTLS := errors.DefineUnavailable("tls", "no TLS")
+		host := grpcservice.New(ctx, grpcservice.Config{}, func(context.Context) (*tls.Config, error) {
+			return nil, errTLS.New()
+		})
+		for _, parameter := range []string{
+			"localhost:1234",
+			"grpcs://localhost:1234",
+		} {
+			err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{}, parameter)
+			a.So(errors.Resemble(err, errTLS), should.BeTrue)
+		}
+	})
+
+	t.Run("AddressNotAllowed", func(t *testing.T) {
+		a := assertions.New(t)
+		host := grpcservice.New(ctx, grpcservice.Config{}, noTLS)
+		for _, parameter := range []string{
+			parameter,
+			"grpc://10.0.0.1:1234",
+			"grpc://[::1]:1234",
+			"grpc://169.254.169.254:80",
+		} {
+			err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{}, parameter)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		// Host names are verified after name resolution.
+		_, port, _ := net.SplitHostPort(strings.TrimPrefix(parameter, "grpc://"))
+		err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x01}}, "grpc://localhost:"+port)
+		a.So(errors.IsAborted(err), should.BeTrue)
+		a.So(errors.IsUnavailable(errors.Cause(err)), should.BeTrue)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"runtime/trace"
 	"strings"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
@@ -35,11 +36,26 @@ type host struct {
 
 // New creates and returns a new Javascript payload encoder and decoder.
 func New() messageprocessors.PayloadEncodeDecoder {
+	return NewWithOptions(scripting.DefaultOptions)
+}
+
+// NewWithOptions creates and returns a new Javascript payload encoder and decoder with the given scripting options.
+// The execution time of the scripts is accounted per application in options.Quota.
+func NewWithOptions(options scripting.Options) messageprocessors.PayloadEncodeDecoder {
 	return &host{
-		engine: js.New(scripting.DefaultOptions),
+		engine: js.New(options),
 	}
 }
 
+// run runs the main function of the script of the given end device's application.
+func (h *host) run(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, script string, input interface{}) (func(target interface{}) error, error) {
+	ctx = scripting.NewContextWithQuotaKey(ctx, ids.ApplicationId)
+	start := time.Now()
+	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	registerRun(ctx, ids.ApplicationId, time.Since(start), err)
+	return valueAs, err
+}
+
 type encodeDownlinkInput struct {
 	Data  map[string]interface{} `json:"data"`
 	FPort *uint8                 `json:"fPort"`
@@ -90,7 +106,7 @@ func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifier
 			}
 		}
 	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	valueAs, err := h.run(ctx, ids, script, input)
 	if err != nil {
 		return err
 	}
@@ -152,7 +168,7 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 			}
 		}
 	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	valueAs, err := h.run(ctx, ids, script, input)
 	if err != nil {
 		return err
 	}
@@ -206,7 +222,7 @@ func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifier
 			return decodeDownlink(input);
 		}
 	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	valueAs, err := h.run(ctx, ids, script, input)
 	if err != nil {
 		return err
 	}
@@ -16,10 +16,13 @@ package javascript
 
 import (
 	"testing"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -573,3 +576,54 @@ func TestDecodeDownlink(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 }
+
+func TestQuota(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	opts := scripting.DefaultOptions
+	opts.Quota = scripting.NewExecutionTimeQuota(opts.Timeout, time.Hour)
+	host := NewWithOptions(opts)
+
+	fooIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	barIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "bar-app",
+		},
+		DeviceId: "bar-device",
+	}
+
+	// The slow decoder of foo-app exhausts its quota.
+	err := host.DecodeUplink(ctx, fooIDs, nil, &ttnpb.ApplicationUplink{FPort: 1}, `
+		function decodeUplink(input) {
+			while (true) { }
+		}
+	`)
+	a.So(err, should.NotBeNil)
+
+	script := `
+	function decodeUplink(input) {
+		return {
+			data: {
+				sum: input.bytes[0] + input.bytes[1]
+			}
+		}
+	}
+	`
+	message := &ttnpb.ApplicationUplink{
+		FRMPayload: []byte{1, 2},
+		FPort:      1,
+	}
+	err = host.DecodeUplink(ctx, fooIDs, nil, message, script)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// Other applications are not affected.
+	err = host.DecodeUplink(ctx, barIDs, nil, message, script)
+	a.So(err, should.BeNil)
+	a.So(message.DecodedPayload.Fields["sum"].GetNumberValue(), should.Equal, 3)
+}
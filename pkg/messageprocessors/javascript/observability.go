@@ -0,0 +1,106 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const (
+	subsystem     = "messageprocessors_javascript"
+	applicationID = "application_id"
+	result        = "result"
+
+	// maxApplicationLabels is the maximum number of application IDs used as label value.
+	// Runs of other applications are registered with the otherApplications label value.
+	maxApplicationLabels = 1000
+	otherApplications    = "other"
+)
+
+var jsMetrics = &messageProcessorMetrics{
+	runs: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "runs_total",
+			Help:      "Total number of JavaScript payload formatter runs",
+		},
+		[]string{applicationID, result},
+	),
+	runSeconds: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "run_seconds_total",
+			Help:      "Total execution time (seconds) of JavaScript payload formatter runs",
+		},
+		[]string{applicationID},
+	),
+	applicationIDs: make(map[string]struct{}),
+}
+
+func init() {
+	metrics.MustRegister(jsMetrics)
+}
+
+type messageProcessorMetrics struct {
+	runs       *metrics.ContextualCounterVec
+	runSeconds *metrics.ContextualCounterVec
+
+	applicationIDsMu sync.Mutex
+	applicationIDs   map[string]struct{}
+}
+
+// applicationLabel returns the label value of the given application ID.
+// The first maxApplicationLabels application IDs are used as is, so that the cardinality of the metrics is bounded.
+func (m *messageProcessorMetrics) applicationLabel(appID string) string {
+	m.applicationIDsMu.Lock()
+	defer m.applicationIDsMu.Unlock()
+	if _, ok := m.applicationIDs[appID]; ok {
+		return appID
+	}
+	if len(m.applicationIDs) >= maxApplicationLabels {
+		return otherApplications
+	}
+	m.applicationIDs[appID] = struct{}{}
+	return appID
+}
+
+func (m *messageProcessorMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.runs.Describe(ch)
+	m.runSeconds.Describe(ch)
+}
+
+func (m *messageProcessorMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.runs.Collect(ch)
+	m.runSeconds.Collect(ch)
+}
+
+func registerRun(ctx context.Context, appID string, d time.Duration, err error) {
+	res := "ok"
+	switch {
+	case errors.IsResourceExhausted(err):
+		res = "resource_exhausted"
+	case err != nil:
+		res = "error"
+	}
+	label := jsMetrics.applicationLabel(appID)
+	jsMetrics.runs.WithLabelValues(ctx, label, res).Inc()
+	jsMetrics.runSeconds.WithLabelValues(ctx, label).Add(d.Seconds())
+}
@@ -0,0 +1,212 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AirtimeBudgetAction is the action, which is taken on queued application downlinks once the downlink budget of an
+// end device is exhausted.
+type AirtimeBudgetAction uint8
+
+const (
+	// AirtimeBudgetDefer keeps the application downlinks in the queue until the budget is reset.
+	AirtimeBudgetDefer AirtimeBudgetAction = iota
+	// AirtimeBudgetReject drops the application downlinks and reports them as failed to the Application Server.
+	AirtimeBudgetReject
+)
+
+// String implements fmt.Stringer.
+func (a AirtimeBudgetAction) String() string {
+	switch a {
+	case AirtimeBudgetDefer:
+		return "defer"
+	case AirtimeBudgetReject:
+		return "reject"
+	}
+	return unknown
+}
+
+// AirtimeBudgetPolicy is the daily airtime budget of an end device. Zero limits are unlimited.
+type AirtimeBudgetPolicy struct {
+	UplinkAirtime   time.Duration
+	DownlinkAirtime time.Duration
+	Downlinks       uint32
+	Action          AirtimeBudgetAction
+}
+
+// unlimited reports whether the policy does not limit the airtime of end devices.
+func (p AirtimeBudgetPolicy) unlimited() bool {
+	return p.UplinkAirtime == 0 && p.DownlinkAirtime == 0 && p.Downlinks == 0
+}
+
+// uplinkExceeded reports whether u exceeds the uplink airtime budget.
+func (p AirtimeBudgetPolicy) uplinkExceeded(u AirtimeUsage) bool {
+	return p.UplinkAirtime > 0 && u.UplinkAirtime > p.UplinkAirtime
+}
+
+// downlinkExhausted reports whether no downlinks can be transmitted anymore with usage u.
+func (p AirtimeBudgetPolicy) downlinkExhausted(u AirtimeUsage) bool {
+	return p.Downlinks > 0 && u.Downlinks >= p.Downlinks ||
+		p.DownlinkAirtime > 0 && u.DownlinkAirtime >= p.DownlinkAirtime
+}
+
+// AirtimeBudgets are the daily airtime budgets of end devices.
+type AirtimeBudgets struct {
+	Default      AirtimeBudgetPolicy
+	Applications map[string]AirtimeBudgetPolicy
+}
+
+// Policy returns the policy of end devices in the application identified by ids.
+func (b AirtimeBudgets) Policy(ids ttnpb.ApplicationIdentifiers) AirtimeBudgetPolicy {
+	if p, ok := b.Applications[ids.ApplicationId]; ok {
+		return p
+	}
+	return b.Default
+}
+
+// unlimited reports whether none of the policies limits the airtime of end devices.
+func (b AirtimeBudgets) unlimited() bool {
+	if !b.Default.unlimited() {
+		return false
+	}
+	for _, p := range b.Applications {
+		if !p.unlimited() {
+			return false
+		}
+	}
+	return true
+}
+
+// AirtimeUsage is the airtime usage of an end device within a day.
+type AirtimeUsage struct {
+	Uplinks         uint32
+	UplinkAirtime   time.Duration
+	Downlinks       uint32
+	DownlinkAirtime time.Duration
+}
+
+// airtimeBudgetDay returns the start of the UTC day, which contains t.
+func airtimeBudgetDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// recordUplinkAirtime adds the airtime consumed by up to the daily airtime usage of the end device identified by ids.
+func (ns *NetworkServer) recordUplinkAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage) {
+	if ns.airtime == nil || up.ConsumedAirtime == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	u, err := ns.airtime.AddByID(ctx, ids, airtimeBudgetDay(time.Now()), AirtimeUsage{
+		Uplinks:       1,
+		UplinkAirtime: *up.ConsumedAirtime,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to record uplink airtime")
+		return
+	}
+	p := ns.airtimeBudgets.Policy(ids.ApplicationIdentifiers)
+	if !p.uplinkExceeded(u) || p.uplinkExceeded(AirtimeUsage{UplinkAirtime: u.UplinkAirtime - *up.ConsumedAirtime}) {
+		return
+	}
+	logger.WithFields(log.Fields(
+		"uplink_airtime", u.UplinkAirtime,
+		"uplink_airtime_budget", p.UplinkAirtime,
+	)).Info("Daily uplink airtime budget exceeded")
+	publishEvents(ctx, evtExceedUplinkAirtimeBudget.NewWithIdentifiersAndData(ctx, &ids, errUplinkAirtimeBudgetExceeded.WithAttributes(
+		"airtime", u.UplinkAirtime,
+		"budget", p.UplinkAirtime,
+	)))
+	registerExceedAirtimeBudget(ctx, "uplink")
+}
+
+// downlinkTransmission is a transmission of a downlink in a single RX window.
+type downlinkTransmission struct {
+	DataRate  band.DataRate
+	Frequency uint64
+}
+
+// recordDownlinkAirtime adds a downlink with length n and the airtime of its transmissions txs to the daily airtime
+// usage of the end device identified by ids.
+func (ns *NetworkServer) recordDownlinkAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, phy *band.Band, n int, txs ...downlinkTransmission) {
+	if ns.airtime == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	var d time.Duration
+	for _, tx := range txs {
+		txd, err := toa.Compute(n, ttnpb.TxSettings{
+			DataRate:   tx.DataRate.Rate,
+			CodingRate: phy.LoRaCodingRate,
+			Frequency:  tx.Frequency,
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to compute downlink airtime")
+			return
+		}
+		d += txd
+	}
+	u, err := ns.airtime.AddByID(ctx, ids, airtimeBudgetDay(time.Now()), AirtimeUsage{
+		Downlinks:       1,
+		DownlinkAirtime: d,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to record downlink airtime")
+		return
+	}
+	p := ns.airtimeBudgets.Policy(ids.ApplicationIdentifiers)
+	if !p.downlinkExhausted(u) || p.downlinkExhausted(AirtimeUsage{Downlinks: u.Downlinks - 1, DownlinkAirtime: u.DownlinkAirtime - d}) {
+		return
+	}
+	logger.WithFields(log.Fields(
+		"downlink_airtime", u.DownlinkAirtime,
+		"downlink_airtime_budget", p.DownlinkAirtime,
+		"downlinks", u.Downlinks,
+		"downlinks_budget", p.Downlinks,
+	)).Info("Daily downlink budget exhausted")
+	publishEvents(ctx, evtExhaustDownlinkBudget.NewWithIdentifiersAndData(ctx, &ids, downlinkBudgetExhaustedError(u)))
+	registerExceedAirtimeBudget(ctx, "downlink")
+}
+
+func downlinkBudgetExhaustedError(u AirtimeUsage) errors.Error {
+	return errDownlinkBudgetExhausted.WithAttributes(
+		"downlinks", u.Downlinks,
+		"airtime", u.DownlinkAirtime,
+	)
+}
+
+// checkDownlinkBudget returns the policy and the airtime usage of the end device identified by ids on the day of t,
+// and reports whether the downlink budget of the end device is exhausted.
+// The downlink budget is not enforced if the airtime usage cannot be retrieved.
+func (ns *NetworkServer) checkDownlinkBudget(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, t time.Time) (AirtimeBudgetPolicy, AirtimeUsage, bool) {
+	p := ns.airtimeBudgets.Policy(ids.ApplicationIdentifiers)
+	if ns.airtime == nil || p.Downlinks == 0 && p.DownlinkAirtime == 0 {
+		return p, AirtimeUsage{}, false
+	}
+	u, err := ns.airtime.GetByID(ctx, ids, airtimeBudgetDay(t))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get airtime usage, skip downlink budget check")
+		return p, AirtimeUsage{}, false
+	}
+	return p, u, p.downlinkExhausted(u)
+}
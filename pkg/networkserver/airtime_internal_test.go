@@ -0,0 +1,165 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAirtimeBudgetConfig(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Config         AirtimeBudgetConfig
+		Expected       AirtimeBudgets
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Empty",
+			Expected: AirtimeBudgets{
+				Applications: map[string]AirtimeBudgetPolicy{},
+			},
+		},
+		{
+			Name: "Default and applications",
+			Config: AirtimeBudgetConfig{
+				Default: AirtimeBudgetPolicyConfig{
+					UplinkAirtime: 30 * time.Second,
+					Downlinks:     10,
+				},
+				Applications: map[string]string{
+					"app1": "downlinks=20; action=reject",
+					"app2": "uplink-airtime=1m;downlink-airtime=5s;downlinks=0",
+				},
+			},
+			Expected: AirtimeBudgets{
+				Default: AirtimeBudgetPolicy{
+					UplinkAirtime: 30 * time.Second,
+					Downlinks:     10,
+					Action:        AirtimeBudgetDefer,
+				},
+				Applications: map[string]AirtimeBudgetPolicy{
+					"app1": {
+						UplinkAirtime: 30 * time.Second,
+						Downlinks:     20,
+						Action:        AirtimeBudgetReject,
+					},
+					"app2": {
+						UplinkAirtime:   time.Minute,
+						DownlinkAirtime: 5 * time.Second,
+						Action:          AirtimeBudgetDefer,
+					},
+				},
+			},
+		},
+		{
+			Name: "Invalid default action",
+			Config: AirtimeBudgetConfig{
+				Default: AirtimeBudgetPolicyConfig{
+					Action: "drop",
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Unknown key",
+			Config: AirtimeBudgetConfig{
+				Applications: map[string]string{
+					"app1": "uplinks=10",
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Invalid duration",
+			Config: AirtimeBudgetConfig{
+				Applications: map[string]string{
+					"app1": "uplink-airtime=30",
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				b, err := tc.Config.Parse()
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					return
+				}
+				if a.So(err, should.BeNil) {
+					a.So(b, should.Resemble, tc.Expected)
+				}
+			},
+		})
+	}
+}
+
+func TestAirtimeBudgetPolicy(t *testing.T) {
+	a := assertions.New(t)
+
+	p := AirtimeBudgets{
+		Default: AirtimeBudgetPolicy{
+			UplinkAirtime: 30 * time.Second,
+		},
+		Applications: map[string]AirtimeBudgetPolicy{
+			"app1": {
+				DownlinkAirtime: 5 * time.Second,
+				Downlinks:       10,
+			},
+		},
+	}
+	a.So(p.unlimited(), should.BeFalse)
+	a.So(AirtimeBudgets{
+		Applications: map[string]AirtimeBudgetPolicy{
+			"app1": {Action: AirtimeBudgetReject},
+		},
+	}.unlimited(), should.BeTrue)
+
+	def := p.Policy(ttnpb.ApplicationIdentifiers{ApplicationId: "app2"})
+	a.So(def, should.Resemble, p.Default)
+	a.So(def.uplinkExceeded(AirtimeUsage{UplinkAirtime: 30 * time.Second}), should.BeFalse)
+	a.So(def.uplinkExceeded(AirtimeUsage{UplinkAirtime: 31 * time.Second}), should.BeTrue)
+	a.So(def.downlinkExhausted(AirtimeUsage{Downlinks: 100, DownlinkAirtime: time.Hour}), should.BeFalse)
+
+	app1 := p.Policy(ttnpb.ApplicationIdentifiers{ApplicationId: "app1"})
+	a.So(app1.uplinkExceeded(AirtimeUsage{UplinkAirtime: time.Hour}), should.BeFalse)
+	for _, tc := range []struct {
+		Usage     AirtimeUsage
+		Exhausted bool
+	}{
+		{Usage: AirtimeUsage{}},
+		{Usage: AirtimeUsage{Downlinks: 9, DownlinkAirtime: 4 * time.Second}},
+		{Usage: AirtimeUsage{Downlinks: 10}, Exhausted: true},
+		{Usage: AirtimeUsage{Downlinks: 1, DownlinkAirtime: 5 * time.Second}, Exhausted: true},
+	} {
+		a.So(app1.downlinkExhausted(tc.Usage), should.Equal, tc.Exhausted)
+	}
+
+	day := airtimeBudgetDay(time.Date(2021, 3, 4, 23, 59, 59, 0, time.FixedZone("UTC-1", -3600)))
+	a.So(day.Equal(time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC)), should.BeTrue)
+	a.So(fmt.Sprint(AirtimeBudgetReject), should.Equal, "reject")
+}
@@ -15,6 +15,9 @@
 package networkserver
 
 import (
+	"strconv"
+	"strings"
+
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -33,6 +36,7 @@ type ApplicationUplinkQueueConfig struct {
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
 	ADRMargin                  *float32                   `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
+	ADRStrategy                string                     `name:"adr-strategy" description:"The default ADR strategy (dynamic, loss_based, mobile) Network Server should use if not configured in device's MAC settings"`
 	DesiredRx1Delay            *ttnpb.RxDelay             `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
 	DesiredMaxDutyCycle        *ttnpb.AggregatedDutyCycle `name:"desired-max-duty-cycle" description:"Desired MaxDutyCycle value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckLimitExponent *ttnpb.ADRAckLimitExponent `name:"desired-adr-ack-limit-exponent" description:"Desired ADR_ACK_LIMIT value Network Server should use if not configured in device's MAC settings"`
@@ -43,8 +47,10 @@ type MACSettingConfig struct {
 	StatusCountPeriodicity     *uint32                    `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 }
 
-// Parse parses the configuration and returns ttnpb.MACSettings.
-func (c MACSettingConfig) Parse() ttnpb.MACSettings {
+var errADRStrategy = errors.DefineInvalidArgument("adr_strategy", "invalid ADR strategy `{value}`")
+
+// Parse attempts to parse the configuration and returns ttnpb.MACSettings.
+func (c MACSettingConfig) Parse() (ttnpb.MACSettings, error) {
 	p := ttnpb.MACSettings{
 		ClassBTimeout:         c.ClassBTimeout,
 		ClassCTimeout:         c.ClassCTimeout,
@@ -53,6 +59,23 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	if c.ADRMargin != nil {
 		p.ADRMargin = &pbtypes.FloatValue{Value: *c.ADRMargin}
 	}
+	switch c.ADRStrategy {
+	case mac.ADRStrategyDynamic:
+		p.ADR = &ttnpb.ADRSettings{
+			Strategy: &ttnpb.ADRSettings_Dynamic{Dynamic: &ttnpb.ADRSettings_DynamicStrategy{}},
+		}
+	case mac.ADRStrategyLossBased:
+		p.ADR = &ttnpb.ADRSettings{
+			Strategy: &ttnpb.ADRSettings_LossBased{LossBased: &ttnpb.ADRSettings_LossBasedStrategy{}},
+		}
+	case mac.ADRStrategyMobile:
+		p.ADR = &ttnpb.ADRSettings{
+			Strategy: &ttnpb.ADRSettings_Mobile{Mobile: &ttnpb.ADRSettings_MobileStrategy{}},
+		}
+	case "":
+	default:
+		return ttnpb.MACSettings{}, errADRStrategy.WithAttributes("value", c.ADRStrategy)
+	}
 	if c.DesiredRx1Delay != nil {
 		p.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *c.DesiredRx1Delay}
 	}
@@ -68,7 +91,7 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	if c.StatusCountPeriodicity != nil {
 		p.StatusCountPeriodicity = &pbtypes.UInt32Value{Value: *c.StatusCountPeriodicity}
 	}
-	return p
+	return p, nil
 }
 
 // DownlinkPriorityConfig defines priorities for downlink messages.
@@ -112,6 +135,104 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	return p, nil
 }
 
+// AirtimeBudgetPolicyConfig defines the daily airtime budget of an end device.
+type AirtimeBudgetPolicyConfig struct {
+	UplinkAirtime   time.Duration `name:"uplink-airtime" description:"Daily uplink airtime of an end device (0 is unlimited)"`
+	DownlinkAirtime time.Duration `name:"downlink-airtime" description:"Daily downlink airtime of an end device (0 is unlimited)"`
+	Downlinks       uint32        `name:"downlinks" description:"Daily number of downlinks of an end device (0 is unlimited)"`
+	Action          string        `name:"action" description:"Action on queued application downlinks when the downlink budget is exhausted (defer, reject)"`
+}
+
+var airtimeBudgetActionConfigTable = map[string]AirtimeBudgetAction{
+	"":       AirtimeBudgetDefer,
+	"defer":  AirtimeBudgetDefer,
+	"reject": AirtimeBudgetReject,
+}
+
+var (
+	errAirtimeBudgetAction = errors.DefineInvalidArgument("airtime_budget_action", "invalid airtime budget action `{value}`")
+	errAirtimeBudgetPolicy = errors.DefineInvalidArgument("airtime_budget_policy", "invalid airtime budget policy `{value}` for application `{application_id}`")
+)
+
+// Parse attempts to parse the configuration and returns an AirtimeBudgetPolicy.
+func (c AirtimeBudgetPolicyConfig) Parse() (AirtimeBudgetPolicy, error) {
+	action, ok := airtimeBudgetActionConfigTable[c.Action]
+	if !ok {
+		return AirtimeBudgetPolicy{}, errAirtimeBudgetAction.WithAttributes("value", c.Action)
+	}
+	return AirtimeBudgetPolicy{
+		UplinkAirtime:   c.UplinkAirtime,
+		DownlinkAirtime: c.DownlinkAirtime,
+		Downlinks:       c.Downlinks,
+		Action:          action,
+	}, nil
+}
+
+// AirtimeBudgetConfig defines the daily airtime budgets of end devices.
+type AirtimeBudgetConfig struct {
+	Registry AirtimeRegistry           `name:"-"`
+	Default  AirtimeBudgetPolicyConfig `name:"default" description:"Default daily airtime budget of end devices"`
+	// Applications are the policies per application ID. The policies are formatted as semicolon-separated key=value pairs,
+	// where the keys are the names of the AirtimeBudgetPolicyConfig fields. Fields which are not set are taken from Default.
+	Applications map[string]string `name:"applications" description:"Daily airtime budget of end devices per application ID (uplink-airtime=30s;downlinks=10;action=reject)"`
+}
+
+// Parse attempts to parse the configuration and returns AirtimeBudgets.
+func (c AirtimeBudgetConfig) Parse() (AirtimeBudgets, error) {
+	def, err := c.Default.Parse()
+	if err != nil {
+		return AirtimeBudgets{}, err
+	}
+	b := AirtimeBudgets{
+		Default:      def,
+		Applications: make(map[string]AirtimeBudgetPolicy, len(c.Applications)),
+	}
+	for appID, s := range c.Applications {
+		conf := c.Default
+		for _, kv := range strings.Split(s, ";") {
+			kv = strings.TrimSpace(kv)
+			if kv == "" {
+				continue
+			}
+			i := strings.IndexByte(kv, '=')
+			if i < 0 {
+				return AirtimeBudgets{}, errAirtimeBudgetPolicy.WithAttributes("value", s, "application_id", appID)
+			}
+			k, v := strings.TrimSpace(kv[:i]), strings.TrimSpace(kv[i+1:])
+			switch k {
+			case "uplink-airtime":
+				conf.UplinkAirtime, err = time.ParseDuration(v)
+			case "downlink-airtime":
+				conf.DownlinkAirtime, err = time.ParseDuration(v)
+			case "downlinks":
+				var n uint64
+				n, err = strconv.ParseUint(v, 10, 32)
+				conf.Downlinks = uint32(n)
+			case "action":
+				conf.Action = v
+			default:
+				return AirtimeBudgets{}, errAirtimeBudgetPolicy.WithAttributes("value", s, "application_id", appID)
+			}
+			if err != nil {
+				return AirtimeBudgets{}, errAirtimeBudgetPolicy.WithAttributes("value", s, "application_id", appID).WithCause(err)
+			}
+		}
+		p, err := conf.Parse()
+		if err != nil {
+			return AirtimeBudgets{}, err
+		}
+		b.Applications[appID] = p
+	}
+	return b, nil
+}
+
+// DownlinkAttemptsConfig defines the recording of downlink scheduling attempts of end devices.
+type DownlinkAttemptsConfig struct {
+	Registry DownlinkAttemptRegistry `name:"-"`
+	Count    int                     `name:"count" description:"Number of most recent downlink scheduling attempts to keep per end device"`
+	TTL      time.Duration           `name:"ttl" description:"Time after which the downlink scheduling attempts of an end device are removed"`
+}
+
 // Config represents the NetworkServer configuration.
 type Config struct {
 	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
@@ -119,6 +240,9 @@ type Config struct {
 	DownlinkTasks            DownlinkTaskQueue            `name:"-"`
 	UplinkDeduplicator       UplinkDeduplicator           `name:"-"`
 	ScheduledDownlinkMatcher ScheduledDownlinkMatcher     `name:"-"`
+	Relays                   RelayRegistry                `name:"-"`
+	AirtimeBudget            AirtimeBudgetConfig          `name:"airtime-budget" description:"Daily airtime budgets of end devices"`
+	DownlinkAttempts         DownlinkAttemptsConfig       `name:"downlink-attempts" description:"Recording of downlink scheduling attempts of end devices"`
 	NetID                    types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
 	DevAddrPrefixes          []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
 	DeduplicationWindow      time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
@@ -150,5 +274,9 @@ var DefaultConfig = Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
+	DownlinkAttempts: DownlinkAttemptsConfig{
+		Count: 10,
+		TTL:   7 * 24 * time.Hour,
+	},
 	DownlinkQueueCapacity: 10000,
 }
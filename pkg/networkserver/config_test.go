@@ -0,0 +1,63 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMACSettingConfigParse(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		ADRStrategy    string
+		Expected       *ttnpb.ADRSettings
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:        "LossBased",
+			ADRStrategy: "loss_based",
+			Expected: &ttnpb.ADRSettings{
+				Strategy: &ttnpb.ADRSettings_LossBased{LossBased: &ttnpb.ADRSettings_LossBasedStrategy{}},
+			},
+		},
+		{
+			Name:           "Unknown",
+			ADRStrategy:    "unknown",
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			settings, err := MACSettingConfig{ADRStrategy: tc.ADRStrategy}.Parse()
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(settings.ADR, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -82,6 +82,7 @@ type generatedDownlink struct {
 	Priority       ttnpb.TxSchedulePriority
 	NeedsMACAnswer bool
 	SessionKeyID   []byte
+	MACCommands    []ttnpb.MACCommandIdentifier
 }
 
 type generateDownlinkState struct {
@@ -146,6 +147,74 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 	return ns.downlinkTasks.Add(ctx, dev.EndDeviceIdentifiers, taskAt, true)
 }
 
+// nextNFCntDown returns the FCnt of the next network downlink of dev.
+func nextNFCntDown(ctx context.Context, dev *ttnpb.EndDevice) uint32 {
+	logger := log.FromContext(ctx)
+	for i := len(dev.MACState.RecentDownlinks) - 1; i >= 0; i-- {
+		down := dev.MACState.RecentDownlinks[i]
+		switch {
+		case down == nil:
+			logger.Error("Empty downlink stored in device's MAC state")
+			continue
+
+		case down.Payload == nil:
+			logger.Error("Downlink with no payload stored in device's MAC state")
+			continue
+		}
+
+		switch down.Payload.MType {
+		case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+			return dev.Session.LastNFCntDown + 1
+		case ttnpb.MType_JOIN_ACCEPT:
+			// NOTE: Join-accepts sent in response to rejoin-requests reset the frame counters as well.
+			return 0
+		case ttnpb.MType_PROPRIETARY:
+		default:
+			panic(fmt.Sprintf("invalid downlink MType: %s", down.Payload.MType))
+		}
+	}
+	return 0
+}
+
+// computeDataDownlinkMIC computes the MIC of the encoded data downlink b with payload pld sent to dev in response to up.
+// b must not contain the MIC.
+func (ns *NetworkServer) computeDataDownlinkMIC(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACPayload, up *ttnpb.UplinkMessage, b []byte) ([4]byte, error) {
+	if dev.Session.SNwkSIntKey == nil || len(dev.Session.SNwkSIntKey.Key) == 0 {
+		return [4]byte{}, errUnknownSNwkSIntKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.SNwkSIntKey, ns.KeyVault)
+	if err != nil {
+		log.FromContext(ctx).WithField("kek_label", dev.Session.SNwkSIntKey.KEKLabel).WithError(err).Warn("Failed to unwrap SNwkSIntKey")
+		return [4]byte{}, err
+	}
+
+	var mic [4]byte
+	if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		mic, err = crypto.ComputeLegacyDownlinkMIC(
+			key,
+			dev.Session.DevAddr,
+			pld.FullFCnt,
+			b,
+		)
+	} else {
+		var confFCnt uint32
+		if pld.Ack {
+			confFCnt = up.GetPayload().GetMACPayload().GetFullFCnt()
+		}
+		mic, err = crypto.ComputeDownlinkMIC(
+			key,
+			dev.Session.DevAddr,
+			confFCnt,
+			pld.FullFCnt,
+			b,
+		)
+	}
+	if err != nil {
+		return [4]byte{}, errComputeMIC.New()
+	}
+	return mic, nil
+}
+
 // generateDataDownlink attempts to generate a downlink.
 // generateDataDownlink returns the generated downlink, application uplinks associated with the generation and error, if any.
 // generateDataDownlink may mutate the device in order to record the downlink generated.
@@ -186,6 +255,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		fPending bool
 		genState generateDownlinkState
 		cmdBuf   []byte
+		cmdCIDs  []ttnpb.MACCommandIdentifier
 	)
 	if class == ttnpb.CLASS_A {
 		spec := lorawan.DefaultMACCommands
@@ -273,6 +343,10 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			)
 		}
 
+		if ns.relays != nil {
+			enqueuers = append(enqueuers, ns.enqueueRelayRequests)
+		}
+
 		for _, f := range enqueuers {
 			st := f(ctx, dev, maxDownLen, maxUpLen)
 			maxDownLen = st.MaxDownLen
@@ -286,11 +360,20 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		for _, cmd := range cmds {
 			logger := logger.WithField("cid", cmd.CID)
 			logger.Debug("Add MAC command to buffer")
+			enc := cmd
 			var err error
-			b, err = spec.AppendDownlink(*phy, b, *cmd)
+			if cmd.CID == ttnpb.CID_RELAY_UPDATE_UPLINK_LIST {
+				enc, err = ns.unwrapRelayUpdateUplinkListRequest(ctx, cmd)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to unwrap RootWorSKey")
+					return nil, generateDownlinkState{}, errEncodeMAC.WithCause(err)
+				}
+			}
+			b, err = spec.AppendDownlink(*phy, b, *enc)
 			if err != nil {
 				return nil, generateDownlinkState{}, errEncodeMAC.WithCause(err)
 			}
+			cmdCIDs = append(cmdCIDs, cmd.CID)
 		}
 		logger = logger.WithFields(log.Fields(
 			"mac_count", len(cmds),
@@ -341,6 +424,14 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 
 	cmdsInFOpts := len(cmdBuf) <= fOptsCapacity
 	if cmdsInFOpts {
+		var (
+			budgetPolicy    AirtimeBudgetPolicy
+			budgetUsage     AirtimeUsage
+			budgetExhausted bool
+		)
+		if len(dev.Session.QueuedApplicationDownlinks) > 0 {
+			budgetPolicy, budgetUsage, budgetExhausted = ns.checkDownlinkBudget(ctx, dev.EndDeviceIdentifiers, transmitAt)
+		}
 		appDowns := dev.Session.QueuedApplicationDownlinks[:0:0]
 	outer:
 		for i, down := range dev.Session.QueuedApplicationDownlinks {
@@ -433,6 +524,26 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 					// TODO: Check if following downlinks must be dropped (https://github.com/TheThingsNetwork/lorawan-stack/issues/1653).
 				}
 
+			case budgetExhausted && budgetPolicy.Action == AirtimeBudgetDefer:
+				logger.Debug("Defer application downlink due to exhausted downlink budget")
+				registerLimitApplicationDownlink(ctx, budgetPolicy.Action)
+				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i:]...)
+				break outer
+
+			case budgetExhausted:
+				logger.Debug("Drop application downlink due to exhausted downlink budget")
+				registerLimitApplicationDownlink(ctx, budgetPolicy.Action)
+				genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
+					Up: &ttnpb.ApplicationUp_DownlinkFailed{
+						DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+							ApplicationDownlink: *down,
+							Error:               *ttnpb.ErrorDetailsToProto(downlinkBudgetExhaustedError(budgetUsage)),
+						},
+					},
+				})
+
 			default:
 				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i+1:]...)
 				genState.ApplicationDownlink = down
@@ -459,32 +570,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		}
 
 	case len(cmdBuf) > 0, needsDownlink:
-		pld.FullFCnt = func() uint32 {
-			for i := len(dev.MACState.RecentDownlinks) - 1; i >= 0; i-- {
-				down := dev.MACState.RecentDownlinks[i]
-				switch {
-				case down == nil:
-					logger.Error("Empty downlink stored in device's MAC state")
-					continue
-
-				case down.Payload == nil:
-					logger.Error("Downlink with no payload stored in device's MAC state")
-					continue
-				}
-
-				switch down.Payload.MType {
-				case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
-					return dev.Session.LastNFCntDown + 1
-				case ttnpb.MType_JOIN_ACCEPT:
-					// TODO: Support rejoins (https://github.com/TheThingsNetwork/lorawan-stack/issues/8).
-					return 0
-				case ttnpb.MType_PROPRIETARY:
-				default:
-					panic(fmt.Sprintf("invalid downlink MType: %s", down.Payload.MType))
-				}
-			}
-			return 0
-		}()
+		pld.FullFCnt = nextNFCntDown(ctx, dev)
 
 	default:
 		return nil, genState, errNoDownlink.New()
@@ -570,39 +656,10 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	}
 	// NOTE: It is assumed, that b does not contain MIC.
 
-	if dev.Session.SNwkSIntKey == nil || len(dev.Session.SNwkSIntKey.Key) == 0 {
-		return nil, genState, errUnknownSNwkSIntKey.New()
-	}
-	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.SNwkSIntKey, ns.KeyVault)
+	mic, err := ns.computeDataDownlinkMIC(ctx, dev, pld, up, b)
 	if err != nil {
-		logger.WithField("kek_label", dev.Session.SNwkSIntKey.KEKLabel).WithError(err).Warn("Failed to unwrap SNwkSIntKey")
 		return nil, genState, err
 	}
-
-	var mic [4]byte
-	if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
-		mic, err = crypto.ComputeLegacyDownlinkMIC(
-			key,
-			dev.Session.DevAddr,
-			pld.FullFCnt,
-			b,
-		)
-	} else {
-		var confFCnt uint32
-		if pld.Ack {
-			confFCnt = up.GetPayload().GetMACPayload().GetFullFCnt()
-		}
-		mic, err = crypto.ComputeDownlinkMIC(
-			key,
-			dev.Session.DevAddr,
-			confFCnt,
-			pld.FullFCnt,
-			b,
-		)
-	}
-	if err != nil {
-		return nil, genState, errComputeMIC.New()
-	}
 	b = append(b, mic[:]...)
 	msg.MIC = mic[:]
 
@@ -627,12 +684,16 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		Priority:       priority,
 		NeedsMACAnswer: len(dev.MACState.PendingRequests) > 0 && class == ttnpb.CLASS_A,
 		SessionKeyID:   dev.Session.SessionKeyID,
+		MACCommands:    cmdCIDs,
 	}, genState, nil
 }
 
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+
+	// Relay identifies the relay, which forwarded the uplink. If set, the downlink is forwarded through the relay.
+	Relay *ttnpb.EndDeviceIdentifiers
 }
 
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
@@ -645,6 +706,12 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 	body := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
 	for _, md := range mds {
+		if relay := relayFromRxMetadata(md); relay != nil {
+			head = append(head, downlinkPath{
+				Relay: relay,
+			})
+			continue
+		}
 		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
 			continue
 		}
@@ -684,6 +751,8 @@ func downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath
 type scheduledDownlink struct {
 	Message    *ttnpb.DownlinkMessage
 	TransmitAt time.Time
+	// Rx1 and Rx2 report whether the Gateway Server chose RX1 and RX2 respectively.
+	Rx1, Rx2 bool
 }
 
 type downlinkSchedulingError []error
@@ -755,13 +824,18 @@ type scheduleRequest struct {
 	RawPayload   []byte
 	SessionKeyID []byte
 
+	// Slot is the time at which the downlink is intended to be transmitted, if known.
+	Slot *time.Time
+	// MACCommands are the identifiers of the MAC commands contained in the payload.
+	MACCommands []ttnpb.MACCommandIdentifier
+
 	// DownlinkEvents are the event builders associated with particular downlink. Only published on success.
 	DownlinkEvents events.Builders
 }
 
 type downlinkTarget interface {
 	Equal(downlinkTarget) bool
-	Schedule(context.Context, *ttnpb.DownlinkMessage, ...grpc.CallOption) (time.Duration, error)
+	Schedule(context.Context, *ttnpb.DownlinkMessage, ...grpc.CallOption) (*ttnpb.ScheduleDownlinkResponse, error)
 }
 
 type gatewayServerDownlinkTarget struct {
@@ -776,16 +850,12 @@ func (t *gatewayServerDownlinkTarget) Equal(target downlinkTarget) bool {
 	return other.peer == t.peer
 }
 
-func (t *gatewayServerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, callOpts ...grpc.CallOption) (time.Duration, error) {
+func (t *gatewayServerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, callOpts ...grpc.CallOption) (*ttnpb.ScheduleDownlinkResponse, error) {
 	conn, err := t.peer.Conn()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	res, err := ttnpb.NewNsGsClient(conn).ScheduleDownlink(ctx, msg, callOpts...)
-	if err != nil {
-		return 0, err
-	}
-	return res.Delay, nil
+	return ttnpb.NewNsGsClient(conn).ScheduleDownlink(ctx, msg, callOpts...)
 }
 
 type packetBrokerDownlinkTarget struct {
@@ -797,16 +867,18 @@ func (t *packetBrokerDownlinkTarget) Equal(target downlinkTarget) bool {
 	return ok
 }
 
-func (t *packetBrokerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, callOpts ...grpc.CallOption) (time.Duration, error) {
+func (t *packetBrokerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, callOpts ...grpc.CallOption) (*ttnpb.ScheduleDownlinkResponse, error) {
 	conn, err := t.peer.Conn()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	_, err = ttnpb.NewNsPbaClient(conn).PublishDownlink(ctx, msg, callOpts...)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return peeringScheduleDelay, nil
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: peeringScheduleDelay,
+	}, nil
 }
 
 // scheduleDownlinkByPaths attempts to schedule payload b using parameters in req using paths.
@@ -814,21 +886,33 @@ func (t *packetBrokerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.Do
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
 func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *scheduleRequest, paths ...downlinkPath) (*scheduledDownlink, []events.Event, error) {
 	if len(paths) == 0 {
-		return nil, nil, errNoPath.New()
+		err := errNoPath.New()
+		record := newDownlinkSchedulingAttempt(ctx, req, nil)
+		record.Error = ttnpb.ErrorDetailsToProto(err)
+		ns.recordDownlinkSchedulingAttempt(ctx, req.EndDeviceIdentifiers, record)
+		return nil, nil, err
 	}
 
 	logger := log.FromContext(ctx)
 
 	type attempt struct {
 		downlinkTarget
-		paths []*ttnpb.DownlinkPath
+		paths   []*ttnpb.DownlinkPath
+		indices []int
 	}
 
 	queuedEvents := make([]events.Event, 0, len(paths))
 	attempts := make([]*attempt, 0, len(paths))
-	for _, path := range paths {
+	peerErrs := make([]error, len(paths))
+	for i, path := range paths {
 		var target downlinkTarget
-		if path.GatewayIdentifiers != nil {
+		if path.Relay != nil {
+			target = &relayDownlinkTarget{
+				ns:     ns,
+				relay:  *path.Relay,
+				device: req.EndDeviceIdentifiers,
+			}
+		} else if path.GatewayIdentifiers != nil {
 			logger := logger.WithFields(log.Fields(
 				"target", "gateway_server",
 				"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
@@ -836,6 +920,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 			peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to get Gateway Server peer")
+				peerErrs[i] = err
 				continue
 			}
 			target = &gatewayServerDownlinkTarget{peer: peer}
@@ -844,6 +929,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 			peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_PACKET_BROKER_AGENT, nil)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to get Packet Broker Agent peer")
+				peerErrs[i] = err
 				continue
 			}
 			target = &packetBrokerDownlinkTarget{peer: peer}
@@ -861,7 +947,10 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 			}
 			attempts = append(attempts, a)
 		}
-		a.paths = append(a.paths, path.DownlinkPath)
+		if path.DownlinkPath != nil {
+			a.paths = append(a.paths, path.DownlinkPath)
+		}
+		a.indices = append(a.indices, i)
 	}
 
 	var (
@@ -896,6 +985,12 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 		panic(fmt.Sprintf("attempt to schedule downlink with invalid MType '%s'", req.Payload.MType))
 	}
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:downlink:%s", events.NewCorrelationID()))
+	record := newDownlinkSchedulingAttempt(ctx, req, paths)
+	for i, err := range peerErrs {
+		if err != nil {
+			record.Paths[i].Error = scheduleErrorDetails(err)
+		}
+	}
 	errs := make([]error, 0, len(attempts))
 	eventIDOpt := events.WithIdentifiers(&req.EndDeviceIdentifiers)
 	for _, a := range attempts {
@@ -911,7 +1006,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 		queuedEvents = append(queuedEvents, attemptEvent.New(ctx, eventIDOpt, events.WithData(down)))
 		registerAttempt(ctx)
 		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Schedule downlink")
-		delay, err := a.Schedule(ctx, &ttnpb.DownlinkMessage{
+		res, err := a.Schedule(ctx, &ttnpb.DownlinkMessage{
 			RawPayload:     down.RawPayload,
 			Settings:       down.Settings,
 			CorrelationIDs: down.CorrelationIDs,
@@ -919,8 +1014,14 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 		if err != nil {
 			queuedEvents = append(queuedEvents, failEvent.New(ctx, eventIDOpt, events.WithData(err)))
 			errs = append(errs, err)
+			recordPaths := make([]*ttnpb.DownlinkSchedulingAttempt_Path, 0, len(a.indices))
+			for _, i := range a.indices {
+				recordPaths = append(recordPaths, record.Paths[i])
+			}
+			setDownlinkSchedulingAttemptPathErrors(recordPaths, err)
 			continue
 		}
+		delay := res.Delay
 		transmitAt := time.Now().Add(delay)
 		if err := ns.scheduledDownlinkMatcher.Add(ctx, &ttnpb.DownlinkMessage{
 			CorrelationIDs: events.CorrelationIDsFromContext(ctx),
@@ -945,11 +1046,22 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 			),
 		}, []events.Builder(req.DownlinkEvents)...)).New(ctx, eventIDOpt)...)
 		registerSuccess(ctx)
+		switch {
+		case res.Rx1:
+			record.RxWindow = 1
+		case res.Rx2:
+			record.RxWindow = 2
+		}
+		ns.recordDownlinkSchedulingAttempt(ctx, req.EndDeviceIdentifiers, record)
 		return &scheduledDownlink{
 			Message:    down,
 			TransmitAt: transmitAt,
+			Rx1:        res.Rx1,
+			Rx2:        res.Rx2,
 		}, queuedEvents, nil
 	}
+	record.Error = ttnpb.ErrorDetailsToProto(errSchedule)
+	ns.recordDownlinkSchedulingAttempt(ctx, req.EndDeviceIdentifiers, record)
 	return nil, queuedEvents, downlinkSchedulingError(errs)
 }
 
@@ -1247,6 +1359,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		req.Rx2Frequency = dev.MACState.CurrentParameters.Rx2Frequency
 		req.Rx2DataRateIndex = dev.MACState.CurrentParameters.Rx2DataRateIndex
 	}
+	rx1 := slot.RX1()
 	down, queuedEvents, err := ns.scheduleDownlinkByPaths(
 		log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRX1, attemptRX2).WithField("rx1_delay", req.Rx1Delay)),
 		&scheduleRequest{
@@ -1255,6 +1368,8 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 			Payload:              genDown.Payload,
 			RawPayload:           genDown.RawPayload,
 			SessionKeyID:         genDown.SessionKeyID,
+			Slot:                 &rx1,
+			MACCommands:          genDown.MACCommands,
 			DownlinkEvents:       genState.EventBuilders,
 		},
 		paths...,
@@ -1286,6 +1401,14 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	if genState.EvictDownlinkQueueIfScheduled {
 		dev.Session.QueuedApplicationDownlinks = dev.Session.QueuedApplicationDownlinks[:0:0]
 	}
+	var txs []downlinkTransmission
+	if down.Rx1 {
+		txs = append(txs, downlinkTransmission{DataRate: rx1DR, Frequency: req.Rx1Frequency})
+	}
+	if down.Rx2 {
+		txs = append(txs, downlinkTransmission{DataRate: rx2DR, Frequency: req.Rx2Frequency})
+	}
+	ns.recordDownlinkAirtime(ctx, dev.EndDeviceIdentifiers, phy, len(genDown.RawPayload), txs...)
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
 	return downlinkAttemptResult{
 		SetPaths: ttnpb.AddFields(sets,
@@ -1423,6 +1546,8 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 			RawPayload:           genDown.RawPayload,
 			DownlinkEvents:       genState.EventBuilders,
 			SessionKeyID:         dev.GetSession().GetSessionKeyID(),
+			Slot:                 absTime,
+			MACCommands:          genDown.MACCommands,
 		},
 		paths...,
 	)
@@ -1488,6 +1613,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	}
 
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
+	ns.recordDownlinkAirtime(ctx, dev.EndDeviceIdentifiers, phy, len(genDown.RawPayload), downlinkTransmission{DataRate: dr, Frequency: freq})
 	if genState.ApplicationDownlink != nil || genState.EvictDownlinkQueueIfScheduled {
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
 	}
@@ -1654,6 +1780,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							TxRequest:            req,
 							EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
 							RawPayload:           dev.PendingMACState.QueuedJoinAccept.Payload,
+							Slot:                 &rx1,
 							Payload: &ttnpb.Message{
 								MHDR: ttnpb.MHDR{
 									MType: ttnpb.MType_JOIN_ACCEPT,
@@ -0,0 +1,89 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// newDownlinkSchedulingAttempt returns the downlink scheduling attempt of req using the candidate paths.
+func newDownlinkSchedulingAttempt(ctx context.Context, req *scheduleRequest, paths []downlinkPath) *ttnpb.DownlinkSchedulingAttempt {
+	attempt := &ttnpb.DownlinkSchedulingAttempt{
+		AttemptedAt:    time.Now(),
+		Class:          req.TxRequest.Class,
+		Slot:           req.Slot,
+		AttemptRx1:     req.TxRequest.Rx1Frequency != 0,
+		AttemptRx2:     req.TxRequest.Rx2Frequency != 0,
+		Paths:          make([]*ttnpb.DownlinkSchedulingAttempt_Path, 0, len(paths)),
+		MACCommands:    req.MACCommands,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+	}
+	for _, path := range paths {
+		attempt.Paths = append(attempt.Paths, &ttnpb.DownlinkSchedulingAttempt_Path{
+			GatewayIds: path.GatewayIdentifiers,
+			RelayIds:   path.Relay,
+		})
+	}
+	return attempt
+}
+
+// scheduleErrorDetails returns the error details of err.
+// Errors, which are not defined by the stack, are wrapped by errSchedule.
+func scheduleErrorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errSchedule.WithCause(err))
+}
+
+// setDownlinkSchedulingAttemptPathErrors sets the errors of paths to the path errors reported in err.
+// If err does not contain exactly one error per path, the errors of all paths are set to err.
+func setDownlinkSchedulingAttemptPathErrors(paths []*ttnpb.DownlinkSchedulingAttempt_Path, err error) {
+	var pathErrs []*ttnpb.ErrorDetails
+	if ttnErr, ok := errors.From(err); ok {
+		for _, msg := range ttnErr.Details() {
+			if d, ok := msg.(*ttnpb.ScheduleDownlinkErrorDetails); ok {
+				pathErrs = append(pathErrs, d.PathErrors...)
+			}
+		}
+	}
+	if len(pathErrs) != len(paths) {
+		details := scheduleErrorDetails(err)
+		for _, path := range paths {
+			path.Error = details
+		}
+		return
+	}
+	for i, path := range paths {
+		path.Error = pathErrs[i]
+	}
+}
+
+// recordDownlinkSchedulingAttempt adds attempt to the most recent downlink scheduling attempts of the end device
+// identified by ids.
+func (ns *NetworkServer) recordDownlinkSchedulingAttempt(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, attempt *ttnpb.DownlinkSchedulingAttempt) {
+	if ns.downlinkAttempts.Registry == nil {
+		return
+	}
+	if err := ns.downlinkAttempts.Registry.Add(ctx, ids, attempt, ns.downlinkAttempts.Count, ns.downlinkAttempts.TTL); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record downlink scheduling attempt")
+	}
+}
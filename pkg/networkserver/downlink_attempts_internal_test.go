@@ -0,0 +1,64 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSetDownlinkSchedulingAttemptPathErrors(t *testing.T) {
+	a := assertions.New(t)
+
+	newPaths := func(n int) []*ttnpb.DownlinkSchedulingAttempt_Path {
+		paths := make([]*ttnpb.DownlinkSchedulingAttempt_Path, 0, n)
+		for i := 0; i < n; i++ {
+			paths = append(paths, &ttnpb.DownlinkSchedulingAttempt_Path{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: fmt.Sprintf("gtw%d", i)},
+			})
+		}
+		return paths
+	}
+
+	pathErrs := []*ttnpb.ErrorDetails{
+		ttnpb.ErrorDetailsToProto(errNoPath),
+		ttnpb.ErrorDetailsToProto(errConfirmedDownlinkTooSoon),
+	}
+	gsErr := errSchedule.WithDetails(&ttnpb.ScheduleDownlinkErrorDetails{
+		PathErrors: pathErrs,
+	})
+
+	paths := newPaths(2)
+	setDownlinkSchedulingAttemptPathErrors(paths, gsErr)
+	a.So(paths[0].Error, should.Resemble, pathErrs[0])
+	a.So(paths[1].Error, should.Resemble, pathErrs[1])
+
+	paths = newPaths(3)
+	setDownlinkSchedulingAttemptPathErrors(paths, gsErr)
+	for _, path := range paths {
+		a.So(path.Error, should.Resemble, ttnpb.ErrorDetailsToProto(gsErr))
+	}
+
+	paths = newPaths(1)
+	setDownlinkSchedulingAttemptPathErrors(paths, fmt.Errorf("connection refused"))
+	if a.So(paths[0].Error, should.NotBeNil) {
+		a.So(paths[0].Error.Namespace, should.Equal, errSchedule.Namespace())
+		a.So(paths[0].Error.Name, should.Equal, errSchedule.Name())
+	}
+}
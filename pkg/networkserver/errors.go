@@ -19,42 +19,55 @@ import (
 )
 
 var (
-	errABPJoinRequest             = errors.DefineInvalidArgument("abp_join_request", "received a join-request from ABP device")
-	errApplicationDownlinkTooLong = errors.DefineInvalidArgument("application_downlink_too_long", "application downlink payload length `{length}` exceeds maximum '{max}'")
-	errComputeMIC                 = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
-	errConfirmedDownlinkTooSoon   = errors.DefineUnavailable("confirmed_too_soon", "confirmed downlink is scheduled too soon")
-	errConfirmedMulticastDownlink = errors.DefineInvalidArgument("confirmed_multicast_downlink", "confirmed downlink queued for multicast device")
-	errCorruptedMACState          = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
-	errDataRateNotFound           = errors.DefineNotFound("data_rate_not_found", "data rate not found")
-	errDataRateIndexNotFound      = errors.DefineNotFound("data_rate_index_not_found", "data rate with index `{index}` not found")
-	errDecodePayload              = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
-	errDeviceNotFound             = errors.DefineNotFound("device_not_found", "device not found")
-	errDuplicate                  = errors.DefineFailedPrecondition("duplicate", "uplink is a duplicate")
-	errEmptySession               = errors.DefineFailedPrecondition("empty_session", "session in empty")
-	errEncodeMAC                  = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
-	errEncodePayload              = errors.Define("encode_payload", "failed to encode payload")
-	errEncryptMAC                 = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
-	errExpiredDownlink            = errors.DefineFailedPrecondition("downlink_expired", "queued downlink is expired")
-	errFCntTooLow                 = errors.DefineInvalidArgument("f_cnt_too_low", "FCnt `{f_cnt}` is lower than minimum of `{min_f_cnt}`")
-	errInvalidAbsoluteTime        = errors.DefineInvalidArgument("absolute_time", "invalid absolute time set in application downlink")
-	errInvalidChannelIndex        = errors.DefineInvalidArgument("channel_index", "invalid channel index")
-	errInvalidConfiguration       = errors.DefineInvalidArgument("configuration", "invalid configuration")
-	errInvalidDataRate            = errors.DefineInvalidArgument("data_rate", "invalid data rate")
-	errInvalidFieldMask           = errors.DefineInvalidArgument("field_mask", "invalid field mask")
-	errInvalidFieldValue          = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
-	errInvalidFixedPaths          = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
-	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
-	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
-	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
-	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
-	errNoPath                     = errors.DefineNotFound("no_downlink_path", "no downlink path available")
-	errOutdatedData               = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
-	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
-	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
-	errUnknownMACState            = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
-	errUnknownNwkSEncKey          = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
-	errUnknownSession             = errors.DefineNotFound("unknown_session", "unknown session")
-	errUnknownSNwkSIntKey         = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
-	errUnsupportedLoRaWANVersion  = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: `{version}`", "version")
-	errUplinkChannelNotFound      = errors.DefineNotFound("uplink_channel_not_found", "uplink channel not found")
+	errABPJoinRequest               = errors.DefineInvalidArgument("abp_join_request", "received a join-request from ABP device")
+	errAirtimeUsageDisabled         = errors.DefineFailedPrecondition("airtime_usage_disabled", "recording of airtime usage is disabled")
+	errApplicationDownlinkTooLong   = errors.DefineInvalidArgument("application_downlink_too_long", "application downlink payload length `{length}` exceeds maximum '{max}'")
+	errComputeMIC                   = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
+	errConfirmedDownlinkTooSoon     = errors.DefineUnavailable("confirmed_too_soon", "confirmed downlink is scheduled too soon")
+	errConfirmedMulticastDownlink   = errors.DefineInvalidArgument("confirmed_multicast_downlink", "confirmed downlink queued for multicast device")
+	errCorruptedMACState            = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
+	errDataRateNotFound             = errors.DefineNotFound("data_rate_not_found", "data rate not found")
+	errDataRateIndexNotFound        = errors.DefineNotFound("data_rate_index_not_found", "data rate with index `{index}` not found")
+	errDecodePayload                = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
+	errDeviceNotFound               = errors.DefineNotFound("device_not_found", "device not found")
+	errDownlinkAttemptsDisabled     = errors.DefineFailedPrecondition("downlink_attempts_disabled", "recording of downlink scheduling attempts is disabled")
+	errDownlinkBudgetExhausted      = errors.DefineResourceExhausted("downlink_budget_exhausted", "daily downlink budget exhausted")
+	errDuplicate                    = errors.DefineFailedPrecondition("duplicate", "uplink is a duplicate")
+	errEmptySession                 = errors.DefineFailedPrecondition("empty_session", "session in empty")
+	errEncodeMAC                    = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
+	errEncodePayload                = errors.Define("encode_payload", "failed to encode payload")
+	errEncryptMAC                   = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
+	errExpiredDownlink              = errors.DefineFailedPrecondition("downlink_expired", "queued downlink is expired")
+	errFCntTooLow                   = errors.DefineInvalidArgument("f_cnt_too_low", "FCnt `{f_cnt}` is lower than minimum of `{min_f_cnt}`")
+	errInvalidAbsoluteTime          = errors.DefineInvalidArgument("absolute_time", "invalid absolute time set in application downlink")
+	errInvalidChannelIndex          = errors.DefineInvalidArgument("channel_index", "invalid channel index")
+	errInvalidConfiguration         = errors.DefineInvalidArgument("configuration", "invalid configuration")
+	errInvalidDataRate              = errors.DefineInvalidArgument("data_rate", "invalid data rate")
+	errInvalidFieldMask             = errors.DefineInvalidArgument("field_mask", "invalid field mask")
+	errInvalidFieldValue            = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
+	errInvalidFixedPaths            = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
+	errInvalidPayload               = errors.DefineInvalidArgument("payload", "invalid payload")
+	errJoinServerNotFound           = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errNetIDMismatch                = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match `{expected_net_id}`")
+	errNoDevEUI                     = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
+	errNoJoinEUI                    = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
+	errNoPath                       = errors.DefineNotFound("no_downlink_path", "no downlink path available")
+	errNoRecentUplinks              = errors.DefineFailedPrecondition("no_recent_uplinks", "no recent uplinks")
+	errOutdatedData                 = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
+	errRelayApplicationMismatch     = errors.DefineInvalidArgument("relay_application_mismatch", "end device `{device_uid}` does not belong to the application of relay `{relay_uid}`")
+	errRelayDisabled                = errors.DefineFailedPrecondition("relay_disabled", "relay support is disabled")
+	errRelayNotServing              = errors.DefineFailedPrecondition("relay_not_serving", "relay `{relay_uid}` does not serve end device `{device_uid}`")
+	errRelayUntrustedDevice         = errors.DefinePermissionDenied("relay_untrusted_device", "end device `{device_uid}` is not trusted by relay `{relay_uid}`")
+	errRelayUplinkListEntryNotFound = errors.DefineNotFound("relay_uplink_list_entry_not_found", "end device `{device_uid}` is not in the uplink list of relay `{relay_uid}`")
+	errRelayUplinkListFull          = errors.DefineResourceExhausted("relay_uplink_list_full", "uplink list of relay `{relay_uid}` is full")
+	errRJCountReplay                = errors.DefineInvalidArgument("rj_count_replay", "RJcount `{rj_count}` is not higher than last RJcount `{last_rj_count}`")
+	errRawPayloadTooShort           = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errSchedule                     = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errUnknownMACState              = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
+	errUnknownNwkSEncKey            = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
+	errUnknownSession               = errors.DefineNotFound("unknown_session", "unknown session")
+	errUnknownSNwkSIntKey           = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
+	errUnsupportedLoRaWANVersion    = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: `{version}`", "version")
+	errUplinkAirtimeBudgetExceeded  = errors.DefineResourceExhausted("uplink_airtime_budget_exceeded", "daily uplink airtime budget of `{budget}` exceeded")
+	errUplinkChannelNotFound        = errors.DefineNotFound("uplink_channel_not_found", "uplink channel not found")
 )
@@ -127,7 +127,7 @@ func minAFCntDown(session *ttnpb.Session, macState *ttnpb.MACState) (uint32, err
 					break outer
 				}
 			case ttnpb.MType_JOIN_ACCEPT:
-				// TODO: Support rejoins (https://github.com/TheThingsNetwork/lorawan-stack/issues/8).
+				// NOTE: Join-accepts sent in response to rejoin-requests reset the frame counters as well.
 				minFCnt = 0
 				break outer
 			case ttnpb.MType_PROPRIETARY:
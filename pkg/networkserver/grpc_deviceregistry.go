@@ -1169,6 +1169,8 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	if st.HasSetField(
 		"frequency_plan_id",
 		"lorawan_phy_version",
+		"mac_settings.adr.strategy.static.data_rate_index",
+		"mac_settings.adr.strategy.static.tx_power_index",
 		"mac_settings.factory_preset_frequencies",
 		"mac_settings.ping_slot_frequency.value",
 		"mac_settings.use_adr.value",
@@ -1452,6 +1454,12 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		}
 
 		for p, isValid := range map[string]func(*ttnpb.EndDevice, *band.Band) bool{
+			"mac_settings.adr.strategy.static.data_rate_index": func(dev *ttnpb.EndDevice, phy *band.Band) bool {
+				return dev.GetMACSettings().GetADR().GetStatic().GetDataRateIndex() <= phy.MaxADRDataRateIndex
+			},
+			"mac_settings.adr.strategy.static.tx_power_index": func(dev *ttnpb.EndDevice, phy *band.Band) bool {
+				return dev.GetMACSettings().GetADR().GetStatic().GetTxPowerIndex() <= uint32(phy.MaxTxPowerIndex())
+			},
 			"mac_settings.use_adr.value": func(dev *ttnpb.EndDevice, phy *band.Band) bool {
 				return !dev.GetMACSettings().GetUseADR().GetValue() || phy.EnableADR
 			},
@@ -2398,3 +2406,180 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	}
 	return ttnpb.Empty, nil
 }
+
+// SimulateADR implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) SimulateADR(ctx context.Context, req *ttnpb.SimulateADRRequest) (*ttnpb.ADRSimulation, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+
+	dev, ctx, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceId, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"mac_settings",
+		"mac_state.current_parameters",
+		"mac_state.recent_uplinks",
+		"mac_state.rejected_adr_data_rate_indexes",
+		"mac_state.rejected_adr_tx_power_indexes",
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device from registry")
+		return nil, err
+	}
+	if len(dev.GetMACState().GetRecentUplinks()) == 0 {
+		return nil, errNoRecentUplinks.New()
+	}
+	if len(req.FieldMask.Paths) > 0 {
+		if dev.MACSettings == nil {
+			dev.MACSettings = &ttnpb.MACSettings{}
+		}
+		if err := dev.MACSettings.SetFields(req.MACSettings, req.FieldMask.Paths...); err != nil {
+			return nil, err
+		}
+	}
+	phy, err := DeviceBand(dev, ns.FrequencyPlans)
+	if err != nil {
+		return nil, err
+	}
+	return mac.SimulateADR(ctx, dev, phy, ns.defaultMACSettings)
+}
+
+// GetDownlinkSchedulingAttempts implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetDownlinkSchedulingAttempts(ctx context.Context, req *ttnpb.GetDownlinkSchedulingAttemptsRequest) (*ttnpb.DownlinkSchedulingAttempts, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if ns.downlinkAttempts.Registry == nil {
+		return nil, errDownlinkAttemptsDisabled.New()
+	}
+	attempts, err := ns.downlinkAttempts.Registry.List(ctx, req.EndDeviceIdentifiers, int(req.Limit))
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to list downlink scheduling attempts")
+		return nil, err
+	}
+	return &ttnpb.DownlinkSchedulingAttempts{
+		Attempts: attempts,
+	}, nil
+}
+
+// GetRelay implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetRelay(ctx context.Context, req *ttnpb.GetRelayRequest) (*ttnpb.Relay, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	if ns.relays == nil {
+		return nil, errRelayDisabled.New()
+	}
+	relay, err := ns.relays.GetByID(ctx, req.EndDeviceIdentifiers)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get relay from registry")
+		return nil, err
+	}
+	if relay == nil {
+		relay = &ttnpb.Relay{
+			EndDeviceIdentifiers: req.EndDeviceIdentifiers,
+		}
+	}
+	return relay, nil
+}
+
+// SetRelaySettings implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) SetRelaySettings(ctx context.Context, req *ttnpb.SetRelaySettingsRequest) (*ttnpb.Relay, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if ns.relays == nil {
+		return nil, errRelayDisabled.New()
+	}
+	if _, _, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceId, []string{"ids"}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device from registry")
+		return nil, err
+	}
+	relay, err := ns.relays.SetByID(ctx, req.EndDeviceIdentifiers, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		if relay == nil {
+			relay = &ttnpb.Relay{}
+		}
+		relay.Settings = req.Settings
+		return relay, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to set relay settings in registry")
+		return nil, err
+	}
+	return relay, nil
+}
+
+// AddRelayUplinkListEntry implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) AddRelayUplinkListEntry(ctx context.Context, req *ttnpb.RelayUplinkListEntryRequest) (*ttnpb.Relay, error) {
+	if err := rights.RequireApplication(ctx, req.RelayIds.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if ns.relays == nil {
+		return nil, errRelayDisabled.New()
+	}
+	if _, _, err := ns.devices.GetByID(ctx, req.RelayIds.ApplicationIdentifiers, req.RelayIds.DeviceId, []string{"ids"}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get relay from registry")
+		return nil, err
+	}
+	relay, err := ns.addRelayUplinkListEntry(ctx, *req.RelayIds, *req.EndDeviceIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to add end device to relay uplink list")
+		return nil, err
+	}
+	return relay, nil
+}
+
+// DeleteRelayUplinkListEntry implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) DeleteRelayUplinkListEntry(ctx context.Context, req *ttnpb.RelayUplinkListEntryRequest) (*ttnpb.Relay, error) {
+	if err := rights.RequireApplication(ctx, req.RelayIds.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if ns.relays == nil {
+		return nil, errRelayDisabled.New()
+	}
+	relay, err := ns.removeRelayUplinkListEntry(ctx, *req.RelayIds, *req.EndDeviceIds)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to remove end device from relay uplink list")
+		return nil, err
+	}
+	return relay, nil
+}
+
+// GetAirtimeUsage implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetAirtimeUsage(ctx context.Context, req *ttnpb.GetAirtimeUsageRequest) (*ttnpb.AirtimeUsage, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	if ns.airtime == nil {
+		return nil, errAirtimeUsageDisabled.New()
+	}
+	day := airtimeBudgetDay(time.Now())
+	u, err := ns.airtime.GetByID(ctx, req.EndDeviceIdentifiers, day)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get airtime usage")
+		return nil, err
+	}
+	return &ttnpb.AirtimeUsage{
+		Day:             day,
+		Uplinks:         u.Uplinks,
+		UplinkAirtime:   u.UplinkAirtime,
+		Downlinks:       u.Downlinks,
+		DownlinkAirtime: u.DownlinkAirtime,
+	}, nil
+}
@@ -397,7 +397,7 @@ func TestDeviceRegistryGet(t *testing.T) {
 }
 
 func TestDeviceRegistrySet(t *testing.T) {
-	defaultMACSettings := DefaultConfig.DefaultMACSettings.Parse()
+	defaultMACSettings := test.Must(DefaultConfig.DefaultMACSettings.Parse()).(ttnpb.MACSettings)
 
 	customMACSettings := defaultMACSettings
 	customMACSettings.Rx1Delay = &ttnpb.RxDelayValue{Value: ttnpb.RX_DELAY_2}
@@ -957,7 +957,7 @@ func TestDeviceRegistryResetFactoryDefaults(t *testing.T) {
 		SessionOptions.WithLastNFCntDown(0x24),
 		SessionOptions.WithDefaultQueuedApplicationDownlinks(),
 	}
-	macSettings := DefaultConfig.DefaultMACSettings.Parse()
+	macSettings := test.Must(DefaultConfig.DefaultMACSettings.Parse()).(ttnpb.MACSettings)
 	activateOpt := EndDeviceOptions.Activate(macSettings, true, activeSessionOpts)
 
 	// TODO: Refactor into same structure as Set
@@ -1177,7 +1177,7 @@ func TestDeviceRegistryResetFactoryDefaults(t *testing.T) {
 						}
 
 						var newErr error
-						macState, newErr = mac.NewState(created, ns.FrequencyPlans, DefaultConfig.DefaultMACSettings.Parse())
+						macState, newErr = mac.NewState(created, ns.FrequencyPlans, test.Must(DefaultConfig.DefaultMACSettings.Parse()).(ttnpb.MACSettings))
 						if newErr != nil {
 							a.So(err, should.NotBeNil)
 							a.So(err, should.HaveSameErrorDefinitionAs, newErr)
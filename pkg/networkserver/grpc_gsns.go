@@ -147,6 +147,7 @@ type matchResult struct {
 	IsRetransmission         bool
 	QueuedApplicationUplinks []*ttnpb.ApplicationUp
 	QueuedEventBuilders      events.Builders
+	RelayAnswers             []relayAnswer
 	SetPaths                 []string
 }
 
@@ -474,6 +475,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 		deferredMACHandlers = make([]macHandler, 0, 1)
 	}
 	var setPaths []string
+	var relayAnswers []relayAnswer
 	dev.MACState.QueuedResponses = dev.MACState.QueuedResponses[:0]
 macLoop:
 	for len(cmds) > 0 {
@@ -554,6 +556,23 @@ macLoop:
 			evs, err = mac.HandleBeaconFreqAns(ctx, dev, cmd.GetBeaconFreqAns())
 		case ttnpb.CID_DEVICE_MODE:
 			evs, err = mac.HandleDeviceModeInd(ctx, dev, cmd.GetDeviceModeInd())
+		case ttnpb.CID_RELAY_CONF,
+			ttnpb.CID_RELAY_END_DEVICE_CONF,
+			ttnpb.CID_RELAY_FILTER_LIST,
+			ttnpb.CID_RELAY_UPDATE_UPLINK_LIST,
+			ttnpb.CID_RELAY_CTRL_UPLINK_LIST,
+			ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT:
+			var req *ttnpb.MACCommand
+			var accepted bool
+			req, accepted, evs, err = mac.HandleRelayAns(ctx, dev, cmd)
+			if err == nil {
+				relayAnswers = append(relayAnswers, relayAnswer{
+					Request:  req,
+					Accepted: accepted,
+				})
+			}
+		case ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE:
+			evs, err = mac.HandleRelayNotifyNewEndDeviceReq(ctx, dev, cmd.GetRelayNotifyNewEndDeviceReq())
 		default:
 			logger.Warn("Unknown MAC command received, skip the rest")
 			break macLoop
@@ -577,7 +596,9 @@ macLoop:
 			logger.Debug("No RekeyInd received for LoRaWAN 1.1+ device")
 			return nil, false, nil
 		}
-		setPaths = append(setPaths, "ids.dev_addr")
+		// NOTE: RJcount0 is reset on every successful processing of a (re)join-accept, so RJcount0 0 is expected next.
+		dev.LastRJCount0 = 0
+		setPaths = append(setPaths, "ids.dev_addr", "last_rj_count_0")
 	} else if dev.PendingSession != nil || dev.PendingMACState != nil {
 		// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
 	}
@@ -667,6 +688,7 @@ macLoop:
 		IsRetransmission:         matchType == currentRetransmissionMatch,
 		QueuedApplicationUplinks: queuedApplicationUplinks,
 		QueuedEventBuilders:      queuedEventBuilders,
+		RelayAnswers:             relayAnswers,
 		SetPaths: append(setPaths,
 			"mac_state",
 			"pending_mac_state",
@@ -811,6 +833,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		publishEvents(ctx, queuedEvents...)
 	}(matched.Device.EndDeviceIdentifiers)
 
+	if err := ns.checkRelayedDevice(ctx, matched.Device, matched.Device.Session.SessionKeyID); err != nil {
+		return err
+	}
+
 	ok, err = ns.deduplicateUplink(ctx, up)
 	if err != nil {
 		return err
@@ -907,8 +933,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			if !pld.FHDR.ADR || !useADR {
 				return stored, paths, nil
 			}
-			if err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.defaultMACSettings); err != nil {
+			decision, err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.defaultMACSettings)
+			if err != nil {
 				log.FromContext(ctx).WithError(err).Info("Failed to adapt data rate, avoid ADR")
+			} else if decision != nil {
+				queuedEvents = append(queuedEvents, mac.EvtADRDecision.NewWithIdentifiersAndData(ctx, &stored.EndDeviceIdentifiers, decision))
 			}
 			return stored, paths, nil
 		})
@@ -920,10 +949,20 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	matched.Device = stored
 	ctx = matched.Context
 
+	if len(matched.RelayAnswers) > 0 && ns.relays != nil {
+		ns.handleRelayAnswers(ctx, stored.EndDeviceIdentifiers, matched.RelayAnswers...)
+	}
+	_, isRelayed := relayFromContext(ctx)
+	isRelayForward := pld.FPort == lorawan.RelayForwardPort && ns.relays != nil && !isRelayed
+	if isRelayForward && !matched.IsRetransmission {
+		if err := ns.handleRelayForwardUplink(ctx, stored, up); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to handle uplink forwarded by relay")
+		}
+	}
 	if err := ns.updateDataDownlinkTask(ctx, stored, time.Time{}); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after data uplink")
 	}
-	if !matched.IsRetransmission {
+	if !matched.IsRetransmission && !isRelayForward {
 		queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
 			EndDeviceIdentifiers: stored.EndDeviceIdentifiers,
 			CorrelationIDs:       up.CorrelationIDs,
@@ -942,6 +981,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			},
 		})
 	}
+	ns.recordUplinkAirtime(ctx, matched.Device.EndDeviceIdentifiers, up)
 	queuedEvents = append(queuedEvents, evtProcessDataUplink.NewWithIdentifiersAndData(ctx, &matched.Device.EndDeviceIdentifiers, up))
 	registerProcessUplink(ctx, up)
 	return nil
@@ -984,7 +1024,16 @@ func (ns *NetworkServer) sendJoinRequest(ctx context.Context, ids ttnpb.EndDevic
 	}
 	if ns.interopClient != nil {
 		queuedEvents = append(queuedEvents, evtInteropJoinAttempt.NewWithIdentifiersAndData(ctx, &ids, req))
-		resp, err := ns.interopClient.HandleJoinRequest(ctx, ns.netID, req)
+		var resp *ttnpb.JoinResponse
+		if req.Payload.MType == ttnpb.MType_REJOIN_REQUEST {
+			// NOTE: Type 0 and 2 rejoin-requests do not contain the JoinEUI, so the Join Server is looked up by the JoinEUI of the device.
+			if ids.JoinEui == nil {
+				return nil, queuedEvents, errNoJoinEUI.New()
+			}
+			resp, err = ns.interopClient.HandleRejoinRequest(ctx, ns.netID, *ids.JoinEui, req)
+		} else {
+			resp, err = ns.interopClient.HandleJoinRequest(ctx, ns.netID, req)
+		}
 		if err == nil {
 			logger.Debug("Join-request accepted by interop Join Server")
 			queuedEvents = append(queuedEvents, evtInteropJoinSuccess.NewWithIdentifiersAndData(ctx, &ids, joinResponseWithoutKeys(resp)))
@@ -1044,6 +1093,9 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, errABPJoinRequest))
 		return nil
 	}
+	if err := ns.checkRelayedDevice(ctx, matched, nil); err != nil {
+		return err
+	}
 
 	fp, phy, err := DeviceFrequencyPlanAndBand(matched, ns.FrequencyPlans)
 	if err != nil {
@@ -1117,6 +1169,10 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	}
 	registerForwardJoinRequest(ctx, up)
 
+	if err := ns.checkRelayedDevice(ctx, matched, resp.SessionKeys.SessionKeyID); err != nil {
+		return err
+	}
+
 	keys := resp.SessionKeys
 	if !dlSettings.OptNeg {
 		keys.NwkSEncKey = keys.FNwkSIntKey
@@ -1182,25 +1238,334 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	matched = stored
 	ctx = storedCtx
 
-	// TODO: Extract this into a utility function shared with mac.HandleRejoinRequest. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
-	downAt := up.ReceivedAt.Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - macState.DesiredParameters.Rx1Delay.Duration()/2 - nsScheduleWindow())
-	if earliestAt := time.Now().Add(nsScheduleWindow()); downAt.Before(earliestAt) {
-		downAt = earliestAt
-	}
+	downAt := joinAcceptDownlinkTaskTime(up, phy, macState)
 	logger.WithField("start_at", downAt).Debug("Add downlink task")
 	if err := ns.downlinkTasks.Add(ctx, stored.EndDeviceIdentifiers, downAt, true); err != nil {
 		logger.WithError(err).Error("Failed to add downlink task after join-request")
 	}
+	ns.recordUplinkAirtime(ctx, matched.EndDeviceIdentifiers, up)
 	queuedEvents = append(queuedEvents, evtProcessJoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, up))
 	registerProcessUplink(ctx, up)
 	return nil
 }
 
-var errRejoinRequest = errors.DefineUnimplemented("rejoin_request", "rejoin-request handling is not implemented")
+// joinAcceptDownlinkTaskTime returns the time at which the downlink task for the join-accept in response to up should be executed.
+func joinAcceptDownlinkTaskTime(up *ttnpb.UplinkMessage, phy *band.Band, macState *ttnpb.MACState) time.Time {
+	downAt := up.ReceivedAt.Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - macState.DesiredParameters.Rx1Delay.Duration()/2 - nsScheduleWindow())
+	if earliestAt := time.Now().Add(nsScheduleWindow()); downAt.Before(earliestAt) {
+		downAt = earliestAt
+	}
+	return downAt
+}
 
-func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return errRejoinRequest.New()
+var handleRejoinRequestGetPaths = [...]string{
+	"frequency_plan_id",
+	"last_rj_count_0",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"session.dev_addr",
+	"session.keys.s_nwk_s_int_key",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// matchRejoinRequestMIC returns whether the MIC of the type 0 or 2 rejoin-request up matches the MIC computed with
+// SNwkSIntKey of the current session of dev.
+func (ns *NetworkServer) matchRejoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) bool {
+	if dev.Session == nil || dev.Session.SNwkSIntKey == nil {
+		return false
+	}
+	sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.SNwkSIntKey, ns.KeyVault)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("kek_label", dev.Session.SNwkSIntKey.KEKLabel).Warn("Failed to unwrap SNwkSIntKey")
+		return false
+	}
+	registerMICComputation(ctx)
+	mic, err := crypto.ComputeRejoinRequestMIC(sNwkSIntKey, up.RawPayload[:len(up.RawPayload)-4])
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to compute rejoin-request MIC")
+		return false
+	}
+	if !bytes.Equal(up.Payload.MIC, mic[:]) {
+		registerMICMismatch(ctx)
+		return false
+	}
+	return true
+}
+
+// matchRejoinRequest returns the device, which sent the rejoin-request up.
+// Type 0 and 2 rejoin-requests are matched by DevEUI and verified using SNwkSIntKey of the current session.
+// Type 1 rejoin-requests are matched by JoinEUI and DevEUI. The MIC of type 1 rejoin-requests is computed using
+// JSIntKey and is therefore verified by the Join Server.
+func (ns *NetworkServer) matchRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (*ttnpb.EndDevice, context.Context, error) {
+	pld := up.Payload.GetRejoinRequestPayload()
+	switch pld.RejoinType {
+	case ttnpb.RejoinRequestType_CONTEXT, ttnpb.RejoinRequestType_KEYS:
+		if !pld.NetID.Equal(ns.netID) {
+			return nil, ctx, errNetIDMismatch.WithAttributes(
+				"net_id", pld.NetID,
+				"expected_net_id", ns.netID,
+			)
+		}
+		var (
+			matched    *ttnpb.EndDevice
+			matchedCtx context.Context
+		)
+		if err := ns.devices.RangeByDevEUI(ctx, pld.DevEui, handleRejoinRequestGetPaths[:],
+			func(ctx context.Context, dev *ttnpb.EndDevice) (bool, error) {
+				if !dev.SupportsJoin || dev.MACState == nil || dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+					return false, nil
+				}
+				if !ns.matchRejoinRequestMIC(ctx, dev, up) {
+					return false, nil
+				}
+				matched, matchedCtx = dev, ctx
+				return true, nil
+			},
+		); err != nil {
+			logRegistryRPCError(ctx, err, "Failed to load devices from registry by DevEUI")
+			return nil, ctx, err
+		}
+		if matched == nil {
+			return nil, ctx, errDeviceNotFound.New()
+		}
+		return matched, matchedCtx, nil
+
+	case ttnpb.RejoinRequestType_SESSION:
+		matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEui, pld.DevEui, handleRejoinRequestGetPaths[:])
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
+			return nil, ctx, err
+		}
+		return matched, matchedCtx, nil
+
+	default:
+		return nil, ctx, errInvalidPayload.New()
+	}
+}
+
+func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
+	pld := up.Payload.GetRejoinRequestPayload()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", pld.DevEui,
+		"rejoin_cnt", pld.RejoinCnt,
+		"rejoin_type", pld.RejoinType,
+	))
+
+	matched, matchedCtx, err := ns.matchRejoinRequest(ctx, up)
+	if err != nil {
+		return err
+	}
+	ctx = matchedCtx
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, matched.EndDeviceIdentifiers))
+
+	queuedEvents := []events.Event{
+		evtReceiveRejoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, up),
+	}
+	defer func() {
+		if err != nil {
+			queuedEvents = append(queuedEvents, evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, err))
+		}
+		publishEvents(ctx, queuedEvents...)
+	}()
+
+	if !matched.SupportsJoin {
+		log.FromContext(ctx).Warn("ABP device sent a rejoin-request, drop")
+		queuedEvents = append(queuedEvents, evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, errABPJoinRequest))
+		return nil
+	}
+	if matched.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		return errUnsupportedLoRaWANVersion.WithAttributes("version", matched.LoRaWANVersion)
+	}
+	// NOTE: RJcount1 is tracked by the Join Server.
+	// NOTE: LastRJCount0 holds the next expected RJcount0, so that RJcount0 0 is accepted once after the reset.
+	checkRJCount := pld.RejoinType != ttnpb.RejoinRequestType_SESSION
+	if checkRJCount && pld.RejoinCnt < matched.LastRJCount0 {
+		log.FromContext(ctx).WithField("last_rj_count_0", matched.LastRJCount0).Debug("RJcount0 replay, drop")
+		return errRJCountReplay.WithAttributes(
+			"rj_count", pld.RejoinCnt,
+			"last_rj_count", matched.LastRJCount0-1,
+		)
+	}
+
+	fp, phy, err := DeviceFrequencyPlanAndBand(matched, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	drIdx, _, ok := phy.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return errDataRateNotFound.New()
+	}
+	up.Settings.DataRateIndex = drIdx
+	ctx = log.NewContextWithField(ctx,
+		"data_rate_index", drIdx,
+	)
+
+	var macState *ttnpb.MACState
+	var cfList *ttnpb.CFList
+	if pld.RejoinType != ttnpb.RejoinRequestType_KEYS || matched.MACState == nil {
+		macState, err = mac.NewState(matched, ns.FrequencyPlans, ns.defaultMACSettings)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to reset device's MAC state")
+			return err
+		}
+		cfList = frequencyplans.CFList(*fp, matched.LoRaWANPHYVersion)
+	} else {
+		// NOTE: Type 2 rejoin-requests only rekey the session and the radio parameters of the device are kept.
+		// The rejoin-accept does not contain a CFList in this case and the current parameters are used.
+		macState = matched.MACState
+		macState.DesiredParameters.Rx1Delay = macState.CurrentParameters.Rx1Delay
+		macState.DesiredParameters.Rx1DataRateOffset = macState.CurrentParameters.Rx1DataRateOffset
+		macState.DesiredParameters.Rx2DataRateIndex = macState.CurrentParameters.Rx2DataRateIndex
+		macState.PendingApplicationDownlink = nil
+		macState.PendingJoinRequest = nil
+		macState.PendingRequests = nil
+		macState.QueuedResponses = nil
+		macState.RecentDownlinks = nil
+	}
+
+	chIdx, err := searchUplinkChannel(up.Settings.Frequency, macState)
+	if err != nil {
+		return err
+	}
+	up.DeviceChannelIndex = uint32(chIdx)
+	ctx = log.NewContextWithField(ctx,
+		"device_channel_index", chIdx,
+	)
+
+	ok, err = ns.deduplicateUplink(ctx, up)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		queuedEvents = append(queuedEvents, evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, errDuplicate))
+		registerReceiveDuplicateUplink(ctx, up)
+		return nil
+	}
+
+	devAddr := ns.newDevAddr(ctx, matched)
+	const maxDevAddrGenerationRetries = 5
+	for i := 0; i < maxDevAddrGenerationRetries && matched.Session != nil && devAddr.Equal(matched.Session.DevAddr); i++ {
+		devAddr = ns.newDevAddr(ctx, matched)
+	}
+	ctx = log.NewContextWithField(ctx, "dev_addr", devAddr)
+	if matched.Session != nil && devAddr.Equal(matched.Session.DevAddr) {
+		log.FromContext(ctx).Error("Reusing the DevAddr used for current session")
+	}
+
+	dlSettings := ttnpb.DLSettings{
+		Rx1DROffset: macState.DesiredParameters.Rx1DataRateOffset,
+		Rx2DR:       macState.DesiredParameters.Rx2DataRateIndex,
+		OptNeg:      true,
+	}
+	joinReq := &ttnpb.JoinRequest{
+		Payload:            up.Payload,
+		CFList:             cfList,
+		CorrelationIDs:     events.CorrelationIDsFromContext(ctx),
+		DevAddr:            devAddr,
+		NetID:              ns.netID,
+		RawPayload:         up.RawPayload,
+		RxDelay:            macState.DesiredParameters.Rx1Delay,
+		SelectedMACVersion: matched.LoRaWANVersion,
+		DownlinkSettings:   dlSettings,
+		ConsumedAirtime:    up.ConsumedAirtime,
+	}
+	if pld.RejoinType != ttnpb.RejoinRequestType_SESSION {
+		// NOTE: Type 0 and 2 rejoin-requests do not contain the JoinEUI, which the Join Server needs to look up the device.
+		joinReq.JoinEui = matched.JoinEui
+	}
+	resp, joinEvents, err := ns.sendJoinRequest(ctx, matched.EndDeviceIdentifiers, joinReq)
+	queuedEvents = append(queuedEvents, joinEvents...)
+	if err != nil {
+		return err
+	}
+	registerForwardJoinRequest(ctx, up)
+
+	macState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
+		CorrelationIDs: resp.CorrelationIDs,
+		Keys:           resp.SessionKeys,
+		Payload:        resp.RawPayload,
+		DevAddr:        devAddr,
+		NetID:          ns.netID,
+		Request: ttnpb.MACState_JoinRequest{
+			RxDelay:          macState.DesiredParameters.Rx1Delay,
+			CFList:           cfList,
+			DownlinkSettings: dlSettings,
+		},
+	}
+	macState.RxWindowsAvailable = true
+	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIDs...)
+
+	publishEvents(ctx, queuedEvents...)
+	queuedEvents = nil
+	up = CopyUplinkMessage(up)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	ns.mergeMetadata(ctx, up)
+	macState.RecentUplinks = []*ttnpb.UplinkMessage{{
+		Payload:            up.Payload,
+		Settings:           up.Settings,
+		RxMetadata:         up.RxMetadata,
+		ReceivedAt:         up.ReceivedAt,
+		CorrelationIDs:     up.CorrelationIDs,
+		DeviceChannelIndex: up.DeviceChannelIndex,
+		ConsumedAirtime:    up.ConsumedAirtime,
+	}}
+
+	logger := log.FromContext(ctx)
+	stored, storedCtx, err := ns.devices.SetByID(ctx, matched.EndDeviceIdentifiers.ApplicationIdentifiers, matched.EndDeviceIdentifiers.DeviceId,
+		[]string{
+			"frequency_plan_id",
+			"last_rj_count_0",
+			"lorawan_phy_version",
+			"pending_session.queued_application_downlinks",
+			"session.queued_application_downlinks",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				logger.Warn("Device deleted during rejoin-request handling, drop")
+				return nil, nil, errOutdatedData.New()
+			}
+			stored.PendingMACState = macState
+			if !checkRJCount {
+				return stored, []string{
+					"pending_mac_state",
+				}, nil
+			}
+			if pld.RejoinCnt < stored.LastRJCount0 {
+				return nil, nil, errRJCountReplay.WithAttributes(
+					"rj_count", pld.RejoinCnt,
+					"last_rj_count", stored.LastRJCount0-1,
+				)
+			}
+			stored.LastRJCount0 = pld.RejoinCnt + 1
+			return stored, []string{
+				"last_rj_count_0",
+				"pending_mac_state",
+			}, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to update device in registry")
+		return err
+	}
+	matched = stored
+	ctx = storedCtx
+
+	downAt := joinAcceptDownlinkTaskTime(up, phy, macState)
+	logger.WithField("start_at", downAt).Debug("Add downlink task")
+	if err := ns.downlinkTasks.Add(ctx, stored.EndDeviceIdentifiers, downAt, true); err != nil {
+		logger.WithError(err).Error("Failed to add downlink task after rejoin-request")
+	}
+	ns.recordUplinkAirtime(ctx, matched.EndDeviceIdentifiers, up)
+	queuedEvents = append(queuedEvents, evtProcessRejoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, up))
+	registerProcessUplink(ctx, up)
+	return nil
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
@@ -1219,9 +1584,17 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	up.ReceivedAt = time.Now().UTC()
 
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// handleUplink decodes the uplink up and handles it according to its MType.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1230,7 +1603,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		}
 	}()
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"version", up.Payload.Major,
 		)
 	}
@@ -1253,7 +1626,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"spreading_factor", dr.LoRa.GetSpreadingFactor(),
 		))
 	default:
-		return nil, errDataRateNotFound.New()
+		return errDataRateNotFound.New()
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1264,14 +1637,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
 
 // ReportTxAcknowledgment is called by the Gateway Server when a tx acknowledgment arrives.
@@ -121,6 +121,20 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 			return false
 		}
 
+		err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEui, ttnpb.EndDeviceFieldPathsTopLevel,
+			func(storedCtx context.Context, stored *ttnpb.EndDevice) (bool, error) {
+				t.Errorf("RangeByDevEUI called f with empty registry: %v", stored)
+				return false, nil
+			},
+		)
+		if !test.AllTrue(
+			a.So(err, should.NotBeNil),
+			a.So(errors.IsNotFound(err), should.BeTrue),
+		) {
+			t.Error("RangeByDevEUI assertion failed with empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.ApplicationIdentifiers, pb.DeviceId, ttnpb.EndDeviceFieldPathsTopLevel,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -188,6 +202,22 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		ctx = storedCtx
 
+		var ranged []*ttnpb.EndDevice
+		err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEui, ttnpb.EndDeviceFieldPathsTopLevel,
+			func(storedCtx context.Context, stored *ttnpb.EndDevice) (bool, error) {
+				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
+				ranged = append(ranged, stored)
+				return true, nil
+			},
+		)
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(ranged, should.Resemble, []*ttnpb.EndDevice{pb}),
+		) {
+			t.Error("RangeByDevEUI assertion failed with non-empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.ApplicationIdentifiers, pb.DeviceId, fields,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
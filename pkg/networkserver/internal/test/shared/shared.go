@@ -19,12 +19,13 @@ import (
 	"time"
 
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 )
 
 var (
 	CacheTTL           = (1 << 6) * test.Delay
-	DefaultMACSettings = DefaultConfig.DefaultMACSettings.Parse()
+	DefaultMACSettings = test.Must(DefaultConfig.DefaultMACSettings.Parse()).(ttnpb.MACSettings)
 )
 
 type TaskPopFuncResponse struct {
@@ -67,3 +67,7 @@ func Until(t time.Time) time.Duration {
 func Unix(sec int64, nsec int64) time.Time {
 	return time.Unix(sec, nsec)
 }
+
+func ParseDuration(s string) (time.Duration, error) {
+	return time.ParseDuration(s)
+}
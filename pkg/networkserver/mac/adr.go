@@ -17,6 +17,7 @@ package mac
 import (
 	"context"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
@@ -144,10 +145,64 @@ func txPowerStep(phy *band.Band, from, to uint8) float32 {
 	return phy.TxOffset[from] - phy.TxOffset[to]
 }
 
-func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
+// DefaultADRMaxLossRate is the default maximum packet loss rate at which the loss-based ADR strategy may increase the data rate.
+const DefaultADRMaxLossRate = 0.05
+
+// ADR strategy names, as reported in ttnpb.ADRDecision.
+const (
+	ADRStrategyDynamic   = "dynamic"
+	ADRStrategyLossBased = "loss_based"
+	ADRStrategyMobile    = "mobile"
+	ADRStrategyStatic    = "static"
+)
+
+// DeviceADRSettings returns the ADR settings of the device, falling back to the defaults.
+func DeviceADRSettings(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) *ttnpb.ADRSettings {
+	if s := dev.GetMACSettings().GetADR(); s.GetStrategy() != nil {
+		return s
+	}
+	if s := defaults.GetADR(); s.GetStrategy() != nil {
+		return s
+	}
+	return &ttnpb.ADRSettings{
+		Strategy: &ttnpb.ADRSettings_Dynamic{
+			Dynamic: &ttnpb.ADRSettings_DynamicStrategy{},
+		},
+	}
+}
+
+func adrStrategyMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings, margin *pbtypes.FloatValue) float32 {
+	if margin != nil {
+		return margin.Value
+	}
+	return deviceADRMargin(dev, defaults)
+}
+
+func minSNRFromUplinks(ups ...*ttnpb.UplinkMessage) (float32, bool) {
+	var (
+		minSNR float32
+		found  bool
+	)
+	for _, up := range ups {
+		snr, ok := maxSNRFromMetadata(up.RxMetadata...)
+		if !ok {
+			continue
+		}
+		if !found || snr < minSNR {
+			minSNR, found = snr, true
+		}
+	}
+	return minSNR, found
+}
+
+// AdaptDataRate computes the desired ADR parameters of the device according to the ADR strategy configured
+// in the MAC settings of the device or the defaults.
+// AdaptDataRate returns the decision made, or nil if the parameters were not adapted.
+func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) (*ttnpb.ADRDecision, error) {
 	if dev.MACState == nil {
-		return nil
+		return nil, nil
 	}
+	settings := DeviceADRSettings(dev, defaults)
 
 	adrUplinks := func() []*ttnpb.UplinkMessage {
 		for i := len(dev.MACState.RecentUplinks) - 1; i >= 0; i-- {
@@ -162,12 +217,12 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		return dev.MACState.RecentUplinks
 	}()
 	if len(adrUplinks) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	minDataRateIndex, maxDataRateIndex, ok := channelDataRateRange(dev.MACState.CurrentParameters.Channels...)
 	if !ok {
-		return ErrCorruptedMACState
+		return nil, ErrCorruptedMACState
 	}
 	if maxDataRateIndex > phy.MaxADRDataRateIndex {
 		maxDataRateIndex = phy.MaxADRDataRateIndex
@@ -188,10 +243,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minDataRateIndex > maxDataRateIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible data rate values given the channels enabled, avoid ADR.")
-		return nil
-	}
-	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
-		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+		return nil, nil
 	}
 
 	minTxPowerIndex := uint8(0)
@@ -212,30 +264,65 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	}
 	if minTxPowerIndex > maxTxPowerIndex {
 		log.FromContext(ctx).Debug("Device has rejected all possible TX output power index values, avoid ADR.")
-		return nil
+		return nil, nil
 	}
 
-	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
+	if static := settings.GetStatic(); static != nil {
+		return adaptStaticDataRate(dev, static, adrUplinks, minDataRateIndex, maxDataRateIndex, rejectedDataRateIndexes, minTxPowerIndex, maxTxPowerIndex, rejectedTxPowerIndexes), nil
+	}
+
+	if dev.MACState.CurrentParameters.ADRDataRateIndex > minDataRateIndex {
+		minDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	}
+
+	var (
+		strategy         string
+		adrMargin        float32
+		snr              float32
+		increaseDataRate = true
+	)
+	lossRate := adrLossRate(adrUplinks...)
+	switch s := settings.Strategy.(type) {
+	case *ttnpb.ADRSettings_LossBased:
+		strategy = ADRStrategyLossBased
+		adrMargin = adrStrategyMargin(dev, defaults, s.LossBased.GetMargin())
+		snr, ok = minSNRFromUplinks(adrUplinks...)
+		maxLossRate := float32(DefaultADRMaxLossRate)
+		if v := s.LossBased.GetMaxLossRate(); v != nil {
+			maxLossRate = v.Value
+		}
+		increaseDataRate = lossRate <= maxLossRate
+	case *ttnpb.ADRSettings_Mobile:
+		strategy = ADRStrategyMobile
+		adrMargin = adrStrategyMargin(dev, defaults, s.Mobile.GetMargin())
+		snr, ok = maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
+		increaseDataRate = false
+	default:
+		strategy = ADRStrategyDynamic
+		adrMargin = adrStrategyMargin(dev, defaults, settings.GetDynamic().GetMargin())
+		snr, ok = maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
+	}
 	if !ok {
-		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR.")
-		return nil
+		log.FromContext(ctx).Debug("Failed to determine SNR, avoid ADR.")
+		return nil, nil
 	}
 
 	// The link margin indicates how much stronger the signal (SNR) is than the
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin, and an extra safety margin if we're afraid that we
 	// don't have enough data for our decision.
-	var margin float32
+	var margin, df float32
 	// NOTE: We currently assume that the uplink's SF and BW correspond to CurrentParameters.ADRDataRateIndex.
 	if dr := LastUplink(adrUplinks...).Settings.DataRate.GetLoRa(); dr != nil {
 		var ok bool
-		df, ok := demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
+		df, ok = demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
 		if !ok {
-			return ErrInvalidDataRate.New()
+			return nil, ErrInvalidDataRate.New()
 		}
-		margin = maxSNR - df - deviceADRMargin(dev, defaults)
+		margin = snr - df - adrMargin
 	}
 	if len(adrUplinks) < OptimalADRUplinkCount {
+		adrMargin += safetyMargin
 		margin -= safetyMargin
 	}
 
@@ -248,17 +335,19 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		dev.MACState.DesiredParameters.ADRDataRateIndex = minDataRateIndex
 		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
 	}
-	if marginSteps := (margin - txPowerStep(phy, 0, minTxPowerIndex)) / drStep; marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex) {
-		maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex + ttnpb.DataRateIndex(marginSteps)
-	}
-	for drIdx := maxDataRateIndex; drIdx > minDataRateIndex; drIdx-- {
-		if _, ok := rejectedDataRateIndexes[drIdx]; ok {
-			continue
+	if increaseDataRate {
+		if marginSteps := (margin - txPowerStep(phy, 0, minTxPowerIndex)) / drStep; marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex) {
+			maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex + ttnpb.DataRateIndex(marginSteps)
+		}
+		for drIdx := maxDataRateIndex; drIdx > minDataRateIndex; drIdx-- {
+			if _, ok := rejectedDataRateIndexes[drIdx]; ok {
+				continue
+			}
+			margin -= float32(drIdx-dev.MACState.DesiredParameters.ADRDataRateIndex) * drStep
+			dev.MACState.DesiredParameters.ADRDataRateIndex = drIdx
+			dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			break
 		}
-		margin -= float32(drIdx-dev.MACState.DesiredParameters.ADRDataRateIndex) * drStep
-		dev.MACState.DesiredParameters.ADRDataRateIndex = drIdx
-		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
-		break
 	}
 
 	if dev.MACState.DesiredParameters.ADRTxPowerIndex < uint32(minTxPowerIndex) {
@@ -285,7 +374,7 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
 	}
 	if len(adrUplinks) >= OptimalADRUplinkCount/2 {
-		switch r := adrLossRate(adrUplinks...); {
+		switch r := lossRate; {
 		case r < 0.05:
 			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
 		case r < 0.10:
@@ -295,5 +384,45 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
 		}
 	}
-	return nil
+	return &ttnpb.ADRDecision{
+		Strategy:          strategy,
+		UplinkCount:       uint32(len(adrUplinks)),
+		SNR:               snr,
+		DemodulationFloor: df,
+		Margin:            adrMargin,
+		RemainingMargin:   margin,
+		LossRate:          lossRate,
+		DataRateIndex:     dev.MACState.DesiredParameters.ADRDataRateIndex,
+		TxPowerIndex:      dev.MACState.DesiredParameters.ADRTxPowerIndex,
+		NbTrans:           dev.MACState.DesiredParameters.ADRNbTrans,
+	}, nil
+}
+
+// adaptStaticDataRate sets the desired ADR parameters to the fixed parameters of the static strategy.
+// Parameters that the device cannot use are left unchanged.
+func adaptStaticDataRate(
+	dev *ttnpb.EndDevice, static *ttnpb.ADRSettings_StaticStrategy, adrUplinks []*ttnpb.UplinkMessage,
+	minDataRateIndex, maxDataRateIndex ttnpb.DataRateIndex, rejectedDataRateIndexes map[ttnpb.DataRateIndex]struct{},
+	minTxPowerIndex, maxTxPowerIndex uint8, rejectedTxPowerIndexes map[uint8]struct{},
+) *ttnpb.ADRDecision {
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	if _, ok := rejectedDataRateIndexes[static.DataRateIndex]; !ok && static.DataRateIndex >= minDataRateIndex && static.DataRateIndex <= maxDataRateIndex {
+		dev.MACState.DesiredParameters.ADRDataRateIndex = static.DataRateIndex
+	}
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
+	if _, ok := rejectedTxPowerIndexes[uint8(static.TxPowerIndex)]; !ok && static.TxPowerIndex >= uint32(minTxPowerIndex) && static.TxPowerIndex <= uint32(maxTxPowerIndex) {
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = static.TxPowerIndex
+	}
+	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
+	if static.NbTrans > 0 {
+		dev.MACState.DesiredParameters.ADRNbTrans = static.NbTrans
+	}
+	return &ttnpb.ADRDecision{
+		Strategy:      ADRStrategyStatic,
+		UplinkCount:   uint32(len(adrUplinks)),
+		LossRate:      adrLossRate(adrUplinks...),
+		DataRateIndex: dev.MACState.DesiredParameters.ADRDataRateIndex,
+		TxPowerIndex:  dev.MACState.DesiredParameters.ADRTxPowerIndex,
+		NbTrans:       dev.MACState.DesiredParameters.ADRNbTrans,
+	}
 }
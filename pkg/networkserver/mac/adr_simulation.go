@@ -0,0 +1,154 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// uplinkEnergy returns the radiated energy in mJ of nbTrans transmissions with the given airtime and EIRP in dBm.
+func uplinkEnergy(airtime time.Duration, eirp float32, nbTrans uint32) float32 {
+	return float32(math.Pow(10, float64(eirp)/10) * airtime.Seconds() * float64(nbTrans))
+}
+
+// adrSimulationTxSettings returns settings with the data rate of phy at index idx.
+func adrSimulationTxSettings(phy *band.Band, settings ttnpb.TxSettings, idx ttnpb.DataRateIndex) (ttnpb.TxSettings, error) {
+	dr, ok := phy.DataRates[idx]
+	if !ok {
+		return ttnpb.TxSettings{}, ErrInvalidDataRate.New()
+	}
+	settings.DataRateIndex = idx
+	settings.DataRate = dr.Rate
+	if settings.CodingRate == "" && dr.Rate.GetLoRa() != nil {
+		settings.CodingRate = phy.LoRaCodingRate
+	}
+	return settings, nil
+}
+
+// SimulateADR replays the recent uplinks of dev through AdaptDataRate, using the MAC settings of dev.
+// The end device is assumed to apply each LinkADRReq before the next uplink. The SNR of the recent uplinks is assumed
+// to be independent of the data rate, and is corrected for the difference between the simulated TX output power
+// and the current TX output power of the end device.
+func SimulateADR(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) (*ttnpb.ADRSimulation, error) {
+	sim := &ttnpb.ADRSimulation{}
+	if dev.MACState == nil || len(dev.MACState.RecentUplinks) == 0 {
+		return sim, nil
+	}
+
+	recordedTxPowerIndex := dev.MACState.CurrentParameters.ADRTxPowerIndex
+	recordedNbTrans := dev.MACState.CurrentParameters.ADRNbTrans
+	if recordedNbTrans == 0 {
+		recordedNbTrans = 1
+	}
+	maxEIRP := dev.MACState.CurrentParameters.MaxEIRP
+	if maxEIRP == 0 {
+		maxEIRP = phy.DefaultMaxEIRP
+	}
+	eirp := func(txPowerIndex uint32) float32 {
+		if max := uint32(phy.MaxTxPowerIndex()); txPowerIndex > max {
+			txPowerIndex = max
+		}
+		return maxEIRP + phy.TxOffset[txPowerIndex]
+	}
+
+	simDev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+		MACSettings:          dev.MACSettings,
+		MACState: &ttnpb.MACState{
+			CurrentParameters:          dev.MACState.CurrentParameters,
+			DesiredParameters:          dev.MACState.CurrentParameters,
+			RejectedADRDataRateIndexes: dev.MACState.RejectedADRDataRateIndexes,
+			RejectedADRTxPowerIndexes:  dev.MACState.RejectedADRTxPowerIndexes,
+		},
+	}
+	cur := &simDev.MACState.CurrentParameters
+	cur.ADRDataRateIndex = dev.MACState.RecentUplinks[0].Settings.DataRateIndex
+	cur.ADRNbTrans = recordedNbTrans
+
+	for _, up := range dev.MACState.RecentUplinks {
+		b, err := lorawan.MarshalMessage(*up.Payload)
+		if err != nil {
+			return nil, err
+		}
+		recordedSettings, err := adrSimulationTxSettings(phy, up.Settings, up.Settings.DataRateIndex)
+		if err != nil {
+			return nil, err
+		}
+		recordedAirtime, err := toa.Compute(len(b), recordedSettings)
+		if err != nil {
+			return nil, err
+		}
+		sim.RecordedAirtime += recordedAirtime * time.Duration(recordedNbTrans)
+		sim.RecordedEnergy += uplinkEnergy(recordedAirtime, eirp(recordedTxPowerIndex), recordedNbTrans)
+
+		simUp := *up
+		simUp.Settings, err = adrSimulationTxSettings(phy, up.Settings, cur.ADRDataRateIndex)
+		if err != nil {
+			return nil, err
+		}
+		snrDiff := txPowerStep(phy, uint8(recordedTxPowerIndex), uint8(cur.ADRTxPowerIndex))
+		simUp.RxMetadata = make([]*ttnpb.RxMetadata, 0, len(up.RxMetadata))
+		for _, md := range up.RxMetadata {
+			simMD := *md
+			simMD.SNR -= snrDiff
+			simUp.RxMetadata = append(simUp.RxMetadata, &simMD)
+		}
+		simulatedAirtime, err := toa.Compute(len(b), simUp.Settings)
+		if err != nil {
+			return nil, err
+		}
+		nbTrans := cur.ADRNbTrans
+		if nbTrans == 0 {
+			nbTrans = 1
+		}
+		step := &ttnpb.ADRSimulation_Step{
+			FCnt:          up.Payload.GetMACPayload().GetFullFCnt(),
+			DataRateIndex: cur.ADRDataRateIndex,
+			TxPowerIndex:  cur.ADRTxPowerIndex,
+			NbTrans:       nbTrans,
+			Airtime:       simulatedAirtime * time.Duration(nbTrans),
+			Energy:        uplinkEnergy(simulatedAirtime, eirp(cur.ADRTxPowerIndex), nbTrans),
+		}
+		sim.SimulatedAirtime += step.Airtime
+		sim.SimulatedEnergy += step.Energy
+
+		simDev.MACState.RecentUplinks = append(simDev.MACState.RecentUplinks, &simUp)
+		step.Decision, err = AdaptDataRate(ctx, simDev, phy, defaults)
+		if err != nil {
+			return nil, err
+		}
+		desired := simDev.MACState.DesiredParameters
+		if step.Decision != nil &&
+			(desired.ADRDataRateIndex != cur.ADRDataRateIndex ||
+				desired.ADRTxPowerIndex != cur.ADRTxPowerIndex ||
+				desired.ADRNbTrans != cur.ADRNbTrans) {
+			step.LinkADRReq = true
+			cur.ADRDataRateIndex = desired.ADRDataRateIndex
+			cur.ADRTxPowerIndex = desired.ADRTxPowerIndex
+			cur.ADRNbTrans = desired.ADRNbTrans
+			simDev.MACState.LastADRChangeFCntUp = step.FCnt
+		}
+		sim.Steps = append(sim.Steps, step)
+	}
+	return sim, nil
+}
@@ -0,0 +1,95 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSimulateADR(t *testing.T) {
+	a, ctx := test.New(t)
+
+	rows := make([]ADRMatrixRow, 0, 20)
+	for fCnt := uint32(10); fCnt < 30; fCnt++ {
+		rows = append(rows, ADRMatrixRow{
+			FCnt:         fCnt,
+			MaxSNR:       -6,
+			GtwDiversity: 1,
+			TxSettings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 12,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				DataRateIndex: ttnpb.DATA_RATE_0,
+				Frequency:     868100000,
+			},
+		})
+	}
+	dev := &ttnpb.EndDevice{
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+		MACState: &ttnpb.MACState{
+			CurrentParameters: ttnpb.MACParameters{
+				ADRNbTrans:      1,
+				ADRTxPowerIndex: 1,
+				Channels:        MakeDefaultEU868CurrentChannels(),
+			},
+			RecentUplinks: ADRMatrixToUplinks(rows),
+		},
+		MACSettings: &ttnpb.MACSettings{
+			ADRMargin: &pbtypes.FloatValue{
+				Value: 2,
+			},
+		},
+	}
+	fp := test.FrequencyPlan(dev.FrequencyPlanID)
+	sim, err := SimulateADR(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], ttnpb.MACSettings{})
+	if !a.So(err, should.BeNil) || !a.So(sim.Steps, should.HaveLength, 20) {
+		t.FailNow()
+	}
+
+	// The first uplink allows increasing the data rate to DR3.
+	a.So(sim.Steps[0].DataRateIndex, should.Equal, ttnpb.DATA_RATE_0)
+	a.So(sim.Steps[0].LinkADRReq, should.BeTrue)
+	a.So(sim.Steps[0].Decision.DataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+	a.So(sim.Steps[0].Decision.TxPowerIndex, should.Equal, 1)
+
+	// The remaining margin at DR3 allows lowering the TX output power.
+	a.So(sim.Steps[1].DataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+	a.So(sim.Steps[1].TxPowerIndex, should.Equal, 1)
+	a.So(sim.Steps[1].LinkADRReq, should.BeTrue)
+	a.So(sim.Steps[1].Decision.TxPowerIndex, should.Equal, 2)
+
+	for _, step := range sim.Steps[2:] {
+		a.So(step.DataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+		a.So(step.TxPowerIndex, should.Equal, 2)
+		a.So(step.NbTrans, should.Equal, 1)
+		a.So(step.LinkADRReq, should.BeFalse)
+	}
+	a.So(sim.SimulatedAirtime, should.BeLessThan, sim.RecordedAirtime)
+	a.So(sim.SimulatedEnergy, should.BeLessThan, sim.RecordedEnergy)
+}
@@ -68,6 +68,7 @@ func TestAdaptDataRate(t *testing.T) {
 		Name       string
 		Device     *ttnpb.EndDevice
 		DeviceDiff func(*ttnpb.EndDevice)
+		Decision   *ttnpb.ADRDecision
 		Error      error
 	}{
 		{
@@ -262,6 +263,140 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MACState.DesiredParameters.ADRNbTrans = 1
 			},
 		},
+		{
+			Name: "adapted example from Semtech paper/mobile strategy",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADR: &ttnpb.ADRSettings{
+						Strategy: &ttnpb.ADRSettings_Mobile{
+							Mobile: &ttnpb.ADRSettings_MobileStrategy{},
+						},
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 7
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+			Decision: &ttnpb.ADRDecision{
+				Strategy:          ADRStrategyMobile,
+				UplinkCount:       20,
+				SNR:               -6,
+				DemodulationFloor: -20,
+				Margin:            2,
+				LossRate:          float32(2) / 22,
+				DataRateIndex:     ttnpb.DATA_RATE_0,
+				TxPowerIndex:      7,
+				NbTrans:           1,
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper/loss-based strategy",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADR: &ttnpb.ADRSettings{
+						Strategy: &ttnpb.ADRSettings_LossBased{
+							LossBased: &ttnpb.ADRSettings_LossBasedStrategy{},
+						},
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+			Decision: &ttnpb.ADRDecision{
+				Strategy:          ADRStrategyLossBased,
+				UplinkCount:       20,
+				SNR:               -25,
+				DemodulationFloor: -20,
+				Margin:            2,
+				RemainingMargin:   -7,
+				LossRate:          float32(2) / 22,
+				DataRateIndex:     ttnpb.DATA_RATE_0,
+				TxPowerIndex:      1,
+				NbTrans:           1,
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper/static strategy",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+					RecentUplinks: semtechPaperUplinks,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADR: &ttnpb.ADRSettings{
+						Strategy: &ttnpb.ADRSettings_Static{
+							Static: &ttnpb.ADRSettings_StaticStrategy{
+								DataRateIndex: ttnpb.DATA_RATE_3,
+								TxPowerIndex:  2,
+								NbTrans:       2,
+							},
+						},
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 2
+				dev.MACState.DesiredParameters.ADRNbTrans = 2
+			},
+			Decision: &ttnpb.ADRDecision{
+				Strategy:      ADRStrategyStatic,
+				UplinkCount:   20,
+				LossRate:      float32(2) / 22,
+				DataRateIndex: ttnpb.DATA_RATE_3,
+				TxPowerIndex:  2,
+				NbTrans:       2,
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -270,10 +405,13 @@ func TestAdaptDataRate(t *testing.T) {
 			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
 				dev := CopyEndDevice(tc.Device)
 				fp := test.FrequencyPlan(dev.FrequencyPlanID)
-				err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], ttnpb.MACSettings{})
+				decision, err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion], ttnpb.MACSettings{})
 				if !a.So(err, should.Equal, tc.Error) {
 					t.Fatalf("ADR failed with: %s", err)
 				}
+				if tc.Decision != nil {
+					a.So(decision, should.Resemble, tc.Decision)
+				}
 				expected := CopyEndDevice(tc.Device)
 				if tc.DeviceDiff != nil {
 					tc.DeviceDiff(expected)
@@ -97,6 +97,12 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
 	)
 
+	EvtADRDecision = events.Define(
+		"ns.mac.adr.decide", "decide ADR parameters",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ADRDecision{}),
+	)
+
 	EvtClassASwitch = defineClassSwitchEvent('a')()
 	EvtClassBSwitch = defineClassSwitchEvent('b')()
 	EvtClassCSwitch = defineClassSwitchEvent('c')()
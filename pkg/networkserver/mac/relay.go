@@ -0,0 +1,176 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayConfRequest = defineEnqueueMACRequestEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayConfAccept = defineReceiveMACAcceptEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayConfReject = defineReceiveMACRejectEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtEnqueueRelayEndDeviceConfRequest = defineEnqueueMACRequestEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayEndDeviceConfAccept = defineReceiveMACAcceptEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayEndDeviceConfReject = defineReceiveMACRejectEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtEnqueueRelayFilterListRequest = defineEnqueueMACRequestEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayFilterListAccept = defineReceiveMACAcceptEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayFilterListReject = defineReceiveMACRejectEvent(
+		"relay_filter_list", "relay filter list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtEnqueueRelayUpdateUplinkListRequest = defineEnqueueMACRequestEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+	)()
+	EvtReceiveRelayUpdateUplinkListAnswer = defineReceiveMACAnswerEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+	)()
+	EvtEnqueueRelayCtrlUplinkListRequest = defineEnqueueMACRequestEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayCtrlUplinkListAccept = defineReceiveMACAcceptEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayCtrlUplinkListReject = defineReceiveMACRejectEvent(
+		"relay_ctrl_uplink_list", "relay control uplink list",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtEnqueueRelayConfigureFwdLimitRequest = defineEnqueueMACRequestEvent(
+		"relay_configure_fwd_limit", "relay configure forward limit",
+		events.WithDataType(&ttnpb.MACCommand{}),
+	)()
+	EvtReceiveRelayConfigureFwdLimitAnswer = defineReceiveMACAnswerEvent(
+		"relay_configure_fwd_limit", "relay configure forward limit",
+	)()
+	EvtReceiveRelayNotifyNewEndDeviceRequest = defineReceiveMACRequestEvent(
+		"relay_notify_new_end_device", "relay notify new end device",
+		events.WithDataType(&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{}),
+	)()
+)
+
+var relayRequestEvents = map[ttnpb.MACCommandIdentifier]events.Builder{
+	ttnpb.CID_RELAY_CONF:                EvtEnqueueRelayConfRequest,
+	ttnpb.CID_RELAY_END_DEVICE_CONF:     EvtEnqueueRelayEndDeviceConfRequest,
+	ttnpb.CID_RELAY_FILTER_LIST:         EvtEnqueueRelayFilterListRequest,
+	ttnpb.CID_RELAY_UPDATE_UPLINK_LIST:  EvtEnqueueRelayUpdateUplinkListRequest,
+	ttnpb.CID_RELAY_CTRL_UPLINK_LIST:    EvtEnqueueRelayCtrlUplinkListRequest,
+	ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT: EvtEnqueueRelayConfigureFwdLimitRequest,
+}
+
+// relayAnswerEvents maps the CID of relay answers to the accept and reject events.
+// Answers without acknowledgment bits are always accepted.
+var relayAnswerEvents = map[ttnpb.MACCommandIdentifier][2]events.Builder{
+	ttnpb.CID_RELAY_CONF:                {EvtReceiveRelayConfAccept, EvtReceiveRelayConfReject},
+	ttnpb.CID_RELAY_END_DEVICE_CONF:     {EvtReceiveRelayEndDeviceConfAccept, EvtReceiveRelayEndDeviceConfReject},
+	ttnpb.CID_RELAY_FILTER_LIST:         {EvtReceiveRelayFilterListAccept, EvtReceiveRelayFilterListReject},
+	ttnpb.CID_RELAY_UPDATE_UPLINK_LIST:  {EvtReceiveRelayUpdateUplinkListAnswer, EvtReceiveRelayUpdateUplinkListAnswer},
+	ttnpb.CID_RELAY_CTRL_UPLINK_LIST:    {EvtReceiveRelayCtrlUplinkListAccept, EvtReceiveRelayCtrlUplinkListReject},
+	ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT: {EvtReceiveRelayConfigureFwdLimitAnswer, EvtReceiveRelayConfigureFwdLimitAnswer},
+}
+
+// EnqueueRelayRequest enqueues the relay request cmd in the pending requests of the relay dev.
+func EnqueueRelayRequest(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, cmd *ttnpb.MACCommand) EnqueueState {
+	evt, ok := relayRequestEvents[cmd.CID]
+	if !ok {
+		panic("invalid relay request CID")
+	}
+
+	var st EnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(cmd.CID, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		desc := lorawan.DefaultMACCommands[cmd.CID]
+		if nDown < 1 || desc.UplinkLength > 0 && nUp < 1 {
+			return nil, 0, nil, false
+		}
+		var nAns uint16
+		if desc.UplinkLength > 0 {
+			nAns = 1
+		}
+		log.FromContext(ctx).WithField("cid", cmd.CID).Debug("Enqueued relay request")
+		if cmd.CID == ttnpb.CID_RELAY_UPDATE_UPLINK_LIST {
+			// The payload of UpdateUplinkListReq contains the RootWorSKey of the served end device.
+			return []*ttnpb.MACCommand{cmd}, nAns, events.Builders{evt}, true
+		}
+		return []*ttnpb.MACCommand{cmd}, nAns, events.Builders{evt.With(events.WithData(cmd))}, true
+	}, dev.MACState.PendingRequests...)
+	return st
+}
+
+// HandleRelayAns handles the answer cmd to a relay request of the relay dev.
+// HandleRelayAns returns the answered request and whether the relay accepted the request.
+func HandleRelayAns(ctx context.Context, dev *ttnpb.EndDevice, cmd *ttnpb.MACCommand) (*ttnpb.MACCommand, bool, events.Builders, error) {
+	evts, ok := relayAnswerEvents[cmd.CID]
+	if !ok {
+		panic("invalid relay answer CID")
+	}
+
+	var req *ttnpb.MACCommand
+	var err error
+	dev.MACState.PendingRequests, err = handleMACResponse(cmd.CID, func(found *ttnpb.MACCommand) error {
+		req = found
+		return nil
+	}, dev.MACState.PendingRequests...)
+
+	accepted := lorawan.RelayAnswerAccepted(cmd)
+	evt := evts[0]
+	if !accepted {
+		evt = evts[1]
+	}
+	if cmd.CID == ttnpb.CID_RELAY_UPDATE_UPLINK_LIST || cmd.CID == ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT {
+		return req, accepted, events.Builders{evt}, err
+	}
+	return req, accepted, events.Builders{evt.With(events.WithData(cmd))}, err
+}
+
+// HandleRelayNotifyNewEndDeviceReq handles the notification pld of the relay dev about an end device that is not in
+// the trusted uplink list of the relay.
+func HandleRelayNotifyNewEndDeviceReq(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayNotifyNewEndDeviceReq) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+	return events.Builders{
+		EvtReceiveRelayNotifyNewEndDeviceRequest.With(events.WithData(pld)),
+	}, nil
+}
@@ -0,0 +1,234 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEnqueueRelayRequest(t *testing.T) {
+	confReq := (&ttnpb.MACCommand_RelayConfReq{Enabled: true, CadPeriodicity: 1}).MACCommand()
+	updateReq := (&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+		Index:   1,
+		DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		RootWorSKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			KEKLabel:     "test",
+		},
+	}).MACCommand()
+
+	for _, tc := range []struct {
+		Name                               string
+		Device, Expected                   *ttnpb.EndDevice
+		Command                            *ttnpb.MACCommand
+		MaxDownlinkLength, MaxUplinkLength uint16
+		State                              EnqueueState
+	}{
+		{
+			Name: "relay configuration",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{confReq},
+				},
+			},
+			Command:           confReq,
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 36,
+				MaxUpLen:   22,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayConfRequest.With(events.WithData(confReq)),
+				},
+				Ok: true,
+			},
+		},
+		{
+			Name: "update uplink list/without answer",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{updateReq},
+				},
+			},
+			Command:           updateReq,
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   0,
+			State: EnqueueState{
+				MaxDownLen: 15,
+				MaxUpLen:   0,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest,
+				},
+				Ok: true,
+			},
+		},
+		{
+			Name: "update uplink list/does not fit",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Command:           updateReq,
+			MaxDownlinkLength: 15,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 15,
+				MaxUpLen:   24,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				st := EnqueueRelayRequest(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength, tc.Command)
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayAns(t *testing.T) {
+	ctrlReq := (&ttnpb.MACCommand_RelayCtrlUplinkListReq{Index: 2, Action: lorawan.RelayCtrlUplinkListRemove}).MACCommand()
+
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Answer           *ttnpb.MACCommand
+		Request          *ttnpb.MACCommand
+		Accepted         bool
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Answer:   (&ttnpb.MACCommand_RelayCtrlUplinkListAns{IndexAck: true}).MACCommand(),
+			Accepted: true,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData((&ttnpb.MACCommand_RelayCtrlUplinkListAns{IndexAck: true}).MACCommand())),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "accepted",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{ctrlReq},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Answer:   (&ttnpb.MACCommand_RelayCtrlUplinkListAns{IndexAck: true, WFCnt: 42}).MACCommand(),
+			Request:  ctrlReq,
+			Accepted: true,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData((&ttnpb.MACCommand_RelayCtrlUplinkListAns{IndexAck: true, WFCnt: 42}).MACCommand())),
+			},
+		},
+		{
+			Name: "rejected",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{ctrlReq},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Answer:  (&ttnpb.MACCommand_RelayCtrlUplinkListAns{}).MACCommand(),
+			Request: ctrlReq,
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListReject.With(events.WithData((&ttnpb.MACCommand_RelayCtrlUplinkListAns{}).MACCommand())),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				req, accepted, evs, err := HandleRelayAns(ctx, dev, tc.Answer)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(req, should.Resemble, tc.Request)
+				a.So(accepted, should.Equal, tc.Accepted)
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
+
+func TestHandleRelayNotifyNewEndDeviceReq(t *testing.T) {
+	a, ctx := test.New(t)
+
+	dev := &ttnpb.EndDevice{
+		MACState: &ttnpb.MACState{},
+	}
+	_, err := HandleRelayNotifyNewEndDeviceReq(ctx, dev, nil)
+	a.So(err, should.EqualErrorOrDefinition, ErrNoPayload)
+
+	pld := &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+		DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		SNR:     5,
+		RSSI:    -100,
+	}
+	evs, err := HandleRelayNotifyNewEndDeviceReq(ctx, dev, pld)
+	if a.So(err, should.BeNil) {
+		a.So(evs, should.ResembleEventBuilders, events.Builders{
+			EvtReceiveRelayNotifyNewEndDeviceRequest.With(events.WithData(pld)),
+		})
+	}
+}
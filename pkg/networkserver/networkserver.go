@@ -93,6 +93,7 @@ type DownlinkPriorities struct {
 // InteropClient is a client, which Network Server can use for interoperability.
 type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequest(context.Context, types.NetID, types.EUI64, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 }
 
 // NetworkServer implements the Network Server component.
@@ -126,6 +127,18 @@ type NetworkServer struct {
 	downlinkQueueCapacity int
 
 	scheduledDownlinkMatcher ScheduledDownlinkMatcher
+
+	// relays is the registry of relay states. Relay support is disabled if relays is nil.
+	relays RelayRegistry
+
+	// airtime is the registry of daily airtime usage. The airtime usage is not recorded and airtime budgets are not
+	// enforced if airtime is nil.
+	airtime        AirtimeRegistry
+	airtimeBudgets AirtimeBudgets
+
+	// downlinkAttempts configures the recording of downlink scheduling attempts.
+	// Downlink scheduling attempts are not recorded if downlinkAttempts.Registry is nil.
+	downlinkAttempts DownlinkAttemptsConfig
 }
 
 // Option configures the NetworkServer.
@@ -187,6 +200,19 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	airtimeBudgets, err := conf.AirtimeBudget.Parse()
+	if err != nil {
+		return nil, err
+	}
+	airtime := conf.AirtimeBudget.Registry
+	if airtimeBudgets.unlimited() {
+		// The airtime usage is only recorded if it is limited by a budget.
+		airtime = nil
+	}
+	defaultMACSettings, err := conf.DefaultMACSettings.Parse()
+	if err != nil {
+		return nil, err
+	}
 
 	var interopCl InteropClient
 	if !conf.Interop.IsZero() {
@@ -221,12 +247,16 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		devices:                  wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
 		downlinkTasks:            conf.DownlinkTasks,
 		downlinkPriorities:       downlinkPriorities,
-		defaultMACSettings:       conf.DefaultMACSettings.Parse(),
+		defaultMACSettings:       defaultMACSettings,
 		interopClient:            interopCl,
 		uplinkDeduplicator:       conf.UplinkDeduplicator,
 		deviceKEKLabel:           conf.DeviceKEKLabel,
 		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
 		scheduledDownlinkMatcher: conf.ScheduledDownlinkMatcher,
+		relays:                   conf.Relays,
+		airtime:                  airtime,
+		airtimeBudgets:           airtimeBudgets,
+		downlinkAttempts:         conf.DownlinkAttempts,
 	}
 	ctx = ns.Context()
 
@@ -25,6 +25,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
@@ -321,6 +322,188 @@ func makeClassAOTAAFlowTest(macVersion ttnpb.MACVersion, phyVersion ttnpb.PHYVer
 	})
 }
 
+func makeClassAOTAARejoinFlowTest(macVersion ttnpb.MACVersion, phyVersion ttnpb.PHYVersion, fpID string, rjCount uint16) func(context.Context, TestEnvironment) {
+	return makeOTAAFlowTest(OTAAFlowTestConfig{
+		CreateDevice: &ttnpb.SetEndDeviceRequest{
+			EndDevice: *MakeOTAAEndDevice(
+				EndDeviceOptions.WithFrequencyPlanID(fpID),
+				EndDeviceOptions.WithLoRaWANVersion(macVersion),
+				EndDeviceOptions.WithLoRaWANPHYVersion(phyVersion),
+			),
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{
+					"frequency_plan_id",
+					"lorawan_phy_version",
+					"lorawan_version",
+					"supports_join",
+				},
+			},
+		},
+		DownlinkMACCommanders: []MACCommander{ttnpb.CID_DEV_STATUS},
+		DownlinkEventBuilders: []events.Builder{mac.EvtEnqueueDevStatusRequest},
+		Func: func(ctx context.Context, env TestEnvironment, dev *ttnpb.EndDevice) {
+			t, a := test.MustNewTFromContext(ctx)
+
+			fp := test.FrequencyPlan(dev.FrequencyPlanID)
+			phy := LoRaWANBands[fp.BandID][dev.LoRaWANPHYVersion]
+
+			upConf := RejoinRequestConfig{
+				RejoinType:     ttnpb.RejoinRequestType_CONTEXT,
+				NetID:          env.Config.NetID,
+				DevEUI:         *dev.DevEui,
+				RJCount:        rjCount,
+				SNwkSIntKey:    *dev.Session.SNwkSIntKey.Key,
+				DataRate:       phy.DataRates[ttnpb.DATA_RATE_2].Rate,
+				Frequency:      phy.UplinkChannels[1].Frequency,
+				RxMetadata:     DefaultRxMetadata[:2],
+				CorrelationIDs: []string{"GsNs-rejoin-1"},
+			}
+			joinResp := &ttnpb.JoinResponse{
+				RawPayload: bytes.Repeat([]byte{0x43}, 33),
+				SessionKeys: *test.MakeSessionKeys(
+					test.SessionKeysOptions.WithDefaultNwkKeys(dev.LoRaWANVersion),
+					test.SessionKeysOptions.WithSessionKeyID([]byte("rejoin-session-key-id")),
+				),
+				Lifetime:       time.Hour,
+				CorrelationIDs: []string{"NsJs-rejoin-1"},
+			}
+			start := time.Now().UTC()
+			var joinReq *ttnpb.JoinRequest
+			if !a.So(env.AssertHandleDeviceUplinkSuccess(ctx, func(ctx context.Context, assertEvents func(...events.Event) bool) bool {
+				_, a := test.MustNewTFromContext(ctx)
+				return test.AllTrue(
+					a.So(env.AssertNsJsJoin(
+						ctx,
+						func(ctx, reqCtx context.Context, peerIDs cluster.EntityIdentifiers) bool {
+							return a.So(peerIDs.GetEntityIdentifiers().GetDeviceIds(), should.Resemble, &dev.EndDeviceIdentifiers)
+						},
+						func(ctx, reqCtx context.Context, req *ttnpb.JoinRequest) bool {
+							joinReq = req
+							return test.AllTrue(
+								a.So(req.CorrelationIDs, should.BeProperSupersetOfElementsFunc, test.StringEqual, upConf.CorrelationIDs),
+								a.So(req.JoinEui, should.Resemble, dev.JoinEui),
+								a.So(req.RawPayload, should.Resemble, MakeRejoinRequestPHYPayload(upConf.RejoinType, upConf.NetID, upConf.DevEUI, upConf.RJCount, upConf.SNwkSIntKey)),
+								a.So(req.Payload, should.Resemble, MakeRejoinRequestDecodedPayload(upConf.RejoinType, upConf.NetID, upConf.DevEUI, upConf.RJCount, upConf.SNwkSIntKey)),
+								a.So(req.DevAddr.NwkID(), should.Resemble, env.Config.NetID.ID()),
+								a.So(req.NetID, should.Resemble, env.Config.NetID),
+								a.So(req.SelectedMACVersion, should.Equal, dev.LoRaWANVersion),
+								a.So(req.DownlinkSettings.OptNeg, should.BeTrue),
+								a.So(req.CFList, should.Resemble, frequencyplans.CFList(*fp, dev.LoRaWANPHYVersion)),
+							)
+						},
+						joinResp,
+						nil,
+					), should.BeTrue),
+					a.So(assertEvents(events.Builders{
+						EvtReceiveRejoinRequest,
+						EvtClusterJoinAttempt,
+						EvtClusterJoinSuccess.With(events.WithData(JoinResponseWithoutKeys(joinResp))),
+						EvtProcessRejoinRequest,
+					}.New(
+						ctx,
+						events.WithIdentifiers(&dev.EndDeviceIdentifiers),
+					)...), should.BeTrue),
+				)
+			}, MakeRejoinRequest(upConf)), should.BeTrue) {
+				t.Error("Rejoin-request assertion failed")
+				return
+			}
+
+			oldDevAddr := dev.Session.DevAddr
+			rejoinUpConf := upConf
+			rejoinUpConf.DecodePayload = true
+			rejoinUpConf.ChannelIndex = 1
+			rejoinUpConf.DataRateIndex = ttnpb.DATA_RATE_2
+
+			dev = CopyEndDevice(dev)
+			dev.PendingMACState = MakeMACState(dev, test.Must(env.Config.DefaultMACSettings.Parse()).(ttnpb.MACSettings))
+			dev.PendingMACState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
+				Payload: joinResp.RawPayload,
+				DevAddr: joinReq.DevAddr,
+				NetID:   joinReq.NetID,
+				Request: ttnpb.MACState_JoinRequest{
+					DownlinkSettings: joinReq.DownlinkSettings,
+					RxDelay:          joinReq.RxDelay,
+					CFList:           joinReq.CFList,
+				},
+				Keys:           joinResp.SessionKeys,
+				CorrelationIDs: joinResp.CorrelationIDs,
+			}
+			dev.PendingMACState.RxWindowsAvailable = true
+			dev.PendingMACState.RecentUplinks = []*ttnpb.UplinkMessage{
+				MakeRejoinRequest(rejoinUpConf),
+			}
+			dev, ok := env.AssertScheduleJoinAccept(ctx, dev)
+			if !a.So(ok, should.BeTrue) {
+				t.Error("Rejoin-accept scheduling assertion failed")
+				return
+			}
+			if !a.So(env.AssertForwardJoinAccept(ctx, dev.EndDeviceIdentifiers, joinReq, joinResp, start), should.BeTrue) {
+				t.Error("Rejoin-accept forwarding assertion failed")
+				return
+			}
+
+			if !a.So(env.AssertHandleDeviceUplink(ctx, func(ctx context.Context, assertEvents func(...events.Event) bool) (func(context.Context, error) bool, bool) {
+				_, a := test.MustNewTFromContext(ctx)
+				return func(ctx context.Context, err error) bool {
+						_, a := test.MustNewTFromContext(ctx)
+						return a.So(err, should.HaveSameErrorDefinitionAs, ErrRJCountReplay)
+					}, a.So(assertEvents(events.Builders{
+						EvtReceiveRejoinRequest,
+						EvtDropRejoinRequest.With(events.WithData(ErrRJCountReplay)),
+					}.New(
+						ctx,
+						events.WithIdentifiers(&dev.EndDeviceIdentifiers),
+					)...), should.BeTrue)
+			}, MakeRejoinRequest(upConf)), should.BeTrue) {
+				t.Error("Replayed rejoin-request assertion failed")
+				return
+			}
+
+			deviceChannels, ok := ApplyCFList(dev.PendingMACState.PendingJoinRequest.CFList, phy, dev.PendingMACState.CurrentParameters.Channels...)
+			if !a.So(ok, should.BeTrue) {
+				t.Error("Failed to apply CFList")
+				return
+			}
+			dev.PendingMACState.CurrentParameters.Channels = deviceChannels
+			dev.EndDeviceIdentifiers.DevAddr = &dev.PendingSession.DevAddr
+
+			rekeyInd := &ttnpb.MACCommand_RekeyInd{
+				MinorVersion: ttnpb.MINOR_1,
+			}
+			rekeyConf := &ttnpb.MACCommand_RekeyConf{
+				MinorVersion: ttnpb.MINOR_1,
+			}
+			dev, ok = env.AssertHandleDataUplink(ctx, DataUplinkAssertionConfig{
+				Device:        dev,
+				ChannelIndex:  2,
+				DataRateIndex: ttnpb.DATA_RATE_1,
+				RxMetadatas: [][]*ttnpb.RxMetadata{
+					DefaultRxMetadata[:2],
+				},
+				CorrelationIDs: []string{"GsNs-data-rejoin-1"},
+
+				Pending:    true,
+				FRMPayload: []byte("test"),
+				FOpts:      MakeUplinkMACBuffer(phy, rekeyInd),
+				FCtrl:      ttnpb.FCtrl{ADR: true},
+				FPort:      0x42,
+				EventBuilders: []events.Builder{
+					mac.EvtReceiveRekeyIndication.With(events.WithData(rekeyInd)),
+					mac.EvtEnqueueRekeyConfirmation.With(events.WithData(rekeyConf)),
+				},
+			})
+			if !a.So(ok, should.BeTrue) {
+				t.Error("Data uplink assertion failed")
+				return
+			}
+			a.So(dev.Session.DevAddr, should.Equal, joinReq.DevAddr)
+			a.So(dev.Session.DevAddr, should.NotEqual, oldDevAddr)
+			a.So(dev.Session.SessionKeyID, should.Resemble, joinResp.SessionKeyID)
+		},
+	})
+}
+
 func makeClassCOTAAFlowTest(macVersion ttnpb.MACVersion, phyVersion ttnpb.PHYVersion, fpID string) func(context.Context, TestEnvironment) {
 	var upCmders []MACCommander
 	var upEvBuilders []events.Builder
@@ -374,10 +557,17 @@ func makeClassCOTAAFlowTest(macVersion ttnpb.MACVersion, phyVersion ttnpb.PHYVer
 
 func TestFlow(t *testing.T) {
 	ForEachFrequencyPlanLoRaWANVersionPair(t, func(makeName func(...string) string, fpID string, _ *frequencyplans.FrequencyPlan, phy *band.Band, macVersion ttnpb.MACVersion, phyVersion ttnpb.PHYVersion) {
-		for flowName, handleFlowTest := range map[string]func(context.Context, TestEnvironment){
+		flows := map[string]func(context.Context, TestEnvironment){
 			MakeTestCaseName("Class A", "OTAA"): makeClassAOTAAFlowTest(macVersion, phyVersion, fpID),
 			MakeTestCaseName("Class C", "OTAA"): makeClassCOTAAFlowTest(macVersion, phyVersion, fpID),
-		} {
+		}
+		if macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+			// The first rejoin-request of a session may use RJcount0 0.
+			for _, rjCount := range []uint16{0, 1} {
+				flows[MakeTestCaseName("Class A", "OTAA", "Rejoin", fmt.Sprintf("RJcount0 %d", rjCount))] = makeClassAOTAARejoinFlowTest(macVersion, phyVersion, fpID, rjCount)
+			}
+		}
+		for flowName, handleFlowTest := range flows {
 			handleFlowTest := handleFlowTest
 			test.RunSubtest(t, test.SubtestConfig{
 				Name:     makeName(flowName),
@@ -30,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -70,7 +71,7 @@ var (
 	ErrDuplicate                  = errDuplicate
 	ErrInvalidAbsoluteTime        = errInvalidAbsoluteTime
 	ErrOutdatedData               = errOutdatedData
-	ErrRejoinRequest              = errRejoinRequest
+	ErrRJCountReplay              = errRJCountReplay
 	ErrUnsupportedLoRaWANVersion  = errUnsupportedLoRaWANVersion
 
 	EvtClusterJoinAttempt          = evtClusterJoinAttempt
@@ -79,6 +80,7 @@ var (
 	EvtCreateEndDevice             = evtCreateEndDevice
 	EvtDropDataUplink              = evtDropDataUplink
 	EvtDropJoinRequest             = evtDropJoinRequest
+	EvtDropRejoinRequest           = evtDropRejoinRequest
 	EvtForwardDataUplink           = evtForwardDataUplink
 	EvtForwardJoinAccept           = evtForwardJoinAccept
 	EvtInteropJoinAttempt          = evtInteropJoinAttempt
@@ -86,8 +88,10 @@ var (
 	EvtInteropJoinSuccess          = evtInteropJoinSuccess
 	EvtProcessDataUplink           = evtProcessDataUplink
 	EvtProcessJoinRequest          = evtProcessJoinRequest
+	EvtProcessRejoinRequest        = evtProcessRejoinRequest
 	EvtReceiveDataUplink           = evtReceiveDataUplink
 	EvtReceiveJoinRequest          = evtReceiveJoinRequest
+	EvtReceiveRejoinRequest        = evtReceiveRejoinRequest
 	EvtScheduleDataDownlinkAttempt = evtScheduleDataDownlinkAttempt
 	EvtScheduleDataDownlinkFail    = evtScheduleDataDownlinkFail
 	EvtScheduleDataDownlinkSuccess = evtScheduleDataDownlinkSuccess
@@ -224,6 +228,87 @@ func MakeNsJsJoinRequest(conf NsJsJoinRequestConfig) *ttnpb.JoinRequest {
 	}
 }
 
+var RejoinRequestCorrelationIDs = [...]string{
+	"rejoin-request-correlation-id-1",
+	"rejoin-request-correlation-id-2",
+}
+
+// MakeRejoinRequestPHYPayload returns a type 0 or 2 rejoin-request PHYPayload with MIC computed using sNwkSIntKey.
+func MakeRejoinRequestPHYPayload(rejoinType ttnpb.RejoinRequestType, netID types.NetID, devEUI types.EUI64, rjCount uint16, sNwkSIntKey types.AES128Key) []byte {
+	b := []byte{
+		/* MHDR */
+		0b110_000_00,
+		byte(rejoinType),
+		netID[2], netID[1], netID[0],
+		devEUI[7], devEUI[6], devEUI[5], devEUI[4], devEUI[3], devEUI[2], devEUI[1], devEUI[0],
+		/* RJcount0 */
+		byte(rjCount), byte(rjCount >> 8),
+	}
+	mic := test.Must(crypto.ComputeRejoinRequestMIC(sNwkSIntKey, b)).([4]byte)
+	return append(b, mic[:]...)
+}
+
+func MakeRejoinRequestDecodedPayload(rejoinType ttnpb.RejoinRequestType, netID types.NetID, devEUI types.EUI64, rjCount uint16, sNwkSIntKey types.AES128Key) *ttnpb.Message {
+	b := MakeRejoinRequestPHYPayload(rejoinType, netID, devEUI, rjCount, sNwkSIntKey)
+	return &ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_REJOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		MIC: CopyBytes(b[len(b)-4:]),
+		Payload: &ttnpb.Message_RejoinRequestPayload{
+			RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+				RejoinType: rejoinType,
+				NetID:      *netID.Copy(&types.NetID{}),
+				DevEui:     *devEUI.Copy(&types.EUI64{}),
+				RejoinCnt:  uint32(rjCount),
+			},
+		},
+	}
+}
+
+type RejoinRequestConfig struct {
+	DecodePayload bool
+
+	RejoinType     ttnpb.RejoinRequestType
+	NetID          types.NetID
+	DevEUI         types.EUI64
+	RJCount        uint16
+	SNwkSIntKey    types.AES128Key
+	DataRate       ttnpb.DataRate
+	DataRateIndex  ttnpb.DataRateIndex
+	Frequency      uint64
+	ChannelIndex   uint8
+	ReceivedAt     time.Time
+	RxMetadata     []*ttnpb.RxMetadata
+	CorrelationIDs []string
+}
+
+// MakeRejoinRequest returns a type 0 or 2 rejoin-request uplink message.
+func MakeRejoinRequest(conf RejoinRequestConfig) *ttnpb.UplinkMessage {
+	return MakeUplinkMessage(UplinkMessageConfig{
+		RawPayload: MakeRejoinRequestPHYPayload(conf.RejoinType, conf.NetID, conf.DevEUI, conf.RJCount, conf.SNwkSIntKey),
+		Payload: func() *ttnpb.Message {
+			if conf.DecodePayload {
+				return MakeRejoinRequestDecodedPayload(conf.RejoinType, conf.NetID, conf.DevEUI, conf.RJCount, conf.SNwkSIntKey)
+			}
+			return nil
+		}(),
+		DataRate:      conf.DataRate,
+		DataRateIndex: conf.DataRateIndex,
+		Frequency:     conf.Frequency,
+		ChannelIndex:  conf.ChannelIndex,
+		ReceivedAt:    conf.ReceivedAt,
+		RxMetadata:    conf.RxMetadata,
+		CorrelationIDs: func() []string {
+			if len(conf.CorrelationIDs) == 0 {
+				return RejoinRequestCorrelationIDs[:]
+			}
+			return conf.CorrelationIDs
+		}(),
+	})
+}
+
 func NewISPeer(ctx context.Context, is interface {
 	ttnpb.ApplicationAccessServer
 }) cluster.Peer {
@@ -252,7 +337,8 @@ var _ InteropClient = MockInteropClient{}
 
 // MockInteropClient is a mock InteropClient used for testing.
 type MockInteropClient struct {
-	HandleJoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleJoinRequestFunc   func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequestFunc func(context.Context, types.NetID, types.EUI64, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 }
 
 // HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
@@ -263,6 +349,14 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+// HandleRejoinRequest calls HandleRejoinRequestFunc if set and panics otherwise.
+func (m MockInteropClient) HandleRejoinRequest(ctx context.Context, netID types.NetID, joinEUI types.EUI64, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if m.HandleRejoinRequestFunc == nil {
+		panic("HandleRejoinRequest called, but not set")
+	}
+	return m.HandleRejoinRequestFunc(ctx, netID, joinEUI, req)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -1479,7 +1573,7 @@ func (env TestEnvironment) AssertJoin(ctx context.Context, conf JoinAssertionCon
 			t, a := test.MustNewTFromContext(ctx)
 			t.Helper()
 
-			defaultMACSettings := env.Config.DefaultMACSettings.Parse()
+			defaultMACSettings := test.Must(env.Config.DefaultMACSettings.Parse()).(ttnpb.MACSettings)
 
 			defaultLoRaWANVersion := mac.DeviceDefaultLoRaWANVersion(conf.Device)
 
@@ -1654,12 +1748,19 @@ func (env TestEnvironment) AssertJoin(ctx context.Context, conf JoinAssertionCon
 		t.Error("Join-accept scheduling assertion failed")
 		return nil, false
 	}
+	return dev, env.AssertForwardJoinAccept(ctx, conf.Device.EndDeviceIdentifiers, joinReq, joinResp, start)
+}
 
-	idsWithDevAddr := conf.Device.EndDeviceIdentifiers
+// AssertForwardJoinAccept asserts that the join-accept corresponding to joinReq and joinResp is forwarded to the Application Server.
+func (env TestEnvironment) AssertForwardJoinAccept(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, joinReq *ttnpb.JoinRequest, joinResp *ttnpb.JoinResponse, start time.Time) bool {
+	t, a := test.MustNewTFromContext(ctx)
+	t.Helper()
+
+	idsWithDevAddr := ids
 	idsWithDevAddr.DevAddr = &joinReq.DevAddr
 
 	var appUp *ttnpb.ApplicationUp
-	if !a.So(env.AssertNsAsHandleUplink(ctx, conf.Device.ApplicationIdentifiers, func(ctx context.Context, ups ...*ttnpb.ApplicationUp) bool {
+	if !a.So(env.AssertNsAsHandleUplink(ctx, ids.ApplicationIdentifiers, func(ctx context.Context, ups ...*ttnpb.ApplicationUp) bool {
 		_, a := test.MustNewTFromContext(ctx)
 		if !a.So(ups, should.HaveLength, 1) {
 			return false
@@ -1684,9 +1785,9 @@ func (env TestEnvironment) AssertJoin(ctx context.Context, conf JoinAssertionCon
 		)
 	}, nil), should.BeTrue) {
 		t.Error("Failed to send join-accept to Application Server")
-		return nil, false
+		return false
 	}
-	return dev, a.So(env.Events, should.ReceiveEventFunc, test.MakeEventEqual(test.EventEqualConfig{
+	return a.So(env.Events, should.ReceiveEventFunc, test.MakeEventEqual(test.EventEqualConfig{
 		Identifiers:    true,
 		Data:           true,
 		Origin:         true,
@@ -2405,3 +2506,8 @@ func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.Application
 func (m MockDeviceRegistry) RangeByUplinkMatches(context.Context, *ttnpb.UplinkMessage, time.Duration, func(context.Context, *UplinkMatch) (bool, error)) error {
 	panic("RangeByUplinkMatches must not be called")
 }
+
+// RangeByDevEUI panics.
+func (m MockDeviceRegistry) RangeByDevEUI(context.Context, types.EUI64, []string, func(context.Context, *ttnpb.EndDevice) (bool, error)) error {
+	panic("RangeByDevEUI must not be called")
+}
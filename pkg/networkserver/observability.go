@@ -80,6 +80,56 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
 		events.WithDataType(&ttnpb.UplinkMessage{}),
 	)
+	evtReceiveRejoinRequest = events.Define(
+		"ns.up.rejoin.receive", "receive rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtDropRejoinRequest = events.Define(
+		"ns.up.rejoin.drop", "drop rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtProcessRejoinRequest = events.Define(
+		"ns.up.rejoin.process", "successfully processed rejoin-request",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtExceedUplinkAirtimeBudget = events.Define(
+		"ns.airtime_budget.uplink.exceed", "exceed daily uplink airtime budget",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtExhaustDownlinkBudget = events.Define(
+		"ns.airtime_budget.downlink.exhaust", "exhaust daily downlink budget",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtReceiveRelayForwardUplink = events.Define(
+		"ns.relay.up.forward.receive", "receive uplink forwarded by relay",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtDropRelayForwardUplink = events.Define(
+		"ns.relay.up.forward.drop", "drop uplink forwarded by relay",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtForwardRelayDownlink = events.Define(
+		"ns.relay.down.forward", "forward downlink to relay",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.DownlinkMessage{}),
+	)
+	evtAddRelayUplinkListEntry = events.Define(
+		"ns.relay.uplink_list.add", "add end device to relay uplink list",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
+	evtRemoveRelayUplinkListEntry = events.Define(
+		"ns.relay.uplink_list.remove", "remove end device from relay uplink list",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
 	evtClusterJoinAttempt = events.Define(
 		"ns.up.join.cluster.attempt", "send join-request to cluster-local Join Server",
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
@@ -232,6 +282,23 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{messageType},
 	),
+
+	airtimeBudgetExceeded: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "airtime_budget_exceeded_total",
+			Help:      "Total number of end devices that exceeded their daily airtime budget",
+		},
+		[]string{"direction"},
+	),
+	downlinkBudgetLimited: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "downlink_budget_limited_total",
+			Help:      "Total number of application downlinks deferred or rejected due to an exhausted downlink budget",
+		},
+		[]string{"action"},
+	),
 }
 
 func init() {
@@ -251,6 +318,9 @@ type messageMetrics struct {
 
 	downlinkAttempted *metrics.ContextualCounterVec
 	downlinkForwarded *metrics.ContextualCounterVec
+
+	airtimeBudgetExceeded *metrics.ContextualCounterVec
+	downlinkBudgetLimited *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
@@ -266,6 +336,9 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 
 	m.downlinkAttempted.Describe(ch)
 	m.downlinkForwarded.Describe(ch)
+
+	m.airtimeBudgetExceeded.Describe(ch)
+	m.downlinkBudgetLimited.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
@@ -281,6 +354,9 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 
 	m.downlinkAttempted.Collect(ch)
 	m.downlinkForwarded.Collect(ch)
+
+	m.airtimeBudgetExceeded.Collect(ch)
+	m.downlinkBudgetLimited.Collect(ch)
 }
 
 func mTypeLabel(mType ttnpb.MType) string {
@@ -365,3 +441,11 @@ func registerForwardConfirmedDataDownlink(ctx context.Context) {
 func registerForwardJoinAcceptDownlink(ctx context.Context) {
 	nsMetrics.downlinkForwarded.WithLabelValues(ctx, joinAcceptDownlinkMTypeLabel).Inc()
 }
+
+func registerExceedAirtimeBudget(ctx context.Context, direction string) {
+	nsMetrics.airtimeBudgetExceeded.WithLabelValues(ctx, direction).Inc()
+}
+
+func registerLimitApplicationDownlink(ctx context.Context, action AirtimeBudgetAction) {
+	nsMetrics.downlinkBudgetLimited.WithLabelValues(ctx, action.String()).Inc()
+}
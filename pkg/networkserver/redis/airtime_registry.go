@@ -0,0 +1,109 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	airtimeUplinksField         = "u"
+	airtimeUplinkAirtimeField   = "ua"
+	airtimeDownlinksField       = "d"
+	airtimeDownlinkAirtimeField = "da"
+
+	// airtimeTTL is the time after the start of a day, after which the airtime usage of that day is removed.
+	airtimeTTL = 48 * time.Hour
+)
+
+// AirtimeRegistry is an implementation of networkserver.AirtimeRegistry.
+type AirtimeRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *AirtimeRegistry) key(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, day time.Time) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids), day.UTC().Format("2006-01-02"))
+}
+
+func parseAirtimeUsage(vs map[string]string) (networkserver.AirtimeUsage, error) {
+	parse := func(k string) (int64, error) {
+		v, ok := vs[k]
+		if !ok {
+			return 0, nil
+		}
+		return strconv.ParseInt(v, 10, 64)
+	}
+	var u networkserver.AirtimeUsage
+	n, err := parse(airtimeUplinksField)
+	if err != nil {
+		return networkserver.AirtimeUsage{}, err
+	}
+	u.Uplinks = uint32(n)
+	n, err = parse(airtimeUplinkAirtimeField)
+	if err != nil {
+		return networkserver.AirtimeUsage{}, err
+	}
+	u.UplinkAirtime = time.Duration(n)
+	n, err = parse(airtimeDownlinksField)
+	if err != nil {
+		return networkserver.AirtimeUsage{}, err
+	}
+	u.Downlinks = uint32(n)
+	n, err = parse(airtimeDownlinkAirtimeField)
+	if err != nil {
+		return networkserver.AirtimeUsage{}, err
+	}
+	u.DownlinkAirtime = time.Duration(n)
+	return u, nil
+}
+
+// GetByID implements networkserver.AirtimeRegistry.
+func (r *AirtimeRegistry) GetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, day time.Time) (networkserver.AirtimeUsage, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.key(ctx, ids, day)).Result()
+	if err != nil {
+		return networkserver.AirtimeUsage{}, ttnredis.ConvertError(err)
+	}
+	return parseAirtimeUsage(vs)
+}
+
+// AddByID implements networkserver.AirtimeRegistry.
+func (r *AirtimeRegistry) AddByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, day time.Time, usage networkserver.AirtimeUsage) (networkserver.AirtimeUsage, error) {
+	k := r.key(ctx, ids, day)
+	var cmds [4]*redis.IntCmd
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		cmds[0] = p.HIncrBy(ctx, k, airtimeUplinksField, int64(usage.Uplinks))
+		cmds[1] = p.HIncrBy(ctx, k, airtimeUplinkAirtimeField, int64(usage.UplinkAirtime))
+		cmds[2] = p.HIncrBy(ctx, k, airtimeDownlinksField, int64(usage.Downlinks))
+		cmds[3] = p.HIncrBy(ctx, k, airtimeDownlinkAirtimeField, int64(usage.DownlinkAirtime))
+		p.ExpireAt(ctx, k, day.Add(airtimeTTL))
+		return nil
+	}); err != nil {
+		return networkserver.AirtimeUsage{}, ttnredis.ConvertError(err)
+	}
+	return networkserver.AirtimeUsage{
+		Uplinks:         uint32(cmds[0].Val()),
+		UplinkAirtime:   time.Duration(cmds[1].Val()),
+		Downlinks:       uint32(cmds[2].Val()),
+		DownlinkAirtime: time.Duration(cmds[3].Val()),
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestAirtimeRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.AirtimeRegistry{Redis: cl}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+
+	u, err := r.GetByID(ctx, ids, day)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, networkserver.AirtimeUsage{})
+
+	u, err = r.AddByID(ctx, ids, day, networkserver.AirtimeUsage{
+		Uplinks:       1,
+		UplinkAirtime: 61696 * time.Microsecond,
+	})
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, networkserver.AirtimeUsage{
+		Uplinks:       1,
+		UplinkAirtime: 61696 * time.Microsecond,
+	})
+
+	u, err = r.AddByID(ctx, ids, day, networkserver.AirtimeUsage{
+		Downlinks:       1,
+		DownlinkAirtime: 41216 * time.Microsecond,
+	})
+	a.So(err, should.BeNil)
+	expected := networkserver.AirtimeUsage{
+		Uplinks:         1,
+		UplinkAirtime:   61696 * time.Microsecond,
+		Downlinks:       1,
+		DownlinkAirtime: 41216 * time.Microsecond,
+	}
+	a.So(u, should.Resemble, expected)
+
+	u, err = r.GetByID(ctx, ids, day)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, expected)
+
+	u, err = r.GetByID(ctx, ids, day.Add(24*time.Hour))
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, networkserver.AirtimeUsage{})
+}
@@ -0,0 +1,75 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DownlinkAttemptRegistry is an implementation of networkserver.DownlinkAttemptRegistry.
+// The attempts of an end device are stored in a list, which is trimmed on every addition.
+type DownlinkAttemptRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DownlinkAttemptRegistry) key(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids))
+}
+
+// Add implements networkserver.DownlinkAttemptRegistry.
+func (r *DownlinkAttemptRegistry) Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, attempt *ttnpb.DownlinkSchedulingAttempt, count int, ttl time.Duration) error {
+	s, err := ttnredis.MarshalProto(attempt)
+	if err != nil {
+		return err
+	}
+	k := r.key(ctx, ids)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, s)
+		if count > 0 {
+			p.LTrim(ctx, k, 0, int64(count-1))
+		}
+		if ttl > 0 {
+			p.PExpire(ctx, k, ttl)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// List implements networkserver.DownlinkAttemptRegistry.
+func (r *DownlinkAttemptRegistry) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, limit int) ([]*ttnpb.DownlinkSchedulingAttempt, error) {
+	// If limit is zero, the stop index is -1, which selects all stored attempts.
+	ss, err := r.Redis.LRange(ctx, r.key(ctx, ids), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	attempts := make([]*ttnpb.DownlinkSchedulingAttempt, 0, len(ss))
+	for _, s := range ss {
+		attempt := &ttnpb.DownlinkSchedulingAttempt{}
+		if err := ttnredis.UnmarshalProto(s, attempt); err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var _ networkserver.DownlinkAttemptRegistry = &redis.DownlinkAttemptRegistry{}
+
+func TestDownlinkAttemptRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.DownlinkAttemptRegistry{Redis: cl}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+
+	attempts, err := r.List(ctx, ids, 0)
+	a.So(err, should.BeNil)
+	a.So(attempts, should.BeEmpty)
+
+	now := time.Now().UTC()
+	makeAttempt := func(i int) *ttnpb.DownlinkSchedulingAttempt {
+		return &ttnpb.DownlinkSchedulingAttempt{
+			AttemptedAt: now.Add(time.Duration(i) * time.Second),
+			Class:       ttnpb.CLASS_A,
+			AttemptRx1:  true,
+			AttemptRx2:  true,
+			Paths: []*ttnpb.DownlinkSchedulingAttempt_Path{
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"},
+				},
+			},
+			MACCommands: []ttnpb.MACCommandIdentifier{
+				ttnpb.CID_LINK_ADR,
+			},
+			RxWindow: 1,
+		}
+	}
+	for i := 0; i < 4; i++ {
+		a.So(r.Add(ctx, ids, makeAttempt(i), 3, time.Hour), should.BeNil)
+	}
+
+	attempts, err = r.List(ctx, ids, 0)
+	a.So(err, should.BeNil)
+	a.So(attempts, should.Resemble, []*ttnpb.DownlinkSchedulingAttempt{
+		makeAttempt(3),
+		makeAttempt(2),
+		makeAttempt(1),
+	})
+
+	attempts, err = r.List(ctx, ids, 2)
+	a.So(err, should.BeNil)
+	a.So(attempts, should.Resemble, []*ttnpb.DownlinkSchedulingAttempt{
+		makeAttempt(3),
+		makeAttempt(2),
+	})
+
+	attempts, err = r.List(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids.ApplicationIdentifiers,
+		DeviceId:               "dev2",
+	}, 0)
+	a.So(err, should.BeNil)
+	a.So(attempts, should.BeEmpty)
+}
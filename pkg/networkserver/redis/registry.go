@@ -72,6 +72,10 @@ func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
 
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev_eui", devEUI.String())
+}
+
 // GetByID gets device by appID, devID.
 func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	ids := ttnpb.EndDeviceIdentifiers{
@@ -112,6 +116,40 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	return pb, ctx, nil
 }
 
+var errNoDevEUIMatch = errors.DefineNotFound("no_dev_eui_match", "no device with DevEUI `{dev_eui}` matches")
+
+// RangeByDevEUI calls f for each device with the given devEUI, regardless of its JoinEUI, until f returns true
+// or an error.
+// RangeByDevEUI returns a not found error if no device is found or f never returns true.
+func (r *DeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (bool, error)) error {
+	defer trace.StartRegion(ctx, "range end devices by dev eui").End()
+
+	uids, err := r.Redis.SMembers(ctx, r.devEUIKey(devEUI)).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, uid := range uids {
+		pb := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(uid)).ScanProto(pb); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+		if err != nil {
+			return err
+		}
+		ok, err := f(ctx, pb)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return errNoDevEUIMatch.WithAttributes("dev_eui", devEUI)
+}
+
 type UplinkMatchSession struct {
 	FNwkSIntKey       *ttnpb.KeyEnvelope
 	ResetsFCnt        *ttnpb.BoolValue
@@ -859,6 +897,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if stored.JoinEui != nil && stored.DevEui != nil {
 					p.Del(ctx, r.euiKey(*stored.JoinEui, *stored.DevEui))
 				}
+				if stored.DevEui != nil {
+					p.SRem(ctx, r.devEUIKey(*stored.DevEui), uid)
+				}
 				if stored.PendingSession != nil {
 					removeAddrMapping(ctx, p, PendingAddrKey(r.addrKey(stored.PendingSession.DevAddr)), uid)
 				}
@@ -930,6 +971,11 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				updated.CreatedAt = updated.UpdatedAt
 			}
 
+			if updated.DevEui != nil {
+				// NOTE: The set is updated on every write, so that devices created before the index was introduced are indexed too.
+				p.SAdd(ctx, r.devEUIKey(*updated.DevEui), uid)
+			}
+
 			if updated.Session != nil && updated.MACState == nil ||
 				updated.PendingSession != nil && updated.PendingMACState == nil {
 				return errInvalidDevice.New()
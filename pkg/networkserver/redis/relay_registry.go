@@ -0,0 +1,155 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// RelayRegistry is an implementation of networkserver.RelayRegistry.
+type RelayRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *RelayRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *RelayRegistry) servedKey(uid string) string {
+	return r.Redis.Key("served", uid)
+}
+
+func getRelay(ctx context.Context, r redis.Cmdable, k string) (*ttnpb.Relay, error) {
+	pb := &ttnpb.Relay{}
+	if err := ttnredis.GetProto(ctx, r, k).ScanProto(pb); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pb, nil
+}
+
+// servedDevices returns the UIDs of the end devices, which are served by relay.
+func servedDevices(ctx context.Context, relay *ttnpb.Relay) map[string]struct{} {
+	uids := make(map[string]struct{}, len(relay.GetUplinkList()))
+	for _, e := range relay.GetUplinkList() {
+		if !e.Removing {
+			uids[unique.ID(ctx, e.EndDeviceIds)] = struct{}{}
+		}
+	}
+	return uids
+}
+
+// GetServingRelay implements networkserver.RelayRegistry.
+func (r *RelayRegistry) GetServingRelay(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceIdentifiers, error) {
+	uid, err := r.Redis.Get(ctx, r.servedKey(unique.ID(ctx, ids))).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	relayIDs, err := unique.ToDeviceID(uid)
+	if err != nil {
+		return nil, err
+	}
+	return &relayIDs, nil
+}
+
+// GetByID implements networkserver.RelayRegistry.
+func (r *RelayRegistry) GetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.Relay, error) {
+	pb, err := getRelay(ctx, r.Redis, r.uidKey(unique.ID(ctx, ids)))
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// SetByID implements networkserver.RelayRegistry.
+func (r *RelayRegistry) SetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(context.Context, *ttnpb.Relay) (*ttnpb.Relay, error)) (*ttnpb.Relay, error) {
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	var pb *ttnpb.Relay
+	if err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		stored, err := getRelay(ctx, tx, uk)
+		if err != nil {
+			return err
+		}
+		oldServed := servedDevices(ctx, stored)
+
+		pb, err = f(ctx, stored)
+		if err != nil {
+			return err
+		}
+		newServed := servedDevices(ctx, pb)
+
+		var s string
+		if pb != nil {
+			pb.EndDeviceIdentifiers = ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceId:               ids.DeviceId,
+			}
+			s, err = ttnredis.MarshalProto(pb)
+			if err != nil {
+				return err
+			}
+		}
+		// NOTE: Only served device keys, which still point to this relay, are removed, since the end device may
+		// already be served by a different relay.
+		var stale []string
+		for devUID := range oldServed {
+			if _, ok := newServed[devUID]; ok {
+				continue
+			}
+			sk := r.servedKey(devUID)
+			v, err := tx.Get(ctx, sk).Result()
+			if err == redis.Nil {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if v == uid {
+				stale = append(stale, sk)
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if pb == nil {
+				p.Del(ctx, uk)
+			} else {
+				p.Set(ctx, uk, s, 0)
+			}
+			for devUID := range newServed {
+				p.Set(ctx, r.servedKey(devUID), uid, 0)
+			}
+			if len(stale) > 0 {
+				p.Del(ctx, stale...)
+			}
+			return nil
+		})
+		return err
+	}, uk); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
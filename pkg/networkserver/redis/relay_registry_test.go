@@ -0,0 +1,140 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestRelayRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.RelayRegistry{Redis: cl}
+
+	makeIDs := func(devID string) ttnpb.EndDeviceIdentifiers {
+		return ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "app1",
+			},
+			DeviceId: devID,
+		}
+	}
+	relay1, relay2 := makeIDs("relay1"), makeIDs("relay2")
+	dev1, dev2 := makeIDs("dev1"), makeIDs("dev2")
+
+	st, err := r.GetByID(ctx, relay1)
+	a.So(err, should.BeNil)
+	a.So(st, should.BeNil)
+
+	serving, err := r.GetServingRelay(ctx, dev1)
+	a.So(err, should.BeNil)
+	a.So(serving, should.BeNil)
+
+	stored := &ttnpb.Relay{
+		EndDeviceIdentifiers: relay1,
+		Settings: &ttnpb.RelaySettings{
+			Serving: &ttnpb.ServingRelaySettings{
+				CadPeriodicity: 1,
+				JoinRequestFilters: []*ttnpb.RelayJoinRequestFilter{
+					{
+						Action:           1,
+						RawJoinEuiDevEui: []byte{0x01, 0x02},
+					},
+				},
+			},
+		},
+		UplinkList: []*ttnpb.RelayUplinkListEntry{
+			{
+				EndDeviceIds: &dev1,
+				Index:        0,
+				SessionKeyID: []byte{0x01},
+			},
+			{
+				EndDeviceIds: &dev2,
+				Index:        1,
+				SessionKeyID: []byte{0x02},
+				Synchronized: true,
+			},
+		},
+	}
+	st, err = r.SetByID(ctx, relay1, func(ctx context.Context, st *ttnpb.Relay) (*ttnpb.Relay, error) {
+		a.So(st, should.BeNil)
+		return stored, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(st, should.Resemble, stored)
+
+	st, err = r.GetByID(ctx, relay1)
+	a.So(err, should.BeNil)
+	a.So(st, should.Resemble, stored)
+
+	for _, ids := range []ttnpb.EndDeviceIdentifiers{dev1, dev2} {
+		serving, err = r.GetServingRelay(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(serving, should.Resemble, &relay1)
+	}
+
+	// Move dev2 to relay2 before relay1 marks it as pending removal.
+	_, err = r.SetByID(ctx, relay2, func(ctx context.Context, st *ttnpb.Relay) (*ttnpb.Relay, error) {
+		return &ttnpb.Relay{
+			UplinkList: []*ttnpb.RelayUplinkListEntry{
+				{
+					EndDeviceIds: &dev2,
+					Index:        0,
+				},
+			},
+		}, nil
+	})
+	a.So(err, should.BeNil)
+	_, err = r.SetByID(ctx, relay1, func(ctx context.Context, st *ttnpb.Relay) (*ttnpb.Relay, error) {
+		st.UplinkList[1].Removing = true
+		return st, nil
+	})
+	a.So(err, should.BeNil)
+
+	serving, err = r.GetServingRelay(ctx, dev1)
+	a.So(err, should.BeNil)
+	a.So(serving, should.Resemble, &relay1)
+	serving, err = r.GetServingRelay(ctx, dev2)
+	a.So(err, should.BeNil)
+	a.So(serving, should.Resemble, &relay2)
+
+	st, err = r.SetByID(ctx, relay1, func(ctx context.Context, st *ttnpb.Relay) (*ttnpb.Relay, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(st, should.BeNil)
+
+	st, err = r.GetByID(ctx, relay1)
+	a.So(err, should.BeNil)
+	a.So(st, should.BeNil)
+
+	serving, err = r.GetServingRelay(ctx, dev1)
+	a.So(err, should.BeNil)
+	a.So(serving, should.BeNil)
+	serving, err = r.GetServingRelay(ctx, dev2)
+	a.So(err, should.BeNil)
+	a.So(serving, should.Resemble, &relay2)
+}
@@ -41,6 +41,7 @@ type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, *UplinkMatch) (bool, error)) error
+	RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (bool, error)) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
 
@@ -105,6 +106,16 @@ func (w replacedEndDeviceFieldRegistryWrapper) GetByID(ctx context.Context, appI
 	return dev, ctx, nil
 }
 
+func (w replacedEndDeviceFieldRegistryWrapper) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (bool, error)) error {
+	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
+	return w.DeviceRegistry.RangeByDevEUI(ctx, devEUI, paths, func(ctx context.Context, dev *ttnpb.EndDevice) (bool, error) {
+		for _, d := range replaced {
+			d.GetTransform(dev)
+		}
+		return f(ctx, dev)
+	})
+}
+
 func (w replacedEndDeviceFieldRegistryWrapper) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
 	dev, ctx, err := w.DeviceRegistry.SetByID(ctx, appID, devID, paths, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
@@ -270,3 +281,33 @@ type ScheduledDownlinkMatcher interface {
 	// successful, for example if a long time has passed since the downlink was scheduled.
 	Match(ctx context.Context, ack *ttnpb.TxAcknowledgment) (*ttnpb.DownlinkMessage, error)
 }
+
+// RelayRegistry is a registry, containing the relay settings and state of end devices.
+type RelayRegistry interface {
+	// GetServingRelay returns the identifiers of the relay, which has the end device identified by ids in its trusted uplink list.
+	// GetServingRelay returns nil if the end device is not served by a relay.
+	GetServingRelay(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceIdentifiers, error)
+	// GetByID returns the relay state of the end device identified by ids or nil if the state is not found.
+	GetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.Relay, error)
+	// SetByID updates the relay state of the end device identified by ids using f. If f returns nil, the state is deleted.
+	SetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(context.Context, *ttnpb.Relay) (*ttnpb.Relay, error)) (*ttnpb.Relay, error)
+}
+
+// AirtimeRegistry is a registry, containing the daily airtime usage of end devices.
+type AirtimeRegistry interface {
+	// GetByID returns the airtime usage of the end device identified by ids on the day starting at day.
+	GetByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, day time.Time) (AirtimeUsage, error)
+	// AddByID adds usage to the airtime usage of the end device identified by ids on the day starting at day
+	// and returns the updated airtime usage.
+	AddByID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, day time.Time, usage AirtimeUsage) (AirtimeUsage, error)
+}
+
+// DownlinkAttemptRegistry is a registry, containing the most recent downlink scheduling attempts of end devices.
+type DownlinkAttemptRegistry interface {
+	// Add adds attempt to the downlink scheduling attempts of the end device identified by ids.
+	// Only the count most recent attempts are kept, which are removed once no attempt has been added for ttl.
+	Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, attempt *ttnpb.DownlinkSchedulingAttempt, count int, ttl time.Duration) error
+	// List returns at most limit most recent downlink scheduling attempts of the end device identified by ids,
+	// most recent first. If limit is zero, all stored attempts are returned.
+	List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, limit int) ([]*ttnpb.DownlinkSchedulingAttempt, error)
+}
@@ -0,0 +1,857 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+func sameDevice(a, b ttnpb.EndDeviceIdentifiers) bool {
+	return a.ApplicationId == b.ApplicationId && a.DeviceId == b.DeviceId
+}
+
+// relayUplinkListEntry returns the entry of the end device identified by ids in the trusted uplink list of relay, if any.
+func relayUplinkListEntry(relay *ttnpb.Relay, ids ttnpb.EndDeviceIdentifiers) *ttnpb.RelayUplinkListEntry {
+	for _, e := range relay.GetUplinkList() {
+		if e.EndDeviceIds != nil && sameDevice(*e.EndDeviceIds, ids) {
+			return e
+		}
+	}
+	return nil
+}
+
+func relayUplinkListEntryByIndex(relay *ttnpb.Relay, idx uint32) *ttnpb.RelayUplinkListEntry {
+	for _, e := range relay.GetUplinkList() {
+		if e.Index == idx {
+			return e
+		}
+	}
+	return nil
+}
+
+// appendRelayUplinkListEntry adds the end device identified by ids to the trusted uplink list of relay using the lowest
+// free index. appendRelayUplinkListEntry returns false if the trusted uplink list is full.
+func appendRelayUplinkListEntry(relay *ttnpb.Relay, ids ttnpb.EndDeviceIdentifiers, sessionKeyID []byte) (*ttnpb.RelayUplinkListEntry, bool) {
+	var used [lorawan.RelayUplinkListLength]bool
+	for _, e := range relay.UplinkList {
+		if int(e.Index) < len(used) {
+			used[e.Index] = true
+		}
+	}
+	for i, ok := range used {
+		if ok {
+			continue
+		}
+		e := &ttnpb.RelayUplinkListEntry{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceId:               ids.DeviceId,
+			},
+			Index:        uint32(i),
+			SessionKeyID: sessionKeyID,
+		}
+		relay.UplinkList = append(relay.UplinkList, e)
+		return e, true
+	}
+	return nil, false
+}
+
+// dropRelayUplinkListEntry removes the entry at index idx from the trusted uplink list of relay, if the entry
+// is being removed.
+func dropRelayUplinkListEntry(relay *ttnpb.Relay, idx uint32) {
+	for i, e := range relay.UplinkList {
+		if e.Index == idx && e.Removing {
+			relay.UplinkList = append(relay.UplinkList[:i], relay.UplinkList[i+1:]...)
+			return
+		}
+	}
+}
+
+// relayServedSettings returns the served relay settings of s or nil if the relay mode is disabled.
+func relayServedSettings(s *ttnpb.RelaySettings) *ttnpb.ServedRelaySettings {
+	if served := s.GetServed(); served != nil && served.Mode != lorawan.RelayModeDisabled {
+		return served
+	}
+	return nil
+}
+
+// relayJoinRequestFilter returns the join-request filter at index idx of s or nil if there is no rule at idx.
+func relayJoinRequestFilter(s *ttnpb.ServingRelaySettings, idx int) *ttnpb.RelayJoinRequestFilter {
+	if idx >= len(s.GetJoinRequestFilters()) {
+		return nil
+	}
+	if f := s.JoinRequestFilters[idx]; f.GetAction() != lorawan.RelayFilterNoRule {
+		return f
+	}
+	return nil
+}
+
+// setRelayJoinRequestFilter sets the join-request filter at index idx of s to f.
+func setRelayJoinRequestFilter(s *ttnpb.ServingRelaySettings, idx int, f *ttnpb.RelayJoinRequestFilter) {
+	if f.Action == lorawan.RelayFilterNoRule {
+		f = &ttnpb.RelayJoinRequestFilter{}
+	}
+	for len(s.JoinRequestFilters) <= idx {
+		s.JoinRequestFilters = append(s.JoinRequestFilters, &ttnpb.RelayJoinRequestFilter{})
+	}
+	s.JoinRequestFilters[idx] = f
+	n := len(s.JoinRequestFilters)
+	for n > 0 && s.JoinRequestFilters[n-1].Action == lorawan.RelayFilterNoRule {
+		n--
+	}
+	s.JoinRequestFilters = s.JoinRequestFilters[:n]
+}
+
+type relayContextKeyType struct{}
+
+var relayContextKey relayContextKeyType
+
+func newContextWithRelay(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) context.Context {
+	return context.WithValue(ctx, relayContextKey, ids)
+}
+
+// relayFromContext returns the identifiers of the relay, which forwarded the uplink being handled, if any.
+func relayFromContext(ctx context.Context) (ttnpb.EndDeviceIdentifiers, bool) {
+	ids, ok := ctx.Value(relayContextKey).(ttnpb.EndDeviceIdentifiers)
+	return ids, ok
+}
+
+// relayRxMetadataField is the field of the advanced RX metadata, which identifies the relay that forwarded the uplink.
+const relayRxMetadataField = "relay"
+
+func relayRxMetadata(ids ttnpb.EndDeviceIdentifiers, md lorawan.RelayUplinkMetadata) *pbtypes.Struct {
+	return &pbtypes.Struct{
+		Fields: map[string]*pbtypes.Value{
+			relayRxMetadataField: {
+				Kind: &pbtypes.Value_StructValue{
+					StructValue: &pbtypes.Struct{
+						Fields: map[string]*pbtypes.Value{
+							"application_id": {Kind: &pbtypes.Value_StringValue{StringValue: ids.ApplicationId}},
+							"device_id":      {Kind: &pbtypes.Value_StringValue{StringValue: ids.DeviceId}},
+							"wor_channel":    {Kind: &pbtypes.Value_NumberValue{NumberValue: float64(md.WORChannel)}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// relayFromRxMetadata returns the identifiers of the relay, which forwarded the uplink received with md, if any.
+func relayFromRxMetadata(md *ttnpb.RxMetadata) *ttnpb.EndDeviceIdentifiers {
+	if len(md.UplinkToken) > 0 {
+		return nil
+	}
+	fields := md.GetAdvanced().GetFields()[relayRxMetadataField].GetStructValue().GetFields()
+	appID, devID := fields["application_id"].GetStringValue(), fields["device_id"].GetStringValue()
+	if appID == "" || devID == "" {
+		return nil
+	}
+	return &ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: appID,
+		},
+		DeviceId: devID,
+	}
+}
+
+// handleRelayForwardUplink handles the uplink forwarded by the relay in the FRMPayload of up.
+func (ns *NetworkServer) handleRelayForwardUplink(ctx context.Context, relay *ttnpb.EndDevice, up *ttnpb.UplinkMessage) (err error) {
+	defer func() {
+		if err != nil {
+			publishEvents(ctx, evtDropRelayForwardUplink.NewWithIdentifiersAndData(ctx, &relay.EndDeviceIdentifiers, err))
+		}
+	}()
+
+	pld := up.Payload.GetMACPayload()
+	if relay.Session.NwkSEncKey == nil || len(relay.Session.NwkSEncKey.Key) == 0 {
+		return errUnknownNwkSEncKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, relay.Session.NwkSEncKey, ns.KeyVault)
+	if err != nil {
+		log.FromContext(ctx).WithField("kek_label", relay.Session.NwkSEncKey.KEKLabel).WithError(err).Warn("Failed to unwrap NwkSEncKey")
+		return err
+	}
+	b, err := crypto.DecryptUplink(key, relay.Session.DevAddr, pld.FullFCnt, pld.FRMPayload, false)
+	if err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	var md lorawan.RelayUplinkMetadata
+	phyPayload, err := lorawan.UnmarshalRelayForwardUplink(b, &md)
+	if err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	_, phy, err := DeviceFrequencyPlanAndBand(relay, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	dr, ok := phy.DataRates[md.DataRateIndex]
+	if !ok {
+		return errDataRateIndexNotFound.WithAttributes("index", md.DataRateIndex)
+	}
+
+	rxMD := &ttnpb.RxMetadata{
+		RSSI:        float32(md.RSSI),
+		ChannelRSSI: float32(md.RSSI),
+		SNR:         float32(md.SNR),
+		Time:        up.Settings.Time,
+		Advanced:    relayRxMetadata(relay.EndDeviceIdentifiers, md),
+	}
+	if len(up.RxMetadata) > 0 {
+		rxMD.GatewayIdentifiers = up.RxMetadata[0].GatewayIdentifiers
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()))
+	forwarded := &ttnpb.UplinkMessage{
+		RawPayload: phyPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:   dr.Rate,
+			CodingRate: up.Settings.CodingRate,
+			Frequency:  md.Frequency,
+			Timestamp:  up.Settings.Timestamp,
+			Time:       up.Settings.Time,
+		},
+		RxMetadata:     []*ttnpb.RxMetadata{rxMD},
+		ReceivedAt:     up.ReceivedAt,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+	}
+	publishEvents(ctx, evtReceiveRelayForwardUplink.NewWithIdentifiersAndData(ctx, &relay.EndDeviceIdentifiers, forwarded))
+
+	ctx = log.NewContextWithField(ctx, "relay_uid", unique.ID(ctx, relay.EndDeviceIdentifiers))
+	return ns.handleUplink(newContextWithRelay(ctx, relay.EndDeviceIdentifiers), forwarded)
+}
+
+// checkRelayedDevice checks whether dev may be served by the relay, which forwarded the uplink being handled.
+// End devices are trusted by a relay if they are in the trusted uplink list of the relay.
+// sessionKeyID is the identifier of the session, which the relay must use for dev.
+func (ns *NetworkServer) checkRelayedDevice(ctx context.Context, dev *ttnpb.EndDevice, sessionKeyID []byte) error {
+	relayIDs, ok := relayFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	errUntrusted := errRelayUntrustedDevice.WithAttributes(
+		"device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers),
+		"relay_uid", unique.ID(ctx, relayIDs),
+	)
+	if sameDevice(dev.EndDeviceIdentifiers, relayIDs) {
+		return errUntrusted
+	}
+	relay, err := ns.relays.GetByID(ctx, relayIDs)
+	if err != nil {
+		return err
+	}
+	e := relayUplinkListEntry(relay, dev.EndDeviceIdentifiers)
+	switch {
+	case e == nil || e.Removing:
+		return errUntrusted
+	case len(sessionKeyID) == 0 || bytes.Equal(e.SessionKeyID, sessionKeyID):
+		return nil
+	}
+	_, err = ns.relays.SetByID(ctx, relayIDs, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		e := relayUplinkListEntry(relay, dev.EndDeviceIdentifiers)
+		if e == nil || e.Removing {
+			return nil, errUntrusted
+		}
+		e.SessionKeyID = sessionKeyID
+		e.Synchronized = false
+		return relay, nil
+	})
+	return err
+}
+
+// addRelayUplinkListEntry adds the end device identified by ids to the trusted uplink list of the relay identified
+// by relayIDs. If the end device is served by a different relay, it is removed from the trusted uplink list of that relay.
+func (ns *NetworkServer) addRelayUplinkListEntry(ctx context.Context, relayIDs, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.Relay, error) {
+	if ids.ApplicationId != relayIDs.ApplicationId || sameDevice(ids, relayIDs) {
+		return nil, errRelayApplicationMismatch.WithAttributes(
+			"device_uid", unique.ID(ctx, ids),
+			"relay_uid", unique.ID(ctx, relayIDs),
+		)
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceId, []string{
+		"session",
+	})
+	if err != nil {
+		return nil, err
+	}
+	prev, err := ns.relays.GetServingRelay(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var added bool
+	relay, err := ns.relays.SetByID(ctx, relayIDs, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		if relay == nil {
+			relay = &ttnpb.Relay{}
+		}
+		if e := relayUplinkListEntry(relay, ids); e != nil {
+			added = e.Removing
+			if e.Removing {
+				e.SessionKeyID = dev.GetSession().GetSessionKeyID()
+				e.Synchronized = false
+				e.Removing = false
+			}
+			return relay, nil
+		}
+		if _, ok := appendRelayUplinkListEntry(relay, ids, dev.GetSession().GetSessionKeyID()); !ok {
+			return nil, errRelayUplinkListFull.WithAttributes("relay_uid", unique.ID(ctx, relayIDs))
+		}
+		added = true
+		return relay, nil
+	})
+	if err != nil || !added {
+		return relay, err
+	}
+	queuedEvents := []events.Event{
+		evtAddRelayUplinkListEntry.NewWithIdentifiersAndData(ctx, &relayIDs, &ids),
+	}
+	defer func() { publishEvents(ctx, queuedEvents...) }()
+
+	if prev == nil || sameDevice(*prev, relayIDs) {
+		return relay, nil
+	}
+	// NOTE: The end device moved to a different relay, hence it must be removed from the previous one.
+	if _, err := ns.relays.SetByID(ctx, *prev, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		if relay == nil {
+			return nil, nil
+		}
+		if e := relayUplinkListEntry(relay, ids); e != nil {
+			e.Removing = true
+		}
+		return relay, nil
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to remove end device from previous relay")
+		return relay, nil
+	}
+	queuedEvents = append(queuedEvents, evtRemoveRelayUplinkListEntry.NewWithIdentifiersAndData(ctx, prev, &ids))
+	return relay, nil
+}
+
+// removeRelayUplinkListEntry marks the end device identified by ids for removal from the trusted uplink list of the
+// relay identified by relayIDs.
+func (ns *NetworkServer) removeRelayUplinkListEntry(ctx context.Context, relayIDs, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.Relay, error) {
+	errNotFound := errRelayUplinkListEntryNotFound.WithAttributes(
+		"device_uid", unique.ID(ctx, ids),
+		"relay_uid", unique.ID(ctx, relayIDs),
+	)
+	var removed bool
+	relay, err := ns.relays.SetByID(ctx, relayIDs, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		e := relayUplinkListEntry(relay, ids)
+		if e == nil {
+			return nil, errNotFound
+		}
+		removed = !e.Removing
+		e.Removing = true
+		return relay, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		publishEvents(ctx, evtRemoveRelayUplinkListEntry.NewWithIdentifiersAndData(ctx, &relayIDs, &ids))
+	}
+	return relay, nil
+}
+
+// relayAnswer is an answer of a relay to a relay request.
+type relayAnswer struct {
+	Request  *ttnpb.MACCommand
+	Accepted bool
+}
+
+// handleRelayAnswers updates the current relay settings and the trusted uplink list of the end device identified by
+// ids according to the answers received.
+func (ns *NetworkServer) handleRelayAnswers(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, answers ...relayAnswer) {
+	if _, err := ns.relays.SetByID(ctx, ids, func(ctx context.Context, relay *ttnpb.Relay) (*ttnpb.Relay, error) {
+		if relay == nil {
+			return nil, nil
+		}
+		if relay.CurrentSettings == nil {
+			relay.CurrentSettings = &ttnpb.RelaySettings{}
+		}
+		current := relay.CurrentSettings
+		for _, ans := range answers {
+			if !ans.Accepted {
+				log.FromContext(ctx).WithField("cid", ans.Request.CID).Warn("Relay rejected configuration request")
+			}
+			switch req := ans.Request.GetPayload().(type) {
+			case *ttnpb.MACCommand_RelayConfReq_:
+				if !ans.Accepted {
+					continue
+				}
+				if !req.RelayConfReq.Enabled {
+					current.Serving = nil
+					continue
+				}
+				if current.Serving == nil {
+					current.Serving = &ttnpb.ServingRelaySettings{}
+				}
+				current.Serving.CadPeriodicity = req.RelayConfReq.CadPeriodicity
+				current.Serving.DefaultChannelIndex = req.RelayConfReq.DefaultChannelIndex
+				current.Serving.SecondChannel = req.RelayConfReq.SecondChannel
+
+			case *ttnpb.MACCommand_RelayFilterListReq_:
+				if !ans.Accepted || current.Serving == nil {
+					continue
+				}
+				setRelayJoinRequestFilter(current.Serving, int(req.RelayFilterListReq.Index), &ttnpb.RelayJoinRequestFilter{
+					Action:           req.RelayFilterListReq.Action,
+					RawJoinEuiDevEui: req.RelayFilterListReq.RawJoinEuiDevEui,
+				})
+
+			case *ttnpb.MACCommand_RelayConfigureFwdLimitReq_:
+				if current.Serving == nil {
+					continue
+				}
+				current.Serving.JoinRequestLimits = req.RelayConfigureFwdLimitReq.JoinRequestLimits
+				current.Serving.NotifyLimits = req.RelayConfigureFwdLimitReq.NotifyLimits
+				current.Serving.GlobalUplinkLimits = req.RelayConfigureFwdLimitReq.GlobalUplinkLimits
+				current.Serving.OverallLimits = req.RelayConfigureFwdLimitReq.OverallLimits
+
+			case *ttnpb.MACCommand_RelayEndDeviceConfReq_:
+				if !ans.Accepted {
+					continue
+				}
+				if req.RelayEndDeviceConfReq.Mode == lorawan.RelayModeDisabled {
+					current.Served = nil
+					continue
+				}
+				current.Served = &ttnpb.ServedRelaySettings{
+					Mode:             req.RelayEndDeviceConfReq.Mode,
+					SmartEnableLevel: req.RelayEndDeviceConfReq.SmartEnableLevel,
+					Backoff:          req.RelayEndDeviceConfReq.Backoff,
+					SecondChannel:    req.RelayEndDeviceConfReq.SecondChannel,
+				}
+
+			case *ttnpb.MACCommand_RelayUpdateUplinkListReq_:
+				if e := relayUplinkListEntryByIndex(relay, req.RelayUpdateUplinkListReq.Index); e != nil && !e.Removing {
+					e.Synchronized = true
+				}
+
+			case *ttnpb.MACCommand_RelayCtrlUplinkListReq_:
+				// NOTE: A rejected removal means that the relay does not know the index, which is equivalent to removal.
+				if req.RelayCtrlUplinkListReq.Action == lorawan.RelayCtrlUplinkListRemove {
+					dropRelayUplinkListEntry(relay, req.RelayCtrlUplinkListReq.Index)
+				}
+			}
+		}
+		if current.Serving == nil && current.Served == nil {
+			relay.CurrentSettings = nil
+		}
+		return relay, nil
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update relay state")
+	}
+}
+
+// relayUpdateUplinkListRequest returns the UpdateUplinkListReq, which adds the end device of e to the relay.
+// The RootWorSKey of the end device is wrapped using the device KEK of the Network Server.
+func (ns *NetworkServer) relayUpdateUplinkListRequest(ctx context.Context, e *ttnpb.RelayUplinkListEntry) (*ttnpb.MACCommand, error) {
+	if len(e.SessionKeyID) == 0 {
+		return nil, errUnknownSession.New()
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, e.EndDeviceIds.ApplicationIdentifiers, e.EndDeviceIds.DeviceId, []string{
+		"pending_mac_state.queued_join_accept",
+		"pending_session",
+		"session",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		devAddr    types.DevAddr
+		nwkSEncKey *ttnpb.KeyEnvelope
+	)
+	switch ja := dev.GetPendingMACState().GetQueuedJoinAccept(); {
+	case dev.Session != nil && bytes.Equal(dev.Session.SessionKeyID, e.SessionKeyID):
+		devAddr, nwkSEncKey = dev.Session.DevAddr, dev.Session.NwkSEncKey
+	case dev.PendingSession != nil && bytes.Equal(dev.PendingSession.SessionKeyID, e.SessionKeyID):
+		devAddr, nwkSEncKey = dev.PendingSession.DevAddr, dev.PendingSession.NwkSEncKey
+	case ja != nil && bytes.Equal(ja.Keys.SessionKeyID, e.SessionKeyID):
+		devAddr, nwkSEncKey = ja.DevAddr, ja.Keys.NwkSEncKey
+	default:
+		return nil, errUnknownSession.New()
+	}
+	if nwkSEncKey == nil || len(nwkSEncKey.Key) == 0 {
+		return nil, errUnknownNwkSEncKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, nwkSEncKey, ns.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	rootWorSKey, err := cryptoutil.WrapAES128Key(ctx, crypto.DeriveRootWorSKey(key), ns.deviceKEKLabel, ns.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	return (&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+		Index: e.Index,
+		// NOTE: The maximum reload rate disables the uplink limit of the end device.
+		UplinkLimitReloadRate: 0x3f,
+		DevAddr:               devAddr,
+		RootWorSKey:           rootWorSKey,
+	}).MACCommand(), nil
+}
+
+// unwrapRelayUpdateUplinkListRequest returns a copy of cmd with the RootWorSKey unwrapped, which is necessary
+// to encode cmd. The RootWorSKey is never stored unwrapped.
+func (ns *NetworkServer) unwrapRelayUpdateUplinkListRequest(ctx context.Context, cmd *ttnpb.MACCommand) (*ttnpb.MACCommand, error) {
+	req := cmd.GetRelayUpdateUplinkListReq()
+	if req == nil || req.RootWorSKey == nil {
+		return cmd, nil
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, req.RootWorSKey, ns.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	unwrapped := *req
+	unwrapped.RootWorSKey = &ttnpb.KeyEnvelope{
+		Key: &key,
+	}
+	return unwrapped.MACCommand(), nil
+}
+
+// relayServingRequests returns the requests, which configure the relay according to the desired serving settings.
+// The join-request filters and the forwarding limits are only configured when the relay is enabled.
+func relayServingRequests(desired, current *ttnpb.ServingRelaySettings) []*ttnpb.MACCommand {
+	switch {
+	case desired == nil && current == nil:
+		return nil
+	case desired == nil:
+		return []*ttnpb.MACCommand{
+			(&ttnpb.MACCommand_RelayConfReq{}).MACCommand(),
+		}
+	case current == nil ||
+		desired.CadPeriodicity != current.CadPeriodicity ||
+		desired.DefaultChannelIndex != current.DefaultChannelIndex ||
+		!desired.SecondChannel.Equal(current.SecondChannel):
+		return []*ttnpb.MACCommand{
+			(&ttnpb.MACCommand_RelayConfReq{
+				Enabled:             true,
+				CadPeriodicity:      desired.CadPeriodicity,
+				DefaultChannelIndex: desired.DefaultChannelIndex,
+				SecondChannel:       desired.SecondChannel,
+			}).MACCommand(),
+		}
+	}
+	var cmds []*ttnpb.MACCommand
+	n := len(desired.JoinRequestFilters)
+	if len(current.JoinRequestFilters) > n {
+		n = len(current.JoinRequestFilters)
+	}
+	for i := 0; i < n; i++ {
+		desiredFilter, currentFilter := relayJoinRequestFilter(desired, i), relayJoinRequestFilter(current, i)
+		switch {
+		case desiredFilter.Equal(currentFilter):
+		case desiredFilter == nil:
+			cmds = append(cmds, (&ttnpb.MACCommand_RelayFilterListReq{
+				Index:            uint32(i),
+				Action:           lorawan.RelayFilterNoRule,
+				RawJoinEuiDevEui: currentFilter.RawJoinEuiDevEui,
+			}).MACCommand())
+		default:
+			cmds = append(cmds, (&ttnpb.MACCommand_RelayFilterListReq{
+				Index:            uint32(i),
+				Action:           desiredFilter.Action,
+				RawJoinEuiDevEui: desiredFilter.RawJoinEuiDevEui,
+			}).MACCommand())
+		}
+	}
+	if !desired.JoinRequestLimits.Equal(current.JoinRequestLimits) ||
+		!desired.NotifyLimits.Equal(current.NotifyLimits) ||
+		!desired.GlobalUplinkLimits.Equal(current.GlobalUplinkLimits) ||
+		!desired.OverallLimits.Equal(current.OverallLimits) {
+		cmds = append(cmds, (&ttnpb.MACCommand_RelayConfigureFwdLimitReq{
+			JoinRequestLimits:  desired.JoinRequestLimits,
+			NotifyLimits:       desired.NotifyLimits,
+			GlobalUplinkLimits: desired.GlobalUplinkLimits,
+			OverallLimits:      desired.OverallLimits,
+		}).MACCommand())
+	}
+	return cmds
+}
+
+// relayServedRequests returns the requests, which configure the end device according to the desired served settings.
+func relayServedRequests(desired, current *ttnpb.ServedRelaySettings) []*ttnpb.MACCommand {
+	switch {
+	case desired.Equal(current):
+		return nil
+	case desired == nil:
+		return []*ttnpb.MACCommand{
+			(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Mode: lorawan.RelayModeDisabled,
+			}).MACCommand(),
+		}
+	default:
+		return []*ttnpb.MACCommand{
+			(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Mode:             desired.Mode,
+				SmartEnableLevel: desired.SmartEnableLevel,
+				Backoff:          desired.Backoff,
+				SecondChannel:    desired.SecondChannel,
+			}).MACCommand(),
+		}
+	}
+}
+
+// enqueueRelayRequests enqueues the requests, which apply the desired relay settings and the trusted uplink list
+// of dev.
+func (ns *NetworkServer) enqueueRelayRequests(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) mac.EnqueueState {
+	st := mac.EnqueueState{
+		MaxDownLen: maxDownLen,
+		MaxUpLen:   maxUpLen,
+		Ok:         true,
+	}
+	relay, err := ns.relays.GetByID(ctx, dev.EndDeviceIdentifiers)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get relay state")
+		return st
+	}
+	if relay == nil {
+		return st
+	}
+
+	cmds := relayServingRequests(relay.GetSettings().GetServing(), relay.GetCurrentSettings().GetServing())
+	cmds = append(cmds, relayServedRequests(relayServedSettings(relay.GetSettings()), relayServedSettings(relay.GetCurrentSettings()))...)
+	for _, e := range relay.UplinkList {
+		switch {
+		case e.Removing:
+			cmds = append(cmds, (&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				Index:  e.Index,
+				Action: lorawan.RelayCtrlUplinkListRemove,
+			}).MACCommand())
+
+		case !e.Synchronized && relay.GetCurrentSettings().GetServing() != nil:
+			cmd, err := ns.relayUpdateUplinkListRequest(ctx, e)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).WithField("device_uid", unique.ID(ctx, e.EndDeviceIds)).Debug("Skip relay uplink list entry")
+				continue
+			}
+			cmds = append(cmds, cmd)
+		}
+	}
+	for _, cmd := range cmds {
+		cmdSt := mac.EnqueueRelayRequest(ctx, dev, st.MaxDownLen, st.MaxUpLen, cmd)
+		st.MaxDownLen, st.MaxUpLen = cmdSt.MaxDownLen, cmdSt.MaxUpLen
+		st.QueuedEvents = append(st.QueuedEvents, cmdSt.QueuedEvents...)
+		if !cmdSt.Ok {
+			st.Ok = false
+			break
+		}
+	}
+	return st
+}
+
+type relayDownlinkTarget struct {
+	ns     *NetworkServer
+	relay  ttnpb.EndDeviceIdentifiers
+	device ttnpb.EndDeviceIdentifiers
+}
+
+func (t *relayDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*relayDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return sameDevice(other.relay, t.relay)
+}
+
+func (t *relayDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption) (*ttnpb.ScheduleDownlinkResponse, error) {
+	delay, err := t.ns.forwardRelayDownlink(ctx, t.relay, t.device, msg.RawPayload)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: delay,
+	}, nil
+}
+
+var forwardRelayDownlinkGetPaths = [...]string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"mac_settings",
+	"mac_state",
+	"session",
+}
+
+// forwardRelayDownlink forwards phyPayload, which is the downlink of the end device identified by deviceIDs,
+// through the relay identified by relayIDs in the class A downlink slot of the relay.
+// forwardRelayDownlink returns the delay after which the relay receives the downlink.
+func (ns *NetworkServer) forwardRelayDownlink(ctx context.Context, relayIDs, deviceIDs ttnpb.EndDeviceIdentifiers, phyPayload []byte) (time.Duration, error) {
+	if ns.relays == nil {
+		return 0, errRelayNotServing.WithAttributes(
+			"device_uid", unique.ID(ctx, deviceIDs),
+			"relay_uid", unique.ID(ctx, relayIDs),
+		)
+	}
+	relay, err := ns.relays.GetByID(ctx, relayIDs)
+	if err != nil {
+		return 0, err
+	}
+	if e := relayUplinkListEntry(relay, deviceIDs); e == nil || e.Removing {
+		return 0, errRelayNotServing.WithAttributes(
+			"device_uid", unique.ID(ctx, deviceIDs),
+			"relay_uid", unique.ID(ctx, relayIDs),
+		)
+	}
+	ctx = log.NewContextWithField(ctx, "relay_uid", unique.ID(ctx, relayIDs))
+
+	var (
+		delay        time.Duration
+		queuedEvents []events.Event
+	)
+	defer func() { publishEvents(ctx, queuedEvents...) }()
+	_, _, err = ns.devices.SetByID(ctx, relayIDs.ApplicationIdentifiers, relayIDs.DeviceId, forwardRelayDownlinkGetPaths[:],
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			queuedEvents = nil
+			switch {
+			case dev == nil:
+				return nil, nil, errDeviceNotFound.New()
+			case dev.MACState == nil:
+				return nil, nil, errUnknownMACState.New()
+			case dev.Session == nil:
+				return nil, nil, errEmptySession.New()
+			case !dev.MACState.RxWindowsAvailable || len(dev.MACState.RecentUplinks) == 0:
+				return nil, nil, errNoRecentUplinks.New()
+			}
+			up := LastUplink(dev.MACState.RecentUplinks...)
+			switch up.Payload.MHDR.MType {
+			case ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP:
+			default:
+				return nil, nil, errNoRecentUplinks.New()
+			}
+			_, phy, err := DeviceFrequencyPlanAndBand(dev, ns.FrequencyPlans)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			req := &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Priority:         ns.downlinkPriorities.MACCommands,
+				FrequencyPlanID:  dev.FrequencyPlanID,
+				Rx1Delay:         dev.MACState.CurrentParameters.Rx1Delay,
+				Rx2Frequency:     dev.MACState.CurrentParameters.Rx2Frequency,
+				Rx2DataRateIndex: dev.MACState.CurrentParameters.Rx2DataRateIndex,
+			}
+			if !up.ReceivedAt.Add(dev.MACState.CurrentParameters.Rx1Delay.Duration()).Before(time.Now()) {
+				freq, drIdx, err := rx1Parameters(phy, dev.MACState, up)
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to compute RX1 parameters of relay")
+				} else {
+					req.Rx1Frequency = freq
+					req.Rx1DataRateIndex = drIdx
+				}
+			}
+
+			pld := &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: dev.Session.DevAddr,
+					FCtrl: ttnpb.FCtrl{
+						Ack: up.Payload.MHDR.MType == ttnpb.MType_CONFIRMED_UP,
+						ADR: mac.DeviceUseADR(dev, ns.defaultMACSettings, phy),
+					},
+				},
+				FPort:    lorawan.RelayForwardPort,
+				FullFCnt: nextNFCntDown(ctx, dev),
+			}
+			pld.FHDR.FCnt = pld.FullFCnt & 0xffff
+
+			if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+				return nil, nil, errUnknownNwkSEncKey.New()
+			}
+			key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+			if err != nil {
+				log.FromContext(ctx).WithField("kek_label", dev.Session.NwkSEncKey.KEKLabel).WithError(err).Warn("Failed to unwrap NwkSEncKey")
+				return nil, nil, err
+			}
+			pld.FRMPayload, err = crypto.EncryptDownlink(key, dev.Session.DevAddr, pld.FullFCnt, phyPayload, false)
+			if err != nil {
+				return nil, nil, errEncodePayload.WithCause(err)
+			}
+			msg := &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: pld,
+				},
+			}
+			b, err := lorawan.MarshalMessage(*msg)
+			if err != nil {
+				return nil, nil, errEncodePayload.WithCause(err)
+			}
+			mic, err := ns.computeDataDownlinkMIC(ctx, dev, pld, up, b)
+			if err != nil {
+				return nil, nil, err
+			}
+			b = append(b, mic[:]...)
+			msg.MIC = mic[:]
+
+			// NOTE: Downlinks are not forwarded by a relay through another relay.
+			var paths []downlinkPath
+			for _, path := range downlinkPathsFromMetadata(up.RxMetadata...) {
+				if path.Relay == nil {
+					paths = append(paths, path)
+				}
+			}
+			down, downEvs, err := ns.scheduleDownlinkByPaths(
+				log.NewContext(ctx, loggerWithTxRequestFields(log.FromContext(ctx), req, req.Rx1Frequency > 0, true)),
+				&scheduleRequest{
+					TxRequest:            req,
+					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					Payload:              msg,
+					RawPayload:           b,
+					SessionKeyID:         dev.Session.SessionKeyID,
+					DownlinkEvents: events.Builders{
+						evtForwardRelayDownlink.With(events.WithData(&ttnpb.DownlinkMessage{
+							RawPayload:   phyPayload,
+							EndDeviceIds: &deviceIDs,
+						})),
+					},
+				},
+				paths...,
+			)
+			queuedEvents = append(queuedEvents, downEvs...)
+			if err != nil {
+				return nil, nil, err
+			}
+			recordDataDownlink(dev, generateDownlinkState{}, false, down, ns.defaultMACSettings)
+			delay = time.Until(down.TransmitAt)
+			return dev, []string{
+				"mac_state.last_downlink_at",
+				"mac_state.recent_downlinks",
+				"mac_state.rx_windows_available",
+				"session",
+			}, nil
+		})
+	if err != nil {
+		return 0, err
+	}
+	return delay, nil
+}
@@ -424,3 +424,54 @@ func TestGetDataRatesFromFrequencyPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBeaconing(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		BandID         string
+		Beaconing      *Beaconing
+		ErrorAssertion func(err error) bool
+	}{
+		{
+			Name:   "EU868",
+			BandID: "EU_863_870",
+			Beaconing: &Beaconing{
+				DataRate:    3,
+				Layout:      [3]int{2, 8, 17},
+				Frequencies: []int{869525000},
+			},
+		},
+		{
+			Name:   "US915",
+			BandID: "US_902_928",
+			Beaconing: &Beaconing{
+				DataRate: 8,
+				Layout:   [3]int{5, 11, 23},
+				Frequencies: []int{
+					923300000, 923900000, 924500000, 925100000,
+					925700000, 926300000, 926900000, 927500000,
+				},
+			},
+		},
+		{
+			Name:   "InvalidBand",
+			BandID: "PinkFloyd",
+			ErrorAssertion: func(err error) bool {
+				return err != nil
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			beaconing, err := GetBeaconing(tc.BandID)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(beaconing, should.Resemble, tc.Beaconing)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/shared"
@@ -36,6 +37,7 @@ const (
 var (
 	errFrequencyPlan = errors.DefineInvalidArgument("frequency_plan", "invalid frequency plan `{name}`")
 	errInvalidKey    = errors.DefineInvalidArgument("invalid_key", "key `{key}` invalid")
+	errNoBeacon      = errors.DefineInvalidArgument("no_beacon", "band `{band_id}` does not define beacons")
 )
 
 type kv struct {
@@ -208,6 +210,7 @@ type RouterConfig struct {
 	FrequencyRange []int             `json:"freq_range"`
 	DataRates      DataRates         `json:"DRs"`
 	SX1301Config   []LBSSX1301Config `json:"sx1301_conf"`
+	Beaconing      *Beaconing        `json:"bcning,omitempty"`
 
 	// These are debug options to be unset in production gateways.
 	NoCCA       bool `json:"nocca"`
@@ -217,6 +220,44 @@ type RouterConfig struct {
 	MuxTime float64 `json:"MuxTime"`
 }
 
+// Beaconing is the Class B beaconing configuration of the gateway.
+type Beaconing struct {
+	DataRate int `json:"DR"`
+	// Layout contains the offset of the time field, the offset of the InfoDesc field and the length of the beacon frame.
+	Layout      [3]int `json:"layout"`
+	Frequencies []int  `json:"freqs"`
+}
+
+// GetBeaconing returns the beaconing configuration for the given band.
+func GetBeaconing(bandID string) (*Beaconing, error) {
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := phy.DataRates[phy.Beacon.DataRateIndex]
+	if !ok || phy.Beacon.ComputeFrequency == nil {
+		return nil, errNoBeacon.WithAttributes("band_id", bandID)
+	}
+	layout, err := lorawan.BeaconLayoutFromDataRate(dr.Rate)
+	if err != nil {
+		return nil, err
+	}
+	conf := &Beaconing{
+		DataRate: int(phy.Beacon.DataRateIndex),
+		Layout:   [3]int{layout.TimeOffset(), layout.InfoDescOffset(), layout.Length()},
+	}
+	// The beacon frequency is selected by the beacon period since GPS epoch, modulo the number of frequencies.
+	// Bands that hop beacons use 8 frequencies; other bands use a single frequency.
+	for i := 0; i < 8; i++ {
+		frequency := int(phy.Beacon.ComputeFrequency(float64(i * 128)))
+		if i > 0 && frequency == conf.Frequencies[0] {
+			break
+		}
+		conf.Frequencies = append(conf.Frequencies, frequency)
+	}
+	return conf, nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (conf RouterConfig) MarshalJSON() ([]byte, error) {
 	type Alias RouterConfig
@@ -0,0 +1,125 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import "github.com/dop251/goja"
+
+// allocationLimitProgram returns a function, which wraps the built-in constructors and methods that allocate arrays,
+// buffers and strings of arbitrary size, so that they call exceed and throw when the allocation exceeds limit.
+// The function is called with the global object, the limit and exceed.
+var allocationLimitProgram = goja.MustCompile("allocation_limit.js", `(function(global, limit, exceed) {
+	function check(n) {
+		if (n > limit) {
+			exceed();
+			throw new RangeError("allocation limit of " + limit + " exceeded");
+		}
+	}
+	function checkLength(n) {
+		if (typeof n === "number") {
+			check(n);
+		}
+	}
+
+	function wrapConstructor(name) {
+		var target = global[name];
+		if (typeof target !== "function") {
+			return;
+		}
+		var wrapped = new Proxy(target, {
+			apply: function(target, self, args) {
+				checkLength(args[0]);
+				return Reflect.apply(target, self, args);
+			},
+			construct: function(target, args, newTarget) {
+				checkLength(args[0]);
+				return Reflect.construct(target, args, newTarget);
+			},
+		});
+		Object.defineProperty(global, name, {
+			value: wrapped,
+			writable: true,
+			configurable: true,
+		});
+		Object.defineProperty(target.prototype, "constructor", {
+			value: wrapped,
+			writable: true,
+			configurable: true,
+		});
+	}
+	[
+		"Array", "ArrayBuffer",
+		"Int8Array", "Uint8Array", "Uint8ClampedArray", "Int16Array", "Uint16Array",
+		"Int32Array", "Uint32Array", "Float32Array", "Float64Array",
+	].forEach(wrapConstructor);
+
+	function wrapMethod(proto, name, before, after) {
+		var target = proto[name];
+		if (typeof target !== "function") {
+			return;
+		}
+		Object.defineProperty(proto, name, {
+			value: function() {
+				if (before) {
+					before(this, arguments);
+				}
+				var res = target.apply(this, arguments);
+				if (after) {
+					after(this, res);
+				}
+				return res;
+			},
+			writable: true,
+			configurable: true,
+		});
+	}
+	function checkSelf(self) {
+		check(self.length);
+	}
+	function checkResult(self, res) {
+		check(res.length);
+	}
+	wrapMethod(global.Array, "from", function(self, args) {
+		checkLength(args[0] != null ? args[0].length : 0);
+	});
+	wrapMethod(global.Array.prototype, "push", null, checkSelf);
+	wrapMethod(global.Array.prototype, "unshift", null, checkSelf);
+	wrapMethod(global.Array.prototype, "splice", null, checkSelf);
+	wrapMethod(global.Array.prototype, "concat", null, checkResult);
+	wrapMethod(global.Array.prototype, "fill", checkSelf);
+	wrapMethod(global.String.prototype, "repeat", function(self, args) {
+		checkLength(String(self).length * args[0]);
+	});
+	wrapMethod(global.String.prototype, "padStart", function(self, args) {
+		checkLength(args[0]);
+	});
+	wrapMethod(global.String.prototype, "padEnd", function(self, args) {
+		checkLength(args[0]);
+	});
+})`, true)
+
+// limitAllocations limits the allocations of scripts run in vm to limit.
+// exceed is called when a script exceeds the limit.
+func limitAllocations(vm *goja.Runtime, limit int, exceed func()) error {
+	v, err := vm.RunProgram(allocationLimitProgram)
+	if err != nil {
+		return err
+	}
+	fn, ok := goja.AssertFunction(v)
+	if !ok {
+		panic("allocation limit program does not return a function")
+	}
+	_, err = fn(goja.Undefined(), vm.GlobalObject(), vm.ToValue(limit), vm.ToValue(exceed))
+	return err
+}
@@ -36,6 +36,8 @@ func New(options scripting.Options) scripting.Engine {
 
 var (
 	errScriptTimeout      = errors.DefineDeadlineExceeded("script_timeout", "script timeout")
+	errQuotaExceeded      = errors.DefineResourceExhausted("quota_exceeded", "script execution time quota of `{key}` exceeded")
+	errAllocationLimit    = errors.DefineResourceExhausted("allocation_limit", "script allocation limit of `{limit}` exceeded")
 	errScriptInterrupt    = errors.DefineAborted("script_interrupt", "script interrupt")
 	errScript             = errors.DefineAborted("script", "{message}")
 	errNoScriptOutput     = errors.DefineAborted("no_script_output", "no script output")
@@ -43,12 +45,28 @@ var (
 	errEntrypointNotFound = errors.DefineNotFound("entrypoint_not_found", "entrypoint `{entrypoint}` not found")
 )
 
+// quotaInterrupt is the interrupt value when the execution time quota of the key is exhausted.
+type quotaInterrupt struct {
+	key string
+}
+
+// allocationInterrupt is the interrupt value when the script exceeds the allocation limit.
+type allocationInterrupt struct {
+	limit int
+}
+
 func convertError(err error) error {
 	if err == nil {
 		return nil
 	}
 	switch gojaErr := err.(type) {
 	case *goja.InterruptedError:
+		switch val := gojaErr.Value().(type) {
+		case quotaInterrupt:
+			return errQuotaExceeded.WithAttributes("key", val.key).WithCause(err)
+		case allocationInterrupt:
+			return errAllocationLimit.WithAttributes("limit", val.limit).WithCause(err)
+		}
 		if gojaErr.Value() == context.DeadlineExceeded {
 			return errScriptTimeout.WithCause(err)
 		}
@@ -61,28 +79,75 @@ func convertError(err error) error {
 }
 
 // Run executes the Javascript script in the environment env and returns the output.
+// The run is limited in call stack depth, execution time and the size of single allocations.
 func (j *js) Run(ctx context.Context, script, fn string, params ...interface{}) (as func(target interface{}) error, err error) {
 	defer trace.StartRegion(ctx, "run javascript").End()
 
+	timeout, interruptValue := j.options.Timeout, interface{}(context.DeadlineExceeded)
+	quotaKey, hasQuotaKey := scripting.QuotaKeyFromContext(ctx)
+	if quota := j.options.Quota; quota != nil && hasQuotaKey {
+		remaining := quota.Remaining(quotaKey)
+		if remaining <= 0 {
+			runs.WithLabelValues("quota_exceeded").Inc()
+			return nil, errQuotaExceeded.WithAttributes("key", quotaKey)
+		}
+		if remaining < timeout {
+			timeout, interruptValue = remaining, quotaInterrupt{key: quotaKey}
+		}
+	}
+
 	start := time.Now()
 	defer func() {
-		runLatency.Observe(time.Since(start).Seconds())
-		if err != nil {
-			runs.WithLabelValues("error").Inc()
-		} else {
+		elapsed := time.Since(start)
+		if quota := j.options.Quota; quota != nil && hasQuotaKey {
+			quota.Consume(quotaKey, elapsed)
+		}
+		runLatency.Observe(elapsed.Seconds())
+		switch {
+		case err == nil:
 			runs.WithLabelValues("ok").Inc()
+		case errors.Resemble(err, errQuotaExceeded):
+			runs.WithLabelValues("quota_exceeded").Inc()
+		case errors.Resemble(err, errAllocationLimit):
+			runs.WithLabelValues("allocation_limit").Inc()
+		default:
+			runs.WithLabelValues("error").Inc()
 		}
 	}()
 
 	vm := goja.New()
 	vm.SetFieldNameMapper(goja.TagFieldNameMapper("json", true))
-	// TODO: Set memory limit (https://github.com/dop251/goja/issues/6)
+	if j.options.StackDepthLimit > 0 {
+		vm.SetMaxCallStackSize(j.options.StackDepthLimit)
+	}
 
-	interrupt := time.AfterFunc(j.options.Timeout, func() {
-		vm.Interrupt(context.DeadlineExceeded)
+	interrupt := time.AfterFunc(timeout, func() {
+		vm.Interrupt(interruptValue)
 	})
 	defer interrupt.Stop()
 
+	// exceeded is set when the script exceeds the allocation limit. The allocation throws and the script is
+	// interrupted, so the run fails even if the script catches the exception.
+	var exceeded *allocationInterrupt
+	if limit := j.options.AllocationLimit; limit > 0 {
+		if err := limitAllocations(vm, limit, func() {
+			exceeded = &allocationInterrupt{limit: limit}
+			vm.Interrupt(*exceeded)
+		}); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	runError := func(err error) error {
+		switch {
+		case exceeded == nil:
+			return convertError(err)
+		case err == nil:
+			return errAllocationLimit.WithAttributes("limit", exceeded.limit)
+		default:
+			return errAllocationLimit.WithAttributes("limit", exceeded.limit).WithCause(err)
+		}
+	}
+
 	defer func() {
 		if caught := recover(); caught != nil {
 			switch val := caught.(type) {
@@ -96,7 +161,7 @@ func (j *js) Run(ctx context.Context, script, fn string, params ...interface{})
 
 	_, err = vm.RunString(script)
 	if err != nil {
-		return nil, convertError(err)
+		return nil, runError(err)
 	}
 
 	entrypoint, ok := goja.AssertFunction(vm.Get(fn))
@@ -109,8 +174,8 @@ func (j *js) Run(ctx context.Context, script, fn string, params ...interface{})
 		args[i] = vm.ToValue(param)
 	}
 	res, err := entrypoint(goja.Undefined(), args...)
-	if err != nil {
-		return nil, convertError(err)
+	if err != nil || exceeded != nil {
+		return nil, runError(err)
 	}
 
 	return func(target interface{}) (err error) {
@@ -16,6 +16,7 @@ package javascript_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -100,3 +101,129 @@ func TestRunTimeout(t *testing.T) {
 	a.So(err, should.NotBeNil)
 	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
 }
+
+func TestRunStackDepthLimit(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	script := `
+		function recurse(n) {
+			return n == 0 ? 0 : 1 + recurse(n - 1);
+		}
+		function test(n) {
+			return { depth: recurse(n) };
+		}
+	`
+
+	e := New(scripting.DefaultOptions)
+	_, err := e.Run(ctx, script, "test", scripting.DefaultOptions.StackDepthLimit/2)
+	a.So(err, should.BeNil)
+	_, err = e.Run(ctx, script, "test", scripting.DefaultOptions.StackDepthLimit*2)
+	a.So(err, should.NotBeNil)
+}
+
+func TestRunQuota(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	script := `
+		function test() {
+			while (true) { }
+			return {};
+		}
+	`
+
+	opts := scripting.DefaultOptions
+	opts.Quota = scripting.NewExecutionTimeQuota(opts.Timeout*3/2, time.Hour)
+	e := New(opts)
+
+	// Without quota key, the script is only limited by the timeout.
+	_, err := e.Run(ctx, script, "test")
+	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+
+	ctx = scripting.NewContextWithQuotaKey(ctx, "foo")
+
+	// The first run consumes the timeout.
+	_, err = e.Run(ctx, script, "test")
+	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+
+	// The second run is interrupted when the quota is consumed.
+	_, err = e.Run(ctx, script, "test")
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// Further runs are rejected.
+	_, err = e.Run(ctx, `function test() { return {}; }`, "test")
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// Other keys have their own quota.
+	_, err = e.Run(scripting.NewContextWithQuotaKey(ctx, "bar"), `function test() { return {}; }`, "test")
+	a.So(err, should.BeNil)
+}
+
+func TestRunAllocationLimit(t *testing.T) {
+	ctx := test.Context()
+
+	opts := scripting.DefaultOptions
+	opts.AllocationLimit = 1024
+	e := New(opts)
+
+	for _, tc := range []struct {
+		Name   string
+		Script string
+		OK     bool
+	}{
+		{
+			Name:   "ArrayWithinLimit",
+			Script: `function test() { return { length: new Array(1024).length }; }`,
+			OK:     true,
+		},
+		{
+			Name:   "ArrayConstructor",
+			Script: `function test() { return { length: new Array(1025).length }; }`,
+		},
+		{
+			Name:   "ArrayFunction",
+			Script: `function test() { return { length: Array(1025).length }; }`,
+		},
+		{
+			Name:   "TypedArray",
+			Script: `function test() { return { length: new Uint8Array(1 << 20).length }; }`,
+		},
+		{
+			Name: "Push",
+			Script: `function test() {
+				var a = [];
+				for (var i = 0; i < 2048; i++) {
+					a.push(i);
+				}
+				return { length: a.length };
+			}`,
+		},
+		{
+			Name:   "Repeat",
+			Script: `function test() { return { length: "abc".repeat(1024).length }; }`,
+		},
+		{
+			Name: "CaughtException",
+			Script: `function test() {
+				try {
+					new Array(1 << 30);
+				} catch (e) {
+				}
+				return {};
+			}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := e.Run(ctx, tc.Script, "test")
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scripting
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Quota accounts the cumulative execution time of scripts per key.
+type Quota interface {
+	// Remaining returns the remaining execution time for the given key.
+	Remaining(key string) time.Duration
+	// Consume consumes the given execution time for the given key.
+	Consume(key string, d time.Duration)
+}
+
+type quotaKeyKey struct{}
+
+// NewContextWithQuotaKey returns a derived context with the given key used to account the execution time of scripts.
+func NewContextWithQuotaKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, quotaKeyKey{}, key)
+}
+
+// QuotaKeyFromContext returns the key used to account the execution time of scripts.
+func QuotaKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(quotaKeyKey{}).(string)
+	return key, ok
+}
+
+type quotaWindow struct {
+	start time.Time
+	used  time.Duration
+}
+
+type executionTimeQuota struct {
+	budget   time.Duration
+	interval time.Duration
+
+	mu         sync.Mutex
+	windows    map[string]*quotaWindow
+	lastPruned time.Time
+}
+
+// NewExecutionTimeQuota returns a Quota that allows the given execution time budget per key in each interval.
+func NewExecutionTimeQuota(budget, interval time.Duration) Quota {
+	return &executionTimeQuota{
+		budget:     budget,
+		interval:   interval,
+		windows:    make(map[string]*quotaWindow),
+		lastPruned: time.Now(),
+	}
+}
+
+// window returns the current window of the given key. The caller must hold the lock.
+func (q *executionTimeQuota) window(key string, now time.Time) *quotaWindow {
+	if now.Sub(q.lastPruned) >= q.interval {
+		for k, w := range q.windows {
+			if now.Sub(w.start) >= q.interval {
+				delete(q.windows, k)
+			}
+		}
+		q.lastPruned = now
+	}
+	w, ok := q.windows[key]
+	if !ok || now.Sub(w.start) >= q.interval {
+		w = &quotaWindow{start: now}
+		q.windows[key] = w
+	}
+	return w
+}
+
+// Remaining implements Quota.
+func (q *executionTimeQuota) Remaining(key string) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.budget - q.window(key, time.Now()).used
+}
+
+// Consume implements Quota.
+func (q *executionTimeQuota) Consume(key string, d time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.window(key, time.Now()).used += d
+}
@@ -0,0 +1,55 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scripting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestExecutionTimeQuota(t *testing.T) {
+	a := assertions.New(t)
+
+	interval := 4 * test.Delay
+	q := scripting.NewExecutionTimeQuota(time.Second, interval)
+
+	a.So(q.Remaining("foo"), should.Equal, time.Second)
+	q.Consume("foo", 600*time.Millisecond)
+	a.So(q.Remaining("foo"), should.Equal, 400*time.Millisecond)
+	a.So(q.Remaining("bar"), should.Equal, time.Second)
+	q.Consume("foo", 600*time.Millisecond)
+	a.So(q.Remaining("foo"), should.BeLessThanOrEqualTo, 0)
+
+	time.Sleep(interval)
+	a.So(q.Remaining("foo"), should.Equal, time.Second)
+}
+
+func TestQuotaKeyContext(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	_, ok := scripting.QuotaKeyFromContext(ctx)
+	a.So(ok, should.BeFalse)
+
+	ctx = scripting.NewContextWithQuotaKey(ctx, "foo")
+	key, ok := scripting.QuotaKeyFromContext(ctx)
+	a.So(ok, should.BeTrue)
+	a.So(key, should.Equal, "foo")
+}
@@ -21,10 +21,17 @@ import "time"
 type Options struct {
 	StackDepthLimit int
 	Timeout         time.Duration
+	// AllocationLimit limits the number of elements of arrays, the size of buffers and the length of strings that
+	// a script allocates in a single operation. If zero, allocations are only limited by Timeout.
+	AllocationLimit int
+	// Quota limits the cumulative execution time of scripts per quota key. See NewContextWithQuotaKey.
+	// If nil, the execution time is only limited by Timeout.
+	Quota Quota
 }
 
 // DefaultOptions are the default Options.
 var DefaultOptions = Options{
 	StackDepthLimit: 32,
 	Timeout:         100 * time.Millisecond,
+	AllocationLimit: 1 << 20,
 }
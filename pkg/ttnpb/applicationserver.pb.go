@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -412,6 +413,8 @@ type AsConfiguration_PubSub_Providers struct {
 	MQTT                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,1,opt,name=mqtt,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"mqtt,omitempty"`
 	NATS                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,2,opt,name=nats,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"nats,omitempty"`
 	AWSIoT               AsConfiguration_PubSub_Providers_Status `protobuf:"varint,3,opt,name=aws_iot,json=awsIot,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"aws_iot,omitempty"`
+	AMQP                 AsConfiguration_PubSub_Providers_Status `protobuf:"varint,4,opt,name=amqp,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"amqp,omitempty"`
+	Kafka                AsConfiguration_PubSub_Providers_Status `protobuf:"varint,5,opt,name=kafka,proto3,enum=ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status" json:"kafka,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
 	XXX_sizecache        int32                                   `json:"-"`
 }
@@ -469,6 +472,20 @@ func (m *AsConfiguration_PubSub_Providers) GetAWSIoT() AsConfiguration_PubSub_Pr
 	return AsConfiguration_PubSub_Providers_ENABLED
 }
 
+func (m *AsConfiguration_PubSub_Providers) GetAMQP() AsConfiguration_PubSub_Providers_Status {
+	if m != nil {
+		return m.AMQP
+	}
+	return AsConfiguration_PubSub_Providers_ENABLED
+}
+
+func (m *AsConfiguration_PubSub_Providers) GetKafka() AsConfiguration_PubSub_Providers_Status {
+	if m != nil {
+		return m.Kafka
+	}
+	return AsConfiguration_PubSub_Providers_ENABLED
+}
+
 type GetAsConfigurationRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -963,6 +980,333 @@ func (m *DecodeDownlinkResponse) GetDownlink() *ApplicationDownlink {
 	return nil
 }
 
+// A fixture to test a payload formatter with.
+type PayloadFormatterTestCase struct {
+	// Name of the test case in the report.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Decode the frm_payload as downlink message instead of uplink message.
+	Downlink   bool   `protobuf:"varint,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	FPort      uint32 `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FRMPayload []byte `protobuf:"bytes,4,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	// Expected decoded payload. If not set, the decoded payload is not checked.
+	ExpectedDecodedPayload *types.Struct `protobuf:"bytes,5,opt,name=expected_decoded_payload,json=expectedDecodedPayload,proto3" json:"expected_decoded_payload,omitempty"`
+	// Expected warnings of the payload formatter.
+	ExpectedWarnings []string `protobuf:"bytes,6,rep,name=expected_warnings,json=expectedWarnings,proto3" json:"expected_warnings,omitempty"`
+	// Expected errors of the payload formatter.
+	// If set, the test case passes if decoding fails and the error contains each of the expected errors.
+	ExpectedErrors       []string `protobuf:"bytes,7,rep,name=expected_errors,json=expectedErrors,proto3" json:"expected_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayloadFormatterTestCase) Reset()      { *m = PayloadFormatterTestCase{} }
+func (*PayloadFormatterTestCase) ProtoMessage() {}
+func (*PayloadFormatterTestCase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{14}
+}
+func (m *PayloadFormatterTestCase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadFormatterTestCase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadFormatterTestCase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadFormatterTestCase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadFormatterTestCase.Merge(m, src)
+}
+func (m *PayloadFormatterTestCase) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadFormatterTestCase) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadFormatterTestCase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadFormatterTestCase proto.InternalMessageInfo
+
+func (m *PayloadFormatterTestCase) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PayloadFormatterTestCase) GetDownlink() bool {
+	if m != nil {
+		return m.Downlink
+	}
+	return false
+}
+
+func (m *PayloadFormatterTestCase) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *PayloadFormatterTestCase) GetFRMPayload() []byte {
+	if m != nil {
+		return m.FRMPayload
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestCase) GetExpectedDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.ExpectedDecodedPayload
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestCase) GetExpectedWarnings() []string {
+	if m != nil {
+		return m.ExpectedWarnings
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestCase) GetExpectedErrors() []string {
+	if m != nil {
+		return m.ExpectedErrors
+	}
+	return nil
+}
+
+type TestPayloadFormatterRequest struct {
+	EndDeviceIds         *EndDeviceIdentifiers        `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	VersionIds           *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	Formatter            PayloadFormatter             `protobuf:"varint,3,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	Parameter            string                       `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Cases                []*PayloadFormatterTestCase  `protobuf:"bytes,5,rep,name=cases,proto3" json:"cases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TestPayloadFormatterRequest) Reset()      { *m = TestPayloadFormatterRequest{} }
+func (*TestPayloadFormatterRequest) ProtoMessage() {}
+func (*TestPayloadFormatterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{15}
+}
+func (m *TestPayloadFormatterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestPayloadFormatterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestPayloadFormatterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TestPayloadFormatterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadFormatterRequest.Merge(m, src)
+}
+func (m *TestPayloadFormatterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestPayloadFormatterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadFormatterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadFormatterRequest proto.InternalMessageInfo
+
+func (m *TestPayloadFormatterRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
+	}
+	return nil
+}
+
+func (m *TestPayloadFormatterRequest) GetVersionIds() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIds
+	}
+	return nil
+}
+
+func (m *TestPayloadFormatterRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
+	}
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *TestPayloadFormatterRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *TestPayloadFormatterRequest) GetCases() []*PayloadFormatterTestCase {
+	if m != nil {
+		return m.Cases
+	}
+	return nil
+}
+
+// The result of a payload formatter test case.
+type PayloadFormatterTestResult struct {
+	Name           string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed         bool          `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	DecodedPayload *types.Struct `protobuf:"bytes,3,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	Warnings       []string      `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The error of the payload formatter, if decoding failed.
+	Error *ErrorDetails `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The reasons why the test case failed.
+	Failures             []string `protobuf:"bytes,6,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayloadFormatterTestResult) Reset()      { *m = PayloadFormatterTestResult{} }
+func (*PayloadFormatterTestResult) ProtoMessage() {}
+func (*PayloadFormatterTestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{16}
+}
+func (m *PayloadFormatterTestResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadFormatterTestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadFormatterTestResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadFormatterTestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadFormatterTestResult.Merge(m, src)
+}
+func (m *PayloadFormatterTestResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadFormatterTestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadFormatterTestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadFormatterTestResult proto.InternalMessageInfo
+
+func (m *PayloadFormatterTestResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PayloadFormatterTestResult) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *PayloadFormatterTestResult) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestResult) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestResult) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *PayloadFormatterTestResult) GetFailures() []string {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type TestPayloadFormatterResponse struct {
+	// The results of the test cases, in the order of the request.
+	Results              []*PayloadFormatterTestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Passed               uint32                        `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed               uint32                        `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *TestPayloadFormatterResponse) Reset()      { *m = TestPayloadFormatterResponse{} }
+func (*TestPayloadFormatterResponse) ProtoMessage() {}
+func (*TestPayloadFormatterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{17}
+}
+func (m *TestPayloadFormatterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestPayloadFormatterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestPayloadFormatterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TestPayloadFormatterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadFormatterResponse.Merge(m, src)
+}
+func (m *TestPayloadFormatterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestPayloadFormatterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadFormatterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadFormatterResponse proto.InternalMessageInfo
+
+func (m *TestPayloadFormatterResponse) GetResults() []*PayloadFormatterTestResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *TestPayloadFormatterResponse) GetPassed() uint32 {
+	if m != nil {
+		return m.Passed
+	}
+	return 0
+}
+
+func (m *TestPayloadFormatterResponse) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
@@ -998,6 +1342,14 @@ func init() {
 	golang_proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
 	proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
 	golang_proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+	proto.RegisterType((*PayloadFormatterTestCase)(nil), "ttn.lorawan.v3.PayloadFormatterTestCase")
+	golang_proto.RegisterType((*PayloadFormatterTestCase)(nil), "ttn.lorawan.v3.PayloadFormatterTestCase")
+	proto.RegisterType((*TestPayloadFormatterRequest)(nil), "ttn.lorawan.v3.TestPayloadFormatterRequest")
+	golang_proto.RegisterType((*TestPayloadFormatterRequest)(nil), "ttn.lorawan.v3.TestPayloadFormatterRequest")
+	proto.RegisterType((*PayloadFormatterTestResult)(nil), "ttn.lorawan.v3.PayloadFormatterTestResult")
+	golang_proto.RegisterType((*PayloadFormatterTestResult)(nil), "ttn.lorawan.v3.PayloadFormatterTestResult")
+	proto.RegisterType((*TestPayloadFormatterResponse)(nil), "ttn.lorawan.v3.TestPayloadFormatterResponse")
+	golang_proto.RegisterType((*TestPayloadFormatterResponse)(nil), "ttn.lorawan.v3.TestPayloadFormatterResponse")
 }
 
 func init() {
@@ -1008,132 +1360,159 @@ func init() {
 }
 
 var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5d, 0x6c, 0xdb, 0xd6,
-	0x15, 0xd6, 0x95, 0x64, 0x5b, 0xbe, 0x71, 0x1c, 0xe7, 0x3a, 0x4d, 0x6d, 0x35, 0xa3, 0x3d, 0x36,
-	0xcd, 0x6c, 0xaf, 0xa2, 0x32, 0x67, 0x7f, 0xf5, 0xb0, 0x19, 0x94, 0xed, 0x38, 0x36, 0x6c, 0xd7,
-	0xa1, 0xec, 0x06, 0x4b, 0x93, 0x0a, 0x57, 0xe2, 0xb5, 0x4c, 0x58, 0x22, 0x59, 0xde, 0x4b, 0xb9,
-	0xce, 0x0f, 0x50, 0x14, 0x41, 0x57, 0xf4, 0x61, 0x2b, 0x3a, 0x14, 0xe8, 0xe3, 0x80, 0x6d, 0x40,
-	0x1f, 0x8b, 0xf5, 0x61, 0x7d, 0xda, 0x0a, 0x0c, 0x03, 0x32, 0xec, 0x25, 0x45, 0x5f, 0x0a, 0x0c,
-	0xf3, 0x6a, 0x79, 0x18, 0x0a, 0x0c, 0x18, 0xfa, 0x58, 0xe4, 0x69, 0xe0, 0x25, 0x29, 0x89, 0x94,
-	0x64, 0x2b, 0x3f, 0x70, 0x30, 0x60, 0x6f, 0x57, 0xbc, 0xe7, 0x7c, 0xe7, 0x3b, 0xbf, 0x3c, 0xb4,
-	0xe1, 0x78, 0xc9, 0xb0, 0xf0, 0x36, 0xd6, 0x53, 0x94, 0xe1, 0xc2, 0x56, 0x1a, 0x9b, 0x5a, 0x1a,
-	0x9b, 0x66, 0x49, 0x2b, 0x60, 0xa6, 0x19, 0x3a, 0x25, 0x56, 0x85, 0x58, 0x92, 0x69, 0x19, 0xcc,
-	0x40, 0xfd, 0x8c, 0xe9, 0x92, 0x27, 0x2e, 0x55, 0x2e, 0x24, 0xe5, 0xa2, 0xc6, 0x36, 0xed, 0xbc,
-	0x54, 0x30, 0xca, 0x69, 0xa2, 0x57, 0x8c, 0x1d, 0xd3, 0x32, 0x5e, 0xdb, 0x49, 0x73, 0xe1, 0x42,
-	0xaa, 0x48, 0xf4, 0x54, 0x05, 0x97, 0x34, 0x15, 0x33, 0x92, 0x6e, 0x3a, 0xb8, 0x90, 0xc9, 0x54,
-	0x03, 0x44, 0xd1, 0x28, 0x1a, 0xae, 0x72, 0xde, 0xde, 0xe0, 0xbf, 0xf8, 0x0f, 0x7e, 0xf2, 0xc4,
-	0xcf, 0x14, 0x0d, 0xa3, 0x58, 0x22, 0x2e, 0x4b, 0x5d, 0x37, 0x98, 0x4b, 0xd2, 0xbb, 0x7d, 0xc6,
-	0xbb, 0xad, 0x61, 0x90, 0xb2, 0xc9, 0x76, 0xbc, 0xcb, 0xd1, 0xf0, 0xe5, 0x86, 0x46, 0x4a, 0x6a,
-	0xae, 0x8c, 0xe9, 0x96, 0x27, 0x31, 0x12, 0x96, 0x60, 0x5a, 0x99, 0x50, 0x86, 0xcb, 0xa6, 0x27,
-	0x20, 0x84, 0x05, 0xb6, 0x2d, 0x6c, 0x9a, 0xc4, 0xf2, 0xed, 0x8b, 0xcd, 0xa1, 0x24, 0xba, 0x9a,
-	0x53, 0x49, 0x45, 0x2b, 0xf8, 0x0e, 0x3f, 0xdb, 0x2c, 0xa3, 0xa9, 0x44, 0x67, 0xda, 0x86, 0x56,
-	0x07, 0x1a, 0x6d, 0x16, 0x2a, 0x13, 0x4a, 0x71, 0x91, 0xf8, 0x12, 0x67, 0x5a, 0x48, 0xbc, 0xca,
-	0x98, 0x7b, 0x2b, 0xfe, 0x1d, 0xc0, 0x13, 0x72, 0x3d, 0x89, 0x4b, 0x9a, 0xbe, 0x85, 0xae, 0x40,
-	0xa4, 0x92, 0x0d, 0x6c, 0x97, 0x58, 0x6e, 0xc3, 0xb0, 0xca, 0x98, 0x31, 0x62, 0xd1, 0xa1, 0xd8,
-	0x28, 0x18, 0x3b, 0x36, 0x39, 0x26, 0x05, 0x33, 0x2b, 0x2d, 0xbb, 0xd6, 0x56, 0xf1, 0x4e, 0xc9,
-	0xc0, 0xea, 0xc5, 0x9a, 0xbc, 0x72, 0xd2, 0xc3, 0xa8, 0x3f, 0x42, 0xc3, 0x30, 0xc6, 0x4a, 0x74,
-	0x28, 0x3e, 0x0a, 0xc6, 0x12, 0x99, 0x9e, 0xea, 0xee, 0x48, 0x6c, 0x6d, 0x29, 0xab, 0x38, 0xcf,
-	0xd0, 0x22, 0x1c, 0xa4, 0x5b, 0x9a, 0x99, 0x33, 0x5d, 0x9c, 0x5c, 0xc1, 0xda, 0x31, 0x99, 0x31,
-	0xd4, 0xc5, 0x8d, 0x26, 0x25, 0x37, 0x9c, 0x92, 0x1f, 0x4e, 0x29, 0x63, 0x18, 0xa5, 0x97, 0x70,
-	0xc9, 0x26, 0xca, 0x49, 0x47, 0xcd, 0xb3, 0x3e, 0xc3, 0x95, 0x16, 0xe3, 0x09, 0x30, 0x10, 0x5d,
-	0x8c, 0x27, 0xa2, 0x03, 0x31, 0xf1, 0x8f, 0x00, 0x0e, 0xcf, 0x13, 0x16, 0x72, 0x51, 0x21, 0xaf,
-	0xda, 0x84, 0x32, 0x84, 0xe1, 0x89, 0x86, 0x0a, 0xce, 0x69, 0x2a, 0x1d, 0x02, 0xdc, 0xe2, 0xb9,
-	0xb0, 0x9b, 0x0d, 0x00, 0x0b, 0xf5, 0x24, 0x64, 0x06, 0xee, 0x67, 0xba, 0xde, 0x06, 0xd1, 0x01,
-	0x70, 0x77, 0x77, 0x24, 0x72, 0x6f, 0x77, 0x04, 0x28, 0xfd, 0xb8, 0x51, 0x92, 0xa2, 0x69, 0x08,
-	0xeb, 0xe5, 0x33, 0x14, 0x6d, 0xe3, 0xcf, 0x45, 0x47, 0x64, 0x19, 0xd3, 0xad, 0x4c, 0xdc, 0x41,
-	0x52, 0x7a, 0x37, 0xfc, 0x07, 0xe2, 0x9b, 0x51, 0x38, 0x9c, 0x7d, 0x92, 0x1e, 0xcc, 0xc1, 0x78,
-	0x49, 0xd3, 0x7d, 0xee, 0x23, 0x07, 0xe0, 0x3a, 0xc4, 0x5a, 0x00, 0x72, 0xf5, 0x50, 0x20, 0x62,
-	0x0f, 0x1e, 0x88, 0x5f, 0xc4, 0xe1, 0xa9, 0x90, 0xb1, 0x2c, 0xc3, 0x8c, 0xa2, 0x1f, 0xc3, 0x5e,
-	0xc7, 0x02, 0x51, 0x73, 0x98, 0x79, 0xde, 0x37, 0x03, 0xaf, 0xf9, 0x1d, 0x9a, 0x89, 0xbf, 0xf3,
-	0x8f, 0x11, 0xa0, 0x24, 0x5c, 0x15, 0x99, 0xa1, 0x3f, 0x03, 0x78, 0x5a, 0x27, 0x6c, 0xdb, 0xb0,
-	0xb6, 0x72, 0xee, 0x10, 0xcb, 0x61, 0x55, 0xb5, 0x08, 0xa5, 0xdc, 0xe5, 0xde, 0xcc, 0xcf, 0xc1,
-	0xfd, 0xcc, 0xdb, 0xc0, 0xfa, 0x19, 0x98, 0xbc, 0x03, 0x5e, 0x19, 0x9b, 0x9e, 0x1a, 0x9b, 0x9e,
-	0x7a, 0x19, 0xa7, 0x6e, 0xc8, 0xa9, 0xab, 0xe7, 0x53, 0x2f, 0x5c, 0xbf, 0xd5, 0x70, 0xae, 0x1f,
-	0xaf, 0xa5, 0xae, 0x4f, 0x34, 0x5c, 0x8c, 0x5f, 0x93, 0xc6, 0x27, 0x1c, 0x3d, 0x39, 0x75, 0x15,
-	0xa7, 0x6e, 0xb8, 0x7a, 0xf5, 0x73, 0xfd, 0xc8, 0xf5, 0xea, 0x17, 0xe3, 0x63, 0xd3, 0x53, 0x53,
-	0x2f, 0x3b, 0xa7, 0x9b, 0xdf, 0x79, 0xfe, 0x7b, 0xb7, 0xc7, 0xa7, 0xcf, 0xde, 0x7a, 0xe5, 0xac,
-	0x72, 0xca, 0xa3, 0x9b, 0xe5, 0x6c, 0x65, 0x97, 0x2c, 0x7a, 0x11, 0x0e, 0x96, 0x30, 0x65, 0x39,
-	0xdb, 0xcc, 0x59, 0xa4, 0x40, 0xb4, 0x8a, 0x1b, 0x90, 0x58, 0x87, 0x01, 0x19, 0x70, 0x94, 0xd7,
-	0x4d, 0xc5, 0x53, 0x95, 0x19, 0x1a, 0x86, 0x09, 0xdb, 0xcc, 0x15, 0x0c, 0x5b, 0x67, 0xbc, 0x67,
-	0xe3, 0x4a, 0x8f, 0x6d, 0xce, 0x38, 0x3f, 0xd1, 0x75, 0x98, 0xe4, 0xb6, 0x54, 0x63, 0x5b, 0x77,
-	0x02, 0xe9, 0x0c, 0x8a, 0x6d, 0x6c, 0xa9, 0xae, 0xc9, 0xae, 0x0e, 0x4d, 0x3e, 0xed, 0x60, 0xcc,
-	0x7a, 0x10, 0x17, 0x7d, 0x04, 0x99, 0xa1, 0xe7, 0x60, 0x7f, 0x0d, 0xd9, 0xb5, 0xdf, 0xcd, 0xed,
-	0x1f, 0xf7, 0x9f, 0x72, 0x16, 0xe2, 0x9d, 0x38, 0x3c, 0x21, 0xd3, 0x19, 0x43, 0xdf, 0xd0, 0x8a,
-	0xb6, 0xc5, 0xab, 0x02, 0x2d, 0xc2, 0x6e, 0xd3, 0xce, 0x53, 0x3b, 0xdf, 0xb6, 0x0f, 0x82, 0x0a,
-	0xd2, 0xaa, 0x9d, 0xcf, 0xda, 0xf9, 0x0c, 0xac, 0xee, 0x8e, 0x74, 0xbb, 0x67, 0xc5, 0x43, 0x48,
-	0xfe, 0x26, 0x06, 0xbd, 0x47, 0x68, 0x05, 0xf6, 0x9a, 0x96, 0x51, 0xd1, 0x54, 0x67, 0x14, 0xba,
-	0xc8, 0xe7, 0x3b, 0x43, 0x96, 0x56, 0x7d, 0x3d, 0xa5, 0x0e, 0x91, 0xfc, 0x4b, 0x14, 0xf6, 0xd6,
-	0x2e, 0xd0, 0x3a, 0x8c, 0x3b, 0x33, 0x99, 0x03, 0xf7, 0x4f, 0xfe, 0xe0, 0x41, 0x81, 0x25, 0xa7,
-	0x0f, 0x6c, 0x9a, 0x49, 0x54, 0x77, 0x47, 0xe2, 0xcb, 0x97, 0xd7, 0xd6, 0x14, 0x0e, 0xe7, 0xc0,
-	0xea, 0x98, 0xb9, 0x65, 0xfc, 0xa8, 0xb0, 0x2b, 0xf2, 0x5a, 0x56, 0xe1, 0x70, 0xe8, 0x1a, 0xec,
-	0xc1, 0xdb, 0x34, 0xa7, 0x19, 0x6e, 0x71, 0x3d, 0x02, 0x32, 0x0f, 0xba, 0x7c, 0x25, 0xbb, 0x60,
-	0xac, 0x29, 0xdd, 0x78, 0x9b, 0x2e, 0x18, 0x4c, 0x3c, 0x0f, 0xbb, 0xdd, 0x5b, 0x74, 0x0c, 0xf6,
-	0xcc, 0xad, 0xc8, 0x99, 0xa5, 0xb9, 0xd9, 0x81, 0x88, 0xf3, 0xe3, 0x8a, 0xac, 0xac, 0x2c, 0xac,
-	0xcc, 0x0f, 0x00, 0xd4, 0x07, 0x13, 0xb3, 0x0b, 0x59, 0xf7, 0x2a, 0x2a, 0x3e, 0xe3, 0x8e, 0xf8,
-	0xa0, 0x4d, 0x6f, 0x40, 0x8a, 0x05, 0x98, 0x6c, 0x75, 0x49, 0x4d, 0x67, 0x63, 0x41, 0x73, 0xf0,
-	0x78, 0xa1, 0xf1, 0xc2, 0x4b, 0xed, 0xc8, 0x21, 0x0e, 0x29, 0x41, 0x2d, 0x71, 0x0b, 0x3e, 0xbd,
-	0x42, 0x65, 0x7a, 0x09, 0xeb, 0x6a, 0x89, 0xac, 0x9b, 0xa5, 0x86, 0x01, 0xbd, 0x1a, 0x1c, 0xd0,
-	0xb6, 0xe9, 0x94, 0x4f, 0x6c, 0xec, 0xd8, 0xe4, 0x37, 0x0e, 0x18, 0xa4, 0xeb, 0x66, 0x26, 0x71,
-	0x3f, 0xd3, 0xf5, 0x2e, 0x88, 0x26, 0x82, 0xf3, 0x78, 0xdd, 0xa4, 0xe2, 0x7f, 0xa2, 0xf0, 0xa9,
-	0x39, 0xbd, 0x60, 0xa8, 0xc4, 0x6f, 0x1d, 0xdf, 0xd6, 0x1a, 0xec, 0xaf, 0x6f, 0x11, 0x0d, 0xef,
-	0x82, 0xb3, 0x61, 0x53, 0x73, 0xba, 0x3a, 0xcb, 0x85, 0x1a, 0xdf, 0x04, 0x09, 0x7f, 0x70, 0x2b,
-	0x7d, 0xa4, 0x7e, 0x4f, 0xd1, 0x12, 0x3c, 0x56, 0x21, 0x16, 0xf5, 0x5f, 0x2f, 0xee, 0x6b, 0xe0,
-	0xdb, 0x6d, 0x21, 0x5f, 0x72, 0x65, 0x1b, 0x90, 0x15, 0x58, 0xf1, 0x9f, 0x51, 0xb4, 0x00, 0x13,
-	0x7e, 0x13, 0x7b, 0xa3, 0xe9, 0xd9, 0x03, 0x02, 0xe1, 0x7b, 0xd8, 0x40, 0xae, 0xa6, 0x8e, 0x2e,
-	0xc1, 0xde, 0xda, 0x7e, 0xc2, 0x07, 0x54, 0xff, 0xe4, 0x68, 0x18, 0x2b, 0xbc, 0x97, 0x70, 0xa0,
-	0x37, 0x38, 0x50, 0x5d, 0x19, 0x9d, 0x81, 0xbd, 0x26, 0xb6, 0x70, 0x99, 0x38, 0x48, 0xce, 0xf4,
-	0xea, 0x55, 0xea, 0x0f, 0xc4, 0x9f, 0xc2, 0xd3, 0xe1, 0x78, 0x7b, 0xe5, 0x33, 0xdd, 0xe0, 0x0c,
-	0xe8, 0xd8, 0x99, 0xba, 0x0b, 0xe2, 0xbf, 0xa2, 0x70, 0x70, 0x96, 0x38, 0xd8, 0xc1, 0xaa, 0xf9,
-	0x5f, 0xc8, 0xe4, 0x0c, 0xec, 0xb6, 0xcd, 0x86, 0x3c, 0x7e, 0xf3, 0xc0, 0x82, 0x0e, 0x65, 0xd1,
-	0x53, 0x3d, 0xb2, 0x1c, 0x5e, 0x86, 0xa7, 0x82, 0x71, 0xf6, 0x32, 0xf8, 0x42, 0xcd, 0x09, 0xd0,
-	0xa1, 0x13, 0x3e, 0x75, 0xde, 0x87, 0x2e, 0xe6, 0xff, 0xfb, 0xf0, 0xa8, 0xfa, 0x30, 0x1c, 0xef,
-	0xc7, 0xd4, 0x87, 0x93, 0x9f, 0x76, 0xc1, 0xa8, 0x4c, 0xd1, 0x7b, 0x00, 0xf6, 0xcc, 0x13, 0xc6,
-	0xbf, 0x82, 0xc6, 0xc3, 0x08, 0x6d, 0x3f, 0x23, 0x92, 0x87, 0xed, 0xc4, 0xe2, 0x4f, 0xde, 0xf8,
-	0xec, 0x9f, 0xbf, 0x8c, 0xfe, 0x10, 0x7d, 0x3f, 0x8d, 0x69, 0xe0, 0x9b, 0x39, 0x7d, 0x33, 0xb4,
-	0xbd, 0x4b, 0xc1, 0xdf, 0xb7, 0xd3, 0x3c, 0xc2, 0xef, 0x03, 0xd8, 0x93, 0x6d, 0xc7, 0x2b, 0xfb,
-	0xf0, 0xbc, 0x64, 0xce, 0xeb, 0x47, 0xc9, 0x87, 0xe4, 0x35, 0x05, 0x26, 0xd0, 0x2d, 0x08, 0x67,
-	0x49, 0x89, 0x30, 0xc2, 0xc9, 0x75, 0xf8, 0xd5, 0x91, 0x3c, 0xdd, 0xb4, 0x1b, 0xce, 0x39, 0x1f,
-	0xe0, 0xa2, 0xc4, 0x09, 0x8d, 0x4d, 0x9c, 0x3b, 0x8c, 0x90, 0x17, 0x98, 0x77, 0x01, 0xec, 0xf3,
-	0x12, 0xe6, 0x7e, 0x0b, 0x74, 0x4a, 0xe0, 0xec, 0x21, 0xa1, 0xe1, 0x68, 0xe2, 0x77, 0x39, 0x1d,
-	0x09, 0x3d, 0xdf, 0x19, 0x9d, 0x34, 0xe5, 0x1c, 0xee, 0x00, 0x38, 0x30, 0x4f, 0x58, 0x70, 0x2f,
-	0x6d, 0x59, 0x4e, 0x2d, 0x57, 0x96, 0xe4, 0x44, 0x27, 0xa2, 0x6e, 0xe5, 0x8b, 0xc3, 0x9c, 0xe1,
-	0x20, 0x3a, 0xe9, 0x30, 0x0c, 0x2c, 0x25, 0x93, 0x57, 0x60, 0xdc, 0x59, 0x4a, 0xd0, 0x8b, 0xb0,
-	0xaf, 0x71, 0x31, 0x41, 0xdf, 0x0a, 0xc3, 0xb7, 0x59, 0x5d, 0xda, 0x25, 0x69, 0xf2, 0xa3, 0xe3,
-	0xb0, 0x4b, 0x36, 0x4d, 0x99, 0xa2, 0x35, 0xd8, 0x9b, 0xb5, 0xf3, 0xb4, 0x60, 0x69, 0x79, 0xd2,
-	0x71, 0xe8, 0x0f, 0x5e, 0x7c, 0xce, 0x03, 0xf4, 0x57, 0x00, 0x4f, 0xfa, 0x3d, 0x7a, 0xd9, 0x26,
-	0x36, 0x59, 0xb5, 0xe9, 0x26, 0x6a, 0xca, 0x58, 0x40, 0xe4, 0x10, 0xce, 0xe2, 0x6b, 0x3c, 0x4e,
-	0x96, 0x58, 0x6e, 0xce, 0x64, 0x70, 0x54, 0x4b, 0x87, 0x15, 0xbe, 0x2b, 0xda, 0xac, 0x57, 0x3b,
-	0xde, 0x4e, 0x3b, 0x63, 0x25, 0x6d, 0xda, 0x74, 0xd3, 0x69, 0x90, 0x4f, 0x01, 0x3c, 0x15, 0xa2,
-	0x6a, 0x96, 0x70, 0x81, 0x3c, 0xa2, 0x43, 0x37, 0xb9, 0x43, 0xb6, 0x68, 0x1e, 0x99, 0x43, 0x96,
-	0xcb, 0xdb, 0xf1, 0xe9, 0xa3, 0x70, 0x86, 0x96, 0x34, 0xca, 0x50, 0x47, 0xaf, 0xb7, 0x03, 0x3b,
-	0xcf, 0xc7, 0xa4, 0xa2, 0xc2, 0xdd, 0x5b, 0x42, 0x8b, 0x0f, 0x3e, 0x99, 0x6a, 0xfe, 0x84, 0x1c,
-	0x40, 0xbf, 0x06, 0xf0, 0xa9, 0x79, 0xc2, 0x9c, 0x0f, 0xa4, 0x19, 0x43, 0xd7, 0x49, 0x81, 0x57,
-	0xa6, 0xbe, 0x61, 0x74, 0x5c, 0xba, 0x62, 0xd3, 0x5f, 0xbf, 0x9a, 0xb0, 0x3a, 0x9f, 0xf5, 0xb7,
-	0xf9, 0xdf, 0xe1, 0x52, 0x85, 0x9a, 0x7a, 0x4a, 0x73, 0xb8, 0xfc, 0x09, 0xc0, 0xfe, 0xac, 0x56,
-	0xb6, 0x4b, 0x98, 0xf9, 0x1d, 0x7b, 0x70, 0xc7, 0xb4, 0x2d, 0x91, 0x1b, 0x9c, 0x09, 0x13, 0x8d,
-	0xa3, 0x28, 0x11, 0xdb, 0x4c, 0x53, 0x8f, 0xb5, 0x53, 0x21, 0x7f, 0x03, 0xb0, 0x3f, 0xb8, 0x34,
-	0xa3, 0xe7, 0x9a, 0xcb, 0xa3, 0xc5, 0xf2, 0x94, 0x3c, 0x77, 0x98, 0x98, 0x37, 0xf9, 0x8e, 0xd4,
-	0x3b, 0xde, 0x00, 0x84, 0x13, 0x71, 0xbc, 0xfb, 0x0c, 0xc0, 0xbe, 0xc6, 0x75, 0x12, 0x35, 0xad,
-	0x1b, 0x2d, 0x96, 0xfa, 0xe6, 0xca, 0x6f, 0xb5, 0x91, 0x1e, 0xed, 0xa4, 0xb2, 0xcd, 0xb4, 0x4a,
-	0x7c, 0xaf, 0x9c, 0x9c, 0x05, 0x17, 0xac, 0xe6, 0x9c, 0xb5, 0x5c, 0x78, 0x9b, 0x73, 0xd6, 0x7a,
-	0x4f, 0x7b, 0x02, 0x39, 0xab, 0x79, 0x37, 0xf9, 0xef, 0x38, 0x1c, 0x94, 0x69, 0x6d, 0x24, 0x29,
-	0xa4, 0xa8, 0x51, 0x66, 0xed, 0xa0, 0xdf, 0x01, 0x18, 0x9b, 0x27, 0xac, 0x39, 0x85, 0xf3, 0x84,
-	0x35, 0x48, 0xbb, 0x8e, 0x0e, 0xb7, 0x1d, 0x71, 0xe2, 0x16, 0xf7, 0x8d, 0xa0, 0xc2, 0x11, 0xf8,
-	0x86, 0xde, 0x8c, 0xc2, 0x58, 0xb6, 0x15, 0xe9, 0xec, 0x83, 0x91, 0xfe, 0x03, 0xe0, 0xac, 0x7f,
-	0x0f, 0x92, 0x07, 0xd2, 0x96, 0x1e, 0x92, 0xb6, 0x14, 0xa4, 0x3d, 0x05, 0x26, 0xae, 0x2e, 0x8b,
-	0x97, 0x1e, 0x97, 0x25, 0xa7, 0x66, 0xdf, 0x03, 0xb0, 0xdb, 0xdd, 0x3f, 0x3b, 0x7c, 0xfd, 0xb4,
-	0x1b, 0x96, 0xcb, 0x3c, 0x10, 0xf3, 0x13, 0x73, 0x8f, 0xe5, 0x85, 0x93, 0xf9, 0x2d, 0xb8, 0xbb,
-	0x27, 0x80, 0x7b, 0x7b, 0x02, 0xf8, 0x7c, 0x4f, 0x88, 0x7c, 0xb1, 0x27, 0x44, 0xbe, 0xdc, 0x13,
-	0x22, 0x5f, 0xed, 0x09, 0x91, 0xaf, 0xf7, 0x04, 0xf0, 0x7a, 0x55, 0x00, 0x6f, 0x55, 0x85, 0xc8,
-	0x07, 0x55, 0x01, 0x7c, 0x58, 0x15, 0x22, 0x1f, 0x57, 0x85, 0xc8, 0x27, 0x55, 0x21, 0x72, 0xb7,
-	0x2a, 0x80, 0x7b, 0x55, 0x01, 0x7c, 0x5e, 0x15, 0x22, 0x5f, 0x54, 0x05, 0xf0, 0x65, 0x55, 0x88,
-	0x7c, 0x55, 0x15, 0xc0, 0xd7, 0x55, 0x21, 0xf2, 0xfa, 0xbe, 0x10, 0x79, 0x6b, 0x5f, 0x00, 0xef,
-	0xec, 0x0b, 0x91, 0xf7, 0xf7, 0x05, 0xf0, 0xab, 0x7d, 0x21, 0xf2, 0xc1, 0xbe, 0x10, 0xf9, 0x70,
-	0x5f, 0x00, 0x1f, 0xef, 0x0b, 0xe0, 0x93, 0x7d, 0x01, 0x5c, 0x4d, 0x17, 0x0d, 0x89, 0x6d, 0x12,
-	0xb6, 0xa9, 0xe9, 0x45, 0x2a, 0x79, 0x7f, 0x26, 0x4e, 0x07, 0xff, 0x03, 0x54, 0xb9, 0x90, 0x36,
-	0xb7, 0x8a, 0x69, 0xc6, 0x74, 0x33, 0x9f, 0xef, 0xe6, 0x61, 0xb8, 0xf0, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x78, 0x14, 0x59, 0x5c, 0xdc, 0x1b, 0x00, 0x00,
+	// 2419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
+	0xf9, 0xe7, 0xf0, 0x25, 0x6a, 0x2c, 0x4b, 0xf2, 0xd8, 0x51, 0x28, 0xda, 0x59, 0xe9, 0xbf, 0x71,
+	0x12, 0x49, 0x31, 0x49, 0xff, 0xe5, 0xbe, 0xa2, 0xa2, 0x55, 0x49, 0x49, 0x96, 0x25, 0x48, 0x8a,
+	0xbc, 0x94, 0x62, 0xd4, 0xb1, 0x43, 0x8c, 0xb8, 0x43, 0x7a, 0x41, 0x72, 0x77, 0xbd, 0x33, 0x2b,
+	0x59, 0x7e, 0x14, 0x41, 0x10, 0xa4, 0x41, 0x0e, 0x6d, 0xe0, 0x36, 0x40, 0x8e, 0x05, 0x8a, 0xa2,
+	0x41, 0x4f, 0x41, 0x7b, 0x68, 0x4e, 0x6d, 0x80, 0xa2, 0x80, 0xd1, 0x02, 0x85, 0x83, 0x5c, 0x02,
+	0x14, 0x55, 0x23, 0xaa, 0x28, 0x02, 0x14, 0x68, 0xd3, 0x43, 0x8b, 0xc0, 0x97, 0x16, 0x3b, 0xbb,
+	0xcb, 0xc7, 0xf2, 0x21, 0xfa, 0x01, 0xb5, 0x01, 0x7a, 0x9b, 0x9d, 0xf9, 0xbe, 0xdf, 0xfc, 0xbe,
+	0x6f, 0x7e, 0xf3, 0x5c, 0x38, 0x5e, 0xd2, 0x0c, 0xbc, 0x85, 0xd5, 0x38, 0x65, 0x38, 0x57, 0x4c,
+	0x62, 0x5d, 0x49, 0x62, 0x5d, 0x2f, 0x29, 0x39, 0xcc, 0x14, 0x4d, 0xa5, 0xc4, 0xd8, 0x24, 0x46,
+	0x42, 0x37, 0x34, 0xa6, 0xa1, 0x7e, 0xc6, 0xd4, 0x84, 0x63, 0x9e, 0xd8, 0x3c, 0x13, 0x4b, 0x15,
+	0x14, 0x76, 0xc5, 0xdc, 0x48, 0xe4, 0xb4, 0x72, 0x92, 0xa8, 0x9b, 0xda, 0xb6, 0x6e, 0x68, 0xd7,
+	0xb6, 0x93, 0xdc, 0x38, 0x17, 0x2f, 0x10, 0x35, 0xbe, 0x89, 0x4b, 0x8a, 0x8c, 0x19, 0x49, 0x36,
+	0x15, 0x6c, 0xc8, 0x58, 0xbc, 0x0e, 0xa2, 0xa0, 0x15, 0x34, 0xdb, 0x79, 0xc3, 0xcc, 0xf3, 0x2f,
+	0xfe, 0xc1, 0x4b, 0x8e, 0xf9, 0x89, 0x82, 0xa6, 0x15, 0x4a, 0xc4, 0x66, 0xa9, 0xaa, 0x1a, 0xb3,
+	0x49, 0x3a, 0xad, 0xc7, 0x9d, 0xd6, 0x2a, 0x06, 0x29, 0xeb, 0x6c, 0xdb, 0x69, 0x1c, 0xf5, 0x36,
+	0xe6, 0x15, 0x52, 0x92, 0xb3, 0x65, 0x4c, 0x8b, 0x1e, 0xf0, 0xaa, 0x05, 0x65, 0x86, 0x99, 0x63,
+	0x4e, 0xeb, 0x88, 0xb7, 0x95, 0x29, 0x65, 0x42, 0x19, 0x2e, 0xeb, 0x8e, 0x81, 0xe0, 0x35, 0xd8,
+	0x32, 0xb0, 0xae, 0x13, 0xc3, 0x65, 0x27, 0x36, 0x27, 0x9a, 0xa8, 0x72, 0x56, 0x26, 0x9b, 0x4a,
+	0xce, 0x4d, 0xc7, 0x13, 0x2d, 0x6c, 0x0c, 0x43, 0x73, 0x06, 0x20, 0xf6, 0x64, 0x73, 0xb3, 0x22,
+	0x13, 0x95, 0x29, 0x79, 0xa5, 0xd6, 0xcf, 0x68, 0xb3, 0x51, 0x99, 0x50, 0x8a, 0x0b, 0xc4, 0xb5,
+	0x38, 0xd1, 0xc2, 0xe2, 0x2a, 0x73, 0x02, 0x15, 0xff, 0x00, 0xe0, 0x40, 0xaa, 0xa6, 0x80, 0x25,
+	0x45, 0x2d, 0xa2, 0x0b, 0x10, 0xc9, 0x24, 0x8f, 0xcd, 0x12, 0xcb, 0xe6, 0x35, 0xa3, 0x8c, 0x19,
+	0x23, 0x06, 0x8d, 0x06, 0x46, 0xc1, 0xd8, 0xa1, 0xc9, 0xb1, 0x44, 0xa3, 0x2c, 0x12, 0xcb, 0x76,
+	0x6f, 0xab, 0x78, 0xbb, 0xa4, 0x61, 0xf9, 0x6c, 0xd5, 0x5e, 0x3a, 0xe2, 0x60, 0xd4, 0xaa, 0xd0,
+	0x30, 0x0c, 0xb0, 0x12, 0x8d, 0x06, 0x47, 0xc1, 0x58, 0x24, 0xdd, 0x53, 0xd9, 0x19, 0x09, 0xac,
+	0x2d, 0x65, 0x24, 0xab, 0x0e, 0x2d, 0xc2, 0xa3, 0xb4, 0xa8, 0xe8, 0x59, 0xdd, 0xc6, 0xc9, 0xe6,
+	0x8c, 0x6d, 0x9d, 0x69, 0xd1, 0x10, 0xef, 0x34, 0x96, 0xb0, 0xb3, 0x9d, 0x70, 0xb3, 0x9d, 0x48,
+	0x6b, 0x5a, 0xe9, 0x05, 0x5c, 0x32, 0x89, 0x74, 0xc4, 0x72, 0x73, 0x7a, 0x9f, 0xe1, 0x4e, 0x8b,
+	0xc1, 0x08, 0x18, 0xf4, 0x2f, 0x06, 0x23, 0xfe, 0xc1, 0x80, 0xf8, 0x4b, 0x00, 0x87, 0xe7, 0x09,
+	0xf3, 0x84, 0x28, 0x91, 0xab, 0x26, 0xa1, 0x0c, 0x61, 0x38, 0x50, 0x27, 0xff, 0xac, 0x22, 0xd3,
+	0x28, 0xe0, 0x3d, 0x3e, 0xed, 0x0d, 0xb3, 0x0e, 0x60, 0xa1, 0x36, 0x08, 0xe9, 0xc1, 0x7b, 0xe9,
+	0xd0, 0x1b, 0xc0, 0x3f, 0x08, 0xee, 0xec, 0x8c, 0xf8, 0xee, 0xee, 0x8c, 0x00, 0xa9, 0x1f, 0xd7,
+	0x5b, 0x52, 0x34, 0x0d, 0x61, 0x4d, 0x7b, 0x51, 0x7f, 0x9b, 0x78, 0xce, 0x5a, 0x26, 0xcb, 0x98,
+	0x16, 0xd3, 0x41, 0x0b, 0x49, 0xea, 0xcd, 0xbb, 0x15, 0xe2, 0x6b, 0x7e, 0x38, 0x9c, 0xf9, 0x4f,
+	0x46, 0x30, 0x07, 0x83, 0x25, 0x45, 0x75, 0xb9, 0x8f, 0x74, 0xc0, 0xb5, 0x88, 0xb5, 0x00, 0xe4,
+	0xee, 0x9e, 0x44, 0x04, 0xee, 0x3f, 0x11, 0xdf, 0x0d, 0xc2, 0x63, 0x9e, 0xce, 0x32, 0x0c, 0x33,
+	0x8a, 0xbe, 0x06, 0x7b, 0xad, 0x1e, 0x88, 0x9c, 0xc5, 0x2c, 0x0a, 0xda, 0x00, 0xaf, 0xb9, 0x13,
+	0x38, 0x1d, 0x7c, 0xf3, 0x8f, 0x23, 0x40, 0x8a, 0xd8, 0x2e, 0x29, 0x86, 0x7e, 0x0d, 0xe0, 0x90,
+	0x4a, 0xd8, 0x96, 0x66, 0x14, 0xb3, 0xf6, 0x0a, 0x98, 0xc5, 0xb2, 0x6c, 0x10, 0x4a, 0x79, 0xc8,
+	0xbd, 0xe9, 0xef, 0x80, 0x7b, 0xe9, 0x37, 0x80, 0xf1, 0x6d, 0x30, 0xf9, 0x2a, 0x78, 0x69, 0x6c,
+	0x7a, 0x6a, 0x6c, 0x7a, 0xea, 0x45, 0x1c, 0xbf, 0x9e, 0x8a, 0x5f, 0x3c, 0x1d, 0x7f, 0xee, 0xf2,
+	0xcd, 0xba, 0x72, 0xad, 0x78, 0x29, 0x7e, 0x79, 0xa2, 0xae, 0x61, 0xfc, 0x52, 0x62, 0x7c, 0xc2,
+	0xf2, 0x4b, 0xc5, 0x2f, 0xe2, 0xf8, 0x75, 0xdb, 0xaf, 0x56, 0xae, 0x15, 0xb9, 0x5f, 0xad, 0x61,
+	0x7c, 0x6c, 0x7a, 0x6a, 0xea, 0x45, 0xab, 0x74, 0xe3, 0xff, 0x4f, 0x7d, 0xf1, 0xd6, 0xf8, 0xf4,
+	0xc9, 0x9b, 0x2f, 0x9d, 0x94, 0x8e, 0x39, 0x74, 0x33, 0x9c, 0x6d, 0xca, 0x26, 0x8b, 0x9e, 0x87,
+	0x47, 0x4b, 0x98, 0xb2, 0xac, 0xa9, 0x67, 0x0d, 0x92, 0x23, 0xca, 0xa6, 0x9d, 0x90, 0x40, 0x97,
+	0x09, 0x19, 0xb4, 0x9c, 0xd7, 0x75, 0xc9, 0x71, 0x4d, 0x31, 0x34, 0x0c, 0x23, 0xa6, 0x9e, 0xcd,
+	0x69, 0xa6, 0xca, 0xf8, 0x9c, 0x0d, 0x4a, 0x3d, 0xa6, 0x3e, 0x63, 0x7d, 0xa2, 0xcb, 0x30, 0xc6,
+	0xfb, 0x92, 0xb5, 0x2d, 0xd5, 0x4a, 0xa4, 0xb5, 0x50, 0x6c, 0x61, 0x43, 0xb6, 0xbb, 0x0c, 0x75,
+	0xd9, 0xe5, 0xe3, 0x16, 0xc6, 0xac, 0x03, 0x71, 0xd6, 0x45, 0x48, 0x31, 0xf4, 0x14, 0xec, 0xaf,
+	0x22, 0xdb, 0xfd, 0x87, 0x79, 0xff, 0x87, 0xdd, 0x5a, 0xce, 0x42, 0xfc, 0x49, 0x08, 0x0e, 0xa4,
+	0xe8, 0x8c, 0xa6, 0xe6, 0x95, 0x82, 0x69, 0x70, 0x55, 0xa0, 0x45, 0x18, 0xd6, 0xcd, 0x0d, 0x6a,
+	0x6e, 0xb4, 0x9d, 0x07, 0x8d, 0x0e, 0x89, 0x55, 0x73, 0x23, 0x63, 0x6e, 0xa4, 0x61, 0x65, 0x67,
+	0x24, 0x6c, 0x97, 0x25, 0x07, 0x21, 0xf6, 0x9b, 0x20, 0x74, 0xaa, 0xd0, 0x0a, 0xec, 0xd5, 0x0d,
+	0x6d, 0x53, 0x91, 0xad, 0xa5, 0xd0, 0x46, 0x3e, 0xdd, 0x1d, 0x72, 0x62, 0xd5, 0xf5, 0x93, 0x6a,
+	0x10, 0xb1, 0xbf, 0x05, 0x60, 0x6f, 0xb5, 0x01, 0xad, 0xc3, 0xa0, 0xb5, 0x26, 0x73, 0xe0, 0xfe,
+	0xc9, 0x2f, 0xdf, 0x2f, 0x70, 0xc2, 0x9a, 0x07, 0x26, 0x4d, 0x47, 0x2a, 0x3b, 0x23, 0xc1, 0xe5,
+	0xf3, 0x6b, 0x6b, 0x12, 0x87, 0xb3, 0x60, 0x55, 0xcc, 0x6c, 0x19, 0x3f, 0x2c, 0xec, 0x4a, 0x6a,
+	0x2d, 0x23, 0x71, 0x38, 0x74, 0x09, 0xf6, 0xe0, 0x2d, 0x9a, 0x55, 0x34, 0x5b, 0x5c, 0x0f, 0x81,
+	0xcc, 0x93, 0x9e, 0xba, 0x90, 0x59, 0xd0, 0xd6, 0xa4, 0x30, 0xde, 0xa2, 0x0b, 0x1a, 0x27, 0x8d,
+	0xcb, 0x57, 0xf5, 0x68, 0xf0, 0xe1, 0xa0, 0x39, 0xe9, 0xd4, 0xf2, 0xf9, 0x55, 0x89, 0xc3, 0xa1,
+	0x65, 0x18, 0x2a, 0xe2, 0x7c, 0x11, 0x47, 0x43, 0x0f, 0x85, 0x2b, 0xd9, 0x28, 0xe2, 0x69, 0x18,
+	0xb6, 0x2b, 0xd0, 0x21, 0xd8, 0x33, 0xb7, 0x92, 0x4a, 0x2f, 0xcd, 0xcd, 0x0e, 0xfa, 0xac, 0x8f,
+	0x0b, 0x29, 0x69, 0x65, 0x61, 0x65, 0x7e, 0x10, 0xa0, 0x3e, 0x18, 0x99, 0x5d, 0xc8, 0xd8, 0x4d,
+	0x7e, 0xf1, 0xb8, 0xbd, 0x11, 0x35, 0x76, 0xe3, 0x2c, 0xe3, 0x62, 0x0e, 0xc6, 0x5a, 0x35, 0x52,
+	0x5d, 0x53, 0x29, 0x41, 0x73, 0xf0, 0x70, 0xae, 0xbe, 0x21, 0x0a, 0xda, 0x2c, 0xc5, 0x1e, 0xff,
+	0x46, 0x2f, 0xb1, 0x08, 0x1f, 0x5f, 0xa1, 0x29, 0x7a, 0x0e, 0xab, 0x72, 0x89, 0xac, 0xeb, 0xa5,
+	0xba, 0x6d, 0x64, 0xb5, 0x71, 0x1b, 0x31, 0x75, 0x4b, 0xe4, 0x81, 0xb1, 0x43, 0x93, 0x4f, 0x74,
+	0x58, 0xee, 0xd7, 0xf5, 0x74, 0xe4, 0x5e, 0x3a, 0x74, 0x1b, 0xf8, 0x23, 0x8d, 0xbb, 0xc6, 0xba,
+	0x4e, 0xc5, 0xbf, 0xfa, 0xe1, 0x63, 0x73, 0x6a, 0x4e, 0x93, 0x89, 0x3b, 0xc1, 0xdd, 0xbe, 0xd6,
+	0x60, 0x7f, 0xed, 0x28, 0x54, 0xb7, 0x63, 0x9d, 0xf4, 0x76, 0x35, 0xa7, 0xca, 0xb3, 0xdc, 0xa8,
+	0x7e, 0xbf, 0x8a, 0xb8, 0xdb, 0x8b, 0xd4, 0x47, 0x6a, 0xed, 0x14, 0x2d, 0xc1, 0x43, 0x9b, 0xc4,
+	0xa0, 0xee, 0x26, 0x68, 0x6f, 0x56, 0xcf, 0xb6, 0x85, 0x7c, 0xc1, 0xb6, 0xad, 0x43, 0x96, 0xe0,
+	0xa6, 0x5b, 0x47, 0xd1, 0x02, 0x8c, 0xb8, 0x4b, 0x8d, 0xb3, 0x80, 0x3e, 0xd9, 0x21, 0x11, 0x6e,
+	0x84, 0x75, 0xe4, 0xaa, 0xee, 0xe8, 0x1c, 0xec, 0xad, 0x9e, 0xa2, 0x1c, 0x51, 0x8f, 0x7a, 0xb1,
+	0xbc, 0xa7, 0x27, 0x0e, 0xf4, 0x0a, 0x07, 0xaa, 0x39, 0xa3, 0x13, 0xb0, 0x57, 0xc7, 0x06, 0x2e,
+	0x13, 0x0b, 0xc9, 0x92, 0x71, 0xaf, 0x54, 0xab, 0x10, 0xbf, 0x09, 0x87, 0xbc, 0xf9, 0x76, 0xe4,
+	0x33, 0x5d, 0x17, 0x0c, 0xe8, 0x3a, 0x98, 0x5a, 0x08, 0xe2, 0x9f, 0xfd, 0xf0, 0xe8, 0x2c, 0xb1,
+	0xb0, 0xd7, 0xf5, 0xcf, 0xdb, 0x48, 0xce, 0xc0, 0xb0, 0xa9, 0xd7, 0x8d, 0xe3, 0xff, 0x75, 0x14,
+	0xb4, 0x67, 0x14, 0x1d, 0xd7, 0x03, 0x1b, 0xc3, 0xf3, 0xf0, 0x58, 0x63, 0x9e, 0x9d, 0x11, 0x7c,
+	0xae, 0x1a, 0x04, 0xe8, 0x32, 0x08, 0x97, 0x3a, 0x9f, 0x87, 0x36, 0xe6, 0xff, 0xe6, 0xe1, 0x41,
+	0xcd, 0x43, 0x6f, 0xbe, 0x1f, 0xd5, 0x3c, 0xfc, 0x9d, 0x1f, 0x46, 0xbd, 0x14, 0xd7, 0x08, 0x65,
+	0x33, 0x98, 0x12, 0x74, 0xdc, 0xda, 0xec, 0xcb, 0x84, 0x23, 0xf7, 0xa6, 0x7b, 0xee, 0xa5, 0x83,
+	0x86, 0x3f, 0x2a, 0x4b, 0xbc, 0x12, 0xc5, 0xea, 0xba, 0xb6, 0x86, 0x24, 0x52, 0x97, 0x98, 0x11,
+	0x18, 0xce, 0x67, 0x75, 0xcd, 0xb0, 0x77, 0xf3, 0xc3, 0x3c, 0xe6, 0x89, 0x40, 0xf4, 0x5f, 0x40,
+	0x0a, 0xe5, 0x57, 0x35, 0x83, 0xa1, 0x24, 0x3c, 0x94, 0x37, 0xca, 0xee, 0xd5, 0x8c, 0xe7, 0xae,
+	0x2f, 0xdd, 0x5f, 0xd9, 0x19, 0x81, 0x67, 0xa5, 0x65, 0x87, 0x8f, 0x04, 0xf3, 0x46, 0xd9, 0x29,
+	0xa3, 0xf3, 0x30, 0x4a, 0xae, 0xe9, 0x24, 0xc7, 0x88, 0x25, 0x2f, 0x2b, 0x17, 0x72, 0xd5, 0xdb,
+	0x3e, 0x1b, 0x3e, 0xde, 0x74, 0x36, 0xcc, 0xf0, 0xeb, 0xb7, 0x34, 0xe4, 0x3a, 0xda, 0x39, 0x94,
+	0x5d, 0xc8, 0x67, 0xe1, 0x91, 0x2a, 0xe4, 0x16, 0x36, 0x54, 0x45, 0x2d, 0xd0, 0x68, 0x78, 0x34,
+	0x30, 0xd6, 0x2b, 0x0d, 0xba, 0x0d, 0x17, 0x9c, 0x7a, 0xf4, 0x0c, 0x1c, 0xa8, 0x1a, 0xf3, 0x1b,
+	0x35, 0x8d, 0xf6, 0x70, 0xd3, 0x7e, 0xb7, 0x7a, 0x8e, 0xd7, 0x8a, 0xff, 0xf0, 0xc3, 0xe3, 0x56,
+	0x02, 0xbd, 0x49, 0xfd, 0x3c, 0x4d, 0x91, 0x06, 0x5d, 0x07, 0x1e, 0x99, 0xae, 0x83, 0x1e, 0x5d,
+	0xa3, 0x45, 0x18, 0xca, 0x61, 0x4a, 0x68, 0x34, 0x34, 0x1a, 0x68, 0xf5, 0x10, 0xd0, 0x4e, 0x98,
+	0x69, 0x78, 0x2f, 0xdd, 0x73, 0x1b, 0x58, 0x97, 0x72, 0x59, 0xb2, 0x21, 0xc4, 0x7f, 0x02, 0x18,
+	0x6b, 0x65, 0x2f, 0x11, 0x6a, 0x96, 0x18, 0x42, 0xf5, 0x52, 0x76, 0x14, 0x3c, 0x04, 0xc3, 0x3a,
+	0xa6, 0x94, 0xc8, 0x8e, 0x7e, 0x9d, 0x2f, 0xf4, 0x0d, 0x38, 0xe0, 0x95, 0x58, 0xa0, 0xb3, 0xc4,
+	0xfa, 0xe5, 0x46, 0x69, 0xc5, 0x60, 0xa4, 0xaa, 0xa8, 0x20, 0x97, 0x49, 0xf5, 0x1b, 0x4d, 0xc2,
+	0x10, 0x17, 0x90, 0x23, 0xdb, 0x13, 0x4d, 0x83, 0x64, 0x35, 0xce, 0x12, 0x86, 0x95, 0x12, 0x95,
+	0x6c, 0x53, 0x0b, 0x2f, 0x8f, 0x95, 0x92, 0x69, 0x10, 0x57, 0xa1, 0xd5, 0x6f, 0xf1, 0xfb, 0x00,
+	0x9e, 0x68, 0x2d, 0x38, 0x67, 0x8d, 0x98, 0x85, 0x3d, 0x06, 0x4f, 0x82, 0x7b, 0x00, 0x9b, 0xe8,
+	0x26, 0xcf, 0x76, 0xde, 0x24, 0xd7, 0xd5, 0x93, 0xac, 0xc3, 0xd5, 0x64, 0x0d, 0xc1, 0xb0, 0x45,
+	0x85, 0xd8, 0x39, 0x3a, 0x2c, 0x39, 0x5f, 0x93, 0x1f, 0x84, 0xa0, 0x3f, 0x45, 0xd1, 0x5b, 0x00,
+	0xf6, 0xcc, 0x13, 0xc6, 0x1f, 0x81, 0xc6, 0xbd, 0xfd, 0xb6, 0x7d, 0x45, 0x89, 0xed, 0xf7, 0x24,
+	0x20, 0x7e, 0xfd, 0x95, 0x0f, 0xff, 0xf4, 0x3d, 0xff, 0x57, 0xd0, 0x97, 0x92, 0x98, 0x36, 0xbc,
+	0x37, 0x26, 0x6f, 0x78, 0x1e, 0x2f, 0x12, 0x8d, 0xdf, 0xb7, 0x92, 0x7c, 0x85, 0x7a, 0x1b, 0xc0,
+	0x9e, 0x4c, 0x3b, 0x5e, 0x99, 0x07, 0xe7, 0x95, 0xe2, 0xbc, 0xbe, 0x1a, 0x7b, 0x40, 0x5e, 0x53,
+	0x60, 0x02, 0xdd, 0x84, 0x70, 0x96, 0x94, 0x08, 0x23, 0x9c, 0x5c, 0x97, 0x8f, 0x2e, 0xb1, 0xa1,
+	0x26, 0x6d, 0xce, 0x59, 0x8f, 0x97, 0x62, 0x82, 0x13, 0x1a, 0x9b, 0x78, 0x7a, 0x3f, 0x42, 0x4e,
+	0x62, 0x6e, 0x03, 0xd8, 0xe7, 0x0c, 0x98, 0xfd, 0x14, 0xd2, 0x2d, 0x81, 0x93, 0xfb, 0xa4, 0x86,
+	0xa3, 0x89, 0x5f, 0xe0, 0x74, 0x12, 0xe8, 0x54, 0x77, 0x74, 0x92, 0x94, 0x73, 0x78, 0x15, 0xc0,
+	0xc1, 0x79, 0xc2, 0x1a, 0xaf, 0xe5, 0x2d, 0xe5, 0xd4, 0xf2, 0x2e, 0x14, 0x9b, 0xe8, 0xc6, 0xd4,
+	0x9e, 0x2e, 0xe2, 0x30, 0x67, 0x78, 0x14, 0x1d, 0xb1, 0x18, 0x36, 0xdc, 0x76, 0x26, 0x2f, 0xc0,
+	0xa0, 0x75, 0xdb, 0x41, 0xcf, 0xc3, 0xbe, 0xfa, 0x1b, 0x0f, 0x7a, 0xc6, 0x0b, 0xdf, 0xe6, 0x4e,
+	0xd4, 0x6e, 0x90, 0x26, 0x7f, 0x3c, 0x00, 0x43, 0x29, 0x5d, 0x4f, 0x51, 0xb4, 0x06, 0x7b, 0x33,
+	0xe6, 0x06, 0xcd, 0x19, 0xca, 0x06, 0xe9, 0x3a, 0xf5, 0x9d, 0x6f, 0x54, 0xa7, 0x01, 0xfa, 0x2d,
+	0x80, 0x47, 0xdc, 0xcd, 0xff, 0xbc, 0x49, 0x4c, 0xb2, 0x6a, 0xd2, 0x2b, 0xa8, 0x69, 0xc4, 0x1a,
+	0x4c, 0xf6, 0xe1, 0x2c, 0x5e, 0xe3, 0x79, 0x32, 0xc4, 0x72, 0xf3, 0x48, 0x36, 0x6e, 0x70, 0x89,
+	0xfd, 0x84, 0x6f, 0x9b, 0x36, 0xfb, 0x55, 0x8b, 0xb7, 0x92, 0xd6, 0xc9, 0x22, 0xa9, 0x9b, 0xf4,
+	0x8a, 0x35, 0x41, 0x3e, 0x00, 0xf0, 0x98, 0x87, 0xaa, 0x5e, 0xc2, 0x39, 0xf2, 0x90, 0x01, 0xdd,
+	0xe0, 0x01, 0x99, 0xa2, 0x7e, 0x60, 0x01, 0x19, 0x36, 0x6f, 0x2b, 0xa6, 0x9f, 0x79, 0x47, 0x68,
+	0x49, 0xa1, 0x0c, 0x75, 0x75, 0x28, 0xe8, 0x38, 0xf3, 0x5c, 0x4c, 0x2a, 0x4a, 0x3c, 0xbc, 0x25,
+	0xb4, 0x78, 0xff, 0x2b, 0x53, 0x35, 0x1e, 0x4f, 0x00, 0xe8, 0x87, 0x00, 0x3e, 0x36, 0x4f, 0x98,
+	0xf5, 0x3e, 0x34, 0xa3, 0xa9, 0x2a, 0xc9, 0x71, 0x65, 0xaa, 0x79, 0xad, 0x6b, 0xe9, 0x8a, 0x4d,
+	0x8f, 0xff, 0x4d, 0x58, 0xdd, 0xaf, 0xf5, 0xb7, 0xf8, 0x6f, 0x88, 0x78, 0xae, 0xea, 0x1e, 0x57,
+	0x2c, 0x2e, 0xbf, 0x02, 0xb0, 0x3f, 0xa3, 0x94, 0xcd, 0x12, 0x66, 0xee, 0x8c, 0xed, 0x3c, 0x63,
+	0xda, 0x4a, 0xe4, 0x3a, 0x67, 0xc2, 0x44, 0xed, 0x20, 0x24, 0x62, 0xea, 0x49, 0xea, 0xb0, 0xb6,
+	0x14, 0xf2, 0x7b, 0x00, 0xfb, 0x1b, 0x6f, 0xe3, 0xe8, 0xa9, 0x66, 0x79, 0xb4, 0xb8, 0x95, 0xc5,
+	0x9e, 0xde, 0xcf, 0xcc, 0x59, 0xf9, 0x0e, 0x34, 0x3a, 0x3e, 0x01, 0x08, 0x27, 0x62, 0x45, 0xf7,
+	0x21, 0x80, 0x7d, 0xf5, 0xf7, 0x54, 0xd4, 0x74, 0x8f, 0x69, 0xf1, 0x5a, 0x10, 0x3b, 0xd9, 0xd9,
+	0xc8, 0x89, 0xeb, 0x40, 0x57, 0x2a, 0x53, 0x4f, 0xca, 0xc4, 0x8d, 0xca, 0x1a, 0xb3, 0x59, 0xd2,
+	0x79, 0xcc, 0x66, 0x49, 0x57, 0x63, 0x36, 0x4b, 0xfe, 0x4b, 0xc6, 0xac, 0x16, 0xdd, 0xdf, 0x01,
+	0x3c, 0xd6, 0xea, 0xe4, 0x89, 0x9a, 0x2e, 0x1e, 0x1d, 0x2e, 0x44, 0xb1, 0x53, 0xdd, 0x19, 0x3b,
+	0xf1, 0x7e, 0x8b, 0xc7, 0x7b, 0x4d, 0xa4, 0x07, 0x11, 0x6f, 0xed, 0x57, 0x65, 0x92, 0x11, 0xca,
+	0xa6, 0xc0, 0xc4, 0xe4, 0x5f, 0x82, 0xf0, 0x68, 0x8a, 0x56, 0x97, 0x61, 0x89, 0x14, 0x14, 0xca,
+	0x8c, 0x6d, 0xf4, 0x53, 0x00, 0x03, 0xf3, 0x84, 0x35, 0xcb, 0x76, 0x9e, 0xb0, 0x3a, 0x6b, 0x3b,
+	0xe4, 0xe1, 0xb6, 0xcb, 0xba, 0x58, 0xe4, 0xf1, 0x11, 0x94, 0x3b, 0x80, 0xf8, 0xd0, 0x6b, 0x7e,
+	0x18, 0xc8, 0xb4, 0x22, 0x9d, 0xb9, 0x3f, 0xd2, 0xbf, 0x00, 0x9c, 0xf5, 0xcf, 0x41, 0xac, 0x23,
+	0xed, 0xc4, 0x03, 0xd2, 0x4e, 0x34, 0xd2, 0x9e, 0x02, 0x13, 0x17, 0x97, 0xc5, 0x73, 0x8f, 0xaa,
+	0x27, 0x4b, 0xc9, 0x6f, 0x01, 0x18, 0xb6, 0xcf, 0xdc, 0x5d, 0x6e, 0xb9, 0xed, 0x36, 0x88, 0x65,
+	0x9e, 0x88, 0xf9, 0x89, 0xb9, 0x47, 0xb2, 0xc9, 0xa6, 0x7f, 0x04, 0xee, 0xec, 0x0a, 0xe0, 0xee,
+	0xae, 0x00, 0x3e, 0xda, 0x15, 0x7c, 0x1f, 0xef, 0x0a, 0xbe, 0x4f, 0x76, 0x05, 0xdf, 0xa7, 0xbb,
+	0x82, 0xef, 0xb3, 0x5d, 0x01, 0xbc, 0x5c, 0x11, 0xc0, 0xeb, 0x15, 0xc1, 0xf7, 0x4e, 0x45, 0x00,
+	0xef, 0x56, 0x04, 0xdf, 0x7b, 0x15, 0xc1, 0xf7, 0x7e, 0x45, 0xf0, 0xdd, 0xa9, 0x08, 0xe0, 0x6e,
+	0x45, 0x00, 0x1f, 0x55, 0x04, 0xdf, 0xc7, 0x15, 0x01, 0x7c, 0x52, 0x11, 0x7c, 0x9f, 0x56, 0x04,
+	0xf0, 0x59, 0x45, 0xf0, 0xbd, 0xbc, 0x27, 0xf8, 0x5e, 0xdf, 0x13, 0xc0, 0x9b, 0x7b, 0x82, 0xef,
+	0xed, 0x3d, 0x01, 0xfc, 0x60, 0x4f, 0xf0, 0xbd, 0xb3, 0x27, 0xf8, 0xde, 0xdd, 0x13, 0xc0, 0x7b,
+	0x7b, 0x02, 0x78, 0x7f, 0x4f, 0x00, 0x17, 0x93, 0x05, 0x2d, 0xc1, 0xae, 0x10, 0x76, 0xc5, 0xba,
+	0xb0, 0x26, 0x9c, 0x3f, 0x83, 0xc9, 0xc6, 0x9f, 0xfe, 0x9b, 0x67, 0x92, 0x7a, 0xb1, 0x90, 0x64,
+	0x4c, 0xd5, 0x37, 0x36, 0xc2, 0x3c, 0x0d, 0x67, 0xfe, 0x3d, 0x00, 0x0a, 0xed, 0x1c, 0xc6, 0x0c,
+	0x22, 0x00, 0x00,
 }
 
 func (x AsConfiguration_PubSub_Providers_Status) String() string {
@@ -1357,6 +1736,12 @@ func (this *AsConfiguration_PubSub_Providers) Equal(that interface{}) bool {
 	if this.AWSIoT != that1.AWSIoT {
 		return false
 	}
+	if this.AMQP != that1.AMQP {
+		return false
+	}
+	if this.Kafka != that1.Kafka {
+		return false
+	}
 	return true
 }
 func (this *GetAsConfigurationRequest) Equal(that interface{}) bool {
@@ -1613,44 +1998,221 @@ func (this *DecodeDownlinkResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PayloadFormatterTestCase) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadFormatterTestCase)
+	if !ok {
+		that2, ok := that.(PayloadFormatterTestCase)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Downlink != that1.Downlink {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
+		return false
+	}
+	if !this.ExpectedDecodedPayload.Equal(that1.ExpectedDecodedPayload) {
+		return false
+	}
+	if len(this.ExpectedWarnings) != len(that1.ExpectedWarnings) {
+		return false
+	}
+	for i := range this.ExpectedWarnings {
+		if this.ExpectedWarnings[i] != that1.ExpectedWarnings[i] {
+			return false
+		}
+	}
+	if len(this.ExpectedErrors) != len(that1.ExpectedErrors) {
+		return false
+	}
+	for i := range this.ExpectedErrors {
+		if this.ExpectedErrors[i] != that1.ExpectedErrors[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TestPayloadFormatterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TestPayloadFormatterRequest)
+	if !ok {
+		that2, ok := that.(TestPayloadFormatterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+		return false
+	}
+	if !this.VersionIds.Equal(that1.VersionIds) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	if len(this.Cases) != len(that1.Cases) {
+		return false
+	}
+	for i := range this.Cases {
+		if !this.Cases[i].Equal(that1.Cases[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PayloadFormatterTestResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadFormatterTestResult)
+	if !ok {
+		that2, ok := that.(PayloadFormatterTestResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Passed != that1.Passed {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	if len(this.Warnings) != len(that1.Warnings) {
+		return false
+	}
+	for i := range this.Warnings {
+		if this.Warnings[i] != that1.Warnings[i] {
+			return false
+		}
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Failures) != len(that1.Failures) {
+		return false
+	}
+	for i := range this.Failures {
+		if this.Failures[i] != that1.Failures[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TestPayloadFormatterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TestPayloadFormatterResponse)
+	if !ok {
+		that2, ok := that.(TestPayloadFormatterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	if this.Passed != that1.Passed {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AsClient is the client API for As service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsClient interface {
+	// Get a link configuration from the Application Server to Network Server.
+	// This only contains the configuration. Use GetLinkStats to view statistics and any link errors.
+	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	// Set a link configuration from the Application Server a Network Server.
+	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
+	// To get link statistics or errors, use GetLinkStats.
+	// Note that there can only be one Application Server instance linked to a Network Server for a given application at a time.
+	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	// Delete the link between the Application Server and Network Server for the specified application.
+	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetLinkStats returns the link statistics.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	// This call returns the error code of the link error if linking to a Network Server failed.
+	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
+}
+
+type asClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsClient(cc *grpc.ClientConn) AsClient {
+	return &asClient{cc}
+}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AsClient is the client API for As service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsClient interface {
-	// Get a link configuration from the Application Server to Network Server.
-	// This only contains the configuration. Use GetLinkStats to view statistics and any link errors.
-	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	// Set a link configuration from the Application Server a Network Server.
-	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
-	// To get link statistics or errors, use GetLinkStats.
-	// Note that there can only be one Application Server instance linked to a Network Server for a given application at a time.
-	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	// Delete the link between the Application Server and Network Server for the specified application.
-	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
-	// GetLinkStats returns the link statistics.
-	// This call returns a NotFound error code if there is no link for the given application identifiers.
-	// This call returns the error code of the link error if linking to a Network Server failed.
-	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
-	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
-}
-
-type asClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewAsClient(cc *grpc.ClientConn) AsClient {
-	return &asClient{cc}
-}
-
 func (c *asClient) GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error) {
 	out := new(ApplicationLink)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetLink", in, out, opts...)
@@ -1952,6 +2514,8 @@ type AppAsClient interface {
 	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
 	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
 	DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error)
+	// Test a payload formatter with a batch of fixtures and report which fixtures pass.
+	TestPayloadFormatter(ctx context.Context, in *TestPayloadFormatterRequest, opts ...grpc.CallOption) (*TestPayloadFormatterResponse, error)
 }
 
 type appAsClient struct {
@@ -2066,6 +2630,15 @@ func (c *appAsClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequ
 	return out, nil
 }
 
+func (c *appAsClient) TestPayloadFormatter(ctx context.Context, in *TestPayloadFormatterRequest, opts ...grpc.CallOption) (*TestPayloadFormatterResponse, error) {
+	out := new(TestPayloadFormatterResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/TestPayloadFormatter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppAsServer is the server API for AppAs service.
 type AppAsServer interface {
 	// Subscribe to upstream messages.
@@ -2084,6 +2657,8 @@ type AppAsServer interface {
 	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
 	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
 	DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error)
+	// Test a payload formatter with a batch of fixtures and report which fixtures pass.
+	TestPayloadFormatter(context.Context, *TestPayloadFormatterRequest) (*TestPayloadFormatterResponse, error)
 }
 
 // UnimplementedAppAsServer can be embedded to have forward compatible implementations.
@@ -2117,6 +2692,9 @@ func (*UnimplementedAppAsServer) DecodeUplink(ctx context.Context, req *DecodeUp
 func (*UnimplementedAppAsServer) DecodeDownlink(ctx context.Context, req *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
 }
+func (*UnimplementedAppAsServer) TestPayloadFormatter(ctx context.Context, req *TestPayloadFormatterRequest) (*TestPayloadFormatterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestPayloadFormatter not implemented")
+}
 
 func RegisterAppAsServer(s *grpc.Server, srv AppAsServer) {
 	s.RegisterService(&_AppAs_serviceDesc, srv)
@@ -2287,6 +2865,24 @@ func _AppAs_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppAs_TestPayloadFormatter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadFormatterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).TestPayloadFormatter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/TestPayloadFormatter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).TestPayloadFormatter(ctx, req.(*TestPayloadFormatterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AppAs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.AppAs",
 	HandlerType: (*AppAsServer)(nil),
@@ -2323,6 +2919,10 @@ var _AppAs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeDownlink",
 			Handler:    _AppAs_DecodeDownlink_Handler,
 		},
+		{
+			MethodName: "TestPayloadFormatter",
+			Handler:    _AppAs_TestPayloadFormatter_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2801,6 +3401,16 @@ func (m *AsConfiguration_PubSub_Providers) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Kafka != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Kafka))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AMQP != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.AMQP))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AWSIoT != 0 {
 		i = encodeVarintApplicationserver(dAtA, i, uint64(m.AWSIoT))
 		i--
@@ -3232,57 +3842,341 @@ func (m *DecodeDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
-	this := &ApplicationLink{}
-	if r.Intn(5) != 0 {
-		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
-	}
-	this.TLS = bool(r.Intn(2) == 0)
-	if r.Intn(5) != 0 {
-		this.SkipPayloadCrypto = types.NewPopulatedBoolValue(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *PayloadFormatterTestCase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
-	this := &GetApplicationLinkRequest{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	v2 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v2
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *PayloadFormatterTestCase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
-	this := &SetApplicationLinkRequest{}
-	v3 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v3
-	v4 := NewPopulatedApplicationLink(r, easy)
-	this.ApplicationLink = *v4
-	v5 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v5
-	if !easy && r.Intn(10) != 0 {
+func (m *PayloadFormatterTestCase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedErrors) > 0 {
+		for iNdEx := len(m.ExpectedErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectedErrors[iNdEx])
+			copy(dAtA[i:], m.ExpectedErrors[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.ExpectedErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	return this
-}
-
-func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
-	this := &ApplicationLinkStats{}
+	if len(m.ExpectedWarnings) > 0 {
+		for iNdEx := len(m.ExpectedWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectedWarnings[iNdEx])
+			copy(dAtA[i:], m.ExpectedWarnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.ExpectedWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpectedDecodedPayload != nil {
+		{
+			size, err := m.ExpectedDecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FRMPayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Downlink {
+		i--
+		if m.Downlink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestPayloadFormatterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestPayloadFormatterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestPayloadFormatterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cases) > 0 {
+		for iNdEx := len(m.Cases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VersionIds != nil {
+		{
+			size, err := m.VersionIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EndDeviceIds != nil {
+		{
+			size, err := m.EndDeviceIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayloadFormatterTestResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFormatterTestResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayloadFormatterTestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failures[iNdEx])
+			copy(dAtA[i:], m.Failures[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Failures[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DecodedPayload != nil {
+		{
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestPayloadFormatterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestPayloadFormatterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestPayloadFormatterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Passed != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Passed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
+	this := &ApplicationLink{}
+	if r.Intn(5) != 0 {
+		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
+	}
+	this.TLS = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.SkipPayloadCrypto = types.NewPopulatedBoolValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
+	this := &GetApplicationLinkRequest{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	v2 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
+	this := &SetApplicationLinkRequest{}
+	v3 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v3
+	v4 := NewPopulatedApplicationLink(r, easy)
+	this.ApplicationLink = *v4
+	v5 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
+	this := &ApplicationLinkStats{}
 	if r.Intn(5) != 0 {
 		this.LinkedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -3325,6 +4219,8 @@ func NewPopulatedAsConfiguration_PubSub_Providers(r randyApplicationserver, easy
 	this.MQTT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
 	this.NATS = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
 	this.AWSIoT = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.AMQP = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
+	this.Kafka = AsConfiguration_PubSub_Providers_Status([]int32{0, 1, 2}[r.Intn(3)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3445,28 +4341,119 @@ func NewPopulatedDecodeDownlinkResponse(r randyApplicationserver, easy bool) *De
 	return this
 }
 
-type randyApplicationserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
+func NewPopulatedPayloadFormatterTestCase(r randyApplicationserver, easy bool) *PayloadFormatterTestCase {
+	this := &PayloadFormatterTestCase{}
+	this.Name = randStringApplicationserver(r)
+	this.Downlink = bool(r.Intn(2) == 0)
+	this.FPort = r.Uint32()
+	v7 := r.Intn(100)
+	this.FRMPayload = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.FRMPayload[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.ExpectedDecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	v8 := r.Intn(10)
+	this.ExpectedWarnings = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.ExpectedWarnings[i] = randStringApplicationserver(r)
+	}
+	v9 := r.Intn(10)
+	this.ExpectedErrors = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.ExpectedErrors[i] = randStringApplicationserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTestPayloadFormatterRequest(r randyApplicationserver, easy bool) *TestPayloadFormatterRequest {
+	this := &TestPayloadFormatterRequest{}
+	if r.Intn(5) != 0 {
+		this.EndDeviceIds = NewPopulatedEndDeviceIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.VersionIds = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Parameter = randStringApplicationserver(r)
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.Cases = make([]*PayloadFormatterTestCase, v10)
+		for i := 0; i < v10; i++ {
+			this.Cases[i] = NewPopulatedPayloadFormatterTestCase(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPayloadFormatterTestResult(r randyApplicationserver, easy bool) *PayloadFormatterTestResult {
+	this := &PayloadFormatterTestResult{}
+	this.Name = randStringApplicationserver(r)
+	this.Passed = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	v11 := r.Intn(10)
+	this.Warnings = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.Warnings[i] = randStringApplicationserver(r)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	v12 := r.Intn(10)
+	this.Failures = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.Failures[i] = randStringApplicationserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTestPayloadFormatterResponse(r randyApplicationserver, easy bool) *TestPayloadFormatterResponse {
+	this := &TestPayloadFormatterResponse{}
+	if r.Intn(5) == 0 {
+		v13 := r.Intn(5)
+		this.Results = make([]*PayloadFormatterTestResult, v13)
+		for i := 0; i < v13; i++ {
+			this.Results[i] = NewPopulatedPayloadFormatterTestResult(r, easy)
+		}
+	}
+	this.Passed = r.Uint32()
+	this.Failed = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
 		return rune(ru + 55)
 	}
 	return rune(ru + 61)
 }
 func randStringApplicationserver(r randyApplicationserver) string {
-	v7 := r.Intn(100)
-	tmps := make([]rune, v7)
-	for i := 0; i < v7; i++ {
+	v14 := r.Intn(100)
+	tmps := make([]rune, v14)
+	for i := 0; i < v14; i++ {
 		tmps[i] = randUTF8RuneApplicationserver(r)
 	}
 	return string(tmps)
@@ -3488,11 +4475,11 @@ func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumb
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		v8 := r.Int63()
+		v15 := r.Int63()
 		if r.Intn(2) == 0 {
-			v8 *= -1
+			v15 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v8))
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v15))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3637,6 +4624,12 @@ func (m *AsConfiguration_PubSub_Providers) Size() (n int) {
 	if m.AWSIoT != 0 {
 		n += 1 + sovApplicationserver(uint64(m.AWSIoT))
 	}
+	if m.AMQP != 0 {
+		n += 1 + sovApplicationserver(uint64(m.AMQP))
+	}
+	if m.Kafka != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Kafka))
+	}
 	return n
 }
 
@@ -3800,6 +4793,132 @@ func (m *DecodeDownlinkResponse) Size() (n int) {
 	return n
 }
 
+func (m *PayloadFormatterTestCase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Downlink {
+		n += 2
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.ExpectedDecodedPayload != nil {
+		l = m.ExpectedDecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.ExpectedWarnings) > 0 {
+		for _, s := range m.ExpectedWarnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if len(m.ExpectedErrors) > 0 {
+		for _, s := range m.ExpectedErrors {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TestPayloadFormatterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndDeviceIds != nil {
+		l = m.EndDeviceIds.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.VersionIds != nil {
+		l = m.VersionIds.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.Cases) > 0 {
+		for _, e := range m.Cases {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayloadFormatterTestResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.Failures) > 0 {
+		for _, s := range m.Failures {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TestPayloadFormatterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if m.Passed != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Passed))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Failed))
+	}
+	return n
+}
+
 func sovApplicationserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3884,6 +5003,8 @@ func (this *AsConfiguration_PubSub_Providers) String() string {
 		`MQTT:` + fmt.Sprintf("%v", this.MQTT) + `,`,
 		`NATS:` + fmt.Sprintf("%v", this.NATS) + `,`,
 		`AWSIoT:` + fmt.Sprintf("%v", this.AWSIoT) + `,`,
+		`AMQP:` + fmt.Sprintf("%v", this.AMQP) + `,`,
+		`Kafka:` + fmt.Sprintf("%v", this.Kafka) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3994,6 +5115,73 @@ func (this *DecodeDownlinkResponse) String() string {
 	}, "")
 	return s
 }
+func (this *PayloadFormatterTestCase) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadFormatterTestCase{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Downlink:` + fmt.Sprintf("%v", this.Downlink) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`ExpectedDecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.ExpectedDecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`ExpectedWarnings:` + fmt.Sprintf("%v", this.ExpectedWarnings) + `,`,
+		`ExpectedErrors:` + fmt.Sprintf("%v", this.ExpectedErrors) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TestPayloadFormatterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCases := "[]*PayloadFormatterTestCase{"
+	for _, f := range this.Cases {
+		repeatedStringForCases += strings.Replace(f.String(), "PayloadFormatterTestCase", "PayloadFormatterTestCase", 1) + ","
+	}
+	repeatedStringForCases += "}"
+	s := strings.Join([]string{`&TestPayloadFormatterRequest{`,
+		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`VersionIds:` + strings.Replace(fmt.Sprintf("%v", this.VersionIds), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`Cases:` + repeatedStringForCases + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadFormatterTestResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadFormatterTestResult{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Passed:` + fmt.Sprintf("%v", this.Passed) + `,`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TestPayloadFormatterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*PayloadFormatterTestResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "PayloadFormatterTestResult", "PayloadFormatterTestResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&TestPayloadFormatterResponse{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`Passed:` + fmt.Sprintf("%v", this.Passed) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4086,10 +5274,980 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
-		case 5:
+			m.TLS = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SkipPayloadCrypto == nil {
+				m.SkipPayloadCrypto = &types.BoolValue{}
+			}
+			if err := m.SkipPayloadCrypto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkedAt == nil {
+				m.LinkedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpReceivedAt == nil {
+				m.LastUpReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
+			}
+			m.UpCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDownlinkForwardedAt == nil {
+				m.LastDownlinkForwardedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AsConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubSub == nil {
+				m.PubSub = &AsConfiguration_PubSub{}
+			}
+			if err := m.PubSub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubSub: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubSub: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Providers == nil {
+				m.Providers = &AsConfiguration_PubSub_Providers{}
+			}
+			if err := m.Providers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			m.MQTT = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MQTT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			m.NATS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NATS |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
+			}
+			m.AWSIoT = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AWSIoT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			m.AMQP = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AMQP |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			m.Kafka = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kafka |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAsConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAsConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAsConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4116,10 +6274,10 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkipPayloadCrypto == nil {
-				m.SkipPayloadCrypto = &types.BoolValue{}
+			if m.Configuration == nil {
+				m.Configuration = &AsConfiguration{}
 			}
-			if err := m.SkipPayloadCrypto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4147,7 +6305,7 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4170,48 +6328,15 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NsAsHandleUplinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NsAsHandleUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationUps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4238,7 +6363,8 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationUps = append(m.ApplicationUps, &ApplicationUp{})
+			if err := m.ApplicationUps[len(m.ApplicationUps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4266,7 +6392,7 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4289,15 +6415,15 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodeDownlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4324,13 +6450,16 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4357,13 +6486,16 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VersionIds == nil {
+				m.VersionIds = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4390,7 +6522,150 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Downlink == nil {
+				m.Downlink = &ApplicationDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodeDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &ApplicationDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4418,7 +6693,7 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4441,15 +6716,15 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeUplinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4476,18 +6751,18 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinkedAt == nil {
-				m.LinkedAt = new(time.Time)
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -4497,27 +6772,31 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			if m.VersionIds == nil {
+				m.VersionIds = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4544,18 +6823,18 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpReceivedAt == nil {
-				m.LastUpReceivedAt = new(time.Time)
+			if m.Uplink == nil {
+				m.Uplink = &ApplicationUplink{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
 			}
-			m.UpCount = 0
+			m.Formatter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -4565,16 +6844,16 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpCount |= uint64(b&0x7F) << shift
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -4584,47 +6863,24 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDownlinkForwardedAt == nil {
-				m.LastDownlinkForwardedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
-			}
-			m.DownlinkCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownlinkCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -4649,7 +6905,7 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
+func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +6928,15 @@ func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AsConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeUplinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeUplinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4707,10 +6963,10 @@ func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubSub == nil {
-				m.PubSub = &AsConfiguration_PubSub{}
+			if m.Uplink == nil {
+				m.Uplink = &ApplicationUplink{}
 			}
-			if err := m.PubSub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4738,7 +6994,7 @@ func (m *AsConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
+func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4761,15 +7017,15 @@ func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubSub: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeDownlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubSub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4796,109 +7052,18 @@ func (m *AsConfiguration_PubSub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Providers == nil {
-				m.Providers = &AsConfiguration_PubSub_Providers{}
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
 			}
-			if err := m.Providers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Providers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Providers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
-			}
-			m.MQTT = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MQTT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
-			}
-			m.NATS = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NATS |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIds", wireType)
 			}
-			m.AWSIoT = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -4908,120 +7073,31 @@ func (m *AsConfiguration_PubSub_Providers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AWSIoT |= AsConfiguration_PubSub_Providers_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAsConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAsConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.VersionIds == nil {
+				m.VersionIds = &EndDeviceVersionIdentifiers{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.VersionIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAsConfigurationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5048,13 +7124,64 @@ func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Configuration == nil {
-				m.Configuration = &AsConfiguration{}
+			if m.Downlink == nil {
+				m.Downlink = &ApplicationDownlink{}
 			}
-			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -5079,7 +7206,7 @@ func (m *GetAsConfigurationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
+func (m *DecodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5102,15 +7229,15 @@ func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NsAsHandleUplinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodeDownlinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsAsHandleUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationUps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5137,8 +7264,10 @@ func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationUps = append(m.ApplicationUps, &ApplicationUp{})
-			if err := m.ApplicationUps[len(m.ApplicationUps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Downlink == nil {
+				m.Downlink = &ApplicationDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5166,7 +7295,7 @@ func (m *NsAsHandleUplinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
+func (m *PayloadFormatterTestCase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5189,17 +7318,17 @@ func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncodeDownlinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayloadFormatterTestCase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayloadFormatterTestCase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5209,33 +7338,68 @@ func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDeviceIds == nil {
-				m.EndDeviceIds = &EndDeviceIdentifiers{}
-			}
-			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Downlink = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5245,31 +7409,29 @@ func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionIds == nil {
-				m.VersionIds = &EndDeviceVersionIdentifiers{}
-			}
-			if err := m.VersionIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedDecodedPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5296,35 +7458,16 @@ func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &ApplicationDownlink{}
+			if m.ExpectedDecodedPayload == nil {
+				m.ExpectedDecodedPayload = &types.Struct{}
 			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedDecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
-			}
-			m.Formatter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Formatter |= PayloadFormatter(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedWarnings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5350,68 +7493,15 @@ func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EncodeDownlinkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ExpectedWarnings = append(m.ExpectedWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedErrors", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5421,27 +7511,23 @@ func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &ApplicationDownlink{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpectedErrors = append(m.ExpectedErrors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5467,7 +7553,7 @@ func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
+func (m *TestPayloadFormatterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5490,10 +7576,10 @@ func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecodeUplinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestPayloadFormatterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecodeUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestPayloadFormatterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5569,42 +7655,6 @@ func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Uplink == nil {
-				m.Uplink = &ApplicationUplink{}
-			}
-			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
 			}
@@ -5623,7 +7673,7 @@ func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
@@ -5655,62 +7705,9 @@ func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DecodeUplinkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecodeUplinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5737,10 +7734,8 @@ func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Uplink == nil {
-				m.Uplink = &ApplicationUplink{}
-			}
-			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cases = append(m.Cases, &PayloadFormatterTestCase{})
+			if err := m.Cases[len(m.Cases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5768,7 +7763,7 @@ func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
+func (m *PayloadFormatterTestResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5791,17 +7786,17 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecodeDownlinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayloadFormatterTestResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayloadFormatterTestResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5811,31 +7806,47 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDeviceIds == nil {
-				m.EndDeviceIds = &EndDeviceIdentifiers{}
-			}
-			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5862,18 +7873,18 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionIds == nil {
-				m.VersionIds = &EndDeviceVersionIdentifiers{}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
 			}
-			if err := m.VersionIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5883,33 +7894,29 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &ApplicationDownlink{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Formatter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -5919,14 +7926,31 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5954,7 +7978,7 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameter = string(dAtA[iNdEx:postIndex])
+			m.Failures = append(m.Failures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5980,7 +8004,7 @@ func (m *DecodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecodeDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *TestPayloadFormatterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6003,15 +8027,15 @@ func (m *DecodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecodeDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestPayloadFormatterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestPayloadFormatterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6038,13 +8062,49 @@ func (m *DecodeDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &ApplicationDownlink{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &PayloadFormatterTestResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			m.Passed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
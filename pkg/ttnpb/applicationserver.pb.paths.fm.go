@@ -66,7 +66,9 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 var AsConfigurationFieldPathsNested = []string{
 	"pubsub",
 	"pubsub.providers",
+	"pubsub.providers.amqp",
 	"pubsub.providers.aws_iot",
+	"pubsub.providers.kafka",
 	"pubsub.providers.mqtt",
 	"pubsub.providers.nats",
 }
@@ -80,7 +82,9 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 	"configuration",
 	"configuration.pubsub",
 	"configuration.pubsub.providers",
+	"configuration.pubsub.providers.amqp",
 	"configuration.pubsub.providers.aws_iot",
+	"configuration.pubsub.providers.kafka",
 	"configuration.pubsub.providers.mqtt",
 	"configuration.pubsub.providers.nats",
 }
@@ -322,9 +326,97 @@ var DecodeDownlinkResponseFieldPathsNested = []string{
 var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 	"downlink",
 }
+var PayloadFormatterTestCaseFieldPathsNested = []string{
+	"downlink",
+	"expected_decoded_payload",
+	"expected_errors",
+	"expected_warnings",
+	"f_port",
+	"frm_payload",
+	"name",
+}
+
+var PayloadFormatterTestCaseFieldPathsTopLevel = []string{
+	"downlink",
+	"expected_decoded_payload",
+	"expected_errors",
+	"expected_warnings",
+	"f_port",
+	"frm_payload",
+	"name",
+}
+var TestPayloadFormatterRequestFieldPathsNested = []string{
+	"cases",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"formatter",
+	"parameter",
+	"version_ids",
+	"version_ids.band_id",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var TestPayloadFormatterRequestFieldPathsTopLevel = []string{
+	"cases",
+	"end_device_ids",
+	"formatter",
+	"parameter",
+	"version_ids",
+}
+var PayloadFormatterTestResultFieldPathsNested = []string{
+	"decoded_payload",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"failures",
+	"name",
+	"passed",
+	"warnings",
+}
+
+var PayloadFormatterTestResultFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"error",
+	"failures",
+	"name",
+	"passed",
+	"warnings",
+}
+var TestPayloadFormatterResponseFieldPathsNested = []string{
+	"failed",
+	"passed",
+	"results",
+}
+
+var TestPayloadFormatterResponseFieldPathsTopLevel = []string{
+	"failed",
+	"passed",
+	"results",
+}
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
+	"providers.amqp",
 	"providers.aws_iot",
+	"providers.kafka",
 	"providers.mqtt",
 	"providers.nats",
 }
@@ -333,13 +425,17 @@ var AsConfiguration_PubSubFieldPathsTopLevel = []string{
 	"providers",
 }
 var AsConfiguration_PubSub_ProvidersFieldPathsNested = []string{
+	"amqp",
 	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
 
 var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
+	"amqp",
 	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
@@ -755,6 +755,296 @@ func (dst *DecodeDownlinkResponse) SetFields(src *DecodeDownlinkResponse, paths
 	return nil
 }
 
+func (dst *PayloadFormatterTestCase) SetFields(src *PayloadFormatterTestCase, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "downlink":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlink = src.Downlink
+			} else {
+				var zero bool
+				dst.Downlink = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FRMPayload = src.FRMPayload
+			} else {
+				dst.FRMPayload = nil
+			}
+		case "expected_decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expected_decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpectedDecodedPayload = src.ExpectedDecodedPayload
+			} else {
+				dst.ExpectedDecodedPayload = nil
+			}
+		case "expected_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expected_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpectedWarnings = src.ExpectedWarnings
+			} else {
+				dst.ExpectedWarnings = nil
+			}
+		case "expected_errors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expected_errors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpectedErrors = src.ExpectedErrors
+			} else {
+				dst.ExpectedErrors = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestPayloadFormatterRequest) SetFields(src *TestPayloadFormatterRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIds == nil) && dst.VersionIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIds
+				}
+				if dst.VersionIds != nil {
+					newDst = dst.VersionIds
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIds = src.VersionIds
+				} else {
+					dst.VersionIds = nil
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+		case "cases":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cases' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Cases = src.Cases
+			} else {
+				dst.Cases = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PayloadFormatterTestResult) SetFields(src *PayloadFormatterTestResult, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "passed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'passed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Passed = src.Passed
+			} else {
+				var zero bool
+				dst.Passed = zero
+			}
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+		case "warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Warnings = src.Warnings
+			} else {
+				dst.Warnings = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "failures":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failures' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Failures = src.Failures
+			} else {
+				dst.Failures = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestPayloadFormatterResponse) SetFields(src *TestPayloadFormatterResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+		case "passed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'passed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Passed = src.Passed
+			} else {
+				var zero uint32
+				dst.Passed = zero
+			}
+		case "failed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Failed = src.Failed
+			} else {
+				var zero uint32
+				dst.Failed = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AsConfiguration_PubSub) SetFields(src *AsConfiguration_PubSub, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -824,6 +1114,26 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 				var zero AsConfiguration_PubSub_Providers_Status
 				dst.AWSIoT = zero
 			}
+		case "amqp":
+			if len(subs) > 0 {
+				return fmt.Errorf("'amqp' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AMQP = src.AMQP
+			} else {
+				var zero AsConfiguration_PubSub_Providers_Status
+				dst.AMQP = zero
+			}
+		case "kafka":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kafka' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Kafka = src.Kafka
+			} else {
+				var zero AsConfiguration_PubSub_Providers_Status
+				dst.Kafka = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1558,6 +1558,498 @@ var _ interface {
 	ErrorName() string
 } = DecodeDownlinkResponseValidationError{}
 
+// ValidateFields checks the field values on PayloadFormatterTestCase with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PayloadFormatterTestCase) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PayloadFormatterTestCaseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 100 {
+				return PayloadFormatterTestCaseValidationError{
+					field:  "name",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "downlink":
+			// no validation rules for Downlink
+		case "f_port":
+
+			if m.GetFPort() > 255 {
+				return PayloadFormatterTestCaseValidationError{
+					field:  "f_port",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frm_payload":
+			// no validation rules for FRMPayload
+		case "expected_decoded_payload":
+
+			if v, ok := interface{}(m.GetExpectedDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PayloadFormatterTestCaseValidationError{
+						field:  "expected_decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "expected_warnings":
+
+		case "expected_errors":
+
+		default:
+			return PayloadFormatterTestCaseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PayloadFormatterTestCaseValidationError is the validation error returned by
+// PayloadFormatterTestCase.ValidateFields if the designated constraints
+// aren't met.
+type PayloadFormatterTestCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadFormatterTestCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadFormatterTestCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadFormatterTestCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadFormatterTestCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadFormatterTestCaseValidationError) ErrorName() string {
+	return "PayloadFormatterTestCaseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadFormatterTestCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadFormatterTestCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadFormatterTestCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadFormatterTestCaseValidationError{}
+
+// ValidateFields checks the field values on TestPayloadFormatterRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestPayloadFormatterRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestPayloadFormatterRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return TestPayloadFormatterRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormatterRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormatterRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return TestPayloadFormatterRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+			// no validation rules for Parameter
+		case "cases":
+
+			if len(m.GetCases()) < 1 {
+				return TestPayloadFormatterRequestValidationError{
+					field:  "cases",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			if len(m.GetCases()) > 100 {
+				return TestPayloadFormatterRequestValidationError{
+					field:  "cases",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetCases() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return TestPayloadFormatterRequestValidationError{
+							field:  fmt.Sprintf("cases[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return TestPayloadFormatterRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestPayloadFormatterRequestValidationError is the validation error returned
+// by TestPayloadFormatterRequest.ValidateFields if the designated constraints
+// aren't met.
+type TestPayloadFormatterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestPayloadFormatterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestPayloadFormatterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestPayloadFormatterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestPayloadFormatterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestPayloadFormatterRequestValidationError) ErrorName() string {
+	return "TestPayloadFormatterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestPayloadFormatterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestPayloadFormatterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestPayloadFormatterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestPayloadFormatterRequestValidationError{}
+
+// ValidateFields checks the field values on PayloadFormatterTestResult with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *PayloadFormatterTestResult) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PayloadFormatterTestResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+			// no validation rules for Name
+		case "passed":
+			// no validation rules for Passed
+		case "decoded_payload":
+
+			if v, ok := interface{}(m.GetDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PayloadFormatterTestResultValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "warnings":
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PayloadFormatterTestResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "failures":
+
+		default:
+			return PayloadFormatterTestResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PayloadFormatterTestResultValidationError is the validation error returned
+// by PayloadFormatterTestResult.ValidateFields if the designated constraints
+// aren't met.
+type PayloadFormatterTestResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadFormatterTestResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadFormatterTestResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadFormatterTestResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadFormatterTestResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadFormatterTestResultValidationError) ErrorName() string {
+	return "PayloadFormatterTestResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadFormatterTestResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadFormatterTestResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadFormatterTestResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadFormatterTestResultValidationError{}
+
+// ValidateFields checks the field values on TestPayloadFormatterResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestPayloadFormatterResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestPayloadFormatterResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return TestPayloadFormatterResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "passed":
+			// no validation rules for Passed
+		case "failed":
+			// no validation rules for Failed
+		default:
+			return TestPayloadFormatterResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestPayloadFormatterResponseValidationError is the validation error returned
+// by TestPayloadFormatterResponse.ValidateFields if the designated
+// constraints aren't met.
+type TestPayloadFormatterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestPayloadFormatterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestPayloadFormatterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestPayloadFormatterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestPayloadFormatterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestPayloadFormatterResponseValidationError) ErrorName() string {
+	return "TestPayloadFormatterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestPayloadFormatterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestPayloadFormatterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestPayloadFormatterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestPayloadFormatterResponseValidationError{}
+
 // ValidateFields checks the field values on AsConfiguration_PubSub with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1672,6 +2164,10 @@ func (m *AsConfiguration_PubSub_Providers) ValidateFields(paths ...string) error
 			// no validation rules for NATS
 		case "aws_iot":
 			// no validation rules for AWSIoT
+		case "amqp":
+			// no validation rules for AMQP
+		case "kafka":
+			// no validation rules for Kafka
 		default:
 			return AsConfiguration_PubSub_ProvidersValidationError{
 				field:  name,
@@ -40,3 +40,15 @@ func (m *GetStoredApplicationUpRequest) WithApplicationIds(ids *ApplicationIdent
 	m.ApplicationIds = ids
 	return m
 }
+
+// WithEndDeviceIds returns the request with set EndDeviceIdentifiers
+func (m *GetStoredApplicationUpCountRequest) WithEndDeviceIds(ids *EndDeviceIdentifiers) *GetStoredApplicationUpCountRequest {
+	m.EndDeviceIds = ids
+	return m
+}
+
+// WithApplicationIds returns the request with set ApplicationIdentifiers
+func (m *GetStoredApplicationUpCountRequest) WithApplicationIds(ids *ApplicationIdentifiers) *GetStoredApplicationUpCountRequest {
+	m.ApplicationIds = ids
+	return m
+}
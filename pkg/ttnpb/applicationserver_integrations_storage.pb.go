@@ -14,6 +14,7 @@ import (
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -162,9 +163,161 @@ func (m *GetStoredApplicationUpRequest) GetLast() *types.Duration {
 	return nil
 }
 
+type GetStoredApplicationUpCountRequest struct {
+	// Count upstream messages from all end devices of an application. Cannot be used in conjunction with end_device_ids.
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// Count upstream messages from a single end device. Cannot be used in conjunction with application_ids.
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,2,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Count upstream messages of a specific type. If not set, then all upstream messages are counted.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Count upstream messages after this timestamp only. Cannot be used in conjunction with last.
+	After *types.Timestamp `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	// Count upstream messages before this timestamp only. Cannot be used in conjunction with last.
+	Before *types.Timestamp `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	// Count uplinks on a specific FPort only.
+	FPort *types.UInt32Value `protobuf:"bytes,6,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Count upstream messages that have arrived in the last minutes or hours. Cannot be used in conjunction with after and before.
+	Last                 *types.Duration `protobuf:"bytes,7,opt,name=last,proto3" json:"last,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetStoredApplicationUpCountRequest) Reset()      { *m = GetStoredApplicationUpCountRequest{} }
+func (*GetStoredApplicationUpCountRequest) ProtoMessage() {}
+func (*GetStoredApplicationUpCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ff0e9f52f73d254, []int{1}
+}
+func (m *GetStoredApplicationUpCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStoredApplicationUpCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStoredApplicationUpCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStoredApplicationUpCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStoredApplicationUpCountRequest.Merge(m, src)
+}
+func (m *GetStoredApplicationUpCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStoredApplicationUpCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStoredApplicationUpCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStoredApplicationUpCountRequest proto.InternalMessageInfo
+
+func (m *GetStoredApplicationUpCountRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
+	}
+	return nil
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetAfter() *types.Timestamp {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetBefore() *types.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetFPort() *types.UInt32Value {
+	if m != nil {
+		return m.FPort
+	}
+	return nil
+}
+
+func (m *GetStoredApplicationUpCountRequest) GetLast() *types.Duration {
+	if m != nil {
+		return m.Last
+	}
+	return nil
+}
+
+type GetStoredApplicationUpCountResponse struct {
+	// Number of stored upstream messages by end device ID.
+	Count                map[string]uint32 `protobuf:"bytes,1,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetStoredApplicationUpCountResponse) Reset()      { *m = GetStoredApplicationUpCountResponse{} }
+func (*GetStoredApplicationUpCountResponse) ProtoMessage() {}
+func (*GetStoredApplicationUpCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ff0e9f52f73d254, []int{2}
+}
+func (m *GetStoredApplicationUpCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStoredApplicationUpCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStoredApplicationUpCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStoredApplicationUpCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStoredApplicationUpCountResponse.Merge(m, src)
+}
+func (m *GetStoredApplicationUpCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStoredApplicationUpCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStoredApplicationUpCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStoredApplicationUpCountResponse proto.InternalMessageInfo
+
+func (m *GetStoredApplicationUpCountResponse) GetCount() map[string]uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetStoredApplicationUpRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpRequest")
 	golang_proto.RegisterType((*GetStoredApplicationUpRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpRequest")
+	proto.RegisterType((*GetStoredApplicationUpCountRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountRequest")
+	golang_proto.RegisterType((*GetStoredApplicationUpCountRequest)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountRequest")
+	proto.RegisterType((*GetStoredApplicationUpCountResponse)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountResponse")
+	golang_proto.RegisterType((*GetStoredApplicationUpCountResponse)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountResponse")
+	proto.RegisterMapType((map[string]uint32)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountResponse.CountEntry")
+	golang_proto.RegisterMapType((map[string]uint32)(nil), "ttn.lorawan.v3.GetStoredApplicationUpCountResponse.CountEntry")
 }
 
 func init() {
@@ -175,60 +328,69 @@ func init() {
 }
 
 var fileDescriptor_6ff0e9f52f73d254 = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3d, 0x6c, 0x1c, 0x45,
-	0x14, 0xde, 0x31, 0xb6, 0xc1, 0x6b, 0x63, 0x4b, 0x2b, 0x84, 0x96, 0x53, 0x3c, 0xb1, 0x0c, 0x42,
-	0x69, 0x6e, 0x37, 0xba, 0x6b, 0xa0, 0x41, 0x8a, 0x15, 0x40, 0x06, 0x21, 0xd0, 0x04, 0x53, 0xa4,
-	0x59, 0xcd, 0xed, 0xbc, 0x5d, 0x0f, 0xb7, 0x37, 0x33, 0x99, 0x99, 0x3b, 0x63, 0x59, 0x96, 0x22,
-	0xaa, 0x94, 0x48, 0x34, 0x94, 0x34, 0x48, 0x96, 0x28, 0x48, 0x99, 0x82, 0x22, 0x05, 0x45, 0x44,
-	0x15, 0x84, 0x90, 0x52, 0xe6, 0x76, 0x29, 0x52, 0xa6, 0x42, 0x51, 0x2a, 0x74, 0xbb, 0x7b, 0xbe,
-	0x3f, 0x9c, 0xd0, 0xcd, 0x7b, 0xf3, 0x7d, 0x6f, 0xde, 0xbc, 0xf7, 0xbe, 0xe7, 0x7e, 0x90, 0x49,
-	0x4d, 0x8f, 0xa8, 0x68, 0x1a, 0x4b, 0xe3, 0x6e, 0x48, 0x15, 0x0f, 0xa9, 0x52, 0x19, 0x8f, 0xa9,
-	0xe5, 0x52, 0x18, 0xd0, 0x03, 0xd0, 0x11, 0x17, 0x16, 0x52, 0x5d, 0x79, 0x22, 0x63, 0xa5, 0xa6,
-	0x29, 0x04, 0x4a, 0x4b, 0x2b, 0xbd, 0x4d, 0x6b, 0x45, 0x50, 0xc7, 0x08, 0x06, 0xed, 0xc6, 0xb5,
-	0x94, 0xdb, 0xc3, 0x7e, 0x27, 0x88, 0x65, 0x2f, 0x04, 0x31, 0x90, 0xc7, 0x4a, 0xcb, 0x6f, 0x8e,
-	0xc3, 0x12, 0x1c, 0x37, 0x53, 0x10, 0xcd, 0x01, 0xcd, 0x38, 0xa3, 0x16, 0xc2, 0x85, 0x43, 0x15,
-	0xb2, 0x71, 0x29, 0x95, 0x32, 0xcd, 0xa0, 0xca, 0x45, 0x08, 0x69, 0xab, 0x87, 0xeb, 0xdb, 0x9d,
-	0xfa, 0xb6, 0xb4, 0x3a, 0xfd, 0x24, 0x4c, 0x38, 0x64, 0x2c, 0xea, 0x51, 0xd3, 0xad, 0x11, 0x97,
-	0xe7, 0x11, 0x96, 0xf7, 0xc0, 0x58, 0xda, 0x53, 0x35, 0x00, 0xcf, 0x03, 0x58, 0xbf, 0xfa, 0xdc,
-	0x45, 0xf7, 0x47, 0x9a, 0x2a, 0x05, 0x7a, 0x9c, 0xc2, 0xdb, 0x8b, 0x35, 0xe3, 0x0c, 0x84, 0xe5,
-	0x09, 0x9f, 0x80, 0x76, 0x16, 0x41, 0x3d, 0x30, 0x86, 0xa6, 0x50, 0x23, 0x76, 0xff, 0x59, 0x71,
-	0xb7, 0x3f, 0x06, 0x7b, 0xc3, 0x4a, 0x0d, 0xec, 0xda, 0xa4, 0xe8, 0x07, 0x8a, 0xc0, 0xad, 0x3e,
-	0x18, 0xeb, 0x7d, 0xee, 0x6e, 0x4d, 0x35, 0x23, 0xe2, 0xcc, 0xf8, 0x68, 0x07, 0x5d, 0x59, 0x6f,
-	0xbd, 0x1b, 0xcc, 0x96, 0x3d, 0x98, 0xa2, 0xef, 0x4f, 0x52, 0x21, 0x9b, 0x74, 0xda, 0x6f, 0xbc,
-	0x4f, 0xdc, 0x4d, 0x10, 0x2c, 0x62, 0x30, 0xe0, 0x31, 0x94, 0xf1, 0x96, 0xca, 0x78, 0xef, 0xcc,
-	0xc7, 0xfb, 0x50, 0xb0, 0xeb, 0x25, 0x68, 0x3a, 0xda, 0x06, 0x4c, 0xbc, 0xc6, 0xfb, 0x0d, 0xb9,
-	0xcb, 0xf6, 0x58, 0x81, 0xff, 0xca, 0x0e, 0xba, 0xb2, 0xb6, 0xf7, 0x0b, 0x7a, 0xbe, 0xf7, 0x33,
-	0xd2, 0x67, 0x88, 0x38, 0x64, 0xb3, 0xaf, 0x32, 0x2e, 0xba, 0x51, 0xfd, 0x61, 0xb2, 0xfe, 0xb5,
-	0xe4, 0x22, 0xa2, 0x71, 0x0c, 0xca, 0x92, 0x0d, 0x26, 0x8f, 0x44, 0x79, 0x4d, 0xe3, 0x2e, 0x79,
-	0xfd, 0xdc, 0x12, 0xb3, 0xa6, 0x01, 0x61, 0xc9, 0xd6, 0xb9, 0x99, 0x50, 0x9e, 0x01, 0x9b, 0x72,
-	0xdc, 0xea, 0x43, 0x1f, 0x18, 0x69, 0xcc, 0x3a, 0x22, 0x2e, 0xc6, 0xd3, 0xc4, 0xc8, 0x56, 0x26,
-	0xeb, 0xca, 0x19, 0x99, 0x0d, 0x80, 0x91, 0x8d, 0xd1, 0x3c, 0x8f, 0x7e, 0xce, 0xa8, 0xa5, 0xa4,
-	0xcc, 0xde, 0x6b, 0xb9, 0x2b, 0x19, 0xef, 0x71, 0xeb, 0x2f, 0x97, 0x95, 0xb8, 0x14, 0x54, 0xcd,
-	0x0f, 0xc6, 0xcd, 0x0f, 0x0e, 0xf6, 0x85, 0x6d, 0xb7, 0xbe, 0xa2, 0x59, 0x1f, 0x48, 0x05, 0xf5,
-	0xae, 0xba, 0x2b, 0x34, 0xb1, 0xa0, 0xfd, 0x95, 0x92, 0xd3, 0x58, 0xe0, 0x7c, 0x39, 0x9e, 0x38,
-	0x52, 0x01, 0xbd, 0x96, 0xbb, 0xda, 0x81, 0x44, 0x6a, 0xf0, 0x57, 0x5f, 0x4a, 0xa9, 0x91, 0x5e,
-	0xdb, 0x5d, 0x4d, 0x22, 0x25, 0xb5, 0xf5, 0x5f, 0xfd, 0x3f, 0xa9, 0x25, 0x5f, 0x48, 0x6d, 0xbd,
-	0xf7, 0xdc, 0x15, 0xa9, 0x19, 0x68, 0xff, 0xb5, 0xb2, 0x2b, 0xbb, 0xcf, 0xf7, 0x2e, 0xeb, 0x6d,
-	0xe2, 0x90, 0x8d, 0xa6, 0x86, 0x18, 0xf8, 0x00, 0x58, 0x44, 0x2d, 0x59, 0x9f, 0x36, 0x2a, 0x82,
-	0xf7, 0xbe, 0xeb, 0x4e, 0xa4, 0xe4, 0xaf, 0x5d, 0x90, 0xe6, 0x47, 0x23, 0xc8, 0x67, 0xd4, 0x74,
-	0xc9, 0x5a, 0x32, 0x3e, 0x7a, 0x4d, 0x77, 0x39, 0xa3, 0xc6, 0xfa, 0x6e, 0x49, 0x7a, 0x6b, 0x81,
-	0x74, 0xbd, 0xd6, 0x17, 0x29, 0x61, 0xad, 0xbf, 0x96, 0xdc, 0x37, 0x66, 0xe6, 0xfd, 0x46, 0xb5,
-	0x52, 0xbc, 0x5f, 0x97, 0xdc, 0x37, 0xff, 0x5b, 0x11, 0x5e, 0x73, 0x7e, 0x42, 0x5f, 0xa8, 0x9c,
-	0xc6, 0xf6, 0x0b, 0x04, 0x72, 0xa0, 0x76, 0xff, 0x40, 0xdf, 0xfe, 0xf9, 0xf7, 0xf7, 0x4b, 0xbf,
-	0x23, 0xef, 0x24, 0xa4, 0x66, 0x66, 0xe3, 0x85, 0x27, 0xb3, 0x0a, 0x09, 0xe6, 0x14, 0x38, 0x67,
-	0x9f, 0x86, 0x15, 0x74, 0x91, 0x77, 0x7e, 0x3c, 0x0d, 0x15, 0x8d, 0xbb, 0x23, 0xe1, 0x87, 0xf5,
-	0xee, 0x0c, 0x4f, 0x46, 0xa3, 0x77, 0x7a, 0xf3, 0x53, 0x6f, 0x7f, 0xf1, 0xf9, 0x97, 0xbd, 0x77,
-	0x41, 0xb0, 0xab, 0x68, 0xef, 0x27, 0xf4, 0x60, 0x88, 0xd1, 0xc3, 0x21, 0x46, 0x8f, 0x86, 0xd8,
-	0x79, 0x3c, 0xc4, 0xce, 0x93, 0x21, 0x76, 0x9e, 0x0e, 0xb1, 0xf3, 0x6c, 0x88, 0xd1, 0xed, 0x1c,
-	0xa3, 0x3b, 0x39, 0x76, 0xce, 0x72, 0x8c, 0xee, 0xe6, 0xd8, 0xb9, 0x97, 0x63, 0xe7, 0x7e, 0x8e,
-	0x9d, 0x07, 0x39, 0x46, 0x0f, 0x73, 0x8c, 0x1e, 0xe5, 0xd8, 0x79, 0x9c, 0x63, 0xf4, 0x24, 0xc7,
-	0xce, 0xd3, 0x1c, 0xa3, 0x67, 0x39, 0x76, 0x6e, 0x17, 0xd8, 0xb9, 0x53, 0x60, 0xf4, 0x5d, 0x81,
-	0x9d, 0x1f, 0x0a, 0x8c, 0x7e, 0x2c, 0xb0, 0x73, 0x56, 0x60, 0xe7, 0x6e, 0x81, 0xd1, 0xbd, 0x02,
-	0xa3, 0xfb, 0x05, 0x46, 0x37, 0xc3, 0x54, 0x06, 0xf6, 0x10, 0xec, 0x21, 0x17, 0xa9, 0x09, 0x04,
-	0xd8, 0x23, 0xa9, 0xbb, 0xe1, 0xec, 0xfa, 0x1b, 0xb4, 0x43, 0xd5, 0x4d, 0x43, 0x6b, 0x85, 0xea,
-	0x74, 0x56, 0xcb, 0xc1, 0x68, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xee, 0x23, 0x13, 0x74, 0x7c,
-	0x06, 0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x3d, 0x6c, 0x1c, 0x45,
+	0x14, 0xde, 0xb1, 0x7d, 0x0e, 0x1e, 0x3b, 0x36, 0x1a, 0x45, 0xe8, 0x38, 0xe2, 0x89, 0x75, 0x41,
+	0xc8, 0xcd, 0xed, 0x46, 0x77, 0x8d, 0xa1, 0x88, 0x14, 0x93, 0x80, 0x0c, 0x42, 0x81, 0x49, 0x4c,
+	0xe1, 0xe6, 0x34, 0xde, 0x7d, 0xb7, 0x1e, 0x6e, 0x6f, 0x66, 0x33, 0x3b, 0x77, 0xc6, 0xb2, 0x2c,
+	0x05, 0xaa, 0x94, 0x48, 0x34, 0x94, 0x34, 0x48, 0x96, 0x28, 0x48, 0x83, 0x94, 0x02, 0x89, 0x14,
+	0x14, 0x11, 0x55, 0x80, 0x82, 0x54, 0x28, 0xb7, 0x87, 0x44, 0xca, 0x54, 0x28, 0x4a, 0x85, 0x6e,
+	0x77, 0xcf, 0xf7, 0x17, 0x3b, 0x4e, 0x84, 0xa0, 0x49, 0x37, 0xef, 0xcd, 0xf7, 0xbe, 0x79, 0xf3,
+	0xde, 0xf7, 0x76, 0x16, 0x9f, 0x0f, 0x94, 0xe6, 0xdb, 0x5c, 0x96, 0x22, 0xc3, 0xdd, 0xba, 0xc3,
+	0x43, 0xe1, 0xf0, 0x30, 0x0c, 0x84, 0xcb, 0x8d, 0x50, 0x32, 0x02, 0xdd, 0x02, 0x5d, 0x15, 0xd2,
+	0x80, 0xaf, 0x53, 0x4f, 0x35, 0x32, 0x4a, 0x73, 0x1f, 0xec, 0x50, 0x2b, 0xa3, 0xc8, 0xbc, 0x31,
+	0xd2, 0xce, 0x38, 0xec, 0x56, 0xa5, 0x70, 0xc1, 0x17, 0x66, 0xab, 0xb9, 0x69, 0xbb, 0xaa, 0xe1,
+	0x80, 0x6c, 0xa9, 0x9d, 0x50, 0xab, 0x4f, 0x77, 0x9c, 0x04, 0xec, 0x96, 0x7c, 0x90, 0xa5, 0x16,
+	0x0f, 0x84, 0xc7, 0x0d, 0x38, 0x63, 0x8b, 0x94, 0xb2, 0x70, 0xda, 0x57, 0xca, 0x0f, 0x20, 0xcd,
+	0x45, 0x4a, 0x65, 0xd2, 0x83, 0xb3, 0xdd, 0xa5, 0x6c, 0x37, 0xb1, 0x36, 0x9b, 0x35, 0xa7, 0x26,
+	0x20, 0xf0, 0xaa, 0x0d, 0x1e, 0xd5, 0x33, 0xc4, 0x99, 0x51, 0x84, 0x11, 0x0d, 0x88, 0x0c, 0x6f,
+	0x84, 0x19, 0x80, 0x8e, 0x02, 0xbc, 0x66, 0x7a, 0xb9, 0xc3, 0xf6, 0xb7, 0x35, 0x0f, 0x43, 0xd0,
+	0xbd, 0x14, 0xce, 0x8e, 0xd7, 0x4c, 0x78, 0x20, 0x8d, 0xa8, 0x89, 0x3e, 0x68, 0x69, 0x1c, 0xd4,
+	0x80, 0x28, 0xe2, 0x3e, 0x64, 0x88, 0xe2, 0xdf, 0x39, 0xbc, 0xf8, 0x2e, 0x98, 0x2b, 0x46, 0x69,
+	0xf0, 0x2e, 0xf4, 0x8b, 0xbe, 0x1e, 0x32, 0xb8, 0xd6, 0x84, 0xc8, 0x90, 0xcb, 0x78, 0x61, 0xa0,
+	0x19, 0x55, 0xe1, 0x45, 0x79, 0xb4, 0x84, 0x96, 0x67, 0xcb, 0x6f, 0xd8, 0xc3, 0x65, 0xb7, 0x07,
+	0xc2, 0xd7, 0xfa, 0xa9, 0xb0, 0x79, 0x3e, 0xe8, 0x8f, 0xc8, 0x7b, 0x78, 0x1e, 0xa4, 0x57, 0xf5,
+	0xa0, 0x25, 0x5c, 0x48, 0xf8, 0x26, 0x12, 0xbe, 0xd7, 0x47, 0xf9, 0x2e, 0x49, 0xef, 0x62, 0x02,
+	0x1a, 0x64, 0x9b, 0x83, 0xbe, 0x37, 0x22, 0x3f, 0x21, 0x3c, 0x65, 0x76, 0x42, 0xc8, 0x4f, 0x2e,
+	0xa1, 0xe5, 0x99, 0xd5, 0xef, 0xd0, 0xe3, 0xd5, 0x6f, 0x91, 0xde, 0x47, 0xcc, 0x62, 0xf3, 0xcd,
+	0x30, 0x10, 0xb2, 0x5e, 0xcd, 0x2e, 0xcc, 0x66, 0x3f, 0x51, 0x42, 0x56, 0xb9, 0xeb, 0x42, 0x68,
+	0xd8, 0x9c, 0xa7, 0xb6, 0x65, 0xb2, 0xcd, 0xdd, 0x3a, 0x3b, 0x79, 0x60, 0xc9, 0x61, 0x33, 0x02,
+	0x69, 0xd8, 0xc2, 0x81, 0x59, 0xe3, 0x22, 0x00, 0x6f, 0xc0, 0x71, 0xad, 0x09, 0x4d, 0xf0, 0x58,
+	0x61, 0xd8, 0x51, 0x15, 0xb2, 0xa7, 0x26, 0x8f, 0x2d, 0x04, 0x2a, 0xab, 0x5c, 0xa4, 0x82, 0x16,
+	0x78, 0x6c, 0xae, 0xab, 0xe7, 0xee, 0xcd, 0x3d, 0x6e, 0x38, 0x4b, 0xb2, 0x27, 0x65, 0x9c, 0x0b,
+	0x44, 0x43, 0x98, 0xfc, 0x54, 0x52, 0x89, 0xd3, 0x76, 0xda, 0x7c, 0xbb, 0xd7, 0x7c, 0x7b, 0x7d,
+	0x4d, 0x9a, 0x4a, 0xf9, 0x63, 0x1e, 0x34, 0x81, 0xa5, 0x50, 0x72, 0x0e, 0xe7, 0x78, 0xcd, 0x80,
+	0xce, 0xe7, 0x92, 0x98, 0xc2, 0x58, 0xcc, 0xd5, 0x9e, 0xe2, 0x58, 0x0a, 0x24, 0x65, 0x3c, 0xbd,
+	0x09, 0x35, 0xa5, 0x21, 0x3f, 0xfd, 0xd4, 0x90, 0x0c, 0x49, 0x2a, 0x78, 0xba, 0x56, 0x0d, 0x95,
+	0x36, 0xf9, 0x13, 0xc7, 0x49, 0xad, 0xf6, 0xa1, 0xd2, 0x86, 0xac, 0xe0, 0x9c, 0xd2, 0x1e, 0xe8,
+	0xfc, 0x4b, 0x49, 0x57, 0x8a, 0x8f, 0x57, 0xcf, 0xe8, 0x45, 0x66, 0xb1, 0xb9, 0x92, 0x06, 0x17,
+	0x44, 0x0b, 0xbc, 0x2a, 0x37, 0x6c, 0x76, 0xd0, 0x48, 0x03, 0xc8, 0x9b, 0x18, 0xf7, 0x47, 0x29,
+	0x3f, 0x73, 0x48, 0x9a, 0xef, 0x74, 0x21, 0x1f, 0xf0, 0xa8, 0xce, 0x66, 0x6a, 0xbd, 0x25, 0x29,
+	0xe1, 0xa9, 0x80, 0x47, 0x26, 0x8f, 0x93, 0xa0, 0x57, 0xc7, 0x82, 0x2e, 0x66, 0xf3, 0xc5, 0x12,
+	0x58, 0xf1, 0xd7, 0x29, 0x5c, 0x7c, 0xb2, 0xf0, 0xdf, 0x56, 0x4d, 0x69, 0x5e, 0xa8, 0xff, 0xbf,
+	0x53, 0xff, 0x81, 0x92, 0xa7, 0x9e, 0x5d, 0xc9, 0xb9, 0xe7, 0x50, 0xf2, 0xf4, 0xf1, 0x95, 0xdc,
+	0x13, 0xd5, 0x89, 0xe3, 0x89, 0xea, 0x7b, 0x84, 0xcf, 0x1e, 0x29, 0xaa, 0x28, 0xec, 0xbe, 0x67,
+	0xe4, 0x2a, 0xce, 0xb9, 0x5d, 0x47, 0x1e, 0x2d, 0x4d, 0x2e, 0xcf, 0x96, 0xcf, 0x8f, 0xf6, 0xfe,
+	0x18, 0x1c, 0x76, 0x62, 0x5d, 0x92, 0x46, 0xef, 0xb0, 0x94, 0xac, 0xb0, 0x82, 0x71, 0xdf, 0x49,
+	0x5e, 0xc6, 0x93, 0x75, 0xd8, 0x49, 0xd4, 0x3a, 0xc3, 0xba, 0x4b, 0x72, 0x0a, 0xe7, 0x5a, 0xdd,
+	0xcb, 0x25, 0x8a, 0x3b, 0xc9, 0x52, 0xe3, 0xad, 0x89, 0x15, 0x54, 0xfe, 0x31, 0x87, 0x4f, 0x0d,
+	0x1d, 0x75, 0x25, 0x7d, 0x5f, 0xc9, 0x0f, 0x13, 0xf8, 0x95, 0x27, 0x27, 0x43, 0x4a, 0xc7, 0x4b,
+	0x3a, 0x1b, 0xa4, 0xc2, 0xe2, 0x11, 0xf3, 0xb2, 0x1e, 0x16, 0x7f, 0x41, 0x9f, 0xff, 0xf6, 0xe7,
+	0x97, 0x13, 0x3f, 0x23, 0xb2, 0xeb, 0xf0, 0x68, 0xe8, 0xf9, 0x77, 0x76, 0x87, 0x07, 0xc6, 0x1e,
+	0x19, 0xc8, 0x11, 0x7b, 0xcf, 0x49, 0xa1, 0xe3, 0x71, 0x07, 0xcb, 0x3d, 0x27, 0xe4, 0x6e, 0xbd,
+	0xfb, 0x0a, 0x3a, 0xd9, 0x8f, 0x84, 0xb3, 0xdb, 0x55, 0xe2, 0xde, 0xc6, 0xfb, 0x64, 0x6d, 0xfc,
+	0xf8, 0xa7, 0x9d, 0x77, 0x08, 0xd9, 0x39, 0x44, 0xfe, 0x9a, 0xc0, 0xaf, 0x1d, 0xd1, 0x4b, 0x52,
+	0x7e, 0xa6, 0xc6, 0xa7, 0x85, 0xac, 0x3c, 0x87, 0x58, 0x8a, 0x7f, 0xa4, 0xe5, 0xfd, 0x1d, 0x91,
+	0xcf, 0xd0, 0xff, 0x58, 0x5f, 0x27, 0x11, 0xea, 0xc6, 0x47, 0xe4, 0xf2, 0xbf, 0x56, 0xe5, 0x94,
+	0x72, 0xf5, 0x1b, 0x74, 0xa7, 0x4d, 0xd1, 0xdd, 0x36, 0x45, 0xf7, 0xda, 0xd4, 0xba, 0xdf, 0xa6,
+	0xd6, 0x83, 0x36, 0xb5, 0x1e, 0xb6, 0xa9, 0xf5, 0xa8, 0x4d, 0xd1, 0xf5, 0x98, 0xa2, 0x1b, 0x31,
+	0xb5, 0xf6, 0x63, 0x8a, 0x6e, 0xc6, 0xd4, 0xba, 0x15, 0x53, 0xeb, 0x76, 0x4c, 0xad, 0x3b, 0x31,
+	0x45, 0x77, 0x63, 0x8a, 0xee, 0xc5, 0xd4, 0xba, 0x1f, 0x53, 0xf4, 0x20, 0xa6, 0xd6, 0xc3, 0x98,
+	0xa2, 0x47, 0x31, 0xb5, 0xae, 0x77, 0xa8, 0x75, 0xa3, 0x43, 0xd1, 0x17, 0x1d, 0x6a, 0x7d, 0xd5,
+	0xa1, 0xe8, 0xeb, 0x0e, 0xb5, 0xf6, 0x3b, 0xd4, 0xba, 0xd9, 0xa1, 0xe8, 0x56, 0x87, 0xa2, 0xdb,
+	0x1d, 0x8a, 0x36, 0x1c, 0x5f, 0xd9, 0x66, 0x0b, 0xcc, 0x96, 0x90, 0x7e, 0x64, 0x4b, 0x30, 0xdb,
+	0x4a, 0xd7, 0x9d, 0xe1, 0xbf, 0xae, 0x56, 0xc5, 0x09, 0xeb, 0xbe, 0x63, 0x8c, 0x0c, 0x37, 0x37,
+	0xa7, 0x93, 0x4f, 0x47, 0xe5, 0x9f, 0x01, 0x00, 0x43, 0x0d, 0x28, 0x5b, 0xf3, 0x0a, 0x00, 0x00,
 }
 
 func (this *GetStoredApplicationUpRequest) Equal(that interface{}) bool {
@@ -282,6 +444,77 @@ func (this *GetStoredApplicationUpRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetStoredApplicationUpCountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetStoredApplicationUpCountRequest)
+	if !ok {
+		that2, ok := that.(GetStoredApplicationUpCountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
+		return false
+	}
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.After.Equal(that1.After) {
+		return false
+	}
+	if !this.Before.Equal(that1.Before) {
+		return false
+	}
+	if !this.FPort.Equal(that1.FPort) {
+		return false
+	}
+	if !this.Last.Equal(that1.Last) {
+		return false
+	}
+	return true
+}
+func (this *GetStoredApplicationUpCountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetStoredApplicationUpCountResponse)
+	if !ok {
+		that2, ok := that.(GetStoredApplicationUpCountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Count) != len(that1.Count) {
+		return false
+	}
+	for i := range this.Count {
+		if this.Count[i] != that1.Count[i] {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -297,6 +530,8 @@ const _ = grpc.SupportPackageIsVersion4
 type ApplicationUpStorageClient interface {
 	// Returns a stream of application messages that have been stored in the database.
 	GetStoredApplicationUp(ctx context.Context, in *GetStoredApplicationUpRequest, opts ...grpc.CallOption) (ApplicationUpStorage_GetStoredApplicationUpClient, error)
+	// Returns the number of application messages that have been stored in the database, by end device.
+	GetStoredApplicationUpCount(ctx context.Context, in *GetStoredApplicationUpCountRequest, opts ...grpc.CallOption) (*GetStoredApplicationUpCountResponse, error)
 }
 
 type applicationUpStorageClient struct {
@@ -339,10 +574,21 @@ func (x *applicationUpStorageGetStoredApplicationUpClient) Recv() (*ApplicationU
 	return m, nil
 }
 
+func (c *applicationUpStorageClient) GetStoredApplicationUpCount(ctx context.Context, in *GetStoredApplicationUpCountRequest, opts ...grpc.CallOption) (*GetStoredApplicationUpCountResponse, error) {
+	out := new(GetStoredApplicationUpCountResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationUpStorage/GetStoredApplicationUpCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationUpStorageServer is the server API for ApplicationUpStorage service.
 type ApplicationUpStorageServer interface {
 	// Returns a stream of application messages that have been stored in the database.
 	GetStoredApplicationUp(*GetStoredApplicationUpRequest, ApplicationUpStorage_GetStoredApplicationUpServer) error
+	// Returns the number of application messages that have been stored in the database, by end device.
+	GetStoredApplicationUpCount(context.Context, *GetStoredApplicationUpCountRequest) (*GetStoredApplicationUpCountResponse, error)
 }
 
 // UnimplementedApplicationUpStorageServer can be embedded to have forward compatible implementations.
@@ -352,6 +598,9 @@ type UnimplementedApplicationUpStorageServer struct {
 func (*UnimplementedApplicationUpStorageServer) GetStoredApplicationUp(req *GetStoredApplicationUpRequest, srv ApplicationUpStorage_GetStoredApplicationUpServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetStoredApplicationUp not implemented")
 }
+func (*UnimplementedApplicationUpStorageServer) GetStoredApplicationUpCount(ctx context.Context, req *GetStoredApplicationUpCountRequest) (*GetStoredApplicationUpCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoredApplicationUpCount not implemented")
+}
 
 func RegisterApplicationUpStorageServer(s *grpc.Server, srv ApplicationUpStorageServer) {
 	s.RegisterService(&_ApplicationUpStorage_serviceDesc, srv)
@@ -378,10 +627,33 @@ func (x *applicationUpStorageGetStoredApplicationUpServer) Send(m *ApplicationUp
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApplicationUpStorage_GetStoredApplicationUpCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoredApplicationUpCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationUpStorageServer).GetStoredApplicationUpCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationUpStorage/GetStoredApplicationUpCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationUpStorageServer).GetStoredApplicationUpCount(ctx, req.(*GetStoredApplicationUpCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationUpStorage_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationUpStorage",
 	HandlerType: (*ApplicationUpStorageServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStoredApplicationUpCount",
+			Handler:    _ApplicationUpStorage_GetStoredApplicationUpCount_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetStoredApplicationUp",
@@ -525,73 +797,255 @@ func (m *GetStoredApplicationUpRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserverIntegrationsStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverIntegrationsStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedGetStoredApplicationUpRequest(r randyApplicationserverIntegrationsStorage, easy bool) *GetStoredApplicationUpRequest {
-	this := &GetStoredApplicationUpRequest{}
-	if r.Intn(5) != 0 {
-		this.ApplicationIds = NewPopulatedApplicationIdentifiers(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.EndDeviceIds = NewPopulatedEndDeviceIdentifiers(r, easy)
-	}
-	this.Type = randStringApplicationserverIntegrationsStorage(r)
-	if r.Intn(5) != 0 {
-		this.Limit = types.NewPopulatedUInt32Value(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.After = types.NewPopulatedTimestamp(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Before = types.NewPopulatedTimestamp(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.FPort = types.NewPopulatedUInt32Value(r, easy)
-	}
-	this.Order = randStringApplicationserverIntegrationsStorage(r)
-	if r.Intn(5) != 0 {
-		this.FieldMask = types.NewPopulatedFieldMask(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Last = types.NewPopulatedDuration(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *GetStoredApplicationUpCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyApplicationserverIntegrationsStorage interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *GetStoredApplicationUpCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneApplicationserverIntegrationsStorage(r randyApplicationserverIntegrationsStorage) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (m *GetStoredApplicationUpCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Last != nil {
+		{
+			size, err := m.Last.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return rune(ru + 61)
-}
-func randStringApplicationserverIntegrationsStorage(r randyApplicationserverIntegrationsStorage) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
-		tmps[i] = randUTF8RuneApplicationserverIntegrationsStorage(r)
+	if m.FPort != nil {
+		{
+			size, err := m.FPort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDeviceIds != nil {
+		{
+			size, err := m.EndDeviceIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApplicationIds != nil {
+		{
+			size, err := m.ApplicationIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStoredApplicationUpCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStoredApplicationUpCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStoredApplicationUpCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Count) > 0 {
+		for k := range m.Count {
+			v := m.Count[k]
+			baseI := i
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverIntegrationsStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverIntegrationsStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverIntegrationsStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGetStoredApplicationUpRequest(r randyApplicationserverIntegrationsStorage, easy bool) *GetStoredApplicationUpRequest {
+	this := &GetStoredApplicationUpRequest{}
+	if r.Intn(5) != 0 {
+		this.ApplicationIds = NewPopulatedApplicationIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.EndDeviceIds = NewPopulatedEndDeviceIdentifiers(r, easy)
+	}
+	this.Type = randStringApplicationserverIntegrationsStorage(r)
+	if r.Intn(5) != 0 {
+		this.Limit = types.NewPopulatedUInt32Value(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.After = types.NewPopulatedTimestamp(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Before = types.NewPopulatedTimestamp(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.FPort = types.NewPopulatedUInt32Value(r, easy)
+	}
+	this.Order = randStringApplicationserverIntegrationsStorage(r)
+	if r.Intn(5) != 0 {
+		this.FieldMask = types.NewPopulatedFieldMask(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Last = types.NewPopulatedDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetStoredApplicationUpCountRequest(r randyApplicationserverIntegrationsStorage, easy bool) *GetStoredApplicationUpCountRequest {
+	this := &GetStoredApplicationUpCountRequest{}
+	if r.Intn(5) != 0 {
+		this.ApplicationIds = NewPopulatedApplicationIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.EndDeviceIds = NewPopulatedEndDeviceIdentifiers(r, easy)
+	}
+	this.Type = randStringApplicationserverIntegrationsStorage(r)
+	if r.Intn(5) != 0 {
+		this.After = types.NewPopulatedTimestamp(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Before = types.NewPopulatedTimestamp(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.FPort = types.NewPopulatedUInt32Value(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Last = types.NewPopulatedDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetStoredApplicationUpCountResponse(r randyApplicationserverIntegrationsStorage, easy bool) *GetStoredApplicationUpCountResponse {
+	this := &GetStoredApplicationUpCountResponse{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(10)
+		this.Count = make(map[string]uint32)
+		for i := 0; i < v1; i++ {
+			this.Count[randStringApplicationserverIntegrationsStorage(r)] = uint32(r.Uint32())
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserverIntegrationsStorage interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserverIntegrationsStorage(r randyApplicationserverIntegrationsStorage) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserverIntegrationsStorage(r randyApplicationserverIntegrationsStorage) string {
+	v1 := r.Intn(100)
+	tmps := make([]rune, v1)
+	for i := 0; i < v1; i++ {
+		tmps[i] = randUTF8RuneApplicationserverIntegrationsStorage(r)
 	}
 	return string(tmps)
 }
@@ -627,103 +1081,598 @@ func randFieldApplicationserverIntegrationsStorage(dAtA []byte, r randyApplicati
 		for j := 0; j < ll; j++ {
 			dAtA = append(dAtA, byte(r.Intn(256)))
 		}
-	default:
-		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
-}
-func encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *GetStoredApplicationUpRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ApplicationIds != nil {
-		l = m.ApplicationIds.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.EndDeviceIds != nil {
-		l = m.EndDeviceIds.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.Limit != nil {
-		l = m.Limit.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.After != nil {
-		l = m.After.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.Before != nil {
-		l = m.Before.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.FPort != nil {
-		l = m.FPort.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	l = len(m.Order)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.FieldMask != nil {
-		l = m.FieldMask.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
-	}
-	if m.Last != nil {
-		l = m.Last.Size()
-		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	default:
+		dAtA = encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserverIntegrationsStorage(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GetStoredApplicationUpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationIds != nil {
+		l = m.ApplicationIds.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.EndDeviceIds != nil {
+		l = m.EndDeviceIds.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.FPort != nil {
+		l = m.FPort.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.FieldMask != nil {
+		l = m.FieldMask.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.Last != nil {
+		l = m.Last.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *GetStoredApplicationUpCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationIds != nil {
+		l = m.ApplicationIds.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.EndDeviceIds != nil {
+		l = m.EndDeviceIds.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.FPort != nil {
+		l = m.FPort.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	if m.Last != nil {
+		l = m.Last.Size()
+		n += 1 + l + sovApplicationserverIntegrationsStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *GetStoredApplicationUpCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Count) > 0 {
+		for k, v := range m.Count {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverIntegrationsStorage(uint64(len(k))) + 1 + sovApplicationserverIntegrationsStorage(uint64(v))
+			n += mapEntrySize + 1 + sovApplicationserverIntegrationsStorage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovApplicationserverIntegrationsStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserverIntegrationsStorage(x uint64) (n int) {
+	return sovApplicationserverIntegrationsStorage((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GetStoredApplicationUpRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetStoredApplicationUpRequest{`,
+		`ApplicationIds:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIds), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
+		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FPort:` + strings.Replace(fmt.Sprintf("%v", this.FPort), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`FieldMask:` + strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1) + `,`,
+		`Last:` + strings.Replace(fmt.Sprintf("%v", this.Last), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetStoredApplicationUpCountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetStoredApplicationUpCountRequest{`,
+		`ApplicationIds:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIds), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
+		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FPort:` + strings.Replace(fmt.Sprintf("%v", this.FPort), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`Last:` + strings.Replace(fmt.Sprintf("%v", this.Last), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetStoredApplicationUpCountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCount := make([]string, 0, len(this.Count))
+	for k := range this.Count {
+		keysForCount = append(keysForCount, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCount)
+	mapStringForCount := "map[string]uint32{"
+	for _, k := range keysForCount {
+		mapStringForCount += fmt.Sprintf("%v: %v,", k, this.Count[k])
+	}
+	mapStringForCount += "}"
+	s := strings.Join([]string{`&GetStoredApplicationUpCountResponse{`,
+		`Count:` + mapStringForCount + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApplicationserverIntegrationsStorage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverIntegrationsStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStoredApplicationUpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStoredApplicationUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationIds == nil {
+				m.ApplicationIds = &ApplicationIdentifiers{}
+			}
+			if err := m.ApplicationIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.UInt32Value{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &types.Timestamp{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &types.Timestamp{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FPort == nil {
+				m.FPort = &types.UInt32Value{}
+			}
+			if err := m.FPort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FieldMask == nil {
+				m.FieldMask = &types.FieldMask{}
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverIntegrationsStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Last == nil {
+				m.Last = &types.Duration{}
+			}
+			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverIntegrationsStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverIntegrationsStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovApplicationserverIntegrationsStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplicationserverIntegrationsStorage(x uint64) (n int) {
-	return sovApplicationserverIntegrationsStorage((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GetStoredApplicationUpRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetStoredApplicationUpRequest{`,
-		`ApplicationIds:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIds), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
-		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
-		`FPort:` + strings.Replace(fmt.Sprintf("%v", this.FPort), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`FieldMask:` + strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1) + `,`,
-		`Last:` + strings.Replace(fmt.Sprintf("%v", this.Last), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringApplicationserverIntegrationsStorage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
+func (m *GetStoredApplicationUpCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -746,10 +1695,10 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoredApplicationUpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoredApplicationUpCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoredApplicationUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoredApplicationUpCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -857,42 +1806,6 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverIntegrationsStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverIntegrationsStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverIntegrationsStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Limit == nil {
-				m.Limit = &types.UInt32Value{}
-			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
@@ -928,7 +1841,7 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
@@ -964,7 +1877,7 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
 			}
@@ -1000,11 +1913,11 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverIntegrationsStorage
@@ -1014,63 +1927,84 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverIntegrationsStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverIntegrationsStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if m.Last == nil {
+				m.Last = &types.Duration{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverIntegrationsStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverIntegrationsStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplicationserverIntegrationsStorage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthApplicationserverIntegrationsStorage
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FieldMask == nil {
-				m.FieldMask = &types.FieldMask{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStoredApplicationUpCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverIntegrationsStorage
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStoredApplicationUpCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStoredApplicationUpCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1097,12 +2031,89 @@ func (m *GetStoredApplicationUpRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Last == nil {
-				m.Last = &types.Duration{}
-			}
-			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Count == nil {
+				m.Count = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverIntegrationsStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverIntegrationsStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverIntegrationsStorage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverIntegrationsStorage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverIntegrationsStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverIntegrationsStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverIntegrationsStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Count[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34,3 +34,38 @@ var GetStoredApplicationUpRequestFieldPathsTopLevel = []string{
 	"order",
 	"type",
 }
+
+var GetStoredApplicationUpCountRequestFieldPathsNested = []string{
+	"after",
+	"application_ids",
+	"application_ids.application_id",
+	"before",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"last",
+	"type",
+}
+
+var GetStoredApplicationUpCountRequestFieldPathsTopLevel = []string{
+	"after",
+	"application_ids",
+	"before",
+	"end_device_ids",
+	"f_port",
+	"last",
+	"type",
+}
+
+var GetStoredApplicationUpCountResponseFieldPathsNested = []string{
+	"count",
+}
+
+var GetStoredApplicationUpCountResponseFieldPathsTopLevel = []string{
+	"count",
+}
@@ -0,0 +1,3640 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lorawan-stack/api/applicationserver_packages_fuota.proto
+
+package ttnpb
+
+import (
+	bytes "bytes"
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+	time "time"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FUOTASession_State int32
+
+const (
+	// The members are being set up.
+	FUOTASession_SETUP FUOTASession_State = 0
+	// The fragments are queued for transmission to the multicast group.
+	FUOTASession_TRANSMITTING FUOTASession_State = 1
+	// All members reconstructed the data block.
+	FUOTASession_FINISHED FUOTASession_State = 2
+)
+
+var FUOTASession_State_name = map[int32]string{
+	0: "SETUP",
+	1: "TRANSMITTING",
+	2: "FINISHED",
+}
+
+var FUOTASession_State_value = map[string]int32{
+	"SETUP":        0,
+	"TRANSMITTING": 1,
+	"FINISHED":     2,
+}
+
+func (FUOTASession_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{1, 0}
+}
+
+type FUOTASessionIdentifiers struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	SessionID              string   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *FUOTASessionIdentifiers) Reset()      { *m = FUOTASessionIdentifiers{} }
+func (*FUOTASessionIdentifiers) ProtoMessage() {}
+func (*FUOTASessionIdentifiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{0}
+}
+func (m *FUOTASessionIdentifiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FUOTASessionIdentifiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FUOTASessionIdentifiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FUOTASessionIdentifiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTASessionIdentifiers.Merge(m, src)
+}
+func (m *FUOTASessionIdentifiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *FUOTASessionIdentifiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTASessionIdentifiers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTASessionIdentifiers proto.InternalMessageInfo
+
+func (m *FUOTASessionIdentifiers) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+// A firmware update over the air (FUOTA) session distributes a data block to the members of a multicast group,
+// using Remote Multicast Setup (TS005) and Fragmented Data Block Transport (TS004).
+type FUOTASession struct {
+	FUOTASessionIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	CreatedAt               time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt               time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// The end devices that take part in the session.
+	Members []*FUOTASession_Member `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	// ID of the multicast end device that is created for the session.
+	MulticastDeviceId string `protobuf:"bytes,5,opt,name=multicast_device_id,json=multicastDeviceId,proto3" json:"multicast_device_id,omitempty"`
+	// The multicast group ID that the end devices use for the session.
+	MulticastGroupId uint32 `protobuf:"varint,6,opt,name=multicast_group_id,json=multicastGroupId,proto3" json:"multicast_group_id,omitempty"`
+	// The downlink frequency (Hz) of the Class C multicast session.
+	Frequency uint64 `protobuf:"varint,7,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// The downlink data rate index of the Class C multicast session.
+	DataRateIndex uint32 `protobuf:"varint,8,opt,name=data_rate_index,json=dataRateIndex,proto3" json:"data_rate_index,omitempty"`
+	// The time at which the Class C multicast session starts.
+	// If not set, the configured start delay after the creation of the session is used.
+	StartAt *time.Time `protobuf:"bytes,9,opt,name=start_at,json=startAt,proto3,stdtime" json:"start_at,omitempty"`
+	// The duration of the Class C multicast session, as 2^session_timeout seconds.
+	SessionTimeout uint32 `protobuf:"varint,10,opt,name=session_timeout,json=sessionTimeout,proto3" json:"session_timeout,omitempty"`
+	// The fragmentation session index that the end devices use for the session.
+	FragmentationIndex uint32 `protobuf:"varint,11,opt,name=fragmentation_index,json=fragmentationIndex,proto3" json:"fragmentation_index,omitempty"`
+	// The data block to distribute.
+	Data []byte `protobuf:"bytes,12,opt,name=data,proto3" json:"data,omitempty"`
+	// The size of each fragment in bytes.
+	FragmentSize uint32 `protobuf:"varint,13,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	// The number of redundant (coded) fragments that are sent after the uncoded fragments.
+	Redundancy uint32 `protobuf:"varint,14,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	// The application specific descriptor of the data block, for example the firmware version.
+	DataDescriptor uint32             `protobuf:"fixed32,15,opt,name=data_descriptor,json=dataDescriptor,proto3" json:"data_descriptor,omitempty"`
+	State          FUOTASession_State `protobuf:"varint,16,opt,name=state,proto3,enum=ttn.lorawan.v3.FUOTASession_State" json:"state,omitempty"`
+	// The gateways that transmit the fragments to the multicast group.
+	Gateways             []GatewayAntennaIdentifiers `protobuf:"bytes,17,rep,name=gateways,proto3" json:"gateways"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *FUOTASession) Reset()      { *m = FUOTASession{} }
+func (*FUOTASession) ProtoMessage() {}
+func (*FUOTASession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{1}
+}
+func (m *FUOTASession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FUOTASession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FUOTASession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FUOTASession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTASession.Merge(m, src)
+}
+func (m *FUOTASession) XXX_Size() int {
+	return m.Size()
+}
+func (m *FUOTASession) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTASession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTASession proto.InternalMessageInfo
+
+func (m *FUOTASession) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *FUOTASession) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *FUOTASession) GetMembers() []*FUOTASession_Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *FUOTASession) GetMulticastDeviceId() string {
+	if m != nil {
+		return m.MulticastDeviceId
+	}
+	return ""
+}
+
+func (m *FUOTASession) GetMulticastGroupId() uint32 {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetDataRateIndex() uint32 {
+	if m != nil {
+		return m.DataRateIndex
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetStartAt() *time.Time {
+	if m != nil {
+		return m.StartAt
+	}
+	return nil
+}
+
+func (m *FUOTASession) GetSessionTimeout() uint32 {
+	if m != nil {
+		return m.SessionTimeout
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetFragmentationIndex() uint32 {
+	if m != nil {
+		return m.FragmentationIndex
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FUOTASession) GetFragmentSize() uint32 {
+	if m != nil {
+		return m.FragmentSize
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetRedundancy() uint32 {
+	if m != nil {
+		return m.Redundancy
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetDataDescriptor() uint32 {
+	if m != nil {
+		return m.DataDescriptor
+	}
+	return 0
+}
+
+func (m *FUOTASession) GetState() FUOTASession_State {
+	if m != nil {
+		return m.State
+	}
+	return FUOTASession_SETUP
+}
+
+func (m *FUOTASession) GetGateways() []GatewayAntennaIdentifiers {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+type FUOTASession_Member struct {
+	// ID of the end device in the application of the session.
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// The end device acknowledged the multicast group setup.
+	MulticastGroupSetup bool `protobuf:"varint,2,opt,name=multicast_group_setup,json=multicastGroupSetup,proto3" json:"multicast_group_setup,omitempty"`
+	// The end device acknowledged the multicast Class C session setup.
+	MulticastSessionSetup bool `protobuf:"varint,3,opt,name=multicast_session_setup,json=multicastSessionSetup,proto3" json:"multicast_session_setup,omitempty"`
+	// The end device acknowledged the fragmentation session setup.
+	FragmentationSetup bool `protobuf:"varint,4,opt,name=fragmentation_setup,json=fragmentationSetup,proto3" json:"fragmentation_setup,omitempty"`
+	// The number of fragments received by the end device, as last reported by the end device.
+	FragmentsReceived uint32 `protobuf:"varint,5,opt,name=fragments_received,json=fragmentsReceived,proto3" json:"fragments_received,omitempty"`
+	// The number of fragments that the end device still needs to reconstruct the data block, as last reported by the end device.
+	FragmentsMissing uint32 `protobuf:"varint,6,opt,name=fragments_missing,json=fragmentsMissing,proto3" json:"fragments_missing,omitempty"`
+	// The end device reconstructed the data block.
+	Completed bool `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The error reported by the end device, if any.
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FUOTASession_Member) Reset()      { *m = FUOTASession_Member{} }
+func (*FUOTASession_Member) ProtoMessage() {}
+func (*FUOTASession_Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{1, 0}
+}
+func (m *FUOTASession_Member) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FUOTASession_Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FUOTASession_Member.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FUOTASession_Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTASession_Member.Merge(m, src)
+}
+func (m *FUOTASession_Member) XXX_Size() int {
+	return m.Size()
+}
+func (m *FUOTASession_Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTASession_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTASession_Member proto.InternalMessageInfo
+
+func (m *FUOTASession_Member) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *FUOTASession_Member) GetMulticastGroupSetup() bool {
+	if m != nil {
+		return m.MulticastGroupSetup
+	}
+	return false
+}
+
+func (m *FUOTASession_Member) GetMulticastSessionSetup() bool {
+	if m != nil {
+		return m.MulticastSessionSetup
+	}
+	return false
+}
+
+func (m *FUOTASession_Member) GetFragmentationSetup() bool {
+	if m != nil {
+		return m.FragmentationSetup
+	}
+	return false
+}
+
+func (m *FUOTASession_Member) GetFragmentsReceived() uint32 {
+	if m != nil {
+		return m.FragmentsReceived
+	}
+	return 0
+}
+
+func (m *FUOTASession_Member) GetFragmentsMissing() uint32 {
+	if m != nil {
+		return m.FragmentsMissing
+	}
+	return 0
+}
+
+func (m *FUOTASession_Member) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *FUOTASession_Member) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type FUOTASessions struct {
+	Sessions             []*FUOTASession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FUOTASessions) Reset()      { *m = FUOTASessions{} }
+func (*FUOTASessions) ProtoMessage() {}
+func (*FUOTASessions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{2}
+}
+func (m *FUOTASessions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FUOTASessions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FUOTASessions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FUOTASessions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTASessions.Merge(m, src)
+}
+func (m *FUOTASessions) XXX_Size() int {
+	return m.Size()
+}
+func (m *FUOTASessions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTASessions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTASessions proto.InternalMessageInfo
+
+func (m *FUOTASessions) GetSessions() []*FUOTASession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type GetFUOTASessionRequest struct {
+	FUOTASessionIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	FieldMask               types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
+	XXX_sizecache           int32           `json:"-"`
+}
+
+func (m *GetFUOTASessionRequest) Reset()      { *m = GetFUOTASessionRequest{} }
+func (*GetFUOTASessionRequest) ProtoMessage() {}
+func (*GetFUOTASessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{3}
+}
+func (m *GetFUOTASessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFUOTASessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFUOTASessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFUOTASessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFUOTASessionRequest.Merge(m, src)
+}
+func (m *GetFUOTASessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFUOTASessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFUOTASessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFUOTASessionRequest proto.InternalMessageInfo
+
+func (m *GetFUOTASessionRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+type ListFUOTASessionsRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *ListFUOTASessionsRequest) Reset()      { *m = ListFUOTASessionsRequest{} }
+func (*ListFUOTASessionsRequest) ProtoMessage() {}
+func (*ListFUOTASessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{4}
+}
+func (m *ListFUOTASessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFUOTASessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFUOTASessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListFUOTASessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFUOTASessionsRequest.Merge(m, src)
+}
+func (m *ListFUOTASessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFUOTASessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFUOTASessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFUOTASessionsRequest proto.InternalMessageInfo
+
+func (m *ListFUOTASessionsRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+type CreateFUOTASessionRequest struct {
+	FUOTASession `protobuf:"bytes,1,opt,name=session,proto3,embedded=session" json:"session"`
+	// GenAppKeys of the members by end device ID, used to encrypt the multicast group key.
+	// The keys are not stored.
+	GenAppKeys           map[string]*KeyEnvelope `protobuf:"bytes,2,rep,name=gen_app_keys,json=genAppKeys,proto3" json:"gen_app_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CreateFUOTASessionRequest) Reset()      { *m = CreateFUOTASessionRequest{} }
+func (*CreateFUOTASessionRequest) ProtoMessage() {}
+func (*CreateFUOTASessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_053c607dc5c47b39, []int{5}
+}
+func (m *CreateFUOTASessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateFUOTASessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFUOTASessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateFUOTASessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFUOTASessionRequest.Merge(m, src)
+}
+func (m *CreateFUOTASessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateFUOTASessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFUOTASessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFUOTASessionRequest proto.InternalMessageInfo
+
+func (m *CreateFUOTASessionRequest) GetGenAppKeys() map[string]*KeyEnvelope {
+	if m != nil {
+		return m.GenAppKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.FUOTASession_State", FUOTASession_State_name, FUOTASession_State_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.FUOTASession_State", FUOTASession_State_name, FUOTASession_State_value)
+	proto.RegisterType((*FUOTASessionIdentifiers)(nil), "ttn.lorawan.v3.FUOTASessionIdentifiers")
+	golang_proto.RegisterType((*FUOTASessionIdentifiers)(nil), "ttn.lorawan.v3.FUOTASessionIdentifiers")
+	proto.RegisterType((*FUOTASession)(nil), "ttn.lorawan.v3.FUOTASession")
+	golang_proto.RegisterType((*FUOTASession)(nil), "ttn.lorawan.v3.FUOTASession")
+	proto.RegisterType((*FUOTASession_Member)(nil), "ttn.lorawan.v3.FUOTASession.Member")
+	golang_proto.RegisterType((*FUOTASession_Member)(nil), "ttn.lorawan.v3.FUOTASession.Member")
+	proto.RegisterType((*FUOTASessions)(nil), "ttn.lorawan.v3.FUOTASessions")
+	golang_proto.RegisterType((*FUOTASessions)(nil), "ttn.lorawan.v3.FUOTASessions")
+	proto.RegisterType((*GetFUOTASessionRequest)(nil), "ttn.lorawan.v3.GetFUOTASessionRequest")
+	golang_proto.RegisterType((*GetFUOTASessionRequest)(nil), "ttn.lorawan.v3.GetFUOTASessionRequest")
+	proto.RegisterType((*ListFUOTASessionsRequest)(nil), "ttn.lorawan.v3.ListFUOTASessionsRequest")
+	golang_proto.RegisterType((*ListFUOTASessionsRequest)(nil), "ttn.lorawan.v3.ListFUOTASessionsRequest")
+	proto.RegisterType((*CreateFUOTASessionRequest)(nil), "ttn.lorawan.v3.CreateFUOTASessionRequest")
+	golang_proto.RegisterType((*CreateFUOTASessionRequest)(nil), "ttn.lorawan.v3.CreateFUOTASessionRequest")
+	proto.RegisterMapType((map[string]*KeyEnvelope)(nil), "ttn.lorawan.v3.CreateFUOTASessionRequest.GenAppKeysEntry")
+	golang_proto.RegisterMapType((map[string]*KeyEnvelope)(nil), "ttn.lorawan.v3.CreateFUOTASessionRequest.GenAppKeysEntry")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver_packages_fuota.proto", fileDescriptor_053c607dc5c47b39)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_packages_fuota.proto", fileDescriptor_053c607dc5c47b39)
+}
+
+var fileDescriptor_053c607dc5c47b39 = []byte{
+	// 1536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x8c, 0x13, 0xc9,
+	0x15, 0xee, 0xf2, 0xcf, 0xd8, 0xae, 0xf9, 0x33, 0x85, 0xb2, 0xdb, 0xf1, 0xce, 0xf6, 0x58, 0x5e,
+	0x14, 0xcc, 0x24, 0x76, 0x6f, 0x4c, 0x12, 0xcd, 0x92, 0x03, 0xeb, 0xde, 0x19, 0x66, 0x9d, 0xc9,
+	0x90, 0xa4, 0x3d, 0x93, 0x44, 0xa0, 0xc5, 0x5b, 0xe3, 0x2e, 0x37, 0x2d, 0xdb, 0xdd, 0x9d, 0xae,
+	0xb2, 0xc1, 0x83, 0x90, 0x46, 0x48, 0x91, 0x50, 0x4e, 0x88, 0x5c, 0x72, 0x8a, 0xa2, 0x48, 0x89,
+	0xc8, 0x0d, 0x89, 0x0b, 0xa7, 0x88, 0x23, 0xb7, 0x10, 0xe5, 0xc2, 0x89, 0x30, 0x76, 0x0e, 0x1c,
+	0x51, 0x4e, 0xc8, 0xa7, 0xa8, 0xab, 0xbb, 0xfd, 0x3b, 0x33, 0x40, 0x40, 0x7b, 0x73, 0xbf, 0xf7,
+	0xbd, 0x57, 0x5f, 0xbd, 0xf7, 0xbd, 0xaa, 0x32, 0x5c, 0x6d, 0x58, 0x0e, 0xbe, 0x86, 0xcd, 0x1c,
+	0x65, 0xb8, 0x5a, 0x97, 0xb1, 0x6d, 0xc8, 0xd8, 0xb6, 0x1b, 0x46, 0x15, 0x33, 0xc3, 0x32, 0x29,
+	0x71, 0xda, 0xc4, 0xa9, 0xd8, 0xb8, 0x5a, 0xc7, 0x3a, 0xa1, 0x95, 0x5a, 0xcb, 0x62, 0x38, 0x6f,
+	0x3b, 0x16, 0xb3, 0xd0, 0x02, 0x63, 0x66, 0xde, 0x8f, 0xce, 0xb7, 0xcf, 0xa6, 0x8a, 0xba, 0xc1,
+	0xae, 0xb6, 0x76, 0xf3, 0x55, 0xab, 0x29, 0x13, 0xb3, 0x6d, 0x75, 0x6c, 0xc7, 0xba, 0xde, 0x91,
+	0x39, 0xb8, 0x9a, 0xd3, 0x89, 0x99, 0x6b, 0xe3, 0x86, 0xa1, 0x61, 0x46, 0xe4, 0xa9, 0x1f, 0x5e,
+	0xca, 0x54, 0x6e, 0x24, 0x85, 0x6e, 0xe9, 0x96, 0x17, 0xbc, 0xdb, 0xaa, 0xf1, 0x2f, 0xfe, 0xc1,
+	0x7f, 0xf9, 0xf0, 0x25, 0xdd, 0xb2, 0xf4, 0x06, 0xf1, 0x48, 0x9b, 0xa6, 0xc5, 0x3c, 0xce, 0xbe,
+	0xf7, 0x23, 0xdf, 0x3b, 0xc8, 0x41, 0x9a, 0x36, 0xeb, 0xf8, 0xce, 0xf4, 0xa4, 0xb3, 0x66, 0x90,
+	0x86, 0x56, 0x69, 0x62, 0x5a, 0xf7, 0x11, 0xcb, 0x93, 0x08, 0x66, 0x34, 0x09, 0x65, 0xb8, 0x69,
+	0xfb, 0x80, 0x4f, 0xa6, 0x2b, 0x67, 0x68, 0xc4, 0x64, 0x46, 0xcd, 0x20, 0x4e, 0x40, 0x62, 0x69,
+	0x1a, 0x54, 0x27, 0x9d, 0xc0, 0xbb, 0x3c, 0xed, 0x0d, 0x0a, 0xca, 0x01, 0x99, 0x7f, 0x02, 0xf8,
+	0xe1, 0x85, 0x9d, 0x9f, 0x6d, 0x17, 0xcb, 0x84, 0x52, 0xc3, 0x32, 0x4b, 0xc3, 0x05, 0x10, 0x86,
+	0x8b, 0x23, 0x9d, 0xaa, 0x18, 0x1a, 0x15, 0x41, 0x1a, 0x64, 0x67, 0x0b, 0xdf, 0xc9, 0x8f, 0x77,
+	0x26, 0x5f, 0x1c, 0xc2, 0x46, 0x12, 0x28, 0xc9, 0xbe, 0x12, 0xfd, 0x1d, 0x08, 0x25, 0xc1, 0xe3,
+	0x67, 0xcb, 0xc2, 0x93, 0x67, 0xcb, 0x40, 0x5d, 0xc0, 0xa3, 0x48, 0x8a, 0xca, 0x10, 0x52, 0x6f,
+	0xe1, 0x8a, 0xa1, 0x89, 0xa1, 0x34, 0xc8, 0x26, 0x94, 0x1f, 0xf4, 0x95, 0x53, 0x4e, 0x46, 0x3c,
+	0x55, 0x90, 0xae, 0x5c, 0xc6, 0xb9, 0xbd, 0x4f, 0x73, 0x9f, 0x7d, 0x95, 0x3d, 0x7f, 0xee, 0x72,
+	0xee, 0xab, 0xf3, 0xc1, 0xe7, 0x99, 0x1b, 0x85, 0xef, 0xdd, 0x3c, 0xd5, 0x7d, 0xb6, 0x9c, 0x08,
+	0x58, 0xaf, 0xa9, 0x09, 0x1a, 0x6c, 0x20, 0xf3, 0x60, 0x16, 0xce, 0x8d, 0xee, 0x09, 0x6d, 0xc2,
+	0xf0, 0x90, 0xfc, 0xe9, 0x49, 0xf2, 0x47, 0x6c, 0xff, 0x10, 0xf6, 0x6e, 0x16, 0xf4, 0x05, 0x84,
+	0x55, 0x87, 0x60, 0x46, 0xb4, 0x0a, 0x66, 0x9c, 0xf2, 0x6c, 0x21, 0x95, 0xf7, 0x7a, 0x99, 0x0f,
+	0x7a, 0x99, 0xdf, 0x0e, 0x7a, 0xa9, 0xc4, 0xdd, 0xf0, 0x3b, 0xff, 0x5e, 0x06, 0x6a, 0xc2, 0x8f,
+	0x2b, 0x32, 0x37, 0x49, 0xcb, 0xd6, 0x82, 0x24, 0xe1, 0xb7, 0x49, 0xe2, 0xc7, 0x15, 0x19, 0xfa,
+	0x09, 0x8c, 0x35, 0x49, 0x73, 0x97, 0x38, 0x54, 0x8c, 0xa4, 0xc3, 0xd9, 0xd9, 0xc2, 0x27, 0xc7,
+	0x6d, 0x2d, 0xbf, 0xc5, 0xb1, 0xca, 0x6c, 0x5f, 0x89, 0xdf, 0x05, 0xd1, 0x38, 0x48, 0xee, 0x87,
+	0xd4, 0x20, 0x01, 0xfa, 0x15, 0x3c, 0xd9, 0x6c, 0x35, 0x98, 0x51, 0xc5, 0x94, 0x55, 0x34, 0xd2,
+	0x36, 0xaa, 0xc4, 0xed, 0x48, 0x94, 0x77, 0xe4, 0xf4, 0x1b, 0x76, 0x44, 0x3d, 0x31, 0xc8, 0xb1,
+	0xc6, 0x53, 0x94, 0x34, 0xf4, 0x43, 0x88, 0x86, 0x89, 0x75, 0xc7, 0x6a, 0xd9, 0x6e, 0xde, 0x99,
+	0x34, 0xc8, 0xce, 0x2b, 0xb1, 0xbe, 0x12, 0x59, 0x09, 0x89, 0x61, 0x35, 0x39, 0x80, 0x6c, 0xb8,
+	0x88, 0x92, 0x86, 0x96, 0x60, 0xa2, 0xe6, 0x90, 0xdf, 0xb4, 0x88, 0x59, 0xed, 0x88, 0xb1, 0x34,
+	0xc8, 0x46, 0xd4, 0xa1, 0x01, 0xc9, 0x70, 0x51, 0xc3, 0x0c, 0x57, 0x1c, 0xcc, 0x48, 0xc5, 0x30,
+	0x35, 0x72, 0x5d, 0x8c, 0x8f, 0x66, 0x5c, 0x54, 0xe7, 0x5d, 0xbf, 0x8a, 0x19, 0x29, 0xb9, 0x5e,
+	0xf4, 0x63, 0x18, 0xa7, 0x0c, 0x3b, 0xcc, 0xad, 0x76, 0xe2, 0xb5, 0xd5, 0x8e, 0xf0, 0x4a, 0xc7,
+	0x78, 0x44, 0x91, 0xa1, 0x4f, 0xe1, 0x62, 0x20, 0x52, 0x77, 0x44, 0xad, 0x16, 0x13, 0xe1, 0xf8,
+	0x6a, 0x0b, 0xbe, 0x7f, 0xdb, 0x73, 0xa3, 0x55, 0x78, 0xb2, 0xe6, 0x60, 0xbd, 0x49, 0x4c, 0xe6,
+	0xcf, 0x0e, 0xe7, 0x38, 0x3b, 0xbe, 0x6b, 0x34, 0x86, 0xf1, 0x88, 0x7e, 0x0c, 0x23, 0x2e, 0x73,
+	0x71, 0x2e, 0x0d, 0xb2, 0x73, 0x4a, 0xa2, 0xaf, 0xcc, 0xec, 0x45, 0xc4, 0xfd, 0xfd, 0xcf, 0x55,
+	0x6e, 0x46, 0x32, 0x9c, 0x0f, 0x82, 0x2a, 0xd4, 0xd8, 0x23, 0xe2, 0x3c, 0x4f, 0x09, 0xfb, 0x4a,
+	0x6c, 0x25, 0x2a, 0xfe, 0x17, 0x64, 0x81, 0x3a, 0x17, 0x00, 0xca, 0xc6, 0x1e, 0x41, 0x59, 0x08,
+	0x1d, 0xa2, 0xb5, 0x4c, 0x0d, 0xbb, 0x85, 0x5c, 0xe0, 0xe8, 0x78, 0x5f, 0x89, 0xae, 0x84, 0xc5,
+	0xfd, 0xcf, 0xd5, 0x11, 0x1f, 0x3a, 0xed, 0xd7, 0x54, 0x23, 0xb4, 0xea, 0x18, 0x36, 0xb3, 0x1c,
+	0x71, 0x31, 0x0d, 0xb2, 0x31, 0x75, 0xc1, 0x35, 0xaf, 0x0d, 0xac, 0x48, 0x81, 0x51, 0xca, 0x30,
+	0x23, 0x62, 0x32, 0x0d, 0xb2, 0x0b, 0x85, 0xcc, 0xb1, 0xa2, 0x2b, 0xbb, 0x48, 0xbe, 0xe2, 0x2d,
+	0x77, 0x94, 0x54, 0x2f, 0x14, 0xed, 0xc0, 0xb8, 0x8e, 0x19, 0xb9, 0x86, 0x3b, 0x54, 0x3c, 0xc1,
+	0xb5, 0x7b, 0x66, 0x32, 0xcd, 0x86, 0xe7, 0x2f, 0x9a, 0x8c, 0x98, 0x26, 0x1e, 0x1d, 0xcc, 0x85,
+	0xbe, 0x12, 0xbb, 0x0b, 0x22, 0x71, 0x90, 0x4c, 0xba, 0x63, 0xa1, 0x0e, 0x52, 0xa5, 0x7e, 0x1b,
+	0x86, 0x33, 0x9e, 0xcc, 0xd1, 0x1a, 0x4c, 0x0c, 0x65, 0x0c, 0xde, 0x4e, 0xc6, 0x71, 0x2d, 0x50,
+	0x6f, 0x01, 0x7e, 0x6b, 0x52, 0xbd, 0x94, 0xb0, 0x96, 0xcd, 0xe7, 0x3e, 0xae, 0x9e, 0x1c, 0xd7,
+	0x6d, 0xd9, 0x75, 0xa1, 0x1f, 0xc1, 0x0f, 0x87, 0x31, 0x81, 0x70, 0xbc, 0xa8, 0x30, 0x8f, 0x1a,
+	0xa6, 0xf4, 0xab, 0xe4, 0xc5, 0xc9, 0x93, 0xa2, 0xf1, 0x62, 0x22, 0x3c, 0x66, 0x5c, 0x2b, 0x5e,
+	0x40, 0x0e, 0x0e, 0xac, 0xb4, 0xe2, 0x90, 0x2a, 0x31, 0xda, 0xc4, 0x1b, 0xd9, 0x79, 0xf5, 0xc4,
+	0xc0, 0xa3, 0xfa, 0x0e, 0xf4, 0x5d, 0x38, 0x34, 0x56, 0x9a, 0x06, 0xa5, 0x86, 0xa9, 0x7b, 0x83,
+	0xa8, 0x26, 0x07, 0x8e, 0x2d, 0xcf, 0xee, 0xce, 0x5f, 0xd5, 0x6a, 0xda, 0x0d, 0xc2, 0x88, 0xc6,
+	0xe7, 0x2f, 0xae, 0x0e, 0x0d, 0x48, 0x82, 0x51, 0xe2, 0x38, 0x96, 0xc3, 0xa7, 0x2e, 0xc1, 0xdb,
+	0xeb, 0x84, 0xc5, 0xfd, 0x90, 0xea, 0x99, 0x33, 0x05, 0x18, 0xe5, 0x8d, 0x47, 0x09, 0x18, 0x2d,
+	0xaf, 0x6f, 0xef, 0xfc, 0x3c, 0x29, 0xa0, 0x24, 0x9c, 0xdb, 0x56, 0x8b, 0x17, 0xcb, 0x5b, 0xa5,
+	0xed, 0xed, 0xd2, 0xc5, 0x8d, 0x24, 0x40, 0x73, 0x30, 0x7e, 0xa1, 0x74, 0xb1, 0x54, 0xfe, 0x72,
+	0x7d, 0x2d, 0x19, 0xca, 0x94, 0xe0, 0xfc, 0xa8, 0x72, 0x28, 0x5a, 0x85, 0x71, 0xbf, 0x7a, 0xee,
+	0xd1, 0xed, 0x6a, 0x64, 0xe9, 0x38, 0xa9, 0xa9, 0x03, 0x74, 0xe6, 0xaf, 0x00, 0x7e, 0xb0, 0x41,
+	0xd8, 0x98, 0xd7, 0x3d, 0x3a, 0x28, 0x7b, 0xbf, 0x57, 0xc1, 0x79, 0x08, 0x87, 0xb7, 0xfa, 0x91,
+	0x57, 0xc1, 0x05, 0x17, 0xb2, 0x85, 0x69, 0x5d, 0x89, 0x70, 0xb9, 0x26, 0x6a, 0x81, 0x21, 0xf3,
+	0x77, 0x00, 0xc5, 0x9f, 0x1a, 0x74, 0x8c, 0x29, 0x0d, 0xa8, 0x7e, 0x03, 0xd7, 0xef, 0x3b, 0x6f,
+	0xe0, 0x8f, 0x21, 0xf8, 0xed, 0x2f, 0xf8, 0xad, 0x76, 0x58, 0xb1, 0xbf, 0x84, 0x31, 0xbf, 0x27,
+	0x3e, 0xf3, 0x63, 0x1b, 0x78, 0x08, 0xdf, 0x20, 0x1c, 0x5d, 0x86, 0x73, 0x3a, 0x31, 0x2b, 0xd8,
+	0xb6, 0x2b, 0xee, 0xeb, 0x46, 0x0c, 0x71, 0x3d, 0x7c, 0x36, 0x99, 0xee, 0x48, 0x2a, 0xf9, 0x0d,
+	0x62, 0x16, 0x6d, 0x7b, 0x93, 0x74, 0xe8, 0xba, 0xc9, 0x9c, 0x8e, 0x0a, 0xf5, 0x81, 0x21, 0x75,
+	0x09, 0x2e, 0x4e, 0xb8, 0x51, 0x12, 0x86, 0xeb, 0xa4, 0xe3, 0x9d, 0x1b, 0xaa, 0xfb, 0x13, 0x7d,
+	0x1f, 0x46, 0xdb, 0xb8, 0xd1, 0x22, 0x7e, 0x95, 0x3e, 0x9a, 0x5c, 0x7a, 0x93, 0x74, 0xd6, 0xcd,
+	0x36, 0x69, 0x58, 0x36, 0x51, 0x3d, 0xe4, 0xb9, 0xd0, 0x2a, 0x28, 0xfc, 0x23, 0x0a, 0x93, 0x23,
+	0xed, 0xe1, 0xd4, 0xd0, 0x03, 0x00, 0x67, 0x3c, 0xaa, 0xe8, 0xcc, 0x1b, 0x6f, 0x21, 0x75, 0x6c,
+	0xf1, 0x32, 0x5f, 0xdf, 0xfa, 0xd7, 0x7f, 0x7e, 0x1f, 0xba, 0x94, 0xd9, 0x91, 0x31, 0x1d, 0x7b,
+	0x60, 0xcb, 0x37, 0xfc, 0x22, 0xe6, 0x0d, 0x8d, 0xe6, 0x27, 0x14, 0x35, 0xf1, 0x7d, 0x53, 0x0e,
+	0x1e, 0xe2, 0x32, 0x7f, 0x88, 0xcb, 0x7e, 0x28, 0x3d, 0x07, 0x56, 0x5c, 0xd6, 0xe1, 0x0d, 0xc2,
+	0xd0, 0x94, 0xfc, 0x0e, 0x1f, 0xb5, 0xd7, 0xf0, 0xad, 0x71, 0xbe, 0x5f, 0xa3, 0x2b, 0xd3, 0x7c,
+	0xdf, 0x81, 0xa7, 0x17, 0x3c, 0x7c, 0x52, 0xde, 0x44, 0x7f, 0x06, 0x30, 0xe2, 0x8e, 0x18, 0xca,
+	0x4e, 0xd2, 0x39, 0x6a, 0xf0, 0x52, 0x1f, 0x1f, 0x47, 0x9c, 0x66, 0x7e, 0xc1, 0x99, 0x6f, 0xa2,
+	0xd2, 0x34, 0xf3, 0xff, 0x93, 0x35, 0xfa, 0x1b, 0x80, 0x33, 0x6b, 0xc4, 0x3d, 0x5b, 0xd1, 0x9b,
+	0x9e, 0x49, 0xa9, 0x0f, 0xa6, 0xe6, 0x74, 0xdd, 0xfd, 0xfb, 0x91, 0xb9, 0xc2, 0xe9, 0xfd, 0x7a,
+	0xe5, 0x97, 0xef, 0x8d, 0xde, 0x40, 0x41, 0x2e, 0x48, 0xf9, 0x0b, 0x78, 0x7c, 0x20, 0x81, 0x27,
+	0x07, 0x12, 0x78, 0x7a, 0x20, 0x09, 0xcf, 0x0f, 0x24, 0xe1, 0xc5, 0x81, 0x24, 0xbc, 0x3c, 0x90,
+	0x84, 0x57, 0x07, 0x12, 0xd8, 0xef, 0x4a, 0xe0, 0x76, 0x57, 0x12, 0xee, 0x75, 0x25, 0x70, 0xbf,
+	0x2b, 0x09, 0x0f, 0xbb, 0x92, 0xf0, 0xa8, 0x2b, 0x09, 0x8f, 0xbb, 0x12, 0x78, 0xd2, 0x95, 0xc0,
+	0xd3, 0xae, 0x24, 0x3c, 0xef, 0x4a, 0xe0, 0x45, 0x57, 0x12, 0x5e, 0x76, 0x25, 0xf0, 0xaa, 0x2b,
+	0x09, 0xfb, 0x3d, 0x49, 0xb8, 0xdd, 0x93, 0xc0, 0x9d, 0x9e, 0x24, 0xfc, 0xa1, 0x27, 0x81, 0x3f,
+	0xf5, 0x24, 0xe1, 0x5e, 0x4f, 0x12, 0xee, 0xf7, 0x24, 0xf0, 0xb0, 0x27, 0x81, 0x47, 0x3d, 0x09,
+	0x5c, 0x92, 0x75, 0x2b, 0xcf, 0xae, 0x12, 0x76, 0xd5, 0x30, 0x75, 0x9a, 0x37, 0x09, 0xbb, 0x66,
+	0x39, 0x75, 0x79, 0xfc, 0xef, 0x4d, 0xfb, 0xac, 0x6c, 0xd7, 0x75, 0x99, 0x31, 0xd3, 0xde, 0xdd,
+	0x9d, 0xe1, 0x75, 0x39, 0xfb, 0xbf, 0x01, 0x00, 0xec, 0xa6, 0x32, 0x4d, 0x80, 0x0e, 0x00, 0x00,
+}
+
+func (x FUOTASession_State) String() string {
+	s, ok := FUOTASession_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *FUOTASessionIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FUOTASessionIdentifiers)
+	if !ok {
+		that2, ok := that.(FUOTASessionIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	return true
+}
+func (this *FUOTASession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FUOTASession)
+	if !ok {
+		that2, ok := that.(FUOTASession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FUOTASessionIdentifiers.Equal(&that1.FUOTASessionIdentifiers) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(that1.Members[i]) {
+			return false
+		}
+	}
+	if this.MulticastDeviceId != that1.MulticastDeviceId {
+		return false
+	}
+	if this.MulticastGroupId != that1.MulticastGroupId {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if that1.StartAt == nil {
+		if this.StartAt != nil {
+			return false
+		}
+	} else if !this.StartAt.Equal(*that1.StartAt) {
+		return false
+	}
+	if this.SessionTimeout != that1.SessionTimeout {
+		return false
+	}
+	if this.FragmentationIndex != that1.FragmentationIndex {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.FragmentSize != that1.FragmentSize {
+		return false
+	}
+	if this.Redundancy != that1.Redundancy {
+		return false
+	}
+	if this.DataDescriptor != that1.DataDescriptor {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if len(this.Gateways) != len(that1.Gateways) {
+		return false
+	}
+	for i := range this.Gateways {
+		if !this.Gateways[i].Equal(&that1.Gateways[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FUOTASession_Member) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FUOTASession_Member)
+	if !ok {
+		that2, ok := that.(FUOTASession_Member)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeviceId != that1.DeviceId {
+		return false
+	}
+	if this.MulticastGroupSetup != that1.MulticastGroupSetup {
+		return false
+	}
+	if this.MulticastSessionSetup != that1.MulticastSessionSetup {
+		return false
+	}
+	if this.FragmentationSetup != that1.FragmentationSetup {
+		return false
+	}
+	if this.FragmentsReceived != that1.FragmentsReceived {
+		return false
+	}
+	if this.FragmentsMissing != that1.FragmentsMissing {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *FUOTASessions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FUOTASessions)
+	if !ok {
+		that2, ok := that.(FUOTASessions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sessions) != len(that1.Sessions) {
+		return false
+	}
+	for i := range this.Sessions {
+		if !this.Sessions[i].Equal(that1.Sessions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetFUOTASessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetFUOTASessionRequest)
+	if !ok {
+		that2, ok := that.(GetFUOTASessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FUOTASessionIdentifiers.Equal(&that1.FUOTASessionIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ListFUOTASessionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListFUOTASessionsRequest)
+	if !ok {
+		that2, ok := that.(ListFUOTASessionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *CreateFUOTASessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateFUOTASessionRequest)
+	if !ok {
+		that2, ok := that.(CreateFUOTASessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FUOTASession.Equal(&that1.FUOTASession) {
+		return false
+	}
+	if len(this.GenAppKeys) != len(that1.GenAppKeys) {
+		return false
+	}
+	for i := range this.GenAppKeys {
+		if !this.GenAppKeys[i].Equal(that1.GenAppKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationFUOTAClient is the client API for ApplicationFUOTA service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationFUOTAClient interface {
+	// Create a FUOTA session. This creates the multicast end device of the session and sends the multicast
+	// and fragmentation session setup requests to the members.
+	Create(ctx context.Context, in *CreateFUOTASessionRequest, opts ...grpc.CallOption) (*FUOTASession, error)
+	Get(ctx context.Context, in *GetFUOTASessionRequest, opts ...grpc.CallOption) (*FUOTASession, error)
+	List(ctx context.Context, in *ListFUOTASessionsRequest, opts ...grpc.CallOption) (*FUOTASessions, error)
+	// Delete a FUOTA session. This deletes the multicast end device of the session.
+	Delete(ctx context.Context, in *FUOTASessionIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type applicationFUOTAClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationFUOTAClient(cc *grpc.ClientConn) ApplicationFUOTAClient {
+	return &applicationFUOTAClient{cc}
+}
+
+func (c *applicationFUOTAClient) Create(ctx context.Context, in *CreateFUOTASessionRequest, opts ...grpc.CallOption) (*FUOTASession, error) {
+	out := new(FUOTASession)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFUOTA/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationFUOTAClient) Get(ctx context.Context, in *GetFUOTASessionRequest, opts ...grpc.CallOption) (*FUOTASession, error) {
+	out := new(FUOTASession)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFUOTA/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationFUOTAClient) List(ctx context.Context, in *ListFUOTASessionsRequest, opts ...grpc.CallOption) (*FUOTASessions, error) {
+	out := new(FUOTASessions)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFUOTA/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationFUOTAClient) Delete(ctx context.Context, in *FUOTASessionIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFUOTA/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationFUOTAServer is the server API for ApplicationFUOTA service.
+type ApplicationFUOTAServer interface {
+	// Create a FUOTA session. This creates the multicast end device of the session and sends the multicast
+	// and fragmentation session setup requests to the members.
+	Create(context.Context, *CreateFUOTASessionRequest) (*FUOTASession, error)
+	Get(context.Context, *GetFUOTASessionRequest) (*FUOTASession, error)
+	List(context.Context, *ListFUOTASessionsRequest) (*FUOTASessions, error)
+	// Delete a FUOTA session. This deletes the multicast end device of the session.
+	Delete(context.Context, *FUOTASessionIdentifiers) (*types.Empty, error)
+}
+
+// UnimplementedApplicationFUOTAServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationFUOTAServer struct {
+}
+
+func (*UnimplementedApplicationFUOTAServer) Create(ctx context.Context, req *CreateFUOTASessionRequest) (*FUOTASession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedApplicationFUOTAServer) Get(ctx context.Context, req *GetFUOTASessionRequest) (*FUOTASession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedApplicationFUOTAServer) List(ctx context.Context, req *ListFUOTASessionsRequest) (*FUOTASessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedApplicationFUOTAServer) Delete(ctx context.Context, req *FUOTASessionIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterApplicationFUOTAServer(s *grpc.Server, srv ApplicationFUOTAServer) {
+	s.RegisterService(&_ApplicationFUOTA_serviceDesc, srv)
+}
+
+func _ApplicationFUOTA_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFUOTASessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFUOTAServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFUOTA/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFUOTAServer).Create(ctx, req.(*CreateFUOTASessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationFUOTA_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFUOTASessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFUOTAServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFUOTA/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFUOTAServer).Get(ctx, req.(*GetFUOTASessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationFUOTA_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTASessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFUOTAServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFUOTA/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFUOTAServer).List(ctx, req.(*ListFUOTASessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationFUOTA_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FUOTASessionIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFUOTAServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFUOTA/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFUOTAServer).Delete(ctx, req.(*FUOTASessionIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationFUOTA_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationFUOTA",
+	HandlerType: (*ApplicationFUOTAServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ApplicationFUOTA_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApplicationFUOTA_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ApplicationFUOTA_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ApplicationFUOTA_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_packages_fuota.proto",
+}
+
+func (m *FUOTASessionIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FUOTASessionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FUOTASessionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FUOTASession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FUOTASession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FUOTASession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for iNdEx := len(m.Gateways) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gateways[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.State != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DataDescriptor != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], m.DataDescriptor)
+		i--
+		dAtA[i] = 0x7d
+	}
+	if m.Redundancy != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.Redundancy))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FragmentSize != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.FragmentSize))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.FragmentationIndex != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.FragmentationIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SessionTimeout != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.SessionTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MulticastGroupId != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.MulticastGroupId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MulticastDeviceId) > 0 {
+		i -= len(m.MulticastDeviceId)
+		copy(dAtA[i:], m.MulticastDeviceId)
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(m.MulticastDeviceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FUOTASessionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FUOTASession_Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FUOTASession_Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FUOTASession_Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FragmentsMissing != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.FragmentsMissing))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FragmentsReceived != 0 {
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(m.FragmentsReceived))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FragmentationSetup {
+		i--
+		if m.FragmentationSetup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MulticastSessionSetup {
+		i--
+		if m.MulticastSessionSetup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MulticastGroupSetup {
+		i--
+		if m.MulticastGroupSetup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FUOTASessions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FUOTASessions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FUOTASessions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFUOTASessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFUOTASessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFUOTASessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FUOTASessionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFUOTASessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFUOTASessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFUOTASessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateFUOTASessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFUOTASessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFUOTASessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenAppKeys) > 0 {
+		for k := range m.GenAppKeys {
+			v := m.GenAppKeys[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.FUOTASession.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackagesFuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverPackagesFuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverPackagesFuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedFUOTASessionIdentifiers(r randyApplicationserverPackagesFuota, easy bool) *FUOTASessionIdentifiers {
+	this := &FUOTASessionIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.SessionID = randStringApplicationserverPackagesFuota(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFUOTASession(r randyApplicationserverPackagesFuota, easy bool) *FUOTASession {
+	this := &FUOTASession{}
+	v2 := NewPopulatedFUOTASessionIdentifiers(r, easy)
+	this.FUOTASessionIdentifiers = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v3
+	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v4
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.Members = make([]*FUOTASession_Member, v5)
+		for i := 0; i < v5; i++ {
+			this.Members[i] = NewPopulatedFUOTASession_Member(r, easy)
+		}
+	}
+	this.MulticastDeviceId = randStringApplicationserverPackagesFuota(r)
+	this.MulticastGroupId = r.Uint32()
+	this.Frequency = uint64(r.Uint32())
+	this.DataRateIndex = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.StartAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.SessionTimeout = r.Uint32()
+	this.FragmentationIndex = r.Uint32()
+	v6 := r.Intn(100)
+	this.Data = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	this.FragmentSize = r.Uint32()
+	this.Redundancy = r.Uint32()
+	this.DataDescriptor = r.Uint32()
+	this.State = FUOTASession_State([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.Gateways = make([]GatewayAntennaIdentifiers, v7)
+		for i := 0; i < v7; i++ {
+			v8 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+			this.Gateways[i] = *v8
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFUOTASession_Member(r randyApplicationserverPackagesFuota, easy bool) *FUOTASession_Member {
+	this := &FUOTASession_Member{}
+	this.DeviceId = randStringApplicationserverPackagesFuota(r)
+	this.MulticastGroupSetup = bool(r.Intn(2) == 0)
+	this.MulticastSessionSetup = bool(r.Intn(2) == 0)
+	this.FragmentationSetup = bool(r.Intn(2) == 0)
+	this.FragmentsReceived = r.Uint32()
+	this.FragmentsMissing = r.Uint32()
+	this.Completed = bool(r.Intn(2) == 0)
+	this.Error = randStringApplicationserverPackagesFuota(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFUOTASessions(r randyApplicationserverPackagesFuota, easy bool) *FUOTASessions {
+	this := &FUOTASessions{}
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.Sessions = make([]*FUOTASession, v9)
+		for i := 0; i < v9; i++ {
+			this.Sessions[i] = NewPopulatedFUOTASession(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetFUOTASessionRequest(r randyApplicationserverPackagesFuota, easy bool) *GetFUOTASessionRequest {
+	this := &GetFUOTASessionRequest{}
+	v10 := NewPopulatedFUOTASessionIdentifiers(r, easy)
+	this.FUOTASessionIdentifiers = *v10
+	v11 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v11
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListFUOTASessionsRequest(r randyApplicationserverPackagesFuota, easy bool) *ListFUOTASessionsRequest {
+	this := &ListFUOTASessionsRequest{}
+	v12 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v12
+	v13 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v13
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateFUOTASessionRequest(r randyApplicationserverPackagesFuota, easy bool) *CreateFUOTASessionRequest {
+	this := &CreateFUOTASessionRequest{}
+	v14 := NewPopulatedFUOTASession(r, easy)
+	this.FUOTASession = *v14
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(10)
+		this.GenAppKeys = make(map[string]*KeyEnvelope)
+		for i := 0; i < v15; i++ {
+			this.GenAppKeys[randStringApplicationserverPackagesFuota(r)] = NewPopulatedKeyEnvelope(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserverPackagesFuota interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserverPackagesFuota(r randyApplicationserverPackagesFuota) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserverPackagesFuota(r randyApplicationserverPackagesFuota) string {
+	v16 := r.Intn(100)
+	tmps := make([]rune, v16)
+	for i := 0; i < v16; i++ {
+		tmps[i] = randUTF8RuneApplicationserverPackagesFuota(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplicationserverPackagesFuota(r randyApplicationserverPackagesFuota, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplicationserverPackagesFuota(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplicationserverPackagesFuota(dAtA []byte, r randyApplicationserverPackagesFuota, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(key))
+		v17 := r.Int63()
+		if r.Intn(2) == 0 {
+			v17 *= -1
+		}
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(v17))
+	case 1:
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplicationserverPackagesFuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserverPackagesFuota(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *FUOTASessionIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	return n
+}
+
+func (m *FUOTASession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FUOTASessionIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+		}
+	}
+	l = len(m.MulticastDeviceId)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	if m.MulticastGroupId != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.MulticastGroupId))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.DataRateIndex))
+	}
+	if m.StartAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartAt)
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	if m.SessionTimeout != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.SessionTimeout))
+	}
+	if m.FragmentationIndex != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.FragmentationIndex))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	if m.FragmentSize != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.FragmentSize))
+	}
+	if m.Redundancy != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.Redundancy))
+	}
+	if m.DataDescriptor != 0 {
+		n += 5
+	}
+	if m.State != 0 {
+		n += 2 + sovApplicationserverPackagesFuota(uint64(m.State))
+	}
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 2 + l + sovApplicationserverPackagesFuota(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FUOTASession_Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	if m.MulticastGroupSetup {
+		n += 2
+	}
+	if m.MulticastSessionSetup {
+		n += 2
+	}
+	if m.FragmentationSetup {
+		n += 2
+	}
+	if m.FragmentsReceived != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.FragmentsReceived))
+	}
+	if m.FragmentsMissing != 0 {
+		n += 1 + sovApplicationserverPackagesFuota(uint64(m.FragmentsMissing))
+	}
+	if m.Completed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	}
+	return n
+}
+
+func (m *FUOTASessions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetFUOTASessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FUOTASessionIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	return n
+}
+
+func (m *ListFUOTASessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	return n
+}
+
+func (m *CreateFUOTASessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FUOTASession.Size()
+	n += 1 + l + sovApplicationserverPackagesFuota(uint64(l))
+	if len(m.GenAppKeys) > 0 {
+		for k, v := range m.GenAppKeys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovApplicationserverPackagesFuota(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovApplicationserverPackagesFuota(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovApplicationserverPackagesFuota(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovApplicationserverPackagesFuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserverPackagesFuota(x uint64) (n int) {
+	return sovApplicationserverPackagesFuota((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *FUOTASessionIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FUOTASessionIdentifiers{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FUOTASession) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMembers := "[]*FUOTASession_Member{"
+	for _, f := range this.Members {
+		repeatedStringForMembers += strings.Replace(fmt.Sprintf("%v", f), "FUOTASession_Member", "FUOTASession_Member", 1) + ","
+	}
+	repeatedStringForMembers += "}"
+	repeatedStringForGateways := "[]GatewayAntennaIdentifiers{"
+	for _, f := range this.Gateways {
+		repeatedStringForGateways += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForGateways += "}"
+	s := strings.Join([]string{`&FUOTASession{`,
+		`FUOTASessionIdentifiers:` + strings.Replace(strings.Replace(this.FUOTASessionIdentifiers.String(), "FUOTASessionIdentifiers", "FUOTASessionIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Members:` + repeatedStringForMembers + `,`,
+		`MulticastDeviceId:` + fmt.Sprintf("%v", this.MulticastDeviceId) + `,`,
+		`MulticastGroupId:` + fmt.Sprintf("%v", this.MulticastGroupId) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`StartAt:` + strings.Replace(fmt.Sprintf("%v", this.StartAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`SessionTimeout:` + fmt.Sprintf("%v", this.SessionTimeout) + `,`,
+		`FragmentationIndex:` + fmt.Sprintf("%v", this.FragmentationIndex) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`FragmentSize:` + fmt.Sprintf("%v", this.FragmentSize) + `,`,
+		`Redundancy:` + fmt.Sprintf("%v", this.Redundancy) + `,`,
+		`DataDescriptor:` + fmt.Sprintf("%v", this.DataDescriptor) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Gateways:` + repeatedStringForGateways + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FUOTASession_Member) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FUOTASession_Member{`,
+		`DeviceId:` + fmt.Sprintf("%v", this.DeviceId) + `,`,
+		`MulticastGroupSetup:` + fmt.Sprintf("%v", this.MulticastGroupSetup) + `,`,
+		`MulticastSessionSetup:` + fmt.Sprintf("%v", this.MulticastSessionSetup) + `,`,
+		`FragmentationSetup:` + fmt.Sprintf("%v", this.FragmentationSetup) + `,`,
+		`FragmentsReceived:` + fmt.Sprintf("%v", this.FragmentsReceived) + `,`,
+		`FragmentsMissing:` + fmt.Sprintf("%v", this.FragmentsMissing) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FUOTASessions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSessions := "[]*FUOTASession{"
+	for _, f := range this.Sessions {
+		repeatedStringForSessions += strings.Replace(f.String(), "FUOTASession", "FUOTASession", 1) + ","
+	}
+	repeatedStringForSessions += "}"
+	s := strings.Join([]string{`&FUOTASessions{`,
+		`Sessions:` + repeatedStringForSessions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetFUOTASessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetFUOTASessionRequest{`,
+		`FUOTASessionIdentifiers:` + strings.Replace(strings.Replace(this.FUOTASessionIdentifiers.String(), "FUOTASessionIdentifiers", "FUOTASessionIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFUOTASessionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListFUOTASessionsRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateFUOTASessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForGenAppKeys := make([]string, 0, len(this.GenAppKeys))
+	for k := range this.GenAppKeys {
+		keysForGenAppKeys = append(keysForGenAppKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGenAppKeys)
+	mapStringForGenAppKeys := "map[string]*KeyEnvelope{"
+	for _, k := range keysForGenAppKeys {
+		mapStringForGenAppKeys += fmt.Sprintf("%v: %v,", k, this.GenAppKeys[k])
+	}
+	mapStringForGenAppKeys += "}"
+	s := strings.Join([]string{`&CreateFUOTASessionRequest{`,
+		`FUOTASession:` + strings.Replace(strings.Replace(this.FUOTASession.String(), "FUOTASession", "FUOTASession", 1), `&`, ``, 1) + `,`,
+		`GenAppKeys:` + mapStringForGenAppKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApplicationserverPackagesFuota(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *FUOTASessionIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FUOTASessionIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FUOTASessionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FUOTASession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FUOTASession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FUOTASession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FUOTASessionIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FUOTASessionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &FUOTASession_Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MulticastDeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MulticastDeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MulticastGroupId", wireType)
+			}
+			m.MulticastGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MulticastGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartAt == nil {
+				m.StartAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTimeout", wireType)
+			}
+			m.SessionTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentationIndex", wireType)
+			}
+			m.FragmentationIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentationIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentSize", wireType)
+			}
+			m.FragmentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redundancy", wireType)
+			}
+			m.Redundancy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Redundancy |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDescriptor", wireType)
+			}
+			m.DataDescriptor = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataDescriptor = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= FUOTASession_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateways = append(m.Gateways, GatewayAntennaIdentifiers{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FUOTASession_Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MulticastGroupSetup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MulticastGroupSetup = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MulticastSessionSetup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MulticastSessionSetup = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentationSetup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FragmentationSetup = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentsReceived", wireType)
+			}
+			m.FragmentsReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentsReceived |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentsMissing", wireType)
+			}
+			m.FragmentsMissing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentsMissing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FUOTASessions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FUOTASessions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FUOTASessions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &FUOTASession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFUOTASessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFUOTASessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFUOTASessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FUOTASessionIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FUOTASessionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFUOTASessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFUOTASessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFUOTASessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateFUOTASessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateFUOTASessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateFUOTASessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FUOTASession", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FUOTASession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenAppKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenAppKeys == nil {
+				m.GenAppKeys = make(map[string]*KeyEnvelope)
+			}
+			var mapkey string
+			var mapvalue *KeyEnvelope
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverPackagesFuota
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverPackagesFuota
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverPackagesFuota
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverPackagesFuota
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverPackagesFuota
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApplicationserverPackagesFuota
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthApplicationserverPackagesFuota
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &KeyEnvelope{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverPackagesFuota
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GenAppKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackagesFuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApplicationserverPackagesFuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApplicationserverPackagesFuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApplicationserverPackagesFuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApplicationserverPackagesFuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApplicationserverPackagesFuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApplicationserverPackagesFuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApplicationserverPackagesFuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApplicationserverPackagesFuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApplicationserverPackagesFuota = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+var FUOTASessionIdentifiersFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"session_id",
+}
+
+var FUOTASessionIdentifiersFieldPathsTopLevel = []string{
+	"application_ids",
+	"session_id",
+}
+
+var FUOTASessionFieldPathsNested = []string{
+	"created_at",
+	"data",
+	"data_descriptor",
+	"data_rate_index",
+	"fragment_size",
+	"fragmentation_index",
+	"frequency",
+	"gateways",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.session_id",
+	"members",
+	"multicast_device_id",
+	"multicast_group_id",
+	"redundancy",
+	"session_timeout",
+	"start_at",
+	"state",
+	"updated_at",
+}
+
+var FUOTASessionFieldPathsTopLevel = []string{
+	"created_at",
+	"data",
+	"data_descriptor",
+	"data_rate_index",
+	"fragment_size",
+	"fragmentation_index",
+	"frequency",
+	"gateways",
+	"ids",
+	"members",
+	"multicast_device_id",
+	"multicast_group_id",
+	"redundancy",
+	"session_timeout",
+	"start_at",
+	"state",
+	"updated_at",
+}
+
+var FUOTASessionsFieldPathsNested = []string{
+	"sessions",
+}
+
+var FUOTASessionsFieldPathsTopLevel = []string{
+	"sessions",
+}
+
+var GetFUOTASessionRequestFieldPathsNested = []string{
+	"field_mask",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.session_id",
+}
+
+var GetFUOTASessionRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"ids",
+}
+
+var ListFUOTASessionsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"field_mask",
+}
+
+var ListFUOTASessionsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"field_mask",
+}
+
+var CreateFUOTASessionRequestFieldPathsNested = []string{
+	"gen_app_keys",
+	"session",
+	"session.created_at",
+	"session.data",
+	"session.data_descriptor",
+	"session.data_rate_index",
+	"session.fragment_size",
+	"session.fragmentation_index",
+	"session.frequency",
+	"session.gateways",
+	"session.ids",
+	"session.ids.application_ids",
+	"session.ids.application_ids.application_id",
+	"session.ids.session_id",
+	"session.members",
+	"session.multicast_device_id",
+	"session.multicast_group_id",
+	"session.redundancy",
+	"session.session_timeout",
+	"session.start_at",
+	"session.state",
+	"session.updated_at",
+}
+
+var CreateFUOTASessionRequestFieldPathsTopLevel = []string{
+	"gen_app_keys",
+	"session",
+}
+
+var FUOTASession_MemberFieldPathsNested = []string{
+	"completed",
+	"device_id",
+	"error",
+	"fragmentation_setup",
+	"fragments_missing",
+	"fragments_received",
+	"multicast_group_setup",
+	"multicast_session_setup",
+}
+
+var FUOTASession_MemberFieldPathsTopLevel = []string{
+	"completed",
+	"device_id",
+	"error",
+	"fragmentation_setup",
+	"fragments_missing",
+	"fragments_received",
+	"multicast_group_setup",
+	"multicast_session_setup",
+}
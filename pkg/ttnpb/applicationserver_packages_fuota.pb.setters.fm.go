@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	fmt "fmt"
+	time "time"
+
+	types "github.com/gogo/protobuf/types"
+)
+
+func (dst *FUOTASessionIdentifiers) SetFields(src *FUOTASessionIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "session_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionID = src.SessionID
+			} else {
+				var zero string
+				dst.SessionID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FUOTASession) SetFields(src *FUOTASession, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *FUOTASessionIdentifiers
+				if src != nil {
+					newSrc = &src.FUOTASessionIdentifiers
+				}
+				newDst = &dst.FUOTASessionIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.FUOTASessionIdentifiers = src.FUOTASessionIdentifiers
+				} else {
+					var zero FUOTASessionIdentifiers
+					dst.FUOTASessionIdentifiers = zero
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+		case "members":
+			if len(subs) > 0 {
+				return fmt.Errorf("'members' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Members = src.Members
+			} else {
+				dst.Members = nil
+			}
+		case "multicast_device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'multicast_device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MulticastDeviceId = src.MulticastDeviceId
+			} else {
+				var zero string
+				dst.MulticastDeviceId = zero
+			}
+		case "multicast_group_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'multicast_group_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MulticastGroupId = src.MulticastGroupId
+			} else {
+				var zero uint32
+				dst.MulticastGroupId = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero uint32
+				dst.DataRateIndex = zero
+			}
+		case "start_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartAt = src.StartAt
+			} else {
+				dst.StartAt = nil
+			}
+		case "session_timeout":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_timeout' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionTimeout = src.SessionTimeout
+			} else {
+				var zero uint32
+				dst.SessionTimeout = zero
+			}
+		case "fragmentation_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fragmentation_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FragmentationIndex = src.FragmentationIndex
+			} else {
+				var zero uint32
+				dst.FragmentationIndex = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+		case "fragment_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fragment_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FragmentSize = src.FragmentSize
+			} else {
+				var zero uint32
+				dst.FragmentSize = zero
+			}
+		case "redundancy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'redundancy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Redundancy = src.Redundancy
+			} else {
+				var zero uint32
+				dst.Redundancy = zero
+			}
+		case "data_descriptor":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_descriptor' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataDescriptor = src.DataDescriptor
+			} else {
+				var zero uint32
+				dst.DataDescriptor = zero
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero FUOTASession_State
+				dst.State = zero
+			}
+		case "gateways":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateways' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Gateways = src.Gateways
+			} else {
+				dst.Gateways = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FUOTASessions) SetFields(src *FUOTASessions, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "sessions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sessions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Sessions = src.Sessions
+			} else {
+				dst.Sessions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetFUOTASessionRequest) SetFields(src *GetFUOTASessionRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *FUOTASessionIdentifiers
+				if src != nil {
+					newSrc = &src.FUOTASessionIdentifiers
+				}
+				newDst = &dst.FUOTASessionIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.FUOTASessionIdentifiers = src.FUOTASessionIdentifiers
+				} else {
+					var zero FUOTASessionIdentifiers
+					dst.FUOTASessionIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListFUOTASessionsRequest) SetFields(src *ListFUOTASessionsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateFUOTASessionRequest) SetFields(src *CreateFUOTASessionRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "session":
+			if len(subs) > 0 {
+				var newDst, newSrc *FUOTASession
+				if src != nil {
+					newSrc = &src.FUOTASession
+				}
+				newDst = &dst.FUOTASession
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.FUOTASession = src.FUOTASession
+				} else {
+					var zero FUOTASession
+					dst.FUOTASession = zero
+				}
+			}
+
+		case "gen_app_keys":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gen_app_keys' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GenAppKeys = src.GenAppKeys
+			} else {
+				dst.GenAppKeys = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FUOTASession_Member) SetFields(src *FUOTASession_Member, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "multicast_group_setup":
+			if len(subs) > 0 {
+				return fmt.Errorf("'multicast_group_setup' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MulticastGroupSetup = src.MulticastGroupSetup
+			} else {
+				var zero bool
+				dst.MulticastGroupSetup = zero
+			}
+		case "multicast_session_setup":
+			if len(subs) > 0 {
+				return fmt.Errorf("'multicast_session_setup' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MulticastSessionSetup = src.MulticastSessionSetup
+			} else {
+				var zero bool
+				dst.MulticastSessionSetup = zero
+			}
+		case "fragmentation_setup":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fragmentation_setup' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FragmentationSetup = src.FragmentationSetup
+			} else {
+				var zero bool
+				dst.FragmentationSetup = zero
+			}
+		case "fragments_received":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fragments_received' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FragmentsReceived = src.FragmentsReceived
+			} else {
+				var zero uint32
+				dst.FragmentsReceived = zero
+			}
+		case "fragments_missing":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fragments_missing' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FragmentsMissing = src.FragmentsMissing
+			} else {
+				var zero uint32
+				dst.FragmentsMissing = zero
+			}
+		case "completed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'completed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Completed = src.Completed
+			} else {
+				var zero bool
+				dst.Completed = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				var zero string
+				dst.Error = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,974 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = types.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _applicationserver_packages_fuota_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// ValidateFields checks the field values on FUOTASessionIdentifiers with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FUOTASessionIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FUOTASessionIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FUOTASessionIdentifiersValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "session_id":
+
+			if utf8.RuneCountInString(m.GetSessionID()) > 36 {
+				return FUOTASessionIdentifiersValidationError{
+					field:  "session_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_FUOTASessionIdentifiers_SessionID_Pattern.MatchString(m.GetSessionID()) {
+				return FUOTASessionIdentifiersValidationError{
+					field:  "session_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return FUOTASessionIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FUOTASessionIdentifiersValidationError is the validation error returned by
+// FUOTASessionIdentifiers.ValidateFields if the designated constraints aren't met.
+type FUOTASessionIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FUOTASessionIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FUOTASessionIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FUOTASessionIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FUOTASessionIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FUOTASessionIdentifiersValidationError) ErrorName() string {
+	return "FUOTASessionIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FUOTASessionIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFUOTASessionIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FUOTASessionIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FUOTASessionIdentifiersValidationError{}
+
+var _FUOTASessionIdentifiers_SessionID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on FUOTASession with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FUOTASession) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FUOTASessionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.FUOTASessionIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FUOTASessionValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FUOTASessionValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FUOTASessionValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "members":
+
+			if len(m.GetMembers()) < 1 {
+				return FUOTASessionValidationError{
+					field:  "members",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			if len(m.GetMembers()) > 256 {
+				return FUOTASessionValidationError{
+					field:  "members",
+					reason: "value must contain no more than 256 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetMembers() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return FUOTASessionValidationError{
+							field:  fmt.Sprintf("members[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "multicast_device_id":
+
+			if utf8.RuneCountInString(m.GetMulticastDeviceId()) > 36 {
+				return FUOTASessionValidationError{
+					field:  "multicast_device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_FUOTASession_MulticastDeviceId_Pattern.MatchString(m.GetMulticastDeviceId()) {
+				return FUOTASessionValidationError{
+					field:  "multicast_device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "multicast_group_id":
+
+			if m.GetMulticastGroupId() > 3 {
+				return FUOTASessionValidationError{
+					field:  "multicast_group_id",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "frequency":
+			// no validation rules for Frequency
+		case "data_rate_index":
+
+			if m.GetDataRateIndex() > 15 {
+				return FUOTASessionValidationError{
+					field:  "data_rate_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "start_at":
+
+			if v, ok := interface{}(m.GetStartAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FUOTASessionValidationError{
+						field:  "start_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "session_timeout":
+
+			if m.GetSessionTimeout() > 15 {
+				return FUOTASessionValidationError{
+					field:  "session_timeout",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "fragmentation_index":
+
+			if m.GetFragmentationIndex() > 3 {
+				return FUOTASessionValidationError{
+					field:  "fragmentation_index",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "data":
+
+			if len(m.GetData()) > 1048576 {
+				return FUOTASessionValidationError{
+					field:  "data",
+					reason: "value length must be at most 1048576 bytes",
+				}
+			}
+
+		case "fragment_size":
+
+			if val := m.GetFragmentSize(); val < 1 || val > 242 {
+				return FUOTASessionValidationError{
+					field:  "fragment_size",
+					reason: "value must be inside range [1, 242]",
+				}
+			}
+
+		case "redundancy":
+
+			if m.GetRedundancy() > 8192 {
+				return FUOTASessionValidationError{
+					field:  "redundancy",
+					reason: "value must be less than or equal to 8192",
+				}
+			}
+
+		case "data_descriptor":
+			// no validation rules for DataDescriptor
+		case "state":
+
+			if _, ok := FUOTASession_State_name[int32(m.GetState())]; !ok {
+				return FUOTASessionValidationError{
+					field:  "state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "gateways":
+
+			if len(m.Gateways) < 1 {
+				return FUOTASessionValidationError{
+					field:  "gateways",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			if len(m.Gateways) > 16 {
+				return FUOTASessionValidationError{
+					field:  "gateways",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.Gateways {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return FUOTASessionValidationError{
+							field:  fmt.Sprintf("gateways[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return FUOTASessionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FUOTASessionValidationError is the validation error returned by
+// FUOTASession.ValidateFields if the designated constraints aren't met.
+type FUOTASessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FUOTASessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FUOTASessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FUOTASessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FUOTASessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FUOTASessionValidationError) ErrorName() string {
+	return "FUOTASessionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FUOTASessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFUOTASession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FUOTASessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FUOTASessionValidationError{}
+
+var _FUOTASession_MulticastDeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on FUOTASessions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FUOTASessions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FUOTASessionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "sessions":
+
+			for idx, item := range m.GetSessions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return FUOTASessionsValidationError{
+							field:  fmt.Sprintf("sessions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return FUOTASessionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FUOTASessionsValidationError is the validation error returned by
+// FUOTASessions.ValidateFields if the designated constraints aren't met.
+type FUOTASessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FUOTASessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FUOTASessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FUOTASessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FUOTASessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FUOTASessionsValidationError) ErrorName() string {
+	return "FUOTASessionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FUOTASessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFUOTASessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FUOTASessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FUOTASessionsValidationError{}
+
+// ValidateFields checks the field values on GetFUOTASessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetFUOTASessionRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetFUOTASessionRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.FUOTASessionIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetFUOTASessionRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetFUOTASessionRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetFUOTASessionRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetFUOTASessionRequestValidationError is the validation error returned by
+// GetFUOTASessionRequest.ValidateFields if the designated constraints aren't met.
+type GetFUOTASessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFUOTASessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFUOTASessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFUOTASessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFUOTASessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFUOTASessionRequestValidationError) ErrorName() string {
+	return "GetFUOTASessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFUOTASessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFUOTASessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFUOTASessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFUOTASessionRequestValidationError{}
+
+// ValidateFields checks the field values on ListFUOTASessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListFUOTASessionsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListFUOTASessionsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListFUOTASessionsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListFUOTASessionsRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListFUOTASessionsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListFUOTASessionsRequestValidationError is the validation error returned by
+// ListFUOTASessionsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListFUOTASessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFUOTASessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFUOTASessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFUOTASessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFUOTASessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFUOTASessionsRequestValidationError) ErrorName() string {
+	return "ListFUOTASessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFUOTASessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFUOTASessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFUOTASessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFUOTASessionsRequestValidationError{}
+
+// ValidateFields checks the field values on CreateFUOTASessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateFUOTASessionRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateFUOTASessionRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "session":
+
+			if v, ok := interface{}(&m.FUOTASession).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateFUOTASessionRequestValidationError{
+						field:  "session",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gen_app_keys":
+
+			for key, val := range m.GetGenAppKeys() {
+				_ = val
+
+				// no validation rules for GenAppKeys[key]
+
+				if v, ok := interface{}(val).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return CreateFUOTASessionRequestValidationError{
+							field:  fmt.Sprintf("gen_app_keys[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return CreateFUOTASessionRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateFUOTASessionRequestValidationError is the validation error returned by
+// CreateFUOTASessionRequest.ValidateFields if the designated constraints
+// aren't met.
+type CreateFUOTASessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFUOTASessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFUOTASessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFUOTASessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFUOTASessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFUOTASessionRequestValidationError) ErrorName() string {
+	return "CreateFUOTASessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFUOTASessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFUOTASessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFUOTASessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFUOTASessionRequestValidationError{}
+
+// ValidateFields checks the field values on FUOTASession_Member with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FUOTASession_Member) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FUOTASession_MemberFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceId()) > 36 {
+				return FUOTASession_MemberValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_FUOTASession_Member_DeviceId_Pattern.MatchString(m.GetDeviceId()) {
+				return FUOTASession_MemberValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "multicast_group_setup":
+			// no validation rules for MulticastGroupSetup
+		case "multicast_session_setup":
+			// no validation rules for MulticastSessionSetup
+		case "fragmentation_setup":
+			// no validation rules for FragmentationSetup
+		case "fragments_received":
+			// no validation rules for FragmentsReceived
+		case "fragments_missing":
+			// no validation rules for FragmentsMissing
+		case "completed":
+			// no validation rules for Completed
+		case "error":
+
+			if utf8.RuneCountInString(m.GetError()) > 256 {
+				return FUOTASession_MemberValidationError{
+					field:  "error",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		default:
+			return FUOTASession_MemberValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FUOTASession_MemberValidationError is the validation error returned by
+// FUOTASession_Member.ValidateFields if the designated constraints aren't met.
+type FUOTASession_MemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FUOTASession_MemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FUOTASession_MemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FUOTASession_MemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FUOTASession_MemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FUOTASession_MemberValidationError) ErrorName() string {
+	return "FUOTASession_MemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FUOTASession_MemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFUOTASession_Member.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FUOTASession_MemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FUOTASession_MemberValidationError{}
+
+var _FUOTASession_Member_DeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
@@ -124,6 +124,8 @@ type ApplicationPubSub struct {
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
 	//	*ApplicationPubSub_AWSIoT
+	//	*ApplicationPubSub_AMQP
+	//	*ApplicationPubSub_Kafka
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
@@ -193,10 +195,18 @@ type ApplicationPubSub_MQTT struct {
 type ApplicationPubSub_AWSIoT struct {
 	AWSIoT *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,101,opt,name=aws_iot,json=awsIot,proto3,oneof" json:"aws_iot,omitempty"`
 }
+type ApplicationPubSub_AMQP struct {
+	AMQP *ApplicationPubSub_AMQPProvider `protobuf:"bytes,20,opt,name=amqp,proto3,oneof" json:"amqp,omitempty"`
+}
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,21,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
 
 func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_AWSIoT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_AMQP) isApplicationPubSub_Provider()   {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider()  {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
 	if m != nil {
@@ -247,6 +257,20 @@ func (m *ApplicationPubSub) GetAWSIoT() *ApplicationPubSub_AWSIoTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetAMQP() *ApplicationPubSub_AMQPProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_AMQP); ok {
+		return x.AMQP
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetBaseTopic() string {
 	if m != nil {
 		return m.BaseTopic
@@ -344,6 +368,8 @@ func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
 		(*ApplicationPubSub_AWSIoT)(nil),
+		(*ApplicationPubSub_AMQP)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
 	}
 }
 
@@ -809,6 +835,120 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) GetStackName() str
 	return ""
 }
 
+// The AMQP provider settings.
+type ApplicationPubSub_AMQPProvider struct {
+	// The server connection URL.
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	// The exchange to which the Application Server publishes the messages and binds the queues of the subscriptions.
+	// If empty, the `amq.topic` exchange is used.
+	Exchange             string   `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Reset()      { *m = ApplicationPubSub_AMQPProvider{} }
+func (*ApplicationPubSub_AMQPProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AMQPProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_AMQPProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_AMQPProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_AMQPProvider) GetServerURL() string {
+	if m != nil {
+		return m.ServerURL
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The addresses of the bootstrap brokers, in the form of `host:port`.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// The consumer group which the Application Server uses to consume the downlink queue operations.
+	// Application Server instances share the consumer group, so each operation is handled once.
+	ConsumerGroup        string   `protobuf:"bytes,2,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetConsumerGroup() string {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return ""
+}
+
 type ApplicationPubSub_Message struct {
 	// The topic on which the Application Server publishes or receives the messages.
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -819,7 +959,7 @@ type ApplicationPubSub_Message struct {
 func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+	return fileDescriptor_1dce56ec18597200, []int{1, 5}
 }
 func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,6 +1245,10 @@ func init() {
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole")
 	proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
+	proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
 	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
@@ -1129,152 +1273,162 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0x80, 0x39, 0xa2, 0x44, 0x89, 0x43, 0x4a, 0xa2, 0xa7, 0x49, 0xb3, 0x66, 0xe2, 0xa5, 0xcb,
-	0x08, 0x29, 0x25, 0x67, 0xc9, 0x98, 0x4a, 0x83, 0x94, 0x6e, 0x6b, 0x73, 0x25, 0x39, 0x56, 0xa2,
-	0xc8, 0xd2, 0x92, 0x86, 0x1b, 0x5b, 0xf6, 0x62, 0xc8, 0x1d, 0x51, 0x1b, 0x2d, 0x77, 0xd7, 0x3b,
-	0xb3, 0x92, 0x15, 0xdb, 0x80, 0x91, 0x93, 0xdb, 0x43, 0x61, 0xa0, 0x87, 0x06, 0xe8, 0xa1, 0x05,
-	0x8a, 0x22, 0x01, 0x7a, 0xf1, 0xad, 0xb9, 0x35, 0x40, 0x2f, 0x3e, 0x1a, 0x68, 0x0f, 0x39, 0xa9,
-	0x11, 0xd5, 0x43, 0x2e, 0x45, 0x73, 0xab, 0xa1, 0x02, 0x45, 0x31, 0xfb, 0x43, 0x52, 0xa2, 0x63,
-	0x99, 0x32, 0xda, 0x13, 0xdf, 0xec, 0x7b, 0xef, 0xdb, 0x37, 0x6f, 0x1e, 0xe7, 0xcd, 0x2c, 0x7c,
-	0xc3, 0xb0, 0x1c, 0xbc, 0x89, 0x4d, 0x89, 0x32, 0x5c, 0x5f, 0x2f, 0x60, 0x5b, 0x2f, 0x60, 0xdb,
-	0x36, 0xf4, 0x3a, 0x66, 0xba, 0x65, 0x52, 0xe2, 0x6c, 0x10, 0x47, 0xb5, 0xdd, 0x1a, 0x75, 0x6b,
-	0x79, 0xdb, 0xb1, 0x98, 0x85, 0xc6, 0x18, 0x33, 0xf3, 0x81, 0x57, 0x7e, 0x63, 0x3a, 0x5d, 0x6e,
-	0xe8, 0x6c, 0xcd, 0xad, 0xe5, 0xeb, 0x56, 0xb3, 0x40, 0xcc, 0x0d, 0x6b, 0xcb, 0x76, 0xac, 0x9b,
-	0x5b, 0x05, 0xcf, 0xb8, 0x2e, 0x35, 0x88, 0x29, 0x6d, 0x60, 0x43, 0xd7, 0x30, 0x23, 0x85, 0x1e,
-	0xc1, 0x47, 0xa6, 0xa5, 0x2e, 0x44, 0xc3, 0x6a, 0x58, 0xbe, 0x73, 0xcd, 0x5d, 0xf5, 0x46, 0xde,
-	0xc0, 0x93, 0x02, 0xf3, 0x57, 0x1a, 0x96, 0xd5, 0x30, 0x88, 0x1f, 0xac, 0x69, 0x5a, 0xcc, 0x8f,
-	0x35, 0xd0, 0x8a, 0x81, 0xb6, 0xcd, 0xd0, 0x5c, 0xc7, 0x33, 0x08, 0xf4, 0x2f, 0x1f, 0xd4, 0x93,
-	0xa6, 0xcd, 0xb6, 0x02, 0xe5, 0xc9, 0x83, 0xca, 0x55, 0x9d, 0x18, 0x9a, 0xda, 0xc4, 0x74, 0x3d,
-	0xb0, 0xc8, 0x1c, 0xb4, 0x60, 0x7a, 0x93, 0x50, 0x86, 0x9b, 0x76, 0x60, 0xf0, 0x6a, 0x6f, 0x46,
-	0x75, 0x8d, 0x98, 0x4c, 0x5f, 0xd5, 0x89, 0x13, 0x04, 0x99, 0xfd, 0x2b, 0x80, 0xaf, 0x94, 0x3b,
-	0x79, 0x5e, 0x72, 0x6b, 0x15, 0xb7, 0x36, 0xdf, 0x31, 0x43, 0x18, 0x8e, 0x77, 0xad, 0x83, 0xaa,
-	0x6b, 0x54, 0x00, 0x27, 0x41, 0x2e, 0x51, 0x7c, 0x2d, 0xbf, 0x3f, 0xff, 0xf9, 0x2e, 0x4c, 0x17,
-	0x40, 0x4e, 0xed, 0xc9, 0x43, 0x3f, 0x07, 0x03, 0x29, 0xf0, 0x70, 0x3b, 0x13, 0x79, 0xb4, 0x9d,
-	0x01, 0xca, 0x18, 0xee, 0xb6, 0xa4, 0x68, 0x19, 0x42, 0xdb, 0xad, 0xa9, 0xd4, 0xad, 0xa9, 0xba,
-	0x26, 0x0c, 0x9c, 0x04, 0xb9, 0xb8, 0x3c, 0xbd, 0x27, 0x4f, 0x38, 0x59, 0x61, 0xa2, 0x28, 0x5e,
-	0xbf, 0x8a, 0xa5, 0x8f, 0xde, 0x90, 0x7e, 0x78, 0x2d, 0x77, 0xb6, 0x74, 0x55, 0xba, 0x76, 0x36,
-	0x1c, 0x4e, 0xde, 0x2a, 0xbe, 0x7e, 0x67, 0xa2, 0xb5, 0x9d, 0x19, 0x09, 0x82, 0x9e, 0x55, 0x46,
-	0xec, 0x20, 0xfc, 0xec, 0x3f, 0x4e, 0xc0, 0x63, 0x3d, 0xd3, 0x42, 0x4b, 0x30, 0xda, 0x89, 0xff,
-	0xf5, 0xa7, 0xc4, 0xdf, 0x93, 0x86, 0x27, 0xcc, 0x82, 0xa3, 0xd0, 0x0c, 0x84, 0x75, 0x87, 0x60,
-	0x46, 0x34, 0x15, 0x33, 0x2f, 0xf4, 0x44, 0x31, 0x9d, 0xf7, 0x57, 0x26, 0x1f, 0xae, 0x4c, 0xbe,
-	0x1a, 0xae, 0x8c, 0x3c, 0xc2, 0xdd, 0xef, 0xff, 0x2d, 0x03, 0x94, 0x78, 0xe0, 0x57, 0x66, 0x1c,
-	0xe2, 0xda, 0x5a, 0x08, 0x89, 0xf6, 0x03, 0x09, 0xfc, 0xca, 0x0c, 0x9d, 0x85, 0xb1, 0x55, 0xcb,
-	0x69, 0x62, 0x26, 0x0c, 0x7a, 0x09, 0xfc, 0xbe, 0x9f, 0xc0, 0x17, 0x0e, 0x4b, 0xa0, 0x12, 0xb8,
-	0xa1, 0x45, 0x38, 0x68, 0x62, 0x46, 0x85, 0x63, 0xde, 0xfb, 0xf3, 0x87, 0x66, 0x27, 0xbf, 0x58,
-	0xae, 0x56, 0x96, 0x1c, 0x6b, 0x43, 0xd7, 0x88, 0x23, 0x8f, 0xb4, 0xb6, 0x33, 0x83, 0xfc, 0xc9,
-	0x85, 0x88, 0xe2, 0x71, 0x38, 0xaf, 0x79, 0x83, 0x31, 0xe1, 0xf8, 0xb3, 0xf2, 0xde, 0x5f, 0xae,
-	0x56, 0xf7, 0xf3, 0xf8, 0x13, 0xce, 0xe3, 0x1c, 0x74, 0x19, 0x0e, 0xe3, 0x4d, 0xaa, 0xea, 0x16,
-	0x13, 0x88, 0x87, 0x7c, 0xe3, 0x70, 0x64, 0xf9, 0x72, 0x65, 0xde, 0xea, 0x40, 0x61, 0x6b, 0x3b,
-	0x13, 0xf3, 0x9f, 0x5d, 0x88, 0x28, 0x31, 0xbc, 0x49, 0xe7, 0x2d, 0x86, 0x5e, 0x83, 0xb0, 0x86,
-	0x29, 0x51, 0x99, 0x65, 0xeb, 0x75, 0x21, 0xe6, 0x65, 0x6f, 0x78, 0x4f, 0x1e, 0x74, 0x06, 0x04,
-	0x4d, 0x89, 0x73, 0x55, 0x95, 0x6b, 0xd0, 0x22, 0x1c, 0xd5, 0xac, 0x4d, 0xd3, 0xd0, 0xcd, 0x75,
-	0xd5, 0x76, 0xe9, 0x9a, 0x30, 0xec, 0x85, 0x31, 0xf9, 0x0c, 0x33, 0x23, 0x94, 0xe2, 0x06, 0x51,
-	0x92, 0xa1, 0xff, 0x92, 0x4b, 0xd7, 0x50, 0x15, 0xa6, 0xda, 0x3c, 0x87, 0xd8, 0x06, 0xae, 0x13,
-	0x61, 0xa4, 0x5f, 0xe4, 0x78, 0x88, 0x50, 0x7c, 0x02, 0x5a, 0x82, 0x63, 0xae, 0xed, 0x31, 0x9b,
-	0xbe, 0x89, 0x10, 0xef, 0x97, 0x39, 0xea, 0x03, 0x82, 0x21, 0x7a, 0x17, 0x26, 0x3e, 0xb4, 0x74,
-	0x53, 0xc5, 0xf5, 0x3a, 0xb1, 0x99, 0x00, 0xfb, 0xc5, 0x41, 0xee, 0x5d, 0xf6, 0x9c, 0xd1, 0x02,
-	0x6c, 0xe7, 0x40, 0xc5, 0xf5, 0x75, 0x21, 0xd1, 0x2f, 0x2c, 0x11, 0xba, 0x97, 0xeb, 0xeb, 0xfb,
-	0x56, 0xc4, 0xe4, 0xb8, 0xe4, 0x91, 0x57, 0x64, 0x11, 0x1f, 0xe0, 0x51, 0x62, 0x32, 0x61, 0xf4,
-	0xc8, 0xbc, 0x0a, 0x31, 0x19, 0x52, 0x60, 0x7b, 0x79, 0xd4, 0x55, 0xac, 0x1b, 0x44, 0x13, 0xc6,
-	0xfa, 0x25, 0x8e, 0x85, 0x84, 0xf3, 0x1e, 0x60, 0x1f, 0xf3, 0x86, 0x4b, 0x5c, 0xa2, 0x09, 0xe3,
-	0x47, 0x66, 0x2e, 0x7b, 0x00, 0xd4, 0x80, 0xe9, 0xfd, 0x4c, 0x55, 0x37, 0xc3, 0xce, 0xa8, 0x09,
-	0xdf, 0xe9, 0x17, 0x2f, 0xec, 0xc3, 0xcf, 0x77, 0x50, 0x3c, 0x78, 0xc3, 0x0a, 0x3a, 0x09, 0xb5,
-	0x8c, 0x0d, 0xa2, 0x09, 0xa9, 0xbe, 0x83, 0x0f, 0x09, 0x15, 0x0f, 0xc0, 0x4b, 0x8a, 0x9f, 0x0e,
-	0xf4, 0x3a, 0x51, 0x35, 0xcc, 0xb0, 0x80, 0xfa, 0x2e, 0xa9, 0xc0, 0x7d, 0x16, 0x33, 0x9c, 0x9e,
-	0x85, 0xc9, 0xee, 0x7d, 0x0d, 0xbd, 0x09, 0x61, 0x70, 0xf6, 0x70, 0x1d, 0xc3, 0xeb, 0x1c, 0x71,
-	0xf9, 0xc5, 0x3d, 0x79, 0xc8, 0x89, 0xde, 0x03, 0xa0, 0xb5, 0x9d, 0x89, 0x57, 0x3c, 0xed, 0x25,
-	0x65, 0x41, 0x89, 0xfb, 0x86, 0x97, 0x1c, 0x23, 0xfd, 0x69, 0x0c, 0x26, 0xbb, 0xb7, 0xb3, 0xa3,
-	0x61, 0xd0, 0x04, 0x8c, 0xd7, 0x0d, 0x9d, 0x98, 0xac, 0xd3, 0x17, 0x83, 0x8d, 0xe9, 0x25, 0x65,
-	0xc4, 0xd7, 0xcc, 0x6b, 0xe8, 0x55, 0x38, 0xe2, 0x52, 0xe2, 0x98, 0xb8, 0x49, 0xbc, 0xe6, 0xd1,
-	0xb5, 0x7b, 0xb5, 0x15, 0xdc, 0xc8, 0xc6, 0x94, 0x6e, 0x5a, 0x8e, 0x16, 0x34, 0x88, 0x8e, 0x51,
-	0xa8, 0x40, 0x3a, 0x1c, 0xa5, 0x6e, 0x8d, 0xd6, 0x1d, 0xbd, 0x46, 0xd4, 0x1b, 0x16, 0x15, 0x86,
-	0x4e, 0x82, 0xdc, 0x58, 0xb1, 0xd8, 0xdf, 0xde, 0x9d, 0x5f, 0xb6, 0x2a, 0x72, 0xaa, 0xb5, 0x9d,
-	0x49, 0x56, 0x42, 0xd8, 0xb2, 0x55, 0x51, 0x92, 0xb4, 0x33, 0xa2, 0xa8, 0x0e, 0x13, 0xb6, 0x5b,
-	0x33, 0x74, 0xba, 0xe6, 0xbd, 0x28, 0x76, 0xe4, 0x17, 0x8d, 0xb5, 0xb6, 0x33, 0x70, 0xc9, 0x47,
-	0xf1, 0xd7, 0x40, 0x3b, 0x94, 0x29, 0x7a, 0x15, 0x0e, 0xbb, 0x7c, 0x63, 0x37, 0xa8, 0xb7, 0x57,
-	0x8f, 0xf8, 0x0d, 0xe0, 0x12, 0x25, 0xd5, 0x85, 0x8a, 0x12, 0x73, 0x29, 0xa9, 0x1a, 0x14, 0xe5,
-	0x60, 0x8c, 0x19, 0x54, 0xad, 0x63, 0x6f, 0xf3, 0x4d, 0xca, 0xc7, 0xf6, 0xe4, 0xa1, 0x8f, 0xa2,
-	0xc2, 0xdd, 0x73, 0xad, 0xed, 0xcc, 0x50, 0x75, 0xa1, 0x32, 0x53, 0x56, 0x86, 0x98, 0x41, 0x67,
-	0x30, 0x2a, 0xc3, 0x71, 0xcf, 0xd2, 0x5f, 0x92, 0x3a, 0x71, 0x98, 0xb7, 0xb7, 0x26, 0xe5, 0xe3,
-	0x5d, 0x2e, 0xa3, 0xdc, 0xc5, 0xb3, 0x98, 0x21, 0x0e, 0x53, 0x46, 0xb9, 0x6b, 0x7b, 0x88, 0x7e,
-	0x02, 0xc7, 0xba, 0x10, 0xeb, 0x64, 0xcb, 0xdb, 0x4e, 0x93, 0xb2, 0xd0, 0x45, 0x48, 0xb6, 0x09,
-	0xef, 0x91, 0x2d, 0x25, 0xd9, 0x06, 0xbc, 0x47, 0xb6, 0xd0, 0x25, 0x38, 0xbc, 0x46, 0xb0, 0x46,
-	0x1c, 0x2a, 0x24, 0x4e, 0x46, 0x73, 0x89, 0xe2, 0x99, 0x3e, 0x53, 0x76, 0xc1, 0xf7, 0x9e, 0x33,
-	0x99, 0xb3, 0xa5, 0x84, 0xac, 0x74, 0x09, 0x26, 0xbb, 0x15, 0x28, 0x05, 0xa3, 0x3c, 0x36, 0xaf,
-	0x4e, 0x15, 0x2e, 0xa2, 0x17, 0xe0, 0xd0, 0x06, 0x36, 0x5c, 0xe2, 0x97, 0xa1, 0xe2, 0x0f, 0x4a,
-	0x03, 0x6f, 0x83, 0xec, 0x8f, 0x60, 0x74, 0xd9, 0xaa, 0xa0, 0x14, 0x4c, 0x96, 0xab, 0xea, 0xfb,
-	0x17, 0x2b, 0x55, 0xf5, 0xe2, 0xe2, 0xcc, 0x5c, 0x2a, 0x82, 0x8e, 0xc1, 0xd1, 0x72, 0x55, 0x5d,
-	0x98, 0x2b, 0x87, 0x8f, 0x00, 0x37, 0x9a, 0xfb, 0x69, 0x79, 0xa6, 0xba, 0xf0, 0x81, 0xff, 0x64,
-	0x20, 0xfd, 0x33, 0x08, 0xc7, 0xf6, 0x77, 0x69, 0xf4, 0xeb, 0x01, 0x18, 0x73, 0x48, 0x43, 0xb7,
-	0xcc, 0xe0, 0x8f, 0xf2, 0xf1, 0xc0, 0x9e, 0xfc, 0x1f, 0xe0, 0xfc, 0x1b, 0x28, 0x10, 0xaf, 0x4a,
-	0xd4, 0x72, 0xd9, 0x9a, 0x74, 0x5a, 0x89, 0x63, 0x5b, 0x22, 0x98, 0x32, 0xe9, 0x34, 0x3f, 0x50,
-	0x4a, 0xa6, 0xe5, 0xb0, 0xb5, 0x27, 0x8e, 0x8b, 0x0a, 0xc4, 0x76, 0xdb, 0x6d, 0x2c, 0x94, 0xbb,
-	0x6c, 0x3b, 0xe3, 0xa2, 0x92, 0xac, 0x63, 0xa9, 0x4e, 0x4c, 0xe6, 0x60, 0x43, 0x3a, 0xad, 0x24,
-	0x89, 0xdb, 0x35, 0x82, 0xc4, 0xf5, 0xb9, 0x81, 0xdc, 0x0e, 0x85, 0xb8, 0xd2, 0x26, 0xf1, 0x70,
-	0x6d, 0xb1, 0xd8, 0x11, 0xa7, 0x15, 0xd8, 0x24, 0x1d, 0x63, 0x8a, 0xc3, 0xb8, 0xe3, 0x2e, 0xed,
-	0x11, 0x8b, 0x9e, 0x18, 0xd2, 0x42, 0xb1, 0xa8, 0x04, 0x29, 0x41, 0x1f, 0x40, 0xc8, 0x1b, 0x31,
-	0xa5, 0x5e, 0xf5, 0xf8, 0x27, 0xce, 0x52, 0xbf, 0x27, 0xa1, 0x7c, 0xd9, 0x43, 0xf0, 0xfa, 0x8a,
-	0xe3, 0x50, 0x44, 0x2b, 0x30, 0x81, 0x29, 0x75, 0x9b, 0x44, 0x75, 0x2c, 0x83, 0x04, 0x07, 0xd1,
-	0x33, 0xfd, 0xb3, 0x3d, 0x86, 0x62, 0x19, 0x44, 0x81, 0xb8, 0x2d, 0xa3, 0xdf, 0x01, 0x98, 0x22,
-	0xa6, 0x66, 0x5b, 0xba, 0xc9, 0x54, 0xac, 0x69, 0x0e, 0xa1, 0x34, 0xd8, 0x8a, 0x6e, 0xee, 0xc9,
-	0xae, 0x43, 0x85, 0xbb, 0xa0, 0x68, 0x5e, 0xcf, 0xe5, 0x72, 0xfc, 0x80, 0x5a, 0x96, 0xae, 0xf0,
-	0x33, 0xea, 0xed, 0x2e, 0xb9, 0x23, 0xae, 0x48, 0xd7, 0xa6, 0xba, 0x14, 0x93, 0x2b, 0xf9, 0xc9,
-	0xa9, 0xdc, 0xd5, 0xb2, 0x74, 0x25, 0x38, 0xd9, 0xde, 0xee, 0x92, 0x3b, 0xa2, 0xe7, 0xd5, 0x51,
-	0x4c, 0xde, 0x9e, 0x9c, 0x50, 0xc6, 0xc3, 0x88, 0xca, 0x7e, 0x40, 0x48, 0x85, 0xc3, 0x1a, 0x59,
-	0xc5, 0xae, 0xc1, 0xbc, 0xcd, 0x2f, 0x51, 0x9c, 0xe9, 0x7b, 0xfe, 0xb3, 0xbe, 0xff, 0xbc, 0xc9,
-	0x48, 0xc3, 0xbf, 0xf0, 0x5d, 0x88, 0x28, 0x21, 0x35, 0xfd, 0x47, 0x00, 0xe3, 0xed, 0xec, 0xa3,
-	0xf3, 0x70, 0xb4, 0xb3, 0x9a, 0x7c, 0x97, 0xf7, 0x2b, 0x3e, 0xbb, 0x27, 0xa7, 0x9c, 0xb1, 0x54,
-	0x8a, 0xa7, 0x64, 0xf8, 0xfa, 0xd5, 0x95, 0xcd, 0x6b, 0x53, 0xfc, 0xa6, 0x93, 0x68, 0x3b, 0xce,
-	0xcf, 0x2a, 0x89, 0xf6, 0xc2, 0xcd, 0x6b, 0x68, 0x1a, 0x1e, 0xa3, 0xa4, 0xee, 0x10, 0xa6, 0x1e,
-	0x28, 0x8e, 0xf6, 0x3e, 0x9f, 0x53, 0xc6, 0x7d, 0x8b, 0xce, 0xcb, 0x25, 0x38, 0x4a, 0x09, 0xa5,
-	0xbc, 0x19, 0x33, 0x6b, 0x9d, 0x98, 0x41, 0xf7, 0x18, 0xf1, 0xfa, 0x92, 0x70, 0x77, 0x40, 0x49,
-	0x06, 0xea, 0x2a, 0xd7, 0xa6, 0xff, 0x05, 0x20, 0xec, 0xac, 0x2d, 0x52, 0x60, 0x14, 0x3b, 0xe1,
-	0x5f, 0xf4, 0xdc, 0x9e, 0xfc, 0x96, 0xf3, 0x66, 0xb1, 0x78, 0x1d, 0x3b, 0x66, 0x09, 0x6f, 0xd2,
-	0x92, 0x8e, 0x9b, 0xa5, 0xd2, 0x55, 0x9e, 0xe7, 0x5b, 0xa7, 0x8b, 0x77, 0x4a, 0xbc, 0xa0, 0x56,
-	0x0a, 0x9d, 0xec, 0xab, 0xa7, 0x7e, 0xfc, 0x7a, 0xfe, 0x9c, 0x74, 0xed, 0x14, 0x9f, 0x56, 0xb4,
-	0xac, 0x2c, 0x2a, 0x1c, 0x86, 0xe6, 0x61, 0x82, 0xdc, 0x64, 0xbc, 0x65, 0x19, 0x9d, 0x96, 0x97,
-	0xdb, 0x93, 0x5f, 0x72, 0x5e, 0x14, 0x1e, 0xc6, 0x8b, 0x29, 0x9e, 0x0a, 0xcf, 0xb3, 0xb4, 0x52,
-	0x90, 0xfc, 0x9c, 0xc0, 0xb9, 0xc0, 0x61, 0x7e, 0x56, 0x81, 0xa1, 0xf3, 0xbc, 0x86, 0xde, 0x85,
-	0xa9, 0x70, 0x72, 0xe1, 0xbd, 0x3b, 0xa8, 0xe8, 0xe3, 0x3d, 0x57, 0xab, 0xd9, 0xc0, 0x40, 0x1e,
-	0xfc, 0x84, 0xdf, 0xaa, 0xc6, 0x03, 0xc7, 0xf0, 0x71, 0xfa, 0x32, 0x44, 0xbd, 0x8b, 0x8a, 0xca,
-	0x10, 0x7a, 0x37, 0x6b, 0xd5, 0xeb, 0xbc, 0xed, 0x85, 0xcb, 0x38, 0x27, 0xf8, 0xb2, 0x09, 0xd7,
-	0x83, 0xd9, 0x1e, 0xa8, 0xc0, 0x09, 0x25, 0xee, 0x79, 0x2d, 0xe2, 0x26, 0x91, 0x93, 0x10, 0x6a,
-	0xc4, 0x36, 0xac, 0xad, 0x26, 0x31, 0x59, 0x3a, 0x07, 0x87, 0xc3, 0x33, 0xf7, 0x09, 0x38, 0xe4,
-	0x5f, 0x47, 0xc0, 0xfe, 0x5e, 0xed, 0x3f, 0x95, 0xc7, 0xe1, 0x88, 0x1d, 0x6e, 0x97, 0xd1, 0xc7,
-	0x32, 0xc8, 0x2e, 0x43, 0xd4, 0x53, 0x97, 0x14, 0x9d, 0x81, 0xc3, 0xfe, 0x17, 0x13, 0x7e, 0xe7,
-	0xe5, 0xdd, 0xe2, 0x7b, 0x87, 0x16, 0xb3, 0x12, 0x7a, 0x64, 0x3f, 0x05, 0x50, 0xe8, 0x51, 0x9f,
-	0xf7, 0xee, 0x8a, 0x14, 0x5d, 0x84, 0xc3, 0xfe, 0xb5, 0x31, 0x24, 0xff, 0xe0, 0x50, 0x72, 0xe0,
-	0x9a, 0x0f, 0x7e, 0x83, 0x0e, 0x14, 0x50, 0x78, 0x07, 0xea, 0x56, 0xf4, 0xd5, 0x81, 0x1e, 0x00,
-	0xf8, 0xf2, 0x3b, 0x84, 0xf5, 0xce, 0x85, 0xdc, 0x70, 0x09, 0x65, 0xff, 0x83, 0x6b, 0xff, 0x59,
-	0x08, 0x3b, 0xdf, 0x63, 0xbe, 0xf5, 0xda, 0x7f, 0x9e, 0x9b, 0xbc, 0x8f, 0xe9, 0xba, 0x3c, 0xc8,
-	0xdd, 0x95, 0xf8, 0x6a, 0xf8, 0x20, 0xfb, 0x67, 0x00, 0x4f, 0x2c, 0xe8, 0xb4, 0x37, 0x66, 0x1a,
-	0x06, 0xfd, 0x7f, 0xf8, 0xee, 0xf2, 0xdc, 0xb3, 0xf8, 0x03, 0x80, 0x2f, 0x57, 0x9e, 0x92, 0xf8,
-	0xf7, 0x60, 0xcc, 0xaf, 0xa6, 0x20, 0xf4, 0xc3, 0xcb, 0xef, 0x09, 0x51, 0x07, 0x88, 0xe7, 0x8e,
-	0xb6, 0xf8, 0xa7, 0x18, 0x3c, 0xfe, 0x84, 0x50, 0x1b, 0x3a, 0xe5, 0x05, 0xf7, 0x21, 0x84, 0xef,
-	0x10, 0x16, 0xd6, 0xf7, 0x77, 0x7b, 0xc0, 0x73, 0x4d, 0x9b, 0x6d, 0xa5, 0x73, 0xcf, 0x5a, 0xe6,
-	0xd9, 0xf4, 0xc7, 0x7f, 0xf9, 0xfb, 0x2f, 0x07, 0x5e, 0x40, 0xa8, 0x80, 0x69, 0xc1, 0x9f, 0x82,
-	0x14, 0x14, 0x3b, 0xfa, 0x0d, 0x80, 0xd1, 0x77, 0x08, 0x43, 0xa7, 0x0e, 0xd2, 0x9e, 0x52, 0xc5,
-	0xe9, 0xc3, 0x93, 0x97, 0xbd, 0xe0, 0xbd, 0x53, 0x46, 0xe7, 0x3a, 0xef, 0x2c, 0xdc, 0xd2, 0x35,
-	0x9a, 0x3f, 0x50, 0x49, 0x07, 0xc6, 0x77, 0x7c, 0xa3, 0xce, 0x37, 0xb8, 0x3b, 0xe8, 0x17, 0x00,
-	0x0e, 0xf2, 0xfa, 0x44, 0xd2, 0xc1, 0xb7, 0x3e, 0xb5, 0x6a, 0xd3, 0xd9, 0x43, 0x83, 0xa4, 0xd9,
-	0x69, 0x2f, 0x4a, 0x09, 0x9d, 0xea, 0x8e, 0xf2, 0x90, 0x08, 0xd1, 0x3f, 0x01, 0x8c, 0x56, 0x9e,
-	0x94, 0xb2, 0xca, 0xf3, 0xa5, 0xec, 0x57, 0xc0, 0x8b, 0xe6, 0x3e, 0x48, 0x2f, 0x76, 0x87, 0x13,
-	0x7c, 0x68, 0x7e, 0xa6, 0xdc, 0x75, 0xd9, 0x76, 0xa5, 0xb0, 0x04, 0xa6, 0xae, 0x9c, 0xc9, 0xbe,
-	0x75, 0x34, 0x68, 0x09, 0x4c, 0xa1, 0xfb, 0x00, 0xc6, 0x66, 0x89, 0x41, 0x18, 0x41, 0x7d, 0xed,
-	0x59, 0xe9, 0x6f, 0xa9, 0xdd, 0xec, 0x39, 0x6f, 0xa6, 0xa5, 0xa9, 0xb7, 0xfb, 0xc8, 0xbb, 0x17,
-	0x74, 0x38, 0x25, 0xf9, 0xf7, 0xe0, 0xe1, 0x8e, 0x08, 0x1e, 0xed, 0x88, 0xe0, 0xcb, 0x1d, 0x31,
-	0xf2, 0xd5, 0x8e, 0x18, 0xf9, 0x7a, 0x47, 0x8c, 0x7c, 0xb3, 0x23, 0x46, 0x1e, 0xef, 0x88, 0xe0,
-	0x6e, 0x4b, 0x04, 0xf7, 0x5a, 0x62, 0xe4, 0xb3, 0x96, 0x08, 0x1e, 0xb4, 0xc4, 0xc8, 0xe7, 0x2d,
-	0x31, 0xf2, 0x45, 0x4b, 0x8c, 0x3c, 0x6c, 0x89, 0xe0, 0x51, 0x4b, 0x04, 0x5f, 0xb6, 0xc4, 0xc8,
-	0x57, 0x2d, 0x11, 0x7c, 0xdd, 0x12, 0x23, 0xdf, 0xb4, 0x44, 0xf0, 0xb8, 0x25, 0x46, 0xee, 0xee,
-	0x8a, 0x91, 0x7b, 0xbb, 0x22, 0xb8, 0xbf, 0x2b, 0x46, 0x3e, 0xd9, 0x15, 0xc1, 0x6f, 0x77, 0xc5,
-	0xc8, 0x67, 0xbb, 0x62, 0xe4, 0xc1, 0xae, 0x08, 0x3e, 0xdf, 0x15, 0xc1, 0x17, 0xbb, 0x22, 0xb8,
-	0x52, 0x68, 0x58, 0x79, 0xb6, 0x46, 0xd8, 0x9a, 0x6e, 0x36, 0x68, 0xde, 0x24, 0x6c, 0xd3, 0x72,
-	0xd6, 0x0b, 0xfb, 0xbf, 0x6e, 0x6f, 0x4c, 0x17, 0xec, 0xf5, 0x46, 0x81, 0x31, 0xd3, 0xae, 0xd5,
-	0x62, 0xde, 0xcc, 0xa7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xb3, 0xef, 0x5d, 0x54, 0x18,
-	0x00, 0x00,
+	// 2472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x99, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0x80, 0x39, 0xa2, 0x44, 0x89, 0x43, 0x4a, 0xa2, 0x27, 0x4e, 0xb3, 0xa6, 0x93, 0x95, 0xc3,
+	0x08, 0x29, 0xfd, 0xb3, 0x64, 0x4c, 0xa5, 0x41, 0x42, 0xb7, 0xb5, 0xb9, 0x92, 0x6c, 0x2b, 0x96,
+	0x15, 0x69, 0x49, 0x23, 0x8d, 0x2d, 0x7b, 0x31, 0xe4, 0x8e, 0xa8, 0x0d, 0x97, 0xbb, 0xeb, 0x9d,
+	0x59, 0xc9, 0x8a, 0x63, 0xc0, 0x08, 0x50, 0x34, 0xed, 0xa1, 0x30, 0xda, 0x43, 0x03, 0xf4, 0xd0,
+	0x02, 0x45, 0x91, 0x00, 0xbd, 0xe4, 0xd6, 0xdc, 0x1a, 0xa0, 0x17, 0x1f, 0x03, 0xb4, 0x87, 0x9c,
+	0xd4, 0x88, 0xea, 0x21, 0xb7, 0xe6, 0xd6, 0x40, 0x05, 0xda, 0x62, 0xf6, 0x87, 0x5c, 0x49, 0x8e,
+	0x65, 0x2a, 0x68, 0x4f, 0x9a, 0x99, 0xf7, 0xde, 0x37, 0x6f, 0xde, 0x3c, 0xce, 0x9b, 0x59, 0xc1,
+	0x97, 0x0c, 0xcb, 0xc1, 0xeb, 0xd8, 0x94, 0x28, 0xc3, 0x8d, 0x56, 0x11, 0xdb, 0x7a, 0x11, 0xdb,
+	0xb6, 0xa1, 0x37, 0x30, 0xd3, 0x2d, 0x93, 0x12, 0x67, 0x8d, 0x38, 0xaa, 0xed, 0xd6, 0xa9, 0x5b,
+	0x2f, 0xd8, 0x8e, 0xc5, 0x2c, 0x34, 0xc6, 0x98, 0x59, 0x08, 0xac, 0x0a, 0x6b, 0x53, 0xd9, 0x4a,
+	0x53, 0x67, 0xab, 0x6e, 0xbd, 0xd0, 0xb0, 0xda, 0x45, 0x62, 0xae, 0x59, 0x1b, 0xb6, 0x63, 0xdd,
+	0xd9, 0x28, 0x7a, 0xca, 0x0d, 0xa9, 0x49, 0x4c, 0x69, 0x0d, 0x1b, 0xba, 0x86, 0x19, 0x29, 0xee,
+	0x6b, 0xf8, 0xc8, 0xac, 0x14, 0x41, 0x34, 0xad, 0xa6, 0xe5, 0x1b, 0xd7, 0xdd, 0x15, 0xaf, 0xe7,
+	0x75, 0xbc, 0x56, 0xa0, 0xfe, 0x6c, 0xd3, 0xb2, 0x9a, 0x06, 0xf1, 0x9d, 0x35, 0x4d, 0x8b, 0xf9,
+	0xbe, 0x06, 0x52, 0x31, 0x90, 0x76, 0x19, 0x9a, 0xeb, 0x78, 0x0a, 0x81, 0xfc, 0xf8, 0x5e, 0x39,
+	0x69, 0xdb, 0x6c, 0x23, 0x10, 0x9e, 0xd8, 0x2b, 0x5c, 0xd1, 0x89, 0xa1, 0xa9, 0x6d, 0x4c, 0x5b,
+	0x81, 0xc6, 0xc4, 0x5e, 0x0d, 0xa6, 0xb7, 0x09, 0x65, 0xb8, 0x6d, 0x07, 0x0a, 0x2f, 0xec, 0x8f,
+	0xa8, 0xae, 0x11, 0x93, 0xe9, 0x2b, 0x3a, 0x71, 0x02, 0x27, 0x73, 0x7f, 0x05, 0xf0, 0xd9, 0x4a,
+	0x2f, 0xce, 0x8b, 0x6e, 0xbd, 0xea, 0xd6, 0xe7, 0x7a, 0x6a, 0x08, 0xc3, 0xf1, 0xc8, 0x3e, 0xa8,
+	0xba, 0x46, 0x05, 0x70, 0x02, 0xe4, 0x53, 0xa5, 0x17, 0x0b, 0xbb, 0xe3, 0x5f, 0x88, 0x60, 0x22,
+	0x00, 0x39, 0xb3, 0x23, 0x0f, 0xfd, 0x0c, 0x0c, 0x64, 0xc0, 0xc3, 0xcd, 0x89, 0xd8, 0x67, 0x9b,
+	0x13, 0x40, 0x19, 0xc3, 0x51, 0x4d, 0x8a, 0x96, 0x20, 0xb4, 0xdd, 0xba, 0x4a, 0xdd, 0xba, 0xaa,
+	0x6b, 0xc2, 0xc0, 0x09, 0x90, 0x4f, 0xca, 0x53, 0x3b, 0xf2, 0xa4, 0x93, 0x13, 0x26, 0x4b, 0xe2,
+	0xad, 0x1b, 0x58, 0x7a, 0xe7, 0x25, 0xe9, 0xb5, 0x9b, 0xf9, 0xf3, 0xe5, 0x1b, 0xd2, 0xcd, 0xf3,
+	0x61, 0xf7, 0xe4, 0xdd, 0xd2, 0x99, 0x7b, 0x93, 0x9d, 0xcd, 0x89, 0x91, 0xc0, 0xe9, 0x19, 0x65,
+	0xc4, 0x0e, 0xdc, 0xcf, 0xfd, 0xe4, 0x79, 0x78, 0x64, 0xdf, 0xb2, 0xd0, 0x22, 0x8c, 0xf7, 0xfc,
+	0x3f, 0xf3, 0x18, 0xff, 0xf7, 0x85, 0xe1, 0x11, 0xab, 0xe0, 0x28, 0x34, 0x0d, 0x61, 0xc3, 0x21,
+	0x98, 0x11, 0x4d, 0xc5, 0xcc, 0x73, 0x3d, 0x55, 0xca, 0x16, 0xfc, 0x9d, 0x29, 0x84, 0x3b, 0x53,
+	0xa8, 0x85, 0x3b, 0x23, 0x8f, 0x70, 0xf3, 0x07, 0x7f, 0x9b, 0x00, 0x4a, 0x32, 0xb0, 0xab, 0x30,
+	0x0e, 0x71, 0x6d, 0x2d, 0x84, 0xc4, 0xfb, 0x81, 0x04, 0x76, 0x15, 0x86, 0xce, 0xc3, 0xc4, 0x8a,
+	0xe5, 0xb4, 0x31, 0x13, 0x06, 0xbd, 0x00, 0x7e, 0xd7, 0x0f, 0xe0, 0xd1, 0x83, 0x02, 0xa8, 0x04,
+	0x66, 0x68, 0x01, 0x0e, 0x9a, 0x98, 0x51, 0xe1, 0x88, 0x37, 0x7f, 0xe1, 0xc0, 0xe8, 0x14, 0x16,
+	0x2a, 0xb5, 0xea, 0xa2, 0x63, 0xad, 0xe9, 0x1a, 0x71, 0xe4, 0x91, 0xce, 0xe6, 0xc4, 0x20, 0x1f,
+	0xb9, 0x1c, 0x53, 0x3c, 0x0e, 0xe7, 0xb5, 0x6f, 0x33, 0x26, 0x1c, 0x7b, 0x52, 0xde, 0xd5, 0xa5,
+	0x5a, 0x6d, 0x37, 0x8f, 0x8f, 0x70, 0x1e, 0xe7, 0xa0, 0x37, 0xe1, 0x30, 0x5e, 0xa7, 0xaa, 0x6e,
+	0x31, 0x81, 0x78, 0xc8, 0x97, 0x0e, 0x46, 0x56, 0xde, 0xac, 0xce, 0x59, 0x3d, 0x28, 0xec, 0x6c,
+	0x4e, 0x24, 0xfc, 0xb1, 0xcb, 0x31, 0x25, 0x81, 0xd7, 0xe9, 0x9c, 0xe5, 0x2d, 0x1c, 0xb7, 0x6f,
+	0xdb, 0xc2, 0xd1, 0x27, 0x75, 0xb4, 0x72, 0x75, 0x69, 0x71, 0xb7, 0xa3, 0x7c, 0x84, 0x3b, 0xca,
+	0x39, 0xe8, 0x12, 0x1c, 0x6a, 0xe1, 0x95, 0x16, 0x16, 0x9e, 0xf6, 0x80, 0xc5, 0x83, 0x81, 0x57,
+	0xb8, 0x7a, 0x48, 0xbc, 0x1c, 0x53, 0x7c, 0x7b, 0xf4, 0x22, 0x84, 0x75, 0x4c, 0x89, 0xca, 0x2c,
+	0x5b, 0x6f, 0x08, 0x09, 0x6f, 0x5b, 0x87, 0x77, 0xe4, 0x41, 0x67, 0x40, 0xd0, 0x94, 0x24, 0x17,
+	0xd5, 0xb8, 0x04, 0x2d, 0xc0, 0x51, 0xcd, 0x5a, 0x37, 0x0d, 0xdd, 0x6c, 0xa9, 0xb6, 0x4b, 0x57,
+	0x85, 0x61, 0x6f, 0xe2, 0x93, 0x4f, 0x10, 0x72, 0x42, 0x29, 0x6e, 0x12, 0x25, 0x1d, 0xda, 0x2f,
+	0xba, 0x74, 0x15, 0xd5, 0x60, 0xa6, 0xcb, 0x73, 0x88, 0x6d, 0xe0, 0x06, 0x11, 0x46, 0xfa, 0x45,
+	0x8e, 0x87, 0x08, 0xc5, 0x27, 0xa0, 0x45, 0x38, 0xe6, 0xda, 0x1e, 0xb3, 0xed, 0xab, 0x08, 0xc9,
+	0x7e, 0x99, 0xa3, 0x3e, 0x20, 0xe8, 0xa2, 0xd7, 0x61, 0xea, 0x6d, 0x4b, 0x37, 0x55, 0xdc, 0x68,
+	0x10, 0x9b, 0x09, 0xb0, 0x5f, 0x1c, 0xe4, 0xd6, 0x15, 0xcf, 0x18, 0xcd, 0xc3, 0x6e, 0x0c, 0x54,
+	0xdc, 0x68, 0x09, 0xa9, 0x7e, 0x61, 0xa9, 0xd0, 0xbc, 0xd2, 0x68, 0xed, 0xda, 0x11, 0x93, 0xe3,
+	0xd2, 0x87, 0xde, 0x91, 0x05, 0xbc, 0x87, 0x47, 0x89, 0xc9, 0x84, 0xd1, 0x43, 0xf3, 0xaa, 0xc4,
+	0x64, 0x48, 0x81, 0xdd, 0xed, 0x51, 0x57, 0xb0, 0x6e, 0x10, 0x4d, 0x18, 0xeb, 0x97, 0x38, 0x16,
+	0x12, 0x2e, 0x7a, 0x80, 0x5d, 0xcc, 0xdb, 0x2e, 0x71, 0x89, 0x26, 0x8c, 0x1f, 0x9a, 0xb9, 0xe4,
+	0x01, 0x50, 0x13, 0x66, 0x77, 0x33, 0x55, 0xdd, 0x0c, 0x4b, 0xb6, 0x26, 0x3c, 0xd5, 0x2f, 0x5e,
+	0xd8, 0x85, 0x9f, 0xeb, 0xa1, 0xb8, 0xf3, 0x86, 0x15, 0x94, 0x38, 0x6a, 0x19, 0x6b, 0x44, 0x13,
+	0x32, 0x7d, 0x3b, 0x1f, 0x12, 0xaa, 0x1e, 0x80, 0xa7, 0x14, 0xbf, 0xb6, 0xe8, 0x0d, 0xa2, 0x6a,
+	0x98, 0x61, 0x01, 0xf5, 0x9d, 0x52, 0x81, 0xf9, 0x0c, 0x66, 0x38, 0x3b, 0x03, 0xd3, 0xd1, 0x03,
+	0x17, 0xbd, 0x0c, 0x61, 0x70, 0x29, 0x72, 0x1d, 0xc3, 0x2b, 0x69, 0x49, 0xf9, 0xe9, 0x1d, 0x79,
+	0xc8, 0x89, 0xbf, 0x0f, 0x40, 0x67, 0x73, 0x22, 0x59, 0xf5, 0xa4, 0xd7, 0x94, 0x79, 0x25, 0xe9,
+	0x2b, 0x5e, 0x73, 0x8c, 0xec, 0x87, 0x09, 0x98, 0x8e, 0x9e, 0xb3, 0x87, 0xc3, 0xa0, 0x49, 0x98,
+	0x6c, 0x18, 0x3a, 0x31, 0x59, 0xaf, 0x60, 0x07, 0x07, 0xd3, 0x33, 0xca, 0x88, 0x2f, 0x99, 0xd3,
+	0xd0, 0x0b, 0x70, 0xc4, 0xa5, 0xc4, 0x31, 0x71, 0x9b, 0x08, 0xf1, 0xa8, 0x92, 0xa6, 0x74, 0x05,
+	0x5c, 0xc9, 0xc6, 0x94, 0xae, 0x5b, 0x8e, 0x26, 0x0c, 0xee, 0x51, 0x0a, 0x05, 0x48, 0x87, 0xa3,
+	0xd4, 0xad, 0xd3, 0x86, 0xa3, 0xd7, 0x89, 0x7a, 0xdb, 0xa2, 0xc2, 0xd0, 0x09, 0x90, 0x1f, 0x2b,
+	0x95, 0xfa, 0x2b, 0x2a, 0x85, 0x25, 0xab, 0x2a, 0x67, 0x3a, 0x9b, 0x13, 0xe9, 0x6a, 0x08, 0x5b,
+	0xb2, 0xaa, 0x4a, 0x9a, 0xf6, 0x7a, 0x14, 0x35, 0x60, 0xca, 0x76, 0xeb, 0x86, 0x4e, 0x57, 0xbd,
+	0x89, 0x12, 0x87, 0x9e, 0x68, 0xac, 0xb3, 0x39, 0x01, 0x17, 0x7d, 0x14, 0x9f, 0x06, 0xda, 0x61,
+	0x9b, 0xa2, 0x17, 0xe0, 0xb0, 0xcb, 0x0f, 0x76, 0x83, 0x7a, 0x67, 0xf5, 0x88, 0x5f, 0x99, 0xae,
+	0x51, 0x52, 0x9b, 0xaf, 0x2a, 0x09, 0x97, 0x92, 0x9a, 0x41, 0x51, 0x1e, 0x26, 0x98, 0x41, 0xd5,
+	0x06, 0xf6, 0x0e, 0xdf, 0xb4, 0x7c, 0x64, 0x47, 0x1e, 0x7a, 0x27, 0x2e, 0xdc, 0xbf, 0xd0, 0xd9,
+	0x9c, 0x18, 0xaa, 0xcd, 0x57, 0xa7, 0x2b, 0xca, 0x10, 0x33, 0xe8, 0x34, 0x46, 0x15, 0x38, 0xee,
+	0x69, 0xfa, 0x5b, 0xd2, 0x20, 0x0e, 0xf3, 0xce, 0xd6, 0xb4, 0x7c, 0x2c, 0x62, 0x32, 0xca, 0x4d,
+	0x3c, 0x8d, 0x69, 0xe2, 0x30, 0x65, 0x94, 0x9b, 0x76, 0xbb, 0xe8, 0x87, 0x70, 0x2c, 0x82, 0x68,
+	0x91, 0x0d, 0xef, 0x38, 0x4d, 0xcb, 0x42, 0x84, 0x90, 0xee, 0x12, 0xae, 0x90, 0x0d, 0x25, 0xdd,
+	0x05, 0x5c, 0x21, 0x1b, 0xe8, 0x1a, 0x1c, 0x5e, 0x25, 0x58, 0x23, 0x0e, 0x15, 0x52, 0x27, 0xe2,
+	0xf9, 0x54, 0xe9, 0x5c, 0x9f, 0x21, 0xbb, 0xec, 0x5b, 0xcf, 0x9a, 0xcc, 0xd9, 0x50, 0x42, 0x56,
+	0xb6, 0x0c, 0xd3, 0x51, 0x01, 0xca, 0xc0, 0x38, 0xf7, 0xcd, 0xcb, 0x53, 0x85, 0x37, 0xd1, 0x51,
+	0x38, 0xb4, 0x86, 0x0d, 0x97, 0xf8, 0x69, 0xa8, 0xf8, 0x9d, 0xf2, 0xc0, 0xab, 0x20, 0xf7, 0x7d,
+	0x18, 0x5f, 0xb2, 0xaa, 0x28, 0x03, 0xd3, 0x95, 0x9a, 0x7a, 0xf5, 0x8d, 0x6a, 0x4d, 0x7d, 0x63,
+	0x61, 0x7a, 0x36, 0x13, 0x43, 0x47, 0xe0, 0x68, 0xa5, 0xa6, 0xce, 0xcf, 0x56, 0xc2, 0x21, 0xc0,
+	0x95, 0x66, 0x7f, 0x54, 0x99, 0xae, 0xcd, 0xbf, 0xe5, 0x8f, 0x0c, 0x64, 0x7f, 0x0a, 0xe1, 0xd8,
+	0xee, 0xeb, 0x03, 0xfa, 0xf5, 0x00, 0x4c, 0x38, 0xa4, 0xa9, 0x5b, 0x66, 0xf0, 0x43, 0x79, 0x6f,
+	0x60, 0x47, 0xfe, 0x37, 0x70, 0xfe, 0x05, 0x14, 0x88, 0x57, 0x24, 0x6a, 0xb9, 0x6c, 0x55, 0x3a,
+	0xab, 0x24, 0xb1, 0x2d, 0x11, 0x4c, 0x99, 0x74, 0x96, 0xdf, 0x74, 0x25, 0xd3, 0x72, 0xd8, 0xea,
+	0x23, 0xfb, 0x25, 0x05, 0x62, 0xbb, 0x6b, 0x36, 0x16, 0xb6, 0x23, 0xba, 0xbd, 0x7e, 0x49, 0x49,
+	0x37, 0xb0, 0xd4, 0x20, 0x26, 0x73, 0xb0, 0x21, 0x9d, 0x55, 0xd2, 0xc4, 0x8d, 0xf4, 0x20, 0x71,
+	0x7d, 0x6e, 0xd0, 0xee, 0xba, 0x42, 0x5c, 0x69, 0x9d, 0x50, 0x16, 0x6d, 0x96, 0x7a, 0xcd, 0x29,
+	0x05, 0xb6, 0x49, 0x4f, 0x99, 0xe2, 0xd0, 0xef, 0xa4, 0x4b, 0xf7, 0x35, 0x4b, 0x5e, 0x33, 0xa4,
+	0x85, 0xcd, 0x92, 0x12, 0x84, 0x04, 0xbd, 0x05, 0x21, 0x2f, 0xc4, 0x94, 0x7a, 0xd9, 0xe3, 0x5f,
+	0x85, 0xcb, 0xfd, 0x5e, 0xd1, 0x0a, 0x15, 0x0f, 0xc1, 0xf3, 0x2b, 0x89, 0xc3, 0x26, 0x5a, 0x86,
+	0x29, 0x4c, 0xa9, 0xdb, 0x26, 0xaa, 0x63, 0x19, 0x24, 0xb8, 0x21, 0x9f, 0xeb, 0x9f, 0xed, 0x31,
+	0x14, 0xcb, 0x20, 0x0a, 0xc4, 0xdd, 0x36, 0xfa, 0x1d, 0x80, 0x19, 0x62, 0x6a, 0xb6, 0xa5, 0x9b,
+	0x4c, 0xc5, 0x9a, 0xe6, 0x10, 0x4a, 0x83, 0xa3, 0xe8, 0xce, 0x8e, 0xec, 0x3a, 0x54, 0xb8, 0x0f,
+	0x4a, 0xe6, 0xad, 0x7c, 0x3e, 0xcf, 0x6f, 0xce, 0x15, 0xe9, 0x3a, 0xbf, 0x3c, 0xbf, 0x1b, 0x69,
+	0xf7, 0x9a, 0xcb, 0xd2, 0xcd, 0x53, 0x11, 0xc1, 0xc9, 0xe5, 0xc2, 0xc9, 0x53, 0xf9, 0x1b, 0x15,
+	0xe9, 0x7a, 0x70, 0xe5, 0x7e, 0x37, 0xd2, 0xee, 0x35, 0x3d, 0xab, 0x9e, 0xe0, 0xe4, 0xbb, 0x27,
+	0x27, 0x95, 0xf1, 0xd0, 0xa3, 0x8a, 0xef, 0x10, 0x52, 0xe1, 0xb0, 0x46, 0x56, 0xb0, 0x6b, 0x30,
+	0xef, 0xf0, 0x4b, 0x95, 0xa6, 0xfb, 0x5e, 0xff, 0x8c, 0x6f, 0x3f, 0x67, 0x32, 0xd2, 0xf4, 0x5f,
+	0xa2, 0x97, 0x63, 0x4a, 0x48, 0xcd, 0xfe, 0x11, 0xc0, 0x64, 0x37, 0xfa, 0xe8, 0x22, 0x1c, 0xed,
+	0xed, 0x26, 0x3f, 0xe5, 0xfd, 0x8c, 0xcf, 0xed, 0xc8, 0x19, 0x67, 0x2c, 0x93, 0xe1, 0x21, 0x19,
+	0xbe, 0x75, 0x63, 0x79, 0xfd, 0xe6, 0x29, 0xfe, 0x04, 0x4b, 0x75, 0x0d, 0xe7, 0x66, 0x94, 0x54,
+	0x77, 0xe3, 0xe6, 0x34, 0x34, 0x05, 0x8f, 0x50, 0xd2, 0x70, 0x08, 0x53, 0xf7, 0x24, 0x47, 0xf7,
+	0x9c, 0xcf, 0x2b, 0xe3, 0xbe, 0x46, 0x6f, 0x72, 0x09, 0x8e, 0x52, 0x42, 0x29, 0x2f, 0xc6, 0xcc,
+	0x6a, 0x11, 0x33, 0xa8, 0x1e, 0x23, 0x5e, 0x5d, 0x12, 0xee, 0x0f, 0x28, 0xe9, 0x40, 0x5c, 0xe3,
+	0xd2, 0xec, 0x3f, 0x01, 0x84, 0xbd, 0xbd, 0x45, 0x0a, 0x8c, 0x63, 0x27, 0xfc, 0x89, 0x5e, 0xd8,
+	0x91, 0x5f, 0x71, 0x5e, 0x2e, 0x95, 0x6e, 0x61, 0xc7, 0x2c, 0xe3, 0x75, 0x5a, 0xd6, 0x71, 0xbb,
+	0x5c, 0xbe, 0xc1, 0xe3, 0x7c, 0xf7, 0x6c, 0xe9, 0x5e, 0x99, 0x27, 0xd4, 0x72, 0xb1, 0x17, 0x7d,
+	0xf5, 0xf4, 0x0f, 0xce, 0x14, 0x2e, 0x48, 0x37, 0x4f, 0xf3, 0x65, 0xc5, 0x2b, 0xca, 0x82, 0xc2,
+	0x61, 0x68, 0x0e, 0xa6, 0xc8, 0x1d, 0xc6, 0x4b, 0x96, 0xd1, 0x2b, 0x79, 0xf9, 0x1d, 0xf9, 0x19,
+	0xe7, 0x69, 0xe1, 0x61, 0xb2, 0x94, 0xe1, 0xa1, 0xf0, 0x2c, 0xcb, 0xcb, 0x45, 0xc9, 0x8f, 0x09,
+	0x9c, 0x0d, 0x0c, 0xe6, 0x66, 0x14, 0x18, 0x1a, 0xcf, 0x69, 0xe8, 0x75, 0x98, 0x09, 0x17, 0x17,
+	0x7e, 0x10, 0x08, 0x32, 0xfa, 0xd8, 0xbe, 0x37, 0xdf, 0x4c, 0xa0, 0x20, 0x0f, 0x7e, 0xc0, 0x9f,
+	0x7b, 0xe3, 0x81, 0x61, 0x38, 0x9c, 0x7d, 0x13, 0xa2, 0xfd, 0x9b, 0x8a, 0x2a, 0x10, 0x7a, 0x4f,
+	0x7e, 0xd5, 0xab, 0xbc, 0xdd, 0x8d, 0x9b, 0x70, 0x9e, 0xe3, 0xdb, 0x26, 0xdc, 0x0a, 0x56, 0xbb,
+	0x27, 0x03, 0x27, 0x95, 0xa4, 0x67, 0xb5, 0x80, 0xdb, 0x44, 0x4e, 0x43, 0xa8, 0x11, 0xdb, 0xb0,
+	0x36, 0xda, 0xc4, 0x64, 0xd9, 0x75, 0x98, 0x8e, 0xbe, 0x79, 0x0e, 0x79, 0x69, 0x38, 0x0b, 0x47,
+	0xc8, 0x9d, 0xc6, 0x2a, 0x36, 0x9b, 0xc1, 0x61, 0xcd, 0x6d, 0x90, 0x93, 0x11, 0xfe, 0x03, 0x4a,
+	0x29, 0x1e, 0xc0, 0x65, 0xa9, 0x50, 0xe6, 0x7e, 0x74, 0xd5, 0xb2, 0x3f, 0x06, 0x70, 0x74, 0xd7,
+	0xe3, 0x08, 0x49, 0x70, 0xb8, 0xee, 0x58, 0x2d, 0x5e, 0x67, 0xc0, 0x89, 0x78, 0x3e, 0x29, 0x3f,
+	0xb5, 0x23, 0x67, 0x7e, 0x01, 0x46, 0x47, 0x40, 0x26, 0x93, 0x1b, 0x76, 0x86, 0x32, 0x80, 0xe7,
+	0x47, 0xa8, 0x83, 0x66, 0xe1, 0x58, 0xc3, 0x32, 0x79, 0x6a, 0x38, 0x6a, 0xd3, 0xb1, 0x5c, 0x3b,
+	0x98, 0x59, 0xdc, 0x91, 0x8f, 0x3b, 0xc7, 0x4a, 0xcf, 0xdc, 0xea, 0xfd, 0x5a, 0x0b, 0xaa, 0x74,
+	0xf3, 0xee, 0xd9, 0x33, 0xa5, 0x97, 0x5f, 0xbb, 0x37, 0xa9, 0x8c, 0x86, 0x56, 0x97, 0xb8, 0x51,
+	0x36, 0x0f, 0x87, 0xc3, 0x47, 0xc7, 0x73, 0x70, 0xc8, 0x7f, 0x8f, 0x81, 0xdd, 0x97, 0x15, 0x7f,
+	0x54, 0x1e, 0x87, 0x23, 0x76, 0xe8, 0x6b, 0xfc, 0x6b, 0x19, 0xe4, 0x96, 0x20, 0xda, 0xf7, 0xc3,
+	0xa4, 0xe8, 0x1c, 0x1c, 0xf6, 0xbf, 0x65, 0xf9, 0xcb, 0x48, 0x95, 0x9e, 0x3f, 0xf0, 0xd7, 0xac,
+	0x84, 0x16, 0xb9, 0x0f, 0x01, 0x14, 0xf6, 0x89, 0x2f, 0x7a, 0xaf, 0x78, 0x8a, 0xde, 0x80, 0xc3,
+	0xfe, 0x83, 0x3e, 0x24, 0x7f, 0xef, 0x40, 0x72, 0x60, 0x5a, 0x08, 0xfe, 0x06, 0x25, 0x38, 0xa0,
+	0xf0, 0x12, 0x1c, 0x15, 0xf4, 0x55, 0x82, 0x3f, 0x06, 0xf0, 0xf8, 0x25, 0xc2, 0xf6, 0xaf, 0x85,
+	0xdc, 0x76, 0x09, 0x65, 0xff, 0x83, 0x0f, 0x32, 0xe7, 0x21, 0xec, 0x7d, 0x29, 0xfb, 0xc6, 0x0f,
+	0x32, 0x17, 0xb9, 0xca, 0x55, 0x4c, 0x5b, 0xf2, 0x20, 0x37, 0x57, 0x92, 0x2b, 0xe1, 0x40, 0xee,
+	0xcf, 0x00, 0x3e, 0x37, 0xaf, 0xd3, 0xfd, 0x3e, 0xd3, 0xd0, 0xe9, 0xff, 0xc3, 0x17, 0xb1, 0x6f,
+	0xbd, 0x8a, 0x3f, 0x00, 0x78, 0xbc, 0xfa, 0x98, 0xc0, 0x5f, 0x81, 0x09, 0x3f, 0x9b, 0x02, 0xd7,
+	0x0f, 0x4e, 0xbf, 0x47, 0x78, 0x1d, 0x20, 0xbe, 0xb5, 0xb7, 0xa5, 0x3f, 0x25, 0xe0, 0xb1, 0x47,
+	0xb8, 0xda, 0xd4, 0x29, 0x4f, 0xb8, 0xb7, 0x21, 0xbc, 0x44, 0x58, 0x98, 0xdf, 0xdf, 0xd9, 0x07,
+	0x9e, 0xe5, 0x9f, 0x4d, 0xb3, 0xf9, 0x27, 0x4d, 0xf3, 0x5c, 0xf6, 0xbd, 0xbf, 0xfc, 0xfd, 0x97,
+	0x03, 0x47, 0x11, 0x2a, 0x62, 0x5a, 0xf4, 0x97, 0x20, 0x05, 0xc9, 0x8e, 0x7e, 0x03, 0x60, 0xfc,
+	0x12, 0x61, 0xe8, 0xf4, 0x5e, 0xda, 0x63, 0xb2, 0x38, 0x7b, 0x70, 0xf0, 0x72, 0x97, 0xbd, 0x39,
+	0x65, 0x74, 0xa1, 0x37, 0x67, 0xf1, 0xae, 0xae, 0xd1, 0xc2, 0x9e, 0x4c, 0xda, 0xd3, 0xbf, 0xe7,
+	0x2b, 0xf5, 0xbe, 0x8e, 0xde, 0x43, 0x3f, 0x07, 0x70, 0x90, 0xe7, 0x27, 0x92, 0xf6, 0xce, 0xfa,
+	0xd8, 0xac, 0xcd, 0xe6, 0x0e, 0x74, 0x92, 0xe6, 0xa6, 0x3c, 0x2f, 0x25, 0x74, 0x3a, 0xea, 0xe5,
+	0x01, 0x1e, 0xa2, 0x7f, 0x00, 0x18, 0xaf, 0x3e, 0x2a, 0x64, 0xd5, 0x6f, 0x17, 0xb2, 0x5f, 0x01,
+	0xcf, 0x9b, 0x07, 0x20, 0xbb, 0x10, 0x75, 0xc7, 0xff, 0x5b, 0x78, 0xa2, 0xd8, 0x45, 0x74, 0x23,
+	0x21, 0x2c, 0x83, 0x53, 0xd7, 0xcf, 0xe5, 0x5e, 0x39, 0x1c, 0xb4, 0x0c, 0x4e, 0xa1, 0x07, 0x00,
+	0x26, 0x66, 0x88, 0x41, 0x18, 0x41, 0x7d, 0x9d, 0x59, 0xd9, 0x6f, 0xc8, 0xdd, 0xdc, 0x05, 0x6f,
+	0xa5, 0xe5, 0x53, 0xaf, 0xf6, 0x11, 0xf7, 0xe2, 0xdd, 0xc8, 0x92, 0xe4, 0xdf, 0x83, 0x87, 0x5b,
+	0x22, 0xf8, 0x6c, 0x4b, 0x04, 0x9f, 0x6f, 0x89, 0xb1, 0x2f, 0xb6, 0xc4, 0xd8, 0x97, 0x5b, 0x62,
+	0xec, 0xab, 0x2d, 0x31, 0xf6, 0xf5, 0x96, 0x08, 0xee, 0x77, 0x44, 0xf0, 0x7e, 0x47, 0x8c, 0x7d,
+	0xd4, 0x11, 0xc1, 0xc7, 0x1d, 0x31, 0xf6, 0x49, 0x47, 0x8c, 0x7d, 0xda, 0x11, 0x63, 0x0f, 0x3b,
+	0x22, 0xf8, 0xac, 0x23, 0x82, 0xcf, 0x3b, 0x62, 0xec, 0x8b, 0x8e, 0x08, 0xbe, 0xec, 0x88, 0xb1,
+	0xaf, 0x3a, 0x22, 0xf8, 0xba, 0x23, 0xc6, 0xee, 0x6f, 0x8b, 0xb1, 0xf7, 0xb7, 0x45, 0xf0, 0x60,
+	0x5b, 0x8c, 0x7d, 0xb0, 0x2d, 0x82, 0xdf, 0x6e, 0x8b, 0xb1, 0x8f, 0xb6, 0xc5, 0xd8, 0xc7, 0xdb,
+	0x22, 0xf8, 0x64, 0x5b, 0x04, 0x9f, 0x6e, 0x8b, 0xe0, 0x7a, 0xb1, 0x69, 0x15, 0xd8, 0x2a, 0x61,
+	0xab, 0xba, 0xd9, 0xa4, 0x05, 0x93, 0xb0, 0x75, 0xcb, 0x69, 0x15, 0x77, 0xff, 0xdf, 0x61, 0x6d,
+	0xaa, 0x68, 0xb7, 0x9a, 0x45, 0xc6, 0x4c, 0xbb, 0x5e, 0x4f, 0x78, 0x2b, 0x9f, 0xfa, 0xef, 0x00,
+	0x17, 0x17, 0x65, 0xcf, 0xee, 0x19, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -1464,6 +1618,54 @@ func (this *ApplicationPubSub_AWSIoT) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationPubSub_AMQP) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQP)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AMQP.Equal(that1.AMQP) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Kafka)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Kafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1703,6 +1905,65 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Equal(that inte
 	}
 	return true
 }
+func (this *ApplicationPubSub_AMQPProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQPProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQPProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServerURL != that1.ServerURL {
+		return false
+	}
+	if this.Exchange != that1.Exchange {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
+	}
+	if this.ConsumerGroup != that1.ConsumerGroup {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2376,6 +2637,52 @@ func (m *ApplicationPubSub_NATS) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationPubSub_AMQP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AMQP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AMQP != nil {
+		{
+			size, err := m.AMQP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ApplicationPubSub_MQTT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -2719,12 +3026,12 @@ func (m *ApplicationPubSub_AWSIoTProvider_AssumeRole) MarshalToSizedBuffer(dAtA
 	var l int
 	_ = l
 	if m.SessionDuration != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
-		if err23 != nil {
-			return 0, err23
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n23
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n23))
+		i -= n25
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2775,7 +3082,7 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_AMQPProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2785,27 +3092,34 @@ func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Topic) > 0 {
-		i -= len(m.Topic)
-		copy(dAtA[i:], m.Topic)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+	if len(m.Exchange) > 0 {
+		i -= len(m.Exchange)
+		copy(dAtA[i:], m.Exchange)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Exchange)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2815,26 +3129,28 @@ func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pubsubs) > 0 {
-		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-			}
+	if len(m.ConsumerGroup) > 0 {
+		i -= len(m.ConsumerGroup)
+		copy(dAtA[i:], m.ConsumerGroup)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroup)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2842,7 +3158,7 @@ func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2852,31 +3168,98 @@ func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3074,10 +3457,14 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
 	}
-	oneofNumber_Provider := []int32{17, 25, 101}[r.Intn(3)]
+	oneofNumber_Provider := []int32{17, 20, 21, 25, 101}[r.Intn(5)]
 	switch oneofNumber_Provider {
 	case 17:
 		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
+	case 20:
+		this.Provider = NewPopulatedApplicationPubSub_AMQP(r, easy)
+	case 21:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
 	case 25:
 		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
 	case 101:
@@ -3099,6 +3486,16 @@ func NewPopulatedApplicationPubSub_NATS(r randyApplicationserverPubsub, easy boo
 	this.NATS = NewPopulatedApplicationPubSub_NATSProvider(r, easy)
 	return this
 }
+func NewPopulatedApplicationPubSub_AMQP(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQP {
+	this := &ApplicationPubSub_AMQP{}
+	this.AMQP = NewPopulatedApplicationPubSub_AMQPProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
 func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTT {
 	this := &ApplicationPubSub_MQTT{}
 	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
@@ -3208,6 +3605,28 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider_DefaultIntegration(r randyAppl
 	return this
 }
 
+func NewPopulatedApplicationPubSub_AMQPProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQPProvider {
+	this := &ApplicationPubSub_AMQPProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.Exchange = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v9 := r.Intn(10)
+	this.Brokers = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.ConsumerGroup = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
 	this := &ApplicationPubSub_Message{}
 	this.Topic = randStringApplicationserverPubsub(r)
@@ -3219,9 +3638,9 @@ func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy
 func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
 	this := &ApplicationPubSubs{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v9)
-		for i := 0; i < v9; i++ {
+		v10 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v10)
+		for i := 0; i < v10; i++ {
 			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
 		}
 	}
@@ -3233,9 +3652,9 @@ func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *
 func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
 	this := &ApplicationPubSubFormats{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(10)
+		v11 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v10; i++ {
+		for i := 0; i < v11; i++ {
 			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
 		}
 	}
@@ -3246,10 +3665,10 @@ func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy b
 
 func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
 	this := &GetApplicationPubSubRequest{}
-	v11 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v12 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v12
+	v13 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v13
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3257,10 +3676,10 @@ func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, eas
 
 func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
 	this := &ListApplicationPubSubsRequest{}
-	v13 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v14 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v14
+	v15 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3268,10 +3687,10 @@ func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, e
 
 func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
 	this := &SetApplicationPubSubRequest{}
-	v15 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v16 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3296,9 +3715,9 @@ func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v17 := r.Intn(100)
-	tmps := make([]rune, v17)
-	for i := 0; i < v17; i++ {
+	v18 := r.Intn(100)
+	tmps := make([]rune, v18)
+	for i := 0; i < v18; i++ {
 		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
 	}
 	return string(tmps)
@@ -3320,11 +3739,11 @@ func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsu
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v18 := r.Int63()
+		v19 := r.Int63()
 		if r.Intn(2) == 0 {
-			v18 *= -1
+			v19 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v18))
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v19))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3450,6 +3869,30 @@ func (m *ApplicationPubSub_NATS) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationPubSub_AMQP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AMQP != nil {
+		l = m.AMQP.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
 func (m *ApplicationPubSub_MQTT) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3636,6 +4079,42 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationPubSub_AMQPProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	l = len(m.ConsumerGroup)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationPubSub_Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3774,6 +4253,26 @@ func (this *ApplicationPubSub_NATS) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_AMQP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQP{`,
+		`AMQP:` + strings.Replace(fmt.Sprintf("%v", this.AMQP), "ApplicationPubSub_AMQPProvider", "ApplicationPubSub_AMQPProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_MQTT) String() string {
 	if this == nil {
 		return "nil"
@@ -3892,6 +4391,28 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) String() string
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_AMQPProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQPProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`Exchange:` + fmt.Sprintf("%v", this.Exchange) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_Message) String() string {
 	if this == nil {
 		return "nil"
@@ -4755,6 +5276,76 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AMQPProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_AMQP{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_KafkaProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Kafka{v}
+			iNdEx = postIndex
 		case 25:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
@@ -6013,6 +6604,240 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Unmarshal(dAtA []b
 	}
 	return nil
 }
+func (m *ApplicationPubSub_AMQPProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationPubSub_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,9 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"location_solved",
 	"location_solved.topic",
 	"provider",
+	"provider.amqp",
+	"provider.amqp.exchange",
+	"provider.amqp.server_url",
 	"provider.aws_iot",
 	"provider.aws_iot.access_key",
 	"provider.aws_iot.access_key.access_key_id",
@@ -55,6 +58,9 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.aws_iot.deployment.default.stack_name",
 	"provider.aws_iot.endpoint_address",
 	"provider.aws_iot.region",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.consumer_group",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
 	"provider.mqtt.headers",
@@ -163,6 +169,9 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.location_solved",
 	"pubsub.location_solved.topic",
 	"pubsub.provider",
+	"pubsub.provider.amqp",
+	"pubsub.provider.amqp.exchange",
+	"pubsub.provider.amqp.server_url",
 	"pubsub.provider.aws_iot",
 	"pubsub.provider.aws_iot.access_key",
 	"pubsub.provider.aws_iot.access_key.access_key_id",
@@ -177,6 +186,9 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.aws_iot.deployment.default.stack_name",
 	"pubsub.provider.aws_iot.endpoint_address",
 	"pubsub.provider.aws_iot.region",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.consumer_group",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
 	"pubsub.provider.mqtt.headers",
@@ -259,6 +271,24 @@ var ApplicationPubSub_AWSIoTProviderFieldPathsTopLevel = []string{
 	"endpoint_address",
 	"region",
 }
+var ApplicationPubSub_AMQPProviderFieldPathsNested = []string{
+	"exchange",
+	"server_url",
+}
+
+var ApplicationPubSub_AMQPProviderFieldPathsTopLevel = []string{
+	"exchange",
+	"server_url",
+}
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"consumer_group",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"consumer_group",
+}
 var ApplicationPubSub_MessageFieldPathsNested = []string{
 	"topic",
 }
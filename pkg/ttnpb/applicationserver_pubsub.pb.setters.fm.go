@@ -524,6 +524,72 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 							dst.Provider = nil
 						}
 					}
+				case "amqp":
+					_, srcOk := src.Provider.(*ApplicationPubSub_AMQP)
+					if !srcOk && src.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'amqp', while different oneof is set in source")
+					}
+					_, dstOk := dst.Provider.(*ApplicationPubSub_AMQP)
+					if !dstOk && dst.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'amqp', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_AMQPProvider
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Provider.(*ApplicationPubSub_AMQP).AMQP
+						}
+						if dstOk {
+							newDst = dst.Provider.(*ApplicationPubSub_AMQP).AMQP
+						} else {
+							newDst = &ApplicationPubSub_AMQPProvider{}
+							dst.Provider = &ApplicationPubSub_AMQP{AMQP: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
+				case "kafka":
+					_, srcOk := src.Provider.(*ApplicationPubSub_Kafka)
+					if !srcOk && src.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in source")
+					}
+					_, dstOk := dst.Provider.(*ApplicationPubSub_Kafka)
+					if !dstOk && dst.Provider != nil {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_KafkaProvider
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Provider.(*ApplicationPubSub_Kafka).Kafka
+						}
+						if dstOk {
+							newDst = dst.Provider.(*ApplicationPubSub_Kafka).Kafka
+						} else {
+							newDst = &ApplicationPubSub_KafkaProvider{}
+							dst.Provider = &ApplicationPubSub_Kafka{Kafka: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -967,6 +1033,67 @@ func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AW
 	return nil
 }
 
+func (dst *ApplicationPubSub_AMQPProvider) SetFields(src *ApplicationPubSub_AMQPProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "server_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'server_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServerURL = src.ServerURL
+			} else {
+				var zero string
+				dst.ServerURL = zero
+			}
+		case "exchange":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exchange' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Exchange = src.Exchange
+			} else {
+				var zero string
+				dst.Exchange = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_KafkaProvider) SetFields(src *ApplicationPubSub_KafkaProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "brokers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brokers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Brokers = src.Brokers
+			} else {
+				dst.Brokers = nil
+			}
+		case "consumer_group":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer_group' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConsumerGroup = src.ConsumerGroup
+			} else {
+				var zero string
+				dst.ConsumerGroup = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationPubSub_Message) SetFields(src *ApplicationPubSub_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
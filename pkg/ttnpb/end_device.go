@@ -123,12 +123,72 @@ func (v *EndDeviceAuthenticationCode) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *ADRSettings) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "strategy":
+		return v.Strategy == nil
+	case "strategy.dynamic":
+		return v.GetDynamic() == nil
+	case "strategy.dynamic.margin":
+		return v.GetDynamic().GetMargin() == nil
+	case "strategy.loss_based":
+		return v.GetLossBased() == nil
+	case "strategy.loss_based.margin":
+		return v.GetLossBased().GetMargin() == nil
+	case "strategy.loss_based.max_loss_rate":
+		return v.GetLossBased().GetMaxLossRate() == nil
+	case "strategy.mobile":
+		return v.GetMobile() == nil
+	case "strategy.mobile.margin":
+		return v.GetMobile().GetMargin() == nil
+	case "strategy.static":
+		return v.GetStatic() == nil
+	case "strategy.static.data_rate_index":
+		return v.GetStatic().GetDataRateIndex() == 0
+	case "strategy.static.nb_trans":
+		return v.GetStatic().GetNbTrans() == 0
+	case "strategy.static.tx_power_index":
+		return v.GetStatic().GetTxPowerIndex() == 0
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *MACSettings) FieldIsZero(p string) bool {
 	if v == nil {
 		return true
 	}
 	switch p {
+	case "adr":
+		return v.ADR == nil
+	case "adr.strategy":
+		return v.ADR.FieldIsZero("strategy")
+	case "adr.strategy.dynamic":
+		return v.ADR.FieldIsZero("strategy.dynamic")
+	case "adr.strategy.dynamic.margin":
+		return v.ADR.FieldIsZero("strategy.dynamic.margin")
+	case "adr.strategy.loss_based":
+		return v.ADR.FieldIsZero("strategy.loss_based")
+	case "adr.strategy.loss_based.margin":
+		return v.ADR.FieldIsZero("strategy.loss_based.margin")
+	case "adr.strategy.loss_based.max_loss_rate":
+		return v.ADR.FieldIsZero("strategy.loss_based.max_loss_rate")
+	case "adr.strategy.mobile":
+		return v.ADR.FieldIsZero("strategy.mobile")
+	case "adr.strategy.mobile.margin":
+		return v.ADR.FieldIsZero("strategy.mobile.margin")
+	case "adr.strategy.static":
+		return v.ADR.FieldIsZero("strategy.static")
+	case "adr.strategy.static.data_rate_index":
+		return v.ADR.FieldIsZero("strategy.static.data_rate_index")
+	case "adr.strategy.static.nb_trans":
+		return v.ADR.FieldIsZero("strategy.static.nb_trans")
+	case "adr.strategy.static.tx_power_index":
+		return v.ADR.FieldIsZero("strategy.static.tx_power_index")
 	case "adr_margin":
 		return v.ADRMargin == nil
 	case "beacon_frequency":
@@ -931,6 +991,32 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.LoRaWANVersion == 0
 	case "mac_settings":
 		return v.MACSettings == nil
+	case "mac_settings.adr":
+		return v.MACSettings.FieldIsZero("adr")
+	case "mac_settings.adr.strategy":
+		return v.MACSettings.FieldIsZero("adr.strategy")
+	case "mac_settings.adr.strategy.dynamic":
+		return v.MACSettings.FieldIsZero("adr.strategy.dynamic")
+	case "mac_settings.adr.strategy.dynamic.margin":
+		return v.MACSettings.FieldIsZero("adr.strategy.dynamic.margin")
+	case "mac_settings.adr.strategy.loss_based":
+		return v.MACSettings.FieldIsZero("adr.strategy.loss_based")
+	case "mac_settings.adr.strategy.loss_based.margin":
+		return v.MACSettings.FieldIsZero("adr.strategy.loss_based.margin")
+	case "mac_settings.adr.strategy.loss_based.max_loss_rate":
+		return v.MACSettings.FieldIsZero("adr.strategy.loss_based.max_loss_rate")
+	case "mac_settings.adr.strategy.mobile":
+		return v.MACSettings.FieldIsZero("adr.strategy.mobile")
+	case "mac_settings.adr.strategy.mobile.margin":
+		return v.MACSettings.FieldIsZero("adr.strategy.mobile.margin")
+	case "mac_settings.adr.strategy.static":
+		return v.MACSettings.FieldIsZero("adr.strategy.static")
+	case "mac_settings.adr.strategy.static.data_rate_index":
+		return v.MACSettings.FieldIsZero("adr.strategy.static.data_rate_index")
+	case "mac_settings.adr.strategy.static.nb_trans":
+		return v.MACSettings.FieldIsZero("adr.strategy.static.nb_trans")
+	case "mac_settings.adr.strategy.static.tx_power_index":
+		return v.MACSettings.FieldIsZero("adr.strategy.static.tx_power_index")
 	case "mac_settings.adr_margin":
 		return v.MACSettings.FieldIsZero("adr_margin")
 	case "mac_settings.beacon_frequency":
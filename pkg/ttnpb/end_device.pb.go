@@ -765,9 +765,12 @@ type MACSettings struct {
 	DesiredBeaconFrequency *FrequencyValue `protobuf:"bytes,29,opt,name=desired_beacon_frequency,json=desiredBeaconFrequency,proto3" json:"desired_beacon_frequency,omitempty"`
 	// Maximum EIRP (dBm).
 	// If unset, the default value from regional parameters specification will be used.
-	DesiredMaxEirp       *DeviceEIRPValue `protobuf:"bytes,30,opt,name=desired_max_eirp,json=desiredMaxEirp,proto3" json:"desired_max_eirp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	DesiredMaxEirp *DeviceEIRPValue `protobuf:"bytes,30,opt,name=desired_max_eirp,json=desiredMaxEirp,proto3" json:"desired_max_eirp,omitempty"`
+	// Adaptive data rate strategy and its parameters.
+	// If unset, the default value from Network Server configuration will be used.
+	ADR                  *ADRSettings `protobuf:"bytes,31,opt,name=adr,proto3" json:"adr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1005,83 +1008,36 @@ func (m *MACSettings) GetDesiredMaxEirp() *DeviceEIRPValue {
 	return nil
 }
 
-// MACState represents the state of MAC layer of the device.
-// MACState is reset on each join for OTAA or ResetInd for ABP devices.
-// This is used internally by the Network Server.
-type MACState struct {
-	// Current LoRaWAN MAC parameters.
-	CurrentParameters MACParameters `protobuf:"bytes,1,opt,name=current_parameters,json=currentParameters,proto3" json:"current_parameters"`
-	// Desired LoRaWAN MAC parameters.
-	DesiredParameters MACParameters `protobuf:"bytes,2,opt,name=desired_parameters,json=desiredParameters,proto3" json:"desired_parameters"`
-	// Currently active LoRaWAN device class
-	// - Device class is A by default
-	// - If device sets ClassB bit in uplink, this will be set to B
-	// - If device sent DeviceModeInd MAC message, this will be set to that value
-	DeviceClass Class `protobuf:"varint,3,opt,name=device_class,json=deviceClass,proto3,enum=ttn.lorawan.v3.Class" json:"device_class,omitempty"`
-	// LoRaWAN MAC version.
-	LoRaWANVersion MACVersion `protobuf:"varint,4,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
-	// Time when the last confirmed downlink message or MAC command was scheduled.
-	LastConfirmedDownlinkAt *time.Time `protobuf:"bytes,5,opt,name=last_confirmed_downlink_at,json=lastConfirmedDownlinkAt,proto3,stdtime" json:"last_confirmed_downlink_at,omitempty"`
-	// Frame counter value of last uplink containing DevStatusAns.
-	LastDevStatusFCntUp uint32 `protobuf:"varint,6,opt,name=last_dev_status_f_cnt_up,json=lastDevStatusFCntUp,proto3" json:"last_dev_status_f_cnt_up,omitempty"`
-	// Periodicity of the class B ping slot.
-	PingSlotPeriodicity *PingSlotPeriodValue `protobuf:"bytes,7,opt,name=ping_slot_periodicity,json=pingSlotPeriodicity,proto3" json:"ping_slot_periodicity,omitempty"`
-	// A confirmed application downlink, for which an acknowledgment is expected to arrive.
-	PendingApplicationDownlink *ApplicationDownlink `protobuf:"bytes,8,opt,name=pending_application_downlink,json=pendingApplicationDownlink,proto3" json:"pending_application_downlink,omitempty"`
-	// Queued MAC responses.
-	// Regenerated on each uplink.
-	QueuedResponses []*MACCommand `protobuf:"bytes,9,rep,name=queued_responses,json=queuedResponses,proto3" json:"queued_responses,omitempty"`
-	// Pending MAC requests(i.e. sent requests, for which no response has been received yet).
-	// Regenerated on each downlink.
-	PendingRequests []*MACCommand `protobuf:"bytes,10,rep,name=pending_requests,json=pendingRequests,proto3" json:"pending_requests,omitempty"`
-	// Queued join-accept.
-	// Set each time a (re-)join request accept is received from Join Server and removed each time a downlink is scheduled.
-	QueuedJoinAccept *MACState_JoinAccept `protobuf:"bytes,11,opt,name=queued_join_accept,json=queuedJoinAccept,proto3" json:"queued_join_accept,omitempty"`
-	// Pending join request.
-	// Set each time a join-accept is scheduled and removed each time an uplink is received from the device.
-	PendingJoinRequest *MACState_JoinRequest `protobuf:"bytes,12,opt,name=pending_join_request,json=pendingJoinRequest,proto3" json:"pending_join_request,omitempty"`
-	// Whether or not Rx windows are expected to be open.
-	// Set to true every time an uplink is received.
-	// Set to false every time a successful downlink scheduling attempt is made.
-	RxWindowsAvailable bool `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
-	// Recent data uplink messages sorted by time.
-	// The number of messages stored may depend on configuration.
-	RecentUplinks []*UplinkMessage `protobuf:"bytes,14,rep,name=recent_uplinks,json=recentUplinks,proto3" json:"recent_uplinks,omitempty"`
-	// Recent data downlink messages sorted by time.
-	// The number of messages stored may depend on configuration.
-	RecentDownlinks []*DownlinkMessage `protobuf:"bytes,15,rep,name=recent_downlinks,json=recentDownlinks,proto3" json:"recent_downlinks,omitempty"`
-	// Time when the last network-initiated downlink message was scheduled.
-	LastNetworkInitiatedDownlinkAt *time.Time `protobuf:"bytes,16,opt,name=last_network_initiated_downlink_at,json=lastNetworkInitiatedDownlinkAt,proto3,stdtime" json:"last_network_initiated_downlink_at,omitempty"`
-	// ADR Data rate index values rejected by the device.
-	// Reset each time `current_parameters.channels` change.
-	// Elements are sorted in ascending order.
-	RejectedADRDataRateIndexes []DataRateIndex `protobuf:"varint,17,rep,packed,name=rejected_adr_data_rate_indexes,json=rejectedAdrDataRateIndexes,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"rejected_adr_data_rate_indexes,omitempty"`
-	// ADR TX output power index values rejected by the device.
-	// Elements are sorted in ascending order.
-	RejectedADRTxPowerIndexes []uint32 `protobuf:"varint,18,rep,packed,name=rejected_adr_tx_power_indexes,json=rejectedAdrTxPowerIndexes,proto3" json:"rejected_adr_tx_power_indexes,omitempty"`
-	// Frequencies rejected by the device.
-	RejectedFrequencies []uint64 `protobuf:"varint,19,rep,packed,name=rejected_frequencies,json=rejectedFrequencies,proto3" json:"rejected_frequencies,omitempty"`
-	// Time when the last downlink message was scheduled.
-	LastDownlinkAt *time.Time `protobuf:"bytes,20,opt,name=last_downlink_at,json=lastDownlinkAt,proto3,stdtime" json:"last_downlink_at,omitempty"`
-	// Data rate ranges rejected by the device per frequency.
-	RejectedDataRateRanges map[uint64]*MACState_DataRateRanges `protobuf:"bytes,21,rep,name=rejected_data_rate_ranges,json=rejectedDataRateRanges,proto3" json:"rejected_data_rate_ranges,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Frame counter of uplink, which confirmed the last ADR parameter change.
-	LastADRChangeFCntUp  uint32   `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MACSettings) GetADR() *ADRSettings {
+	if m != nil {
+		return m.ADR
+	}
+	return nil
 }
 
-func (m *MACState) Reset()      { *m = MACState{} }
-func (*MACState) ProtoMessage() {}
-func (*MACState) Descriptor() ([]byte, []int) {
+// ADRSettings are the settings of the adaptive data rate (ADR) algorithm of the end device.
+type ADRSettings struct {
+	// Types that are valid to be assigned to Strategy:
+	//	*ADRSettings_Dynamic
+	//	*ADRSettings_LossBased
+	//	*ADRSettings_Mobile
+	//	*ADRSettings_Static
+	Strategy             isADRSettings_Strategy `protobuf_oneof:"strategy"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ADRSettings) Reset()      { *m = ADRSettings{} }
+func (*ADRSettings) ProtoMessage() {}
+func (*ADRSettings) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a656ee0551c94a80, []int{5}
 }
-func (m *MACState) XXX_Unmarshal(b []byte) error {
+func (m *ADRSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MACState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ADRSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MACState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ADRSettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1091,191 +1047,265 @@ func (m *MACState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MACState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MACState.Merge(m, src)
+func (m *ADRSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSettings.Merge(m, src)
 }
-func (m *MACState) XXX_Size() int {
+func (m *ADRSettings) XXX_Size() int {
 	return m.Size()
 }
-func (m *MACState) XXX_DiscardUnknown() {
-	xxx_messageInfo_MACState.DiscardUnknown(m)
+func (m *ADRSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSettings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MACState proto.InternalMessageInfo
+var xxx_messageInfo_ADRSettings proto.InternalMessageInfo
 
-func (m *MACState) GetCurrentParameters() MACParameters {
-	if m != nil {
-		return m.CurrentParameters
-	}
-	return MACParameters{}
+type isADRSettings_Strategy interface {
+	isADRSettings_Strategy()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *MACState) GetDesiredParameters() MACParameters {
-	if m != nil {
-		return m.DesiredParameters
-	}
-	return MACParameters{}
+type ADRSettings_Dynamic struct {
+	Dynamic *ADRSettings_DynamicStrategy `protobuf:"bytes,1,opt,name=dynamic,proto3,oneof" json:"dynamic,omitempty"`
 }
-
-func (m *MACState) GetDeviceClass() Class {
-	if m != nil {
-		return m.DeviceClass
-	}
-	return CLASS_A
+type ADRSettings_LossBased struct {
+	LossBased *ADRSettings_LossBasedStrategy `protobuf:"bytes,2,opt,name=loss_based,json=lossBased,proto3,oneof" json:"loss_based,omitempty"`
 }
-
-func (m *MACState) GetLoRaWANVersion() MACVersion {
-	if m != nil {
-		return m.LoRaWANVersion
-	}
-	return MAC_UNKNOWN
+type ADRSettings_Mobile struct {
+	Mobile *ADRSettings_MobileStrategy `protobuf:"bytes,3,opt,name=mobile,proto3,oneof" json:"mobile,omitempty"`
 }
-
-func (m *MACState) GetLastConfirmedDownlinkAt() *time.Time {
-	if m != nil {
-		return m.LastConfirmedDownlinkAt
-	}
-	return nil
+type ADRSettings_Static struct {
+	Static *ADRSettings_StaticStrategy `protobuf:"bytes,4,opt,name=static,proto3,oneof" json:"static,omitempty"`
 }
 
-func (m *MACState) GetLastDevStatusFCntUp() uint32 {
-	if m != nil {
-		return m.LastDevStatusFCntUp
-	}
-	return 0
-}
+func (*ADRSettings_Dynamic) isADRSettings_Strategy()   {}
+func (*ADRSettings_LossBased) isADRSettings_Strategy() {}
+func (*ADRSettings_Mobile) isADRSettings_Strategy()    {}
+func (*ADRSettings_Static) isADRSettings_Strategy()    {}
 
-func (m *MACState) GetPingSlotPeriodicity() *PingSlotPeriodValue {
+func (m *ADRSettings) GetStrategy() isADRSettings_Strategy {
 	if m != nil {
-		return m.PingSlotPeriodicity
+		return m.Strategy
 	}
 	return nil
 }
 
-func (m *MACState) GetPendingApplicationDownlink() *ApplicationDownlink {
-	if m != nil {
-		return m.PendingApplicationDownlink
+func (m *ADRSettings) GetDynamic() *ADRSettings_DynamicStrategy {
+	if x, ok := m.GetStrategy().(*ADRSettings_Dynamic); ok {
+		return x.Dynamic
 	}
 	return nil
 }
 
-func (m *MACState) GetQueuedResponses() []*MACCommand {
-	if m != nil {
-		return m.QueuedResponses
+func (m *ADRSettings) GetLossBased() *ADRSettings_LossBasedStrategy {
+	if x, ok := m.GetStrategy().(*ADRSettings_LossBased); ok {
+		return x.LossBased
 	}
 	return nil
 }
 
-func (m *MACState) GetPendingRequests() []*MACCommand {
-	if m != nil {
-		return m.PendingRequests
+func (m *ADRSettings) GetMobile() *ADRSettings_MobileStrategy {
+	if x, ok := m.GetStrategy().(*ADRSettings_Mobile); ok {
+		return x.Mobile
 	}
 	return nil
 }
 
-func (m *MACState) GetQueuedJoinAccept() *MACState_JoinAccept {
-	if m != nil {
-		return m.QueuedJoinAccept
+func (m *ADRSettings) GetStatic() *ADRSettings_StaticStrategy {
+	if x, ok := m.GetStrategy().(*ADRSettings_Static); ok {
+		return x.Static
 	}
 	return nil
 }
 
-func (m *MACState) GetPendingJoinRequest() *MACState_JoinRequest {
-	if m != nil {
-		return m.PendingJoinRequest
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ADRSettings) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ADRSettings_Dynamic)(nil),
+		(*ADRSettings_LossBased)(nil),
+		(*ADRSettings_Mobile)(nil),
+		(*ADRSettings_Static)(nil),
 	}
-	return nil
 }
 
-func (m *MACState) GetRxWindowsAvailable() bool {
-	if m != nil {
-		return m.RxWindowsAvailable
-	}
-	return false
+// The dynamic strategy adapts the data rate, TX output power and number of transmissions to the maximum SNR
+// of the recent uplinks. This is the default strategy.
+type ADRSettings_DynamicStrategy struct {
+	// The ADR margin (dB).
+	// If unset, the ADR margin of the MAC settings will be used.
+	Margin               *types.FloatValue `protobuf:"bytes,1,opt,name=margin,proto3" json:"margin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MACState) GetRecentUplinks() []*UplinkMessage {
-	if m != nil {
-		return m.RecentUplinks
+func (m *ADRSettings_DynamicStrategy) Reset()      { *m = ADRSettings_DynamicStrategy{} }
+func (*ADRSettings_DynamicStrategy) ProtoMessage() {}
+func (*ADRSettings_DynamicStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 0}
+}
+func (m *ADRSettings_DynamicStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRSettings_DynamicStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRSettings_DynamicStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ADRSettings_DynamicStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSettings_DynamicStrategy.Merge(m, src)
+}
+func (m *ADRSettings_DynamicStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRSettings_DynamicStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSettings_DynamicStrategy.DiscardUnknown(m)
 }
 
-func (m *MACState) GetRecentDownlinks() []*DownlinkMessage {
+var xxx_messageInfo_ADRSettings_DynamicStrategy proto.InternalMessageInfo
+
+func (m *ADRSettings_DynamicStrategy) GetMargin() *types.FloatValue {
 	if m != nil {
-		return m.RecentDownlinks
+		return m.Margin
 	}
 	return nil
 }
 
-func (m *MACState) GetLastNetworkInitiatedDownlinkAt() *time.Time {
-	if m != nil {
-		return m.LastNetworkInitiatedDownlinkAt
-	}
-	return nil
+// The loss-based strategy adapts the parameters to the minimum SNR of the recent uplinks, and does not
+// increase the data rate while the packet loss rate exceeds the maximum loss rate.
+type ADRSettings_LossBasedStrategy struct {
+	// The ADR margin (dB).
+	// If unset, the ADR margin of the MAC settings will be used.
+	Margin *types.FloatValue `protobuf:"bytes,1,opt,name=margin,proto3" json:"margin,omitempty"`
+	// The maximum packet loss rate (0-1) at which the data rate may be increased.
+	// If unset, 0.05 will be used.
+	MaxLossRate          *types.FloatValue `protobuf:"bytes,2,opt,name=max_loss_rate,json=maxLossRate,proto3" json:"max_loss_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MACState) GetRejectedADRDataRateIndexes() []DataRateIndex {
-	if m != nil {
-		return m.RejectedADRDataRateIndexes
+func (m *ADRSettings_LossBasedStrategy) Reset()      { *m = ADRSettings_LossBasedStrategy{} }
+func (*ADRSettings_LossBasedStrategy) ProtoMessage() {}
+func (*ADRSettings_LossBasedStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 1}
+}
+func (m *ADRSettings_LossBasedStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRSettings_LossBasedStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRSettings_LossBasedStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ADRSettings_LossBasedStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSettings_LossBasedStrategy.Merge(m, src)
+}
+func (m *ADRSettings_LossBasedStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRSettings_LossBasedStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSettings_LossBasedStrategy.DiscardUnknown(m)
 }
 
-func (m *MACState) GetRejectedADRTxPowerIndexes() []uint32 {
+var xxx_messageInfo_ADRSettings_LossBasedStrategy proto.InternalMessageInfo
+
+func (m *ADRSettings_LossBasedStrategy) GetMargin() *types.FloatValue {
 	if m != nil {
-		return m.RejectedADRTxPowerIndexes
+		return m.Margin
 	}
 	return nil
 }
 
-func (m *MACState) GetRejectedFrequencies() []uint64 {
+func (m *ADRSettings_LossBasedStrategy) GetMaxLossRate() *types.FloatValue {
 	if m != nil {
-		return m.RejectedFrequencies
+		return m.MaxLossRate
 	}
 	return nil
 }
 
-func (m *MACState) GetLastDownlinkAt() *time.Time {
-	if m != nil {
-		return m.LastDownlinkAt
-	}
-	return nil
+// The mobile strategy does not increase the data rate, and only lowers the TX output power when the link
+// margin allows it. This is suitable for end devices that move.
+type ADRSettings_MobileStrategy struct {
+	// The ADR margin (dB).
+	// If unset, the ADR margin of the MAC settings will be used.
+	Margin               *types.FloatValue `protobuf:"bytes,1,opt,name=margin,proto3" json:"margin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MACState) GetRejectedDataRateRanges() map[uint64]*MACState_DataRateRanges {
-	if m != nil {
-		return m.RejectedDataRateRanges
+func (m *ADRSettings_MobileStrategy) Reset()      { *m = ADRSettings_MobileStrategy{} }
+func (*ADRSettings_MobileStrategy) ProtoMessage() {}
+func (*ADRSettings_MobileStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 2}
+}
+func (m *ADRSettings_MobileStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRSettings_MobileStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRSettings_MobileStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ADRSettings_MobileStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSettings_MobileStrategy.Merge(m, src)
+}
+func (m *ADRSettings_MobileStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRSettings_MobileStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSettings_MobileStrategy.DiscardUnknown(m)
 }
 
-func (m *MACState) GetLastADRChangeFCntUp() uint32 {
+var xxx_messageInfo_ADRSettings_MobileStrategy proto.InternalMessageInfo
+
+func (m *ADRSettings_MobileStrategy) GetMargin() *types.FloatValue {
 	if m != nil {
-		return m.LastADRChangeFCntUp
+		return m.Margin
 	}
-	return 0
+	return nil
 }
 
-type MACState_JoinRequest struct {
-	DownlinkSettings     DLSettings `protobuf:"bytes,6,opt,name=downlink_settings,json=downlinkSettings,proto3" json:"downlink_settings"`
-	RxDelay              RxDelay    `protobuf:"varint,7,opt,name=rx_delay,json=rxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"rx_delay,omitempty"`
-	CFList               *CFList    `protobuf:"bytes,8,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+// The static strategy configures fixed parameters, regardless of the link quality.
+type ADRSettings_StaticStrategy struct {
+	DataRateIndex        DataRateIndex `protobuf:"varint,1,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	TxPowerIndex         uint32        `protobuf:"varint,2,opt,name=tx_power_index,json=txPowerIndex,proto3" json:"tx_power_index,omitempty"`
+	NbTrans              uint32        `protobuf:"varint,3,opt,name=nb_trans,json=nbTrans,proto3" json:"nb_trans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *MACState_JoinRequest) Reset()      { *m = MACState_JoinRequest{} }
-func (*MACState_JoinRequest) ProtoMessage() {}
-func (*MACState_JoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 0}
+func (m *ADRSettings_StaticStrategy) Reset()      { *m = ADRSettings_StaticStrategy{} }
+func (*ADRSettings_StaticStrategy) ProtoMessage() {}
+func (*ADRSettings_StaticStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 3}
 }
-func (m *MACState_JoinRequest) XXX_Unmarshal(b []byte) error {
+func (m *ADRSettings_StaticStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MACState_JoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ADRSettings_StaticStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MACState_JoinRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ADRSettings_StaticStrategy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1285,63 +1315,76 @@ func (m *MACState_JoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MACState_JoinRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MACState_JoinRequest.Merge(m, src)
+func (m *ADRSettings_StaticStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSettings_StaticStrategy.Merge(m, src)
 }
-func (m *MACState_JoinRequest) XXX_Size() int {
+func (m *ADRSettings_StaticStrategy) XXX_Size() int {
 	return m.Size()
 }
-func (m *MACState_JoinRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MACState_JoinRequest.DiscardUnknown(m)
+func (m *ADRSettings_StaticStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSettings_StaticStrategy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MACState_JoinRequest proto.InternalMessageInfo
+var xxx_messageInfo_ADRSettings_StaticStrategy proto.InternalMessageInfo
 
-func (m *MACState_JoinRequest) GetDownlinkSettings() DLSettings {
+func (m *ADRSettings_StaticStrategy) GetDataRateIndex() DataRateIndex {
 	if m != nil {
-		return m.DownlinkSettings
+		return m.DataRateIndex
 	}
-	return DLSettings{}
+	return DATA_RATE_0
 }
 
-func (m *MACState_JoinRequest) GetRxDelay() RxDelay {
+func (m *ADRSettings_StaticStrategy) GetTxPowerIndex() uint32 {
 	if m != nil {
-		return m.RxDelay
+		return m.TxPowerIndex
 	}
-	return RX_DELAY_0
+	return 0
 }
 
-func (m *MACState_JoinRequest) GetCFList() *CFList {
+func (m *ADRSettings_StaticStrategy) GetNbTrans() uint32 {
 	if m != nil {
-		return m.CFList
+		return m.NbTrans
 	}
-	return nil
+	return 0
 }
 
-type MACState_JoinAccept struct {
-	// Payload of the join-accept received from Join Server.
-	Payload []byte               `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Request MACState_JoinRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
-	// Network session keys associated with the join.
-	Keys                 SessionKeys                                             `protobuf:"bytes,3,opt,name=keys,proto3" json:"keys"`
-	CorrelationIDs       []string                                                `protobuf:"bytes,4,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	DevAddr              go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,5,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
-	NetID                go_thethings_network_lorawan_stack_v3_pkg_types.NetID   `protobuf:"bytes,6,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_id"`
-	XXX_NoUnkeyedLiteral struct{}                                                `json:"-"`
-	XXX_sizecache        int32                                                   `json:"-"`
+// ADRDecision explains a decision of the adaptive data rate (ADR) algorithm.
+type ADRDecision struct {
+	// The ADR strategy that made the decision.
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Number of recent uplinks considered.
+	UplinkCount uint32 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// The SNR (dB) of the recent uplinks that the decision is based on.
+	SNR float32 `protobuf:"fixed32,3,opt,name=snr,proto3" json:"snr,omitempty"`
+	// The demodulation floor (dB) of the data rate of the recent uplinks.
+	DemodulationFloor float32 `protobuf:"fixed32,4,opt,name=demodulation_floor,json=demodulationFloor,proto3" json:"demodulation_floor,omitempty"`
+	// The ADR margin (dB), including the safety margin if too few uplinks are available.
+	Margin float32 `protobuf:"fixed32,5,opt,name=margin,proto3" json:"margin,omitempty"`
+	// The link margin (dB) that is left after the decision.
+	RemainingMargin float32 `protobuf:"fixed32,6,opt,name=remaining_margin,json=remainingMargin,proto3" json:"remaining_margin,omitempty"`
+	// The packet loss rate of the recent uplinks.
+	LossRate float32 `protobuf:"fixed32,7,opt,name=loss_rate,json=lossRate,proto3" json:"loss_rate,omitempty"`
+	// The chosen data rate index.
+	DataRateIndex DataRateIndex `protobuf:"varint,8,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// The chosen TX output power index.
+	TxPowerIndex uint32 `protobuf:"varint,9,opt,name=tx_power_index,json=txPowerIndex,proto3" json:"tx_power_index,omitempty"`
+	// The chosen number of transmissions.
+	NbTrans              uint32   `protobuf:"varint,10,opt,name=nb_trans,json=nbTrans,proto3" json:"nb_trans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
-func (*MACState_JoinAccept) ProtoMessage() {}
-func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 1}
+func (m *ADRDecision) Reset()      { *m = ADRDecision{} }
+func (*ADRDecision) ProtoMessage() {}
+func (*ADRDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{6}
 }
-func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
+func (m *ADRDecision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MACState_JoinAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ADRDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MACState_JoinAccept.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ADRDecision.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1351,116 +1394,165 @@ func (m *MACState_JoinAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MACState_JoinAccept) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MACState_JoinAccept.Merge(m, src)
+func (m *ADRDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRDecision.Merge(m, src)
 }
-func (m *MACState_JoinAccept) XXX_Size() int {
+func (m *ADRDecision) XXX_Size() int {
 	return m.Size()
 }
-func (m *MACState_JoinAccept) XXX_DiscardUnknown() {
-	xxx_messageInfo_MACState_JoinAccept.DiscardUnknown(m)
+func (m *ADRDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRDecision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MACState_JoinAccept proto.InternalMessageInfo
+var xxx_messageInfo_ADRDecision proto.InternalMessageInfo
 
-func (m *MACState_JoinAccept) GetPayload() []byte {
+func (m *ADRDecision) GetStrategy() string {
 	if m != nil {
-		return m.Payload
+		return m.Strategy
 	}
-	return nil
+	return ""
 }
 
-func (m *MACState_JoinAccept) GetRequest() MACState_JoinRequest {
+func (m *ADRDecision) GetUplinkCount() uint32 {
 	if m != nil {
-		return m.Request
+		return m.UplinkCount
 	}
-	return MACState_JoinRequest{}
+	return 0
 }
 
-func (m *MACState_JoinAccept) GetKeys() SessionKeys {
+func (m *ADRDecision) GetSNR() float32 {
 	if m != nil {
-		return m.Keys
+		return m.SNR
 	}
-	return SessionKeys{}
+	return 0
 }
 
-func (m *MACState_JoinAccept) GetCorrelationIDs() []string {
+func (m *ADRDecision) GetDemodulationFloor() float32 {
 	if m != nil {
-		return m.CorrelationIDs
+		return m.DemodulationFloor
 	}
-	return nil
+	return 0
 }
 
-type MACState_DataRateRange struct {
-	MinDataRateIndex     DataRateIndex `protobuf:"varint,1,opt,name=min_data_rate_index,json=minDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"min_data_rate_index,omitempty"`
-	MaxDataRateIndex     DataRateIndex `protobuf:"varint,2,opt,name=max_data_rate_index,json=maxDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"max_data_rate_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ADRDecision) GetMargin() float32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
 }
 
-func (m *MACState_DataRateRange) Reset()      { *m = MACState_DataRateRange{} }
-func (*MACState_DataRateRange) ProtoMessage() {}
-func (*MACState_DataRateRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 2}
-}
-func (m *MACState_DataRateRange) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MACState_DataRateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MACState_DataRateRange.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ADRDecision) GetRemainingMargin() float32 {
+	if m != nil {
+		return m.RemainingMargin
 	}
-}
-func (m *MACState_DataRateRange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MACState_DataRateRange.Merge(m, src)
-}
-func (m *MACState_DataRateRange) XXX_Size() int {
-	return m.Size()
-}
-func (m *MACState_DataRateRange) XXX_DiscardUnknown() {
-	xxx_messageInfo_MACState_DataRateRange.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_MACState_DataRateRange proto.InternalMessageInfo
+func (m *ADRDecision) GetLossRate() float32 {
+	if m != nil {
+		return m.LossRate
+	}
+	return 0
+}
 
-func (m *MACState_DataRateRange) GetMinDataRateIndex() DataRateIndex {
+func (m *ADRDecision) GetDataRateIndex() DataRateIndex {
 	if m != nil {
-		return m.MinDataRateIndex
+		return m.DataRateIndex
 	}
 	return DATA_RATE_0
 }
 
-func (m *MACState_DataRateRange) GetMaxDataRateIndex() DataRateIndex {
+func (m *ADRDecision) GetTxPowerIndex() uint32 {
 	if m != nil {
-		return m.MaxDataRateIndex
+		return m.TxPowerIndex
 	}
-	return DATA_RATE_0
+	return 0
 }
 
-type MACState_DataRateRanges struct {
-	Ranges               []*MACState_DataRateRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *ADRDecision) GetNbTrans() uint32 {
+	if m != nil {
+		return m.NbTrans
+	}
+	return 0
 }
 
-func (m *MACState_DataRateRanges) Reset()      { *m = MACState_DataRateRanges{} }
-func (*MACState_DataRateRanges) ProtoMessage() {}
-func (*MACState_DataRateRanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 3}
+// MACState represents the state of MAC layer of the device.
+// MACState is reset on each join for OTAA or ResetInd for ABP devices.
+// This is used internally by the Network Server.
+type MACState struct {
+	// Current LoRaWAN MAC parameters.
+	CurrentParameters MACParameters `protobuf:"bytes,1,opt,name=current_parameters,json=currentParameters,proto3" json:"current_parameters"`
+	// Desired LoRaWAN MAC parameters.
+	DesiredParameters MACParameters `protobuf:"bytes,2,opt,name=desired_parameters,json=desiredParameters,proto3" json:"desired_parameters"`
+	// Currently active LoRaWAN device class
+	// - Device class is A by default
+	// - If device sets ClassB bit in uplink, this will be set to B
+	// - If device sent DeviceModeInd MAC message, this will be set to that value
+	DeviceClass Class `protobuf:"varint,3,opt,name=device_class,json=deviceClass,proto3,enum=ttn.lorawan.v3.Class" json:"device_class,omitempty"`
+	// LoRaWAN MAC version.
+	LoRaWANVersion MACVersion `protobuf:"varint,4,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	// Time when the last confirmed downlink message or MAC command was scheduled.
+	LastConfirmedDownlinkAt *time.Time `protobuf:"bytes,5,opt,name=last_confirmed_downlink_at,json=lastConfirmedDownlinkAt,proto3,stdtime" json:"last_confirmed_downlink_at,omitempty"`
+	// Frame counter value of last uplink containing DevStatusAns.
+	LastDevStatusFCntUp uint32 `protobuf:"varint,6,opt,name=last_dev_status_f_cnt_up,json=lastDevStatusFCntUp,proto3" json:"last_dev_status_f_cnt_up,omitempty"`
+	// Periodicity of the class B ping slot.
+	PingSlotPeriodicity *PingSlotPeriodValue `protobuf:"bytes,7,opt,name=ping_slot_periodicity,json=pingSlotPeriodicity,proto3" json:"ping_slot_periodicity,omitempty"`
+	// A confirmed application downlink, for which an acknowledgment is expected to arrive.
+	PendingApplicationDownlink *ApplicationDownlink `protobuf:"bytes,8,opt,name=pending_application_downlink,json=pendingApplicationDownlink,proto3" json:"pending_application_downlink,omitempty"`
+	// Queued MAC responses.
+	// Regenerated on each uplink.
+	QueuedResponses []*MACCommand `protobuf:"bytes,9,rep,name=queued_responses,json=queuedResponses,proto3" json:"queued_responses,omitempty"`
+	// Pending MAC requests(i.e. sent requests, for which no response has been received yet).
+	// Regenerated on each downlink.
+	PendingRequests []*MACCommand `protobuf:"bytes,10,rep,name=pending_requests,json=pendingRequests,proto3" json:"pending_requests,omitempty"`
+	// Queued join-accept.
+	// Set each time a (re-)join request accept is received from Join Server and removed each time a downlink is scheduled.
+	QueuedJoinAccept *MACState_JoinAccept `protobuf:"bytes,11,opt,name=queued_join_accept,json=queuedJoinAccept,proto3" json:"queued_join_accept,omitempty"`
+	// Pending join request.
+	// Set each time a join-accept is scheduled and removed each time an uplink is received from the device.
+	PendingJoinRequest *MACState_JoinRequest `protobuf:"bytes,12,opt,name=pending_join_request,json=pendingJoinRequest,proto3" json:"pending_join_request,omitempty"`
+	// Whether or not Rx windows are expected to be open.
+	// Set to true every time an uplink is received.
+	// Set to false every time a successful downlink scheduling attempt is made.
+	RxWindowsAvailable bool `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
+	// Recent data uplink messages sorted by time.
+	// The number of messages stored may depend on configuration.
+	RecentUplinks []*UplinkMessage `protobuf:"bytes,14,rep,name=recent_uplinks,json=recentUplinks,proto3" json:"recent_uplinks,omitempty"`
+	// Recent data downlink messages sorted by time.
+	// The number of messages stored may depend on configuration.
+	RecentDownlinks []*DownlinkMessage `protobuf:"bytes,15,rep,name=recent_downlinks,json=recentDownlinks,proto3" json:"recent_downlinks,omitempty"`
+	// Time when the last network-initiated downlink message was scheduled.
+	LastNetworkInitiatedDownlinkAt *time.Time `protobuf:"bytes,16,opt,name=last_network_initiated_downlink_at,json=lastNetworkInitiatedDownlinkAt,proto3,stdtime" json:"last_network_initiated_downlink_at,omitempty"`
+	// ADR Data rate index values rejected by the device.
+	// Reset each time `current_parameters.channels` change.
+	// Elements are sorted in ascending order.
+	RejectedADRDataRateIndexes []DataRateIndex `protobuf:"varint,17,rep,packed,name=rejected_adr_data_rate_indexes,json=rejectedAdrDataRateIndexes,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"rejected_adr_data_rate_indexes,omitempty"`
+	// ADR TX output power index values rejected by the device.
+	// Elements are sorted in ascending order.
+	RejectedADRTxPowerIndexes []uint32 `protobuf:"varint,18,rep,packed,name=rejected_adr_tx_power_indexes,json=rejectedAdrTxPowerIndexes,proto3" json:"rejected_adr_tx_power_indexes,omitempty"`
+	// Frequencies rejected by the device.
+	RejectedFrequencies []uint64 `protobuf:"varint,19,rep,packed,name=rejected_frequencies,json=rejectedFrequencies,proto3" json:"rejected_frequencies,omitempty"`
+	// Time when the last downlink message was scheduled.
+	LastDownlinkAt *time.Time `protobuf:"bytes,20,opt,name=last_downlink_at,json=lastDownlinkAt,proto3,stdtime" json:"last_downlink_at,omitempty"`
+	// Data rate ranges rejected by the device per frequency.
+	RejectedDataRateRanges map[uint64]*MACState_DataRateRanges `protobuf:"bytes,21,rep,name=rejected_data_rate_ranges,json=rejectedDataRateRanges,proto3" json:"rejected_data_rate_ranges,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Frame counter of uplink, which confirmed the last ADR parameter change.
+	LastADRChangeFCntUp  uint32   `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *MACState_DataRateRanges) XXX_Unmarshal(b []byte) error {
+
+func (m *MACState) Reset()      { *m = MACState{} }
+func (*MACState) ProtoMessage() {}
+func (*MACState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7}
+}
+func (m *MACState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MACState_DataRateRanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MACState_DataRateRanges.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1470,614 +1562,376 @@ func (m *MACState_DataRateRanges) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MACState_DataRateRanges) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MACState_DataRateRanges.Merge(m, src)
+func (m *MACState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState.Merge(m, src)
 }
-func (m *MACState_DataRateRanges) XXX_Size() int {
+func (m *MACState) XXX_Size() int {
 	return m.Size()
 }
-func (m *MACState_DataRateRanges) XXX_DiscardUnknown() {
-	xxx_messageInfo_MACState_DataRateRanges.DiscardUnknown(m)
+func (m *MACState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MACState_DataRateRanges proto.InternalMessageInfo
+var xxx_messageInfo_MACState proto.InternalMessageInfo
 
-func (m *MACState_DataRateRanges) GetRanges() []*MACState_DataRateRange {
+func (m *MACState) GetCurrentParameters() MACParameters {
 	if m != nil {
-		return m.Ranges
+		return m.CurrentParameters
 	}
-	return nil
-}
-
-// Authentication code for end devices.
-type EndDeviceAuthenticationCode struct {
-	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	ValidFrom            *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
-	ValidTo              *time.Time `protobuf:"bytes,3,opt,name=valid_to,json=validTo,proto3,stdtime" json:"valid_to,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	return MACParameters{}
 }
 
-func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
-func (*EndDeviceAuthenticationCode) ProtoMessage() {}
-func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{6}
-}
-func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EndDeviceAuthenticationCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EndDeviceAuthenticationCode.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MACState) GetDesiredParameters() MACParameters {
+	if m != nil {
+		return m.DesiredParameters
 	}
+	return MACParameters{}
 }
-func (m *EndDeviceAuthenticationCode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceAuthenticationCode.Merge(m, src)
-}
-func (m *EndDeviceAuthenticationCode) XXX_Size() int {
-	return m.Size()
-}
-func (m *EndDeviceAuthenticationCode) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceAuthenticationCode.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EndDeviceAuthenticationCode proto.InternalMessageInfo
 
-func (m *EndDeviceAuthenticationCode) GetValue() string {
+func (m *MACState) GetDeviceClass() Class {
 	if m != nil {
-		return m.Value
+		return m.DeviceClass
 	}
-	return ""
+	return CLASS_A
 }
 
-func (m *EndDeviceAuthenticationCode) GetValidFrom() *time.Time {
+func (m *MACState) GetLoRaWANVersion() MACVersion {
 	if m != nil {
-		return m.ValidFrom
+		return m.LoRaWANVersion
 	}
-	return nil
+	return MAC_UNKNOWN
 }
 
-func (m *EndDeviceAuthenticationCode) GetValidTo() *time.Time {
+func (m *MACState) GetLastConfirmedDownlinkAt() *time.Time {
 	if m != nil {
-		return m.ValidTo
+		return m.LastConfirmedDownlinkAt
 	}
 	return nil
 }
 
-// Defines an End Device registration and its state on the network.
-// The persistence of the EndDevice is divided between the Network Server, Application Server and Join Server.
-// SDKs are responsible for combining (if desired) the three.
-type EndDevice struct {
-	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
-	CreatedAt            time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
-	UpdatedAt            time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
-	// Friendly name of the device. Stored in Entity Registry.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// Description of the device. Stored in Entity Registry.
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// Key-value attributes for this end device. Typically used for organizing end devices or for storing integration-specific data. Stored in Entity Registry.
-	Attributes map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Version Identifiers. Stored in Entity Registry, Network Server and Application Server.
-	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,7,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
-	// Default service profile. Stored in Entity Registry.
-	ServiceProfileID string `protobuf:"bytes,8,opt,name=service_profile_id,json=serviceProfileId,proto3" json:"service_profile_id,omitempty"`
-	// The address of the Network Server where this device is supposed to be registered.
-	// Stored in Entity Registry and Join Server.
-	// The typical format of the address is "host:port". If the port is omitted,
-	// the normal port inference (with DNS lookup, otherwise defaults) is used.
-	// The connection shall be established with transport layer security (TLS).
-	// Custom certificate authorities may be configured out-of-band.
-	NetworkServerAddress string `protobuf:"bytes,9,opt,name=network_server_address,json=networkServerAddress,proto3" json:"network_server_address,omitempty"`
-	// The KEK label of the Network Server to use for wrapping network session keys.
-	// Stored in Join Server.
-	NetworkServerKEKLabel string `protobuf:"bytes,47,opt,name=network_server_kek_label,json=networkServerKekLabel,proto3" json:"network_server_kek_label,omitempty"`
-	// The address of the Application Server where this device is supposed to be registered.
-	// Stored in Entity Registry and Join Server.
-	// The typical format of the address is "host:port". If the port is omitted,
-	// the normal port inference (with DNS lookup, otherwise defaults) is used.
-	// The connection shall be established with transport layer security (TLS).
-	// Custom certificate authorities may be configured out-of-band.
-	ApplicationServerAddress string `protobuf:"bytes,10,opt,name=application_server_address,json=applicationServerAddress,proto3" json:"application_server_address,omitempty"`
-	// The KEK label of the Application Server to use for wrapping the application session key.
-	// Stored in Join Server.
-	ApplicationServerKEKLabel string `protobuf:"bytes,48,opt,name=application_server_kek_label,json=applicationServerKekLabel,proto3" json:"application_server_kek_label,omitempty"`
-	// The AS-ID of the Application Server to use.
-	// Stored in Join Server.
-	ApplicationServerID string `protobuf:"bytes,49,opt,name=application_server_id,json=applicationServerId,proto3" json:"application_server_id,omitempty"`
-	// The address of the Join Server where this device is supposed to be registered.
-	// Stored in Entity Registry.
-	// The typical format of the address is "host:port". If the port is omitted,
-	// the normal port inference (with DNS lookup, otherwise defaults) is used.
-	// The connection shall be established with transport layer security (TLS).
-	// Custom certificate authorities may be configured out-of-band.
-	JoinServerAddress string `protobuf:"bytes,11,opt,name=join_server_address,json=joinServerAddress,proto3" json:"join_server_address,omitempty"`
-	// Location of the device. Stored in Entity Registry.
-	Locations map[string]*Location `protobuf:"bytes,12,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Stored in Entity Registry.
-	Picture *Picture `protobuf:"bytes,50,opt,name=picture,proto3" json:"picture,omitempty"`
-	// Whether the device supports class B.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	SupportsClassB bool `protobuf:"varint,13,opt,name=supports_class_b,json=supportsClassB,proto3" json:"supports_class_b,omitempty"`
-	// Whether the device supports class C.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	SupportsClassC bool `protobuf:"varint,14,opt,name=supports_class_c,json=supportsClassC,proto3" json:"supports_class_c,omitempty"`
-	// LoRaWAN MAC version. Stored in Network Server.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	LoRaWANVersion MACVersion `protobuf:"varint,15,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
-	// LoRaWAN PHY version. Stored in Network Server.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	LoRaWANPHYVersion PHYVersion `protobuf:"varint,16,opt,name=lorawan_phy_version,json=lorawanPhyVersion,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"lorawan_phy_version,omitempty"`
-	// ID of the frequency plan used by this device.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	FrequencyPlanID string `protobuf:"bytes,17,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
-	// Minimum frequency the device is capable of using (Hz). Stored in Network Server.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	MinFrequency uint64 `protobuf:"varint,18,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
-	// Maximum frequency the device is capable of using (Hz). Stored in Network Server.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	MaxFrequency uint64 `protobuf:"varint,19,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
-	// The device supports join (it's OTAA).
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	SupportsJoin bool `protobuf:"varint,20,opt,name=supports_join,json=supportsJoin,proto3" json:"supports_join,omitempty"`
-	// Whether the device resets the join and dev nonces (not LoRaWAN compliant). Stored in Join Server.
-	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
-	ResetsJoinNonces bool `protobuf:"varint,21,opt,name=resets_join_nonces,json=resetsJoinNonces,proto3" json:"resets_join_nonces,omitempty"`
-	// Device root keys. Stored in Join Server.
-	RootKeys *RootKeys `protobuf:"bytes,22,opt,name=root_keys,json=rootKeys,proto3" json:"root_keys,omitempty"`
-	// Home NetID. Stored in Join Server.
-	NetID *go_thethings_network_lorawan_stack_v3_pkg_types.NetID `protobuf:"bytes,23,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_id,omitempty"`
-	// Settings for how the Network Server handles MAC layer for this device. Stored in Network Server.
-	MACSettings *MACSettings `protobuf:"bytes,24,opt,name=mac_settings,json=macSettings,proto3" json:"mac_settings,omitempty"`
-	// MAC state of the device. Stored in Network Server.
-	MACState *MACState `protobuf:"bytes,25,opt,name=mac_state,json=macState,proto3" json:"mac_state,omitempty"`
-	// Pending MAC state of the device. Stored in Network Server.
-	PendingMACState *MACState `protobuf:"bytes,44,opt,name=pending_mac_state,json=pendingMacState,proto3" json:"pending_mac_state,omitempty"`
-	// Current session of the device. Stored in Network Server and Application Server.
-	Session *Session `protobuf:"bytes,26,opt,name=session,proto3" json:"session,omitempty"`
-	// Pending session. Stored in Network Server and Application Server until RekeyInd is received.
-	PendingSession *Session `protobuf:"bytes,27,opt,name=pending_session,json=pendingSession,proto3" json:"pending_session,omitempty"`
-	// Last DevNonce used.
-	// This field is only used for devices using LoRaWAN version 1.1 and later.
-	// Stored in Join Server.
-	LastDevNonce uint32 `protobuf:"varint,28,opt,name=last_dev_nonce,json=lastDevNonce,proto3" json:"last_dev_nonce,omitempty"`
-	// Used DevNonces sorted in ascending order.
-	// This field is only used for devices using LoRaWAN versions preceding 1.1.
-	// Stored in Join Server.
-	UsedDevNonces []uint32 `protobuf:"varint,29,rep,packed,name=used_dev_nonces,json=usedDevNonces,proto3" json:"used_dev_nonces,omitempty"`
-	// Last JoinNonce/AppNonce(for devices using LoRaWAN versions preceding 1.1) used.
-	// Stored in Join Server.
-	LastJoinNonce uint32 `protobuf:"varint,30,opt,name=last_join_nonce,json=lastJoinNonce,proto3" json:"last_join_nonce,omitempty"`
-	// Last Rejoin counter value used (type 0/2).
-	// Stored in Join Server.
-	LastRJCount0 uint32 `protobuf:"varint,31,opt,name=last_rj_count_0,json=lastRjCount0,proto3" json:"last_rj_count_0,omitempty"`
-	// Last Rejoin counter value used (type 1).
-	// Stored in Join Server.
-	LastRJCount1 uint32 `protobuf:"varint,32,opt,name=last_rj_count_1,json=lastRjCount1,proto3" json:"last_rj_count_1,omitempty"`
-	// Time when last DevStatus MAC command was received.
-	// Stored in Network Server.
-	LastDevStatusReceivedAt *time.Time `protobuf:"bytes,33,opt,name=last_dev_status_received_at,json=lastDevStatusReceivedAt,proto3,stdtime" json:"last_dev_status_received_at,omitempty"`
-	// The power state of the device; whether it is battery-powered or connected to an external power source.
-	// Received via the DevStatus MAC command at status_received_at.
-	// Stored in Network Server.
-	PowerState PowerState `protobuf:"varint,34,opt,name=power_state,json=powerState,proto3,enum=ttn.lorawan.v3.PowerState" json:"power_state,omitempty"`
-	// Latest-known battery percentage of the device.
-	// Received via the DevStatus MAC command at last_dev_status_received_at or earlier.
-	// Stored in Network Server.
-	BatteryPercentage *types.FloatValue `protobuf:"bytes,35,opt,name=battery_percentage,json=batteryPercentage,proto3" json:"battery_percentage,omitempty"`
-	// Demodulation signal-to-noise ratio (dB).
-	// Received via the DevStatus MAC command at last_dev_status_received_at.
-	// Stored in Network Server.
-	DownlinkMargin int32 `protobuf:"varint,36,opt,name=downlink_margin,json=downlinkMargin,proto3" json:"downlink_margin,omitempty"`
-	// Queued Application downlink messages. Stored in Application Server,
-	// which sets them on the Network Server.
-	// This field is deprecated and is always set equal to session.queued_application_downlinks.
-	QueuedApplicationDownlinks []*ApplicationDownlink `protobuf:"bytes,40,rep,name=queued_application_downlinks,json=queuedApplicationDownlinks,proto3" json:"queued_application_downlinks,omitempty"`
-	// The payload formatters for this end device. Stored in Application Server.
-	// Copied on creation from template identified by version_ids.
-	Formatters *MessagePayloadFormatters `protobuf:"bytes,41,opt,name=formatters,proto3" json:"formatters,omitempty"`
-	// ID of the provisioner. Stored in Join Server.
-	ProvisionerID string `protobuf:"bytes,42,opt,name=provisioner_id,json=provisionerId,proto3" json:"provisioner_id,omitempty"`
-	// Vendor-specific provisioning data. Stored in Join Server.
-	ProvisioningData *types.Struct `protobuf:"bytes,43,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
-	// Indicates whether this device represents a multicast group.
-	Multicast bool `protobuf:"varint,45,opt,name=multicast,proto3" json:"multicast,omitempty"`
-	// Authentication code to claim ownership of the end device. Stored in Join Server.
-	ClaimAuthenticationCode *EndDeviceAuthenticationCode `protobuf:"bytes,46,opt,name=claim_authentication_code,json=claimAuthenticationCode,proto3" json:"claim_authentication_code,omitempty"`
-	// Skip decryption of uplink payloads and encryption of downlink payloads.
-	// This field is deprecated, use skip_payload_crypto_override instead.
-	SkipPayloadCrypto bool `protobuf:"varint,51,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
-	// Skip decryption of uplink payloads and encryption of downlink payloads.
-	// This field overrides the application-level setting.
-	SkipPayloadCryptoOverride *types.BoolValue `protobuf:"bytes,52,opt,name=skip_payload_crypto_override,json=skipPayloadCryptoOverride,proto3" json:"skip_payload_crypto_override,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}         `json:"-"`
-	XXX_sizecache             int32            `json:"-"`
-}
-
-func (m *EndDevice) Reset()      { *m = EndDevice{} }
-func (*EndDevice) ProtoMessage() {}
-func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{7}
-}
-func (m *EndDevice) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EndDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EndDevice.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MACState) GetLastDevStatusFCntUp() uint32 {
+	if m != nil {
+		return m.LastDevStatusFCntUp
 	}
+	return 0
 }
-func (m *EndDevice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDevice.Merge(m, src)
-}
-func (m *EndDevice) XXX_Size() int {
-	return m.Size()
-}
-func (m *EndDevice) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDevice.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EndDevice proto.InternalMessageInfo
 
-func (m *EndDevice) GetCreatedAt() time.Time {
+func (m *MACState) GetPingSlotPeriodicity() *PingSlotPeriodValue {
 	if m != nil {
-		return m.CreatedAt
+		return m.PingSlotPeriodicity
 	}
-	return time.Time{}
+	return nil
 }
 
-func (m *EndDevice) GetUpdatedAt() time.Time {
+func (m *MACState) GetPendingApplicationDownlink() *ApplicationDownlink {
 	if m != nil {
-		return m.UpdatedAt
+		return m.PendingApplicationDownlink
 	}
-	return time.Time{}
+	return nil
 }
 
-func (m *EndDevice) GetName() string {
+func (m *MACState) GetQueuedResponses() []*MACCommand {
 	if m != nil {
-		return m.Name
+		return m.QueuedResponses
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetDescription() string {
+func (m *MACState) GetPendingRequests() []*MACCommand {
 	if m != nil {
-		return m.Description
+		return m.PendingRequests
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetAttributes() map[string]string {
+func (m *MACState) GetQueuedJoinAccept() *MACState_JoinAccept {
 	if m != nil {
-		return m.Attributes
+		return m.QueuedJoinAccept
 	}
 	return nil
 }
 
-func (m *EndDevice) GetVersionIDs() *EndDeviceVersionIdentifiers {
+func (m *MACState) GetPendingJoinRequest() *MACState_JoinRequest {
 	if m != nil {
-		return m.VersionIDs
+		return m.PendingJoinRequest
 	}
 	return nil
 }
 
-func (m *EndDevice) GetServiceProfileID() string {
+func (m *MACState) GetRxWindowsAvailable() bool {
 	if m != nil {
-		return m.ServiceProfileID
+		return m.RxWindowsAvailable
 	}
-	return ""
+	return false
 }
 
-func (m *EndDevice) GetNetworkServerAddress() string {
+func (m *MACState) GetRecentUplinks() []*UplinkMessage {
 	if m != nil {
-		return m.NetworkServerAddress
+		return m.RecentUplinks
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetNetworkServerKEKLabel() string {
+func (m *MACState) GetRecentDownlinks() []*DownlinkMessage {
 	if m != nil {
-		return m.NetworkServerKEKLabel
+		return m.RecentDownlinks
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetApplicationServerAddress() string {
+func (m *MACState) GetLastNetworkInitiatedDownlinkAt() *time.Time {
 	if m != nil {
-		return m.ApplicationServerAddress
+		return m.LastNetworkInitiatedDownlinkAt
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetApplicationServerKEKLabel() string {
+func (m *MACState) GetRejectedADRDataRateIndexes() []DataRateIndex {
 	if m != nil {
-		return m.ApplicationServerKEKLabel
+		return m.RejectedADRDataRateIndexes
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetApplicationServerID() string {
+func (m *MACState) GetRejectedADRTxPowerIndexes() []uint32 {
 	if m != nil {
-		return m.ApplicationServerID
+		return m.RejectedADRTxPowerIndexes
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetJoinServerAddress() string {
+func (m *MACState) GetRejectedFrequencies() []uint64 {
 	if m != nil {
-		return m.JoinServerAddress
+		return m.RejectedFrequencies
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDevice) GetLocations() map[string]*Location {
+func (m *MACState) GetLastDownlinkAt() *time.Time {
 	if m != nil {
-		return m.Locations
+		return m.LastDownlinkAt
 	}
 	return nil
 }
 
-func (m *EndDevice) GetPicture() *Picture {
+func (m *MACState) GetRejectedDataRateRanges() map[uint64]*MACState_DataRateRanges {
 	if m != nil {
-		return m.Picture
+		return m.RejectedDataRateRanges
 	}
 	return nil
 }
 
-func (m *EndDevice) GetSupportsClassB() bool {
+func (m *MACState) GetLastADRChangeFCntUp() uint32 {
 	if m != nil {
-		return m.SupportsClassB
+		return m.LastADRChangeFCntUp
 	}
-	return false
+	return 0
 }
 
-func (m *EndDevice) GetSupportsClassC() bool {
-	if m != nil {
-		return m.SupportsClassC
-	}
-	return false
+type MACState_JoinRequest struct {
+	DownlinkSettings     DLSettings `protobuf:"bytes,6,opt,name=downlink_settings,json=downlinkSettings,proto3" json:"downlink_settings"`
+	RxDelay              RxDelay    `protobuf:"varint,7,opt,name=rx_delay,json=rxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"rx_delay,omitempty"`
+	CFList               *CFList    `protobuf:"bytes,8,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *EndDevice) GetLoRaWANVersion() MACVersion {
-	if m != nil {
-		return m.LoRaWANVersion
-	}
-	return MAC_UNKNOWN
+func (m *MACState_JoinRequest) Reset()      { *m = MACState_JoinRequest{} }
+func (*MACState_JoinRequest) ProtoMessage() {}
+func (*MACState_JoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7, 0}
 }
-
-func (m *EndDevice) GetLoRaWANPHYVersion() PHYVersion {
-	if m != nil {
-		return m.LoRaWANPHYVersion
-	}
-	return PHY_UNKNOWN
+func (m *MACState_JoinRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EndDevice) GetFrequencyPlanID() string {
-	if m != nil {
-		return m.FrequencyPlanID
+func (m *MACState_JoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACState_JoinRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *EndDevice) GetMinFrequency() uint64 {
-	if m != nil {
-		return m.MinFrequency
-	}
-	return 0
+func (m *MACState_JoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_JoinRequest.Merge(m, src)
 }
-
-func (m *EndDevice) GetMaxFrequency() uint64 {
-	if m != nil {
-		return m.MaxFrequency
-	}
-	return 0
+func (m *MACState_JoinRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EndDevice) GetSupportsJoin() bool {
-	if m != nil {
-		return m.SupportsJoin
-	}
-	return false
+func (m *MACState_JoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_JoinRequest.DiscardUnknown(m)
 }
 
-func (m *EndDevice) GetResetsJoinNonces() bool {
+var xxx_messageInfo_MACState_JoinRequest proto.InternalMessageInfo
+
+func (m *MACState_JoinRequest) GetDownlinkSettings() DLSettings {
 	if m != nil {
-		return m.ResetsJoinNonces
+		return m.DownlinkSettings
 	}
-	return false
+	return DLSettings{}
 }
 
-func (m *EndDevice) GetRootKeys() *RootKeys {
+func (m *MACState_JoinRequest) GetRxDelay() RxDelay {
 	if m != nil {
-		return m.RootKeys
+		return m.RxDelay
 	}
-	return nil
+	return RX_DELAY_0
 }
 
-func (m *EndDevice) GetMACSettings() *MACSettings {
+func (m *MACState_JoinRequest) GetCFList() *CFList {
 	if m != nil {
-		return m.MACSettings
+		return m.CFList
 	}
 	return nil
 }
 
-func (m *EndDevice) GetMACState() *MACState {
-	if m != nil {
-		return m.MACState
-	}
-	return nil
+type MACState_JoinAccept struct {
+	// Payload of the join-accept received from Join Server.
+	Payload []byte               `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Request MACState_JoinRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
+	// Network session keys associated with the join.
+	Keys                 SessionKeys                                             `protobuf:"bytes,3,opt,name=keys,proto3" json:"keys"`
+	CorrelationIDs       []string                                                `protobuf:"bytes,4,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	DevAddr              go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,5,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	NetID                go_thethings_network_lorawan_stack_v3_pkg_types.NetID   `protobuf:"bytes,6,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_id"`
+	XXX_NoUnkeyedLiteral struct{}                                                `json:"-"`
+	XXX_sizecache        int32                                                   `json:"-"`
 }
 
-func (m *EndDevice) GetPendingMACState() *MACState {
-	if m != nil {
-		return m.PendingMACState
+func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
+func (*MACState_JoinAccept) ProtoMessage() {}
+func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7, 1}
+}
+func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACState_JoinAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACState_JoinAccept.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MACState_JoinAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_JoinAccept.Merge(m, src)
+}
+func (m *MACState_JoinAccept) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACState_JoinAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_JoinAccept.DiscardUnknown(m)
 }
 
-func (m *EndDevice) GetSession() *Session {
+var xxx_messageInfo_MACState_JoinAccept proto.InternalMessageInfo
+
+func (m *MACState_JoinAccept) GetPayload() []byte {
 	if m != nil {
-		return m.Session
+		return m.Payload
 	}
 	return nil
 }
 
-func (m *EndDevice) GetPendingSession() *Session {
+func (m *MACState_JoinAccept) GetRequest() MACState_JoinRequest {
 	if m != nil {
-		return m.PendingSession
+		return m.Request
 	}
-	return nil
+	return MACState_JoinRequest{}
 }
 
-func (m *EndDevice) GetLastDevNonce() uint32 {
+func (m *MACState_JoinAccept) GetKeys() SessionKeys {
 	if m != nil {
-		return m.LastDevNonce
+		return m.Keys
 	}
-	return 0
+	return SessionKeys{}
 }
 
-func (m *EndDevice) GetUsedDevNonces() []uint32 {
+func (m *MACState_JoinAccept) GetCorrelationIDs() []string {
 	if m != nil {
-		return m.UsedDevNonces
+		return m.CorrelationIDs
 	}
 	return nil
 }
 
-func (m *EndDevice) GetLastJoinNonce() uint32 {
-	if m != nil {
-		return m.LastJoinNonce
-	}
-	return 0
+type MACState_DataRateRange struct {
+	MinDataRateIndex     DataRateIndex `protobuf:"varint,1,opt,name=min_data_rate_index,json=minDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"min_data_rate_index,omitempty"`
+	MaxDataRateIndex     DataRateIndex `protobuf:"varint,2,opt,name=max_data_rate_index,json=maxDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"max_data_rate_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *EndDevice) GetLastRJCount0() uint32 {
-	if m != nil {
-		return m.LastRJCount0
-	}
-	return 0
+func (m *MACState_DataRateRange) Reset()      { *m = MACState_DataRateRange{} }
+func (*MACState_DataRateRange) ProtoMessage() {}
+func (*MACState_DataRateRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7, 2}
 }
-
-func (m *EndDevice) GetLastRJCount1() uint32 {
-	if m != nil {
-		return m.LastRJCount1
-	}
-	return 0
+func (m *MACState_DataRateRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EndDevice) GetLastDevStatusReceivedAt() *time.Time {
-	if m != nil {
-		return m.LastDevStatusReceivedAt
+func (m *MACState_DataRateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACState_DataRateRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *EndDevice) GetPowerState() PowerState {
-	if m != nil {
-		return m.PowerState
-	}
-	return PowerState_POWER_UNKNOWN
+func (m *MACState_DataRateRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_DataRateRange.Merge(m, src)
 }
-
-func (m *EndDevice) GetBatteryPercentage() *types.FloatValue {
-	if m != nil {
-		return m.BatteryPercentage
-	}
-	return nil
+func (m *MACState_DataRateRange) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EndDevice) GetDownlinkMargin() int32 {
-	if m != nil {
-		return m.DownlinkMargin
-	}
-	return 0
+func (m *MACState_DataRateRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_DataRateRange.DiscardUnknown(m)
 }
 
-func (m *EndDevice) GetQueuedApplicationDownlinks() []*ApplicationDownlink {
+var xxx_messageInfo_MACState_DataRateRange proto.InternalMessageInfo
+
+func (m *MACState_DataRateRange) GetMinDataRateIndex() DataRateIndex {
 	if m != nil {
-		return m.QueuedApplicationDownlinks
+		return m.MinDataRateIndex
 	}
-	return nil
+	return DATA_RATE_0
 }
 
-func (m *EndDevice) GetFormatters() *MessagePayloadFormatters {
+func (m *MACState_DataRateRange) GetMaxDataRateIndex() DataRateIndex {
 	if m != nil {
-		return m.Formatters
+		return m.MaxDataRateIndex
 	}
-	return nil
+	return DATA_RATE_0
 }
 
-func (m *EndDevice) GetProvisionerID() string {
-	if m != nil {
-		return m.ProvisionerID
-	}
-	return ""
+type MACState_DataRateRanges struct {
+	Ranges               []*MACState_DataRateRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *EndDevice) GetProvisioningData() *types.Struct {
-	if m != nil {
-		return m.ProvisioningData
-	}
-	return nil
-}
-
-func (m *EndDevice) GetMulticast() bool {
-	if m != nil {
-		return m.Multicast
-	}
-	return false
-}
-
-func (m *EndDevice) GetClaimAuthenticationCode() *EndDeviceAuthenticationCode {
-	if m != nil {
-		return m.ClaimAuthenticationCode
-	}
-	return nil
-}
-
-func (m *EndDevice) GetSkipPayloadCrypto() bool {
-	if m != nil {
-		return m.SkipPayloadCrypto
-	}
-	return false
-}
-
-func (m *EndDevice) GetSkipPayloadCryptoOverride() *types.BoolValue {
-	if m != nil {
-		return m.SkipPayloadCryptoOverride
-	}
-	return nil
-}
-
-type EndDevices struct {
-	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
-}
-
-func (m *EndDevices) Reset()      { *m = EndDevices{} }
-func (*EndDevices) ProtoMessage() {}
-func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+func (m *MACState_DataRateRanges) Reset()      { *m = MACState_DataRateRanges{} }
+func (*MACState_DataRateRanges) ProtoMessage() {}
+func (*MACState_DataRateRanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7, 3}
 }
-func (m *EndDevices) XXX_Unmarshal(b []byte) error {
+func (m *MACState_DataRateRanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDevices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACState_DataRateRanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDevices.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACState_DataRateRanges.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2087,45 +1941,45 @@ func (m *EndDevices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EndDevices) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDevices.Merge(m, src)
+func (m *MACState_DataRateRanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_DataRateRanges.Merge(m, src)
 }
-func (m *EndDevices) XXX_Size() int {
+func (m *MACState_DataRateRanges) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndDevices) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDevices.DiscardUnknown(m)
+func (m *MACState_DataRateRanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_DataRateRanges.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndDevices proto.InternalMessageInfo
+var xxx_messageInfo_MACState_DataRateRanges proto.InternalMessageInfo
 
-func (m *EndDevices) GetEndDevices() []*EndDevice {
+func (m *MACState_DataRateRanges) GetRanges() []*MACState_DataRateRange {
 	if m != nil {
-		return m.EndDevices
+		return m.Ranges
 	}
 	return nil
 }
 
-type DevAddrPrefix struct {
-	// DevAddr base.
-	DevAddr *go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr,omitempty"`
-	// Number of most significant bits from dev_addr that are used as prefix.
-	Length               uint32   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Authentication code for end devices.
+type EndDeviceAuthenticationCode struct {
+	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	ValidFrom            *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
+	ValidTo              *time.Time `protobuf:"bytes,3,opt,name=valid_to,json=validTo,proto3,stdtime" json:"valid_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *DevAddrPrefix) Reset()      { *m = DevAddrPrefix{} }
-func (*DevAddrPrefix) ProtoMessage() {}
-func (*DevAddrPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
+func (*EndDeviceAuthenticationCode) ProtoMessage() {}
+func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{8}
 }
-func (m *DevAddrPrefix) XXX_Unmarshal(b []byte) error {
+func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DevAddrPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EndDeviceAuthenticationCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DevAddrPrefix.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EndDeviceAuthenticationCode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2135,177 +1989,200 @@ func (m *DevAddrPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DevAddrPrefix) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DevAddrPrefix.Merge(m, src)
+func (m *EndDeviceAuthenticationCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceAuthenticationCode.Merge(m, src)
 }
-func (m *DevAddrPrefix) XXX_Size() int {
+func (m *EndDeviceAuthenticationCode) XXX_Size() int {
 	return m.Size()
 }
-func (m *DevAddrPrefix) XXX_DiscardUnknown() {
-	xxx_messageInfo_DevAddrPrefix.DiscardUnknown(m)
+func (m *EndDeviceAuthenticationCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceAuthenticationCode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DevAddrPrefix proto.InternalMessageInfo
+var xxx_messageInfo_EndDeviceAuthenticationCode proto.InternalMessageInfo
 
-func (m *DevAddrPrefix) GetLength() uint32 {
+func (m *EndDeviceAuthenticationCode) GetValue() string {
 	if m != nil {
-		return m.Length
-	}
-	return 0
-}
-
-type CreateEndDeviceRequest struct {
-	EndDevice            `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3,embedded=end_device" json:"end_device"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
-func (*CreateEndDeviceRequest) ProtoMessage() {}
-func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
-}
-func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *CreateEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CreateEndDeviceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *CreateEndDeviceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateEndDeviceRequest.Merge(m, src)
-}
-func (m *CreateEndDeviceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *CreateEndDeviceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateEndDeviceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateEndDeviceRequest proto.InternalMessageInfo
-
-type UpdateEndDeviceRequest struct {
-	EndDevice `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3,embedded=end_device" json:"end_device"`
-	// The names of the end device fields that should be updated.
-	// See the API reference for which fields can be set on the different services.
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
-}
-
-func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
-func (*UpdateEndDeviceRequest) ProtoMessage() {}
-func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
-}
-func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UpdateEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdateEndDeviceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.Value
 	}
+	return ""
 }
-func (m *UpdateEndDeviceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateEndDeviceRequest.Merge(m, src)
-}
-func (m *UpdateEndDeviceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *UpdateEndDeviceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateEndDeviceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateEndDeviceRequest proto.InternalMessageInfo
 
-func (m *UpdateEndDeviceRequest) GetFieldMask() types.FieldMask {
+func (m *EndDeviceAuthenticationCode) GetValidFrom() *time.Time {
 	if m != nil {
-		return m.FieldMask
-	}
-	return types.FieldMask{}
-}
-
-type GetEndDeviceRequest struct {
-	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
-	// The names of the end device fields that should be returned.
-	// See the API reference for which fields can be returned by the different services.
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
-}
-
-func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
-func (*GetEndDeviceRequest) ProtoMessage() {}
-func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
-}
-func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetEndDeviceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.ValidFrom
 	}
+	return nil
 }
-func (m *GetEndDeviceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEndDeviceRequest.Merge(m, src)
-}
-func (m *GetEndDeviceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetEndDeviceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEndDeviceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetEndDeviceRequest proto.InternalMessageInfo
 
-func (m *GetEndDeviceRequest) GetFieldMask() types.FieldMask {
+func (m *EndDeviceAuthenticationCode) GetValidTo() *time.Time {
 	if m != nil {
-		return m.FieldMask
+		return m.ValidTo
 	}
-	return types.FieldMask{}
-}
-
-type GetEndDeviceIdentifiersForEUIsRequest struct {
-	JoinEui              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
-	DevEui               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
-	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
-	XXX_sizecache        int32                                                 `json:"-"`
+	return nil
 }
 
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
-func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
-func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+// Defines an End Device registration and its state on the network.
+// The persistence of the EndDevice is divided between the Network Server, Application Server and Join Server.
+// SDKs are responsible for combining (if desired) the three.
+type EndDevice struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	CreatedAt            time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt            time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// Friendly name of the device. Stored in Entity Registry.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the device. Stored in Entity Registry.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Key-value attributes for this end device. Typically used for organizing end devices or for storing integration-specific data. Stored in Entity Registry.
+	Attributes map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Version Identifiers. Stored in Entity Registry, Network Server and Application Server.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,7,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Default service profile. Stored in Entity Registry.
+	ServiceProfileID string `protobuf:"bytes,8,opt,name=service_profile_id,json=serviceProfileId,proto3" json:"service_profile_id,omitempty"`
+	// The address of the Network Server where this device is supposed to be registered.
+	// Stored in Entity Registry and Join Server.
+	// The typical format of the address is "host:port". If the port is omitted,
+	// the normal port inference (with DNS lookup, otherwise defaults) is used.
+	// The connection shall be established with transport layer security (TLS).
+	// Custom certificate authorities may be configured out-of-band.
+	NetworkServerAddress string `protobuf:"bytes,9,opt,name=network_server_address,json=networkServerAddress,proto3" json:"network_server_address,omitempty"`
+	// The KEK label of the Network Server to use for wrapping network session keys.
+	// Stored in Join Server.
+	NetworkServerKEKLabel string `protobuf:"bytes,47,opt,name=network_server_kek_label,json=networkServerKekLabel,proto3" json:"network_server_kek_label,omitempty"`
+	// The address of the Application Server where this device is supposed to be registered.
+	// Stored in Entity Registry and Join Server.
+	// The typical format of the address is "host:port". If the port is omitted,
+	// the normal port inference (with DNS lookup, otherwise defaults) is used.
+	// The connection shall be established with transport layer security (TLS).
+	// Custom certificate authorities may be configured out-of-band.
+	ApplicationServerAddress string `protobuf:"bytes,10,opt,name=application_server_address,json=applicationServerAddress,proto3" json:"application_server_address,omitempty"`
+	// The KEK label of the Application Server to use for wrapping the application session key.
+	// Stored in Join Server.
+	ApplicationServerKEKLabel string `protobuf:"bytes,48,opt,name=application_server_kek_label,json=applicationServerKekLabel,proto3" json:"application_server_kek_label,omitempty"`
+	// The AS-ID of the Application Server to use.
+	// Stored in Join Server.
+	ApplicationServerID string `protobuf:"bytes,49,opt,name=application_server_id,json=applicationServerId,proto3" json:"application_server_id,omitempty"`
+	// The address of the Join Server where this device is supposed to be registered.
+	// Stored in Entity Registry.
+	// The typical format of the address is "host:port". If the port is omitted,
+	// the normal port inference (with DNS lookup, otherwise defaults) is used.
+	// The connection shall be established with transport layer security (TLS).
+	// Custom certificate authorities may be configured out-of-band.
+	JoinServerAddress string `protobuf:"bytes,11,opt,name=join_server_address,json=joinServerAddress,proto3" json:"join_server_address,omitempty"`
+	// Location of the device. Stored in Entity Registry.
+	Locations map[string]*Location `protobuf:"bytes,12,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Stored in Entity Registry.
+	Picture *Picture `protobuf:"bytes,50,opt,name=picture,proto3" json:"picture,omitempty"`
+	// Whether the device supports class B.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	SupportsClassB bool `protobuf:"varint,13,opt,name=supports_class_b,json=supportsClassB,proto3" json:"supports_class_b,omitempty"`
+	// Whether the device supports class C.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	SupportsClassC bool `protobuf:"varint,14,opt,name=supports_class_c,json=supportsClassC,proto3" json:"supports_class_c,omitempty"`
+	// LoRaWAN MAC version. Stored in Network Server.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	LoRaWANVersion MACVersion `protobuf:"varint,15,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	// LoRaWAN PHY version. Stored in Network Server.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	LoRaWANPHYVersion PHYVersion `protobuf:"varint,16,opt,name=lorawan_phy_version,json=lorawanPhyVersion,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"lorawan_phy_version,omitempty"`
+	// ID of the frequency plan used by this device.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	FrequencyPlanID string `protobuf:"bytes,17,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Minimum frequency the device is capable of using (Hz). Stored in Network Server.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	MinFrequency uint64 `protobuf:"varint,18,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	// Maximum frequency the device is capable of using (Hz). Stored in Network Server.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	MaxFrequency uint64 `protobuf:"varint,19,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	// The device supports join (it's OTAA).
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	SupportsJoin bool `protobuf:"varint,20,opt,name=supports_join,json=supportsJoin,proto3" json:"supports_join,omitempty"`
+	// Whether the device resets the join and dev nonces (not LoRaWAN compliant). Stored in Join Server.
+	// Copied on creation from template identified by version_ids, if any or from the home Network Server device profile, if any.
+	ResetsJoinNonces bool `protobuf:"varint,21,opt,name=resets_join_nonces,json=resetsJoinNonces,proto3" json:"resets_join_nonces,omitempty"`
+	// Device root keys. Stored in Join Server.
+	RootKeys *RootKeys `protobuf:"bytes,22,opt,name=root_keys,json=rootKeys,proto3" json:"root_keys,omitempty"`
+	// Home NetID. Stored in Join Server.
+	NetID *go_thethings_network_lorawan_stack_v3_pkg_types.NetID `protobuf:"bytes,23,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_id,omitempty"`
+	// Settings for how the Network Server handles MAC layer for this device. Stored in Network Server.
+	MACSettings *MACSettings `protobuf:"bytes,24,opt,name=mac_settings,json=macSettings,proto3" json:"mac_settings,omitempty"`
+	// MAC state of the device. Stored in Network Server.
+	MACState *MACState `protobuf:"bytes,25,opt,name=mac_state,json=macState,proto3" json:"mac_state,omitempty"`
+	// Pending MAC state of the device. Stored in Network Server.
+	PendingMACState *MACState `protobuf:"bytes,44,opt,name=pending_mac_state,json=pendingMacState,proto3" json:"pending_mac_state,omitempty"`
+	// Current session of the device. Stored in Network Server and Application Server.
+	Session *Session `protobuf:"bytes,26,opt,name=session,proto3" json:"session,omitempty"`
+	// Pending session. Stored in Network Server and Application Server until RekeyInd is received.
+	PendingSession *Session `protobuf:"bytes,27,opt,name=pending_session,json=pendingSession,proto3" json:"pending_session,omitempty"`
+	// Last DevNonce used.
+	// This field is only used for devices using LoRaWAN version 1.1 and later.
+	// Stored in Join Server.
+	LastDevNonce uint32 `protobuf:"varint,28,opt,name=last_dev_nonce,json=lastDevNonce,proto3" json:"last_dev_nonce,omitempty"`
+	// Used DevNonces sorted in ascending order.
+	// This field is only used for devices using LoRaWAN versions preceding 1.1.
+	// Stored in Join Server.
+	UsedDevNonces []uint32 `protobuf:"varint,29,rep,packed,name=used_dev_nonces,json=usedDevNonces,proto3" json:"used_dev_nonces,omitempty"`
+	// Last JoinNonce/AppNonce(for devices using LoRaWAN versions preceding 1.1) used.
+	// Stored in Join Server.
+	LastJoinNonce uint32 `protobuf:"varint,30,opt,name=last_join_nonce,json=lastJoinNonce,proto3" json:"last_join_nonce,omitempty"`
+	// Next expected Rejoin counter value (type 0/2), which is the last Rejoin counter value used plus one.
+	// Reset to 0 when a new session is established.
+	// Stored in Network Server.
+	LastRJCount0 uint32 `protobuf:"varint,31,opt,name=last_rj_count_0,json=lastRjCount0,proto3" json:"last_rj_count_0,omitempty"`
+	// Last Rejoin counter value used (type 1).
+	// Stored in Join Server.
+	LastRJCount1 uint32 `protobuf:"varint,32,opt,name=last_rj_count_1,json=lastRjCount1,proto3" json:"last_rj_count_1,omitempty"`
+	// Time when last DevStatus MAC command was received.
+	// Stored in Network Server.
+	LastDevStatusReceivedAt *time.Time `protobuf:"bytes,33,opt,name=last_dev_status_received_at,json=lastDevStatusReceivedAt,proto3,stdtime" json:"last_dev_status_received_at,omitempty"`
+	// The power state of the device; whether it is battery-powered or connected to an external power source.
+	// Received via the DevStatus MAC command at status_received_at.
+	// Stored in Network Server.
+	PowerState PowerState `protobuf:"varint,34,opt,name=power_state,json=powerState,proto3,enum=ttn.lorawan.v3.PowerState" json:"power_state,omitempty"`
+	// Latest-known battery percentage of the device.
+	// Received via the DevStatus MAC command at last_dev_status_received_at or earlier.
+	// Stored in Network Server.
+	BatteryPercentage *types.FloatValue `protobuf:"bytes,35,opt,name=battery_percentage,json=batteryPercentage,proto3" json:"battery_percentage,omitempty"`
+	// Demodulation signal-to-noise ratio (dB).
+	// Received via the DevStatus MAC command at last_dev_status_received_at.
+	// Stored in Network Server.
+	DownlinkMargin int32 `protobuf:"varint,36,opt,name=downlink_margin,json=downlinkMargin,proto3" json:"downlink_margin,omitempty"`
+	// Queued Application downlink messages. Stored in Application Server,
+	// which sets them on the Network Server.
+	// This field is deprecated and is always set equal to session.queued_application_downlinks.
+	QueuedApplicationDownlinks []*ApplicationDownlink `protobuf:"bytes,40,rep,name=queued_application_downlinks,json=queuedApplicationDownlinks,proto3" json:"queued_application_downlinks,omitempty"`
+	// The payload formatters for this end device. Stored in Application Server.
+	// Copied on creation from template identified by version_ids.
+	Formatters *MessagePayloadFormatters `protobuf:"bytes,41,opt,name=formatters,proto3" json:"formatters,omitempty"`
+	// ID of the provisioner. Stored in Join Server.
+	ProvisionerID string `protobuf:"bytes,42,opt,name=provisioner_id,json=provisionerId,proto3" json:"provisioner_id,omitempty"`
+	// Vendor-specific provisioning data. Stored in Join Server.
+	ProvisioningData *types.Struct `protobuf:"bytes,43,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
+	// Indicates whether this device represents a multicast group.
+	Multicast bool `protobuf:"varint,45,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// Authentication code to claim ownership of the end device. Stored in Join Server.
+	ClaimAuthenticationCode *EndDeviceAuthenticationCode `protobuf:"bytes,46,opt,name=claim_authentication_code,json=claimAuthenticationCode,proto3" json:"claim_authentication_code,omitempty"`
+	// Skip decryption of uplink payloads and encryption of downlink payloads.
+	// This field is deprecated, use skip_payload_crypto_override instead.
+	SkipPayloadCrypto bool `protobuf:"varint,51,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
+	// Skip decryption of uplink payloads and encryption of downlink payloads.
+	// This field overrides the application-level setting.
+	SkipPayloadCryptoOverride *types.BoolValue `protobuf:"bytes,52,opt,name=skip_payload_crypto_override,json=skipPayloadCryptoOverride,proto3" json:"skip_payload_crypto_override,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}         `json:"-"`
+	XXX_sizecache             int32            `json:"-"`
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *EndDevice) Reset()      { *m = EndDevice{} }
+func (*EndDevice) ProtoMessage() {}
+func (*EndDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{9}
+}
+func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EndDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EndDevice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2315,336 +2192,364 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.Merge(m, src)
+func (m *EndDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDevice.Merge(m, src)
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Size() int {
+func (m *EndDevice) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.DiscardUnknown(m)
+func (m *EndDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDevice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest proto.InternalMessageInfo
+var xxx_messageInfo_EndDevice proto.InternalMessageInfo
 
-type ListEndDevicesRequest struct {
-	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	// The names of the end device fields that should be returned.
-	// See the API reference for which fields can be returned by the different services.
-	FieldMask types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	// Order the results by this field path (must be present in the field mask).
-	// Default ordering is by ID. Prepend with a minus (-) to reverse the order.
-	Order string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
-	// Limit the number of results per page.
-	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EndDevice) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
 }
 
-func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
-func (*ListEndDevicesRequest) ProtoMessage() {}
-func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
-}
-func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ListEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListEndDevicesRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EndDevice) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-}
-func (m *ListEndDevicesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEndDevicesRequest.Merge(m, src)
-}
-func (m *ListEndDevicesRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ListEndDevicesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEndDevicesRequest.DiscardUnknown(m)
+	return time.Time{}
 }
 
-var xxx_messageInfo_ListEndDevicesRequest proto.InternalMessageInfo
-
-func (m *ListEndDevicesRequest) GetFieldMask() types.FieldMask {
+func (m *EndDevice) GetName() string {
 	if m != nil {
-		return m.FieldMask
+		return m.Name
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-func (m *ListEndDevicesRequest) GetOrder() string {
+func (m *EndDevice) GetDescription() string {
 	if m != nil {
-		return m.Order
+		return m.Description
 	}
 	return ""
 }
 
-func (m *ListEndDevicesRequest) GetLimit() uint32 {
+func (m *EndDevice) GetAttributes() map[string]string {
 	if m != nil {
-		return m.Limit
+		return m.Attributes
 	}
-	return 0
+	return nil
 }
 
-func (m *ListEndDevicesRequest) GetPage() uint32 {
+func (m *EndDevice) GetVersionIDs() *EndDeviceVersionIdentifiers {
 	if m != nil {
-		return m.Page
+		return m.VersionIDs
 	}
-	return 0
+	return nil
 }
 
-type SetEndDeviceRequest struct {
-	EndDevice EndDevice `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
-	// The names of the end device fields that should be updated.
-	// See the API reference for which fields can be set on the different services.
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *EndDevice) GetServiceProfileID() string {
+	if m != nil {
+		return m.ServiceProfileID
+	}
+	return ""
 }
 
-func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
-func (*SetEndDeviceRequest) ProtoMessage() {}
-func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
-}
-func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetEndDeviceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EndDevice) GetNetworkServerAddress() string {
+	if m != nil {
+		return m.NetworkServerAddress
 	}
+	return ""
 }
-func (m *SetEndDeviceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetEndDeviceRequest.Merge(m, src)
-}
-func (m *SetEndDeviceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *SetEndDeviceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetEndDeviceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SetEndDeviceRequest proto.InternalMessageInfo
 
-func (m *SetEndDeviceRequest) GetEndDevice() EndDevice {
+func (m *EndDevice) GetNetworkServerKEKLabel() string {
 	if m != nil {
-		return m.EndDevice
+		return m.NetworkServerKEKLabel
 	}
-	return EndDevice{}
+	return ""
 }
 
-func (m *SetEndDeviceRequest) GetFieldMask() types.FieldMask {
+func (m *EndDevice) GetApplicationServerAddress() string {
 	if m != nil {
-		return m.FieldMask
+		return m.ApplicationServerAddress
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type ResetAndGetEndDeviceRequest struct {
-	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
-	// The names of the end device fields that should be returned.
-	// See the API reference for which fields can be returned by the different services.
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *EndDevice) GetApplicationServerKEKLabel() string {
+	if m != nil {
+		return m.ApplicationServerKEKLabel
+	}
+	return ""
 }
 
-func (m *ResetAndGetEndDeviceRequest) Reset()      { *m = ResetAndGetEndDeviceRequest{} }
-func (*ResetAndGetEndDeviceRequest) ProtoMessage() {}
-func (*ResetAndGetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
-}
-func (m *ResetAndGetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ResetAndGetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ResetAndGetEndDeviceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EndDevice) GetApplicationServerID() string {
+	if m != nil {
+		return m.ApplicationServerID
 	}
-}
-func (m *ResetAndGetEndDeviceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetAndGetEndDeviceRequest.Merge(m, src)
-}
-func (m *ResetAndGetEndDeviceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ResetAndGetEndDeviceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetAndGetEndDeviceRequest.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_ResetAndGetEndDeviceRequest proto.InternalMessageInfo
-
-func (m *ResetAndGetEndDeviceRequest) GetFieldMask() types.FieldMask {
+func (m *EndDevice) GetJoinServerAddress() string {
 	if m != nil {
-		return m.FieldMask
+		return m.JoinServerAddress
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type EndDeviceTemplate struct {
-	EndDevice            EndDevice       `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	MappingKey           string          `protobuf:"bytes,3,opt,name=mapping_key,json=mappingKey,proto3" json:"mapping_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *EndDevice) GetLocations() map[string]*Location {
+	if m != nil {
+		return m.Locations
+	}
+	return nil
 }
 
-func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
-func (*EndDeviceTemplate) ProtoMessage() {}
-func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
-}
-func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EndDeviceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplate.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EndDevice) GetPicture() *Picture {
+	if m != nil {
+		return m.Picture
 	}
+	return nil
 }
-func (m *EndDeviceTemplate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplate.Merge(m, src)
-}
-func (m *EndDeviceTemplate) XXX_Size() int {
-	return m.Size()
-}
-func (m *EndDeviceTemplate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplate.DiscardUnknown(m)
+
+func (m *EndDevice) GetSupportsClassB() bool {
+	if m != nil {
+		return m.SupportsClassB
+	}
+	return false
 }
 
-var xxx_messageInfo_EndDeviceTemplate proto.InternalMessageInfo
+func (m *EndDevice) GetSupportsClassC() bool {
+	if m != nil {
+		return m.SupportsClassC
+	}
+	return false
+}
 
-func (m *EndDeviceTemplate) GetEndDevice() EndDevice {
+func (m *EndDevice) GetLoRaWANVersion() MACVersion {
 	if m != nil {
-		return m.EndDevice
+		return m.LoRaWANVersion
 	}
-	return EndDevice{}
+	return MAC_UNKNOWN
 }
 
-func (m *EndDeviceTemplate) GetFieldMask() types.FieldMask {
+func (m *EndDevice) GetLoRaWANPHYVersion() PHYVersion {
 	if m != nil {
-		return m.FieldMask
+		return m.LoRaWANPHYVersion
 	}
-	return types.FieldMask{}
+	return PHY_UNKNOWN
 }
 
-func (m *EndDeviceTemplate) GetMappingKey() string {
+func (m *EndDevice) GetFrequencyPlanID() string {
 	if m != nil {
-		return m.MappingKey
+		return m.FrequencyPlanID
 	}
 	return ""
 }
 
-type EndDeviceTemplateFormat struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	FileExtensions       []string `protobuf:"bytes,3,rep,name=file_extensions,json=fileExtensions,proto3" json:"file_extensions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EndDevice) GetMinFrequency() uint64 {
+	if m != nil {
+		return m.MinFrequency
+	}
+	return 0
 }
 
-func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
-func (*EndDeviceTemplateFormat) ProtoMessage() {}
-func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
-}
-func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EndDeviceTemplateFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplateFormat.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EndDevice) GetMaxFrequency() uint64 {
+	if m != nil {
+		return m.MaxFrequency
 	}
+	return 0
 }
-func (m *EndDeviceTemplateFormat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplateFormat.Merge(m, src)
+
+func (m *EndDevice) GetSupportsJoin() bool {
+	if m != nil {
+		return m.SupportsJoin
+	}
+	return false
 }
-func (m *EndDeviceTemplateFormat) XXX_Size() int {
-	return m.Size()
+
+func (m *EndDevice) GetResetsJoinNonces() bool {
+	if m != nil {
+		return m.ResetsJoinNonces
+	}
+	return false
 }
-func (m *EndDeviceTemplateFormat) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplateFormat.DiscardUnknown(m)
+
+func (m *EndDevice) GetRootKeys() *RootKeys {
+	if m != nil {
+		return m.RootKeys
+	}
+	return nil
 }
 
-var xxx_messageInfo_EndDeviceTemplateFormat proto.InternalMessageInfo
+func (m *EndDevice) GetMACSettings() *MACSettings {
+	if m != nil {
+		return m.MACSettings
+	}
+	return nil
+}
 
-func (m *EndDeviceTemplateFormat) GetName() string {
+func (m *EndDevice) GetMACState() *MACState {
 	if m != nil {
-		return m.Name
+		return m.MACState
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDeviceTemplateFormat) GetDescription() string {
+func (m *EndDevice) GetPendingMACState() *MACState {
 	if m != nil {
-		return m.Description
+		return m.PendingMACState
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDeviceTemplateFormat) GetFileExtensions() []string {
+func (m *EndDevice) GetSession() *Session {
 	if m != nil {
-		return m.FileExtensions
+		return m.Session
 	}
 	return nil
 }
 
-type EndDeviceTemplateFormats struct {
-	Formats              map[string]*EndDeviceTemplateFormat `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+func (m *EndDevice) GetPendingSession() *Session {
+	if m != nil {
+		return m.PendingSession
+	}
+	return nil
 }
 
-func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
-func (*EndDeviceTemplateFormats) ProtoMessage() {}
-func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+func (m *EndDevice) GetLastDevNonce() uint32 {
+	if m != nil {
+		return m.LastDevNonce
+	}
+	return 0
 }
-func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
+
+func (m *EndDevice) GetUsedDevNonces() []uint32 {
+	if m != nil {
+		return m.UsedDevNonces
+	}
+	return nil
+}
+
+func (m *EndDevice) GetLastJoinNonce() uint32 {
+	if m != nil {
+		return m.LastJoinNonce
+	}
+	return 0
+}
+
+func (m *EndDevice) GetLastRJCount0() uint32 {
+	if m != nil {
+		return m.LastRJCount0
+	}
+	return 0
+}
+
+func (m *EndDevice) GetLastRJCount1() uint32 {
+	if m != nil {
+		return m.LastRJCount1
+	}
+	return 0
+}
+
+func (m *EndDevice) GetLastDevStatusReceivedAt() *time.Time {
+	if m != nil {
+		return m.LastDevStatusReceivedAt
+	}
+	return nil
+}
+
+func (m *EndDevice) GetPowerState() PowerState {
+	if m != nil {
+		return m.PowerState
+	}
+	return PowerState_POWER_UNKNOWN
+}
+
+func (m *EndDevice) GetBatteryPercentage() *types.FloatValue {
+	if m != nil {
+		return m.BatteryPercentage
+	}
+	return nil
+}
+
+func (m *EndDevice) GetDownlinkMargin() int32 {
+	if m != nil {
+		return m.DownlinkMargin
+	}
+	return 0
+}
+
+func (m *EndDevice) GetQueuedApplicationDownlinks() []*ApplicationDownlink {
+	if m != nil {
+		return m.QueuedApplicationDownlinks
+	}
+	return nil
+}
+
+func (m *EndDevice) GetFormatters() *MessagePayloadFormatters {
+	if m != nil {
+		return m.Formatters
+	}
+	return nil
+}
+
+func (m *EndDevice) GetProvisionerID() string {
+	if m != nil {
+		return m.ProvisionerID
+	}
+	return ""
+}
+
+func (m *EndDevice) GetProvisioningData() *types.Struct {
+	if m != nil {
+		return m.ProvisioningData
+	}
+	return nil
+}
+
+func (m *EndDevice) GetMulticast() bool {
+	if m != nil {
+		return m.Multicast
+	}
+	return false
+}
+
+func (m *EndDevice) GetClaimAuthenticationCode() *EndDeviceAuthenticationCode {
+	if m != nil {
+		return m.ClaimAuthenticationCode
+	}
+	return nil
+}
+
+func (m *EndDevice) GetSkipPayloadCrypto() bool {
+	if m != nil {
+		return m.SkipPayloadCrypto
+	}
+	return false
+}
+
+func (m *EndDevice) GetSkipPayloadCryptoOverride() *types.BoolValue {
+	if m != nil {
+		return m.SkipPayloadCryptoOverride
+	}
+	return nil
+}
+
+type EndDevices struct {
+	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EndDevices) Reset()      { *m = EndDevices{} }
+func (*EndDevices) ProtoMessage() {}
+func (*EndDevices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{10}
+}
+func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EndDevices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplateFormats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EndDevices.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2654,45 +2559,45 @@ func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EndDeviceTemplateFormats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplateFormats.Merge(m, src)
+func (m *EndDevices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDevices.Merge(m, src)
 }
-func (m *EndDeviceTemplateFormats) XXX_Size() int {
+func (m *EndDevices) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndDeviceTemplateFormats) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplateFormats.DiscardUnknown(m)
+func (m *EndDevices) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDevices.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndDeviceTemplateFormats proto.InternalMessageInfo
+var xxx_messageInfo_EndDevices proto.InternalMessageInfo
 
-func (m *EndDeviceTemplateFormats) GetFormats() map[string]*EndDeviceTemplateFormat {
+func (m *EndDevices) GetEndDevices() []*EndDevice {
 	if m != nil {
-		return m.Formats
+		return m.EndDevices
 	}
 	return nil
 }
 
-type ConvertEndDeviceTemplateRequest struct {
-	// ID of the format.
-	FormatID string `protobuf:"bytes,1,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
-	// Data to convert.
-	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+type DevAddrPrefix struct {
+	// DevAddr base.
+	DevAddr *go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr,omitempty"`
+	// Number of most significant bits from dev_addr that are used as prefix.
+	Length               uint32   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
-func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
-func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+func (m *DevAddrPrefix) Reset()      { *m = DevAddrPrefix{} }
+func (*DevAddrPrefix) ProtoMessage() {}
+func (*DevAddrPrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{11}
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
+func (m *DevAddrPrefix) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DevAddrPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConvertEndDeviceTemplateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DevAddrPrefix.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2702,847 +2607,1124 @@ func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConvertEndDeviceTemplateRequest.Merge(m, src)
+func (m *DevAddrPrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevAddrPrefix.Merge(m, src)
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Size() int {
+func (m *DevAddrPrefix) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConvertEndDeviceTemplateRequest.DiscardUnknown(m)
+func (m *DevAddrPrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevAddrPrefix.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConvertEndDeviceTemplateRequest proto.InternalMessageInfo
+var xxx_messageInfo_DevAddrPrefix proto.InternalMessageInfo
 
-func (m *ConvertEndDeviceTemplateRequest) GetFormatID() string {
+func (m *DevAddrPrefix) GetLength() uint32 {
 	if m != nil {
-		return m.FormatID
+		return m.Length
 	}
-	return ""
+	return 0
 }
 
-func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
-	if m != nil {
-		return m.Data
+type CreateEndDeviceRequest struct {
+	EndDevice            `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3,embedded=end_device" json:"end_device"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
+func (*CreateEndDeviceRequest) ProtoMessage() {}
+func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{12}
+}
+func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateEndDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *CreateEndDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateEndDeviceRequest.Merge(m, src)
+}
+func (m *CreateEndDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateEndDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateEndDeviceRequest.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	proto.RegisterType((*BoolValue)(nil), "ttn.lorawan.v3.BoolValue")
-	golang_proto.RegisterType((*BoolValue)(nil), "ttn.lorawan.v3.BoolValue")
-	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
-	golang_proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
-	proto.RegisterType((*MACState_JoinRequest)(nil), "ttn.lorawan.v3.MACState.JoinRequest")
-	golang_proto.RegisterType((*MACState_JoinRequest)(nil), "ttn.lorawan.v3.MACState.JoinRequest")
-	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
-	golang_proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
-	proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
-	golang_proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
-	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	proto.RegisterType((*DevAddrPrefix)(nil), "ttn.lorawan.v3.DevAddrPrefix")
-	golang_proto.RegisterType((*DevAddrPrefix)(nil), "ttn.lorawan.v3.DevAddrPrefix")
-	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	proto.RegisterType((*ResetAndGetEndDeviceRequest)(nil), "ttn.lorawan.v3.ResetAndGetEndDeviceRequest")
-	golang_proto.RegisterType((*ResetAndGetEndDeviceRequest)(nil), "ttn.lorawan.v3.ResetAndGetEndDeviceRequest")
-	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
-	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+var xxx_messageInfo_CreateEndDeviceRequest proto.InternalMessageInfo
+
+type UpdateEndDeviceRequest struct {
+	EndDevice `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3,embedded=end_device" json:"end_device"`
+	// The names of the end device fields that should be updated.
+	// See the API reference for which fields can be set on the different services.
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
+func (*UpdateEndDeviceRequest) ProtoMessage() {}
+func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 5203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5b, 0x6c, 0x1c, 0xd7,
-	0x79, 0xde, 0x21, 0x97, 0xe4, 0xee, 0x4f, 0x72, 0x2f, 0x87, 0xb7, 0xd1, 0x4a, 0xda, 0xa5, 0xd6,
-	0xb4, 0xbc, 0x92, 0x45, 0xca, 0x5a, 0x45, 0xb9, 0xc8, 0x75, 0x94, 0x1d, 0x2e, 0x69, 0x2f, 0x25,
-	0xd1, 0xcc, 0xd1, 0xc5, 0xb1, 0x64, 0x69, 0x32, 0xdc, 0x39, 0xa4, 0xc6, 0xdc, 0x9d, 0xd9, 0xcc,
-	0xcc, 0x52, 0x64, 0x6c, 0xa3, 0x6e, 0xd0, 0x22, 0x17, 0xb4, 0x45, 0xe0, 0xa7, 0x34, 0x0f, 0x85,
-	0x5f, 0x02, 0xe4, 0x31, 0x0f, 0x7d, 0x30, 0x8a, 0x02, 0xc9, 0x4b, 0x0b, 0xa3, 0x40, 0x51, 0x3f,
-	0x15, 0x69, 0x0b, 0xb0, 0xd1, 0xea, 0x25, 0x8f, 0x79, 0x0c, 0xf8, 0x50, 0x14, 0xe7, 0x32, 0x97,
-	0xbd, 0x51, 0x4b, 0x5b, 0x09, 0xd2, 0x17, 0x69, 0xf6, 0x9c, 0xff, 0xff, 0xce, 0xed, 0xff, 0xff,
-	0xf3, 0x5f, 0x0e, 0x21, 0x5f, 0xb3, 0x6c, 0xed, 0xb1, 0x66, 0x2e, 0x3a, 0xae, 0x56, 0xdd, 0xb9,
-	0xa8, 0x35, 0x8c, 0x8b, 0xc4, 0xd4, 0x55, 0x9d, 0xec, 0x1a, 0x55, 0xb2, 0xd4, 0xb0, 0x2d, 0xd7,
-	0x42, 0x09, 0xd7, 0x35, 0x97, 0x04, 0xdd, 0xd2, 0xee, 0xe5, 0x4c, 0x69, 0xdb, 0x70, 0x1f, 0x35,
-	0x37, 0x97, 0xaa, 0x56, 0xfd, 0x22, 0x31, 0x77, 0xad, 0xfd, 0x86, 0x6d, 0xed, 0xed, 0x5f, 0x64,
-	0xc4, 0xd5, 0xc5, 0x6d, 0x62, 0x2e, 0xee, 0x6a, 0x35, 0x43, 0xd7, 0x5c, 0x72, 0xb1, 0xeb, 0x83,
-	0x43, 0x66, 0x16, 0x43, 0x10, 0xdb, 0xd6, 0xb6, 0xc5, 0x99, 0x37, 0x9b, 0x5b, 0xec, 0x17, 0xfb,
-	0xc1, 0xbe, 0x04, 0x79, 0x76, 0xdb, 0xb2, 0xb6, 0x6b, 0x24, 0xa0, 0xd2, 0x9b, 0xb6, 0xe6, 0x1a,
-	0x96, 0x29, 0xfa, 0xe7, 0x3b, 0xfb, 0xb7, 0x0c, 0x52, 0xd3, 0xd5, 0xba, 0xe6, 0xec, 0x08, 0x8a,
-	0x53, 0x9d, 0x14, 0x8e, 0x6b, 0x37, 0xab, 0xae, 0xe8, 0xcd, 0x75, 0xf6, 0xba, 0x46, 0x9d, 0x38,
-	0xae, 0x56, 0x6f, 0xf4, 0x9b, 0xc0, 0x63, 0x5b, 0x6b, 0x34, 0x88, 0xed, 0x88, 0xfe, 0x17, 0xba,
-	0xb7, 0xd1, 0xd0, 0x89, 0xe9, 0x1a, 0x5b, 0x46, 0x40, 0x74, 0xaa, 0x9b, 0x68, 0x87, 0xec, 0x7b,
-	0xbd, 0xb9, 0xee, 0x5e, 0x6f, 0xcf, 0xc5, 0x22, 0xbb, 0x09, 0xea, 0xc4, 0x71, 0xb4, 0x6d, 0x72,
-	0x04, 0x44, 0xc3, 0xa8, 0xba, 0x4d, 0x9b, 0x1c, 0x05, 0xe1, 0x6a, 0xba, 0xe6, 0x6a, 0x9c, 0x22,
-	0xff, 0x17, 0x51, 0x18, 0xbb, 0x45, 0x1c, 0xc7, 0xb0, 0x4c, 0x74, 0x0f, 0x62, 0x3a, 0xd9, 0x55,
-	0x35, 0x5d, 0xb7, 0xe5, 0xa1, 0x79, 0xa9, 0x30, 0xa1, 0x5c, 0xfb, 0xf4, 0x20, 0x17, 0xf9, 0xaf,
-	0x83, 0xdc, 0x57, 0xb6, 0xad, 0x25, 0xf7, 0x11, 0x71, 0x1f, 0x19, 0xe6, 0xb6, 0xb3, 0x64, 0x12,
-	0xf7, 0xb1, 0x65, 0xef, 0x5c, 0x6c, 0x07, 0xdf, 0xbd, 0x7c, 0xb1, 0xb1, 0xb3, 0x7d, 0xd1, 0xdd,
-	0x6f, 0x10, 0x67, 0xa9, 0x4c, 0x76, 0x4b, 0xba, 0x6e, 0xe3, 0x31, 0x9d, 0x7f, 0xa0, 0x12, 0x44,
-	0xe9, 0xda, 0xe5, 0xe1, 0x79, 0xa9, 0x30, 0x5e, 0x3c, 0xb9, 0xd4, 0x2e, 0x62, 0x4b, 0x62, 0x0a,
-	0xd7, 0xc9, 0xbe, 0xa3, 0xa4, 0x0e, 0x95, 0x91, 0x1f, 0x49, 0x43, 0x29, 0x89, 0x0e, 0xfe, 0xd9,
-	0x41, 0x4e, 0xc2, 0x8c, 0x15, 0x9d, 0x81, 0xc9, 0x9a, 0xe6, 0xb8, 0xea, 0x96, 0x5a, 0x35, 0x5d,
-	0xb5, 0xd9, 0x90, 0xa3, 0xf3, 0x52, 0x61, 0x12, 0x03, 0x6d, 0x5c, 0x5d, 0x36, 0xdd, 0x3b, 0x0d,
-	0x54, 0x80, 0x34, 0x23, 0x31, 0x05, 0x91, 0x6e, 0x3d, 0x36, 0xe5, 0x11, 0x46, 0xc6, 0x78, 0xd7,
-	0x29, 0x5d, 0xd9, 0x7a, 0x6c, 0xfa, 0x94, 0x5a, 0x98, 0x72, 0x34, 0xa0, 0x2c, 0xf9, 0x94, 0x4b,
-	0x30, 0xcd, 0x28, 0xab, 0x96, 0xb9, 0x15, 0x26, 0x1e, 0x63, 0xc4, 0x29, 0xda, 0xb7, 0x6c, 0x99,
-	0x5b, 0x3e, 0xfd, 0x32, 0x80, 0xe3, 0x6a, 0xb6, 0x4b, 0x74, 0x55, 0x73, 0xe5, 0x18, 0x5b, 0x6f,
-	0x66, 0x89, 0xcb, 0xd3, 0x92, 0x27, 0x4f, 0x4b, 0xb7, 0x3d, 0x81, 0x53, 0x62, 0x74, 0x99, 0x3f,
-	0xfe, 0x9f, 0x9c, 0x84, 0xe3, 0x82, 0xaf, 0xe4, 0x22, 0x02, 0xa7, 0xbe, 0xd3, 0x24, 0x4d, 0x8a,
-	0xd1, 0x68, 0xd4, 0x8c, 0x2a, 0x13, 0x7e, 0x36, 0x6e, 0xcd, 0x30, 0x77, 0x1c, 0x39, 0x3e, 0x3f,
-	0x5c, 0x18, 0x2f, 0xbe, 0xd0, 0xb9, 0x8d, 0xa5, 0x80, 0xb8, 0x2c, 0x68, 0x71, 0x86, 0x03, 0xf5,
-	0xe8, 0x72, 0xd6, 0xa2, 0x31, 0x29, 0x35, 0x94, 0x3f, 0x03, 0x71, 0xc5, 0xb2, 0x6a, 0x77, 0xb5,
-	0x5a, 0x93, 0xa0, 0x69, 0x18, 0xd9, 0xa5, 0x1f, 0xb2, 0x34, 0x2f, 0x15, 0x62, 0x98, 0xff, 0xc8,
-	0xff, 0x7b, 0x0a, 0x26, 0x6f, 0x96, 0x96, 0x37, 0x34, 0x5b, 0xab, 0x13, 0x97, 0xd8, 0x0e, 0x3a,
-	0x0b, 0xb1, 0xba, 0xb6, 0xa7, 0x12, 0xc3, 0x6e, 0x30, 0xd2, 0x21, 0x65, 0xbc, 0x75, 0x90, 0x1b,
-	0xbb, 0xa9, 0xed, 0xad, 0x54, 0xf0, 0x06, 0x1e, 0xab, 0x6b, 0x7b, 0x2b, 0x86, 0xdd, 0x40, 0xef,
-	0xc2, 0x94, 0xa6, 0xdb, 0x2a, 0x15, 0x39, 0xd5, 0xd6, 0x5c, 0xa2, 0x1a, 0xa6, 0x4e, 0xf6, 0xd8,
-	0xd9, 0x25, 0x8a, 0xa7, 0x3b, 0x17, 0x50, 0xd6, 0x5c, 0x0d, 0x6b, 0x2e, 0xa9, 0x50, 0x22, 0xe5,
-	0xd4, 0xa1, 0x32, 0xf2, 0x3d, 0x2a, 0x09, 0xad, 0x83, 0x5c, 0xaa, 0x54, 0xc6, 0x6d, 0xbd, 0x38,
-	0xa5, 0xe9, 0x76, 0x5b, 0x0b, 0x7a, 0x1d, 0x10, 0x1d, 0xcb, 0xdd, 0x53, 0x1b, 0xd6, 0x63, 0x62,
-	0x8b, 0xa1, 0xd8, 0xf9, 0x2b, 0x99, 0x43, 0x25, 0x7a, 0x7e, 0x48, 0x4e, 0xb6, 0x0e, 0x72, 0xc9,
-	0x52, 0x19, 0xdf, 0xde, 0xdb, 0xa0, 0x24, 0x1c, 0x29, 0xa9, 0xe9, 0x76, 0xb8, 0x01, 0x7d, 0x05,
-	0x26, 0x28, 0x90, 0xb9, 0xa9, 0xba, 0xb6, 0x66, 0x3a, 0x5c, 0x30, 0x94, 0x99, 0x00, 0x02, 0x4a,
-	0x65, 0xbc, 0xbe, 0x79, 0x9b, 0x76, 0x62, 0xd0, 0x74, 0x5b, 0x7c, 0xa3, 0x2b, 0x30, 0x49, 0x19,
-	0xb5, 0xea, 0x8e, 0x5a, 0x33, 0xea, 0x86, 0xcb, 0xa5, 0x44, 0x49, 0xb7, 0x0e, 0x72, 0xe3, 0xa5,
-	0x32, 0x2e, 0x55, 0x77, 0x6e, 0xb0, 0x66, 0x09, 0x8f, 0x6b, 0xba, 0xed, 0xfd, 0x0c, 0xb3, 0xe9,
-	0xa4, 0xa6, 0xed, 0x33, 0xb1, 0x69, 0x63, 0x2b, 0xb3, 0x66, 0x9f, 0x8d, 0xfd, 0x44, 0x5f, 0x87,
-	0xb8, 0xbd, 0x77, 0x49, 0xb0, 0xc4, 0xd9, 0x8e, 0xce, 0x75, 0xee, 0x28, 0xde, 0x63, 0xb4, 0x4a,
-	0xcc, 0xdb, 0x4b, 0x1c, 0xb3, 0xf7, 0x2e, 0x71, 0xfe, 0xb7, 0x61, 0x9a, 0xf1, 0xfb, 0x67, 0x63,
-	0x6d, 0x6d, 0x39, 0xc4, 0x95, 0x81, 0x41, 0x65, 0xfb, 0x1d, 0xce, 0x9b, 0x8c, 0x2a, 0x84, 0x98,
-	0xa6, 0x88, 0x6d, 0x9d, 0xe8, 0x2e, 0x4c, 0xd9, 0x7b, 0xc5, 0xae, 0x63, 0x1f, 0x1f, 0xe4, 0xd8,
-	0x03, 0xe0, 0x94, 0xbd, 0x57, 0x6c, 0x3f, 0xe2, 0x25, 0x98, 0xa4, 0xb8, 0x5b, 0x36, 0xf9, 0x4e,
-	0x93, 0x98, 0xd5, 0x7d, 0x79, 0x62, 0x5e, 0x2a, 0x44, 0x95, 0xf8, 0xa1, 0x32, 0x5a, 0x8c, 0x16,
-	0x3e, 0xfe, 0x9b, 0x51, 0x3c, 0x61, 0xef, 0x15, 0x57, 0xbd, 0x6e, 0x74, 0x0b, 0x12, 0x54, 0x4c,
-	0xf5, 0xa6, 0xbb, 0xaf, 0x56, 0xf7, 0xab, 0x35, 0x22, 0x4f, 0xb2, 0x29, 0x74, 0xab, 0xce, 0xf6,
-	0xb6, 0x4d, 0xb6, 0x35, 0x97, 0xe8, 0xe5, 0xa6, 0xbb, 0xbf, 0x4c, 0x49, 0x43, 0x13, 0x99, 0xa8,
-	0x6b, 0x7b, 0x7e, 0x3b, 0xd2, 0x61, 0xce, 0x26, 0xef, 0x5a, 0x86, 0xa9, 0xd2, 0x7b, 0x43, 0x6d,
-	0x10, 0xdb, 0xb0, 0x74, 0xa3, 0x6a, 0xb8, 0xfb, 0x72, 0x82, 0xa1, 0xe7, 0xbb, 0x4e, 0x81, 0x91,
-	0x53, 0xa5, 0x5f, 0xd9, 0x6b, 0x58, 0x26, 0x31, 0xc3, 0xdb, 0x37, 0x63, 0xfb, 0xbd, 0x1b, 0x01,
-	0x14, 0xda, 0x06, 0x59, 0x8c, 0x52, 0xb5, 0x9a, 0xa6, 0xdb, 0x36, 0x4c, 0xb2, 0xf7, 0x22, 0xf8,
-	0x30, 0xcb, 0x94, 0xbc, 0xc7, 0x38, 0xb3, 0x76, 0xd0, 0x1d, 0x1e, 0xe8, 0x55, 0x98, 0x6a, 0x18,
-	0xe6, 0xb6, 0xea, 0xd4, 0x2c, 0x37, 0xb4, 0xb3, 0x29, 0xb6, 0xb3, 0xe3, 0x87, 0x4a, 0xac, 0x38,
-	0x2a, 0x47, 0xd8, 0xde, 0xa6, 0x29, 0xdd, 0xad, 0x9a, 0xe5, 0x06, 0x1b, 0x7c, 0x1f, 0x4e, 0x04,
-	0xcc, 0x9d, 0xc7, 0x9d, 0x1e, 0xe4, 0xb8, 0x87, 0x64, 0x09, 0xcf, 0x78, 0xc0, 0xed, 0xa7, 0xfd,
-	0x65, 0x48, 0x6d, 0x12, 0xad, 0x6a, 0x99, 0xa1, 0x69, 0xa1, 0xee, 0x69, 0x25, 0x39, 0x51, 0x30,
-	0xa9, 0xeb, 0x10, 0xab, 0x3e, 0xd2, 0x4c, 0x93, 0xd4, 0x1c, 0x79, 0x8a, 0x99, 0xca, 0x17, 0x3b,
-	0xe7, 0xd0, 0x66, 0xcd, 0x96, 0x96, 0x39, 0x35, 0xdb, 0xac, 0x8f, 0xa4, 0xa1, 0x98, 0x84, 0x7d,
-	0x00, 0xb4, 0x02, 0xe9, 0x66, 0x83, 0xda, 0x4b, 0x55, 0x7f, 0x4c, 0x6a, 0x35, 0x76, 0xe6, 0xf2,
-	0x34, 0xb3, 0xeb, 0x27, 0x3a, 0x51, 0x7d, 0x3b, 0x8a, 0x93, 0x9c, 0xa7, 0x4c, 0x59, 0xe8, 0xc9,
-	0xa2, 0x0a, 0x4c, 0x79, 0xf6, 0x3b, 0x0c, 0x34, 0xf3, 0x2c, 0xa0, 0xb4, 0xc7, 0x15, 0x40, 0xed,
-	0xc2, 0x6c, 0x9b, 0x95, 0x51, 0x89, 0x38, 0x6c, 0x79, 0x96, 0xa1, 0x15, 0xba, 0x84, 0x3b, 0x30,
-	0x3d, 0x9e, 0x5c, 0x30, 0x70, 0x65, 0xae, 0x75, 0x90, 0x9b, 0xea, 0xd1, 0x8b, 0xa7, 0x42, 0xe6,
-	0xc9, 0x6b, 0x0c, 0x8f, 0xcb, 0x6c, 0x4e, 0x30, 0xee, 0xdc, 0x51, 0xe3, 0x32, 0x63, 0xd3, 0x77,
-	0xdc, 0xb6, 0x5e, 0x6f, 0xdc, 0xb6, 0x46, 0xb4, 0x0d, 0xb9, 0xbe, 0x32, 0xa6, 0xf2, 0xdb, 0x4a,
-	0x66, 0x13, 0xc8, 0x1f, 0x29, 0x69, 0x7c, 0x3f, 0x33, 0x3d, 0x45, 0x8d, 0xf5, 0x65, 0xfe, 0x63,
-	0x08, 0xc6, 0x84, 0x28, 0x50, 0xd9, 0x13, 0xc7, 0x1e, 0xc8, 0x9e, 0xd4, 0x43, 0xf6, 0x38, 0x51,
-	0x20, 0x7b, 0x5f, 0x05, 0xe4, 0x9f, 0x73, 0xc0, 0x39, 0xd4, 0x69, 0xa6, 0xfc, 0x63, 0x0d, 0x38,
-	0xef, 0xc2, 0x54, 0xdd, 0x30, 0xbb, 0x94, 0x68, 0xf8, 0x98, 0x36, 0xb3, 0x6e, 0x98, 0xed, 0x5a,
-	0x44, 0x71, 0xa9, 0x0d, 0xfc, 0x3c, 0x57, 0x70, 0x18, 0x57, 0xdb, 0x6b, 0xc7, 0x7d, 0x01, 0x26,
-	0x89, 0xa9, 0x6d, 0xd6, 0x88, 0xca, 0xf7, 0x80, 0xdd, 0xb4, 0x31, 0x3c, 0xc1, 0x1b, 0xef, 0xb0,
-	0xb6, 0xab, 0xd1, 0x4f, 0x3e, 0xce, 0x45, 0xf8, 0xbf, 0x6b, 0xd1, 0xd8, 0x50, 0x6a, 0x78, 0x2d,
-	0x1a, 0x1b, 0x4e, 0x45, 0xf3, 0x07, 0xa3, 0x90, 0x5a, 0x31, 0xf5, 0x32, 0x0b, 0x3c, 0xee, 0x12,
-	0x9b, 0x79, 0xa0, 0x6f, 0xc2, 0xb0, 0xa1, 0x3b, 0x6c, 0x9b, 0xc7, 0x8b, 0x2f, 0x77, 0xce, 0xac,
-	0x93, 0xbc, 0x12, 0x78, 0xdc, 0x3d, 0x9c, 0x46, 0x8a, 0x84, 0x54, 0x48, 0x0a, 0x00, 0x75, 0x97,
-	0x33, 0xb1, 0x93, 0x48, 0x14, 0x33, 0x3d, 0xec, 0x81, 0x80, 0xa5, 0xae, 0x82, 0xef, 0x76, 0x24,
-	0x6e, 0x58, 0x58, 0x7b, 0xab, 0xb4, 0x2e, 0xfa, 0x70, 0x42, 0xb0, 0x78, 0x33, 0x36, 0x60, 0xca,
-	0x1b, 0xa0, 0xf1, 0x68, 0xdf, 0x1f, 0x64, 0xb8, 0xf7, 0x20, 0x1b, 0x6f, 0xbc, 0xed, 0x0d, 0x72,
-	0x3a, 0x34, 0x48, 0x5a, 0x0c, 0x12, 0x74, 0xe3, 0xb4, 0xe0, 0xda, 0x78, 0xb4, 0xef, 0x0d, 0xb5,
-	0x0a, 0x69, 0x5f, 0x9e, 0xd4, 0x46, 0x4d, 0x33, 0x55, 0x43, 0x67, 0x87, 0x18, 0x67, 0xce, 0x8d,
-	0x3d, 0x24, 0x7f, 0x83, 0x3a, 0x37, 0xbe, 0x3c, 0x6d, 0xd4, 0x34, 0xb3, 0x52, 0xc6, 0xc9, 0xad,
-	0xb6, 0x06, 0x1d, 0xcd, 0xc3, 0x68, 0xe3, 0x91, 0xe5, 0x5a, 0x8e, 0x3c, 0x32, 0x3f, 0x5c, 0x88,
-	0x7b, 0x36, 0x2f, 0x05, 0x58, 0xb4, 0xa3, 0x02, 0xa4, 0x9c, 0x66, 0xa3, 0x61, 0xd9, 0xae, 0xa3,
-	0x56, 0x6b, 0x9a, 0xe3, 0xa8, 0x9b, 0xcc, 0x05, 0x8a, 0xe1, 0x84, 0xd7, 0xbe, 0x4c, 0x9b, 0x95,
-	0x1e, 0x94, 0x55, 0xe6, 0xf2, 0x74, 0x52, 0x2e, 0x23, 0x02, 0xd3, 0x3a, 0xd9, 0xd2, 0x9a, 0x35,
-	0x57, 0xad, 0x6b, 0x55, 0xd5, 0x21, 0xae, 0x4b, 0x83, 0x09, 0xe1, 0x20, 0x9f, 0xec, 0x71, 0x1c,
-	0xb7, 0x04, 0x89, 0x32, 0xdb, 0x3a, 0xc8, 0xa1, 0x32, 0x67, 0x0e, 0xb5, 0x63, 0x24, 0x00, 0x6f,
-	0x6a, 0x55, 0xaf, 0x8d, 0xca, 0x24, 0xd5, 0xa1, 0x40, 0xf1, 0xa8, 0x5b, 0x14, 0xc5, 0x13, 0x75,
-	0x23, 0x74, 0x3d, 0x50, 0x22, 0x6d, 0x2f, 0x44, 0x04, 0x82, 0x48, 0xdb, 0x6b, 0x23, 0xf2, 0x97,
-	0x46, 0xaf, 0x4d, 0xe6, 0xbb, 0xc4, 0xf0, 0x84, 0xd7, 0xb8, 0x66, 0x19, 0x26, 0xba, 0x00, 0xc8,
-	0x26, 0x0e, 0x11, 0x24, 0xaa, 0x69, 0x99, 0x55, 0xe2, 0x30, 0x9f, 0x24, 0x86, 0x53, 0xbc, 0x87,
-	0xd2, 0xad, 0xb3, 0x76, 0x44, 0xc0, 0x9b, 0xb2, 0xba, 0x65, 0xd9, 0x75, 0xcd, 0xa5, 0x77, 0x0f,
-	0x73, 0x48, 0x7a, 0xd8, 0xce, 0x9b, 0x3c, 0xd6, 0xdb, 0xd0, 0xf6, 0x6b, 0x96, 0xa6, 0xaf, 0xfa,
-	0xf4, 0xca, 0x44, 0x58, 0xd4, 0x71, 0x5a, 0x20, 0x06, 0x04, 0x5c, 0xd9, 0xf2, 0xad, 0x29, 0x18,
-	0x0f, 0xed, 0x16, 0x7a, 0x1d, 0x92, 0xe2, 0x2c, 0xd9, 0xc5, 0x63, 0x35, 0x5d, 0xa1, 0x67, 0x27,
-	0xba, 0x82, 0x93, 0xb2, 0x88, 0xb6, 0x95, 0xe8, 0x4f, 0x68, 0x5c, 0x32, 0xc9, 0xf8, 0x94, 0xdb,
-	0x9c, 0x0b, 0xbd, 0x05, 0x33, 0x81, 0x35, 0x0e, 0x3b, 0x25, 0x43, 0x0c, 0xae, 0xcb, 0x29, 0xd9,
-	0x10, 0xf6, 0x96, 0xbb, 0x1c, 0xdc, 0x08, 0x4f, 0x35, 0xda, 0x1a, 0xb9, 0x1f, 0xf2, 0xce, 0x51,
-	0xae, 0xc4, 0xf0, 0xc0, 0x06, 0xbe, 0x8f, 0x2f, 0xb1, 0xde, 0xdb, 0xcb, 0x89, 0x32, 0xdc, 0x2e,
-	0x5f, 0xd7, 0x97, 0x03, 0x71, 0x09, 0x77, 0x3b, 0x3e, 0x95, 0x1e, 0xbe, 0xc9, 0x89, 0x81, 0xc0,
-	0xba, 0xdc, 0x15, 0xff, 0x68, 0xaa, 0xfe, 0xd1, 0x8c, 0x1c, 0xe7, 0x68, 0x96, 0xbd, 0xa3, 0xf9,
-	0x5a, 0x38, 0x20, 0x18, 0x65, 0x10, 0xa7, 0xfa, 0x04, 0x04, 0x7c, 0x2a, 0x41, 0x2c, 0x70, 0xbb,
-	0x4f, 0x2c, 0x30, 0xd6, 0xfb, 0x50, 0xdb, 0xdd, 0x7d, 0xb1, 0x49, 0xdd, 0x61, 0xc0, 0x37, 0x7b,
-	0x87, 0x01, 0xb1, 0x81, 0x0f, 0xb3, 0x3b, 0x02, 0x58, 0xee, 0x8c, 0x00, 0xe2, 0x03, 0x6d, 0x7a,
-	0x7b, 0x58, 0xb0, 0x0a, 0x99, 0x2d, 0xad, 0xea, 0x5a, 0xf6, 0xbe, 0xda, 0x60, 0x6a, 0xea, 0xe3,
-	0x19, 0xc4, 0x91, 0x61, 0x7e, 0xb8, 0x10, 0xf5, 0xed, 0xe2, 0xb7, 0xb1, 0x2c, 0x68, 0x37, 0x18,
-	0xe9, 0x6a, 0x40, 0x89, 0xd6, 0xbb, 0xc2, 0x8b, 0xf1, 0x3e, 0x9e, 0x50, 0x77, 0x78, 0x21, 0xe6,
-	0xd5, 0x16, 0x59, 0x6c, 0xc2, 0x8c, 0x6f, 0x74, 0x2e, 0x17, 0xd5, 0x4d, 0x43, 0xa4, 0x3b, 0x98,
-	0x49, 0x39, 0xca, 0x4d, 0x54, 0x66, 0xe8, 0x3d, 0x72, 0x4b, 0xf0, 0x5e, 0x2e, 0x2a, 0x06, 0xcb,
-	0x89, 0xe0, 0xb4, 0xd3, 0xd9, 0x84, 0xbe, 0x0e, 0x63, 0x4d, 0x87, 0xa8, 0x9a, 0x6e, 0x0b, 0xd3,
-	0x73, 0x04, 0x2a, 0xb4, 0x0e, 0x72, 0xa3, 0x77, 0x1c, 0x52, 0x2a, 0x63, 0x3c, 0xda, 0x74, 0x48,
-	0x49, 0xb7, 0x51, 0x05, 0x68, 0xc4, 0xab, 0xd6, 0x35, 0x7b, 0xdb, 0x30, 0x59, 0xc0, 0x43, 0xed,
-	0x77, 0xa7, 0xa0, 0xae, 0xd6, 0x2c, 0x4d, 0x38, 0x7b, 0x93, 0xad, 0x83, 0x5c, 0xbc, 0x54, 0xc6,
-	0x37, 0x19, 0x07, 0x8e, 0x6b, 0xba, 0xcd, 0x3f, 0xd1, 0xab, 0x30, 0x21, 0xcc, 0x27, 0x5f, 0x65,
-	0xf2, 0x59, 0xce, 0x30, 0x70, 0x72, 0xb6, 0x8e, 0xb7, 0x60, 0xce, 0x71, 0x35, 0xb7, 0xe9, 0x74,
-	0x47, 0x61, 0xa9, 0xc1, 0xb4, 0x67, 0x86, 0xf3, 0x77, 0x06, 0x5e, 0x77, 0x41, 0x16, 0xc0, 0xdd,
-	0x81, 0x57, 0x5a, 0x28, 0x55, 0x27, 0xf2, 0x9d, 0x8a, 0xe9, 0x5e, 0x2e, 0xf2, 0x49, 0xce, 0x72,
-	0xee, 0xae, 0x38, 0xeb, 0x0d, 0x48, 0xeb, 0xc4, 0x31, 0x6c, 0xa2, 0xab, 0x81, 0x96, 0xa2, 0x01,
-	0xb4, 0x34, 0x29, 0xd8, 0xb0, 0xa7, 0xac, 0x55, 0x38, 0xd5, 0x86, 0xd4, 0xa9, 0xb4, 0x53, 0x83,
-	0x2b, 0xad, 0x1c, 0xc2, 0x6e, 0xd7, 0xdd, 0x6f, 0xc3, 0xc9, 0x60, 0x90, 0x6e, 0x1d, 0x9e, 0x1e,
-	0x58, 0x87, 0xe7, 0xfc, 0x21, 0x3a, 0x54, 0x19, 0xc3, 0x4c, 0x78, 0x84, 0x40, 0xa5, 0x67, 0x06,
-	0x52, 0xe9, 0xa9, 0x00, 0x37, 0xd0, 0xec, 0x07, 0x30, 0xeb, 0x61, 0x76, 0x68, 0xe6, 0xec, 0x31,
-	0x35, 0xd3, 0x83, 0xbf, 0x19, 0x56, 0xd0, 0xbf, 0x96, 0x20, 0xeb, 0xe1, 0xf7, 0x89, 0xc1, 0xe6,
-	0x8e, 0x19, 0x83, 0x65, 0x5b, 0x07, 0xb9, 0x4c, 0x99, 0x63, 0xf6, 0x0a, 0xc5, 0x32, 0x62, 0xbc,
-	0x52, 0x8f, 0x88, 0xac, 0xd7, 0x74, 0x3a, 0x42, 0x33, 0xf9, 0x98, 0xa1, 0x59, 0xf7, 0x74, 0xda,
-	0x23, 0xb4, 0xf6, 0xe9, 0xb4, 0x07, 0x6a, 0x3b, 0x70, 0xc6, 0x9b, 0x4d, 0xff, 0x9b, 0xfc, 0xe4,
-	0xc0, 0x82, 0xe3, 0x09, 0xf9, 0x46, 0xcf, 0x0b, 0xfd, 0x41, 0x20, 0x9f, 0xbd, 0x2e, 0xf6, 0x53,
-	0x03, 0xc9, 0x90, 0xdc, 0x31, 0x44, 0x20, 0x48, 0xdf, 0x02, 0xaf, 0x4f, 0xed, 0xba, 0xe7, 0x4f,
-	0x0f, 0x84, 0xed, 0x09, 0xa2, 0xd2, 0x71, 0xdd, 0x57, 0x20, 0x15, 0x16, 0x51, 0x96, 0x42, 0xcd,
-	0x32, 0xc4, 0x5c, 0xd7, 0xa6, 0xb0, 0x78, 0x67, 0xa5, 0x82, 0x37, 0x38, 0x64, 0x22, 0x90, 0xc9,
-	0x15, 0xc3, 0x6e, 0xe4, 0xff, 0x7b, 0x0e, 0x62, 0xd4, 0xc9, 0x73, 0x35, 0x97, 0xa0, 0x7b, 0x80,
-	0xaa, 0x4d, 0xdb, 0x26, 0xd4, 0x64, 0xf9, 0xa9, 0x0d, 0xe1, 0xe4, 0x9d, 0x3e, 0x32, 0xff, 0xd1,
-	0xe9, 0x53, 0x0a, 0x98, 0x50, 0xba, 0xf7, 0x1e, 0x75, 0x5d, 0xc5, 0x66, 0x07, 0xd8, 0x43, 0x9f,
-	0x03, 0xdb, 0xdb, 0xf0, 0x00, 0x5b, 0x81, 0x09, 0x5e, 0x7f, 0xe2, 0x21, 0x84, 0x08, 0x9e, 0x66,
-	0x3a, 0x51, 0x79, 0xc8, 0x11, 0x04, 0xa4, 0xe3, 0x9c, 0x89, 0x35, 0xf7, 0x0a, 0xf4, 0xa2, 0xcf,
-	0x35, 0xd0, 0x7b, 0x00, 0x19, 0xbf, 0x0c, 0x60, 0xd8, 0x75, 0xa2, 0xfb, 0xd9, 0x78, 0x55, 0xf3,
-	0xdc, 0xb5, 0xa3, 0xd2, 0xfc, 0x51, 0x96, 0xe2, 0x9f, 0xf3, 0xca, 0x05, 0x0c, 0xc2, 0x4b, 0xc4,
-	0x97, 0x5c, 0x74, 0x05, 0x64, 0x06, 0xaf, 0x93, 0x5d, 0x55, 0x5c, 0x3e, 0x7e, 0x9d, 0x83, 0x97,
-	0x25, 0xa6, 0x68, 0x7f, 0x99, 0xec, 0xde, 0x62, 0xbd, 0xa2, 0xe0, 0xd1, 0xd7, 0x17, 0x1f, 0xfb,
-	0x82, 0xbe, 0x38, 0x81, 0x53, 0x0d, 0x62, 0xea, 0x14, 0xbb, 0x57, 0x05, 0x42, 0x78, 0x70, 0x83,
-	0x15, 0x20, 0x04, 0x50, 0x8f, 0x3e, 0xb4, 0x02, 0x29, 0x51, 0xe7, 0xb0, 0x89, 0xd3, 0xb0, 0x4c,
-	0x87, 0x78, 0xb5, 0x8d, 0x5e, 0xe7, 0xb6, 0x6c, 0xd5, 0xeb, 0x9a, 0xa9, 0xe3, 0x24, 0xe7, 0xc1,
-	0x1e, 0x0b, 0x85, 0xf1, 0x66, 0xcb, 0xb4, 0xcc, 0x71, 0xb9, 0x13, 0xf7, 0x0c, 0x18, 0xc1, 0x83,
-	0x05, 0x0b, 0xfa, 0x26, 0x20, 0x31, 0x1b, 0x16, 0xcd, 0x69, 0xd5, 0x2a, 0x69, 0xb8, 0xc2, 0xa3,
-	0x7b, 0xa1, 0x57, 0x84, 0x4a, 0xd5, 0x6e, 0x89, 0x06, 0x78, 0x25, 0x46, 0x8a, 0xc5, 0x62, 0x82,
-	0x16, 0x74, 0x17, 0xa6, 0xbd, 0x99, 0x31, 0x4c, 0x31, 0x3d, 0xe1, 0xcf, 0x2d, 0x1c, 0x09, 0x2a,
-	0xe6, 0x85, 0x91, 0x40, 0x08, 0xb5, 0xa1, 0x57, 0xa8, 0xbb, 0xae, 0x3e, 0x36, 0x4c, 0xdd, 0x7a,
-	0xec, 0xa8, 0xda, 0xae, 0x66, 0xd4, 0xb4, 0x4d, 0x91, 0xdd, 0x8e, 0x61, 0x64, 0xef, 0xbd, 0xc5,
-	0xbb, 0x4a, 0x5e, 0x0f, 0x2a, 0x43, 0xc2, 0x26, 0x55, 0xc2, 0x44, 0x8a, 0x17, 0x91, 0x12, 0x6c,
-	0x87, 0xba, 0xb4, 0x97, 0x27, 0x6e, 0x44, 0xf8, 0x89, 0x27, 0x39, 0x13, 0x6f, 0x74, 0xd0, 0x1a,
-	0xa4, 0x04, 0x4a, 0x50, 0x8c, 0x4a, 0x32, 0x9c, 0x6e, 0xdb, 0x25, 0x08, 0x3c, 0xa4, 0x24, 0x67,
-	0xf4, 0xab, 0x4f, 0xa8, 0x06, 0x79, 0x5e, 0xad, 0xe3, 0xf5, 0x44, 0xd5, 0x30, 0x0d, 0xd7, 0xa0,
-	0x17, 0x71, 0x9b, 0x6a, 0xa5, 0x06, 0x54, 0xad, 0x2c, 0x2b, 0xf0, 0x71, 0xa8, 0x8a, 0x87, 0x14,
-	0xd2, 0xb0, 0x1f, 0x4b, 0x90, 0xb5, 0xc9, 0xbb, 0xa4, 0xea, 0x8a, 0xbb, 0xb2, 0xe3, 0x5e, 0x22,
-	0x8e, 0x9c, 0x9e, 0x1f, 0x7e, 0x76, 0x46, 0x6c, 0xf1, 0x50, 0x99, 0xf8, 0x48, 0x8a, 0xa7, 0x92,
-	0x79, 0xdf, 0x76, 0x64, 0xb0, 0xc0, 0xed, 0xac, 0x51, 0x11, 0x07, 0x67, 0xbc, 0x31, 0x4b, 0x1d,
-	0xd5, 0x2a, 0xe2, 0xa0, 0x3a, 0x9c, 0x6e, 0x9b, 0x51, 0x7b, 0xe1, 0x8a, 0x38, 0x32, 0x9a, 0x1f,
-	0x2e, 0x4c, 0x2a, 0x2f, 0x1f, 0x2a, 0xe3, 0x1f, 0x49, 0xb1, 0x54, 0x32, 0xef, 0x95, 0x9f, 0x4e,
-	0x84, 0x06, 0x0c, 0x17, 0xae, 0x88, 0x83, 0x4f, 0x84, 0xc6, 0x6b, 0xef, 0x42, 0x25, 0x98, 0xf6,
-	0x87, 0x0b, 0x87, 0x3b, 0x53, 0x2c, 0xdc, 0x49, 0xf0, 0x51, 0xf2, 0x5e, 0x82, 0x72, 0xca, 0xa3,
-	0x0d, 0xc7, 0x3b, 0x6b, 0x90, 0xe2, 0x66, 0x2a, 0x74, 0x40, 0xd3, 0x03, 0x1e, 0x50, 0x82, 0x19,
-	0xb0, 0xe0, 0x40, 0x2c, 0xf0, 0xe7, 0x1a, 0x3a, 0x0b, 0x5b, 0x33, 0xb7, 0x89, 0x23, 0xcf, 0x30,
-	0x99, 0xfa, 0x52, 0x5f, 0xfd, 0xf0, 0x36, 0xc0, 0xdb, 0x52, 0xcc, 0xd8, 0x56, 0x4c, 0xd7, 0xde,
-	0x67, 0x75, 0x8e, 0x1e, 0x9d, 0x68, 0x5d, 0xd8, 0x58, 0xba, 0xd5, 0xd5, 0x47, 0xb4, 0x2d, 0xb0,
-	0xb1, 0xb3, 0xac, 0xe0, 0xc6, 0xd2, 0xd2, 0x37, 0x34, 0xc7, 0x2d, 0x95, 0xf1, 0x32, 0xa3, 0xe0,
-	0x76, 0x96, 0x1b, 0xdf, 0x92, 0x6e, 0x87, 0x1b, 0x33, 0x3f, 0x1c, 0x82, 0xf1, 0xb0, 0x4e, 0xde,
-	0x01, 0x3f, 0xa9, 0x1b, 0xe4, 0xb7, 0x46, 0xc5, 0xee, 0x74, 0xca, 0xd4, 0x0d, 0x3f, 0xbd, 0xd5,
-	0x7e, 0x3f, 0xa6, 0x3c, 0x08, 0x3f, 0x71, 0xf3, 0x67, 0x10, 0xb3, 0xf7, 0x44, 0xb4, 0x30, 0x36,
-	0x68, 0x91, 0x6f, 0xcc, 0xe6, 0x4d, 0xe8, 0x55, 0x18, 0xab, 0x6e, 0xa9, 0x35, 0xc3, 0xf1, 0x6a,
-	0xd1, 0xb3, 0x5d, 0xf7, 0xea, 0xea, 0x0d, 0xc3, 0x71, 0x79, 0xa8, 0xc7, 0xbf, 0xf1, 0x68, 0x75,
-	0x8b, 0xfe, 0xcf, 0xeb, 0xc3, 0xe1, 0xb4, 0xed, 0x5a, 0x34, 0x16, 0x4d, 0x8d, 0xac, 0x45, 0x63,
-	0x23, 0xa9, 0xd1, 0xb5, 0x68, 0x2c, 0x9e, 0x82, 0xb5, 0x68, 0x0c, 0x52, 0xe3, 0x99, 0x7f, 0x1c,
-	0x06, 0x08, 0x99, 0xbd, 0x17, 0x60, 0xac, 0xc1, 0x13, 0x57, 0xcc, 0xff, 0x98, 0x60, 0x99, 0xef,
-	0xef, 0x46, 0x53, 0x69, 0xf9, 0x0c, 0xf6, 0x7a, 0x50, 0x19, 0xc6, 0x3c, 0x73, 0x38, 0x34, 0xb8,
-	0x39, 0x54, 0xa2, 0x6c, 0x9f, 0x3c, 0x56, 0xf4, 0xda, 0xe0, 0x2f, 0x0b, 0xda, 0x77, 0x9a, 0xbf,
-	0x2a, 0x78, 0x1d, 0x92, 0x55, 0xcb, 0xb6, 0x49, 0x8d, 0x5f, 0x70, 0x86, 0xee, 0xc8, 0x51, 0x96,
-	0x16, 0xcd, 0x1e, 0x2a, 0xf1, 0x8f, 0xa4, 0xd1, 0x7c, 0xd4, 0x1e, 0x92, 0x75, 0xea, 0x20, 0x2c,
-	0x07, 0x64, 0x95, 0xb2, 0x83, 0x13, 0x21, 0xb6, 0x8a, 0xee, 0xb4, 0xbd, 0x9e, 0x18, 0x79, 0xce,
-	0xaf, 0x27, 0x1e, 0xc0, 0xa8, 0x49, 0x5c, 0xd5, 0xd0, 0x99, 0x38, 0x4d, 0x28, 0xab, 0x02, 0xf9,
-	0xca, 0x71, 0x91, 0xd7, 0x89, 0x5b, 0x29, 0xb7, 0x0e, 0x72, 0x23, 0xec, 0x03, 0x8f, 0x98, 0xc4,
-	0xad, 0xe8, 0x99, 0x5f, 0x4a, 0x30, 0xd9, 0xa6, 0x2b, 0xfd, 0x4a, 0x11, 0xd2, 0x1f, 0xa8, 0x14,
-	0x31, 0xf4, 0x05, 0x4b, 0x11, 0x99, 0x7b, 0x90, 0xe8, 0x50, 0xf6, 0x37, 0x60, 0x54, 0x98, 0x12,
-	0x89, 0x99, 0x92, 0xb3, 0x7d, 0x65, 0xab, 0x8d, 0x31, 0x54, 0x01, 0x14, 0xfc, 0x19, 0x1b, 0x4e,
-	0x1e, 0x61, 0x6d, 0x50, 0x0a, 0x86, 0x77, 0x88, 0x28, 0x0d, 0x61, 0xfa, 0x89, 0x5e, 0xf3, 0x9e,
-	0x50, 0x70, 0xa9, 0x7e, 0x69, 0xb0, 0x91, 0x1d, 0xf1, 0xd6, 0xe2, 0xea, 0xd0, 0x57, 0x25, 0x91,
-	0xc2, 0xfd, 0x57, 0x09, 0x4e, 0xfa, 0x45, 0x8f, 0x52, 0xd3, 0x7d, 0x44, 0x4c, 0x57, 0x78, 0x50,
-	0xcb, 0x96, 0x4e, 0xd0, 0x62, 0xf8, 0xad, 0x46, 0x5c, 0x99, 0x3b, 0x54, 0xa6, 0x6d, 0x54, 0x4c,
-	0x3d, 0xbc, 0x5f, 0x5a, 0xbc, 0xf7, 0xca, 0xe2, 0xd7, 0x1e, 0xbc, 0x77, 0xe9, 0xc2, 0xe5, 0xe2,
-	0x07, 0x0b, 0x02, 0x18, 0x5d, 0x03, 0x60, 0xcf, 0xb2, 0xd4, 0x2d, 0xdb, 0xaa, 0x8b, 0xc9, 0x3d,
-	0xdb, 0x6c, 0xc7, 0x19, 0xcf, 0xaa, 0x6d, 0xd5, 0xd1, 0xab, 0x10, 0xe3, 0x00, 0xae, 0x25, 0xd4,
-	0xed, 0xd9, 0xec, 0x63, 0x8c, 0xe3, 0xb6, 0x95, 0xff, 0xbb, 0x1c, 0xc4, 0xfd, 0xc5, 0xa0, 0x37,
-	0xc2, 0x95, 0x9e, 0x85, 0xbe, 0x95, 0x9e, 0x01, 0x4a, 0x3c, 0xcb, 0x00, 0x55, 0x9b, 0x68, 0xe2,
-	0xbd, 0xcd, 0xd0, 0x71, 0xde, 0xdb, 0x08, 0xbe, 0x92, 0x4b, 0x41, 0x9a, 0x0d, 0xdd, 0x03, 0x19,
-	0x3e, 0x0e, 0x88, 0xe0, 0x2b, 0xb9, 0xe8, 0x24, 0x44, 0x4d, 0xad, 0x4e, 0x44, 0x4d, 0x66, 0x8c,
-	0xd7, 0x64, 0x8a, 0x98, 0x35, 0xa2, 0xf3, 0x30, 0xae, 0x13, 0xa7, 0x6a, 0x1b, 0x0d, 0x7a, 0x7c,
-	0xcc, 0x42, 0xf0, 0xd2, 0x8b, 0x3d, 0x2c, 0x7f, 0x96, 0xc4, 0xe1, 0x4e, 0xf4, 0xa1, 0x04, 0xa0,
-	0xb9, 0xae, 0x6d, 0x6c, 0x36, 0x5d, 0x42, 0xaf, 0x10, 0x2a, 0xc0, 0xe7, 0xfa, 0x6e, 0xd2, 0x52,
-	0xc9, 0xa7, 0x65, 0x22, 0xa9, 0x5c, 0x39, 0x54, 0x8a, 0x3f, 0x95, 0x2e, 0xa6, 0x20, 0xbf, 0x60,
-	0xe7, 0xe5, 0x85, 0x62, 0xf6, 0xe1, 0x7d, 0x6d, 0xf1, 0xbb, 0x54, 0x22, 0x0a, 0xd7, 0xae, 0xde,
-	0x5f, 0x7c, 0x70, 0xcd, 0xfb, 0x79, 0xee, 0xbd, 0xe2, 0x85, 0x0f, 0x16, 0xce, 0xd3, 0x39, 0x7c,
-	0x2a, 0xe1, 0xd0, 0x98, 0xe8, 0x1d, 0x18, 0x17, 0x71, 0x14, 0x33, 0x89, 0x63, 0xc7, 0xaf, 0xc8,
-	0x25, 0x5a, 0x07, 0x39, 0xf0, 0xda, 0xcb, 0x0e, 0x86, 0x5d, 0x8f, 0xc6, 0x41, 0x15, 0x40, 0x0e,
-	0xb1, 0x59, 0xc8, 0xd7, 0xb0, 0xad, 0x2d, 0xa3, 0x46, 0xa8, 0x6d, 0x8b, 0xb1, 0x3d, 0x39, 0x19,
-	0xd4, 0xb2, 0x52, 0xb7, 0x38, 0xd1, 0x06, 0xa7, 0xa9, 0x94, 0x71, 0xca, 0x69, 0x6f, 0xd1, 0xd1,
-	0x3f, 0x4b, 0x30, 0xeb, 0x39, 0x90, 0xb4, 0x93, 0xd8, 0xcc, 0x04, 0x13, 0xc7, 0x61, 0x89, 0xe1,
-	0xb8, 0xf2, 0xb7, 0xd2, 0xa1, 0xf2, 0x23, 0xc9, 0xfe, 0xbe, 0x54, 0xfc, 0x4b, 0xe9, 0x61, 0xe1,
-	0xda, 0x55, 0xba, 0x05, 0xda, 0xe2, 0x77, 0x85, 0x8a, 0xbc, 0x1f, 0xfa, 0x0e, 0x3e, 0xdf, 0x59,
-	0x7c, 0x70, 0x3e, 0xd4, 0x71, 0xee, 0x9d, 0xa5, 0x73, 0xe7, 0x29, 0x5f, 0x69, 0xf1, 0x9e, 0xd8,
-	0xb9, 0xf7, 0x43, 0xdf, 0xc1, 0x27, 0xe3, 0x0b, 0x3a, 0xce, 0x15, 0xae, 0x5d, 0xbd, 0x7a, 0x5f,
-	0x68, 0xe2, 0x95, 0x0f, 0xce, 0x5d, 0x5b, 0x78, 0xff, 0xe1, 0x02, 0x9e, 0x16, 0xd3, 0xbd, 0xc5,
-	0x66, 0x5b, 0xe2, 0x93, 0x45, 0xf7, 0x40, 0xee, 0x58, 0xc6, 0x0e, 0xd9, 0x51, 0x6b, 0xda, 0x26,
-	0xa9, 0xc9, 0x17, 0xd9, 0x42, 0xce, 0x70, 0x61, 0xf9, 0x30, 0xd5, 0x3a, 0xc8, 0xcd, 0xac, 0x87,
-	0x31, 0xae, 0xaf, 0x5c, 0xbf, 0x41, 0x09, 0xf1, 0x4c, 0x1b, 0xf4, 0x75, 0xb2, 0xc3, 0x9a, 0xd1,
-	0xbf, 0x49, 0x90, 0x09, 0x47, 0x71, 0x1d, 0xfb, 0x04, 0x7f, 0x9a, 0xfb, 0x24, 0x87, 0xa6, 0xdc,
-	0xbe, 0x57, 0x5b, 0x70, 0xaa, 0xc7, 0x72, 0x82, 0xfd, 0x7a, 0x85, 0x2d, 0xe8, 0xc5, 0xd0, 0x7e,
-	0x9d, 0x28, 0x75, 0x62, 0xf9, 0x7b, 0x76, 0xa2, 0x6b, 0x18, 0x7f, 0xdf, 0x30, 0xcc, 0xf4, 0x18,
-	0xc7, 0xd0, 0xe5, 0x4b, 0x6c, 0x80, 0x2c, 0x97, 0x54, 0x9d, 0xbd, 0x65, 0xe8, 0x04, 0xa9, 0x94,
-	0xf1, 0x54, 0x17, 0x72, 0x45, 0x47, 0xbf, 0x94, 0x60, 0x8a, 0x45, 0x82, 0x1d, 0x87, 0x30, 0xfe,
-	0xa7, 0x79, 0x08, 0x69, 0x3a, 0xd7, 0xf6, 0xdd, 0x77, 0x21, 0x5e, 0xb3, 0xf8, 0xaa, 0x1c, 0x79,
-	0x82, 0xd9, 0xa6, 0x42, 0x7f, 0xdb, 0x74, 0xc3, 0x23, 0xe5, 0xa6, 0xe9, 0xc2, 0xa1, 0x72, 0xee,
-	0xa7, 0xd2, 0xd9, 0xc1, 0x0c, 0x13, 0x0e, 0x06, 0x42, 0x97, 0x60, 0x4c, 0xbc, 0x6d, 0x95, 0x8b,
-	0xcc, 0x18, 0xcd, 0x75, 0xe7, 0x36, 0x58, 0x37, 0xf6, 0xe8, 0x7a, 0x96, 0xb1, 0x27, 0x07, 0x2e,
-	0x63, 0x27, 0x7a, 0x96, 0xb1, 0x7b, 0xe4, 0x99, 0x92, 0x7f, 0x8c, 0x07, 0x05, 0xa9, 0x3f, 0xd6,
-	0x83, 0x82, 0xf4, 0xf1, 0x1f, 0x14, 0x74, 0xd5, 0xdc, 0xd1, 0x20, 0x35, 0xf7, 0xa9, 0x41, 0x6a,
-	0xee, 0xd3, 0x03, 0xd7, 0xdc, 0x67, 0xfa, 0xd4, 0xdc, 0xaf, 0x40, 0xdc, 0xb6, 0x2c, 0x57, 0x65,
-	0x31, 0x02, 0x2f, 0x01, 0xc8, 0x5d, 0xe1, 0x93, 0x65, 0xb9, 0x34, 0x40, 0xc0, 0x31, 0x5b, 0x7c,
-	0xa1, 0xb7, 0x7d, 0x8f, 0x7b, 0x8e, 0x79, 0xdc, 0xca, 0x73, 0xf3, 0xb6, 0xd1, 0x9b, 0x30, 0xd1,
-	0xf6, 0x02, 0x42, 0x7e, 0xf6, 0x0b, 0x08, 0x1a, 0xf3, 0x87, 0x8b, 0xf9, 0x78, 0xbc, 0x1e, 0x7a,
-	0xf3, 0xb0, 0x0c, 0x71, 0x06, 0x48, 0x7d, 0x4a, 0x51, 0x82, 0x96, 0xfb, 0xf9, 0x9c, 0xca, 0x44,
-	0xeb, 0x20, 0xe7, 0xa7, 0x8c, 0x71, 0x8c, 0xe2, 0xb0, 0xe4, 0xf1, 0xdb, 0x90, 0xf6, 0x12, 0x55,
-	0x01, 0xd8, 0x85, 0x67, 0x80, 0x4d, 0x51, 0xf9, 0xd8, 0xe0, 0x6c, 0x3e, 0xa6, 0x97, 0x56, 0xbb,
-	0xe9, 0x41, 0x5f, 0x82, 0x31, 0x87, 0x47, 0x61, 0x72, 0xa6, 0xb7, 0xea, 0x8a, 0x20, 0x0d, 0x7b,
-	0x74, 0xe8, 0x1b, 0xe0, 0xa1, 0xa8, 0x1e, 0xeb, 0xc9, 0xa3, 0x59, 0x13, 0x82, 0xde, 0x7b, 0xcc,
-	0xbe, 0x00, 0x09, 0x3f, 0xa1, 0xca, 0x44, 0x84, 0x15, 0x04, 0x26, 0xf1, 0x84, 0x48, 0xa3, 0x32,
-	0xf1, 0x40, 0x67, 0x21, 0xd9, 0x74, 0x88, 0x1e, 0x50, 0x39, 0xf2, 0xe9, 0xf9, 0xe1, 0xc2, 0x24,
-	0x9e, 0xa4, 0xcd, 0x1e, 0x99, 0x43, 0xe9, 0x18, 0x5a, 0x20, 0x71, 0x2c, 0x63, 0x2f, 0x1e, 0x8b,
-	0xfb, 0xe2, 0x86, 0xbe, 0x22, 0xe8, 0xec, 0x77, 0x45, 0xed, 0xf0, 0x15, 0x39, 0xc7, 0x32, 0x0b,
-	0xf4, 0x12, 0x9a, 0xb8, 0xa1, 0x39, 0x2e, 0x5e, 0x63, 0x75, 0xc1, 0x57, 0xf8, 0x44, 0xf0, 0xbb,
-	0xfc, 0x57, 0x37, 0xe3, 0x25, 0x79, 0xbe, 0x27, 0xe3, 0xa5, 0x36, 0xc6, 0x4b, 0xe8, 0x21, 0x9c,
-	0xec, 0x4c, 0x1c, 0xdb, 0xa4, 0x4a, 0x8c, 0x5d, 0xee, 0xca, 0x9e, 0x39, 0x4e, 0x62, 0xda, 0xcf,
-	0x2e, 0x63, 0x81, 0x50, 0x72, 0xd1, 0x0a, 0x8c, 0xf3, 0x9c, 0x14, 0x97, 0x88, 0x7c, 0x1f, 0x3b,
-	0x44, 0x49, 0xb8, 0x4c, 0x04, 0x61, 0x1a, 0x34, 0xfc, 0x56, 0x74, 0x1f, 0xd0, 0x26, 0x7b, 0x9e,
-	0xb2, 0xaf, 0x36, 0x88, 0x5d, 0x25, 0xa6, 0xab, 0x6d, 0x13, 0xf9, 0x85, 0x67, 0x17, 0x8f, 0x93,
-	0x87, 0xca, 0x04, 0xc0, 0xe9, 0x48, 0xe4, 0xc3, 0x6b, 0x8b, 0x91, 0x48, 0x24, 0x82, 0xd3, 0x02,
-	0x67, 0xc3, 0x87, 0x41, 0x2f, 0x41, 0xd2, 0x4f, 0xbc, 0x88, 0xb2, 0xf4, 0xc2, 0xbc, 0x54, 0x18,
-	0xc1, 0x09, 0xaf, 0x59, 0xd4, 0x9b, 0x9f, 0xf5, 0xac, 0xbe, 0xf0, 0x5c, 0x9e, 0xd5, 0xa3, 0x37,
-	0x00, 0x42, 0xef, 0x7b, 0xce, 0x1d, 0xef, 0x7d, 0x0f, 0x0e, 0xf1, 0xa2, 0x4d, 0x48, 0x34, 0x6c,
-	0x6b, 0xd7, 0xa0, 0x22, 0xcd, 0x5d, 0x8f, 0xf3, 0xcc, 0x3e, 0xbf, 0x7a, 0xa8, 0xbc, 0x64, 0xbf,
-	0x28, 0x2f, 0x14, 0xcf, 0x1c, 0x7d, 0x83, 0xbe, 0xff, 0x70, 0xa1, 0x75, 0x90, 0x9b, 0xdc, 0x08,
-	0x30, 0x2a, 0x65, 0x3c, 0x19, 0x82, 0xac, 0xe8, 0xa8, 0x0c, 0x69, 0xbf, 0x81, 0x2a, 0x1c, 0x0d,
-	0xce, 0xe5, 0x97, 0x85, 0xb6, 0x75, 0x9e, 0xcc, 0x2d, 0xf6, 0x67, 0x34, 0x38, 0x15, 0xe6, 0xa0,
-	0x61, 0x2c, 0x3a, 0x05, 0xf1, 0x7a, 0xb3, 0x46, 0xc3, 0x53, 0xc7, 0x95, 0x17, 0x99, 0x31, 0x0e,
-	0x1a, 0xd0, 0x36, 0x9c, 0xa8, 0xd6, 0x34, 0xa3, 0xae, 0x6a, 0x6d, 0x51, 0xac, 0x5a, 0xb5, 0x74,
-	0x22, 0x2f, 0x3d, 0x23, 0xb8, 0xe8, 0x8e, 0x7c, 0xf1, 0x1c, 0x43, 0xeb, 0x11, 0x12, 0x2f, 0xc1,
-	0x94, 0xb3, 0x63, 0x34, 0x54, 0x91, 0x63, 0x52, 0xab, 0xf6, 0x7e, 0xc3, 0xb5, 0xe4, 0xcb, 0x6c,
-	0x42, 0x69, 0xda, 0x25, 0x36, 0x7c, 0x99, 0x75, 0xa0, 0xfb, 0x70, 0xaa, 0x07, 0xbd, 0x6a, 0xed,
-	0x12, 0xdb, 0x36, 0x74, 0x22, 0x7f, 0xa9, 0x8f, 0xfe, 0x04, 0x4f, 0x12, 0x4e, 0x74, 0x81, 0xbe,
-	0x29, 0x98, 0x33, 0xaf, 0x41, 0xb2, 0x23, 0x34, 0x0b, 0x67, 0x0b, 0xe2, 0x3c, 0x5b, 0x30, 0x1d,
-	0xce, 0x16, 0xc4, 0x43, 0x49, 0x80, 0xcc, 0x5d, 0x48, 0xb4, 0x7b, 0x4f, 0x3d, 0xb8, 0x97, 0xda,
-	0x73, 0x0d, 0x5d, 0xa6, 0xda, 0x03, 0x08, 0xe1, 0xae, 0x45, 0x63, 0x2f, 0xa6, 0xce, 0xae, 0x45,
-	0x63, 0x67, 0x53, 0x2f, 0xad, 0x45, 0x63, 0x2f, 0xa5, 0x0a, 0xf9, 0x37, 0x00, 0xfc, 0xdd, 0x76,
-	0xd0, 0x55, 0x18, 0x0f, 0xfe, 0x26, 0xcc, 0xcb, 0x9f, 0x9c, 0xe8, 0x7b, 0x3c, 0x18, 0x88, 0xcf,
-	0x9b, 0xff, 0x73, 0x98, 0x14, 0xd9, 0xab, 0x0d, 0x9b, 0x6c, 0x19, 0x7b, 0xe8, 0x6e, 0x28, 0x2d,
-	0xc6, 0x53, 0x81, 0xaf, 0x3e, 0x97, 0x94, 0xd8, 0x2c, 0x8c, 0xd6, 0x88, 0xb9, 0xed, 0x3e, 0x62,
-	0x2b, 0x9f, 0xc4, 0xe2, 0x57, 0x5e, 0x87, 0xd9, 0x65, 0x16, 0xd6, 0x07, 0xf3, 0x13, 0x89, 0xc2,
-	0x35, 0x80, 0x60, 0x59, 0xfe, 0xdb, 0xb7, 0x7e, 0xab, 0xea, 0x91, 0x6e, 0x88, 0xfb, 0xeb, 0xcc,
-	0xff, 0x4c, 0x82, 0xd9, 0x3b, 0x2c, 0xf0, 0xff, 0x43, 0x0e, 0x83, 0xae, 0x01, 0x04, 0x7f, 0xd9,
-	0xd6, 0x37, 0xb7, 0xb1, 0x4a, 0x49, 0x6e, 0x6a, 0xce, 0x8e, 0x48, 0x8d, 0xc6, 0xb7, 0xbc, 0x86,
-	0xfc, 0x3f, 0x48, 0x30, 0xf5, 0x3a, 0x71, 0xbb, 0x26, 0xf9, 0x0e, 0x24, 0x82, 0x49, 0xaa, 0x5f,
-	0x3c, 0x13, 0x33, 0x41, 0x02, 0x3a, 0xe7, 0x8b, 0x4f, 0xfb, 0x3f, 0x25, 0x78, 0x31, 0x3c, 0xed,
-	0xd0, 0xe0, 0xab, 0x96, 0xbd, 0x72, 0xa7, 0xe2, 0x78, 0x0b, 0xf9, 0x16, 0xc4, 0xd8, 0x9d, 0x4c,
-	0x9a, 0x86, 0x10, 0xaf, 0xd7, 0x3e, 0x6f, 0x6e, 0x74, 0xe5, 0x4e, 0xe5, 0xcb, 0x5f, 0xc2, 0x63,
-	0x14, 0x6e, 0xa5, 0x69, 0xa0, 0xbb, 0x40, 0x65, 0x8d, 0x01, 0x0f, 0x3d, 0x0f, 0xe0, 0x51, 0x9d,
-	0xec, 0xae, 0x34, 0x8d, 0xfc, 0x47, 0xc3, 0x30, 0x73, 0xc3, 0x70, 0x82, 0xc5, 0xf9, 0x6b, 0xd1,
-	0x20, 0x19, 0xbe, 0x96, 0x82, 0x53, 0x39, 0x7b, 0xc4, 0x85, 0x74, 0xf4, 0xb9, 0x24, 0xb4, 0x30,
-	0xe5, 0x17, 0x3f, 0x19, 0xf4, 0xb1, 0x04, 0x23, 0x96, 0xad, 0x13, 0x9b, 0x25, 0xc9, 0xe2, 0xca,
-	0x0f, 0xa5, 0x43, 0xe5, 0xaf, 0x24, 0xfb, 0x7b, 0x12, 0x8e, 0xe0, 0xb8, 0x2f, 0x4e, 0x18, 0x16,
-	0x83, 0x6f, 0xff, 0x80, 0x70, 0x7c, 0xd1, 0xff, 0xf4, 0xf6, 0x16, 0xc7, 0x16, 0xbd, 0x2f, 0x96,
-	0x27, 0xc3, 0x23, 0x8b, 0xec, 0xbf, 0x70, 0x3e, 0x0c, 0x4f, 0x2c, 0x86, 0x7f, 0x85, 0xd2, 0x7d,
-	0x78, 0x7c, 0x31, 0xf4, 0x83, 0x4f, 0x0c, 0x65, 0x61, 0x84, 0xff, 0xed, 0x15, 0xfb, 0xfb, 0x40,
-	0xe6, 0x8f, 0x9c, 0x1f, 0x96, 0x7f, 0x3b, 0x86, 0x79, 0x33, 0x42, 0x10, 0x6d, 0x50, 0xe7, 0x83,
-	0xff, 0x5d, 0x20, 0xfb, 0xce, 0xff, 0xbd, 0x04, 0x53, 0xb7, 0x7a, 0xe8, 0xc9, 0xea, 0xf1, 0x94,
-	0xb9, 0xbd, 0xc0, 0xf0, 0x3c, 0x15, 0xf9, 0x9f, 0x24, 0x38, 0x89, 0x69, 0x6c, 0x53, 0x32, 0xf5,
-	0xff, 0x87, 0x0a, 0xfd, 0x2f, 0x12, 0xa4, 0xfd, 0x91, 0x6f, 0x93, 0x7a, 0xa3, 0x46, 0x9d, 0xc2,
-	0x3f, 0x95, 0xdd, 0x45, 0x05, 0x18, 0xaf, 0x6b, 0x0d, 0xf6, 0x92, 0x82, 0xde, 0xaf, 0xc3, 0xe1,
-	0x04, 0xae, 0x8e, 0x41, 0xf4, 0x5d, 0x27, 0xfb, 0xf9, 0x4f, 0x24, 0x98, 0xeb, 0x5a, 0x08, 0x77,
-	0xde, 0xfc, 0xfc, 0xaf, 0xd4, 0xce, 0xde, 0x33, 0xff, 0x3b, 0x14, 0xce, 0xff, 0x7e, 0x2a, 0xb5,
-	0xe7, 0x7f, 0x6f, 0x43, 0x92, 0xe5, 0x44, 0xc9, 0x9e, 0x4b, 0x4c, 0x87, 0xe5, 0x59, 0x86, 0x59,
-	0x4d, 0xea, 0xe5, 0x43, 0xa5, 0xf0, 0x91, 0xf4, 0x62, 0x4a, 0x97, 0xa5, 0x7c, 0xce, 0x3e, 0x5d,
-	0x3c, 0xf9, 0xb0, 0x70, 0xed, 0xea, 0x3b, 0x4b, 0x9e, 0xcf, 0xf7, 0xde, 0xa5, 0x0b, 0x97, 0xbe,
-	0xfc, 0xc1, 0xb9, 0xf7, 0x2e, 0x5d, 0x28, 0x7e, 0xb0, 0x80, 0x13, 0x14, 0x63, 0xc5, 0x87, 0xc8,
-	0xff, 0xaf, 0x04, 0x72, 0x9f, 0xa9, 0x3b, 0xe8, 0x03, 0x18, 0xe3, 0x6e, 0xa7, 0x77, 0xdf, 0x5f,
-	0xe9, 0x7b, 0x0e, 0x1d, 0xac, 0x4b, 0xe2, 0xff, 0xcf, 0x93, 0xdf, 0xf1, 0xc6, 0xcc, 0x54, 0x61,
-	0x22, 0x0c, 0xd3, 0xc3, 0xd1, 0x79, 0x56, 0x51, 0xa5, 0xcf, 0xf4, 0x42, 0x7e, 0x4f, 0xfe, 0xfb,
-	0x12, 0xe4, 0x96, 0x2d, 0x73, 0x97, 0xd8, 0x6e, 0x17, 0xb5, 0xa7, 0x47, 0x1b, 0x10, 0xe7, 0x73,
-	0xa2, 0xbe, 0x36, 0x3f, 0xc8, 0xcb, 0x87, 0xca, 0x40, 0xab, 0xa1, 0x81, 0x35, 0x1f, 0xb4, 0x52,
-	0xc6, 0x31, 0x8e, 0x52, 0xd1, 0xa9, 0xb9, 0x61, 0x1e, 0x35, 0xbb, 0x44, 0x30, 0xfb, 0x3e, 0xbf,
-	0x0a, 0x10, 0x44, 0x4c, 0x28, 0x0d, 0x93, 0x1b, 0x6f, 0xbe, 0xb5, 0x82, 0xd5, 0x3b, 0xeb, 0xd7,
-	0xd7, 0xdf, 0x7c, 0x6b, 0x3d, 0x15, 0x09, 0x9a, 0x94, 0xd2, 0xed, 0xdb, 0x2b, 0xf8, 0xed, 0x94,
-	0x84, 0x10, 0x24, 0x78, 0xd3, 0xca, 0xb7, 0x6e, 0xaf, 0xe0, 0xf5, 0xd2, 0x8d, 0xd4, 0x90, 0xf2,
-	0x33, 0xe9, 0xd3, 0x27, 0x59, 0xe9, 0xb3, 0x27, 0x59, 0xe9, 0xd7, 0x4f, 0xb2, 0x91, 0xdf, 0x3c,
-	0xc9, 0x46, 0x7e, 0xfb, 0x24, 0x1b, 0xf9, 0xdd, 0x93, 0x6c, 0xe4, 0xf7, 0x4f, 0xb2, 0xd2, 0x87,
-	0xad, 0xac, 0xf4, 0x83, 0x56, 0x36, 0xf2, 0xf3, 0x56, 0x56, 0xfa, 0x45, 0x2b, 0x1b, 0xf9, 0xa4,
-	0x95, 0x8d, 0xfc, 0xaa, 0x95, 0x8d, 0x7c, 0xda, 0xca, 0x4a, 0x9f, 0xb5, 0xb2, 0xd2, 0xaf, 0x5b,
-	0xd9, 0xc8, 0x6f, 0x5a, 0x59, 0xe9, 0xb7, 0xad, 0x6c, 0xe4, 0x77, 0xad, 0xac, 0xf4, 0xfb, 0x56,
-	0x36, 0xf2, 0xe1, 0xd3, 0x6c, 0xe4, 0x07, 0x4f, 0xb3, 0xd2, 0x8f, 0x9f, 0x66, 0x23, 0x3f, 0x79,
-	0x9a, 0x95, 0x3e, 0x7e, 0x9a, 0x8d, 0xfc, 0xfc, 0x69, 0x36, 0xf2, 0x8b, 0xa7, 0x59, 0xe9, 0x93,
-	0xa7, 0x59, 0xe9, 0x57, 0x4f, 0xb3, 0xd2, 0xbd, 0x8b, 0xc7, 0xb8, 0x01, 0x5d, 0xb3, 0xb1, 0xb9,
-	0x39, 0xca, 0x94, 0xf0, 0xf2, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x7c, 0x11, 0xe1, 0x7e,
-	0x40, 0x00, 0x00,
+func (m *UpdateEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateEndDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateEndDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateEndDeviceRequest.Merge(m, src)
+}
+func (m *UpdateEndDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateEndDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateEndDeviceRequest.DiscardUnknown(m)
 }
 
-func (x PowerState) String() string {
-	s, ok := PowerState_name[int32(x)]
-	if ok {
-		return s
+var xxx_messageInfo_UpdateEndDeviceRequest proto.InternalMessageInfo
+
+func (m *UpdateEndDeviceRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return strconv.Itoa(int(x))
+	return types.FieldMask{}
 }
-func (this *Session) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Session)
-	if !ok {
-		that2, ok := that.(Session)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
-	}
-	if !this.SessionKeys.Equal(&that1.SessionKeys) {
-		return false
-	}
-	if this.LastFCntUp != that1.LastFCntUp {
-		return false
-	}
-	if this.LastNFCntDown != that1.LastNFCntDown {
-		return false
-	}
-	if this.LastAFCntDown != that1.LastAFCntDown {
-		return false
-	}
-	if this.LastConfFCntDown != that1.LastConfFCntDown {
-		return false
-	}
-	if !this.StartedAt.Equal(that1.StartedAt) {
-		return false
-	}
-	if len(this.QueuedApplicationDownlinks) != len(that1.QueuedApplicationDownlinks) {
-		return false
-	}
-	for i := range this.QueuedApplicationDownlinks {
-		if !this.QueuedApplicationDownlinks[i].Equal(that1.QueuedApplicationDownlinks[i]) {
-			return false
-		}
-	}
-	return true
+type GetEndDeviceRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The names of the end device fields that should be returned.
+	// See the API reference for which fields can be returned by the different services.
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (this *BoolValue) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*BoolValue)
-	if !ok {
-		that2, ok := that.(BoolValue)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
+func (*GetEndDeviceRequest) ProtoMessage() {}
+func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{14}
+}
+func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEndDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
-func (this *MACParameters) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetEndDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEndDeviceRequest.Merge(m, src)
+}
+func (m *GetEndDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEndDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEndDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEndDeviceRequest proto.InternalMessageInfo
+
+func (m *GetEndDeviceRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
+	return types.FieldMask{}
+}
 
-	that1, ok := that.(*MACParameters)
-	if !ok {
-		that2, ok := that.(MACParameters)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetEndDeviceIdentifiersForEUIsRequest struct {
+	JoinEui              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
+	DevEui               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
+func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
+func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{15}
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxEIRP != that1.MaxEIRP {
-		return false
-	}
-	if this.ADRDataRateIndex != that1.ADRDataRateIndex {
-		return false
-	}
-	if this.ADRTxPowerIndex != that1.ADRTxPowerIndex {
-		return false
-	}
-	if this.ADRNbTrans != that1.ADRNbTrans {
-		return false
-	}
-	if this.ADRAckLimit != that1.ADRAckLimit {
-		return false
-	}
-	if this.ADRAckDelay != that1.ADRAckDelay {
-		return false
-	}
-	if this.Rx1Delay != that1.Rx1Delay {
-		return false
-	}
-	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
-		return false
-	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
-		return false
-	}
-	if this.MaxDutyCycle != that1.MaxDutyCycle {
-		return false
-	}
-	if this.RejoinTimePeriodicity != that1.RejoinTimePeriodicity {
-		return false
-	}
-	if this.RejoinCountPeriodicity != that1.RejoinCountPeriodicity {
-		return false
-	}
-	if this.PingSlotFrequency != that1.PingSlotFrequency {
-		return false
-	}
-	if this.PingSlotDataRateIndex != that1.PingSlotDataRateIndex {
-		return false
-	}
-	if this.BeaconFrequency != that1.BeaconFrequency {
-		return false
-	}
-	if len(this.Channels) != len(that1.Channels) {
-		return false
-	}
-	for i := range this.Channels {
-		if !this.Channels[i].Equal(that1.Channels[i]) {
-			return false
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.Merge(m, src)
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest proto.InternalMessageInfo
+
+type ListEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// The names of the end device fields that should be returned.
+	// See the API reference for which fields can be returned by the different services.
+	FieldMask types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Order the results by this field path (must be present in the field mask).
+	// Default ordering is by ID. Prepend with a minus (-) to reverse the order.
+	Order string `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
+func (*ListEndDevicesRequest) ProtoMessage() {}
+func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{16}
+}
+func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.UplinkDwellTime.Equal(that1.UplinkDwellTime) {
-		return false
-	}
-	if !this.DownlinkDwellTime.Equal(that1.DownlinkDwellTime) {
-		return false
-	}
-	if !this.ADRAckLimitExponent.Equal(that1.ADRAckLimitExponent) {
-		return false
+}
+func (m *ListEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEndDevicesRequest.Merge(m, src)
+}
+func (m *ListEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEndDevicesRequest proto.InternalMessageInfo
+
+func (m *ListEndDevicesRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if !this.ADRAckDelayExponent.Equal(that1.ADRAckDelayExponent) {
-		return false
+	return types.FieldMask{}
+}
+
+func (m *ListEndDevicesRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
 	}
-	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
-		return false
+	return ""
+}
+
+func (m *ListEndDevicesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return true
+	return 0
 }
-func (this *MACParameters_Channel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListEndDevicesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
 	}
+	return 0
+}
 
-	that1, ok := that.(*MACParameters_Channel)
-	if !ok {
-		that2, ok := that.(MACParameters_Channel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetEndDeviceRequest struct {
+	EndDevice EndDevice `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
+	// The names of the end device fields that should be updated.
+	// See the API reference for which fields can be set on the different services.
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
+func (*SetEndDeviceRequest) ProtoMessage() {}
+func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{17}
+}
+func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetEndDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.UplinkFrequency != that1.UplinkFrequency {
-		return false
-	}
-	if this.DownlinkFrequency != that1.DownlinkFrequency {
-		return false
-	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
-		return false
-	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
-		return false
-	}
-	if this.EnableUplink != that1.EnableUplink {
-		return false
+}
+func (m *SetEndDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEndDeviceRequest.Merge(m, src)
+}
+func (m *SetEndDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetEndDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEndDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetEndDeviceRequest proto.InternalMessageInfo
+
+func (m *SetEndDeviceRequest) GetEndDevice() EndDevice {
+	if m != nil {
+		return m.EndDevice
 	}
-	return true
+	return EndDevice{}
 }
-func (this *EndDeviceVersion) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetEndDeviceRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
+	return types.FieldMask{}
+}
 
-	that1, ok := that.(*EndDeviceVersion)
-	if !ok {
-		that2, ok := that.(EndDeviceVersion)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ResetAndGetEndDeviceRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The names of the end device fields that should be returned.
+	// See the API reference for which fields can be returned by the different services.
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ResetAndGetEndDeviceRequest) Reset()      { *m = ResetAndGetEndDeviceRequest{} }
+func (*ResetAndGetEndDeviceRequest) ProtoMessage() {}
+func (*ResetAndGetEndDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{18}
+}
+func (m *ResetAndGetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetAndGetEndDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetAndGetEndDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
-		return false
-	}
-	if this.LoRaWANVersion != that1.LoRaWANVersion {
-		return false
-	}
-	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
-		return false
-	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
-		return false
-	}
-	if len(this.Photos) != len(that1.Photos) {
-		return false
+}
+func (m *ResetAndGetEndDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetAndGetEndDeviceRequest.Merge(m, src)
+}
+func (m *ResetAndGetEndDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetAndGetEndDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetAndGetEndDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetAndGetEndDeviceRequest proto.InternalMessageInfo
+
+func (m *ResetAndGetEndDeviceRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	for i := range this.Photos {
-		if this.Photos[i] != that1.Photos[i] {
-			return false
+	return types.FieldMask{}
+}
+
+type EndDeviceTemplate struct {
+	EndDevice            EndDevice       `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	MappingKey           string          `protobuf:"bytes,3,opt,name=mapping_key,json=mappingKey,proto3" json:"mapping_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
+func (*EndDeviceTemplate) ProtoMessage() {}
+func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{19}
+}
+func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.SupportsClassB != that1.SupportsClassB {
-		return false
-	}
-	if this.SupportsClassC != that1.SupportsClassC {
-		return false
-	}
-	if !this.DefaultMACSettings.Equal(that1.DefaultMACSettings) {
-		return false
+}
+func (m *EndDeviceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplate.Merge(m, src)
+}
+func (m *EndDeviceTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplate proto.InternalMessageInfo
+
+func (m *EndDeviceTemplate) GetEndDevice() EndDevice {
+	if m != nil {
+		return m.EndDevice
 	}
-	if this.MinFrequency != that1.MinFrequency {
-		return false
+	return EndDevice{}
+}
+
+func (m *EndDeviceTemplate) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.MaxFrequency != that1.MaxFrequency {
-		return false
+	return types.FieldMask{}
+}
+
+func (m *EndDeviceTemplate) GetMappingKey() string {
+	if m != nil {
+		return m.MappingKey
 	}
-	if this.SupportsJoin != that1.SupportsJoin {
-		return false
+	return ""
+}
+
+type EndDeviceTemplateFormat struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FileExtensions       []string `protobuf:"bytes,3,rep,name=file_extensions,json=fileExtensions,proto3" json:"file_extensions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
+func (*EndDeviceTemplateFormat) ProtoMessage() {}
+func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{20}
+}
+func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplateFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplateFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
-		return false
+}
+func (m *EndDeviceTemplateFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplateFormat.Merge(m, src)
+}
+func (m *EndDeviceTemplateFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplateFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplateFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplateFormat proto.InternalMessageInfo
+
+func (m *EndDeviceTemplateFormat) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if !this.DefaultFormatters.Equal(&that1.DefaultFormatters) {
-		return false
+	return ""
+}
+
+func (m *EndDeviceTemplateFormat) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return true
+	return ""
 }
-func (this *MACSettings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceTemplateFormat) GetFileExtensions() []string {
+	if m != nil {
+		return m.FileExtensions
 	}
+	return nil
+}
 
-	that1, ok := that.(*MACSettings)
-	if !ok {
-		that2, ok := that.(MACSettings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EndDeviceTemplateFormats struct {
+	Formats              map[string]*EndDeviceTemplateFormat `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
+func (*EndDeviceTemplateFormats) ProtoMessage() {}
+func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{21}
+}
+func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplateFormats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EndDeviceTemplateFormats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplateFormats.Merge(m, src)
+}
+func (m *EndDeviceTemplateFormats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplateFormats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplateFormats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplateFormats proto.InternalMessageInfo
+
+func (m *EndDeviceTemplateFormats) GetFormats() map[string]*EndDeviceTemplateFormat {
+	if m != nil {
+		return m.Formats
 	}
-	if this.ClassBTimeout != nil && that1.ClassBTimeout != nil {
-		if *this.ClassBTimeout != *that1.ClassBTimeout {
-			return false
+	return nil
+}
+
+type ConvertEndDeviceTemplateRequest struct {
+	// ID of the format.
+	FormatID string `protobuf:"bytes,1,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
+	// Data to convert.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
+func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
+func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{22}
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertEndDeviceTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.ClassBTimeout != nil {
-		return false
-	} else if that1.ClassBTimeout != nil {
-		return false
-	}
-	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
-		return false
-	}
-	if !this.PingSlotDataRateIndex.Equal(that1.PingSlotDataRateIndex) {
-		return false
+		return b[:n], nil
 	}
-	if !this.PingSlotFrequency.Equal(that1.PingSlotFrequency) {
-		return false
-	}
-	if !this.BeaconFrequency.Equal(that1.BeaconFrequency) {
-		return false
-	}
-	if this.ClassCTimeout != nil && that1.ClassCTimeout != nil {
-		if *this.ClassCTimeout != *that1.ClassCTimeout {
-			return false
-		}
-	} else if this.ClassCTimeout != nil {
-		return false
-	} else if that1.ClassCTimeout != nil {
-		return false
-	}
-	if !this.Rx1Delay.Equal(that1.Rx1Delay) {
-		return false
-	}
-	if !this.Rx1DataRateOffset.Equal(that1.Rx1DataRateOffset) {
-		return false
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest.Merge(m, src)
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertEndDeviceTemplateRequest proto.InternalMessageInfo
+
+func (m *ConvertEndDeviceTemplateRequest) GetFormatID() string {
+	if m != nil {
+		return m.FormatID
 	}
-	if !this.Rx2DataRateIndex.Equal(that1.Rx2DataRateIndex) {
-		return false
+	return ""
+}
+
+func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if !this.Rx2Frequency.Equal(that1.Rx2Frequency) {
-		return false
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	proto.RegisterType((*BoolValue)(nil), "ttn.lorawan.v3.BoolValue")
+	golang_proto.RegisterType((*BoolValue)(nil), "ttn.lorawan.v3.BoolValue")
+	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	proto.RegisterType((*ADRSettings)(nil), "ttn.lorawan.v3.ADRSettings")
+	golang_proto.RegisterType((*ADRSettings)(nil), "ttn.lorawan.v3.ADRSettings")
+	proto.RegisterType((*ADRSettings_DynamicStrategy)(nil), "ttn.lorawan.v3.ADRSettings.DynamicStrategy")
+	golang_proto.RegisterType((*ADRSettings_DynamicStrategy)(nil), "ttn.lorawan.v3.ADRSettings.DynamicStrategy")
+	proto.RegisterType((*ADRSettings_LossBasedStrategy)(nil), "ttn.lorawan.v3.ADRSettings.LossBasedStrategy")
+	golang_proto.RegisterType((*ADRSettings_LossBasedStrategy)(nil), "ttn.lorawan.v3.ADRSettings.LossBasedStrategy")
+	proto.RegisterType((*ADRSettings_MobileStrategy)(nil), "ttn.lorawan.v3.ADRSettings.MobileStrategy")
+	golang_proto.RegisterType((*ADRSettings_MobileStrategy)(nil), "ttn.lorawan.v3.ADRSettings.MobileStrategy")
+	proto.RegisterType((*ADRSettings_StaticStrategy)(nil), "ttn.lorawan.v3.ADRSettings.StaticStrategy")
+	golang_proto.RegisterType((*ADRSettings_StaticStrategy)(nil), "ttn.lorawan.v3.ADRSettings.StaticStrategy")
+	proto.RegisterType((*ADRDecision)(nil), "ttn.lorawan.v3.ADRDecision")
+	golang_proto.RegisterType((*ADRDecision)(nil), "ttn.lorawan.v3.ADRDecision")
+	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
+	golang_proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
+	proto.RegisterType((*MACState_JoinRequest)(nil), "ttn.lorawan.v3.MACState.JoinRequest")
+	golang_proto.RegisterType((*MACState_JoinRequest)(nil), "ttn.lorawan.v3.MACState.JoinRequest")
+	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
+	golang_proto.RegisterType((*MACState_DataRateRange)(nil), "ttn.lorawan.v3.MACState.DataRateRange")
+	proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
+	golang_proto.RegisterType((*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.DataRateRanges")
+	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	proto.RegisterType((*DevAddrPrefix)(nil), "ttn.lorawan.v3.DevAddrPrefix")
+	golang_proto.RegisterType((*DevAddrPrefix)(nil), "ttn.lorawan.v3.DevAddrPrefix")
+	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	proto.RegisterType((*ResetAndGetEndDeviceRequest)(nil), "ttn.lorawan.v3.ResetAndGetEndDeviceRequest")
+	golang_proto.RegisterType((*ResetAndGetEndDeviceRequest)(nil), "ttn.lorawan.v3.ResetAndGetEndDeviceRequest")
+	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
+
+var fileDescriptor_a656ee0551c94a80 = []byte{
+	// 5562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5b, 0x6c, 0x1c, 0xd7,
+	0x79, 0xde, 0xd9, 0x5d, 0x72, 0x77, 0x7f, 0x92, 0xbb, 0xcb, 0xc3, 0xdb, 0x70, 0x25, 0xed, 0x52,
+	0x6b, 0x59, 0xa6, 0x64, 0x91, 0xb2, 0xa8, 0xd8, 0x49, 0xe4, 0x3a, 0xca, 0x0e, 0x97, 0xb4, 0x48,
+	0x49, 0x14, 0x73, 0x74, 0x71, 0x2c, 0x59, 0x9e, 0x0c, 0x77, 0x0e, 0xa9, 0x31, 0x77, 0x67, 0x36,
+	0x33, 0xb3, 0x14, 0x19, 0xdb, 0xa8, 0x1b, 0xb4, 0xc8, 0x05, 0x6d, 0x11, 0xe8, 0x29, 0xcd, 0x43,
+	0xe1, 0x97, 0x00, 0x79, 0xcc, 0x43, 0x51, 0x18, 0x45, 0x81, 0xe4, 0xa5, 0x85, 0x51, 0xa0, 0xa8,
+	0x9f, 0x8a, 0xb4, 0x05, 0xd8, 0x68, 0xf5, 0x92, 0xc7, 0x3c, 0x06, 0x7c, 0x08, 0x8a, 0x73, 0x99,
+	0xcb, 0xde, 0xc8, 0xa5, 0xad, 0x04, 0xe9, 0x8b, 0x34, 0x7b, 0xce, 0xff, 0x7f, 0xe7, 0xf6, 0xff,
+	0xff, 0xf9, 0x2f, 0x87, 0x50, 0xac, 0x5a, 0xb6, 0xf6, 0x58, 0x33, 0xe7, 0x1c, 0x57, 0xab, 0x6c,
+	0x5f, 0xd4, 0xea, 0xc6, 0x45, 0x62, 0xea, 0xaa, 0x4e, 0x76, 0x8c, 0x0a, 0x99, 0xaf, 0xdb, 0x96,
+	0x6b, 0xa1, 0xb4, 0xeb, 0x9a, 0xf3, 0x82, 0x6e, 0x7e, 0xe7, 0x72, 0xae, 0xb4, 0x65, 0xb8, 0x8f,
+	0x1a, 0x1b, 0xf3, 0x15, 0xab, 0x76, 0x91, 0x98, 0x3b, 0xd6, 0x5e, 0xdd, 0xb6, 0x76, 0xf7, 0x2e,
+	0x32, 0xe2, 0xca, 0xdc, 0x16, 0x31, 0xe7, 0x76, 0xb4, 0xaa, 0xa1, 0x6b, 0x2e, 0xb9, 0xd8, 0xf1,
+	0xc1, 0x21, 0x73, 0x73, 0x21, 0x88, 0x2d, 0x6b, 0xcb, 0xe2, 0xcc, 0x1b, 0x8d, 0x4d, 0xf6, 0x8b,
+	0xfd, 0x60, 0x5f, 0x82, 0x3c, 0xbf, 0x65, 0x59, 0x5b, 0x55, 0x12, 0x50, 0xe9, 0x0d, 0x5b, 0x73,
+	0x0d, 0xcb, 0x14, 0xfd, 0x33, 0xed, 0xfd, 0x9b, 0x06, 0xa9, 0xea, 0x6a, 0x4d, 0x73, 0xb6, 0x05,
+	0xc5, 0xc9, 0x76, 0x0a, 0xc7, 0xb5, 0x1b, 0x15, 0x57, 0xf4, 0x16, 0xda, 0x7b, 0x5d, 0xa3, 0x46,
+	0x1c, 0x57, 0xab, 0xd5, 0x7b, 0x4d, 0xe0, 0xb1, 0xad, 0xd5, 0xeb, 0xc4, 0x76, 0x44, 0xff, 0x0b,
+	0x9d, 0xdb, 0x68, 0xe8, 0xc4, 0x74, 0x8d, 0x4d, 0x23, 0x20, 0x3a, 0xd9, 0x49, 0xb4, 0x4d, 0xf6,
+	0xbc, 0xde, 0x42, 0x67, 0xaf, 0xb7, 0xe7, 0x62, 0x91, 0x9d, 0x04, 0x35, 0xe2, 0x38, 0xda, 0x16,
+	0x39, 0x04, 0xa2, 0x6e, 0x54, 0xdc, 0x86, 0x4d, 0x0e, 0x83, 0x70, 0x35, 0x5d, 0x73, 0x35, 0x4e,
+	0x51, 0xfc, 0x8b, 0x38, 0x24, 0x6e, 0x13, 0xc7, 0x31, 0x2c, 0x13, 0xdd, 0x87, 0xa4, 0x4e, 0x76,
+	0x54, 0x4d, 0xd7, 0x6d, 0x39, 0x3a, 0x23, 0xcd, 0x0e, 0x2b, 0x57, 0x3f, 0xdd, 0x2f, 0x44, 0xfe,
+	0x7b, 0xbf, 0xf0, 0xe5, 0x2d, 0x6b, 0xde, 0x7d, 0x44, 0xdc, 0x47, 0x86, 0xb9, 0xe5, 0xcc, 0x9b,
+	0xc4, 0x7d, 0x6c, 0xd9, 0xdb, 0x17, 0x5b, 0xc1, 0x77, 0x2e, 0x5f, 0xac, 0x6f, 0x6f, 0x5d, 0x74,
+	0xf7, 0xea, 0xc4, 0x99, 0x2f, 0x93, 0x9d, 0x92, 0xae, 0xdb, 0x38, 0xa1, 0xf3, 0x0f, 0x54, 0x82,
+	0x38, 0x5d, 0xbb, 0x1c, 0x9b, 0x91, 0x66, 0x87, 0x16, 0x4e, 0xcc, 0xb7, 0x8a, 0xd8, 0xbc, 0x98,
+	0xc2, 0x75, 0xb2, 0xe7, 0x28, 0xd9, 0x03, 0x65, 0xe0, 0x87, 0x52, 0x34, 0x2b, 0xd1, 0xc1, 0x3f,
+	0xdb, 0x2f, 0x48, 0x98, 0xb1, 0xa2, 0xd3, 0x30, 0x52, 0xd5, 0x1c, 0x57, 0xdd, 0x54, 0x2b, 0xa6,
+	0xab, 0x36, 0xea, 0x72, 0x7c, 0x46, 0x9a, 0x1d, 0xc1, 0x40, 0x1b, 0x97, 0x17, 0x4d, 0xf7, 0x6e,
+	0x1d, 0xcd, 0xc2, 0x28, 0x23, 0x31, 0x05, 0x91, 0x6e, 0x3d, 0x36, 0xe5, 0x01, 0x46, 0xc6, 0x78,
+	0xd7, 0x28, 0x5d, 0xd9, 0x7a, 0x6c, 0xfa, 0x94, 0x5a, 0x98, 0x72, 0x30, 0xa0, 0x2c, 0xf9, 0x94,
+	0xf3, 0x30, 0xce, 0x28, 0x2b, 0x96, 0xb9, 0x19, 0x26, 0x4e, 0x30, 0xe2, 0x2c, 0xed, 0x5b, 0xb4,
+	0xcc, 0x4d, 0x9f, 0x7e, 0x11, 0xc0, 0x71, 0x35, 0xdb, 0x25, 0xba, 0xaa, 0xb9, 0x72, 0x92, 0xad,
+	0x37, 0x37, 0xcf, 0xe5, 0x69, 0xde, 0x93, 0xa7, 0xf9, 0x3b, 0x9e, 0xc0, 0x29, 0x49, 0xba, 0xcc,
+	0x1f, 0xfd, 0x6f, 0x41, 0xc2, 0x29, 0xc1, 0x57, 0x72, 0x11, 0x81, 0x93, 0xdf, 0x6e, 0x90, 0x06,
+	0xc5, 0xa8, 0xd7, 0xab, 0x46, 0x85, 0x09, 0x3f, 0x1b, 0xb7, 0x6a, 0x98, 0xdb, 0x8e, 0x9c, 0x9a,
+	0x89, 0xcd, 0x0e, 0x2d, 0xbc, 0xd0, 0xbe, 0x8d, 0xa5, 0x80, 0xb8, 0x2c, 0x68, 0x71, 0x8e, 0x03,
+	0x75, 0xe9, 0x72, 0x56, 0xe3, 0x49, 0x29, 0x1b, 0x2d, 0x9e, 0x86, 0x94, 0x62, 0x59, 0xd5, 0x7b,
+	0x5a, 0xb5, 0x41, 0xd0, 0x38, 0x0c, 0xec, 0xd0, 0x0f, 0x59, 0x9a, 0x91, 0x66, 0x93, 0x98, 0xff,
+	0x28, 0xfe, 0x47, 0x16, 0x46, 0x6e, 0x96, 0x16, 0xd7, 0x35, 0x5b, 0xab, 0x11, 0x97, 0xd8, 0x0e,
+	0x3a, 0x0b, 0xc9, 0x9a, 0xb6, 0xab, 0x12, 0xc3, 0xae, 0x33, 0xd2, 0xa8, 0x32, 0xd4, 0xdc, 0x2f,
+	0x24, 0x6e, 0x6a, 0xbb, 0x4b, 0x2b, 0x78, 0x1d, 0x27, 0x6a, 0xda, 0xee, 0x92, 0x61, 0xd7, 0xd1,
+	0x7b, 0x30, 0xa6, 0xe9, 0xb6, 0x4a, 0x45, 0x4e, 0xb5, 0x35, 0x97, 0xa8, 0x86, 0xa9, 0x93, 0x5d,
+	0x76, 0x76, 0xe9, 0x85, 0x53, 0xed, 0x0b, 0x28, 0x6b, 0xae, 0x86, 0x35, 0x97, 0xac, 0x50, 0x22,
+	0xe5, 0xe4, 0x81, 0x32, 0xf0, 0x5d, 0x2a, 0x09, 0xcd, 0xfd, 0x42, 0xb6, 0x54, 0xc6, 0x2d, 0xbd,
+	0x38, 0xab, 0xe9, 0x76, 0x4b, 0x0b, 0x7a, 0x13, 0x10, 0x1d, 0xcb, 0xdd, 0x55, 0xeb, 0xd6, 0x63,
+	0x62, 0x8b, 0xa1, 0xd8, 0xf9, 0x2b, 0xb9, 0x03, 0x25, 0x7e, 0x3e, 0x2a, 0x67, 0x9a, 0xfb, 0x85,
+	0x4c, 0xa9, 0x8c, 0xef, 0xec, 0xae, 0x53, 0x12, 0x8e, 0x94, 0xd1, 0x74, 0x3b, 0xdc, 0x80, 0xbe,
+	0x0c, 0xc3, 0x14, 0xc8, 0xdc, 0x50, 0x5d, 0x5b, 0x33, 0x1d, 0x2e, 0x18, 0xca, 0x44, 0x00, 0x01,
+	0xa5, 0x32, 0x5e, 0xdb, 0xb8, 0x43, 0x3b, 0x31, 0x68, 0xba, 0x2d, 0xbe, 0xd1, 0xab, 0x30, 0x42,
+	0x19, 0xb5, 0xca, 0xb6, 0x5a, 0x35, 0x6a, 0x86, 0xcb, 0xa5, 0x44, 0x19, 0x6d, 0xee, 0x17, 0x86,
+	0x4a, 0x65, 0x5c, 0xaa, 0x6c, 0xdf, 0x60, 0xcd, 0x12, 0x1e, 0xd2, 0x74, 0xdb, 0xfb, 0x19, 0x66,
+	0xd3, 0x49, 0x55, 0xdb, 0x93, 0x93, 0xed, 0x6c, 0x65, 0xd6, 0xec, 0xb3, 0xb1, 0x9f, 0xe8, 0x6b,
+	0x90, 0xb2, 0x77, 0x2f, 0x09, 0x96, 0x14, 0xdb, 0xd1, 0xa9, 0xf6, 0x1d, 0xc5, 0xbb, 0x8c, 0x56,
+	0x49, 0x7a, 0x7b, 0x89, 0x93, 0xf6, 0xee, 0x25, 0xce, 0xff, 0x36, 0x8c, 0x33, 0x7e, 0xff, 0x6c,
+	0xac, 0xcd, 0x4d, 0x87, 0xb8, 0x32, 0x30, 0xa8, 0x7c, 0xaf, 0xc3, 0xb9, 0xc5, 0xa8, 0x42, 0x88,
+	0xa3, 0x14, 0xb1, 0xa5, 0x13, 0xdd, 0x83, 0x31, 0x7b, 0x77, 0xa1, 0xe3, 0xd8, 0x87, 0xfa, 0x39,
+	0xf6, 0x00, 0x38, 0x6b, 0xef, 0x2e, 0xb4, 0x1e, 0xf1, 0x3c, 0x8c, 0x50, 0xdc, 0x4d, 0x9b, 0x7c,
+	0xbb, 0x41, 0xcc, 0xca, 0x9e, 0x3c, 0x3c, 0x23, 0xcd, 0xc6, 0x95, 0xd4, 0x81, 0x32, 0xb8, 0x10,
+	0x9f, 0xfd, 0xf8, 0x6f, 0x06, 0xf1, 0xb0, 0xbd, 0xbb, 0xb0, 0xec, 0x75, 0xa3, 0xdb, 0x90, 0xa6,
+	0x62, 0xaa, 0x37, 0xdc, 0x3d, 0xb5, 0xb2, 0x57, 0xa9, 0x12, 0x79, 0x84, 0x4d, 0xa1, 0x53, 0x75,
+	0xb6, 0xb6, 0x6c, 0xb2, 0xa5, 0xb9, 0x44, 0x2f, 0x37, 0xdc, 0xbd, 0x45, 0x4a, 0x1a, 0x9a, 0xc8,
+	0x70, 0x4d, 0xdb, 0xf5, 0xdb, 0x91, 0x0e, 0x53, 0x36, 0x79, 0xcf, 0x32, 0x4c, 0x95, 0xde, 0x1b,
+	0x6a, 0x9d, 0xd8, 0x86, 0xa5, 0x1b, 0x15, 0xc3, 0xdd, 0x93, 0xd3, 0x0c, 0xbd, 0xd8, 0x71, 0x0a,
+	0x8c, 0x9c, 0x2a, 0xfd, 0xd2, 0x6e, 0xdd, 0x32, 0x89, 0x19, 0xde, 0xbe, 0x09, 0xdb, 0xef, 0x5d,
+	0x0f, 0xa0, 0xd0, 0x16, 0xc8, 0x62, 0x94, 0x8a, 0xd5, 0x30, 0xdd, 0x96, 0x61, 0x32, 0xdd, 0x17,
+	0xc1, 0x87, 0x59, 0xa4, 0xe4, 0x5d, 0xc6, 0x99, 0xb4, 0x83, 0xee, 0xf0, 0x40, 0xaf, 0xc3, 0x58,
+	0xdd, 0x30, 0xb7, 0x54, 0xa7, 0x6a, 0xb9, 0xa1, 0x9d, 0xcd, 0xb2, 0x9d, 0x1d, 0x3a, 0x50, 0x92,
+	0x0b, 0x83, 0x72, 0x84, 0xed, 0xed, 0x28, 0xa5, 0xbb, 0x5d, 0xb5, 0xdc, 0x60, 0x83, 0x1f, 0xc0,
+	0x74, 0xc0, 0xdc, 0x7e, 0xdc, 0xa3, 0xfd, 0x1c, 0x77, 0x54, 0x96, 0xf0, 0x84, 0x07, 0xdc, 0x7a,
+	0xda, 0xaf, 0x41, 0x76, 0x83, 0x68, 0x15, 0xcb, 0x0c, 0x4d, 0x0b, 0x75, 0x4e, 0x2b, 0xc3, 0x89,
+	0x82, 0x49, 0x5d, 0x87, 0x64, 0xe5, 0x91, 0x66, 0x9a, 0xa4, 0xea, 0xc8, 0x63, 0xcc, 0x54, 0xbe,
+	0xd8, 0x3e, 0x87, 0x16, 0x6b, 0x36, 0xbf, 0xc8, 0xa9, 0xd9, 0x66, 0x3d, 0x91, 0xa2, 0x49, 0x09,
+	0xfb, 0x00, 0x68, 0x09, 0x46, 0x1b, 0x75, 0x6a, 0x2f, 0x55, 0xfd, 0x31, 0xa9, 0x56, 0xd9, 0x99,
+	0xcb, 0xe3, 0xcc, 0xae, 0x4f, 0xb7, 0xa3, 0xfa, 0x76, 0x14, 0x67, 0x38, 0x4f, 0x99, 0xb2, 0xd0,
+	0x93, 0x45, 0x2b, 0x30, 0xe6, 0xd9, 0xef, 0x30, 0xd0, 0xc4, 0x51, 0x40, 0xa3, 0x1e, 0x57, 0x00,
+	0xb5, 0x03, 0x93, 0x2d, 0x56, 0x46, 0x25, 0xe2, 0xb0, 0xe5, 0x49, 0x86, 0x36, 0xdb, 0x21, 0xdc,
+	0x81, 0xe9, 0xf1, 0xe4, 0x82, 0x81, 0x2b, 0x53, 0xcd, 0xfd, 0xc2, 0x58, 0x97, 0x5e, 0x3c, 0x16,
+	0x32, 0x4f, 0x5e, 0x63, 0x78, 0x5c, 0x66, 0x73, 0x82, 0x71, 0xa7, 0x0e, 0x1b, 0x97, 0x19, 0x9b,
+	0x9e, 0xe3, 0xb6, 0xf4, 0x7a, 0xe3, 0xb6, 0x34, 0xa2, 0x2d, 0x28, 0xf4, 0x94, 0x31, 0x95, 0xdf,
+	0x56, 0x32, 0x9b, 0x40, 0xf1, 0x50, 0x49, 0xe3, 0xfb, 0x99, 0xeb, 0x2a, 0x6a, 0xac, 0x2f, 0xf7,
+	0x9f, 0x51, 0x48, 0x08, 0x51, 0xa0, 0xb2, 0x27, 0x8e, 0x3d, 0x90, 0x3d, 0xa9, 0x8b, 0xec, 0x71,
+	0xa2, 0x40, 0xf6, 0xbe, 0x02, 0xc8, 0x3f, 0xe7, 0x80, 0x33, 0xda, 0x6e, 0xa6, 0xfc, 0x63, 0x0d,
+	0x38, 0xef, 0xc1, 0x58, 0xcd, 0x30, 0x3b, 0x94, 0x28, 0x76, 0x4c, 0x9b, 0x59, 0x33, 0xcc, 0x56,
+	0x2d, 0xa2, 0xb8, 0xda, 0x6e, 0x07, 0x6e, 0xfc, 0xb8, 0xb8, 0xda, 0x6e, 0x2b, 0xee, 0x0b, 0x30,
+	0x42, 0x4c, 0x6d, 0xa3, 0x4a, 0x54, 0xbe, 0x07, 0xec, 0xa6, 0x4d, 0xe2, 0x61, 0xde, 0x78, 0x97,
+	0xb5, 0x5d, 0x89, 0x7f, 0xf2, 0x71, 0x21, 0xc2, 0xff, 0x5d, 0x8d, 0x27, 0xa3, 0xd9, 0xd8, 0x6a,
+	0x3c, 0x19, 0xcb, 0xc6, 0x8b, 0xfb, 0x83, 0x90, 0x5d, 0x32, 0xf5, 0x32, 0x0b, 0x3c, 0xee, 0x11,
+	0x9b, 0x79, 0xa0, 0xb7, 0x20, 0x66, 0xe8, 0x0e, 0xdb, 0xe6, 0xa1, 0x85, 0x97, 0xdb, 0x67, 0xd6,
+	0x4e, 0xbe, 0x12, 0x78, 0xdc, 0x5d, 0x9c, 0x46, 0x8a, 0x84, 0x54, 0xc8, 0x08, 0x00, 0x75, 0x87,
+	0x33, 0xb1, 0x93, 0x48, 0x2f, 0xe4, 0xba, 0xd8, 0x03, 0x01, 0xab, 0xe4, 0xbc, 0x35, 0x37, 0xf7,
+	0x0b, 0xe9, 0x1b, 0x16, 0xd6, 0xde, 0x2a, 0xad, 0x89, 0x3e, 0x9c, 0x16, 0x2c, 0xde, 0x8c, 0x0d,
+	0x18, 0xf3, 0x06, 0xa8, 0x3f, 0xda, 0xf3, 0x07, 0x89, 0x75, 0x1f, 0x64, 0xfd, 0xda, 0xdb, 0xde,
+	0x20, 0xa7, 0x42, 0x83, 0x8c, 0x8a, 0x41, 0x82, 0x6e, 0x3c, 0x2a, 0xb8, 0xd6, 0x1f, 0xed, 0x79,
+	0x43, 0x2d, 0xc3, 0xa8, 0x2f, 0x4f, 0x6a, 0xbd, 0xaa, 0x99, 0xaa, 0xa1, 0xb3, 0x43, 0x4c, 0x31,
+	0xe7, 0xc6, 0x8e, 0xca, 0x5f, 0xa7, 0xce, 0x8d, 0x2f, 0x4f, 0xeb, 0x55, 0xcd, 0x5c, 0x29, 0xe3,
+	0xcc, 0x66, 0x4b, 0x83, 0x8e, 0x66, 0x60, 0xb0, 0xfe, 0xc8, 0x72, 0x2d, 0x47, 0x1e, 0x98, 0x89,
+	0xcd, 0xa6, 0x3c, 0x9b, 0x97, 0x05, 0x2c, 0xda, 0xd1, 0x2c, 0x64, 0x9d, 0x46, 0xbd, 0x6e, 0xd9,
+	0xae, 0xa3, 0x56, 0xaa, 0x9a, 0xe3, 0xa8, 0x1b, 0xcc, 0x05, 0x4a, 0xe2, 0xb4, 0xd7, 0xbe, 0x48,
+	0x9b, 0x95, 0x2e, 0x94, 0x15, 0x39, 0xd1, 0x85, 0x72, 0x11, 0x11, 0x18, 0xd7, 0xc9, 0xa6, 0xd6,
+	0xa8, 0xba, 0x6a, 0x4d, 0xab, 0xa8, 0x0e, 0x71, 0x5d, 0x1a, 0x4c, 0xc8, 0xc9, 0xee, 0x01, 0xc1,
+	0xcd, 0xd2, 0xe2, 0x6d, 0x41, 0xa2, 0x4c, 0x36, 0xf7, 0x0b, 0xa8, 0xcc, 0x99, 0x43, 0xed, 0x18,
+	0x09, 0xc0, 0x9b, 0x5a, 0xc5, 0x6b, 0xa3, 0x32, 0x49, 0x75, 0x28, 0x50, 0x3c, 0xea, 0x16, 0xc5,
+	0xf1, 0x70, 0xcd, 0x08, 0x5d, 0x0f, 0x94, 0x48, 0xdb, 0x0d, 0x11, 0x81, 0x20, 0xd2, 0x76, 0x5b,
+	0x88, 0xfc, 0xa5, 0xd1, 0x6b, 0x93, 0xf9, 0x2e, 0x49, 0x3c, 0xec, 0x35, 0xae, 0x5a, 0x86, 0x89,
+	0x2e, 0x00, 0xb2, 0x89, 0x43, 0x04, 0x89, 0x6a, 0x5a, 0x66, 0x85, 0x38, 0xcc, 0x27, 0x49, 0xe2,
+	0x2c, 0xef, 0xa1, 0x74, 0x6b, 0xac, 0x1d, 0x11, 0xf0, 0xa6, 0xac, 0x6e, 0x5a, 0x76, 0x4d, 0x73,
+	0xe9, 0xdd, 0x23, 0x8f, 0x74, 0xb7, 0x9d, 0x37, 0x79, 0xac, 0xb7, 0xae, 0xed, 0x55, 0x2d, 0x4d,
+	0x5f, 0xf6, 0xe9, 0x95, 0xe1, 0xb0, 0xa8, 0xe3, 0x51, 0x81, 0x18, 0x10, 0x70, 0x65, 0x2b, 0xfe,
+	0x64, 0x1c, 0x86, 0x42, 0xbb, 0x85, 0xde, 0x84, 0x8c, 0x38, 0x4b, 0x76, 0xf1, 0x58, 0x0d, 0x57,
+	0xe8, 0xd9, 0x74, 0x47, 0x70, 0x52, 0x16, 0xd1, 0xb6, 0x12, 0xff, 0x31, 0x8d, 0x4b, 0x46, 0x18,
+	0x9f, 0x72, 0x87, 0x73, 0xa1, 0xb7, 0x60, 0x22, 0xb0, 0xc6, 0x61, 0xa7, 0x24, 0xca, 0xe0, 0x3a,
+	0x9c, 0x92, 0x75, 0x61, 0x6f, 0xb9, 0xcb, 0xc1, 0x8d, 0xf0, 0x58, 0xbd, 0xa5, 0x91, 0xfb, 0x21,
+	0xef, 0x1c, 0xe6, 0x4a, 0xc4, 0xfa, 0x36, 0xf0, 0x3d, 0x7c, 0x89, 0xb5, 0xee, 0x5e, 0x4e, 0x9c,
+	0xe1, 0x76, 0xf8, 0xba, 0xbe, 0x1c, 0x88, 0x4b, 0xb8, 0xd3, 0xf1, 0x59, 0xe9, 0xe2, 0x9b, 0x4c,
+	0xf7, 0x05, 0xd6, 0xe1, 0xae, 0xf8, 0x47, 0x53, 0xf1, 0x8f, 0x66, 0xe0, 0x38, 0x47, 0xb3, 0xe8,
+	0x1d, 0xcd, 0x57, 0xc3, 0x01, 0xc1, 0x20, 0x83, 0x38, 0xd9, 0x23, 0x20, 0xe0, 0x53, 0x09, 0x62,
+	0x81, 0x3b, 0x3d, 0x62, 0x81, 0x44, 0xf7, 0x43, 0x6d, 0x75, 0xf7, 0xc5, 0x26, 0x75, 0x86, 0x01,
+	0xdf, 0xe8, 0x1e, 0x06, 0x24, 0xfb, 0x3e, 0xcc, 0xce, 0x08, 0x60, 0xb1, 0x3d, 0x02, 0x48, 0xf5,
+	0xb5, 0xe9, 0xad, 0x61, 0xc1, 0x32, 0xe4, 0x36, 0xb5, 0x8a, 0x6b, 0xd9, 0x7b, 0x6a, 0x9d, 0xa9,
+	0xa9, 0x8f, 0x67, 0x10, 0x47, 0x86, 0x99, 0xd8, 0x6c, 0xdc, 0xb7, 0x8b, 0xdf, 0xc2, 0xb2, 0xa0,
+	0x5d, 0x67, 0xa4, 0xcb, 0x01, 0x25, 0x5a, 0xeb, 0x08, 0x2f, 0x86, 0x7a, 0x78, 0x42, 0x9d, 0xe1,
+	0x85, 0x98, 0x57, 0x4b, 0x64, 0xb1, 0x01, 0x13, 0xbe, 0xd1, 0xb9, 0xbc, 0xa0, 0x6e, 0x18, 0x22,
+	0xdd, 0x21, 0x0f, 0x0b, 0x79, 0xe8, 0xe5, 0x26, 0x2a, 0x13, 0xf4, 0x1e, 0xb9, 0x2d, 0x78, 0x2f,
+	0x2f, 0x28, 0x06, 0xcb, 0x89, 0xe0, 0x51, 0xa7, 0xbd, 0x09, 0x7d, 0x0d, 0x12, 0x0d, 0x87, 0xa8,
+	0x9a, 0x6e, 0xcb, 0x23, 0x47, 0xa1, 0x42, 0x73, 0xbf, 0x30, 0x78, 0xd7, 0x21, 0xa5, 0x32, 0xc6,
+	0x83, 0x0d, 0x87, 0x94, 0x74, 0x1b, 0xad, 0x00, 0x8d, 0x78, 0xd5, 0x9a, 0x66, 0x6f, 0x19, 0xa6,
+	0x9c, 0x16, 0xf6, 0xbb, 0x5d, 0x50, 0x97, 0xab, 0x96, 0x26, 0x9c, 0xbd, 0x91, 0xe6, 0x7e, 0x21,
+	0x55, 0x2a, 0xe3, 0x9b, 0x8c, 0x03, 0xa7, 0x34, 0xdd, 0xe6, 0x9f, 0xe8, 0x75, 0x18, 0x16, 0xe6,
+	0x93, 0xaf, 0x32, 0x73, 0x94, 0x33, 0x0c, 0x9c, 0x9c, 0xad, 0xe3, 0x2d, 0x98, 0x72, 0x5c, 0xcd,
+	0x6d, 0x38, 0x9d, 0x51, 0x58, 0xb6, 0x3f, 0xed, 0x99, 0xe0, 0xfc, 0xed, 0x81, 0xd7, 0x3d, 0x90,
+	0x05, 0x70, 0x67, 0xe0, 0x35, 0x2a, 0x94, 0xaa, 0x1d, 0xf9, 0xee, 0x8a, 0xe9, 0x5e, 0x5e, 0xe0,
+	0x93, 0x9c, 0xe4, 0xdc, 0x1d, 0x71, 0xd6, 0x35, 0x18, 0xd5, 0x89, 0x63, 0xd8, 0x44, 0x57, 0x03,
+	0x2d, 0x45, 0x7d, 0x68, 0x69, 0x46, 0xb0, 0x61, 0x4f, 0x59, 0x2b, 0x70, 0xb2, 0x05, 0xa9, 0x5d,
+	0x69, 0xc7, 0xfa, 0x57, 0x5a, 0x39, 0x84, 0xdd, 0xaa, 0xbb, 0xdf, 0x82, 0x13, 0xc1, 0x20, 0x9d,
+	0x3a, 0x3c, 0xde, 0xb7, 0x0e, 0x4f, 0xf9, 0x43, 0xb4, 0xa9, 0x32, 0x86, 0x89, 0xf0, 0x08, 0x81,
+	0x4a, 0x4f, 0xf4, 0xa5, 0xd2, 0x63, 0x01, 0x6e, 0xa0, 0xd9, 0x0f, 0x61, 0xd2, 0xc3, 0x6c, 0xd3,
+	0xcc, 0xc9, 0x63, 0x6a, 0xa6, 0x07, 0x7f, 0x33, 0xac, 0xa0, 0x7f, 0x2d, 0x41, 0xde, 0xc3, 0xef,
+	0x11, 0x83, 0x4d, 0x1d, 0x33, 0x06, 0xcb, 0x37, 0xf7, 0x0b, 0xb9, 0x32, 0xc7, 0xec, 0x42, 0x84,
+	0x73, 0x62, 0xbc, 0x52, 0x97, 0x88, 0xac, 0xdb, 0x74, 0xda, 0x42, 0x33, 0xf9, 0x98, 0xa1, 0x59,
+	0xe7, 0x74, 0x5a, 0x88, 0xda, 0xa6, 0xd3, 0xd2, 0x87, 0xb6, 0xe1, 0xb4, 0x37, 0x9b, 0xde, 0x37,
+	0xf9, 0x89, 0xbe, 0x05, 0xc7, 0x13, 0xf2, 0xf5, 0xae, 0x17, 0xfa, 0x43, 0x38, 0xd1, 0x39, 0x58,
+	0x20, 0x43, 0x27, 0xfb, 0x92, 0x21, 0xb9, 0x6d, 0x88, 0x40, 0x90, 0xbe, 0x09, 0x5e, 0x9f, 0xda,
+	0x71, 0xcf, 0x9f, 0xea, 0x0b, 0xdb, 0x13, 0x44, 0xa5, 0xed, 0xba, 0x5f, 0x81, 0x6c, 0x58, 0x44,
+	0x59, 0x0a, 0x35, 0xcf, 0x10, 0x0b, 0x1d, 0x9b, 0xc2, 0xe2, 0x1d, 0x9a, 0x51, 0xe5, 0x90, 0xe9,
+	0x40, 0x26, 0x59, 0x76, 0xf5, 0x35, 0x88, 0x51, 0x3b, 0x5e, 0xe8, 0xee, 0x44, 0x97, 0xca, 0xd8,
+	0x77, 0xa2, 0x13, 0xcd, 0xfd, 0x42, 0x8c, 0x9a, 0x71, 0xca, 0x50, 0x7c, 0x32, 0x08, 0x43, 0xa1,
+	0x5e, 0xf4, 0x26, 0x24, 0xf4, 0x3d, 0x53, 0xab, 0x19, 0x95, 0x5e, 0xc1, 0x57, 0x88, 0x7a, 0xbe,
+	0xcc, 0x49, 0x6f, 0xbb, 0xf4, 0x54, 0xb7, 0xf6, 0xae, 0x45, 0xb0, 0xc7, 0x8d, 0xd6, 0x00, 0xaa,
+	0x16, 0x75, 0x32, 0x35, 0x87, 0xe8, 0xc2, 0x23, 0x9c, 0x3b, 0x0c, 0xeb, 0x86, 0xe5, 0x38, 0x0a,
+	0x25, 0x0e, 0xa1, 0xa5, 0xaa, 0x5e, 0x23, 0x2a, 0xc3, 0x60, 0xcd, 0xda, 0x30, 0xaa, 0x44, 0x38,
+	0x80, 0xe7, 0x0f, 0xc3, 0xba, 0xc9, 0x28, 0x43, 0x40, 0x82, 0x97, 0xa2, 0x50, 0x9b, 0x6c, 0x54,
+	0xe4, 0xf8, 0xd1, 0x28, 0xb7, 0x19, 0x65, 0x18, 0x85, 0xf3, 0xe6, 0x96, 0x21, 0xd3, 0xb6, 0x72,
+	0x74, 0x19, 0x06, 0xc5, 0x3d, 0x28, 0x1d, 0x79, 0x0f, 0x62, 0x41, 0x9a, 0xfb, 0x81, 0x04, 0xa3,
+	0x1d, 0xcb, 0xfe, 0x5c, 0x50, 0xe8, 0x2a, 0x8f, 0x64, 0xd8, 0x96, 0x53, 0x1c, 0x39, 0x7a, 0x34,
+	0xef, 0x50, 0x4d, 0xdb, 0xa5, 0xc3, 0x53, 0x4d, 0xca, 0x2d, 0x41, 0xba, 0x75, 0xd7, 0x3e, 0xdf,
+	0x92, 0xfe, 0x51, 0x82, 0x74, 0xeb, 0xbe, 0xa1, 0x5b, 0x90, 0x69, 0xd7, 0x7c, 0xe9, 0x78, 0x19,
+	0x87, 0x11, 0xbd, 0x45, 0xdf, 0xe7, 0x20, 0xdd, 0x96, 0xd9, 0x8f, 0xb2, 0x2c, 0x79, 0x42, 0xa4,
+	0xe5, 0xf1, 0xb0, 0x1b, 0xce, 0xe1, 0x9f, 0x81, 0xa4, 0x9f, 0xbf, 0x8f, 0x31, 0x42, 0x9a, 0x7d,
+	0x39, 0x1f, 0x97, 0x33, 0xb3, 0x12, 0x4e, 0x98, 0x3c, 0x61, 0xaf, 0x00, 0x24, 0x1d, 0x31, 0xe3,
+	0xe2, 0xef, 0xa3, 0x4c, 0x29, 0xca, 0xa4, 0x62, 0xb0, 0x80, 0x3b, 0x17, 0xf4, 0xb1, 0xa9, 0xa7,
+	0xb0, 0xff, 0x1b, 0x9d, 0x86, 0x61, 0x91, 0x1d, 0x62, 0x3e, 0x02, 0x9f, 0x0a, 0x1e, 0xe2, 0x6d,
+	0xec, 0xe6, 0x47, 0xd3, 0x10, 0x73, 0x4c, 0x9b, 0x8d, 0x1d, 0xe5, 0xea, 0x77, 0x7b, 0x0d, 0x63,
+	0xda, 0x86, 0xe6, 0x68, 0x20, 0x58, 0xb3, 0xf4, 0x46, 0x95, 0x17, 0x76, 0x36, 0xab, 0x96, 0x65,
+	0x33, 0xd9, 0x8c, 0xe2, 0xd1, 0x70, 0xcf, 0x32, 0xed, 0x40, 0x93, 0xfe, 0x91, 0x0c, 0x30, 0x12,
+	0xef, 0xf4, 0xcf, 0x41, 0xd6, 0x26, 0x35, 0xcd, 0x30, 0xa9, 0xf9, 0x13, 0x14, 0x83, 0x8c, 0x22,
+	0xe3, 0xb7, 0x0b, 0x4f, 0xeb, 0x04, 0xa4, 0x02, 0x21, 0x49, 0x30, 0x9a, 0x64, 0x55, 0x08, 0x01,
+	0x5a, 0x82, 0x4c, 0x37, 0x0f, 0xfd, 0xa8, 0xa3, 0x6a, 0x3f, 0xa0, 0x33, 0x1d, 0x07, 0x94, 0x62,
+	0xbb, 0xd2, 0x7a, 0x2e, 0xd3, 0xa1, 0x73, 0x01, 0xd6, 0xef, 0x1d, 0x46, 0xf1, 0x7f, 0xa6, 0x20,
+	0x49, 0x43, 0x56, 0x97, 0x4e, 0xea, 0x3e, 0xa0, 0x4a, 0xc3, 0xb6, 0x09, 0x75, 0xc0, 0xfc, 0x44,
+	0xad, 0x90, 0xc9, 0x53, 0x87, 0x66, 0x73, 0xdb, 0x23, 0x64, 0x01, 0x13, 0x10, 0x50, 0x6c, 0xff,
+	0xea, 0x08, 0xb0, 0xa3, 0x9f, 0x03, 0xdb, 0xbb, 0x3e, 0x02, 0x6c, 0x05, 0x86, 0x79, 0x35, 0x9d,
+	0x27, 0x44, 0x44, 0x2a, 0x68, 0xa2, 0x1d, 0x95, 0x27, 0x50, 0x02, 0x61, 0x1f, 0xe2, 0x4c, 0xac,
+	0xb9, 0x5b, 0xda, 0x2a, 0xfe, 0x5c, 0xd3, 0x56, 0x0f, 0x21, 0xe7, 0x17, 0x35, 0x0d, 0xbb, 0x46,
+	0x74, 0xbf, 0xb6, 0x48, 0x8b, 0x96, 0x03, 0x47, 0x16, 0x2d, 0xe3, 0xac, 0x60, 0x39, 0xe5, 0x15,
+	0x3f, 0x19, 0x84, 0x57, 0x56, 0x2c, 0xd1, 0x7a, 0x96, 0xcc, 0xe0, 0x69, 0x39, 0x59, 0xb8, 0xd2,
+	0x7e, 0xd5, 0x96, 0x17, 0x59, 0xc7, 0x68, 0x7f, 0x99, 0xec, 0xdc, 0x66, 0xbd, 0xa2, 0x7c, 0xdb,
+	0x33, 0xb3, 0x90, 0xf8, 0x82, 0x99, 0x05, 0x02, 0x27, 0xeb, 0xc4, 0xd4, 0x29, 0x76, 0xb7, 0x7a,
+	0xaa, 0x9c, 0xec, 0x8e, 0xdf, 0xb5, 0x9c, 0x2a, 0x80, 0xba, 0xf4, 0xa1, 0x25, 0xc8, 0x8a, 0xaa,
+	0xad, 0x4d, 0x9c, 0xba, 0x65, 0x3a, 0xc4, 0xab, 0xd4, 0x76, 0x3b, 0xb7, 0x45, 0xab, 0x56, 0xd3,
+	0x4c, 0x1d, 0x67, 0x38, 0x0f, 0xf6, 0x58, 0x28, 0x8c, 0x37, 0x5b, 0xe6, 0x33, 0x38, 0x2e, 0x0f,
+	0x49, 0x8f, 0x80, 0x11, 0x3c, 0x58, 0xb0, 0xa0, 0x6f, 0x00, 0x12, 0xb3, 0x61, 0xb9, 0x29, 0xad,
+	0x52, 0x21, 0x75, 0x57, 0x1e, 0xea, 0xbe, 0x54, 0x4f, 0xed, 0xe6, 0x69, 0xba, 0xaa, 0xc4, 0x48,
+	0xb1, 0x58, 0x4c, 0xd0, 0x82, 0xee, 0xc1, 0xb8, 0x37, 0x33, 0x86, 0x29, 0xa6, 0x27, 0xa2, 0xd3,
+	0x33, 0x87, 0x82, 0x8a, 0x79, 0x61, 0x24, 0x10, 0x42, 0x6d, 0xe8, 0x15, 0x9a, 0x7c, 0x50, 0x1f,
+	0x1b, 0xa6, 0x6e, 0x3d, 0x76, 0x54, 0x6d, 0x47, 0x33, 0xaa, 0x34, 0x85, 0xcc, 0xe2, 0xd3, 0x24,
+	0x46, 0xf6, 0xee, 0x5b, 0xbc, 0xab, 0xe4, 0xf5, 0xa0, 0x32, 0xa4, 0x6d, 0x52, 0x21, 0x4c, 0xa4,
+	0x78, 0x49, 0x3c, 0x3d, 0x13, 0xeb, 0xa6, 0xbd, 0x3c, 0x0d, 0x2d, 0x92, 0x69, 0x78, 0x84, 0x33,
+	0xf1, 0x46, 0x07, 0xad, 0x42, 0x96, 0x37, 0x84, 0x4a, 0xeb, 0x99, 0x99, 0x58, 0x57, 0x4f, 0x4c,
+	0x10, 0x78, 0x48, 0x19, 0xce, 0xe8, 0x35, 0x3b, 0xa8, 0x0a, 0x45, 0x26, 0xf3, 0xe2, 0x75, 0x84,
+	0x6a, 0x98, 0x86, 0x6b, 0x68, 0x6e, 0x9b, 0x6a, 0x65, 0xfb, 0x54, 0xad, 0x3c, 0x7b, 0xae, 0xc0,
+	0xa1, 0x56, 0x3c, 0xa4, 0x90, 0x86, 0xfd, 0x48, 0x82, 0xbc, 0x4d, 0xde, 0x23, 0x15, 0x57, 0x78,
+	0xfe, 0x6d, 0x06, 0x9c, 0x38, 0xf2, 0xe8, 0x4c, 0xec, 0xe8, 0xdb, 0x76, 0xee, 0x40, 0x19, 0x7e,
+	0x22, 0xa5, 0xb2, 0x99, 0xa2, 0x6f, 0x3b, 0x72, 0x58, 0xe0, 0xb6, 0x57, 0xdc, 0x89, 0x83, 0x73,
+	0xde, 0x98, 0xa5, 0xb6, 0xda, 0x3b, 0x71, 0x50, 0x0d, 0x4e, 0xb5, 0xcc, 0xa8, 0xf5, 0x2e, 0x20,
+	0x8e, 0x8c, 0x66, 0x62, 0xb3, 0x23, 0xca, 0xcb, 0x07, 0xca, 0xd0, 0x13, 0x29, 0x99, 0xcd, 0x14,
+	0xbd, 0x62, 0xfa, 0x74, 0x68, 0xc0, 0x70, 0x19, 0x9e, 0x38, 0x78, 0x3a, 0x34, 0x5e, 0x6b, 0x17,
+	0x2a, 0xc1, 0xb8, 0x3f, 0x5c, 0x38, 0x79, 0x33, 0xc6, 0x92, 0x37, 0x69, 0x3e, 0x4a, 0xd1, 0x2b,
+	0xb7, 0x8c, 0x79, 0xb4, 0xe1, 0xec, 0xcd, 0x2a, 0x64, 0xb9, 0x99, 0x0a, 0x1d, 0xd0, 0x78, 0x9f,
+	0x07, 0x94, 0x66, 0x06, 0x2c, 0x38, 0x10, 0x0b, 0xfc, 0xb9, 0x86, 0xce, 0xc2, 0xd6, 0xcc, 0x2d,
+	0xe2, 0xc8, 0x13, 0x4c, 0xa6, 0xbe, 0xd4, 0x53, 0x3f, 0xbc, 0x0d, 0xf0, 0xb6, 0x14, 0x33, 0xb6,
+	0x25, 0xd3, 0xb5, 0xf7, 0x58, 0xd5, 0xb6, 0x4b, 0x27, 0x5a, 0x13, 0x36, 0x96, 0x6e, 0x35, 0xad,
+	0x55, 0x6e, 0x91, 0xc0, 0xc6, 0x4e, 0x32, 0x7f, 0x87, 0x15, 0xd9, 0x6e, 0xd0, 0xc7, 0x2c, 0x65,
+	0xbc, 0xc8, 0x28, 0xb8, 0x9d, 0xe5, 0xc6, 0xb7, 0xa4, 0xdb, 0xe1, 0xc6, 0xdc, 0x0f, 0xa2, 0x30,
+	0x14, 0xd6, 0xc9, 0xbb, 0xe0, 0x97, 0xa8, 0x82, 0x6c, 0xfd, 0xa0, 0xd8, 0x9d, 0x76, 0x99, 0xba,
+	0xe1, 0xc7, 0x19, 0xad, 0xf7, 0x63, 0xd6, 0x83, 0xf0, 0xfa, 0xd1, 0x9f, 0x41, 0xd2, 0xde, 0x15,
+	0xb9, 0x8f, 0x44, 0xbf, 0x4f, 0x16, 0x12, 0x36, 0x6f, 0x42, 0xaf, 0x43, 0xa2, 0xb2, 0xa9, 0x56,
+	0x0d, 0xc7, 0x7b, 0x59, 0x33, 0xd9, 0x71, 0xaf, 0x2e, 0xdf, 0x30, 0x1c, 0x97, 0x27, 0xae, 0xf8,
+	0x37, 0x1e, 0xac, 0x6c, 0xd2, 0xff, 0xf9, 0x6b, 0x97, 0x70, 0x11, 0x6a, 0x35, 0x9e, 0x8c, 0x67,
+	0x07, 0x56, 0xe3, 0xc9, 0x81, 0xec, 0xe0, 0x6a, 0x3c, 0x99, 0xca, 0xc2, 0x6a, 0x3c, 0x09, 0xd9,
+	0xa1, 0xdc, 0x3f, 0xc5, 0x00, 0x42, 0x66, 0xef, 0x05, 0x48, 0xd4, 0x79, 0x1a, 0x9e, 0xf9, 0x1f,
+	0xc3, 0xcc, 0x93, 0xfc, 0x4e, 0x3c, 0x3b, 0x2a, 0x9f, 0xc6, 0x5e, 0x0f, 0x2a, 0x43, 0xc2, 0x33,
+	0x87, 0xd1, 0xfe, 0xcd, 0xa1, 0x12, 0x67, 0xfb, 0xe4, 0xb1, 0xa2, 0x37, 0xfa, 0x7f, 0x27, 0xd5,
+	0xba, 0xd3, 0x8c, 0x8d, 0x65, 0x92, 0x2d, 0xdb, 0x26, 0xc2, 0xaf, 0xa4, 0xc5, 0xb4, 0x38, 0x2b,
+	0xf2, 0xe4, 0x0f, 0x94, 0xd4, 0x13, 0x69, 0xb0, 0x48, 0xcb, 0x44, 0x3a, 0x75, 0x10, 0x16, 0x03,
+	0xb2, 0x95, 0xb2, 0x83, 0xd3, 0x21, 0xb6, 0x15, 0xdd, 0x69, 0x79, 0x0b, 0x36, 0xf0, 0x9c, 0xdf,
+	0x82, 0x3d, 0x84, 0x41, 0x93, 0xb8, 0xb4, 0x7a, 0x35, 0xc8, 0x90, 0x97, 0x05, 0xf2, 0xab, 0xc7,
+	0x45, 0x5e, 0x23, 0xee, 0x4a, 0xb9, 0xb9, 0x5f, 0x18, 0x60, 0x1f, 0x78, 0xc0, 0x24, 0xee, 0x8a,
+	0x9e, 0xfb, 0x85, 0x04, 0x23, 0x2d, 0xba, 0xd2, 0xab, 0xb0, 0x2a, 0xfd, 0x81, 0x0a, 0xab, 0xd1,
+	0x2f, 0x58, 0x58, 0xcd, 0xdd, 0x87, 0x74, 0x9b, 0xb2, 0x5f, 0x83, 0x41, 0x61, 0x4a, 0x24, 0x66,
+	0x4a, 0xce, 0xf6, 0x94, 0xad, 0x16, 0xc6, 0xd0, 0x7b, 0x06, 0xc1, 0x9f, 0xb3, 0xe1, 0xc4, 0x21,
+	0xd6, 0x06, 0x65, 0x21, 0xb6, 0x4d, 0x44, 0xa1, 0x1b, 0xd3, 0x4f, 0xf4, 0x86, 0xf7, 0x20, 0x8c,
+	0x4b, 0xf5, 0x4b, 0xfd, 0x8d, 0xec, 0x88, 0x97, 0x63, 0x57, 0xa2, 0x5f, 0x91, 0x44, 0x41, 0xea,
+	0xdf, 0x24, 0x38, 0xe1, 0x97, 0x70, 0x4b, 0x0d, 0xf7, 0x11, 0x31, 0x5d, 0xe1, 0x41, 0x2d, 0x5a,
+	0x3a, 0x41, 0x73, 0xe1, 0x97, 0x67, 0x29, 0x65, 0xea, 0x40, 0x19, 0xb7, 0xd1, 0x42, 0xf6, 0xdd,
+	0x07, 0xa5, 0xb9, 0xfb, 0xaf, 0xcc, 0x7d, 0xf5, 0xe1, 0xfb, 0x97, 0x2e, 0x5c, 0x5e, 0xf8, 0xf0,
+	0x8c, 0x00, 0x46, 0x57, 0x01, 0xd8, 0x23, 0x53, 0x75, 0xd3, 0xb6, 0x6a, 0x72, 0xb4, 0x4f, 0xb3,
+	0x9d, 0x62, 0x3c, 0xcb, 0xb6, 0x55, 0x43, 0xaf, 0x43, 0x92, 0x03, 0xb8, 0x96, 0x1c, 0xeb, 0x93,
+	0x3d, 0xc1, 0x38, 0xee, 0x58, 0xc5, 0xbf, 0x2b, 0x40, 0xca, 0x5f, 0x0c, 0xba, 0x16, 0xae, 0x5b,
+	0x9f, 0xe9, 0x59, 0xb7, 0xee, 0xa3, 0x60, 0xbd, 0x08, 0x50, 0xb1, 0x89, 0x26, 0x5e, 0x0f, 0x46,
+	0x8f, 0xf3, 0x7a, 0x50, 0xf0, 0x95, 0x5c, 0x0a, 0xd2, 0xa8, 0xeb, 0x1e, 0x48, 0xec, 0x38, 0x20,
+	0x82, 0xaf, 0xe4, 0xa2, 0x13, 0x10, 0x37, 0xb5, 0x1a, 0x11, 0x15, 0xe6, 0x04, 0xaf, 0x30, 0x2f,
+	0x60, 0xd6, 0x88, 0xce, 0xc3, 0x90, 0x4e, 0x9c, 0x8a, 0x6d, 0xd4, 0xe9, 0xf1, 0x31, 0x0b, 0xc1,
+	0x0b, 0xc9, 0x76, 0x4c, 0xfe, 0x2c, 0x83, 0xc3, 0x9d, 0xe8, 0x23, 0x09, 0x40, 0x73, 0x5d, 0xdb,
+	0xd8, 0x68, 0xb8, 0x84, 0x5e, 0x21, 0x54, 0x80, 0xcf, 0xf5, 0xdc, 0xa4, 0xf9, 0x92, 0x4f, 0xcb,
+	0x44, 0x52, 0x79, 0xf5, 0x40, 0x59, 0xf8, 0x89, 0x74, 0x31, 0x0b, 0xc5, 0x33, 0x76, 0x51, 0x3e,
+	0xb3, 0x90, 0x7f, 0xf7, 0x81, 0x36, 0xf7, 0x1d, 0x2a, 0x11, 0xb3, 0x57, 0xaf, 0x3c, 0x98, 0x7b,
+	0x78, 0xd5, 0xfb, 0x79, 0xee, 0xfd, 0x85, 0x0b, 0x1f, 0x9e, 0x39, 0x4f, 0xe7, 0xf0, 0xa9, 0x84,
+	0x43, 0x63, 0xa2, 0x77, 0x60, 0x48, 0xc4, 0x51, 0xcc, 0x24, 0x26, 0x8e, 0xff, 0xbe, 0x20, 0x4d,
+	0x1f, 0xfd, 0x79, 0xed, 0x65, 0x07, 0xc3, 0x8e, 0x47, 0xe3, 0xa0, 0x15, 0x40, 0x0e, 0xb1, 0x29,
+	0xa3, 0x5a, 0xb7, 0xad, 0x4d, 0xa3, 0x4a, 0xa8, 0x6d, 0x4b, 0xb2, 0x3d, 0x39, 0x11, 0x54, 0xe6,
+	0xb3, 0xb7, 0x39, 0xd1, 0x3a, 0xa7, 0x59, 0x29, 0xe3, 0xac, 0xd3, 0xda, 0xa2, 0xa3, 0x7f, 0x91,
+	0x60, 0xd2, 0x73, 0x20, 0x69, 0x27, 0xb1, 0x99, 0x09, 0x26, 0x8e, 0xc3, 0x62, 0xe9, 0x94, 0xf2,
+	0xb7, 0xd2, 0x81, 0xf2, 0x43, 0xc9, 0xfe, 0x9e, 0xb4, 0xf0, 0x97, 0xd2, 0xbb, 0xb3, 0x57, 0xaf,
+	0xd0, 0x2d, 0xd0, 0xe6, 0xbe, 0x23, 0x54, 0xe4, 0x83, 0xd0, 0x77, 0xf0, 0xf9, 0xce, 0xdc, 0xc3,
+	0xf3, 0xa1, 0x8e, 0x73, 0xef, 0xcc, 0x9f, 0x3b, 0x4f, 0xf9, 0x4a, 0x73, 0xf7, 0xc5, 0xce, 0x7d,
+	0x10, 0xfa, 0x0e, 0x3e, 0x19, 0x5f, 0xd0, 0x71, 0x6e, 0xf6, 0xea, 0x95, 0x2b, 0x0f, 0x84, 0x26,
+	0xbe, 0xfa, 0xe1, 0xb9, 0xab, 0x67, 0x3e, 0x78, 0xf7, 0x0c, 0x1e, 0x17, 0xd3, 0xbd, 0xcd, 0x66,
+	0x5b, 0xe2, 0x93, 0x45, 0xf7, 0x41, 0x6e, 0x5b, 0xc6, 0x36, 0xd9, 0x56, 0xab, 0xda, 0x06, 0xa9,
+	0xca, 0x17, 0xd9, 0x42, 0x4e, 0x73, 0x61, 0xf9, 0x28, 0xdb, 0xdc, 0x2f, 0x4c, 0xac, 0x85, 0x31,
+	0xae, 0x2f, 0x5d, 0xbf, 0x41, 0x09, 0xf1, 0x44, 0x0b, 0xf4, 0x75, 0xb2, 0xcd, 0x9a, 0xd1, 0xbf,
+	0x4b, 0x90, 0x0b, 0x47, 0x71, 0x6d, 0xfb, 0x04, 0x7f, 0x9a, 0xfb, 0x24, 0x87, 0xa6, 0xdc, 0xba,
+	0x57, 0x9b, 0x70, 0xb2, 0xcb, 0x72, 0x82, 0xfd, 0x7a, 0x85, 0x2d, 0xe8, 0xc5, 0xd0, 0x7e, 0x4d,
+	0x97, 0xda, 0xb1, 0xfc, 0x3d, 0x9b, 0xee, 0x18, 0xc6, 0xdf, 0x37, 0x0c, 0x13, 0x5d, 0xc6, 0x31,
+	0x74, 0xf9, 0x12, 0x1b, 0x20, 0x7f, 0xa0, 0x78, 0xce, 0xc1, 0x58, 0x07, 0xfe, 0x4a, 0x19, 0x8f,
+	0x75, 0x20, 0xaf, 0xe8, 0xe8, 0x17, 0x12, 0x8c, 0xb1, 0x48, 0xb0, 0xed, 0x10, 0x86, 0xfe, 0x34,
+	0x0f, 0x61, 0x94, 0xce, 0xb5, 0x75, 0xf7, 0x5d, 0x9a, 0x18, 0xe3, 0xab, 0xa2, 0x0f, 0x37, 0x62,
+	0xdd, 0x6a, 0x25, 0x81, 0x6d, 0xba, 0xe1, 0x91, 0x72, 0xd3, 0x74, 0xe1, 0x40, 0x39, 0xf7, 0x13,
+	0xe9, 0x6c, 0x7f, 0x86, 0x09, 0x07, 0x03, 0xa1, 0x4b, 0x90, 0x10, 0x2f, 0xf5, 0xe5, 0x05, 0x66,
+	0x8c, 0xa6, 0x3a, 0x73, 0x1b, 0xac, 0x1b, 0x7b, 0x74, 0x5d, 0x1f, 0xe5, 0x8c, 0xf4, 0xfd, 0x28,
+	0x27, 0xdd, 0xf5, 0x51, 0x4e, 0x97, 0x3c, 0x53, 0xe6, 0x8f, 0xf1, 0x3c, 0x2a, 0xfb, 0xc7, 0x7a,
+	0x1e, 0x35, 0x7a, 0xfc, 0xe7, 0x51, 0x1d, 0x2f, 0x88, 0x50, 0x3f, 0x2f, 0x88, 0xc6, 0xfa, 0x79,
+	0x41, 0x34, 0xde, 0xf7, 0x0b, 0xa2, 0x89, 0x1e, 0x2f, 0x88, 0x5e, 0x85, 0x94, 0x6d, 0x59, 0xae,
+	0xca, 0x62, 0x04, 0x5e, 0xd0, 0x94, 0x3b, 0xc2, 0x27, 0xcb, 0x72, 0x69, 0x80, 0x80, 0x93, 0xb6,
+	0xf8, 0x42, 0x6f, 0xfb, 0x1e, 0xf7, 0x14, 0xf3, 0xb8, 0x95, 0xe7, 0xe6, 0x6d, 0xa3, 0x5b, 0x30,
+	0xdc, 0xf2, 0x9e, 0x4b, 0x3e, 0xfa, 0x3d, 0x17, 0x8d, 0xf9, 0xc3, 0x4f, 0x93, 0x68, 0x45, 0x22,
+	0x78, 0xc1, 0xb5, 0x08, 0x29, 0x06, 0xe8, 0x6a, 0x2e, 0x91, 0xa7, 0xbb, 0x2f, 0xd1, 0xf3, 0x39,
+	0x95, 0xe1, 0xe6, 0x7e, 0xc1, 0x4f, 0x19, 0xe3, 0x24, 0xc5, 0xa1, 0x5f, 0xe8, 0x6d, 0x18, 0xf5,
+	0x12, 0x55, 0x01, 0xd8, 0x85, 0x23, 0xc0, 0xc6, 0xa8, 0x7c, 0xac, 0x73, 0x36, 0x1f, 0xd3, 0x4b,
+	0xab, 0xdd, 0xf4, 0xa0, 0x2f, 0x41, 0xc2, 0xe1, 0x51, 0x98, 0x9c, 0xeb, 0xae, 0xba, 0x22, 0x48,
+	0xc3, 0x1e, 0x1d, 0xfa, 0x3a, 0x78, 0x28, 0xaa, 0xc7, 0x7a, 0xe2, 0x70, 0xd6, 0xb4, 0xa0, 0x17,
+	0xbf, 0x69, 0x6a, 0xdd, 0x4f, 0xa8, 0x32, 0x11, 0x61, 0xe5, 0xcd, 0x11, 0x3c, 0x2c, 0xd2, 0xa8,
+	0x4c, 0x3c, 0xd0, 0x59, 0xc8, 0x34, 0x1c, 0xa2, 0x07, 0x54, 0x8e, 0x7c, 0x8a, 0xe6, 0x5c, 0xf0,
+	0x08, 0x6d, 0xf6, 0xc8, 0xe8, 0xdf, 0x6e, 0x64, 0x18, 0x5a, 0x20, 0x71, 0x72, 0x3e, 0xf8, 0xd3,
+	0x17, 0x5f, 0xdc, 0xd0, 0x97, 0x05, 0x9d, 0xfd, 0x9e, 0x78, 0x09, 0xf1, 0x0a, 0xab, 0x34, 0x8e,
+	0x28, 0xf4, 0x12, 0x1a, 0xa6, 0x99, 0x05, 0xbc, 0xca, 0x6a, 0x1d, 0xaf, 0xf0, 0x89, 0xe0, 0xf7,
+	0xf8, 0xaf, 0x4e, 0xc6, 0x4b, 0xf2, 0x4c, 0x57, 0xc6, 0x4b, 0x2d, 0x8c, 0x97, 0xd0, 0xbb, 0x70,
+	0xa2, 0x3d, 0x71, 0x4c, 0xf3, 0x6c, 0xc6, 0x0e, 0x77, 0x65, 0x4f, 0x1f, 0x27, 0x31, 0xed, 0x67,
+	0x97, 0xb1, 0x40, 0x28, 0xb9, 0x68, 0x09, 0x86, 0x78, 0x4e, 0x8a, 0x4b, 0x44, 0xb1, 0x87, 0x1d,
+	0xa2, 0x24, 0x5c, 0x26, 0x82, 0x30, 0x0d, 0xea, 0x7e, 0x2b, 0x7a, 0x00, 0x68, 0x83, 0x3d, 0xb6,
+	0xdb, 0xa3, 0x69, 0xea, 0x0a, 0x31, 0x5d, 0x6d, 0x8b, 0xc8, 0x2f, 0x1c, 0xfd, 0x14, 0x26, 0x73,
+	0xa0, 0x0c, 0x03, 0x9c, 0x8a, 0x44, 0x3e, 0xba, 0x3a, 0x17, 0x89, 0x44, 0x22, 0x78, 0x54, 0xe0,
+	0xac, 0xfb, 0x30, 0xe8, 0x25, 0xc8, 0xf8, 0x89, 0x17, 0x51, 0xd4, 0x39, 0x33, 0x23, 0xcd, 0x0e,
+	0xe0, 0xb4, 0xd7, 0x2c, 0x6a, 0x3a, 0x47, 0xfd, 0x91, 0xd0, 0xec, 0x73, 0xf9, 0x23, 0x21, 0x74,
+	0x0d, 0x20, 0xf4, 0x5a, 0xf1, 0xdc, 0xf1, 0x5e, 0x2b, 0xe2, 0x10, 0x2f, 0xda, 0x80, 0x74, 0xdd,
+	0xb6, 0x76, 0x58, 0x75, 0x8d, 0xbb, 0x1e, 0xe7, 0x99, 0x7d, 0x7e, 0xfd, 0x40, 0x79, 0xc9, 0x7e,
+	0x51, 0x3e, 0xb3, 0x70, 0xfa, 0xf0, 0x1b, 0xf4, 0x83, 0x77, 0xcf, 0x34, 0xf7, 0x0b, 0x23, 0xeb,
+	0x01, 0xc6, 0x4a, 0x19, 0x8f, 0x84, 0x20, 0x57, 0x68, 0x1a, 0x66, 0xd4, 0x6f, 0xa0, 0x0a, 0xa7,
+	0x6b, 0xae, 0x26, 0xbf, 0x2c, 0xb4, 0xad, 0xfd, 0x64, 0x6e, 0xb3, 0x3f, 0x0a, 0xc4, 0xd9, 0x30,
+	0x07, 0x0d, 0x63, 0xd1, 0x49, 0x48, 0xd5, 0x1a, 0x55, 0x1a, 0x9e, 0x3a, 0xae, 0x3c, 0xc7, 0x8c,
+	0x71, 0xd0, 0x80, 0xb6, 0x60, 0xba, 0x52, 0xd5, 0x8c, 0x9a, 0xaa, 0xb5, 0x44, 0xb1, 0x6a, 0xc5,
+	0xd2, 0x89, 0x3c, 0x7f, 0x44, 0x70, 0xd1, 0x19, 0xf9, 0xe2, 0x29, 0x86, 0xd6, 0xd9, 0x81, 0xe6,
+	0x61, 0xcc, 0xd9, 0x36, 0xea, 0xaa, 0xc8, 0x31, 0xa9, 0x15, 0x7b, 0xaf, 0xee, 0x5a, 0xf2, 0x65,
+	0x36, 0xa1, 0x51, 0xda, 0x25, 0x36, 0x7c, 0x91, 0x75, 0xa0, 0x07, 0x70, 0xb2, 0x0b, 0xbd, 0x6a,
+	0xed, 0x10, 0xdb, 0x36, 0x74, 0x22, 0x7f, 0xa9, 0x87, 0xfe, 0x04, 0x0f, 0xac, 0xa6, 0x3b, 0x40,
+	0x6f, 0x09, 0xe6, 0xdc, 0x1b, 0x90, 0x69, 0x0b, 0xcd, 0xc2, 0xd9, 0x82, 0x14, 0xcf, 0x16, 0x8c,
+	0x87, 0xb3, 0x05, 0xa9, 0x50, 0x12, 0x20, 0x77, 0x0f, 0xd2, 0xad, 0xde, 0x53, 0x17, 0xee, 0xf9,
+	0xd6, 0x5c, 0x43, 0x87, 0xa9, 0xf6, 0x00, 0x42, 0xb8, 0xab, 0xf1, 0xe4, 0x8b, 0xd9, 0xb3, 0xab,
+	0xf1, 0xe4, 0xd9, 0xec, 0x4b, 0xab, 0xf1, 0xe4, 0x4b, 0xd9, 0xd9, 0xe2, 0x35, 0x00, 0x7f, 0xb7,
+	0x1d, 0x74, 0x05, 0x86, 0x82, 0xbf, 0x70, 0xf5, 0xf2, 0x27, 0xd3, 0x3d, 0x8f, 0x07, 0x03, 0xf1,
+	0x79, 0x8b, 0x7f, 0x0e, 0x23, 0x22, 0x7b, 0xb5, 0x6e, 0x93, 0x4d, 0x83, 0x66, 0x7c, 0x82, 0xb4,
+	0x18, 0x4f, 0x05, 0xbe, 0xfe, 0x5c, 0x52, 0x62, 0x93, 0x30, 0x58, 0x25, 0xe6, 0x96, 0xfb, 0x48,
+	0x14, 0x92, 0xc5, 0xaf, 0xa2, 0x0e, 0x93, 0x8b, 0x2c, 0xac, 0x0f, 0xe6, 0x27, 0x12, 0x85, 0xab,
+	0x00, 0xc1, 0xb2, 0xfc, 0x97, 0xbc, 0xbd, 0x56, 0xd5, 0x25, 0xdd, 0x90, 0xf2, 0xd7, 0x59, 0xfc,
+	0xa9, 0x04, 0x93, 0x77, 0x59, 0xe0, 0xff, 0x87, 0x1c, 0x86, 0x66, 0x6c, 0x82, 0xbf, 0xd3, 0xed,
+	0x99, 0xdb, 0x58, 0xa6, 0x24, 0x37, 0x35, 0x67, 0x5b, 0xa4, 0x46, 0x53, 0x9b, 0x5e, 0x43, 0xf1,
+	0x1f, 0x24, 0x18, 0x7b, 0x93, 0xb8, 0x1d, 0x93, 0x7c, 0x07, 0xd2, 0xc1, 0x24, 0xd5, 0x2f, 0x9e,
+	0x89, 0x19, 0x26, 0x01, 0x9d, 0xf3, 0xc5, 0xa7, 0xfd, 0x5f, 0x12, 0xbc, 0x18, 0x9e, 0x76, 0x68,
+	0xf0, 0x65, 0xcb, 0x5e, 0xba, 0xbb, 0xe2, 0x78, 0x0b, 0xf9, 0x26, 0x24, 0xd9, 0x9d, 0x4c, 0x1a,
+	0x86, 0x10, 0xaf, 0x37, 0x3e, 0x6f, 0x6e, 0x74, 0xe9, 0xee, 0xca, 0x6b, 0x5f, 0xc2, 0x09, 0x0a,
+	0xb7, 0xd4, 0x30, 0xd0, 0x3d, 0xa0, 0xb2, 0xc6, 0x80, 0xa3, 0xcf, 0x03, 0x78, 0x50, 0x27, 0x3b,
+	0x4b, 0x0d, 0xa3, 0xf8, 0x24, 0x06, 0x13, 0x34, 0xb9, 0x1e, 0x28, 0x9c, 0xb7, 0x16, 0x0d, 0x32,
+	0xe1, 0x6b, 0x29, 0x38, 0x95, 0xb3, 0x87, 0x5c, 0x48, 0x87, 0x9f, 0x4b, 0x5a, 0x0b, 0x53, 0x7e,
+	0xf1, 0x93, 0x41, 0x1f, 0x4b, 0x30, 0x60, 0xd9, 0x3a, 0xe1, 0xaf, 0x34, 0x52, 0xca, 0x0f, 0xa4,
+	0x03, 0xe5, 0xaf, 0x24, 0xfb, 0xbb, 0x12, 0x8e, 0xe0, 0x94, 0x2f, 0x4e, 0x18, 0xe6, 0x82, 0x6f,
+	0xff, 0x80, 0x70, 0x6a, 0xce, 0xff, 0xf4, 0xf6, 0x16, 0x27, 0xe7, 0xbc, 0x2f, 0x96, 0x27, 0xc3,
+	0x03, 0x73, 0xec, 0xbf, 0x70, 0x3e, 0x0c, 0x0f, 0xcf, 0x85, 0x7f, 0x85, 0xd2, 0x7d, 0x78, 0x68,
+	0x2e, 0xf4, 0x83, 0x4f, 0x0c, 0xe5, 0x61, 0x80, 0xff, 0x25, 0x29, 0xfb, 0x6b, 0x67, 0xe6, 0x8f,
+	0x9c, 0x8f, 0xc9, 0xbf, 0x49, 0x60, 0xde, 0x8c, 0x10, 0xc4, 0xeb, 0xd4, 0xf9, 0xe0, 0x7f, 0xe5,
+	0xcc, 0xbe, 0x8b, 0x7f, 0x2f, 0xc1, 0xd8, 0xed, 0x2e, 0x7a, 0xb2, 0x7c, 0x3c, 0x65, 0x6e, 0x2d,
+	0x30, 0x3c, 0x4f, 0x45, 0xfe, 0x67, 0x89, 0x66, 0xa1, 0x1d, 0xe2, 0x96, 0x4c, 0xfd, 0xff, 0xa1,
+	0x42, 0xff, 0xab, 0x04, 0xa3, 0xfe, 0xc8, 0x77, 0x48, 0xad, 0x5e, 0xa5, 0x4e, 0xe1, 0x9f, 0xca,
+	0xee, 0xa2, 0x59, 0x18, 0xaa, 0x69, 0x75, 0xf6, 0x92, 0x82, 0xde, 0xaf, 0xb1, 0x70, 0x02, 0x57,
+	0xc7, 0x20, 0xfa, 0xae, 0x93, 0xbd, 0xe2, 0x27, 0x12, 0x4c, 0x75, 0x2c, 0x84, 0x3b, 0x6f, 0x7e,
+	0xfe, 0x57, 0x6a, 0x65, 0xef, 0x9a, 0xff, 0x8d, 0x86, 0xf3, 0xbf, 0x9f, 0x4a, 0xad, 0xf9, 0xdf,
+	0x3b, 0x90, 0x61, 0x39, 0x51, 0xb2, 0xeb, 0x12, 0xd3, 0x61, 0x79, 0x96, 0x18, 0xab, 0x49, 0xbd,
+	0x7c, 0xa0, 0xcc, 0x3e, 0x91, 0x5e, 0xcc, 0xea, 0xb2, 0x54, 0x2c, 0xd8, 0xa7, 0x16, 0x4e, 0xd0,
+	0x1c, 0xd1, 0x3b, 0xf3, 0x9e, 0xcf, 0xf7, 0xfe, 0xa5, 0x0b, 0x97, 0x5e, 0xfb, 0xf0, 0xdc, 0xfb,
+	0x97, 0x2e, 0xd0, 0xac, 0x7f, 0x9a, 0x62, 0x2c, 0xf9, 0x10, 0xc5, 0xdf, 0x4b, 0x20, 0xf7, 0x98,
+	0xba, 0x83, 0x3e, 0x84, 0x04, 0x77, 0x3b, 0xbd, 0xfb, 0xfe, 0xd5, 0x9e, 0xe7, 0xd0, 0xc6, 0x3a,
+	0x2f, 0xfe, 0xff, 0x3c, 0xf9, 0x1d, 0x6f, 0xcc, 0x5c, 0x05, 0x86, 0xc3, 0x30, 0x5d, 0x1c, 0x9d,
+	0xa3, 0x8a, 0x2a, 0x3d, 0xa6, 0x17, 0xf2, 0x7b, 0x8a, 0xdf, 0x93, 0xa0, 0xb0, 0x68, 0x99, 0x3b,
+	0xc4, 0x76, 0x3b, 0xa8, 0x3d, 0x3d, 0x5a, 0x87, 0x14, 0x9f, 0x13, 0xf5, 0xb5, 0xf9, 0x41, 0x5e,
+	0x3e, 0x50, 0xfa, 0x5a, 0x0d, 0x0d, 0xac, 0xf9, 0xa0, 0x2b, 0x65, 0x9c, 0xe4, 0x28, 0x2b, 0x3a,
+	0x35, 0x37, 0xcc, 0xa3, 0x66, 0x97, 0x08, 0x66, 0xdf, 0xe7, 0x97, 0x01, 0x82, 0x88, 0x09, 0x8d,
+	0xc2, 0xc8, 0xfa, 0xad, 0xb7, 0x96, 0xb0, 0x7a, 0x77, 0xed, 0xfa, 0xda, 0xad, 0xb7, 0xd6, 0xb2,
+	0x91, 0xa0, 0x49, 0x29, 0xdd, 0xb9, 0xb3, 0x84, 0xdf, 0xce, 0x4a, 0x08, 0x41, 0x9a, 0x37, 0x2d,
+	0x7d, 0xf3, 0xce, 0x12, 0x5e, 0x2b, 0xdd, 0xc8, 0x46, 0x95, 0x9f, 0x4a, 0x9f, 0x3e, 0xcd, 0x4b,
+	0x9f, 0x3d, 0xcd, 0x4b, 0xbf, 0x7a, 0x9a, 0x8f, 0xfc, 0xfa, 0x69, 0x3e, 0xf2, 0x9b, 0xa7, 0xf9,
+	0xc8, 0x6f, 0x9f, 0xe6, 0x23, 0xbf, 0x7b, 0x9a, 0x97, 0x3e, 0x6a, 0xe6, 0xa5, 0xef, 0x37, 0xf3,
+	0x91, 0x9f, 0x35, 0xf3, 0xd2, 0xcf, 0x9b, 0xf9, 0xc8, 0x27, 0xcd, 0x7c, 0xe4, 0x97, 0xcd, 0x7c,
+	0xe4, 0xd3, 0x66, 0x5e, 0xfa, 0xac, 0x99, 0x97, 0x7e, 0xd5, 0xcc, 0x47, 0x7e, 0xdd, 0xcc, 0x4b,
+	0xbf, 0x69, 0xe6, 0x23, 0xbf, 0x6d, 0xe6, 0xa5, 0xdf, 0x35, 0xf3, 0x91, 0x8f, 0x9e, 0xe5, 0x23,
+	0xdf, 0x7f, 0x96, 0x97, 0x7e, 0xf4, 0x2c, 0x1f, 0xf9, 0xf1, 0xb3, 0xbc, 0xf4, 0xf1, 0xb3, 0x7c,
+	0xe4, 0x67, 0xcf, 0xf2, 0x91, 0x9f, 0x3f, 0xcb, 0x4b, 0x9f, 0x3c, 0xcb, 0x4b, 0xbf, 0x7c, 0x96,
+	0x97, 0xee, 0x5f, 0x3c, 0xc6, 0x0d, 0xe8, 0x9a, 0xf5, 0x8d, 0x8d, 0x41, 0xa6, 0x84, 0x97, 0xff,
+	0x6f, 0x00, 0x56, 0x17, 0xa9, 0xd3, 0x4c, 0x45, 0x00, 0x00,
+}
+
+func (x PowerState) String() string {
+	s, ok := PowerState_name[int32(x)]
+	if ok {
+		return s
 	}
-	if len(this.FactoryPresetFrequencies) != len(that1.FactoryPresetFrequencies) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.FactoryPresetFrequencies {
-		if this.FactoryPresetFrequencies[i] != that1.FactoryPresetFrequencies[i] {
+
+	that1, ok := that.(*Session)
+	if !ok {
+		that2, ok := that.(Session)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if !this.MaxDutyCycle.Equal(that1.MaxDutyCycle) {
-		return false
-	}
-	if !this.Supports32BitFCnt.Equal(that1.Supports32BitFCnt) {
-		return false
-	}
-	if !this.UseADR.Equal(that1.UseADR) {
-		return false
-	}
-	if !this.ADRMargin.Equal(that1.ADRMargin) {
-		return false
-	}
-	if !this.ResetsFCnt.Equal(that1.ResetsFCnt) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.StatusTimePeriodicity != nil && that1.StatusTimePeriodicity != nil {
-		if *this.StatusTimePeriodicity != *that1.StatusTimePeriodicity {
-			return false
-		}
-	} else if this.StatusTimePeriodicity != nil {
-		return false
-	} else if that1.StatusTimePeriodicity != nil {
+	if !this.DevAddr.Equal(that1.DevAddr) {
 		return false
 	}
-	if !this.StatusCountPeriodicity.Equal(that1.StatusCountPeriodicity) {
+	if !this.SessionKeys.Equal(&that1.SessionKeys) {
 		return false
 	}
-	if !this.DesiredRx1Delay.Equal(that1.DesiredRx1Delay) {
+	if this.LastFCntUp != that1.LastFCntUp {
 		return false
 	}
-	if !this.DesiredRx1DataRateOffset.Equal(that1.DesiredRx1DataRateOffset) {
+	if this.LastNFCntDown != that1.LastNFCntDown {
 		return false
 	}
-	if !this.DesiredRx2DataRateIndex.Equal(that1.DesiredRx2DataRateIndex) {
+	if this.LastAFCntDown != that1.LastAFCntDown {
 		return false
 	}
-	if !this.DesiredRx2Frequency.Equal(that1.DesiredRx2Frequency) {
+	if this.LastConfFCntDown != that1.LastConfFCntDown {
 		return false
 	}
-	if !this.DesiredMaxDutyCycle.Equal(that1.DesiredMaxDutyCycle) {
+	if !this.StartedAt.Equal(that1.StartedAt) {
 		return false
 	}
-	if !this.DesiredADRAckLimitExponent.Equal(that1.DesiredADRAckLimitExponent) {
+	if len(this.QueuedApplicationDownlinks) != len(that1.QueuedApplicationDownlinks) {
 		return false
 	}
-	if !this.DesiredADRAckDelayExponent.Equal(that1.DesiredADRAckDelayExponent) {
-		return false
+	for i := range this.QueuedApplicationDownlinks {
+		if !this.QueuedApplicationDownlinks[i].Equal(that1.QueuedApplicationDownlinks[i]) {
+			return false
+		}
 	}
-	if !this.DesiredPingSlotDataRateIndex.Equal(that1.DesiredPingSlotDataRateIndex) {
-		return false
+	return true
+}
+func (this *BoolValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if !this.DesiredPingSlotFrequency.Equal(that1.DesiredPingSlotFrequency) {
-		return false
+
+	that1, ok := that.(*BoolValue)
+	if !ok {
+		that2, ok := that.(BoolValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.DesiredMaxEirp.Equal(that1.DesiredMaxEirp) {
+	if this.Value != that1.Value {
 		return false
 	}
 	return true
 }
-func (this *MACState) Equal(that interface{}) bool {
+func (this *MACParameters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACState)
+	that1, ok := that.(*MACParameters)
 	if !ok {
-		that2, ok := that.(MACState)
+		that2, ok := that.(MACParameters)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3554,134 +3736,87 @@ func (this *MACState) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CurrentParameters.Equal(&that1.CurrentParameters) {
+	if this.MaxEIRP != that1.MaxEIRP {
 		return false
 	}
-	if !this.DesiredParameters.Equal(&that1.DesiredParameters) {
+	if this.ADRDataRateIndex != that1.ADRDataRateIndex {
 		return false
 	}
-	if this.DeviceClass != that1.DeviceClass {
+	if this.ADRTxPowerIndex != that1.ADRTxPowerIndex {
 		return false
 	}
-	if this.LoRaWANVersion != that1.LoRaWANVersion {
+	if this.ADRNbTrans != that1.ADRNbTrans {
 		return false
 	}
-	if that1.LastConfirmedDownlinkAt == nil {
-		if this.LastConfirmedDownlinkAt != nil {
-			return false
-		}
-	} else if !this.LastConfirmedDownlinkAt.Equal(*that1.LastConfirmedDownlinkAt) {
+	if this.ADRAckLimit != that1.ADRAckLimit {
 		return false
 	}
-	if this.LastDevStatusFCntUp != that1.LastDevStatusFCntUp {
+	if this.ADRAckDelay != that1.ADRAckDelay {
 		return false
 	}
-	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
+	if this.Rx1Delay != that1.Rx1Delay {
 		return false
 	}
-	if !this.PendingApplicationDownlink.Equal(that1.PendingApplicationDownlink) {
+	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
 		return false
 	}
-	if len(this.QueuedResponses) != len(that1.QueuedResponses) {
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
 		return false
 	}
-	for i := range this.QueuedResponses {
-		if !this.QueuedResponses[i].Equal(that1.QueuedResponses[i]) {
-			return false
-		}
-	}
-	if len(this.PendingRequests) != len(that1.PendingRequests) {
+	if this.Rx2Frequency != that1.Rx2Frequency {
 		return false
 	}
-	for i := range this.PendingRequests {
-		if !this.PendingRequests[i].Equal(that1.PendingRequests[i]) {
-			return false
-		}
+	if this.MaxDutyCycle != that1.MaxDutyCycle {
+		return false
 	}
-	if !this.QueuedJoinAccept.Equal(that1.QueuedJoinAccept) {
+	if this.RejoinTimePeriodicity != that1.RejoinTimePeriodicity {
 		return false
 	}
-	if !this.PendingJoinRequest.Equal(that1.PendingJoinRequest) {
-		return false
-	}
-	if this.RxWindowsAvailable != that1.RxWindowsAvailable {
+	if this.RejoinCountPeriodicity != that1.RejoinCountPeriodicity {
 		return false
 	}
-	if len(this.RecentUplinks) != len(that1.RecentUplinks) {
+	if this.PingSlotFrequency != that1.PingSlotFrequency {
 		return false
 	}
-	for i := range this.RecentUplinks {
-		if !this.RecentUplinks[i].Equal(that1.RecentUplinks[i]) {
-			return false
-		}
-	}
-	if len(this.RecentDownlinks) != len(that1.RecentDownlinks) {
+	if this.PingSlotDataRateIndex != that1.PingSlotDataRateIndex {
 		return false
 	}
-	for i := range this.RecentDownlinks {
-		if !this.RecentDownlinks[i].Equal(that1.RecentDownlinks[i]) {
-			return false
-		}
-	}
-	if that1.LastNetworkInitiatedDownlinkAt == nil {
-		if this.LastNetworkInitiatedDownlinkAt != nil {
-			return false
-		}
-	} else if !this.LastNetworkInitiatedDownlinkAt.Equal(*that1.LastNetworkInitiatedDownlinkAt) {
+	if this.BeaconFrequency != that1.BeaconFrequency {
 		return false
 	}
-	if len(this.RejectedADRDataRateIndexes) != len(that1.RejectedADRDataRateIndexes) {
+	if len(this.Channels) != len(that1.Channels) {
 		return false
 	}
-	for i := range this.RejectedADRDataRateIndexes {
-		if this.RejectedADRDataRateIndexes[i] != that1.RejectedADRDataRateIndexes[i] {
+	for i := range this.Channels {
+		if !this.Channels[i].Equal(that1.Channels[i]) {
 			return false
 		}
 	}
-	if len(this.RejectedADRTxPowerIndexes) != len(that1.RejectedADRTxPowerIndexes) {
+	if !this.UplinkDwellTime.Equal(that1.UplinkDwellTime) {
 		return false
 	}
-	for i := range this.RejectedADRTxPowerIndexes {
-		if this.RejectedADRTxPowerIndexes[i] != that1.RejectedADRTxPowerIndexes[i] {
-			return false
-		}
-	}
-	if len(this.RejectedFrequencies) != len(that1.RejectedFrequencies) {
+	if !this.DownlinkDwellTime.Equal(that1.DownlinkDwellTime) {
 		return false
 	}
-	for i := range this.RejectedFrequencies {
-		if this.RejectedFrequencies[i] != that1.RejectedFrequencies[i] {
-			return false
-		}
-	}
-	if that1.LastDownlinkAt == nil {
-		if this.LastDownlinkAt != nil {
-			return false
-		}
-	} else if !this.LastDownlinkAt.Equal(*that1.LastDownlinkAt) {
+	if !this.ADRAckLimitExponent.Equal(that1.ADRAckLimitExponent) {
 		return false
 	}
-	if len(this.RejectedDataRateRanges) != len(that1.RejectedDataRateRanges) {
+	if !this.ADRAckDelayExponent.Equal(that1.ADRAckDelayExponent) {
 		return false
 	}
-	for i := range this.RejectedDataRateRanges {
-		if !this.RejectedDataRateRanges[i].Equal(that1.RejectedDataRateRanges[i]) {
-			return false
-		}
-	}
-	if this.LastADRChangeFCntUp != that1.LastADRChangeFCntUp {
+	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
 		return false
 	}
 	return true
 }
-func (this *MACState_JoinRequest) Equal(that interface{}) bool {
+func (this *MACParameters_Channel) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACState_JoinRequest)
+	that1, ok := that.(*MACParameters_Channel)
 	if !ok {
-		that2, ok := that.(MACState_JoinRequest)
+		that2, ok := that.(MACParameters_Channel)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3693,25 +3828,31 @@ func (this *MACState_JoinRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DownlinkSettings.Equal(&that1.DownlinkSettings) {
+	if this.UplinkFrequency != that1.UplinkFrequency {
 		return false
 	}
-	if this.RxDelay != that1.RxDelay {
+	if this.DownlinkFrequency != that1.DownlinkFrequency {
 		return false
 	}
-	if !this.CFList.Equal(that1.CFList) {
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
+	}
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+		return false
+	}
+	if this.EnableUplink != that1.EnableUplink {
 		return false
 	}
 	return true
 }
-func (this *MACState_JoinAccept) Equal(that interface{}) bool {
+func (this *EndDeviceVersion) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACState_JoinAccept)
+	that1, ok := that.(*EndDeviceVersion)
 	if !ok {
-		that2, ok := that.(MACState_JoinAccept)
+		that2, ok := that.(EndDeviceVersion)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3723,66 +3864,60 @@ func (this *MACState_JoinAccept) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Payload, that1.Payload) {
+	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
 		return false
 	}
-	if !this.Request.Equal(&that1.Request) {
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
 		return false
 	}
-	if !this.Keys.Equal(&that1.Keys) {
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
 		return false
 	}
-	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
 		return false
 	}
-	for i := range this.CorrelationIDs {
-		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+	if len(this.Photos) != len(that1.Photos) {
+		return false
+	}
+	for i := range this.Photos {
+		if this.Photos[i] != that1.Photos[i] {
 			return false
 		}
 	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
+	if this.SupportsClassB != that1.SupportsClassB {
 		return false
 	}
-	if !this.NetID.Equal(that1.NetID) {
+	if this.SupportsClassC != that1.SupportsClassC {
 		return false
 	}
-	return true
-}
-func (this *MACState_DataRateRange) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.DefaultMACSettings.Equal(that1.DefaultMACSettings) {
+		return false
 	}
-
-	that1, ok := that.(*MACState_DataRateRange)
-	if !ok {
-		that2, ok := that.(MACState_DataRateRange)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.MaxFrequency != that1.MaxFrequency {
 		return false
 	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
+	if this.SupportsJoin != that1.SupportsJoin {
 		return false
 	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
+		return false
+	}
+	if !this.DefaultFormatters.Equal(&that1.DefaultFormatters) {
 		return false
 	}
 	return true
 }
-func (this *MACState_DataRateRanges) Equal(that interface{}) bool {
+func (this *MACSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACState_DataRateRanges)
+	that1, ok := that.(*MACSettings)
 	if !ok {
-		that2, ok := that.(MACState_DataRateRanges)
+		that2, ok := that.(MACSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3794,258 +3929,129 @@ func (this *MACState_DataRateRanges) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Ranges) != len(that1.Ranges) {
-		return false
-	}
-	for i := range this.Ranges {
-		if !this.Ranges[i].Equal(that1.Ranges[i]) {
-			return false
-		}
-	}
-	return true
-}
-func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*EndDeviceAuthenticationCode)
-	if !ok {
-		that2, ok := that.(EndDeviceAuthenticationCode)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.ClassBTimeout != nil && that1.ClassBTimeout != nil {
+		if *this.ClassBTimeout != *that1.ClassBTimeout {
 			return false
 		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	} else if this.ClassBTimeout != nil {
+		return false
+	} else if that1.ClassBTimeout != nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
 		return false
 	}
-	if that1.ValidFrom == nil {
-		if this.ValidFrom != nil {
-			return false
-		}
-	} else if !this.ValidFrom.Equal(*that1.ValidFrom) {
+	if !this.PingSlotDataRateIndex.Equal(that1.PingSlotDataRateIndex) {
 		return false
 	}
-	if that1.ValidTo == nil {
-		if this.ValidTo != nil {
-			return false
-		}
-	} else if !this.ValidTo.Equal(*that1.ValidTo) {
+	if !this.PingSlotFrequency.Equal(that1.PingSlotFrequency) {
 		return false
 	}
-	return true
-}
-func (this *EndDevice) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.BeaconFrequency.Equal(that1.BeaconFrequency) {
+		return false
 	}
-
-	that1, ok := that.(*EndDevice)
-	if !ok {
-		that2, ok := that.(EndDevice)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.ClassCTimeout != nil && that1.ClassCTimeout != nil {
+		if *this.ClassCTimeout != *that1.ClassCTimeout {
 			return false
 		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	} else if this.ClassCTimeout != nil {
 		return false
-	}
-	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+	} else if that1.ClassCTimeout != nil {
 		return false
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
+	if !this.Rx1Delay.Equal(that1.Rx1Delay) {
 		return false
 	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+	if !this.Rx1DataRateOffset.Equal(that1.Rx1DataRateOffset) {
 		return false
 	}
-	if this.Name != that1.Name {
+	if !this.Rx2DataRateIndex.Equal(that1.Rx2DataRateIndex) {
 		return false
 	}
-	if this.Description != that1.Description {
+	if !this.Rx2Frequency.Equal(that1.Rx2Frequency) {
 		return false
 	}
-	if len(this.Attributes) != len(that1.Attributes) {
+	if len(this.FactoryPresetFrequencies) != len(that1.FactoryPresetFrequencies) {
 		return false
 	}
-	for i := range this.Attributes {
-		if this.Attributes[i] != that1.Attributes[i] {
+	for i := range this.FactoryPresetFrequencies {
+		if this.FactoryPresetFrequencies[i] != that1.FactoryPresetFrequencies[i] {
 			return false
 		}
 	}
-	if !this.VersionIDs.Equal(that1.VersionIDs) {
+	if !this.MaxDutyCycle.Equal(that1.MaxDutyCycle) {
 		return false
 	}
-	if this.ServiceProfileID != that1.ServiceProfileID {
+	if !this.Supports32BitFCnt.Equal(that1.Supports32BitFCnt) {
 		return false
 	}
-	if this.NetworkServerAddress != that1.NetworkServerAddress {
+	if !this.UseADR.Equal(that1.UseADR) {
 		return false
 	}
-	if this.NetworkServerKEKLabel != that1.NetworkServerKEKLabel {
+	if !this.ADRMargin.Equal(that1.ADRMargin) {
 		return false
 	}
-	if this.ApplicationServerAddress != that1.ApplicationServerAddress {
+	if !this.ResetsFCnt.Equal(that1.ResetsFCnt) {
 		return false
 	}
-	if this.ApplicationServerKEKLabel != that1.ApplicationServerKEKLabel {
+	if this.StatusTimePeriodicity != nil && that1.StatusTimePeriodicity != nil {
+		if *this.StatusTimePeriodicity != *that1.StatusTimePeriodicity {
+			return false
+		}
+	} else if this.StatusTimePeriodicity != nil {
 		return false
-	}
-	if this.ApplicationServerID != that1.ApplicationServerID {
+	} else if that1.StatusTimePeriodicity != nil {
 		return false
 	}
-	if this.JoinServerAddress != that1.JoinServerAddress {
+	if !this.StatusCountPeriodicity.Equal(that1.StatusCountPeriodicity) {
 		return false
 	}
-	if len(this.Locations) != len(that1.Locations) {
+	if !this.DesiredRx1Delay.Equal(that1.DesiredRx1Delay) {
 		return false
 	}
-	for i := range this.Locations {
-		if !this.Locations[i].Equal(that1.Locations[i]) {
-			return false
-		}
-	}
-	if !this.Picture.Equal(that1.Picture) {
+	if !this.DesiredRx1DataRateOffset.Equal(that1.DesiredRx1DataRateOffset) {
 		return false
 	}
-	if this.SupportsClassB != that1.SupportsClassB {
+	if !this.DesiredRx2DataRateIndex.Equal(that1.DesiredRx2DataRateIndex) {
 		return false
 	}
-	if this.SupportsClassC != that1.SupportsClassC {
+	if !this.DesiredRx2Frequency.Equal(that1.DesiredRx2Frequency) {
 		return false
 	}
-	if this.LoRaWANVersion != that1.LoRaWANVersion {
+	if !this.DesiredMaxDutyCycle.Equal(that1.DesiredMaxDutyCycle) {
 		return false
 	}
-	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
-		return false
-	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
-		return false
-	}
-	if this.MinFrequency != that1.MinFrequency {
-		return false
-	}
-	if this.MaxFrequency != that1.MaxFrequency {
-		return false
-	}
-	if this.SupportsJoin != that1.SupportsJoin {
-		return false
-	}
-	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
-		return false
-	}
-	if !this.RootKeys.Equal(that1.RootKeys) {
-		return false
-	}
-	if that1.NetID == nil {
-		if this.NetID != nil {
-			return false
-		}
-	} else if !this.NetID.Equal(*that1.NetID) {
-		return false
-	}
-	if !this.MACSettings.Equal(that1.MACSettings) {
-		return false
-	}
-	if !this.MACState.Equal(that1.MACState) {
-		return false
-	}
-	if !this.PendingMACState.Equal(that1.PendingMACState) {
-		return false
-	}
-	if !this.Session.Equal(that1.Session) {
-		return false
-	}
-	if !this.PendingSession.Equal(that1.PendingSession) {
-		return false
-	}
-	if this.LastDevNonce != that1.LastDevNonce {
-		return false
-	}
-	if len(this.UsedDevNonces) != len(that1.UsedDevNonces) {
-		return false
-	}
-	for i := range this.UsedDevNonces {
-		if this.UsedDevNonces[i] != that1.UsedDevNonces[i] {
-			return false
-		}
-	}
-	if this.LastJoinNonce != that1.LastJoinNonce {
-		return false
-	}
-	if this.LastRJCount0 != that1.LastRJCount0 {
-		return false
-	}
-	if this.LastRJCount1 != that1.LastRJCount1 {
-		return false
-	}
-	if that1.LastDevStatusReceivedAt == nil {
-		if this.LastDevStatusReceivedAt != nil {
-			return false
-		}
-	} else if !this.LastDevStatusReceivedAt.Equal(*that1.LastDevStatusReceivedAt) {
-		return false
-	}
-	if this.PowerState != that1.PowerState {
-		return false
-	}
-	if !this.BatteryPercentage.Equal(that1.BatteryPercentage) {
-		return false
-	}
-	if this.DownlinkMargin != that1.DownlinkMargin {
-		return false
-	}
-	if len(this.QueuedApplicationDownlinks) != len(that1.QueuedApplicationDownlinks) {
-		return false
-	}
-	for i := range this.QueuedApplicationDownlinks {
-		if !this.QueuedApplicationDownlinks[i].Equal(that1.QueuedApplicationDownlinks[i]) {
-			return false
-		}
-	}
-	if !this.Formatters.Equal(that1.Formatters) {
+	if !this.DesiredADRAckLimitExponent.Equal(that1.DesiredADRAckLimitExponent) {
 		return false
 	}
-	if this.ProvisionerID != that1.ProvisionerID {
+	if !this.DesiredADRAckDelayExponent.Equal(that1.DesiredADRAckDelayExponent) {
 		return false
 	}
-	if !this.ProvisioningData.Equal(that1.ProvisioningData) {
+	if !this.DesiredPingSlotDataRateIndex.Equal(that1.DesiredPingSlotDataRateIndex) {
 		return false
 	}
-	if this.Multicast != that1.Multicast {
+	if !this.DesiredPingSlotFrequency.Equal(that1.DesiredPingSlotFrequency) {
 		return false
 	}
-	if !this.ClaimAuthenticationCode.Equal(that1.ClaimAuthenticationCode) {
+	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
 		return false
 	}
-	if this.SkipPayloadCrypto != that1.SkipPayloadCrypto {
+	if !this.DesiredMaxEirp.Equal(that1.DesiredMaxEirp) {
 		return false
 	}
-	if !this.SkipPayloadCryptoOverride.Equal(that1.SkipPayloadCryptoOverride) {
+	if !this.ADR.Equal(that1.ADR) {
 		return false
 	}
 	return true
 }
-func (this *EndDevices) Equal(that interface{}) bool {
+func (this *ADRSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDevices)
+	that1, ok := that.(*ADRSettings)
 	if !ok {
-		that2, ok := that.(EndDevices)
+		that2, ok := that.(ADRSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4057,24 +4063,25 @@ func (this *EndDevices) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.EndDevices) != len(that1.EndDevices) {
-		return false
-	}
-	for i := range this.EndDevices {
-		if !this.EndDevices[i].Equal(that1.EndDevices[i]) {
+	if that1.Strategy == nil {
+		if this.Strategy != nil {
 			return false
 		}
+	} else if this.Strategy == nil {
+		return false
+	} else if !this.Strategy.Equal(that1.Strategy) {
+		return false
 	}
 	return true
 }
-func (this *DevAddrPrefix) Equal(that interface{}) bool {
+func (this *ADRSettings_Dynamic) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DevAddrPrefix)
+	that1, ok := that.(*ADRSettings_Dynamic)
 	if !ok {
-		that2, ok := that.(DevAddrPrefix)
+		that2, ok := that.(ADRSettings_Dynamic)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4086,26 +4093,19 @@ func (this *DevAddrPrefix) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.DevAddr == nil {
-		if this.DevAddr != nil {
-			return false
-		}
-	} else if !this.DevAddr.Equal(*that1.DevAddr) {
-		return false
-	}
-	if this.Length != that1.Length {
+	if !this.Dynamic.Equal(that1.Dynamic) {
 		return false
 	}
 	return true
 }
-func (this *CreateEndDeviceRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_LossBased) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateEndDeviceRequest)
+	that1, ok := that.(*ADRSettings_LossBased)
 	if !ok {
-		that2, ok := that.(CreateEndDeviceRequest)
+		that2, ok := that.(ADRSettings_LossBased)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4117,19 +4117,19 @@ func (this *CreateEndDeviceRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDevice.Equal(&that1.EndDevice) {
+	if !this.LossBased.Equal(that1.LossBased) {
 		return false
 	}
 	return true
 }
-func (this *UpdateEndDeviceRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_Mobile) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateEndDeviceRequest)
+	that1, ok := that.(*ADRSettings_Mobile)
 	if !ok {
-		that2, ok := that.(UpdateEndDeviceRequest)
+		that2, ok := that.(ADRSettings_Mobile)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4141,22 +4141,19 @@ func (this *UpdateEndDeviceRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDevice.Equal(&that1.EndDevice) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.Mobile.Equal(that1.Mobile) {
 		return false
 	}
 	return true
 }
-func (this *GetEndDeviceRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_Static) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetEndDeviceRequest)
+	that1, ok := that.(*ADRSettings_Static)
 	if !ok {
-		that2, ok := that.(GetEndDeviceRequest)
+		that2, ok := that.(ADRSettings_Static)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4168,22 +4165,19 @@ func (this *GetEndDeviceRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.Static.Equal(that1.Static) {
 		return false
 	}
 	return true
 }
-func (this *GetEndDeviceIdentifiersForEUIsRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_DynamicStrategy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetEndDeviceIdentifiersForEUIsRequest)
+	that1, ok := that.(*ADRSettings_DynamicStrategy)
 	if !ok {
-		that2, ok := that.(GetEndDeviceIdentifiersForEUIsRequest)
+		that2, ok := that.(ADRSettings_DynamicStrategy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4195,22 +4189,19 @@ func (this *GetEndDeviceIdentifiersForEUIsRequest) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if !this.JoinEui.Equal(that1.JoinEui) {
-		return false
-	}
-	if !this.DevEui.Equal(that1.DevEui) {
+	if !this.Margin.Equal(that1.Margin) {
 		return false
 	}
 	return true
 }
-func (this *ListEndDevicesRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_LossBasedStrategy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListEndDevicesRequest)
+	that1, ok := that.(*ADRSettings_LossBasedStrategy)
 	if !ok {
-		that2, ok := that.(ListEndDevicesRequest)
+		that2, ok := that.(ADRSettings_LossBasedStrategy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4222,31 +4213,22 @@ func (this *ListEndDevicesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	if this.Order != that1.Order {
+	if !this.Margin.Equal(that1.Margin) {
 		return false
 	}
-	if this.Limit != that1.Limit {
-		return false
-	}
-	if this.Page != that1.Page {
+	if !this.MaxLossRate.Equal(that1.MaxLossRate) {
 		return false
 	}
 	return true
 }
-func (this *SetEndDeviceRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_MobileStrategy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SetEndDeviceRequest)
+	that1, ok := that.(*ADRSettings_MobileStrategy)
 	if !ok {
-		that2, ok := that.(SetEndDeviceRequest)
+		that2, ok := that.(ADRSettings_MobileStrategy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4258,22 +4240,19 @@ func (this *SetEndDeviceRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDevice.Equal(&that1.EndDevice) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.Margin.Equal(that1.Margin) {
 		return false
 	}
 	return true
 }
-func (this *ResetAndGetEndDeviceRequest) Equal(that interface{}) bool {
+func (this *ADRSettings_StaticStrategy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResetAndGetEndDeviceRequest)
+	that1, ok := that.(*ADRSettings_StaticStrategy)
 	if !ok {
-		that2, ok := that.(ResetAndGetEndDeviceRequest)
+		that2, ok := that.(ADRSettings_StaticStrategy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4285,22 +4264,25 @@ func (this *ResetAndGetEndDeviceRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+	if this.DataRateIndex != that1.DataRateIndex {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if this.TxPowerIndex != that1.TxPowerIndex {
+		return false
+	}
+	if this.NbTrans != that1.NbTrans {
 		return false
 	}
 	return true
 }
-func (this *EndDeviceTemplate) Equal(that interface{}) bool {
+func (this *ADRDecision) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceTemplate)
+	that1, ok := that.(*ADRDecision)
 	if !ok {
-		that2, ok := that.(EndDeviceTemplate)
+		that2, ok := that.(ADRDecision)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4312,60 +4294,46 @@ func (this *EndDeviceTemplate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDevice.Equal(&that1.EndDevice) {
+	if this.Strategy != that1.Strategy {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if this.UplinkCount != that1.UplinkCount {
 		return false
 	}
-	if this.MappingKey != that1.MappingKey {
+	if this.SNR != that1.SNR {
 		return false
 	}
-	return true
-}
-func (this *EndDeviceTemplateFormat) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.DemodulationFloor != that1.DemodulationFloor {
+		return false
 	}
-
-	that1, ok := that.(*EndDeviceTemplateFormat)
-	if !ok {
-		that2, ok := that.(EndDeviceTemplateFormat)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.Margin != that1.Margin {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.RemainingMargin != that1.RemainingMargin {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.LossRate != that1.LossRate {
 		return false
 	}
-	if this.Description != that1.Description {
+	if this.DataRateIndex != that1.DataRateIndex {
 		return false
 	}
-	if len(this.FileExtensions) != len(that1.FileExtensions) {
+	if this.TxPowerIndex != that1.TxPowerIndex {
 		return false
 	}
-	for i := range this.FileExtensions {
-		if this.FileExtensions[i] != that1.FileExtensions[i] {
-			return false
-		}
+	if this.NbTrans != that1.NbTrans {
+		return false
 	}
 	return true
 }
-func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
+func (this *MACState) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceTemplateFormats)
+	that1, ok := that.(*MACState)
 	if !ok {
-		that2, ok := that.(EndDeviceTemplateFormats)
+		that2, ok := that.(MACState)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4377,1652 +4345,886 @@ func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Formats) != len(that1.Formats) {
+	if !this.CurrentParameters.Equal(&that1.CurrentParameters) {
 		return false
 	}
-	for i := range this.Formats {
-		if !this.Formats[i].Equal(that1.Formats[i]) {
-			return false
-		}
+	if !this.DesiredParameters.Equal(&that1.DesiredParameters) {
+		return false
 	}
-	return true
-}
-func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.DeviceClass != that1.DeviceClass {
+		return false
 	}
-
-	that1, ok := that.(*ConvertEndDeviceTemplateRequest)
-	if !ok {
-		that2, ok := that.(ConvertEndDeviceTemplateRequest)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
+	}
+	if that1.LastConfirmedDownlinkAt == nil {
+		if this.LastConfirmedDownlinkAt != nil {
 			return false
 		}
+	} else if !this.LastConfirmedDownlinkAt.Equal(*that1.LastConfirmedDownlinkAt) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.LastDevStatusFCntUp != that1.LastDevStatusFCntUp {
 		return false
 	}
-	if this.FormatID != that1.FormatID {
+	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if !this.PendingApplicationDownlink.Equal(that1.PendingApplicationDownlink) {
 		return false
 	}
-	return true
-}
-func (m *Session) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.QueuedResponses) != len(that1.QueuedResponses) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	for i := range this.QueuedResponses {
+		if !this.QueuedResponses[i].Equal(that1.QueuedResponses[i]) {
+			return false
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
-	if err1 != nil {
-		return 0, err1
+	if len(this.PendingRequests) != len(that1.PendingRequests) {
+		return false
 	}
-	i -= n1
-	i = encodeVarintEndDevice(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	if m.LastConfFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastConfFCntDown))
-		i--
-		dAtA[i] = 0x38
+	for i := range this.PendingRequests {
+		if !this.PendingRequests[i].Equal(that1.PendingRequests[i]) {
+			return false
+		}
 	}
-	if m.LastAFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastAFCntDown))
-		i--
-		dAtA[i] = 0x30
+	if !this.QueuedJoinAccept.Equal(that1.QueuedJoinAccept) {
+		return false
 	}
-	if m.LastNFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastNFCntDown))
-		i--
-		dAtA[i] = 0x28
+	if !this.PendingJoinRequest.Equal(that1.PendingJoinRequest) {
+		return false
 	}
-	if m.LastFCntUp != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastFCntUp))
-		i--
-		dAtA[i] = 0x20
+	if this.RxWindowsAvailable != that1.RxWindowsAvailable {
+		return false
 	}
-	{
-		size, err := m.SessionKeys.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.RecentUplinks) != len(that1.RecentUplinks) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.RecentUplinks {
+		if !this.RecentUplinks[i].Equal(that1.RecentUplinks[i]) {
+			return false
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-
-func (m *BoolValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.RecentDownlinks) != len(that1.RecentDownlinks) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BoolValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value {
-		i--
-		if m.Value {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.RecentDownlinks {
+		if !this.RecentDownlinks[i].Equal(that1.RecentDownlinks[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PingSlotDataRateIndexValue != nil {
-		{
-			size, err := m.PingSlotDataRateIndexValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if that1.LastNetworkInitiatedDownlinkAt == nil {
+		if this.LastNetworkInitiatedDownlinkAt != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	} else if !this.LastNetworkInitiatedDownlinkAt.Equal(*that1.LastNetworkInitiatedDownlinkAt) {
+		return false
 	}
-	if m.ADRAckDelayExponent != nil {
-		{
-			size, err := m.ADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.RejectedADRDataRateIndexes) != len(that1.RejectedADRDataRateIndexes) {
+		return false
+	}
+	for i := range this.RejectedADRDataRateIndexes {
+		if this.RejectedADRDataRateIndexes[i] != that1.RejectedADRDataRateIndexes[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
 	}
-	if m.ADRAckLimitExponent != nil {
-		{
-			size, err := m.ADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.RejectedADRTxPowerIndexes) != len(that1.RejectedADRTxPowerIndexes) {
+		return false
+	}
+	for i := range this.RejectedADRTxPowerIndexes {
+		if this.RejectedADRTxPowerIndexes[i] != that1.RejectedADRTxPowerIndexes[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
 	}
-	if m.DownlinkDwellTime != nil {
-		{
-			size, err := m.DownlinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.RejectedFrequencies) != len(that1.RejectedFrequencies) {
+		return false
+	}
+	for i := range this.RejectedFrequencies {
+		if this.RejectedFrequencies[i] != that1.RejectedFrequencies[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
 	}
-	if m.UplinkDwellTime != nil {
-		{
-			size, err := m.UplinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if that1.LastDownlinkAt == nil {
+		if this.LastDownlinkAt != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	} else if !this.LastDownlinkAt.Equal(*that1.LastDownlinkAt) {
+		return false
 	}
-	if len(m.Channels) > 0 {
-		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+	if len(this.RejectedDataRateRanges) != len(that1.RejectedDataRateRanges) {
+		return false
+	}
+	for i := range this.RejectedDataRateRanges {
+		if !this.RejectedDataRateRanges[i].Equal(that1.RejectedDataRateRanges[i]) {
+			return false
 		}
 	}
-	if m.BeaconFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.BeaconFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	if this.LastADRChangeFCntUp != that1.LastADRChangeFCntUp {
+		return false
 	}
-	if m.PingSlotDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.PingSlotDataRateIndex))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	return true
+}
+func (this *MACState_JoinRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.PingSlotFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.PingSlotFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+
+	that1, ok := that.(*MACState_JoinRequest)
+	if !ok {
+		that2, ok := that.(MACState_JoinRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.RejoinCountPeriodicity != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinCountPeriodicity))
-		i--
-		dAtA[i] = 0x78
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.RejoinTimePeriodicity != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinTimePeriodicity))
-		i--
-		dAtA[i] = 0x70
+	if !this.DownlinkSettings.Equal(&that1.DownlinkSettings) {
+		return false
 	}
-	if m.MaxDutyCycle != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDutyCycle))
-		i--
-		dAtA[i] = 0x68
+	if this.RxDelay != that1.RxDelay {
+		return false
 	}
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x60
+	if !this.CFList.Equal(that1.CFList) {
+		return false
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx2DataRateIndex))
-		i--
-		dAtA[i] = 0x58
+	return true
+}
+func (this *MACState_JoinAccept) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Rx1DataRateOffset != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1DataRateOffset))
-		i--
-		dAtA[i] = 0x50
+
+	that1, ok := that.(*MACState_JoinAccept)
+	if !ok {
+		that2, ok := that.(MACState_JoinAccept)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Rx1Delay != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1Delay))
-		i--
-		dAtA[i] = 0x48
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ADRAckDelay != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckDelay))
-		i--
-		dAtA[i] = 0x40
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
 	}
-	if m.ADRAckLimit != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckLimit))
-		i--
-		dAtA[i] = 0x38
+	if !this.Request.Equal(&that1.Request) {
+		return false
 	}
-	if m.ADRNbTrans != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRNbTrans))
-		i--
-		dAtA[i] = 0x30
+	if !this.Keys.Equal(&that1.Keys) {
+		return false
 	}
-	if m.ADRTxPowerIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRTxPowerIndex))
-		i--
-		dAtA[i] = 0x28
+	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+		return false
 	}
-	if m.ADRDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRDataRateIndex))
-		i--
-		dAtA[i] = 0x20
+	for i := range this.CorrelationIDs {
+		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+			return false
+		}
 	}
-	if m.MaxEIRP != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.MaxEIRP)))
-		i--
-		dAtA[i] = 0xd
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACParameters_Channel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.NetID.Equal(that1.NetID) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACParameters_Channel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACState_DataRateRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACParameters_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableUplink {
-		i--
-		if m.EnableUplink {
-			dAtA[i] = 1
+	that1, ok := that.(*MACState_DataRateRange)
+	if !ok {
+		that2, ok := that.(MACState_DataRateRange)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.MaxDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MinDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DownlinkFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.DownlinkFrequency)
-		i--
-		dAtA[i] = 0x10
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
 	}
-	if m.UplinkFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.UplinkFrequency)
-		i--
-		dAtA[i] = 0x8
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *EndDeviceVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACState_DataRateRanges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EndDeviceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.ResetsJoinNonces {
-		i--
-		if m.ResetsJoinNonces {
-			dAtA[i] = 1
+	that1, ok := that.(*MACState_DataRateRanges)
+	if !ok {
+		that2, ok := that.(MACState_DataRateRanges)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x60
 	}
-	if m.SupportsJoin {
-		i--
-		if m.SupportsJoin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MaxFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
-		i--
-		dAtA[i] = 0x48
+	if len(this.Ranges) != len(that1.Ranges) {
+		return false
 	}
-	if m.DefaultMACSettings != nil {
-		{
-			size, err := m.DefaultMACSettings.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	for i := range this.Ranges {
+		if !this.Ranges[i].Equal(that1.Ranges[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.SupportsClassC {
-		i--
-		if m.SupportsClassC {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return true
+}
+func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.SupportsClassB {
-		i--
-		if m.SupportsClassB {
-			dAtA[i] = 1
+
+	that1, ok := that.(*EndDeviceAuthenticationCode)
+	if !ok {
+		that2, ok := that.(EndDeviceAuthenticationCode)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Photos) > 0 {
-		for iNdEx := len(m.Photos) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Photos[iNdEx])
-			copy(dAtA[i:], m.Photos[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Photos[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+			return false
 		}
 	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LoRaWANPHYVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
-		i--
-		dAtA[i] = 0x18
+	if this.Value != that1.Value {
+		return false
 	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
-		i--
-		dAtA[i] = 0x10
+	if that1.ValidFrom == nil {
+		if this.ValidFrom != nil {
+			return false
+		}
+	} else if !this.ValidFrom.Equal(*that1.ValidFrom) {
+		return false
 	}
-	{
-		size, err := m.EndDeviceVersionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1.ValidTo == nil {
+		if this.ValidTo != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	} else if !this.ValidTo.Equal(*that1.ValidTo) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MACSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EndDevice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DesiredMaxEirp != nil {
-		{
-			size, err := m.DesiredMaxEirp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	that1, ok := that.(*EndDevice)
+	if !ok {
+		that2, ok := that.(EndDevice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
 	}
-	if m.DesiredBeaconFrequency != nil {
-		{
-			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DesiredPingSlotFrequency != nil {
-		{
-			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe2
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
 	}
-	if m.DesiredPingSlotDataRateIndex != nil {
-		{
-			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	if m.BeaconFrequency != nil {
-		{
-			size, err := m.BeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
 	}
-	if m.DesiredADRAckDelayExponent != nil {
-		{
-			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.DesiredADRAckLimitExponent != nil {
-		{
-			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	if this.Description != that1.Description {
+		return false
 	}
-	if m.DesiredMaxDutyCycle != nil {
-		{
-			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
 	}
-	if m.DesiredRx2Frequency != nil {
-		{
-			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
 	}
-	if m.DesiredRx2DataRateIndex != nil {
-		{
-			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	if this.ServiceProfileID != that1.ServiceProfileID {
+		return false
 	}
-	if m.DesiredRx1DataRateOffset != nil {
-		{
-			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	if this.NetworkServerAddress != that1.NetworkServerAddress {
+		return false
 	}
-	if m.DesiredRx1Delay != nil {
-		{
-			size, err := m.DesiredRx1Delay.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	if this.NetworkServerKEKLabel != that1.NetworkServerKEKLabel {
+		return false
 	}
-	if m.StatusCountPeriodicity != nil {
-		{
-			size, err := m.StatusCountPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	if this.ApplicationServerAddress != that1.ApplicationServerAddress {
+		return false
 	}
-	if m.StatusTimePeriodicity != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
-		if err24 != nil {
-			return 0, err24
-		}
-		i -= n24
-		i = encodeVarintEndDevice(dAtA, i, uint64(n24))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if this.ApplicationServerKEKLabel != that1.ApplicationServerKEKLabel {
+		return false
 	}
-	if m.ResetsFCnt != nil {
-		{
-			size, err := m.ResetsFCnt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+	if this.ApplicationServerID != that1.ApplicationServerID {
+		return false
 	}
-	if m.ADRMargin != nil {
-		{
-			size, err := m.ADRMargin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	if this.JoinServerAddress != that1.JoinServerAddress {
+		return false
 	}
-	if m.UseADR != nil {
-		{
-			size, err := m.UseADR.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	if len(this.Locations) != len(that1.Locations) {
+		return false
 	}
-	if m.Supports32BitFCnt != nil {
-		{
-			size, err := m.Supports32BitFCnt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	for i := range this.Locations {
+		if !this.Locations[i].Equal(that1.Locations[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x62
 	}
-	if m.MaxDutyCycle != nil {
-		{
-			size, err := m.MaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	if !this.Picture.Equal(that1.Picture) {
+		return false
 	}
-	if len(m.FactoryPresetFrequencies) > 0 {
-		dAtA31 := make([]byte, len(m.FactoryPresetFrequencies)*10)
-		var j30 int
-		for _, num := range m.FactoryPresetFrequencies {
-			for num >= 1<<7 {
-				dAtA31[j30] = uint8(num&0x7f | 0x80)
-				num >>= 7
-				j30++
-			}
-			dAtA31[j30] = uint8(num)
-			j30++
-		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j30))
-		i--
-		dAtA[i] = 0x52
+	if this.SupportsClassB != that1.SupportsClassB {
+		return false
 	}
-	if m.Rx2Frequency != nil {
-		{
-			size, err := m.Rx2Frequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if this.SupportsClassC != that1.SupportsClassC {
+		return false
 	}
-	if m.Rx2DataRateIndex != nil {
-		{
-			size, err := m.Rx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
 	}
-	if m.Rx1DataRateOffset != nil {
-		{
-			size, err := m.Rx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+		return false
 	}
-	if m.Rx1Delay != nil {
-		{
-			size, err := m.Rx1Delay.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
 	}
-	if m.ClassCTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
-		if err36 != nil {
-			return 0, err36
-		}
-		i -= n36
-		i = encodeVarintEndDevice(dAtA, i, uint64(n36))
-		i--
-		dAtA[i] = 0x2a
+	if this.MinFrequency != that1.MinFrequency {
+		return false
 	}
-	if m.PingSlotFrequency != nil {
-		{
-			size, err := m.PingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
 	}
-	if m.PingSlotDataRateIndex != nil {
-		{
-			size, err := m.PingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.SupportsJoin != that1.SupportsJoin {
+		return false
 	}
-	if m.PingSlotPeriodicity != nil {
-		{
-			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.ResetsJoinNonces != that1.ResetsJoinNonces {
+		return false
 	}
-	if m.ClassBTimeout != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
-		if err40 != nil {
-			return 0, err40
-		}
-		i -= n40
-		i = encodeVarintEndDevice(dAtA, i, uint64(n40))
-		i--
-		dAtA[i] = 0xa
+	if !this.RootKeys.Equal(that1.RootKeys) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastADRChangeFCntUp != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRChangeFCntUp))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
-	}
-	if len(m.RejectedDataRateRanges) > 0 {
-		for k := range m.RejectedDataRateRanges {
-			v := m.RejectedDataRateRanges[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEndDevice(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintEndDevice(dAtA, i, k)
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
+	if that1.NetID == nil {
+		if this.NetID != nil {
+			return false
 		}
+	} else if !this.NetID.Equal(*that1.NetID) {
+		return false
 	}
-	if m.LastDownlinkAt != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt):])
-		if err42 != nil {
-			return 0, err42
-		}
-		i -= n42
-		i = encodeVarintEndDevice(dAtA, i, uint64(n42))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	if !this.MACSettings.Equal(that1.MACSettings) {
+		return false
 	}
-	if len(m.RejectedFrequencies) > 0 {
-		dAtA44 := make([]byte, len(m.RejectedFrequencies)*10)
-		var j43 int
-		for _, num := range m.RejectedFrequencies {
-			for num >= 1<<7 {
-				dAtA44[j43] = uint8(num&0x7f | 0x80)
-				num >>= 7
-				j43++
-			}
-			dAtA44[j43] = uint8(num)
-			j43++
-		}
-		i -= j43
-		copy(dAtA[i:], dAtA44[:j43])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j43))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	if !this.MACState.Equal(that1.MACState) {
+		return false
 	}
-	if len(m.RejectedADRTxPowerIndexes) > 0 {
-		dAtA46 := make([]byte, len(m.RejectedADRTxPowerIndexes)*10)
-		var j45 int
-		for _, num := range m.RejectedADRTxPowerIndexes {
-			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j45++
-			}
-			dAtA46[j45] = uint8(num)
-			j45++
-		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j45))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	if !this.PendingMACState.Equal(that1.PendingMACState) {
+		return false
 	}
-	if len(m.RejectedADRDataRateIndexes) > 0 {
-		dAtA48 := make([]byte, len(m.RejectedADRDataRateIndexes)*10)
-		var j47 int
-		for _, num := range m.RejectedADRDataRateIndexes {
-			for num >= 1<<7 {
-				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j47++
-			}
-			dAtA48[j47] = uint8(num)
-			j47++
-		}
-		i -= j47
-		copy(dAtA[i:], dAtA48[:j47])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j47))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	if !this.Session.Equal(that1.Session) {
+		return false
 	}
-	if m.LastNetworkInitiatedDownlinkAt != nil {
-		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
-		if err49 != nil {
-			return 0, err49
-		}
-		i -= n49
-		i = encodeVarintEndDevice(dAtA, i, uint64(n49))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if !this.PendingSession.Equal(that1.PendingSession) {
+		return false
 	}
-	if len(m.RecentDownlinks) > 0 {
-		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+	if this.LastDevNonce != that1.LastDevNonce {
+		return false
 	}
-	if len(m.RecentUplinks) > 0 {
-		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
+	if len(this.UsedDevNonces) != len(that1.UsedDevNonces) {
+		return false
 	}
-	if m.RxWindowsAvailable {
-		i--
-		if m.RxWindowsAvailable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.UsedDevNonces {
+		if this.UsedDevNonces[i] != that1.UsedDevNonces[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x68
 	}
-	if m.PendingJoinRequest != nil {
-		{
-			size, err := m.PendingJoinRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	if this.LastJoinNonce != that1.LastJoinNonce {
+		return false
 	}
-	if m.QueuedJoinAccept != nil {
-		{
-			size, err := m.QueuedJoinAccept.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	if this.LastRJCount0 != that1.LastRJCount0 {
+		return false
 	}
-	if len(m.PendingRequests) > 0 {
-		for iNdEx := len(m.PendingRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	if this.LastRJCount1 != that1.LastRJCount1 {
+		return false
 	}
-	if len(m.QueuedResponses) > 0 {
-		for iNdEx := len(m.QueuedResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	if that1.LastDevStatusReceivedAt == nil {
+		if this.LastDevStatusReceivedAt != nil {
+			return false
 		}
+	} else if !this.LastDevStatusReceivedAt.Equal(*that1.LastDevStatusReceivedAt) {
+		return false
 	}
-	if m.PendingApplicationDownlink != nil {
-		{
-			size, err := m.PendingApplicationDownlink.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	if this.PowerState != that1.PowerState {
+		return false
 	}
-	if m.PingSlotPeriodicity != nil {
-		{
-			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if !this.BatteryPercentage.Equal(that1.BatteryPercentage) {
+		return false
 	}
-	if m.LastDevStatusFCntUp != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevStatusFCntUp))
-		i--
-		dAtA[i] = 0x30
+	if this.DownlinkMargin != that1.DownlinkMargin {
+		return false
 	}
-	if m.LastConfirmedDownlinkAt != nil {
-		n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
-		if err54 != nil {
-			return 0, err54
+	if len(this.QueuedApplicationDownlinks) != len(that1.QueuedApplicationDownlinks) {
+		return false
+	}
+	for i := range this.QueuedApplicationDownlinks {
+		if !this.QueuedApplicationDownlinks[i].Equal(that1.QueuedApplicationDownlinks[i]) {
+			return false
 		}
-		i -= n54
-		i = encodeVarintEndDevice(dAtA, i, uint64(n54))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
-		i--
-		dAtA[i] = 0x20
+	if !this.Formatters.Equal(that1.Formatters) {
+		return false
 	}
-	if m.DeviceClass != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.DeviceClass))
-		i--
-		dAtA[i] = 0x18
+	if this.ProvisionerID != that1.ProvisionerID {
+		return false
 	}
-	{
-		size, err := m.DesiredParameters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if !this.ProvisioningData.Equal(that1.ProvisioningData) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CurrentParameters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if this.Multicast != that1.Multicast {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MACState_JoinRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.ClaimAuthenticationCode.Equal(that1.ClaimAuthenticationCode) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACState_JoinRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SkipPayloadCrypto != that1.SkipPayloadCrypto {
+		return false
+	}
+	if !this.SkipPayloadCryptoOverride.Equal(that1.SkipPayloadCryptoOverride) {
+		return false
+	}
+	return true
 }
+func (this *EndDevices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACState_JoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CFList != nil {
-		{
-			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	that1, ok := that.(*EndDevices)
+	if !ok {
+		that2, ok := that.(EndDevices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.RxDelay != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.RxDelay))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.DownlinkSettings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.EndDevices) != len(that1.EndDevices) {
+		return false
+	}
+	for i := range this.EndDevices {
+		if !this.EndDevices[i].Equal(that1.EndDevices[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MACState_JoinAccept) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DevAddrPrefix) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACState_JoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NetID.Size()
-		i -= size
-		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.CorrelationIDs) > 0 {
-		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CorrelationIDs[iNdEx])
-			copy(dAtA[i:], m.CorrelationIDs[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.CorrelationIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*DevAddrPrefix)
+	if !ok {
+		that2, ok := that.(DevAddrPrefix)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size, err := m.Keys.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1.DevAddr == nil {
+		if this.DevAddr != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	} else if !this.DevAddr.Equal(*that1.DevAddr) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0xa
+	if this.Length != that1.Length {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MACState_DataRateRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CreateEndDeviceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACState_DataRateRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACState_DataRateRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*CreateEndDeviceRequest)
+	if !ok {
+		that2, ok := that.(CreateEndDeviceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.MinDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACState_DataRateRanges) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.EndDevice.Equal(&that1.EndDevice) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *UpdateEndDeviceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACState_DataRateRanges) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACState_DataRateRanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ranges) > 0 {
-		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*UpdateEndDeviceRequest)
+	if !ok {
+		that2, ok := that.(UpdateEndDeviceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.EndDevice.Equal(&that1.EndDevice) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *GetEndDeviceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidTo != nil {
-		n61, err61 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
-		if err61 != nil {
-			return 0, err61
+	that1, ok := that.(*GetEndDeviceRequest)
+	if !ok {
+		that2, ok := that.(GetEndDeviceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n61
-		i = encodeVarintEndDevice(dAtA, i, uint64(n61))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.ValidFrom != nil {
-		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
-		if err62 != nil {
-			return 0, err62
-		}
-		i -= n62
-		i = encodeVarintEndDevice(dAtA, i, uint64(n62))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDevice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *GetEndDeviceIdentifiersForEUIsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SkipPayloadCryptoOverride != nil {
-		{
-			size, err := m.SkipPayloadCryptoOverride.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	that1, ok := that.(*GetEndDeviceIdentifiersForEUIsRequest)
+	if !ok {
+		that2, ok := that.(GetEndDeviceIdentifiersForEUIsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xa2
 	}
-	if m.SkipPayloadCrypto {
-		i--
-		if m.SkipPayloadCrypto {
-			dAtA[i] = 1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	return true
+}
+func (this *ListEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(ListEndDevicesRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x98
 	}
-	if m.Picture != nil {
-		{
-			size, err := m.Picture.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x92
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ApplicationServerID) > 0 {
-		i -= len(m.ApplicationServerID)
-		copy(dAtA[i:], m.ApplicationServerID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x8a
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
 	}
-	if len(m.ApplicationServerKEKLabel) > 0 {
-		i -= len(m.ApplicationServerKEKLabel)
-		copy(dAtA[i:], m.ApplicationServerKEKLabel)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerKEKLabel)))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x82
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
 	}
-	if len(m.NetworkServerKEKLabel) > 0 {
-		i -= len(m.NetworkServerKEKLabel)
-		copy(dAtA[i:], m.NetworkServerKEKLabel)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerKEKLabel)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xfa
+	if this.Order != that1.Order {
+		return false
 	}
-	if m.ClaimAuthenticationCode != nil {
-		{
-			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xf2
+	if this.Limit != that1.Limit {
+		return false
 	}
-	if m.Multicast {
-		i--
-		if m.Multicast {
-			dAtA[i] = 1
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
+}
+func (this *SetEndDeviceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetEndDeviceRequest)
+	if !ok {
+		that2, ok := that.(SetEndDeviceRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xe8
 	}
-	if m.PendingMACState != nil {
-		{
-			size, err := m.PendingMACState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDevice.Equal(&that1.EndDevice) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ResetAndGetEndDeviceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetAndGetEndDeviceRequest)
+	if !ok {
+		that2, ok := that.(ResetAndGetEndDeviceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xe2
 	}
-	if m.ProvisioningData != nil {
-		{
-			size, err := m.ProvisioningData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceTemplate)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xda
 	}
-	if len(m.ProvisionerID) > 0 {
-		i -= len(m.ProvisionerID)
-		copy(dAtA[i:], m.ProvisionerID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProvisionerID)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Formatters != nil {
-		{
-			size, err := m.Formatters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if !this.EndDevice.Equal(&that1.EndDevice) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.MappingKey != that1.MappingKey {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceTemplateFormat) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceTemplateFormat)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplateFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xca
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.FileExtensions) != len(that1.FileExtensions) {
+		return false
+	}
+	for i := range this.FileExtensions {
+		if this.FileExtensions[i] != that1.FileExtensions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceTemplateFormats)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplateFormats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Formats) != len(that1.Formats) {
+		return false
+	}
+	for i := range this.Formats {
+		if !this.Formats[i].Equal(that1.Formats[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConvertEndDeviceTemplateRequest)
+	if !ok {
+		that2, ok := that.(ConvertEndDeviceTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FormatID != that1.FormatID {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.QueuedApplicationDownlinks) > 0 {
 		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6034,102 +5236,116 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintEndDevice(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.DownlinkMargin != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkMargin))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEndDevice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.LastConfFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastConfFCntDown))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x38
+	}
+	if m.LastAFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastAFCntDown))
 		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0x30
 	}
-	if m.BatteryPercentage != nil {
-		{
-			size, err := m.BatteryPercentage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.LastNFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastNFCntDown))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x28
+	}
+	if m.LastFCntUp != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastFCntUp))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x20
 	}
-	if m.PowerState != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.PowerState))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.LastDevStatusReceivedAt != nil {
-		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
-		if err70 != nil {
-			return 0, err70
+	{
+		size, err := m.SessionKeys.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i -= n70
-		i = encodeVarintEndDevice(dAtA, i, uint64(n70))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.LastRJCount1 != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x80
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.LastRJCount0 != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount0))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf8
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.LastJoinNonce != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastJoinNonce))
-		i--
-		dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value {
 		i--
-		dAtA[i] = 0xf0
-	}
-	if len(m.UsedDevNonces) > 0 {
-		dAtA72 := make([]byte, len(m.UsedDevNonces)*10)
-		var j71 int
-		for _, num := range m.UsedDevNonces {
-			for num >= 1<<7 {
-				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j71++
-			}
-			dAtA72[j71] = uint8(num)
-			j71++
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j71
-		copy(dAtA[i:], dAtA72[:j71])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j71))
-		i--
-		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x8
 	}
-	if m.LastDevNonce != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe0
+	return len(dAtA) - i, nil
+}
+
+func (m *MACParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PendingSession != nil {
+	return dAtA[:n], nil
+}
+
+func (m *MACParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PingSlotDataRateIndexValue != nil {
 		{
-			size, err := m.PendingSession.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PingSlotDataRateIndexValue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6139,11 +5355,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xc2
 	}
-	if m.Session != nil {
+	if m.ADRAckDelayExponent != nil {
 		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6153,11 +5369,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xba
 	}
-	if m.MACState != nil {
+	if m.ADRAckLimitExponent != nil {
 		{
-			size, err := m.MACState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6167,11 +5383,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0xb2
 	}
-	if m.MACSettings != nil {
+	if m.DownlinkDwellTime != nil {
 		{
-			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DownlinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6181,25 +5397,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc2
-	}
-	if m.NetID != nil {
-		{
-			size := m.NetID.Size()
-			i -= size
-			if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0xaa
 	}
-	if m.RootKeys != nil {
+	if m.UplinkDwellTime != nil {
 		{
-			size, err := m.RootKeys.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UplinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6209,216 +5411,115 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0xa2
 	}
-	if m.ResetsJoinNonces {
-		i--
-		if m.ResetsJoinNonces {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
+	}
+	if m.BeaconFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.BeaconFrequency)
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa8
+		dAtA[i] = 0x90
 	}
-	if m.SupportsJoin {
-		i--
-		if m.SupportsJoin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.PingSlotDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PingSlotDataRateIndex))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0x88
 	}
-	if m.MaxFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
+	if m.PingSlotFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.PingSlotFrequency)
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x80
 	}
-	if m.MinFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
-		i--
-		dAtA[i] = 0x1
+	if m.RejoinCountPeriodicity != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinCountPeriodicity))
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0x78
 	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
+	if m.RejoinTimePeriodicity != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinTimePeriodicity))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x70
+	}
+	if m.MaxDutyCycle != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDutyCycle))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x68
 	}
-	if m.LoRaWANPHYVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.Rx2Frequency)
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x60
+	}
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx2DataRateIndex))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x58
 	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+	if m.Rx1DataRateOffset != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1DataRateOffset))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x50
 	}
-	if m.SupportsClassC {
+	if m.Rx1Delay != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1Delay))
 		i--
-		if m.SupportsClassC {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x48
+	}
+	if m.ADRAckDelay != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckDelay))
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x40
 	}
-	if m.SupportsClassB {
+	if m.ADRAckLimit != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckLimit))
 		i--
-		if m.SupportsClassB {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.Locations) > 0 {
-		for k := range m.Locations {
-			v := m.Locations[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEndDevice(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.JoinServerAddress) > 0 {
-		i -= len(m.JoinServerAddress)
-		copy(dAtA[i:], m.JoinServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.JoinServerAddress)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ApplicationServerAddress) > 0 {
-		i -= len(m.ApplicationServerAddress)
-		copy(dAtA[i:], m.ApplicationServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerAddress)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.NetworkServerAddress) > 0 {
-		i -= len(m.NetworkServerAddress)
-		copy(dAtA[i:], m.NetworkServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerAddress)))
-		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x38
 	}
-	if len(m.ServiceProfileID) > 0 {
-		i -= len(m.ServiceProfileID)
-		copy(dAtA[i:], m.ServiceProfileID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ServiceProfileID)))
+	if m.ADRNbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRNbTrans))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x30
 	}
-	if m.VersionIDs != nil {
-		{
-			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.ADRTxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRTxPowerIndex))
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+		dAtA[i] = 0x28
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+	if m.ADRDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRDataRateIndex))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+	if m.MaxEIRP != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.MaxEIRP)))
 		i--
-		dAtA[i] = 0x22
-	}
-	n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err80 != nil {
-		return 0, err80
-	}
-	i -= n80
-	i = encodeVarintEndDevice(dAtA, i, uint64(n80))
-	i--
-	dAtA[i] = 0x1a
-	n81, err81 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err81 != nil {
-		return 0, err81
-	}
-	i -= n81
-	i = encodeVarintEndDevice(dAtA, i, uint64(n81))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		dAtA[i] = 0xd
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EndDevices) Marshal() (dAtA []byte, err error) {
+func (m *MACParameters_Channel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6428,74 +5529,50 @@ func (m *EndDevices) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDevices) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACParameters_Channel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACParameters_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EndDevices) > 0 {
-		for iNdEx := len(m.EndDevices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EndDevices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.EnableUplink {
+		i--
+		if m.EnableUplink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DevAddrPrefix) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DevAddrPrefix) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DevAddrPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Length != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Length))
+	if m.MinDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DownlinkFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.DownlinkFrequency)
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.DevAddr != nil {
-		{
-			size := m.DevAddr.Size()
-			i -= size
-			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.UplinkFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.UplinkFrequency)
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6505,18 +5582,18 @@ func (m *CreateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDeviceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6524,188 +5601,97 @@ func (m *CreateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0x6a
+	if m.ResetsJoinNonces {
+		i--
+		if m.ResetsJoinNonces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x60
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if m.SupportsJoin {
+		i--
+		if m.SupportsJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
+		i--
+		dAtA[i] = 0x50
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if m.MinFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
+		i--
+		dAtA[i] = 0x48
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DefaultMACSettings != nil {
+		{
+			size, err := m.DefaultMACSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i--
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DevEui.Size()
-		i -= size
-		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.SupportsClassC {
+		i--
+		if m.SupportsClassC {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x38
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.JoinEui.Size()
-		i -= size
-		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.SupportsClassB {
+		i--
+		if m.SupportsClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x30
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ListEndDevicesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Photos) > 0 {
+		for iNdEx := len(m.Photos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Photos[iNdEx])
+			copy(dAtA[i:], m.Photos[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Photos[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Page))
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x22
 	}
-	if m.Limit != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Limit))
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	if len(m.Order) > 0 {
-		i -= len(m.Order)
-		copy(dAtA[i:], m.Order)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Order)))
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
 		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.EndDeviceVersionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6717,7 +5703,7 @@ func (m *ListEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+func (m *MACSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6727,228 +5713,410 @@ func (m *SetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ADR != nil {
+		{
+			size, err := m.ADR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DesiredMaxEirp != nil {
+		{
+			size, err := m.DesiredMaxEirp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ResetAndGetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.DesiredBeaconFrequency != nil {
+		{
+			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResetAndGetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResetAndGetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DesiredPingSlotFrequency != nil {
+		{
+			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DesiredPingSlotDataRateIndex != nil {
+		{
+			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.BeaconFrequency != nil {
+		{
+			size, err := m.BeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MappingKey) > 0 {
-		i -= len(m.MappingKey)
-		copy(dAtA[i:], m.MappingKey)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.MappingKey)))
+	if m.DesiredADRAckDelayExponent != nil {
+		{
+			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DesiredADRAckLimitExponent != nil {
+		{
+			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.DesiredMaxDutyCycle != nil {
+		{
+			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplateFormat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.DesiredRx2Frequency != nil {
+		{
+			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplateFormat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplateFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FileExtensions) > 0 {
-		for iNdEx := len(m.FileExtensions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FileExtensions[iNdEx])
-			copy(dAtA[i:], m.FileExtensions[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FileExtensions[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.DesiredRx2DataRateIndex != nil {
+		{
+			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+	if m.DesiredRx1DataRateOffset != nil {
+		{
+			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+	if m.DesiredRx1Delay != nil {
+		{
+			size, err := m.DesiredRx1Delay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplateFormats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StatusCountPeriodicity != nil {
+		{
+			size, err := m.StatusCountPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplateFormats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplateFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEndDevice(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.StatusTimePeriodicity != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintEndDevice(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ResetsFCnt != nil {
+		{
+			size, err := m.ResetsFCnt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ADRMargin != nil {
+		{
+			size, err := m.ADRMargin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UseADR != nil {
+		{
+			size, err := m.UseADR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Supports32BitFCnt != nil {
+		{
+			size, err := m.Supports32BitFCnt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxDutyCycle != nil {
+		{
+			size, err := m.MaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FactoryPresetFrequencies) > 0 {
+		dAtA32 := make([]byte, len(m.FactoryPresetFrequencies)*10)
+		var j31 int
+		for _, num := range m.FactoryPresetFrequencies {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(num&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Rx2Frequency != nil {
+		{
+			size, err := m.Rx2Frequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Rx2DataRateIndex != nil {
+		{
+			size, err := m.Rx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Rx1DataRateOffset != nil {
+		{
+			size, err := m.Rx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Rx1Delay != nil {
+		{
+			size, err := m.Rx1Delay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ClassCTimeout != nil {
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintEndDevice(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PingSlotFrequency != nil {
+		{
+			size, err := m.PingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PingSlotDataRateIndex != nil {
+		{
+			size, err := m.PingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PingSlotPeriodicity != nil {
+		{
+			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassBTimeout != nil {
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
+		if err41 != nil {
+			return 0, err41
 		}
+		i -= n41
+		i = encodeVarintEndDevice(dAtA, i, uint64(n41))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConvertEndDeviceTemplateRequest) Marshal() (dAtA []byte, err error) {
+func (m *ADRSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6958,2062 +6126,4779 @@ func (m *ConvertEndDeviceTemplateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConvertEndDeviceTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ADRSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConvertEndDeviceTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ADRSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	if m.Strategy != nil {
+		{
+			size := m.Strategy.Size()
+			i -= size
+			if _, err := m.Strategy.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if len(m.FormatID) > 0 {
-		i -= len(m.FormatID)
-		copy(dAtA[i:], m.FormatID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FormatID)))
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSettings_Dynamic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_Dynamic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Dynamic != nil {
+		{
+			size, err := m.Dynamic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ADRSettings_LossBased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEndDevice(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ADRSettings_LossBased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LossBased != nil {
+		{
+			size, err := m.LossBased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
-	this := &Session{}
-	v1 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	this.DevAddr = *v1
-	v2 := NewPopulatedSessionKeys(r, easy)
-	this.SessionKeys = *v2
-	this.LastFCntUp = r.Uint32()
-	this.LastNFCntDown = r.Uint32()
-	this.LastAFCntDown = r.Uint32()
-	this.LastConfFCntDown = r.Uint32()
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.StartedAt = *v3
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v4)
-		for i := 0; i < v4; i++ {
-			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
+func (m *ADRSettings_Mobile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_Mobile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mobile != nil {
+		{
+			size, err := m.Mobile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *ADRSettings_Static) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedBoolValue(r randyEndDevice, easy bool) *BoolValue {
-	this := &BoolValue{}
-	this.Value = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *ADRSettings_Static) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Static != nil {
+		{
+			size, err := m.Static.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *ADRSettings_DynamicStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
-	this := &MACSettings{}
-	if r.Intn(5) != 0 {
-		this.ClassBTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+func (m *ADRSettings_DynamicStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_DynamicStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Margin != nil {
+		{
+			size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotPeriodicity = NewPopulatedPingSlotPeriodValue(r, easy)
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSettings_LossBasedStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	return dAtA[:n], nil
+}
+
+func (m *ADRSettings_LossBasedStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_LossBasedStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLossRate != nil {
+		{
+			size, err := m.MaxLossRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotFrequency = NewPopulatedFrequencyValue(r, easy)
+	if m.Margin != nil {
+		{
+			size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if r.Intn(5) != 0 {
-		this.ClassCTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSettings_MobileStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		this.Rx1Delay = NewPopulatedRxDelayValue(r, easy)
+	return dAtA[:n], nil
+}
+
+func (m *ADRSettings_MobileStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_MobileStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Margin != nil {
+		{
+			size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if r.Intn(5) != 0 {
-		this.Rx1DataRateOffset = NewPopulatedDataRateOffsetValue(r, easy)
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSettings_StaticStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		this.Rx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	return dAtA[:n], nil
+}
+
+func (m *ADRSettings_StaticStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSettings_StaticStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.NbTrans))
+		i--
+		dAtA[i] = 0x18
 	}
-	if r.Intn(5) != 0 {
-		this.Rx2Frequency = NewPopulatedFrequencyValue(r, easy)
+	if m.TxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.TxPowerIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	v5 := r.Intn(10)
-	this.FactoryPresetFrequencies = make([]uint64, v5)
-	for i := 0; i < v5; i++ {
-		this.FactoryPresetFrequencies[i] = uint64(r.Uint32())
+	if m.DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	if r.Intn(5) != 0 {
-		this.MaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		this.Supports32BitFCnt = NewPopulatedBoolValue(r, easy)
+	return dAtA[:n], nil
+}
+
+func (m *ADRDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.NbTrans))
+		i--
+		dAtA[i] = 0x50
 	}
-	if r.Intn(5) != 0 {
-		this.UseADR = NewPopulatedBoolValue(r, easy)
+	if m.TxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.TxPowerIndex))
+		i--
+		dAtA[i] = 0x48
 	}
-	if r.Intn(5) != 0 {
-		this.ADRMargin = types.NewPopulatedFloatValue(r, easy)
+	if m.DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x40
 	}
-	if r.Intn(5) != 0 {
-		this.ResetsFCnt = NewPopulatedBoolValue(r, easy)
+	if m.LossRate != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.LossRate)))
+		i--
+		dAtA[i] = 0x3d
 	}
-	if r.Intn(5) != 0 {
-		this.StatusTimePeriodicity = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	if m.RemainingMargin != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.RemainingMargin)))
+		i--
+		dAtA[i] = 0x35
 	}
-	if r.Intn(5) != 0 {
-		this.StatusCountPeriodicity = types.NewPopulatedUInt32Value(r, easy)
+	if m.Margin != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Margin)))
+		i--
+		dAtA[i] = 0x2d
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx1Delay = NewPopulatedRxDelayValue(r, easy)
+	if m.DemodulationFloor != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.DemodulationFloor)))
+		i--
+		dAtA[i] = 0x25
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx1DataRateOffset = NewPopulatedDataRateOffsetValue(r, easy)
+	if m.SNR != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.SNR)))
+		i--
+		dAtA[i] = 0x1d
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	if m.UplinkCount != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.UplinkCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx2Frequency = NewPopulatedFrequencyValue(r, easy)
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredMaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredADRAckLimitExponent = NewPopulatedADRAckLimitExponentValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredADRAckDelayExponent = NewPopulatedADRAckDelayExponentValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.BeaconFrequency = NewPopulatedFrequencyValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredPingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredPingSlotFrequency = NewPopulatedFrequencyValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredBeaconFrequency = NewPopulatedFrequencyValue(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DesiredMaxEirp = NewPopulatedDeviceEIRPValue(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedMACState_JoinRequest(r randyEndDevice, easy bool) *MACState_JoinRequest {
-	this := &MACState_JoinRequest{}
-	v6 := NewPopulatedDLSettings(r, easy)
-	this.DownlinkSettings = *v6
-	this.RxDelay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if r.Intn(5) != 0 {
-		this.CFList = NewPopulatedCFList(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
-	this := &MACState_JoinAccept{}
-	v7 := r.Intn(100)
-	this.Payload = make([]byte, v7)
-	for i := 0; i < v7; i++ {
-		this.Payload[i] = byte(r.Intn(256))
-	}
-	v8 := NewPopulatedMACState_JoinRequest(r, easy)
-	this.Request = *v8
-	v9 := NewPopulatedSessionKeys(r, easy)
-	this.Keys = *v9
-	v10 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v10)
-	for i := 0; i < v10; i++ {
-		this.CorrelationIDs[i] = randStringEndDevice(r)
-	}
-	v11 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	this.DevAddr = *v11
-	v12 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
-	this.NetID = *v12
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACState_DataRateRange(r randyEndDevice, easy bool) *MACState_DataRateRange {
-	this := &MACState_DataRateRange{}
-	this.MinDataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.MaxDataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastADRChangeFCntUp != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRChangeFCntUp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	return this
-}
-
-func NewPopulatedMACState_DataRateRanges(r randyEndDevice, easy bool) *MACState_DataRateRanges {
-	this := &MACState_DataRateRanges{}
-	if r.Intn(5) != 0 {
-		v13 := r.Intn(5)
-		this.Ranges = make([]*MACState_DataRateRange, v13)
-		for i := 0; i < v13; i++ {
-			this.Ranges[i] = NewPopulatedMACState_DataRateRange(r, easy)
+	if len(m.RejectedDataRateRanges) > 0 {
+		for k := range m.RejectedDataRateRanges {
+			v := m.RejectedDataRateRanges[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintEndDevice(dAtA, i, k)
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedEndDeviceAuthenticationCode(r randyEndDevice, easy bool) *EndDeviceAuthenticationCode {
-	this := &EndDeviceAuthenticationCode{}
-	this.Value = randStringEndDevice(r)
-	if r.Intn(5) != 0 {
-		this.ValidFrom = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.ValidTo = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+	if m.LastDownlinkAt != nil {
+		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt):])
+		if err51 != nil {
+			return 0, err51
+		}
+		i -= n51
+		i = encodeVarintEndDevice(dAtA, i, uint64(n51))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return this
-}
-
-func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
-	this := &EndDevice{}
-	v14 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v14
-	v15 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v15
-	v16 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v16
-	this.Name = randStringEndDevice(r)
-	this.Description = randStringEndDevice(r)
-	if r.Intn(5) != 0 {
-		v17 := r.Intn(10)
-		this.Attributes = make(map[string]string)
-		for i := 0; i < v17; i++ {
-			this.Attributes[randStringEndDevice(r)] = randStringEndDevice(r)
+	if len(m.RejectedFrequencies) > 0 {
+		dAtA53 := make([]byte, len(m.RejectedFrequencies)*10)
+		var j52 int
+		for _, num := range m.RejectedFrequencies {
+			for num >= 1<<7 {
+				dAtA53[j52] = uint8(num&0x7f | 0x80)
+				num >>= 7
+				j52++
+			}
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j52))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if r.Intn(5) != 0 {
-		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	if len(m.RejectedADRTxPowerIndexes) > 0 {
+		dAtA55 := make([]byte, len(m.RejectedADRTxPowerIndexes)*10)
+		var j54 int
+		for _, num := range m.RejectedADRTxPowerIndexes {
+			for num >= 1<<7 {
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j54++
+			}
+			dAtA55[j54] = uint8(num)
+			j54++
+		}
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j54))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	this.ServiceProfileID = randStringEndDevice(r)
-	this.NetworkServerAddress = randStringEndDevice(r)
-	this.ApplicationServerAddress = randStringEndDevice(r)
-	this.JoinServerAddress = randStringEndDevice(r)
-	if r.Intn(5) != 0 {
-		v18 := r.Intn(10)
-		this.Locations = make(map[string]*Location)
-		for i := 0; i < v18; i++ {
-			this.Locations[randStringEndDevice(r)] = NewPopulatedLocation(r, easy)
+	if len(m.RejectedADRDataRateIndexes) > 0 {
+		dAtA57 := make([]byte, len(m.RejectedADRDataRateIndexes)*10)
+		var j56 int
+		for _, num := range m.RejectedADRDataRateIndexes {
+			for num >= 1<<7 {
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j56++
+			}
+			dAtA57[j56] = uint8(num)
+			j56++
 		}
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j56))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	this.SupportsClassB = bool(r.Intn(2) == 0)
-	this.SupportsClassC = bool(r.Intn(2) == 0)
-	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
-	this.LoRaWANPHYVersion = PHYVersion([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	this.FrequencyPlanID = randStringEndDevice(r)
-	this.MinFrequency = uint64(r.Uint32())
-	this.MaxFrequency = uint64(r.Uint32())
-	this.SupportsJoin = bool(r.Intn(2) == 0)
-	this.ResetsJoinNonces = bool(r.Intn(2) == 0)
-	if r.Intn(5) != 0 {
-		this.RootKeys = NewPopulatedRootKeys(r, easy)
+	if m.LastNetworkInitiatedDownlinkAt != nil {
+		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
+		if err58 != nil {
+			return 0, err58
+		}
+		i -= n58
+		i = encodeVarintEndDevice(dAtA, i, uint64(n58))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	this.NetID = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
-	if r.Intn(5) != 0 {
-		this.MACSettings = NewPopulatedMACSettings(r, easy)
+	if len(m.RecentDownlinks) > 0 {
+		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.MACState = NewPopulatedMACState(r, easy)
+	if len(m.RecentUplinks) > 0 {
+		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.Session = NewPopulatedSession(r, easy)
+	if m.RxWindowsAvailable {
+		i--
+		if m.RxWindowsAvailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if r.Intn(5) != 0 {
-		this.PendingSession = NewPopulatedSession(r, easy)
-	}
-	this.LastDevNonce = r.Uint32()
-	v19 := r.Intn(10)
-	this.UsedDevNonces = make([]uint32, v19)
-	for i := 0; i < v19; i++ {
-		this.UsedDevNonces[i] = r.Uint32()
-	}
-	this.LastJoinNonce = r.Uint32()
-	this.LastRJCount0 = r.Uint32()
-	this.LastRJCount1 = r.Uint32()
-	if r.Intn(5) != 0 {
-		this.LastDevStatusReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	this.PowerState = PowerState([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(5) != 0 {
-		this.BatteryPercentage = types.NewPopulatedFloatValue(r, easy)
+	if m.PendingJoinRequest != nil {
+		{
+			size, err := m.PendingJoinRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	this.DownlinkMargin = r.Int31()
-	if r.Intn(2) == 0 {
-		this.DownlinkMargin *= -1
+	if m.QueuedJoinAccept != nil {
+		{
+			size, err := m.QueuedJoinAccept.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v20)
-		for i := 0; i < v20; i++ {
-			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
+	if len(m.PendingRequests) > 0 {
+		for iNdEx := len(m.PendingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	if r.Intn(5) != 0 {
-		this.Formatters = NewPopulatedMessagePayloadFormatters(r, easy)
+	if len(m.QueuedResponses) > 0 {
+		for iNdEx := len(m.QueuedResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	this.ProvisionerID = randStringEndDevice(r)
-	if r.Intn(5) != 0 {
-		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
+	if m.PendingApplicationDownlink != nil {
+		{
+			size, err := m.PendingApplicationDownlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if r.Intn(5) != 0 {
-		this.PendingMACState = NewPopulatedMACState(r, easy)
+	if m.PingSlotPeriodicity != nil {
+		{
+			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	this.Multicast = bool(r.Intn(2) == 0)
-	if r.Intn(5) != 0 {
-		this.ClaimAuthenticationCode = NewPopulatedEndDeviceAuthenticationCode(r, easy)
+	if m.LastDevStatusFCntUp != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevStatusFCntUp))
+		i--
+		dAtA[i] = 0x30
 	}
-	this.NetworkServerKEKLabel = randStringEndDevice(r)
-	this.ApplicationServerKEKLabel = randStringEndDevice(r)
-	this.ApplicationServerID = randStringEndDevice(r)
-	if r.Intn(5) != 0 {
-		this.Picture = NewPopulatedPicture(r, easy)
+	if m.LastConfirmedDownlinkAt != nil {
+		n63, err63 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
+		if err63 != nil {
+			return 0, err63
+		}
+		i -= n63
+		i = encodeVarintEndDevice(dAtA, i, uint64(n63))
+		i--
+		dAtA[i] = 0x2a
 	}
-	this.SkipPayloadCrypto = bool(r.Intn(2) == 0)
-	if r.Intn(5) != 0 {
-		this.SkipPayloadCryptoOverride = types.NewPopulatedBoolValue(r, easy)
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x20
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.DeviceClass != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DeviceClass))
+		i--
+		dAtA[i] = 0x18
 	}
-	return this
-}
-
-func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
-	this := &EndDevices{}
-	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v21)
-		for i := 0; i < v21; i++ {
-			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
+	{
+		size, err := m.DesiredParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if !easy && r.Intn(10) != 0 {
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrentParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedDevAddrPrefix(r randyEndDevice, easy bool) *DevAddrPrefix {
-	this := &DevAddrPrefix{}
-	this.DevAddr = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	this.Length = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_JoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
-	this := &CreateEndDeviceRequest{}
-	v22 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v22
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACState_JoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
-	this := &UpdateEndDeviceRequest{}
-	v23 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v23
-	v24 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v24
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_JoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CFList != nil {
+		{
+			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return this
+	if m.RxDelay != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.RxDelay))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.DownlinkSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
-	this := &GetEndDeviceRequest{}
-	v25 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v25
-	v26 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v26
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_JoinAccept) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
-	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v27 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEui = *v27
-	v28 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.DevEui = *v28
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
-	this := &ListEndDevicesRequest{}
-	v29 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v29
-	v30 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v30
-	this.Order = randStringEndDevice(r)
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_JoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CorrelationIDs) > 0 {
+		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrelationIDs[iNdEx])
+			copy(dAtA[i:], m.CorrelationIDs[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.CorrelationIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Keys.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
-	this := &SetEndDeviceRequest{}
-	v31 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v31
-	v32 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v32
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_DataRateRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedResetAndGetEndDeviceRequest(r randyEndDevice, easy bool) *ResetAndGetEndDeviceRequest {
-	this := &ResetAndGetEndDeviceRequest{}
-	v33 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v33
-	v34 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v34
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACState_DataRateRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
-	this := &EndDeviceTemplate{}
-	v35 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v35
-	v36 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v36
-	this.MappingKey = randStringEndDevice(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_DataRateRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return this
+	if m.MinDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDeviceTemplateFormat {
-	this := &EndDeviceTemplateFormat{}
-	this.Name = randStringEndDevice(r)
-	this.Description = randStringEndDevice(r)
-	v37 := r.Intn(10)
-	this.FileExtensions = make([]string, v37)
-	for i := 0; i < v37; i++ {
-		this.FileExtensions[i] = randStringEndDevice(r)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACState_DataRateRanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
-	this := &EndDeviceTemplateFormats{}
-	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
-		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v38; i++ {
-			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
+func (m *MACState_DataRateRanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACState_DataRateRanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
-	this := &ConvertEndDeviceTemplateRequest{}
-	this.FormatID = randStringEndDevice(r)
-	v39 := r.Intn(100)
-	this.Data = make([]byte, v39)
-	for i := 0; i < v39; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyEndDevice interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneEndDevice(r randyEndDevice) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidTo != nil {
+		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
+		if err70 != nil {
+			return 0, err70
+		}
+		i -= n70
+		i = encodeVarintEndDevice(dAtA, i, uint64(n70))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return rune(ru + 61)
-}
-func randStringEndDevice(r randyEndDevice) string {
-	v40 := r.Intn(100)
-	tmps := make([]rune, v40)
-	for i := 0; i < v40; i++ {
-		tmps[i] = randUTF8RuneEndDevice(r)
+	if m.ValidFrom != nil {
+		n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
+		if err71 != nil {
+			return 0, err71
+		}
+		i -= n71
+		i = encodeVarintEndDevice(dAtA, i, uint64(n71))
+		i--
+		dAtA[i] = 0x12
 	}
-	return string(tmps)
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func randUnrecognizedEndDevice(r randyEndDevice, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldEndDevice(dAtA, r, fieldNumber, wire)
+
+func (m *EndDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return dAtA
-}
-func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v41 := r.Int63()
-		if r.Intn(2) == 0 {
-			v41 *= -1
-		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v41))
-	case 1:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
-}
-func encodeVarintPopulateEndDevice(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *Session) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DevAddr.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.SessionKeys.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.LastFCntUp != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastFCntUp))
-	}
-	if m.LastNFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastNFCntDown))
-	}
-	if m.LastAFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastAFCntDown))
-	}
-	if m.LastConfFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastConfFCntDown))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for _, e := range m.QueuedApplicationDownlinks {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BoolValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value {
-		n += 2
-	}
-	return n
+func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACParameters) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxEIRP != 0 {
-		n += 5
-	}
-	if m.ADRDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRDataRateIndex))
-	}
-	if m.ADRTxPowerIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRTxPowerIndex))
-	}
-	if m.ADRNbTrans != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRNbTrans))
-	}
-	if m.ADRAckLimit != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRAckLimit))
-	}
-	if m.ADRAckDelay != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRAckDelay))
+	if m.SkipPayloadCryptoOverride != nil {
+		{
+			size, err := m.SkipPayloadCryptoOverride.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.Rx1Delay != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx1Delay))
+	if m.SkipPayloadCrypto {
+		i--
+		if m.SkipPayloadCrypto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.Rx1DataRateOffset != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx1DataRateOffset))
+	if m.Picture != nil {
+		{
+			size, err := m.Picture.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx2DataRateIndex))
+	if len(m.ApplicationServerID) > 0 {
+		i -= len(m.ApplicationServerID)
+		copy(dAtA[i:], m.ApplicationServerID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovEndDevice(m.Rx2Frequency)
+	if len(m.ApplicationServerKEKLabel) > 0 {
+		i -= len(m.ApplicationServerKEKLabel)
+		copy(dAtA[i:], m.ApplicationServerKEKLabel)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerKEKLabel)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.MaxDutyCycle != 0 {
-		n += 1 + sovEndDevice(uint64(m.MaxDutyCycle))
+	if len(m.NetworkServerKEKLabel) > 0 {
+		i -= len(m.NetworkServerKEKLabel)
+		copy(dAtA[i:], m.NetworkServerKEKLabel)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerKEKLabel)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
 	}
-	if m.RejoinTimePeriodicity != 0 {
-		n += 1 + sovEndDevice(uint64(m.RejoinTimePeriodicity))
+	if m.ClaimAuthenticationCode != nil {
+		{
+			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
 	}
-	if m.RejoinCountPeriodicity != 0 {
-		n += 1 + sovEndDevice(uint64(m.RejoinCountPeriodicity))
+	if m.Multicast {
+		i--
+		if m.Multicast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
 	}
-	if m.PingSlotFrequency != 0 {
-		n += 2 + sovEndDevice(m.PingSlotFrequency)
+	if m.PendingMACState != nil {
+		{
+			size, err := m.PendingMACState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
 	}
-	if m.PingSlotDataRateIndex != 0 {
-		n += 2 + sovEndDevice(uint64(m.PingSlotDataRateIndex))
+	if m.ProvisioningData != nil {
+		{
+			size, err := m.ProvisioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.BeaconFrequency != 0 {
-		n += 2 + sovEndDevice(m.BeaconFrequency)
+	if len(m.ProvisionerID) > 0 {
+		i -= len(m.ProvisionerID)
+		copy(dAtA[i:], m.ProvisionerID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProvisionerID)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
 	}
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
+	if m.Formatters != nil {
+		{
+			size, err := m.Formatters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
 	}
-	if m.UplinkDwellTime != nil {
-		l = m.UplinkDwellTime.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DownlinkDwellTime != nil {
-		l = m.DownlinkDwellTime.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.ADRAckLimitExponent != nil {
-		l = m.ADRAckLimitExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	if m.ADRAckDelayExponent != nil {
-		l = m.ADRAckDelayExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if m.DownlinkMargin != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkMargin))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.PingSlotDataRateIndexValue != nil {
-		l = m.PingSlotDataRateIndexValue.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if m.BatteryPercentage != nil {
+		{
+			size, err := m.BatteryPercentage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
-}
-
-func (m *MACParameters_Channel) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PowerState != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PowerState))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	var l int
-	_ = l
-	if m.UplinkFrequency != 0 {
-		n += 1 + sovEndDevice(m.UplinkFrequency)
+	if m.LastDevStatusReceivedAt != nil {
+		n79, err79 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
+		if err79 != nil {
+			return 0, err79
+		}
+		i -= n79
+		i = encodeVarintEndDevice(dAtA, i, uint64(n79))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.DownlinkFrequency != 0 {
-		n += 1 + sovEndDevice(m.DownlinkFrequency)
+	if m.LastRJCount1 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount1))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.MinDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
+	if m.LastRJCount0 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount0))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if m.MaxDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+	if m.LastJoinNonce != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastJoinNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if m.EnableUplink {
-		n += 2
+	if len(m.UsedDevNonces) > 0 {
+		dAtA81 := make([]byte, len(m.UsedDevNonces)*10)
+		var j80 int
+		for _, num := range m.UsedDevNonces {
+			for num >= 1<<7 {
+				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j80++
+			}
+			dAtA81[j80] = uint8(num)
+			j80++
+		}
+		i -= j80
+		copy(dAtA[i:], dAtA81[:j80])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j80))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return n
-}
-
-func (m *EndDeviceVersion) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LastDevNonce != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	var l int
-	_ = l
-	l = m.EndDeviceVersionIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	if m.PendingSession != nil {
+		{
+			size, err := m.PendingSession.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.LoRaWANPHYVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.MACState != nil {
+		{
+			size, err := m.MACState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if len(m.Photos) > 0 {
-		for _, s := range m.Photos {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
+	if m.MACSettings != nil {
+		{
+			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.SupportsClassB {
-		n += 2
+	if m.NetID != nil {
+		{
+			size := m.NetID.Size()
+			i -= size
+			if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.SupportsClassC {
-		n += 2
+	if m.RootKeys != nil {
+		{
+			size, err := m.RootKeys.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.DefaultMACSettings != nil {
-		l = m.DefaultMACSettings.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.ResetsJoinNonces {
+		i--
+		if m.ResetsJoinNonces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.MinFrequency != 0 {
-		n += 1 + sovEndDevice(m.MinFrequency)
+	if m.SupportsJoin {
+		i--
+		if m.SupportsJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
 	if m.MaxFrequency != 0 {
-		n += 1 + sovEndDevice(m.MaxFrequency)
+		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.SupportsJoin {
-		n += 2
+	if m.MinFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.ResetsJoinNonces {
-		n += 2
-	}
-	l = m.DefaultFormatters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
-}
-
-func (m *MACSettings) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	var l int
-	_ = l
-	if m.ClassBTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout)
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.PingSlotPeriodicity != nil {
-		l = m.PingSlotPeriodicity.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.PingSlotDataRateIndex != nil {
-		l = m.PingSlotDataRateIndex.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.SupportsClassC {
+		i--
+		if m.SupportsClassC {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.PingSlotFrequency != nil {
-		l = m.PingSlotFrequency.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.SupportsClassB {
+		i--
+		if m.SupportsClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.ClassCTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout)
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Locations) > 0 {
+		for k := range m.Locations {
+			v := m.Locations[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.Rx1Delay != nil {
-		l = m.Rx1Delay.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.JoinServerAddress) > 0 {
+		i -= len(m.JoinServerAddress)
+		copy(dAtA[i:], m.JoinServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.JoinServerAddress)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.Rx1DataRateOffset != nil {
-		l = m.Rx1DataRateOffset.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.ApplicationServerAddress) > 0 {
+		i -= len(m.ApplicationServerAddress)
+		copy(dAtA[i:], m.ApplicationServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerAddress)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Rx2DataRateIndex != nil {
-		l = m.Rx2DataRateIndex.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.NetworkServerAddress) > 0 {
+		i -= len(m.NetworkServerAddress)
+		copy(dAtA[i:], m.NetworkServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerAddress)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Rx2Frequency != nil {
-		l = m.Rx2Frequency.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.ServiceProfileID) > 0 {
+		i -= len(m.ServiceProfileID)
+		copy(dAtA[i:], m.ServiceProfileID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ServiceProfileID)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.FactoryPresetFrequencies) > 0 {
-		l = 0
-		for _, e := range m.FactoryPresetFrequencies {
-			l += sovEndDevice(e)
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		n += 1 + sovEndDevice(uint64(l)) + l
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.MaxDutyCycle != nil {
-		l = m.MaxDutyCycle.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Supports32BitFCnt != nil {
-		l = m.Supports32BitFCnt.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.UseADR != nil {
-		l = m.UseADR.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ADRMargin != nil {
-		l = m.ADRMargin.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err89 != nil {
+		return 0, err89
 	}
-	if m.ResetsFCnt != nil {
-		l = m.ResetsFCnt.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	i -= n89
+	i = encodeVarintEndDevice(dAtA, i, uint64(n89))
+	i--
+	dAtA[i] = 0x1a
+	n90, err90 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err90 != nil {
+		return 0, err90
 	}
-	if m.StatusTimePeriodicity != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity)
-		n += 2 + l + sovEndDevice(uint64(l))
+	i -= n90
+	i = encodeVarintEndDevice(dAtA, i, uint64(n90))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.StatusCountPeriodicity != nil {
-		l = m.StatusCountPeriodicity.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDevices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DesiredRx1Delay != nil {
-		l = m.DesiredRx1Delay.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EndDevices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndDevices) > 0 {
+		for iNdEx := len(m.EndDevices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndDevices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.DesiredRx1DataRateOffset != nil {
-		l = m.DesiredRx1DataRateOffset.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DevAddrPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DesiredRx2DataRateIndex != nil {
-		l = m.DesiredRx2DataRateIndex.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredRx2Frequency != nil {
-		l = m.DesiredRx2Frequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredMaxDutyCycle != nil {
-		l = m.DesiredMaxDutyCycle.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredADRAckLimitExponent != nil {
-		l = m.DesiredADRAckLimitExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredADRAckDelayExponent != nil {
-		l = m.DesiredADRAckDelayExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.BeaconFrequency != nil {
-		l = m.BeaconFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredPingSlotDataRateIndex != nil {
-		l = m.DesiredPingSlotDataRateIndex.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredPingSlotFrequency != nil {
-		l = m.DesiredPingSlotFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredBeaconFrequency != nil {
-		l = m.DesiredBeaconFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredMaxEirp != nil {
-		l = m.DesiredMaxEirp.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DevAddrPrefix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevAddrPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CurrentParameters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.DesiredParameters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.DeviceClass != 0 {
-		n += 1 + sovEndDevice(uint64(m.DeviceClass))
-	}
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
-	}
-	if m.LastConfirmedDownlinkAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.LastDevStatusFCntUp != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastDevStatusFCntUp))
-	}
-	if m.PingSlotPeriodicity != nil {
-		l = m.PingSlotPeriodicity.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingApplicationDownlink != nil {
-		l = m.PendingApplicationDownlink.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if len(m.QueuedResponses) > 0 {
-		for _, e := range m.QueuedResponses {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	if len(m.PendingRequests) > 0 {
-		for _, e := range m.PendingRequests {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	if m.QueuedJoinAccept != nil {
-		l = m.QueuedJoinAccept.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingJoinRequest != nil {
-		l = m.PendingJoinRequest.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.RxWindowsAvailable {
-		n += 2
-	}
-	if len(m.RecentUplinks) > 0 {
-		for _, e := range m.RecentUplinks {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	if len(m.RecentDownlinks) > 0 {
-		for _, e := range m.RecentDownlinks {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	if m.LastNetworkInitiatedDownlinkAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt)
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if len(m.RejectedADRDataRateIndexes) > 0 {
-		l = 0
-		for _, e := range m.RejectedADRDataRateIndexes {
-			l += sovEndDevice(uint64(e))
-		}
-		n += 2 + sovEndDevice(uint64(l)) + l
-	}
-	if len(m.RejectedADRTxPowerIndexes) > 0 {
-		l = 0
-		for _, e := range m.RejectedADRTxPowerIndexes {
-			l += sovEndDevice(uint64(e))
-		}
-		n += 2 + sovEndDevice(uint64(l)) + l
-	}
-	if len(m.RejectedFrequencies) > 0 {
-		l = 0
-		for _, e := range m.RejectedFrequencies {
-			l += sovEndDevice(e)
-		}
-		n += 2 + sovEndDevice(uint64(l)) + l
-	}
-	if m.LastDownlinkAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt)
-		n += 2 + l + sovEndDevice(uint64(l))
+	if m.Length != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.RejectedDataRateRanges) > 0 {
-		for k, v := range m.RejectedDataRateRanges {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovEndDevice(uint64(l))
+	if m.DevAddr != nil {
+		{
+			size := m.DevAddr.Size()
+			i -= size
+			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + sovEndDevice(k) + l
-			n += mapEntrySize + 2 + sovEndDevice(uint64(mapEntrySize))
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.LastADRChangeFCntUp != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastADRChangeFCntUp))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACState_JoinRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DownlinkSettings.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.RxDelay != 0 {
-		n += 1 + sovEndDevice(uint64(m.RxDelay))
-	}
-	if m.CFList != nil {
-		l = m.CFList.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+func (m *CreateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACState_JoinAccept) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = m.Request.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.Keys.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if len(m.CorrelationIDs) > 0 {
-		for _, s := range m.CorrelationIDs {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = m.DevAddr.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.NetID.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACState_DataRateRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
-	}
-	if m.MaxDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+func (m *UpdateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACState_DataRateRanges) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ranges) > 0 {
-		for _, e := range m.Ranges {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
+func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceAuthenticationCode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.ValidFrom != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.ValidTo != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDevice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	{
+		size := m.DevEui.Size()
+		i -= size
+		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.VersionIDs != nil {
-		l = m.VersionIDs.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEui.Size()
+		i -= size
+		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = len(m.ServiceProfileID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NetworkServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ApplicationServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.JoinServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Locations) > 0 {
-		for k, v := range m.Locations {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovEndDevice(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.SupportsClassB {
-		n += 2
-	}
-	if m.SupportsClassC {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.LoRaWANPHYVersion != 0 {
-		n += 2 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	return dAtA[:n], nil
+}
+
+func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.MinFrequency != 0 {
-		n += 2 + sovEndDevice(m.MinFrequency)
-	}
-	if m.MaxFrequency != 0 {
-		n += 2 + sovEndDevice(m.MaxFrequency)
-	}
-	if m.SupportsJoin {
-		n += 3
-	}
-	if m.ResetsJoinNonces {
-		n += 3
-	}
-	if m.RootKeys != nil {
-		l = m.RootKeys.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.NetID != nil {
-		l = m.NetID.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.MACSettings != nil {
-		l = m.MACSettings.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.MACState != nil {
-		l = m.MACState.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingSession != nil {
-		l = m.PendingSession.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.LastDevNonce != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastDevNonce))
-	}
-	if len(m.UsedDevNonces) > 0 {
-		l = 0
-		for _, e := range m.UsedDevNonces {
-			l += sovEndDevice(uint64(e))
-		}
-		n += 2 + sovEndDevice(uint64(l)) + l
-	}
-	if m.LastJoinNonce != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastJoinNonce))
-	}
-	if m.LastRJCount0 != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastRJCount0))
-	}
-	if m.LastRJCount1 != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastRJCount1))
-	}
-	if m.LastDevStatusReceivedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.PowerState != 0 {
-		n += 2 + sovEndDevice(uint64(m.PowerState))
-	}
-	if m.BatteryPercentage != nil {
-		l = m.BatteryPercentage.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DownlinkMargin != 0 {
-		n += 2 + sovEndDevice(uint64(m.DownlinkMargin))
-	}
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for _, e := range m.QueuedApplicationDownlinks {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.Formatters != nil {
-		l = m.Formatters.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.ProvisioningData != nil {
-		l = m.ProvisioningData.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingMACState != nil {
-		l = m.PendingMACState.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.Multicast {
-		n += 3
-	}
-	if m.ClaimAuthenticationCode != nil {
-		l = m.ClaimAuthenticationCode.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.NetworkServerKEKLabel)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ApplicationServerKEKLabel)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ApplicationServerID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.Picture != nil {
-		l = m.Picture.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.SkipPayloadCrypto {
-		n += 3
-	}
-	if m.SkipPayloadCryptoOverride != nil {
-		l = m.SkipPayloadCryptoOverride.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDevices) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResetAndGetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetAndGetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetAndGetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.EndDevices) > 0 {
-		for _, e := range m.EndDevices {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DevAddrPrefix) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EndDeviceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DevAddr != nil {
-		l = m.DevAddr.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.MappingKey) > 0 {
+		i -= len(m.MappingKey)
+		copy(dAtA[i:], m.MappingKey)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.MappingKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Length != 0 {
-		n += 1 + sovEndDevice(uint64(m.Length))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *CreateEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EndDeviceTemplateFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
-}
-
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.JoinEui.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.DevEui.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+func (m *EndDeviceTemplateFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListEndDevicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EndDeviceTemplateFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.Order)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.FileExtensions) > 0 {
+		for iNdEx := len(m.FileExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileExtensions[iNdEx])
+			copy(dAtA[i:], m.FileExtensions[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FileExtensions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEndDevice(uint64(m.Limit))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Page != 0 {
-		n += 1 + sovEndDevice(uint64(m.Page))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EndDeviceTemplateFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetAndGetEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+func (m *EndDeviceTemplateFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDeviceTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EndDeviceTemplateFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.MappingKey)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceTemplateFormat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConvertEndDeviceTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertEndDeviceTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.FileExtensions) > 0 {
-		for _, s := range m.FileExtensions {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
+	if len(m.FormatID) > 0 {
+		i -= len(m.FormatID)
+		copy(dAtA[i:], m.FormatID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FormatID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceTemplateFormats) Size() (n int) {
-	if m == nil {
-		return 0
+func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEndDevice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k, v := range m.Formats {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovEndDevice(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
+	this := &Session{}
+	v1 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v1
+	v2 := NewPopulatedSessionKeys(r, easy)
+	this.SessionKeys = *v2
+	this.LastFCntUp = r.Uint32()
+	this.LastNFCntDown = r.Uint32()
+	this.LastAFCntDown = r.Uint32()
+	this.LastConfFCntDown = r.Uint32()
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v3
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v4)
+		for i := 0; i < v4; i++ {
+			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *ConvertEndDeviceTemplateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FormatID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+func NewPopulatedBoolValue(r randyEndDevice, easy bool) *BoolValue {
+	this := &BoolValue{}
+	this.Value = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func sovEndDevice(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEndDevice(x uint64) (n int) {
-	return sovEndDevice((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *Session) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
-	for _, f := range this.QueuedApplicationDownlinks {
-		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
+	this := &MACSettings{}
+	if r.Intn(5) != 0 {
+		this.ClassBTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	repeatedStringForQueuedApplicationDownlinks += "}"
-	s := strings.Join([]string{`&Session{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`SessionKeys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SessionKeys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
-		`LastFCntUp:` + fmt.Sprintf("%v", this.LastFCntUp) + `,`,
-		`LastNFCntDown:` + fmt.Sprintf("%v", this.LastNFCntDown) + `,`,
-		`LastAFCntDown:` + fmt.Sprintf("%v", this.LastAFCntDown) + `,`,
-		`LastConfFCntDown:` + fmt.Sprintf("%v", this.LastConfFCntDown) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BoolValue) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.PingSlotPeriodicity = NewPopulatedPingSlotPeriodValue(r, easy)
 	}
-	s := strings.Join([]string{`&BoolValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACParameters) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.PingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	repeatedStringForChannels := "[]*MACParameters_Channel{"
-	for _, f := range this.Channels {
-		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "MACParameters_Channel", "MACParameters_Channel", 1) + ","
+	if r.Intn(5) != 0 {
+		this.PingSlotFrequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	repeatedStringForChannels += "}"
-	s := strings.Join([]string{`&MACParameters{`,
-		`MaxEIRP:` + fmt.Sprintf("%v", this.MaxEIRP) + `,`,
-		`ADRDataRateIndex:` + fmt.Sprintf("%v", this.ADRDataRateIndex) + `,`,
-		`ADRTxPowerIndex:` + fmt.Sprintf("%v", this.ADRTxPowerIndex) + `,`,
-		`ADRNbTrans:` + fmt.Sprintf("%v", this.ADRNbTrans) + `,`,
-		`ADRAckLimit:` + fmt.Sprintf("%v", this.ADRAckLimit) + `,`,
-		`ADRAckDelay:` + fmt.Sprintf("%v", this.ADRAckDelay) + `,`,
-		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
-		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
-		`RejoinTimePeriodicity:` + fmt.Sprintf("%v", this.RejoinTimePeriodicity) + `,`,
-		`RejoinCountPeriodicity:` + fmt.Sprintf("%v", this.RejoinCountPeriodicity) + `,`,
-		`PingSlotFrequency:` + fmt.Sprintf("%v", this.PingSlotFrequency) + `,`,
-		`PingSlotDataRateIndex:` + fmt.Sprintf("%v", this.PingSlotDataRateIndex) + `,`,
-		`BeaconFrequency:` + fmt.Sprintf("%v", this.BeaconFrequency) + `,`,
-		`Channels:` + repeatedStringForChannels + `,`,
-		`UplinkDwellTime:` + strings.Replace(this.UplinkDwellTime.String(), "BoolValue", "BoolValue", 1) + `,`,
-		`DownlinkDwellTime:` + strings.Replace(this.DownlinkDwellTime.String(), "BoolValue", "BoolValue", 1) + `,`,
-		`ADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
-		`ADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
-		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACParameters_Channel) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.ClassCTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	s := strings.Join([]string{`&MACParameters_Channel{`,
-		`UplinkFrequency:` + fmt.Sprintf("%v", this.UplinkFrequency) + `,`,
-		`DownlinkFrequency:` + fmt.Sprintf("%v", this.DownlinkFrequency) + `,`,
-		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
-		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
-		`EnableUplink:` + fmt.Sprintf("%v", this.EnableUplink) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDeviceVersion) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.Rx1Delay = NewPopulatedRxDelayValue(r, easy)
 	}
-	s := strings.Join([]string{`&EndDeviceVersion{`,
-		`EndDeviceVersionIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceVersionIdentifiers), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1), `&`, ``, 1) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`Photos:` + fmt.Sprintf("%v", this.Photos) + `,`,
-		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
-		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
-		`DefaultMACSettings:` + strings.Replace(this.DefaultMACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
-		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
-		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
-		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
-		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
-		`DefaultFormatters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACSettings) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.Rx1DataRateOffset = NewPopulatedDataRateOffsetValue(r, easy)
 	}
-	s := strings.Join([]string{`&MACSettings{`,
-		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
-		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
-		`PingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`PingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`ClassCTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassCTimeout), "Duration", "types.Duration", 1) + `,`,
-		`Rx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.Rx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
-		`Rx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.Rx1DataRateOffset), "DataRateOffsetValue", "DataRateOffsetValue", 1) + `,`,
-		`Rx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.Rx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`Rx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.Rx2Frequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`FactoryPresetFrequencies:` + fmt.Sprintf("%v", this.FactoryPresetFrequencies) + `,`,
-		`MaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.MaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
-		`Supports32BitFCnt:` + strings.Replace(this.Supports32BitFCnt.String(), "BoolValue", "BoolValue", 1) + `,`,
-		`UseADR:` + strings.Replace(this.UseADR.String(), "BoolValue", "BoolValue", 1) + `,`,
-		`ADRMargin:` + strings.Replace(fmt.Sprintf("%v", this.ADRMargin), "FloatValue", "types.FloatValue", 1) + `,`,
-		`ResetsFCnt:` + strings.Replace(this.ResetsFCnt.String(), "BoolValue", "BoolValue", 1) + `,`,
-		`StatusTimePeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusTimePeriodicity), "Duration", "types.Duration", 1) + `,`,
-		`StatusCountPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusCountPeriodicity), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`DesiredRx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
-		`DesiredRx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1DataRateOffset), "DataRateOffsetValue", "DataRateOffsetValue", 1) + `,`,
-		`DesiredRx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`DesiredRx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2Frequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`DesiredMaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
-		`DesiredADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
-		`DesiredADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
-		`BeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
-		`DesiredMaxEirp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxEirp), "DeviceEIRPValue", "DeviceEIRPValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACState) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.Rx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	repeatedStringForQueuedResponses := "[]*MACCommand{"
-	for _, f := range this.QueuedResponses {
-		repeatedStringForQueuedResponses += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	if r.Intn(5) != 0 {
+		this.Rx2Frequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	repeatedStringForQueuedResponses += "}"
-	repeatedStringForPendingRequests := "[]*MACCommand{"
-	for _, f := range this.PendingRequests {
-		repeatedStringForPendingRequests += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	v5 := r.Intn(10)
+	this.FactoryPresetFrequencies = make([]uint64, v5)
+	for i := 0; i < v5; i++ {
+		this.FactoryPresetFrequencies[i] = uint64(r.Uint32())
 	}
-	repeatedStringForPendingRequests += "}"
-	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
-	for _, f := range this.RecentUplinks {
-		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	if r.Intn(5) != 0 {
+		this.MaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
 	}
-	repeatedStringForRecentUplinks += "}"
-	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
-	for _, f := range this.RecentDownlinks {
-		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	if r.Intn(5) != 0 {
+		this.Supports32BitFCnt = NewPopulatedBoolValue(r, easy)
 	}
-	repeatedStringForRecentDownlinks += "}"
-	keysForRejectedDataRateRanges := make([]uint64, 0, len(this.RejectedDataRateRanges))
-	for k := range this.RejectedDataRateRanges {
-		keysForRejectedDataRateRanges = append(keysForRejectedDataRateRanges, k)
+	if r.Intn(5) != 0 {
+		this.UseADR = NewPopulatedBoolValue(r, easy)
 	}
-	github_com_gogo_protobuf_sortkeys.Uint64s(keysForRejectedDataRateRanges)
-	mapStringForRejectedDataRateRanges := "map[uint64]*MACState_DataRateRanges{"
-	for _, k := range keysForRejectedDataRateRanges {
-		mapStringForRejectedDataRateRanges += fmt.Sprintf("%v: %v,", k, this.RejectedDataRateRanges[k])
+	if r.Intn(5) != 0 {
+		this.ADRMargin = types.NewPopulatedFloatValue(r, easy)
 	}
-	mapStringForRejectedDataRateRanges += "}"
-	s := strings.Join([]string{`&MACState{`,
-		`CurrentParameters:` + strings.Replace(strings.Replace(this.CurrentParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
-		`DesiredParameters:` + strings.Replace(strings.Replace(this.DesiredParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
-		`DeviceClass:` + fmt.Sprintf("%v", this.DeviceClass) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LastConfirmedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastConfirmedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastDevStatusFCntUp:` + fmt.Sprintf("%v", this.LastDevStatusFCntUp) + `,`,
-		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
-		`PendingApplicationDownlink:` + strings.Replace(fmt.Sprintf("%v", this.PendingApplicationDownlink), "ApplicationDownlink", "ApplicationDownlink", 1) + `,`,
-		`QueuedResponses:` + repeatedStringForQueuedResponses + `,`,
-		`PendingRequests:` + repeatedStringForPendingRequests + `,`,
-		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
-		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "MACState_JoinRequest", "MACState_JoinRequest", 1) + `,`,
-		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
-		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
-		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
-		`LastNetworkInitiatedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastNetworkInitiatedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`RejectedADRDataRateIndexes:` + fmt.Sprintf("%v", this.RejectedADRDataRateIndexes) + `,`,
-		`RejectedADRTxPowerIndexes:` + fmt.Sprintf("%v", this.RejectedADRTxPowerIndexes) + `,`,
-		`RejectedFrequencies:` + fmt.Sprintf("%v", this.RejectedFrequencies) + `,`,
-		`LastDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`RejectedDataRateRanges:` + mapStringForRejectedDataRateRanges + `,`,
-		`LastADRChangeFCntUp:` + fmt.Sprintf("%v", this.LastADRChangeFCntUp) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACState_JoinRequest) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.ResetsFCnt = NewPopulatedBoolValue(r, easy)
 	}
-	s := strings.Join([]string{`&MACState_JoinRequest{`,
-		`DownlinkSettings:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DownlinkSettings), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
-		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
-		`CFList:` + strings.Replace(fmt.Sprintf("%v", this.CFList), "CFList", "CFList", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACState_JoinAccept) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.StatusTimePeriodicity = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	s := strings.Join([]string{`&MACState_JoinAccept{`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`Request:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Request), "MACState_JoinRequest", "MACState_JoinRequest", 1), `&`, ``, 1) + `,`,
-		`Keys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Keys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
-		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACState_DataRateRange) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.StatusCountPeriodicity = types.NewPopulatedUInt32Value(r, easy)
 	}
-	s := strings.Join([]string{`&MACState_DataRateRange{`,
-		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
-		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACState_DataRateRanges) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.DesiredRx1Delay = NewPopulatedRxDelayValue(r, easy)
 	}
-	repeatedStringForRanges := "[]*MACState_DataRateRange{"
-	for _, f := range this.Ranges {
-		repeatedStringForRanges += strings.Replace(fmt.Sprintf("%v", f), "MACState_DataRateRange", "MACState_DataRateRange", 1) + ","
+	if r.Intn(5) != 0 {
+		this.DesiredRx1DataRateOffset = NewPopulatedDataRateOffsetValue(r, easy)
 	}
-	repeatedStringForRanges += "}"
-	s := strings.Join([]string{`&MACState_DataRateRanges{`,
-		`Ranges:` + repeatedStringForRanges + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDeviceAuthenticationCode) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.DesiredRx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDevice) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.DesiredRx2Frequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
-	for _, f := range this.QueuedApplicationDownlinks {
-		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	if r.Intn(5) != 0 {
+		this.DesiredMaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
 	}
-	repeatedStringForQueuedApplicationDownlinks += "}"
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
+	if r.Intn(5) != 0 {
+		this.DesiredADRAckLimitExponent = NewPopulatedADRAckLimitExponentValue(r, easy)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-	mapStringForAttributes := "map[string]string{"
-	for _, k := range keysForAttributes {
-		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	if r.Intn(5) != 0 {
+		this.DesiredADRAckDelayExponent = NewPopulatedADRAckDelayExponentValue(r, easy)
 	}
-	mapStringForAttributes += "}"
-	keysForLocations := make([]string, 0, len(this.Locations))
-	for k := range this.Locations {
-		keysForLocations = append(keysForLocations, k)
+	if r.Intn(5) != 0 {
+		this.BeaconFrequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLocations)
-	mapStringForLocations := "map[string]*Location{"
-	for _, k := range keysForLocations {
-		mapStringForLocations += fmt.Sprintf("%v: %v,", k, this.Locations[k])
+	if r.Intn(5) != 0 {
+		this.DesiredPingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	mapStringForLocations += "}"
-	s := strings.Join([]string{`&EndDevice{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Attributes:` + mapStringForAttributes + `,`,
-		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
-		`ServiceProfileID:` + fmt.Sprintf("%v", this.ServiceProfileID) + `,`,
-		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
-		`ApplicationServerAddress:` + fmt.Sprintf("%v", this.ApplicationServerAddress) + `,`,
-		`JoinServerAddress:` + fmt.Sprintf("%v", this.JoinServerAddress) + `,`,
-		`Locations:` + mapStringForLocations + `,`,
-		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
-		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
-		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
-		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
-		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
-		`RootKeys:` + strings.Replace(fmt.Sprintf("%v", this.RootKeys), "RootKeys", "RootKeys", 1) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`MACSettings:` + strings.Replace(this.MACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
-		`MACState:` + strings.Replace(this.MACState.String(), "MACState", "MACState", 1) + `,`,
-		`Session:` + strings.Replace(this.Session.String(), "Session", "Session", 1) + `,`,
-		`PendingSession:` + strings.Replace(this.PendingSession.String(), "Session", "Session", 1) + `,`,
-		`LastDevNonce:` + fmt.Sprintf("%v", this.LastDevNonce) + `,`,
-		`UsedDevNonces:` + fmt.Sprintf("%v", this.UsedDevNonces) + `,`,
-		`LastJoinNonce:` + fmt.Sprintf("%v", this.LastJoinNonce) + `,`,
-		`LastRJCount0:` + fmt.Sprintf("%v", this.LastRJCount0) + `,`,
-		`LastRJCount1:` + fmt.Sprintf("%v", this.LastRJCount1) + `,`,
-		`LastDevStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDevStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`PowerState:` + fmt.Sprintf("%v", this.PowerState) + `,`,
-		`BatteryPercentage:` + strings.Replace(fmt.Sprintf("%v", this.BatteryPercentage), "FloatValue", "types.FloatValue", 1) + `,`,
-		`DownlinkMargin:` + fmt.Sprintf("%v", this.DownlinkMargin) + `,`,
-		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
-		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
-		`PendingMACState:` + strings.Replace(this.PendingMACState.String(), "MACState", "MACState", 1) + `,`,
-		`Multicast:` + fmt.Sprintf("%v", this.Multicast) + `,`,
-		`ClaimAuthenticationCode:` + strings.Replace(this.ClaimAuthenticationCode.String(), "EndDeviceAuthenticationCode", "EndDeviceAuthenticationCode", 1) + `,`,
-		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
-		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
-		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
-		`Picture:` + strings.Replace(fmt.Sprintf("%v", this.Picture), "Picture", "Picture", 1) + `,`,
-		`SkipPayloadCrypto:` + fmt.Sprintf("%v", this.SkipPayloadCrypto) + `,`,
-		`SkipPayloadCryptoOverride:` + strings.Replace(fmt.Sprintf("%v", this.SkipPayloadCryptoOverride), "BoolValue", "types.BoolValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDevices) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.DesiredPingSlotFrequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	repeatedStringForEndDevices := "[]*EndDevice{"
-	for _, f := range this.EndDevices {
-		repeatedStringForEndDevices += strings.Replace(f.String(), "EndDevice", "EndDevice", 1) + ","
+	if r.Intn(5) != 0 {
+		this.DesiredBeaconFrequency = NewPopulatedFrequencyValue(r, easy)
 	}
-	repeatedStringForEndDevices += "}"
-	s := strings.Join([]string{`&EndDevices{`,
-		`EndDevices:` + repeatedStringForEndDevices + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DevAddrPrefix) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.DesiredMaxEirp = NewPopulatedDeviceEIRPValue(r, easy)
 	}
-	s := strings.Join([]string{`&DevAddrPrefix{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+	if r.Intn(5) != 0 {
+		this.ADR = NewPopulatedADRSettings(r, easy)
 	}
-	s := strings.Join([]string{`&CreateEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *UpdateEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRSettings(r randyEndDevice, easy bool) *ADRSettings {
+	this := &ADRSettings{}
+	oneofNumber_Strategy := []int32{1, 2, 3, 4}[r.Intn(4)]
+	switch oneofNumber_Strategy {
+	case 1:
+		this.Strategy = NewPopulatedADRSettings_Dynamic(r, easy)
+	case 2:
+		this.Strategy = NewPopulatedADRSettings_LossBased(r, easy)
+	case 3:
+		this.Strategy = NewPopulatedADRSettings_Mobile(r, easy)
+	case 4:
+		this.Strategy = NewPopulatedADRSettings_Static(r, easy)
 	}
-	s := strings.Join([]string{`&UpdateEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *GetEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRSettings_Dynamic(r randyEndDevice, easy bool) *ADRSettings_Dynamic {
+	this := &ADRSettings_Dynamic{}
+	this.Dynamic = NewPopulatedADRSettings_DynamicStrategy(r, easy)
+	return this
+}
+func NewPopulatedADRSettings_LossBased(r randyEndDevice, easy bool) *ADRSettings_LossBased {
+	this := &ADRSettings_LossBased{}
+	this.LossBased = NewPopulatedADRSettings_LossBasedStrategy(r, easy)
+	return this
+}
+func NewPopulatedADRSettings_Mobile(r randyEndDevice, easy bool) *ADRSettings_Mobile {
+	this := &ADRSettings_Mobile{}
+	this.Mobile = NewPopulatedADRSettings_MobileStrategy(r, easy)
+	return this
+}
+func NewPopulatedADRSettings_Static(r randyEndDevice, easy bool) *ADRSettings_Static {
+	this := &ADRSettings_Static{}
+	this.Static = NewPopulatedADRSettings_StaticStrategy(r, easy)
+	return this
+}
+func NewPopulatedADRSettings_DynamicStrategy(r randyEndDevice, easy bool) *ADRSettings_DynamicStrategy {
+	this := &ADRSettings_DynamicStrategy{}
+	if r.Intn(5) != 0 {
+		this.Margin = types.NewPopulatedFloatValue(r, easy)
 	}
-	s := strings.Join([]string{`&GetEndDeviceRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *GetEndDeviceIdentifiersForEUIsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRSettings_LossBasedStrategy(r randyEndDevice, easy bool) *ADRSettings_LossBasedStrategy {
+	this := &ADRSettings_LossBasedStrategy{}
+	if r.Intn(5) != 0 {
+		this.Margin = types.NewPopulatedFloatValue(r, easy)
 	}
-	s := strings.Join([]string{`&GetEndDeviceIdentifiersForEUIsRequest{`,
-		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
-		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
-		`}`,
-	}, "")
-	return s
+	if r.Intn(5) != 0 {
+		this.MaxLossRate = types.NewPopulatedFloatValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *ListEndDevicesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRSettings_MobileStrategy(r randyEndDevice, easy bool) *ADRSettings_MobileStrategy {
+	this := &ADRSettings_MobileStrategy{}
+	if r.Intn(5) != 0 {
+		this.Margin = types.NewPopulatedFloatValue(r, easy)
 	}
-	s := strings.Join([]string{`&ListEndDevicesRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *SetEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRSettings_StaticStrategy(r randyEndDevice, easy bool) *ADRSettings_StaticStrategy {
+	this := &ADRSettings_StaticStrategy{}
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.TxPowerIndex = r.Uint32()
+	this.NbTrans = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&SetEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *ResetAndGetEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRDecision(r randyEndDevice, easy bool) *ADRDecision {
+	this := &ADRDecision{}
+	this.Strategy = randStringEndDevice(r)
+	this.UplinkCount = r.Uint32()
+	this.SNR = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.SNR *= -1
 	}
-	s := strings.Join([]string{`&ResetAndGetEndDeviceRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	this.DemodulationFloor = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DemodulationFloor *= -1
+	}
+	this.Margin = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Margin *= -1
+	}
+	this.RemainingMargin = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.RemainingMargin *= -1
+	}
+	this.LossRate = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.LossRate *= -1
+	}
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.TxPowerIndex = r.Uint32()
+	this.NbTrans = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *EndDeviceTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACState_JoinRequest(r randyEndDevice, easy bool) *MACState_JoinRequest {
+	this := &MACState_JoinRequest{}
+	v6 := NewPopulatedDLSettings(r, easy)
+	this.DownlinkSettings = *v6
+	this.RxDelay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if r.Intn(5) != 0 {
+		this.CFList = NewPopulatedCFList(r, easy)
 	}
-	s := strings.Join([]string{`&EndDeviceTemplate{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`MappingKey:` + fmt.Sprintf("%v", this.MappingKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *EndDeviceTemplateFormat) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
+	this := &MACState_JoinAccept{}
+	v7 := r.Intn(100)
+	this.Payload = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.Payload[i] = byte(r.Intn(256))
 	}
-	s := strings.Join([]string{`&EndDeviceTemplateFormat{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`FileExtensions:` + fmt.Sprintf("%v", this.FileExtensions) + `,`,
-		`}`,
-	}, "")
-	return s
+	v8 := NewPopulatedMACState_JoinRequest(r, easy)
+	this.Request = *v8
+	v9 := NewPopulatedSessionKeys(r, easy)
+	this.Keys = *v9
+	v10 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v10)
+	for i := 0; i < v10; i++ {
+		this.CorrelationIDs[i] = randStringEndDevice(r)
+	}
+	v11 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v11
+	v12 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v12
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *EndDeviceTemplateFormats) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACState_DataRateRange(r randyEndDevice, easy bool) *MACState_DataRateRange {
+	this := &MACState_DataRateRange{}
+	this.MinDataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.MaxDataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	keysForFormats := make([]string, 0, len(this.Formats))
-	for k := range this.Formats {
-		keysForFormats = append(keysForFormats, k)
+	return this
+}
+
+func NewPopulatedMACState_DataRateRanges(r randyEndDevice, easy bool) *MACState_DataRateRanges {
+	this := &MACState_DataRateRanges{}
+	if r.Intn(5) != 0 {
+		v13 := r.Intn(5)
+		this.Ranges = make([]*MACState_DataRateRange, v13)
+		for i := 0; i < v13; i++ {
+			this.Ranges[i] = NewPopulatedMACState_DataRateRange(r, easy)
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
-	mapStringForFormats := "map[string]*EndDeviceTemplateFormat{"
-	for _, k := range keysForFormats {
-		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceAuthenticationCode(r randyEndDevice, easy bool) *EndDeviceAuthenticationCode {
+	this := &EndDeviceAuthenticationCode{}
+	this.Value = randStringEndDevice(r)
+	if r.Intn(5) != 0 {
+		this.ValidFrom = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ValidTo = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
+	this := &EndDevice{}
+	v14 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v14
+	v15 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v15
+	v16 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v16
+	this.Name = randStringEndDevice(r)
+	this.Description = randStringEndDevice(r)
+	if r.Intn(5) != 0 {
+		v17 := r.Intn(10)
+		this.Attributes = make(map[string]string)
+		for i := 0; i < v17; i++ {
+			this.Attributes[randStringEndDevice(r)] = randStringEndDevice(r)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.ServiceProfileID = randStringEndDevice(r)
+	this.NetworkServerAddress = randStringEndDevice(r)
+	this.ApplicationServerAddress = randStringEndDevice(r)
+	this.JoinServerAddress = randStringEndDevice(r)
+	if r.Intn(5) != 0 {
+		v18 := r.Intn(10)
+		this.Locations = make(map[string]*Location)
+		for i := 0; i < v18; i++ {
+			this.Locations[randStringEndDevice(r)] = NewPopulatedLocation(r, easy)
+		}
+	}
+	this.SupportsClassB = bool(r.Intn(2) == 0)
+	this.SupportsClassC = bool(r.Intn(2) == 0)
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.LoRaWANPHYVersion = PHYVersion([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	this.FrequencyPlanID = randStringEndDevice(r)
+	this.MinFrequency = uint64(r.Uint32())
+	this.MaxFrequency = uint64(r.Uint32())
+	this.SupportsJoin = bool(r.Intn(2) == 0)
+	this.ResetsJoinNonces = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.RootKeys = NewPopulatedRootKeys(r, easy)
+	}
+	this.NetID = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedNetID(r)
+	if r.Intn(5) != 0 {
+		this.MACSettings = NewPopulatedMACSettings(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MACState = NewPopulatedMACState(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Session = NewPopulatedSession(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.PendingSession = NewPopulatedSession(r, easy)
+	}
+	this.LastDevNonce = r.Uint32()
+	v19 := r.Intn(10)
+	this.UsedDevNonces = make([]uint32, v19)
+	for i := 0; i < v19; i++ {
+		this.UsedDevNonces[i] = r.Uint32()
+	}
+	this.LastJoinNonce = r.Uint32()
+	this.LastRJCount0 = r.Uint32()
+	this.LastRJCount1 = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LastDevStatusReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.PowerState = PowerState([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(5) != 0 {
+		this.BatteryPercentage = types.NewPopulatedFloatValue(r, easy)
+	}
+	this.DownlinkMargin = r.Int31()
+	if r.Intn(2) == 0 {
+		this.DownlinkMargin *= -1
+	}
+	if r.Intn(5) != 0 {
+		v20 := r.Intn(5)
+		this.QueuedApplicationDownlinks = make([]*ApplicationDownlink, v20)
+		for i := 0; i < v20; i++ {
+			this.QueuedApplicationDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Formatters = NewPopulatedMessagePayloadFormatters(r, easy)
+	}
+	this.ProvisionerID = randStringEndDevice(r)
+	if r.Intn(5) != 0 {
+		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.PendingMACState = NewPopulatedMACState(r, easy)
+	}
+	this.Multicast = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.ClaimAuthenticationCode = NewPopulatedEndDeviceAuthenticationCode(r, easy)
+	}
+	this.NetworkServerKEKLabel = randStringEndDevice(r)
+	this.ApplicationServerKEKLabel = randStringEndDevice(r)
+	this.ApplicationServerID = randStringEndDevice(r)
+	if r.Intn(5) != 0 {
+		this.Picture = NewPopulatedPicture(r, easy)
+	}
+	this.SkipPayloadCrypto = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.SkipPayloadCryptoOverride = types.NewPopulatedBoolValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
+	this := &EndDevices{}
+	if r.Intn(5) != 0 {
+		v21 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v21)
+		for i := 0; i < v21; i++ {
+			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDevAddrPrefix(r randyEndDevice, easy bool) *DevAddrPrefix {
+	this := &DevAddrPrefix{}
+	this.DevAddr = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	this.Length = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
+	this := &CreateEndDeviceRequest{}
+	v22 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v22
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
+	this := &UpdateEndDeviceRequest{}
+	v23 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v23
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
+	this := &GetEndDeviceRequest{}
+	v25 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v25
+	v26 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v26
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
+	this := &GetEndDeviceIdentifiersForEUIsRequest{}
+	v27 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEui = *v27
+	v28 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.DevEui = *v28
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
+	this := &ListEndDevicesRequest{}
+	v29 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v29
+	v30 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v30
+	this.Order = randStringEndDevice(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
+	this := &SetEndDeviceRequest{}
+	v31 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v31
+	v32 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v32
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedResetAndGetEndDeviceRequest(r randyEndDevice, easy bool) *ResetAndGetEndDeviceRequest {
+	this := &ResetAndGetEndDeviceRequest{}
+	v33 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v33
+	v34 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v34
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
+	this := &EndDeviceTemplate{}
+	v35 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v35
+	v36 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v36
+	this.MappingKey = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDeviceTemplateFormat {
+	this := &EndDeviceTemplateFormat{}
+	this.Name = randStringEndDevice(r)
+	this.Description = randStringEndDevice(r)
+	v37 := r.Intn(10)
+	this.FileExtensions = make([]string, v37)
+	for i := 0; i < v37; i++ {
+		this.FileExtensions[i] = randStringEndDevice(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
+	this := &EndDeviceTemplateFormats{}
+	if r.Intn(5) != 0 {
+		v38 := r.Intn(10)
+		this.Formats = make(map[string]*EndDeviceTemplateFormat)
+		for i := 0; i < v38; i++ {
+			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
+	this := &ConvertEndDeviceTemplateRequest{}
+	this.FormatID = randStringEndDevice(r)
+	v39 := r.Intn(100)
+	this.Data = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyEndDevice interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneEndDevice(r randyEndDevice) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringEndDevice(r randyEndDevice) string {
+	v40 := r.Intn(100)
+	tmps := make([]rune, v40)
+	for i := 0; i < v40; i++ {
+		tmps[i] = randUTF8RuneEndDevice(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedEndDevice(r randyEndDevice, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldEndDevice(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		v41 := r.Int63()
+		if r.Intn(2) == 0 {
+			v41 *= -1
+		}
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v41))
+	case 1:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateEndDevice(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.SessionKeys.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.LastFCntUp != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastFCntUp))
+	}
+	if m.LastNFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastNFCntDown))
+	}
+	if m.LastAFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastAFCntDown))
+	}
+	if m.LastConfFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastConfFCntDown))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for _, e := range m.QueuedApplicationDownlinks {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BoolValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxEIRP != 0 {
+		n += 5
+	}
+	if m.ADRDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRDataRateIndex))
+	}
+	if m.ADRTxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRTxPowerIndex))
+	}
+	if m.ADRNbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRNbTrans))
+	}
+	if m.ADRAckLimit != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRAckLimit))
+	}
+	if m.ADRAckDelay != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRAckDelay))
+	}
+	if m.Rx1Delay != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx1Delay))
+	}
+	if m.Rx1DataRateOffset != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx1DataRateOffset))
+	}
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovEndDevice(m.Rx2Frequency)
+	}
+	if m.MaxDutyCycle != 0 {
+		n += 1 + sovEndDevice(uint64(m.MaxDutyCycle))
+	}
+	if m.RejoinTimePeriodicity != 0 {
+		n += 1 + sovEndDevice(uint64(m.RejoinTimePeriodicity))
+	}
+	if m.RejoinCountPeriodicity != 0 {
+		n += 1 + sovEndDevice(uint64(m.RejoinCountPeriodicity))
+	}
+	if m.PingSlotFrequency != 0 {
+		n += 2 + sovEndDevice(m.PingSlotFrequency)
+	}
+	if m.PingSlotDataRateIndex != 0 {
+		n += 2 + sovEndDevice(uint64(m.PingSlotDataRateIndex))
+	}
+	if m.BeaconFrequency != 0 {
+		n += 2 + sovEndDevice(m.BeaconFrequency)
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.UplinkDwellTime != nil {
+		l = m.UplinkDwellTime.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DownlinkDwellTime != nil {
+		l = m.DownlinkDwellTime.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRAckLimitExponent != nil {
+		l = m.ADRAckLimitExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRAckDelayExponent != nil {
+		l = m.ADRAckDelayExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotDataRateIndexValue != nil {
+		l = m.PingSlotDataRateIndexValue.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *MACParameters_Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UplinkFrequency != 0 {
+		n += 1 + sovEndDevice(m.UplinkFrequency)
+	}
+	if m.DownlinkFrequency != 0 {
+		n += 1 + sovEndDevice(m.DownlinkFrequency)
+	}
+	if m.MinDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
+	}
+	if m.MaxDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+	}
+	if m.EnableUplink {
+		n += 2
+	}
+	return n
+}
+
+func (m *EndDeviceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceVersionIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Photos) > 0 {
+		for _, s := range m.Photos {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.SupportsClassB {
+		n += 2
+	}
+	if m.SupportsClassC {
+		n += 2
+	}
+	if m.DefaultMACSettings != nil {
+		l = m.DefaultMACSettings.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.MinFrequency != 0 {
+		n += 1 + sovEndDevice(m.MinFrequency)
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovEndDevice(m.MaxFrequency)
+	}
+	if m.SupportsJoin {
+		n += 2
+	}
+	if m.ResetsJoinNonces {
+		n += 2
+	}
+	l = m.DefaultFormatters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *MACSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassBTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotPeriodicity != nil {
+		l = m.PingSlotPeriodicity.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotDataRateIndex != nil {
+		l = m.PingSlotDataRateIndex.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotFrequency != nil {
+		l = m.PingSlotFrequency.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ClassCTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx1Delay != nil {
+		l = m.Rx1Delay.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx1DataRateOffset != nil {
+		l = m.Rx1DataRateOffset.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx2DataRateIndex != nil {
+		l = m.Rx2DataRateIndex.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx2Frequency != nil {
+		l = m.Rx2Frequency.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.FactoryPresetFrequencies) > 0 {
+		l = 0
+		for _, e := range m.FactoryPresetFrequencies {
+			l += sovEndDevice(e)
+		}
+		n += 1 + sovEndDevice(uint64(l)) + l
+	}
+	if m.MaxDutyCycle != nil {
+		l = m.MaxDutyCycle.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Supports32BitFCnt != nil {
+		l = m.Supports32BitFCnt.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.UseADR != nil {
+		l = m.UseADR.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRMargin != nil {
+		l = m.ADRMargin.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ResetsFCnt != nil {
+		l = m.ResetsFCnt.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.StatusTimePeriodicity != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.StatusCountPeriodicity != nil {
+		l = m.StatusCountPeriodicity.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx1Delay != nil {
+		l = m.DesiredRx1Delay.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx1DataRateOffset != nil {
+		l = m.DesiredRx1DataRateOffset.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx2DataRateIndex != nil {
+		l = m.DesiredRx2DataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx2Frequency != nil {
+		l = m.DesiredRx2Frequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredMaxDutyCycle != nil {
+		l = m.DesiredMaxDutyCycle.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredADRAckLimitExponent != nil {
+		l = m.DesiredADRAckLimitExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredADRAckDelayExponent != nil {
+		l = m.DesiredADRAckDelayExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.BeaconFrequency != nil {
+		l = m.BeaconFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredPingSlotDataRateIndex != nil {
+		l = m.DesiredPingSlotDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredPingSlotFrequency != nil {
+		l = m.DesiredPingSlotFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredBeaconFrequency != nil {
+		l = m.DesiredBeaconFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredMaxEirp != nil {
+		l = m.DesiredMaxEirp.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADR != nil {
+		l = m.ADR.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ADRSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != nil {
+		n += m.Strategy.Size()
+	}
+	return n
+}
+
+func (m *ADRSettings_Dynamic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dynamic != nil {
+		l = m.Dynamic.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+func (m *ADRSettings_LossBased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LossBased != nil {
+		l = m.LossBased.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+func (m *ADRSettings_Mobile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mobile != nil {
+		l = m.Mobile.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+func (m *ADRSettings_Static) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Static != nil {
+		l = m.Static.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+func (m *ADRSettings_DynamicStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Margin != nil {
+		l = m.Margin.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ADRSettings_LossBasedStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Margin != nil {
+		l = m.Margin.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.MaxLossRate != nil {
+		l = m.MaxLossRate.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ADRSettings_MobileStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Margin != nil {
+		l = m.Margin.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ADRSettings_StaticStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DataRateIndex))
+	}
+	if m.TxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.TxPowerIndex))
+	}
+	if m.NbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.NbTrans))
+	}
+	return n
+}
+
+func (m *ADRDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovEndDevice(uint64(m.UplinkCount))
+	}
+	if m.SNR != 0 {
+		n += 5
+	}
+	if m.DemodulationFloor != 0 {
+		n += 5
+	}
+	if m.Margin != 0 {
+		n += 5
+	}
+	if m.RemainingMargin != 0 {
+		n += 5
+	}
+	if m.LossRate != 0 {
+		n += 5
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DataRateIndex))
+	}
+	if m.TxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.TxPowerIndex))
+	}
+	if m.NbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.NbTrans))
+	}
+	return n
+}
+
+func (m *MACState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentParameters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.DesiredParameters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.DeviceClass != 0 {
+		n += 1 + sovEndDevice(uint64(m.DeviceClass))
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LastConfirmedDownlinkAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastDevStatusFCntUp != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastDevStatusFCntUp))
+	}
+	if m.PingSlotPeriodicity != nil {
+		l = m.PingSlotPeriodicity.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingApplicationDownlink != nil {
+		l = m.PendingApplicationDownlink.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.QueuedResponses) > 0 {
+		for _, e := range m.QueuedResponses {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.PendingRequests) > 0 {
+		for _, e := range m.PendingRequests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.QueuedJoinAccept != nil {
+		l = m.QueuedJoinAccept.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingJoinRequest != nil {
+		l = m.PendingJoinRequest.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.RxWindowsAvailable {
+		n += 2
+	}
+	if len(m.RecentUplinks) > 0 {
+		for _, e := range m.RecentUplinks {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.RecentDownlinks) > 0 {
+		for _, e := range m.RecentDownlinks {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.LastNetworkInitiatedDownlinkAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.RejectedADRDataRateIndexes) > 0 {
+		l = 0
+		for _, e := range m.RejectedADRDataRateIndexes {
+			l += sovEndDevice(uint64(e))
+		}
+		n += 2 + sovEndDevice(uint64(l)) + l
+	}
+	if len(m.RejectedADRTxPowerIndexes) > 0 {
+		l = 0
+		for _, e := range m.RejectedADRTxPowerIndexes {
+			l += sovEndDevice(uint64(e))
+		}
+		n += 2 + sovEndDevice(uint64(l)) + l
+	}
+	if len(m.RejectedFrequencies) > 0 {
+		l = 0
+		for _, e := range m.RejectedFrequencies {
+			l += sovEndDevice(e)
+		}
+		n += 2 + sovEndDevice(uint64(l)) + l
+	}
+	if m.LastDownlinkAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkAt)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.RejectedDataRateRanges) > 0 {
+		for k, v := range m.RejectedDataRateRanges {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEndDevice(uint64(l))
+			}
+			mapEntrySize := 1 + sovEndDevice(k) + l
+			n += mapEntrySize + 2 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.LastADRChangeFCntUp != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastADRChangeFCntUp))
+	}
+	return n
+}
+
+func (m *MACState_JoinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DownlinkSettings.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.RxDelay != 0 {
+		n += 1 + sovEndDevice(uint64(m.RxDelay))
+	}
+	if m.CFList != nil {
+		l = m.CFList.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *MACState_JoinAccept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.Keys.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	l = m.DevAddr.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *MACState_DataRateRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
+	}
+	if m.MaxDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+	}
+	return n
+}
+
+func (m *MACState_DataRateRanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceAuthenticationCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ValidFrom != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ValidTo != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ServiceProfileID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.JoinServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for k, v := range m.Locations {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEndDevice(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.SupportsClassB {
+		n += 2
+	}
+	if m.SupportsClassC {
+		n += 2
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 2 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MinFrequency != 0 {
+		n += 2 + sovEndDevice(m.MinFrequency)
+	}
+	if m.MaxFrequency != 0 {
+		n += 2 + sovEndDevice(m.MaxFrequency)
+	}
+	if m.SupportsJoin {
+		n += 3
+	}
+	if m.ResetsJoinNonces {
+		n += 3
+	}
+	if m.RootKeys != nil {
+		l = m.RootKeys.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.NetID != nil {
+		l = m.NetID.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MACSettings != nil {
+		l = m.MACSettings.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MACState != nil {
+		l = m.MACState.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingSession != nil {
+		l = m.PendingSession.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastDevNonce != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastDevNonce))
+	}
+	if len(m.UsedDevNonces) > 0 {
+		l = 0
+		for _, e := range m.UsedDevNonces {
+			l += sovEndDevice(uint64(e))
+		}
+		n += 2 + sovEndDevice(uint64(l)) + l
+	}
+	if m.LastJoinNonce != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastJoinNonce))
+	}
+	if m.LastRJCount0 != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastRJCount0))
+	}
+	if m.LastRJCount1 != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastRJCount1))
+	}
+	if m.LastDevStatusReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PowerState != 0 {
+		n += 2 + sovEndDevice(uint64(m.PowerState))
+	}
+	if m.BatteryPercentage != nil {
+		l = m.BatteryPercentage.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DownlinkMargin != 0 {
+		n += 2 + sovEndDevice(uint64(m.DownlinkMargin))
+	}
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for _, e := range m.QueuedApplicationDownlinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.Formatters != nil {
+		l = m.Formatters.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ProvisioningData != nil {
+		l = m.ProvisioningData.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingMACState != nil {
+		l = m.PendingMACState.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Multicast {
+		n += 3
+	}
+	if m.ClaimAuthenticationCode != nil {
+		l = m.ClaimAuthenticationCode.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.NetworkServerKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Picture != nil {
+		l = m.Picture.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.SkipPayloadCrypto {
+		n += 3
+	}
+	if m.SkipPayloadCryptoOverride != nil {
+		l = m.SkipPayloadCryptoOverride.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDevices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EndDevices) > 0 {
+		for _, e := range m.EndDevices {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DevAddrPrefix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovEndDevice(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *CreateEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *UpdateEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *GetEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEui.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.DevEui.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *ListEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEndDevice(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovEndDevice(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *SetEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *ResetAndGetEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *EndDeviceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.MappingKey)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceTemplateFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.FileExtensions) > 0 {
+		for _, s := range m.FileExtensions {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceTemplateFormats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k, v := range m.Formats {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEndDevice(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConvertEndDeviceTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FormatID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func sovEndDevice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEndDevice(x uint64) (n int) {
+	return sovEndDevice((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *Session) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.QueuedApplicationDownlinks {
+		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForQueuedApplicationDownlinks += "}"
+	s := strings.Join([]string{`&Session{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`SessionKeys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SessionKeys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
+		`LastFCntUp:` + fmt.Sprintf("%v", this.LastFCntUp) + `,`,
+		`LastNFCntDown:` + fmt.Sprintf("%v", this.LastNFCntDown) + `,`,
+		`LastAFCntDown:` + fmt.Sprintf("%v", this.LastAFCntDown) + `,`,
+		`LastConfFCntDown:` + fmt.Sprintf("%v", this.LastConfFCntDown) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BoolValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BoolValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChannels := "[]*MACParameters_Channel{"
+	for _, f := range this.Channels {
+		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "MACParameters_Channel", "MACParameters_Channel", 1) + ","
+	}
+	repeatedStringForChannels += "}"
+	s := strings.Join([]string{`&MACParameters{`,
+		`MaxEIRP:` + fmt.Sprintf("%v", this.MaxEIRP) + `,`,
+		`ADRDataRateIndex:` + fmt.Sprintf("%v", this.ADRDataRateIndex) + `,`,
+		`ADRTxPowerIndex:` + fmt.Sprintf("%v", this.ADRTxPowerIndex) + `,`,
+		`ADRNbTrans:` + fmt.Sprintf("%v", this.ADRNbTrans) + `,`,
+		`ADRAckLimit:` + fmt.Sprintf("%v", this.ADRAckLimit) + `,`,
+		`ADRAckDelay:` + fmt.Sprintf("%v", this.ADRAckDelay) + `,`,
+		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
+		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
+		`RejoinTimePeriodicity:` + fmt.Sprintf("%v", this.RejoinTimePeriodicity) + `,`,
+		`RejoinCountPeriodicity:` + fmt.Sprintf("%v", this.RejoinCountPeriodicity) + `,`,
+		`PingSlotFrequency:` + fmt.Sprintf("%v", this.PingSlotFrequency) + `,`,
+		`PingSlotDataRateIndex:` + fmt.Sprintf("%v", this.PingSlotDataRateIndex) + `,`,
+		`BeaconFrequency:` + fmt.Sprintf("%v", this.BeaconFrequency) + `,`,
+		`Channels:` + repeatedStringForChannels + `,`,
+		`UplinkDwellTime:` + strings.Replace(this.UplinkDwellTime.String(), "BoolValue", "BoolValue", 1) + `,`,
+		`DownlinkDwellTime:` + strings.Replace(this.DownlinkDwellTime.String(), "BoolValue", "BoolValue", 1) + `,`,
+		`ADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
+		`ADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
+		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACParameters_Channel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACParameters_Channel{`,
+		`UplinkFrequency:` + fmt.Sprintf("%v", this.UplinkFrequency) + `,`,
+		`DownlinkFrequency:` + fmt.Sprintf("%v", this.DownlinkFrequency) + `,`,
+		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
+		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
+		`EnableUplink:` + fmt.Sprintf("%v", this.EnableUplink) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceVersion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceVersion{`,
+		`EndDeviceVersionIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceVersionIdentifiers), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1), `&`, ``, 1) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`Photos:` + fmt.Sprintf("%v", this.Photos) + `,`,
+		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
+		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
+		`DefaultMACSettings:` + strings.Replace(this.DefaultMACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
+		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
+		`DefaultFormatters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACSettings{`,
+		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
+		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
+		`PingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`PingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`ClassCTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassCTimeout), "Duration", "types.Duration", 1) + `,`,
+		`Rx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.Rx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
+		`Rx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.Rx1DataRateOffset), "DataRateOffsetValue", "DataRateOffsetValue", 1) + `,`,
+		`Rx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.Rx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`Rx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.Rx2Frequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`FactoryPresetFrequencies:` + fmt.Sprintf("%v", this.FactoryPresetFrequencies) + `,`,
+		`MaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.MaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
+		`Supports32BitFCnt:` + strings.Replace(this.Supports32BitFCnt.String(), "BoolValue", "BoolValue", 1) + `,`,
+		`UseADR:` + strings.Replace(this.UseADR.String(), "BoolValue", "BoolValue", 1) + `,`,
+		`ADRMargin:` + strings.Replace(fmt.Sprintf("%v", this.ADRMargin), "FloatValue", "types.FloatValue", 1) + `,`,
+		`ResetsFCnt:` + strings.Replace(this.ResetsFCnt.String(), "BoolValue", "BoolValue", 1) + `,`,
+		`StatusTimePeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusTimePeriodicity), "Duration", "types.Duration", 1) + `,`,
+		`StatusCountPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusCountPeriodicity), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`DesiredRx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
+		`DesiredRx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1DataRateOffset), "DataRateOffsetValue", "DataRateOffsetValue", 1) + `,`,
+		`DesiredRx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`DesiredRx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2Frequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`DesiredMaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
+		`DesiredADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
+		`DesiredADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
+		`BeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
+		`DesiredMaxEirp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxEirp), "DeviceEIRPValue", "DeviceEIRPValue", 1) + `,`,
+		`ADR:` + strings.Replace(this.ADR.String(), "ADRSettings", "ADRSettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_Dynamic) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_Dynamic{`,
+		`Dynamic:` + strings.Replace(fmt.Sprintf("%v", this.Dynamic), "ADRSettings_DynamicStrategy", "ADRSettings_DynamicStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_LossBased) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_LossBased{`,
+		`LossBased:` + strings.Replace(fmt.Sprintf("%v", this.LossBased), "ADRSettings_LossBasedStrategy", "ADRSettings_LossBasedStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_Mobile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_Mobile{`,
+		`Mobile:` + strings.Replace(fmt.Sprintf("%v", this.Mobile), "ADRSettings_MobileStrategy", "ADRSettings_MobileStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_Static) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_Static{`,
+		`Static:` + strings.Replace(fmt.Sprintf("%v", this.Static), "ADRSettings_StaticStrategy", "ADRSettings_StaticStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_DynamicStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_DynamicStrategy{`,
+		`Margin:` + strings.Replace(fmt.Sprintf("%v", this.Margin), "FloatValue", "types.FloatValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_LossBasedStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_LossBasedStrategy{`,
+		`Margin:` + strings.Replace(fmt.Sprintf("%v", this.Margin), "FloatValue", "types.FloatValue", 1) + `,`,
+		`MaxLossRate:` + strings.Replace(fmt.Sprintf("%v", this.MaxLossRate), "FloatValue", "types.FloatValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_MobileStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_MobileStrategy{`,
+		`Margin:` + strings.Replace(fmt.Sprintf("%v", this.Margin), "FloatValue", "types.FloatValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSettings_StaticStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSettings_StaticStrategy{`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
+		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRDecision{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`SNR:` + fmt.Sprintf("%v", this.SNR) + `,`,
+		`DemodulationFloor:` + fmt.Sprintf("%v", this.DemodulationFloor) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`RemainingMargin:` + fmt.Sprintf("%v", this.RemainingMargin) + `,`,
+		`LossRate:` + fmt.Sprintf("%v", this.LossRate) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
+		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueuedResponses := "[]*MACCommand{"
+	for _, f := range this.QueuedResponses {
+		repeatedStringForQueuedResponses += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	}
+	repeatedStringForQueuedResponses += "}"
+	repeatedStringForPendingRequests := "[]*MACCommand{"
+	for _, f := range this.PendingRequests {
+		repeatedStringForPendingRequests += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	}
+	repeatedStringForPendingRequests += "}"
+	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
+	for _, f := range this.RecentUplinks {
+		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForRecentUplinks += "}"
+	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
+	for _, f := range this.RecentDownlinks {
+		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	}
+	repeatedStringForRecentDownlinks += "}"
+	keysForRejectedDataRateRanges := make([]uint64, 0, len(this.RejectedDataRateRanges))
+	for k := range this.RejectedDataRateRanges {
+		keysForRejectedDataRateRanges = append(keysForRejectedDataRateRanges, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint64s(keysForRejectedDataRateRanges)
+	mapStringForRejectedDataRateRanges := "map[uint64]*MACState_DataRateRanges{"
+	for _, k := range keysForRejectedDataRateRanges {
+		mapStringForRejectedDataRateRanges += fmt.Sprintf("%v: %v,", k, this.RejectedDataRateRanges[k])
+	}
+	mapStringForRejectedDataRateRanges += "}"
+	s := strings.Join([]string{`&MACState{`,
+		`CurrentParameters:` + strings.Replace(strings.Replace(this.CurrentParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
+		`DesiredParameters:` + strings.Replace(strings.Replace(this.DesiredParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
+		`DeviceClass:` + fmt.Sprintf("%v", this.DeviceClass) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LastConfirmedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastConfirmedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastDevStatusFCntUp:` + fmt.Sprintf("%v", this.LastDevStatusFCntUp) + `,`,
+		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
+		`PendingApplicationDownlink:` + strings.Replace(fmt.Sprintf("%v", this.PendingApplicationDownlink), "ApplicationDownlink", "ApplicationDownlink", 1) + `,`,
+		`QueuedResponses:` + repeatedStringForQueuedResponses + `,`,
+		`PendingRequests:` + repeatedStringForPendingRequests + `,`,
+		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
+		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "MACState_JoinRequest", "MACState_JoinRequest", 1) + `,`,
+		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
+		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
+		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
+		`LastNetworkInitiatedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastNetworkInitiatedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RejectedADRDataRateIndexes:` + fmt.Sprintf("%v", this.RejectedADRDataRateIndexes) + `,`,
+		`RejectedADRTxPowerIndexes:` + fmt.Sprintf("%v", this.RejectedADRTxPowerIndexes) + `,`,
+		`RejectedFrequencies:` + fmt.Sprintf("%v", this.RejectedFrequencies) + `,`,
+		`LastDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RejectedDataRateRanges:` + mapStringForRejectedDataRateRanges + `,`,
+		`LastADRChangeFCntUp:` + fmt.Sprintf("%v", this.LastADRChangeFCntUp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_JoinRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_JoinRequest{`,
+		`DownlinkSettings:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DownlinkSettings), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
+		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
+		`CFList:` + strings.Replace(fmt.Sprintf("%v", this.CFList), "CFList", "CFList", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_JoinAccept) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_JoinAccept{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Request:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Request), "MACState_JoinRequest", "MACState_JoinRequest", 1), `&`, ``, 1) + `,`,
+		`Keys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Keys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
+		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_DataRateRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_DataRateRange{`,
+		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
+		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_DataRateRanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRanges := "[]*MACState_DataRateRange{"
+	for _, f := range this.Ranges {
+		repeatedStringForRanges += strings.Replace(fmt.Sprintf("%v", f), "MACState_DataRateRange", "MACState_DataRateRange", 1) + ","
+	}
+	repeatedStringForRanges += "}"
+	s := strings.Join([]string{`&MACState_DataRateRanges{`,
+		`Ranges:` + repeatedStringForRanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceAuthenticationCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDevice) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.QueuedApplicationDownlinks {
+		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForQueuedApplicationDownlinks += "}"
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	keysForLocations := make([]string, 0, len(this.Locations))
+	for k := range this.Locations {
+		keysForLocations = append(keysForLocations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLocations)
+	mapStringForLocations := "map[string]*Location{"
+	for _, k := range keysForLocations {
+		mapStringForLocations += fmt.Sprintf("%v: %v,", k, this.Locations[k])
+	}
+	mapStringForLocations += "}"
+	s := strings.Join([]string{`&EndDevice{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`ServiceProfileID:` + fmt.Sprintf("%v", this.ServiceProfileID) + `,`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`ApplicationServerAddress:` + fmt.Sprintf("%v", this.ApplicationServerAddress) + `,`,
+		`JoinServerAddress:` + fmt.Sprintf("%v", this.JoinServerAddress) + `,`,
+		`Locations:` + mapStringForLocations + `,`,
+		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
+		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
+		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
+		`RootKeys:` + strings.Replace(fmt.Sprintf("%v", this.RootKeys), "RootKeys", "RootKeys", 1) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`MACSettings:` + strings.Replace(this.MACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
+		`MACState:` + strings.Replace(this.MACState.String(), "MACState", "MACState", 1) + `,`,
+		`Session:` + strings.Replace(this.Session.String(), "Session", "Session", 1) + `,`,
+		`PendingSession:` + strings.Replace(this.PendingSession.String(), "Session", "Session", 1) + `,`,
+		`LastDevNonce:` + fmt.Sprintf("%v", this.LastDevNonce) + `,`,
+		`UsedDevNonces:` + fmt.Sprintf("%v", this.UsedDevNonces) + `,`,
+		`LastJoinNonce:` + fmt.Sprintf("%v", this.LastJoinNonce) + `,`,
+		`LastRJCount0:` + fmt.Sprintf("%v", this.LastRJCount0) + `,`,
+		`LastRJCount1:` + fmt.Sprintf("%v", this.LastRJCount1) + `,`,
+		`LastDevStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDevStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PowerState:` + fmt.Sprintf("%v", this.PowerState) + `,`,
+		`BatteryPercentage:` + strings.Replace(fmt.Sprintf("%v", this.BatteryPercentage), "FloatValue", "types.FloatValue", 1) + `,`,
+		`DownlinkMargin:` + fmt.Sprintf("%v", this.DownlinkMargin) + `,`,
+		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
+		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`PendingMACState:` + strings.Replace(this.PendingMACState.String(), "MACState", "MACState", 1) + `,`,
+		`Multicast:` + fmt.Sprintf("%v", this.Multicast) + `,`,
+		`ClaimAuthenticationCode:` + strings.Replace(this.ClaimAuthenticationCode.String(), "EndDeviceAuthenticationCode", "EndDeviceAuthenticationCode", 1) + `,`,
+		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
+		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
+		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
+		`Picture:` + strings.Replace(fmt.Sprintf("%v", this.Picture), "Picture", "Picture", 1) + `,`,
+		`SkipPayloadCrypto:` + fmt.Sprintf("%v", this.SkipPayloadCrypto) + `,`,
+		`SkipPayloadCryptoOverride:` + strings.Replace(fmt.Sprintf("%v", this.SkipPayloadCryptoOverride), "BoolValue", "types.BoolValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDevices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEndDevices := "[]*EndDevice{"
+	for _, f := range this.EndDevices {
+		repeatedStringForEndDevices += strings.Replace(f.String(), "EndDevice", "EndDevice", 1) + ","
+	}
+	repeatedStringForEndDevices += "}"
+	s := strings.Join([]string{`&EndDevices{`,
+		`EndDevices:` + repeatedStringForEndDevices + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DevAddrPrefix) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DevAddrPrefix{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceIdentifiersForEUIsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceIdentifiersForEUIsRequest{`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetAndGetEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetAndGetEndDeviceRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceTemplate{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`MappingKey:` + fmt.Sprintf("%v", this.MappingKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplateFormat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceTemplateFormat{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`FileExtensions:` + fmt.Sprintf("%v", this.FileExtensions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplateFormats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFormats := make([]string, 0, len(this.Formats))
+	for k := range this.Formats {
+		keysForFormats = append(keysForFormats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
+	mapStringForFormats := "map[string]*EndDeviceTemplateFormat{"
+	for _, k := range keysForFormats {
+		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	}
+	mapStringForFormats += "}"
+	s := strings.Join([]string{`&EndDeviceTemplateFormats{`,
+		`Formats:` + mapStringForFormats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvertEndDeviceTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvertEndDeviceTemplateRequest{`,
+		`FormatID:` + fmt.Sprintf("%v", this.FormatID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEndDevice(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntUp", wireType)
+			}
+			m.LastFCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFCntUp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNFCntDown", wireType)
+			}
+			m.LastNFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAFCntDown", wireType)
+			}
+			m.LastAFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConfFCntDown", wireType)
+			}
+			m.LastConfFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConfFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
+			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	mapStringForFormats += "}"
-	s := strings.Join([]string{`&EndDeviceTemplateFormats{`,
-		`Formats:` + mapStringForFormats + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *ConvertEndDeviceTemplateRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *BoolValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ConvertEndDeviceTemplateRequest{`,
-		`FormatID:` + fmt.Sprintf("%v", this.FormatID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringEndDevice(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
+func (m *MACParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9023,30 +10908,193 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEndDevice
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRP", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.MaxEIRP = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRDataRateIndex", wireType)
+			}
+			m.ADRDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRTxPowerIndex", wireType)
+			}
+			m.ADRTxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRTxPowerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRNbTrans", wireType)
+			}
+			m.ADRNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRNbTrans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimit", wireType)
+			}
+			m.ADRAckLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRAckLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelay", wireType)
+			}
+			m.ADRAckDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRAckDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+			}
+			m.Rx1Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1Delay |= RxDelay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+			}
+			m.Rx1DataRateOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DataRateOffset |= DataRateOffset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rx2DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Session: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
-			var byteLen int
+			m.Rx2Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9056,30 +11104,54 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEndDevice
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			m.MaxDutyCycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinTimePeriodicity", wireType)
 			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RejoinTimePeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinTimePeriodicity |= RejoinTimeExponent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCountPeriodicity", wireType)
 			}
-			var msglen int
+			m.RejoinCountPeriodicity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9089,30 +11161,54 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RejoinCountPeriodicity |= RejoinCountExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			m.PingSlotFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
 			}
-			if err := m.SessionKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PingSlotDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntUp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
 			}
-			m.LastFCntUp = 0
+			m.BeaconFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9122,16 +11218,16 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFCntUp |= uint32(b&0x7F) << shift
+				m.BeaconFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNFCntDown", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			m.LastNFCntDown = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9141,16 +11237,31 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastNFCntDown |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAFCntDown", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.LastAFCntDown = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &MACParameters_Channel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9160,16 +11271,33 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastAFCntDown |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastConfFCntDown", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.LastConfFCntDown = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkDwellTime == nil {
+				m.UplinkDwellTime = &BoolValue{}
+			}
+			if err := m.UplinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9179,14 +11307,67 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastConfFCntDown |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkDwellTime == nil {
+				m.DownlinkDwellTime = &BoolValue{}
+			}
+			if err := m.DownlinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAckLimitExponent == nil {
+				m.ADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			}
+			if err := m.ADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9213,13 +11394,16 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ADRAckDelayExponent == nil {
+				m.ADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			}
+			if err := m.ADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndexValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9246,8 +11430,10 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
-			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PingSlotDataRateIndexValue == nil {
+				m.PingSlotDataRateIndexValue = &DataRateIndexValue{}
+			}
+			if err := m.PingSlotDataRateIndexValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9275,7 +11461,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BoolValue) Unmarshal(dAtA []byte) error {
+func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9298,15 +11484,91 @@ func (m *BoolValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BoolValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BoolValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFrequency", wireType)
+			}
+			m.UplinkFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFrequency", wireType)
+			}
+			m.DownlinkFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+			}
+			m.MinDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+			}
+			m.MaxDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableUplink", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9323,7 +11585,7 @@ func (m *BoolValue) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Value = bool(v != 0)
+			m.EnableUplink = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -9348,7 +11610,7 @@ func (m *BoolValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACParameters) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9371,28 +11633,17 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MACParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRP", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
-			iNdEx += 4
-			m.MaxEIRP = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRDataRateIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
 			}
-			m.ADRDataRateIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9402,54 +11653,30 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRTxPowerIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.ADRTxPowerIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRTxPowerIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRNbTrans", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ADRNbTrans = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRNbTrans |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
 			}
-			m.ADRAckLimit = 0
+			m.LoRaWANVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9459,16 +11686,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckLimit |= uint32(b&0x7F) << shift
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
 			}
-			m.ADRAckDelay = 0
+			m.LoRaWANPHYVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9478,16 +11705,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckDelay |= uint32(b&0x7F) << shift
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			m.Rx1Delay = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9497,35 +11724,29 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1Delay |= RxDelay(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.Rx1DataRateOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1DataRateOffset |= DataRateOffset(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Rx2DataRateIndex = 0
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9535,16 +11756,29 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
 			}
-			m.Rx2Frequency = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9554,16 +11788,17 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			m.SupportsClassB = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
 			}
-			m.MaxDutyCycle = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9573,16 +11808,17 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinTimePeriodicity", wireType)
+			m.SupportsClassC = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
 			}
-			m.RejoinTimePeriodicity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9592,16 +11828,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinTimePeriodicity |= RejoinTimeExponent(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultMACSettings == nil {
+				m.DefaultMACSettings = &MACSettings{}
+			}
+			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCountPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
 			}
-			m.RejoinCountPeriodicity = 0
+			m.MinFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9611,16 +11864,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinCountPeriodicity |= RejoinCountExponent(b&0x7F) << shift
+				m.MinFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
 			}
-			m.PingSlotFrequency = 0
+			m.MaxFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9630,16 +11883,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PingSlotFrequency |= uint64(b&0x7F) << shift
+				m.MaxFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
 			}
-			m.PingSlotDataRateIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9649,16 +11902,17 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PingSlotDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			m.SupportsJoin = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
 			}
-			m.BeaconFrequency = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9668,14 +11922,15 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeaconFrequency |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+			m.ResetsJoinNonces = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9702,14 +11957,66 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, &MACParameters_Channel{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9736,16 +12043,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkDwellTime == nil {
-				m.UplinkDwellTime = &BoolValue{}
+			if m.ClassBTimeout == nil {
+				m.ClassBTimeout = new(time.Duration)
 			}
-			if err := m.UplinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9772,16 +12079,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkDwellTime == nil {
-				m.DownlinkDwellTime = &BoolValue{}
+			if m.PingSlotPeriodicity == nil {
+				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
 			}
-			if err := m.DownlinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9808,16 +12115,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRAckLimitExponent == nil {
-				m.ADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			if m.PingSlotDataRateIndex == nil {
+				m.PingSlotDataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.ADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9844,16 +12151,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRAckDelayExponent == nil {
-				m.ADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			if m.PingSlotFrequency == nil {
+				m.PingSlotFrequency = &FrequencyValue{}
 			}
-			if err := m.ADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndexValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9880,71 +12187,18 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotDataRateIndexValue == nil {
-				m.PingSlotDataRateIndexValue = &DataRateIndexValue{}
-			}
-			if err := m.PingSlotDataRateIndexValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFrequency", wireType)
+			if m.ClassCTimeout == nil {
+				m.ClassCTimeout = new(time.Duration)
 			}
-			m.UplinkFrequency = 0
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9954,16 +12208,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UplinkFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFrequency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.DownlinkFrequency = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rx1Delay == nil {
+				m.Rx1Delay = &RxDelayValue{}
+			}
+			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9973,16 +12244,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownlinkFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.MinDataRateIndex = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rx1DataRateOffset == nil {
+				m.Rx1DataRateOffset = &DataRateOffsetValue{}
+			}
+			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9992,16 +12280,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.MaxDataRateIndex = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rx2DataRateIndex == nil {
+				m.Rx2DataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10011,16 +12316,109 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableUplink", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rx2Frequency == nil {
+				m.Rx2Frequency = &FrequencyValue{}
+			}
+			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
+					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10030,68 +12428,31 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableUplink = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MaxDutyCycle == nil {
+				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10118,15 +12479,18 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Supports32BitFCnt == nil {
+				m.Supports32BitFCnt = &BoolValue{}
+			}
+			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
 			}
-			m.LoRaWANVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10136,35 +12500,33 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.LoRaWANPHYVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UseADR == nil {
+				m.UseADR = &BoolValue{}
+			}
+			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10174,29 +12536,33 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			if m.ADRMargin == nil {
+				m.ADRMargin = &types.FloatValue{}
+			}
+			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10206,67 +12572,31 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupportsClassB = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+			if m.ResetsFCnt == nil {
+				m.ResetsFCnt = &BoolValue{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SupportsClassC = bool(v != 0)
-		case 8:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10293,18 +12623,18 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultMACSettings == nil {
-				m.DefaultMACSettings = &MACSettings{}
+			if m.StatusTimePeriodicity == nil {
+				m.StatusTimePeriodicity = new(time.Duration)
 			}
-			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
 			}
-			m.MinFrequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10314,73 +12644,31 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
-			}
-			m.MaxFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SupportsJoin = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
+			if m.StatusCountPeriodicity == nil {
+				m.StatusCountPeriodicity = &types.UInt32Value{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ResetsJoinNonces = bool(v != 0)
-		case 13:
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10407,66 +12695,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.DesiredRx1Delay == nil {
+				m.DesiredRx1Delay = &RxDelayValue{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10493,16 +12731,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassBTimeout == nil {
-				m.ClassBTimeout = new(time.Duration)
+			if m.DesiredRx1DataRateOffset == nil {
+				m.DesiredRx1DataRateOffset = &DataRateOffsetValue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10529,16 +12767,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotPeriodicity == nil {
-				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			if m.DesiredRx2DataRateIndex == nil {
+				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10565,16 +12803,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotDataRateIndex == nil {
-				m.PingSlotDataRateIndex = &DataRateIndexValue{}
+			if m.DesiredRx2Frequency == nil {
+				m.DesiredRx2Frequency = &FrequencyValue{}
 			}
-			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10601,16 +12839,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotFrequency == nil {
-				m.PingSlotFrequency = &FrequencyValue{}
+			if m.DesiredMaxDutyCycle == nil {
+				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
 			}
-			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10637,16 +12875,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassCTimeout == nil {
-				m.ClassCTimeout = new(time.Duration)
+			if m.DesiredADRAckLimitExponent == nil {
+				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10673,16 +12911,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1Delay == nil {
-				m.Rx1Delay = &RxDelayValue{}
+			if m.DesiredADRAckDelayExponent == nil {
+				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
 			}
-			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10709,16 +12947,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1DataRateOffset == nil {
-				m.Rx1DataRateOffset = &DataRateOffsetValue{}
+			if m.BeaconFrequency == nil {
+				m.BeaconFrequency = &FrequencyValue{}
 			}
-			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10745,16 +12983,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2DataRateIndex == nil {
-				m.Rx2DataRateIndex = &DataRateIndexValue{}
+			if m.DesiredPingSlotDataRateIndex == nil {
+				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10781,92 +13019,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2Frequency == nil {
-				m.Rx2Frequency = &FrequencyValue{}
+			if m.DesiredPingSlotFrequency == nil {
+				m.DesiredPingSlotFrequency = &FrequencyValue{}
 			}
-			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
-					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
-			}
-		case 11:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10893,16 +13055,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDutyCycle == nil {
-				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
+			if m.DesiredBeaconFrequency == nil {
+				m.DesiredBeaconFrequency = &FrequencyValue{}
 			}
-			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxEirp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10929,16 +13091,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supports32BitFCnt == nil {
-				m.Supports32BitFCnt = &BoolValue{}
+			if m.DesiredMaxEirp == nil {
+				m.DesiredMaxEirp = &DeviceEIRPValue{}
 			}
-			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DesiredMaxEirp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10965,16 +13127,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UseADR == nil {
-				m.UseADR = &BoolValue{}
+			if m.ADR == nil {
+				m.ADR = &ADRSettings{}
 			}
-			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dynamic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11001,16 +13216,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRMargin == nil {
-				m.ADRMargin = &types.FloatValue{}
-			}
-			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ADRSettings_DynamicStrategy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Strategy = &ADRSettings_Dynamic{v}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBased", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11037,16 +13251,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetsFCnt == nil {
-				m.ResetsFCnt = &BoolValue{}
-			}
-			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ADRSettings_LossBasedStrategy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Strategy = &ADRSettings_LossBased{v}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mobile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11073,16 +13286,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTimePeriodicity == nil {
-				m.StatusTimePeriodicity = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
+			v := &ADRSettings_MobileStrategy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Strategy = &ADRSettings_Mobile{v}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Static", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11109,16 +13321,68 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusCountPeriodicity == nil {
-				m.StatusCountPeriodicity = &types.UInt32Value{}
-			}
-			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ADRSettings_StaticStrategy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Strategy = &ADRSettings_Static{v}
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSettings_DynamicStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11145,16 +13409,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx1Delay == nil {
-				m.DesiredRx1Delay = &RxDelayValue{}
+			if m.Margin == nil {
+				m.Margin = &types.FloatValue{}
 			}
-			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSettings_LossBasedStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LossBasedStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LossBasedStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11181,16 +13498,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx1DataRateOffset == nil {
-				m.DesiredRx1DataRateOffset = &DataRateOffsetValue{}
+			if m.Margin == nil {
+				m.Margin = &types.FloatValue{}
 			}
-			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLossRate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11217,16 +13534,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2DataRateIndex == nil {
-				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
+			if m.MaxLossRate == nil {
+				m.MaxLossRate = &types.FloatValue{}
 			}
-			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxLossRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSettings_MobileStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MobileStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MobileStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11253,18 +13623,71 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2Frequency == nil {
-				m.DesiredRx2Frequency = &FrequencyValue{}
+			if m.Margin == nil {
+				m.Margin = &types.FloatValue{}
 			}
-			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSettings_StaticStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StaticStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StaticStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			var msglen int
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11274,33 +13697,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredMaxDutyCycle == nil {
-				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
-			}
-			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
 			}
-			var msglen int
+			m.TxPowerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11310,33 +13716,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxPowerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredADRAckLimitExponent == nil {
-				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
-			}
-			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
 			}
-			var msglen int
+			m.NbTrans = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11346,33 +13735,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NbTrans |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredADRAckDelayExponent == nil {
-				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 25:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11382,33 +13807,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeaconFrequency == nil {
-				m.BeaconFrequency = &FrequencyValue{}
-			}
-			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
 			}
-			var msglen int
+			m.UplinkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11418,33 +13839,71 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UplinkCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.SNR = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemodulationFloor", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredPingSlotDataRateIndex == nil {
-				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.DemodulationFloor = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Margin = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMargin", wireType)
 			}
-			var msglen int
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.RemainingMargin = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.LossRate = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11454,33 +13913,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredPingSlotFrequency == nil {
-				m.DesiredPingSlotFrequency = &FrequencyValue{}
-			}
-			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
 			}
-			var msglen int
+			m.TxPowerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11490,33 +13932,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxPowerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredBeaconFrequency == nil {
-				m.DesiredBeaconFrequency = &FrequencyValue{}
-			}
-			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxEirp", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
 			}
-			var msglen int
+			m.NbTrans = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11526,28 +13951,11 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NbTrans |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredMaxEirp == nil {
-				m.DesiredMaxEirp = &DeviceEIRPValue{}
-			}
-			if err := m.DesiredMaxEirp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
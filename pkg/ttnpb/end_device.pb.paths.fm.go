@@ -108,6 +108,19 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
+	"default_mac_settings.adr",
+	"default_mac_settings.adr.strategy",
+	"default_mac_settings.adr.strategy.dynamic",
+	"default_mac_settings.adr.strategy.dynamic.margin",
+	"default_mac_settings.adr.strategy.loss_based",
+	"default_mac_settings.adr.strategy.loss_based.margin",
+	"default_mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"default_mac_settings.adr.strategy.mobile",
+	"default_mac_settings.adr.strategy.mobile.margin",
+	"default_mac_settings.adr.strategy.static",
+	"default_mac_settings.adr.strategy.static.data_rate_index",
+	"default_mac_settings.adr.strategy.static.nb_trans",
+	"default_mac_settings.adr.strategy.static.tx_power_index",
 	"default_mac_settings.adr_margin",
 	"default_mac_settings.beacon_frequency",
 	"default_mac_settings.beacon_frequency.value",
@@ -194,6 +207,19 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"supports_join",
 }
 var MACSettingsFieldPathsNested = []string{
+	"adr",
+	"adr.strategy",
+	"adr.strategy.dynamic",
+	"adr.strategy.dynamic.margin",
+	"adr.strategy.loss_based",
+	"adr.strategy.loss_based.margin",
+	"adr.strategy.loss_based.max_loss_rate",
+	"adr.strategy.mobile",
+	"adr.strategy.mobile.margin",
+	"adr.strategy.static",
+	"adr.strategy.static.data_rate_index",
+	"adr.strategy.static.nb_trans",
+	"adr.strategy.static.tx_power_index",
 	"adr_margin",
 	"beacon_frequency",
 	"beacon_frequency.value",
@@ -249,6 +275,7 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -279,6 +306,49 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"supports_32_bit_f_cnt",
 	"use_adr",
 }
+var ADRSettingsFieldPathsNested = []string{
+	"strategy",
+	"strategy.dynamic",
+	"strategy.dynamic.margin",
+	"strategy.loss_based",
+	"strategy.loss_based.margin",
+	"strategy.loss_based.max_loss_rate",
+	"strategy.mobile",
+	"strategy.mobile.margin",
+	"strategy.static",
+	"strategy.static.data_rate_index",
+	"strategy.static.nb_trans",
+	"strategy.static.tx_power_index",
+}
+
+var ADRSettingsFieldPathsTopLevel = []string{
+	"strategy",
+}
+var ADRDecisionFieldPathsNested = []string{
+	"data_rate_index",
+	"demodulation_floor",
+	"loss_rate",
+	"margin",
+	"nb_trans",
+	"remaining_margin",
+	"snr",
+	"strategy",
+	"tx_power_index",
+	"uplink_count",
+}
+
+var ADRDecisionFieldPathsTopLevel = []string{
+	"data_rate_index",
+	"demodulation_floor",
+	"loss_rate",
+	"margin",
+	"nb_trans",
+	"remaining_margin",
+	"snr",
+	"strategy",
+	"tx_power_index",
+	"uplink_count",
+}
 var MACStateFieldPathsNested = []string{
 	"current_parameters",
 	"current_parameters.adr_ack_delay",
@@ -483,6 +553,19 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr",
+	"mac_settings.adr.strategy",
+	"mac_settings.adr.strategy.dynamic",
+	"mac_settings.adr.strategy.dynamic.margin",
+	"mac_settings.adr.strategy.loss_based",
+	"mac_settings.adr.strategy.loss_based.margin",
+	"mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"mac_settings.adr.strategy.mobile",
+	"mac_settings.adr.strategy.mobile.margin",
+	"mac_settings.adr.strategy.static",
+	"mac_settings.adr.strategy.static.data_rate_index",
+	"mac_settings.adr.strategy.static.nb_trans",
+	"mac_settings.adr.strategy.static.tx_power_index",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.beacon_frequency.value",
@@ -996,6 +1079,19 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.strategy",
+	"end_device.mac_settings.adr.strategy.dynamic",
+	"end_device.mac_settings.adr.strategy.dynamic.margin",
+	"end_device.mac_settings.adr.strategy.loss_based",
+	"end_device.mac_settings.adr.strategy.loss_based.margin",
+	"end_device.mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"end_device.mac_settings.adr.strategy.mobile",
+	"end_device.mac_settings.adr.strategy.mobile.margin",
+	"end_device.mac_settings.adr.strategy.static",
+	"end_device.mac_settings.adr.strategy.static.data_rate_index",
+	"end_device.mac_settings.adr.strategy.static.nb_trans",
+	"end_device.mac_settings.adr.strategy.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
@@ -1445,6 +1541,19 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.strategy",
+	"end_device.mac_settings.adr.strategy.dynamic",
+	"end_device.mac_settings.adr.strategy.dynamic.margin",
+	"end_device.mac_settings.adr.strategy.loss_based",
+	"end_device.mac_settings.adr.strategy.loss_based.margin",
+	"end_device.mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"end_device.mac_settings.adr.strategy.mobile",
+	"end_device.mac_settings.adr.strategy.mobile.margin",
+	"end_device.mac_settings.adr.strategy.static",
+	"end_device.mac_settings.adr.strategy.static.data_rate_index",
+	"end_device.mac_settings.adr.strategy.static.nb_trans",
+	"end_device.mac_settings.adr.strategy.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
@@ -1936,6 +2045,19 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.strategy",
+	"end_device.mac_settings.adr.strategy.dynamic",
+	"end_device.mac_settings.adr.strategy.dynamic.margin",
+	"end_device.mac_settings.adr.strategy.loss_based",
+	"end_device.mac_settings.adr.strategy.loss_based.margin",
+	"end_device.mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"end_device.mac_settings.adr.strategy.mobile",
+	"end_device.mac_settings.adr.strategy.mobile.margin",
+	"end_device.mac_settings.adr.strategy.static",
+	"end_device.mac_settings.adr.strategy.static.data_rate_index",
+	"end_device.mac_settings.adr.strategy.static.nb_trans",
+	"end_device.mac_settings.adr.strategy.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
@@ -2402,6 +2524,19 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.strategy",
+	"end_device.mac_settings.adr.strategy.dynamic",
+	"end_device.mac_settings.adr.strategy.dynamic.margin",
+	"end_device.mac_settings.adr.strategy.loss_based",
+	"end_device.mac_settings.adr.strategy.loss_based.margin",
+	"end_device.mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"end_device.mac_settings.adr.strategy.mobile",
+	"end_device.mac_settings.adr.strategy.mobile.margin",
+	"end_device.mac_settings.adr.strategy.static",
+	"end_device.mac_settings.adr.strategy.static.data_rate_index",
+	"end_device.mac_settings.adr.strategy.static.nb_trans",
+	"end_device.mac_settings.adr.strategy.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
@@ -2860,6 +2995,40 @@ var MACParameters_ChannelFieldPathsTopLevel = []string{
 	"min_data_rate_index",
 	"uplink_frequency",
 }
+var ADRSettings_DynamicStrategyFieldPathsNested = []string{
+	"margin",
+}
+
+var ADRSettings_DynamicStrategyFieldPathsTopLevel = []string{
+	"margin",
+}
+var ADRSettings_LossBasedStrategyFieldPathsNested = []string{
+	"margin",
+	"max_loss_rate",
+}
+
+var ADRSettings_LossBasedStrategyFieldPathsTopLevel = []string{
+	"margin",
+	"max_loss_rate",
+}
+var ADRSettings_MobileStrategyFieldPathsNested = []string{
+	"margin",
+}
+
+var ADRSettings_MobileStrategyFieldPathsTopLevel = []string{
+	"margin",
+}
+var ADRSettings_StaticStrategyFieldPathsNested = []string{
+	"data_rate_index",
+	"nb_trans",
+	"tx_power_index",
+}
+
+var ADRSettings_StaticStrategyFieldPathsTopLevel = []string{
+	"data_rate_index",
+	"nb_trans",
+	"tx_power_index",
+}
 var MACState_JoinRequestFieldPathsNested = []string{
 	"cf_list",
 	"cf_list.ch_masks",
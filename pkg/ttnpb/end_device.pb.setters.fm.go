@@ -1238,6 +1238,318 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 				}
 			}
 
+		case "adr":
+			if len(subs) > 0 {
+				var newDst, newSrc *ADRSettings
+				if (src == nil || src.ADR == nil) && dst.ADR == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ADR
+				}
+				if dst.ADR != nil {
+					newDst = dst.ADR
+				} else {
+					newDst = &ADRSettings{}
+					dst.ADR = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADR = src.ADR
+				} else {
+					dst.ADR = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSettings) SetFields(src *ADRSettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "strategy":
+			if len(subs) == 0 && src == nil {
+				dst.Strategy = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Strategy = src.Strategy
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field 'strategy'")
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "dynamic":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Strategy.(*ADRSettings_Dynamic)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Strategy == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Strategy.(*ADRSettings_Dynamic)
+					if dstValid := dstTypeOk || dst.Strategy == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ADRSettings_DynamicStrategy
+						if srcTypeOk {
+							newSrc = src.Strategy.(*ADRSettings_Dynamic).Dynamic
+						}
+						if dstTypeOk {
+							newDst = dst.Strategy.(*ADRSettings_Dynamic).Dynamic
+						} else if srcTypeOk {
+							newDst = &ADRSettings_DynamicStrategy{}
+							dst.Strategy = &ADRSettings_Dynamic{Dynamic: newDst}
+						} else {
+							dst.Strategy = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Strategy = src.Strategy
+						} else {
+							dst.Strategy = nil
+						}
+					}
+				case "loss_based":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Strategy.(*ADRSettings_LossBased)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Strategy == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'loss_based', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Strategy.(*ADRSettings_LossBased)
+					if dstValid := dstTypeOk || dst.Strategy == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'loss_based', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ADRSettings_LossBasedStrategy
+						if srcTypeOk {
+							newSrc = src.Strategy.(*ADRSettings_LossBased).LossBased
+						}
+						if dstTypeOk {
+							newDst = dst.Strategy.(*ADRSettings_LossBased).LossBased
+						} else if srcTypeOk {
+							newDst = &ADRSettings_LossBasedStrategy{}
+							dst.Strategy = &ADRSettings_LossBased{LossBased: newDst}
+						} else {
+							dst.Strategy = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Strategy = src.Strategy
+						} else {
+							dst.Strategy = nil
+						}
+					}
+				case "mobile":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Strategy.(*ADRSettings_Mobile)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Strategy == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'mobile', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Strategy.(*ADRSettings_Mobile)
+					if dstValid := dstTypeOk || dst.Strategy == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'mobile', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ADRSettings_MobileStrategy
+						if srcTypeOk {
+							newSrc = src.Strategy.(*ADRSettings_Mobile).Mobile
+						}
+						if dstTypeOk {
+							newDst = dst.Strategy.(*ADRSettings_Mobile).Mobile
+						} else if srcTypeOk {
+							newDst = &ADRSettings_MobileStrategy{}
+							dst.Strategy = &ADRSettings_Mobile{Mobile: newDst}
+						} else {
+							dst.Strategy = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Strategy = src.Strategy
+						} else {
+							dst.Strategy = nil
+						}
+					}
+				case "static":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Strategy.(*ADRSettings_Static)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Strategy == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'static', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Strategy.(*ADRSettings_Static)
+					if dstValid := dstTypeOk || dst.Strategy == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'static', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ADRSettings_StaticStrategy
+						if srcTypeOk {
+							newSrc = src.Strategy.(*ADRSettings_Static).Static
+						}
+						if dstTypeOk {
+							newDst = dst.Strategy.(*ADRSettings_Static).Static
+						} else if srcTypeOk {
+							newDst = &ADRSettings_StaticStrategy{}
+							dst.Strategy = &ADRSettings_Static{Static: newDst}
+						} else {
+							dst.Strategy = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Strategy = src.Strategy
+						} else {
+							dst.Strategy = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRDecision) SetFields(src *ADRDecision, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "strategy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'strategy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Strategy = src.Strategy
+			} else {
+				var zero string
+				dst.Strategy = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				var zero float32
+				dst.SNR = zero
+			}
+		case "demodulation_floor":
+			if len(subs) > 0 {
+				return fmt.Errorf("'demodulation_floor' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DemodulationFloor = src.DemodulationFloor
+			} else {
+				var zero float32
+				dst.DemodulationFloor = zero
+			}
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero float32
+				dst.Margin = zero
+			}
+		case "remaining_margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'remaining_margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RemainingMargin = src.RemainingMargin
+			} else {
+				var zero float32
+				dst.RemainingMargin = zero
+			}
+		case "loss_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'loss_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LossRate = src.LossRate
+			} else {
+				var zero float32
+				dst.LossRate = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndex = src.TxPowerIndex
+			} else {
+				var zero uint32
+				dst.TxPowerIndex = zero
+			}
+		case "nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NbTrans = src.NbTrans
+			} else {
+				var zero uint32
+				dst.NbTrans = zero
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -2765,6 +3077,116 @@ func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ..
 	return nil
 }
 
+func (dst *ADRSettings_DynamicStrategy) SetFields(src *ADRSettings_DynamicStrategy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				dst.Margin = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSettings_LossBasedStrategy) SetFields(src *ADRSettings_LossBasedStrategy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				dst.Margin = nil
+			}
+		case "max_loss_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_loss_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxLossRate = src.MaxLossRate
+			} else {
+				dst.MaxLossRate = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSettings_MobileStrategy) SetFields(src *ADRSettings_MobileStrategy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				dst.Margin = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSettings_StaticStrategy) SetFields(src *ADRSettings_StaticStrategy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndex = src.TxPowerIndex
+			} else {
+				var zero uint32
+				dst.TxPowerIndex = zero
+			}
+		case "nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NbTrans = src.NbTrans
+			} else {
+				var zero uint32
+				dst.NbTrans = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACState_JoinRequest) SetFields(src *MACState_JoinRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
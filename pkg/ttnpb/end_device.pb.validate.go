@@ -1048,6 +1048,18 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "adr":
+
+			if v, ok := interface{}(m.GetADR()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1112,6 +1124,250 @@ var _ interface {
 	ErrorName() string
 } = MACSettingsValidationError{}
 
+// ValidateFields checks the field values on ADRSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ADRSettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "strategy":
+			if len(subs) == 0 {
+				subs = []string{
+					"dynamic",
+					"loss_based",
+					"mobile",
+					"static",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "dynamic":
+
+					if v, ok := interface{}(m.GetDynamic()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ADRSettingsValidationError{
+								field:  "dynamic",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "loss_based":
+
+					if v, ok := interface{}(m.GetLossBased()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ADRSettingsValidationError{
+								field:  "loss_based",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "mobile":
+
+					if v, ok := interface{}(m.GetMobile()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ADRSettingsValidationError{
+								field:  "mobile",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "static":
+
+					if v, ok := interface{}(m.GetStatic()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ADRSettingsValidationError{
+								field:  "static",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return ADRSettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSettingsValidationError is the validation error returned by
+// ADRSettings.ValidateFields if the designated constraints aren't met.
+type ADRSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSettingsValidationError) ErrorName() string {
+	return "ADRSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSettingsValidationError{}
+
+// ValidateFields checks the field values on ADRDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ADRDecision) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRDecisionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "strategy":
+			// no validation rules for Strategy
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "snr":
+			// no validation rules for SNR
+		case "demodulation_floor":
+			// no validation rules for DemodulationFloor
+		case "margin":
+			// no validation rules for Margin
+		case "remaining_margin":
+			// no validation rules for RemainingMargin
+		case "loss_rate":
+			// no validation rules for LossRate
+		case "data_rate_index":
+			// no validation rules for DataRateIndex
+		case "tx_power_index":
+			// no validation rules for TxPowerIndex
+		case "nb_trans":
+			// no validation rules for NbTrans
+		default:
+			return ADRDecisionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRDecisionValidationError is the validation error returned by
+// ADRDecision.ValidateFields if the designated constraints aren't met.
+type ADRDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRDecisionValidationError) ErrorName() string {
+	return "ADRDecisionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRDecisionValidationError{}
+
 // ValidateFields checks the field values on MACState with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -3628,6 +3884,409 @@ var _ interface {
 	ErrorName() string
 } = MACParameters_ChannelValidationError{}
 
+// ValidateFields checks the field values on ADRSettings_DynamicStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ADRSettings_DynamicStrategy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSettings_DynamicStrategyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "margin":
+
+			if v, ok := interface{}(m.GetMargin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ADRSettings_DynamicStrategyValidationError{
+						field:  "margin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ADRSettings_DynamicStrategyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSettings_DynamicStrategyValidationError is the validation error returned
+// by ADRSettings_DynamicStrategy.ValidateFields if the designated constraints
+// aren't met.
+type ADRSettings_DynamicStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSettings_DynamicStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSettings_DynamicStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSettings_DynamicStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSettings_DynamicStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSettings_DynamicStrategyValidationError) ErrorName() string {
+	return "ADRSettings_DynamicStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSettings_DynamicStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSettings_DynamicStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSettings_DynamicStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSettings_DynamicStrategyValidationError{}
+
+// ValidateFields checks the field values on ADRSettings_LossBasedStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ADRSettings_LossBasedStrategy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSettings_LossBasedStrategyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "margin":
+
+			if v, ok := interface{}(m.GetMargin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ADRSettings_LossBasedStrategyValidationError{
+						field:  "margin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_loss_rate":
+
+			if v, ok := interface{}(m.GetMaxLossRate()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ADRSettings_LossBasedStrategyValidationError{
+						field:  "max_loss_rate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ADRSettings_LossBasedStrategyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSettings_LossBasedStrategyValidationError is the validation error
+// returned by ADRSettings_LossBasedStrategy.ValidateFields if the designated
+// constraints aren't met.
+type ADRSettings_LossBasedStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSettings_LossBasedStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSettings_LossBasedStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSettings_LossBasedStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSettings_LossBasedStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSettings_LossBasedStrategyValidationError) ErrorName() string {
+	return "ADRSettings_LossBasedStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSettings_LossBasedStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSettings_LossBasedStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSettings_LossBasedStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSettings_LossBasedStrategyValidationError{}
+
+// ValidateFields checks the field values on ADRSettings_MobileStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ADRSettings_MobileStrategy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSettings_MobileStrategyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "margin":
+
+			if v, ok := interface{}(m.GetMargin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ADRSettings_MobileStrategyValidationError{
+						field:  "margin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ADRSettings_MobileStrategyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSettings_MobileStrategyValidationError is the validation error returned
+// by ADRSettings_MobileStrategy.ValidateFields if the designated constraints
+// aren't met.
+type ADRSettings_MobileStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSettings_MobileStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSettings_MobileStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSettings_MobileStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSettings_MobileStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSettings_MobileStrategyValidationError) ErrorName() string {
+	return "ADRSettings_MobileStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSettings_MobileStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSettings_MobileStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSettings_MobileStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSettings_MobileStrategyValidationError{}
+
+// ValidateFields checks the field values on ADRSettings_StaticStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ADRSettings_StaticStrategy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSettings_StaticStrategyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return ADRSettings_StaticStrategyValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "tx_power_index":
+
+			if m.GetTxPowerIndex() > 15 {
+				return ADRSettings_StaticStrategyValidationError{
+					field:  "tx_power_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "nb_trans":
+
+			if val := m.GetNbTrans(); val < 1 || val > 15 {
+				return ADRSettings_StaticStrategyValidationError{
+					field:  "nb_trans",
+					reason: "value must be inside range [1, 15]",
+				}
+			}
+
+		default:
+			return ADRSettings_StaticStrategyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSettings_StaticStrategyValidationError is the validation error returned
+// by ADRSettings_StaticStrategy.ValidateFields if the designated constraints
+// aren't met.
+type ADRSettings_StaticStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSettings_StaticStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSettings_StaticStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSettings_StaticStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSettings_StaticStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSettings_StaticStrategyValidationError) ErrorName() string {
+	return "ADRSettings_StaticStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSettings_StaticStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSettings_StaticStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSettings_StaticStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSettings_StaticStrategyValidationError{}
+
 // ValidateFields checks the field values on MACState_JoinRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
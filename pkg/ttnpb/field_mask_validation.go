@@ -89,6 +89,19 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr",
+	"mac_settings.adr.strategy",
+	"mac_settings.adr.strategy.dynamic",
+	"mac_settings.adr.strategy.dynamic.margin",
+	"mac_settings.adr.strategy.loss_based",
+	"mac_settings.adr.strategy.loss_based.margin",
+	"mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"mac_settings.adr.strategy.mobile",
+	"mac_settings.adr.strategy.mobile.margin",
+	"mac_settings.adr.strategy.static",
+	"mac_settings.adr.strategy.static.data_rate_index",
+	"mac_settings.adr.strategy.static.nb_trans",
+	"mac_settings.adr.strategy.static.tx_power_index",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.beacon_frequency.value",
@@ -582,6 +595,19 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"lorawan_phy_version",
 			"lorawan_version",
 			"mac_settings",
+			"mac_settings.adr",
+			"mac_settings.adr.strategy",
+			"mac_settings.adr.strategy.dynamic",
+			"mac_settings.adr.strategy.dynamic.margin",
+			"mac_settings.adr.strategy.loss_based",
+			"mac_settings.adr.strategy.loss_based.margin",
+			"mac_settings.adr.strategy.loss_based.max_loss_rate",
+			"mac_settings.adr.strategy.mobile",
+			"mac_settings.adr.strategy.mobile.margin",
+			"mac_settings.adr.strategy.static",
+			"mac_settings.adr.strategy.static.data_rate_index",
+			"mac_settings.adr.strategy.static.nb_trans",
+			"mac_settings.adr.strategy.static.tx_power_index",
 			"mac_settings.adr_margin",
 			"mac_settings.beacon_frequency",
 			"mac_settings.beacon_frequency.value",
@@ -858,6 +884,7 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		Set: true,
 	},
 	"/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults": {All: EndDeviceFieldPathsNested, Allowed: nsEndDeviceReadFieldPaths[:]},
+	"/ttn.lorawan.v3.NsEndDeviceRegistry/SimulateADR":          {All: MACSettingsFieldPathsNested, Allowed: MACSettingsFieldPathsNested, Set: true},
 
 	// Gateways:
 	"/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways": {
@@ -936,6 +963,10 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	// Storage Integration:
 	"/ttn.lorawan.v3.ApplicationUpStorage/GetStoredApplicationUp": {All: ApplicationUpFieldPathsNested, Allowed: applicationUpFieldMaskPaths()},
 
+	// FUOTA Package:
+	"/ttn.lorawan.v3.ApplicationFUOTA/Get":  {All: FUOTASessionFieldPathsNested, Allowed: FUOTASessionFieldPathsNested},
+	"/ttn.lorawan.v3.ApplicationFUOTA/List": {All: FUOTASessionFieldPathsNested, Allowed: FUOTASessionFieldPathsNested},
+
 	// Device Repository:
 	"/ttn.lorawan.v3.DeviceRepository/GetBrand":           {All: EndDeviceBrandFieldPathsNested, Allowed: EndDeviceBrandFieldPathsNested},
 	"/ttn.lorawan.v3.DeviceRepository/ListBrands":         {All: EndDeviceBrandFieldPathsNested, Allowed: EndDeviceBrandFieldPathsNested},
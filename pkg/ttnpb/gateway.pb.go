@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -36,6 +37,36 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GatewayUDPAuthenticationMode is the authentication mode of Semtech UDP packets of a gateway.
+// Authenticated packets end with an authentication timestamp and an authentication tag that is computed with the UDP secret of the gateway.
+type GatewayUDPAuthenticationMode int32
+
+const (
+	// UDP packets are not authenticated.
+	UDP_AUTHENTICATION_DISABLED GatewayUDPAuthenticationMode = 0
+	// UDP packets with an authentication tag are verified, and UDP packets without an authentication tag are accepted.
+	// Use this mode while migrating the gateway to authenticated UDP.
+	UDP_AUTHENTICATION_OPTIONAL GatewayUDPAuthenticationMode = 1
+	// Only UDP packets with a valid authentication tag are accepted.
+	UDP_AUTHENTICATION_REQUIRED GatewayUDPAuthenticationMode = 2
+)
+
+var GatewayUDPAuthenticationMode_name = map[int32]string{
+	0: "UDP_AUTHENTICATION_DISABLED",
+	1: "UDP_AUTHENTICATION_OPTIONAL",
+	2: "UDP_AUTHENTICATION_REQUIRED",
+}
+
+var GatewayUDPAuthenticationMode_value = map[string]int32{
+	"UDP_AUTHENTICATION_DISABLED": 0,
+	"UDP_AUTHENTICATION_OPTIONAL": 1,
+	"UDP_AUTHENTICATION_REQUIRED": 2,
+}
+
+func (GatewayUDPAuthenticationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{0}
+}
+
 type GatewayBrand struct {
 	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -502,6 +533,241 @@ func (m *GatewayClaimAuthenticationCode) GetValidTo() *time.Time {
 	return nil
 }
 
+// Settings for alerts about the health of a gateway connection.
+// Durations that are not set use the Gateway Server defaults. Durations that are zero disable the alert.
+type GatewayAlertSettings struct {
+	// Disable all alerts for the gateway.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Time after a disconnect after which an alert is raised if the gateway did not reconnect.
+	DisconnectThreshold *time.Duration `protobuf:"bytes,2,opt,name=disconnect_threshold,json=disconnectThreshold,proto3,stdduration" json:"disconnect_threshold,omitempty"`
+	// Time without uplink messages after which an alert is raised while the gateway is connected.
+	UplinkSilenceThreshold *time.Duration `protobuf:"bytes,3,opt,name=uplink_silence_threshold,json=uplinkSilenceThreshold,proto3,stdduration" json:"uplink_silence_threshold,omitempty"`
+	// Expected interval of status messages. An alert is raised when no status message is received for three intervals.
+	StatusInterval *time.Duration `protobuf:"bytes,4,opt,name=status_interval,json=statusInterval,proto3,stdduration" json:"status_interval,omitempty"`
+	// Maximum 90th percentile of the round-trip times.
+	MaxRoundTripTime     *time.Duration `protobuf:"bytes,5,opt,name=max_round_trip_time,json=maxRoundTripTime,proto3,stdduration" json:"max_round_trip_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GatewayAlertSettings) Reset()      { *m = GatewayAlertSettings{} }
+func (*GatewayAlertSettings) ProtoMessage() {}
+func (*GatewayAlertSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{6}
+}
+func (m *GatewayAlertSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAlertSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayAlertSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayAlertSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAlertSettings.Merge(m, src)
+}
+func (m *GatewayAlertSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAlertSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAlertSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAlertSettings proto.InternalMessageInfo
+
+func (m *GatewayAlertSettings) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *GatewayAlertSettings) GetDisconnectThreshold() *time.Duration {
+	if m != nil {
+		return m.DisconnectThreshold
+	}
+	return nil
+}
+
+func (m *GatewayAlertSettings) GetUplinkSilenceThreshold() *time.Duration {
+	if m != nil {
+		return m.UplinkSilenceThreshold
+	}
+	return nil
+}
+
+func (m *GatewayAlertSettings) GetStatusInterval() *time.Duration {
+	if m != nil {
+		return m.StatusInterval
+	}
+	return nil
+}
+
+func (m *GatewayAlertSettings) GetMaxRoundTripTime() *time.Duration {
+	if m != nil {
+		return m.MaxRoundTripTime
+	}
+	return nil
+}
+
+// Rules to filter the uplink messages of a gateway before they are forwarded upstream.
+// Uplink messages are dropped if any of the rules does not pass.
+type GatewayUplinkFilters struct {
+	// Count the uplink messages that do not pass the rules, but forward them anyway.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// DevAddr prefixes of data uplink messages that are forwarded. If empty, all DevAddrs are forwarded.
+	AllowDevAddrPrefixes []*DevAddrPrefix `protobuf:"bytes,2,rep,name=allow_dev_addr_prefixes,json=allowDevAddrPrefixes,proto3" json:"allow_dev_addr_prefixes,omitempty"`
+	// DevAddr prefixes of data uplink messages that are dropped.
+	DenyDevAddrPrefixes []*DevAddrPrefix `protobuf:"bytes,3,rep,name=deny_dev_addr_prefixes,json=denyDevAddrPrefixes,proto3" json:"deny_dev_addr_prefixes,omitempty"`
+	// JoinEUI ranges of join-request and rejoin-request messages that are forwarded. If empty, all JoinEUIs are forwarded.
+	AllowJoinEUIRanges []*GatewayUplinkFilters_JoinEUIRange `protobuf:"bytes,4,rep,name=allow_join_eui_ranges,json=allowJoinEuiRanges,proto3" json:"allow_join_eui_ranges,omitempty"`
+	// JoinEUI ranges of join-request and rejoin-request messages that are dropped.
+	DenyJoinEUIRanges []*GatewayUplinkFilters_JoinEUIRange `protobuf:"bytes,5,rep,name=deny_join_eui_ranges,json=denyJoinEuiRanges,proto3" json:"deny_join_eui_ranges,omitempty"`
+	// Minimum SNR (dB) of the best antenna.
+	MinSNR *types.FloatValue `protobuf:"bytes,6,opt,name=min_snr,json=minSnr,proto3" json:"min_snr,omitempty"`
+	// Minimum RSSI (dBm) of the best antenna.
+	MinRSSI *types.FloatValue `protobuf:"bytes,7,opt,name=min_rssi,json=minRssi,proto3" json:"min_rssi,omitempty"`
+	// Frequencies (Hz) of uplink messages that are forwarded. If empty, all frequencies are forwarded.
+	Frequencies          []uint64 `protobuf:"varint,8,rep,packed,name=frequencies,proto3" json:"frequencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayUplinkFilters) Reset()      { *m = GatewayUplinkFilters{} }
+func (*GatewayUplinkFilters) ProtoMessage() {}
+func (*GatewayUplinkFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{7}
+}
+func (m *GatewayUplinkFilters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayUplinkFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayUplinkFilters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayUplinkFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilters.Merge(m, src)
+}
+func (m *GatewayUplinkFilters) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayUplinkFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilters proto.InternalMessageInfo
+
+func (m *GatewayUplinkFilters) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *GatewayUplinkFilters) GetAllowDevAddrPrefixes() []*DevAddrPrefix {
+	if m != nil {
+		return m.AllowDevAddrPrefixes
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetDenyDevAddrPrefixes() []*DevAddrPrefix {
+	if m != nil {
+		return m.DenyDevAddrPrefixes
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetAllowJoinEUIRanges() []*GatewayUplinkFilters_JoinEUIRange {
+	if m != nil {
+		return m.AllowJoinEUIRanges
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetDenyJoinEUIRanges() []*GatewayUplinkFilters_JoinEUIRange {
+	if m != nil {
+		return m.DenyJoinEUIRanges
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetMinSNR() *types.FloatValue {
+	if m != nil {
+		return m.MinSNR
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetMinRSSI() *types.FloatValue {
+	if m != nil {
+		return m.MinRSSI
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilters) GetFrequencies() []uint64 {
+	if m != nil {
+		return m.Frequencies
+	}
+	return nil
+}
+
+// Inclusive range of JoinEUIs.
+type GatewayUplinkFilters_JoinEUIRange struct {
+	From                 go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=from,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"from"`
+	To                   go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=to,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"to"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *GatewayUplinkFilters_JoinEUIRange) Reset()      { *m = GatewayUplinkFilters_JoinEUIRange{} }
+func (*GatewayUplinkFilters_JoinEUIRange) ProtoMessage() {}
+func (*GatewayUplinkFilters_JoinEUIRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{7, 0}
+}
+func (m *GatewayUplinkFilters_JoinEUIRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayUplinkFilters_JoinEUIRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayUplinkFilters_JoinEUIRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayUplinkFilters_JoinEUIRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilters_JoinEUIRange.Merge(m, src)
+}
+func (m *GatewayUplinkFilters_JoinEUIRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayUplinkFilters_JoinEUIRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilters_JoinEUIRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilters_JoinEUIRange proto.InternalMessageInfo
+
 // Gateway is the message that defines a gateway on the network.
 type Gateway struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
@@ -564,15 +830,26 @@ type Gateway struct {
 	// Requires the RIGHT_GATEWAY_READ_SECRETS for reading and RIGHT_GATEWAY_WRITE_SECRETS for updating this value.
 	TargetCUPSKey *Secret `protobuf:"bytes,25,opt,name=target_cups_key,json=targetCupsKey,proto3" json:"target_cups_key,omitempty"`
 	// Require an authenticated gateway connection. This prevents the gateway from using the UDP protocol and requires authentication when using other protocols.
-	RequireAuthenticatedConnection bool     `protobuf:"varint,27,opt,name=require_authenticated_connection,json=requireAuthenticatedConnection,proto3" json:"require_authenticated_connection,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
-	XXX_sizecache                  int32    `json:"-"`
+	RequireAuthenticatedConnection bool `protobuf:"varint,27,opt,name=require_authenticated_connection,json=requireAuthenticatedConnection,proto3" json:"require_authenticated_connection,omitempty"`
+	// Settings for alerts about the health of the gateway connection.
+	// Alerts are published as events and sent by email to the collaborators of the gateway.
+	AlertSettings *GatewayAlertSettings `protobuf:"bytes,28,opt,name=alert_settings,json=alertSettings,proto3" json:"alert_settings,omitempty"`
+	// Rules to filter the uplink messages of the gateway before they are forwarded upstream.
+	UplinkFilters *GatewayUplinkFilters `protobuf:"bytes,29,opt,name=uplink_filters,json=uplinkFilters,proto3" json:"uplink_filters,omitempty"`
+	// The secret that authenticates UDP packets of the gateway.
+	// The authentication tag of a UDP packet is the HMAC-SHA256 of the packet and its authentication timestamp keyed with this secret, truncated to 16 bytes.
+	// Requires the RIGHT_GATEWAY_READ_SECRETS for reading and RIGHT_GATEWAY_WRITE_SECRETS for updating this value.
+	UDPSecret *Secret `protobuf:"bytes,30,opt,name=udp_secret,json=udpSecret,proto3" json:"udp_secret,omitempty"`
+	// The authentication mode of UDP packets of the gateway.
+	UDPAuthenticationMode GatewayUDPAuthenticationMode `protobuf:"varint,31,opt,name=udp_authentication_mode,json=udpAuthenticationMode,proto3,enum=ttn.lorawan.v3.GatewayUDPAuthenticationMode" json:"udp_authentication_mode,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                     `json:"-"`
+	XXX_sizecache         int32                        `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
 func (*Gateway) ProtoMessage() {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{6}
+	return fileDescriptor_1df6bae1ac946b39, []int{8}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,6 +1053,34 @@ func (m *Gateway) GetRequireAuthenticatedConnection() bool {
 	return false
 }
 
+func (m *Gateway) GetAlertSettings() *GatewayAlertSettings {
+	if m != nil {
+		return m.AlertSettings
+	}
+	return nil
+}
+
+func (m *Gateway) GetUplinkFilters() *GatewayUplinkFilters {
+	if m != nil {
+		return m.UplinkFilters
+	}
+	return nil
+}
+
+func (m *Gateway) GetUDPSecret() *Secret {
+	if m != nil {
+		return m.UDPSecret
+	}
+	return nil
+}
+
+func (m *Gateway) GetUDPAuthenticationMode() GatewayUDPAuthenticationMode {
+	if m != nil {
+		return m.UDPAuthenticationMode
+	}
+	return UDP_AUTHENTICATION_DISABLED
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -785,7 +1090,7 @@ type Gateways struct {
 func (m *Gateways) Reset()      { *m = Gateways{} }
 func (*Gateways) ProtoMessage() {}
 func (*Gateways) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{7}
+	return fileDescriptor_1df6bae1ac946b39, []int{9}
 }
 func (m *Gateways) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +1137,7 @@ type GetGatewayRequest struct {
 func (m *GetGatewayRequest) Reset()      { *m = GetGatewayRequest{} }
 func (*GetGatewayRequest) ProtoMessage() {}
 func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{8}
+	return fileDescriptor_1df6bae1ac946b39, []int{10}
 }
 func (m *GetGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -877,7 +1182,7 @@ type GetGatewayIdentifiersForEUIRequest struct {
 func (m *GetGatewayIdentifiersForEUIRequest) Reset()      { *m = GetGatewayIdentifiersForEUIRequest{} }
 func (*GetGatewayIdentifiersForEUIRequest) ProtoMessage() {}
 func (*GetGatewayIdentifiersForEUIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{9}
+	return fileDescriptor_1df6bae1ac946b39, []int{11}
 }
 func (m *GetGatewayIdentifiersForEUIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1234,7 @@ type ListGatewaysRequest struct {
 func (m *ListGatewaysRequest) Reset()      { *m = ListGatewaysRequest{} }
 func (*ListGatewaysRequest) ProtoMessage() {}
 func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{10}
+	return fileDescriptor_1df6bae1ac946b39, []int{12}
 }
 func (m *ListGatewaysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1011,7 +1316,7 @@ type CreateGatewayRequest struct {
 func (m *CreateGatewayRequest) Reset()      { *m = CreateGatewayRequest{} }
 func (*CreateGatewayRequest) ProtoMessage() {}
 func (*CreateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{11}
+	return fileDescriptor_1df6bae1ac946b39, []int{13}
 }
 func (m *CreateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1363,7 @@ type UpdateGatewayRequest struct {
 func (m *UpdateGatewayRequest) Reset()      { *m = UpdateGatewayRequest{} }
 func (*UpdateGatewayRequest) ProtoMessage() {}
 func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{12}
+	return fileDescriptor_1df6bae1ac946b39, []int{14}
 }
 func (m *UpdateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1412,7 @@ type ListGatewayAPIKeysRequest struct {
 func (m *ListGatewayAPIKeysRequest) Reset()      { *m = ListGatewayAPIKeysRequest{} }
 func (*ListGatewayAPIKeysRequest) ProtoMessage() {}
 func (*ListGatewayAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{13}
+	return fileDescriptor_1df6bae1ac946b39, []int{15}
 }
 func (m *ListGatewayAPIKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,7 +1466,7 @@ type GetGatewayAPIKeyRequest struct {
 func (m *GetGatewayAPIKeyRequest) Reset()      { *m = GetGatewayAPIKeyRequest{} }
 func (*GetGatewayAPIKeyRequest) ProtoMessage() {}
 func (*GetGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{14}
+	return fileDescriptor_1df6bae1ac946b39, []int{16}
 }
 func (m *GetGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1514,7 @@ type CreateGatewayAPIKeyRequest struct {
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
 func (*CreateGatewayAPIKeyRequest) ProtoMessage() {}
 func (*CreateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{15}
+	return fileDescriptor_1df6bae1ac946b39, []int{17}
 }
 func (m *CreateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1576,7 @@ type UpdateGatewayAPIKeyRequest struct {
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
 func (*UpdateGatewayAPIKeyRequest) ProtoMessage() {}
 func (*UpdateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{16}
+	return fileDescriptor_1df6bae1ac946b39, []int{18}
 }
 func (m *UpdateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1320,7 +1625,7 @@ type ListGatewayCollaboratorsRequest struct {
 func (m *ListGatewayCollaboratorsRequest) Reset()      { *m = ListGatewayCollaboratorsRequest{} }
 func (*ListGatewayCollaboratorsRequest) ProtoMessage() {}
 func (*ListGatewayCollaboratorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{17}
+	return fileDescriptor_1df6bae1ac946b39, []int{19}
 }
 func (m *ListGatewayCollaboratorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,7 +1678,7 @@ type GetGatewayCollaboratorRequest struct {
 func (m *GetGatewayCollaboratorRequest) Reset()      { *m = GetGatewayCollaboratorRequest{} }
 func (*GetGatewayCollaboratorRequest) ProtoMessage() {}
 func (*GetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{18}
+	return fileDescriptor_1df6bae1ac946b39, []int{20}
 }
 func (m *GetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1412,7 +1717,7 @@ type SetGatewayCollaboratorRequest struct {
 func (m *SetGatewayCollaboratorRequest) Reset()      { *m = SetGatewayCollaboratorRequest{} }
 func (*SetGatewayCollaboratorRequest) ProtoMessage() {}
 func (*SetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{19}
+	return fileDescriptor_1df6bae1ac946b39, []int{21}
 }
 func (m *SetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1462,7 +1767,7 @@ type GatewayAntenna struct {
 func (m *GatewayAntenna) Reset()      { *m = GatewayAntenna{} }
 func (*GatewayAntenna) ProtoMessage() {}
 func (*GatewayAntenna) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{20}
+	return fileDescriptor_1df6bae1ac946b39, []int{22}
 }
 func (m *GatewayAntenna) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1549,7 +1854,7 @@ type GatewayStatus struct {
 func (m *GatewayStatus) Reset()      { *m = GatewayStatus{} }
 func (*GatewayStatus) ProtoMessage() {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{21}
+	return fileDescriptor_1df6bae1ac946b39, []int{23}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1639,15 +1944,19 @@ type GatewayConnectionStats struct {
 	DownlinkCount          uint64                                 `protobuf:"varint,8,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
 	RoundTripTimes         *GatewayConnectionStats_RoundTripTimes `protobuf:"bytes,9,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
 	// Statistics for each sub band.
-	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Number of uplink messages that were dropped by the uplink filters of the gateway.
+	FilteredUplinkCount uint64 `protobuf:"varint,11,opt,name=filtered_uplink_count,json=filteredUplinkCount,proto3" json:"filtered_uplink_count,omitempty"`
+	// Number of uplink messages that did not pass the uplink filters of the gateway in dry-run mode.
+	DryRunFilteredUplinkCount uint64   `protobuf:"varint,12,opt,name=dry_run_filtered_uplink_count,json=dryRunFilteredUplinkCount,proto3" json:"dry_run_filtered_uplink_count,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()      { *m = GatewayConnectionStats{} }
 func (*GatewayConnectionStats) ProtoMessage() {}
 func (*GatewayConnectionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22}
+	return fileDescriptor_1df6bae1ac946b39, []int{24}
 }
 func (m *GatewayConnectionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1746,6 +2055,20 @@ func (m *GatewayConnectionStats) GetSubBands() []*GatewayConnectionStats_SubBand
 	return nil
 }
 
+func (m *GatewayConnectionStats) GetFilteredUplinkCount() uint64 {
+	if m != nil {
+		return m.FilteredUplinkCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats) GetDryRunFilteredUplinkCount() uint64 {
+	if m != nil {
+		return m.DryRunFilteredUplinkCount
+	}
+	return 0
+}
+
 type GatewayConnectionStats_RoundTripTimes struct {
 	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
 	Max                  time.Duration `protobuf:"bytes,2,opt,name=max,proto3,stdduration" json:"max"`
@@ -1758,7 +2081,7 @@ type GatewayConnectionStats_RoundTripTimes struct {
 func (m *GatewayConnectionStats_RoundTripTimes) Reset()      { *m = GatewayConnectionStats_RoundTripTimes{} }
 func (*GatewayConnectionStats_RoundTripTimes) ProtoMessage() {}
 func (*GatewayConnectionStats_RoundTripTimes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 0}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 0}
 }
 func (m *GatewayConnectionStats_RoundTripTimes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +2150,7 @@ type GatewayConnectionStats_SubBand struct {
 func (m *GatewayConnectionStats_SubBand) Reset()      { *m = GatewayConnectionStats_SubBand{} }
 func (*GatewayConnectionStats_SubBand) ProtoMessage() {}
 func (*GatewayConnectionStats_SubBand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 1}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 1}
 }
 func (m *GatewayConnectionStats_SubBand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1885,6 +2208,8 @@ func (m *GatewayConnectionStats_SubBand) GetDownlinkUtilization() float32 {
 }
 
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayUDPAuthenticationMode", GatewayUDPAuthenticationMode_name, GatewayUDPAuthenticationMode_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayUDPAuthenticationMode", GatewayUDPAuthenticationMode_name, GatewayUDPAuthenticationMode_value)
 	proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	golang_proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	proto.RegisterType((*GatewayModel)(nil), "ttn.lorawan.v3.GatewayModel")
@@ -1899,6 +2224,12 @@ func init() {
 	golang_proto.RegisterType((*GatewayVersion)(nil), "ttn.lorawan.v3.GatewayVersion")
 	proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
 	golang_proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
+	proto.RegisterType((*GatewayAlertSettings)(nil), "ttn.lorawan.v3.GatewayAlertSettings")
+	golang_proto.RegisterType((*GatewayAlertSettings)(nil), "ttn.lorawan.v3.GatewayAlertSettings")
+	proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
+	golang_proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
+	proto.RegisterType((*GatewayUplinkFilters_JoinEUIRange)(nil), "ttn.lorawan.v3.GatewayUplinkFilters.JoinEUIRange")
+	golang_proto.RegisterType((*GatewayUplinkFilters_JoinEUIRange)(nil), "ttn.lorawan.v3.GatewayUplinkFilters.JoinEUIRange")
 	proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	golang_proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.Gateway.AttributesEntry")
@@ -1953,199 +2284,245 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 3028 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x70, 0x1b, 0xc7,
-	0x95, 0x46, 0x03, 0xfc, 0x01, 0x1b, 0xfc, 0x81, 0xda, 0x34, 0x35, 0xa2, 0xa4, 0x01, 0x0d, 0xd3,
-	0x6b, 0x4a, 0x2b, 0x80, 0x36, 0x65, 0x6d, 0xed, 0xca, 0x2b, 0x4b, 0x00, 0x68, 0xd9, 0x2c, 0x51,
-	0x16, 0x3d, 0x14, 0xd7, 0xb5, 0x96, 0xac, 0xa9, 0xc6, 0x4c, 0x13, 0x1c, 0x73, 0x30, 0x83, 0xed,
-	0xe9, 0xa1, 0x08, 0xff, 0x95, 0x6b, 0xcb, 0x5b, 0xeb, 0xf2, 0x21, 0xe5, 0xd2, 0xc9, 0xe5, 0xe4,
-	0xe0, 0x1c, 0x92, 0xb8, 0xe2, 0x1c, 0x5c, 0x39, 0xa4, 0x7c, 0xc8, 0xc1, 0x87, 0x24, 0xa5, 0x53,
-	0x4a, 0xa7, 0x94, 0x2b, 0xa9, 0x62, 0x2c, 0xf0, 0xe2, 0xdc, 0x5c, 0xc9, 0x21, 0x2e, 0x9e, 0x52,
-	0xdd, 0xd3, 0x33, 0x18, 0x80, 0x3f, 0x22, 0x23, 0xcb, 0xc9, 0x09, 0xd3, 0xdd, 0xdf, 0x7b, 0xfd,
-	0xfa, 0xbd, 0xd7, 0xef, 0xf5, 0x7b, 0x80, 0x39, 0xdb, 0xa5, 0xf8, 0x26, 0x76, 0x0a, 0x1e, 0xc3,
-	0xc6, 0xea, 0x34, 0x6e, 0x58, 0xd3, 0x35, 0xcc, 0xc8, 0x4d, 0xdc, 0x2c, 0x36, 0xa8, 0xcb, 0x5c,
-	0x34, 0xcc, 0x98, 0x53, 0x94, 0xa0, 0xe2, 0xda, 0xe9, 0xf1, 0x52, 0xcd, 0x62, 0x2b, 0x7e, 0xb5,
-	0x68, 0xb8, 0xf5, 0x69, 0xe2, 0xac, 0xb9, 0xcd, 0x06, 0x75, 0xd7, 0x9b, 0xd3, 0x02, 0x6c, 0x14,
-	0x6a, 0xc4, 0x29, 0xac, 0x61, 0xdb, 0x32, 0x31, 0x23, 0xd3, 0xdb, 0x3e, 0x02, 0x96, 0xe3, 0x85,
-	0x18, 0x8b, 0x9a, 0x5b, 0x73, 0x03, 0xe2, 0xaa, 0xbf, 0x2c, 0x46, 0x62, 0x20, 0xbe, 0x24, 0x5c,
-	0xad, 0xb9, 0x6e, 0xcd, 0x26, 0x6d, 0x94, 0xe9, 0x53, 0xcc, 0x2c, 0xd7, 0x91, 0xeb, 0x13, 0xdd,
-	0xeb, 0xcb, 0x16, 0xb1, 0x4d, 0xbd, 0x8e, 0xbd, 0x55, 0x89, 0x38, 0xd6, 0x8d, 0xf0, 0x18, 0xf5,
-	0x0d, 0x26, 0x57, 0x73, 0xdd, 0xab, 0xcc, 0xaa, 0x13, 0x8f, 0xe1, 0x7a, 0x43, 0x02, 0x26, 0xb7,
-	0xeb, 0xc8, 0x70, 0x1d, 0x86, 0x0d, 0xa6, 0x5b, 0xce, 0x72, 0x28, 0xe6, 0xf1, 0xed, 0x28, 0xe2,
-	0xf8, 0x75, 0x4f, 0x2e, 0x3f, 0xba, 0x7d, 0xd9, 0x32, 0x89, 0xc3, 0xac, 0x65, 0x8b, 0xd0, 0x10,
-	0x34, 0xb1, 0x1d, 0x54, 0x27, 0x0c, 0x9b, 0x98, 0xe1, 0x50, 0x19, 0xdb, 0x11, 0xd4, 0xaa, 0xad,
-	0xb0, 0x90, 0xc3, 0x0e, 0xf6, 0xf4, 0x88, 0x41, 0x49, 0x08, 0xc8, 0xaf, 0xc2, 0xc1, 0xe7, 0x02,
-	0x03, 0x97, 0x29, 0x76, 0x4c, 0x34, 0x06, 0x93, 0x96, 0xa9, 0x80, 0x09, 0x30, 0x35, 0x50, 0xee,
-	0x6b, 0x6d, 0xe4, 0x92, 0x73, 0xb3, 0x5a, 0xd2, 0x32, 0x11, 0x82, 0x3d, 0x0e, 0xae, 0x13, 0x25,
-	0xc9, 0x57, 0x34, 0xf1, 0x8d, 0x8e, 0xc0, 0x94, 0x4f, 0x6d, 0x25, 0x25, 0xc0, 0xfd, 0xad, 0x8d,
-	0x5c, 0x6a, 0x49, 0x9b, 0xd7, 0xf8, 0x1c, 0x1a, 0x85, 0xbd, 0xb6, 0x5b, 0x73, 0x3d, 0xa5, 0x67,
-	0x22, 0x35, 0x35, 0xa0, 0x05, 0x83, 0xfc, 0x2f, 0x40, 0xb4, 0xdb, 0x65, 0xd7, 0x24, 0x36, 0x5a,
-	0x80, 0xe9, 0x2a, 0xdf, 0x56, 0x8f, 0xf6, 0x3c, 0xb3, 0x55, 0x7e, 0x9c, 0x3e, 0xa6, 0x4c, 0xce,
-	0xa8, 0x37, 0xae, 0xe1, 0xc2, 0x6b, 0x4f, 0x14, 0xfe, 0xe3, 0x95, 0xa9, 0xf3, 0x67, 0xaf, 0x15,
-	0x5e, 0x39, 0x1f, 0x0e, 0x4f, 0xbc, 0x3e, 0x73, 0xea, 0xcd, 0xc9, 0x3b, 0x00, 0xb4, 0x36, 0x72,
-	0xfd, 0x42, 0xe8, 0xb9, 0x59, 0xad, 0x5f, 0xb0, 0x99, 0x33, 0xd1, 0x05, 0x21, 0xbf, 0x90, 0xb2,
-	0xfc, 0xc4, 0x81, 0x78, 0x75, 0x9f, 0x34, 0xd5, 0x3e, 0x69, 0xfe, 0x27, 0x49, 0x78, 0x44, 0x0a,
-	0xfe, 0x5f, 0x84, 0x7a, 0x96, 0xeb, 0xcc, 0xb5, 0x8d, 0xf5, 0x00, 0x4e, 0xb1, 0x00, 0xd3, 0x75,
-	0xae, 0x20, 0x3d, 0x3a, 0xcb, 0x01, 0x39, 0x0a, 0xf5, 0x72, 0x8e, 0x82, 0xcd, 0x9c, 0x89, 0x66,
-	0x60, 0x76, 0x05, 0x53, 0xf3, 0x26, 0xa6, 0x44, 0x5f, 0x0b, 0x8e, 0x10, 0x1a, 0x6e, 0xab, 0xdc,
-	0x43, 0x93, 0xca, 0x84, 0x36, 0x12, 0x02, 0xe4, 0x11, 0x39, 0xcd, 0xb2, 0x45, 0xeb, 0x1d, 0x34,
-	0x3d, 0x5d, 0x34, 0x21, 0x40, 0xd2, 0xe4, 0xff, 0x9c, 0x8c, 0x4c, 0xac, 0x61, 0xd3, 0x72, 0xd1,
-	0x18, 0xec, 0x23, 0x0e, 0xae, 0xda, 0x44, 0xa8, 0x26, 0xad, 0xc9, 0x11, 0x3a, 0x0a, 0x07, 0x8c,
-	0x15, 0xab, 0xa1, 0xb3, 0x66, 0x23, 0xf4, 0xaa, 0x34, 0x9f, 0xb8, 0xda, 0x6c, 0x10, 0x74, 0x0c,
-	0x0e, 0x2c, 0x53, 0xf2, 0x3f, 0x3e, 0x71, 0x8c, 0xa6, 0x10, 0xb3, 0x47, 0x6b, 0x4f, 0xa0, 0x69,
-	0x98, 0xa1, 0x9e, 0x67, 0xe9, 0xee, 0xf2, 0xb2, 0x47, 0x98, 0x10, 0x29, 0x59, 0x1e, 0x6e, 0x6d,
-	0xe4, 0xa0, 0xb6, 0xb8, 0x38, 0x77, 0x45, 0xcc, 0x6a, 0x90, 0x43, 0x82, 0x6f, 0xf4, 0x12, 0xcc,
-	0xb2, 0x75, 0xdd, 0x70, 0x9d, 0x65, 0xab, 0x26, 0x83, 0x85, 0xd2, 0x3b, 0x01, 0xa6, 0x32, 0x33,
-	0xa7, 0x8a, 0x9d, 0xf1, 0xac, 0x18, 0x97, 0xbd, 0x78, 0x75, 0xbd, 0x12, 0xa7, 0xd1, 0x46, 0x58,
-	0xe7, 0xc4, 0xf8, 0x3b, 0x00, 0x8e, 0x74, 0x81, 0xd0, 0xa3, 0x70, 0xa8, 0x6e, 0x39, 0x7a, 0x5b,
-	0x7e, 0x20, 0xe4, 0x1f, 0xac, 0x5b, 0xce, 0xc5, 0xe8, 0x08, 0x1c, 0x84, 0xd7, 0x63, 0xa0, 0xa4,
-	0x04, 0xe1, 0xf5, 0x36, 0xe8, 0x71, 0x38, 0xe2, 0xb8, 0xcc, 0x58, 0xd1, 0xbb, 0x75, 0x31, 0x2c,
-	0xa6, 0x23, 0x60, 0xfe, 0x77, 0x00, 0x0e, 0x77, 0xba, 0x27, 0xba, 0x0c, 0x53, 0x96, 0xe9, 0x89,
-	0xbd, 0x33, 0x33, 0x27, 0x76, 0x39, 0xe5, 0x76, 0x5f, 0x2e, 0x67, 0xb7, 0xca, 0xbd, 0xef, 0x81,
-	0x64, 0x16, 0xdc, 0xde, 0xc8, 0x25, 0xee, 0x6c, 0xe4, 0x80, 0xc6, 0xf9, 0x70, 0x2b, 0x36, 0x56,
-	0x5c, 0xe6, 0x7a, 0x4a, 0x52, 0x5c, 0x68, 0x39, 0x42, 0x4f, 0xc1, 0x3e, 0xca, 0x55, 0xe5, 0x29,
-	0xa9, 0x89, 0xd4, 0x54, 0x66, 0xe6, 0xd8, 0x5e, 0xfa, 0xd4, 0x24, 0x16, 0x3d, 0x02, 0x07, 0x0d,
-	0xdb, 0x35, 0x56, 0x75, 0xcf, 0xf5, 0xa9, 0x41, 0x94, 0xfe, 0x09, 0x30, 0x35, 0xa4, 0x65, 0xc4,
-	0xdc, 0xa2, 0x98, 0x3a, 0xdb, 0xf3, 0xd9, 0x47, 0xb9, 0x44, 0xfe, 0xb7, 0x00, 0xaa, 0x92, 0x43,
-	0xc5, 0xc6, 0x56, 0xbd, 0xe4, 0xb3, 0x15, 0x2e, 0xab, 0x21, 0x54, 0x5d, 0x71, 0x4d, 0x82, 0x8a,
-	0xb0, 0x2f, 0x88, 0x68, 0xf2, 0xac, 0x63, 0xdd, 0x12, 0x2c, 0x8a, 0x55, 0x4d, 0xa2, 0xd0, 0x79,
-	0x08, 0x45, 0xfe, 0xd1, 0x97, 0xa9, 0x5b, 0x17, 0x6a, 0xcf, 0xcc, 0x8c, 0x17, 0x83, 0x98, 0x5f,
-	0x0c, 0x63, 0x7e, 0xf1, 0x6a, 0x18, 0xf3, 0xcb, 0x3d, 0xef, 0xff, 0x31, 0x07, 0xb4, 0x01, 0x41,
-	0x73, 0x91, 0xba, 0x75, 0xf4, 0x34, 0x4c, 0x07, 0x0c, 0x98, 0x2b, 0xcc, 0xb1, 0x1f, 0xf2, 0x7e,
-	0x41, 0x71, 0xd5, 0xcd, 0xff, 0x30, 0x0b, 0xfb, 0xe5, 0x81, 0xd0, 0xc5, 0xb8, 0x89, 0xf2, 0xbb,
-	0x28, 0x6e, 0x1f, 0xb6, 0xa9, 0x40, 0x68, 0x50, 0x82, 0x19, 0x31, 0x75, 0xcc, 0xf6, 0x71, 0xa2,
-	0x34, 0x27, 0x0f, 0x4e, 0x25, 0xe9, 0x4a, 0x8c, 0x33, 0xf1, 0x1b, 0x66, 0xc8, 0x24, 0x75, 0x10,
-	0x26, 0x92, 0xae, 0x24, 0x74, 0x6b, 0x12, 0x9b, 0x48, 0x26, 0xe3, 0xfb, 0xd5, 0xad, 0xa4, 0x29,
-	0x31, 0x74, 0x54, 0xc6, 0xde, 0x8e, 0x28, 0x33, 0x23, 0xd3, 0xcd, 0x49, 0x98, 0x31, 0x89, 0x67,
-	0x50, 0xab, 0x11, 0x5d, 0xe0, 0x81, 0x72, 0x7a, 0xab, 0xdc, 0x4b, 0x53, 0xca, 0x9d, 0x11, 0x2d,
-	0xbe, 0x88, 0xde, 0x82, 0x10, 0x33, 0x46, 0xad, 0xaa, 0xcf, 0x88, 0xa7, 0xf4, 0x09, 0xdf, 0x7c,
-	0x7c, 0x17, 0x15, 0x17, 0x4b, 0x11, 0xf2, 0x59, 0x87, 0xd1, 0x26, 0x8f, 0xb5, 0x33, 0x1f, 0x82,
-	0xe9, 0x2c, 0xcc, 0x4f, 0xd2, 0xfc, 0xbd, 0x43, 0xee, 0x49, 0x2e, 0xc0, 0x6d, 0xa0, 0xc5, 0x76,
-	0x44, 0xcf, 0xc3, 0xc1, 0xf8, 0x9b, 0x40, 0xe9, 0x17, 0x12, 0x1c, 0xed, 0x96, 0xa0, 0x12, 0x60,
-	0xe6, 0x9c, 0x65, 0x57, 0x9c, 0xe4, 0x16, 0x48, 0x66, 0xa1, 0x96, 0x31, 0xda, 0xd3, 0xe8, 0x3a,
-	0xcc, 0xc8, 0xd8, 0xab, 0x73, 0x6f, 0x49, 0xdf, 0xff, 0x85, 0x86, 0x6b, 0x21, 0xca, 0x43, 0xbf,
-	0x06, 0x70, 0x4c, 0x3e, 0xf0, 0x74, 0x8f, 0xd0, 0x35, 0x42, 0x75, 0x6c, 0x9a, 0x94, 0x78, 0x9e,
-	0x32, 0x20, 0xf4, 0xfb, 0x3d, 0xb0, 0x55, 0x7e, 0x0f, 0xd0, 0xff, 0x07, 0x33, 0xef, 0x80, 0x1b,
-	0x53, 0xe7, 0xcf, 0x72, 0x0d, 0xe0, 0xc2, 0x6b, 0xa5, 0xc2, 0xcb, 0x5c, 0x01, 0x6f, 0xc4, 0xbe,
-	0xdb, 0x9f, 0xd7, 0x0b, 0xaf, 0x9c, 0x8c, 0x2d, 0x9c, 0xb8, 0x5e, 0x3c, 0x71, 0x92, 0xd3, 0x95,
-	0x0a, 0x2f, 0x4b, 0xc5, 0xbd, 0x11, 0xfb, 0x6e, 0x7f, 0x0a, 0xba, 0xf6, 0xc2, 0x89, 0xa9, 0xf3,
-	0x67, 0xcf, 0x5e, 0xe3, 0x5f, 0xaf, 0x3f, 0x79, 0xea, 0xcc, 0x9b, 0x27, 0xce, 0x4f, 0xbe, 0x71,
-	0x63, 0x52, 0x1b, 0x95, 0xe2, 0x2e, 0x0a, 0x69, 0x4b, 0x81, 0xb0, 0x28, 0x07, 0x33, 0xd8, 0x67,
-	0xae, 0x1e, 0xf8, 0xa2, 0x02, 0x45, 0xaa, 0x81, 0x7c, 0x6a, 0x49, 0xcc, 0xa0, 0x69, 0x38, 0x1c,
-	0xac, 0xe9, 0xc6, 0x0a, 0x76, 0x1c, 0x62, 0x2b, 0x99, 0xb8, 0xff, 0xbc, 0x0d, 0xb4, 0xa1, 0x60,
-	0xbd, 0x12, 0x2c, 0xa3, 0x8b, 0xf0, 0x50, 0x14, 0x76, 0xf5, 0x86, 0x8d, 0xb9, 0xfa, 0x95, 0x41,
-	0x41, 0x33, 0x1e, 0xf8, 0xe5, 0x85, 0xd6, 0x46, 0x6e, 0x24, 0x0a, 0xc2, 0x0b, 0x36, 0x76, 0xe6,
-	0x66, 0xb5, 0x91, 0xe5, 0x8e, 0x09, 0x9e, 0xca, 0xd1, 0x36, 0x3e, 0x9e, 0x32, 0xca, 0xa3, 0x68,
-	0x39, 0xbf, 0x55, 0xce, 0xdc, 0x02, 0xe9, 0x6c, 0x3a, 0x1f, 0xf2, 0xcb, 0x76, 0xf1, 0xf3, 0xb4,
-	0x6c, 0x17, 0x43, 0xee, 0x5b, 0x69, 0xec, 0x30, 0xe2, 0x38, 0xd8, 0x53, 0x86, 0x84, 0x5f, 0xa9,
-	0xbb, 0xb8, 0x43, 0x29, 0x80, 0x95, 0x07, 0xa5, 0x6b, 0x89, 0x4b, 0xab, 0x45, 0xd4, 0x3c, 0x0b,
-	0x79, 0x0c, 0x33, 0xdf, 0xd3, 0x1b, 0x7e, 0xd5, 0xb6, 0x0c, 0x65, 0x58, 0xe8, 0x6d, 0x30, 0x98,
-	0x5c, 0x10, 0x73, 0x3c, 0x0b, 0xd9, 0x6e, 0x10, 0x70, 0x43, 0xd8, 0x88, 0x80, 0x0d, 0x87, 0xd3,
-	0x12, 0xf8, 0x14, 0x1c, 0xf3, 0x8c, 0x15, 0x62, 0xfa, 0x36, 0xd1, 0x4d, 0xf7, 0xa6, 0x63, 0x5b,
-	0xce, 0xaa, 0x6e, 0x73, 0x73, 0x64, 0x05, 0x7e, 0x34, 0x5c, 0x9d, 0x95, 0x8b, 0xf3, 0xdc, 0x30,
-	0xa7, 0x20, 0x22, 0xce, 0xb2, 0x4b, 0x0d, 0xa2, 0x9b, 0x3e, 0x6b, 0xea, 0x46, 0xd3, 0xb0, 0x89,
-	0x72, 0x48, 0x50, 0x64, 0xe5, 0xca, 0xac, 0xcf, 0x9a, 0x15, 0x3e, 0x8f, 0x5e, 0x85, 0x4a, 0xc4,
-	0xba, 0x81, 0xd9, 0x0a, 0x4f, 0xea, 0x1e, 0xa3, 0xd8, 0x72, 0x98, 0x82, 0x26, 0xc0, 0xd4, 0xf0,
-	0xcc, 0xbf, 0x74, 0xeb, 0x22, 0xdc, 0x6d, 0x01, 0xb3, 0x95, 0x4a, 0x84, 0x16, 0x86, 0xff, 0x5f,
-	0x7e, 0x2f, 0xb4, 0x31, 0x73, 0x47, 0x04, 0xfa, 0xef, 0xd8, 0x79, 0xb0, 0xd3, 0xe4, 0x65, 0x80,
-	0x6e, 0x12, 0x1b, 0x37, 0x95, 0x87, 0xc4, 0x25, 0x3c, 0xb2, 0x2d, 0xb2, 0xcd, 0xca, 0x37, 0x80,
-	0x88, 0x8e, 0xe0, 0x03, 0x1e, 0xdc, 0xa2, 0x43, 0x97, 0x02, 0x0e, 0xb3, 0x9c, 0x01, 0x3a, 0x07,
-	0x8f, 0x4a, 0x6f, 0x8c, 0x54, 0xcb, 0xd3, 0x91, 0x1e, 0x28, 0x5e, 0x79, 0x58, 0x9c, 0x5e, 0x09,
-	0x20, 0xf3, 0x12, 0xc1, 0x93, 0xcf, 0xa2, 0x58, 0x47, 0x2f, 0xc0, 0x61, 0xbb, 0xea, 0xe9, 0xb6,
-	0xe3, 0xe9, 0x32, 0xf7, 0x8d, 0xed, 0x95, 0xfb, 0xca, 0xd9, 0xd6, 0x46, 0x6e, 0x70, 0xbe, 0xbc,
-	0x38, 0xff, 0xc2, 0xa2, 0xcc, 0x86, 0x83, 0x76, 0xd5, 0x9b, 0x77, 0xbc, 0x60, 0x84, 0x5e, 0x85,
-	0x47, 0x0c, 0x9e, 0x5e, 0x75, 0xdc, 0x91, 0x5f, 0x75, 0xc3, 0x35, 0x89, 0x72, 0x58, 0xb0, 0x2e,
-	0xee, 0xe2, 0x62, 0xbb, 0xa4, 0x65, 0xed, 0xb0, 0xb1, 0x4b, 0xbe, 0x9e, 0x85, 0x23, 0x0c, 0xd3,
-	0x1a, 0x61, 0xba, 0xe1, 0x37, 0x3c, 0xdd, 0xa7, 0x96, 0xa2, 0x88, 0x5b, 0x75, 0x6c, 0xab, 0x9c,
-	0xa6, 0x7d, 0xef, 0x02, 0x10, 0x3c, 0x61, 0x87, 0xae, 0x0a, 0x54, 0x65, 0x69, 0x61, 0x71, 0x49,
-	0x9b, 0xd3, 0x86, 0x02, 0xa2, 0x8a, 0xdf, 0xf0, 0x96, 0xa8, 0x85, 0x5e, 0xec, 0xe4, 0xb2, 0x4a,
-	0x9a, 0xca, 0x91, 0x3d, 0x55, 0x70, 0xa8, 0x93, 0xe5, 0x25, 0xd2, 0x8c, 0xb3, 0xbc, 0x44, 0x9a,
-	0xe8, 0x79, 0x38, 0xc1, 0x6f, 0x9a, 0x45, 0x49, 0x5c, 0x0d, 0xc4, 0xe4, 0x2e, 0xe6, 0x10, 0x43,
-	0xe4, 0x9c, 0xa3, 0xc2, 0x30, 0xaa, 0xc4, 0x95, 0xe2, 0xb0, 0x4a, 0x84, 0x1a, 0x3f, 0x07, 0x47,
-	0xba, 0x52, 0x0a, 0xca, 0xc2, 0x14, 0x97, 0x51, 0x54, 0x07, 0x1a, 0xff, 0xe4, 0x15, 0xd2, 0x1a,
-	0xb6, 0xfd, 0xf0, 0xed, 0x1b, 0x0c, 0xce, 0x26, 0xff, 0x1d, 0xe4, 0xcf, 0xc3, 0xb4, 0x54, 0xae,
-	0x87, 0x4e, 0xc3, 0xb4, 0x8c, 0x77, 0xfc, 0xa1, 0xc0, 0xef, 0xfa, 0xe1, 0xdd, 0x5e, 0x58, 0x11,
-	0x30, 0xff, 0x09, 0x80, 0x87, 0x9e, 0x23, 0x2c, 0x5c, 0xe0, 0xe1, 0xc3, 0x63, 0x68, 0x09, 0x66,
-	0xc2, 0x48, 0x7f, 0xbf, 0xcf, 0x0e, 0x58, 0x0b, 0x51, 0x1e, 0xcf, 0xf9, 0xed, 0x02, 0x7b, 0xd7,
-	0xd7, 0xc7, 0x45, 0x0e, 0xb9, 0x8c, 0xbd, 0xd5, 0x72, 0x8f, 0x88, 0x41, 0x03, 0xcb, 0xe1, 0x44,
-	0xde, 0x87, 0xf9, 0xb6, 0xb0, 0xb1, 0x7d, 0x2f, 0xba, 0xf4, 0xd9, 0xa5, 0xb9, 0x50, 0xfa, 0x2b,
-	0x30, 0x45, 0x7c, 0x4b, 0x48, 0x3d, 0x58, 0x3e, 0xc7, 0x79, 0xfc, 0x7e, 0x23, 0x77, 0xa6, 0xe6,
-	0x16, 0xd9, 0x0a, 0x61, 0x2b, 0x96, 0x53, 0xf3, 0x8a, 0x0e, 0x61, 0x37, 0x5d, 0xba, 0x3a, 0xdd,
-	0x59, 0xf2, 0xae, 0x9d, 0x9e, 0x6e, 0xac, 0xd6, 0xa6, 0x79, 0x99, 0xe1, 0x15, 0x9f, 0x5d, 0x9a,
-	0xfb, 0xb7, 0xa7, 0x34, 0xce, 0x29, 0xff, 0x75, 0x12, 0x3e, 0x34, 0x6f, 0x79, 0xe1, 0xc6, 0x5e,
-	0xb8, 0xd1, 0x8b, 0x3c, 0x73, 0xdb, 0x36, 0xae, 0xba, 0x14, 0x33, 0x97, 0x4a, 0x3d, 0x15, 0xba,
-	0xf5, 0x74, 0x85, 0xd6, 0xb0, 0x63, 0xbd, 0x26, 0xfc, 0xfa, 0x0a, 0x5d, 0xf2, 0x08, 0x8d, 0x89,
-	0xae, 0x75, 0xb0, 0xb8, 0x6f, 0x15, 0xa1, 0x9b, 0xb0, 0xd7, 0xa5, 0x26, 0xa1, 0xb2, 0x62, 0xc3,
-	0x5b, 0xe5, 0x1b, 0xf4, 0xba, 0x96, 0x88, 0xec, 0xa0, 0x5b, 0xa6, 0x96, 0x29, 0xc4, 0x07, 0xe1,
-	0x37, 0xf1, 0x2d, 0x6d, 0xb0, 0x10, 0x1f, 0x89, 0xb7, 0x94, 0xd6, 0x5b, 0x10, 0x3f, 0xb1, 0x87,
-	0xa3, 0x96, 0x29, 0xc4, 0x06, 0xc1, 0x7e, 0x48, 0x85, 0xbd, 0xb6, 0x55, 0xb7, 0x82, 0x1a, 0x6b,
-	0x48, 0xc4, 0xcc, 0x93, 0x29, 0xe5, 0xab, 0x7e, 0x2d, 0x98, 0xe6, 0xb5, 0x72, 0x03, 0xd7, 0x88,
-	0x78, 0x8b, 0x0d, 0x69, 0xe2, 0x1b, 0x29, 0xb0, 0x5f, 0x3e, 0xe8, 0x94, 0x3e, 0x71, 0x5d, 0xc2,
-	0x61, 0xfe, 0x97, 0x00, 0x8e, 0x56, 0xc4, 0x1e, 0x5d, 0xae, 0x59, 0x81, 0xfd, 0x52, 0x44, 0xa9,
-	0xee, 0xdd, 0x9c, 0x7c, 0x07, 0x5f, 0x0c, 0x29, 0x91, 0xde, 0x65, 0xb8, 0xe4, 0xdf, 0x61, 0x38,
-	0x91, 0x29, 0x23, 0xfe, 0x9d, 0x66, 0xcc, 0xff, 0x00, 0xc0, 0xd1, 0xe0, 0x35, 0xf1, 0x20, 0xc4,
-	0xbf, 0xef, 0x7b, 0xf4, 0x63, 0x00, 0x8f, 0xc4, 0x1c, 0xba, 0xb4, 0x30, 0x77, 0x89, 0xb4, 0xdd,
-	0xfa, 0x01, 0xdd, 0xfe, 0xc8, 0x41, 0x92, 0x7b, 0x3b, 0x48, 0xaa, 0xed, 0x20, 0xf9, 0x5b, 0x00,
-	0x1e, 0x6e, 0xdf, 0xf8, 0x40, 0xce, 0x07, 0x2c, 0xe6, 0x04, 0xec, 0x5b, 0x25, 0xcd, 0x76, 0x37,
-	0x65, 0xa0, 0xb5, 0x91, 0xeb, 0xbd, 0x44, 0x9a, 0x73, 0xb3, 0x5a, 0xef, 0x2a, 0x69, 0xce, 0x99,
-	0xf9, 0xef, 0x27, 0xe1, 0x78, 0x87, 0x6f, 0x7e, 0x27, 0x72, 0x1d, 0x8d, 0x77, 0xd5, 0xba, 0xeb,
-	0x9d, 0x0b, 0xb0, 0x2f, 0xe8, 0xe5, 0x89, 0xda, 0x7a, 0x78, 0xe6, 0xe1, 0xee, 0xed, 0x34, 0xbe,
-	0x5a, 0x3e, 0xb4, 0x55, 0x1e, 0xbe, 0x05, 0x32, 0x69, 0xa0, 0x80, 0xbc, 0x7c, 0xd1, 0x48, 0x3a,
-	0xf4, 0x1c, 0x84, 0x64, 0xbd, 0x61, 0x51, 0xe2, 0xf1, 0x7a, 0xac, 0xe7, 0x9e, 0xf5, 0x18, 0xf7,
-	0xfe, 0x9f, 0x83, 0xe4, 0x05, 0x10, 0xd4, 0x65, 0x92, 0xb6, 0xc4, 0xf2, 0x7f, 0x05, 0x70, 0xbc,
-	0xc3, 0xf5, 0xbf, 0x13, 0xed, 0x94, 0x60, 0x3f, 0x6e, 0x58, 0x22, 0xb9, 0x27, 0x77, 0x4e, 0xee,
-	0x81, 0x18, 0x3b, 0xb0, 0xe9, 0xc3, 0x0d, 0x8b, 0x27, 0xf5, 0xce, 0x5b, 0x95, 0x3a, 0xf8, 0xad,
-	0xfa, 0x19, 0x80, 0xb9, 0xd8, 0xad, 0xaa, 0xc4, 0x02, 0xc2, 0x3f, 0xe3, 0xdd, 0xfa, 0x03, 0x80,
-	0xc7, 0xdb, 0x77, 0x2b, 0x2e, 0xed, 0x03, 0x16, 0xd6, 0xf8, 0x36, 0xa2, 0xef, 0xf6, 0x2d, 0x3a,
-	0x23, 0xf0, 0x6f, 0x00, 0x3c, 0xbe, 0xf8, 0x8f, 0x38, 0xdd, 0x0b, 0x3b, 0x9e, 0xee, 0xd8, 0xf6,
-	0x72, 0xbe, 0x8d, 0xd9, 0x33, 0x95, 0x7c, 0x92, 0x8c, 0x1a, 0x76, 0xb2, 0x46, 0xe3, 0xd6, 0xac,
-	0x61, 0xcb, 0x11, 0x22, 0x27, 0x35, 0xf1, 0x8d, 0x9e, 0x81, 0xe9, 0xb0, 0x3e, 0x90, 0x5b, 0x2a,
-	0xdd, 0x5b, 0x86, 0xd5, 0x81, 0x70, 0x0f, 0xb1, 0x9d, 0x16, 0xd1, 0xa0, 0xff, 0x03, 0x1d, 0x6d,
-	0x90, 0xa0, 0x45, 0x57, 0xdc, 0xbb, 0x58, 0x7c, 0x00, 0xdd, 0x90, 0xfb, 0x7d, 0x10, 0x7f, 0xda,
-	0x0b, 0x87, 0xa4, 0x90, 0xb2, 0x00, 0xba, 0x00, 0x7b, 0x78, 0x31, 0x25, 0xed, 0xbb, 0x57, 0x48,
-	0xcb, 0x06, 0x21, 0x2d, 0x0d, 0xa2, 0x7e, 0x95, 0xa0, 0x44, 0x25, 0x38, 0x50, 0x75, 0x5d, 0xa6,
-	0x0b, 0x36, 0x07, 0xe9, 0x99, 0xa5, 0x39, 0x19, 0x5f, 0x40, 0x6f, 0xc1, 0xb4, 0xec, 0xa4, 0x84,
-	0xaa, 0xfd, 0xd7, 0x5d, 0x54, 0x1b, 0x48, 0x5d, 0x94, 0xdd, 0x99, 0x6d, 0x7a, 0x7d, 0x8c, 0x3e,
-	0xaa, 0x4c, 0xce, 0xe4, 0x3a, 0xf4, 0xaa, 0x6f, 0x57, 0x6c, 0xd0, 0x71, 0x8f, 0xf6, 0x44, 0x57,
-	0xe0, 0x21, 0x59, 0xc9, 0x47, 0x55, 0x64, 0xf0, 0x7f, 0xcb, 0xbd, 0xdc, 0x44, 0xb4, 0x02, 0xb4,
-	0xac, 0x24, 0x0e, 0x97, 0x3c, 0x34, 0x09, 0x93, 0x56, 0x43, 0xe9, 0x15, 0xad, 0x89, 0x51, 0xd9,
-	0x9a, 0x80, 0xf9, 0x1e, 0x9a, 0x6c, 0x04, 0xff, 0x8f, 0x2c, 0x68, 0x49, 0xab, 0x81, 0x7c, 0xd8,
-	0x5f, 0x27, 0x8c, 0x5a, 0x46, 0xd8, 0x57, 0x3b, 0xb9, 0xf7, 0xa9, 0x2f, 0x07, 0xe0, 0xe0, 0xd0,
-	0xd3, 0x5b, 0xe5, 0x53, 0x1f, 0x82, 0x13, 0xfb, 0x3e, 0xb4, 0x16, 0xee, 0xc5, 0x2b, 0x21, 0x6c,
-	0xae, 0x61, 0xc7, 0x20, 0xa6, 0x62, 0xc8, 0x57, 0x56, 0xb7, 0xbd, 0x16, 0xc5, 0xff, 0x78, 0x5a,
-	0x04, 0x1c, 0x7f, 0x1a, 0x0e, 0x75, 0x28, 0xfd, 0x20, 0x6e, 0x37, 0x7e, 0x16, 0x0e, 0xc6, 0x65,
-	0xbf, 0x17, 0x6d, 0x32, 0xee, 0xb2, 0x7f, 0x49, 0xc3, 0xb1, 0x28, 0x4c, 0x85, 0x85, 0x21, 0x57,
-	0x88, 0x87, 0x2a, 0xa2, 0x35, 0xc8, 0xa7, 0x82, 0x36, 0x29, 0xd8, 0x67, 0x9b, 0x34, 0x13, 0x51,
-	0x95, 0x18, 0x1a, 0x87, 0xe9, 0xe0, 0x2f, 0x56, 0xd7, 0x0e, 0xff, 0x3c, 0x09, 0xc7, 0xe8, 0x25,
-	0x78, 0xd8, 0xc6, 0x1e, 0x93, 0xcd, 0x04, 0x9d, 0x12, 0x83, 0x58, 0x6b, 0xfb, 0xed, 0xeb, 0x06,
-	0x7b, 0x8d, 0x72, 0x06, 0x81, 0xfd, 0x34, 0x49, 0x5e, 0x62, 0xe8, 0x19, 0x98, 0x89, 0x31, 0x96,
-	0xef, 0x89, 0xe3, 0x7b, 0x5a, 0x5f, 0x83, 0x6d, 0x4e, 0x91, 0x60, 0x7e, 0x43, 0xf4, 0x6f, 0xe2,
-	0x82, 0xf5, 0x1e, 0x44, 0xb0, 0x25, 0x41, 0x1f, 0x13, 0xec, 0x11, 0x38, 0x28, 0x79, 0x1a, 0xae,
-	0xef, 0x30, 0x51, 0x77, 0xf4, 0x68, 0x99, 0x60, 0xae, 0xc2, 0xa7, 0xd0, 0x35, 0x78, 0x44, 0xec,
-	0x1d, 0x75, 0x8f, 0xe2, 0xbb, 0xf7, 0xef, 0x73, 0xf7, 0x31, 0xce, 0x22, 0xec, 0x27, 0xc5, 0xf6,
-	0x7f, 0x0c, 0x0e, 0x47, 0x7c, 0x03, 0x09, 0xd2, 0x42, 0x82, 0xa1, 0x70, 0x36, 0x90, 0x41, 0x87,
-	0x59, 0xea, 0xfa, 0x8e, 0xa9, 0x33, 0x6a, 0x35, 0x44, 0xe4, 0x09, 0xba, 0xac, 0x99, 0x99, 0x33,
-	0xbb, 0x75, 0x57, 0x3a, 0x7d, 0xa7, 0xa8, 0x71, 0xf2, 0xab, 0xd4, 0x6a, 0x08, 0xc9, 0xb4, 0x61,
-	0xda, 0x31, 0x46, 0x97, 0xe0, 0x80, 0xe7, 0x57, 0xf5, 0x2a, 0x76, 0x4c, 0x4f, 0x81, 0x7b, 0x46,
-	0xfb, 0x6e, 0xce, 0x8b, 0x7e, 0xb5, 0x8c, 0x1d, 0x53, 0x4b, 0x7b, 0xc1, 0x87, 0x37, 0xfe, 0x27,
-	0x00, 0x87, 0x3b, 0xf7, 0x43, 0xe7, 0x60, 0xaa, 0x2e, 0x53, 0xd4, 0x9e, 0xed, 0x2f, 0x1e, 0x74,
-	0x7f, 0x1a, 0x06, 0x5d, 0xd1, 0x06, 0xe3, 0x74, 0x82, 0x1c, 0xaf, 0xcb, 0x68, 0x7b, 0x40, 0x72,
-	0xbc, 0x8e, 0x2a, 0xb0, 0xaf, 0x4e, 0x4c, 0x0b, 0x3b, 0xd2, 0x8d, 0x0f, 0xc4, 0x41, 0x92, 0xf2,
-	0x2b, 0x1b, 0x58, 0x48, 0x54, 0xb4, 0x5a, 0x30, 0x18, 0xff, 0x15, 0x80, 0xfd, 0x52, 0x03, 0xdf,
-	0xe2, 0xff, 0x77, 0xff, 0x09, 0xc7, 0x23, 0xb7, 0xf0, 0x99, 0x65, 0xcb, 0x77, 0x8f, 0x1e, 0xbc,
-	0xea, 0x52, 0x22, 0x66, 0x44, 0xed, 0xcc, 0xa5, 0x36, 0x60, 0x5e, 0x3c, 0xef, 0x9e, 0x84, 0xa3,
-	0x3b, 0x51, 0x07, 0x7f, 0x77, 0x6a, 0x0f, 0xed, 0x40, 0x57, 0xfe, 0x11, 0xb8, 0x7d, 0x57, 0x05,
-	0x77, 0xee, 0xaa, 0xe0, 0x8b, 0xbb, 0x6a, 0xe2, 0xcb, 0xbb, 0x6a, 0xe2, 0xab, 0xbb, 0x6a, 0xe2,
-	0xeb, 0xbb, 0x6a, 0xe2, 0x9b, 0xbb, 0x2a, 0x78, 0xbb, 0xa5, 0x82, 0x77, 0x5b, 0x6a, 0xe2, 0xe3,
-	0x96, 0x0a, 0x3e, 0x6d, 0xa9, 0x89, 0xcf, 0x5a, 0x6a, 0xe2, 0xf3, 0x96, 0x9a, 0xb8, 0xdd, 0x52,
-	0xc1, 0x9d, 0x96, 0x0a, 0xbe, 0x68, 0xa9, 0x89, 0x2f, 0x5b, 0x2a, 0xf8, 0xaa, 0xa5, 0x26, 0xbe,
-	0x6e, 0xa9, 0xe0, 0x9b, 0x96, 0x9a, 0x78, 0x7b, 0x53, 0x4d, 0xbc, 0xbb, 0xa9, 0x82, 0xf7, 0x37,
-	0xd5, 0xc4, 0x07, 0x9b, 0x2a, 0xf8, 0x68, 0x53, 0x4d, 0x7c, 0xbc, 0xa9, 0x26, 0x3e, 0xdd, 0x54,
-	0xc1, 0x67, 0x9b, 0x2a, 0xf8, 0x7c, 0x53, 0x05, 0x2f, 0x4f, 0x1f, 0xa0, 0x0d, 0xc3, 0x9c, 0x46,
-	0xb5, 0xda, 0x27, 0x2c, 0x76, 0xfa, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xed, 0x73, 0xea,
-	0x6e, 0x22, 0x00, 0x00,
+	// 3656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x53, 0x33, 0xfc, 0x0c, 0x6b, 0xf8, 0x19, 0x95, 0x28, 0xaa, 0x45, 0x49, 0x3d, 0xf4, 0x58,
+	0x8e, 0x29, 0xc5, 0x1c, 0xae, 0x69, 0x3b, 0x48, 0xb4, 0xf1, 0x4a, 0xf3, 0x11, 0xe5, 0x89, 0x28,
+	0x89, 0x2e, 0x92, 0xbb, 0x58, 0xff, 0x1a, 0xc5, 0xee, 0xe2, 0xb0, 0xcd, 0x9e, 0xee, 0x49, 0x77,
+	0x35, 0xc5, 0xf1, 0x7a, 0x17, 0x46, 0xb0, 0x81, 0x8d, 0x0d, 0x10, 0x2c, 0x7c, 0x5a, 0x6c, 0x72,
+	0x58, 0x20, 0x48, 0xb2, 0xc8, 0x06, 0x81, 0x91, 0x43, 0xe0, 0x43, 0x0e, 0x7b, 0x48, 0x02, 0x9f,
+	0x02, 0x9d, 0x82, 0x45, 0x02, 0x30, 0xab, 0xe1, 0xc5, 0x7b, 0x33, 0x72, 0xc9, 0x82, 0xb9, 0x04,
+	0xf5, 0xe9, 0x9e, 0x9e, 0xe1, 0x47, 0xa4, 0x65, 0x6d, 0x72, 0x9a, 0xaa, 0x57, 0xef, 0x57, 0xaf,
+	0x5e, 0xbd, 0x7a, 0xef, 0xf5, 0xc0, 0x82, 0xe3, 0xf9, 0xe4, 0x01, 0x71, 0xe7, 0x02, 0x46, 0xcc,
+	0xad, 0x79, 0xd2, 0xb2, 0xe7, 0x1b, 0x84, 0xd1, 0x07, 0xa4, 0x5d, 0x6a, 0xf9, 0x1e, 0xf3, 0xd0,
+	0x38, 0x63, 0x6e, 0x49, 0x21, 0x95, 0xb6, 0x5f, 0x9a, 0x2e, 0x37, 0x6c, 0xb6, 0x19, 0xae, 0x97,
+	0x4c, 0xaf, 0x39, 0x4f, 0xdd, 0x6d, 0xaf, 0xdd, 0xf2, 0xbd, 0x9d, 0xf6, 0xbc, 0x40, 0x36, 0xe7,
+	0x1a, 0xd4, 0x9d, 0xdb, 0x26, 0x8e, 0x6d, 0x11, 0x46, 0xe7, 0x0f, 0x0c, 0x24, 0xcb, 0xe9, 0xb9,
+	0x04, 0x8b, 0x86, 0xd7, 0xf0, 0x24, 0xf1, 0x7a, 0xb8, 0x21, 0x66, 0x62, 0x22, 0x46, 0x0a, 0x5d,
+	0x6f, 0x78, 0x5e, 0xc3, 0xa1, 0x5d, 0x2c, 0x2b, 0xf4, 0x09, 0xb3, 0x3d, 0x57, 0xad, 0xcf, 0xf4,
+	0xaf, 0x6f, 0xd8, 0xd4, 0xb1, 0x8c, 0x26, 0x09, 0xb6, 0x14, 0xc6, 0xa5, 0x7e, 0x8c, 0x80, 0xf9,
+	0xa1, 0xc9, 0xd4, 0x6a, 0xa1, 0x7f, 0x95, 0xd9, 0x4d, 0x1a, 0x30, 0xd2, 0x6c, 0x1d, 0xa5, 0xc0,
+	0x03, 0x9f, 0xb4, 0x5a, 0xd4, 0x0f, 0xd4, 0xfa, 0x95, 0x83, 0x36, 0x34, 0x3d, 0x97, 0x11, 0x93,
+	0x19, 0xb6, 0xbb, 0x11, 0x6d, 0xa3, 0x78, 0x10, 0x8b, 0xba, 0x96, 0x61, 0xd1, 0x6d, 0xdb, 0x8c,
+	0x2c, 0x73, 0xf9, 0x30, 0x9c, 0xb0, 0x19, 0x09, 0x7a, 0xf6, 0xe0, 0xb2, 0x6d, 0x51, 0x97, 0xd9,
+	0x1b, 0x76, 0x57, 0x9b, 0x99, 0x83, 0x48, 0x4d, 0xca, 0x88, 0x45, 0x18, 0x89, 0xf6, 0x73, 0x10,
+	0xc3, 0xb7, 0x1b, 0x9b, 0x2c, 0xe2, 0x70, 0x88, 0x4f, 0x04, 0xd4, 0xf4, 0x69, 0x84, 0x50, 0xdc,
+	0x82, 0xa3, 0xb7, 0xa5, 0x93, 0x54, 0x7c, 0xe2, 0x5a, 0x68, 0x0a, 0xa6, 0x6d, 0x4b, 0x03, 0x33,
+	0x60, 0x76, 0xa4, 0x32, 0xd4, 0xd9, 0x2d, 0xa4, 0xeb, 0x35, 0x9c, 0xb6, 0x2d, 0x84, 0xe0, 0x80,
+	0x4b, 0x9a, 0x54, 0x4b, 0xf3, 0x15, 0x2c, 0xc6, 0xe8, 0x02, 0xcc, 0x84, 0xbe, 0xa3, 0x65, 0x04,
+	0xf2, 0x70, 0x67, 0xb7, 0x90, 0x59, 0xc3, 0x4b, 0x98, 0xc3, 0xd0, 0x24, 0x1c, 0x74, 0xbc, 0x86,
+	0x17, 0x68, 0x03, 0x33, 0x99, 0xd9, 0x11, 0x2c, 0x27, 0xc5, 0x7f, 0x00, 0xb1, 0xb4, 0xbb, 0x9e,
+	0x45, 0x1d, 0xb4, 0x0c, 0xb3, 0xeb, 0x5c, 0xac, 0x11, 0xcb, 0x7c, 0x65, 0xbf, 0xf2, 0xbc, 0xff,
+	0x9c, 0x76, 0x65, 0x41, 0x7f, 0xe7, 0x4d, 0x32, 0xf7, 0xde, 0xd7, 0xe6, 0x7e, 0xef, 0xed, 0xd9,
+	0x1b, 0xd7, 0xdf, 0x9c, 0x7b, 0xfb, 0x46, 0x34, 0xbd, 0xfa, 0x9d, 0x85, 0x17, 0xbe, 0x7b, 0xe5,
+	0x21, 0x00, 0x9d, 0xdd, 0xc2, 0xb0, 0x50, 0xba, 0x5e, 0xc3, 0xc3, 0x82, 0x4d, 0xdd, 0x42, 0x37,
+	0x85, 0xfe, 0x42, 0xcb, 0xca, 0xd7, 0x4e, 0xc5, 0xab, 0x7f, 0xa7, 0x99, 0xee, 0x4e, 0x8b, 0x7f,
+	0x9d, 0x86, 0x17, 0x94, 0xe2, 0xdf, 0xa4, 0x7e, 0x60, 0x7b, 0x6e, 0xbd, 0x7b, 0x58, 0x4f, 0x61,
+	0x17, 0xcb, 0x30, 0xdb, 0xe4, 0x06, 0x32, 0xe2, 0xbd, 0x9c, 0x92, 0xa3, 0x30, 0x2f, 0xe7, 0x28,
+	0xd8, 0xd4, 0x2d, 0xb4, 0x00, 0xf3, 0x9b, 0xc4, 0xb7, 0x1e, 0x10, 0x9f, 0x1a, 0xdb, 0x72, 0x0b,
+	0xd1, 0xc1, 0xed, 0x57, 0x06, 0xfc, 0xb4, 0x36, 0x83, 0x27, 0x22, 0x04, 0xb5, 0x45, 0x4e, 0xb3,
+	0x61, 0xfb, 0xcd, 0x1e, 0x9a, 0x81, 0x3e, 0x9a, 0x08, 0x41, 0xd1, 0x14, 0xff, 0x2b, 0x1d, 0x1f,
+	0x31, 0x26, 0x96, 0xed, 0xa1, 0x29, 0x38, 0x44, 0x5d, 0xb2, 0xee, 0x50, 0x61, 0x9a, 0x2c, 0x56,
+	0x33, 0x74, 0x11, 0x8e, 0x98, 0x9b, 0x76, 0xcb, 0x60, 0xed, 0x56, 0xe4, 0x55, 0x59, 0x0e, 0x58,
+	0x6d, 0xb7, 0x28, 0xba, 0x04, 0x47, 0x36, 0x7c, 0xfa, 0x87, 0x21, 0x75, 0xcd, 0xb6, 0x50, 0x73,
+	0x00, 0x77, 0x01, 0x68, 0x1e, 0xe6, 0xfc, 0x20, 0xb0, 0x0d, 0x6f, 0x63, 0x23, 0xa0, 0x4c, 0xa8,
+	0x94, 0xae, 0x8c, 0x77, 0x76, 0x0b, 0x10, 0xaf, 0xac, 0xd4, 0xef, 0x0b, 0x28, 0x86, 0x1c, 0x45,
+	0x8e, 0xd1, 0xb7, 0x60, 0x9e, 0xed, 0x18, 0xa6, 0xe7, 0x6e, 0xd8, 0x0d, 0x15, 0x70, 0xb4, 0xc1,
+	0x19, 0x30, 0x9b, 0x5b, 0x78, 0xa1, 0xd4, 0x1b, 0x13, 0x4b, 0x49, 0xdd, 0x4b, 0xab, 0x3b, 0xd5,
+	0x24, 0x0d, 0x9e, 0x60, 0xbd, 0x80, 0xe9, 0xef, 0x03, 0x38, 0xd1, 0x87, 0x84, 0x9e, 0x85, 0x63,
+	0x4d, 0xdb, 0x35, 0xba, 0xfa, 0x03, 0xa1, 0xff, 0x68, 0xd3, 0x76, 0x17, 0xe3, 0x2d, 0x70, 0x24,
+	0xb2, 0x93, 0x40, 0x4a, 0x2b, 0x24, 0xb2, 0xd3, 0x45, 0x7a, 0x1e, 0x4e, 0xb8, 0x1e, 0x33, 0x37,
+	0x8d, 0x7e, 0x5b, 0x8c, 0x0b, 0x70, 0x8c, 0x58, 0xfc, 0x37, 0x00, 0xc7, 0x7b, 0xdd, 0x13, 0xdd,
+	0x85, 0x19, 0xdb, 0x0a, 0x84, 0xec, 0xdc, 0xc2, 0xd5, 0x23, 0x76, 0x79, 0xd0, 0x97, 0x2b, 0xf9,
+	0xfd, 0xca, 0xe0, 0x0f, 0x40, 0x3a, 0x0f, 0x3e, 0xdb, 0x2d, 0xa4, 0x1e, 0xee, 0x16, 0x00, 0xe6,
+	0x7c, 0xf8, 0x29, 0xb6, 0x36, 0x3d, 0xe6, 0x05, 0x5a, 0x5a, 0x5c, 0x68, 0x35, 0x43, 0x2f, 0xc3,
+	0x21, 0x9f, 0x9b, 0x2a, 0xd0, 0x32, 0x33, 0x99, 0xd9, 0xdc, 0xc2, 0xa5, 0xe3, 0xec, 0x89, 0x15,
+	0x2e, 0x7a, 0x06, 0x8e, 0x9a, 0x8e, 0x67, 0x6e, 0x19, 0x81, 0x17, 0xfa, 0x26, 0xd5, 0x86, 0x67,
+	0xc0, 0xec, 0x18, 0xce, 0x09, 0xd8, 0x8a, 0x00, 0x5d, 0x1f, 0xf8, 0xf4, 0x27, 0x85, 0x54, 0xf1,
+	0x5f, 0x01, 0xd4, 0x15, 0x87, 0xaa, 0x43, 0xec, 0x66, 0x39, 0x64, 0x9b, 0x5c, 0x57, 0x53, 0x98,
+	0xba, 0xea, 0x59, 0x14, 0x95, 0xe0, 0x90, 0x8c, 0x68, 0x6a, 0xaf, 0x53, 0xfd, 0x1a, 0xac, 0x88,
+	0x55, 0xac, 0xb0, 0xd0, 0x0d, 0x08, 0xc5, 0x1b, 0x66, 0x6c, 0xf8, 0x5e, 0x53, 0x98, 0x3d, 0xb7,
+	0x30, 0x5d, 0x92, 0xcf, 0x42, 0x29, 0x7a, 0x16, 0x4a, 0xab, 0xd1, 0xbb, 0x51, 0x19, 0xf8, 0xe1,
+	0x7f, 0x16, 0x00, 0x1e, 0x11, 0x34, 0x8b, 0xbe, 0xd7, 0x44, 0x5f, 0x87, 0x59, 0xc9, 0x80, 0x79,
+	0x5a, 0xe6, 0x84, 0xe4, 0xc3, 0x82, 0x62, 0xd5, 0x2b, 0xfe, 0x4f, 0x1a, 0x4e, 0xaa, 0x0d, 0x95,
+	0x1d, 0xea, 0xb3, 0x15, 0xca, 0x98, 0xed, 0x36, 0x02, 0x34, 0x0d, 0xb3, 0x96, 0x1d, 0xf0, 0x9b,
+	0x61, 0xa9, 0x8b, 0x12, 0xcf, 0x11, 0x86, 0x93, 0x96, 0x1d, 0x98, 0x9e, 0xeb, 0x52, 0x93, 0x19,
+	0x6c, 0xd3, 0xa7, 0xc1, 0xa6, 0xe7, 0x58, 0x4a, 0xf9, 0x0b, 0x07, 0xa4, 0xd7, 0x94, 0x2b, 0x56,
+	0x06, 0x7e, 0xc4, 0x85, 0x9f, 0xed, 0x12, 0xaf, 0x46, 0xb4, 0xe8, 0xdb, 0x50, 0x0b, 0x5b, 0x8e,
+	0xed, 0x6e, 0x19, 0x81, 0xed, 0x50, 0xd7, 0xa4, 0x09, 0xbe, 0x99, 0x93, 0xf1, 0x9d, 0x92, 0x0c,
+	0x56, 0x24, 0x7d, 0x97, 0xf5, 0x6b, 0x70, 0x22, 0x60, 0x84, 0x85, 0x81, 0x61, 0xbb, 0x8c, 0xfa,
+	0xdb, 0xc4, 0xd1, 0x06, 0x4e, 0xc6, 0x71, 0x5c, 0xd2, 0xd5, 0x15, 0x19, 0xba, 0x07, 0xcf, 0xf2,
+	0x5b, 0xe2, 0x7b, 0xa1, 0x6b, 0x19, 0xcc, 0xe7, 0xd1, 0xc2, 0x6e, 0x52, 0x6d, 0xf0, 0x64, 0xdc,
+	0xf2, 0x4d, 0xb2, 0x83, 0x39, 0xe9, 0xaa, 0x6f, 0xb7, 0xf8, 0x89, 0x14, 0xff, 0x6e, 0x28, 0xb6,
+	0xfe, 0x9a, 0xd0, 0x7d, 0xd1, 0x76, 0x18, 0x8f, 0xe0, 0xe7, 0xe1, 0xb0, 0xe5, 0xb7, 0x0d, 0x3f,
+	0x74, 0xa3, 0x28, 0x65, 0xf9, 0x6d, 0x1c, 0xba, 0x68, 0x15, 0x9e, 0x27, 0x8e, 0xe3, 0x3d, 0xe0,
+	0x6f, 0xbb, 0x41, 0x2c, 0xcb, 0x37, 0x5a, 0x3e, 0xdd, 0xb0, 0x77, 0xa8, 0xbc, 0x08, 0xb9, 0x85,
+	0xcb, 0xfd, 0xee, 0x56, 0xa3, 0xdb, 0x65, 0xcb, 0xf2, 0x97, 0x05, 0x1a, 0x9e, 0x14, 0xd4, 0x3d,
+	0x30, 0x1a, 0x20, 0x0c, 0xa7, 0x2c, 0xea, 0xb6, 0x0f, 0x61, 0x9a, 0x39, 0x09, 0xd3, 0xb3, 0x9c,
+	0xb8, 0x9f, 0xe7, 0xfb, 0xf0, 0x9c, 0xd4, 0xf4, 0x5d, 0xcf, 0x76, 0x0d, 0x1a, 0xda, 0x86, 0x4f,
+	0xdc, 0x06, 0x95, 0x2f, 0x70, 0x6e, 0xe1, 0xc5, 0x23, 0x2e, 0x66, 0x8f, 0x1d, 0x4a, 0x7f, 0xe0,
+	0xd9, 0xee, 0xad, 0xb5, 0x3a, 0xe6, 0x94, 0x95, 0xa9, 0xce, 0x6e, 0x01, 0x95, 0x39, 0xcf, 0x24,
+	0x38, 0xc0, 0x88, 0xc4, 0xb0, 0xd0, 0x96, 0x30, 0xd4, 0x86, 0x93, 0x62, 0x47, 0xfd, 0xc2, 0x07,
+	0xbf, 0xac, 0xf0, 0x73, 0x9d, 0xdd, 0xc2, 0x99, 0x1a, 0x75, 0xdb, 0xbd, 0xb2, 0xcf, 0x58, 0x11,
+	0x28, 0x16, 0x7d, 0x13, 0x0e, 0xf3, 0x78, 0x1b, 0xb8, 0xbe, 0x36, 0x24, 0x1c, 0xe3, 0xe2, 0x01,
+	0xc7, 0x58, 0x74, 0x3c, 0xc2, 0xbe, 0x49, 0x9c, 0x90, 0x56, 0x60, 0x67, 0xb7, 0x30, 0x74, 0xd7,
+	0x76, 0x57, 0xee, 0x61, 0x3c, 0xd4, 0xb4, 0xdd, 0x15, 0xd7, 0x47, 0x55, 0x98, 0xe5, 0x1c, 0xf8,
+	0x83, 0xa1, 0x0d, 0x3f, 0x9e, 0x45, 0x4e, 0x3c, 0xb0, 0xb6, 0xcb, 0x1f, 0x1b, 0xcc, 0x65, 0xe3,
+	0x20, 0xb0, 0xd1, 0x0c, 0xcc, 0x45, 0x61, 0xda, 0xa6, 0x81, 0x96, 0x9d, 0xc9, 0xcc, 0x0e, 0xe0,
+	0x24, 0x68, 0xfa, 0x13, 0x00, 0x47, 0x93, 0xbb, 0x41, 0xaf, 0xc3, 0x01, 0x11, 0x84, 0xb8, 0xcb,
+	0x8d, 0x56, 0x5e, 0xe5, 0x11, 0xf7, 0xdf, 0x77, 0x0b, 0xaf, 0x34, 0xbc, 0x12, 0xdb, 0xa4, 0x6c,
+	0x93, 0xc7, 0x85, 0x92, 0x4b, 0xd9, 0x03, 0xcf, 0xdf, 0x9a, 0xef, 0xcd, 0xe3, 0xb6, 0x5f, 0x9a,
+	0x6f, 0x6d, 0x35, 0xe6, 0xf9, 0xdb, 0x19, 0x94, 0x6e, 0xad, 0xd5, 0x7f, 0xe7, 0x65, 0x2c, 0x58,
+	0xa1, 0xbb, 0x30, 0xcd, 0x3c, 0x2d, 0xfd, 0x55, 0x30, 0x4c, 0x33, 0xaf, 0xf8, 0x27, 0x67, 0xe1,
+	0xb0, 0x3a, 0x2b, 0xb4, 0x98, 0x7c, 0x51, 0x8a, 0x47, 0x9c, 0xe8, 0x09, 0x9e, 0x92, 0x2a, 0x84,
+	0xa6, 0x4f, 0x09, 0xa3, 0x96, 0x41, 0xd8, 0x09, 0x02, 0x70, 0x96, 0x93, 0xcb, 0x20, 0xac, 0xe8,
+	0xca, 0x8c, 0x33, 0x09, 0x5b, 0x56, 0xc4, 0x24, 0x73, 0x1a, 0x26, 0x8a, 0xae, 0x2c, 0x9e, 0x02,
+	0x8b, 0x3a, 0x54, 0x31, 0x99, 0x3e, 0xe9, 0x53, 0xa0, 0x68, 0xca, 0x0c, 0x5d, 0x54, 0xa9, 0x62,
+	0x4f, 0x52, 0xb4, 0xa0, 0xb2, 0xe3, 0x6b, 0x30, 0x67, 0xd1, 0xc0, 0xf4, 0xed, 0x56, 0x9c, 0x6f,
+	0x8c, 0x54, 0xb2, 0xfb, 0x95, 0x41, 0x3f, 0xa3, 0x3d, 0x9c, 0xc0, 0xc9, 0x45, 0xf4, 0x3d, 0x08,
+	0x09, 0x63, 0xbe, 0xbd, 0x1e, 0x32, 0x1a, 0x68, 0x43, 0xe2, 0xd2, 0x3c, 0x7f, 0x84, 0x89, 0x4b,
+	0xe5, 0x18, 0xf3, 0x96, 0xcb, 0xfc, 0x36, 0x4f, 0x0d, 0x17, 0x7e, 0x0c, 0xe6, 0xf3, 0xb0, 0x78,
+	0xc5, 0x2f, 0x3e, 0x3e, 0x43, 0xbc, 0xc6, 0x15, 0xf8, 0x0c, 0xe0, 0x84, 0x44, 0xf4, 0x1a, 0x1c,
+	0x4d, 0x96, 0x39, 0xda, 0xb0, 0xd0, 0xe0, 0x62, 0xbf, 0x06, 0x55, 0x89, 0x53, 0x77, 0x37, 0x3c,
+	0xb1, 0x93, 0x8f, 0x41, 0x3a, 0x0f, 0x71, 0xce, 0xec, 0x82, 0xd1, 0x5b, 0x30, 0xa7, 0x52, 0x45,
+	0x83, 0x7b, 0x4b, 0xf6, 0xc9, 0xf3, 0x0f, 0xb8, 0x1d, 0x61, 0x05, 0xe8, 0x9f, 0x01, 0x9c, 0x52,
+	0x35, 0xad, 0x11, 0x50, 0x7f, 0x9b, 0xfa, 0x22, 0x7e, 0xd2, 0x20, 0xd0, 0x46, 0x84, 0x7d, 0xff,
+	0x14, 0xec, 0x57, 0x7e, 0x00, 0xfc, 0x0f, 0xc1, 0xc2, 0xf7, 0xc1, 0x3b, 0xb3, 0x37, 0xae, 0x73,
+	0x0b, 0x90, 0xb9, 0xf7, 0xca, 0x73, 0x6f, 0x70, 0x03, 0xbc, 0x9f, 0x18, 0x77, 0x87, 0x6f, 0xcd,
+	0xbd, 0x7d, 0x2d, 0xb1, 0x70, 0xf5, 0xad, 0xd2, 0xd5, 0x6b, 0x9c, 0xae, 0x3c, 0xf7, 0x86, 0x32,
+	0xdc, 0xfb, 0x89, 0x71, 0x77, 0x28, 0xe8, 0xba, 0x0b, 0x57, 0x67, 0x6f, 0x5c, 0xbf, 0xfe, 0x26,
+	0x1f, 0x7d, 0xe7, 0xc5, 0x17, 0x5e, 0xf9, 0xee, 0xd5, 0x1b, 0x57, 0xde, 0x7f, 0xe7, 0x0a, 0x9e,
+	0x54, 0xea, 0xae, 0x08, 0x6d, 0xcb, 0x52, 0x59, 0x54, 0x80, 0x39, 0x12, 0x32, 0xcf, 0x90, 0xbe,
+	0xa8, 0x41, 0xf1, 0xe6, 0x40, 0x0e, 0x5a, 0x13, 0x10, 0x34, 0x0f, 0xc7, 0xe5, 0x9a, 0x61, 0x6e,
+	0x12, 0xd7, 0xa5, 0x8e, 0x96, 0x4b, 0xfa, 0xcf, 0x07, 0x00, 0x8f, 0xc9, 0xf5, 0xaa, 0x5c, 0x46,
+	0x8b, 0xf0, 0x4c, 0x9c, 0x25, 0x1a, 0x2d, 0x87, 0x70, 0xf3, 0x6b, 0xa3, 0x82, 0x66, 0x5a, 0xfa,
+	0xe5, 0xcd, 0xce, 0x6e, 0x61, 0x22, 0xce, 0x19, 0x97, 0x1d, 0xe2, 0xd6, 0x6b, 0x78, 0x62, 0xa3,
+	0x07, 0xc0, 0x2b, 0x0f, 0x74, 0x80, 0x4f, 0xa0, 0x4d, 0xf2, 0xa4, 0xaf, 0x52, 0xdc, 0xaf, 0xe4,
+	0x3e, 0x06, 0xd9, 0x7c, 0xb6, 0x18, 0xf1, 0xcb, 0xf7, 0xf1, 0x0b, 0x70, 0xbe, 0x8f, 0x21, 0xf7,
+	0xad, 0x2c, 0x71, 0x19, 0x75, 0x5d, 0x12, 0x68, 0x63, 0xc2, 0xaf, 0xf4, 0x23, 0xdc, 0xa1, 0x2c,
+	0xd1, 0x2a, 0xa3, 0xca, 0xb5, 0xc4, 0xa5, 0xc5, 0x31, 0x35, 0x4f, 0x9a, 0x55, 0x62, 0xd1, 0x0a,
+	0xd7, 0x1d, 0xdb, 0xd4, 0xc6, 0x85, 0xdd, 0x46, 0x25, 0x70, 0x59, 0xc0, 0x78, 0xd2, 0xec, 0x78,
+	0x32, 0x3f, 0x8c, 0xd0, 0x26, 0x04, 0xda, 0x78, 0x04, 0x56, 0x88, 0x2f, 0xc3, 0xa9, 0xc0, 0xdc,
+	0xa4, 0x56, 0xe8, 0x50, 0xc3, 0xf2, 0x1e, 0xb8, 0x22, 0x19, 0x72, 0xf8, 0x71, 0xe4, 0x05, 0xfe,
+	0x64, 0xb4, 0x5a, 0x53, 0x8b, 0x4b, 0xfc, 0x60, 0x5e, 0x80, 0x88, 0xba, 0x1b, 0x9e, 0x6f, 0x52,
+	0xc3, 0x0a, 0x59, 0xdb, 0x30, 0xdb, 0xa6, 0x43, 0xb5, 0x33, 0x82, 0x22, 0xaf, 0x56, 0x6a, 0x21,
+	0x6b, 0x57, 0x39, 0x1c, 0xbd, 0x0b, 0xb5, 0x98, 0x75, 0x8b, 0xb0, 0x4d, 0x5e, 0x83, 0x04, 0xcc,
+	0x27, 0xb6, 0xcb, 0x34, 0x34, 0x03, 0x66, 0xc7, 0x17, 0x7e, 0xeb, 0xc0, 0x53, 0xaf, 0xf0, 0x97,
+	0x09, 0xdb, 0xac, 0xc6, 0xd8, 0xe2, 0xe0, 0xff, 0x88, 0xdf, 0x0b, 0x3c, 0x65, 0x1d, 0x8a, 0x81,
+	0xbe, 0x9d, 0xd8, 0x0f, 0x71, 0xdb, 0x3c, 0x53, 0x32, 0x2c, 0xea, 0x90, 0xb6, 0x76, 0xf6, 0x71,
+	0xf9, 0x12, 0x37, 0x34, 0x10, 0x39, 0x53, 0xbc, 0xe9, 0xb2, 0xe4, 0x50, 0xe3, 0x0c, 0xd0, 0xab,
+	0xf0, 0xa2, 0xf2, 0xc6, 0xd8, 0xb4, 0xfc, 0xb5, 0x31, 0xa4, 0xe1, 0xb5, 0x73, 0x62, 0xf7, 0x9a,
+	0x44, 0x59, 0x52, 0x18, 0x3c, 0x57, 0x5e, 0x11, 0xeb, 0xe8, 0x1e, 0x1c, 0x77, 0xd6, 0x03, 0xc3,
+	0x71, 0x03, 0x43, 0xa5, 0xea, 0x53, 0xc7, 0xa5, 0xea, 0x95, 0x7c, 0x67, 0xb7, 0x30, 0xba, 0x54,
+	0x59, 0x59, 0xba, 0xb7, 0x22, 0x21, 0x78, 0xd4, 0x59, 0x0f, 0x96, 0xdc, 0x40, 0xce, 0xd0, 0xbb,
+	0xf0, 0x82, 0xc9, 0xab, 0x01, 0x83, 0xf4, 0x94, 0x03, 0x86, 0xe9, 0x59, 0x54, 0x3b, 0x2f, 0x58,
+	0x97, 0x8e, 0x70, 0xb1, 0x23, 0xaa, 0x08, 0x7c, 0xde, 0x3c, 0x7c, 0x01, 0xd5, 0xe0, 0x04, 0x23,
+	0x7e, 0x83, 0x32, 0xc3, 0x0c, 0x5b, 0x81, 0x11, 0xfa, 0xb6, 0xa6, 0x89, 0x5b, 0x75, 0x69, 0xbf,
+	0x92, 0xf5, 0x87, 0x3e, 0x02, 0x40, 0x56, 0xdc, 0x63, 0xab, 0x02, 0xab, 0xba, 0xb6, 0xbc, 0xb2,
+	0x86, 0xeb, 0x78, 0x4c, 0x12, 0x55, 0xc3, 0x56, 0xb0, 0xe6, 0xdb, 0xe8, 0xf5, 0x5e, 0x2e, 0x5b,
+	0xb4, 0xad, 0x5d, 0x38, 0xd6, 0x04, 0x67, 0x7a, 0x59, 0xde, 0xa1, 0xed, 0x24, 0xcb, 0x3b, 0xb4,
+	0x8d, 0x5e, 0x83, 0x33, 0xfc, 0xa6, 0xd9, 0x3e, 0x4d, 0x9a, 0x81, 0x5a, 0x86, 0x4a, 0xf5, 0xf9,
+	0x9b, 0x73, 0x51, 0x1c, 0x8c, 0xae, 0xf0, 0xca, 0x49, 0xb4, 0x6a, 0x8c, 0x85, 0xee, 0xc0, 0x71,
+	0xc2, 0x6b, 0x11, 0x23, 0x50, 0xc5, 0x88, 0x76, 0x49, 0xe8, 0x76, 0xe5, 0xa8, 0x6b, 0x9a, 0x2c,
+	0x5c, 0xf0, 0x18, 0x49, 0x4e, 0x39, 0x33, 0x55, 0x57, 0x6c, 0xc8, 0xb4, 0x4e, 0xbb, 0x7c, 0x2c,
+	0xb3, 0x9e, 0x14, 0x90, 0x07, 0xb5, 0xc4, 0x14, 0xd5, 0x20, 0x0c, 0xad, 0x56, 0xe4, 0x34, 0xfa,
+	0xb1, 0x16, 0x1b, 0xeb, 0xec, 0x16, 0x46, 0xd6, 0x6a, 0xcb, 0x72, 0x8a, 0x47, 0x42, 0xab, 0x25,
+	0x87, 0xe8, 0x43, 0x00, 0xcf, 0x73, 0x36, 0x7d, 0xde, 0xc2, 0x3b, 0x23, 0x5a, 0x41, 0x5c, 0xc2,
+	0xa3, 0xba, 0x00, 0x6b, 0xb5, 0xe5, 0x5e, 0x97, 0xe0, 0x6d, 0x95, 0xca, 0x33, 0xd1, 0x55, 0xec,
+	0xec, 0x16, 0xce, 0x1d, 0x8a, 0x82, 0xcf, 0x85, 0x56, 0xeb, 0x20, 0x78, 0xfa, 0x55, 0x38, 0xd1,
+	0xf7, 0x78, 0xa3, 0x3c, 0xcc, 0x70, 0x6f, 0x10, 0x6d, 0x23, 0xcc, 0x87, 0xbc, 0x75, 0xb6, 0xcd,
+	0xf3, 0x4c, 0xd5, 0x14, 0x91, 0x93, 0xeb, 0xe9, 0xdf, 0x05, 0xc5, 0x1b, 0x30, 0xab, 0x14, 0x0b,
+	0xd0, 0x4b, 0x30, 0xab, 0x5e, 0x16, 0x9e, 0x92, 0xf1, 0xa8, 0x7a, 0xfe, 0xa8, 0xd2, 0x3b, 0x46,
+	0x2c, 0xfe, 0x0c, 0xc0, 0x33, 0xb7, 0x29, 0x8b, 0x16, 0x78, 0xa0, 0x0e, 0x18, 0x5a, 0x83, 0xb9,
+	0xe8, 0x4d, 0x7d, 0xd2, 0x04, 0x0f, 0x36, 0x22, 0xac, 0x80, 0x67, 0x57, 0xdd, 0xee, 0xed, 0x91,
+	0x79, 0xde, 0x22, 0x47, 0xb9, 0x4b, 0x82, 0xad, 0xca, 0x80, 0x88, 0xf6, 0x23, 0x1b, 0x11, 0xa0,
+	0x18, 0xc2, 0x62, 0x57, 0xd9, 0x84, 0xdc, 0x45, 0xcf, 0xe7, 0x29, 0xb4, 0xd2, 0xfe, 0x3e, 0xcc,
+	0xd0, 0xd0, 0xfe, 0x6a, 0x72, 0x68, 0xce, 0xa9, 0xf8, 0x45, 0x1a, 0x9e, 0x5d, 0xb2, 0x83, 0x48,
+	0x70, 0x10, 0x09, 0x7a, 0x9d, 0xe7, 0x48, 0x8e, 0x43, 0xd6, 0x3d, 0x9f, 0x30, 0xcf, 0x57, 0x76,
+	0x9a, 0xeb, 0xb7, 0xd3, 0x7d, 0xbf, 0x41, 0x5c, 0xfb, 0x3d, 0x71, 0xe8, 0xf7, 0xfd, 0xb5, 0x80,
+	0xfa, 0x09, 0xd5, 0x71, 0x0f, 0x8b, 0x27, 0x36, 0x11, 0x7a, 0x00, 0x07, 0x3d, 0xdf, 0xa2, 0xbe,
+	0x6a, 0xe5, 0x91, 0xfd, 0xca, 0x3b, 0xfe, 0x5b, 0x38, 0x15, 0x9f, 0x83, 0x61, 0x5b, 0x38, 0x37,
+	0x97, 0x9c, 0x44, 0x63, 0x1a, 0xda, 0x78, 0x74, 0x2e, 0x39, 0x13, 0x59, 0x2b, 0x1e, 0x9c, 0x13,
+	0x3f, 0x89, 0x14, 0x1d, 0xe7, 0xe6, 0x12, 0x13, 0x29, 0x0f, 0xe9, 0x70, 0xd0, 0xb1, 0x9b, 0xb6,
+	0x6c, 0xbe, 0x8d, 0x89, 0xd7, 0xe9, 0x5a, 0x46, 0xfb, 0x7c, 0x18, 0x4b, 0x30, 0x6f, 0xa2, 0xb6,
+	0x48, 0x43, 0x96, 0xea, 0x63, 0x58, 0x8c, 0x91, 0x06, 0x87, 0x55, 0xea, 0x2c, 0x0a, 0xb5, 0x2c,
+	0x8e, 0xa6, 0xc5, 0x7f, 0x04, 0x70, 0xb2, 0x2a, 0x64, 0xf4, 0xb9, 0x66, 0x15, 0x0e, 0x2b, 0x15,
+	0x95, 0xb9, 0x8f, 0x72, 0xf2, 0x43, 0x7c, 0x31, 0xa2, 0x44, 0x46, 0xdf, 0xc1, 0xa5, 0xbf, 0xc4,
+	0xc1, 0x55, 0x46, 0x93, 0xfc, 0x7b, 0x8f, 0xb1, 0xf8, 0xe7, 0x00, 0x4e, 0xca, 0xbc, 0xed, 0x69,
+	0xa8, 0xff, 0xc4, 0xf7, 0xe8, 0xaf, 0x00, 0xbc, 0x90, 0x70, 0xe8, 0xf2, 0x72, 0xfd, 0x0e, 0x6d,
+	0x07, 0x4f, 0xf9, 0xf6, 0xc7, 0x0e, 0x92, 0x3e, 0xde, 0x41, 0x32, 0x5d, 0x07, 0x29, 0x7e, 0x0c,
+	0xe0, 0xf9, 0xdb, 0xb4, 0x57, 0xcf, 0xa7, 0xac, 0xe6, 0x0c, 0x1c, 0xda, 0xa2, 0xed, 0x6e, 0x9b,
+	0x7d, 0xa4, 0xb3, 0x5b, 0x18, 0xbc, 0x43, 0xdb, 0xf5, 0x1a, 0x1e, 0xdc, 0xa2, 0xed, 0xba, 0x55,
+	0xfc, 0xb3, 0x34, 0x9c, 0xee, 0xf1, 0xcd, 0xdf, 0x88, 0x5e, 0x17, 0x93, 0x9f, 0x5b, 0xfa, 0x2b,
+	0xcb, 0x9b, 0x70, 0x48, 0x7e, 0xe4, 0x11, 0xed, 0xa2, 0xf1, 0x85, 0x73, 0xfd, 0xe2, 0x30, 0x5f,
+	0xad, 0x9c, 0xd9, 0xaf, 0x8c, 0x7f, 0x0c, 0x72, 0x59, 0xa0, 0x81, 0xa2, 0xca, 0x1d, 0x15, 0x1d,
+	0xba, 0x0d, 0x21, 0xdd, 0x69, 0xd9, 0x3e, 0x0d, 0x0c, 0x22, 0xef, 0xf0, 0xf1, 0x95, 0x2f, 0xf7,
+	0xfe, 0xbf, 0x07, 0xe9, 0x9b, 0x40, 0x56, 0xc0, 0x8a, 0xb6, 0xcc, 0x8a, 0xff, 0x0d, 0xe0, 0x74,
+	0x8f, 0xeb, 0xff, 0x46, 0xac, 0x53, 0x86, 0xc3, 0xa4, 0x65, 0x8b, 0x34, 0x2a, 0x7d, 0x78, 0x52,
+	0x20, 0xd5, 0x38, 0x84, 0xcd, 0x10, 0x69, 0xd9, 0x77, 0x68, 0xff, 0xad, 0xca, 0x9c, 0xfe, 0x56,
+	0xfd, 0x2d, 0x80, 0x85, 0xc4, 0xad, 0xaa, 0x26, 0x02, 0xc2, 0xff, 0xc7, 0xbb, 0xf5, 0x1f, 0x00,
+	0x5e, 0xbe, 0x4d, 0x0f, 0xd3, 0xf6, 0x29, 0x2b, 0x6b, 0x7e, 0x15, 0xd1, 0xf7, 0xa0, 0x88, 0xde,
+	0x08, 0xfc, 0x2f, 0x00, 0x5e, 0x5e, 0xf9, 0xbf, 0xd8, 0xdd, 0xbd, 0x43, 0x77, 0x77, 0xe9, 0x60,
+	0xe3, 0xa4, 0x8b, 0x73, 0xec, 0x53, 0xf2, 0xb3, 0x34, 0x1c, 0xef, 0xad, 0x86, 0xf9, 0x69, 0x36,
+	0x88, 0x2d, 0x1b, 0xd3, 0x69, 0x2c, 0xc6, 0xe8, 0x1b, 0x30, 0x1b, 0x55, 0x62, 0x4a, 0xa4, 0xd6,
+	0x2f, 0x32, 0xaa, 0xc3, 0x2a, 0xd9, 0x48, 0x1c, 0x8e, 0x69, 0xd0, 0x1f, 0x83, 0x9e, 0x86, 0x93,
+	0xec, 0x3a, 0x97, 0x8e, 0x2f, 0xcb, 0x9f, 0x42, 0xdf, 0xe9, 0x49, 0x13, 0xe2, 0x4f, 0x06, 0xe1,
+	0x98, 0x52, 0x52, 0x95, 0x9a, 0x37, 0xe1, 0x80, 0xf8, 0x44, 0x00, 0x1e, 0x1b, 0xd2, 0xf2, 0x32,
+	0xa4, 0x65, 0x41, 0xdc, 0x19, 0x14, 0x94, 0xa8, 0x0c, 0x47, 0xd6, 0x3d, 0x8f, 0xc9, 0x2f, 0x0d,
+	0xa7, 0xe9, 0x4e, 0x66, 0x39, 0x19, 0x5f, 0x40, 0xdf, 0x83, 0x59, 0xd5, 0xb3, 0x8a, 0x4c, 0xfb,
+	0xdb, 0x47, 0x98, 0x56, 0x6a, 0x5d, 0x52, 0x7d, 0xb0, 0x03, 0x76, 0x7d, 0xce, 0x7f, 0x56, 0xbb,
+	0xb2, 0x50, 0xe8, 0xb1, 0xab, 0x71, 0xd0, 0xb0, 0xf2, 0x53, 0x6c, 0x2c, 0x13, 0xdd, 0x87, 0x67,
+	0x54, 0xcf, 0x24, 0xae, 0xd7, 0xa3, 0xcf, 0x00, 0xc7, 0xbb, 0x89, 0x68, 0xba, 0xe0, 0xbc, 0x22,
+	0x8e, 0x96, 0x02, 0x74, 0x05, 0xa6, 0xed, 0x96, 0xe8, 0xe5, 0x8f, 0x54, 0x26, 0x55, 0x13, 0x08,
+	0xf2, 0x26, 0x50, 0x4b, 0x7e, 0x38, 0x5f, 0xc6, 0x69, 0xbb, 0x85, 0x42, 0x38, 0xdc, 0xa4, 0xcc,
+	0xb7, 0xcd, 0xa8, 0x83, 0x79, 0xed, 0xf8, 0x5d, 0xdf, 0x95, 0xc8, 0x72, 0xd3, 0xf3, 0xfb, 0x95,
+	0x17, 0x7e, 0x0c, 0xae, 0x9e, 0x78, 0xd3, 0x38, 0x92, 0xc5, 0x2b, 0x21, 0x62, 0x6d, 0x13, 0xd7,
+	0xa4, 0x96, 0x66, 0xaa, 0x2c, 0xab, 0xff, 0xbc, 0x56, 0xc4, 0x9f, 0x44, 0x70, 0x8c, 0x38, 0xfd,
+	0x75, 0x38, 0xd6, 0x63, 0xf4, 0xd3, 0xb8, 0xdd, 0xf4, 0x75, 0x38, 0x9a, 0xd4, 0xfd, 0x71, 0xb4,
+	0xe9, 0xa4, 0xcb, 0xfe, 0x6a, 0x04, 0x4e, 0xc5, 0x61, 0x2a, 0x2a, 0xc1, 0xb9, 0x41, 0x78, 0x63,
+	0x7c, 0x54, 0xd5, 0xee, 0xb2, 0x21, 0x0d, 0x4e, 0xd8, 0x90, 0xce, 0xc5, 0x54, 0x65, 0xc6, 0xbf,
+	0x23, 0x0a, 0x44, 0xd3, 0x73, 0xa2, 0xaf, 0xea, 0xd1, 0x1c, 0x7d, 0x0b, 0x9e, 0x77, 0x48, 0xc0,
+	0x54, 0xdb, 0xc6, 0xf0, 0xa9, 0x49, 0xed, 0xed, 0x93, 0x76, 0xd0, 0xa5, 0xac, 0x49, 0xce, 0x40,
+	0x9e, 0x1f, 0x56, 0xe4, 0x65, 0x86, 0xbe, 0x01, 0x73, 0x09, 0xc6, 0x2a, 0x9f, 0xb8, 0x7c, 0xec,
+	0xe9, 0x63, 0xd8, 0xe5, 0x14, 0x2b, 0xa6, 0x3a, 0x07, 0x49, 0xc5, 0x06, 0x4f, 0xa3, 0x98, 0x6c,
+	0x26, 0x24, 0x14, 0x7b, 0x06, 0x8e, 0x2a, 0x9e, 0xa6, 0x17, 0xba, 0x4c, 0xd4, 0x1d, 0x03, 0x38,
+	0x27, 0x61, 0x55, 0x0e, 0x42, 0x6f, 0xc2, 0x0b, 0x42, 0x76, 0xdc, 0xa7, 0x4b, 0x4a, 0x1f, 0x3e,
+	0xa1, 0xf4, 0x29, 0xce, 0x22, 0xea, 0xdc, 0x25, 0xe4, 0x3f, 0x07, 0xc7, 0x63, 0xbe, 0x52, 0x83,
+	0xac, 0xd0, 0x60, 0x2c, 0x82, 0x4a, 0x1d, 0x0c, 0x98, 0xef, 0xfb, 0xc6, 0x29, 0xfb, 0xd9, 0xb9,
+	0x85, 0x57, 0x8e, 0xea, 0x63, 0xf5, 0xfa, 0x4e, 0xa9, 0xe7, 0x3b, 0x67, 0x80, 0xc7, 0xfd, 0x9e,
+	0x39, 0xba, 0x03, 0x47, 0x82, 0x70, 0xdd, 0x58, 0x27, 0xae, 0x15, 0x68, 0xf0, 0xd8, 0x68, 0xdf,
+	0xcf, 0x79, 0x25, 0x5c, 0xaf, 0x10, 0xd7, 0xc2, 0xd9, 0x40, 0x0e, 0x02, 0xb4, 0x00, 0xcf, 0xc9,
+	0xde, 0x0e, 0xb5, 0x8c, 0x1e, 0xeb, 0xe6, 0xc4, 0xde, 0xce, 0x46, 0x8b, 0x6b, 0x09, 0x2b, 0xdf,
+	0x84, 0x97, 0xd5, 0x07, 0x56, 0xe3, 0x70, 0xda, 0x51, 0x41, 0x7b, 0x41, 0x7e, 0x76, 0x5d, 0x3c,
+	0xc8, 0x61, 0xfa, 0x57, 0x00, 0x8e, 0xf7, 0xee, 0x12, 0xbd, 0x0a, 0x33, 0x4d, 0xf5, 0x30, 0x1e,
+	0xdb, 0xde, 0xe4, 0xa1, 0xfe, 0x6f, 0xa2, 0x50, 0x2f, 0xda, 0x9c, 0x9c, 0x4e, 0x90, 0x93, 0x1d,
+	0x2d, 0xfd, 0x65, 0xc8, 0xc9, 0x0e, 0xaa, 0xc2, 0xa1, 0x26, 0xb5, 0x6c, 0xe2, 0x6a, 0x99, 0xd3,
+	0x73, 0x50, 0xa4, 0x3c, 0x50, 0xc8, 0xfd, 0x8b, 0x3a, 0x1a, 0xcb, 0xc9, 0xf4, 0x3f, 0x01, 0x38,
+	0xac, 0xec, 0xfe, 0x15, 0xfe, 0x9d, 0xe4, 0xf7, 0xe1, 0x74, 0xec, 0x8c, 0x21, 0xb3, 0x1d, 0x95,
+	0x6d, 0x19, 0x32, 0x97, 0xcc, 0x88, 0x48, 0x15, 0xb7, 0xab, 0xd7, 0xba, 0x08, 0x4b, 0x7c, 0x1d,
+	0xbd, 0x08, 0x27, 0x0f, 0xa3, 0x96, 0xff, 0xbe, 0xc1, 0x67, 0x0f, 0xa1, 0xbb, 0xf6, 0x21, 0x80,
+	0x97, 0x8e, 0xeb, 0xa4, 0xa1, 0x02, 0xbc, 0xb8, 0x56, 0x5b, 0x36, 0xca, 0x6b, 0xab, 0xaf, 0xdd,
+	0xba, 0xb7, 0x5a, 0xaf, 0x96, 0x57, 0xeb, 0xf7, 0xef, 0x19, 0xb5, 0xfa, 0x4a, 0xb9, 0xb2, 0x74,
+	0xab, 0x96, 0x4f, 0x1d, 0x81, 0x70, 0x7f, 0x99, 0xff, 0x94, 0x97, 0xf2, 0xe0, 0x08, 0x04, 0x7c,
+	0xeb, 0xf5, 0xb5, 0x3a, 0xbe, 0x55, 0xcb, 0xa7, 0xa7, 0x07, 0x3e, 0xfa, 0x0b, 0x3d, 0x55, 0xf9,
+	0x4b, 0xf0, 0xd9, 0x23, 0x1d, 0x3c, 0x7c, 0xa4, 0x83, 0x5f, 0x3c, 0xd2, 0x53, 0xbf, 0x7c, 0xa4,
+	0xa7, 0x3e, 0x7f, 0xa4, 0xa7, 0xbe, 0x78, 0xa4, 0xa7, 0x7e, 0xfd, 0x48, 0x07, 0x1f, 0x74, 0x74,
+	0xf0, 0x51, 0x47, 0x4f, 0xfd, 0xb4, 0xa3, 0x83, 0x4f, 0x3a, 0x7a, 0xea, 0xd3, 0x8e, 0x9e, 0xfa,
+	0x79, 0x47, 0x4f, 0x7d, 0xd6, 0xd1, 0xc1, 0xc3, 0x8e, 0x0e, 0x7e, 0xd1, 0xd1, 0x53, 0xbf, 0xec,
+	0xe8, 0xe0, 0xf3, 0x8e, 0x9e, 0xfa, 0xa2, 0xa3, 0x83, 0x5f, 0x77, 0xf4, 0xd4, 0x07, 0x7b, 0x7a,
+	0xea, 0xa3, 0x3d, 0x1d, 0xfc, 0x70, 0x4f, 0x4f, 0xfd, 0x68, 0x4f, 0x07, 0x3f, 0xd9, 0xd3, 0x53,
+	0x3f, 0xdd, 0xd3, 0x53, 0x9f, 0xec, 0xe9, 0xe0, 0xd3, 0x3d, 0x1d, 0xfc, 0x7c, 0x4f, 0x07, 0x6f,
+	0xcc, 0x9f, 0xa2, 0x0d, 0xc5, 0xdc, 0xd6, 0xfa, 0xfa, 0x90, 0xf0, 0x9d, 0x97, 0xfe, 0x77, 0x00,
+	0xf1, 0x48, 0x24, 0xf1, 0xcb, 0x29, 0x00, 0x00,
+}
+
+func (x GatewayUDPAuthenticationMode) String() string {
+	s, ok := GatewayUDPAuthenticationMode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (this *GatewayBrand) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2394,14 +2771,14 @@ func (this *GatewayClaimAuthenticationCode) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Gateway) Equal(that interface{}) bool {
+func (this *GatewayAlertSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Gateway)
+	that1, ok := that.(*GatewayAlertSettings)
 	if !ok {
-		that2, ok := that.(Gateway)
+		that2, ok := that.(GatewayAlertSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2413,64 +2790,221 @@ func (this *Gateway) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+	if this.Disabled != that1.Disabled {
 		return false
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
+	if this.DisconnectThreshold != nil && that1.DisconnectThreshold != nil {
+		if *this.DisconnectThreshold != *that1.DisconnectThreshold {
+			return false
+		}
+	} else if this.DisconnectThreshold != nil {
 		return false
-	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+	} else if that1.DisconnectThreshold != nil {
 		return false
 	}
-	if that1.DeletedAt == nil {
-		if this.DeletedAt != nil {
+	if this.UplinkSilenceThreshold != nil && that1.UplinkSilenceThreshold != nil {
+		if *this.UplinkSilenceThreshold != *that1.UplinkSilenceThreshold {
 			return false
 		}
-	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+	} else if this.UplinkSilenceThreshold != nil {
 		return false
-	}
-	if this.Name != that1.Name {
+	} else if that1.UplinkSilenceThreshold != nil {
 		return false
 	}
-	if this.Description != that1.Description {
+	if this.StatusInterval != nil && that1.StatusInterval != nil {
+		if *this.StatusInterval != *that1.StatusInterval {
+			return false
+		}
+	} else if this.StatusInterval != nil {
 		return false
-	}
-	if len(this.Attributes) != len(that1.Attributes) {
+	} else if that1.StatusInterval != nil {
 		return false
 	}
-	for i := range this.Attributes {
-		if this.Attributes[i] != that1.Attributes[i] {
+	if this.MaxRoundTripTime != nil && that1.MaxRoundTripTime != nil {
+		if *this.MaxRoundTripTime != *that1.MaxRoundTripTime {
 			return false
 		}
-	}
-	if len(this.ContactInfo) != len(that1.ContactInfo) {
+	} else if this.MaxRoundTripTime != nil {
+		return false
+	} else if that1.MaxRoundTripTime != nil {
 		return false
 	}
-	for i := range this.ContactInfo {
-		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
+	return true
+}
+func (this *GatewayUplinkFilters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUplinkFilters)
+	if !ok {
+		that2, ok := that.(GatewayUplinkFilters)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if !this.GatewayVersionIdentifiers.Equal(&that1.GatewayVersionIdentifiers) {
-		return false
-	}
-	if this.GatewayServerAddress != that1.GatewayServerAddress {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.AutoUpdate != that1.AutoUpdate {
+	if this.DryRun != that1.DryRun {
 		return false
 	}
-	if this.UpdateChannel != that1.UpdateChannel {
+	if len(this.AllowDevAddrPrefixes) != len(that1.AllowDevAddrPrefixes) {
 		return false
 	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
-		return false
+	for i := range this.AllowDevAddrPrefixes {
+		if !this.AllowDevAddrPrefixes[i].Equal(that1.AllowDevAddrPrefixes[i]) {
+			return false
+		}
 	}
-	if len(this.FrequencyPlanIDs) != len(that1.FrequencyPlanIDs) {
+	if len(this.DenyDevAddrPrefixes) != len(that1.DenyDevAddrPrefixes) {
 		return false
 	}
-	for i := range this.FrequencyPlanIDs {
-		if this.FrequencyPlanIDs[i] != that1.FrequencyPlanIDs[i] {
+	for i := range this.DenyDevAddrPrefixes {
+		if !this.DenyDevAddrPrefixes[i].Equal(that1.DenyDevAddrPrefixes[i]) {
+			return false
+		}
+	}
+	if len(this.AllowJoinEUIRanges) != len(that1.AllowJoinEUIRanges) {
+		return false
+	}
+	for i := range this.AllowJoinEUIRanges {
+		if !this.AllowJoinEUIRanges[i].Equal(that1.AllowJoinEUIRanges[i]) {
+			return false
+		}
+	}
+	if len(this.DenyJoinEUIRanges) != len(that1.DenyJoinEUIRanges) {
+		return false
+	}
+	for i := range this.DenyJoinEUIRanges {
+		if !this.DenyJoinEUIRanges[i].Equal(that1.DenyJoinEUIRanges[i]) {
+			return false
+		}
+	}
+	if !this.MinSNR.Equal(that1.MinSNR) {
+		return false
+	}
+	if !this.MinRSSI.Equal(that1.MinRSSI) {
+		return false
+	}
+	if len(this.Frequencies) != len(that1.Frequencies) {
+		return false
+	}
+	for i := range this.Frequencies {
+		if this.Frequencies[i] != that1.Frequencies[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayUplinkFilters_JoinEUIRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUplinkFilters_JoinEUIRange)
+	if !ok {
+		that2, ok := that.(GatewayUplinkFilters_JoinEUIRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.From.Equal(that1.From) {
+		return false
+	}
+	if !this.To.Equal(that1.To) {
+		return false
+	}
+	return true
+}
+func (this *Gateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway)
+	if !ok {
+		that2, ok := that.(Gateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return false
+		}
+	}
+	if len(this.ContactInfo) != len(that1.ContactInfo) {
+		return false
+	}
+	for i := range this.ContactInfo {
+		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
+			return false
+		}
+	}
+	if !this.GatewayVersionIdentifiers.Equal(&that1.GatewayVersionIdentifiers) {
+		return false
+	}
+	if this.GatewayServerAddress != that1.GatewayServerAddress {
+		return false
+	}
+	if this.AutoUpdate != that1.AutoUpdate {
+		return false
+	}
+	if this.UpdateChannel != that1.UpdateChannel {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if len(this.FrequencyPlanIDs) != len(that1.FrequencyPlanIDs) {
+		return false
+	}
+	for i := range this.FrequencyPlanIDs {
+		if this.FrequencyPlanIDs[i] != that1.FrequencyPlanIDs[i] {
 			return false
 		}
 	}
@@ -2524,6 +3058,18 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if this.RequireAuthenticatedConnection != that1.RequireAuthenticatedConnection {
 		return false
 	}
+	if !this.AlertSettings.Equal(that1.AlertSettings) {
+		return false
+	}
+	if !this.UplinkFilters.Equal(that1.UplinkFilters) {
+		return false
+	}
+	if !this.UDPSecret.Equal(that1.UDPSecret) {
+		return false
+	}
+	if this.UDPAuthenticationMode != that1.UDPAuthenticationMode {
+		return false
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -3079,6 +3625,12 @@ func (this *GatewayConnectionStats) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.FilteredUplinkCount != that1.FilteredUplinkCount {
+		return false
+	}
+	if this.DryRunFilteredUplinkCount != that1.DryRunFilteredUplinkCount {
+		return false
+	}
 	return true
 }
 func (this *GatewayConnectionStats_RoundTripTimes) Equal(that interface{}) bool {
@@ -3512,7 +4064,7 @@ func (m *GatewayClaimAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *Gateway) Marshal() (dAtA []byte, err error) {
+func (m *GatewayAlertSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3522,66 +4074,110 @@ func (m *Gateway) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayAlertSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayAlertSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RequireAuthenticatedConnection {
-		i--
-		if m.RequireAuthenticatedConnection {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
-	}
-	if m.DeletedAt != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+	if m.MaxRoundTripTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxRoundTripTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxRoundTripTime):])
 		if err6 != nil {
 			return 0, err6
 		}
 		i -= n6
 		i = encodeVarintGateway(dAtA, i, uint64(n6))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2a
+	}
+	if m.StatusInterval != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusInterval):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGateway(dAtA, i, uint64(n7))
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x22
 	}
-	if m.TargetCUPSKey != nil {
-		{
-			size, err := m.TargetCUPSKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGateway(dAtA, i, uint64(size))
+	if m.UplinkSilenceThreshold != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.UplinkSilenceThreshold, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.UplinkSilenceThreshold):])
+		if err8 != nil {
+			return 0, err8
 		}
+		i -= n8
+		i = encodeVarintGateway(dAtA, i, uint64(n8))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x1a
+	}
+	if m.DisconnectThreshold != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DisconnectThreshold, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DisconnectThreshold):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGateway(dAtA, i, uint64(n9))
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x12
 	}
-	if len(m.TargetCUPSURI) > 0 {
-		i -= len(m.TargetCUPSURI)
-		copy(dAtA[i:], m.TargetCUPSURI)
-		i = encodeVarintGateway(dAtA, i, uint64(len(m.TargetCUPSURI)))
+	if m.Disabled {
 		i--
-		dAtA[i] = 0x1
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x8
 	}
-	if m.ClaimAuthenticationCode != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayUplinkFilters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUplinkFilters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUplinkFilters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Frequencies) > 0 {
+		dAtA11 := make([]byte, len(m.Frequencies)*10)
+		var j10 int
+		for _, num := range m.Frequencies {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(num&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGateway(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MinRSSI != nil {
 		{
-			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinRSSI.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3589,13 +4185,11 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGateway(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x3a
 	}
-	if m.LBSLNSSecret != nil {
+	if m.MinSNR != nil {
 		{
-			size, err := m.LBSLNSSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinSNR.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3603,40 +4197,294 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGateway(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x32
 	}
-	if m.UpdateLocationFromStatus {
-		i--
-		if m.UpdateLocationFromStatus {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.DenyJoinEUIRanges) > 0 {
+		for iNdEx := len(m.DenyJoinEUIRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenyJoinEUIRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
 	}
-	if len(m.FrequencyPlanIDs) > 0 {
-		for iNdEx := len(m.FrequencyPlanIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FrequencyPlanIDs[iNdEx])
-			copy(dAtA[i:], m.FrequencyPlanIDs[iNdEx])
-			i = encodeVarintGateway(dAtA, i, uint64(len(m.FrequencyPlanIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x1
+	if len(m.AllowJoinEUIRanges) > 0 {
+		for iNdEx := len(m.AllowJoinEUIRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowJoinEUIRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0x22
 		}
 	}
-	if m.ScheduleAnytimeDelay != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleAnytimeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleAnytimeDelay):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintGateway(dAtA, i, uint64(n10))
+	if len(m.DenyDevAddrPrefixes) > 0 {
+		for iNdEx := len(m.DenyDevAddrPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenyDevAddrPrefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowDevAddrPrefixes) > 0 {
+		for iNdEx := len(m.AllowDevAddrPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowDevAddrPrefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayUplinkFilters_JoinEUIRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUplinkFilters_JoinEUIRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUplinkFilters_JoinEUIRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.To.Size()
+		i -= size
+		if _, err := m.To.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UDPAuthenticationMode != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.UDPAuthenticationMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.UDPSecret != nil {
+		{
+			size, err := m.UDPSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.UplinkFilters != nil {
+		{
+			size, err := m.UplinkFilters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.AlertSettings != nil {
+		{
+			size, err := m.AlertSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.RequireAuthenticatedConnection {
+		i--
+		if m.RequireAuthenticatedConnection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.DeletedAt != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGateway(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.TargetCUPSKey != nil {
+		{
+			size, err := m.TargetCUPSKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.TargetCUPSURI) > 0 {
+		i -= len(m.TargetCUPSURI)
+		copy(dAtA[i:], m.TargetCUPSURI)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.TargetCUPSURI)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.ClaimAuthenticationCode != nil {
+		{
+			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.LBSLNSSecret != nil {
+		{
+			size, err := m.LBSLNSSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.UpdateLocationFromStatus {
+		i--
+		if m.UpdateLocationFromStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.FrequencyPlanIDs) > 0 {
+		for iNdEx := len(m.FrequencyPlanIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrequencyPlanIDs[iNdEx])
+			copy(dAtA[i:], m.FrequencyPlanIDs[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.FrequencyPlanIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ScheduleAnytimeDelay != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleAnytimeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleAnytimeDelay):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintGateway(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -3795,20 +4643,20 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err12 != nil {
-		return 0, err12
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n12
-	i = encodeVarintGateway(dAtA, i, uint64(n12))
+	i -= n23
+	i = encodeVarintGateway(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x1a
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err13 != nil {
-		return 0, err13
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n13
-	i = encodeVarintGateway(dAtA, i, uint64(n13))
+	i -= n24
+	i = encodeVarintGateway(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -4199,30 +5047,30 @@ func (m *CreateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if m.ExpiresAt != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
-		if err25 != nil {
-			return 0, err25
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n25
-		i = encodeVarintGateway(dAtA, i, uint64(n25))
+		i -= n36
+		i = encodeVarintGateway(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.Rights) > 0 {
-		dAtA27 := make([]byte, len(m.Rights)*10)
-		var j26 int
+		dAtA38 := make([]byte, len(m.Rights)*10)
+		var j37 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j26++
+				j37++
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j26
-		copy(dAtA[i:], dAtA27[:j26])
-		i = encodeVarintGateway(dAtA, i, uint64(j26))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintGateway(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4582,20 +5430,20 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
-	if err39 != nil {
-		return 0, err39
+	n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
+	if err50 != nil {
+		return 0, err50
 	}
-	i -= n39
-	i = encodeVarintGateway(dAtA, i, uint64(n39))
+	i -= n50
+	i = encodeVarintGateway(dAtA, i, uint64(n50))
 	i--
 	dAtA[i] = 0x12
-	n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err40 != nil {
-		return 0, err40
+	n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err51 != nil {
+		return 0, err51
 	}
-	i -= n40
-	i = encodeVarintGateway(dAtA, i, uint64(n40))
+	i -= n51
+	i = encodeVarintGateway(dAtA, i, uint64(n51))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4621,6 +5469,16 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DryRunFilteredUplinkCount != 0 {
+		i = encodeVarintGateway(dAtA, i, m.DryRunFilteredUplinkCount)
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FilteredUplinkCount != 0 {
+		i = encodeVarintGateway(dAtA, i, m.FilteredUplinkCount)
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.SubBands) > 0 {
 		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4653,12 +5511,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x40
 	}
 	if m.LastDownlinkReceivedAt != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
-		if err42 != nil {
-			return 0, err42
+		n53, err53 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
+		if err53 != nil {
+			return 0, err53
 		}
-		i -= n42
-		i = encodeVarintGateway(dAtA, i, uint64(n42))
+		i -= n53
+		i = encodeVarintGateway(dAtA, i, uint64(n53))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4668,12 +5526,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x30
 	}
 	if m.LastUplinkReceivedAt != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
-		if err43 != nil {
-			return 0, err43
+		n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
+		if err54 != nil {
+			return 0, err54
 		}
-		i -= n43
-		i = encodeVarintGateway(dAtA, i, uint64(n43))
+		i -= n54
+		i = encodeVarintGateway(dAtA, i, uint64(n54))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4690,12 +5548,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 	}
 	if m.LastStatusReceivedAt != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
-		if err45 != nil {
-			return 0, err45
+		n56, err56 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err56 != nil {
+			return 0, err56
 		}
-		i -= n45
-		i = encodeVarintGateway(dAtA, i, uint64(n45))
+		i -= n56
+		i = encodeVarintGateway(dAtA, i, uint64(n56))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4707,12 +5565,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 	}
 	if m.ConnectedAt != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
-		if err46 != nil {
-			return 0, err46
+		n57, err57 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
+		if err57 != nil {
+			return 0, err57
 		}
-		i -= n46
-		i = encodeVarintGateway(dAtA, i, uint64(n46))
+		i -= n57
+		i = encodeVarintGateway(dAtA, i, uint64(n57))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4744,28 +5602,28 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x20
 	}
-	n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
-	if err47 != nil {
-		return 0, err47
+	n58, err58 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err58 != nil {
+		return 0, err58
 	}
-	i -= n47
-	i = encodeVarintGateway(dAtA, i, uint64(n47))
+	i -= n58
+	i = encodeVarintGateway(dAtA, i, uint64(n58))
 	i--
 	dAtA[i] = 0x1a
-	n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
-	if err48 != nil {
-		return 0, err48
+	n59, err59 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err59 != nil {
+		return 0, err59
 	}
-	i -= n48
-	i = encodeVarintGateway(dAtA, i, uint64(n48))
+	i -= n59
+	i = encodeVarintGateway(dAtA, i, uint64(n59))
 	i--
 	dAtA[i] = 0x12
-	n49, err49 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
-	if err49 != nil {
-		return 0, err49
+	n60, err60 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err60 != nil {
+		return 0, err60
 	}
-	i -= n49
-	i = encodeVarintGateway(dAtA, i, uint64(n49))
+	i -= n60
+	i = encodeVarintGateway(dAtA, i, uint64(n60))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4906,63 +5764,141 @@ func NewPopulatedGatewayClaimAuthenticationCode(r randyGateway, easy bool) *Gate
 	return this
 }
 
-func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
-	this := &Gateway{}
-	v2 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v3
-	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v4
-	this.Name = randStringGateway(r)
-	this.Description = randStringGateway(r)
+func NewPopulatedGatewayAlertSettings(r randyGateway, easy bool) *GatewayAlertSettings {
+	this := &GatewayAlertSettings{}
+	this.Disabled = bool(r.Intn(2) == 0)
 	if r.Intn(5) != 0 {
-		v5 := r.Intn(10)
-		this.Attributes = make(map[string]string)
-		for i := 0; i < v5; i++ {
-			this.Attributes[randStringGateway(r)] = randStringGateway(r)
-		}
+		this.DisconnectThreshold = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.ContactInfo = make([]*ContactInfo, v6)
-		for i := 0; i < v6; i++ {
-			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
-		}
+		this.UplinkSilenceThreshold = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	v7 := NewPopulatedGatewayVersionIdentifiers(r, easy)
-	this.GatewayVersionIdentifiers = *v7
-	this.GatewayServerAddress = randStringGateway(r)
-	this.AutoUpdate = bool(r.Intn(2) == 0)
-	this.UpdateChannel = randStringGateway(r)
-	this.FrequencyPlanID = randStringGateway(r)
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Antennas = make([]GatewayAntenna, v8)
-		for i := 0; i < v8; i++ {
-			v9 := NewPopulatedGatewayAntenna(r, easy)
-			this.Antennas[i] = *v9
-		}
+		this.StatusInterval = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	this.StatusPublic = bool(r.Intn(2) == 0)
-	this.LocationPublic = bool(r.Intn(2) == 0)
-	this.ScheduleDownlinkLate = bool(r.Intn(2) == 0)
-	this.EnforceDutyCycle = bool(r.Intn(2) == 0)
-	this.DownlinkPathConstraint = DownlinkPathConstraint([]int32{0, 1, 2}[r.Intn(3)])
 	if r.Intn(5) != 0 {
-		this.ScheduleAnytimeDelay = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+		this.MaxRoundTripTime = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	v10 := r.Intn(10)
-	this.FrequencyPlanIDs = make([]string, v10)
-	for i := 0; i < v10; i++ {
-		this.FrequencyPlanIDs[i] = randStringGateway(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	this.UpdateLocationFromStatus = bool(r.Intn(2) == 0)
+	return this
+}
+
+func NewPopulatedGatewayUplinkFilters(r randyGateway, easy bool) *GatewayUplinkFilters {
+	this := &GatewayUplinkFilters{}
+	this.DryRun = bool(r.Intn(2) == 0)
 	if r.Intn(5) != 0 {
-		this.LBSLNSSecret = NewPopulatedSecret(r, easy)
+		v2 := r.Intn(5)
+		this.AllowDevAddrPrefixes = make([]*DevAddrPrefix, v2)
+		for i := 0; i < v2; i++ {
+			this.AllowDevAddrPrefixes[i] = NewPopulatedDevAddrPrefix(r, easy)
+		}
 	}
 	if r.Intn(5) != 0 {
-		this.ClaimAuthenticationCode = NewPopulatedGatewayClaimAuthenticationCode(r, easy)
+		v3 := r.Intn(5)
+		this.DenyDevAddrPrefixes = make([]*DevAddrPrefix, v3)
+		for i := 0; i < v3; i++ {
+			this.DenyDevAddrPrefixes[i] = NewPopulatedDevAddrPrefix(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.AllowJoinEUIRanges = make([]*GatewayUplinkFilters_JoinEUIRange, v4)
+		for i := 0; i < v4; i++ {
+			this.AllowJoinEUIRanges[i] = NewPopulatedGatewayUplinkFilters_JoinEUIRange(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.DenyJoinEUIRanges = make([]*GatewayUplinkFilters_JoinEUIRange, v5)
+		for i := 0; i < v5; i++ {
+			this.DenyJoinEUIRanges[i] = NewPopulatedGatewayUplinkFilters_JoinEUIRange(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.MinSNR = types.NewPopulatedFloatValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MinRSSI = types.NewPopulatedFloatValue(r, easy)
+	}
+	v6 := r.Intn(10)
+	this.Frequencies = make([]uint64, v6)
+	for i := 0; i < v6; i++ {
+		this.Frequencies[i] = uint64(r.Uint32())
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayUplinkFilters_JoinEUIRange(r randyGateway, easy bool) *GatewayUplinkFilters_JoinEUIRange {
+	this := &GatewayUplinkFilters_JoinEUIRange{}
+	v7 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.From = *v7
+	v8 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.To = *v8
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
+	this := &Gateway{}
+	v9 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v9
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v10
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v11
+	this.Name = randStringGateway(r)
+	this.Description = randStringGateway(r)
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(10)
+		this.Attributes = make(map[string]string)
+		for i := 0; i < v12; i++ {
+			this.Attributes[randStringGateway(r)] = randStringGateway(r)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v13 := r.Intn(5)
+		this.ContactInfo = make([]*ContactInfo, v13)
+		for i := 0; i < v13; i++ {
+			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
+		}
+	}
+	v14 := NewPopulatedGatewayVersionIdentifiers(r, easy)
+	this.GatewayVersionIdentifiers = *v14
+	this.GatewayServerAddress = randStringGateway(r)
+	this.AutoUpdate = bool(r.Intn(2) == 0)
+	this.UpdateChannel = randStringGateway(r)
+	this.FrequencyPlanID = randStringGateway(r)
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(5)
+		this.Antennas = make([]GatewayAntenna, v15)
+		for i := 0; i < v15; i++ {
+			v16 := NewPopulatedGatewayAntenna(r, easy)
+			this.Antennas[i] = *v16
+		}
+	}
+	this.StatusPublic = bool(r.Intn(2) == 0)
+	this.LocationPublic = bool(r.Intn(2) == 0)
+	this.ScheduleDownlinkLate = bool(r.Intn(2) == 0)
+	this.EnforceDutyCycle = bool(r.Intn(2) == 0)
+	this.DownlinkPathConstraint = DownlinkPathConstraint([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(5) != 0 {
+		this.ScheduleAnytimeDelay = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	v17 := r.Intn(10)
+	this.FrequencyPlanIDs = make([]string, v17)
+	for i := 0; i < v17; i++ {
+		this.FrequencyPlanIDs[i] = randStringGateway(r)
+	}
+	this.UpdateLocationFromStatus = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.LBSLNSSecret = NewPopulatedSecret(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ClaimAuthenticationCode = NewPopulatedGatewayClaimAuthenticationCode(r, easy)
 	}
 	this.TargetCUPSURI = randStringGateway(r)
 	if r.Intn(5) != 0 {
@@ -4972,6 +5908,16 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
 	this.RequireAuthenticatedConnection = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.AlertSettings = NewPopulatedGatewayAlertSettings(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkFilters = NewPopulatedGatewayUplinkFilters(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UDPSecret = NewPopulatedSecret(r, easy)
+	}
+	this.UDPAuthenticationMode = GatewayUDPAuthenticationMode([]int32{0, 1, 2}[r.Intn(3)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4980,9 +5926,9 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 	this := &Gateways{}
 	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Gateways = make([]*Gateway, v11)
-		for i := 0; i < v11; i++ {
+		v18 := r.Intn(5)
+		this.Gateways = make([]*Gateway, v18)
+		for i := 0; i < v18; i++ {
 			this.Gateways[i] = NewPopulatedGateway(r, easy)
 		}
 	}
@@ -4993,10 +5939,10 @@ func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 
 func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest {
 	this := &GetGatewayRequest{}
-	v12 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v19 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5004,8 +5950,8 @@ func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest
 
 func NewPopulatedGetGatewayIdentifiersForEUIRequest(r randyGateway, easy bool) *GetGatewayIdentifiersForEUIRequest {
 	this := &GetGatewayIdentifiersForEUIRequest{}
-	v14 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
-	this.Eui = *v14
+	v21 := go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedEUI64(r)
+	this.Eui = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5016,8 +5962,8 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	if r.Intn(5) != 0 {
 		this.Collaborator = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
 	}
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -5029,10 +5975,10 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 
 func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayRequest {
 	this := &CreateGatewayRequest{}
-	v16 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v16
-	v17 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.Collaborator = *v17
+	v23 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v23
+	v24 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.Collaborator = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5040,10 +5986,10 @@ func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayR
 
 func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayRequest {
 	this := &UpdateGatewayRequest{}
-	v18 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v18
-	v19 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v19
+	v25 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v25
+	v26 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5051,8 +5997,8 @@ func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayR
 
 func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatewayAPIKeysRequest {
 	this := &ListGatewayAPIKeysRequest{}
-	v20 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v20
+	v27 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v27
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -5062,8 +6008,8 @@ func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatew
 
 func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayAPIKeyRequest {
 	this := &GetGatewayAPIKeyRequest{}
-	v21 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v21
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
 	this.KeyID = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -5072,13 +6018,13 @@ func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayA
 
 func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGatewayAPIKeyRequest {
 	this := &CreateGatewayAPIKeyRequest{}
-	v22 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v22
+	v29 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v29
 	this.Name = randStringGateway(r)
-	v23 := r.Intn(10)
-	this.Rights = make([]Right, v23)
-	for i := 0; i < v23; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+	v30 := r.Intn(10)
+	this.Rights = make([]Right, v30)
+	for i := 0; i < v30; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if r.Intn(5) != 0 {
 		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
@@ -5090,12 +6036,12 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v24 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v24
-	v25 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v25
-	v26 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v26
+	v31 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v31
+	v32 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v32
+	v33 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v33
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5103,8 +6049,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
+	v34 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v34
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -5114,10 +6060,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v28 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v28
-	v29 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v29
+	v35 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v35
+	v36 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v36
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5125,10 +6071,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v30 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v30
-	v31 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v31
+	v37 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v37
+	v38 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v38
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5144,9 +6090,9 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 		this.Location = NewPopulatedLocation(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v39 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v39; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -5157,37 +6103,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
-	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	v40 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v40
+	v41 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v41
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v42 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v42; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v43 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v43)
+		for i := 0; i < v43; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v44 := r.Intn(10)
+	this.IP = make([]string, v44)
+	for i := 0; i < v44; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v45 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v45; i++ {
+			v46 := randStringGateway(r)
+			this.Metrics[v46] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v46] *= -1
 			}
 		}
 	}
@@ -5223,12 +6169,14 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 		this.RoundTripTimes = NewPopulatedGatewayConnectionStats_RoundTripTimes(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v40 := r.Intn(5)
-		this.SubBands = make([]*GatewayConnectionStats_SubBand, v40)
-		for i := 0; i < v40; i++ {
+		v47 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v47)
+		for i := 0; i < v47; i++ {
 			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
 		}
 	}
+	this.FilteredUplinkCount = uint64(r.Uint32())
+	this.DryRunFilteredUplinkCount = uint64(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5236,12 +6184,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v41
-	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v42
-	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v43
+	v48 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Min = *v48
+	v49 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v49
+	v50 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v50
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -5284,9 +6232,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v44 := r.Intn(100)
-	tmps := make([]rune, v44)
-	for i := 0; i < v44; i++ {
+	v51 := r.Intn(100)
+	tmps := make([]rune, v51)
+	for i := 0; i < v51; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -5308,11 +6256,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v45 := r.Int63()
+		v52 := r.Int63()
 		if r.Intn(2) == 0 {
-			v45 *= -1
+			v52 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v45))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v52))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5501,6 +6449,98 @@ func (m *GatewayClaimAuthenticationCode) Size() (n int) {
 	return n
 }
 
+func (m *GatewayAlertSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Disabled {
+		n += 2
+	}
+	if m.DisconnectThreshold != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DisconnectThreshold)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.UplinkSilenceThreshold != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.UplinkSilenceThreshold)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.StatusInterval != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusInterval)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.MaxRoundTripTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxRoundTripTime)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayUplinkFilters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.AllowDevAddrPrefixes) > 0 {
+		for _, e := range m.AllowDevAddrPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.DenyDevAddrPrefixes) > 0 {
+		for _, e := range m.DenyDevAddrPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.AllowJoinEUIRanges) > 0 {
+		for _, e := range m.AllowJoinEUIRanges {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.DenyJoinEUIRanges) > 0 {
+		for _, e := range m.DenyJoinEUIRanges {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if m.MinSNR != nil {
+		l = m.MinSNR.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.MinRSSI != nil {
+		l = m.MinRSSI.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.Frequencies) > 0 {
+		l = 0
+		for _, e := range m.Frequencies {
+			l += sovGateway(e)
+		}
+		n += 1 + sovGateway(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GatewayUplinkFilters_JoinEUIRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.From.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	return n
+}
+
 func (m *Gateway) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5609,6 +6649,21 @@ func (m *Gateway) Size() (n int) {
 	if m.RequireAuthenticatedConnection {
 		n += 3
 	}
+	if m.AlertSettings != nil {
+		l = m.AlertSettings.Size()
+		n += 2 + l + sovGateway(uint64(l))
+	}
+	if m.UplinkFilters != nil {
+		l = m.UplinkFilters.Size()
+		n += 2 + l + sovGateway(uint64(l))
+	}
+	if m.UDPSecret != nil {
+		l = m.UDPSecret.Size()
+		n += 2 + l + sovGateway(uint64(l))
+	}
+	if m.UDPAuthenticationMode != 0 {
+		n += 2 + sovGateway(uint64(m.UDPAuthenticationMode))
+	}
 	return n
 }
 
@@ -5936,6 +6991,12 @@ func (m *GatewayConnectionStats) Size() (n int) {
 			n += 1 + l + sovGateway(uint64(l))
 		}
 	}
+	if m.FilteredUplinkCount != 0 {
+		n += 1 + sovGateway(m.FilteredUplinkCount)
+	}
+	if m.DryRunFilteredUplinkCount != 0 {
+		n += 1 + sovGateway(m.DryRunFilteredUplinkCount)
+	}
 	return n
 }
 
@@ -6078,6 +7139,68 @@ func (this *GatewayClaimAuthenticationCode) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayAlertSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAlertSettings{`,
+		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
+		`DisconnectThreshold:` + strings.Replace(fmt.Sprintf("%v", this.DisconnectThreshold), "Duration", "types.Duration", 1) + `,`,
+		`UplinkSilenceThreshold:` + strings.Replace(fmt.Sprintf("%v", this.UplinkSilenceThreshold), "Duration", "types.Duration", 1) + `,`,
+		`StatusInterval:` + strings.Replace(fmt.Sprintf("%v", this.StatusInterval), "Duration", "types.Duration", 1) + `,`,
+		`MaxRoundTripTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxRoundTripTime), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayUplinkFilters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAllowDevAddrPrefixes := "[]*DevAddrPrefix{"
+	for _, f := range this.AllowDevAddrPrefixes {
+		repeatedStringForAllowDevAddrPrefixes += strings.Replace(fmt.Sprintf("%v", f), "DevAddrPrefix", "DevAddrPrefix", 1) + ","
+	}
+	repeatedStringForAllowDevAddrPrefixes += "}"
+	repeatedStringForDenyDevAddrPrefixes := "[]*DevAddrPrefix{"
+	for _, f := range this.DenyDevAddrPrefixes {
+		repeatedStringForDenyDevAddrPrefixes += strings.Replace(fmt.Sprintf("%v", f), "DevAddrPrefix", "DevAddrPrefix", 1) + ","
+	}
+	repeatedStringForDenyDevAddrPrefixes += "}"
+	repeatedStringForAllowJoinEUIRanges := "[]*GatewayUplinkFilters_JoinEUIRange{"
+	for _, f := range this.AllowJoinEUIRanges {
+		repeatedStringForAllowJoinEUIRanges += strings.Replace(fmt.Sprintf("%v", f), "GatewayUplinkFilters_JoinEUIRange", "GatewayUplinkFilters_JoinEUIRange", 1) + ","
+	}
+	repeatedStringForAllowJoinEUIRanges += "}"
+	repeatedStringForDenyJoinEUIRanges := "[]*GatewayUplinkFilters_JoinEUIRange{"
+	for _, f := range this.DenyJoinEUIRanges {
+		repeatedStringForDenyJoinEUIRanges += strings.Replace(fmt.Sprintf("%v", f), "GatewayUplinkFilters_JoinEUIRange", "GatewayUplinkFilters_JoinEUIRange", 1) + ","
+	}
+	repeatedStringForDenyJoinEUIRanges += "}"
+	s := strings.Join([]string{`&GatewayUplinkFilters{`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`AllowDevAddrPrefixes:` + repeatedStringForAllowDevAddrPrefixes + `,`,
+		`DenyDevAddrPrefixes:` + repeatedStringForDenyDevAddrPrefixes + `,`,
+		`AllowJoinEUIRanges:` + repeatedStringForAllowJoinEUIRanges + `,`,
+		`DenyJoinEUIRanges:` + repeatedStringForDenyJoinEUIRanges + `,`,
+		`MinSNR:` + strings.Replace(fmt.Sprintf("%v", this.MinSNR), "FloatValue", "types.FloatValue", 1) + `,`,
+		`MinRSSI:` + strings.Replace(fmt.Sprintf("%v", this.MinRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
+		`Frequencies:` + fmt.Sprintf("%v", this.Frequencies) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayUplinkFilters_JoinEUIRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayUplinkFilters_JoinEUIRange{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Gateway) String() string {
 	if this == nil {
 		return "nil"
@@ -6130,6 +7253,10 @@ func (this *Gateway) String() string {
 		`TargetCUPSKey:` + strings.Replace(fmt.Sprintf("%v", this.TargetCUPSKey), "Secret", "Secret", 1) + `,`,
 		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`RequireAuthenticatedConnection:` + fmt.Sprintf("%v", this.RequireAuthenticatedConnection) + `,`,
+		`AlertSettings:` + strings.Replace(this.AlertSettings.String(), "GatewayAlertSettings", "GatewayAlertSettings", 1) + `,`,
+		`UplinkFilters:` + strings.Replace(this.UplinkFilters.String(), "GatewayUplinkFilters", "GatewayUplinkFilters", 1) + `,`,
+		`UDPSecret:` + strings.Replace(fmt.Sprintf("%v", this.UDPSecret), "Secret", "Secret", 1) + `,`,
+		`UDPAuthenticationMode:` + fmt.Sprintf("%v", this.UDPAuthenticationMode) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6372,6 +7499,8 @@ func (this *GatewayConnectionStats) String() string {
 		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
 		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStats_RoundTripTimes", "GatewayConnectionStats_RoundTripTimes", 1) + `,`,
 		`SubBands:` + repeatedStringForSubBands + `,`,
+		`FilteredUplinkCount:` + fmt.Sprintf("%v", this.FilteredUplinkCount) + `,`,
+		`DryRunFilteredUplinkCount:` + fmt.Sprintf("%v", this.DryRunFilteredUplinkCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7339,15 +8468,750 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSource |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidTo == nil {
+				m.ValidTo = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayAlertSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayAlertSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayAlertSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisconnectThreshold == nil {
+				m.DisconnectThreshold = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.DisconnectThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkSilenceThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkSilenceThreshold == nil {
+				m.UplinkSilenceThreshold = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.UplinkSilenceThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusInterval == nil {
+				m.StatusInterval = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoundTripTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxRoundTripTime == nil {
+				m.MaxRoundTripTime = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxRoundTripTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayUplinkFilters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUplinkFilters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUplinkFilters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowDevAddrPrefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowDevAddrPrefixes = append(m.AllowDevAddrPrefixes, &DevAddrPrefix{})
+			if err := m.AllowDevAddrPrefixes[len(m.AllowDevAddrPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyDevAddrPrefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyDevAddrPrefixes = append(m.DenyDevAddrPrefixes, &DevAddrPrefix{})
+			if err := m.DenyDevAddrPrefixes[len(m.DenyDevAddrPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowJoinEUIRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowJoinEUIRanges = append(m.AllowJoinEUIRanges, &GatewayUplinkFilters_JoinEUIRange{})
+			if err := m.AllowJoinEUIRanges[len(m.AllowJoinEUIRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyJoinEUIRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyJoinEUIRanges = append(m.DenyJoinEUIRanges, &GatewayUplinkFilters_JoinEUIRange{})
+			if err := m.DenyJoinEUIRanges[len(m.DenyJoinEUIRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSNR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSNR == nil {
+				m.MinSNR = &types.FloatValue{}
+			}
+			if err := m.MinSNR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRSSI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinRSSI == nil {
+				m.MinRSSI = &types.FloatValue{}
+			}
+			if err := m.MinRSSI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGateway
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Frequencies = append(m.Frequencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGateway
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGateway
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGateway
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClockSource |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Frequencies) == 0 {
+					m.Frequencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGateway
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Frequencies = append(m.Frequencies, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequencies", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -7373,7 +9237,7 @@ func (m *GatewayVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
+func (m *GatewayUplinkFilters_JoinEUIRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7396,17 +9260,17 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinEUIRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayClaimAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinEUIRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7416,69 +9280,30 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGateway
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGateway
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGateway
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValidFrom == nil {
-				m.ValidFrom = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -7488,25 +9313,22 @@ func (m *GatewayClaimAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidTo == nil {
-				m.ValidTo = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8453,6 +10275,133 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequireAuthenticatedConnection = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AlertSettings == nil {
+				m.AlertSettings = &GatewayAlertSettings{}
+			}
+			if err := m.AlertSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkFilters == nil {
+				m.UplinkFilters = &GatewayUplinkFilters{}
+			}
+			if err := m.UplinkFilters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDPSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UDPSecret == nil {
+				m.UDPSecret = &Secret{}
+			}
+			if err := m.UDPSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDPAuthenticationMode", wireType)
+			}
+			m.UDPAuthenticationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UDPAuthenticationMode |= GatewayUDPAuthenticationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -11228,6 +13177,44 @@ func (m *GatewayConnectionStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredUplinkCount", wireType)
+			}
+			m.FilteredUplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilteredUplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRunFilteredUplinkCount", wireType)
+			}
+			m.DryRunFilteredUplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DryRunFilteredUplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
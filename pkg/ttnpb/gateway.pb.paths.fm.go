@@ -87,7 +87,58 @@ var GatewayClaimAuthenticationCodeFieldPathsTopLevel = []string{
 	"valid_from",
 	"valid_to",
 }
+var GatewayAlertSettingsFieldPathsNested = []string{
+	"disabled",
+	"disconnect_threshold",
+	"max_round_trip_time",
+	"status_interval",
+	"uplink_silence_threshold",
+}
+
+var GatewayAlertSettingsFieldPathsTopLevel = []string{
+	"disabled",
+	"disconnect_threshold",
+	"max_round_trip_time",
+	"status_interval",
+	"uplink_silence_threshold",
+}
+var GatewayUplinkFilters_JoinEUIRangeFieldPathsNested = []string{
+	"from",
+	"to",
+}
+
+var GatewayUplinkFilters_JoinEUIRangeFieldPathsTopLevel = []string{
+	"from",
+	"to",
+}
+var GatewayUplinkFiltersFieldPathsNested = []string{
+	"allow_dev_addr_prefixes",
+	"allow_join_eui_ranges",
+	"deny_dev_addr_prefixes",
+	"deny_join_eui_ranges",
+	"dry_run",
+	"frequencies",
+	"min_rssi",
+	"min_snr",
+}
+
+var GatewayUplinkFiltersFieldPathsTopLevel = []string{
+	"allow_dev_addr_prefixes",
+	"allow_join_eui_ranges",
+	"deny_dev_addr_prefixes",
+	"deny_join_eui_ranges",
+	"dry_run",
+	"frequencies",
+	"min_rssi",
+	"min_snr",
+}
 var GatewayFieldPathsNested = []string{
+	"alert_settings",
+	"alert_settings.disabled",
+	"alert_settings.disconnect_threshold",
+	"alert_settings.max_round_trip_time",
+	"alert_settings.status_interval",
+	"alert_settings.uplink_silence_threshold",
 	"antennas",
 	"attributes",
 	"auto_update",
@@ -122,9 +173,22 @@ var GatewayFieldPathsNested = []string{
 	"target_cups_key.key_id",
 	"target_cups_key.value",
 	"target_cups_uri",
+	"udp_authentication_mode",
+	"udp_secret",
+	"udp_secret.key_id",
+	"udp_secret.value",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
+	"uplink_filters.allow_dev_addr_prefixes",
+	"uplink_filters.allow_join_eui_ranges",
+	"uplink_filters.deny_dev_addr_prefixes",
+	"uplink_filters.deny_join_eui_ranges",
+	"uplink_filters.dry_run",
+	"uplink_filters.frequencies",
+	"uplink_filters.min_rssi",
+	"uplink_filters.min_snr",
 	"version_ids",
 	"version_ids.brand_id",
 	"version_ids.firmware_version",
@@ -133,6 +197,7 @@ var GatewayFieldPathsNested = []string{
 }
 
 var GatewayFieldPathsTopLevel = []string{
+	"alert_settings",
 	"antennas",
 	"attributes",
 	"auto_update",
@@ -156,9 +221,12 @@ var GatewayFieldPathsTopLevel = []string{
 	"status_public",
 	"target_cups_key",
 	"target_cups_uri",
+	"udp_authentication_mode",
+	"udp_secret",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
 	"version_ids",
 }
 var GatewaysFieldPathsNested = []string{
@@ -218,6 +286,12 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
 	"gateway",
+	"gateway.alert_settings",
+	"gateway.alert_settings.disabled",
+	"gateway.alert_settings.disconnect_threshold",
+	"gateway.alert_settings.max_round_trip_time",
+	"gateway.alert_settings.status_interval",
+	"gateway.alert_settings.uplink_silence_threshold",
 	"gateway.antennas",
 	"gateway.attributes",
 	"gateway.auto_update",
@@ -252,9 +326,22 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.target_cups_key.key_id",
 	"gateway.target_cups_key.value",
 	"gateway.target_cups_uri",
+	"gateway.udp_authentication_mode",
+	"gateway.udp_secret",
+	"gateway.udp_secret.key_id",
+	"gateway.udp_secret.value",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
+	"gateway.uplink_filters.allow_dev_addr_prefixes",
+	"gateway.uplink_filters.allow_join_eui_ranges",
+	"gateway.uplink_filters.deny_dev_addr_prefixes",
+	"gateway.uplink_filters.deny_join_eui_ranges",
+	"gateway.uplink_filters.dry_run",
+	"gateway.uplink_filters.frequencies",
+	"gateway.uplink_filters.min_rssi",
+	"gateway.uplink_filters.min_snr",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -269,6 +356,12 @@ var CreateGatewayRequestFieldPathsTopLevel = []string{
 var UpdateGatewayRequestFieldPathsNested = []string{
 	"field_mask",
 	"gateway",
+	"gateway.alert_settings",
+	"gateway.alert_settings.disabled",
+	"gateway.alert_settings.disconnect_threshold",
+	"gateway.alert_settings.max_round_trip_time",
+	"gateway.alert_settings.status_interval",
+	"gateway.alert_settings.uplink_silence_threshold",
 	"gateway.antennas",
 	"gateway.attributes",
 	"gateway.auto_update",
@@ -303,9 +396,22 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.target_cups_key.key_id",
 	"gateway.target_cups_key.value",
 	"gateway.target_cups_uri",
+	"gateway.udp_authentication_mode",
+	"gateway.udp_secret",
+	"gateway.udp_secret.key_id",
+	"gateway.udp_secret.value",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
+	"gateway.uplink_filters.allow_dev_addr_prefixes",
+	"gateway.uplink_filters.allow_join_eui_ranges",
+	"gateway.uplink_filters.deny_dev_addr_prefixes",
+	"gateway.uplink_filters.deny_join_eui_ranges",
+	"gateway.uplink_filters.dry_run",
+	"gateway.uplink_filters.frequencies",
+	"gateway.uplink_filters.min_rssi",
+	"gateway.uplink_filters.min_snr",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -463,6 +569,8 @@ var GatewayStatusFieldPathsTopLevel = []string{
 var GatewayConnectionStatsFieldPathsNested = []string{
 	"connected_at",
 	"downlink_count",
+	"dry_run_filtered_uplink_count",
+	"filtered_uplink_count",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status.advanced",
@@ -487,6 +595,8 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"connected_at",
 	"downlink_count",
+	"dry_run_filtered_uplink_count",
+	"filtered_uplink_count",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status_received_at",
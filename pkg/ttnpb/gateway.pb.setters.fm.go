@@ -339,6 +339,178 @@ func (dst *GatewayClaimAuthenticationCode) SetFields(src *GatewayClaimAuthentica
 	return nil
 }
 
+func (dst *GatewayAlertSettings) SetFields(src *GatewayAlertSettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "disabled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disabled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Disabled = src.Disabled
+			} else {
+				var zero bool
+				dst.Disabled = zero
+			}
+		case "disconnect_threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disconnect_threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisconnectThreshold = src.DisconnectThreshold
+			} else {
+				dst.DisconnectThreshold = nil
+			}
+		case "uplink_silence_threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_silence_threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkSilenceThreshold = src.UplinkSilenceThreshold
+			} else {
+				dst.UplinkSilenceThreshold = nil
+			}
+		case "status_interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status_interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StatusInterval = src.StatusInterval
+			} else {
+				dst.StatusInterval = nil
+			}
+		case "max_round_trip_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_round_trip_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxRoundTripTime = src.MaxRoundTripTime
+			} else {
+				dst.MaxRoundTripTime = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayUplinkFilters_JoinEUIRange) SetFields(src *GatewayUplinkFilters_JoinEUIRange, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "from":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.From = src.From
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.From = zero
+			}
+		case "to":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.To = src.To
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.To = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayUplinkFilters) SetFields(src *GatewayUplinkFilters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "dry_run":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dry_run' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DryRun = src.DryRun
+			} else {
+				var zero bool
+				dst.DryRun = zero
+			}
+		case "allow_dev_addr_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allow_dev_addr_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowDevAddrPrefixes = src.AllowDevAddrPrefixes
+			} else {
+				dst.AllowDevAddrPrefixes = nil
+			}
+		case "deny_dev_addr_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deny_dev_addr_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DenyDevAddrPrefixes = src.DenyDevAddrPrefixes
+			} else {
+				dst.DenyDevAddrPrefixes = nil
+			}
+		case "allow_join_eui_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allow_join_eui_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowJoinEUIRanges = src.AllowJoinEUIRanges
+			} else {
+				dst.AllowJoinEUIRanges = nil
+			}
+		case "deny_join_eui_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deny_join_eui_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DenyJoinEUIRanges = src.DenyJoinEUIRanges
+			} else {
+				dst.DenyJoinEUIRanges = nil
+			}
+		case "min_snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinSNR = src.MinSNR
+			} else {
+				dst.MinSNR = nil
+			}
+		case "min_rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinRSSI = src.MinRSSI
+			} else {
+				dst.MinRSSI = nil
+			}
+		case "frequencies":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequencies' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequencies = src.Frequencies
+			} else {
+				dst.Frequencies = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -668,6 +840,95 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 				dst.RequireAuthenticatedConnection = zero
 			}
 
+		case "alert_settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayAlertSettings
+				if (src == nil || src.AlertSettings == nil) && dst.AlertSettings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.AlertSettings
+				}
+				if dst.AlertSettings != nil {
+					newDst = dst.AlertSettings
+				} else {
+					newDst = &GatewayAlertSettings{}
+					dst.AlertSettings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.AlertSettings = src.AlertSettings
+				} else {
+					dst.AlertSettings = nil
+				}
+			}
+
+		case "uplink_filters":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayUplinkFilters
+				if (src == nil || src.UplinkFilters == nil) && dst.UplinkFilters == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UplinkFilters
+				}
+				if dst.UplinkFilters != nil {
+					newDst = dst.UplinkFilters
+				} else {
+					newDst = &GatewayUplinkFilters{}
+					dst.UplinkFilters = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UplinkFilters = src.UplinkFilters
+				} else {
+					dst.UplinkFilters = nil
+				}
+			}
+
+		case "udp_secret":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.UDPSecret == nil) && dst.UDPSecret == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UDPSecret
+				}
+				if dst.UDPSecret != nil {
+					newDst = dst.UDPSecret
+				} else {
+					newDst = &Secret{}
+					dst.UDPSecret = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UDPSecret = src.UDPSecret
+				} else {
+					dst.UDPSecret = nil
+				}
+			}
+
+		case "udp_authentication_mode":
+			if len(subs) > 0 {
+				return fmt.Errorf("'udp_authentication_mode' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UDPAuthenticationMode = src.UDPAuthenticationMode
+			} else {
+				var zero GatewayUDPAuthenticationMode
+				dst.UDPAuthenticationMode = zero
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -1532,6 +1793,28 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 				dst.SubBands = nil
 			}
 
+		case "filtered_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filtered_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FilteredUplinkCount = src.FilteredUplinkCount
+			} else {
+				var zero uint64
+				dst.FilteredUplinkCount = zero
+			}
+
+		case "dry_run_filtered_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dry_run_filtered_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DryRunFilteredUplinkCount = src.DryRunFilteredUplinkCount
+			} else {
+				var zero uint64
+				dst.DryRunFilteredUplinkCount = zero
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -720,6 +720,400 @@ var _ interface {
 	ErrorName() string
 } = GatewayClaimAuthenticationCodeValidationError{}
 
+// ValidateFields checks the field values on GatewayAlertSettings with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlertSettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertSettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "disabled":
+			// no validation rules for Disabled
+		case "disconnect_threshold":
+
+			if v, ok := interface{}(m.GetDisconnectThreshold()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertSettingsValidationError{
+						field:  "disconnect_threshold",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_silence_threshold":
+
+			if v, ok := interface{}(m.GetUplinkSilenceThreshold()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertSettingsValidationError{
+						field:  "uplink_silence_threshold",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "status_interval":
+
+			if v, ok := interface{}(m.GetStatusInterval()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertSettingsValidationError{
+						field:  "status_interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_round_trip_time":
+
+			if v, ok := interface{}(m.GetMaxRoundTripTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertSettingsValidationError{
+						field:  "max_round_trip_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayAlertSettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertSettingsValidationError is the validation error returned by
+// GatewayAlertSettings.ValidateFields if the designated constraints aren't met.
+type GatewayAlertSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertSettingsValidationError) ErrorName() string {
+	return "GatewayAlertSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlertSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertSettingsValidationError{}
+
+// ValidateFields checks the field values on GatewayUplinkFilters_JoinEUIRange
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayUplinkFilters_JoinEUIRange) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFilters_JoinEUIRangeFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "from":
+			// no validation rules for From
+		case "to":
+			// no validation rules for To
+		default:
+			return GatewayUplinkFilters_JoinEUIRangeValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFilters_JoinEUIRangeValidationError is the validation error
+// returned by GatewayUplinkFilters_JoinEUIRange.ValidateFields if the
+// designated constraints aren't met.
+type GatewayUplinkFilters_JoinEUIRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) ErrorName() string {
+	return "GatewayUplinkFilters_JoinEUIRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFilters_JoinEUIRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilters_JoinEUIRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFilters_JoinEUIRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFilters_JoinEUIRangeValidationError{}
+
+// ValidateFields checks the field values on GatewayUplinkFilters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayUplinkFilters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFiltersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "dry_run":
+			// no validation rules for DryRun
+		case "allow_dev_addr_prefixes":
+
+			for idx, item := range m.GetAllowDevAddrPrefixes() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFiltersValidationError{
+							field:  fmt.Sprintf("allow_dev_addr_prefixes[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "deny_dev_addr_prefixes":
+
+			for idx, item := range m.GetDenyDevAddrPrefixes() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFiltersValidationError{
+							field:  fmt.Sprintf("deny_dev_addr_prefixes[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "allow_join_eui_ranges":
+
+			for idx, item := range m.GetAllowJoinEUIRanges() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFiltersValidationError{
+							field:  fmt.Sprintf("allow_join_eui_ranges[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "deny_join_eui_ranges":
+
+			for idx, item := range m.GetDenyJoinEUIRanges() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFiltersValidationError{
+							field:  fmt.Sprintf("deny_join_eui_ranges[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "min_snr":
+
+			if v, ok := interface{}(m.GetMinSNR()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "min_snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "min_rssi":
+
+			if v, ok := interface{}(m.GetMinRSSI()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayUplinkFiltersValidationError{
+						field:  "min_rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "frequencies":
+
+		default:
+			return GatewayUplinkFiltersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFiltersValidationError is the validation error returned by
+// GatewayUplinkFilters.ValidateFields if the designated constraints aren't met.
+type GatewayUplinkFiltersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFiltersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFiltersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFiltersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFiltersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFiltersValidationError) ErrorName() string {
+	return "GatewayUplinkFiltersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFiltersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFiltersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFiltersValidationError{}
+
 // ValidateFields checks the field values on Gateway with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -1034,6 +1428,51 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 
 		case "require_authenticated_connection":
 			// no validation rules for RequireAuthenticatedConnection
+		case "alert_settings":
+
+			if v, ok := interface{}(m.GetAlertSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "alert_settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_filters":
+
+			if v, ok := interface{}(m.GetUplinkFilters()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "uplink_filters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "udp_secret":
+
+			if v, ok := interface{}(m.GetUDPSecret()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "udp_secret",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "udp_authentication_mode":
+
+			if _, ok := GatewayUDPAuthenticationMode_name[int32(m.GetUDPAuthenticationMode())]; !ok {
+				return GatewayValidationError{
+					field:  "udp_authentication_mode",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
 			return GatewayValidationError{
 				field:  name,
@@ -2986,6 +3425,10 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 
 			}
 
+		case "filtered_uplink_count":
+			// no validation rules for FilteredUplinkCount
+		case "dry_run_filtered_uplink_count":
+			// no validation rules for DryRunFilteredUplinkCount
 		default:
 			return GatewayConnectionStatsValidationError{
 				field:  name,
@@ -4,12 +4,14 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -38,6 +40,61 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GatewayCaptureFormat int32
+
+const (
+	// Capture records as JSON lines.
+	CAPTURE_FORMAT_JSON_LINES GatewayCaptureFormat = 0
+	// Capture records as PCAP Next Generation blocks.
+	// The packet data of each record starts with the record type, see the Gateway Server documentation.
+	CAPTURE_FORMAT_PCAPNG GatewayCaptureFormat = 1
+)
+
+var GatewayCaptureFormat_name = map[int32]string{
+	0: "CAPTURE_FORMAT_JSON_LINES",
+	1: "CAPTURE_FORMAT_PCAPNG",
+}
+
+var GatewayCaptureFormat_value = map[string]int32{
+	"CAPTURE_FORMAT_JSON_LINES": 0,
+	"CAPTURE_FORMAT_PCAPNG":     1,
+}
+
+func (GatewayCaptureFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{0}
+}
+
+type GatewayAlertType int32
+
+const (
+	// The gateway did not reconnect within the disconnect threshold.
+	GATEWAY_ALERT_DISCONNECTED GatewayAlertType = 0
+	// The gateway did not forward uplink messages within the uplink silence threshold.
+	GATEWAY_ALERT_UPLINK_SILENCE GatewayAlertType = 1
+	// The gateway did not send status messages within three expected status intervals.
+	GATEWAY_ALERT_STATUS_SILENCE GatewayAlertType = 2
+	// The 90th percentile of the round-trip times exceeds the maximum round-trip time.
+	GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME GatewayAlertType = 3
+)
+
+var GatewayAlertType_name = map[int32]string{
+	0: "GATEWAY_ALERT_DISCONNECTED",
+	1: "GATEWAY_ALERT_UPLINK_SILENCE",
+	2: "GATEWAY_ALERT_STATUS_SILENCE",
+	3: "GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME",
+}
+
+var GatewayAlertType_value = map[string]int32{
+	"GATEWAY_ALERT_DISCONNECTED":         0,
+	"GATEWAY_ALERT_UPLINK_SILENCE":       1,
+	"GATEWAY_ALERT_STATUS_SILENCE":       2,
+	"GATEWAY_ALERT_HIGH_ROUND_TRIP_TIME": 3,
+}
+
+func (GatewayAlertType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{1}
+}
+
 // GatewayUp may contain zero or more uplink messages and/or a status message for the gateway.
 type GatewayUp struct {
 	// Uplink messages received by the gateway.
@@ -271,1069 +328,7441 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
-	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+type RunGatewayCommandRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Command to run on the gateway.
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// Arguments of the command.
+	Arguments            []string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+func (m *RunGatewayCommandRequest) Reset()      { *m = RunGatewayCommandRequest{} }
+func (*RunGatewayCommandRequest) ProtoMessage() {}
+func (*RunGatewayCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+func (m *RunGatewayCommandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6c, 0xdc, 0x44,
-	0x14, 0xf5, 0x24, 0x0d, 0x2a, 0x13, 0x9a, 0x2e, 0x23, 0x01, 0x9b, 0x6d, 0x3a, 0x89, 0x16, 0x81,
-	0x22, 0xc4, 0xda, 0xc1, 0x91, 0x10, 0x17, 0x0e, 0x49, 0xb6, 0xac, 0x82, 0x08, 0x82, 0x4d, 0x82,
-	0x04, 0x52, 0x15, 0x4d, 0xd6, 0x13, 0xaf, 0xb5, 0xce, 0x8c, 0xf1, 0xcc, 0xee, 0x66, 0x85, 0x90,
-	0x2a, 0x4e, 0x3d, 0x22, 0x71, 0xa0, 0x12, 0x17, 0x84, 0x84, 0x54, 0x71, 0xea, 0xb1, 0xc7, 0x1e,
-	0x38, 0xe4, 0x58, 0x89, 0x4b, 0x4f, 0xb4, 0x6b, 0x73, 0xc8, 0xb1, 0xc7, 0x88, 0x13, 0xf2, 0x78,
-	0x9c, 0x6c, 0xec, 0x18, 0xb8, 0xf4, 0xe6, 0x99, 0xff, 0xfe, 0xfb, 0xef, 0x3f, 0xff, 0x6f, 0xc3,
-	0xb7, 0x7c, 0x1e, 0x92, 0x21, 0x61, 0x0d, 0x21, 0x49, 0xa7, 0x67, 0x91, 0xc0, 0xb3, 0x5c, 0x22,
-	0xe9, 0x90, 0x8c, 0x04, 0x0d, 0x07, 0x34, 0x34, 0x83, 0x90, 0x4b, 0x8e, 0xe6, 0xa4, 0x64, 0xa6,
-	0x86, 0x9a, 0x83, 0xd5, 0xda, 0x9a, 0xeb, 0xc9, 0x6e, 0x7f, 0xdf, 0xec, 0xf0, 0x43, 0x8b, 0xb2,
-	0x01, 0x1f, 0x05, 0x21, 0x3f, 0x1a, 0x59, 0x0a, 0xdc, 0x69, 0xb8, 0x94, 0x35, 0x06, 0xc4, 0xf7,
-	0x1c, 0x22, 0xa9, 0x55, 0x78, 0x48, 0x29, 0x6b, 0x8d, 0x09, 0x0a, 0x97, 0xbb, 0x3c, 0x4d, 0xde,
-	0xef, 0x1f, 0xa8, 0x93, 0x3a, 0xa8, 0x27, 0x0d, 0x5f, 0x70, 0x39, 0x77, 0x7d, 0xaa, 0x14, 0x12,
-	0xc6, 0xb8, 0x24, 0xd2, 0xe3, 0x4c, 0xe8, 0x28, 0xd6, 0xd1, 0x33, 0x0e, 0xa7, 0x1f, 0x2a, 0x80,
-	0x8e, 0xdf, 0xc8, 0xc7, 0xe9, 0x61, 0x20, 0x47, 0x3a, 0x78, 0xb3, 0xe8, 0x01, 0x0d, 0x43, 0xae,
-	0x7b, 0xaf, 0x2d, 0x96, 0x5a, 0xa4, 0x01, 0x6f, 0x16, 0x01, 0x9e, 0x43, 0x99, 0xf4, 0x0e, 0x3c,
-	0x1a, 0x8a, 0x72, 0x96, 0xcc, 0xcf, 0x14, 0xb0, 0x54, 0x04, 0x1c, 0x52, 0x21, 0x88, 0x4b, 0x33,
-	0x8a, 0x85, 0x4b, 0x10, 0x5f, 0x4b, 0x59, 0x9e, 0x1f, 0x52, 0xd7, 0xe3, 0x8c, 0xf8, 0x29, 0xa2,
-	0x7e, 0x02, 0xe0, 0xcb, 0xad, 0x54, 0xf9, 0x6e, 0x80, 0x3e, 0x82, 0xd7, 0xfb, 0x81, 0xef, 0xb1,
-	0xde, 0x5e, 0x56, 0xa6, 0x0a, 0x96, 0xa6, 0x97, 0x67, 0xed, 0x9b, 0xe6, 0xc5, 0x97, 0x6d, 0xee,
-	0x2a, 0xd8, 0x56, 0x8a, 0x6a, 0xcf, 0xf5, 0x27, 0x8f, 0x02, 0x35, 0xe1, 0x9c, 0xb6, 0x63, 0x4f,
-	0x48, 0x22, 0xfb, 0xa2, 0x3a, 0xb5, 0x04, 0x2e, 0xa3, 0xd1, 0xa5, 0xb7, 0x15, 0xa8, 0x7d, 0xcd,
-	0x9d, 0x3c, 0xa2, 0x2d, 0xf8, 0xaa, 0x3c, 0xda, 0x23, 0x9d, 0x1e, 0xe3, 0x43, 0x9f, 0x3a, 0xee,
-	0x21, 0x65, 0xb2, 0x3a, 0xad, 0x88, 0x96, 0xf2, 0x44, 0x3b, 0x47, 0x6b, 0x17, 0x70, 0xed, 0x8a,
-	0xcc, 0xdd, 0xd4, 0xbf, 0x84, 0xb3, 0xba, 0x5c, 0x93, 0x0f, 0x19, 0xfa, 0x18, 0x56, 0x1c, 0x3e,
-	0x64, 0x93, 0xdd, 0x56, 0x81, 0x22, 0x5f, 0xcc, 0x93, 0x37, 0x35, 0x2e, 0x6b, 0xf7, 0xba, 0x73,
-	0xf1, 0xa2, 0xfe, 0x3b, 0x80, 0xd5, 0xed, 0x4e, 0x97, 0x3a, 0x7d, 0x9f, 0x66, 0xe0, 0x36, 0x15,
-	0x01, 0x67, 0x82, 0xa2, 0x35, 0x38, 0xe3, 0x50, 0x9f, 0x8c, 0x34, 0xfb, 0xbc, 0x99, 0xce, 0x9d,
-	0x99, 0xcd, 0x9d, 0xd9, 0xd4, 0x73, 0xb9, 0x5e, 0xf9, 0x7b, 0x7d, 0xe6, 0x37, 0x30, 0x75, 0x15,
-	0x1c, 0xff, 0xb9, 0x68, 0xdc, 0x7b, 0xba, 0x08, 0xda, 0x69, 0x26, 0x5a, 0x83, 0xd7, 0xce, 0xb4,
-	0x06, 0x44, 0x76, 0xb5, 0x9d, 0x0b, 0x65, 0x42, 0x3f, 0x23, 0xb2, 0xdb, 0x7e, 0xc5, 0x99, 0x38,
-	0xa1, 0x0a, 0x9c, 0x0e, 0x8f, 0xde, 0x53, 0xf6, 0x5d, 0x6d, 0x27, 0x8f, 0xe9, 0x8d, 0x5d, 0xbd,
-	0x92, 0xdd, 0xd8, 0xf5, 0xdb, 0x70, 0x21, 0xdf, 0xc5, 0xad, 0x64, 0xe8, 0x9b, 0x54, 0x12, 0xcf,
-	0x17, 0xe8, 0x43, 0x38, 0x9b, 0x54, 0xdf, 0x53, 0x9b, 0x90, 0x8d, 0x46, 0x41, 0xc4, 0x64, 0x4a,
-	0x1b, 0x26, 0x09, 0xea, 0x46, 0xd8, 0x4f, 0xa7, 0xe1, 0x4c, 0x4b, 0x0e, 0x5b, 0x02, 0x6d, 0xc2,
-	0xd9, 0x4f, 0x3c, 0xd6, 0xd3, 0xaf, 0x03, 0xcd, 0x97, 0x8c, 0xc5, 0x6e, 0x50, 0xbb, 0x51, 0x12,
-	0x4a, 0xf4, 0x2d, 0x83, 0x15, 0x80, 0xb6, 0xe1, 0x6b, 0x2d, 0x2a, 0x37, 0x38, 0xeb, 0x50, 0x26,
-	0x43, 0x22, 0x79, 0xb8, 0xc1, 0xd9, 0x81, 0xe7, 0xa2, 0xd7, 0x0b, 0x3e, 0xdf, 0x4a, 0xf6, 0xbb,
-	0x56, 0xcf, 0x33, 0x5e, 0x92, 0xfb, 0x23, 0x50, 0xac, 0x5b, 0x9f, 0xef, 0xec, 0x6c, 0x70, 0xc6,
-	0x68, 0x27, 0x79, 0x3d, 0x9b, 0xec, 0x80, 0xa3, 0x7a, 0x89, 0x9e, 0xcd, 0xf3, 0xe5, 0x2e, 0x56,
-	0x28, 0xf2, 0xd4, 0xdf, 0xff, 0xee, 0x8f, 0xbf, 0x7e, 0x98, 0x5a, 0x41, 0xa6, 0xe5, 0x8a, 0xb3,
-	0xaf, 0xab, 0xf5, 0x4d, 0xb6, 0x35, 0x9e, 0xf3, 0xad, 0xda, 0xe3, 0x46, 0xe7, 0x2c, 0xad, 0xe1,
-	0x25, 0xf5, 0x7f, 0x02, 0xf0, 0x0d, 0xad, 0xec, 0x0b, 0xfb, 0x05, 0x69, 0xfb, 0x40, 0x69, 0xb3,
-	0xd1, 0xca, 0xbf, 0x6b, 0x1b, 0xd8, 0x79, 0x75, 0x36, 0x85, 0x57, 0x3e, 0x15, 0x2d, 0x81, 0x6e,
-	0xc3, 0x4a, 0x7e, 0x90, 0xd0, 0x7f, 0x6d, 0x55, 0x6d, 0x39, 0x0f, 0x28, 0xdb, 0x28, 0xfb, 0x17,
-	0x00, 0xa7, 0x5a, 0x22, 0xf1, 0x62, 0xbe, 0x45, 0xa5, 0xee, 0xf2, 0xbc, 0x89, 0xe4, 0xf3, 0x21,
-	0xfe, 0x97, 0x1b, 0x6f, 0x97, 0x60, 0x72, 0x5c, 0x75, 0x5b, 0x39, 0xf2, 0x2e, 0x7a, 0xa7, 0xdc,
-	0x91, 0x73, 0x2b, 0xac, 0xe4, 0x93, 0x27, 0xd6, 0x7f, 0x05, 0xc7, 0x63, 0x0c, 0x1e, 0x8f, 0x31,
-	0x78, 0x32, 0xc6, 0xc6, 0xb3, 0x31, 0x36, 0x4e, 0xc6, 0xd8, 0x78, 0x3e, 0xc6, 0xc6, 0xe9, 0x18,
-	0x83, 0x3b, 0x11, 0x06, 0x77, 0x23, 0x6c, 0xdc, 0x8f, 0x30, 0x78, 0x10, 0x61, 0xe3, 0x61, 0x84,
-	0x8d, 0x47, 0x11, 0x36, 0x8e, 0x23, 0x0c, 0x1e, 0x47, 0x18, 0x3c, 0x89, 0xb0, 0xf1, 0x2c, 0xc2,
-	0xe0, 0x24, 0xc2, 0xc6, 0xf3, 0x08, 0x83, 0xd3, 0x08, 0x1b, 0x77, 0x62, 0x6c, 0xdc, 0x8d, 0x31,
-	0xf8, 0x3e, 0xc6, 0xc6, 0xbd, 0x18, 0x83, 0x9f, 0x63, 0x6c, 0xdc, 0x8f, 0xb1, 0xf1, 0x20, 0xc6,
-	0xe0, 0x61, 0x8c, 0xc1, 0xa3, 0x18, 0x83, 0xaf, 0x2c, 0x97, 0x9b, 0xb2, 0x4b, 0x65, 0xd7, 0x63,
-	0xae, 0x30, 0x19, 0x95, 0x43, 0x1e, 0xf6, 0xac, 0x8b, 0xff, 0x81, 0xc1, 0xaa, 0x15, 0xf4, 0x5c,
-	0x4b, 0x4a, 0x16, 0xec, 0xef, 0xbf, 0xa4, 0xf6, 0x63, 0xf5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x51, 0x17, 0xb3, 0xeb, 0xf6, 0x07, 0x00, 0x00,
+func (m *RunGatewayCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunGatewayCommandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RunGatewayCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunGatewayCommandRequest.Merge(m, src)
+}
+func (m *RunGatewayCommandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunGatewayCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunGatewayCommandRequest.DiscardUnknown(m)
 }
 
-func (this *GatewayUp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_RunGatewayCommandRequest proto.InternalMessageInfo
 
-	that1, ok := that.(*GatewayUp)
-	if !ok {
-		that2, ok := that.(GatewayUp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
-		return false
-	}
-	for i := range this.UplinkMessages {
-		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
-			return false
-		}
-	}
-	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
-		return false
-	}
-	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
-		return false
+func (m *RunGatewayCommandRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
 	}
-	return true
+	return ""
 }
-func (this *GatewayDown) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GatewayDown)
-	if !ok {
-		that2, ok := that.(GatewayDown)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
-		return false
+func (m *RunGatewayCommandRequest) GetArguments() []string {
+	if m != nil {
+		return m.Arguments
 	}
-	return true
+	return nil
 }
-func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkResponse)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	if !this.DownlinkPath.Equal(that1.DownlinkPath) {
-		return false
-	}
-	if this.Rx1 != that1.Rx1 {
-		return false
-	}
-	if this.Rx2 != that1.Rx2 {
-		return false
-	}
-	return true
+type GatewayRemoteShellRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// User to run the shell as. If empty, the gateway chooses the user.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Terminal type of the shell, for example `xterm`. If empty, the gateway chooses the terminal type.
+	Term string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	// Input to write to the shell once the session is open.
+	Input []byte `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	// Duration after which the session is closed.
+	// If zero, the session is closed after one minute. The maximum is one hour.
+	Timeout              *time.Duration `protobuf:"bytes,5,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkErrorDetails)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkErrorDetails)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.PathErrors) != len(that1.PathErrors) {
-		return false
-	}
-	for i := range this.PathErrors {
-		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
-			return false
+func (m *GatewayRemoteShellRequest) Reset()      { *m = GatewayRemoteShellRequest{} }
+func (*GatewayRemoteShellRequest) ProtoMessage() {}
+func (*GatewayRemoteShellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *GatewayRemoteShellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayRemoteShellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayRemoteShellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
+}
+func (m *GatewayRemoteShellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellRequest.Merge(m, src)
+}
+func (m *GatewayRemoteShellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayRemoteShellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GatewayRemoteShellRequest proto.InternalMessageInfo
 
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+func (m *GatewayRemoteShellRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
 }
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+func (m *GatewayRemoteShellRequest) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
 }
 
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
-	return &gtwGsClient{cc}
+func (m *GatewayRemoteShellRequest) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
 }
 
-func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayRemoteShellRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	x := &gtwGsLinkGatewayClient{stream}
-	return x, nil
+	return nil
 }
 
-type GtwGs_LinkGatewayClient interface {
-	Send(*GatewayUp) error
-	Recv() (*GatewayDown, error)
-	grpc.ClientStream
+type GatewayRemoteShellOutput struct {
+	// Output of the remote shell.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type gtwGsLinkGatewayClient struct {
-	grpc.ClientStream
+func (m *GatewayRemoteShellOutput) Reset()      { *m = GatewayRemoteShellOutput{} }
+func (*GatewayRemoteShellOutput) ProtoMessage() {}
+func (*GatewayRemoteShellOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
 }
-
-func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
-	return x.ClientStream.SendMsg(m)
+func (m *GatewayRemoteShellOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
-	m := new(GatewayDown)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayRemoteShellOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayRemoteShellOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *GatewayRemoteShellOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellOutput.Merge(m, src)
+}
+func (m *GatewayRemoteShellOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayRemoteShellOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellOutput.DiscardUnknown(m)
 }
 
-func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
-	out := new(ConcentratorConfig)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayRemoteShellOutput proto.InternalMessageInfo
+
+func (m *GatewayRemoteShellOutput) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ScheduleProprietaryDownlinkRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Raw PHYPayload of the downlink message. The MType must be proprietary.
+	RawPayload []byte `protobuf:"bytes,2,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	// Transmission request of the downlink message.
+	// The downlink paths must refer to the gateway. If there are no downlink paths, the first antenna of the gateway is used.
+	Request              *TxRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ScheduleProprietaryDownlinkRequest) Reset()      { *m = ScheduleProprietaryDownlinkRequest{} }
+func (*ScheduleProprietaryDownlinkRequest) ProtoMessage() {}
+func (*ScheduleProprietaryDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
+}
+func (m *ScheduleProprietaryDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleProprietaryDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleProprietaryDownlinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScheduleProprietaryDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleProprietaryDownlinkRequest.Merge(m, src)
+}
+func (m *ScheduleProprietaryDownlinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleProprietaryDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleProprietaryDownlinkRequest.DiscardUnknown(m)
 }
 
-func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduleProprietaryDownlinkRequest proto.InternalMessageInfo
+
+func (m *ScheduleProprietaryDownlinkRequest) GetRawPayload() []byte {
+	if m != nil {
+		return m.RawPayload
 	}
-	return out, nil
+	return nil
 }
 
-// GtwGsServer is the server API for GtwGs service.
-type GtwGsServer interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(GtwGs_LinkGatewayServer) error
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+func (m *ScheduleProprietaryDownlinkRequest) GetRequest() *TxRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGtwGsServer struct {
+type GatewayCaptureRecord struct {
+	// Time when the record was captured.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Raw bytes received from the gateway by the frontend.
+	RawUp []byte `protobuf:"bytes,2,opt,name=raw_up,json=rawUp,proto3" json:"raw_up,omitempty"`
+	// Raw bytes sent to the gateway by the frontend.
+	RawDown []byte `protobuf:"bytes,3,opt,name=raw_down,json=rawDown,proto3" json:"raw_down,omitempty"`
+	// Uplink message handled by the gateway connection.
+	UplinkMessage *UplinkMessage `protobuf:"bytes,4,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	// Gateway status handled by the gateway connection.
+	GatewayStatus *GatewayStatus `protobuf:"bytes,5,opt,name=gateway_status,json=gatewayStatus,proto3" json:"gateway_status,omitempty"`
+	// Transmission acknowledgment handled by the gateway connection.
+	TxAcknowledgment *TxAcknowledgment `protobuf:"bytes,6,opt,name=tx_acknowledgment,json=txAcknowledgment,proto3" json:"tx_acknowledgment,omitempty"`
+	// Downlink message sent to the gateway.
+	DownlinkMessage      *DownlinkMessage `protobuf:"bytes,7,opt,name=downlink_message,json=downlinkMessage,proto3" json:"downlink_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
-	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+func (m *GatewayCaptureRecord) Reset()      { *m = GatewayCaptureRecord{} }
+func (*GatewayCaptureRecord) ProtoMessage() {}
+func (*GatewayCaptureRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8}
 }
-func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+func (m *GatewayCaptureRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+func (m *GatewayCaptureRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCaptureRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+func (m *GatewayCaptureRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCaptureRecord.Merge(m, src)
 }
-
-func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
-	s.RegisterService(&_GtwGs_serviceDesc, srv)
+func (m *GatewayCaptureRecord) XXX_Size() int {
+	return m.Size()
 }
-
-func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+func (m *GatewayCaptureRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCaptureRecord.DiscardUnknown(m)
 }
 
-type GtwGs_LinkGatewayServer interface {
-	Send(*GatewayDown) error
-	Recv() (*GatewayUp, error)
-	grpc.ServerStream
-}
+var xxx_messageInfo_GatewayCaptureRecord proto.InternalMessageInfo
 
-type gtwGsLinkGatewayServer struct {
-	grpc.ServerStream
+func (m *GatewayCaptureRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GatewayCaptureRecord) GetRawUp() []byte {
+	if m != nil {
+		return m.RawUp
+	}
+	return nil
 }
 
-func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
-	m := new(GatewayUp)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayCaptureRecord) GetRawDown() []byte {
+	if m != nil {
+		return m.RawDown
 	}
-	return m, nil
+	return nil
 }
 
-func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+func (m *GatewayCaptureRecord) GetUplinkMessage() *UplinkMessage {
+	if m != nil {
+		return m.UplinkMessage
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayCaptureRecord) GetGatewayStatus() *GatewayStatus {
+	if m != nil {
+		return m.GatewayStatus
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayCaptureRecord) GetTxAcknowledgment() *TxAcknowledgment {
+	if m != nil {
+		return m.TxAcknowledgment
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _GtwGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GtwGs",
-	HandlerType: (*GtwGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetConcentratorConfig",
-			Handler:    _GtwGs_GetConcentratorConfig_Handler,
-		},
-		{
-			MethodName: "GetMQTTConnectionInfo",
-			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
-		},
-		{
-			MethodName: "GetMQTTV2ConnectionInfo",
-			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "LinkGateway",
-			Handler:       _GtwGs_LinkGateway_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayCaptureRecord) GetDownlinkMessage() *DownlinkMessage {
+	if m != nil {
+		return m.DownlinkMessage
+	}
+	return nil
 }
 
-// NsGsClient is the client API for NsGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsGsClient interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+type StartGatewayCaptureRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Duration after which the capture is stopped.
+	// If zero, the capture is stopped after five minutes. The maximum is configured by the Gateway Server.
+	Duration             *time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-type nsGsClient struct {
-	cc *grpc.ClientConn
+func (m *StartGatewayCaptureRequest) Reset()      { *m = StartGatewayCaptureRequest{} }
+func (*StartGatewayCaptureRequest) ProtoMessage() {}
+func (*StartGatewayCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{9}
 }
-
-func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
-	return &nsGsClient{cc}
+func (m *StartGatewayCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
-	out := new(ScheduleDownlinkResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StartGatewayCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartGatewayCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// NsGsServer is the server API for NsGs service.
-type NsGsServer interface {
-	// Instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+func (m *StartGatewayCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGatewayCaptureRequest.Merge(m, src)
 }
-
-// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsGsServer struct {
+func (m *StartGatewayCaptureRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+func (m *StartGatewayCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGatewayCaptureRequest.DiscardUnknown(m)
 }
 
-func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
-	s.RegisterService(&_NsGs_serviceDesc, srv)
-}
+var xxx_messageInfo_StartGatewayCaptureRequest proto.InternalMessageInfo
 
-func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+func (m *StartGatewayCaptureRequest) GetDuration() *time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _NsGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsGs",
-	HandlerType: (*NsGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ScheduleDownlink",
-			Handler:    _NsGs_ScheduleDownlink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+type StopGatewayCaptureRequest struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// GsClient is the client API for Gs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsClient interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+func (m *StopGatewayCaptureRequest) Reset()      { *m = StopGatewayCaptureRequest{} }
+func (*StopGatewayCaptureRequest) ProtoMessage() {}
+func (*StopGatewayCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{10}
 }
-
-type gsClient struct {
-	cc *grpc.ClientConn
+func (m *StopGatewayCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopGatewayCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopGatewayCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopGatewayCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopGatewayCaptureRequest.Merge(m, src)
+}
+func (m *StopGatewayCaptureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopGatewayCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopGatewayCaptureRequest.DiscardUnknown(m)
 }
 
-func NewGsClient(cc *grpc.ClientConn) GsClient {
-	return &gsClient{cc}
+var xxx_messageInfo_StopGatewayCaptureRequest proto.InternalMessageInfo
+
+type GatewayCapture struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	CaptureID            string    `protobuf:"bytes,2,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+	StartedAt            time.Time `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	StopsAt              time.Time `protobuf:"bytes,4,opt,name=stops_at,json=stopsAt,proto3,stdtime" json:"stops_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
-	out := new(GatewayConnectionStats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayCapture) Reset()      { *m = GatewayCapture{} }
+func (*GatewayCapture) ProtoMessage() {}
+func (*GatewayCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{11}
+}
+func (m *GatewayCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCapture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GatewayCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCapture.Merge(m, src)
+}
+func (m *GatewayCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCapture.DiscardUnknown(m)
 }
 
-// GsServer is the server API for Gs service.
-type GsServer interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+var xxx_messageInfo_GatewayCapture proto.InternalMessageInfo
+
+func (m *GatewayCapture) GetCaptureID() string {
+	if m != nil {
+		return m.CaptureID
+	}
+	return ""
 }
 
-// UnimplementedGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsServer struct {
+func (m *GatewayCapture) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
 }
 
-func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+func (m *GatewayCapture) GetStopsAt() time.Time {
+	if m != nil {
+		return m.StopsAt
+	}
+	return time.Time{}
 }
 
-func RegisterGsServer(s *grpc.Server, srv GsServer) {
-	s.RegisterService(&_Gs_serviceDesc, srv)
+type DownloadGatewayCaptureRequest struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	CaptureID            string               `protobuf:"bytes,2,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+	Format               GatewayCaptureFormat `protobuf:"varint,3,opt,name=format,proto3,enum=ttn.lorawan.v3.GatewayCaptureFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+func (m *DownloadGatewayCaptureRequest) Reset()      { *m = DownloadGatewayCaptureRequest{} }
+func (*DownloadGatewayCaptureRequest) ProtoMessage() {}
+func (*DownloadGatewayCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{12}
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownloadGatewayCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadGatewayCaptureRequest.Merge(m, src)
+}
+func (m *DownloadGatewayCaptureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownloadGatewayCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadGatewayCaptureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadGatewayCaptureRequest proto.InternalMessageInfo
+
+func (m *DownloadGatewayCaptureRequest) GetCaptureID() string {
+	if m != nil {
+		return m.CaptureID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	return ""
+}
+
+func (m *DownloadGatewayCaptureRequest) GetFormat() GatewayCaptureFormat {
+	if m != nil {
+		return m.Format
 	}
-	return interceptor(ctx, in, info, handler)
+	return CAPTURE_FORMAT_JSON_LINES
 }
 
-var _Gs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Gs",
-	HandlerType: (*GsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetGatewayConnectionStats",
-			Handler:    _Gs_GetGatewayConnectionStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+type GatewayCaptureData struct {
+	// Chunk of the capture file.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GatewayCaptureData) Reset()      { *m = GatewayCaptureData{} }
+func (*GatewayCaptureData) ProtoMessage() {}
+func (*GatewayCaptureData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{13}
+}
+func (m *GatewayCaptureData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayCaptureData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCaptureData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GatewayCaptureData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCaptureData.Merge(m, src)
+}
+func (m *GatewayCaptureData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayCaptureData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCaptureData.DiscardUnknown(m)
 }
 
-func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GatewayCaptureData proto.InternalMessageInfo
+
+func (m *GatewayCaptureData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxAcknowledgment != nil {
-		{
-			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+type GatewayConnectionStatsSample struct {
+	// Start time of the interval of the sample.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Number of samples that are aggregated in this sample.
+	// Samples are taken while the gateway is connected.
+	Samples uint32 `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Number of uplink messages received in the interval.
+	UplinkCount uint64 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of downlink messages sent in the interval.
+	DownlinkCount uint64 `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Round-trip times measured in the interval.
+	// The median and 90th percentile of aggregated samples are weighted by the number of round-trip times.
+	RoundTripTimes *GatewayConnectionStatsSample_RoundTripTimes `protobuf:"bytes,5,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
+	// Statistics for each sub band, with the highest downlink utilization in the interval.
+	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,6,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Last status received in the interval.
+	LastStatus           *GatewayStatus `protobuf:"bytes,7,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	LastStatusReceivedAt *time.Time     `protobuf:"bytes,8,opt,name=last_status_received_at,json=lastStatusReceivedAt,proto3,stdtime" json:"last_status_received_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GatewayConnectionStatsSample) Reset()      { *m = GatewayConnectionStatsSample{} }
+func (*GatewayConnectionStatsSample) ProtoMessage() {}
+func (*GatewayConnectionStatsSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{14}
+}
+func (m *GatewayConnectionStatsSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStatsSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.GatewayStatus != nil {
-		{
-			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *GatewayConnectionStatsSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsSample.Merge(m, src)
+}
+func (m *GatewayConnectionStatsSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStatsSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionStatsSample proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsSample) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.UplinkMessages) > 0 {
-		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return time.Time{}
+}
+
+func (m *GatewayConnectionStatsSample) GetSamples() uint32 {
+	if m != nil {
+		return m.Samples
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsSample) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GatewayConnectionStatsSample) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
 }
 
-func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		{
-			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *GatewayConnectionStatsSample) GetRoundTripTimes() *GatewayConnectionStatsSample_RoundTripTimes {
+	if m != nil {
+		return m.RoundTripTimes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GatewayConnectionStatsSample) GetSubBands() []*GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBands
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GatewayConnectionStatsSample) GetLastStatus() *GatewayStatus {
+	if m != nil {
+		return m.LastStatus
+	}
+	return nil
 }
 
-func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Rx2 {
-		i--
-		if m.Rx2 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Rx1 {
-		i--
-		if m.Rx1 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func (m *GatewayConnectionStatsSample) GetLastStatusReceivedAt() *time.Time {
+	if m != nil {
+		return m.LastStatusReceivedAt
 	}
-	if m.DownlinkPath != nil {
-		{
-			size, err := m.DownlinkPath.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	return nil
+}
+
+type GatewayConnectionStatsSample_RoundTripTimes struct {
+	Median               time.Duration `protobuf:"bytes,1,opt,name=median,proto3,stdduration" json:"median"`
+	P90                  time.Duration `protobuf:"bytes,2,opt,name=p90,proto3,stdduration" json:"p90"`
+	Max                  time.Duration `protobuf:"bytes,3,opt,name=max,proto3,stdduration" json:"max"`
+	Count                uint32        `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) Reset() {
+	*m = GatewayConnectionStatsSample_RoundTripTimes{}
+}
+func (*GatewayConnectionStatsSample_RoundTripTimes) ProtoMessage() {}
+func (*GatewayConnectionStatsSample_RoundTripTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{14, 0}
+}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsSample_RoundTripTimes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
-	if err5 != nil {
-		return 0, err5
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintGatewayserver(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsSample_RoundTripTimes.Merge(m, src)
+}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsSample_RoundTripTimes.DiscardUnknown(m)
 }
 
-func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayConnectionStatsSample_RoundTripTimes proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) GetMedian() time.Duration {
+	if m != nil {
+		return m.Median
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GatewayConnectionStatsSample_RoundTripTimes) GetP90() time.Duration {
+	if m != nil {
+		return m.P90
+	}
+	return 0
 }
 
-func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *GatewayConnectionStatsSample_RoundTripTimes) GetMax() time.Duration {
+	if m != nil {
+		return m.Max
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GatewayConnectionStatsSample_RoundTripTimes) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+
+type GetGatewayConnectionStatsHistoryRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Start of the time range. If not set, the time range starts 24 hours before the end.
+	From *time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	// End of the time range. If not set, the time range ends now.
+	To *time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+	// Resolution of the samples. If zero, the finest stored resolution for the time range is used.
+	Resolution           *time.Duration `protobuf:"bytes,4,opt,name=resolution,proto3,stdduration" json:"resolution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Reset() {
+	*m = GetGatewayConnectionStatsHistoryRequest{}
+}
+func (*GetGatewayConnectionStatsHistoryRequest) ProtoMessage() {}
+func (*GetGatewayConnectionStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{15}
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if r.Intn(5) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Merge(m, src)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest proto.InternalMessageInfo
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
 	}
-	if !easy && r.Intn(10) != 0 {
+	return nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
 	}
-	return this
+	return nil
 }
 
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
-	this := &GatewayDown{}
-	if r.Intn(5) != 0 {
-		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+func (m *GetGatewayConnectionStatsHistoryRequest) GetResolution() *time.Duration {
+	if m != nil {
+		return m.Resolution
 	}
-	if !easy && r.Intn(10) != 0 {
+	return nil
+}
+
+type GatewayConnectionStatsHistory struct {
+	// Resolution of the samples.
+	Resolution time.Duration `protobuf:"bytes,1,opt,name=resolution,proto3,stdduration" json:"resolution"`
+	// Samples in the time range, ordered by time. Intervals in which the gateway was not connected have no sample.
+	Samples              []*GatewayConnectionStatsSample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GatewayConnectionStatsHistory) Reset()      { *m = GatewayConnectionStatsHistory{} }
+func (*GatewayConnectionStatsHistory) ProtoMessage() {}
+func (*GatewayConnectionStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{16}
+}
+func (m *GatewayConnectionStatsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStatsHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return this
+}
+func (m *GatewayConnectionStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsHistory.Merge(m, src)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsHistory.DiscardUnknown(m)
 }
 
-func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
-	this := &ScheduleDownlinkResponse{}
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Delay = *v2
-	if r.Intn(5) != 0 {
-		this.DownlinkPath = NewPopulatedDownlinkPath(r, easy)
+var xxx_messageInfo_GatewayConnectionStatsHistory proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsHistory) GetResolution() time.Duration {
+	if m != nil {
+		return m.Resolution
 	}
-	this.Rx1 = bool(r.Intn(2) == 0)
-	this.Rx2 = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+	return 0
+}
+
+func (m *GatewayConnectionStatsHistory) GetSamples() []*GatewayConnectionStatsSample {
+	if m != nil {
+		return m.Samples
 	}
-	return this
+	return nil
 }
 
-func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
-	this := &ScheduleDownlinkErrorDetails{}
-	if r.Intn(5) == 0 {
-		v3 := r.Intn(5)
-		this.PathErrors = make([]*ErrorDetails, v3)
-		for i := 0; i < v3; i++ {
-			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+type GatewayAlert struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	Type               GatewayAlertType `protobuf:"varint,2,opt,name=type,proto3,enum=ttn.lorawan.v3.GatewayAlertType" json:"type,omitempty"`
+	RaisedAt           time.Time        `protobuf:"bytes,3,opt,name=raised_at,json=raisedAt,proto3,stdtime" json:"raised_at"`
+	// Time when the alert was resolved. Active alerts are not resolved.
+	ResolvedAt *time.Time `protobuf:"bytes,4,opt,name=resolved_at,json=resolvedAt,proto3,stdtime" json:"resolved_at,omitempty"`
+	// Threshold that was exceeded.
+	Threshold time.Duration `protobuf:"bytes,5,opt,name=threshold,proto3,stdduration" json:"threshold"`
+	// Value that exceeded the threshold when the alert was raised.
+	Value                time.Duration `protobuf:"bytes,6,opt,name=value,proto3,stdduration" json:"value"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GatewayAlert) Reset()      { *m = GatewayAlert{} }
+func (*GatewayAlert) ProtoMessage() {}
+func (*GatewayAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{17}
+}
+func (m *GatewayAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
 }
-
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *GatewayAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAlert.Merge(m, src)
+}
+func (m *GatewayAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAlert.DiscardUnknown(m)
 }
 
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+var xxx_messageInfo_GatewayAlert proto.InternalMessageInfo
+
+func (m *GatewayAlert) GetType() GatewayAlertType {
+	if m != nil {
+		return m.Type
 	}
-	return rune(ru + 61)
+	return GATEWAY_ALERT_DISCONNECTED
 }
-func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
-		tmps[i] = randUTF8RuneGatewayserver(r)
+
+func (m *GatewayAlert) GetRaisedAt() time.Time {
+	if m != nil {
+		return m.RaisedAt
 	}
-	return string(tmps)
+	return time.Time{}
 }
-func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+
+func (m *GatewayAlert) GetResolvedAt() *time.Time {
+	if m != nil {
+		return m.ResolvedAt
 	}
-	return dAtA
+	return nil
 }
-func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
-		if r.Intn(2) == 0 {
-			v5 *= -1
+
+func (m *GatewayAlert) GetThreshold() time.Duration {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GatewayAlert) GetValue() time.Duration {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ListGatewayAlertsRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Include recently resolved alerts.
+	IncludeResolved      bool     `protobuf:"varint,2,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGatewayAlertsRequest) Reset()      { *m = ListGatewayAlertsRequest{} }
+func (*ListGatewayAlertsRequest) ProtoMessage() {}
+func (*ListGatewayAlertsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{18}
+}
+func (m *ListGatewayAlertsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListGatewayAlertsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListGatewayAlertsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
-	case 1:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+		return b[:n], nil
+	}
+}
+func (m *ListGatewayAlertsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewayAlertsRequest.Merge(m, src)
+}
+func (m *ListGatewayAlertsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListGatewayAlertsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewayAlertsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewayAlertsRequest proto.InternalMessageInfo
+
+func (m *ListGatewayAlertsRequest) GetIncludeResolved() bool {
+	if m != nil {
+		return m.IncludeResolved
+	}
+	return false
+}
+
+type GatewayAlerts struct {
+	Alerts               []*GatewayAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GatewayAlerts) Reset()      { *m = GatewayAlerts{} }
+func (*GatewayAlerts) ProtoMessage() {}
+func (*GatewayAlerts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{19}
+}
+func (m *GatewayAlerts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAlerts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayAlerts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	default:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		return b[:n], nil
 	}
-	return dAtA
 }
-func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+func (m *GatewayAlerts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAlerts.Merge(m, src)
+}
+func (m *GatewayAlerts) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAlerts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAlerts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAlerts proto.InternalMessageInfo
+
+func (m *GatewayAlerts) GetAlerts() []*GatewayAlert {
+	if m != nil {
+		return m.Alerts
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return nil
 }
-func (m *GatewayUp) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayCaptureFormat", GatewayCaptureFormat_name, GatewayCaptureFormat_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayCaptureFormat", GatewayCaptureFormat_name, GatewayCaptureFormat_value)
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayAlertType", GatewayAlertType_name, GatewayAlertType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayAlertType", GatewayAlertType_name, GatewayAlertType_value)
+	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*RunGatewayCommandRequest)(nil), "ttn.lorawan.v3.RunGatewayCommandRequest")
+	golang_proto.RegisterType((*RunGatewayCommandRequest)(nil), "ttn.lorawan.v3.RunGatewayCommandRequest")
+	proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	golang_proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	proto.RegisterType((*GatewayRemoteShellOutput)(nil), "ttn.lorawan.v3.GatewayRemoteShellOutput")
+	golang_proto.RegisterType((*GatewayRemoteShellOutput)(nil), "ttn.lorawan.v3.GatewayRemoteShellOutput")
+	proto.RegisterType((*ScheduleProprietaryDownlinkRequest)(nil), "ttn.lorawan.v3.ScheduleProprietaryDownlinkRequest")
+	golang_proto.RegisterType((*ScheduleProprietaryDownlinkRequest)(nil), "ttn.lorawan.v3.ScheduleProprietaryDownlinkRequest")
+	proto.RegisterType((*GatewayCaptureRecord)(nil), "ttn.lorawan.v3.GatewayCaptureRecord")
+	golang_proto.RegisterType((*GatewayCaptureRecord)(nil), "ttn.lorawan.v3.GatewayCaptureRecord")
+	proto.RegisterType((*StartGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayCaptureRequest")
+	golang_proto.RegisterType((*StartGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayCaptureRequest")
+	proto.RegisterType((*StopGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StopGatewayCaptureRequest")
+	golang_proto.RegisterType((*StopGatewayCaptureRequest)(nil), "ttn.lorawan.v3.StopGatewayCaptureRequest")
+	proto.RegisterType((*GatewayCapture)(nil), "ttn.lorawan.v3.GatewayCapture")
+	golang_proto.RegisterType((*GatewayCapture)(nil), "ttn.lorawan.v3.GatewayCapture")
+	proto.RegisterType((*DownloadGatewayCaptureRequest)(nil), "ttn.lorawan.v3.DownloadGatewayCaptureRequest")
+	golang_proto.RegisterType((*DownloadGatewayCaptureRequest)(nil), "ttn.lorawan.v3.DownloadGatewayCaptureRequest")
+	proto.RegisterType((*GatewayCaptureData)(nil), "ttn.lorawan.v3.GatewayCaptureData")
+	golang_proto.RegisterType((*GatewayCaptureData)(nil), "ttn.lorawan.v3.GatewayCaptureData")
+	proto.RegisterType((*GatewayConnectionStatsSample)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSample")
+	golang_proto.RegisterType((*GatewayConnectionStatsSample)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSample")
+	proto.RegisterType((*GatewayConnectionStatsSample_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSample.RoundTripTimes")
+	golang_proto.RegisterType((*GatewayConnectionStatsSample_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSample.RoundTripTimes")
+	proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	golang_proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	golang_proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	proto.RegisterType((*GatewayAlert)(nil), "ttn.lorawan.v3.GatewayAlert")
+	golang_proto.RegisterType((*GatewayAlert)(nil), "ttn.lorawan.v3.GatewayAlert")
+	proto.RegisterType((*ListGatewayAlertsRequest)(nil), "ttn.lorawan.v3.ListGatewayAlertsRequest")
+	golang_proto.RegisterType((*ListGatewayAlertsRequest)(nil), "ttn.lorawan.v3.ListGatewayAlertsRequest")
+	proto.RegisterType((*GatewayAlerts)(nil), "ttn.lorawan.v3.GatewayAlerts")
+	golang_proto.RegisterType((*GatewayAlerts)(nil), "ttn.lorawan.v3.GatewayAlerts")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+
+var fileDescriptor_62b07a36420f2d6d = []byte{
+	// 2373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0xf0, 0x47, 0xa2, 0x9e, 0x7e, 0xc2, 0x4c, 0xed, 0x78, 0x45, 0x4b, 0x2b, 0x75, 0xeb,
+	0xb8, 0xb2, 0x6a, 0x91, 0x2a, 0x6d, 0x24, 0x96, 0x0d, 0xff, 0xf0, 0x4f, 0x32, 0x13, 0x59, 0x56,
+	0x97, 0x54, 0x82, 0x34, 0x70, 0x89, 0x15, 0x39, 0xa2, 0x16, 0x22, 0x77, 0x37, 0xbb, 0x43, 0xc9,
+	0xb2, 0x61, 0xc0, 0xc8, 0xa5, 0x6e, 0x4f, 0x41, 0x02, 0xb4, 0x01, 0x1a, 0xa0, 0x05, 0xda, 0x02,
+	0x49, 0xd0, 0x83, 0xd1, 0x53, 0x4e, 0x6d, 0x50, 0xf4, 0xe0, 0xa3, 0xd1, 0x5e, 0x72, 0x72, 0x22,
+	0xaa, 0x40, 0x7d, 0xf4, 0x31, 0x30, 0x7a, 0x28, 0x76, 0x76, 0x57, 0xfc, 0x59, 0xae, 0x44, 0xb9,
+	0x55, 0x6e, 0x3b, 0x33, 0xdf, 0x7b, 0xf3, 0xcd, 0x9b, 0x37, 0xdf, 0x0c, 0x1f, 0xe1, 0xd5, 0xaa,
+	0xaa, 0x4b, 0x5b, 0x92, 0x32, 0x63, 0x50, 0xa9, 0xb4, 0x11, 0x97, 0x34, 0x39, 0x5e, 0x91, 0x28,
+	0xd9, 0x92, 0xb6, 0x0d, 0xa2, 0x6f, 0x12, 0x3d, 0xa6, 0xe9, 0x2a, 0x55, 0xf1, 0x08, 0xa5, 0x4a,
+	0xcc, 0x86, 0xc6, 0x36, 0xcf, 0x45, 0x93, 0x15, 0x99, 0xae, 0xd7, 0x57, 0x63, 0x25, 0xb5, 0x16,
+	0x27, 0xca, 0xa6, 0xba, 0xad, 0xe9, 0xea, 0xed, 0xed, 0x38, 0x03, 0x97, 0x66, 0x2a, 0x44, 0x99,
+	0xd9, 0x94, 0xaa, 0x72, 0x59, 0xa2, 0x24, 0xee, 0xfa, 0xb0, 0x5c, 0x46, 0x67, 0x5a, 0x5c, 0x54,
+	0xd4, 0x8a, 0x6a, 0x19, 0xaf, 0xd6, 0xd7, 0x58, 0x8b, 0x35, 0xd8, 0x97, 0x0d, 0x1f, 0xab, 0xa8,
+	0x6a, 0xa5, 0x4a, 0x18, 0x43, 0x49, 0x51, 0x54, 0x2a, 0x51, 0x59, 0x55, 0x0c, 0x7b, 0x94, 0xb7,
+	0x47, 0xf7, 0x7c, 0x94, 0xeb, 0x3a, 0x03, 0xd8, 0xe3, 0x27, 0x3b, 0xc7, 0x49, 0x4d, 0xa3, 0xdb,
+	0xf6, 0xe0, 0x44, 0xe7, 0x20, 0x95, 0x6b, 0xc4, 0xa0, 0x52, 0x4d, 0xb3, 0x01, 0xe3, 0xee, 0x20,
+	0x11, 0x5d, 0x57, 0x75, 0xc7, 0xde, 0x33, 0x86, 0x36, 0xe0, 0x07, 0x6e, 0x80, 0x5c, 0x26, 0x0a,
+	0x95, 0xd7, 0x64, 0xa2, 0x1b, 0xde, 0x5e, 0x9c, 0x80, 0x5b, 0x80, 0x49, 0x37, 0xa0, 0x46, 0x0c,
+	0x43, 0xaa, 0x10, 0xc7, 0xc5, 0x58, 0x17, 0xc4, 0x7b, 0x94, 0x7a, 0xdb, 0xeb, 0xa4, 0x22, 0xab,
+	0x8a, 0x54, 0xb5, 0x10, 0xc2, 0x53, 0x04, 0x03, 0x0b, 0x16, 0xf3, 0x15, 0x0d, 0xcf, 0xc3, 0x4b,
+	0x75, 0xad, 0x2a, 0x2b, 0x1b, 0x45, 0x67, 0x1a, 0x0e, 0x4d, 0x06, 0xa6, 0x06, 0x13, 0xe3, 0xb1,
+	0xf6, 0x6c, 0x88, 0xad, 0x30, 0xd8, 0x0d, 0x0b, 0x25, 0x8e, 0xd4, 0x5b, 0x9b, 0x06, 0xce, 0xc0,
+	0x88, 0x1d, 0x8e, 0xa2, 0x41, 0x25, 0x5a, 0x37, 0x38, 0xff, 0x24, 0xea, 0xe6, 0xc6, 0x9e, 0x3a,
+	0xcf, 0x40, 0xe2, 0x70, 0xa5, 0xb5, 0x89, 0x6f, 0xc0, 0xcb, 0xf4, 0x76, 0x51, 0x2a, 0x6d, 0x28,
+	0xea, 0x56, 0x95, 0x94, 0x2b, 0x35, 0xa2, 0x50, 0x2e, 0xc0, 0x1c, 0x4d, 0x76, 0x3a, 0x2a, 0xdc,
+	0x4e, 0xb6, 0xe1, 0xc4, 0x08, 0xed, 0xe8, 0x11, 0xde, 0x81, 0x41, 0x7b, 0xba, 0x8c, 0xba, 0xa5,
+	0xe0, 0x37, 0x20, 0x52, 0x56, 0xb7, 0x94, 0xd6, 0xd5, 0x72, 0x88, 0x39, 0x9f, 0xe8, 0x74, 0x9e,
+	0xb1, 0x71, 0xce, 0x72, 0x5f, 0x2a, 0xb7, 0x77, 0x08, 0x7f, 0x47, 0xc0, 0xe5, 0x4b, 0xeb, 0xa4,
+	0x5c, 0xaf, 0x12, 0x07, 0x2c, 0x12, 0x43, 0x53, 0x15, 0x83, 0xe0, 0x24, 0x84, 0xca, 0xa4, 0x2a,
+	0x6d, 0xdb, 0xde, 0x47, 0x63, 0x56, 0xee, 0xc5, 0x9c, 0xdc, 0x8b, 0x65, 0xec, 0xc4, 0x4d, 0x45,
+	0x9e, 0xa7, 0x42, 0x9f, 0x23, 0x7f, 0x18, 0x3d, 0x7a, 0x32, 0xe1, 0xfb, 0xf8, 0xeb, 0x09, 0x24,
+	0x5a, 0x96, 0x38, 0x09, 0xc3, 0x7b, 0x5c, 0x35, 0x89, 0xae, 0xdb, 0xe1, 0x1c, 0xf3, 0x22, 0xba,
+	0x2c, 0xd1, 0x75, 0x71, 0xa8, 0xdc, 0xd2, 0xc2, 0x11, 0x08, 0xe8, 0xb7, 0x7f, 0xcc, 0xc2, 0x17,
+	0x16, 0xcd, 0x4f, 0xab, 0x27, 0xc1, 0x05, 0x9d, 0x9e, 0x84, 0x70, 0x0b, 0xc6, 0x3a, 0x57, 0x91,
+	0x35, 0x93, 0x3e, 0x43, 0xa8, 0x24, 0x57, 0x0d, 0x7c, 0x19, 0x06, 0xcd, 0xd9, 0x8b, 0xec, 0x24,
+	0x38, 0xa9, 0xe1, 0x22, 0xd1, 0x6a, 0x22, 0x82, 0x69, 0xc0, 0x7a, 0x0c, 0xe1, 0xaf, 0x08, 0x38,
+	0xb1, 0xae, 0xd8, 0x9b, 0x90, 0x56, 0x6b, 0x35, 0x49, 0x29, 0x8b, 0xe4, 0xbd, 0x3a, 0x31, 0x28,
+	0x5e, 0x81, 0x41, 0x27, 0x65, 0xe4, 0xb2, 0x61, 0xc7, 0x4a, 0xf0, 0xc8, 0x97, 0x5c, 0xf3, 0x28,
+	0xb1, 0xa0, 0xfd, 0x12, 0xf9, 0x23, 0x2c, 0x68, 0x8f, 0x9f, 0x4c, 0x20, 0x11, 0x2a, 0x0e, 0xca,
+	0xc0, 0xa7, 0xa0, 0xbf, 0x64, 0x4d, 0xc4, 0x62, 0x36, 0x90, 0x82, 0xe7, 0xa9, 0x7e, 0x3d, 0x14,
+	0x41, 0xdc, 0xfd, 0xb0, 0xe8, 0x0c, 0xe1, 0xd3, 0x30, 0x20, 0xe9, 0x95, 0xba, 0x99, 0x26, 0x06,
+	0x17, 0x98, 0x0c, 0x4c, 0x0d, 0xa4, 0xc2, 0xcf, 0x53, 0xa1, 0x0f, 0x91, 0x3f, 0x72, 0x4d, 0x6c,
+	0x0e, 0x09, 0x3f, 0xf7, 0xc3, 0xa8, 0x4d, 0x41, 0x24, 0x35, 0x95, 0x92, 0xfc, 0x3a, 0xa9, 0x56,
+	0x8f, 0x78, 0x09, 0x27, 0x21, 0x58, 0x37, 0x88, 0x6e, 0xf3, 0xef, 0x7f, 0x9e, 0x0a, 0xea, 0x7e,
+	0xee, 0x9a, 0xc8, 0x3a, 0xcd, 0x41, 0x4a, 0xf4, 0x1a, 0x17, 0xe8, 0x18, 0x34, 0x3b, 0x31, 0x0f,
+	0x21, 0x59, 0xd1, 0xea, 0x94, 0xed, 0xf1, 0x10, 0x5b, 0xd2, 0x9d, 0x00, 0x77, 0x7f, 0x52, 0xb4,
+	0xba, 0xf1, 0x1c, 0xf4, 0x9b, 0xba, 0xa7, 0xd6, 0x29, 0x17, 0x3a, 0x28, 0x37, 0x83, 0x2c, 0x1f,
+	0x1d, 0xbc, 0x10, 0x03, 0xce, 0x1d, 0x88, 0x9b, 0x75, 0x6a, 0xba, 0xc5, 0x10, 0x2c, 0x4b, 0x54,
+	0x62, 0x01, 0x18, 0x12, 0xd9, 0xb7, 0xf0, 0x6f, 0x04, 0x82, 0x93, 0x5b, 0xcb, 0xba, 0xaa, 0xe9,
+	0x32, 0xa1, 0x92, 0xbe, 0xdd, 0x3c, 0x2c, 0x47, 0x1a, 0xc2, 0x1f, 0xc1, 0xa0, 0x2e, 0x6d, 0x15,
+	0x35, 0x69, 0xbb, 0xaa, 0x4a, 0x56, 0x26, 0x0c, 0xb1, 0x4c, 0xb8, 0xc3, 0x32, 0xc1, 0x2f, 0x82,
+	0x2e, 0x6d, 0x2d, 0x5b, 0xa3, 0xf8, 0x32, 0xf4, 0xeb, 0x16, 0x1d, 0x5b, 0x6c, 0x46, 0xdd, 0x62,
+	0x63, 0xf3, 0x4d, 0x85, 0x9d, 0x69, 0x45, 0xc7, 0x46, 0xf8, 0x2c, 0x00, 0xc7, 0x9c, 0x14, 0x97,
+	0x34, 0x5a, 0xd7, 0x89, 0x48, 0x4a, 0xaa, 0x5e, 0xc6, 0x17, 0x20, 0x68, 0x46, 0xcf, 0x5e, 0x54,
+	0xd4, 0x15, 0xea, 0x82, 0x73, 0x05, 0xa5, 0xc2, 0xe6, 0x22, 0x3e, 0x30, 0xe3, 0xcd, 0x2c, 0xf0,
+	0x71, 0xe8, 0x33, 0xe9, 0xd7, 0x35, 0x8b, 0xb9, 0x18, 0xd2, 0xa5, 0xad, 0x15, 0x0d, 0x8f, 0x42,
+	0xd8, 0xec, 0x36, 0x8f, 0x39, 0x63, 0x3a, 0x24, 0xf6, 0xeb, 0xd2, 0x16, 0x13, 0xb7, 0x0c, 0x8c,
+	0xb4, 0x0b, 0x39, 0x17, 0xec, 0x2e, 0xc0, 0xed, 0x3a, 0x3e, 0xdc, 0xa6, 0xe3, 0x5d, 0x64, 0x3c,
+	0xf4, 0xff, 0x92, 0xf1, 0xbe, 0x17, 0x95, 0xf1, 0xae, 0xba, 0xdd, 0xff, 0x82, 0xba, 0xfd, 0x10,
+	0x41, 0x34, 0x4f, 0x25, 0x9d, 0x76, 0x6e, 0xd8, 0x91, 0x66, 0xe3, 0x25, 0x08, 0x3b, 0x6f, 0x15,
+	0xce, 0xdf, 0xdb, 0xb9, 0xdb, 0x33, 0x10, 0x74, 0x18, 0xcd, 0x53, 0x55, 0xfb, 0x2e, 0x09, 0x0b,
+	0x7f, 0xf3, 0xc3, 0x48, 0xfb, 0x84, 0x47, 0x15, 0x9a, 0x3c, 0x40, 0xc9, 0x9a, 0xa1, 0x28, 0x3b,
+	0x8a, 0x7d, 0xfe, 0x79, 0xea, 0x94, 0x2e, 0x70, 0xa7, 0x12, 0xfc, 0xcf, 0xde, 0x95, 0x66, 0xee,
+	0xcc, 0xce, 0xcc, 0xdd, 0x9a, 0xba, 0x7a, 0xf1, 0xdd, 0x99, 0x5b, 0x57, 0x9d, 0xe6, 0x99, 0xbb,
+	0x89, 0xb3, 0xf7, 0x4e, 0x35, 0x9e, 0x4c, 0x0c, 0xd8, 0xf4, 0x72, 0x19, 0x71, 0xc0, 0xf6, 0x93,
+	0x2b, 0xe3, 0x34, 0x80, 0x61, 0x6e, 0x32, 0x29, 0x17, 0x25, 0xe7, 0x4c, 0xf7, 0x76, 0xfc, 0x06,
+	0x6c, 0xbb, 0x24, 0xc5, 0x57, 0x21, 0x6c, 0x50, 0x55, 0x33, 0x4c, 0x17, 0xc1, 0x43, 0xb8, 0xe8,
+	0x67, 0x56, 0x49, 0x2a, 0x7c, 0xe4, 0x87, 0x71, 0x96, 0x90, 0xaa, 0x54, 0xfe, 0x4e, 0xd3, 0xed,
+	0x48, 0x62, 0x3a, 0x0f, 0x7d, 0x6b, 0xaa, 0x5e, 0xb3, 0xe3, 0x39, 0x92, 0x38, 0xe5, 0x41, 0xd3,
+	0x36, 0x9e, 0x67, 0x58, 0x26, 0x97, 0xef, 0x33, 0xb9, 0xb4, 0xad, 0x85, 0x29, 0xc0, 0xed, 0xc8,
+	0x8c, 0x44, 0xa5, 0xae, 0x37, 0xc8, 0x7f, 0x42, 0x30, 0xe6, 0x40, 0x55, 0x45, 0x21, 0x25, 0xf3,
+	0x38, 0x98, 0x12, 0x63, 0xe4, 0xa5, 0x9a, 0x56, 0x25, 0xff, 0x83, 0xbe, 0x72, 0xd0, 0x6f, 0x30,
+	0x1f, 0xd6, 0x3b, 0x75, 0x58, 0x74, 0x9a, 0xf8, 0xfb, 0x30, 0x64, 0xeb, 0x68, 0x49, 0xad, 0xdb,
+	0xaf, 0xcf, 0xa0, 0x38, 0x68, 0xf5, 0xa5, 0xcd, 0x2e, 0xfc, 0x2a, 0x8c, 0xec, 0xe9, 0x91, 0x05,
+	0x0a, 0x32, 0xd0, 0xde, 0x8b, 0xcd, 0x82, 0x11, 0x88, 0xe8, 0x6a, 0x5d, 0x29, 0x17, 0xa9, 0x2e,
+	0x6b, 0x45, 0x73, 0x5a, 0x47, 0x4d, 0x2f, 0x79, 0x85, 0xae, 0xdb, 0x2a, 0x63, 0xa2, 0xe9, 0xa4,
+	0xa0, 0xcb, 0x1a, 0x5b, 0x8e, 0x38, 0xa2, 0xb7, 0xb5, 0xf1, 0x9b, 0x30, 0x60, 0xd4, 0x57, 0x8b,
+	0xab, 0x92, 0x52, 0x36, 0xb8, 0x3e, 0xf6, 0x40, 0x8b, 0xf5, 0xe6, 0x3f, 0x96, 0xaf, 0xaf, 0xa6,
+	0xcc, 0x07, 0x59, 0xd8, 0xb0, 0x3e, 0x0c, 0x7c, 0x05, 0x06, 0xab, 0x92, 0x41, 0x1d, 0xf1, 0xef,
+	0xef, 0x45, 0xfc, 0xc1, 0xb4, 0xb0, 0xbe, 0xf1, 0xdb, 0x70, 0xa2, 0xc5, 0xbe, 0xa8, 0x93, 0x12,
+	0x91, 0x37, 0xad, 0x53, 0x18, 0x3e, 0x70, 0x93, 0x82, 0x6c, 0x83, 0x8e, 0x35, 0xdd, 0x89, 0xb6,
+	0x79, 0x92, 0x46, 0x9f, 0x21, 0x18, 0x69, 0x0f, 0x04, 0x4e, 0x43, 0x5f, 0x8d, 0x94, 0x65, 0x49,
+	0x79, 0x91, 0x67, 0xb6, 0x6d, 0x8a, 0x33, 0x10, 0xd0, 0xe6, 0x66, 0x0f, 0x16, 0xe5, 0x13, 0xad,
+	0x1e, 0x1a, 0x4f, 0x26, 0x02, 0xcb, 0x73, 0xb3, 0xcc, 0x91, 0x69, 0x8e, 0x2f, 0x43, 0xa0, 0x26,
+	0xdd, 0xe6, 0x02, 0x07, 0x79, 0x71, 0xf3, 0x30, 0xed, 0xf0, 0x31, 0x08, 0x35, 0xf3, 0x68, 0x58,
+	0xb4, 0x1a, 0xc2, 0x67, 0x7e, 0xf8, 0xe1, 0x02, 0xa1, 0xdd, 0xf7, 0xee, 0xba, 0x6c, 0x50, 0x55,
+	0xdf, 0x3e, 0x62, 0x21, 0x39, 0x0f, 0xc1, 0x35, 0x5d, 0xad, 0x71, 0xfe, 0x1e, 0xf7, 0x8e, 0xa1,
+	0xf1, 0x2c, 0xf8, 0xa9, 0xca, 0x05, 0x7a, 0xb4, 0xf1, 0x53, 0x15, 0x5f, 0x05, 0xd0, 0x89, 0xa1,
+	0x56, 0xeb, 0xec, 0x86, 0x0c, 0xf6, 0x76, 0x43, 0xb6, 0x98, 0x08, 0x7f, 0x42, 0x30, 0xbe, 0x6f,
+	0xa0, 0xcc, 0x2b, 0xa1, 0x65, 0x8a, 0x03, 0x33, 0x26, 0xbc, 0xb7, 0x43, 0x2d, 0x66, 0x78, 0xbe,
+	0x55, 0x36, 0xcc, 0x93, 0x76, 0xf6, 0x30, 0x27, 0x79, 0x4f, 0x64, 0xcc, 0x17, 0xe3, 0x90, 0x8d,
+	0x4c, 0x56, 0x89, 0x7e, 0x64, 0xfb, 0x77, 0x05, 0x82, 0x74, 0x5b, 0x23, 0x6c, 0xff, 0x46, 0x12,
+	0x93, 0x1e, 0xfe, 0x18, 0x85, 0xc2, 0xb6, 0x46, 0x5a, 0xd4, 0x9a, 0xd9, 0xe1, 0x24, 0x0c, 0xe8,
+	0x92, 0x6c, 0x1c, 0xfe, 0x1a, 0x0d, 0x5b, 0x66, 0x49, 0x8a, 0x93, 0x30, 0xc8, 0x02, 0x68, 0xab,
+	0x40, 0xb0, 0xc7, 0xac, 0x00, 0xc7, 0x88, 0xb9, 0x18, 0xa0, 0xeb, 0x3a, 0x31, 0xd6, 0xd5, 0x6a,
+	0xf9, 0xe0, 0x9f, 0x2d, 0xcd, 0x9d, 0x6b, 0x5a, 0xe1, 0x39, 0x08, 0x6d, 0x4a, 0xd5, 0x3a, 0xe1,
+	0xfa, 0x7a, 0x37, 0xb7, 0x2c, 0x84, 0x4f, 0x10, 0x70, 0x8b, 0xb2, 0x41, 0x5b, 0x83, 0x65, 0x1c,
+	0xf1, 0xb9, 0x3b, 0x03, 0x11, 0x59, 0x29, 0x55, 0xeb, 0x65, 0x52, 0x74, 0xe2, 0xc0, 0xf6, 0x30,
+	0x2c, 0xbe, 0x64, 0xf7, 0x8b, 0x76, 0xb7, 0x90, 0x85, 0xe1, 0x36, 0x66, 0xf8, 0x3c, 0xf4, 0x49,
+	0xec, 0xcb, 0xeb, 0xd7, 0x7a, 0x2b, 0x5c, 0xb4, 0xb1, 0xd3, 0x6f, 0xc1, 0xb1, 0x6e, 0xf7, 0x37,
+	0x1e, 0x87, 0xd1, 0x74, 0x72, 0xb9, 0xb0, 0x22, 0x66, 0x8b, 0xf3, 0x37, 0xc5, 0x1b, 0xc9, 0x42,
+	0xf1, 0x8d, 0xfc, 0xcd, 0xa5, 0xe2, 0x62, 0x6e, 0x29, 0x9b, 0x8f, 0xf8, 0xf0, 0x28, 0x1c, 0xef,
+	0x18, 0x5e, 0x4e, 0x27, 0x97, 0x97, 0x16, 0x22, 0x28, 0x1a, 0x7c, 0xf0, 0x7b, 0xde, 0x37, 0xfd,
+	0x07, 0x04, 0x91, 0xce, 0x34, 0xc3, 0x3c, 0x44, 0x17, 0x92, 0x85, 0xec, 0xdb, 0xc9, 0x77, 0x8a,
+	0xc9, 0xc5, 0xac, 0x58, 0x28, 0x66, 0x72, 0xf9, 0xf4, 0xcd, 0xa5, 0xa5, 0x6c, 0xba, 0x90, 0xcd,
+	0x44, 0x7c, 0x78, 0x12, 0xc6, 0xda, 0xc7, 0x57, 0x96, 0x17, 0x73, 0x4b, 0x6f, 0x16, 0xf3, 0xb9,
+	0xc5, 0xec, 0x52, 0x3a, 0x1b, 0x41, 0x6e, 0x44, 0xbe, 0x90, 0x2c, 0xac, 0xe4, 0xf7, 0x10, 0x7e,
+	0x7c, 0x1a, 0x84, 0x76, 0xc4, 0xf5, 0xdc, 0xc2, 0xf5, 0xa2, 0x78, 0x73, 0x65, 0x29, 0x53, 0x2c,
+	0x88, 0xb9, 0xe5, 0x62, 0x21, 0x77, 0x23, 0x1b, 0x09, 0x58, 0x34, 0x13, 0x5f, 0x07, 0x20, 0xb4,
+	0x40, 0xb7, 0x16, 0x0c, 0x9c, 0x83, 0xc1, 0x45, 0x59, 0xd9, 0xb0, 0x39, 0xe3, 0x51, 0x8f, 0xe8,
+	0xad, 0x68, 0xd1, 0x93, 0x1e, 0x43, 0xe6, 0x93, 0x6f, 0x0a, 0xcd, 0x22, 0x9c, 0x87, 0xe3, 0x0b,
+	0x84, 0xa6, 0x55, 0xa5, 0x44, 0x14, 0xaa, 0x4b, 0x54, 0xd5, 0xd3, 0xaa, 0xb2, 0x26, 0x57, 0xf0,
+	0x2b, 0xae, 0xf4, 0xcb, 0x9a, 0x95, 0xca, 0xa8, 0x2b, 0x71, 0xba, 0xd8, 0xfe, 0x1a, 0x31, 0xaf,
+	0x37, 0x7e, 0x52, 0x28, 0x34, 0x45, 0x26, 0xa7, 0xac, 0xa9, 0xb8, 0x87, 0xb4, 0x73, 0xcf, 0xe0,
+	0xf6, 0x23, 0xbc, 0xf6, 0xfe, 0x3f, 0xff, 0xf5, 0x91, 0x7f, 0x16, 0xc7, 0xe2, 0x15, 0x63, 0xaf,
+	0x4e, 0x1c, 0xbf, 0xdb, 0xcc, 0xf3, 0x7b, 0xac, 0xe0, 0x38, 0x53, 0xda, 0x33, 0x9b, 0x91, 0xcd,
+	0xf9, 0x7f, 0x83, 0xe0, 0x84, 0xcd, 0xec, 0xad, 0xc4, 0x11, 0x71, 0xbb, 0xc0, 0xb8, 0x25, 0xf0,
+	0xec, 0xfe, 0xdc, 0x36, 0x13, 0x9d, 0xec, 0x12, 0x04, 0x82, 0x4b, 0xc6, 0x82, 0x81, 0x6f, 0x41,
+	0xa4, 0xb3, 0xe2, 0x85, 0x0f, 0xfa, 0x19, 0x19, 0x9d, 0xea, 0x04, 0x78, 0x95, 0xfe, 0x12, 0xbf,
+	0x18, 0x02, 0xff, 0x82, 0x61, 0xc6, 0x62, 0xd4, 0xf3, 0xee, 0xee, 0x29, 0x1a, 0xa7, 0x7b, 0xbb,
+	0x59, 0x84, 0x04, 0x8b, 0xc8, 0x59, 0x3c, 0xed, 0x1d, 0x91, 0x66, 0x28, 0xe2, 0x06, 0x9b, 0xff,
+	0x1f, 0x08, 0x26, 0x0f, 0x7a, 0x59, 0xe0, 0xd7, 0x5d, 0x04, 0x7a, 0x7b, 0x8b, 0x44, 0x67, 0x7a,
+	0x63, 0x6e, 0x5b, 0x09, 0xf3, 0x6c, 0x01, 0xd7, 0xf0, 0x15, 0xaf, 0x05, 0x18, 0xb1, 0xfd, 0x16,
+	0x13, 0x5f, 0xb7, 0xf9, 0x7e, 0x88, 0xe0, 0x65, 0x97, 0x4e, 0x63, 0xd7, 0xce, 0x79, 0x49, 0x79,
+	0x74, 0x7c, 0x3f, 0x9d, 0x34, 0x84, 0xf3, 0x8c, 0x66, 0x0c, 0x9f, 0xed, 0x8d, 0xa6, 0x25, 0xab,
+	0xf8, 0x57, 0x08, 0x5e, 0x76, 0x15, 0x40, 0xdd, 0xa4, 0xbc, 0x6a, 0xa4, 0x51, 0x0f, 0xa5, 0x10,
+	0xae, 0x32, 0x36, 0x73, 0xc2, 0xf9, 0xde, 0xd8, 0xe8, 0xac, 0x62, 0x17, 0xb7, 0x8b, 0x9f, 0x17,
+	0xd1, 0x34, 0xfe, 0x1c, 0x01, 0x76, 0x97, 0xf3, 0xf0, 0x19, 0x8f, 0x20, 0xb8, 0x6b, 0x9f, 0xd1,
+	0xa9, 0x83, 0xa1, 0x56, 0x75, 0x50, 0xb8, 0xcc, 0xc8, 0xbe, 0x2e, 0x24, 0x0e, 0x45, 0xd6, 0x30,
+	0x3d, 0x5c, 0x44, 0xd3, 0xb3, 0x08, 0xff, 0x05, 0xc1, 0xc9, 0x7d, 0x4a, 0x89, 0x38, 0xe1, 0x75,
+	0x3c, 0xbd, 0xeb, 0x8e, 0xbd, 0x1f, 0x69, 0x21, 0xc9, 0xe8, 0x5f, 0x12, 0x5e, 0xeb, 0x8d, 0xbe,
+	0xd6, 0x9c, 0x33, 0x6e, 0xfe, 0x20, 0x34, 0xa3, 0xfd, 0x09, 0x82, 0xef, 0x75, 0xa9, 0x3a, 0xe1,
+	0x69, 0x17, 0x09, 0xcf, 0xd2, 0x54, 0x94, 0xdf, 0xff, 0xf7, 0xb6, 0x30, 0xc7, 0x68, 0x9e, 0x13,
+	0x62, 0x3d, 0x9e, 0x23, 0xcb, 0xcc, 0x30, 0xe9, 0xfd, 0x16, 0x01, 0x76, 0x97, 0x98, 0xdc, 0xc9,
+	0xe0, 0x59, 0x86, 0x3a, 0x90, 0xdc, 0x15, 0x46, 0xee, 0x82, 0x70, 0xee, 0x70, 0xe4, 0xe2, 0x66,
+	0x29, 0xc5, 0x64, 0xf8, 0x67, 0x04, 0xaf, 0x74, 0x2f, 0xa5, 0xe0, 0x99, 0xae, 0xe2, 0xed, 0x55,
+	0x72, 0x89, 0x0a, 0xfb, 0x33, 0x35, 0x8b, 0x11, 0x42, 0x9a, 0xb1, 0xbd, 0x8c, 0x2f, 0x1d, 0x92,
+	0xed, 0xdd, 0x66, 0xd5, 0xe5, 0xde, 0x2c, 0x4a, 0xfd, 0x11, 0x3d, 0xda, 0xe1, 0xd1, 0xe3, 0x1d,
+	0x1e, 0x7d, 0xb5, 0xc3, 0xfb, 0xbe, 0xd9, 0xe1, 0x7d, 0x4f, 0x77, 0x78, 0xdf, 0xb3, 0x1d, 0xde,
+	0xf7, 0xed, 0x0e, 0x8f, 0xee, 0x37, 0x78, 0xf4, 0xa0, 0xc1, 0xfb, 0x3e, 0x6d, 0xf0, 0xe8, 0x61,
+	0x83, 0xf7, 0x7d, 0xd1, 0xe0, 0x7d, 0x5f, 0x36, 0x78, 0xdf, 0xa3, 0x06, 0x8f, 0x1e, 0x37, 0x78,
+	0xf4, 0x55, 0x83, 0xf7, 0x7d, 0xd3, 0xe0, 0xd1, 0xd3, 0x06, 0xef, 0x7b, 0xd6, 0xe0, 0xd1, 0xb7,
+	0x0d, 0xde, 0x77, 0x7f, 0x97, 0xf7, 0x3d, 0xd8, 0xe5, 0xd1, 0x07, 0xbb, 0xbc, 0xef, 0xe3, 0x5d,
+	0x1e, 0xfd, 0x6e, 0x97, 0xf7, 0x7d, 0xba, 0xcb, 0xfb, 0x1e, 0xee, 0xf2, 0xe8, 0x8b, 0x5d, 0x1e,
+	0x7d, 0xb9, 0xcb, 0xa3, 0x9f, 0xc6, 0x2b, 0x6a, 0x8c, 0xae, 0x13, 0xba, 0x2e, 0x2b, 0x15, 0x23,
+	0xa6, 0x10, 0xba, 0xa5, 0xea, 0x1b, 0xf1, 0xf6, 0xff, 0x05, 0x37, 0xcf, 0xc5, 0xb5, 0x8d, 0x4a,
+	0x9c, 0x52, 0x45, 0x5b, 0x5d, 0xed, 0x63, 0xe2, 0x72, 0xee, 0xbf, 0x03, 0x00, 0x18, 0xf5, 0x75,
+	0xb0, 0x27, 0x1e, 0x00, 0x00,
+}
+
+func (x GatewayCaptureFormat) String() string {
+	s, ok := GatewayCaptureFormat_name[int32(x)]
+	if ok {
+		return s
 	}
-	var l int
-	_ = l
-	if len(m.UplinkMessages) > 0 {
-		for _, e := range m.UplinkMessages {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
+	return strconv.Itoa(int(x))
+}
+func (x GatewayAlertType) String() string {
+	s, ok := GatewayAlertType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *GatewayUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUp)
+	if !ok {
+		that2, ok := that.(GatewayUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.GatewayStatus != nil {
-		l = m.GatewayStatus.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TxAcknowledgment != nil {
-		l = m.TxAcknowledgment.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
+		return false
 	}
-	return n
+	for i := range this.UplinkMessages {
+		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
+			return false
+		}
+	}
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
+	}
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
+	}
+	return true
 }
+func (this *GatewayDown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayDown) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*GatewayDown)
+	if !ok {
+		that2, ok := that.(GatewayDown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		l = m.DownlinkMessage.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
 }
+func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ScheduleDownlinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ScheduleDownlinkResponse)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
-	n += 1 + l + sovGatewayserver(uint64(l))
-	if m.DownlinkPath != nil {
-		l = m.DownlinkPath.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Rx1 {
-		n += 2
+	if this.Delay != that1.Delay {
+		return false
 	}
-	if m.Rx2 {
-		n += 2
+	if !this.DownlinkPath.Equal(that1.DownlinkPath) {
+		return false
 	}
-	return n
+	if this.Rx1 != that1.Rx1 {
+		return false
+	}
+	if this.Rx2 != that1.Rx2 {
+		return false
+	}
+	return true
 }
+func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ScheduleDownlinkErrorDetails)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkErrorDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for _, e := range m.PathErrors {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PathErrors) != len(that1.PathErrors) {
+		return false
+	}
+	for i := range this.PathErrors {
+		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RunGatewayCommandRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RunGatewayCommandRequest)
+	if !ok {
+		that2, ok := that.(RunGatewayCommandRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Command != that1.Command {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if this.Arguments[i] != that1.Arguments[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayRemoteShellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayRemoteShellRequest)
+	if !ok {
+		that2, ok := that.(GatewayRemoteShellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Term != that1.Term {
+		return false
+	}
+	if !bytes.Equal(this.Input, that1.Input) {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	return true
+}
+func (this *GatewayRemoteShellOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayRemoteShellOutput)
+	if !ok {
+		that2, ok := that.(GatewayRemoteShellOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *ScheduleProprietaryDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleProprietaryDownlinkRequest)
+	if !ok {
+		that2, ok := that.(ScheduleProprietaryDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
+}
+func (this *GatewayCaptureRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayCaptureRecord)
+	if !ok {
+		that2, ok := that.(GatewayCaptureRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !bytes.Equal(this.RawUp, that1.RawUp) {
+		return false
+	}
+	if !bytes.Equal(this.RawDown, that1.RawDown) {
+		return false
+	}
+	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
+		return false
+	}
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
+	}
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
+	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *StartGatewayCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartGatewayCaptureRequest)
+	if !ok {
+		that2, ok := that.(StartGatewayCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	return true
+}
+func (this *StopGatewayCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StopGatewayCaptureRequest)
+	if !ok {
+		that2, ok := that.(StopGatewayCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	return true
+}
+func (this *GatewayCapture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayCapture)
+	if !ok {
+		that2, ok := that.(GatewayCapture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.CaptureID != that1.CaptureID {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
+		return false
+	}
+	if !this.StopsAt.Equal(that1.StopsAt) {
+		return false
+	}
+	return true
+}
+func (this *DownloadGatewayCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownloadGatewayCaptureRequest)
+	if !ok {
+		that2, ok := that.(DownloadGatewayCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.CaptureID != that1.CaptureID {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	return true
+}
+func (this *GatewayCaptureData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayCaptureData)
+	if !ok {
+		that2, ok := that.(GatewayCaptureData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStatsSample) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsSample)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsSample)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Samples != that1.Samples {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if !this.RoundTripTimes.Equal(that1.RoundTripTimes) {
+		return false
+	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	if !this.LastStatus.Equal(that1.LastStatus) {
+		return false
+	}
+	if that1.LastStatusReceivedAt == nil {
+		if this.LastStatusReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastStatusReceivedAt.Equal(*that1.LastStatusReceivedAt) {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStatsSample_RoundTripTimes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsSample_RoundTripTimes)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsSample_RoundTripTimes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.P90 != that1.P90 {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *GetGatewayConnectionStatsHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewayConnectionStatsHistoryRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayConnectionStatsHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if that1.From == nil {
+		if this.From != nil {
+			return false
+		}
+	} else if !this.From.Equal(*that1.From) {
+		return false
+	}
+	if that1.To == nil {
+		if this.To != nil {
+			return false
+		}
+	} else if !this.To.Equal(*that1.To) {
+		return false
+	}
+	if this.Resolution != nil && that1.Resolution != nil {
+		if *this.Resolution != *that1.Resolution {
+			return false
+		}
+	} else if this.Resolution != nil {
+		return false
+	} else if that1.Resolution != nil {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStatsHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStatsHistory)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStatsHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Resolution != that1.Resolution {
+		return false
+	}
+	if len(this.Samples) != len(that1.Samples) {
+		return false
+	}
+	for i := range this.Samples {
+		if !this.Samples[i].Equal(that1.Samples[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayAlert) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayAlert)
+	if !ok {
+		that2, ok := that.(GatewayAlert)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.RaisedAt.Equal(that1.RaisedAt) {
+		return false
+	}
+	if that1.ResolvedAt == nil {
+		if this.ResolvedAt != nil {
+			return false
+		}
+	} else if !this.ResolvedAt.Equal(*that1.ResolvedAt) {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *ListGatewayAlertsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListGatewayAlertsRequest)
+	if !ok {
+		that2, ok := that.(ListGatewayAlertsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.IncludeResolved != that1.IncludeResolved {
+		return false
+	}
+	return true
+}
+func (this *GatewayAlerts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayAlerts)
+	if !ok {
+		that2, ok := that.(GatewayAlerts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Alerts) != len(that1.Alerts) {
+		return false
+	}
+	for i := range this.Alerts {
+		if !this.Alerts[i].Equal(that1.Alerts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	return &gtwGsClient{cc}
+}
+
+func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gtwGsLinkGatewayClient{stream}
+	return x, nil
+}
+
+type GtwGs_LinkGatewayClient interface {
+	Send(*GatewayUp) error
+	Recv() (*GatewayDown, error)
+	grpc.ClientStream
+}
+
+type gtwGsLinkGatewayClient struct {
+	grpc.ClientStream
+}
+
+func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
+	m := new(GatewayDown)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
+	out := new(ConcentratorConfig)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GtwGsServer is the server API for GtwGs service.
+type GtwGsServer interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(GtwGs_LinkGatewayServer) error
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+}
+
+// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGtwGsServer struct {
+}
+
+func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
+	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+}
+func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+}
+
+func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
+	s.RegisterService(&_GtwGs_serviceDesc, srv)
+}
+
+func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+}
+
+type GtwGs_LinkGatewayServer interface {
+	Send(*GatewayDown) error
+	Recv() (*GatewayUp, error)
+	grpc.ServerStream
+}
+
+type gtwGsLinkGatewayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
+	m := new(GatewayUp)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GtwGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GtwGs",
+	HandlerType: (*GtwGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConcentratorConfig",
+			Handler:    _GtwGs_GetConcentratorConfig_Handler,
+		},
+		{
+			MethodName: "GetMQTTConnectionInfo",
+			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
+		},
+		{
+			MethodName: "GetMQTTV2ConnectionInfo",
+			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LinkGateway",
+			Handler:       _GtwGs_LinkGateway_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// NsGsClient is the client API for NsGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsGsClient interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+}
+
+type nsGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
+	return &nsGsClient{cc}
+}
+
+func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsGsServer is the server API for NsGs service.
+type NsGsServer interface {
+	// Instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+}
+
+// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsGsServer struct {
+}
+
+func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+}
+
+func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
+	s.RegisterService(&_NsGs_serviceDesc, srv)
+}
+
+func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsGs",
+	HandlerType: (*NsGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleDownlink",
+			Handler:    _NsGs_ScheduleDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// GsClient is the client API for Gs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsClient interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Get the history of sampled statistics about the connections of the gateway to the Gateway Server.
+	GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error)
+	// List the active and recently resolved alerts about the health of the gateway connection.
+	ListGatewayAlerts(ctx context.Context, in *ListGatewayAlertsRequest, opts ...grpc.CallOption) (*GatewayAlerts, error)
+	// Run a command on the gateway.
+	// The gateway must be connected to this Gateway Server with a protocol that supports remote control.
+	RunGatewayCommand(ctx context.Context, in *RunGatewayCommandRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Open a remote shell session on the gateway, write the input to it and stream the output.
+	// The session is closed when the timeout expires, when the gateway disconnects or when the client cancels the request.
+	// The gateway must be connected to this Gateway Server with a protocol that supports remote control.
+	GatewayRemoteShell(ctx context.Context, in *GatewayRemoteShellRequest, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error)
+	// Schedule a proprietary downlink message on the gateway.
+	// This method returns an error if the downlink message cannot be scheduled, see NsGs.ScheduleDownlink.
+	ScheduleProprietaryDownlink(ctx context.Context, in *ScheduleProprietaryDownlinkRequest, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+	// Start a capture of the traffic of the gateway connection.
+	// The capture is stopped when the duration expires, when the gateway disconnects or when the capture is stopped.
+	// The gateway must be connected to this Gateway Server.
+	StartGatewayCapture(ctx context.Context, in *StartGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error)
+	// Stop the active capture of the traffic of the gateway connection.
+	StopGatewayCapture(ctx context.Context, in *StopGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error)
+	// Download a stored capture of the traffic of the gateway.
+	DownloadGatewayCapture(ctx context.Context, in *DownloadGatewayCaptureRequest, opts ...grpc.CallOption) (Gs_DownloadGatewayCaptureClient, error)
+}
+
+type gsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsClient(cc *grpc.ClientConn) GsClient {
+	return &gsClient{cc}
+}
+
+func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
+	out := new(GatewayConnectionStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error) {
+	out := new(GatewayConnectionStatsHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) ListGatewayAlerts(ctx context.Context, in *ListGatewayAlertsRequest, opts ...grpc.CallOption) (*GatewayAlerts, error) {
+	out := new(GatewayAlerts)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/ListGatewayAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) RunGatewayCommand(ctx context.Context, in *RunGatewayCommandRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/RunGatewayCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GatewayRemoteShell(ctx context.Context, in *GatewayRemoteShellRequest, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[0], "/ttn.lorawan.v3.Gs/GatewayRemoteShell", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsGatewayRemoteShellClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_GatewayRemoteShellClient interface {
+	Recv() (*GatewayRemoteShellOutput, error)
+	grpc.ClientStream
+}
+
+type gsGatewayRemoteShellClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsGatewayRemoteShellClient) Recv() (*GatewayRemoteShellOutput, error) {
+	m := new(GatewayRemoteShellOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) ScheduleProprietaryDownlink(ctx context.Context, in *ScheduleProprietaryDownlinkRequest, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/ScheduleProprietaryDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) StartGatewayCapture(ctx context.Context, in *StartGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error) {
+	out := new(GatewayCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StartGatewayCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) StopGatewayCapture(ctx context.Context, in *StopGatewayCaptureRequest, opts ...grpc.CallOption) (*GatewayCapture, error) {
+	out := new(GatewayCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StopGatewayCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) DownloadGatewayCapture(ctx context.Context, in *DownloadGatewayCaptureRequest, opts ...grpc.CallOption) (Gs_DownloadGatewayCaptureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[1], "/ttn.lorawan.v3.Gs/DownloadGatewayCapture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsDownloadGatewayCaptureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_DownloadGatewayCaptureClient interface {
+	Recv() (*GatewayCaptureData, error)
+	grpc.ClientStream
+}
+
+type gsDownloadGatewayCaptureClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsDownloadGatewayCaptureClient) Recv() (*GatewayCaptureData, error) {
+	m := new(GatewayCaptureData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GsServer is the server API for Gs service.
+type GsServer interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Get the history of sampled statistics about the connections of the gateway to the Gateway Server.
+	GetGatewayConnectionStatsHistory(context.Context, *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error)
+	// List the active and recently resolved alerts about the health of the gateway connection.
+	ListGatewayAlerts(context.Context, *ListGatewayAlertsRequest) (*GatewayAlerts, error)
+	// Run a command on the gateway.
+	// The gateway must be connected to this Gateway Server with a protocol that supports remote control.
+	RunGatewayCommand(context.Context, *RunGatewayCommandRequest) (*types.Empty, error)
+	// Open a remote shell session on the gateway, write the input to it and stream the output.
+	// The session is closed when the timeout expires, when the gateway disconnects or when the client cancels the request.
+	// The gateway must be connected to this Gateway Server with a protocol that supports remote control.
+	GatewayRemoteShell(*GatewayRemoteShellRequest, Gs_GatewayRemoteShellServer) error
+	// Schedule a proprietary downlink message on the gateway.
+	// This method returns an error if the downlink message cannot be scheduled, see NsGs.ScheduleDownlink.
+	ScheduleProprietaryDownlink(context.Context, *ScheduleProprietaryDownlinkRequest) (*ScheduleDownlinkResponse, error)
+	// Start a capture of the traffic of the gateway connection.
+	// The capture is stopped when the duration expires, when the gateway disconnects or when the capture is stopped.
+	// The gateway must be connected to this Gateway Server.
+	StartGatewayCapture(context.Context, *StartGatewayCaptureRequest) (*GatewayCapture, error)
+	// Stop the active capture of the traffic of the gateway connection.
+	StopGatewayCapture(context.Context, *StopGatewayCaptureRequest) (*GatewayCapture, error)
+	// Download a stored capture of the traffic of the gateway.
+	DownloadGatewayCapture(*DownloadGatewayCaptureRequest, Gs_DownloadGatewayCaptureServer) error
+}
+
+// UnimplementedGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsServer struct {
+}
+
+func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+}
+func (*UnimplementedGsServer) GetGatewayConnectionStatsHistory(ctx context.Context, req *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStatsHistory not implemented")
+}
+func (*UnimplementedGsServer) ListGatewayAlerts(ctx context.Context, req *ListGatewayAlertsRequest) (*GatewayAlerts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGatewayAlerts not implemented")
+}
+func (*UnimplementedGsServer) RunGatewayCommand(ctx context.Context, req *RunGatewayCommandRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGatewayCommand not implemented")
+}
+func (*UnimplementedGsServer) GatewayRemoteShell(req *GatewayRemoteShellRequest, srv Gs_GatewayRemoteShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method GatewayRemoteShell not implemented")
+}
+func (*UnimplementedGsServer) ScheduleProprietaryDownlink(ctx context.Context, req *ScheduleProprietaryDownlinkRequest) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleProprietaryDownlink not implemented")
+}
+func (*UnimplementedGsServer) StartGatewayCapture(ctx context.Context, req *StartGatewayCaptureRequest) (*GatewayCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGatewayCapture not implemented")
+}
+func (*UnimplementedGsServer) StopGatewayCapture(ctx context.Context, req *StopGatewayCaptureRequest) (*GatewayCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopGatewayCapture not implemented")
+}
+func (*UnimplementedGsServer) DownloadGatewayCapture(req *DownloadGatewayCaptureRequest, srv Gs_DownloadGatewayCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadGatewayCapture not implemented")
+}
+
+func RegisterGsServer(s *grpc.Server, srv GsServer) {
+	s.RegisterService(&_Gs_serviceDesc, srv)
+}
+
+func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewayConnectionStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConnectionStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, req.(*GetGatewayConnectionStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_ListGatewayAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).ListGatewayAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/ListGatewayAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).ListGatewayAlerts(ctx, req.(*ListGatewayAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_RunGatewayCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunGatewayCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).RunGatewayCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/RunGatewayCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).RunGatewayCommand(ctx, req.(*RunGatewayCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GatewayRemoteShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayRemoteShellRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).GatewayRemoteShell(m, &gsGatewayRemoteShellServer{stream})
+}
+
+type Gs_GatewayRemoteShellServer interface {
+	Send(*GatewayRemoteShellOutput) error
+	grpc.ServerStream
+}
+
+type gsGatewayRemoteShellServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsGatewayRemoteShellServer) Send(m *GatewayRemoteShellOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gs_ScheduleProprietaryDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleProprietaryDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).ScheduleProprietaryDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/ScheduleProprietaryDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).ScheduleProprietaryDownlink(ctx, req.(*ScheduleProprietaryDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_StartGatewayCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGatewayCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StartGatewayCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StartGatewayCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StartGatewayCapture(ctx, req.(*StartGatewayCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_StopGatewayCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopGatewayCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StopGatewayCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StopGatewayCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StopGatewayCapture(ctx, req.(*StopGatewayCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_DownloadGatewayCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadGatewayCaptureRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).DownloadGatewayCapture(m, &gsDownloadGatewayCaptureServer{stream})
+}
+
+type Gs_DownloadGatewayCaptureServer interface {
+	Send(*GatewayCaptureData) error
+	grpc.ServerStream
+}
+
+type gsDownloadGatewayCaptureServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsDownloadGatewayCaptureServer) Send(m *GatewayCaptureData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Gs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Gs",
+	HandlerType: (*GsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayConnectionStats",
+			Handler:    _Gs_GetGatewayConnectionStats_Handler,
+		},
+		{
+			MethodName: "GetGatewayConnectionStatsHistory",
+			Handler:    _Gs_GetGatewayConnectionStatsHistory_Handler,
+		},
+		{
+			MethodName: "ListGatewayAlerts",
+			Handler:    _Gs_ListGatewayAlerts_Handler,
+		},
+		{
+			MethodName: "RunGatewayCommand",
+			Handler:    _Gs_RunGatewayCommand_Handler,
+		},
+		{
+			MethodName: "ScheduleProprietaryDownlink",
+			Handler:    _Gs_ScheduleProprietaryDownlink_Handler,
+		},
+		{
+			MethodName: "StartGatewayCapture",
+			Handler:    _Gs_StartGatewayCapture_Handler,
+		},
+		{
+			MethodName: "StopGatewayCapture",
+			Handler:    _Gs_StopGatewayCapture_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GatewayRemoteShell",
+			Handler:       _Gs_GatewayRemoteShell_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadGatewayCapture",
+			Handler:       _Gs_DownloadGatewayCapture_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UplinkMessages) > 0 {
+		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rx2 {
+		i--
+		if m.Rx2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rx1 {
+		i--
+		if m.Rx1 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DownlinkPath != nil {
+		{
+			size, err := m.DownlinkPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunGatewayCommandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunGatewayCommandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunGatewayCommandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayRemoteShellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayRemoteShellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayRemoteShellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayRemoteShellOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayRemoteShellOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayRemoteShellOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleProprietaryDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleProprietaryDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleProprietaryDownlinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawPayload) > 0 {
+		i -= len(m.RawPayload)
+		copy(dAtA[i:], m.RawPayload)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.RawPayload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCaptureRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCaptureRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCaptureRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UplinkMessage != nil {
+		{
+			size, err := m.UplinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RawDown) > 0 {
+		i -= len(m.RawDown)
+		copy(dAtA[i:], m.RawDown)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.RawDown)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawUp) > 0 {
+		i -= len(m.RawUp)
+		copy(dAtA[i:], m.RawUp)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.RawUp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StartGatewayCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartGatewayCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartGatewayCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StopGatewayCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopGatewayCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopGatewayCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCapture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCapture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCapture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StopsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StopsAt):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CaptureID) > 0 {
+		i -= len(m.CaptureID)
+		copy(dAtA[i:], m.CaptureID)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.CaptureID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DownloadGatewayCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownloadGatewayCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownloadGatewayCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CaptureID) > 0 {
+		i -= len(m.CaptureID)
+		copy(dAtA[i:], m.CaptureID)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.CaptureID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCaptureData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCaptureData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCaptureData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastStatusReceivedAt != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastStatus != nil {
+		{
+			size, err := m.LastStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SubBands) > 0 {
+		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RoundTripTimes != nil {
+		{
+			size, err := m.RoundTripTimes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.UplinkCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Samples != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x10
+	}
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	n27, err27 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x1a
+	n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P90, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x12
+	n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolution != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Resolution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Resolution):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.To):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.From):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionStatsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStatsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionStatsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Resolution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Value, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Value):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x32
+	n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Threshold, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Threshold):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x2a
+	if m.ResolvedAt != nil {
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ResolvedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolvedAt):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x22
+	}
+	n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RaisedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RaisedAt):])
+	if err38 != nil {
+		return 0, err38
+	}
+	i -= n38
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x1a
+	if m.Type != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListGatewayAlertsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListGatewayAlertsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListGatewayAlertsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeResolved {
+		i--
+		if m.IncludeResolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayAlerts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAlerts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAlerts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Alerts) > 0 {
+		for iNdEx := len(m.Alerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Alerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+	this := &GatewayDown{}
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
+	this := &ScheduleDownlinkResponse{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Delay = *v2
+	if r.Intn(5) != 0 {
+		this.DownlinkPath = NewPopulatedDownlinkPath(r, easy)
+	}
+	this.Rx1 = bool(r.Intn(2) == 0)
+	this.Rx2 = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
+	this := &ScheduleDownlinkErrorDetails{}
+	if r.Intn(5) == 0 {
+		v3 := r.Intn(5)
+		this.PathErrors = make([]*ErrorDetails, v3)
+		for i := 0; i < v3; i++ {
+			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRunGatewayCommandRequest(r randyGatewayserver, easy bool) *RunGatewayCommandRequest {
+	this := &RunGatewayCommandRequest{}
+	v4 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v4
+	this.Command = randStringGatewayserver(r)
+	v5 := r.Intn(10)
+	this.Arguments = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.Arguments[i] = randStringGatewayserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayRemoteShellRequest(r randyGatewayserver, easy bool) *GatewayRemoteShellRequest {
+	this := &GatewayRemoteShellRequest{}
+	v6 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v6
+	this.User = randStringGatewayserver(r)
+	this.Term = randStringGatewayserver(r)
+	v7 := r.Intn(100)
+	this.Input = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.Input[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.Timeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayRemoteShellOutput(r randyGatewayserver, easy bool) *GatewayRemoteShellOutput {
+	this := &GatewayRemoteShellOutput{}
+	v8 := r.Intn(100)
+	this.Data = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleProprietaryDownlinkRequest(r randyGatewayserver, easy bool) *ScheduleProprietaryDownlinkRequest {
+	this := &ScheduleProprietaryDownlinkRequest{}
+	v9 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v9
+	v10 := r.Intn(100)
+	this.RawPayload = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.RawPayload[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.Request = NewPopulatedTxRequest(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayCaptureRecord(r randyGatewayserver, easy bool) *GatewayCaptureRecord {
+	this := &GatewayCaptureRecord{}
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v11
+	v12 := r.Intn(100)
+	this.RawUp = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.RawUp[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.RawDown = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.RawDown[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkMessage = NewPopulatedUplinkMessage(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStartGatewayCaptureRequest(r randyGatewayserver, easy bool) *StartGatewayCaptureRequest {
+	this := &StartGatewayCaptureRequest{}
+	v14 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v14
+	if r.Intn(5) != 0 {
+		this.Duration = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStopGatewayCaptureRequest(r randyGatewayserver, easy bool) *StopGatewayCaptureRequest {
+	this := &StopGatewayCaptureRequest{}
+	v15 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v15
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayCapture(r randyGatewayserver, easy bool) *GatewayCapture {
+	this := &GatewayCapture{}
+	v16 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v16
+	this.CaptureID = randStringGatewayserver(r)
+	v17 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v17
+	v18 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StopsAt = *v18
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownloadGatewayCaptureRequest(r randyGatewayserver, easy bool) *DownloadGatewayCaptureRequest {
+	this := &DownloadGatewayCaptureRequest{}
+	v19 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v19
+	this.CaptureID = randStringGatewayserver(r)
+	this.Format = GatewayCaptureFormat([]int32{0, 1}[r.Intn(2)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayCaptureData(r randyGatewayserver, easy bool) *GatewayCaptureData {
+	this := &GatewayCaptureData{}
+	v20 := r.Intn(100)
+	this.Data = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsSample(r randyGatewayserver, easy bool) *GatewayConnectionStatsSample {
+	this := &GatewayConnectionStatsSample{}
+	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v21
+	this.Samples = r.Uint32()
+	this.UplinkCount = uint64(r.Uint32())
+	this.DownlinkCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.RoundTripTimes = NewPopulatedGatewayConnectionStatsSample_RoundTripTimes(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v22)
+		for i := 0; i < v22; i++ {
+			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.LastStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastStatusReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsSample_RoundTripTimes(r randyGatewayserver, easy bool) *GatewayConnectionStatsSample_RoundTripTimes {
+	this := &GatewayConnectionStatsSample_RoundTripTimes{}
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v23
+	v24 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.P90 = *v24
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v25
+	this.Count = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewayConnectionStatsHistoryRequest(r randyGatewayserver, easy bool) *GetGatewayConnectionStatsHistoryRequest {
+	this := &GetGatewayConnectionStatsHistoryRequest{}
+	v26 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v26
+	if r.Intn(5) != 0 {
+		this.From = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.To = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Resolution = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionStatsHistory(r randyGatewayserver, easy bool) *GatewayConnectionStatsHistory {
+	this := &GatewayConnectionStatsHistory{}
+	v27 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Resolution = *v27
+	if r.Intn(5) != 0 {
+		v28 := r.Intn(5)
+		this.Samples = make([]*GatewayConnectionStatsSample, v28)
+		for i := 0; i < v28; i++ {
+			this.Samples[i] = NewPopulatedGatewayConnectionStatsSample(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayAlert(r randyGatewayserver, easy bool) *GatewayAlert {
+	this := &GatewayAlert{}
+	v29 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v29
+	this.Type = GatewayAlertType([]int32{0, 1, 2, 3}[r.Intn(4)])
+	v30 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.RaisedAt = *v30
+	if r.Intn(5) != 0 {
+		this.ResolvedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v31 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Threshold = *v31
+	v32 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Value = *v32
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListGatewayAlertsRequest(r randyGatewayserver, easy bool) *ListGatewayAlertsRequest {
+	this := &ListGatewayAlertsRequest{}
+	v33 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v33
+	this.IncludeResolved = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayAlerts(r randyGatewayserver, easy bool) *GatewayAlerts {
+	this := &GatewayAlerts{}
+	if r.Intn(5) != 0 {
+		v34 := r.Intn(5)
+		this.Alerts = make([]*GatewayAlert, v34)
+		for i := 0; i < v34; i++ {
+			this.Alerts[i] = NewPopulatedGatewayAlert(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringGatewayserver(r randyGatewayserver) string {
+	v35 := r.Intn(100)
+	tmps := make([]rune, v35)
+	for i := 0; i < v35; i++ {
+		tmps[i] = randUTF8RuneGatewayserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		v36 := r.Int63()
+		if r.Intn(2) == 0 {
+			v36 *= -1
+		}
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v36))
+	case 1:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GatewayUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UplinkMessages) > 0 {
+		for _, e := range m.UplinkMessages {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.DownlinkPath != nil {
+		l = m.DownlinkPath.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Rx1 {
+		n += 2
+	}
+	if m.Rx2 {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for _, e := range m.PathErrors {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RunGatewayCommandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, s := range m.Arguments {
+			l = len(s)
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayRemoteShellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayRemoteShellOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleProprietaryDownlinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.RawPayload)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayCaptureRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.RawUp)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.RawDown)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.UplinkMessage != nil {
+		l = m.UplinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *StartGatewayCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Duration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *StopGatewayCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *GatewayCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.CaptureID)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StopsAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *DownloadGatewayCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.CaptureID)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *GatewayCaptureData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Samples))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(m.UplinkCount)
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(m.DownlinkCount)
+	}
+	if m.RoundTripTimes != nil {
+		l = m.RoundTripTimes.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.LastStatus != nil {
+		l = m.LastStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.LastStatusReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsSample_RoundTripTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Resolution != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Resolution)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayConnectionStatsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RaisedAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.ResolvedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResolvedAt)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Threshold)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Value)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *ListGatewayAlertsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.IncludeResolved {
+		n += 2
+	}
+	return n
+}
+
+func (m *GatewayAlerts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Alerts) > 0 {
+		for _, e := range m.Alerts {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GatewayUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
+	for _, f := range this.UplinkMessages {
+		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForUplinkMessages += "}"
+	s := strings.Join([]string{`&GatewayUp{`,
+		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayDown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDown{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinkPath:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPath), "DownlinkPath", "DownlinkPath", 1) + `,`,
+		`Rx1:` + fmt.Sprintf("%v", this.Rx1) + `,`,
+		`Rx2:` + fmt.Sprintf("%v", this.Rx2) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkErrorDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPathErrors := "[]*ErrorDetails{"
+	for _, f := range this.PathErrors {
+		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForPathErrors += "}"
+	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
+		`PathErrors:` + repeatedStringForPathErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunGatewayCommandRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunGatewayCommandRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayRemoteShellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayRemoteShellRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayRemoteShellOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayRemoteShellOutput{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleProprietaryDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleProprietaryDownlinkRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "TxRequest", "TxRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayCaptureRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayCaptureRecord{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`RawUp:` + fmt.Sprintf("%v", this.RawUp) + `,`,
+		`RawDown:` + fmt.Sprintf("%v", this.RawDown) + `,`,
+		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "UplinkMessage", "UplinkMessage", 1) + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartGatewayCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartGatewayCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopGatewayCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopGatewayCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayCapture) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayCapture{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CaptureID:` + fmt.Sprintf("%v", this.CaptureID) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`StopsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StopsAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownloadGatewayCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownloadGatewayCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CaptureID:` + fmt.Sprintf("%v", this.CaptureID) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayCaptureData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayCaptureData{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsSample) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubBands := "[]*GatewayConnectionStats_SubBand{"
+	for _, f := range this.SubBands {
+		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + ","
+	}
+	repeatedStringForSubBands += "}"
+	s := strings.Join([]string{`&GatewayConnectionStatsSample{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Samples:` + fmt.Sprintf("%v", this.Samples) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStatsSample_RoundTripTimes", "GatewayConnectionStatsSample_RoundTripTimes", 1) + `,`,
+		`SubBands:` + repeatedStringForSubBands + `,`,
+		`LastStatus:` + strings.Replace(fmt.Sprintf("%v", this.LastStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`LastStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsSample_RoundTripTimes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionStatsSample_RoundTripTimes{`,
+		`Median:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`P90:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.P90), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Max:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Max), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayConnectionStatsHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayConnectionStatsHistoryRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Resolution:` + strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStatsHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSamples := "[]*GatewayConnectionStatsSample{"
+	for _, f := range this.Samples {
+		repeatedStringForSamples += strings.Replace(f.String(), "GatewayConnectionStatsSample", "GatewayConnectionStatsSample", 1) + ","
+	}
+	repeatedStringForSamples += "}"
+	s := strings.Join([]string{`&GatewayConnectionStatsHistory{`,
+		`Resolution:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Samples:` + repeatedStringForSamples + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAlert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAlert{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`RaisedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RaisedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ResolvedAt:` + strings.Replace(fmt.Sprintf("%v", this.ResolvedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Threshold:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Threshold), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Value:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Value), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListGatewayAlertsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListGatewayAlertsRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`IncludeResolved:` + fmt.Sprintf("%v", this.IncludeResolved) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAlerts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAlerts := "[]*GatewayAlert{"
+	for _, f := range this.Alerts {
+		repeatedStringForAlerts += strings.Replace(f.String(), "GatewayAlert", "GatewayAlert", 1) + ","
+	}
+	repeatedStringForAlerts += "}"
+	s := strings.Join([]string{`&GatewayAlerts{`,
+		`Alerts:` + repeatedStringForAlerts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkPath == nil {
+				m.DownlinkPath = &DownlinkPath{}
+			}
+			if err := m.DownlinkPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rx1 = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rx2 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunGatewayCommandRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunGatewayCommandRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunGatewayCommandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayRemoteShellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayRemoteShellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayRemoteShellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayRemoteShellOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayRemoteShellOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayRemoteShellOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleProprietaryDownlinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleProprietaryDownlinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleProprietaryDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPayload = append(m.RawPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawPayload == nil {
+				m.RawPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &TxRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCaptureRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCaptureRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCaptureRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawUp", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawUp = append(m.RawUp[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawUp == nil {
+				m.RawUp = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawDown", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawDown = append(m.RawDown[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawDown == nil {
+				m.RawDown = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &UplinkMessage{}
+			}
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartGatewayCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartGatewayCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartGatewayCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopGatewayCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopGatewayCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopGatewayCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCapture) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCapture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCapture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptureID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaptureID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StopsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownloadGatewayCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownloadGatewayCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownloadGatewayCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptureID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaptureID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= GatewayCaptureFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCaptureData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCaptureData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCaptureData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionStatsSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayConnectionStatsSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayConnectionStatsSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTripTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoundTripTimes == nil {
+				m.RoundTripTimes = &GatewayConnectionStatsSample_RoundTripTimes{}
+			}
+			if err := m.RoundTripTimes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayConnectionStats_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStatus == nil {
+				m.LastStatus = &GatewayStatus{}
+			}
+			if err := m.LastStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStatusReceivedAt == nil {
+				m.LastStatusReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGatewayserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GatewayUp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
-	for _, f := range this.UplinkMessages {
-		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
-	}
-	repeatedStringForUplinkMessages += "}"
-	s := strings.Join([]string{`&GatewayUp{`,
-		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
-		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
-		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GatewayDown) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GatewayDown{`,
-		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleDownlinkResponse) String() string {
-	if this == nil {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
-		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
-		`DownlinkPath:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPath), "DownlinkPath", "DownlinkPath", 1) + `,`,
-		`Rx1:` + fmt.Sprintf("%v", this.Rx1) + `,`,
-		`Rx2:` + fmt.Sprintf("%v", this.Rx2) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *ScheduleDownlinkErrorDetails) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPathErrors := "[]*ErrorDetails{"
-	for _, f := range this.PathErrors {
-		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+func (m *GatewayConnectionStatsSample_RoundTripTimes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundTripTimes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundTripTimes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Median, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P90, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Max, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForPathErrors += "}"
-	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
-		`PathErrors:` + repeatedStringForPathErrors + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GetGatewayConnectionStatsHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,15 +7785,48 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGatewayConnectionStatsHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGatewayConnectionStatsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1391,14 +7853,16 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.From == nil {
+				m.From = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1425,16 +7889,16 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
+			if m.To == nil {
+				m.To = new(time.Time)
 			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1461,10 +7925,10 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
+			if m.Resolution == nil {
+				m.Resolution = new(time.Duration)
 			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Resolution, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1492,7 +7956,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+func (m *GatewayConnectionStatsHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,15 +7979,15 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayConnectionStatsHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayConnectionStatsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1550,10 +8014,41 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Resolution, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, &GatewayConnectionStatsSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1581,7 +8076,7 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *GatewayAlert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,15 +8099,15 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayAlert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayAlert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1639,13 +8134,32 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= GatewayAlertType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaisedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1672,18 +8186,51 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkPath == nil {
-				m.DownlinkPath = &DownlinkPath{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RaisedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.DownlinkPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedAt == nil {
+				m.ResolvedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ResolvedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1693,15 +8240,147 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Rx1 = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Threshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Value, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListGatewayAlertsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListGatewayAlertsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListGatewayAlertsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeResolved", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1718,7 +8397,7 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Rx2 = bool(v != 0)
+			m.IncludeResolved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGatewayserver(dAtA[iNdEx:])
@@ -1743,7 +8422,7 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+func (m *GatewayAlerts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1766,15 +8445,15 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayAlerts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayAlerts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1801,8 +8480,8 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alerts = append(m.Alerts, &GatewayAlert{})
+			if err := m.Alerts[len(m.Alerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
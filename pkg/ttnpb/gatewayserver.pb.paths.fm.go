@@ -229,3 +229,495 @@ var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
 	"path_errors",
 }
+var RunGatewayCommandRequestFieldPathsNested = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var RunGatewayCommandRequestFieldPathsTopLevel = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+}
+var GatewayRemoteShellRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"input",
+	"term",
+	"timeout",
+	"user",
+}
+
+var GatewayRemoteShellRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"input",
+	"term",
+	"timeout",
+	"user",
+}
+var GatewayRemoteShellOutputFieldPathsNested = []string{
+	"data",
+}
+
+var GatewayRemoteShellOutputFieldPathsTopLevel = []string{
+	"data",
+}
+var ScheduleProprietaryDownlinkRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"raw_payload",
+	"request",
+	"request.absolute_time",
+	"request.advanced",
+	"request.class",
+	"request.downlink_paths",
+	"request.frequency_plan_id",
+	"request.priority",
+	"request.rx1_data_rate_index",
+	"request.rx1_delay",
+	"request.rx1_frequency",
+	"request.rx2_data_rate_index",
+	"request.rx2_frequency",
+}
+
+var ScheduleProprietaryDownlinkRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"raw_payload",
+	"request",
+}
+var GatewayCaptureRecordFieldPathsNested = []string{
+	"downlink_message",
+	"downlink_message.correlation_ids",
+	"downlink_message.end_device_ids",
+	"downlink_message.end_device_ids.application_ids",
+	"downlink_message.end_device_ids.application_ids.application_id",
+	"downlink_message.end_device_ids.dev_addr",
+	"downlink_message.end_device_ids.dev_eui",
+	"downlink_message.end_device_ids.device_id",
+	"downlink_message.end_device_ids.join_eui",
+	"downlink_message.payload",
+	"downlink_message.payload.Payload",
+	"downlink_message.payload.Payload.join_accept_payload",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"downlink_message.payload.Payload.join_accept_payload.net_id",
+	"downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"downlink_message.payload.Payload.join_request_payload",
+	"downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"downlink_message.payload.Payload.join_request_payload.join_eui",
+	"downlink_message.payload.Payload.mac_payload",
+	"downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"downlink_message.payload.Payload.mac_payload.f_hdr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"downlink_message.payload.Payload.mac_payload.f_port",
+	"downlink_message.payload.Payload.mac_payload.frm_payload",
+	"downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"downlink_message.payload.Payload.rejoin_request_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"downlink_message.payload.m_hdr",
+	"downlink_message.payload.m_hdr.m_type",
+	"downlink_message.payload.m_hdr.major",
+	"downlink_message.payload.mic",
+	"downlink_message.raw_payload",
+	"downlink_message.session_key_id",
+	"downlink_message.settings",
+	"downlink_message.settings.request",
+	"downlink_message.settings.request.absolute_time",
+	"downlink_message.settings.request.advanced",
+	"downlink_message.settings.request.class",
+	"downlink_message.settings.request.downlink_paths",
+	"downlink_message.settings.request.frequency_plan_id",
+	"downlink_message.settings.request.priority",
+	"downlink_message.settings.request.rx1_data_rate_index",
+	"downlink_message.settings.request.rx1_delay",
+	"downlink_message.settings.request.rx1_frequency",
+	"downlink_message.settings.request.rx2_data_rate_index",
+	"downlink_message.settings.request.rx2_frequency",
+	"downlink_message.settings.scheduled",
+	"downlink_message.settings.scheduled.coding_rate",
+	"downlink_message.settings.scheduled.data_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate_index",
+	"downlink_message.settings.scheduled.downlink",
+	"downlink_message.settings.scheduled.downlink.antenna_index",
+	"downlink_message.settings.scheduled.downlink.invert_polarization",
+	"downlink_message.settings.scheduled.downlink.tx_power",
+	"downlink_message.settings.scheduled.enable_crc",
+	"downlink_message.settings.scheduled.frequency",
+	"downlink_message.settings.scheduled.time",
+	"downlink_message.settings.scheduled.timestamp",
+	"gateway_status",
+	"gateway_status.advanced",
+	"gateway_status.antenna_locations",
+	"gateway_status.boot_time",
+	"gateway_status.ip",
+	"gateway_status.metrics",
+	"gateway_status.time",
+	"gateway_status.versions",
+	"raw_down",
+	"raw_up",
+	"time",
+	"tx_acknowledgment",
+	"tx_acknowledgment.correlation_ids",
+	"tx_acknowledgment.downlink_message",
+	"tx_acknowledgment.downlink_message.correlation_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"tx_acknowledgment.downlink_message.payload",
+	"tx_acknowledgment.downlink_message.payload.Payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"tx_acknowledgment.downlink_message.payload.mic",
+	"tx_acknowledgment.downlink_message.raw_payload",
+	"tx_acknowledgment.downlink_message.session_key_id",
+	"tx_acknowledgment.downlink_message.settings",
+	"tx_acknowledgment.downlink_message.settings.request",
+	"tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"tx_acknowledgment.downlink_message.settings.request.advanced",
+	"tx_acknowledgment.downlink_message.settings.request.class",
+	"tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"tx_acknowledgment.downlink_message.settings.request.priority",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled",
+	"tx_acknowledgment.downlink_message.settings.scheduled.coding_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
+	"tx_acknowledgment.result",
+	"uplink_message",
+	"uplink_message.consumed_airtime",
+	"uplink_message.correlation_ids",
+	"uplink_message.device_channel_index",
+	"uplink_message.payload",
+	"uplink_message.payload.Payload",
+	"uplink_message.payload.Payload.join_accept_payload",
+	"uplink_message.payload.Payload.join_accept_payload.cf_list",
+	"uplink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"uplink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"uplink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"uplink_message.payload.Payload.join_accept_payload.dev_addr",
+	"uplink_message.payload.Payload.join_accept_payload.dl_settings",
+	"uplink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"uplink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"uplink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"uplink_message.payload.Payload.join_accept_payload.encrypted",
+	"uplink_message.payload.Payload.join_accept_payload.join_nonce",
+	"uplink_message.payload.Payload.join_accept_payload.net_id",
+	"uplink_message.payload.Payload.join_accept_payload.rx_delay",
+	"uplink_message.payload.Payload.join_request_payload",
+	"uplink_message.payload.Payload.join_request_payload.dev_eui",
+	"uplink_message.payload.Payload.join_request_payload.dev_nonce",
+	"uplink_message.payload.Payload.join_request_payload.join_eui",
+	"uplink_message.payload.Payload.mac_payload",
+	"uplink_message.payload.Payload.mac_payload.decoded_payload",
+	"uplink_message.payload.Payload.mac_payload.f_hdr",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"uplink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"uplink_message.payload.Payload.mac_payload.f_port",
+	"uplink_message.payload.Payload.mac_payload.frm_payload",
+	"uplink_message.payload.Payload.mac_payload.full_f_cnt",
+	"uplink_message.payload.Payload.rejoin_request_payload",
+	"uplink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"uplink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"uplink_message.payload.Payload.rejoin_request_payload.net_id",
+	"uplink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"uplink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"uplink_message.payload.m_hdr",
+	"uplink_message.payload.m_hdr.m_type",
+	"uplink_message.payload.m_hdr.major",
+	"uplink_message.payload.mic",
+	"uplink_message.raw_payload",
+	"uplink_message.received_at",
+	"uplink_message.rx_metadata",
+	"uplink_message.settings",
+	"uplink_message.settings.coding_rate",
+	"uplink_message.settings.data_rate",
+	"uplink_message.settings.data_rate.modulation",
+	"uplink_message.settings.data_rate.modulation.fsk",
+	"uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"uplink_message.settings.data_rate.modulation.lora",
+	"uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"uplink_message.settings.data_rate_index",
+	"uplink_message.settings.downlink",
+	"uplink_message.settings.downlink.antenna_index",
+	"uplink_message.settings.downlink.invert_polarization",
+	"uplink_message.settings.downlink.tx_power",
+	"uplink_message.settings.enable_crc",
+	"uplink_message.settings.frequency",
+	"uplink_message.settings.time",
+	"uplink_message.settings.timestamp",
+}
+
+var GatewayCaptureRecordFieldPathsTopLevel = []string{
+	"downlink_message",
+	"gateway_status",
+	"raw_down",
+	"raw_up",
+	"time",
+	"tx_acknowledgment",
+	"uplink_message",
+}
+var StartGatewayCaptureRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var StartGatewayCaptureRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+}
+var StopGatewayCaptureRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var StopGatewayCaptureRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+}
+var GatewayCaptureFieldPathsNested = []string{
+	"capture_id",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"started_at",
+	"stops_at",
+}
+
+var GatewayCaptureFieldPathsTopLevel = []string{
+	"capture_id",
+	"gateway_ids",
+	"started_at",
+	"stops_at",
+}
+var DownloadGatewayCaptureRequestFieldPathsNested = []string{
+	"capture_id",
+	"format",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var DownloadGatewayCaptureRequestFieldPathsTopLevel = []string{
+	"capture_id",
+	"format",
+	"gateway_ids",
+}
+var GatewayCaptureDataFieldPathsNested = []string{
+	"data",
+}
+
+var GatewayCaptureDataFieldPathsTopLevel = []string{
+	"data",
+}
+var GatewayConnectionStatsSample_RoundTripTimesFieldPathsNested = []string{
+	"count",
+	"max",
+	"median",
+	"p90",
+}
+
+var GatewayConnectionStatsSample_RoundTripTimesFieldPathsTopLevel = []string{
+	"count",
+	"max",
+	"median",
+	"p90",
+}
+var GatewayConnectionStatsSampleFieldPathsNested = []string{
+	"downlink_count",
+	"last_status",
+	"last_status.advanced",
+	"last_status.antenna_locations",
+	"last_status.boot_time",
+	"last_status.ip",
+	"last_status.metrics",
+	"last_status.time",
+	"last_status.versions",
+	"last_status_received_at",
+	"round_trip_times",
+	"round_trip_times.count",
+	"round_trip_times.max",
+	"round_trip_times.median",
+	"round_trip_times.p90",
+	"samples",
+	"sub_bands",
+	"time",
+	"uplink_count",
+}
+
+var GatewayConnectionStatsSampleFieldPathsTopLevel = []string{
+	"downlink_count",
+	"last_status",
+	"last_status_received_at",
+	"round_trip_times",
+	"samples",
+	"sub_bands",
+	"time",
+	"uplink_count",
+}
+var GetGatewayConnectionStatsHistoryRequestFieldPathsNested = []string{
+	"from",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"resolution",
+	"to",
+}
+
+var GetGatewayConnectionStatsHistoryRequestFieldPathsTopLevel = []string{
+	"from",
+	"gateway_ids",
+	"resolution",
+	"to",
+}
+var GatewayConnectionStatsHistoryFieldPathsNested = []string{
+	"resolution",
+	"samples",
+}
+
+var GatewayConnectionStatsHistoryFieldPathsTopLevel = []string{
+	"resolution",
+	"samples",
+}
+var GatewayAlertFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"raised_at",
+	"resolved_at",
+	"threshold",
+	"type",
+	"value",
+}
+
+var GatewayAlertFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"raised_at",
+	"resolved_at",
+	"threshold",
+	"type",
+	"value",
+}
+var ListGatewayAlertsRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"include_resolved",
+}
+
+var ListGatewayAlertsRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"include_resolved",
+}
+var GatewayAlertsFieldPathsNested = []string{
+	"alerts",
+}
+
+var GatewayAlertsFieldPathsTopLevel = []string{
+	"alerts",
+}
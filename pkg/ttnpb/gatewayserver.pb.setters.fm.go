@@ -198,3 +198,928 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 	}
 	return nil
 }
+
+func (dst *RunGatewayCommandRequest) SetFields(src *RunGatewayCommandRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "command":
+			if len(subs) > 0 {
+				return fmt.Errorf("'command' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Command = src.Command
+			} else {
+				var zero string
+				dst.Command = zero
+			}
+		case "arguments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'arguments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Arguments = src.Arguments
+			} else {
+				dst.Arguments = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellRequest) SetFields(src *GatewayRemoteShellRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "user":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.User = src.User
+			} else {
+				var zero string
+				dst.User = zero
+			}
+		case "term":
+			if len(subs) > 0 {
+				return fmt.Errorf("'term' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Term = src.Term
+			} else {
+				var zero string
+				dst.Term = zero
+			}
+		case "input":
+			if len(subs) > 0 {
+				return fmt.Errorf("'input' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Input = src.Input
+			} else {
+				dst.Input = nil
+			}
+		case "timeout":
+			if len(subs) > 0 {
+				return fmt.Errorf("'timeout' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Timeout = src.Timeout
+			} else {
+				dst.Timeout = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellOutput) SetFields(src *GatewayRemoteShellOutput, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ScheduleProprietaryDownlinkRequest) SetFields(src *ScheduleProprietaryDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "raw_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawPayload = src.RawPayload
+			} else {
+				dst.RawPayload = nil
+			}
+		case "request":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxRequest
+				if (src == nil || src.Request == nil) && dst.Request == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Request
+				}
+				if dst.Request != nil {
+					newDst = dst.Request
+				} else {
+					newDst = &TxRequest{}
+					dst.Request = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Request = src.Request
+				} else {
+					dst.Request = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayCaptureRecord) SetFields(src *GatewayCaptureRecord, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "raw_up":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_up' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawUp = src.RawUp
+			} else {
+				dst.RawUp = nil
+			}
+		case "raw_down":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_down' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawDown = src.RawDown
+			} else {
+				dst.RawDown = nil
+			}
+		case "uplink_message":
+			if len(subs) > 0 {
+				var newDst, newSrc *UplinkMessage
+				if (src == nil || src.UplinkMessage == nil) && dst.UplinkMessage == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UplinkMessage
+				}
+				if dst.UplinkMessage != nil {
+					newDst = dst.UplinkMessage
+				} else {
+					newDst = &UplinkMessage{}
+					dst.UplinkMessage = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UplinkMessage = src.UplinkMessage
+				} else {
+					dst.UplinkMessage = nil
+				}
+			}
+		case "gateway_status":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayStatus
+				if (src == nil || src.GatewayStatus == nil) && dst.GatewayStatus == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayStatus
+				}
+				if dst.GatewayStatus != nil {
+					newDst = dst.GatewayStatus
+				} else {
+					newDst = &GatewayStatus{}
+					dst.GatewayStatus = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayStatus = src.GatewayStatus
+				} else {
+					dst.GatewayStatus = nil
+				}
+			}
+		case "tx_acknowledgment":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxAcknowledgment
+				if (src == nil || src.TxAcknowledgment == nil) && dst.TxAcknowledgment == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TxAcknowledgment
+				}
+				if dst.TxAcknowledgment != nil {
+					newDst = dst.TxAcknowledgment
+				} else {
+					newDst = &TxAcknowledgment{}
+					dst.TxAcknowledgment = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TxAcknowledgment = src.TxAcknowledgment
+				} else {
+					dst.TxAcknowledgment = nil
+				}
+			}
+		case "downlink_message":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkMessage
+				if (src == nil || src.DownlinkMessage == nil) && dst.DownlinkMessage == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkMessage
+				}
+				if dst.DownlinkMessage != nil {
+					newDst = dst.DownlinkMessage
+				} else {
+					newDst = &DownlinkMessage{}
+					dst.DownlinkMessage = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkMessage = src.DownlinkMessage
+				} else {
+					dst.DownlinkMessage = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StartGatewayCaptureRequest) SetFields(src *StartGatewayCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StopGatewayCaptureRequest) SetFields(src *StopGatewayCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayCapture) SetFields(src *GatewayCapture, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "capture_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'capture_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CaptureID = src.CaptureID
+			} else {
+				var zero string
+				dst.CaptureID = zero
+			}
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				var zero time.Time
+				dst.StartedAt = zero
+			}
+		case "stops_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stops_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StopsAt = src.StopsAt
+			} else {
+				var zero time.Time
+				dst.StopsAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownloadGatewayCaptureRequest) SetFields(src *DownloadGatewayCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "capture_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'capture_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CaptureID = src.CaptureID
+			} else {
+				var zero string
+				dst.CaptureID = zero
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero GatewayCaptureFormat
+				dst.Format = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayCaptureData) SetFields(src *GatewayCaptureData, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsSample_RoundTripTimes) SetFields(src *GatewayConnectionStatsSample_RoundTripTimes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				var zero time.Duration
+				dst.Median = zero
+			}
+		case "p90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P90 = src.P90
+			} else {
+				var zero time.Duration
+				dst.P90 = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero time.Duration
+				dst.Max = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsSample) SetFields(src *GatewayConnectionStatsSample, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "samples":
+			if len(subs) > 0 {
+				return fmt.Errorf("'samples' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Samples = src.Samples
+			} else {
+				var zero uint32
+				dst.Samples = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStatsSample_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayConnectionStatsSample_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "last_status":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayStatus
+				if (src == nil || src.LastStatus == nil) && dst.LastStatus == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastStatus
+				}
+				if dst.LastStatus != nil {
+					newDst = dst.LastStatus
+				} else {
+					newDst = &GatewayStatus{}
+					dst.LastStatus = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastStatus = src.LastStatus
+				} else {
+					dst.LastStatus = nil
+				}
+			}
+		case "last_status_received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_status_received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastStatusReceivedAt = src.LastStatusReceivedAt
+			} else {
+				dst.LastStatusReceivedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConnectionStatsHistoryRequest) SetFields(src *GetGatewayConnectionStatsHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "from":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.From = src.From
+			} else {
+				dst.From = nil
+			}
+		case "to":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.To = src.To
+			} else {
+				dst.To = nil
+			}
+		case "resolution":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resolution' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Resolution = src.Resolution
+			} else {
+				dst.Resolution = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsHistory) SetFields(src *GatewayConnectionStatsHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "resolution":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resolution' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Resolution = src.Resolution
+			} else {
+				var zero time.Duration
+				dst.Resolution = zero
+			}
+		case "samples":
+			if len(subs) > 0 {
+				return fmt.Errorf("'samples' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Samples = src.Samples
+			} else {
+				dst.Samples = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlert) SetFields(src *GatewayAlert, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero GatewayAlertType
+				dst.Type = zero
+			}
+		case "raised_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raised_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RaisedAt = src.RaisedAt
+			} else {
+				var zero time.Time
+				dst.RaisedAt = zero
+			}
+		case "resolved_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resolved_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResolvedAt = src.ResolvedAt
+			} else {
+				dst.ResolvedAt = nil
+			}
+		case "threshold":
+			if len(subs) > 0 {
+				return fmt.Errorf("'threshold' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Threshold = src.Threshold
+			} else {
+				var zero time.Duration
+				dst.Threshold = zero
+			}
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero time.Duration
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListGatewayAlertsRequest) SetFields(src *ListGatewayAlertsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "include_resolved":
+			if len(subs) > 0 {
+				return fmt.Errorf("'include_resolved' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IncludeResolved = src.IncludeResolved
+			} else {
+				var zero bool
+				dst.IncludeResolved = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayAlerts) SetFields(src *GatewayAlerts, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "alerts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'alerts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Alerts = src.Alerts
+			} else {
+				dst.Alerts = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -444,3 +444,1997 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on RunGatewayCommandRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RunGatewayCommandRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RunGatewayCommandRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RunGatewayCommandRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "command":
+
+			if l := utf8.RuneCountInString(m.GetCommand()); l < 1 || l > 1024 {
+				return RunGatewayCommandRequestValidationError{
+					field:  "command",
+					reason: "value length must be between 1 and 1024 runes, inclusive",
+				}
+			}
+
+		case "arguments":
+
+			if len(m.GetArguments()) > 64 {
+				return RunGatewayCommandRequestValidationError{
+					field:  "arguments",
+					reason: "value must contain no more than 64 item(s)",
+				}
+			}
+
+		default:
+			return RunGatewayCommandRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RunGatewayCommandRequestValidationError is the validation error returned by
+// RunGatewayCommandRequest.ValidateFields if the designated constraints
+// aren't met.
+type RunGatewayCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunGatewayCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunGatewayCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunGatewayCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunGatewayCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunGatewayCommandRequestValidationError) ErrorName() string {
+	return "RunGatewayCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RunGatewayCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunGatewayCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunGatewayCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunGatewayCommandRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteShellRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "user":
+
+			if utf8.RuneCountInString(m.GetUser()) > 64 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "user",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "term":
+
+			if utf8.RuneCountInString(m.GetTerm()) > 64 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "term",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "input":
+
+			if len(m.GetInput()) > 4096 {
+				return GatewayRemoteShellRequestValidationError{
+					field:  "input",
+					reason: "value length must be at most 4096 bytes",
+				}
+			}
+
+		case "timeout":
+
+			if v, ok := interface{}(m.GetTimeout()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteShellRequestValidationError{
+						field:  "timeout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayRemoteShellRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequestValidationError is the validation error returned by
+// GatewayRemoteShellRequest.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellRequestValidationError) ErrorName() string {
+	return "GatewayRemoteShellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellOutput) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellOutputFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayRemoteShellOutputValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellOutputValidationError is the validation error returned by
+// GatewayRemoteShellOutput.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellOutputValidationError) ErrorName() string {
+	return "GatewayRemoteShellOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellOutputValidationError{}
+
+// ValidateFields checks the field values on ScheduleProprietaryDownlinkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ScheduleProprietaryDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ScheduleProprietaryDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ScheduleProprietaryDownlinkRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "raw_payload":
+
+			if l := len(m.GetRawPayload()); l < 1 || l > 256 {
+				return ScheduleProprietaryDownlinkRequestValidationError{
+					field:  "raw_payload",
+					reason: "value length must be between 1 and 256 bytes, inclusive",
+				}
+			}
+
+		case "request":
+
+			if m.GetRequest() == nil {
+				return ScheduleProprietaryDownlinkRequestValidationError{
+					field:  "request",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRequest()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ScheduleProprietaryDownlinkRequestValidationError{
+						field:  "request",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ScheduleProprietaryDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ScheduleProprietaryDownlinkRequestValidationError is the validation error
+// returned by ScheduleProprietaryDownlinkRequest.ValidateFields if the
+// designated constraints aren't met.
+type ScheduleProprietaryDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleProprietaryDownlinkRequestValidationError) ErrorName() string {
+	return "ScheduleProprietaryDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleProprietaryDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleProprietaryDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleProprietaryDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleProprietaryDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayCaptureRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayCaptureRecord) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCaptureRecordFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureRecordValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "raw_up":
+			// no validation rules for RawUp
+		case "raw_down":
+			// no validation rules for RawDown
+		case "uplink_message":
+
+			if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureRecordValidationError{
+						field:  "uplink_message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "gateway_status":
+
+			if v, ok := interface{}(m.GetGatewayStatus()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureRecordValidationError{
+						field:  "gateway_status",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "tx_acknowledgment":
+
+			if v, ok := interface{}(m.GetTxAcknowledgment()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureRecordValidationError{
+						field:  "tx_acknowledgment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_message":
+
+			if v, ok := interface{}(m.GetDownlinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureRecordValidationError{
+						field:  "downlink_message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayCaptureRecordValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCaptureRecordValidationError is the validation error returned by
+// GatewayCaptureRecord.ValidateFields if the designated constraints aren't met.
+type GatewayCaptureRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCaptureRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCaptureRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCaptureRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCaptureRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCaptureRecordValidationError) ErrorName() string {
+	return "GatewayCaptureRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCaptureRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCaptureRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCaptureRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCaptureRecordValidationError{}
+
+// ValidateFields checks the field values on StartGatewayCaptureRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *StartGatewayCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StartGatewayCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartGatewayCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if v, ok := interface{}(m.GetDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartGatewayCaptureRequestValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return StartGatewayCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StartGatewayCaptureRequestValidationError is the validation error returned
+// by StartGatewayCaptureRequest.ValidateFields if the designated constraints
+// aren't met.
+type StartGatewayCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartGatewayCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartGatewayCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartGatewayCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartGatewayCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartGatewayCaptureRequestValidationError) ErrorName() string {
+	return "StartGatewayCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartGatewayCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartGatewayCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartGatewayCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartGatewayCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on StopGatewayCaptureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StopGatewayCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StopGatewayCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StopGatewayCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return StopGatewayCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StopGatewayCaptureRequestValidationError is the validation error returned by
+// StopGatewayCaptureRequest.ValidateFields if the designated constraints
+// aren't met.
+type StopGatewayCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopGatewayCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopGatewayCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopGatewayCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopGatewayCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopGatewayCaptureRequestValidationError) ErrorName() string {
+	return "StopGatewayCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StopGatewayCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopGatewayCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopGatewayCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopGatewayCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayCapture with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayCapture) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCaptureFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "capture_id":
+
+			if utf8.RuneCountInString(m.GetCaptureID()) > 36 {
+				return GatewayCaptureValidationError{
+					field:  "capture_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayCapture_CaptureID_Pattern.MatchString(m.GetCaptureID()) {
+				return GatewayCaptureValidationError{
+					field:  "capture_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "started_at":
+
+			if v, ok := interface{}(&m.StartedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "stops_at":
+
+			if v, ok := interface{}(&m.StopsAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayCaptureValidationError{
+						field:  "stops_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayCaptureValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCaptureValidationError is the validation error returned by
+// GatewayCapture.ValidateFields if the designated constraints aren't met.
+type GatewayCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCaptureValidationError) ErrorName() string {
+	return "GatewayCaptureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCaptureValidationError{}
+
+var _GatewayCapture_CaptureID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on DownloadGatewayCaptureRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DownloadGatewayCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownloadGatewayCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownloadGatewayCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "capture_id":
+
+			if utf8.RuneCountInString(m.GetCaptureID()) > 36 {
+				return DownloadGatewayCaptureRequestValidationError{
+					field:  "capture_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DownloadGatewayCaptureRequest_CaptureID_Pattern.MatchString(m.GetCaptureID()) {
+				return DownloadGatewayCaptureRequestValidationError{
+					field:  "capture_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "format":
+
+			if _, ok := GatewayCaptureFormat_name[int32(m.GetFormat())]; !ok {
+				return DownloadGatewayCaptureRequestValidationError{
+					field:  "format",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return DownloadGatewayCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownloadGatewayCaptureRequestValidationError is the validation error
+// returned by DownloadGatewayCaptureRequest.ValidateFields if the designated
+// constraints aren't met.
+type DownloadGatewayCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadGatewayCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadGatewayCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadGatewayCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadGatewayCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadGatewayCaptureRequestValidationError) ErrorName() string {
+	return "DownloadGatewayCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadGatewayCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadGatewayCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadGatewayCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadGatewayCaptureRequestValidationError{}
+
+var _DownloadGatewayCaptureRequest_CaptureID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on GatewayCaptureData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayCaptureData) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayCaptureDataFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayCaptureDataValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayCaptureDataValidationError is the validation error returned by
+// GatewayCaptureData.ValidateFields if the designated constraints aren't met.
+type GatewayCaptureDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayCaptureDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayCaptureDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayCaptureDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayCaptureDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayCaptureDataValidationError) ErrorName() string {
+	return "GatewayCaptureDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayCaptureDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayCaptureData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayCaptureDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayCaptureDataValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionStatsSample_RoundTripTimes with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConnectionStatsSample_RoundTripTimes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsSample_RoundTripTimesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "median":
+
+			if v, ok := interface{}(&m.Median).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSample_RoundTripTimesValidationError{
+						field:  "median",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p90":
+
+			if v, ok := interface{}(&m.P90).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSample_RoundTripTimesValidationError{
+						field:  "p90",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max":
+
+			if v, ok := interface{}(&m.Max).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSample_RoundTripTimesValidationError{
+						field:  "max",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "count":
+			// no validation rules for Count
+		default:
+			return GatewayConnectionStatsSample_RoundTripTimesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsSample_RoundTripTimesValidationError is the validation
+// error returned by
+// GatewayConnectionStatsSample_RoundTripTimes.ValidateFields if the
+// designated constraints aren't met.
+type GatewayConnectionStatsSample_RoundTripTimesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) ErrorName() string {
+	return "GatewayConnectionStatsSample_RoundTripTimesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsSample_RoundTripTimesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsSample_RoundTripTimes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsSample_RoundTripTimesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsSample_RoundTripTimesValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsSample with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConnectionStatsSample) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsSampleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSampleValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "samples":
+			// no validation rules for Samples
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSampleValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsSampleValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "last_status":
+
+			if v, ok := interface{}(m.GetLastStatus()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSampleValidationError{
+						field:  "last_status",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_status_received_at":
+
+			if v, ok := interface{}(m.GetLastStatusReceivedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSampleValidationError{
+						field:  "last_status_received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionStatsSampleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsSampleValidationError is the validation error returned
+// by GatewayConnectionStatsSample.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsSampleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsSampleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsSampleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsSampleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsSampleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsSampleValidationError) ErrorName() string {
+	return "GatewayConnectionStatsSampleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsSampleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsSample.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsSampleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsSampleValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConnectionStatsHistoryRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetGatewayConnectionStatsHistoryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConnectionStatsHistoryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "from":
+
+			if v, ok := interface{}(m.GetFrom()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "from",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "to":
+
+			if v, ok := interface{}(m.GetTo()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "to",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "resolution":
+
+			if v, ok := interface{}(m.GetResolution()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "resolution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewayConnectionStatsHistoryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConnectionStatsHistoryRequestValidationError is the validation
+// error returned by GetGatewayConnectionStatsHistoryRequest.ValidateFields if
+// the designated constraints aren't met.
+type GetGatewayConnectionStatsHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) ErrorName() string {
+	return "GetGatewayConnectionStatsHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConnectionStatsHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsHistory with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConnectionStatsHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "resolution":
+
+			if v, ok := interface{}(&m.Resolution).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryValidationError{
+						field:  "resolution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "samples":
+
+			for idx, item := range m.GetSamples() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsHistoryValidationError{
+							field:  fmt.Sprintf("samples[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryValidationError is the validation error
+// returned by GatewayConnectionStatsHistory.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryValidationError{}
+
+// ValidateFields checks the field values on GatewayAlert with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlert) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "type":
+
+			if _, ok := GatewayAlertType_name[int32(m.GetType())]; !ok {
+				return GatewayAlertValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "raised_at":
+
+			if v, ok := interface{}(&m.RaisedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "raised_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "resolved_at":
+
+			if v, ok := interface{}(m.GetResolvedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "resolved_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "threshold":
+
+			if v, ok := interface{}(&m.Threshold).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "threshold",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "value":
+
+			if v, ok := interface{}(&m.Value).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayAlertValidationError{
+						field:  "value",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayAlertValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertValidationError is the validation error returned by
+// GatewayAlert.ValidateFields if the designated constraints aren't met.
+type GatewayAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertValidationError) ErrorName() string {
+	return "GatewayAlertValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertValidationError{}
+
+// ValidateFields checks the field values on ListGatewayAlertsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGatewayAlertsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListGatewayAlertsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListGatewayAlertsRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "include_resolved":
+			// no validation rules for IncludeResolved
+		default:
+			return ListGatewayAlertsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListGatewayAlertsRequestValidationError is the validation error returned by
+// ListGatewayAlertsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListGatewayAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGatewayAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGatewayAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGatewayAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGatewayAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGatewayAlertsRequestValidationError) ErrorName() string {
+	return "ListGatewayAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGatewayAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGatewayAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGatewayAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGatewayAlertsRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayAlerts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayAlerts) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayAlertsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "alerts":
+
+			for idx, item := range m.GetAlerts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayAlertsValidationError{
+							field:  fmt.Sprintf("alerts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayAlertsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayAlertsValidationError is the validation error returned by
+// GatewayAlerts.ValidateFields if the designated constraints aren't met.
+type GatewayAlertsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayAlertsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayAlertsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayAlertsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayAlertsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayAlertsValidationError) ErrorName() string {
+	return "GatewayAlertsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayAlertsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayAlerts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayAlertsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayAlertsValidationError{}
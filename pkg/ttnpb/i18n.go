@@ -86,6 +86,13 @@ func init() {
 	defineEnum(CID_BEACON_TIMING, "beacon timing")
 	defineEnum(CID_BEACON_FREQ, "beacon frequency")
 	defineEnum(CID_DEVICE_MODE, "device mode")
+	defineEnum(CID_RELAY_CONF, "relay configuration")
+	defineEnum(CID_RELAY_END_DEVICE_CONF, "relay end device configuration")
+	defineEnum(CID_RELAY_FILTER_LIST, "relay filter list")
+	defineEnum(CID_RELAY_UPDATE_UPLINK_LIST, "relay update uplink list")
+	defineEnum(CID_RELAY_CTRL_UPLINK_LIST, "relay control uplink list")
+	defineEnum(CID_RELAY_CONFIGURE_FWD_LIMIT, "relay configure forward limit")
+	defineEnum(CID_RELAY_NOTIFY_NEW_END_DEVICE, "relay notify new end device")
 
 	defineEnum(SOURCE_UNKNOWN, "unknown location source")
 	defineEnum(SOURCE_GPS, "determined by GPS")
@@ -148,6 +155,7 @@ func init() {
 	defineEnum(RIGHT_GATEWAY_LOCATION_READ, "view gateway location")
 	defineEnum(RIGHT_GATEWAY_WRITE_SECRETS, "store secrets for a gateway")
 	defineEnum(RIGHT_GATEWAY_READ_SECRETS, "retrieve secrets associated with a gateway")
+	defineEnum(RIGHT_GATEWAY_REMOTE_CONTROL, "run commands and open remote shell sessions on a gateway")
 	defineEnum(RIGHT_GATEWAY_ALL, "all gateway rights")
 
 	defineEnum(RIGHT_ORGANIZATION_INFO, "view organization information")
@@ -147,6 +147,15 @@ func (dst *JoinRequest) SetFields(src *JoinRequest, paths ...string) error {
 			} else {
 				dst.ConsumedAirtime = nil
 			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEui = src.JoinEui
+			} else {
+				dst.JoinEui = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
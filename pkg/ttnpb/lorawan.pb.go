@@ -406,27 +406,34 @@ func (TxSchedulePriority) EnumDescriptor() ([]byte, []int) {
 type MACCommandIdentifier int32
 
 const (
-	CID_RFU_0              MACCommandIdentifier = 0
-	CID_RESET              MACCommandIdentifier = 1
-	CID_LINK_CHECK         MACCommandIdentifier = 2
-	CID_LINK_ADR           MACCommandIdentifier = 3
-	CID_DUTY_CYCLE         MACCommandIdentifier = 4
-	CID_RX_PARAM_SETUP     MACCommandIdentifier = 5
-	CID_DEV_STATUS         MACCommandIdentifier = 6
-	CID_NEW_CHANNEL        MACCommandIdentifier = 7
-	CID_RX_TIMING_SETUP    MACCommandIdentifier = 8
-	CID_TX_PARAM_SETUP     MACCommandIdentifier = 9
-	CID_DL_CHANNEL         MACCommandIdentifier = 10
-	CID_REKEY              MACCommandIdentifier = 11
-	CID_ADR_PARAM_SETUP    MACCommandIdentifier = 12
-	CID_DEVICE_TIME        MACCommandIdentifier = 13
-	CID_FORCE_REJOIN       MACCommandIdentifier = 14
-	CID_REJOIN_PARAM_SETUP MACCommandIdentifier = 15
-	CID_PING_SLOT_INFO     MACCommandIdentifier = 16
-	CID_PING_SLOT_CHANNEL  MACCommandIdentifier = 17
-	CID_BEACON_TIMING      MACCommandIdentifier = 18
-	CID_BEACON_FREQ        MACCommandIdentifier = 19
-	CID_DEVICE_MODE        MACCommandIdentifier = 32
+	CID_RFU_0                       MACCommandIdentifier = 0
+	CID_RESET                       MACCommandIdentifier = 1
+	CID_LINK_CHECK                  MACCommandIdentifier = 2
+	CID_LINK_ADR                    MACCommandIdentifier = 3
+	CID_DUTY_CYCLE                  MACCommandIdentifier = 4
+	CID_RX_PARAM_SETUP              MACCommandIdentifier = 5
+	CID_DEV_STATUS                  MACCommandIdentifier = 6
+	CID_NEW_CHANNEL                 MACCommandIdentifier = 7
+	CID_RX_TIMING_SETUP             MACCommandIdentifier = 8
+	CID_TX_PARAM_SETUP              MACCommandIdentifier = 9
+	CID_DL_CHANNEL                  MACCommandIdentifier = 10
+	CID_REKEY                       MACCommandIdentifier = 11
+	CID_ADR_PARAM_SETUP             MACCommandIdentifier = 12
+	CID_DEVICE_TIME                 MACCommandIdentifier = 13
+	CID_FORCE_REJOIN                MACCommandIdentifier = 14
+	CID_REJOIN_PARAM_SETUP          MACCommandIdentifier = 15
+	CID_PING_SLOT_INFO              MACCommandIdentifier = 16
+	CID_PING_SLOT_CHANNEL           MACCommandIdentifier = 17
+	CID_BEACON_TIMING               MACCommandIdentifier = 18
+	CID_BEACON_FREQ                 MACCommandIdentifier = 19
+	CID_DEVICE_MODE                 MACCommandIdentifier = 32
+	CID_RELAY_CONF                  MACCommandIdentifier = 64
+	CID_RELAY_END_DEVICE_CONF       MACCommandIdentifier = 65
+	CID_RELAY_FILTER_LIST           MACCommandIdentifier = 66
+	CID_RELAY_UPDATE_UPLINK_LIST    MACCommandIdentifier = 67
+	CID_RELAY_CTRL_UPLINK_LIST      MACCommandIdentifier = 68
+	CID_RELAY_CONFIGURE_FWD_LIMIT   MACCommandIdentifier = 69
+	CID_RELAY_NOTIFY_NEW_END_DEVICE MACCommandIdentifier = 70
 )
 
 var MACCommandIdentifier_name = map[int32]string{
@@ -451,30 +458,44 @@ var MACCommandIdentifier_name = map[int32]string{
 	18: "CID_BEACON_TIMING",
 	19: "CID_BEACON_FREQ",
 	32: "CID_DEVICE_MODE",
+	64: "CID_RELAY_CONF",
+	65: "CID_RELAY_END_DEVICE_CONF",
+	66: "CID_RELAY_FILTER_LIST",
+	67: "CID_RELAY_UPDATE_UPLINK_LIST",
+	68: "CID_RELAY_CTRL_UPLINK_LIST",
+	69: "CID_RELAY_CONFIGURE_FWD_LIMIT",
+	70: "CID_RELAY_NOTIFY_NEW_END_DEVICE",
 }
 
 var MACCommandIdentifier_value = map[string]int32{
-	"CID_RFU_0":              0,
-	"CID_RESET":              1,
-	"CID_LINK_CHECK":         2,
-	"CID_LINK_ADR":           3,
-	"CID_DUTY_CYCLE":         4,
-	"CID_RX_PARAM_SETUP":     5,
-	"CID_DEV_STATUS":         6,
-	"CID_NEW_CHANNEL":        7,
-	"CID_RX_TIMING_SETUP":    8,
-	"CID_TX_PARAM_SETUP":     9,
-	"CID_DL_CHANNEL":         10,
-	"CID_REKEY":              11,
-	"CID_ADR_PARAM_SETUP":    12,
-	"CID_DEVICE_TIME":        13,
-	"CID_FORCE_REJOIN":       14,
-	"CID_REJOIN_PARAM_SETUP": 15,
-	"CID_PING_SLOT_INFO":     16,
-	"CID_PING_SLOT_CHANNEL":  17,
-	"CID_BEACON_TIMING":      18,
-	"CID_BEACON_FREQ":        19,
-	"CID_DEVICE_MODE":        32,
+	"CID_RFU_0":                       0,
+	"CID_RESET":                       1,
+	"CID_LINK_CHECK":                  2,
+	"CID_LINK_ADR":                    3,
+	"CID_DUTY_CYCLE":                  4,
+	"CID_RX_PARAM_SETUP":              5,
+	"CID_DEV_STATUS":                  6,
+	"CID_NEW_CHANNEL":                 7,
+	"CID_RX_TIMING_SETUP":             8,
+	"CID_TX_PARAM_SETUP":              9,
+	"CID_DL_CHANNEL":                  10,
+	"CID_REKEY":                       11,
+	"CID_ADR_PARAM_SETUP":             12,
+	"CID_DEVICE_TIME":                 13,
+	"CID_FORCE_REJOIN":                14,
+	"CID_REJOIN_PARAM_SETUP":          15,
+	"CID_PING_SLOT_INFO":              16,
+	"CID_PING_SLOT_CHANNEL":           17,
+	"CID_BEACON_TIMING":               18,
+	"CID_BEACON_FREQ":                 19,
+	"CID_DEVICE_MODE":                 32,
+	"CID_RELAY_CONF":                  64,
+	"CID_RELAY_END_DEVICE_CONF":       65,
+	"CID_RELAY_FILTER_LIST":           66,
+	"CID_RELAY_UPDATE_UPLINK_LIST":    67,
+	"CID_RELAY_CTRL_UPLINK_LIST":      68,
+	"CID_RELAY_CONFIGURE_FWD_LIMIT":   69,
+	"CID_RELAY_NOTIFY_NEW_END_DEVICE": 70,
 }
 
 func (MACCommandIdentifier) EnumDescriptor() ([]byte, []int) {
@@ -2486,6 +2507,17 @@ type MACCommand struct {
 	//	*MACCommand_BeaconFreqAns_
 	//	*MACCommand_DeviceModeInd_
 	//	*MACCommand_DeviceModeConf_
+	//	*MACCommand_RelayConfReq_
+	//	*MACCommand_RelayConfAns_
+	//	*MACCommand_RelayEndDeviceConfReq_
+	//	*MACCommand_RelayEndDeviceConfAns_
+	//	*MACCommand_RelayFilterListReq_
+	//	*MACCommand_RelayFilterListAns_
+	//	*MACCommand_RelayUpdateUplinkListReq_
+	//	*MACCommand_RelayCtrlUplinkListReq_
+	//	*MACCommand_RelayCtrlUplinkListAns_
+	//	*MACCommand_RelayConfigureFwdLimitReq_
+	//	*MACCommand_RelayNotifyNewEndDeviceReq_
 	Payload              isMACCommand_Payload `protobuf_oneof:"payload"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2623,38 +2655,82 @@ type MACCommand_DeviceModeInd_ struct {
 type MACCommand_DeviceModeConf_ struct {
 	DeviceModeConf *MACCommand_DeviceModeConf `protobuf:"bytes,32,opt,name=device_mode_conf,json=deviceModeConf,proto3,oneof" json:"device_mode_conf,omitempty"`
 }
-
-func (*MACCommand_RawPayload) isMACCommand_Payload()           {}
-func (*MACCommand_ResetInd_) isMACCommand_Payload()            {}
-func (*MACCommand_ResetConf_) isMACCommand_Payload()           {}
-func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()        {}
-func (*MACCommand_LinkADRReq_) isMACCommand_Payload()          {}
-func (*MACCommand_LinkADRAns_) isMACCommand_Payload()          {}
-func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()        {}
-func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()     {}
-func (*MACCommand_DevStatusAns_) isMACCommand_Payload()        {}
-func (*MACCommand_NewChannelReq_) isMACCommand_Payload()       {}
-func (*MACCommand_NewChannelAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DLChannelReq_) isMACCommand_Payload()        {}
-func (*MACCommand_DLChannelAns_) isMACCommand_Payload()        {}
-func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()    {}
-func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RekeyInd_) isMACCommand_Payload()            {}
-func (*MACCommand_RekeyConf_) isMACCommand_Payload()           {}
-func (*MACCommand_ADRParamSetupReq_) isMACCommand_Payload()    {}
-func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()       {}
-func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()      {}
-func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload() {}
-func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload() {}
-func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()     {}
-func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()  {}
-func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()  {}
-func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()     {}
-func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()       {}
-func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()      {}
+type MACCommand_RelayConfReq_ struct {
+	RelayConfReq *MACCommand_RelayConfReq `protobuf:"bytes,33,opt,name=relay_conf_req,json=relayConfReq,proto3,oneof" json:"relay_conf_req,omitempty"`
+}
+type MACCommand_RelayConfAns_ struct {
+	RelayConfAns *MACCommand_RelayConfAns `protobuf:"bytes,34,opt,name=relay_conf_ans,json=relayConfAns,proto3,oneof" json:"relay_conf_ans,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfReq_ struct {
+	RelayEndDeviceConfReq *MACCommand_RelayEndDeviceConfReq `protobuf:"bytes,35,opt,name=relay_end_device_conf_req,json=relayEndDeviceConfReq,proto3,oneof" json:"relay_end_device_conf_req,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfAns_ struct {
+	RelayEndDeviceConfAns *MACCommand_RelayEndDeviceConfAns `protobuf:"bytes,36,opt,name=relay_end_device_conf_ans,json=relayEndDeviceConfAns,proto3,oneof" json:"relay_end_device_conf_ans,omitempty"`
+}
+type MACCommand_RelayFilterListReq_ struct {
+	RelayFilterListReq *MACCommand_RelayFilterListReq `protobuf:"bytes,37,opt,name=relay_filter_list_req,json=relayFilterListReq,proto3,oneof" json:"relay_filter_list_req,omitempty"`
+}
+type MACCommand_RelayFilterListAns_ struct {
+	RelayFilterListAns *MACCommand_RelayFilterListAns `protobuf:"bytes,38,opt,name=relay_filter_list_ans,json=relayFilterListAns,proto3,oneof" json:"relay_filter_list_ans,omitempty"`
+}
+type MACCommand_RelayUpdateUplinkListReq_ struct {
+	RelayUpdateUplinkListReq *MACCommand_RelayUpdateUplinkListReq `protobuf:"bytes,39,opt,name=relay_update_uplink_list_req,json=relayUpdateUplinkListReq,proto3,oneof" json:"relay_update_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListReq_ struct {
+	RelayCtrlUplinkListReq *MACCommand_RelayCtrlUplinkListReq `protobuf:"bytes,40,opt,name=relay_ctrl_uplink_list_req,json=relayCtrlUplinkListReq,proto3,oneof" json:"relay_ctrl_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListAns_ struct {
+	RelayCtrlUplinkListAns *MACCommand_RelayCtrlUplinkListAns `protobuf:"bytes,41,opt,name=relay_ctrl_uplink_list_ans,json=relayCtrlUplinkListAns,proto3,oneof" json:"relay_ctrl_uplink_list_ans,omitempty"`
+}
+type MACCommand_RelayConfigureFwdLimitReq_ struct {
+	RelayConfigureFwdLimitReq *MACCommand_RelayConfigureFwdLimitReq `protobuf:"bytes,42,opt,name=relay_configure_fwd_limit_req,json=relayConfigureFwdLimitReq,proto3,oneof" json:"relay_configure_fwd_limit_req,omitempty"`
+}
+type MACCommand_RelayNotifyNewEndDeviceReq_ struct {
+	RelayNotifyNewEndDeviceReq *MACCommand_RelayNotifyNewEndDeviceReq `protobuf:"bytes,43,opt,name=relay_notify_new_end_device_req,json=relayNotifyNewEndDeviceReq,proto3,oneof" json:"relay_notify_new_end_device_req,omitempty"`
+}
+
+func (*MACCommand_RawPayload) isMACCommand_Payload()                  {}
+func (*MACCommand_ResetInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_ResetConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()               {}
+func (*MACCommand_LinkADRReq_) isMACCommand_Payload()                 {}
+func (*MACCommand_LinkADRAns_) isMACCommand_Payload()                 {}
+func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()            {}
+func (*MACCommand_DevStatusAns_) isMACCommand_Payload()               {}
+func (*MACCommand_NewChannelReq_) isMACCommand_Payload()              {}
+func (*MACCommand_NewChannelAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DLChannelReq_) isMACCommand_Payload()               {}
+func (*MACCommand_DLChannelAns_) isMACCommand_Payload()               {}
+func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()           {}
+func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RekeyInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_RekeyConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_ADRParamSetupReq_) isMACCommand_Payload()           {}
+func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()              {}
+func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()             {}
+func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload()        {}
+func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload()        {}
+func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()            {}
+func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()         {}
+func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()         {}
+func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()            {}
+func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()              {}
+func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()             {}
+func (*MACCommand_RelayConfReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayConfAns_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayEndDeviceConfReq_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayEndDeviceConfAns_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayFilterListReq_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayFilterListAns_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayUpdateUplinkListReq_) isMACCommand_Payload()   {}
+func (*MACCommand_RelayCtrlUplinkListReq_) isMACCommand_Payload()     {}
+func (*MACCommand_RelayCtrlUplinkListAns_) isMACCommand_Payload()     {}
+func (*MACCommand_RelayConfigureFwdLimitReq_) isMACCommand_Payload()  {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq_) isMACCommand_Payload() {}
 
 func (m *MACCommand) GetPayload() isMACCommand_Payload {
 	if m != nil {
@@ -2887,6 +2963,83 @@ func (m *MACCommand) GetDeviceModeConf() *MACCommand_DeviceModeConf {
 	return nil
 }
 
+func (m *MACCommand) GetRelayConfReq() *MACCommand_RelayConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfReq_); ok {
+		return x.RelayConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfAns() *MACCommand_RelayConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfAns_); ok {
+		return x.RelayConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfReq() *MACCommand_RelayEndDeviceConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfReq_); ok {
+		return x.RelayEndDeviceConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfAns() *MACCommand_RelayEndDeviceConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfAns_); ok {
+		return x.RelayEndDeviceConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListReq() *MACCommand_RelayFilterListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListReq_); ok {
+		return x.RelayFilterListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListAns() *MACCommand_RelayFilterListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListAns_); ok {
+		return x.RelayFilterListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayUpdateUplinkListReq() *MACCommand_RelayUpdateUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayUpdateUplinkListReq_); ok {
+		return x.RelayUpdateUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListReq() *MACCommand_RelayCtrlUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListReq_); ok {
+		return x.RelayCtrlUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListAns() *MACCommand_RelayCtrlUplinkListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListAns_); ok {
+		return x.RelayCtrlUplinkListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfigureFwdLimitReq() *MACCommand_RelayConfigureFwdLimitReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfigureFwdLimitReq_); ok {
+		return x.RelayConfigureFwdLimitReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayNotifyNewEndDeviceReq() *MACCommand_RelayNotifyNewEndDeviceReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayNotifyNewEndDeviceReq_); ok {
+		return x.RelayNotifyNewEndDeviceReq
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MACCommand) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2921,6 +3074,17 @@ func (*MACCommand) XXX_OneofWrappers() []interface{} {
 		(*MACCommand_BeaconFreqAns_)(nil),
 		(*MACCommand_DeviceModeInd_)(nil),
 		(*MACCommand_DeviceModeConf_)(nil),
+		(*MACCommand_RelayConfReq_)(nil),
+		(*MACCommand_RelayConfAns_)(nil),
+		(*MACCommand_RelayEndDeviceConfReq_)(nil),
+		(*MACCommand_RelayEndDeviceConfAns_)(nil),
+		(*MACCommand_RelayFilterListReq_)(nil),
+		(*MACCommand_RelayFilterListAns_)(nil),
+		(*MACCommand_RelayUpdateUplinkListReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListAns_)(nil),
+		(*MACCommand_RelayConfigureFwdLimitReq_)(nil),
+		(*MACCommand_RelayNotifyNewEndDeviceReq_)(nil),
 	}
 }
 
@@ -4511,23 +4675,30 @@ func (m *MACCommand_DeviceModeConf) GetClass() Class {
 	return CLASS_A
 }
 
-type FrequencyValue struct {
-	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type MACCommand_RelayConfReq struct {
+	// Whether the relay functionality is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Exponent e that configures the channel activity detection period = 2^(e-7) seconds (0 is 1 second).
+	CadPeriodicity uint32 `protobuf:"varint,2,opt,name=cad_periodicity,json=cadPeriodicity,proto3" json:"cad_periodicity,omitempty"`
+	// Index of the default wake on radio channel.
+	DefaultChannelIndex uint32 `protobuf:"varint,3,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	// The second wake on radio channel. If not set, the relay uses only the default channel.
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *FrequencyValue) Reset()      { *m = FrequencyValue{} }
-func (*FrequencyValue) ProtoMessage() {}
-func (*FrequencyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{19}
+func (m *MACCommand_RelayConfReq) Reset()      { *m = MACCommand_RelayConfReq{} }
+func (*MACCommand_RelayConfReq) ProtoMessage() {}
+func (*MACCommand_RelayConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 30}
 }
-func (m *FrequencyValue) XXX_Unmarshal(b []byte) error {
+func (m *MACCommand_RelayConfReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FrequencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FrequencyValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayConfReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4537,42 +4708,68 @@ func (m *FrequencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *FrequencyValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FrequencyValue.Merge(m, src)
+func (m *MACCommand_RelayConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfReq.Merge(m, src)
 }
-func (m *FrequencyValue) XXX_Size() int {
+func (m *MACCommand_RelayConfReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *FrequencyValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_FrequencyValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FrequencyValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfReq proto.InternalMessageInfo
 
-func (m *FrequencyValue) GetValue() uint64 {
+func (m *MACCommand_RelayConfReq) GetEnabled() bool {
 	if m != nil {
-		return m.Value
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfReq) GetCadPeriodicity() uint32 {
+	if m != nil {
+		return m.CadPeriodicity
 	}
 	return 0
 }
 
-type DataRateOffsetValue struct {
-	Value                DataRateOffset `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateOffset" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *MACCommand_RelayConfReq) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
+	}
+	return 0
 }
 
-func (m *DataRateOffsetValue) Reset()      { *m = DataRateOffsetValue{} }
-func (*DataRateOffsetValue) ProtoMessage() {}
-func (*DataRateOffsetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{20}
+func (m *MACCommand_RelayConfReq) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
 }
-func (m *DataRateOffsetValue) XXX_Unmarshal(b []byte) error {
+
+type MACCommand_RelayConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelAckOffsetAck     bool     `protobuf:"varint,2,opt,name=second_channel_ack_offset_ack,json=secondChannelAckOffsetAck,proto3" json:"second_channel_ack_offset_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,3,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	SecondChannelIndexAck         bool     `protobuf:"varint,4,opt,name=second_channel_index_ack,json=secondChannelIndexAck,proto3" json:"second_channel_index_ack,omitempty"`
+	DefaultChannelIndexAck        bool     `protobuf:"varint,5,opt,name=default_channel_index_ack,json=defaultChannelIndexAck,proto3" json:"default_channel_index_ack,omitempty"`
+	CadPeriodicityAck             bool     `protobuf:"varint,6,opt,name=cad_periodicity_ack,json=cadPeriodicityAck,proto3" json:"cad_periodicity_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayConfAns) Reset()      { *m = MACCommand_RelayConfAns{} }
+func (*MACCommand_RelayConfAns) ProtoMessage() {}
+func (*MACCommand_RelayConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 31}
+}
+func (m *MACCommand_RelayConfAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataRateOffsetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataRateOffsetValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayConfAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4582,42 +4779,84 @@ func (m *DataRateOffsetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataRateOffsetValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRateOffsetValue.Merge(m, src)
+func (m *MACCommand_RelayConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfAns.Merge(m, src)
 }
-func (m *DataRateOffsetValue) XXX_Size() int {
+func (m *MACCommand_RelayConfAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataRateOffsetValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRateOffsetValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRateOffsetValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfAns proto.InternalMessageInfo
 
-func (m *DataRateOffsetValue) GetValue() DataRateOffset {
+func (m *MACCommand_RelayConfAns) GetSecondChannelFrequencyAck() bool {
 	if m != nil {
-		return m.Value
+		return m.SecondChannelFrequencyAck
 	}
-	return DataRateOffset_DATA_RATE_OFFSET_0
+	return false
 }
 
-type DataRateIndexValue struct {
-	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *MACCommand_RelayConfAns) GetSecondChannelAckOffsetAck() bool {
+	if m != nil {
+		return m.SecondChannelAckOffsetAck
+	}
+	return false
 }
 
-func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
-func (*DataRateIndexValue) ProtoMessage() {}
-func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{21}
+func (m *MACCommand_RelayConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
 }
-func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayConfAns) GetSecondChannelIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetDefaultChannelIndexAck() bool {
+	if m != nil {
+		return m.DefaultChannelIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayConfAns) GetCadPeriodicityAck() bool {
+	if m != nil {
+		return m.CadPeriodicityAck
+	}
+	return false
+}
+
+type MACCommand_RelayEndDeviceConfReq struct {
+	// Relay activation mode: 0 disables the relay mode, 1 enables it, 2 is dynamic and 3 is end device controlled.
+	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Number of unacknowledged uplinks after which the end device enables the relay mode in dynamic mode.
+	SmartEnableLevel uint32 `protobuf:"varint,2,opt,name=smart_enable_level,json=smartEnableLevel,proto3" json:"smart_enable_level,omitempty"`
+	// Number of uplinks without wake on radio acknowledgment after which the end device transmits without relay.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// The second wake on radio channel. If not set, the end device uses only the default channel.
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq) Reset()      { *m = MACCommand_RelayEndDeviceConfReq{} }
+func (*MACCommand_RelayEndDeviceConfReq) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 32}
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4627,42 +4866,66 @@ func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Merge(m, src)
 }
-func (m *DataRateIndexValue) XXX_Size() int {
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataRateIndexValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfReq proto.InternalMessageInfo
 
-func (m *DataRateIndexValue) GetValue() DataRateIndex {
+func (m *MACCommand_RelayEndDeviceConfReq) GetMode() uint32 {
 	if m != nil {
-		return m.Value
+		return m.Mode
 	}
-	return DATA_RATE_0
+	return 0
 }
 
-type PingSlotPeriodValue struct {
-	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *MACCommand_RelayEndDeviceConfReq) GetSmartEnableLevel() uint32 {
+	if m != nil {
+		return m.SmartEnableLevel
+	}
+	return 0
 }
 
-func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
-func (*PingSlotPeriodValue) ProtoMessage() {}
-func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{22}
+func (m *MACCommand_RelayEndDeviceConfReq) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
 }
-func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayEndDeviceConfReq) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+type MACCommand_RelayEndDeviceConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,2,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	SecondChannelIndexAck         bool     `protobuf:"varint,3,opt,name=second_channel_index_ack,json=secondChannelIndexAck,proto3" json:"second_channel_index_ack,omitempty"`
+	BackoffAck                    bool     `protobuf:"varint,4,opt,name=backoff_ack,json=backoffAck,proto3" json:"backoff_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) Reset()      { *m = MACCommand_RelayEndDeviceConfAns{} }
+func (*MACCommand_RelayEndDeviceConfAns) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 33}
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4672,42 +4935,68 @@ func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Merge(m, src)
 }
-func (m *PingSlotPeriodValue) XXX_Size() int {
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfAns proto.InternalMessageInfo
 
-func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelFrequencyAck() bool {
 	if m != nil {
-		return m.Value
+		return m.SecondChannelFrequencyAck
 	}
-	return PING_EVERY_1S
+	return false
 }
 
-type AggregatedDutyCycleValue struct {
-	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
 }
 
-func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
-func (*AggregatedDutyCycleValue) ProtoMessage() {}
-func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{23}
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelIndexAck
+	}
+	return false
 }
-func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetBackoffAck() bool {
+	if m != nil {
+		return m.BackoffAck
+	}
+	return false
+}
+
+type MACCommand_RelayFilterListReq struct {
+	// Index of the join-request filter in the filter list of the relay.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Filter action: 0 removes the filter, 1 forwards and 2 drops the matching join-requests.
+	Action uint32 `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	// Prefix of the concatenation of the JoinEUI and DevEUI, which join-requests must match, as transmitted.
+	RawJoinEuiDevEui     []byte   `protobuf:"bytes,3,opt,name=raw_join_eui_dev_eui,json=rawJoinEuiDevEui,proto3" json:"raw_join_eui_dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayFilterListReq) Reset()      { *m = MACCommand_RelayFilterListReq{} }
+func (*MACCommand_RelayFilterListReq) ProtoMessage() {}
+func (*MACCommand_RelayFilterListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 34}
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayFilterListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayFilterListReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4717,42 +5006,58 @@ func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+func (m *MACCommand_RelayFilterListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.Merge(m, src)
 }
-func (m *AggregatedDutyCycleValue) XXX_Size() int {
+func (m *MACCommand_RelayFilterListReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+func (m *MACCommand_RelayFilterListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayFilterListReq proto.InternalMessageInfo
 
-func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
+func (m *MACCommand_RelayFilterListReq) GetIndex() uint32 {
 	if m != nil {
-		return m.Value
+		return m.Index
 	}
-	return DUTY_CYCLE_1
+	return 0
 }
 
-type RxDelayValue struct {
-	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
+func (m *MACCommand_RelayFilterListReq) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayFilterListReq) GetRawJoinEuiDevEui() []byte {
+	if m != nil {
+		return m.RawJoinEuiDevEui
+	}
+	return nil
+}
+
+type MACCommand_RelayFilterListAns struct {
+	FilterListActionAck  bool     `protobuf:"varint,1,opt,name=filter_list_action_ack,json=filterListActionAck,proto3" json:"filter_list_action_ack,omitempty"`
+	FilterListLengthAck  bool     `protobuf:"varint,2,opt,name=filter_list_length_ack,json=filterListLengthAck,proto3" json:"filter_list_length_ack,omitempty"`
+	CombinedRulesAck     bool     `protobuf:"varint,3,opt,name=combined_rules_ack,json=combinedRulesAck,proto3" json:"combined_rules_ack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
-func (*RxDelayValue) ProtoMessage() {}
-func (*RxDelayValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{24}
+func (m *MACCommand_RelayFilterListAns) Reset()      { *m = MACCommand_RelayFilterListAns{} }
+func (*MACCommand_RelayFilterListAns) ProtoMessage() {}
+func (*MACCommand_RelayFilterListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 35}
 }
-func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
+func (m *MACCommand_RelayFilterListAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayFilterListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayFilterListAns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4762,42 +5067,66 @@ func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RxDelayValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RxDelayValue.Merge(m, src)
+func (m *MACCommand_RelayFilterListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.Merge(m, src)
 }
-func (m *RxDelayValue) XXX_Size() int {
+func (m *MACCommand_RelayFilterListAns) XXX_Size() int {
 	return m.Size()
 }
-func (m *RxDelayValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+func (m *MACCommand_RelayFilterListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayFilterListAns proto.InternalMessageInfo
 
-func (m *RxDelayValue) GetValue() RxDelay {
+func (m *MACCommand_RelayFilterListAns) GetFilterListActionAck() bool {
 	if m != nil {
-		return m.Value
+		return m.FilterListActionAck
 	}
-	return RX_DELAY_0
+	return false
 }
 
-type ADRAckLimitExponentValue struct {
-	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayFilterListAns) GetFilterListLengthAck() bool {
+	if m != nil {
+		return m.FilterListLengthAck
+	}
+	return false
 }
 
-func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
-func (*ADRAckLimitExponentValue) ProtoMessage() {}
-func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{25}
+func (m *MACCommand_RelayFilterListAns) GetCombinedRulesAck() bool {
+	if m != nil {
+		return m.CombinedRulesAck
+	}
+	return false
 }
-func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
+
+type MACCommand_RelayUpdateUplinkListReq struct {
+	// Index of the end device in the trusted uplink list of the relay.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Size of the token bucket of the uplink limit of the end device.
+	UplinkLimitBucketSize uint32 `protobuf:"varint,2,opt,name=uplink_limit_bucket_size,json=uplinkLimitBucketSize,proto3" json:"uplink_limit_bucket_size,omitempty"`
+	// Reload rate of the token bucket of the uplink limit of the end device. 63 disables the uplink limit.
+	UplinkLimitReloadRate uint32                                                  `protobuf:"varint,3,opt,name=uplink_limit_reload_rate,json=uplinkLimitReloadRate,proto3" json:"uplink_limit_reload_rate,omitempty"`
+	DevAddr               go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,4,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// The wake on radio frame counter of the end device.
+	WFCnt uint32 `protobuf:"varint,5,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	// The RootWorSKey of the end device. The key is stored wrapped and only unwrapped when the downlink is encoded.
+	RootWorSKey          *KeyEnvelope `protobuf:"bytes,6,opt,name=root_wor_s_key,json=rootWorSKey,proto3" json:"root_wor_s_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) Reset()      { *m = MACCommand_RelayUpdateUplinkListReq{} }
+func (*MACCommand_RelayUpdateUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayUpdateUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 36}
+}
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4807,42 +5136,188 @@ func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Merge(m, src)
 }
-func (m *ADRAckLimitExponentValue) XXX_Size() int {
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq proto.InternalMessageInfo
 
-func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+func (m *MACCommand_RelayUpdateUplinkListReq) GetIndex() uint32 {
 	if m != nil {
-		return m.Value
+		return m.Index
 	}
-	return ADR_ACK_LIMIT_1
+	return 0
 }
 
-type ADRAckDelayExponentValue struct {
-	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
+func (m *MACCommand_RelayUpdateUplinkListReq) GetUplinkLimitBucketSize() uint32 {
+	if m != nil {
+		return m.UplinkLimitBucketSize
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetUplinkLimitReloadRate() uint32 {
+	if m != nil {
+		return m.UplinkLimitReloadRate
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) GetRootWorSKey() *KeyEnvelope {
+	if m != nil {
+		return m.RootWorSKey
+	}
+	return nil
+}
+
+type MACCommand_RelayCtrlUplinkListReq struct {
+	// Index of the end device in the trusted uplink list of the relay.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Control action: 0 reads the wake on radio frame counter and 1 removes the end device from the trusted uplink list.
+	Action               uint32   `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) Reset()      { *m = MACCommand_RelayCtrlUplinkListReq{} }
+func (*MACCommand_RelayCtrlUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 37}
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Merge(m, src)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayCtrlUplinkListReq) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+type MACCommand_RelayCtrlUplinkListAns struct {
+	IndexAck bool `protobuf:"varint,1,opt,name=index_ack,json=indexAck,proto3" json:"index_ack,omitempty"`
+	// The wake on radio frame counter of the end device.
+	WFCnt                uint32   `protobuf:"varint,2,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) Reset()      { *m = MACCommand_RelayCtrlUplinkListAns{} }
+func (*MACCommand_RelayCtrlUplinkListAns) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 38}
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Merge(m, src)
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns proto.InternalMessageInfo
+
+func (m *MACCommand_RelayCtrlUplinkListAns) GetIndexAck() bool {
+	if m != nil {
+		return m.IndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
+	}
+	return 0
+}
+
+type MACCommand_RelayConfigureFwdLimitReq struct {
+	// Reset action of the limit counters: 0 keeps, 1 resets to zero, 2 resets to the bucket size and 3 keeps the counters.
+	ResetLimitCounter uint32 `protobuf:"varint,1,opt,name=reset_limit_counter,json=resetLimitCounter,proto3" json:"reset_limit_counter,omitempty"`
+	// The limits of the forwarded join-requests. If not set, join-requests are not limited.
+	JoinRequestLimits *RelayForwardLimits `protobuf:"bytes,2,opt,name=join_request_limits,json=joinRequestLimits,proto3" json:"join_request_limits,omitempty"`
+	// The limits of the NotifyNewEndDeviceReq MAC commands. If not set, notifications are not limited.
+	NotifyLimits *RelayForwardLimits `protobuf:"bytes,3,opt,name=notify_limits,json=notifyLimits,proto3" json:"notify_limits,omitempty"`
+	// The limits of the forwarded uplinks of all end devices. If not set, uplinks are not limited.
+	GlobalUplinkLimits *RelayForwardLimits `protobuf:"bytes,4,opt,name=global_uplink_limits,json=globalUplinkLimits,proto3" json:"global_uplink_limits,omitempty"`
+	// The limits of all the forwarded frames. If not set, frames are not limited.
+	OverallLimits        *RelayForwardLimits `protobuf:"bytes,5,opt,name=overall_limits,json=overallLimits,proto3" json:"overall_limits,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
-func (*ADRAckDelayExponentValue) ProtoMessage() {}
-func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{26}
+func (m *MACCommand_RelayConfigureFwdLimitReq) Reset()      { *m = MACCommand_RelayConfigureFwdLimitReq{} }
+func (*MACCommand_RelayConfigureFwdLimitReq) ProtoMessage() {}
+func (*MACCommand_RelayConfigureFwdLimitReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 39}
 }
-func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4852,42 +5327,74 @@ func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.Merge(m, src)
 }
-func (m *ADRAckDelayExponentValue) XXX_Size() int {
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfigureFwdLimitReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfigureFwdLimitReq proto.InternalMessageInfo
 
-func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetResetLimitCounter() uint32 {
 	if m != nil {
-		return m.Value
+		return m.ResetLimitCounter
 	}
-	return ADR_ACK_DELAY_1
+	return 0
 }
 
-type DeviceEIRPValue struct {
-	Value                DeviceEIRP `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DeviceEIRP" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetJoinRequestLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.JoinRequestLimits
+	}
+	return nil
 }
 
-func (m *DeviceEIRPValue) Reset()      { *m = DeviceEIRPValue{} }
-func (*DeviceEIRPValue) ProtoMessage() {}
-func (*DeviceEIRPValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{27}
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetNotifyLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.NotifyLimits
+	}
+	return nil
 }
-func (m *DeviceEIRPValue) XXX_Unmarshal(b []byte) error {
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetGlobalUplinkLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.GlobalUplinkLimits
+	}
+	return nil
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) GetOverallLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.OverallLimits
+	}
+	return nil
+}
+
+type MACCommand_RelayNotifyNewEndDeviceReq struct {
+	DevAddr go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// The SNR of the uplink of the end device received by the relay.
+	SNR int32 `protobuf:"varint,2,opt,name=snr,proto3" json:"snr,omitempty"`
+	// The RSSI of the uplink of the end device received by the relay.
+	RSSI                 int32    `protobuf:"varint,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Reset()      { *m = MACCommand_RelayNotifyNewEndDeviceReq{} }
+func (*MACCommand_RelayNotifyNewEndDeviceReq) ProtoMessage() {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{18, 40}
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeviceEIRPValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeviceEIRPValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4897,1304 +5404,1313 @@ func (m *DeviceEIRPValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DeviceEIRPValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceEIRPValue.Merge(m, src)
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Merge(m, src)
 }
-func (m *DeviceEIRPValue) XXX_Size() int {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeviceEIRPValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceEIRPValue.DiscardUnknown(m)
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeviceEIRPValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq proto.InternalMessageInfo
 
-func (m *DeviceEIRPValue) GetValue() DeviceEIRP {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetSNR() int32 {
+	if m != nil {
+		return m.SNR
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetRSSI() int32 {
+	if m != nil {
+		return m.RSSI
+	}
+	return 0
+}
+
+type FrequencyValue struct {
+	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrequencyValue) Reset()      { *m = FrequencyValue{} }
+func (*FrequencyValue) ProtoMessage() {}
+func (*FrequencyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19}
+}
+func (m *FrequencyValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrequencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrequencyValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrequencyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrequencyValue.Merge(m, src)
+}
+func (m *FrequencyValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrequencyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrequencyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrequencyValue proto.InternalMessageInfo
+
+func (m *FrequencyValue) GetValue() uint64 {
 	if m != nil {
 		return m.Value
 	}
-	return DEVICE_EIRP_8
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
-	proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
-	golang_proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
-	proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
-	golang_proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
-	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
-	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
-	proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
-	golang_proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+type DataRateOffsetValue struct {
+	Value                DataRateOffset `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateOffset" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
+func (m *DataRateOffsetValue) Reset()      { *m = DataRateOffsetValue{} }
+func (*DataRateOffsetValue) ProtoMessage() {}
+func (*DataRateOffsetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
 }
-
-var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 5719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4d, 0x70, 0x1b, 0xc9,
-	0x75, 0xc6, 0xe0, 0x87, 0x00, 0x1f, 0x40, 0x60, 0xd8, 0xd4, 0xae, 0x28, 0x78, 0x0d, 0xca, 0x94,
-	0x53, 0x96, 0xb9, 0x11, 0x25, 0x82, 0x3f, 0xa2, 0xfc, 0xbb, 0xf8, 0xe3, 0x92, 0x2b, 0xfe, 0x79,
-	0x40, 0xea, 0xc7, 0x3f, 0x35, 0x19, 0x62, 0x06, 0x14, 0x96, 0xc0, 0x00, 0x3b, 0x18, 0x52, 0xa4,
-	0x73, 0x71, 0xec, 0xcb, 0x56, 0x52, 0xa9, 0x72, 0xb9, 0x2a, 0x3f, 0xce, 0x21, 0x76, 0x55, 0x9c,
-	0x8a, 0xab, 0x7c, 0x88, 0x2b, 0x39, 0x64, 0x8f, 0x3e, 0xe4, 0xe0, 0x54, 0x72, 0xd8, 0x5c, 0x52,
-	0xae, 0x54, 0x59, 0x59, 0x51, 0x17, 0x9f, 0x52, 0x3e, 0xba, 0x74, 0xc8, 0xa6, 0x5e, 0x77, 0x0f,
-	0xa6, 0x7b, 0x06, 0x12, 0x49, 0x5b, 0xde, 0xcb, 0xa2, 0xbf, 0xee, 0xfe, 0xfa, 0xf5, 0x7b, 0xaf,
-	0x5f, 0xbf, 0x7e, 0x43, 0xc1, 0x54, 0xbb, 0xeb, 0x18, 0x8f, 0x0d, 0xfb, 0x46, 0xdf, 0x35, 0x1a,
-	0x07, 0x37, 0x8d, 0x5e, 0xeb, 0x26, 0x47, 0x66, 0x7b, 0x4e, 0xd7, 0xed, 0x92, 0xac, 0xeb, 0xda,
-	0xb3, 0x1e, 0x74, 0x34, 0x9f, 0x2f, 0xed, 0xb7, 0xdc, 0x47, 0x87, 0x7b, 0xb3, 0x8d, 0x6e, 0xe7,
-	0xa6, 0x65, 0x1f, 0x75, 0x4f, 0x7a, 0x4e, 0xf7, 0xf8, 0xe4, 0x26, 0x1d, 0xdc, 0xb8, 0xb1, 0x6f,
-	0xd9, 0x37, 0x8e, 0x8c, 0x76, 0xcb, 0x34, 0x5c, 0xeb, 0x66, 0xe8, 0x07, 0xa3, 0xcc, 0xdf, 0x10,
-	0x28, 0xf6, 0xbb, 0xfb, 0x5d, 0x36, 0x79, 0xef, 0xb0, 0x49, 0x5b, 0xb4, 0x41, 0x7f, 0xf1, 0xe1,
-	0x6f, 0xec, 0x77, 0xbb, 0xfb, 0x6d, 0xcb, 0x1f, 0xd5, 0x77, 0x9d, 0xc3, 0x86, 0xcb, 0x7b, 0xa7,
-	0x82, 0xbd, 0x6e, 0xab, 0x63, 0xf5, 0x5d, 0xa3, 0xd3, 0xe3, 0x03, 0xae, 0x85, 0x77, 0xd8, 0x32,
-	0x2d, 0xdb, 0x6d, 0x35, 0x5b, 0x96, 0xd3, 0x67, 0x83, 0xa6, 0x3f, 0x8a, 0x41, 0x72, 0xc3, 0xea,
-	0xf7, 0x8d, 0x7d, 0x8b, 0x7c, 0x1e, 0x12, 0x1d, 0xfd, 0x91, 0xe9, 0x4c, 0x2a, 0x57, 0x95, 0xeb,
-	0xe9, 0xe2, 0xa5, 0x59, 0x59, 0x03, 0xb3, 0x1b, 0xab, 0x55, 0xad, 0xac, 0x3e, 0x2f, 0x27, 0xfe,
-	0x54, 0x89, 0xaa, 0xca, 0xcf, 0x9f, 0x4c, 0x45, 0x3e, 0x7c, 0x32, 0xa5, 0x68, 0xf1, 0xce, 0xaa,
-	0xe9, 0x90, 0x69, 0x88, 0x75, 0x5a, 0x8d, 0xc9, 0xe8, 0x55, 0xe5, 0x7a, 0x06, 0x07, 0x8d, 0x7c,
-	0x33, 0xae, 0x46, 0x26, 0xe3, 0xa7, 0x4f, 0xa6, 0x62, 0x1b, 0x6b, 0x15, 0x0d, 0x3b, 0xc9, 0x06,
-	0xa4, 0x3b, 0x46, 0x43, 0xef, 0x19, 0x27, 0xed, 0xae, 0x61, 0x4e, 0xc6, 0xe8, 0x32, 0xf9, 0xd0,
-	0x32, 0xa5, 0xca, 0x36, 0x1b, 0x51, 0xce, 0x9e, 0x3e, 0x99, 0x02, 0xbf, 0xbd, 0x1a, 0xd1, 0xa0,
-	0x63, 0x34, 0x78, 0x8b, 0xdc, 0x83, 0x4b, 0xef, 0x76, 0x5b, 0xb6, 0xee, 0x58, 0xef, 0x1d, 0x5a,
-	0x7d, 0x77, 0xc0, 0x1b, 0xa7, 0xbc, 0xd3, 0x41, 0xde, 0x77, 0xba, 0x2d, 0x5b, 0x63, 0x43, 0x7d,
-	0x3e, 0xf2, 0x6e, 0x08, 0x25, 0x75, 0x98, 0xa0, 0xbc, 0x46, 0xa3, 0x61, 0xf5, 0x7c, 0xda, 0x04,
-	0xa5, 0xfd, 0xd4, 0x30, 0xda, 0x12, 0x1d, 0xe9, 0xb3, 0x8e, 0xbf, 0x1b, 0x04, 0xc9, 0xd7, 0xe1,
-	0x75, 0xc7, 0x1a, 0x2a, 0xee, 0x08, 0xe5, 0xfd, 0x74, 0x90, 0x57, 0xb3, 0xde, 0x1d, 0x26, 0xf0,
-	0x25, 0x67, 0x08, 0xfe, 0xb9, 0xf8, 0x07, 0x3f, 0x9c, 0x8a, 0x94, 0xb3, 0x90, 0xf4, 0x96, 0x8b,
-	0xfd, 0xa6, 0xac, 0xbc, 0x13, 0x4f, 0x25, 0xd5, 0xd4, 0xf4, 0x21, 0xc4, 0xd1, 0x72, 0x64, 0x09,
-	0x46, 0x3a, 0xba, 0x7b, 0xd2, 0xb3, 0xa8, 0x7d, 0xb3, 0xc5, 0xd7, 0x42, 0x8a, 0xdf, 0x39, 0xe9,
-	0x59, 0xe5, 0xd4, 0xf3, 0x72, 0xe2, 0xdb, 0x68, 0x60, 0x2d, 0xd1, 0x41, 0x80, 0x2c, 0x42, 0xa2,
-	0x63, 0xbc, 0xdb, 0x75, 0xa8, 0x6d, 0x87, 0x4d, 0xc3, 0x4e, 0x69, 0x1a, 0x02, 0xd3, 0x7f, 0x12,
-	0x05, 0xc1, 0x74, 0xe8, 0x5c, 0xcd, 0x97, 0x39, 0xd7, 0xca, 0x0b, 0x9c, 0xab, 0x89, 0xce, 0x35,
-	0x05, 0x23, 0x4d, 0xbd, 0xd7, 0x75, 0x5c, 0x2a, 0xc3, 0x18, 0x5d, 0x6c, 0x26, 0x36, 0xf9, 0xb1,
-	0xa2, 0x25, 0x9a, 0xdb, 0x5d, 0xc7, 0x25, 0x37, 0x21, 0xdd, 0x74, 0x3a, 0x92, 0x67, 0x65, 0x98,
-	0xf7, 0xac, 0x68, 0x1b, 0x5c, 0x04, 0x0d, 0x9a, 0x4e, 0xc7, 0x13, 0xe7, 0x2d, 0xc8, 0x99, 0x56,
-	0xa3, 0x6b, 0x5a, 0x66, 0xc0, 0x6d, 0x2e, 0xcf, 0xb2, 0x73, 0x35, 0xeb, 0x9d, 0xab, 0xd9, 0x3a,
-	0x3d, 0x75, 0x5a, 0x96, 0x8f, 0xf7, 0x18, 0xde, 0x00, 0x68, 0x1e, 0xb6, 0xdb, 0x7a, 0x53, 0x6f,
-	0xd8, 0x2e, 0x75, 0x8e, 0x31, 0x2d, 0x85, 0xc8, 0x4a, 0xc5, 0x76, 0x99, 0x41, 0xa6, 0x7f, 0xa5,
-	0x40, 0x1c, 0x37, 0x46, 0xbe, 0x0a, 0x29, 0xd3, 0x3a, 0xd2, 0x0d, 0x93, 0x2b, 0x20, 0x53, 0xfe,
-	0x32, 0x6e, 0xf1, 0xbf, 0x9f, 0x4c, 0xdd, 0xde, 0xef, 0xce, 0xba, 0x8f, 0x2c, 0xf7, 0x51, 0xcb,
-	0xde, 0xef, 0xcf, 0xda, 0x96, 0xfb, 0xb8, 0xeb, 0x1c, 0xdc, 0x94, 0x8f, 0xee, 0xd1, 0xfc, 0xcd,
-	0xde, 0xc1, 0xfe, 0x4d, 0xb4, 0x5d, 0x7f, 0xb6, 0x6a, 0x1d, 0x95, 0x4c, 0xd3, 0xd1, 0x92, 0x26,
-	0xfb, 0x41, 0xbe, 0x84, 0xca, 0x69, 0xb8, 0x4e, 0x9b, 0x2a, 0x27, 0x1d, 0x36, 0xd0, 0x4a, 0xc5,
-	0x75, 0xda, 0x43, 0x74, 0x9b, 0x68, 0x62, 0x07, 0x29, 0xa0, 0x65, 0x70, 0x0f, 0x31, 0xaa, 0xdb,
-	0xd1, 0xe7, 0xe5, 0x91, 0x99, 0xf8, 0xe4, 0xc7, 0x1f, 0xc7, 0xb4, 0x78, 0xb3, 0x62, 0xbb, 0xa4,
-	0x80, 0xfc, 0xdd, 0x9e, 0xdb, 0xa7, 0x1a, 0xca, 0x94, 0x93, 0xcf, 0xcb, 0xf1, 0x6f, 0x46, 0x27,
-	0x73, 0x5a, 0xa2, 0xb9, 0xd5, 0x73, 0xfb, 0x7c, 0xab, 0xdf, 0x57, 0x20, 0x41, 0x17, 0x22, 0x57,
-	0x20, 0x66, 0xf0, 0x6d, 0xa6, 0xca, 0x49, 0x0c, 0x00, 0xa5, 0xaa, 0xa6, 0x21, 0x46, 0x6e, 0x40,
-	0xda, 0x30, 0x1d, 0xdd, 0x68, 0x1c, 0xe0, 0x29, 0xa0, 0xf2, 0xa6, 0xca, 0x63, 0xa7, 0x4f, 0xa6,
-	0x46, 0x4b, 0x55, 0xad, 0xd4, 0x38, 0xd0, 0xac, 0xf7, 0xb4, 0x51, 0xc3, 0x74, 0xd8, 0x4f, 0xa2,
-	0x42, 0xcc, 0x68, 0x1c, 0x50, 0xb9, 0x52, 0x1a, 0xfe, 0x24, 0x9f, 0x80, 0xd1, 0xa6, 0xde, 0xb3,
-	0x6c, 0xb3, 0x65, 0xef, 0x53, 0x71, 0x52, 0x5a, 0xaa, 0xb9, 0xcd, 0xda, 0xe4, 0x32, 0x24, 0x1b,
-	0x6d, 0xa3, 0xdf, 0xd7, 0xf7, 0xa8, 0x39, 0x52, 0xda, 0x08, 0x6d, 0x96, 0xa7, 0x7f, 0x12, 0x05,
-	0x12, 0x3e, 0xfd, 0xe4, 0x01, 0xa4, 0xe8, 0x81, 0xb4, 0x0e, 0x5b, 0xdc, 0x28, 0x5f, 0xe4, 0x46,
-	0x59, 0xbc, 0xa8, 0x51, 0x6a, 0xbb, 0x6b, 0x4b, 0x0b, 0x5a, 0x12, 0xe9, 0x6a, 0x87, 0x2d, 0x72,
-	0x0f, 0xd0, 0x3a, 0x94, 0x38, 0xfa, 0x2a, 0x88, 0x47, 0x4c, 0xeb, 0x08, 0x79, 0xbf, 0x01, 0xa3,
-	0xc8, 0x6b, 0x77, 0xed, 0x86, 0xc5, 0x9d, 0xfc, 0x2d, 0xce, 0xbc, 0xfc, 0x5b, 0xf8, 0xd1, 0x26,
-	0xf2, 0x68, 0xe8, 0x99, 0xf4, 0x17, 0xb7, 0xe4, 0xdf, 0xc4, 0xe0, 0xd2, 0xb0, 0xe0, 0x43, 0xd6,
-	0x21, 0xcd, 0x43, 0x98, 0x10, 0x45, 0x3e, 0xf5, 0xd2, 0xb8, 0x15, 0x88, 0x28, 0xc0, 0xe6, 0xd3,
-	0xb0, 0xf2, 0x0d, 0x18, 0xb1, 0x2d, 0x57, 0x6f, 0x99, 0x5c, 0x45, 0x2b, 0xbf, 0xad, 0x8a, 0x36,
-	0x2d, 0x77, 0xad, 0x7a, 0xfa, 0x64, 0x2a, 0x41, 0x7f, 0x68, 0x09, 0xdb, 0x72, 0xd7, 0x64, 0xe3,
-	0xc6, 0x7e, 0x5f, 0xc6, 0x8d, 0xbf, 0x4a, 0xe3, 0x7e, 0x12, 0xb8, 0x7a, 0x84, 0x80, 0x32, 0xca,
-	0x10, 0x3f, 0xa2, 0xfc, 0x6d, 0x1c, 0xc6, 0x43, 0x37, 0x0e, 0x79, 0x03, 0x46, 0x2d, 0xbb, 0xe1,
-	0x9c, 0xf4, 0x5c, 0xcb, 0x64, 0xae, 0xac, 0xf9, 0x00, 0xf9, 0x23, 0x00, 0x4a, 0xcb, 0xdc, 0x86,
-	0x69, 0xbb, 0xc4, 0x65, 0xbe, 0x73, 0x51, 0x99, 0x71, 0x71, 0xe6, 0x37, 0xa3, 0xef, 0x7a, 0x3f,
-	0x05, 0x5b, 0xc6, 0x7e, 0x1f, 0xb6, 0x14, 0xa3, 0x67, 0xfc, 0x15, 0x47, 0xcf, 0x0d, 0x48, 0x9b,
-	0x6d, 0xbd, 0x6f, 0xb9, 0x2e, 0x52, 0xf0, 0x4b, 0x3e, 0x94, 0x93, 0x54, 0xd7, 0xeb, 0x7c, 0xc4,
-	0x90, 0x38, 0x0a, 0x66, 0xdb, 0xeb, 0x25, 0x5f, 0x80, 0x94, 0x73, 0xac, 0x9b, 0x56, 0xdb, 0x38,
-	0xa1, 0x17, 0x7b, 0xb6, 0x78, 0x39, 0x74, 0x40, 0x8e, 0xab, 0xd8, 0x2d, 0x1c, 0x8b, 0xa4, 0xc3,
-	0x20, 0xf2, 0x79, 0x48, 0x36, 0x9a, 0x7a, 0xbb, 0xd5, 0x77, 0x27, 0x93, 0x54, 0x90, 0xd7, 0x83,
-	0x93, 0x2b, 0x2b, 0xeb, 0xad, 0xbe, 0x5b, 0x86, 0xd3, 0x27, 0x53, 0x23, 0xec, 0xb7, 0x36, 0xd2,
-	0x68, 0xe2, 0xff, 0xb9, 0x83, 0xfc, 0x52, 0x01, 0xf0, 0xa5, 0x25, 0x5f, 0x83, 0x31, 0xe7, 0x78,
-	0x4e, 0x37, 0x1d, 0xbd, 0xdb, 0x6c, 0xf6, 0x2d, 0x97, 0x9f, 0xda, 0x42, 0x68, 0x83, 0x86, 0x6b,
-	0x68, 0x86, 0x6b, 0x6d, 0xd1, 0x51, 0xe5, 0xcb, 0x9e, 0x6c, 0xa7, 0x4f, 0xa6, 0xd2, 0xda, 0xf1,
-	0x5c, 0x55, 0x63, 0x1d, 0x5a, 0xda, 0x39, 0x9e, 0xab, 0x3a, 0xac, 0x41, 0xde, 0x86, 0x11, 0xe7,
-	0xb8, 0xa8, 0x9b, 0x5e, 0x6a, 0xf0, 0xc9, 0x17, 0xb1, 0xae, 0xd9, 0xa6, 0x75, 0x5c, 0x1e, 0x17,
-	0x48, 0x13, 0xda, 0x71, 0xb1, 0xaa, 0x69, 0x09, 0xe7, 0xb8, 0x58, 0x75, 0xc8, 0x35, 0x48, 0x76,
-	0x7b, 0xae, 0x6e, 0x5b, 0xfb, 0x2c, 0xd8, 0xb3, 0xfd, 0x6d, 0xf5, 0xdc, 0x4d, 0x6b, 0x5f, 0x1b,
-	0xe9, 0xd2, 0xff, 0xf3, 0xfd, 0x3d, 0x06, 0xbe, 0x6f, 0xb2, 0x0c, 0x71, 0x21, 0x0e, 0xe5, 0x87,
-	0x6b, 0x2a, 0x10, 0x80, 0xe8, 0x0c, 0x42, 0x20, 0xde, 0x64, 0xf7, 0x4f, 0xec, 0xfa, 0x98, 0x46,
-	0x7f, 0x93, 0x2b, 0x90, 0x6a, 0x3c, 0xd2, 0x3b, 0x46, 0xff, 0xa0, 0x3f, 0x19, 0xbb, 0x1a, 0xbb,
-	0x9e, 0xd2, 0x92, 0x8d, 0x47, 0x1b, 0xd8, 0xe4, 0x0b, 0xdf, 0x87, 0xcc, 0x7a, 0x57, 0x33, 0xbc,
-	0x2d, 0xe1, 0x99, 0xdb, 0x33, 0x6c, 0xf3, 0x71, 0xcb, 0x74, 0x1f, 0x51, 0x19, 0xc6, 0x34, 0x1f,
-	0x20, 0x9f, 0x05, 0xb5, 0xdf, 0x73, 0x2c, 0x03, 0x2f, 0x26, 0xbd, 0x69, 0x34, 0x5c, 0x9e, 0x3f,
-	0x8d, 0x69, 0xb9, 0x01, 0xbe, 0x42, 0xe1, 0xe9, 0xeb, 0x90, 0x5e, 0xa9, 0xdf, 0x1d, 0xf0, 0x5e,
-	0x81, 0xd4, 0x5e, 0xcb, 0xd5, 0x1d, 0xc3, 0xb5, 0x38, 0x6d, 0x72, 0xaf, 0xe5, 0x62, 0xd7, 0xf4,
-	0x5f, 0x28, 0x90, 0x1a, 0x8c, 0xfb, 0x02, 0xc4, 0x71, 0xb7, 0x3c, 0x9f, 0x7a, 0x23, 0xb8, 0x7d,
-	0x51, 0xd6, 0x72, 0xea, 0xf4, 0xc9, 0x54, 0x1c, 0x91, 0xd5, 0x88, 0x46, 0x67, 0x91, 0x65, 0x88,
-	0x35, 0xfb, 0x07, 0x3c, 0x63, 0xf8, 0x44, 0x28, 0x63, 0xf0, 0xe5, 0x61, 0x37, 0xf8, 0x4a, 0xfd,
-	0xee, 0x6a, 0x44, 0xc3, 0x29, 0xe5, 0x71, 0x80, 0x4e, 0xd7, 0x3c, 0x6c, 0x1b, 0x6e, 0xab, 0x6b,
-	0xd3, 0x3c, 0x73, 0xfa, 0x1f, 0xe2, 0x00, 0x3b, 0xc7, 0x03, 0x9f, 0xab, 0xc0, 0xa8, 0x69, 0xb8,
-	0x86, 0xbf, 0x85, 0x74, 0x71, 0xf2, 0x45, 0x9e, 0x51, 0xce, 0x88, 0xc7, 0x49, 0x4b, 0x99, 0xde,
-	0xf6, 0xb6, 0x20, 0x37, 0x20, 0xd1, 0x5b, 0xe8, 0x3f, 0xe7, 0x73, 0x32, 0xdf, 0xd6, 0x63, 0xa6,
-	0xd8, 0x41, 0xa6, 0x20, 0xdd, 0xe8, 0x52, 0x73, 0x50, 0xb9, 0xd0, 0xcf, 0x46, 0x35, 0x60, 0x90,
-	0x67, 0xd0, 0x26, 0xcd, 0xcd, 0xed, 0xc6, 0x09, 0x0d, 0x33, 0x71, 0xcd, 0x07, 0xc8, 0x1f, 0x02,
-	0x58, 0xb6, 0xb1, 0xd7, 0xb6, 0xf4, 0x86, 0xd3, 0x60, 0xf9, 0x05, 0xcb, 0x5c, 0x6a, 0x14, 0xad,
-	0x68, 0x15, 0x0c, 0xb9, 0xf4, 0xa7, 0xd3, 0x40, 0xae, 0xc1, 0x73, 0x8c, 0xc6, 0x81, 0x31, 0xcd,
-	0x07, 0xc8, 0x02, 0xc4, 0xb1, 0xc1, 0xcf, 0x78, 0x3e, 0x94, 0x71, 0xee, 0x78, 0x23, 0xcb, 0xf1,
-	0xef, 0xfe, 0x0f, 0x26, 0xc1, 0x38, 0x9a, 0x7c, 0x19, 0x52, 0x66, 0xf7, 0xb1, 0xdd, 0x6e, 0xd9,
-	0x07, 0x93, 0x29, 0x3a, 0xf3, 0x5a, 0x50, 0x15, 0xbe, 0x11, 0x66, 0xab, 0x7c, 0xa8, 0x36, 0x98,
-	0x94, 0xff, 0x63, 0x48, 0x79, 0x28, 0xb9, 0x06, 0x63, 0x86, 0xed, 0x5a, 0xb6, 0x6d, 0x70, 0xe5,
-	0x32, 0x57, 0xcb, 0x70, 0x90, 0xa9, 0xec, 0x0a, 0xa4, 0xdc, 0x63, 0xbd, 0xd7, 0x7d, 0x6c, 0x31,
-	0xe7, 0x8d, 0x6a, 0x49, 0xf7, 0x78, 0x1b, 0x9b, 0xe4, 0x26, 0x4c, 0xb4, 0xec, 0x23, 0xcb, 0x71,
-	0xf5, 0x5e, 0xb7, 0x6d, 0x38, 0xad, 0x6f, 0x52, 0x77, 0xe0, 0xa9, 0x1a, 0x61, 0x5d, 0xdb, 0x42,
-	0x0f, 0x3f, 0x44, 0x7f, 0xa5, 0xc0, 0x95, 0xb7, 0x0d, 0xd7, 0x7a, 0x6c, 0x9c, 0x94, 0xf8, 0x4a,
-	0xfe, 0x93, 0x94, 0xec, 0x42, 0x7a, 0x9f, 0x75, 0xea, 0x2d, 0xb3, 0xcf, 0x5d, 0x27, 0xf4, 0x8e,
-	0xe3, 0xf3, 0x85, 0x89, 0xc3, 0x62, 0xf2, 0xbe, 0x37, 0xaa, 0x1f, 0xde, 0x6b, 0x34, 0xbc, 0xd7,
-	0xe9, 0xff, 0x55, 0x20, 0xbd, 0xdb, 0x43, 0xdd, 0xec, 0x74, 0x0f, 0x2c, 0x9b, 0x6c, 0x40, 0xcc,
-	0x97, 0xe1, 0xb3, 0x2f, 0x90, 0x21, 0xbc, 0x87, 0x21, 0xa2, 0x20, 0x8f, 0xec, 0x10, 0xd1, 0xa0,
-	0x43, 0xd4, 0x20, 0xdd, 0xb7, 0x9c, 0x23, 0xcb, 0xd1, 0xa9, 0x5f, 0xc4, 0xce, 0xf4, 0x8b, 0x14,
-	0xb2, 0x53, 0xdf, 0x00, 0x36, 0x11, 0xbb, 0xc8, 0x9b, 0x30, 0xde, 0xc0, 0xfb, 0xd8, 0x76, 0x1d,
-	0xc3, 0xed, 0x72, 0x32, 0xf4, 0xe4, 0x98, 0xa6, 0x8a, 0x1d, 0x38, 0x78, 0xfa, 0x3b, 0x0a, 0x64,
-	0x3c, 0x77, 0xd8, 0x36, 0xdc, 0x47, 0xe4, 0x1a, 0x64, 0x0e, 0xa9, 0x02, 0x74, 0x17, 0x35, 0xc0,
-	0xf2, 0x88, 0xd5, 0x88, 0x96, 0x3e, 0x14, 0xd4, 0x52, 0x82, 0x44, 0xb3, 0x75, 0x6c, 0x99, 0x3c,
-	0x72, 0x9c, 0x5f, 0x31, 0xab, 0x11, 0x8d, 0xcd, 0x2c, 0xa7, 0x21, 0xde, 0xc3, 0xf5, 0x68, 0xe8,
-	0xf8, 0xf7, 0x04, 0x8c, 0xee, 0x1c, 0xf3, 0x6c, 0x91, 0xbc, 0x09, 0x09, 0x9a, 0xb2, 0xbf, 0xe8,
-	0x85, 0x5a, 0xc1, 0x4e, 0x8d, 0x8d, 0x21, 0x15, 0xc8, 0x7a, 0xae, 0xad, 0x23, 0x61, 0x9f, 0xc6,
-	0xf3, 0x21, 0xa1, 0x50, 0xdc, 0xa5, 0x36, 0x66, 0x0a, 0xad, 0x3e, 0xf9, 0x12, 0x8c, 0xd2, 0xfb,
-	0x91, 0x5e, 0xd8, 0xb1, 0xf3, 0x5e, 0xd8, 0x29, 0xbc, 0x05, 0xe9, 0x8d, 0x7d, 0x0f, 0x26, 0xe8,
-	0xfc, 0x40, 0xa8, 0x8a, 0x5f, 0x2c, 0x54, 0xa9, 0xc8, 0x27, 0x45, 0xab, 0x6b, 0xec, 0xde, 0xf6,
-	0x03, 0x52, 0x82, 0x06, 0xa4, 0x8c, 0x73, 0x3c, 0xb7, 0x32, 0x88, 0x49, 0x74, 0xf1, 0x62, 0x68,
-	0xf1, 0x91, 0x0b, 0x2f, 0x5e, 0x1c, 0xb2, 0x78, 0x51, 0x58, 0x3c, 0xe9, 0x2d, 0x5e, 0xf4, 0x17,
-	0x5f, 0x85, 0x54, 0xcf, 0x69, 0x75, 0x9d, 0x96, 0x7b, 0x42, 0xc3, 0x51, 0x36, 0x7c, 0x52, 0x77,
-	0x8e, 0xeb, 0x8d, 0x47, 0x96, 0x79, 0xd8, 0xb6, 0xb6, 0xf9, 0x48, 0x51, 0x87, 0xde, 0x6c, 0x52,
-	0x83, 0x31, 0x63, 0xaf, 0xdf, 0x6d, 0x1f, 0xba, 0x16, 0x73, 0xd9, 0xd1, 0x73, 0xc6, 0xc5, 0x8c,
-	0x37, 0x8d, 0x7a, 0xff, 0x0a, 0x8c, 0x0f, 0x24, 0xd6, 0x7b, 0x6d, 0xc3, 0xc6, 0x7c, 0x14, 0x30,
-	0xcc, 0x97, 0xf3, 0xcf, 0xcb, 0x71, 0x27, 0x3a, 0xf9, 0xd6, 0xe9, 0x93, 0xa9, 0xdc, 0x60, 0x07,
-	0xdb, 0x6d, 0xc3, 0x5e, 0xab, 0x6a, 0xb9, 0xa6, 0x04, 0x98, 0x64, 0x1e, 0x52, 0x86, 0x79, 0x64,
-	0xd8, 0x0d, 0xcb, 0x9c, 0x6c, 0xbc, 0xbc, 0x26, 0x30, 0x18, 0xc8, 0xc3, 0xdb, 0xbf, 0x2c, 0xd2,
-	0x9a, 0x47, 0xa5, 0xdb, 0xe9, 0x18, 0xb6, 0x49, 0xd6, 0x20, 0xd6, 0x68, 0x99, 0xdc, 0x99, 0x3f,
-	0x3d, 0xa4, 0xce, 0xc5, 0x07, 0xfa, 0xc7, 0x04, 0x73, 0xa4, 0xe4, 0xb7, 0x95, 0xb8, 0xaa, 0x5c,
-	0x8d, 0xe0, 0xbd, 0x5b, 0x59, 0xab, 0x6a, 0xc8, 0x41, 0x3e, 0x05, 0x69, 0xc7, 0x78, 0x3c, 0xa8,
-	0x55, 0x44, 0xf9, 0xd9, 0x04, 0xc7, 0x78, 0xec, 0x3d, 0x02, 0xca, 0x30, 0xea, 0x58, 0x7d, 0x4c,
-	0xc3, 0x6d, 0xaf, 0xb6, 0x76, 0xed, 0xc5, 0x6b, 0xce, 0x6a, 0x38, 0x76, 0xcd, 0x36, 0x57, 0x23,
-	0x5a, 0xca, 0xe1, 0xbf, 0x49, 0x0d, 0xdf, 0x20, 0xc8, 0xd1, 0xe8, 0xda, 0x4d, 0x5e, 0x11, 0xf9,
-	0xf4, 0x59, 0x24, 0x95, 0xae, 0xdd, 0x5c, 0x8d, 0x68, 0x6c, 0x75, 0x6c, 0x90, 0x2d, 0xc8, 0xd2,
-	0x63, 0xd9, 0x78, 0x64, 0x35, 0x0e, 0x74, 0xc3, 0xf6, 0xf2, 0xea, 0xcf, 0xbc, 0x84, 0x6a, 0xbd,
-	0x65, 0x1f, 0x54, 0x70, 0x7c, 0xc9, 0xc6, 0x60, 0x91, 0x69, 0x0b, 0x6d, 0xf2, 0x10, 0x68, 0x5b,
-	0x37, 0x4c, 0x87, 0x56, 0x0e, 0x58, 0xcd, 0xec, 0x0f, 0xce, 0xa0, 0x2b, 0x55, 0x35, 0xcd, 0x7a,
-	0x8f, 0xd5, 0x81, 0xfc, 0x36, 0xaa, 0x0d, 0xc9, 0x4a, 0xa6, 0xa3, 0x59, 0xef, 0x49, 0xd4, 0x28,
-	0x69, 0xf2, 0xbc, 0xd4, 0x25, 0xbb, 0x2f, 0x51, 0x33, 0xb9, 0x3d, 0x6a, 0x94, 0x7a, 0x0b, 0xb2,
-	0xe6, 0xa1, 0x7b, 0xa2, 0x37, 0x4e, 0x1a, 0x6d, 0x8b, 0xca, 0x9d, 0x3a, 0x53, 0x0d, 0xd5, 0x43,
-	0xf7, 0xa4, 0x82, 0xe3, 0x99, 0xa4, 0x19, 0x53, 0x68, 0x93, 0x87, 0x40, 0x9c, 0x63, 0xbd, 0x67,
-	0x38, 0x46, 0x07, 0x9f, 0x2c, 0x87, 0x3d, 0x4a, 0xca, 0x8e, 0xcb, 0xcc, 0xcb, 0xcc, 0x74, 0xbc,
-	0x8d, 0x73, 0xea, 0x38, 0x85, 0xf1, 0xe6, 0x1c, 0x19, 0x1a, 0x42, 0x8d, 0xca, 0x80, 0x0b, 0x51,
-	0x33, 0x0d, 0x48, 0xd4, 0x9e, 0x1a, 0xac, 0x23, 0xbd, 0xef, 0x1a, 0xee, 0x61, 0x9f, 0xd2, 0xa6,
-	0xcf, 0x56, 0x83, 0x75, 0x54, 0xa7, 0xe3, 0xb9, 0x37, 0x98, 0x42, 0x9b, 0x68, 0x90, 0xb3, 0xad,
-	0xc7, 0x7a, 0xe3, 0x91, 0x61, 0xdb, 0x56, 0x9b, 0xea, 0x20, 0x43, 0x19, 0xaf, 0xbf, 0x84, 0x71,
-	0xd3, 0x7a, 0x5c, 0x61, 0x13, 0x98, 0x06, 0xc6, 0x6c, 0x11, 0x08, 0x72, 0xa2, 0x94, 0x63, 0x17,
-	0xe0, 0x64, 0x62, 0x0a, 0x9c, 0x28, 0xa7, 0x01, 0x59, 0xb3, 0x2d, 0x89, 0x99, 0x3d, 0x7b, 0xe3,
-	0xeb, 0xbe, 0x50, 0x65, 0xf5, 0xf4, 0xc9, 0x54, 0x46, 0x44, 0xa8, 0x2a, 0xda, 0x82, 0xd8, 0xf2,
-	0x12, 0x28, 0x75, 0xee, 0xfc, 0x4b, 0xa0, 0x07, 0xcb, 0x4b, 0x78, 0xda, 0x6e, 0x0b, 0xbb, 0xf8,
-	0x3a, 0xde, 0x32, 0x18, 0x98, 0x31, 0x77, 0xf6, 0xbd, 0x4e, 0xa5, 0xeb, 0xbc, 0xf9, 0x52, 0xd7,
-	0xd8, 0xa1, 0x93, 0x04, 0xb7, 0x53, 0x9d, 0x00, 0x86, 0x7e, 0xe7, 0x86, 0x5d, 0x7a, 0xfc, 0x4c,
-	0xbf, 0xdb, 0x09, 0xbb, 0xb4, 0x1b, 0x70, 0x69, 0x1a, 0x10, 0x0f, 0xac, 0x13, 0x1a, 0x10, 0xc9,
-	0x39, 0x02, 0xe2, 0x81, 0x75, 0x32, 0x08, 0x88, 0xec, 0x37, 0x0b, 0x88, 0xc8, 0x41, 0x03, 0xe2,
-	0xc4, 0x39, 0x02, 0xe2, 0x81, 0x75, 0xe2, 0x07, 0x44, 0xde, 0x20, 0x0e, 0x4c, 0x60, 0x7c, 0x09,
-	0x6e, 0xf3, 0xd2, 0x99, 0x3a, 0x2c, 0x55, 0x35, 0x69, 0x53, 0xe5, 0x4b, 0xa7, 0x4f, 0xa6, 0xd4,
-	0x20, 0x8a, 0x9a, 0x35, 0x4c, 0x47, 0xde, 0xbe, 0x06, 0x39, 0xd3, 0x3a, 0x6a, 0x35, 0xd8, 0xa5,
-	0x4a, 0x7d, 0xe3, 0xb5, 0x33, 0x3d, 0xba, 0x4a, 0x67, 0xe0, 0x7d, 0xca, 0x3d, 0xda, 0x14, 0x01,
-	0xb2, 0x0b, 0x6a, 0xb3, 0xeb, 0x34, 0x30, 0x98, 0x79, 0xdf, 0x32, 0x26, 0x5f, 0x1f, 0x9e, 0x09,
-	0x0a, 0xa4, 0x2b, 0x38, 0x65, 0x50, 0x17, 0x5c, 0x8d, 0x68, 0xd9, 0xa6, 0x84, 0x10, 0x6b, 0xf0,
-	0x71, 0x24, 0xa8, 0xa1, 0xcb, 0x94, 0x7c, 0xf6, 0xa5, 0x1a, 0xc7, 0x89, 0x41, 0x75, 0x4c, 0x38,
-	0x61, 0xf8, 0x05, 0xcb, 0xa0, 0x62, 0x26, 0x2f, 0xbc, 0x0c, 0x53, 0x4f, 0x68, 0x19, 0x76, 0x59,
-	0x91, 0x1e, 0x3d, 0x2b, 0xed, 0x2e, 0x5e, 0xc6, 0xcd, 0x2e, 0xdd, 0xc9, 0x95, 0x33, 0x5d, 0x7a,
-	0x1b, 0xcf, 0x45, 0xbb, 0xeb, 0xae, 0xd9, 0xcd, 0x2e, 0x77, 0xe9, 0x9e, 0x0c, 0x91, 0x3d, 0x78,
-	0xcd, 0xa7, 0x16, 0x03, 0x4b, 0x9e, 0xb2, 0xdf, 0x38, 0x07, 0xbb, 0x14, 0x4c, 0x48, 0x2f, 0x84,
-	0x0e, 0x5f, 0x03, 0x95, 0xf4, 0x89, 0x8b, 0xae, 0xc1, 0x74, 0x14, 0x5c, 0x03, 0x55, 0xf4, 0x00,
-	0xc6, 0xf7, 0x2c, 0xa3, 0xd1, 0xb5, 0xbd, 0xb8, 0x82, 0xfc, 0x6f, 0x9c, 0xa9, 0xa1, 0x32, 0x9d,
-	0xc3, 0x22, 0x08, 0xbf, 0x6c, 0xf6, 0x64, 0x08, 0xbd, 0x9e, 0x33, 0x63, 0x5e, 0x47, 0x75, 0xf3,
-	0xc9, 0x33, 0xbd, 0x9e, 0xf1, 0x62, 0x66, 0xc8, 0xef, 0x86, 0x3d, 0x11, 0x08, 0x72, 0xa2, 0xac,
-	0x85, 0x0b, 0x70, 0xf2, 0x93, 0xb4, 0x27, 0x02, 0xc2, 0xe9, 0xec, 0x74, 0x4d, 0x9a, 0xb9, 0x4f,
-	0x4e, 0x9d, 0xf3, 0x74, 0x6e, 0x74, 0x4d, 0x8b, 0xc5, 0x29, 0x7e, 0x3a, 0x39, 0x80, 0xa7, 0x53,
-	0xe4, 0xa4, 0x21, 0xeb, 0xea, 0x99, 0xa7, 0xd3, 0x27, 0xe5, 0x71, 0x2b, 0x6b, 0x4a, 0x48, 0xfe,
-	0x01, 0xa4, 0xbc, 0x64, 0x91, 0xac, 0xc0, 0x58, 0xa7, 0x65, 0x77, 0x1d, 0xfd, 0xc8, 0x72, 0xfa,
-	0xf8, 0xe2, 0x7f, 0xd1, 0xb7, 0x44, 0x1c, 0x54, 0x06, 0x2f, 0x9b, 0x9d, 0x54, 0xb4, 0x0c, 0x9d,
-	0x77, 0x8f, 0x4d, 0x63, 0xf9, 0x72, 0xfe, 0x21, 0x8c, 0x0e, 0x32, 0xc8, 0x57, 0x4c, 0x6d, 0x41,
-	0x46, 0xcc, 0x28, 0xc9, 0x55, 0x18, 0xe9, 0x18, 0xce, 0x7e, 0x8b, 0xd1, 0x0e, 0x3e, 0x21, 0xfe,
-	0x9f, 0xa2, 0x71, 0x9c, 0xdc, 0x80, 0x31, 0xaf, 0xfa, 0xd0, 0xe8, 0x1e, 0xda, 0xe1, 0x6f, 0x8d,
-	0x19, 0xde, 0x5d, 0xc1, 0x5e, 0xbe, 0xcc, 0x8f, 0xa2, 0x20, 0xa4, 0x96, 0xc3, 0xaa, 0x56, 0xca,
-	0xef, 0x54, 0xb5, 0xba, 0x01, 0x59, 0xaf, 0x04, 0x23, 0x16, 0x2f, 0xe8, 0x47, 0xb8, 0x99, 0xe8,
-	0x64, 0x4e, 0xcb, 0xf0, 0x8a, 0x0c, 0x1b, 0xfe, 0x26, 0x64, 0xbc, 0x13, 0xdb, 0x31, 0xfa, 0x07,
-	0xac, 0x92, 0x49, 0xd9, 0xbf, 0xa7, 0x44, 0x55, 0x55, 0x4b, 0xf3, 0xde, 0x0d, 0xa3, 0x7f, 0x40,
-	0xee, 0xc0, 0x25, 0x71, 0x30, 0xfa, 0x8b, 0xeb, 0x74, 0xdb, 0xec, 0xd3, 0x83, 0xb7, 0x42, 0x52,
-	0x23, 0xc2, 0x9c, 0x0a, 0x1b, 0x42, 0xa6, 0x21, 0x65, 0xef, 0xe9, 0xae, 0x83, 0x47, 0x61, 0x44,
-	0x16, 0x28, 0x69, 0xef, 0xed, 0x20, 0xce, 0x14, 0xf4, 0x4e, 0x3c, 0x15, 0x57, 0x13, 0xf9, 0xef,
-	0x29, 0x20, 0xa4, 0xc9, 0xe4, 0x3a, 0xa8, 0xd2, 0xca, 0x46, 0xe3, 0x80, 0x7d, 0x2f, 0xd4, 0xb2,
-	0xc2, 0x62, 0xa5, 0xc6, 0x01, 0xb9, 0x01, 0x13, 0x01, 0x85, 0xd2, 0xc1, 0xf4, 0xcb, 0xa1, 0xa6,
-	0x4a, 0xba, 0xc2, 0xe1, 0x6f, 0xb2, 0x6c, 0xc2, 0x57, 0x97, 0xee, 0x7f, 0x40, 0xcc, 0x89, 0x9a,
-	0x2a, 0x35, 0x0e, 0xf2, 0x0d, 0xc8, 0x88, 0xd9, 0x36, 0xa9, 0x43, 0xb6, 0x63, 0x1c, 0xeb, 0x7e,
-	0xca, 0xce, 0x6d, 0x17, 0x4a, 0x1a, 0x4a, 0xfb, 0xfb, 0x8e, 0x85, 0xce, 0x60, 0x0e, 0xe6, 0x0b,
-	0x16, 0xcc, 0x74, 0x8c, 0xe3, 0x01, 0x9e, 0x7f, 0xae, 0x40, 0x2e, 0x90, 0x7e, 0xbf, 0xe8, 0xdd,
-	0xae, 0xfc, 0xae, 0xef, 0xf6, 0x87, 0x70, 0x49, 0x2e, 0x46, 0xf0, 0x9a, 0x7f, 0xf4, 0x5c, 0x35,
-	0x7f, 0x9f, 0x79, 0x5c, 0x28, 0x47, 0xf0, 0x52, 0xff, 0x6c, 0xb0, 0x24, 0x80, 0x3a, 0x8d, 0xd3,
-	0x8f, 0xc5, 0xc5, 0xf8, 0xf5, 0x1f, 0xfe, 0xf9, 0x88, 0x5c, 0x1d, 0xe0, 0xa7, 0xe3, 0x27, 0x81,
-	0xcd, 0xa3, 0xed, 0x17, 0xe0, 0xf2, 0x90, 0xcd, 0x0b, 0x2e, 0x30, 0x11, 0xdc, 0x17, 0x1a, 0x76,
-	0x09, 0x26, 0x87, 0x6d, 0x4d, 0x70, 0x86, 0x4b, 0x21, 0xa1, 0x71, 0xde, 0x0c, 0x8c, 0x4b, 0x72,
-	0x8b, 0xfe, 0x20, 0x0a, 0x8c, 0xfe, 0x60, 0x42, 0x46, 0x7c, 0x76, 0x90, 0x69, 0x48, 0xee, 0x19,
-	0xae, 0x6b, 0x39, 0x27, 0x72, 0xcc, 0xf8, 0x58, 0xd1, 0xbc, 0x0e, 0x32, 0x33, 0x08, 0x2b, 0x28,
-	0x45, 0xa2, 0x4c, 0x9e, 0x97, 0x73, 0xf9, 0xb1, 0xc9, 0xa9, 0xeb, 0x1f, 0x7d, 0xcc, 0xff, 0x1b,
-	0x04, 0x18, 0xae, 0x93, 0x1f, 0x44, 0x61, 0x4c, 0x7a, 0x8b, 0x60, 0xe0, 0xf1, 0x4e, 0x83, 0x50,
-	0x8b, 0x15, 0x03, 0x0f, 0xef, 0x66, 0x56, 0xfe, 0xac, 0x58, 0xa7, 0x8e, 0x52, 0x33, 0xa4, 0x9f,
-	0x97, 0x53, 0xc5, 0x91, 0xc9, 0x08, 0x35, 0x84, 0x50, 0xb4, 0xbe, 0x07, 0x13, 0x9d, 0x96, 0x1d,
-	0x72, 0xb4, 0xd8, 0x05, 0x1d, 0xad, 0xd3, 0xb2, 0x65, 0x47, 0x43, 0x5e, 0x3c, 0x29, 0xbf, 0x63,
-	0xd5, 0x0b, 0x0f, 0x8a, 0xd8, 0xc7, 0x35, 0xf4, 0x40, 0x54, 0x10, 0x1a, 0xe2, 0x1a, 0x8c, 0xc9,
-	0x06, 0x64, 0x8e, 0x92, 0x69, 0x0a, 0xd6, 0x23, 0xd3, 0x30, 0xe6, 0xcb, 0xe3, 0xbb, 0x45, 0xda,
-	0x8b, 0x11, 0x68, 0xe1, 0x36, 0x48, 0xaf, 0xa9, 0x8b, 0x6a, 0xfe, 0x33, 0x61, 0xcd, 0x0b, 0x07,
-	0xc0, 0xef, 0xe3, 0xfb, 0xd0, 0x41, 0x7a, 0x58, 0xa1, 0x2f, 0x4a, 0xab, 0x09, 0x5b, 0xc9, 0x89,
-	0xeb, 0xe0, 0x6e, 0x42, 0x5b, 0x8e, 0x86, 0xb7, 0x9c, 0xbf, 0x0b, 0x6a, 0xf0, 0x8d, 0x45, 0x6e,
-	0x43, 0x82, 0x15, 0x33, 0x95, 0xf3, 0x16, 0x33, 0xd9, 0xf8, 0xfc, 0xbf, 0x29, 0x90, 0x0b, 0x3c,
-	0xaa, 0xc8, 0x57, 0x59, 0x44, 0xb4, 0x5a, 0x4e, 0x4f, 0x8a, 0x51, 0xe1, 0xef, 0xa3, 0x34, 0x63,
-	0xa8, 0xad, 0x69, 0xdb, 0xe5, 0x49, 0xe1, 0x2b, 0x5f, 0x66, 0xc3, 0x38, 0x46, 0x90, 0x6e, 0x8b,
-	0x06, 0xc6, 0x5a, 0xcb, 0xe9, 0x31, 0x65, 0xce, 0xc0, 0x38, 0x2f, 0x37, 0x9b, 0x8f, 0xad, 0x76,
-	0x9b, 0x55, 0xfe, 0xd8, 0x2e, 0x73, 0xac, 0xa3, 0x8a, 0x38, 0x2d, 0xed, 0xcd, 0xc2, 0xc4, 0xa0,
-	0xd4, 0x2b, 0x8c, 0x66, 0xe7, 0x78, 0xdc, 0xeb, 0x1a, 0x8c, 0xcf, 0x6f, 0x63, 0xc6, 0xc2, 0x5f,
-	0x70, 0xd5, 0x0b, 0xa5, 0x15, 0x62, 0x18, 0x17, 0x92, 0x8a, 0xfc, 0x57, 0x30, 0x53, 0xf1, 0x5e,
-	0x73, 0xaf, 0x86, 0xf2, 0xfd, 0x28, 0x84, 0x1e, 0x72, 0xe4, 0x04, 0x5e, 0xf7, 0xfe, 0x42, 0xa6,
-	0xdd, 0xea, 0xb4, 0x5c, 0xdd, 0x3a, 0xee, 0x75, 0x6d, 0xcb, 0x76, 0x5f, 0x78, 0x17, 0xd1, 0x3f,
-	0x9c, 0x59, 0xc7, 0xb1, 0x35, 0x3e, 0xb4, 0x3c, 0x25, 0x98, 0x60, 0x62, 0xc8, 0x00, 0x6d, 0x82,
-	0xfd, 0x8d, 0x8d, 0x04, 0x8a, 0x4b, 0x53, 0x8f, 0xf0, 0x97, 0x8e, 0xbe, 0x6c, 0x69, 0xea, 0x4e,
-	0x2f, 0x5b, 0x5a, 0x1a, 0xe0, 0x2d, 0x2d, 0x81, 0xf9, 0xaf, 0xc0, 0x98, 0xf4, 0xf0, 0x24, 0x6f,
-	0x9d, 0xfb, 0x0b, 0x99, 0xfa, 0xbc, 0x9c, 0xf8, 0x27, 0x25, 0x9a, 0x52, 0x06, 0x5f, 0x44, 0xe8,
-	0xcc, 0xfc, 0x07, 0x51, 0xc8, 0xca, 0xef, 0xce, 0x57, 0xfc, 0xf7, 0x2b, 0xaf, 0xfc, 0x03, 0xe5,
-	0x75, 0x48, 0xe3, 0x61, 0x73, 0x2c, 0xd7, 0x69, 0x59, 0x7d, 0xfe, 0xd7, 0x58, 0x83, 0x2c, 0x0c,
-	0x3a, 0xc6, 0xb1, 0xc6, 0xba, 0xc8, 0x7d, 0xc8, 0xf5, 0x2c, 0xa7, 0xd5, 0x35, 0x7d, 0x13, 0xc5,
-	0x87, 0xd7, 0x98, 0xf9, 0xab, 0x95, 0x0e, 0x1e, 0xd8, 0xc8, 0x97, 0x20, 0xdb, 0x93, 0x7a, 0x78,
-	0xdc, 0xfa, 0x0f, 0x05, 0x26, 0x86, 0xbc, 0xaa, 0xc9, 0xd7, 0x80, 0xa0, 0x80, 0x34, 0x39, 0x3e,
-	0xd3, 0x2f, 0x19, 0x01, 0x4d, 0x95, 0x87, 0x2c, 0x8c, 0xa1, 0x5f, 0xea, 0xc3, 0x17, 0x21, 0x92,
-	0xd3, 0x52, 0x45, 0xc0, 0xf1, 0xa6, 0x87, 0x73, 0xa3, 0x0f, 0x0c, 0xa1, 0xce, 0x75, 0x8c, 0x63,
-	0xb1, 0x2b, 0xbf, 0x1a, 0xde, 0x0d, 0xba, 0xd8, 0x1c, 0xbc, 0x16, 0x5a, 0x50, 0x88, 0xc8, 0x24,
-	0x40, 0x83, 0xf1, 0xb6, 0x0e, 0xb9, 0xc0, 0x1b, 0x9d, 0xbc, 0x05, 0x23, 0x4c, 0x87, 0x2f, 0xfa,
-	0xc3, 0x0a, 0x6f, 0x02, 0xb3, 0x81, 0x20, 0x27, 0x9f, 0x97, 0xff, 0x4b, 0x05, 0x48, 0xf8, 0x6d,
-	0x2e, 0xdf, 0xf2, 0xca, 0x4b, 0x6f, 0xf9, 0x57, 0xed, 0x89, 0xdc, 0x0d, 0x1e, 0x85, 0xe4, 0x3a,
-	0xf7, 0x5d, 0x7c, 0xb1, 0xac, 0x3d, 0xbf, 0x0f, 0xb9, 0xc0, 0xcb, 0x9e, 0x4c, 0x89, 0xd7, 0x98,
-	0xf4, 0x47, 0x89, 0x0c, 0x0f, 0x5f, 0xdd, 0xd1, 0x97, 0x5d, 0xdd, 0x7c, 0x4b, 0x6f, 0xc1, 0x98,
-	0xf4, 0xd4, 0xbf, 0x80, 0x96, 0x39, 0xc3, 0x82, 0xc8, 0x70, 0x5e, 0x7d, 0xe4, 0x57, 0xbc, 0xf8,
-	0xe6, 0xbd, 0xd4, 0x17, 0xcf, 0xf3, 0xa1, 0x53, 0xbc, 0xa3, 0xe9, 0xe8, 0xfc, 0xdb, 0x90, 0x95,
-	0x5f, 0xeb, 0xbf, 0x25, 0x11, 0xff, 0x7b, 0xe1, 0x51, 0x48, 0xf2, 0x0f, 0x4a, 0xd3, 0x73, 0x90,
-	0x1d, 0xe4, 0xc2, 0xf7, 0x8c, 0xf6, 0xa1, 0x85, 0x16, 0x38, 0xc2, 0x1f, 0x5c, 0x2d, 0x42, 0xa2,
-	0xc3, 0xf0, 0xe9, 0x5d, 0x98, 0x90, 0xf3, 0x6d, 0x36, 0xef, 0x4b, 0xe2, 0xbc, 0x8b, 0xbc, 0x3a,
-	0x38, 0x6d, 0x1d, 0x88, 0xe4, 0xa6, 0x8c, 0xf5, 0x8b, 0x32, 0xeb, 0xb9, 0x3d, 0xdb, 0x97, 0x55,
-	0x3e, 0x88, 0xe7, 0x93, 0xf5, 0x85, 0x87, 0x97, 0xd3, 0xea, 0x30, 0x39, 0xe4, 0x2d, 0xc8, 0xb8,
-	0x2b, 0x32, 0xf7, 0x05, 0x1f, 0x91, 0x7c, 0x81, 0xb7, 0x21, 0xc3, 0x13, 0x36, 0x46, 0x7a, 0x5b,
-	0x26, 0x3d, 0x4f, 0x76, 0xe7, 0x4b, 0x1a, 0x4e, 0x04, 0xce, 0x29, 0xe9, 0x90, 0x14, 0xe3, 0xc5,
-	0x0b, 0x48, 0x37, 0xfb, 0x45, 0x16, 0x90, 0x13, 0x89, 0xd0, 0x02, 0x1b, 0x90, 0xf3, 0xb3, 0x4c,
-	0xc6, 0xfb, 0x39, 0x99, 0xf7, 0x65, 0x59, 0x69, 0x90, 0x6e, 0xe6, 0x07, 0x0a, 0x24, 0xe8, 0x1f,
-	0xbc, 0x13, 0x15, 0x32, 0xef, 0x6c, 0xad, 0x6d, 0xea, 0x5a, 0xed, 0x2b, 0xbb, 0xb5, 0xfa, 0x8e,
-	0x1a, 0x21, 0x39, 0x48, 0x53, 0xa4, 0x54, 0xa9, 0xd4, 0xb6, 0x77, 0x54, 0x85, 0x10, 0xc8, 0xee,
-	0x6e, 0x56, 0xb6, 0x36, 0x57, 0xd6, 0xb4, 0x8d, 0x5a, 0x55, 0xdf, 0xdd, 0x56, 0xa3, 0xe4, 0x12,
-	0xa8, 0x22, 0x56, 0xdd, 0xba, 0xbf, 0xa9, 0xc6, 0x90, 0x4c, 0x1a, 0x17, 0xc7, 0xb9, 0x81, 0x51,
-	0x09, 0xc4, 0xb4, 0x9a, 0xb4, 0xe8, 0x08, 0x2e, 0xba, 0xad, 0x6d, 0x6d, 0x6b, 0x6b, 0xb5, 0x9d,
-	0x92, 0xf6, 0x50, 0x4d, 0xce, 0x5c, 0x86, 0x04, 0xfd, 0xd3, 0x7a, 0x92, 0x05, 0x58, 0xdf, 0xd2,
-	0x4a, 0xf7, 0x4b, 0x9b, 0xba, 0x36, 0xa7, 0x46, 0x66, 0xbe, 0xa3, 0xd0, 0xaf, 0xcc, 0x3c, 0x8f,
-	0xc4, 0x89, 0x1b, 0xa5, 0x8a, 0xbe, 0xbb, 0x79, 0x77, 0x13, 0xd9, 0x23, 0x24, 0x03, 0x29, 0x04,
-	0xee, 0xcd, 0xe9, 0xb7, 0x54, 0x05, 0x67, 0x7b, 0x2d, 0x7d, 0x4e, 0x8d, 0x4a, 0xed, 0xa2, 0x1a,
-	0x13, 0x46, 0xcf, 0xa9, 0x71, 0xa9, 0x77, 0x5e, 0x4d, 0x48, 0xed, 0x05, 0x75, 0x24, 0x9f, 0x7a,
-	0xff, 0xef, 0x0a, 0x91, 0x9f, 0xfe, 0xa8, 0x10, 0x99, 0xf9, 0x47, 0x05, 0x60, 0x7b, 0xf5, 0xa1,
-	0x20, 0xc5, 0xf6, 0xea, 0x43, 0x59, 0x0a, 0x04, 0x7c, 0x29, 0xbc, 0x16, 0x95, 0xe2, 0x12, 0xa8,
-	0x83, 0x76, 0x51, 0xd7, 0x6a, 0xf7, 0xf4, 0x92, 0x1a, 0x1b, 0x82, 0x96, 0x99, 0x06, 0x39, 0x3a,
-	0xc7, 0x47, 0x26, 0x42, 0x58, 0x59, 0x1d, 0x91, 0x66, 0xcf, 0xf3, 0x91, 0x49, 0x51, 0xe2, 0x28,
-	0x8c, 0xc9, 0xef, 0xd8, 0x1c, 0xa4, 0xab, 0xa5, 0x9d, 0x92, 0xae, 0x95, 0x76, 0x6a, 0xfa, 0x2d,
-	0x66, 0x79, 0x1f, 0x98, 0x53, 0x15, 0x19, 0x28, 0xaa, 0x51, 0x19, 0x98, 0x57, 0x63, 0x32, 0xb0,
-	0xa0, 0xc6, 0x65, 0x60, 0x51, 0x4d, 0xc8, 0xc0, 0x12, 0x33, 0xb5, 0x0f, 0xdc, 0x56, 0x93, 0x32,
-	0xb0, 0xac, 0xa6, 0x64, 0xe0, 0x8e, 0x3a, 0x8a, 0x7e, 0x25, 0x08, 0x76, 0x4b, 0x85, 0x00, 0x32,
-	0xa7, 0xa6, 0x03, 0x48, 0x51, 0xcd, 0x04, 0x90, 0x79, 0x75, 0x2c, 0x80, 0x2c, 0xa8, 0xd9, 0x00,
-	0xb2, 0xa8, 0xe6, 0x04, 0x8d, 0xfd, 0x97, 0x02, 0xd9, 0x40, 0x21, 0xe8, 0x75, 0x20, 0xfe, 0xf0,
-	0xad, 0x95, 0x95, 0x7a, 0x6d, 0x87, 0x6a, 0x6e, 0x18, 0x8e, 0x0a, 0x1c, 0x86, 0xa3, 0x1e, 0x87,
-	0xe1, 0xa8, 0xce, 0x61, 0x38, 0x6a, 0x75, 0x18, 0x8e, 0xca, 0x1d, 0x86, 0xa3, 0x8e, 0x87, 0xe1,
-	0xb7, 0xd5, 0x64, 0x3e, 0x4e, 0x37, 0xb6, 0x0b, 0xb9, 0x77, 0xe4, 0x2c, 0x5f, 0x38, 0x93, 0x95,
-	0xad, 0xcd, 0x9d, 0xda, 0x03, 0x0c, 0x04, 0x3e, 0x56, 0xaf, 0xd5, 0xeb, 0x6b, 0x5b, 0x9b, 0xcc,
-	0x23, 0x38, 0x76, 0xb7, 0xf6, 0xb0, 0xae, 0x46, 0xc9, 0x28, 0xc4, 0xb1, 0xa9, 0x7e, 0xac, 0xcc,
-	0xdc, 0x86, 0xf1, 0xd0, 0xf3, 0x81, 0xa4, 0x21, 0xe9, 0x33, 0xa6, 0x21, 0xe9, 0x53, 0xa5, 0x20,
-	0xce, 0x38, 0x66, 0x6e, 0x01, 0xf8, 0x7f, 0xaf, 0x8a, 0x4b, 0xac, 0xd0, 0xc0, 0xb0, 0x59, 0x59,
-	0xab, 0xd5, 0xd5, 0x08, 0x19, 0x87, 0xb1, 0xca, 0x6a, 0x69, 0x73, 0xb3, 0xb6, 0xae, 0x6f, 0x94,
-	0xea, 0x77, 0xeb, 0xaa, 0x32, 0xb3, 0x00, 0x09, 0x7a, 0xb7, 0x53, 0xfa, 0xf5, 0x52, 0xbd, 0xae,
-	0x97, 0x18, 0x3d, 0x6b, 0x94, 0x55, 0xc5, 0x6f, 0x54, 0xd4, 0x68, 0x3e, 0x8e, 0x46, 0x9d, 0xe9,
-	0x01, 0x09, 0xff, 0x51, 0x0e, 0x01, 0x18, 0x59, 0xdf, 0xba, 0xcf, 0x62, 0x5f, 0x12, 0x62, 0xeb,
-	0x5b, 0xf7, 0x55, 0x05, 0xfd, 0xa2, 0x5c, 0x5b, 0xdf, 0xba, 0xaf, 0x6f, 0x6e, 0x69, 0x1b, 0xa5,
-	0x75, 0x35, 0x8a, 0xc3, 0xf8, 0x6f, 0x1a, 0xe7, 0x4a, 0xe5, 0xad, 0x7b, 0x35, 0xaf, 0x37, 0x8e,
-	0x9b, 0x59, 0x5d, 0x7b, 0x7b, 0x55, 0x4d, 0xe0, 0xba, 0xf8, 0x8b, 0x86, 0xb5, 0x99, 0x5f, 0xc6,
-	0xe0, 0xd2, 0xb0, 0xbf, 0x74, 0x21, 0x63, 0x30, 0x5a, 0x59, 0xab, 0xea, 0xda, 0xca, 0x2e, 0xf5,
-	0x1f, 0xaf, 0x59, 0xab, 0xd7, 0x78, 0xc4, 0xc5, 0xe6, 0xfa, 0xda, 0xe6, 0x5d, 0xbd, 0xb2, 0x5a,
-	0xab, 0xdc, 0x55, 0xa3, 0x34, 0xb6, 0x7a, 0x58, 0xa9, 0xaa, 0xa9, 0x31, 0x6f, 0x54, 0x75, 0x77,
-	0xe7, 0xa1, 0x5e, 0x79, 0x58, 0x59, 0xaf, 0x31, 0x47, 0xa1, 0x44, 0x0f, 0xf4, 0xed, 0x92, 0x56,
-	0xda, 0xd0, 0xeb, 0xb5, 0x9d, 0xdd, 0x6d, 0x16, 0x31, 0xe8, 0xd8, 0xda, 0x3d, 0xbd, 0xbe, 0x53,
-	0xda, 0xd9, 0xad, 0xab, 0x23, 0x64, 0x02, 0x72, 0x88, 0x6d, 0xd6, 0xee, 0xeb, 0x5c, 0xbf, 0x6a,
-	0x92, 0x5c, 0x86, 0x09, 0x4e, 0xb0, 0xb3, 0xb6, 0xb1, 0xb6, 0xf9, 0x36, 0x67, 0x48, 0x79, 0xcc,
-	0x3b, 0x32, 0xf3, 0xe8, 0x80, 0x79, 0x7d, 0x40, 0x02, 0xfe, 0x76, 0xee, 0xd6, 0x1e, 0xaa, 0x69,
-	0x8f, 0xb3, 0x54, 0xd5, 0xa4, 0xb9, 0x19, 0x4f, 0x82, 0x6a, 0xed, 0xde, 0x5a, 0xa5, 0x86, 0x0b,
-	0xd6, 0xd4, 0x31, 0x0c, 0x64, 0x08, 0xae, 0x6c, 0x69, 0x95, 0x9a, 0xce, 0x9c, 0x4d, 0xcd, 0x92,
-	0x3c, 0xbc, 0xce, 0x28, 0xa9, 0xf3, 0x89, 0x34, 0x39, 0x4f, 0xb4, 0x6d, 0x2a, 0xee, 0xfa, 0xd6,
-	0x8e, 0xbe, 0xb6, 0xb9, 0xb2, 0xa5, 0xaa, 0xe4, 0x0a, 0xbc, 0x26, 0xe3, 0x9e, 0x84, 0xe3, 0xe4,
-	0x35, 0x18, 0xc7, 0xae, 0x72, 0xad, 0x54, 0xd9, 0xda, 0xe4, 0x5b, 0x55, 0x89, 0x27, 0x10, 0x87,
-	0xd1, 0x0d, 0xd5, 0x89, 0x80, 0x94, 0x1b, 0x5b, 0xd5, 0x9a, 0x7a, 0x95, 0x7b, 0xd4, 0x2f, 0xa2,
-	0x30, 0x31, 0x24, 0x95, 0xa1, 0x61, 0x65, 0x60, 0x16, 0x7d, 0x4e, 0x8d, 0x04, 0x90, 0x22, 0x73,
-	0x31, 0x01, 0x59, 0x60, 0x26, 0x16, 0x90, 0x65, 0x35, 0x86, 0xae, 0x2f, 0xf2, 0x2c, 0xa9, 0xf1,
-	0x00, 0x34, 0x5f, 0x54, 0x13, 0x01, 0x68, 0x69, 0x41, 0x1d, 0x41, 0xab, 0x88, 0x13, 0x8b, 0xcb,
-	0x6a, 0x32, 0x80, 0x15, 0x17, 0x97, 0xd4, 0x54, 0x00, 0x5b, 0x9c, 0x2b, 0xaa, 0xa3, 0xb8, 0x5f,
-	0x71, 0xee, 0xad, 0xe2, 0x82, 0x0a, 0x01, 0xb0, 0x78, 0x6b, 0x61, 0x59, 0x4d, 0x07, 0xc0, 0x85,
-	0x5b, 0x77, 0x96, 0x98, 0x51, 0xc5, 0x5d, 0xcc, 0xdd, 0x29, 0x32, 0xa3, 0x4a, 0x1b, 0x99, 0x5f,
-	0xc6, 0xe8, 0x2b, 0xa3, 0xf3, 0xc5, 0xdb, 0x4b, 0xcb, 0x6a, 0x8e, 0xab, 0xf6, 0x9f, 0x15, 0xc8,
-	0xca, 0x19, 0x28, 0xee, 0x93, 0xda, 0xb2, 0x76, 0xaf, 0xa6, 0x3d, 0xd4, 0xe7, 0x78, 0x6c, 0x10,
-	0xa0, 0x62, 0x5d, 0x55, 0x02, 0xd0, 0x02, 0x06, 0x29, 0x19, 0x5a, 0xae, 0xb3, 0xc3, 0x23, 0x72,
-	0x2d, 0xd5, 0xf9, 0x55, 0xeb, 0x63, 0xf3, 0xc5, 0x3a, 0xbf, 0x6a, 0x7d, 0x6c, 0x69, 0x81, 0x1f,
-	0x1c, 0x71, 0x6e, 0x71, 0xb9, 0x8e, 0xa1, 0x95, 0x4a, 0xfd, 0x67, 0x31, 0xef, 0xbd, 0x2d, 0x3f,
-	0xf0, 0x27, 0x20, 0x37, 0x88, 0xaf, 0xbb, 0x9b, 0x3b, 0x68, 0xca, 0x48, 0x08, 0x9c, 0x47, 0xb7,
-	0x08, 0x82, 0x4b, 0x0b, 0x2c, 0x61, 0x90, 0xa7, 0x17, 0x97, 0x59, 0xc2, 0x20, 0xa1, 0x68, 0xd2,
-	0x78, 0x08, 0x45, 0xa3, 0x26, 0xd0, 0xe1, 0x65, 0x06, 0x34, 0xeb, 0x48, 0x08, 0xa6, 0x86, 0x4d,
-	0x86, 0x60, 0x6a, 0xda, 0x54, 0x08, 0xa6, 0xc6, 0x1d, 0xc5, 0xf3, 0x17, 0xd8, 0x1c, 0x9a, 0x17,
-	0x42, 0x38, 0x33, 0x70, 0x3a, 0x84, 0x2f, 0x2d, 0x2e, 0xce, 0xa3, 0xe7, 0x5c, 0x86, 0x09, 0x99,
-	0x67, 0x7e, 0xee, 0xd6, 0x6d, 0xf4, 0x9e, 0x60, 0x47, 0x71, 0xa9, 0x38, 0xb7, 0x80, 0x0e, 0x14,
-	0xec, 0x58, 0x2c, 0x2e, 0x14, 0x97, 0x7d, 0x1f, 0xfa, 0x30, 0x0a, 0x24, 0x5c, 0x2e, 0x41, 0x77,
-	0xe0, 0xb3, 0x30, 0xe4, 0xd0, 0x00, 0x1c, 0x80, 0xe6, 0x98, 0x1f, 0x89, 0x50, 0x91, 0xf9, 0x91,
-	0x08, 0xcd, 0xb3, 0x13, 0x2a, 0x42, 0x0b, 0xec, 0x84, 0x8a, 0xd0, 0x22, 0x3b, 0xa1, 0x22, 0x84,
-	0x57, 0x74, 0x00, 0xc2, 0x44, 0x28, 0x00, 0x61, 0x2a, 0x14, 0x80, 0xee, 0xb0, 0x80, 0x2b, 0x89,
-	0x8a, 0xe9, 0x50, 0x10, 0xc3, 0x84, 0x28, 0x88, 0x61, 0x4a, 0x14, 0xc4, 0x30, 0x29, 0x0a, 0x62,
-	0xa8, 0xd7, 0x20, 0xb6, 0x38, 0x50, 0xe9, 0xbf, 0x2a, 0xde, 0xbf, 0x8f, 0x93, 0xeb, 0x6a, 0x82,
-	0xdf, 0x6e, 0xd7, 0xb4, 0xb5, 0xad, 0x2a, 0x55, 0x6b, 0x08, 0x9c, 0x93, 0x3c, 0x9c, 0x83, 0xa8,
-	0xda, 0x10, 0x88, 0xca, 0x0d, 0x81, 0xa8, 0xde, 0x10, 0x88, 0x0a, 0x0e, 0x81, 0x4b, 0xec, 0x9c,
-	0xca, 0xe0, 0xed, 0xc1, 0x39, 0xfd, 0xcf, 0x28, 0x80, 0xff, 0x40, 0xa2, 0x11, 0x94, 0x85, 0x77,
-	0x6c, 0xea, 0xcb, 0x2c, 0xfb, 0x11, 0xa1, 0xb9, 0x5b, 0xec, 0x5e, 0x96, 0x30, 0x14, 0x3c, 0x88,
-	0xcd, 0xb3, 0xe0, 0x22, 0x61, 0x0b, 0x2c, 0xb8, 0x48, 0xd8, 0x12, 0x0b, 0x2e, 0x12, 0xb6, 0xcc,
-	0x23, 0xb7, 0x80, 0x15, 0x6f, 0xf1, 0xc8, 0x2d, 0x62, 0x73, 0x3c, 0x72, 0x8b, 0xd8, 0x02, 0x73,
-	0x0d, 0x09, 0x5b, 0x62, 0xae, 0x21, 0x61, 0xb7, 0x99, 0x6b, 0x48, 0xd8, 0x1d, 0xe6, 0x1a, 0x22,
-	0x36, 0x7f, 0x8b, 0xb9, 0x86, 0x84, 0xcd, 0x33, 0xd7, 0x90, 0xb0, 0xa5, 0x81, 0x6b, 0xbc, 0x1f,
-	0x83, 0x61, 0xf5, 0x76, 0x34, 0x03, 0x5e, 0xfd, 0xa5, 0xca, 0x5d, 0x7d, 0x7d, 0x6d, 0x63, 0x6d,
-	0x87, 0xde, 0x87, 0x21, 0x90, 0xc7, 0x3e, 0x19, 0x5c, 0x60, 0x9e, 0x21, 0x83, 0x3c, 0xf4, 0x05,
-	0x38, 0x79, 0xe8, 0x93, 0x51, 0x7a, 0x3d, 0x86, 0xd0, 0x25, 0x1e, 0xf9, 0x02, 0x0c, 0x45, 0x1e,
-	0xf9, 0x02, 0x72, 0x2d, 0xf2, 0xc8, 0x27, 0xc3, 0xec, 0xaa, 0x7c, 0x1d, 0x48, 0x80, 0x84, 0xdd,
-	0x96, 0x21, 0x9c, 0x5f, 0x98, 0x21, 0x9c, 0xdf, 0x99, 0x21, 0x9c, 0x5f, 0x9b, 0x97, 0xa9, 0x46,
-	0xa5, 0x6d, 0xb2, 0x9b, 0x33, 0xd4, 0x21, 0x5f, 0x9e, 0xbe, 0x29, 0xa4, 0xba, 0x82, 0xa8, 0xcb,
-	0x6a, 0x6d, 0xbd, 0xf4, 0x30, 0x68, 0x0a, 0x06, 0x06, 0x4c, 0xc1, 0xc0, 0x80, 0x29, 0x18, 0x18,
-	0x30, 0x05, 0xe7, 0x0c, 0x98, 0x82, 0xa1, 0x41, 0x53, 0x30, 0x34, 0x68, 0x0a, 0xce, 0x10, 0x34,
-	0x05, 0x97, 0x2b, 0x68, 0x0a, 0x06, 0x87, 0x4c, 0xc1, 0x49, 0x42, 0xa6, 0xe0, 0x2c, 0x21, 0x53,
-	0xf0, 0x0d, 0x86, 0x4c, 0xc1, 0xf7, 0x18, 0x32, 0x85, 0xb7, 0xcd, 0x90, 0x29, 0xbc, 0x9d, 0x8a,
-	0xa6, 0xf8, 0x7e, 0x14, 0x92, 0xbc, 0x30, 0x45, 0xb2, 0x00, 0xda, 0x03, 0x3e, 0x0a, 0xc3, 0xa3,
-	0xd8, 0x9e, 0x63, 0x75, 0x82, 0x41, 0xbb, 0xc8, 0xaa, 0x15, 0x83, 0x36, 0xc6, 0x15, 0xb1, 0xbd,
-	0xc0, 0xea, 0x15, 0x83, 0xf6, 0x22, 0xab, 0x57, 0x0c, 0xda, 0x18, 0x00, 0xc5, 0x36, 0x5e, 0x30,
-	0x62, 0x1b, 0x6f, 0x17, 0xb1, 0x8d, 0x57, 0x0b, 0xbe, 0xee, 0x06, 0xf2, 0xe0, 0xbd, 0x22, 0x01,
-	0x78, 0xa9, 0x48, 0x00, 0xde, 0x28, 0x12, 0x80, 0xd7, 0x89, 0x04, 0xa0, 0x7e, 0x24, 0x40, 0x7e,
-	0x61, 0xff, 0x20, 0x0a, 0x09, 0xfa, 0x99, 0x90, 0x96, 0x71, 0xd6, 0x36, 0xb7, 0xb4, 0xc1, 0x8b,
-	0x28, 0x0d, 0x49, 0x06, 0xf0, 0x3a, 0x84, 0xdf, 0xcb, 0xeb, 0x10, 0x3e, 0xc0, 0xeb, 0x10, 0x3e,
-	0xc0, 0xeb, 0x10, 0x3e, 0xc0, 0xeb, 0x10, 0x3e, 0xc0, 0xeb, 0x10, 0x3e, 0xc0, 0xeb, 0x10, 0x3e,
-	0xc0, 0xeb, 0x10, 0x3e, 0xc0, 0xeb, 0x10, 0x3e, 0xe0, 0xd5, 0x21, 0x04, 0x84, 0xd7, 0x21, 0x04,
-	0x84, 0xd7, 0x21, 0x04, 0x84, 0xd7, 0x21, 0x04, 0x84, 0xd7, 0x21, 0x04, 0x64, 0x70, 0xdd, 0x96,
-	0xff, 0x5e, 0xf9, 0xf9, 0xd3, 0x82, 0xf2, 0xe1, 0xd3, 0x82, 0xf2, 0x8b, 0xa7, 0x85, 0xc8, 0x47,
-	0x4f, 0x0b, 0x91, 0x5f, 0x3d, 0x2d, 0x44, 0x7e, 0xfd, 0xb4, 0x10, 0xf9, 0xcd, 0xd3, 0x82, 0xf2,
-	0xad, 0xd3, 0x82, 0xf2, 0xfe, 0x69, 0x21, 0xf2, 0xe3, 0xd3, 0x82, 0xf2, 0xd3, 0xd3, 0x42, 0xe4,
-	0x83, 0xd3, 0x42, 0xe4, 0x67, 0xa7, 0x85, 0xc8, 0xcf, 0x4f, 0x0b, 0xca, 0x87, 0xa7, 0x05, 0xe5,
-	0x17, 0xa7, 0x85, 0xc8, 0x47, 0xa7, 0x05, 0xe5, 0x57, 0xa7, 0x85, 0xc8, 0xaf, 0x4f, 0x0b, 0xca,
-	0x6f, 0x4e, 0x0b, 0x91, 0x6f, 0x3d, 0x2b, 0x44, 0xde, 0x7f, 0x56, 0x50, 0xbe, 0xfb, 0xac, 0x10,
-	0xf9, 0xeb, 0x67, 0x05, 0xe5, 0x87, 0xcf, 0x0a, 0x91, 0x1f, 0x3f, 0x2b, 0x44, 0x7e, 0xfa, 0xac,
-	0xa0, 0x7c, 0xf0, 0xac, 0xa0, 0xfc, 0xec, 0x59, 0x41, 0xf9, 0xea, 0xcd, 0x0b, 0xfc, 0xcb, 0x62,
-	0xd7, 0xee, 0xed, 0xed, 0x8d, 0xd0, 0x8f, 0x93, 0xf3, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xad,
-	0xbc, 0x8b, 0x5b, 0x59, 0x46, 0x00, 0x00,
+func (m *DataRateOffsetValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x MType) String() string {
-	s, ok := MType_name[int32(x)]
-	if ok {
-		return s
+func (m *DataRateOffsetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRateOffsetValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x Major) String() string {
-	s, ok := Major_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateOffsetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateOffsetValue.Merge(m, src)
 }
-func (x JoinRequestType) String() string {
-	s, ok := JoinRequestType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateOffsetValue) XXX_Size() int {
+	return m.Size()
 }
-func (x RejoinRequestType) String() string {
-	s, ok := RejoinRequestType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateOffsetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateOffsetValue.DiscardUnknown(m)
 }
-func (x CFListType) String() string {
-	s, ok := CFListType_name[int32(x)]
-	if ok {
-		return s
+
+var xxx_messageInfo_DataRateOffsetValue proto.InternalMessageInfo
+
+func (m *DataRateOffsetValue) GetValue() DataRateOffset {
+	if m != nil {
+		return m.Value
 	}
-	return strconv.Itoa(int(x))
+	return DataRateOffset_DATA_RATE_OFFSET_0
 }
-func (x Class) String() string {
-	s, ok := Class_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+type DataRateIndexValue struct {
+	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (x TxSchedulePriority) String() string {
-	s, ok := TxSchedulePriority_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
+func (*DataRateIndexValue) ProtoMessage() {}
+func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
 }
-func (x MACCommandIdentifier) String() string {
-	s, ok := MACCommandIdentifier_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (x AggregatedDutyCycle) String() string {
-	s, ok := AggregatedDutyCycle_name[int32(x)]
-	if ok {
-		return s
+func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x PingSlotPeriod) String() string {
-	s, ok := PingSlotPeriod_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
 }
-func (x RejoinCountExponent) String() string {
-	s, ok := RejoinCountExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateIndexValue) XXX_Size() int {
+	return m.Size()
 }
-func (x RejoinTimeExponent) String() string {
-	s, ok := RejoinTimeExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataRateIndexValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
 }
-func (x RejoinPeriodExponent) String() string {
-	s, ok := RejoinPeriodExponent_name[int32(x)]
-	if ok {
-		return s
+
+var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+
+func (m *DataRateIndexValue) GetValue() DataRateIndex {
+	if m != nil {
+		return m.Value
 	}
-	return strconv.Itoa(int(x))
+	return DATA_RATE_0
 }
-func (x DeviceEIRP) String() string {
-	s, ok := DeviceEIRP_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+type PingSlotPeriodValue struct {
+	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (x ADRAckLimitExponent) String() string {
-	s, ok := ADRAckLimitExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
+func (*PingSlotPeriodValue) ProtoMessage() {}
+func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
 }
-func (x ADRAckDelayExponent) String() string {
-	s, ok := ADRAckDelayExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (x Minor) String() string {
-	s, ok := Minor_name[int32(x)]
-	if ok {
-		return s
+func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (this *Message) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+}
+func (m *PingSlotPeriodValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Message)
-	if !ok {
-		that2, ok := that.(Message)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.MHDR.Equal(&that1.MHDR) {
-		return false
-	}
-	if !bytes.Equal(this.MIC, that1.MIC) {
-		return false
-	}
-	if that1.Payload == nil {
-		if this.Payload != nil {
-			return false
-		}
-	} else if this.Payload == nil {
-		return false
-	} else if !this.Payload.Equal(that1.Payload) {
-		return false
+var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+
+func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+	if m != nil {
+		return m.Value
 	}
-	return true
+	return PING_EVERY_1S
 }
-func (this *Message_MACPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Message_MACPayload)
-	if !ok {
-		that2, ok := that.(Message_MACPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.MACPayload.Equal(that1.MACPayload) {
-		return false
-	}
-	return true
+type AggregatedDutyCycleValue struct {
+	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Message_JoinRequestPayload)
-	if !ok {
-		that2, ok := that.(Message_JoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
+func (*AggregatedDutyCycleValue) ProtoMessage() {}
+func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
+}
+func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
-		return false
-	}
-	return true
 }
-func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+}
+func (m *AggregatedDutyCycleValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Message_JoinAcceptPayload)
-	if !ok {
-		that2, ok := that.(Message_JoinAcceptPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
-		return false
+var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+
+func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
+	if m != nil {
+		return m.Value
 	}
-	return true
+	return DUTY_CYCLE_1
 }
-func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Message_RejoinRequestPayload)
-	if !ok {
-		that2, ok := that.(Message_RejoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RxDelayValue struct {
+	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
+func (*RxDelayValue) ProtoMessage() {}
+func (*RxDelayValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
+}
+func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
-		return false
-	}
-	return true
 }
-func (this *MHDR) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RxDelayValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RxDelayValue.Merge(m, src)
+}
+func (m *RxDelayValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RxDelayValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+
+func (m *RxDelayValue) GetValue() RxDelay {
+	if m != nil {
+		return m.Value
 	}
+	return RX_DELAY_0
+}
 
-	that1, ok := that.(*MHDR)
-	if !ok {
-		that2, ok := that.(MHDR)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ADRAckLimitExponentValue struct {
+	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
+func (*ADRAckLimitExponentValue) ProtoMessage() {}
+func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
+}
+func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MType != that1.MType {
-		return false
-	}
-	if this.Major != that1.Major {
-		return false
-	}
-	return true
 }
-func (this *MACPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+}
+func (m *ADRAckLimitExponentValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+	if m != nil {
+		return m.Value
 	}
+	return ADR_ACK_LIMIT_1
+}
 
-	that1, ok := that.(*MACPayload)
-	if !ok {
-		that2, ok := that.(MACPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ADRAckDelayExponentValue struct {
+	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
+func (*ADRAckDelayExponentValue) ProtoMessage() {}
+func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{26}
+}
+func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FHDR.Equal(&that1.FHDR) {
-		return false
-	}
-	if this.FPort != that1.FPort {
-		return false
-	}
-	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
-		return false
-	}
-	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
-		return false
-	}
-	if this.FullFCnt != that1.FullFCnt {
-		return false
-	}
-	return true
 }
-func (this *FHDR) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+}
+func (m *ADRAckDelayExponentValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+	if m != nil {
+		return m.Value
 	}
+	return ADR_ACK_DELAY_1
+}
 
-	that1, ok := that.(*FHDR)
-	if !ok {
-		that2, ok := that.(FHDR)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DeviceEIRPValue struct {
+	Value                DeviceEIRP `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DeviceEIRP" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DeviceEIRPValue) Reset()      { *m = DeviceEIRPValue{} }
+func (*DeviceEIRPValue) ProtoMessage() {}
+func (*DeviceEIRPValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{27}
+}
+func (m *DeviceEIRPValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceEIRPValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceEIRPValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
+}
+func (m *DeviceEIRPValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceEIRPValue.Merge(m, src)
+}
+func (m *DeviceEIRPValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceEIRPValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceEIRPValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceEIRPValue proto.InternalMessageInfo
+
+func (m *DeviceEIRPValue) GetValue() DeviceEIRP {
+	if m != nil {
+		return m.Value
 	}
-	if !this.FCtrl.Equal(&that1.FCtrl) {
-		return false
+	return DEVICE_EIRP_8
+}
+
+type RelaySecondChannel struct {
+	// Frequency (Hz) of the second wake on radio channel.
+	Frequency uint64 `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Data rate index of the second wake on radio channel.
+	DataRateIndex DataRateIndex `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Offset of the acknowledgment frequency, see the LoRaWAN Relay specification.
+	AckOffset            uint32   `protobuf:"varint,3,opt,name=ack_offset,json=ackOffset,proto3" json:"ack_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelaySecondChannel) Reset()      { *m = RelaySecondChannel{} }
+func (*RelaySecondChannel) ProtoMessage() {}
+func (*RelaySecondChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{28}
+}
+func (m *RelaySecondChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelaySecondChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelaySecondChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.FCnt != that1.FCnt {
-		return false
+}
+func (m *RelaySecondChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySecondChannel.Merge(m, src)
+}
+func (m *RelaySecondChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelaySecondChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySecondChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelaySecondChannel proto.InternalMessageInfo
+
+func (m *RelaySecondChannel) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
 	}
-	if !bytes.Equal(this.FOpts, that1.FOpts) {
-		return false
+	return 0
+}
+
+func (m *RelaySecondChannel) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	return true
+	return DATA_RATE_0
 }
-func (this *FCtrl) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RelaySecondChannel) GetAckOffset() uint32 {
+	if m != nil {
+		return m.AckOffset
 	}
+	return 0
+}
 
-	that1, ok := that.(*FCtrl)
-	if !ok {
-		that2, ok := that.(FCtrl)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ADR != that1.ADR {
-		return false
-	}
-	if this.ADRAckReq != that1.ADRAckReq {
-		return false
-	}
-	if this.Ack != that1.Ack {
-		return false
-	}
-	if this.FPending != that1.FPending {
-		return false
-	}
-	if this.ClassB != that1.ClassB {
-		return false
-	}
-	return true
+type RelayForwardLimits struct {
+	// Size of the token bucket: 0 is 1, 1 is 2, 2 is 4 and 3 is 12 times the reload rate.
+	BucketSize uint32 `protobuf:"varint,1,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	// Number of tokens added to the token bucket per hour. 127 disables the limit.
+	ReloadRate           uint32   `protobuf:"varint,2,opt,name=reload_rate,json=reloadRate,proto3" json:"reload_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *JoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*JoinRequestPayload)
-	if !ok {
-		that2, ok := that.(JoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.JoinEui.Equal(that1.JoinEui) {
-		return false
-	}
-	if !this.DevEui.Equal(that1.DevEui) {
-		return false
-	}
-	if !this.DevNonce.Equal(that1.DevNonce) {
-		return false
-	}
-	return true
+func (m *RelayForwardLimits) Reset()      { *m = RelayForwardLimits{} }
+func (*RelayForwardLimits) ProtoMessage() {}
+func (*RelayForwardLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{29}
 }
-func (this *RejoinRequestPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*RejoinRequestPayload)
-	if !ok {
-		that2, ok := that.(RejoinRequestPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RejoinType != that1.RejoinType {
-		return false
-	}
-	if !this.NetID.Equal(that1.NetID) {
-		return false
-	}
-	if !this.JoinEui.Equal(that1.JoinEui) {
-		return false
-	}
-	if !this.DevEui.Equal(that1.DevEui) {
-		return false
-	}
-	if this.RejoinCnt != that1.RejoinCnt {
-		return false
-	}
-	return true
+func (m *RelayForwardLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *JoinAcceptPayload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*JoinAcceptPayload)
-	if !ok {
-		that2, ok := that.(JoinAcceptPayload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *RelayForwardLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayForwardLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
-		return false
-	}
-	if !this.JoinNonce.Equal(that1.JoinNonce) {
-		return false
-	}
-	if !this.NetID.Equal(that1.NetID) {
-		return false
-	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
-	}
-	if !this.DLSettings.Equal(&that1.DLSettings) {
-		return false
-	}
-	if this.RxDelay != that1.RxDelay {
-		return false
-	}
-	if !this.CFList.Equal(that1.CFList) {
-		return false
-	}
-	return true
 }
-func (this *DLSettings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*DLSettings)
-	if !ok {
-		that2, ok := that.(DLSettings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Rx1DROffset != that1.Rx1DROffset {
-		return false
-	}
-	if this.Rx2DR != that1.Rx2DR {
-		return false
-	}
-	if this.OptNeg != that1.OptNeg {
-		return false
-	}
-	return true
+func (m *RelayForwardLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayForwardLimits.Merge(m, src)
+}
+func (m *RelayForwardLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayForwardLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayForwardLimits.DiscardUnknown(m)
 }
-func (this *CFList) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CFList)
-	if !ok {
-		that2, ok := that.(CFList)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if len(this.Freq) != len(that1.Freq) {
-		return false
-	}
-	for i := range this.Freq {
-		if this.Freq[i] != that1.Freq[i] {
-			return false
-		}
-	}
-	if len(this.ChMasks) != len(that1.ChMasks) {
-		return false
-	}
-	for i := range this.ChMasks {
-		if this.ChMasks[i] != that1.ChMasks[i] {
-			return false
-		}
+var xxx_messageInfo_RelayForwardLimits proto.InternalMessageInfo
+
+func (m *RelayForwardLimits) GetBucketSize() uint32 {
+	if m != nil {
+		return m.BucketSize
 	}
-	return true
+	return 0
 }
-func (this *LoRaDataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*LoRaDataRate)
-	if !ok {
-		that2, ok := that.(LoRaDataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Bandwidth != that1.Bandwidth {
-		return false
-	}
-	if this.SpreadingFactor != that1.SpreadingFactor {
-		return false
+func (m *RelayForwardLimits) GetReloadRate() uint32 {
+	if m != nil {
+		return m.ReloadRate
 	}
-	return true
+	return 0
 }
-func (this *FSKDataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*FSKDataRate)
-	if !ok {
-		that2, ok := that.(FSKDataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BitRate != that1.BitRate {
-		return false
-	}
-	return true
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
+	proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	proto.RegisterType((*MACCommand_RelayConfigureFwdLimitReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitReq")
+	golang_proto.RegisterType((*MACCommand_RelayConfigureFwdLimitReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfigureFwdLimitReq")
+	proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	golang_proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
+	golang_proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
+	proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
+	golang_proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
+	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+	proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+	golang_proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+	proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	golang_proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
+	golang_proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
 }
-func (this *DataRate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DataRate)
-	if !ok {
-		that2, ok := that.(DataRate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Modulation == nil {
-		if this.Modulation != nil {
-			return false
-		}
-	} else if this.Modulation == nil {
-		return false
-	} else if !this.Modulation.Equal(that1.Modulation) {
-		return false
-	}
-	return true
+func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
 }
-func (this *DataRate_LoRa) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DataRate_LoRa)
-	if !ok {
-		that2, ok := that.(DataRate_LoRa)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.LoRa.Equal(that1.LoRa) {
-		return false
-	}
-	return true
+var fileDescriptor_2084d1d5a227b67e = []byte{
+	// 6878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4d, 0x6c, 0x24, 0x49,
+	0x76, 0x5e, 0x65, 0xfd, 0xb0, 0x8a, 0xaf, 0x8a, 0x64, 0x30, 0xd8, 0x3f, 0xd5, 0x35, 0xd3, 0xc5,
+	0x19, 0xf6, 0x4a, 0xdb, 0xdb, 0xa3, 0x66, 0x37, 0x8b, 0x6c, 0x36, 0x47, 0x2b, 0xed, 0x76, 0xfd,
+	0x71, 0x58, 0xd3, 0xfc, 0xdb, 0x2c, 0xb2, 0x7b, 0x7a, 0x25, 0x39, 0x9d, 0xac, 0xcc, 0x62, 0xd7,
+	0xb0, 0x98, 0x59, 0x9b, 0x95, 0xfc, 0x1b, 0x1b, 0xf0, 0x4a, 0x7b, 0x19, 0xd8, 0x30, 0x20, 0xc8,
+	0x90, 0x2d, 0xf9, 0xe0, 0x5d, 0xc0, 0x32, 0x2c, 0x40, 0x06, 0xbc, 0xb0, 0x61, 0x60, 0x0f, 0x3e,
+	0xe8, 0xe0, 0xc3, 0x1a, 0xf6, 0x61, 0x7d, 0x31, 0x16, 0x36, 0xdc, 0xda, 0x61, 0x5f, 0xf6, 0x60,
+	0x18, 0x3a, 0x2e, 0x06, 0xb0, 0xc7, 0x78, 0x11, 0x91, 0x95, 0x91, 0x99, 0xc5, 0xbf, 0xd9, 0x9e,
+	0x39, 0x0c, 0x2b, 0xbe, 0x88, 0xf8, 0x5e, 0xc4, 0x7b, 0x2f, 0x5e, 0x44, 0xbe, 0x8c, 0x6c, 0x98,
+	0xee, 0xda, 0x8e, 0x7e, 0xa4, 0x5b, 0xf7, 0xfb, 0xae, 0xde, 0xda, 0x7b, 0xa0, 0xf7, 0x3a, 0x0f,
+	0x04, 0x32, 0xdb, 0x73, 0x6c, 0xd7, 0xa6, 0xe3, 0xae, 0x6b, 0xcd, 0x7a, 0xd0, 0xe1, 0x7c, 0xa1,
+	0xbc, 0xdb, 0x71, 0x5f, 0x1e, 0xec, 0xcc, 0xb6, 0xec, 0xfd, 0x07, 0xa6, 0x75, 0x68, 0x9f, 0xf4,
+	0x1c, 0xfb, 0xf8, 0xe4, 0x01, 0x6b, 0xdc, 0xba, 0xbf, 0x6b, 0x5a, 0xf7, 0x0f, 0xf5, 0x6e, 0xc7,
+	0xd0, 0x5d, 0xf3, 0x41, 0xe4, 0x07, 0xa7, 0x2c, 0xdc, 0x97, 0x28, 0x76, 0xed, 0x5d, 0x9b, 0x77,
+	0xde, 0x39, 0x68, 0xb3, 0x12, 0x2b, 0xb0, 0x5f, 0xa2, 0xf9, 0xdb, 0xbb, 0xb6, 0xbd, 0xdb, 0x35,
+	0xfd, 0x56, 0x7d, 0xd7, 0x39, 0x68, 0xb9, 0xa2, 0x76, 0x3a, 0x5c, 0xeb, 0x76, 0xf6, 0xcd, 0xbe,
+	0xab, 0xef, 0xf7, 0x44, 0x83, 0x3b, 0xd1, 0x19, 0x76, 0x0c, 0xd3, 0x72, 0x3b, 0xed, 0x8e, 0xe9,
+	0xf4, 0x3d, 0x19, 0xd1, 0x46, 0x7b, 0xe6, 0x89, 0xa8, 0x9d, 0xf9, 0x45, 0x02, 0xd2, 0x6b, 0x66,
+	0xbf, 0xaf, 0xef, 0x9a, 0xf4, 0x9b, 0x90, 0xda, 0xd7, 0x5e, 0x1a, 0x4e, 0x5e, 0x79, 0x47, 0xb9,
+	0x9b, 0x2d, 0x5d, 0x9b, 0x0d, 0xea, 0x67, 0x76, 0x6d, 0xa5, 0xa6, 0x56, 0xc8, 0xe7, 0x95, 0xd4,
+	0x3f, 0x54, 0xe2, 0x44, 0xf9, 0xe9, 0xab, 0xe9, 0xd8, 0xcf, 0x5e, 0x4d, 0x2b, 0x6a, 0x72, 0x7f,
+	0xc5, 0x70, 0xe8, 0x0c, 0x24, 0xf6, 0x3b, 0xad, 0x7c, 0xfc, 0x1d, 0xe5, 0x6e, 0x0e, 0x1b, 0x8d,
+	0x7c, 0x92, 0x24, 0xb1, 0x7c, 0xf2, 0xf4, 0xd5, 0x74, 0x62, 0xad, 0x51, 0x55, 0xb1, 0x92, 0xae,
+	0x41, 0x76, 0x5f, 0x6f, 0x69, 0x3d, 0xfd, 0xa4, 0x6b, 0xeb, 0x46, 0x3e, 0xc1, 0xc4, 0x14, 0x22,
+	0x62, 0xca, 0xd5, 0x4d, 0xde, 0xa2, 0x32, 0x7e, 0xfa, 0x6a, 0x1a, 0xfc, 0xf2, 0x4a, 0x4c, 0x85,
+	0x7d, 0xbd, 0x25, 0x4a, 0xf4, 0x19, 0x5c, 0xfb, 0xd8, 0xee, 0x58, 0x9a, 0x63, 0x7e, 0xef, 0xc0,
+	0xec, 0xbb, 0x03, 0xde, 0x24, 0xe3, 0x9d, 0x09, 0xf3, 0x7e, 0x68, 0x77, 0x2c, 0x95, 0x37, 0xf5,
+	0xf9, 0xe8, 0xc7, 0x11, 0x94, 0x36, 0x61, 0x8a, 0xf1, 0xea, 0xad, 0x96, 0xd9, 0xf3, 0x69, 0x53,
+	0x8c, 0xf6, 0xdd, 0x61, 0xb4, 0x65, 0xd6, 0xd2, 0x67, 0x9d, 0xfc, 0x38, 0x0c, 0xd2, 0xdf, 0x87,
+	0x1b, 0x8e, 0x39, 0x74, 0xb8, 0x23, 0x8c, 0xf7, 0x6b, 0x61, 0x5e, 0xd5, 0xfc, 0x78, 0xd8, 0x80,
+	0xaf, 0x39, 0x43, 0xf0, 0xdf, 0x4e, 0xfe, 0xe4, 0x47, 0xd3, 0xb1, 0xca, 0x38, 0xa4, 0x3d, 0x71,
+	0x89, 0x5f, 0x55, 0x94, 0x0f, 0x93, 0x99, 0x34, 0xc9, 0xcc, 0x1c, 0x40, 0x12, 0x2d, 0x47, 0x17,
+	0x61, 0x64, 0x5f, 0x73, 0x4f, 0x7a, 0x26, 0xb3, 0xef, 0x78, 0xe9, 0x7a, 0x44, 0xf1, 0x5b, 0x27,
+	0x3d, 0xb3, 0x92, 0xf9, 0xbc, 0x92, 0xfa, 0x23, 0x34, 0xb0, 0x9a, 0xda, 0x47, 0x80, 0x3e, 0x82,
+	0xd4, 0xbe, 0xfe, 0xb1, 0xed, 0xe4, 0xe3, 0x67, 0x74, 0xc3, 0xca, 0x40, 0x37, 0x04, 0x66, 0xfe,
+	0x30, 0x0e, 0x92, 0xe9, 0xd0, 0xb9, 0xda, 0xe7, 0x39, 0xd7, 0xf2, 0x19, 0xce, 0xd5, 0x46, 0xe7,
+	0x9a, 0x86, 0x91, 0xb6, 0xd6, 0xb3, 0x1d, 0x97, 0x8d, 0x61, 0x8c, 0x09, 0xbb, 0x97, 0xc8, 0x7f,
+	0xa1, 0xa8, 0xa9, 0xf6, 0xa6, 0xed, 0xb8, 0xf4, 0x01, 0x64, 0xdb, 0xce, 0x7e, 0xc0, 0xb3, 0x72,
+	0xdc, 0x7b, 0x96, 0xd5, 0x35, 0x31, 0x04, 0x15, 0xda, 0xce, 0xbe, 0x37, 0x9c, 0x27, 0x30, 0x61,
+	0x98, 0x2d, 0xdb, 0x30, 0x8d, 0x90, 0xdb, 0xdc, 0x9c, 0xe5, 0xab, 0x6e, 0xd6, 0x5b, 0x75, 0xb3,
+	0x4d, 0xb6, 0x26, 0xd5, 0x71, 0xd1, 0xde, 0x63, 0x78, 0x1b, 0xa0, 0x7d, 0xd0, 0xed, 0x6a, 0x6d,
+	0xad, 0x65, 0xb9, 0xcc, 0x39, 0xc6, 0xd4, 0x0c, 0x22, 0xcb, 0x55, 0xcb, 0xe5, 0x06, 0x99, 0xf9,
+	0xa5, 0x02, 0x49, 0x9c, 0x18, 0xfd, 0x2e, 0x64, 0x0c, 0xf3, 0x50, 0xd3, 0x0d, 0xa1, 0x80, 0x5c,
+	0xe5, 0xdb, 0x38, 0xc5, 0xff, 0xf1, 0x6a, 0xfa, 0xf1, 0xae, 0x3d, 0xeb, 0xbe, 0x34, 0xdd, 0x97,
+	0x1d, 0x6b, 0xb7, 0x3f, 0x6b, 0x99, 0xee, 0x91, 0xed, 0xec, 0x3d, 0x08, 0xae, 0xd9, 0xc3, 0xf9,
+	0x07, 0xbd, 0xbd, 0xdd, 0x07, 0x68, 0xbb, 0xfe, 0x6c, 0xcd, 0x3c, 0x2c, 0x1b, 0x86, 0xa3, 0xa6,
+	0x0d, 0xfe, 0x83, 0x7e, 0x0b, 0x95, 0xd3, 0x72, 0x9d, 0x2e, 0x53, 0x4e, 0x36, 0x6a, 0xa0, 0xe5,
+	0xaa, 0xeb, 0x74, 0x87, 0xe8, 0x36, 0xd5, 0xc6, 0x0a, 0x5a, 0x84, 0x14, 0x9f, 0x43, 0x82, 0xe9,
+	0x76, 0xf4, 0xf3, 0xca, 0xc8, 0xbd, 0x64, 0xfe, 0x8b, 0x2f, 0x12, 0x6a, 0xb2, 0x5d, 0xb5, 0x5c,
+	0x5a, 0x44, 0x7e, 0xbb, 0xe7, 0xf6, 0x99, 0x86, 0x72, 0x95, 0xf4, 0xe7, 0x95, 0xe4, 0x27, 0xf1,
+	0xfc, 0x84, 0x9a, 0x6a, 0x6f, 0xf4, 0xdc, 0xbe, 0x98, 0xea, 0x9f, 0x2b, 0x90, 0x62, 0x82, 0xe8,
+	0x2d, 0x48, 0xe8, 0x62, 0x9a, 0x99, 0x4a, 0x1a, 0x03, 0x40, 0xb9, 0xa6, 0xaa, 0x88, 0xd1, 0xfb,
+	0x90, 0xd5, 0x0d, 0x47, 0xd3, 0x5b, 0x7b, 0xb8, 0x0a, 0xd8, 0x78, 0x33, 0x95, 0xb1, 0xd3, 0x57,
+	0xd3, 0xa3, 0xe5, 0x9a, 0x5a, 0x6e, 0xed, 0xa9, 0xe6, 0xf7, 0xd4, 0x51, 0xdd, 0x70, 0xf8, 0x4f,
+	0x4a, 0x20, 0xa1, 0xb7, 0xf6, 0xd8, 0xb8, 0x32, 0x2a, 0xfe, 0xa4, 0x6f, 0xc1, 0x68, 0x5b, 0xeb,
+	0x99, 0x96, 0xd1, 0xb1, 0x76, 0xd9, 0x70, 0x32, 0x6a, 0xa6, 0xbd, 0xc9, 0xcb, 0xf4, 0x26, 0xa4,
+	0x5b, 0x5d, 0xbd, 0xdf, 0xd7, 0x76, 0x98, 0x39, 0x32, 0xea, 0x08, 0x2b, 0x56, 0x66, 0xfe, 0x2a,
+	0x0e, 0x34, 0xba, 0xfa, 0xe9, 0x47, 0x90, 0x61, 0x0b, 0xd2, 0x3c, 0xe8, 0x08, 0xa3, 0xfc, 0xae,
+	0x30, 0xca, 0xa3, 0xab, 0x1a, 0xa5, 0xbe, 0xdd, 0x58, 0x5c, 0x50, 0xd3, 0x48, 0x57, 0x3f, 0xe8,
+	0xd0, 0x67, 0x80, 0xd6, 0x61, 0xc4, 0xf1, 0x37, 0x41, 0x3c, 0x62, 0x98, 0x87, 0xc8, 0xfb, 0x07,
+	0x30, 0x8a, 0xbc, 0x96, 0x6d, 0xb5, 0x4c, 0xe1, 0xe4, 0x4f, 0x04, 0xf3, 0xd2, 0x97, 0xf0, 0xa3,
+	0x75, 0xe4, 0x51, 0x33, 0x86, 0xf8, 0x25, 0x2c, 0xf9, 0xcf, 0x13, 0x70, 0x6d, 0x58, 0xf0, 0xa1,
+	0xab, 0x90, 0x15, 0x21, 0x4c, 0x8a, 0x22, 0xef, 0x9e, 0x1b, 0xb7, 0x42, 0x11, 0x05, 0x78, 0x7f,
+	0x44, 0xe9, 0x1f, 0xc0, 0x88, 0x65, 0xba, 0x5a, 0xc7, 0x10, 0x2a, 0x5a, 0xfe, 0xb2, 0x2a, 0x5a,
+	0x37, 0xdd, 0x46, 0xed, 0xf4, 0xd5, 0x74, 0x8a, 0xfd, 0x50, 0x53, 0x96, 0xe9, 0x36, 0x82, 0xc6,
+	0x4d, 0x7c, 0x55, 0xc6, 0x4d, 0xbe, 0x49, 0xe3, 0xde, 0x06, 0xa1, 0x1e, 0x29, 0xa0, 0x8c, 0x72,
+	0xc4, 0x8f, 0x28, 0xff, 0x22, 0x09, 0x93, 0x91, 0x1d, 0x87, 0xbe, 0x0d, 0xa3, 0xa6, 0xd5, 0x72,
+	0x4e, 0x7a, 0xae, 0x69, 0x70, 0x57, 0x56, 0x7d, 0x80, 0xfe, 0x5d, 0x00, 0x46, 0xcb, 0xdd, 0x86,
+	0x6b, 0xbb, 0x2c, 0xc6, 0xfc, 0xfe, 0x55, 0xc7, 0x8c, 0xc2, 0xb9, 0xdf, 0x8c, 0x7e, 0xec, 0xfd,
+	0x94, 0x6c, 0x99, 0xf8, 0x2a, 0x6c, 0x29, 0x47, 0xcf, 0xe4, 0x1b, 0x8e, 0x9e, 0x6b, 0x90, 0x35,
+	0xba, 0x5a, 0xdf, 0x74, 0x5d, 0xa4, 0xc8, 0xa7, 0x86, 0x9f, 0x49, 0x6a, 0xab, 0x4d, 0xd1, 0x62,
+	0x48, 0x1c, 0x05, 0xa3, 0xeb, 0xd5, 0xd2, 0xdf, 0x81, 0x8c, 0x73, 0xac, 0x19, 0x66, 0x57, 0x3f,
+	0x61, 0x1b, 0xfb, 0x78, 0xe9, 0x66, 0x64, 0x81, 0x1c, 0xd7, 0xb0, 0x5a, 0x5a, 0x16, 0x69, 0x87,
+	0x43, 0xf4, 0x9b, 0x90, 0x6e, 0xb5, 0xb5, 0x6e, 0xa7, 0xef, 0xe6, 0xd3, 0x6c, 0x20, 0x37, 0xc2,
+	0x9d, 0xab, 0xcb, 0xab, 0x9d, 0xbe, 0x5b, 0x81, 0xd3, 0x57, 0xd3, 0x23, 0xfc, 0xb7, 0x3a, 0xd2,
+	0x6a, 0xe3, 0x5f, 0xe1, 0x20, 0xff, 0x4b, 0x01, 0xf0, 0x47, 0x4b, 0x7f, 0x0f, 0xc6, 0x9c, 0xe3,
+	0x39, 0xcd, 0x70, 0x34, 0xbb, 0xdd, 0xee, 0x9b, 0xae, 0x58, 0xb5, 0xc5, 0xc8, 0x04, 0x75, 0x57,
+	0x57, 0x75, 0xd7, 0xdc, 0x60, 0xad, 0x2a, 0x37, 0xbd, 0xb1, 0x9d, 0xbe, 0x9a, 0xce, 0xaa, 0xc7,
+	0x73, 0x35, 0x95, 0x57, 0xa8, 0x59, 0xe7, 0x78, 0xae, 0xe6, 0xf0, 0x02, 0xfd, 0x00, 0x46, 0x9c,
+	0xe3, 0x92, 0x66, 0x78, 0x47, 0x83, 0xdb, 0x67, 0xb1, 0x36, 0x2c, 0xc3, 0x3c, 0xae, 0x4c, 0x4a,
+	0xa4, 0x29, 0xf5, 0xb8, 0x54, 0x53, 0xd5, 0x94, 0x73, 0x5c, 0xaa, 0x39, 0xf4, 0x0e, 0xa4, 0xed,
+	0x9e, 0xab, 0x59, 0xe6, 0x2e, 0x0f, 0xf6, 0x7c, 0x7e, 0x1b, 0x3d, 0x77, 0xdd, 0xdc, 0x55, 0x47,
+	0x6c, 0xf6, 0x57, 0xcc, 0xef, 0x08, 0xc4, 0xbc, 0xe9, 0x12, 0x24, 0xa5, 0x38, 0x54, 0x18, 0xae,
+	0xa9, 0x50, 0x00, 0x62, 0x3d, 0x28, 0x85, 0x64, 0x9b, 0xef, 0x3f, 0x89, 0xbb, 0x63, 0x2a, 0xfb,
+	0x4d, 0x6f, 0x41, 0xa6, 0xf5, 0x52, 0xdb, 0xd7, 0xfb, 0x7b, 0xfd, 0x7c, 0xe2, 0x9d, 0xc4, 0xdd,
+	0x8c, 0x9a, 0x6e, 0xbd, 0x5c, 0xc3, 0xa2, 0x10, 0xfc, 0x1c, 0x72, 0xab, 0xb6, 0xaa, 0x7b, 0x53,
+	0xc2, 0x35, 0xb7, 0xa3, 0x5b, 0xc6, 0x51, 0xc7, 0x70, 0x5f, 0xb2, 0x31, 0x8c, 0xa9, 0x3e, 0x40,
+	0xbf, 0x01, 0xa4, 0xdf, 0x73, 0x4c, 0x1d, 0x37, 0x26, 0xad, 0xad, 0xb7, 0x5c, 0x71, 0x7e, 0x1a,
+	0x53, 0x27, 0x06, 0xf8, 0x32, 0x83, 0x67, 0xee, 0x42, 0x76, 0xb9, 0xf9, 0x74, 0xc0, 0x7b, 0x0b,
+	0x32, 0x3b, 0x1d, 0x57, 0x73, 0x74, 0xd7, 0x14, 0xb4, 0xe9, 0x9d, 0x8e, 0x8b, 0x55, 0x33, 0x7f,
+	0xaa, 0x40, 0x66, 0xd0, 0xee, 0x77, 0x20, 0x89, 0xb3, 0x15, 0xe7, 0xa9, 0xb7, 0xc3, 0xd3, 0x97,
+	0xc7, 0x5a, 0xc9, 0x9c, 0xbe, 0x9a, 0x4e, 0x22, 0xb2, 0x12, 0x53, 0x59, 0x2f, 0xba, 0x04, 0x89,
+	0x76, 0x7f, 0x4f, 0x9c, 0x18, 0xde, 0x8a, 0x9c, 0x18, 0xfc, 0xf1, 0xf0, 0x1d, 0x7c, 0xb9, 0xf9,
+	0x74, 0x25, 0xa6, 0x62, 0x97, 0xca, 0x24, 0xc0, 0xbe, 0x6d, 0x1c, 0x74, 0x75, 0xb7, 0x63, 0x5b,
+	0xec, 0x9c, 0x39, 0xf3, 0xaf, 0x93, 0x00, 0x5b, 0xc7, 0x03, 0x9f, 0xab, 0xc2, 0xa8, 0xa1, 0xbb,
+	0xba, 0x3f, 0x85, 0x6c, 0x29, 0x7f, 0x96, 0x67, 0x54, 0x72, 0xf2, 0x72, 0x52, 0x33, 0x86, 0x37,
+	0xbd, 0x0d, 0x98, 0x18, 0x90, 0x68, 0x1d, 0xf4, 0x9f, 0xcb, 0x39, 0x99, 0x6f, 0xeb, 0x31, 0x43,
+	0xae, 0xa0, 0xd3, 0x90, 0x6d, 0xd9, 0xcc, 0x1c, 0x6c, 0x5c, 0xe8, 0x67, 0xa3, 0x2a, 0x70, 0xc8,
+	0x33, 0x68, 0x9b, 0x9d, 0xcd, 0xad, 0xd6, 0x09, 0x0b, 0x33, 0x49, 0xd5, 0x07, 0xe8, 0x6f, 0x01,
+	0x98, 0x96, 0xbe, 0xd3, 0x35, 0xb5, 0x96, 0xd3, 0xca, 0xa7, 0xfc, 0x93, 0x4b, 0x9d, 0xa1, 0x55,
+	0xb5, 0x8a, 0x21, 0x97, 0xfd, 0x74, 0x5a, 0xc8, 0x35, 0x78, 0x58, 0x63, 0x71, 0x60, 0x4c, 0xf5,
+	0x01, 0xba, 0x00, 0x49, 0x2c, 0x88, 0x35, 0x5e, 0x88, 0x9c, 0x38, 0xb7, 0xbc, 0x96, 0x95, 0xe4,
+	0x1f, 0xff, 0x0d, 0x1e, 0x82, 0xb1, 0x35, 0xfd, 0x36, 0x64, 0x0c, 0xfb, 0xc8, 0xea, 0x76, 0xac,
+	0xbd, 0x7c, 0x86, 0xf5, 0xbc, 0x13, 0x56, 0x85, 0x6f, 0x84, 0xd9, 0x9a, 0x68, 0xaa, 0x0e, 0x3a,
+	0x15, 0xfe, 0x1e, 0x64, 0x3c, 0x94, 0xde, 0x81, 0x31, 0xdd, 0x72, 0x4d, 0xcb, 0xd2, 0x85, 0x72,
+	0xb9, 0xab, 0xe5, 0x04, 0xc8, 0x55, 0x76, 0x0b, 0x32, 0xee, 0xb1, 0xd6, 0xb3, 0x8f, 0x4c, 0xee,
+	0xbc, 0x71, 0x35, 0xed, 0x1e, 0x6f, 0x62, 0x91, 0x3e, 0x80, 0xa9, 0x8e, 0x75, 0x68, 0x3a, 0xae,
+	0xd6, 0xb3, 0xbb, 0xba, 0xd3, 0xf9, 0x84, 0xb9, 0x83, 0x38, 0xaa, 0x51, 0x5e, 0xb5, 0x29, 0xd5,
+	0x88, 0x45, 0xf4, 0xcf, 0x14, 0xb8, 0xf5, 0x81, 0xee, 0x9a, 0x47, 0xfa, 0x49, 0x59, 0x48, 0xf2,
+	0x1f, 0x58, 0xe9, 0x36, 0x64, 0x77, 0x79, 0xa5, 0xd6, 0x31, 0xfa, 0x79, 0x65, 0xf8, 0x73, 0x9c,
+	0xe8, 0x2f, 0x75, 0x1c, 0x16, 0x93, 0x77, 0xbd, 0x56, 0xfd, 0xe8, 0x5c, 0xe3, 0xd1, 0xb9, 0xce,
+	0xfc, 0x1f, 0x05, 0xb2, 0xdb, 0x3d, 0xd4, 0xcd, 0x96, 0xbd, 0x67, 0x5a, 0x74, 0x0d, 0x12, 0xfe,
+	0x18, 0xbe, 0x71, 0xc6, 0x18, 0xa2, 0x73, 0x18, 0x32, 0x14, 0xe4, 0x09, 0x3a, 0x44, 0x3c, 0xec,
+	0x10, 0x75, 0xc8, 0xf6, 0x4d, 0xe7, 0xd0, 0x74, 0x34, 0xe6, 0x17, 0x89, 0x0b, 0xfd, 0x22, 0x83,
+	0xec, 0xcc, 0x37, 0x80, 0x77, 0xc4, 0x2a, 0xfa, 0x1e, 0x4c, 0xb6, 0x70, 0x3f, 0xb6, 0x5c, 0x47,
+	0x77, 0x6d, 0x41, 0x86, 0x9e, 0x9c, 0x50, 0x89, 0x5c, 0x81, 0x8d, 0x67, 0x7e, 0xa0, 0x40, 0xce,
+	0x73, 0x87, 0x4d, 0xdd, 0x7d, 0x49, 0xef, 0x40, 0xee, 0x80, 0x29, 0x40, 0x73, 0x51, 0x03, 0xfc,
+	0x1c, 0xb1, 0x12, 0x53, 0xb3, 0x07, 0x92, 0x5a, 0xca, 0x90, 0x6a, 0x77, 0x8e, 0x4d, 0x23, 0x1f,
+	0xbf, 0xa2, 0x62, 0x56, 0x62, 0x2a, 0xef, 0x59, 0xc9, 0x42, 0xb2, 0x87, 0xf2, 0x58, 0xe8, 0xf8,
+	0x2f, 0x29, 0x18, 0xdd, 0x3a, 0x16, 0xa7, 0x45, 0xfa, 0x1e, 0xa4, 0xd8, 0x91, 0xfd, 0xac, 0x27,
+	0xd4, 0x2a, 0x56, 0xaa, 0xbc, 0x0d, 0xad, 0xc2, 0xb8, 0xe7, 0xda, 0x1a, 0x12, 0xf6, 0x59, 0x3c,
+	0x1f, 0x12, 0x0a, 0xe5, 0x59, 0xaa, 0x63, 0x86, 0x54, 0xea, 0xd3, 0x6f, 0xc1, 0x28, 0xdb, 0x1f,
+	0xd9, 0x86, 0x9d, 0xb8, 0xec, 0x86, 0x9d, 0xc1, 0x5d, 0x10, 0x31, 0xfa, 0x0c, 0xa6, 0x58, 0xff,
+	0x50, 0xa8, 0x4a, 0x5e, 0x2d, 0x54, 0x11, 0xe4, 0x93, 0xeb, 0xd0, 0x67, 0x91, 0xd7, 0x0f, 0x48,
+	0x29, 0x16, 0x90, 0x72, 0xce, 0xf1, 0xdc, 0xb2, 0x87, 0x71, 0xe1, 0xa5, 0x88, 0xf0, 0x91, 0x2b,
+	0x0b, 0x2f, 0x0d, 0x11, 0x5e, 0x92, 0x84, 0xa7, 0x3d, 0xe1, 0x25, 0x5f, 0xf8, 0x0a, 0x64, 0x7a,
+	0x4e, 0xc7, 0x76, 0x3a, 0xee, 0x09, 0x0b, 0x47, 0xe3, 0xd1, 0x95, 0xba, 0x75, 0xdc, 0x6c, 0xbd,
+	0x34, 0x8d, 0x83, 0xae, 0xb9, 0x29, 0x5a, 0xca, 0x3a, 0xf4, 0x7a, 0xd3, 0x3a, 0x8c, 0xe9, 0x3b,
+	0x7d, 0xbb, 0x7b, 0xe0, 0x9a, 0xdc, 0x65, 0x47, 0x2f, 0x19, 0x17, 0x73, 0x5e, 0x37, 0xac, 0xa0,
+	0xcb, 0x30, 0x39, 0x18, 0xb1, 0xd6, 0xeb, 0xea, 0x16, 0x9e, 0x47, 0x01, 0xc3, 0x7c, 0xa5, 0xf0,
+	0x79, 0x25, 0xe9, 0xc4, 0xf3, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x0c, 0x66, 0xb0, 0xd9, 0xd5, 0xad,
+	0x46, 0x4d, 0x9d, 0x68, 0x07, 0x00, 0x83, 0xce, 0x43, 0x46, 0x37, 0x0e, 0x75, 0xab, 0x65, 0x1a,
+	0xf9, 0xd6, 0xf9, 0x39, 0x81, 0x41, 0x43, 0x11, 0xde, 0xfe, 0x49, 0x93, 0xe5, 0x3c, 0xaa, 0xf6,
+	0xfe, 0xbe, 0x6e, 0x19, 0xb4, 0x01, 0x89, 0x56, 0xc7, 0x10, 0xce, 0xfc, 0xb5, 0x21, 0x79, 0x2e,
+	0xd1, 0xd0, 0x5f, 0x26, 0x78, 0x46, 0x4a, 0xff, 0x91, 0x92, 0x24, 0xca, 0x3b, 0x31, 0xdc, 0x77,
+	0xab, 0x8d, 0x9a, 0x8a, 0x1c, 0xf4, 0x5d, 0xc8, 0x3a, 0xfa, 0xd1, 0x20, 0x57, 0x11, 0x17, 0x6b,
+	0x13, 0x1c, 0xfd, 0xc8, 0x7b, 0x08, 0xa8, 0xc0, 0xa8, 0x63, 0xf6, 0xf1, 0x18, 0x6e, 0x79, 0xb9,
+	0xb5, 0x3b, 0x67, 0xcb, 0x9c, 0x55, 0xb1, 0x6d, 0xc3, 0xc2, 0x9c, 0x52, 0xc6, 0x11, 0xbf, 0x69,
+	0x1d, 0x80, 0x73, 0xb4, 0x6c, 0xab, 0x2d, 0x32, 0x22, 0x5f, 0xbb, 0x88, 0xa4, 0x6a, 0x5b, 0xed,
+	0x95, 0x98, 0x3a, 0xea, 0x78, 0x05, 0xba, 0x01, 0xe3, 0x6c, 0x59, 0xb6, 0x5e, 0x9a, 0xad, 0x3d,
+	0x4d, 0xb7, 0xbc, 0x73, 0xf5, 0xd7, 0xcf, 0xa1, 0x5a, 0xed, 0x58, 0x7b, 0x55, 0x6c, 0x5f, 0xb6,
+	0x30, 0x58, 0xe4, 0xba, 0x52, 0x99, 0xbe, 0x00, 0x56, 0xd6, 0x30, 0x7b, 0x80, 0x27, 0x37, 0x9e,
+	0x33, 0xfb, 0x8d, 0x0b, 0xe8, 0x30, 0xef, 0x60, 0x7e, 0x8f, 0xe7, 0x81, 0xfc, 0x32, 0xaa, 0x0d,
+	0xc9, 0xca, 0x86, 0x83, 0x49, 0x06, 0x99, 0x1a, 0x47, 0x9a, 0xbe, 0x2c, 0x75, 0xd9, 0xea, 0x07,
+	0xa8, 0xf9, 0xb8, 0x3d, 0x6a, 0x1c, 0xf5, 0x06, 0x8c, 0x1b, 0x07, 0xee, 0x89, 0xd6, 0x3a, 0x69,
+	0x75, 0x4d, 0x36, 0xee, 0xcc, 0x85, 0x6a, 0xa8, 0x1d, 0xb8, 0x27, 0x55, 0x6c, 0xcf, 0x47, 0x9a,
+	0x33, 0xa4, 0x32, 0x7d, 0x01, 0xd4, 0x39, 0xd6, 0x7a, 0xba, 0xa3, 0xef, 0xe3, 0x23, 0xcb, 0x41,
+	0x8f, 0x91, 0xf2, 0xe5, 0x72, 0xef, 0x3c, 0x33, 0x1d, 0x6f, 0x62, 0x9f, 0x26, 0x76, 0xe1, 0xbc,
+	0x13, 0x4e, 0x10, 0x1a, 0x42, 0x8d, 0xca, 0x80, 0x2b, 0x51, 0x73, 0x0d, 0x04, 0xa8, 0x3d, 0x35,
+	0x98, 0x87, 0x5a, 0xdf, 0xd5, 0xdd, 0x83, 0x3e, 0xa3, 0xcd, 0x5e, 0xac, 0x06, 0xf3, 0xb0, 0xc9,
+	0xda, 0x0b, 0x6f, 0x30, 0xa4, 0x32, 0x55, 0x61, 0xc2, 0x32, 0x8f, 0xb4, 0xd6, 0x4b, 0xdd, 0xb2,
+	0xcc, 0x2e, 0xd3, 0x41, 0x8e, 0x31, 0xde, 0x3d, 0x87, 0x71, 0xdd, 0x3c, 0xaa, 0xf2, 0x0e, 0x5c,
+	0x03, 0x63, 0x96, 0x0c, 0x84, 0x39, 0x71, 0x94, 0x63, 0x57, 0xe0, 0xe4, 0xc3, 0x94, 0x38, 0x71,
+	0x9c, 0x3a, 0x8c, 0x1b, 0xdd, 0xc0, 0x30, 0xc7, 0x2f, 0x9e, 0xf8, 0xaa, 0x3f, 0xa8, 0x0a, 0x39,
+	0x7d, 0x35, 0x9d, 0x93, 0x11, 0xa6, 0x8a, 0xae, 0x34, 0xec, 0xa0, 0x08, 0x1c, 0xf5, 0xc4, 0xe5,
+	0x45, 0xa0, 0x07, 0x07, 0x45, 0x78, 0xda, 0xee, 0x4a, 0xb3, 0xf8, 0x7d, 0xdc, 0x65, 0x30, 0x30,
+	0xe3, 0xd9, 0xd9, 0xf7, 0x3a, 0xc2, 0xe4, 0xbc, 0x77, 0xae, 0x6b, 0x6c, 0xb1, 0x4e, 0x92, 0xdb,
+	0x11, 0x27, 0x84, 0xa1, 0xdf, 0xb9, 0x51, 0x97, 0x9e, 0xbc, 0xd0, 0xef, 0xb6, 0xa2, 0x2e, 0xed,
+	0x06, 0x21, 0x1e, 0x10, 0xf7, 0xcc, 0x13, 0x16, 0x10, 0xe9, 0x25, 0x02, 0xe2, 0x9e, 0x79, 0x32,
+	0x08, 0x88, 0xfc, 0x37, 0x0f, 0x88, 0xc8, 0xc1, 0x02, 0xe2, 0xd4, 0x25, 0x02, 0xe2, 0x9e, 0x79,
+	0xe2, 0x07, 0x44, 0x51, 0xa0, 0x0e, 0x4c, 0x61, 0x7c, 0x09, 0x4f, 0xf3, 0xda, 0x85, 0x3a, 0x2c,
+	0xd7, 0xd4, 0xc0, 0xa4, 0x2a, 0xd7, 0x4e, 0x5f, 0x4d, 0x93, 0x30, 0x8a, 0x9a, 0xd5, 0x0d, 0x27,
+	0x38, 0x7d, 0x15, 0x53, 0xdc, 0x87, 0x9d, 0x16, 0xdf, 0x54, 0x99, 0x6f, 0x5c, 0xbf, 0xd0, 0xa3,
+	0x6b, 0xac, 0x07, 0xee, 0xa7, 0xc2, 0xa3, 0x0d, 0x19, 0xa0, 0xdb, 0x40, 0xda, 0xb6, 0xd3, 0xc2,
+	0x60, 0xe6, 0xbd, 0xcb, 0xc8, 0xdf, 0x18, 0x7e, 0x12, 0x94, 0x48, 0x97, 0xb1, 0xcb, 0x20, 0x2f,
+	0xb8, 0x12, 0x53, 0xc7, 0xdb, 0x01, 0x84, 0x9a, 0x83, 0x97, 0x23, 0x61, 0x0d, 0xdd, 0x64, 0xe4,
+	0xb3, 0xe7, 0x6a, 0x1c, 0x3b, 0x86, 0xd5, 0x31, 0xe5, 0x44, 0xe1, 0x33, 0xc4, 0xa0, 0x62, 0xf2,
+	0x57, 0x16, 0xc3, 0xd5, 0x13, 0x11, 0xc3, 0x37, 0x2b, 0xda, 0x63, 0x6b, 0xa5, 0x6b, 0xe3, 0x66,
+	0xdc, 0xb6, 0xd9, 0x4c, 0x6e, 0x5d, 0xe8, 0xd2, 0x9b, 0xb8, 0x2e, 0xba, 0xb6, 0xdb, 0xb0, 0xda,
+	0xb6, 0x70, 0xe9, 0x5e, 0x10, 0xa2, 0x3b, 0x70, 0xdd, 0xa7, 0x96, 0x03, 0x4b, 0x81, 0xb1, 0xdf,
+	0xbf, 0x04, 0x7b, 0x20, 0x98, 0xd0, 0x5e, 0x04, 0x1d, 0x2e, 0x03, 0x95, 0xf4, 0xd6, 0x55, 0x65,
+	0x70, 0x1d, 0x85, 0x65, 0xa0, 0x8a, 0x3e, 0x82, 0xc9, 0x1d, 0x53, 0x6f, 0xd9, 0x96, 0x17, 0x57,
+	0x90, 0xff, 0xed, 0x0b, 0x35, 0x54, 0x61, 0x7d, 0x78, 0x04, 0x11, 0x9b, 0xcd, 0x4e, 0x10, 0x42,
+	0xaf, 0x17, 0xcc, 0x78, 0xae, 0x63, 0xba, 0xb9, 0x7d, 0xa1, 0xd7, 0x73, 0x5e, 0x3c, 0x19, 0x8a,
+	0xbd, 0x61, 0x47, 0x06, 0xc2, 0x9c, 0x38, 0xd6, 0xe2, 0x15, 0x38, 0xc5, 0x4a, 0xda, 0x91, 0x01,
+	0x69, 0x75, 0xee, 0xdb, 0x06, 0x3b, 0xb9, 0xe7, 0xa7, 0x2f, 0xb9, 0x3a, 0xd7, 0x6c, 0xc3, 0xe4,
+	0x71, 0x6a, 0xcc, 0x90, 0x01, 0x5c, 0x9d, 0x32, 0x27, 0x0b, 0x59, 0xef, 0x5c, 0xb8, 0x3a, 0x7d,
+	0x52, 0x11, 0xb7, 0xc6, 0x8d, 0x00, 0x82, 0xfb, 0xb7, 0x83, 0x0f, 0x3b, 0x8c, 0x90, 0x69, 0xf4,
+	0xdd, 0x0b, 0xf7, 0x18, 0x15, 0x3b, 0x60, 0x6f, 0xb1, 0x69, 0x39, 0x52, 0x39, 0x44, 0x88, 0xea,
+	0x9c, 0xb9, 0x3c, 0xa1, 0xd8, 0xa2, 0x1c, 0xa9, 0x4c, 0xbb, 0x70, 0x8b, 0x13, 0x9a, 0x96, 0xa1,
+	0x09, 0x15, 0x0c, 0x06, 0x7b, 0x87, 0x71, 0x3f, 0xbc, 0x88, 0xbb, 0x6e, 0x19, 0x5c, 0x13, 0xfe,
+	0xa8, 0xaf, 0x3b, 0xc3, 0x2a, 0xce, 0x96, 0x86, 0x33, 0xf9, 0xda, 0x97, 0x90, 0xc6, 0xa7, 0x34,
+	0x44, 0x1a, 0xce, 0x6d, 0x07, 0x78, 0x85, 0xd6, 0xee, 0x74, 0x5d, 0xd3, 0x61, 0xd9, 0x61, 0x36,
+	0xaf, 0xdf, 0xb8, 0x70, 0x39, 0x32, 0x49, 0xcb, 0xac, 0x1b, 0xcb, 0x14, 0xf3, 0x25, 0xef, 0x44,
+	0xd0, 0xe1, 0x32, 0x70, 0x36, 0xbf, 0x79, 0x55, 0x19, 0x62, 0xc9, 0x3b, 0x11, 0x94, 0x1e, 0xc0,
+	0xdb, 0x5c, 0xc6, 0x41, 0xcf, 0xc0, 0x67, 0x55, 0x91, 0x6b, 0x18, 0x4c, 0xe7, 0xeb, 0x4c, 0xd4,
+	0xfc, 0x45, 0xa2, 0xb6, 0x59, 0x6f, 0x9e, 0xa9, 0xf1, 0x27, 0x95, 0x77, 0xce, 0xa8, 0xa3, 0x36,
+	0x14, 0x84, 0xaf, 0xb9, 0x4e, 0x37, 0x22, 0xf4, 0x2e, 0x13, 0x3a, 0x77, 0xa1, 0xdf, 0xb9, 0x4e,
+	0x37, 0x2c, 0xf2, 0x86, 0x33, 0xb4, 0xe6, 0x1c, 0x81, 0xa8, 0xd0, 0x6f, 0x7c, 0x19, 0x81, 0x5c,
+	0xa9, 0xc3, 0x04, 0xa2, 0x62, 0x8f, 0xe1, 0xb6, 0xbf, 0x9a, 0x3a, 0xbb, 0x07, 0x8e, 0xa9, 0xb5,
+	0x8f, 0x0c, 0xad, 0xdb, 0xd9, 0xef, 0xf0, 0x49, 0xde, 0x63, 0x32, 0x17, 0x2e, 0xb3, 0xb8, 0x58,
+	0xf7, 0xe5, 0x23, 0x63, 0x15, 0x3b, 0xf3, 0x79, 0xde, 0x72, 0xce, 0xaa, 0xa4, 0x7f, 0x1f, 0xa6,
+	0xb9, 0x64, 0xcb, 0x76, 0x3b, 0xed, 0x13, 0x0d, 0x0f, 0xd0, 0xd2, 0x9a, 0x40, 0xd9, 0xef, 0x31,
+	0xd9, 0x8f, 0x2e, 0x92, 0xbd, 0xce, 0x08, 0xd6, 0xcd, 0xa3, 0x81, 0xfb, 0x73, 0xe1, 0x05, 0xe7,
+	0xcc, 0xda, 0xc2, 0x47, 0x90, 0xf1, 0x9e, 0x61, 0xe9, 0x32, 0x8c, 0xed, 0x77, 0x2c, 0xdb, 0xd1,
+	0x0e, 0x4d, 0xa7, 0x8f, 0x89, 0xc8, 0xb3, 0xae, 0x38, 0x60, 0xa3, 0x0a, 0x78, 0x0f, 0xd9, 0x79,
+	0x45, 0xcd, 0xb1, 0x7e, 0xcf, 0x78, 0x37, 0xfe, 0x18, 0x5f, 0x78, 0x01, 0xa3, 0x83, 0x07, 0xdb,
+	0x37, 0x4c, 0x6d, 0x42, 0x4e, 0x7e, 0xd0, 0xa5, 0xef, 0xc0, 0xc8, 0xbe, 0xee, 0xec, 0x76, 0x38,
+	0xed, 0xe0, 0x66, 0xc3, 0xff, 0x53, 0x54, 0x81, 0xd3, 0xfb, 0x30, 0xe6, 0x25, 0x45, 0x5b, 0xf6,
+	0x81, 0x15, 0xbd, 0x02, 0x91, 0x13, 0xd5, 0x55, 0xac, 0x15, 0x62, 0xfe, 0x22, 0x0e, 0xd2, 0x13,
+	0xef, 0xb0, 0x64, 0xba, 0xf2, 0x6b, 0x25, 0xd3, 0xef, 0xc3, 0xb8, 0x97, 0x19, 0x96, 0x73, 0xaa,
+	0xec, 0x6e, 0xc0, 0x3d, 0xbc, 0x1b, 0x90, 0x13, 0x89, 0x62, 0xde, 0xfc, 0x3d, 0xc8, 0x79, 0x07,
+	0x09, 0x7c, 0xc3, 0xc2, 0x5f, 0xb0, 0x30, 0xf6, 0x3f, 0x51, 0xe2, 0x84, 0xa8, 0x59, 0x51, 0x8b,
+	0xef, 0x5b, 0xe8, 0xfb, 0x70, 0x4d, 0x6e, 0x8c, 0x6e, 0xed, 0x3a, 0x76, 0x37, 0x9f, 0x92, 0x25,
+	0xa4, 0x55, 0x2a, 0xf5, 0xa9, 0xf2, 0x26, 0x74, 0x06, 0x32, 0xd6, 0x8e, 0xe6, 0x3a, 0xb8, 0xd2,
+	0x46, 0x82, 0x03, 0x4a, 0x5b, 0x3b, 0x5b, 0x88, 0x73, 0x05, 0x7d, 0x98, 0xcc, 0x24, 0x49, 0xaa,
+	0xf0, 0x27, 0x0a, 0x48, 0x4f, 0xef, 0xf4, 0x2e, 0x90, 0x80, 0x64, 0xbc, 0x7c, 0xc0, 0xae, 0x31,
+	0xa8, 0xe3, 0x92, 0xb0, 0x72, 0x6b, 0x8f, 0xde, 0x87, 0xa9, 0x90, 0x42, 0x59, 0x63, 0x76, 0xa1,
+	0x41, 0x25, 0x01, 0x5d, 0x61, 0xf3, 0xf7, 0xf8, 0x43, 0x8e, 0xaf, 0x2e, 0xcd, 0xbf, 0xd7, 0x30,
+	0x21, 0x6b, 0xaa, 0xdc, 0xda, 0x2b, 0xb4, 0x20, 0x27, 0x27, 0x01, 0x68, 0x13, 0xc6, 0xf7, 0xf5,
+	0x63, 0xcd, 0xcf, 0x24, 0x08, 0xdb, 0x45, 0x9e, 0x65, 0xca, 0xbb, 0xbb, 0x8e, 0x89, 0xce, 0x60,
+	0x0c, 0xfa, 0x4b, 0x16, 0xcc, 0xed, 0xeb, 0xc7, 0x03, 0xbc, 0xf0, 0xb9, 0x02, 0x13, 0xa1, 0xac,
+	0xc0, 0x59, 0xe9, 0x44, 0xe5, 0xd7, 0x4d, 0x27, 0xbe, 0x80, 0x6b, 0xc1, 0x1c, 0xa9, 0x78, 0x15,
+	0x19, 0xbf, 0xd4, 0xab, 0x48, 0x9f, 0x79, 0x52, 0xca, 0x92, 0xf2, 0x4a, 0x3a, 0x1b, 0xce, 0x54,
+	0xa2, 0x4e, 0x93, 0xec, 0x0e, 0x4b, 0x29, 0x79, 0xf7, 0x47, 0xff, 0x78, 0x24, 0x98, 0xb4, 0x14,
+	0xab, 0xe3, 0xaf, 0x42, 0x93, 0x47, 0xdb, 0x2f, 0xc0, 0xcd, 0x21, 0x93, 0x97, 0x5c, 0x60, 0x2a,
+	0x3c, 0x2f, 0x34, 0xec, 0x22, 0xe4, 0x87, 0x4d, 0x4d, 0x72, 0x86, 0x6b, 0x91, 0x41, 0x63, 0xbf,
+	0x7b, 0x30, 0x19, 0x18, 0xb7, 0xec, 0x0f, 0xf2, 0x80, 0xd1, 0x1f, 0x0c, 0xc8, 0xc9, 0xd9, 0x10,
+	0x3a, 0x03, 0xe9, 0x1d, 0xdd, 0x75, 0x4d, 0xe7, 0x24, 0x18, 0x33, 0xbe, 0x50, 0x54, 0xaf, 0x82,
+	0xde, 0x1b, 0x84, 0x15, 0x1c, 0x45, 0xaa, 0x42, 0x3f, 0xaf, 0x4c, 0x14, 0xc6, 0xf2, 0xd3, 0x77,
+	0x7f, 0xf1, 0x85, 0xf8, 0x6f, 0x10, 0x60, 0x84, 0x4e, 0x7e, 0x18, 0x87, 0xb1, 0x40, 0x8a, 0x04,
+	0x03, 0x8f, 0xb7, 0x1a, 0xa4, 0x57, 0x44, 0x72, 0xe0, 0x11, 0xd5, 0xdc, 0xca, 0xdf, 0x90, 0x5f,
+	0x9f, 0xc5, 0x99, 0x19, 0xb2, 0x9f, 0x57, 0x32, 0xa5, 0x91, 0x7c, 0x8c, 0x19, 0xc2, 0xaf, 0x45,
+	0x47, 0xdb, 0xef, 0x58, 0x11, 0x47, 0x4b, 0x5c, 0xd1, 0xd1, 0xf6, 0x3b, 0x56, 0xa0, 0x8e, 0xf1,
+	0xea, 0xc7, 0x11, 0xde, 0xab, 0x26, 0xe3, 0x71, 0xa1, 0xc8, 0x75, 0x42, 0x43, 0x1f, 0xc9, 0x0a,
+	0x42, 0x43, 0xdc, 0x81, 0xb1, 0xa0, 0x01, 0xb9, 0xa3, 0xe4, 0xda, 0x92, 0xf5, 0xe8, 0x0c, 0x8c,
+	0xf9, 0xe3, 0xf1, 0xdd, 0x22, 0xeb, 0xc5, 0x08, 0xb4, 0x70, 0x17, 0x02, 0x49, 0x9e, 0xab, 0x6a,
+	0xfe, 0xeb, 0x51, 0xcd, 0x4b, 0x0b, 0xc0, 0xaf, 0x13, 0xf3, 0xd0, 0x20, 0x90, 0xef, 0x41, 0x5f,
+	0x0c, 0x48, 0x93, 0xa6, 0x32, 0x21, 0xcb, 0xc1, 0xd9, 0x44, 0xa6, 0x1c, 0x8f, 0x4e, 0xb9, 0xf0,
+	0x14, 0x48, 0x38, 0xf5, 0x43, 0x1f, 0x43, 0x8a, 0xbf, 0x63, 0x51, 0x2e, 0xfb, 0x8e, 0x85, 0xb7,
+	0x2f, 0xfc, 0x67, 0x05, 0x26, 0x42, 0xb9, 0x1e, 0xfa, 0x5d, 0x1e, 0x11, 0xcd, 0x8e, 0xd3, 0x0b,
+	0xc4, 0xa8, 0xe8, 0xb5, 0x0d, 0x76, 0x58, 0xa8, 0x37, 0xd4, 0xcd, 0x4a, 0x5e, 0xba, 0x7c, 0x90,
+	0x5b, 0xd3, 0x8f, 0x11, 0x64, 0xd3, 0x62, 0x81, 0xb1, 0xde, 0x71, 0x7a, 0xac, 0x84, 0xda, 0x10,
+	0x67, 0x36, 0xe3, 0xc8, 0xec, 0x76, 0xf9, 0x0b, 0x09, 0x3e, 0xcb, 0x09, 0x5e, 0x51, 0x43, 0x9c,
+	0xbd, 0x71, 0x98, 0x85, 0xa9, 0xc1, 0x1b, 0x28, 0xa9, 0x35, 0x5f, 0xc7, 0x93, 0x5e, 0xd5, 0xa0,
+	0x7d, 0x61, 0x13, 0x4f, 0x2c, 0x22, 0xb1, 0x54, 0xbb, 0xd2, 0xb1, 0x42, 0x0e, 0xe3, 0xd2, 0xa1,
+	0xa2, 0xf0, 0x1d, 0x3c, 0xa9, 0x78, 0x49, 0xa6, 0x37, 0x43, 0xf9, 0x69, 0x1c, 0x22, 0xf9, 0x25,
+	0x7a, 0x02, 0x37, 0xbc, 0x8b, 0x7b, 0xfc, 0x5c, 0x69, 0x1e, 0xf7, 0x6c, 0xcb, 0xb4, 0xdc, 0x33,
+	0xf7, 0x22, 0x76, 0x9f, 0x8f, 0x9d, 0x14, 0xeb, 0xa2, 0x69, 0x65, 0x5a, 0x32, 0xc1, 0xd4, 0x90,
+	0x06, 0xea, 0x14, 0xbf, 0xfa, 0x17, 0x00, 0x65, 0xd1, 0x06, 0x7f, 0xea, 0xf2, 0x44, 0xc7, 0xcf,
+	0x13, 0xcd, 0xdc, 0xe9, 0x3c, 0xd1, 0x81, 0x06, 0x9e, 0xe8, 0x00, 0x58, 0xf8, 0x0e, 0x8c, 0x05,
+	0xf2, 0x61, 0xf4, 0xc9, 0xa5, 0x5f, 0xdc, 0xe3, 0x6b, 0xe0, 0x7f, 0xa7, 0xc4, 0x33, 0xca, 0xe0,
+	0x45, 0x2d, 0xeb, 0x59, 0xf8, 0x49, 0x1c, 0xc6, 0x83, 0xe9, 0xb0, 0x37, 0x7c, 0xad, 0xee, 0x8d,
+	0xdf, 0x9b, 0xb8, 0x8b, 0x97, 0xb6, 0x8f, 0x35, 0xc7, 0x74, 0x9d, 0x8e, 0xd9, 0xcf, 0x27, 0xe4,
+	0x63, 0x55, 0x1a, 0xef, 0x63, 0x1f, 0xab, 0xbc, 0x8a, 0x3e, 0x87, 0x89, 0x9e, 0xe9, 0x74, 0x6c,
+	0xc3, 0x37, 0x51, 0x72, 0xf8, 0xab, 0x2f, 0x91, 0x4c, 0x63, 0x8d, 0x07, 0x36, 0xf2, 0x47, 0x30,
+	0xde, 0x0b, 0xd4, 0x88, 0xb8, 0xf5, 0x5f, 0x15, 0x98, 0x1a, 0x92, 0xec, 0xa3, 0xbf, 0x07, 0x14,
+	0x07, 0xc8, 0x0e, 0xc7, 0x17, 0xfa, 0x25, 0x27, 0x60, 0x47, 0xe5, 0x21, 0x82, 0x31, 0xf4, 0x07,
+	0xea, 0x30, 0x51, 0x85, 0xe4, 0x2c, 0x83, 0x1a, 0x72, 0xbc, 0x99, 0xe1, 0xdc, 0xe8, 0x03, 0x43,
+	0xa8, 0x27, 0xf6, 0xf5, 0x63, 0xb9, 0xaa, 0xb0, 0x12, 0x9d, 0x0d, 0xba, 0xd8, 0x1c, 0x5c, 0x8f,
+	0x08, 0x94, 0x22, 0x32, 0x0d, 0xd1, 0x60, 0xbc, 0x6d, 0xc2, 0x44, 0x28, 0x75, 0x48, 0x9f, 0xc0,
+	0x08, 0xd7, 0xe1, 0x59, 0xf7, 0xbd, 0xbc, 0x0e, 0xdc, 0x06, 0xd2, 0x38, 0x45, 0xbf, 0xc2, 0x3f,
+	0x55, 0x80, 0x46, 0x53, 0x86, 0xc1, 0x5d, 0x5e, 0x39, 0x77, 0x97, 0x7f, 0xd3, 0x9e, 0x28, 0xdc,
+	0xe0, 0x65, 0x64, 0x5c, 0x97, 0xde, 0x8b, 0xaf, 0x76, 0x6a, 0x2f, 0xec, 0xc2, 0x44, 0x28, 0xe1,
+	0x48, 0xa7, 0xe5, 0x6d, 0x2c, 0x70, 0x57, 0x9a, 0xe3, 0xd1, 0xad, 0x3b, 0x7e, 0xde, 0xd6, 0x2d,
+	0xa6, 0xf4, 0x04, 0xc6, 0x02, 0x19, 0xc8, 0x2b, 0x68, 0x59, 0x30, 0x2c, 0xc8, 0x0c, 0x97, 0xd5,
+	0x47, 0x61, 0xd9, 0x8b, 0x6f, 0x5e, 0x02, 0xf1, 0xd1, 0x65, 0xee, 0x5f, 0xc8, 0x7b, 0x34, 0x6b,
+	0x5d, 0xf8, 0x00, 0xc6, 0x83, 0x49, 0xc4, 0x2f, 0x4b, 0xf4, 0x4b, 0x05, 0x72, 0x72, 0xe6, 0x90,
+	0xe6, 0x21, 0xcd, 0x2f, 0x55, 0x19, 0x62, 0x02, 0x5e, 0x91, 0x3e, 0x84, 0x89, 0x96, 0x6e, 0x68,
+	0xdc, 0x5b, 0x3b, 0x2d, 0xbc, 0x85, 0x10, 0x78, 0x04, 0x4d, 0xa9, 0xe3, 0x2d, 0xdd, 0xd8, 0xf4,
+	0xab, 0xe9, 0x37, 0xe1, 0xba, 0x61, 0xb6, 0xf5, 0x83, 0xae, 0xab, 0x05, 0x4d, 0x14, 0x08, 0x69,
+	0x8a, 0x3a, 0x25, 0x5a, 0x55, 0xe5, 0x33, 0x56, 0x03, 0xc6, 0xfb, 0x66, 0xcb, 0xb6, 0x0c, 0xaf,
+	0xef, 0x59, 0x5f, 0x99, 0xb0, 0xe1, 0x37, 0x59, 0x53, 0x6f, 0xd9, 0x8c, 0xf5, 0xe5, 0xa2, 0xb0,
+	0xd8, 0xf7, 0x13, 0xd2, 0x54, 0xd1, 0x62, 0xdf, 0x86, 0xb7, 0x83, 0x12, 0xb4, 0x61, 0x06, 0xbc,
+	0x15, 0xe0, 0x92, 0x9f, 0x13, 0xe8, 0x13, 0xb8, 0x1d, 0x22, 0xc0, 0x3d, 0x33, 0xf2, 0x40, 0x12,
+	0x64, 0x28, 0xb7, 0xf6, 0xfc, 0xa7, 0x92, 0x06, 0xcc, 0x84, 0x18, 0x86, 0x2d, 0x17, 0x7e, 0xbc,
+	0xb9, 0x1d, 0xa0, 0x89, 0x3c, 0x18, 0x3d, 0x86, 0x7c, 0x88, 0xca, 0x27, 0xe0, 0xf7, 0xf6, 0xaf,
+	0x07, 0x08, 0x06, 0x1d, 0xdf, 0x87, 0x5b, 0x43, 0xad, 0xc4, 0x7a, 0xf2, 0x6b, 0xfd, 0x37, 0x86,
+	0x18, 0x08, 0xbb, 0xce, 0xc2, 0x54, 0xc8, 0x25, 0x58, 0xa7, 0x11, 0x7e, 0x1c, 0x0b, 0x7a, 0x03,
+	0xba, 0xff, 0xdf, 0x28, 0x70, 0x7d, 0x68, 0xea, 0x97, 0xbe, 0x05, 0x49, 0xcc, 0xa0, 0xe7, 0x15,
+	0xd9, 0x33, 0x12, 0x2a, 0x03, 0xe9, 0x23, 0xa0, 0xfd, 0x7d, 0xdd, 0x71, 0x35, 0x71, 0x1f, 0xb0,
+	0x6b, 0x1e, 0x9a, 0xdd, 0xa0, 0xf3, 0x25, 0x54, 0xc2, 0x9a, 0xf0, 0xbb, 0x81, 0xab, 0xd8, 0x80,
+	0xbe, 0x8b, 0x8f, 0x6d, 0xad, 0x3d, 0xbb, 0xdd, 0x0e, 0x3a, 0xdc, 0xb7, 0x55, 0x0f, 0x7f, 0xf3,
+	0x4e, 0xf6, 0x7f, 0x87, 0xce, 0xf0, 0x8d, 0x78, 0xdb, 0xe5, 0x7c, 0x25, 0xfe, 0xeb, 0xfa, 0x4a,
+	0xe2, 0x3c, 0x5f, 0x99, 0x86, 0xac, 0x50, 0x9d, 0xe4, 0x57, 0x20, 0x20, 0xb4, 0xf0, 0x9f, 0x2a,
+	0x40, 0xa3, 0x49, 0x70, 0x7a, 0x1b, 0x52, 0xf2, 0x73, 0xd5, 0x20, 0x47, 0xc4, 0x51, 0xfc, 0xda,
+	0x48, 0x6f, 0xb1, 0xeb, 0x8c, 0x01, 0xa3, 0xc6, 0x55, 0x01, 0x63, 0x86, 0x0a, 0x2f, 0xe3, 0x78,
+	0xdf, 0x17, 0x68, 0xde, 0xe7, 0x00, 0xfc, 0xf2, 0xfb, 0x28, 0xff, 0xf8, 0x4d, 0xc9, 0x13, 0x95,
+	0x38, 0xfa, 0xd1, 0x87, 0xfc, 0xb3, 0x81, 0x1a, 0xbb, 0xe4, 0x2f, 0xec, 0xf2, 0x6f, 0xa2, 0xe3,
+	0x42, 0xa3, 0xcc, 0xc3, 0x8d, 0x40, 0x02, 0x9e, 0x49, 0x93, 0x53, 0x10, 0x6d, 0xbf, 0x39, 0xab,
+	0x43, 0x25, 0x84, 0x3a, 0x75, 0x4d, 0x6b, 0xd7, 0x7d, 0x29, 0x29, 0x5f, 0xea, 0xb4, 0xca, 0xea,
+	0xb0, 0xd3, 0x6f, 0x01, 0x6d, 0xd9, 0xfb, 0x3b, 0x1d, 0xcb, 0x34, 0x34, 0xe7, 0xa0, 0x6b, 0xf6,
+	0x25, 0x65, 0x13, 0xaf, 0x46, 0xc5, 0x0a, 0x54, 0xe3, 0x0f, 0x12, 0x90, 0x3f, 0x2b, 0xf9, 0x7e,
+	0x91, 0x32, 0x9f, 0x40, 0x7e, 0x90, 0x03, 0xc7, 0x07, 0x87, 0x9d, 0x83, 0xd6, 0x9e, 0xe9, 0x6a,
+	0xfd, 0xce, 0x27, 0x66, 0x78, 0xcd, 0x5c, 0x3f, 0x10, 0xc4, 0xfb, 0x1d, 0xb7, 0xc2, 0x9a, 0x35,
+	0x3b, 0x9f, 0x98, 0x11, 0x06, 0xc7, 0xc4, 0xeb, 0x4e, 0xfe, 0x2d, 0x5e, 0x69, 0x25, 0xc9, 0x0c,
+	0x2a, 0x6b, 0xc6, 0x6e, 0xf6, 0x7e, 0x95, 0xdf, 0x0f, 0xdc, 0x80, 0xf4, 0x51, 0xe0, 0x1b, 0xb0,
+	0xd4, 0x11, 0x7e, 0x00, 0x46, 0x3f, 0x84, 0x71, 0xc7, 0xb6, 0x5d, 0xed, 0xc8, 0x76, 0xb4, 0xbe,
+	0xb6, 0x67, 0x9e, 0xe4, 0x47, 0x86, 0xdf, 0xb5, 0x7e, 0x6a, 0x9e, 0xd4, 0xad, 0x43, 0xb3, 0x6b,
+	0x8b, 0x23, 0x3d, 0xbb, 0x3c, 0xaa, 0x66, 0xb1, 0xf3, 0x73, 0xdb, 0x69, 0x3e, 0x35, 0xbd, 0x3d,
+	0xfe, 0xef, 0xc0, 0x8d, 0xe1, 0x2f, 0x23, 0xbe, 0xa4, 0x3f, 0x2b, 0x9e, 0x3f, 0x0b, 0xfe, 0xb5,
+	0xa1, 0xfc, 0xe8, 0x97, 0x6f, 0xc1, 0x68, 0x38, 0x33, 0x90, 0xe9, 0x78, 0x8b, 0x50, 0x52, 0x40,
+	0x5c, 0x52, 0x40, 0xe1, 0xcf, 0x12, 0x70, 0xeb, 0xcc, 0xf7, 0x0a, 0xf4, 0x31, 0x4c, 0xf1, 0x8b,
+	0x66, 0xdc, 0xa6, 0xec, 0xf0, 0x6e, 0x3a, 0xe1, 0x80, 0x3b, 0xc9, 0xda, 0xb0, 0x4e, 0x55, 0xde,
+	0x82, 0xaa, 0xe2, 0xe3, 0x4c, 0xef, 0x2b, 0x4a, 0xd6, 0xbf, 0x9f, 0x8f, 0x9f, 0x13, 0x28, 0x97,
+	0x6d, 0xe7, 0x48, 0x77, 0xb8, 0xec, 0xbe, 0x3a, 0x29, 0x3d, 0x47, 0x71, 0x88, 0x7e, 0x00, 0x63,
+	0xe2, 0x0d, 0x86, 0x60, 0x4b, 0x5c, 0x9a, 0x2d, 0xc7, 0x3b, 0x0a, 0xa2, 0x2d, 0xb8, 0xb6, 0xdb,
+	0xb5, 0x77, 0x74, 0xe9, 0xbd, 0x0f, 0xe3, 0x4b, 0x5e, 0x9a, 0x8f, 0xf2, 0xfe, 0xdb, 0xbe, 0x1f,
+	0xf7, 0x71, 0x5b, 0xb0, 0x0f, 0x4d, 0x47, 0xef, 0x76, 0x3d, 0xbe, 0xd4, 0xa5, 0xf9, 0xc6, 0x44,
+	0x4f, 0x5e, 0x14, 0x96, 0xfe, 0x9f, 0x0a, 0x14, 0xce, 0x7e, 0xed, 0xf2, 0x95, 0x7e, 0xac, 0xf8,
+	0x1e, 0x24, 0xfa, 0x96, 0x23, 0xb2, 0x92, 0xb7, 0x78, 0x56, 0x32, 0x7b, 0xf7, 0x7f, 0x0f, 0xb2,
+	0x92, 0x78, 0xe9, 0xb1, 0xb9, 0xae, 0xaa, 0xd8, 0x8a, 0xce, 0x42, 0xd2, 0xe9, 0xf7, 0x79, 0x5c,
+	0x4d, 0x55, 0x0a, 0xbc, 0x75, 0xec, 0xee, 0x1f, 0xca, 0xad, 0x93, 0x6a, 0xb3, 0xd9, 0x50, 0x59,
+	0x3b, 0x3e, 0x3b, 0xfe, 0xff, 0xca, 0x28, 0xa4, 0xc5, 0x65, 0xc9, 0x99, 0x39, 0x18, 0x1f, 0x6c,
+	0x5d, 0xcf, 0xf4, 0xee, 0x81, 0x89, 0xc7, 0xf8, 0x43, 0xfc, 0x21, 0xce, 0xd6, 0x52, 0xb6, 0x8c,
+	0xe3, 0x33, 0xdb, 0x30, 0x15, 0x4c, 0xda, 0xf2, 0x7e, 0xdf, 0x92, 0xfb, 0x5d, 0x25, 0x75, 0x2d,
+	0x68, 0x9b, 0x40, 0x03, 0xbb, 0x1f, 0x67, 0xfd, 0xdd, 0x20, 0xeb, 0xa5, 0x1f, 0x8f, 0xfc, 0xb1,
+	0x06, 0x9f, 0xe6, 0x2e, 0x37, 0xd6, 0x33, 0x9f, 0x00, 0x05, 0xad, 0x06, 0xf9, 0x21, 0x2f, 0x14,
+	0x38, 0x77, 0x35, 0xc8, 0x7d, 0xc5, 0x37, 0x11, 0x42, 0xc0, 0x07, 0x90, 0x13, 0x59, 0x3f, 0x4e,
+	0xfa, 0x38, 0x48, 0x7a, 0x99, 0x14, 0xa1, 0x3f, 0xd2, 0x68, 0x36, 0xe9, 0x92, 0x23, 0x8d, 0x76,
+	0x3c, 0x47, 0x40, 0x20, 0x3d, 0x74, 0x15, 0x01, 0x81, 0x8e, 0x51, 0x01, 0x6b, 0x30, 0xe1, 0xa7,
+	0x2a, 0x39, 0xef, 0x6f, 0x07, 0x79, 0xcf, 0x4b, 0x6d, 0x46, 0xe8, 0xfe, 0xa3, 0x77, 0xbc, 0x08,
+	0x1c, 0x11, 0x83, 0x79, 0x62, 0xe5, 0xec, 0x3c, 0xf1, 0x9b, 0xcf, 0x21, 0xfd, 0x26, 0x80, 0xff,
+	0x2c, 0x12, 0x4e, 0x21, 0x8d, 0xea, 0xde, 0x33, 0x88, 0xb8, 0x45, 0x6d, 0x01, 0x8d, 0x46, 0x32,
+	0xcc, 0x43, 0xc9, 0x67, 0x87, 0xd0, 0x4e, 0x01, 0x3b, 0xfe, 0x81, 0xe1, 0x2e, 0x26, 0xd4, 0xfc,
+	0x33, 0x42, 0x60, 0xd3, 0xfb, 0x07, 0x2a, 0xf0, 0x3a, 0x1c, 0x1c, 0x97, 0x77, 0xef, 0x87, 0x0a,
+	0xa4, 0xd8, 0xb7, 0xef, 0x94, 0x40, 0xee, 0xc3, 0x8d, 0xc6, 0xba, 0xa6, 0xd6, 0xbf, 0xb3, 0x5d,
+	0x6f, 0x6e, 0x91, 0x18, 0x9d, 0x80, 0x2c, 0x43, 0xca, 0xd5, 0x6a, 0x7d, 0x73, 0x8b, 0x28, 0x94,
+	0xc2, 0xf8, 0xf6, 0x7a, 0x75, 0x63, 0x7d, 0xb9, 0xa1, 0xae, 0xd5, 0x6b, 0xda, 0xf6, 0x26, 0x89,
+	0xd3, 0x6b, 0x40, 0x64, 0xac, 0xb6, 0xf1, 0x7c, 0x9d, 0x24, 0x90, 0x2c, 0xd0, 0x2e, 0x89, 0x7d,
+	0x43, 0xad, 0x52, 0x88, 0xa9, 0xf5, 0x80, 0xd0, 0x11, 0x14, 0xba, 0xa9, 0x6e, 0x6c, 0xaa, 0x8d,
+	0xfa, 0x56, 0x59, 0x7d, 0x41, 0xd2, 0xf7, 0x6e, 0x42, 0x8a, 0x7d, 0x65, 0x4f, 0xc7, 0x01, 0x56,
+	0x37, 0xd4, 0xf2, 0xf3, 0xf2, 0xba, 0xa6, 0xce, 0x91, 0xd8, 0xbd, 0x1f, 0x28, 0xec, 0xc2, 0xb9,
+	0xc8, 0xdd, 0x62, 0xc7, 0xb5, 0x72, 0x55, 0xdb, 0x5e, 0x7f, 0xba, 0x8e, 0xec, 0x31, 0x9a, 0x83,
+	0x0c, 0x02, 0xcf, 0xe6, 0xb4, 0x87, 0x44, 0xc1, 0xde, 0x5e, 0x49, 0x9b, 0x23, 0xf1, 0x40, 0xb9,
+	0x44, 0x12, 0x52, 0xeb, 0x39, 0x92, 0x0c, 0xd4, 0xce, 0x93, 0x54, 0xa0, 0xbc, 0x40, 0x46, 0x0a,
+	0x99, 0x4f, 0xff, 0x65, 0x31, 0xf6, 0xe3, 0xbf, 0x28, 0xc6, 0xee, 0xfd, 0x5b, 0x05, 0x60, 0x73,
+	0xe5, 0x85, 0x34, 0x8a, 0xcd, 0x95, 0x17, 0xc1, 0x51, 0x20, 0xe0, 0x8f, 0xc2, 0x2b, 0xb1, 0x51,
+	0x5c, 0x03, 0x32, 0x28, 0x97, 0x34, 0xb5, 0xfe, 0x4c, 0x2b, 0x93, 0xc4, 0x10, 0xb4, 0xc2, 0x35,
+	0x28, 0xd0, 0x39, 0xd1, 0x32, 0x15, 0xc1, 0x2a, 0x64, 0x24, 0xd0, 0x7b, 0x5e, 0xb4, 0x4c, 0xcb,
+	0x23, 0x8e, 0xc3, 0x58, 0xf0, 0xdd, 0xd1, 0x04, 0x64, 0x6b, 0xe5, 0xad, 0xb2, 0xa6, 0x96, 0xb7,
+	0xea, 0xda, 0x43, 0x12, 0x0b, 0x02, 0x73, 0x44, 0x09, 0x02, 0x25, 0x12, 0x0f, 0x02, 0xf3, 0x24,
+	0x11, 0x04, 0x16, 0x48, 0x32, 0x08, 0x3c, 0x22, 0xa9, 0x20, 0xb0, 0x48, 0x46, 0x82, 0xc0, 0x63,
+	0x92, 0x0e, 0x02, 0x4b, 0x24, 0x13, 0x04, 0xde, 0x27, 0xa3, 0xe8, 0x57, 0xd2, 0xc0, 0x1e, 0x12,
+	0x08, 0x21, 0x73, 0x24, 0x1b, 0x42, 0x4a, 0x24, 0x17, 0x42, 0xe6, 0xc9, 0x58, 0x08, 0x59, 0x20,
+	0xe3, 0x21, 0xe4, 0x11, 0x99, 0x90, 0x34, 0xf6, 0xdf, 0x15, 0x18, 0x0f, 0xbd, 0x7c, 0xbd, 0x01,
+	0xd4, 0x6f, 0xbe, 0xb1, 0xbc, 0xdc, 0xac, 0x6f, 0x31, 0xcd, 0x0d, 0xc3, 0x51, 0x81, 0xc3, 0x70,
+	0xd4, 0xe3, 0x30, 0x1c, 0xd5, 0x39, 0x0c, 0x47, 0xad, 0x0e, 0xc3, 0x51, 0xb9, 0xc3, 0x70, 0xd4,
+	0xf1, 0x30, 0xfc, 0x31, 0x49, 0x17, 0x92, 0x6c, 0x62, 0xdb, 0x30, 0xf1, 0x61, 0x30, 0xb3, 0x2e,
+	0xad, 0xc9, 0xea, 0xc6, 0xfa, 0x56, 0xfd, 0x23, 0x0c, 0x04, 0x3e, 0xd6, 0xac, 0x37, 0x9b, 0x8d,
+	0x8d, 0x75, 0xee, 0x11, 0x02, 0x7b, 0x5a, 0x7f, 0xd1, 0x24, 0x71, 0x3a, 0x0a, 0x49, 0x2c, 0x92,
+	0x2f, 0x94, 0x7b, 0x8f, 0x61, 0x32, 0x92, 0xb2, 0xa7, 0x59, 0x48, 0xfb, 0x8c, 0x59, 0x48, 0xfb,
+	0x54, 0x19, 0x48, 0x72, 0x8e, 0x7b, 0x0f, 0x01, 0xfc, 0x4f, 0x57, 0x51, 0xc4, 0x32, 0x0b, 0x0c,
+	0xeb, 0xd5, 0x46, 0xbd, 0x49, 0x62, 0x74, 0x12, 0xc6, 0xaa, 0x2b, 0xe5, 0xf5, 0xf5, 0xfa, 0xaa,
+	0xb6, 0x56, 0x6e, 0x3e, 0x6d, 0x12, 0xe5, 0xde, 0x02, 0xa4, 0x58, 0x3e, 0x8d, 0xd1, 0xaf, 0x96,
+	0x9b, 0x4d, 0xad, 0x4c, 0x62, 0x7e, 0xa1, 0x42, 0x14, 0xbf, 0x50, 0x25, 0xf1, 0x42, 0x12, 0x8d,
+	0x7a, 0xaf, 0x07, 0x34, 0xfa, 0x7d, 0x0e, 0x05, 0x18, 0x59, 0xdd, 0x78, 0xce, 0x63, 0x5f, 0x1a,
+	0x12, 0xab, 0x1b, 0xcf, 0x89, 0x82, 0x7e, 0x51, 0xa9, 0xaf, 0x6e, 0x3c, 0xd7, 0xd6, 0x37, 0xd4,
+	0xb5, 0xf2, 0x2a, 0x89, 0x63, 0x33, 0xf1, 0x9b, 0xc5, 0xb9, 0x72, 0x65, 0xe3, 0x59, 0xdd, 0xab,
+	0x4d, 0xe2, 0x64, 0x56, 0x1a, 0x1f, 0xac, 0x90, 0x14, 0xca, 0xc5, 0x5f, 0x2c, 0xac, 0xdd, 0xfb,
+	0x0f, 0x29, 0xb8, 0x36, 0xec, 0xa3, 0x17, 0x3a, 0x06, 0xa3, 0xd5, 0x46, 0x4d, 0x53, 0x97, 0xb7,
+	0x99, 0xff, 0x78, 0xc5, 0x7a, 0xb3, 0x2e, 0x22, 0x2e, 0x16, 0x57, 0x1b, 0xeb, 0x4f, 0xb5, 0xea,
+	0x4a, 0xbd, 0xfa, 0x94, 0xc4, 0x59, 0x6c, 0xf5, 0xb0, 0x72, 0x4d, 0x25, 0x09, 0xaf, 0x55, 0x6d,
+	0x7b, 0xeb, 0x85, 0x56, 0x7d, 0x51, 0x5d, 0xad, 0x73, 0x47, 0x61, 0x44, 0x1f, 0x69, 0x9b, 0x65,
+	0xb5, 0xbc, 0xa6, 0x35, 0xeb, 0x5b, 0xdb, 0x9b, 0x3c, 0x62, 0xb0, 0xb6, 0xf5, 0x67, 0x5a, 0x73,
+	0xab, 0xbc, 0xb5, 0xdd, 0x24, 0x23, 0x74, 0x0a, 0x26, 0x10, 0x5b, 0xaf, 0x3f, 0xd7, 0x84, 0x7e,
+	0x49, 0x9a, 0xde, 0x84, 0x29, 0x41, 0xb0, 0xd5, 0x58, 0x6b, 0xac, 0x7f, 0x20, 0x18, 0x32, 0x1e,
+	0xf3, 0x56, 0x90, 0x79, 0x74, 0xc0, 0xbc, 0x3a, 0x20, 0x01, 0x7f, 0x3a, 0x4f, 0xeb, 0x2f, 0x48,
+	0xd6, 0xe3, 0x2c, 0xd7, 0xd4, 0x40, 0xdf, 0x9c, 0x37, 0x82, 0x5a, 0xfd, 0x59, 0xa3, 0x5a, 0x47,
+	0x81, 0x75, 0x32, 0x86, 0x81, 0x0c, 0xc1, 0xe5, 0x0d, 0xb5, 0x5a, 0xd7, 0xb8, 0xb3, 0x91, 0x71,
+	0x5a, 0x80, 0x1b, 0x9c, 0x12, 0xcb, 0x01, 0x9a, 0x09, 0x6f, 0x68, 0x9b, 0x6c, 0xb8, 0xab, 0x1b,
+	0x5b, 0x5a, 0x63, 0x7d, 0x79, 0x83, 0x10, 0x7a, 0x0b, 0xae, 0x07, 0x71, 0x6f, 0x84, 0x93, 0xf4,
+	0x3a, 0x4c, 0x62, 0x55, 0xa5, 0x5e, 0xae, 0x6e, 0xac, 0x8b, 0xa9, 0x12, 0xea, 0x0d, 0x48, 0xc0,
+	0xe8, 0x86, 0x64, 0x2a, 0x34, 0xca, 0xb5, 0x8d, 0x5a, 0x9d, 0xbc, 0xe3, 0x4d, 0x5b, 0xad, 0xaf,
+	0x96, 0x5f, 0xe0, 0x9a, 0x59, 0x26, 0x4f, 0xe8, 0x6d, 0xb8, 0xe5, 0x63, 0xf5, 0xf5, 0x41, 0x17,
+	0x56, 0x5d, 0xf6, 0x86, 0xc3, 0xab, 0x97, 0x1b, 0xab, 0x5b, 0x75, 0x55, 0x5b, 0x6d, 0x34, 0xb7,
+	0x48, 0x85, 0xbe, 0x03, 0x6f, 0xfb, 0x55, 0xdb, 0x9b, 0x35, 0x5c, 0xb6, 0xdb, 0x9b, 0xcc, 0xd6,
+	0xac, 0x45, 0x95, 0x16, 0xa1, 0x20, 0xc9, 0xdb, 0x52, 0x57, 0x03, 0xf5, 0x35, 0xfa, 0x2e, 0xdc,
+	0x0e, 0x8e, 0xa7, 0xf1, 0xc1, 0xb6, 0x5a, 0xd7, 0x96, 0x9f, 0xa3, 0xcb, 0xac, 0x35, 0xb6, 0x48,
+	0x9d, 0xde, 0x81, 0x69, 0xbf, 0xc9, 0xfa, 0xc6, 0x56, 0x63, 0xf9, 0x05, 0x33, 0xbe, 0x3f, 0x52,
+	0xb2, 0x2c, 0x56, 0xca, 0xcf, 0xe3, 0x30, 0x35, 0xe4, 0x44, 0xcb, 0xc2, 0xe5, 0xc0, 0xdd, 0xb4,
+	0x39, 0x12, 0x0b, 0x21, 0x25, 0xa2, 0x84, 0x90, 0x05, 0x12, 0x0f, 0x21, 0x4b, 0x24, 0x81, 0x4b,
+	0x5a, 0xe6, 0x59, 0x24, 0xc9, 0x10, 0x34, 0x5f, 0x22, 0xa9, 0x10, 0xb4, 0xb8, 0x40, 0x46, 0x50,
+	0xed, 0x72, 0xc7, 0xd2, 0x12, 0x49, 0x87, 0xb0, 0xd2, 0xa3, 0x45, 0x92, 0x09, 0x61, 0x8f, 0xe6,
+	0x4a, 0x64, 0x14, 0xed, 0x28, 0xf7, 0x7d, 0x58, 0x5a, 0x20, 0x10, 0x02, 0x4b, 0x0f, 0x17, 0x96,
+	0x48, 0x36, 0x04, 0x2e, 0x3c, 0x7c, 0x7f, 0x91, 0xe4, 0x42, 0xe0, 0xd2, 0xdc, 0xfb, 0x25, 0xee,
+	0xac, 0x81, 0x89, 0xcc, 0x2f, 0xe1, 0xae, 0x12, 0x44, 0xe7, 0x4b, 0x8f, 0x17, 0x97, 0xc8, 0x84,
+	0x50, 0xed, 0xbf, 0x57, 0x60, 0x3c, 0xf8, 0x20, 0x82, 0xf3, 0x64, 0x3e, 0x5a, 0x7f, 0x56, 0x57,
+	0x5f, 0x68, 0x73, 0x22, 0xe6, 0x49, 0x50, 0xa9, 0x49, 0x94, 0x10, 0xb4, 0x80, 0xc1, 0x37, 0x08,
+	0x2d, 0x35, 0x79, 0x50, 0x90, 0xb9, 0x16, 0x9b, 0x24, 0x19, 0xc2, 0xe6, 0x4b, 0x4d, 0x92, 0x0a,
+	0x61, 0x8b, 0x0b, 0x22, 0x20, 0xc8, 0x7d, 0x4b, 0x4b, 0x4d, 0x92, 0x16, 0xa3, 0xfe, 0x47, 0x09,
+	0xef, 0xdd, 0x5d, 0xf0, 0x65, 0xe1, 0x14, 0x4c, 0x0c, 0xf6, 0x8d, 0xed, 0xf5, 0x2d, 0x34, 0x65,
+	0x2c, 0x02, 0xce, 0xa3, 0x5b, 0x84, 0xc1, 0xc5, 0x05, 0x7e, 0x10, 0x0a, 0x76, 0x2f, 0x2d, 0x91,
+	0x44, 0x04, 0x45, 0x93, 0x26, 0x23, 0x28, 0x1a, 0x35, 0x85, 0x0b, 0x39, 0xc8, 0x80, 0x66, 0x1d,
+	0x89, 0xc0, 0xcc, 0xb0, 0xe9, 0x08, 0xcc, 0x4c, 0x9b, 0x89, 0xc0, 0xcc, 0xb8, 0xa3, 0x18, 0x57,
+	0x42, 0x93, 0x43, 0xf3, 0x42, 0x04, 0xe7, 0x06, 0xce, 0x46, 0xf0, 0xc5, 0x47, 0x8f, 0xe6, 0xd1,
+	0x73, 0x6e, 0xc2, 0x54, 0x90, 0x67, 0x7e, 0xee, 0xe1, 0x63, 0xf4, 0x9e, 0x70, 0x45, 0x69, 0xb1,
+	0x34, 0xb7, 0x80, 0x0e, 0x14, 0xae, 0x78, 0x54, 0x5a, 0x28, 0x2d, 0xf9, 0x3e, 0xf4, 0xb3, 0x38,
+	0x50, 0x6e, 0x0d, 0xf9, 0xc5, 0x28, 0xba, 0x83, 0xe8, 0x85, 0xa1, 0x94, 0x6d, 0x2c, 0x21, 0x68,
+	0x8e, 0x28, 0x61, 0xa8, 0x44, 0xe2, 0x61, 0x68, 0x9e, 0x24, 0xc2, 0xd0, 0x02, 0x49, 0x86, 0xa1,
+	0x47, 0x24, 0x15, 0x86, 0xf0, 0xe8, 0x11, 0x82, 0xf0, 0x80, 0x17, 0x82, 0xf0, 0x88, 0x17, 0x82,
+	0xde, 0xe7, 0x1b, 0x49, 0x60, 0xa8, 0x78, 0xcc, 0x0b, 0x63, 0x78, 0xd0, 0x0b, 0x63, 0x78, 0xd4,
+	0x0b, 0x63, 0x78, 0xd8, 0x0b, 0x63, 0xa8, 0xd7, 0x30, 0xf6, 0x68, 0xa0, 0xd2, 0xff, 0xa4, 0x78,
+	0xff, 0x04, 0x50, 0xf0, 0x1d, 0xbd, 0xe4, 0xb7, 0x9b, 0x75, 0xb5, 0xb1, 0x51, 0x63, 0x6a, 0x8d,
+	0x80, 0x73, 0x44, 0x89, 0x82, 0xa8, 0xda, 0x08, 0x88, 0xca, 0x8d, 0x80, 0xa8, 0xde, 0x08, 0x88,
+	0x0a, 0x8e, 0x80, 0x8b, 0x64, 0x24, 0x0a, 0x3e, 0x1e, 0xac, 0xd3, 0xff, 0x16, 0x07, 0xf0, 0x9f,
+	0x93, 0x59, 0x04, 0xe5, 0x7b, 0x10, 0x16, 0xb5, 0x25, 0x7e, 0xaa, 0x93, 0xa1, 0xb9, 0x87, 0x44,
+	0x89, 0x60, 0x38, 0xf0, 0x30, 0x36, 0x4f, 0x12, 0x11, 0x6c, 0x81, 0x24, 0x23, 0xd8, 0x22, 0x49,
+	0x45, 0xb0, 0x25, 0x32, 0x12, 0xc6, 0x4a, 0x0f, 0x49, 0x3a, 0x82, 0xcd, 0x91, 0x4c, 0x04, 0x5b,
+	0x20, 0xa3, 0x11, 0x6c, 0x91, 0x40, 0x04, 0x7b, 0x4c, 0xb2, 0x11, 0xec, 0x7d, 0x92, 0x0b, 0x63,
+	0xf3, 0x0f, 0xc9, 0x58, 0x04, 0x9b, 0x27, 0xe3, 0x11, 0x6c, 0x71, 0xe0, 0x1a, 0x9f, 0x26, 0x60,
+	0xd8, 0xdd, 0x1d, 0x34, 0x03, 0x1e, 0x69, 0xca, 0xd5, 0xa7, 0x7c, 0x8b, 0x65, 0xfb, 0x61, 0x04,
+	0x14, 0xb1, 0x2f, 0x08, 0x2e, 0x90, 0x78, 0x14, 0x14, 0xa1, 0x2f, 0xc4, 0x29, 0x42, 0x5f, 0x10,
+	0x65, 0xdb, 0x63, 0x04, 0x5d, 0x14, 0x91, 0x2f, 0xc4, 0x50, 0x12, 0x91, 0x2f, 0x34, 0xae, 0x47,
+	0x22, 0xf2, 0x05, 0x61, 0xbe, 0x55, 0xde, 0x00, 0x1a, 0x22, 0xe1, 0xbb, 0x65, 0x04, 0x17, 0x1b,
+	0x66, 0x04, 0x17, 0x7b, 0x66, 0x04, 0x17, 0xdb, 0xe6, 0x4d, 0x98, 0x0a, 0xe2, 0xde, 0xce, 0x19,
+	0xa9, 0x08, 0x6e, 0x9e, 0xbe, 0x29, 0x02, 0xe9, 0x25, 0x59, 0x97, 0x35, 0x76, 0xbc, 0x09, 0x99,
+	0x82, 0x83, 0x21, 0x53, 0x70, 0x30, 0x64, 0x0a, 0x0e, 0x86, 0x4c, 0x21, 0x38, 0x43, 0xa6, 0xe0,
+	0x68, 0xd8, 0x14, 0x1c, 0x0d, 0x9b, 0x42, 0x30, 0x84, 0x4d, 0x21, 0xc6, 0x15, 0x36, 0x05, 0x87,
+	0x23, 0xa6, 0x10, 0x24, 0x11, 0x53, 0x08, 0x96, 0x88, 0x29, 0xc4, 0x04, 0x23, 0xa6, 0x10, 0x73,
+	0x8c, 0x98, 0xc2, 0x9b, 0x66, 0xc4, 0x14, 0xde, 0x4c, 0x65, 0x53, 0xfc, 0x79, 0x1c, 0xd2, 0x22,
+	0x3f, 0x89, 0xf9, 0x0d, 0xf5, 0x23, 0xd1, 0x0a, 0xc3, 0xa3, 0x5c, 0x9e, 0x23, 0x4a, 0xa0, 0x5c,
+	0x22, 0xf1, 0x40, 0x19, 0xe3, 0x8a, 0x5c, 0x5e, 0x20, 0xc9, 0x40, 0xf9, 0x11, 0x49, 0x05, 0xca,
+	0x18, 0x00, 0xe5, 0x32, 0x6e, 0x30, 0x72, 0x19, 0x77, 0x17, 0xb9, 0x8c, 0x5b, 0x0b, 0x3e, 0xb5,
+	0x0e, 0xc6, 0x83, 0xfb, 0x4a, 0x00, 0xc0, 0x4d, 0x25, 0x00, 0xe0, 0x8e, 0x12, 0x00, 0x70, 0x3b,
+	0x09, 0x00, 0xa8, 0x9f, 0x00, 0x10, 0xcc, 0x1c, 0xfc, 0x30, 0x0e, 0x29, 0x76, 0xe5, 0x10, 0x1b,
+	0xad, 0x35, 0xd6, 0x37, 0xd4, 0xc1, 0x93, 0x5e, 0x16, 0xd2, 0x1c, 0x10, 0xf9, 0x15, 0xbf, 0x56,
+	0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0x40,
+	0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0x40, 0xe4, 0x57, 0x7c, 0xc0,
+	0xcb, 0xaf, 0x48, 0x88, 0xc8, 0xaf, 0x48, 0x88, 0xc8, 0xaf, 0x48, 0x88, 0xc8, 0xaf, 0x48, 0x88,
+	0xc8, 0xaf, 0x48, 0xc8, 0x60, 0xbb, 0xad, 0xfc, 0x2b, 0xe5, 0xa7, 0x9f, 0x15, 0x95, 0x9f, 0x7d,
+	0x56, 0x54, 0x7e, 0xfe, 0x59, 0x31, 0xf6, 0x8b, 0xcf, 0x8a, 0xb1, 0x5f, 0x7e, 0x56, 0x8c, 0xfd,
+	0xed, 0x67, 0xc5, 0xd8, 0xaf, 0x3e, 0x2b, 0x2a, 0xdf, 0x3f, 0x2d, 0x2a, 0x9f, 0x9e, 0x16, 0x63,
+	0x7f, 0x79, 0x5a, 0x54, 0x7e, 0x7c, 0x5a, 0x8c, 0xfd, 0xe4, 0xb4, 0x18, 0xfb, 0xeb, 0xd3, 0x62,
+	0xec, 0xa7, 0xa7, 0x45, 0xe5, 0x67, 0xa7, 0x45, 0xe5, 0xe7, 0xa7, 0xc5, 0xd8, 0x2f, 0x4e, 0x8b,
+	0xca, 0x2f, 0x4f, 0x8b, 0xb1, 0xbf, 0x3d, 0x2d, 0x2a, 0xbf, 0x3a, 0x2d, 0xc6, 0xbe, 0xff, 0xba,
+	0x18, 0xfb, 0xf4, 0x75, 0x51, 0xf9, 0xe3, 0xd7, 0xc5, 0xd8, 0x9f, 0xbd, 0x2e, 0x2a, 0x3f, 0x7a,
+	0x5d, 0x8c, 0xfd, 0xe5, 0xeb, 0x62, 0xec, 0xc7, 0xaf, 0x8b, 0xca, 0x4f, 0x5e, 0x17, 0x95, 0xbf,
+	0x7e, 0x5d, 0x54, 0xbe, 0xfb, 0xe0, 0x0a, 0x6f, 0x73, 0x5c, 0xab, 0xb7, 0xb3, 0x33, 0xc2, 0x2e,
+	0x3a, 0xce, 0xff, 0xff, 0x01, 0x00, 0x7a, 0x06, 0x05, 0x14, 0x5a, 0x57, 0x00, 0x00,
 }
-func (this *DataRate_FSK) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DataRate_FSK)
-	if !ok {
-		that2, ok := that.(DataRate_FSK)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (x MType) String() string {
+	s, ok := MType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x Major) String() string {
+	s, ok := Major_name[int32(x)]
+	if ok {
+		return s
 	}
-	if !this.FSK.Equal(that1.FSK) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x JoinRequestType) String() string {
+	s, ok := JoinRequestType_name[int32(x)]
+	if ok {
+		return s
 	}
-	return true
+	return strconv.Itoa(int(x))
 }
-func (this *TxSettings) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (x RejoinRequestType) String() string {
+	s, ok := RejoinRequestType_name[int32(x)]
+	if ok {
+		return s
 	}
-
-	that1, ok := that.(*TxSettings)
-	if !ok {
-		that2, ok := that.(TxSettings)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	return strconv.Itoa(int(x))
+}
+func (x CFListType) String() string {
+	s, ok := CFListType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x Class) String() string {
+	s, ok := Class_name[int32(x)]
+	if ok {
+		return s
 	}
-	if !this.DataRate.Equal(&that1.DataRate) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x TxSchedulePriority) String() string {
+	s, ok := TxSchedulePriority_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x MACCommandIdentifier) String() string {
+	s, ok := MACCommandIdentifier_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.CodingRate != that1.CodingRate {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x AggregatedDutyCycle) String() string {
+	s, ok := AggregatedDutyCycle_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Frequency != that1.Frequency {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x PingSlotPeriod) String() string {
+	s, ok := PingSlotPeriod_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.EnableCRC != that1.EnableCRC {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x RejoinCountExponent) String() string {
+	s, ok := RejoinCountExponent_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Timestamp != that1.Timestamp {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x RejoinTimeExponent) String() string {
+	s, ok := RejoinTimeExponent_name[int32(x)]
+	if ok {
+		return s
 	}
-	if that1.Time == nil {
-		if this.Time != nil {
-			return false
-		}
-	} else if !this.Time.Equal(*that1.Time) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x RejoinPeriodExponent) String() string {
+	s, ok := RejoinPeriodExponent_name[int32(x)]
+	if ok {
+		return s
 	}
-	if !this.Downlink.Equal(that1.Downlink) {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x DeviceEIRP) String() string {
+	s, ok := DeviceEIRP_name[int32(x)]
+	if ok {
+		return s
 	}
-	return true
+	return strconv.Itoa(int(x))
 }
-func (this *TxSettings_Downlink) Equal(that interface{}) bool {
+func (x ADRAckLimitExponent) String() string {
+	s, ok := ADRAckLimitExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAckDelayExponent) String() string {
+	s, ok := ADRAckDelayExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Minor) String() string {
+	s, ok := Minor_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Message) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxSettings_Downlink)
+	that1, ok := that.(*Message)
 	if !ok {
-		that2, ok := that.(TxSettings_Downlink)
+		that2, ok := that.(Message)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6206,25 +6722,31 @@ func (this *TxSettings_Downlink) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AntennaIndex != that1.AntennaIndex {
+	if !this.MHDR.Equal(&that1.MHDR) {
 		return false
 	}
-	if this.TxPower != that1.TxPower {
+	if !bytes.Equal(this.MIC, that1.MIC) {
 		return false
 	}
-	if this.InvertPolarization != that1.InvertPolarization {
+	if that1.Payload == nil {
+		if this.Payload != nil {
+			return false
+		}
+	} else if this.Payload == nil {
+		return false
+	} else if !this.Payload.Equal(that1.Payload) {
 		return false
 	}
 	return true
 }
-func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
+func (this *Message_MACPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GatewayAntennaIdentifiers)
+	that1, ok := that.(*Message_MACPayload)
 	if !ok {
-		that2, ok := that.(GatewayAntennaIdentifiers)
+		that2, ok := that.(Message_MACPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6236,22 +6758,19 @@ func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
-		return false
-	}
-	if this.AntennaIndex != that1.AntennaIndex {
+	if !this.MACPayload.Equal(that1.MACPayload) {
 		return false
 	}
 	return true
 }
-func (this *UplinkToken) Equal(that interface{}) bool {
+func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UplinkToken)
+	that1, ok := that.(*Message_JoinRequestPayload)
 	if !ok {
-		that2, ok := that.(UplinkToken)
+		that2, ok := that.(Message_JoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6263,28 +6782,19 @@ func (this *UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayAntennaIdentifiers.Equal(&that1.GatewayAntennaIdentifiers) {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	if !this.ServerTime.Equal(that1.ServerTime) {
-		return false
-	}
-	if this.ConcentratorTime != that1.ConcentratorTime {
+	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath) Equal(that interface{}) bool {
+func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath)
+	that1, ok := that.(*Message_JoinAcceptPayload)
 	if !ok {
-		that2, ok := that.(DownlinkPath)
+		that2, ok := that.(Message_JoinAcceptPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6296,25 +6806,19 @@ func (this *DownlinkPath) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Path == nil {
-		if this.Path != nil {
-			return false
-		}
-	} else if this.Path == nil {
-		return false
-	} else if !this.Path.Equal(that1.Path) {
+	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
+func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_UplinkToken)
+	that1, ok := that.(*Message_RejoinRequestPayload)
 	if !ok {
-		that2, ok := that.(DownlinkPath_UplinkToken)
+		that2, ok := that.(Message_RejoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6326,19 +6830,19 @@ func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
+	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
+func (this *MHDR) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_Fixed)
+	that1, ok := that.(*MHDR)
 	if !ok {
-		that2, ok := that.(DownlinkPath_Fixed)
+		that2, ok := that.(MHDR)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6350,19 +6854,22 @@ func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Fixed.Equal(that1.Fixed) {
+	if this.MType != that1.MType {
+		return false
+	}
+	if this.Major != that1.Major {
 		return false
 	}
 	return true
 }
-func (this *TxRequest) Equal(that interface{}) bool {
+func (this *MACPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxRequest)
+	that1, ok := that.(*MACPayload)
 	if !ok {
-		that2, ok := that.(TxRequest)
+		that2, ok := that.(MACPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6374,58 +6881,31 @@ func (this *TxRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
-		return false
-	}
-	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
-		return false
-	}
-	for i := range this.DownlinkPaths {
-		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
-			return false
-		}
-	}
-	if this.Rx1Delay != that1.Rx1Delay {
-		return false
-	}
-	if this.Rx1DataRateIndex != that1.Rx1DataRateIndex {
-		return false
-	}
-	if this.Rx1Frequency != that1.Rx1Frequency {
-		return false
-	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
+	if !this.FHDR.Equal(&that1.FHDR) {
 		return false
 	}
-	if this.Priority != that1.Priority {
+	if this.FPort != that1.FPort {
 		return false
 	}
-	if that1.AbsoluteTime == nil {
-		if this.AbsoluteTime != nil {
-			return false
-		}
-	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
 		return false
 	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
 		return false
 	}
-	if !this.Advanced.Equal(that1.Advanced) {
+	if this.FullFCnt != that1.FullFCnt {
 		return false
 	}
 	return true
 }
-func (this *MACCommand) Equal(that interface{}) bool {
+func (this *FHDR) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand)
+	that1, ok := that.(*FHDR)
 	if !ok {
-		that2, ok := that.(MACCommand)
+		that2, ok := that.(FHDR)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6437,28 +6917,28 @@ func (this *MACCommand) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CID != that1.CID {
+	if !this.DevAddr.Equal(that1.DevAddr) {
 		return false
 	}
-	if that1.Payload == nil {
-		if this.Payload != nil {
-			return false
-		}
-	} else if this.Payload == nil {
+	if !this.FCtrl.Equal(&that1.FCtrl) {
 		return false
-	} else if !this.Payload.Equal(that1.Payload) {
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if !bytes.Equal(this.FOpts, that1.FOpts) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
+func (this *FCtrl) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RawPayload)
+	that1, ok := that.(*FCtrl)
 	if !ok {
-		that2, ok := that.(MACCommand_RawPayload)
+		that2, ok := that.(FCtrl)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6470,19 +6950,31 @@ func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
+	if this.ADR != that1.ADR {
+		return false
+	}
+	if this.ADRAckReq != that1.ADRAckReq {
+		return false
+	}
+	if this.Ack != that1.Ack {
+		return false
+	}
+	if this.FPending != that1.FPending {
+		return false
+	}
+	if this.ClassB != that1.ClassB {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
+func (this *JoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetInd_)
+	that1, ok := that.(*JoinRequestPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetInd_)
+		that2, ok := that.(JoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6494,19 +6986,25 @@ func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ResetInd.Equal(that1.ResetInd) {
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	if !this.DevNonce.Equal(that1.DevNonce) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
+func (this *RejoinRequestPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf_)
+	that1, ok := that.(*RejoinRequestPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf_)
+		that2, ok := that.(RejoinRequestPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6518,19 +7016,31 @@ func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ResetConf.Equal(that1.ResetConf) {
+	if this.RejoinType != that1.RejoinType {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	if this.RejoinCnt != that1.RejoinCnt {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
+func (this *JoinAcceptPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns_)
+	that1, ok := that.(*JoinAcceptPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns_)
+		that2, ok := that.(JoinAcceptPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6542,19 +7052,37 @@ func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
+	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
+		return false
+	}
+	if !this.JoinNonce.Equal(that1.JoinNonce) {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if !this.DLSettings.Equal(&that1.DLSettings) {
+		return false
+	}
+	if this.RxDelay != that1.RxDelay {
+		return false
+	}
+	if !this.CFList.Equal(that1.CFList) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
+func (this *DLSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRReq_)
+	that1, ok := that.(*DLSettings)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRReq_)
+		that2, ok := that.(DLSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6566,19 +7094,25 @@ func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkADRReq.Equal(that1.LinkADRReq) {
+	if this.Rx1DROffset != that1.Rx1DROffset {
+		return false
+	}
+	if this.Rx2DR != that1.Rx2DR {
+		return false
+	}
+	if this.OptNeg != that1.OptNeg {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
+func (this *CFList) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRAns_)
+	that1, ok := that.(*CFList)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRAns_)
+		that2, ok := that.(CFList)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6590,43 +7124,35 @@ func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkADRAns.Equal(that1.LinkADRAns) {
+	if this.Type != that1.Type {
 		return false
 	}
-	return true
-}
-func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.Freq) != len(that1.Freq) {
+		return false
 	}
-
-	that1, ok := that.(*MACCommand_DutyCycleReq_)
-	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq_)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.Freq {
+		if this.Freq[i] != that1.Freq[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if len(this.ChMasks) != len(that1.ChMasks) {
 		return false
 	}
-	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
-		return false
+	for i := range this.ChMasks {
+		if this.ChMasks[i] != that1.ChMasks[i] {
+			return false
+		}
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
+func (this *LoRaDataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq_)
+	that1, ok := that.(*LoRaDataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq_)
+		that2, ok := that.(LoRaDataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6638,19 +7164,22 @@ func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
+	if this.Bandwidth != that1.Bandwidth {
+		return false
+	}
+	if this.SpreadingFactor != that1.SpreadingFactor {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
+func (this *FSKDataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns_)
+	that1, ok := that.(*FSKDataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns_)
+		that2, ok := that.(FSKDataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6662,19 +7191,19 @@ func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
+	if this.BitRate != that1.BitRate {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
+func (this *DataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns_)
+	that1, ok := that.(*DataRate)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns_)
+		that2, ok := that.(DataRate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6686,19 +7215,25 @@ func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
+	if that1.Modulation == nil {
+		if this.Modulation != nil {
+			return false
+		}
+	} else if this.Modulation == nil {
+		return false
+	} else if !this.Modulation.Equal(that1.Modulation) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
+func (this *DataRate_LoRa) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq_)
+	that1, ok := that.(*DataRate_LoRa)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq_)
+		that2, ok := that.(DataRate_LoRa)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6710,19 +7245,19 @@ func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
+	if !this.LoRa.Equal(that1.LoRa) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
+func (this *DataRate_FSK) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns_)
+	that1, ok := that.(*DataRate_FSK)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns_)
+		that2, ok := that.(DataRate_FSK)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6734,19 +7269,19 @@ func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
+	if !this.FSK.Equal(that1.FSK) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
+func (this *TxSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelReq_)
+	that1, ok := that.(*TxSettings)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelReq_)
+		that2, ok := that.(TxSettings)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6758,19 +7293,44 @@ func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DLChannelReq.Equal(that1.DLChannelReq) {
+	if !this.DataRate.Equal(&that1.DataRate) {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.EnableCRC != that1.EnableCRC {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
+func (this *TxSettings_Downlink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelAns_)
+	that1, ok := that.(*TxSettings_Downlink)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelAns_)
+		that2, ok := that.(TxSettings_Downlink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6782,19 +7342,25 @@ func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DLChannelAns.Equal(that1.DLChannelAns) {
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
+	if this.TxPower != that1.TxPower {
+		return false
+	}
+	if this.InvertPolarization != that1.InvertPolarization {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
+func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
+	that1, ok := that.(*GatewayAntennaIdentifiers)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq_)
+		that2, ok := that.(GatewayAntennaIdentifiers)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6806,19 +7372,22 @@ func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.AntennaIndex != that1.AntennaIndex {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
+func (this *UplinkToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq_)
+	that1, ok := that.(*UplinkToken)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq_)
+		that2, ok := that.(UplinkToken)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6830,19 +7399,28 @@ func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
+	if !this.GatewayAntennaIdentifiers.Equal(&that1.GatewayAntennaIdentifiers) {
 		return false
 	}
-	return true
-}
-func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
-	if that == nil {
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !this.ServerTime.Equal(that1.ServerTime) {
+		return false
+	}
+	if this.ConcentratorTime != that1.ConcentratorTime {
+		return false
+	}
+	return true
+}
+func (this *DownlinkPath) Equal(that interface{}) bool {
+	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd_)
+	that1, ok := that.(*DownlinkPath)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd_)
+		that2, ok := that.(DownlinkPath)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6854,19 +7432,25 @@ func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyInd.Equal(that1.RekeyInd) {
+	if that1.Path == nil {
+		if this.Path != nil {
+			return false
+		}
+	} else if this.Path == nil {
+		return false
+	} else if !this.Path.Equal(that1.Path) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
+func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf_)
+	that1, ok := that.(*DownlinkPath_UplinkToken)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf_)
+		that2, ok := that.(DownlinkPath_UplinkToken)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6878,19 +7462,19 @@ func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyConf.Equal(that1.RekeyConf) {
+	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
+func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ADRParamSetupReq_)
+	that1, ok := that.(*DownlinkPath_Fixed)
 	if !ok {
-		that2, ok := that.(MACCommand_ADRParamSetupReq_)
+		that2, ok := that.(DownlinkPath_Fixed)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6902,19 +7486,19 @@ func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ADRParamSetupReq.Equal(that1.ADRParamSetupReq) {
+	if !this.Fixed.Equal(that1.Fixed) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
+func (this *TxRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns_)
+	that1, ok := that.(*TxRequest)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns_)
+		that2, ok := that.(TxRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6926,19 +7510,58 @@ func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
+	if this.Class != that1.Class {
+		return false
+	}
+	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
+		return false
+	}
+	for i := range this.DownlinkPaths {
+		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
+			return false
+		}
+	}
+	if this.Rx1Delay != that1.Rx1Delay {
+		return false
+	}
+	if this.Rx1DataRateIndex != that1.Rx1DataRateIndex {
+		return false
+	}
+	if this.Rx1Frequency != that1.Rx1Frequency {
+		return false
+	}
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if that1.AbsoluteTime == nil {
+		if this.AbsoluteTime != nil {
+			return false
+		}
+	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
+func (this *MACCommand) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq_)
+	that1, ok := that.(*MACCommand)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq_)
+		that2, ok := that.(MACCommand)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6950,19 +7573,28 @@ func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
+	if this.CID != that1.CID {
+		return false
+	}
+	if that1.Payload == nil {
+		if this.Payload != nil {
+			return false
+		}
+	} else if this.Payload == nil {
+		return false
+	} else if !this.Payload.Equal(that1.Payload) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
+	that1, ok := that.(*MACCommand_RawPayload)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
+		that2, ok := that.(MACCommand_RawPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6974,19 +7606,19 @@ func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
+	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
+	that1, ok := that.(*MACCommand_ResetInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
+		that2, ok := that.(MACCommand_ResetInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6998,19 +7630,19 @@ func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
+	if !this.ResetInd.Equal(that1.ResetInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
+	that1, ok := that.(*MACCommand_ResetConf_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq_)
+		that2, ok := that.(MACCommand_ResetConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7022,19 +7654,19 @@ func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
+	if !this.ResetConf.Equal(that1.ResetConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
+	that1, ok := that.(*MACCommand_LinkCheckAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq_)
+		that2, ok := that.(MACCommand_LinkCheckAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7046,19 +7678,19 @@ func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
+	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
+	that1, ok := that.(*MACCommand_LinkADRReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns_)
+		that2, ok := that.(MACCommand_LinkADRReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7070,19 +7702,19 @@ func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
+	if !this.LinkADRReq.Equal(that1.LinkADRReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns_)
+	that1, ok := that.(*MACCommand_LinkADRAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns_)
+		that2, ok := that.(MACCommand_LinkADRAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7094,19 +7726,19 @@ func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
+	if !this.LinkADRAns.Equal(that1.LinkADRAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
+func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq_)
+	that1, ok := that.(*MACCommand_DutyCycleReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq_)
+		that2, ok := that.(MACCommand_DutyCycleReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7118,19 +7750,19 @@ func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
+	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns_)
+	that1, ok := that.(*MACCommand_RxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns_)
+		that2, ok := that.(MACCommand_RxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7142,19 +7774,19 @@ func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
+	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd_)
+	that1, ok := that.(*MACCommand_RxParamSetupAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd_)
+		that2, ok := that.(MACCommand_RxParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7166,19 +7798,19 @@ func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
+	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
+func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf_)
+	that1, ok := that.(*MACCommand_DevStatusAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf_)
+		that2, ok := that.(MACCommand_DevStatusAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7190,19 +7822,19 @@ func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
+	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetInd)
+	that1, ok := that.(*MACCommand_NewChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetInd)
+		that2, ok := that.(MACCommand_NewChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7214,19 +7846,19 @@ func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf)
+	that1, ok := that.(*MACCommand_NewChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf)
+		that2, ok := that.(MACCommand_NewChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7238,19 +7870,19 @@ func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns)
+	that1, ok := that.(*MACCommand_DLChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns)
+		that2, ok := that.(MACCommand_DLChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7262,22 +7894,19 @@ func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Margin != that1.Margin {
-		return false
-	}
-	if this.GatewayCount != that1.GatewayCount {
+	if !this.DLChannelReq.Equal(that1.DLChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRReq)
+	that1, ok := that.(*MACCommand_DLChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRReq)
+		that2, ok := that.(MACCommand_DLChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7289,36 +7918,43 @@ func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
-	}
-	if this.TxPowerIndex != that1.TxPowerIndex {
+	if !this.DLChannelAns.Equal(that1.DLChannelAns) {
 		return false
 	}
-	if len(this.ChannelMask) != len(that1.ChannelMask) {
-		return false
+	return true
+}
+func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.ChannelMask {
-		if this.ChannelMask[i] != that1.ChannelMask[i] {
+
+	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
+	if !ok {
+		that2, ok := that.(MACCommand_RxTimingSetupReq_)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.ChannelMaskControl != that1.ChannelMaskControl {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.NbTrans != that1.NbTrans {
+	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
+func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRAns)
+	that1, ok := that.(*MACCommand_TxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRAns)
+		that2, ok := that.(MACCommand_TxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7330,25 +7966,19 @@ func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelMaskAck != that1.ChannelMaskAck {
-		return false
-	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
-		return false
-	}
-	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
+	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DutyCycleReq)
+	that1, ok := that.(*MACCommand_RekeyInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq)
+		that2, ok := that.(MACCommand_RekeyInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7360,19 +7990,19 @@ func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxDutyCycle != that1.MaxDutyCycle {
+	if !this.RekeyInd.Equal(that1.RekeyInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq)
+	that1, ok := that.(*MACCommand_RekeyConf_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq)
+		that2, ok := that.(MACCommand_RekeyConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7384,25 +8014,19 @@ func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
+	if !this.RekeyConf.Equal(that1.RekeyConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_ADRParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns)
+	that1, ok := that.(*MACCommand_ADRParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns)
+		that2, ok := that.(MACCommand_ADRParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7414,25 +8038,19 @@ func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
-		return false
-	}
-	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
-		return false
-	}
-	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
+	if !this.ADRParamSetupReq.Equal(that1.ADRParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns)
+	that1, ok := that.(*MACCommand_DeviceTimeAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns)
+		that2, ok := that.(MACCommand_DeviceTimeAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7444,22 +8062,19 @@ func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Battery != that1.Battery {
-		return false
-	}
-	if this.Margin != that1.Margin {
+	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq)
+	that1, ok := that.(*MACCommand_ForceRejoinReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq)
+		that2, ok := that.(MACCommand_ForceRejoinReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7471,28 +8086,19 @@ func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
-		return false
-	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns)
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns)
+		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7504,22 +8110,19 @@ func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
-		return false
-	}
-	if this.DataRateAck != that1.DataRateAck {
+	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelReq)
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelReq)
+		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7531,22 +8134,19 @@ func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
+	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelAns)
+	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelAns)
+		that2, ok := that.(MACCommand_PingSlotInfoReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7558,22 +8158,19 @@ func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndexAck != that1.ChannelIndexAck {
-		return false
-	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq)
+	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq)
+		that2, ok := that.(MACCommand_PingSlotChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7585,19 +8182,19 @@ func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
+	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq)
+	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq)
+		that2, ok := that.(MACCommand_PingSlotChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7609,25 +8206,19 @@ func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxEIRPIndex != that1.MaxEIRPIndex {
-		return false
-	}
-	if this.UplinkDwellTime != that1.UplinkDwellTime {
-		return false
-	}
-	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
+	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd)
+	that1, ok := that.(*MACCommand_BeaconTimingAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd)
+		that2, ok := that.(MACCommand_BeaconTimingAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7639,19 +8230,19 @@ func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf)
+	that1, ok := that.(*MACCommand_BeaconFreqReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf)
+		that2, ok := that.(MACCommand_BeaconFreqReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7663,19 +8254,19 @@ func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ADRParamSetupReq)
+	that1, ok := that.(*MACCommand_BeaconFreqAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_ADRParamSetupReq)
+		that2, ok := that.(MACCommand_BeaconFreqAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7687,22 +8278,19 @@ func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ADRAckLimitExponent != that1.ADRAckLimitExponent {
-		return false
-	}
-	if this.ADRAckDelayExponent != that1.ADRAckDelayExponent {
+	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns)
+	that1, ok := that.(*MACCommand_DeviceModeInd_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns)
+		that2, ok := that.(MACCommand_DeviceModeInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7714,19 +8302,19 @@ func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Time.Equal(that1.Time) {
+	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq)
+	that1, ok := that.(*MACCommand_DeviceModeConf_)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq)
+		that2, ok := that.(MACCommand_DeviceModeConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7738,28 +8326,19 @@ func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RejoinType != that1.RejoinType {
-		return false
-	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
-	}
-	if this.MaxRetries != that1.MaxRetries {
-		return false
-	}
-	if this.PeriodExponent != that1.PeriodExponent {
+	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
+	that1, ok := that.(*MACCommand_RelayConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq)
+		that2, ok := that.(MACCommand_RelayConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7771,22 +8350,19 @@ func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxCountExponent != that1.MaxCountExponent {
-		return false
-	}
-	if this.MaxTimeExponent != that1.MaxTimeExponent {
+	if !this.RelayConfReq.Equal(that1.RelayConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
+	that1, ok := that.(*MACCommand_RelayConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns)
+		that2, ok := that.(MACCommand_RelayConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7798,19 +8374,19 @@ func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
+	if !this.RelayConfAns.Equal(that1.RelayConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7822,19 +8398,19 @@ func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Period != that1.Period {
+	if !this.RelayEndDeviceConfReq.Equal(that1.RelayEndDeviceConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7846,22 +8422,19 @@ func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.DataRateIndex != that1.DataRateIndex {
+	if !this.RelayEndDeviceConfAns.Equal(that1.RelayEndDeviceConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns)
+	that1, ok := that.(*MACCommand_RelayFilterListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns)
+		that2, ok := that.(MACCommand_RelayFilterListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7873,22 +8446,19 @@ func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
-		return false
-	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
+	if !this.RelayFilterListReq.Equal(that1.RelayFilterListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns)
+	that1, ok := that.(*MACCommand_RelayFilterListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns)
+		that2, ok := that.(MACCommand_RelayFilterListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7900,22 +8470,19 @@ func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	if this.ChannelIndex != that1.ChannelIndex {
+	if !this.RelayFilterListAns.Equal(that1.RelayFilterListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayUpdateUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq)
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq)
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7927,19 +8494,19 @@ func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
+	if !this.RelayUpdateUplinkListReq.Equal(that1.RelayUpdateUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7951,19 +8518,19 @@ func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.RelayCtrlUplinkListReq.Equal(that1.RelayCtrlUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7975,19 +8542,19 @@ func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if !this.RelayCtrlUplinkListAns.Equal(that1.RelayCtrlUplinkListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfigureFwdLimitReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf)
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf)
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7999,19 +8566,19 @@ func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if !this.RelayConfigureFwdLimitReq.Equal(that1.RelayConfigureFwdLimitReq) {
 		return false
 	}
 	return true
 }
-func (this *FrequencyValue) Equal(that interface{}) bool {
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FrequencyValue)
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq_)
 	if !ok {
-		that2, ok := that.(FrequencyValue)
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8023,19 +8590,19 @@ func (this *FrequencyValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if !this.RelayNotifyNewEndDeviceReq.Equal(that1.RelayNotifyNewEndDeviceReq) {
 		return false
 	}
 	return true
 }
-func (this *DataRateOffsetValue) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRateOffsetValue)
+	that1, ok := that.(*MACCommand_ResetInd)
 	if !ok {
-		that2, ok := that.(DataRateOffsetValue)
+		that2, ok := that.(MACCommand_ResetInd)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8047,19 +8614,19 @@ func (this *DataRateOffsetValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *DataRateIndexValue) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRateIndexValue)
+	that1, ok := that.(*MACCommand_ResetConf)
 	if !ok {
-		that2, ok := that.(DataRateIndexValue)
+		that2, ok := that.(MACCommand_ResetConf)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8071,19 +8638,19 @@ func (this *DataRateIndexValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PingSlotPeriodValue)
+	that1, ok := that.(*MACCommand_LinkCheckAns)
 	if !ok {
-		that2, ok := that.(PingSlotPeriodValue)
+		that2, ok := that.(MACCommand_LinkCheckAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8095,19 +8662,22 @@ func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.Margin != that1.Margin {
 		return false
 	}
-	return true
-}
-func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
+	if this.GatewayCount != that1.GatewayCount {
+		return false
+	}
+	return true
+}
+func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AggregatedDutyCycleValue)
+	that1, ok := that.(*MACCommand_LinkADRReq)
 	if !ok {
-		that2, ok := that.(AggregatedDutyCycleValue)
+		that2, ok := that.(MACCommand_LinkADRReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8119,19 +8689,36 @@ func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.TxPowerIndex != that1.TxPowerIndex {
+		return false
+	}
+	if len(this.ChannelMask) != len(that1.ChannelMask) {
+		return false
+	}
+	for i := range this.ChannelMask {
+		if this.ChannelMask[i] != that1.ChannelMask[i] {
+			return false
+		}
+	}
+	if this.ChannelMaskControl != that1.ChannelMaskControl {
+		return false
+	}
+	if this.NbTrans != that1.NbTrans {
 		return false
 	}
 	return true
 }
-func (this *RxDelayValue) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RxDelayValue)
+	that1, ok := that.(*MACCommand_LinkADRAns)
 	if !ok {
-		that2, ok := that.(RxDelayValue)
+		that2, ok := that.(MACCommand_LinkADRAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8143,19 +8730,25 @@ func (this *RxDelayValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.ChannelMaskAck != that1.ChannelMaskAck {
+		return false
+	}
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
+		return false
+	}
+	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
 		return false
 	}
 	return true
 }
-func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
+func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ADRAckLimitExponentValue)
+	that1, ok := that.(*MACCommand_DutyCycleReq)
 	if !ok {
-		that2, ok := that.(ADRAckLimitExponentValue)
+		that2, ok := that.(MACCommand_DutyCycleReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8167,19 +8760,19 @@ func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.MaxDutyCycle != that1.MaxDutyCycle {
 		return false
 	}
 	return true
 }
-func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ADRAckDelayExponentValue)
+	that1, ok := that.(*MACCommand_RxParamSetupReq)
 	if !ok {
-		that2, ok := that.(ADRAckDelayExponentValue)
+		that2, ok := that.(MACCommand_RxParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8191,19 +8784,25 @@ func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
 		return false
 	}
 	return true
 }
-func (this *DeviceEIRPValue) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeviceEIRPValue)
+	that1, ok := that.(*MACCommand_RxParamSetupAns)
 	if !ok {
-		that2, ok := that.(DeviceEIRPValue)
+		that2, ok := that.(MACCommand_RxParamSetupAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -8215,1493 +8814,1285 @@ func (this *DeviceEIRPValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
+		return false
+	}
+	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
+		return false
+	}
+	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
 		return false
 	}
 	return true
 }
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_DevStatusAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DevStatusAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.MIC) > 0 {
-		i -= len(m.MIC)
-		copy(dAtA[i:], m.MIC)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.MIC)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.MHDR.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Battery != that1.Battery {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_MACPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Margin != that1.Margin {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MACPayload != nil {
-		{
-			size, err := m.MACPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_NewChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_NewChannelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
+	}
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JoinRequestPayload != nil {
-		{
-			size, err := m.JoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_NewChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_NewChannelAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
+	}
+	if this.DataRateAck != that1.DataRateAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JoinAcceptPayload != nil {
-		{
-			size, err := m.JoinAcceptPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DLChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_DLChannelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Message_RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RejoinRequestPayload != nil {
-		{
-			size, err := m.RejoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DLChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DLChannelAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MHDR) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MHDR) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Major != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Major))
-		i--
-		dAtA[i] = 0x10
+	if this.ChannelIndexAck != that1.ChannelIndexAck {
+		return false
 	}
-	if m.MType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MType))
-		i--
-		dAtA[i] = 0x8
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MACPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullFCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FullFCnt))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DecodedPayload != nil {
-		{
-			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RxTimingSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RxTimingSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FRMPayload) > 0 {
-		i -= len(m.FRMPayload)
-		copy(dAtA[i:], m.FRMPayload)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FRMPayload)))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.FPort != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FPort))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.FHDR.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Delay != that1.Delay {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *FHDR) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FHDR) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *FHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FOpts) > 0 {
-		i -= len(m.FOpts)
-		copy(dAtA[i:], m.FOpts)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FOpts)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*MACCommand_TxParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_TxParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.FCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.FCnt))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.FCtrl.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.MaxEIRPIndex != that1.MaxEIRPIndex {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.UplinkDwellTime != that1.UplinkDwellTime {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *FCtrl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FCtrl) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FCtrl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClassB {
-		i--
-		if m.ClassB {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_RekeyInd)
+	if !ok {
+		that2, ok := that.(MACCommand_RekeyInd)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.FPending {
-		i--
-		if m.FPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Ack {
-		i--
-		if m.Ack {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if this.MinorVersion != that1.MinorVersion {
+		return false
 	}
-	if m.ADRAckReq {
-		i--
-		if m.ADRAckReq {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return true
+}
+func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ADR {
-		i--
-		if m.ADR {
-			dAtA[i] = 1
+
+	that1, ok := that.(*MACCommand_RekeyConf)
+	if !ok {
+		that2, ok := that.(MACCommand_RekeyConf)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JoinRequestPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.MinorVersion != that1.MinorVersion {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DevNonce.Size()
-		i -= size
-		if _, err := m.DevNonce.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MACCommand_ADRParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_ADRParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.DevEui.Size()
-		i -= size
-		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.JoinEui.Size()
-		i -= size
-		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.ADRAckLimitExponent != that1.ADRAckLimitExponent {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if this.ADRAckDelayExponent != that1.ADRAckDelayExponent {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RejoinRequestPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MACCommand_DeviceTimeAns)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceTimeAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MACCommand_ForceRejoinReq)
+	if !ok {
+		that2, ok := that.(MACCommand_ForceRejoinReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RejoinType != that1.RejoinType {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if this.PeriodExponent != that1.PeriodExponent {
+		return false
+	}
+	return true
 }
-
-func (m *RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RejoinCnt != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinCnt))
-		i--
-		dAtA[i] = 0x28
+func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size := m.DevEui.Size()
-		i -= size
-		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RejoinParamSetupReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.JoinEui.Size()
-		i -= size
-		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NetID.Size()
-		i -= size
-		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.MaxCountExponent != that1.MaxCountExponent {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.RejoinType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
-		i--
-		dAtA[i] = 0x8
+	if this.MaxTimeExponent != that1.MaxTimeExponent {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinAcceptPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RejoinParamSetupAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CFList != nil {
-		{
-			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_PingSlotInfoReq)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotInfoReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.RxDelay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RxDelay))
-		i--
-		dAtA[i] = 0x30
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.DLSettings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.Period != that1.Period {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	return true
+}
+func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NetID.Size()
-		i -= size
-		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*MACCommand_PingSlotChannelReq)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotChannelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.JoinNonce.Size()
-		i -= size
-		if _, err := m.JoinNonce.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Encrypted) > 0 {
-		i -= len(m.Encrypted)
-		copy(dAtA[i:], m.Encrypted)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.Encrypted)))
-		i--
-		dAtA[i] = 0xa
+	if this.Frequency != that1.Frequency {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DLSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DLSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DLSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OptNeg {
-		i--
-		if m.OptNeg {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_PingSlotChannelAns)
+	if !ok {
+		that2, ok := that.(MACCommand_PingSlotChannelAns)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.Rx2DR != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DR))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Rx1DROffset != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DROffset))
-		i--
-		dAtA[i] = 0x8
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CFList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CFList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CFList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChMasks) > 0 {
-		for iNdEx := len(m.ChMasks) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ChMasks[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+	that1, ok := that.(*MACCommand_BeaconTimingAns)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconTimingAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChMasks)))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Freq) > 0 {
-		dAtA12 := make([]byte, len(m.Freq)*10)
-		var j11 int
-		for _, num := range m.Freq {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintLorawan(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Type != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if this.Delay != that1.Delay {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	return true
 }
-
-func (m *LoRaDataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LoRaDataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *LoRaDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpreadingFactor != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.SpreadingFactor))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Bandwidth != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Bandwidth))
-		i--
-		dAtA[i] = 0x8
+	that1, ok := that.(*MACCommand_BeaconFreqReq)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconFreqReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FSKDataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FSKDataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitRate != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.BitRate))
-		i--
-		dAtA[i] = 0x8
+	if this.Frequency != that1.Frequency {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DataRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Modulation != nil {
-		{
-			size := m.Modulation.Size()
-			i -= size
-			if _, err := m.Modulation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_BeaconFreqAns)
+	if !ok {
+		that2, ok := that.(MACCommand_BeaconFreqAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DataRate_LoRa) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DataRate_LoRa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LoRa != nil {
-		{
-			size, err := m.LoRa.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DeviceModeInd)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceModeInd)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *DataRate_FSK) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FSK != nil {
-		{
-			size, err := m.FSK.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_DeviceModeConf)
+	if !ok {
+		that2, ok := that.(MACCommand_DeviceModeConf)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TxSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayConfReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TxSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Downlink != nil {
-		{
-			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayConfReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Time != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintLorawan(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x3a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
+	if this.Enabled != that1.Enabled {
+		return false
 	}
-	if m.EnableCRC {
-		i--
-		if m.EnableCRC {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	if this.CadPeriodicity != that1.CadPeriodicity {
+		return false
 	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
-		i--
-		dAtA[i] = 0x20
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
+		return false
 	}
-	if len(m.CodingRate) > 0 {
-		i -= len(m.CodingRate)
-		copy(dAtA[i:], m.CodingRate)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
+	return true
+}
+func (this *MACCommand_RelayConfAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size, err := m.DataRate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*MACCommand_RelayConfAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TxSettings_Downlink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxSettings_Downlink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
+		return false
+	}
+	if this.SecondChannelAckOffsetAck != that1.SecondChannelAckOffsetAck {
+		return false
+	}
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
+	}
+	if this.SecondChannelIndexAck != that1.SecondChannelIndexAck {
+		return false
+	}
+	if this.DefaultChannelIndexAck != that1.DefaultChannelIndexAck {
+		return false
+	}
+	if this.CadPeriodicityAck != that1.CadPeriodicityAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayEndDeviceConfReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TxSettings_Downlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvertPolarization {
-		i--
-		if m.InvertPolarization {
-			dAtA[i] = 1
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.TxPower != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.TxPower)))
-		i--
-		dAtA[i] = 0x15
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AntennaIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
-		i--
-		dAtA[i] = 0x8
+	if this.Mode != that1.Mode {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GatewayAntennaIdentifiers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SmartEnableLevel != that1.SmartEnableLevel {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GatewayAntennaIdentifiers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GatewayAntennaIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AntennaIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
-		i--
-		dAtA[i] = 0x10
+	if this.Backoff != that1.Backoff {
+		return false
 	}
-	{
-		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *UplinkToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MACCommand_RelayEndDeviceConfAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UplinkToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConcentratorTime != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ConcentratorTime))
-		i--
-		dAtA[i] = 0x20
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ServerTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime):])
-	if err19 != nil {
-		return 0, err19
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n19
-	i = encodeVarintLorawan(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x1a
-	if m.Timestamp != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
+		return false
 	}
-	{
-		size, err := m.GatewayAntennaIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *DownlinkPath) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SecondChannelIndexAck != that1.SecondChannelIndexAck {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DownlinkPath) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.BackoffAck != that1.BackoffAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayFilterListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DownlinkPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Path != nil {
-		{
-			size := m.Path.Size()
-			i -= size
-			if _, err := m.Path.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_RelayFilterListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayFilterListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DownlinkPath_UplinkToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !bytes.Equal(this.RawJoinEuiDevEui, that1.RawJoinEuiDevEui) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayFilterListAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DownlinkPath_UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UplinkToken != nil {
-		i -= len(m.UplinkToken)
-		copy(dAtA[i:], m.UplinkToken)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.UplinkToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-func (m *DownlinkPath_Fixed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DownlinkPath_Fixed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Fixed != nil {
-		{
-			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayFilterListAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayFilterListAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.FilterListActionAck != that1.FilterListActionAck {
+		return false
+	}
+	if this.FilterListLengthAck != that1.FilterListLengthAck {
+		return false
+	}
+	if this.CombinedRulesAck != that1.CombinedRulesAck {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayUpdateUplinkListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Advanced != nil {
-		{
-			size, err := m.Advanced.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FrequencyPlanID)))
-		i--
-		dAtA[i] = 0x52
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AbsoluteTime != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err23 != nil {
-			return 0, err23
-		}
-		i -= n23
-		i = encodeVarintLorawan(dAtA, i, uint64(n23))
-		i--
-		dAtA[i] = 0x4a
+	if this.Index != that1.Index {
+		return false
 	}
-	if m.Priority != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Priority))
-		i--
-		dAtA[i] = 0x40
+	if this.UplinkLimitBucketSize != that1.UplinkLimitBucketSize {
+		return false
 	}
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x38
+	if this.UplinkLimitReloadRate != that1.UplinkLimitReloadRate {
+		return false
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
-		i--
-		dAtA[i] = 0x30
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
 	}
-	if m.Rx1Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx1Frequency)
-		i--
-		dAtA[i] = 0x28
+	if this.WFCnt != that1.WFCnt {
+		return false
 	}
-	if m.Rx1DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateIndex))
-		i--
-		dAtA[i] = 0x20
+	if !this.RootWorSKey.Equal(that1.RootWorSKey) {
+		return false
 	}
-	if m.Rx1Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1Delay))
-		i--
-		dAtA[i] = 0x18
+	return true
+}
+func (this *MACCommand_RelayCtrlUplinkListReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.DownlinkPaths) > 0 {
-		for iNdEx := len(m.DownlinkPaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DownlinkPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLorawan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Index != that1.Index {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Action != that1.Action {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayCtrlUplinkListAns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.CID != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.CID))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_RawPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_RawPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RawPayload != nil {
-		i -= len(m.RawPayload)
-		copy(dAtA[i:], m.RawPayload)
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.RawPayload)))
-		i--
-		dAtA[i] = 0x12
+	if this.IndexAck != that1.IndexAck {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_ResetInd_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.WFCnt != that1.WFCnt {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayConfigureFwdLimitReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_ResetInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResetInd != nil {
-		{
-			size, err := m.ResetInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayConfigureFwdLimitReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayConfigureFwdLimitReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_ResetConf_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ResetLimitCounter != that1.ResetLimitCounter {
+		return false
+	}
+	if !this.JoinRequestLimits.Equal(that1.JoinRequestLimits) {
+		return false
+	}
+	if !this.NotifyLimits.Equal(that1.NotifyLimits) {
+		return false
+	}
+	if !this.GlobalUplinkLimits.Equal(that1.GlobalUplinkLimits) {
+		return false
+	}
+	if !this.OverallLimits.Equal(that1.OverallLimits) {
+		return false
+	}
+	return true
 }
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_ResetConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResetConf != nil {
-		{
-			size, err := m.ResetConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq)
+	if !ok {
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkCheckAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if this.SNR != that1.SNR {
+		return false
+	}
+	if this.RSSI != that1.RSSI {
+		return false
+	}
+	return true
 }
+func (this *FrequencyValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_LinkCheckAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkCheckAns != nil {
-		{
-			size, err := m.LinkCheckAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*FrequencyValue)
+	if !ok {
+		that2, ok := that.(FrequencyValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkADRReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *DataRateOffsetValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_LinkADRReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkADRReq != nil {
-		{
-			size, err := m.LinkADRReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*DataRateOffsetValue)
+	if !ok {
+		that2, ok := that.(DataRateOffsetValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_LinkADRAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *DataRateIndexValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_LinkADRAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LinkADRAns != nil {
-		{
-			size, err := m.LinkADRAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*DataRateIndexValue)
+	if !ok {
+		that2, ok := that.(DataRateIndexValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DutyCycleReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *PingSlotPeriodValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DutyCycleReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DutyCycleReq != nil {
-		{
-			size, err := m.DutyCycleReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*PingSlotPeriodValue)
+	if !ok {
+		that2, ok := that.(PingSlotPeriodValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *AggregatedDutyCycleValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RxParamSetupReq != nil {
-		{
-			size, err := m.RxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*AggregatedDutyCycleValue)
+	if !ok {
+		that2, ok := that.(AggregatedDutyCycleValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_RxParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *RxDelayValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_RxParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RxParamSetupAns != nil {
-		{
-			size, err := m.RxParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*RxDelayValue)
+	if !ok {
+		that2, ok := that.(RxDelayValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
-func (m *MACCommand_DevStatusAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ADRAckLimitExponentValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRAckLimitExponentValue)
+	if !ok {
+		that2, ok := that.(ADRAckLimitExponentValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *ADRAckDelayExponentValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_DevStatusAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DevStatusAns != nil {
-		{
-			size, err := m.DevStatusAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*ADRAckDelayExponentValue)
+	if !ok {
+		that2, ok := that.(ADRAckDelayExponentValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
-func (m *MACCommand_NewChannelReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DeviceEIRPValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeviceEIRPValue)
+	if !ok {
+		that2, ok := that.(DeviceEIRPValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *RelaySecondChannel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACCommand_NewChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewChannelReq != nil {
-		{
-			size, err := m.NewChannelReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	that1, ok := that.(*RelaySecondChannel)
+	if !ok {
+		that2, ok := that.(RelaySecondChannel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x62
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.AckOffset != that1.AckOffset {
+		return false
+	}
+	return true
 }
-func (m *MACCommand_NewChannelAns_) MarshalTo(dAtA []byte) (int, error) {
+func (this *RelayForwardLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayForwardLimits)
+	if !ok {
+		that2, ok := that.(RelayForwardLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BucketSize != that1.BucketSize {
+		return false
+	}
+	if this.ReloadRate != that1.ReloadRate {
+		return false
+	}
+	return true
+}
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.NewChannelAns != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
 		{
-			size, err := m.NewChannelAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
+	}
+	if len(m.MIC) > 0 {
+		i -= len(m.MIC)
+		copy(dAtA[i:], m.MIC)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.MIC)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MHDR.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DLChannelReq_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Message_MACPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DLChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DLChannelReq != nil {
+	if m.MACPayload != nil {
 		{
-			size, err := m.DLChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MACPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9709,20 +10100,20 @@ func (m *MACCommand_DLChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DLChannelAns_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DLChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DLChannelAns != nil {
+	if m.JoinRequestPayload != nil {
 		{
-			size, err := m.DLChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9730,20 +10121,20 @@ func (m *MACCommand_DLChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxTimingSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxTimingSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.RxTimingSetupReq != nil {
+	if m.JoinAcceptPayload != nil {
 		{
-			size, err := m.RxTimingSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JoinAcceptPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9751,22 +10142,20 @@ func (m *MACCommand_RxTimingSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_TxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_TxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.TxParamSetupReq != nil {
+	if m.RejoinRequestPayload != nil {
 		{
-			size, err := m.TxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RejoinRequestPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9774,91 +10163,71 @@ func (m *MACCommand_TxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RekeyInd_) MarshalTo(dAtA []byte) (int, error) {
+func (m *MHDR) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_RekeyInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RekeyInd != nil {
-		{
-			size, err := m.RekeyInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *MACCommand_RekeyConf_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MHDR) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RekeyConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.RekeyConf != nil {
-		{
-			size, err := m.RekeyConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Major != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Major))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x10
+	}
+	if m.MType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MType))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ADRParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACCommand_ADRParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ADRParamSetupReq != nil {
-		{
-			size, err := m.ADRParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (m *MACPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *MACCommand_DeviceTimeAns_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MACPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceTimeAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DeviceTimeAns != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.FullFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FullFCnt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DecodedPayload != nil {
 		{
-			size, err := m.DeviceTimeAns.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9866,266 +10235,162 @@ func (m *MACCommand_DeviceTimeAns_) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x22
+	}
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FRMPayload)))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x1a
+	}
+	if m.FPort != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FHDR.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ForceRejoinReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACCommand_ForceRejoinReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ForceRejoinReq != nil {
-		{
-			size, err := m.ForceRejoinReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+func (m *FHDR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *MACCommand_RejoinParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *FHDR) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FHDR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.RejoinParamSetupReq != nil {
-		{
-			size, err := m.RejoinParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if len(m.FOpts) > 0 {
+		i -= len(m.FOpts)
+		copy(dAtA[i:], m.FOpts)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FOpts)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x22
+	}
+	if m.FCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.FCnt))
 		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FCtrl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RejoinParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACCommand_RejoinParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RejoinParamSetupAns != nil {
-		{
-			size, err := m.RejoinParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+func (m *FCtrl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *MACCommand_PingSlotInfoReq_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *FCtrl) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotInfoReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FCtrl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingSlotInfoReq != nil {
-		{
-			size, err := m.PingSlotInfoReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if m.ClassB {
+		i--
+		if m.ClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x28
+	}
+	if m.FPending {
 		i--
-		dAtA[i] = 0xca
+		if m.FPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_PingSlotChannelReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_PingSlotChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PingSlotChannelReq != nil {
-		{
-			size, err := m.PingSlotChannelReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.Ack {
 		i--
-		dAtA[i] = 0xd2
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_PingSlotChannelAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_PingSlotChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PingSlotChannelAns != nil {
-		{
-			size, err := m.PingSlotChannelAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		if m.Ack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_BeaconTimingAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_BeaconTimingAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BeaconTimingAns != nil {
-		{
-			size, err := m.BeaconTimingAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.ADRAckReq {
 		i--
-		dAtA[i] = 0xe2
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_BeaconFreqReq_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_BeaconFreqReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BeaconFreqReq != nil {
-		{
-			size, err := m.BeaconFreqReq.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		if m.ADRAckReq {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_BeaconFreqAns_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_BeaconFreqAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BeaconFreqAns != nil {
-		{
-			size, err := m.BeaconFreqAns.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.ADR {
 		i--
-		dAtA[i] = 0xf2
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_DeviceModeInd_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_DeviceModeInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DeviceModeInd != nil {
-		{
-			size, err := m.DeviceModeInd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		if m.ADR {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DeviceModeConf_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACCommand_DeviceModeConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DeviceModeConf != nil {
-		{
-			size, err := m.DeviceModeConf.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLorawan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
+func (m *JoinRequestPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10135,25 +10400,50 @@ func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ResetInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ResetInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.DevNonce.Size()
+		i -= size
+		if _, err := m.DevNonce.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevEui.Size()
+		i -= size
+		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEui.Size()
+		i -= size
+		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
+func (m *RejoinRequestPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10163,25 +10453,60 @@ func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ResetConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *RejoinRequestPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ResetConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RejoinRequestPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+	if m.RejoinCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinCnt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DevEui.Size()
+		i -= size
+		if _, err := m.DevEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.JoinEui.Size()
+		i -= size
+		if _, err := m.JoinEui.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RejoinType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
+func (m *JoinAcceptPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10191,86 +10516,84 @@ func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_LinkCheckAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinAcceptPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_LinkCheckAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinAcceptPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GatewayCount != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.GatewayCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Margin != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+	if m.CFList != nil {
+		{
+			size, err := m.CFList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_LinkADRReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_LinkADRReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_LinkADRReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NbTrans != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.NbTrans))
+	if m.RxDelay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RxDelay))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.ChannelMaskControl != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelMaskControl))
-		i--
-		dAtA[i] = 0x28
+	{
+		size, err := m.DLSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if len(m.ChannelMask) > 0 {
-		for iNdEx := len(m.ChannelMask) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ChannelMask[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChannelMask)))
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.TxPowerIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.TxPowerIndex))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.JoinNonce.Size()
+		i -= size
+		if _, err := m.JoinNonce.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Encrypted) > 0 {
+		i -= len(m.Encrypted)
+		copy(dAtA[i:], m.Encrypted)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.Encrypted)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
+func (m *DLSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10280,19 +10603,19 @@ func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_LinkADRAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *DLSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DLSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxPowerIndexAck {
+	if m.OptNeg {
 		i--
-		if m.TxPowerIndexAck {
+		if m.OptNeg {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -10300,30 +10623,20 @@ func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.DataRateIndexAck {
-		i--
-		if m.DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Rx2DR != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DR))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ChannelMaskAck {
-		i--
-		if m.ChannelMaskAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Rx1DROffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DROffset))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
+func (m *CFList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10333,25 +10646,56 @@ func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DutyCycleReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *CFList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DutyCycleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CFList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDutyCycle != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDutyCycle))
+	if len(m.ChMasks) > 0 {
+		for iNdEx := len(m.ChMasks) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ChMasks[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChMasks)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Freq) > 0 {
+		dAtA12 := make([]byte, len(m.Freq)*10)
+		var j11 int
+		for _, num := range m.Freq {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintLorawan(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *LoRaDataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10361,35 +10705,30 @@ func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LoRaDataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LoRaDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Rx1DataRateOffset != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateOffset))
+	if m.SpreadingFactor != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SpreadingFactor))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+	if m.Bandwidth != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Bandwidth))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
+func (m *FSKDataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10399,50 +10738,25 @@ func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *FSKDataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Rx2FrequencyAck {
-		i--
-		if m.Rx2FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Rx1DataRateOffsetAck {
-		i--
-		if m.Rx1DataRateOffsetAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Rx2DataRateIndexAck {
-		i--
-		if m.Rx2DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.BitRate != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.BitRate))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
+func (m *DataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10452,73 +10766,71 @@ func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DevStatusAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DevStatusAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Margin != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Battery != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Battery))
-		i--
-		dAtA[i] = 0x8
+	if m.Modulation != nil {
+		{
+			size := m.Modulation.Size()
+			i -= size
+			if _, err := m.Modulation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_NewChannelReq) Marshal() (dAtA []byte, err error) {
+func (m *DataRate_LoRa) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataRate_LoRa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LoRa != nil {
+		{
+			size, err := m.LoRa.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DataRate_FSK) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDataRateIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MinDataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinDataRateIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+	if m.FSK != nil {
+		{
+			size, err := m.FSK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
+func (m *TxSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10528,73 +10840,84 @@ func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_NewChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DataRateAck {
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		if m.DataRateAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x42
+	}
+	if m.Time != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err16 != nil {
+			return 0, err16
 		}
+		i -= n16
+		i = encodeVarintLorawan(dAtA, i, uint64(n16))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x3a
 	}
-	if m.FrequencyAck {
+	if m.Timestamp != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
 		i--
-		if m.FrequencyAck {
+		dAtA[i] = 0x30
+	}
+	if m.EnableCRC {
+		i--
+		if m.EnableCRC {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_DLChannelReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_DLChannelReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_DLChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if m.Frequency != 0 {
 		i = encodeVarintLorawan(dAtA, i, m.Frequency)
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+	if len(m.CodingRate) > 0 {
+		i -= len(m.CodingRate)
+		copy(dAtA[i:], m.CodingRate)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DataRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
+func (m *TxSettings_Downlink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10604,40 +10927,41 @@ func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DLChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxSettings_Downlink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DLChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxSettings_Downlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FrequencyAck {
+	if m.InvertPolarization {
 		i--
-		if m.FrequencyAck {
+		if m.InvertPolarization {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.ChannelIndexAck {
+	if m.TxPower != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.TxPower)))
 		i--
-		if m.ChannelIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x15
+	}
+	if m.AntennaIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *GatewayAntennaIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10647,25 +10971,35 @@ func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayAntennaIdentifiers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RxTimingSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayAntennaIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+	if m.AntennaIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *UplinkToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10675,45 +11009,48 @@ func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_TxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *UplinkToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_TxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DownlinkDwellTime {
-		i--
-		if m.DownlinkDwellTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.ConcentratorTime != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ConcentratorTime))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if m.UplinkDwellTime {
-		i--
-		if m.UplinkDwellTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ServerTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintLorawan(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.MaxEIRPIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxEIRPIndex))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.GatewayAntennaIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
+func (m *DownlinkPath) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10723,86 +11060,66 @@ func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RekeyInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RekeyInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
-		i--
-		dAtA[i] = 0x8
+	if m.Path != nil {
+		{
+			size := m.Path.Size()
+			i -= size
+			if _, err := m.Path.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyConf) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_RekeyConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath_UplinkToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RekeyConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath_UplinkToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+	if m.UplinkToken != nil {
+		i -= len(m.UplinkToken)
+		copy(dAtA[i:], m.UplinkToken)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.UplinkToken)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_ADRParamSetupReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_ADRParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *DownlinkPath_Fixed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ADRParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DownlinkPath_Fixed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ADRAckDelayExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckDelayExponent))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ADRAckLimitExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckLimitExponent))
+	if m.Fixed != nil {
+		{
+			size, err := m.Fixed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
+func (m *TxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10812,71 +11129,100 @@ func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DeviceTimeAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceTimeAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err54 != nil {
-		return 0, err54
+	if m.Advanced != nil {
+		{
+			size, err := m.Advanced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	i -= n54
-	i = encodeVarintLorawan(dAtA, i, uint64(n54))
-	i--
-	dAtA[i] = 0x3a
-	return len(dAtA) - i, nil
-}
-
-func (m *MACCommand_ForceRejoinReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_ForceRejoinReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACCommand_ForceRejoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PeriodExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.PeriodExponent))
+	if m.AbsoluteTime != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintLorawan(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Rx1Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx1Frequency)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rx1DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateIndex))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.MaxRetries != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxRetries))
+	if m.Rx1Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1Delay))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
+	if len(m.DownlinkPaths) > 0 {
+		for iNdEx := len(m.DownlinkPaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DownlinkPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLorawan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.RejoinType != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
+func (m *MACCommand) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10886,3516 +11232,8435 @@ func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RejoinParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxTimeExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxTimeExponent))
-		i--
-		dAtA[i] = 0x10
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.MaxCountExponent != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxCountExponent))
+	if m.CID != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.CID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_RejoinParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RawPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_RejoinParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RawPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxTimeExponentAck {
-		i--
-		if m.MaxTimeExponentAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.RawPayload != nil {
+		i -= len(m.RawPayload)
+		copy(dAtA[i:], m.RawPayload)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.RawPayload)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotInfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotInfoReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetInd_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Period))
+	if m.ResetInd != nil {
+		{
+			size, err := m.ResetInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotChannelReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotChannelReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetConf_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_ResetConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataRateIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+	if m.ResetConf != nil {
+		{
+			size, err := m.ResetConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_PingSlotChannelAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_PingSlotChannelAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkCheckAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkCheckAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataRateIndexAck {
-		i--
-		if m.DataRateIndexAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FrequencyAck {
-		i--
-		if m.FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.LinkCheckAns != nil {
+		{
+			size, err := m.LinkCheckAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconTimingAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconTimingAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconTimingAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelIndex != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Delay != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+	if m.LinkADRReq != nil {
+		{
+			size, err := m.LinkADRReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconFreqReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconFreqReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_LinkADRAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Frequency != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+	if m.LinkADRAns != nil {
+		{
+			size, err := m.LinkADRAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_BeaconFreqAns) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_BeaconFreqAns) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DutyCycleReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DutyCycleReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		i--
-		if m.FrequencyAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.DutyCycleReq != nil {
+		{
+			size, err := m.DutyCycleReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceModeInd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_DeviceModeInd) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+	if m.RxParamSetupReq != nil {
+		{
+			size, err := m.RxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MACCommand_DeviceModeConf) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MACCommand_DeviceModeConf) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Class != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+	if m.RxParamSetupAns != nil {
+		{
+			size, err := m.RxParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *FrequencyValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FrequencyValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DevStatusAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FrequencyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DevStatusAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, m.Value)
+	if m.DevStatusAns != nil {
+		{
+			size, err := m.DevStatusAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x5a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DataRateOffsetValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DataRateOffsetValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataRateOffsetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.NewChannelReq != nil {
+		{
+			size, err := m.NewChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x62
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DataRateIndexValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DataRateIndexValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataRateIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_NewChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.NewChannelAns != nil {
+		{
+			size, err := m.NewChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x6a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *PingSlotPeriodValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PingSlotPeriodValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingSlotPeriodValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.DLChannelReq != nil {
+		{
+			size, err := m.DLChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x72
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *AggregatedDutyCycleValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AggregatedDutyCycleValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelAns_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregatedDutyCycleValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_DLChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.DLChannelAns != nil {
+		{
+			size, err := m.DLChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x7a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RxDelayValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RxDelayValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RxTimingSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RxDelayValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RxTimingSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.RxTimingSetupReq != nil {
+		{
+			size, err := m.RxTimingSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ADRAckLimitExponentValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ADRAckLimitExponentValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_TxParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckLimitExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_TxParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.TxParamSetupReq != nil {
+		{
+			size, err := m.TxParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ADRAckDelayExponentValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ADRAckDelayExponentValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyInd_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ADRAckDelayExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.RekeyInd != nil {
+		{
+			size, err := m.RekeyInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DeviceEIRPValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DeviceEIRPValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyConf_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeviceEIRPValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACCommand_RekeyConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+	if m.RekeyConf != nil {
+		{
+			size, err := m.RekeyConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MACCommand_ADRParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintLorawan(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLorawan(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MACCommand_ADRParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ADRParamSetupReq != nil {
+		{
+			size, err := m.ADRParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func NewPopulatedMHDR(r randyLorawan, easy bool) *MHDR {
-	this := &MHDR{}
-	this.MType = MType([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	this.Major = Major([]int32{0}[r.Intn(1)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_DeviceTimeAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedFCtrl(r randyLorawan, easy bool) *FCtrl {
-	this := &FCtrl{}
-	this.ADR = bool(r.Intn(2) == 0)
-	this.ADRAckReq = bool(r.Intn(2) == 0)
-	this.Ack = bool(r.Intn(2) == 0)
-	this.FPending = bool(r.Intn(2) == 0)
-	this.ClassB = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceTimeAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceTimeAns != nil {
+		{
+			size, err := m.DeviceTimeAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_ForceRejoinReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedLoRaDataRate(r randyLorawan, easy bool) *LoRaDataRate {
-	this := &LoRaDataRate{}
-	this.Bandwidth = r.Uint32()
-	this.SpreadingFactor = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ForceRejoinReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ForceRejoinReq != nil {
+		{
+			size, err := m.ForceRejoinReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RejoinParamSetupReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
-	this := &FSKDataRate{}
-	this.BitRate = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RejoinParamSetupReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RejoinParamSetupReq != nil {
+		{
+			size, err := m.RejoinParamSetupReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RejoinParamSetupAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
-	this := &DataRate{}
-	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
-	switch oneofNumber_Modulation {
-	case 1:
-		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
-	case 2:
-		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RejoinParamSetupAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RejoinParamSetupAns != nil {
+		{
+			size, err := m.RejoinParamSetupAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_PingSlotInfoReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDataRate_LoRa(r randyLorawan, easy bool) *DataRate_LoRa {
-	this := &DataRate_LoRa{}
-	this.LoRa = NewPopulatedLoRaDataRate(r, easy)
-	return this
+func (m *MACCommand_PingSlotInfoReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotInfoReq != nil {
+		{
+			size, err := m.PingSlotInfoReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
 }
-func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
-	this := &DataRate_FSK{}
-	this.FSK = NewPopulatedFSKDataRate(r, easy)
-	return this
+func (m *MACCommand_PingSlotChannelReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
-	this := &TxSettings_Downlink{}
-	this.AntennaIndex = r.Uint32()
-	this.TxPower = float32(r.Float32())
-	if r.Intn(2) == 0 {
-		this.TxPower *= -1
-	}
-	this.InvertPolarization = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+
+func (m *MACCommand_PingSlotChannelReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotChannelReq != nil {
+		{
+			size, err := m.PingSlotChannelReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_PingSlotChannelAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedGatewayAntennaIdentifiers(r randyLorawan, easy bool) *GatewayAntennaIdentifiers {
-	this := &GatewayAntennaIdentifiers{}
-	v1 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v1
-	this.AntennaIndex = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_PingSlotChannelAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PingSlotChannelAns != nil {
+		{
+			size, err := m.PingSlotChannelAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_BeaconTimingAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedUplinkToken(r randyLorawan, easy bool) *UplinkToken {
-	this := &UplinkToken{}
-	v2 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
-	this.GatewayAntennaIdentifiers = *v2
-	this.Timestamp = r.Uint32()
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ServerTime = *v3
-	this.ConcentratorTime = r.Int63()
-	if r.Intn(2) == 0 {
-		this.ConcentratorTime *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_BeaconTimingAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconTimingAns != nil {
+		{
+			size, err := m.BeaconTimingAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedDownlinkPath(r randyLorawan, easy bool) *DownlinkPath {
-	this := &DownlinkPath{}
-	oneofNumber_Path := []int32{1, 2}[r.Intn(2)]
-	switch oneofNumber_Path {
-	case 1:
-		this.Path = NewPopulatedDownlinkPath_UplinkToken(r, easy)
-	case 2:
-		this.Path = NewPopulatedDownlinkPath_Fixed(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_BeaconFreqReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDownlinkPath_UplinkToken(r randyLorawan, easy bool) *DownlinkPath_UplinkToken {
-	this := &DownlinkPath_UplinkToken{}
-	v4 := r.Intn(100)
-	this.UplinkToken = make([]byte, v4)
-	for i := 0; i < v4; i++ {
-		this.UplinkToken[i] = byte(r.Intn(256))
+func (m *MACCommand_BeaconFreqReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconFreqReq != nil {
+		{
+			size, err := m.BeaconFreqReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return this
-}
-func NewPopulatedDownlinkPath_Fixed(r randyLorawan, easy bool) *DownlinkPath_Fixed {
-	this := &DownlinkPath_Fixed{}
-	this.Fixed = NewPopulatedGatewayAntennaIdentifiers(r, easy)
-	return this
+	return len(dAtA) - i, nil
 }
-func NewPopulatedMACCommand_LinkADRAns(r randyLorawan, easy bool) *MACCommand_LinkADRAns {
-	this := &MACCommand_LinkADRAns{}
-	this.ChannelMaskAck = bool(r.Intn(2) == 0)
-	this.DataRateIndexAck = bool(r.Intn(2) == 0)
-	this.TxPowerIndexAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_BeaconFreqAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_DutyCycleReq(r randyLorawan, easy bool) *MACCommand_DutyCycleReq {
-	this := &MACCommand_DutyCycleReq{}
-	this.MaxDutyCycle = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_BeaconFreqAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconFreqAns != nil {
+		{
+			size, err := m.BeaconFreqAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RxParamSetupAns(r randyLorawan, easy bool) *MACCommand_RxParamSetupAns {
-	this := &MACCommand_RxParamSetupAns{}
-	this.Rx2DataRateIndexAck = bool(r.Intn(2) == 0)
-	this.Rx1DataRateOffsetAck = bool(r.Intn(2) == 0)
-	this.Rx2FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_DeviceModeInd_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_NewChannelAns(r randyLorawan, easy bool) *MACCommand_NewChannelAns {
-	this := &MACCommand_NewChannelAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	this.DataRateAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceModeInd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceModeInd != nil {
+		{
+			size, err := m.DeviceModeInd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_DLChannelAns(r randyLorawan, easy bool) *MACCommand_DLChannelAns {
-	this := &MACCommand_DLChannelAns{}
-	this.ChannelIndexAck = bool(r.Intn(2) == 0)
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_DeviceModeConf_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RxTimingSetupReq(r randyLorawan, easy bool) *MACCommand_RxTimingSetupReq {
-	this := &MACCommand_RxTimingSetupReq{}
-	this.Delay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_DeviceModeConf_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeviceModeConf != nil {
+		{
+			size, err := m.DeviceModeConf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_TxParamSetupReq(r randyLorawan, easy bool) *MACCommand_TxParamSetupReq {
-	this := &MACCommand_TxParamSetupReq{}
-	this.MaxEIRPIndex = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.UplinkDwellTime = bool(r.Intn(2) == 0)
-	this.DownlinkDwellTime = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayConfReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RekeyInd(r randyLorawan, easy bool) *MACCommand_RekeyInd {
-	this := &MACCommand_RekeyInd{}
-	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayConfReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfReq != nil {
+		{
+			size, err := m.RelayConfReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RekeyConf(r randyLorawan, easy bool) *MACCommand_RekeyConf {
-	this := &MACCommand_RekeyConf{}
-	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayConfAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_ADRParamSetupReq(r randyLorawan, easy bool) *MACCommand_ADRParamSetupReq {
-	this := &MACCommand_ADRParamSetupReq{}
-	this.ADRAckLimitExponent = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.ADRAckDelayExponent = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayConfAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfAns != nil {
+		{
+			size, err := m.RelayConfAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_DeviceTimeAns(r randyLorawan, easy bool) *MACCommand_DeviceTimeAns {
-	this := &MACCommand_DeviceTimeAns{}
-	v5 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v5
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayEndDeviceConfReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_RejoinParamSetupReq(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupReq {
-	this := &MACCommand_RejoinParamSetupReq{}
-	this.MaxCountExponent = RejoinCountExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	this.MaxTimeExponent = RejoinTimeExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayEndDeviceConfReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayEndDeviceConfReq != nil {
+		{
+			size, err := m.RelayEndDeviceConfReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedMACCommand_RejoinParamSetupAns(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupAns {
-	this := &MACCommand_RejoinParamSetupAns{}
-	this.MaxTimeExponentAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_RelayEndDeviceConfAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_PingSlotInfoReq(r randyLorawan, easy bool) *MACCommand_PingSlotInfoReq {
-	this := &MACCommand_PingSlotInfoReq{}
-	this.Period = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayEndDeviceConfAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayEndDeviceConfAns != nil {
+		{
+			size, err := m.RelayEndDeviceConfAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayFilterListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_PingSlotChannelAns(r randyLorawan, easy bool) *MACCommand_PingSlotChannelAns {
-	this := &MACCommand_PingSlotChannelAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	this.DataRateIndexAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayFilterListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayFilterListReq != nil {
+		{
+			size, err := m.RelayFilterListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayFilterListAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_BeaconFreqAns(r randyLorawan, easy bool) *MACCommand_BeaconFreqAns {
-	this := &MACCommand_BeaconFreqAns{}
-	this.FrequencyAck = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayFilterListAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayFilterListAns != nil {
+		{
+			size, err := m.RelayFilterListAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayUpdateUplinkListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_DeviceModeInd(r randyLorawan, easy bool) *MACCommand_DeviceModeInd {
-	this := &MACCommand_DeviceModeInd{}
-	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayUpdateUplinkListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayUpdateUplinkListReq != nil {
+		{
+			size, err := m.RelayUpdateUplinkListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayCtrlUplinkListReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedMACCommand_DeviceModeConf(r randyLorawan, easy bool) *MACCommand_DeviceModeConf {
-	this := &MACCommand_DeviceModeConf{}
-	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayCtrlUplinkListReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayCtrlUplinkListReq != nil {
+		{
+			size, err := m.RelayCtrlUplinkListReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayCtrlUplinkListAns_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedFrequencyValue(r randyLorawan, easy bool) *FrequencyValue {
-	this := &FrequencyValue{}
-	this.Value = uint64(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayCtrlUplinkListAns_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayCtrlUplinkListAns != nil {
+		{
+			size, err := m.RelayCtrlUplinkListAns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDataRateOffsetValue(r randyLorawan, easy bool) *DataRateOffsetValue {
-	this := &DataRateOffsetValue{}
-	this.Value = DataRateOffset([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayConfigureFwdLimitReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayConfigureFwdLimitReq != nil {
+		{
+			size, err := m.RelayConfigureFwdLimitReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
 	}
-	return this
+	return len(dAtA) - i, nil
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDataRateIndexValue(r randyLorawan, easy bool) *DataRateIndexValue {
-	this := &DataRateIndexValue{}
-	this.Value = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayNotifyNewEndDeviceReq != nil {
+		{
+			size, err := m.RelayNotifyNewEndDeviceReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
-	return this
+	return len(dAtA) - i, nil
 }
-
-func NewPopulatedPingSlotPeriodValue(r randyLorawan, easy bool) *PingSlotPeriodValue {
-	this := &PingSlotPeriodValue{}
-	this.Value = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ResetInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedAggregatedDutyCycleValue(r randyLorawan, easy bool) *AggregatedDutyCycleValue {
-	this := &AggregatedDutyCycleValue{}
-	this.Value = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MACCommand_ResetInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedRxDelayValue(r randyLorawan, easy bool) *RxDelayValue {
-	this := &RxDelayValue{}
-	this.Value = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ResetInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedADRAckLimitExponentValue(r randyLorawan, easy bool) *ADRAckLimitExponentValue {
-	this := &ADRAckLimitExponentValue{}
-	this.Value = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ResetConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedADRAckDelayExponentValue(r randyLorawan, easy bool) *ADRAckDelayExponentValue {
-	this := &ADRAckDelayExponentValue{}
-	this.Value = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_ResetConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_ResetConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedDeviceEIRPValue(r randyLorawan, easy bool) *DeviceEIRPValue {
-	this := &DeviceEIRPValue{}
-	this.Value = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
-	if !easy && r.Intn(10) != 0 {
+func (m *MACCommand_LinkCheckAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyLorawan interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *MACCommand_LinkCheckAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneLorawan(r randyLorawan) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringLorawan(r randyLorawan) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
-		tmps[i] = randUTF8RuneLorawan(r)
+func (m *MACCommand_LinkCheckAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GatewayCount != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.GatewayCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return string(tmps)
-}
-func randUnrecognizedLorawan(r randyLorawan, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldLorawan(dAtA, r, fieldNumber, wire)
+	if m.Margin != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+		i--
+		dAtA[i] = 0x8
 	}
-	return dAtA
+	return len(dAtA) - i, nil
 }
-func randFieldLorawan(dAtA []byte, r randyLorawan, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		v7 := r.Int63()
-		if r.Intn(2) == 0 {
-			v7 *= -1
-		}
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(v7))
-	case 1:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+
+func (m *MACCommand_LinkADRReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return dAtA
+	return dAtA[:n], nil
 }
-func encodeVarintPopulateLorawan(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+
+func (m *MACCommand_LinkADRReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_LinkADRReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MHDR.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = len(m.MIC)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	if m.Payload != nil {
-		n += m.Payload.Size()
+	if m.NbTrans != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.NbTrans))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *Message_MACPayload) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChannelMaskControl != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelMaskControl))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.MACPayload != nil {
-		l = m.MACPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if len(m.ChannelMask) > 0 {
+		for iNdEx := len(m.ChannelMask) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ChannelMask[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.ChannelMask)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *Message_JoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TxPowerIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.TxPowerIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.JoinRequestPayload != nil {
-		l = m.JoinRequestPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_JoinAcceptPayload) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_LinkADRAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_LinkADRAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_LinkADRAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JoinAcceptPayload != nil {
-		l = m.JoinAcceptPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.TxPowerIndexAck {
+		i--
+		if m.TxPowerIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *Message_RejoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DataRateIndexAck {
+		i--
+		if m.DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.RejoinRequestPayload != nil {
-		l = m.RejoinRequestPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ChannelMaskAck {
+		i--
+		if m.ChannelMaskAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MHDR) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_DutyCycleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DutyCycleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DutyCycleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MType != 0 {
-		n += 1 + sovLorawan(uint64(m.MType))
-	}
-	if m.Major != 0 {
-		n += 1 + sovLorawan(uint64(m.Major))
+	if m.MaxDutyCycle != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDutyCycle))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FHDR.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.FPort != 0 {
-		n += 1 + sovLorawan(uint64(m.FPort))
-	}
-	l = len(m.FRMPayload)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	if m.DecodedPayload != nil {
-		l = m.DecodedPayload.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	if m.FullFCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.FullFCnt))
+func (m *MACCommand_RxParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FHDR) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DevAddr.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.FCtrl.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.FCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.FCnt))
-	}
-	l = len(m.FOpts)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
+func (m *MACCommand_RxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FCtrl) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ADR {
-		n += 2
-	}
-	if m.ADRAckReq {
-		n += 2
-	}
-	if m.Ack {
-		n += 2
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Rx2Frequency)
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.FPending {
-		n += 2
+	if m.Rx1DataRateOffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx1DataRateOffset))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ClassB {
-		n += 2
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Rx2DataRateIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RxParamSetupAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.JoinEui.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevEui.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevNonce.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RejoinRequestPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinType != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinType))
-	}
-	l = m.NetID.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.JoinEui.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevEui.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.RejoinCnt != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinCnt))
-	}
-	return n
+func (m *MACCommand_RxParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JoinAcceptPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RxParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Encrypted)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Rx2FrequencyAck {
+		i--
+		if m.Rx2FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.JoinNonce.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.NetID.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DevAddr.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	l = m.DLSettings.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.RxDelay != 0 {
-		n += 1 + sovLorawan(uint64(m.RxDelay))
+	if m.Rx1DataRateOffsetAck {
+		i--
+		if m.Rx1DataRateOffsetAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.CFList != nil {
-		l = m.CFList.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Rx2DataRateIndexAck {
+		i--
+		if m.Rx2DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DLSettings) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Rx1DROffset != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DROffset))
-	}
-	if m.Rx2DR != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DR))
-	}
-	if m.OptNeg {
-		n += 2
+func (m *MACCommand_DevStatusAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CFList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLorawan(uint64(m.Type))
-	}
-	if len(m.Freq) > 0 {
-		l = 0
-		for _, e := range m.Freq {
-			l += sovLorawan(uint64(e))
-		}
-		n += 1 + sovLorawan(uint64(l)) + l
-	}
-	if len(m.ChMasks) > 0 {
-		n += 1 + sovLorawan(uint64(len(m.ChMasks))) + len(m.ChMasks)*1
-	}
-	return n
+func (m *MACCommand_DevStatusAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LoRaDataRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_DevStatusAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Bandwidth != 0 {
-		n += 1 + sovLorawan(uint64(m.Bandwidth))
+	if m.Margin != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Margin))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SpreadingFactor != 0 {
-		n += 1 + sovLorawan(uint64(m.SpreadingFactor))
+	if m.Battery != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Battery))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FSKDataRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BitRate != 0 {
-		n += 1 + sovLorawan(uint64(m.BitRate))
+func (m *MACCommand_NewChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Modulation != nil {
-		n += m.Modulation.Size()
-	}
-	return n
+func (m *MACCommand_NewChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataRate_LoRa) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LoRa != nil {
-		l = m.LoRa.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *DataRate_FSK) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FSK != nil {
-		l = m.FSK.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *TxSettings) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_NewChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DataRate.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	if m.MaxDataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxDataRateIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.CodingRate)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinDataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinDataRateIndex))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
-	}
-	if m.EnableCRC {
-		n += 2
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovLorawan(uint64(m.Timestamp))
-	}
-	if m.Time != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovLorawan(uint64(l))
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Downlink != nil {
-		l = m.Downlink.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TxSettings_Downlink) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_NewChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_NewChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_NewChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AntennaIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.AntennaIndex))
-	}
-	if m.TxPower != 0 {
-		n += 5
+	if m.DataRateAck {
+		i--
+		if m.DataRateAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.InvertPolarization {
-		n += 2
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GatewayAntennaIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GatewayIdentifiers.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.AntennaIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+func (m *MACCommand_DLChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UplinkToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_DLChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DLChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GatewayAntennaIdentifiers.Size()
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovLorawan(uint64(m.Timestamp))
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x10
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime)
-	n += 1 + l + sovLorawan(uint64(l))
-	if m.ConcentratorTime != 0 {
-		n += 1 + sovLorawan(uint64(m.ConcentratorTime))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DownlinkPath) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_DLChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DLChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DLChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Path != nil {
-		n += m.Path.Size()
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ChannelIndexAck {
+		i--
+		if m.ChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DownlinkPath_UplinkToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RxTimingSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RxTimingSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RxTimingSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UplinkToken != nil {
-		l = len(m.UplinkToken)
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *DownlinkPath_Fixed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fixed != nil {
-		l = m.Fixed.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_TxParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *TxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_TxParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_TxParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
-	}
-	if len(m.DownlinkPaths) > 0 {
-		for _, e := range m.DownlinkPaths {
-			l = e.Size()
-			n += 1 + l + sovLorawan(uint64(l))
+	if m.DownlinkDwellTime {
+		i--
+		if m.DownlinkDwellTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Rx1Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1Delay))
-	}
-	if m.Rx1DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DataRateIndex))
-	}
-	if m.Rx1Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx1Frequency)
-	}
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
-	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx2Frequency)
-	}
-	if m.Priority != 0 {
-		n += 1 + sovLorawan(uint64(m.Priority))
-	}
-	if m.AbsoluteTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.UplinkDwellTime {
+		i--
+		if m.UplinkDwellTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Advanced != nil {
-		l = m.Advanced.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.MaxEIRPIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxEIRPIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RekeyInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RekeyInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RekeyInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CID != 0 {
-		n += 1 + sovLorawan(uint64(m.CID))
-	}
-	if m.Payload != nil {
-		n += m.Payload.Size()
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RawPayload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RekeyConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RekeyConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RekeyConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RawPayload != nil {
-		l = len(m.RawPayload)
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MinorVersion != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MinorVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ResetInd_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_ADRParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_ADRParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_ADRParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResetInd != nil {
-		l = m.ResetInd.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ADRAckDelayExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckDelayExponent))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ADRAckLimitExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ADRAckLimitExponent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ResetConf_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_DeviceTimeAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_DeviceTimeAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_DeviceTimeAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResetConf != nil {
-		l = m.ResetConf.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err54 != nil {
+		return 0, err54
 	}
-	return n
+	i -= n54
+	i = encodeVarintLorawan(dAtA, i, uint64(n54))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_LinkCheckAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_ForceRejoinReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_ForceRejoinReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_ForceRejoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkCheckAns != nil {
-		l = m.LinkCheckAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.PeriodExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.PeriodExponent))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-func (m *MACCommand_LinkADRReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxRetries != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.LinkADRReq != nil {
-		l = m.LinkADRReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.RejoinType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RejoinType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_LinkADRAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RejoinParamSetupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RejoinParamSetupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RejoinParamSetupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LinkADRAns != nil {
-		l = m.LinkADRAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MaxTimeExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxTimeExponent))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.MaxCountExponent != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.MaxCountExponent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DutyCycleReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RejoinParamSetupAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RejoinParamSetupAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RejoinParamSetupAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DutyCycleReq != nil {
-		l = m.DutyCycleReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.MaxTimeExponentAck {
+		i--
+		if m.MaxTimeExponentAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxParamSetupReq != nil {
-		l = m.RxParamSetupReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Period != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RxParamSetupAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotChannelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotChannelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotChannelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxParamSetupAns != nil {
-		l = m.RxParamSetupAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DevStatusAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_PingSlotChannelAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_PingSlotChannelAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_PingSlotChannelAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DevStatusAns != nil {
-		l = m.DevStatusAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndexAck {
+		i--
+		if m.DataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_NewChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_BeaconTimingAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_BeaconTimingAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_BeaconTimingAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewChannelReq != nil {
-		l = m.NewChannelReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.ChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ChannelIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Delay != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_NewChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_BeaconFreqReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_BeaconFreqReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_BeaconFreqReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewChannelAns != nil {
-		l = m.NewChannelAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DLChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DLChannelReq != nil {
-		l = m.DLChannelReq.Size()
-		n += 1 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_BeaconFreqAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_DLChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DLChannelAns != nil {
-		l = m.DLChannelAns.Size()
-		n += 1 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_BeaconFreqAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_RxTimingSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_BeaconFreqAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RxTimingSetupReq != nil {
-		l = m.RxTimingSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.FrequencyAck {
+		i--
+		if m.FrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_TxParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxParamSetupReq != nil {
-		l = m.TxParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_DeviceModeInd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_RekeyInd_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RekeyInd != nil {
-		l = m.RekeyInd.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_DeviceModeInd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_RekeyConf_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_DeviceModeInd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RekeyConf != nil {
-		l = m.RekeyConf.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_ADRParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ADRParamSetupReq != nil {
-		l = m.ADRParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_DeviceModeConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_DeviceTimeAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DeviceTimeAns != nil {
-		l = m.DeviceTimeAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_DeviceModeConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_ForceRejoinReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_DeviceModeConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ForceRejoinReq != nil {
-		l = m.ForceRejoinReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Class != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_RejoinParamSetupReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinParamSetupReq != nil {
-		l = m.RejoinParamSetupReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_RelayConfReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_RejoinParamSetupAns_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RejoinParamSetupAns != nil {
-		l = m.RejoinParamSetupAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
+
+func (m *MACCommand_RelayConfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MACCommand_PingSlotInfoReq_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MACCommand_RelayConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PingSlotInfoReq != nil {
-		l = m.PingSlotInfoReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *MACCommand_PingSlotChannelReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.PingSlotChannelReq != nil {
-		l = m.PingSlotChannelReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.DefaultChannelIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DefaultChannelIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *MACCommand_PingSlotChannelAns_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CadPeriodicity != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.CadPeriodicity))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.PingSlotChannelAns != nil {
-		l = m.PingSlotChannelAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_BeaconTimingAns_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MACCommand_RelayConfAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayConfAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayConfAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BeaconTimingAns != nil {
-		l = m.BeaconTimingAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
-	}
-	return n
-}
-func (m *MACCommand_BeaconFreqReq_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CadPeriodicityAck {
+		i--
+		if m.CadPeriodicityAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.BeaconFreqReq != nil {
-		l = m.BeaconFreqReq.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.DefaultChannelIndexAck {
+		i--
+		if m.DefaultChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-func (m *MACCommand_BeaconFreqAns_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SecondChannelIndexAck {
+		i--
+		if m.SecondChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.BeaconFreqAns != nil {
-		l = m.BeaconFreqAns.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannelDataRateIndexAck {
+		i--
+		if m.SecondChannelDataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *MACCommand_DeviceModeInd_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SecondChannelAckOffsetAck {
+		i--
+		if m.SecondChannelAckOffsetAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.DeviceModeInd != nil {
-		l = m.DeviceModeInd.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+	if m.SecondChannelFrequencyAck {
+		i--
+		if m.SecondChannelFrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MACCommand_DeviceModeConf_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DeviceModeConf != nil {
-		l = m.DeviceModeConf.Size()
-		n += 2 + l + sovLorawan(uint64(l))
+
+func (m *MACCommand_RelayEndDeviceConfReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *MACCommand_ResetInd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
-	}
-	return n
+
+func (m *MACCommand_RelayEndDeviceConfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ResetConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayEndDeviceConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *MACCommand_LinkCheckAns) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Backoff != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.Margin != 0 {
-		n += 1 + sovLorawan(uint64(m.Margin))
+	if m.SmartEnableLevel != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SmartEnableLevel))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.GatewayCount != 0 {
-		n += 1 + sovLorawan(uint64(m.GatewayCount))
+	if m.Mode != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkADRReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayEndDeviceConfAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
-	}
-	if m.TxPowerIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.TxPowerIndex))
+	if m.BackoffAck {
+		i--
+		if m.BackoffAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ChannelMask) > 0 {
-		n += 1 + sovLorawan(uint64(len(m.ChannelMask))) + len(m.ChannelMask)*1
+	if m.SecondChannelIndexAck {
+		i--
+		if m.SecondChannelIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ChannelMaskControl != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelMaskControl))
+	if m.SecondChannelDataRateIndexAck {
+		i--
+		if m.SecondChannelDataRateIndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.NbTrans != 0 {
-		n += 1 + sovLorawan(uint64(m.NbTrans))
+	if m.SecondChannelFrequencyAck {
+		i--
+		if m.SecondChannelFrequencyAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_LinkADRAns) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayFilterListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayFilterListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayFilterListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChannelMaskAck {
-		n += 2
+	if len(m.RawJoinEuiDevEui) > 0 {
+		i -= len(m.RawJoinEuiDevEui)
+		copy(dAtA[i:], m.RawJoinEuiDevEui)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.RawJoinEuiDevEui)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.DataRateIndexAck {
-		n += 2
+	if m.Action != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.TxPowerIndexAck {
-		n += 2
+	if m.Index != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DutyCycleReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxDutyCycle != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxDutyCycle))
+func (m *MACCommand_RelayFilterListAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayFilterListAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayFilterListAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	if m.CombinedRulesAck {
+		i--
+		if m.CombinedRulesAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Rx1DataRateOffset != 0 {
-		n += 1 + sovLorawan(uint64(m.Rx1DataRateOffset))
+	if m.FilterListLengthAck {
+		i--
+		if m.FilterListLengthAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovLorawan(m.Rx2Frequency)
+	if m.FilterListActionAck {
+		i--
+		if m.FilterListActionAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RxParamSetupAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Rx2DataRateIndexAck {
-		n += 2
-	}
-	if m.Rx1DataRateOffsetAck {
-		n += 2
-	}
-	if m.Rx2FrequencyAck {
-		n += 2
+func (m *MACCommand_RelayUpdateUplinkListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DevStatusAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Battery != 0 {
-		n += 1 + sovLorawan(uint64(m.Battery))
-	}
-	if m.Margin != 0 {
-		n += 1 + sovLorawan(uint64(m.Margin))
-	}
-	return n
+func (m *MACCommand_RelayUpdateUplinkListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_NewChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayUpdateUplinkListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
-	}
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
-	}
-	if m.MinDataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MinDataRateIndex))
-	}
-	if m.MaxDataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxDataRateIndex))
-	}
-	return n
-}
-
-func (m *MACCommand_NewChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RootWorSKey != nil {
+		{
+			size, err := m.RootWorSKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
+	if m.WFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.WFCnt))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.DataRateAck {
-		n += 2
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MACCommand_DLChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if m.UplinkLimitReloadRate != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.UplinkLimitReloadRate))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	if m.UplinkLimitBucketSize != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.UplinkLimitBucketSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
+	if m.Index != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DLChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelIndexAck {
-		n += 2
-	}
-	if m.FrequencyAck {
-		n += 2
+func (m *MACCommand_RelayCtrlUplinkListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RxTimingSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Delay))
-	}
-	return n
+func (m *MACCommand_RelayCtrlUplinkListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_TxParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayCtrlUplinkListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxEIRPIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxEIRPIndex))
-	}
-	if m.UplinkDwellTime {
-		n += 2
+	if m.Action != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DownlinkDwellTime {
-		n += 2
+	if m.Index != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RekeyInd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
+func (m *MACCommand_RelayCtrlUplinkListAns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_RekeyConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinorVersion != 0 {
-		n += 1 + sovLorawan(uint64(m.MinorVersion))
-	}
-	return n
+func (m *MACCommand_RelayCtrlUplinkListAns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_ADRParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayCtrlUplinkListAns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ADRAckLimitExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.ADRAckLimitExponent))
+	if m.WFCnt != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.WFCnt))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ADRAckDelayExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.ADRAckDelayExponent))
+	if m.IndexAck {
+		i--
+		if m.IndexAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_DeviceTimeAns) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayConfigureFwdLimitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovLorawan(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_ForceRejoinReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MACCommand_RelayConfigureFwdLimitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RejoinType != 0 {
-		n += 1 + sovLorawan(uint64(m.RejoinType))
+	if m.OverallLimits != nil {
+		{
+			size, err := m.OverallLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	if m.GlobalUplinkLimits != nil {
+		{
+			size, err := m.GlobalUplinkLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.MaxRetries != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxRetries))
+	if m.NotifyLimits != nil {
+		{
+			size, err := m.NotifyLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PeriodExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.PeriodExponent))
+	if m.JoinRequestLimits != nil {
+		{
+			size, err := m.JoinRequestLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ResetLimitCounter != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ResetLimitCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxCountExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxCountExponent))
+	if m.RSSI != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RSSI))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MaxTimeExponent != 0 {
-		n += 1 + sovLorawan(uint64(m.MaxTimeExponent))
+	if m.SNR != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.SNR))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLorawan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_RejoinParamSetupAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxTimeExponentAck {
-		n += 2
+func (m *FrequencyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_PingSlotInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Period != 0 {
-		n += 1 + sovLorawan(uint64(m.Period))
-	}
-	return n
+func (m *FrequencyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_PingSlotChannelReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FrequencyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
-	}
-	if m.DataRateIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	if m.Value != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Value)
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_PingSlotChannelAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
-	}
-	if m.DataRateIndexAck {
-		n += 2
+func (m *DataRateOffsetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_BeaconTimingAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Delay != 0 {
-		n += 1 + sovLorawan(uint64(m.Delay))
-	}
-	if m.ChannelIndex != 0 {
-		n += 1 + sovLorawan(uint64(m.ChannelIndex))
-	}
-	return n
+func (m *DataRateOffsetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_BeaconFreqReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DataRateOffsetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Frequency != 0 {
-		n += 1 + sovLorawan(m.Frequency)
+	if m.Value != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MACCommand_BeaconFreqAns) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FrequencyAck {
-		n += 2
+func (m *DataRateIndexValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MACCommand_DeviceModeInd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
-	}
-	return n
+func (m *DataRateIndexValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACCommand_DeviceModeConf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DataRateIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Class != 0 {
-		n += 1 + sovLorawan(uint64(m.Class))
+	if m.Value != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FrequencyValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PingSlotPeriodValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PingSlotPeriodValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingSlotPeriodValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(m.Value)
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataRateOffsetValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregatedDutyCycleValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedDutyCycleValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedDutyCycleValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataRateIndexValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RxDelayValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RxDelayValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RxDelayValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PingSlotPeriodValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ADRAckLimitExponentValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRAckLimitExponentValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRAckLimitExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregatedDutyCycleValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ADRAckDelayExponentValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRAckDelayExponentValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRAckDelayExponentValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RxDelayValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeviceEIRPValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceEIRPValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceEIRPValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+		i = encodeVarintLorawan(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ADRAckLimitExponentValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelaySecondChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelaySecondChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelaySecondChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+	if m.AckOffset != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AckOffset))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.DataRateIndex != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintLorawan(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ADRAckDelayExponentValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayForwardLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayForwardLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayForwardLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+	if m.ReloadRate != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ReloadRate))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.BucketSize != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.BucketSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeviceEIRPValue) Size() (n int) {
-	if m == nil {
-		return 0
+func encodeVarintLorawan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLorawan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovLorawan(uint64(m.Value))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedMHDR(r randyLorawan, easy bool) *MHDR {
+	this := &MHDR{}
+	this.MType = MType([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	this.Major = Major([]int32{0}[r.Intn(1)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func sovLorawan(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLorawan(x uint64) (n int) {
-	return sovLorawan((x << 1) ^ uint64((int64(x) >> 63)))
+func NewPopulatedFCtrl(r randyLorawan, easy bool) *FCtrl {
+	this := &FCtrl{}
+	this.ADR = bool(r.Intn(2) == 0)
+	this.ADRAckReq = bool(r.Intn(2) == 0)
+	this.Ack = bool(r.Intn(2) == 0)
+	this.FPending = bool(r.Intn(2) == 0)
+	this.ClassB = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *Message) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedLoRaDataRate(r randyLorawan, easy bool) *LoRaDataRate {
+	this := &LoRaDataRate{}
+	this.Bandwidth = r.Uint32()
+	this.SpreadingFactor = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message{`,
-		`MHDR:` + strings.Replace(strings.Replace(this.MHDR.String(), "MHDR", "MHDR", 1), `&`, ``, 1) + `,`,
-		`MIC:` + fmt.Sprintf("%v", this.MIC) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_MACPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
+	this := &FSKDataRate{}
+	this.BitRate = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message_MACPayload{`,
-		`MACPayload:` + strings.Replace(fmt.Sprintf("%v", this.MACPayload), "MACPayload", "MACPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_JoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
+	this := &DataRate{}
+	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
+	switch oneofNumber_Modulation {
+	case 1:
+		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
+	case 2:
+		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
 	}
-	s := strings.Join([]string{`&Message_JoinRequestPayload{`,
-		`JoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinRequestPayload), "JoinRequestPayload", "JoinRequestPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Message_JoinAcceptPayload) String() string {
-	if this == nil {
-		return "nil"
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&Message_JoinAcceptPayload{`,
-		`JoinAcceptPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinAcceptPayload), "JoinAcceptPayload", "JoinAcceptPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *Message_RejoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Message_RejoinRequestPayload{`,
-		`RejoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.RejoinRequestPayload), "RejoinRequestPayload", "RejoinRequestPayload", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func NewPopulatedDataRate_LoRa(r randyLorawan, easy bool) *DataRate_LoRa {
+	this := &DataRate_LoRa{}
+	this.LoRa = NewPopulatedLoRaDataRate(r, easy)
+	return this
 }
-func (this *MHDR) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MHDR{`,
-		`MType:` + fmt.Sprintf("%v", this.MType) + `,`,
-		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
-		`}`,
-	}, "")
-	return s
+func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
+	this := &DataRate_FSK{}
+	this.FSK = NewPopulatedFSKDataRate(r, easy)
+	return this
 }
-func (this *MACPayload) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
+	this := &TxSettings_Downlink{}
+	this.AntennaIndex = r.Uint32()
+	this.TxPower = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.TxPower *= -1
 	}
-	s := strings.Join([]string{`&MACPayload{`,
-		`FHDR:` + strings.Replace(strings.Replace(this.FHDR.String(), "FHDR", "FHDR", 1), `&`, ``, 1) + `,`,
-		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
-		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
-		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
-		`FullFCnt:` + fmt.Sprintf("%v", this.FullFCnt) + `,`,
-		`}`,
-	}, "")
-	return s
+	this.InvertPolarization = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *FHDR) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedGatewayAntennaIdentifiers(r randyLorawan, easy bool) *GatewayAntennaIdentifiers {
+	this := &GatewayAntennaIdentifiers{}
+	v1 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v1
+	this.AntennaIndex = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&FHDR{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`FCtrl:` + strings.Replace(strings.Replace(this.FCtrl.String(), "FCtrl", "FCtrl", 1), `&`, ``, 1) + `,`,
-		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
-		`FOpts:` + fmt.Sprintf("%v", this.FOpts) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *FCtrl) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedUplinkToken(r randyLorawan, easy bool) *UplinkToken {
+	this := &UplinkToken{}
+	v2 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+	this.GatewayAntennaIdentifiers = *v2
+	this.Timestamp = r.Uint32()
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ServerTime = *v3
+	this.ConcentratorTime = r.Int63()
+	if r.Intn(2) == 0 {
+		this.ConcentratorTime *= -1
 	}
-	s := strings.Join([]string{`&FCtrl{`,
-		`ADR:` + fmt.Sprintf("%v", this.ADR) + `,`,
-		`ADRAckReq:` + fmt.Sprintf("%v", this.ADRAckReq) + `,`,
-		`Ack:` + fmt.Sprintf("%v", this.Ack) + `,`,
-		`FPending:` + fmt.Sprintf("%v", this.FPending) + `,`,
-		`ClassB:` + fmt.Sprintf("%v", this.ClassB) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *JoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDownlinkPath(r randyLorawan, easy bool) *DownlinkPath {
+	this := &DownlinkPath{}
+	oneofNumber_Path := []int32{1, 2}[r.Intn(2)]
+	switch oneofNumber_Path {
+	case 1:
+		this.Path = NewPopulatedDownlinkPath_UplinkToken(r, easy)
+	case 2:
+		this.Path = NewPopulatedDownlinkPath_Fixed(r, easy)
 	}
-	s := strings.Join([]string{`&JoinRequestPayload{`,
-		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
-		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
-		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *RejoinRequestPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDownlinkPath_UplinkToken(r randyLorawan, easy bool) *DownlinkPath_UplinkToken {
+	this := &DownlinkPath_UplinkToken{}
+	v4 := r.Intn(100)
+	this.UplinkToken = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.UplinkToken[i] = byte(r.Intn(256))
 	}
-	s := strings.Join([]string{`&RejoinRequestPayload{`,
-		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
-		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
-		`RejoinCnt:` + fmt.Sprintf("%v", this.RejoinCnt) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *JoinAcceptPayload) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedDownlinkPath_Fixed(r randyLorawan, easy bool) *DownlinkPath_Fixed {
+	this := &DownlinkPath_Fixed{}
+	this.Fixed = NewPopulatedGatewayAntennaIdentifiers(r, easy)
+	return this
+}
+func NewPopulatedMACCommand_LinkADRAns(r randyLorawan, easy bool) *MACCommand_LinkADRAns {
+	this := &MACCommand_LinkADRAns{}
+	this.ChannelMaskAck = bool(r.Intn(2) == 0)
+	this.DataRateIndexAck = bool(r.Intn(2) == 0)
+	this.TxPowerIndexAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&JoinAcceptPayload{`,
-		`Encrypted:` + fmt.Sprintf("%v", this.Encrypted) + `,`,
-		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`DLSettings:` + strings.Replace(strings.Replace(this.DLSettings.String(), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
-		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
-		`CFList:` + strings.Replace(this.CFList.String(), "CFList", "CFList", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DLSettings) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DutyCycleReq(r randyLorawan, easy bool) *MACCommand_DutyCycleReq {
+	this := &MACCommand_DutyCycleReq{}
+	this.MaxDutyCycle = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DLSettings{`,
-		`Rx1DROffset:` + fmt.Sprintf("%v", this.Rx1DROffset) + `,`,
-		`Rx2DR:` + fmt.Sprintf("%v", this.Rx2DR) + `,`,
-		`OptNeg:` + fmt.Sprintf("%v", this.OptNeg) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *CFList) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RxParamSetupAns(r randyLorawan, easy bool) *MACCommand_RxParamSetupAns {
+	this := &MACCommand_RxParamSetupAns{}
+	this.Rx2DataRateIndexAck = bool(r.Intn(2) == 0)
+	this.Rx1DataRateOffsetAck = bool(r.Intn(2) == 0)
+	this.Rx2FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&CFList{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Freq:` + fmt.Sprintf("%v", this.Freq) + `,`,
-		`ChMasks:` + fmt.Sprintf("%v", this.ChMasks) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *LoRaDataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_NewChannelAns(r randyLorawan, easy bool) *MACCommand_NewChannelAns {
+	this := &MACCommand_NewChannelAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	this.DataRateAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&LoRaDataRate{`,
-		`Bandwidth:` + fmt.Sprintf("%v", this.Bandwidth) + `,`,
-		`SpreadingFactor:` + fmt.Sprintf("%v", this.SpreadingFactor) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *FSKDataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DLChannelAns(r randyLorawan, easy bool) *MACCommand_DLChannelAns {
+	this := &MACCommand_DLChannelAns{}
+	this.ChannelIndexAck = bool(r.Intn(2) == 0)
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&FSKDataRate{`,
-		`BitRate:` + fmt.Sprintf("%v", this.BitRate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RxTimingSetupReq(r randyLorawan, easy bool) *MACCommand_RxTimingSetupReq {
+	this := &MACCommand_RxTimingSetupReq{}
+	this.Delay = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate{`,
-		`Modulation:` + fmt.Sprintf("%v", this.Modulation) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate_LoRa) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_TxParamSetupReq(r randyLorawan, easy bool) *MACCommand_TxParamSetupReq {
+	this := &MACCommand_TxParamSetupReq{}
+	this.MaxEIRPIndex = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.UplinkDwellTime = bool(r.Intn(2) == 0)
+	this.DownlinkDwellTime = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate_LoRa{`,
-		`LoRa:` + strings.Replace(fmt.Sprintf("%v", this.LoRa), "LoRaDataRate", "LoRaDataRate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DataRate_FSK) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RekeyInd(r randyLorawan, easy bool) *MACCommand_RekeyInd {
+	this := &MACCommand_RekeyInd{}
+	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DataRate_FSK{`,
-		`FSK:` + strings.Replace(fmt.Sprintf("%v", this.FSK), "FSKDataRate", "FSKDataRate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxSettings) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RekeyConf(r randyLorawan, easy bool) *MACCommand_RekeyConf {
+	this := &MACCommand_RekeyConf{}
+	this.MinorVersion = Minor([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&TxSettings{`,
-		`DataRate:` + strings.Replace(strings.Replace(this.DataRate.String(), "DataRate", "DataRate", 1), `&`, ``, 1) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`EnableCRC:` + fmt.Sprintf("%v", this.EnableCRC) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "TxSettings_Downlink", "TxSettings_Downlink", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxSettings_Downlink) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_ADRParamSetupReq(r randyLorawan, easy bool) *MACCommand_ADRParamSetupReq {
+	this := &MACCommand_ADRParamSetupReq{}
+	this.ADRAckLimitExponent = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.ADRAckDelayExponent = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&TxSettings_Downlink{`,
-		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
-		`TxPower:` + fmt.Sprintf("%v", this.TxPower) + `,`,
-		`InvertPolarization:` + fmt.Sprintf("%v", this.InvertPolarization) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *GatewayAntennaIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceTimeAns(r randyLorawan, easy bool) *MACCommand_DeviceTimeAns {
+	this := &MACCommand_DeviceTimeAns{}
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v5
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&GatewayAntennaIdentifiers{`,
-		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
-		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *UplinkToken) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RejoinParamSetupReq(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupReq {
+	this := &MACCommand_RejoinParamSetupReq{}
+	this.MaxCountExponent = RejoinCountExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.MaxTimeExponent = RejoinTimeExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&UplinkToken{`,
-		`GatewayAntennaIdentifiers:` + strings.Replace(strings.Replace(this.GatewayAntennaIdentifiers.String(), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1), `&`, ``, 1) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`ServerTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServerTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ConcentratorTime:` + fmt.Sprintf("%v", this.ConcentratorTime) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RejoinParamSetupAns(r randyLorawan, easy bool) *MACCommand_RejoinParamSetupAns {
+	this := &MACCommand_RejoinParamSetupAns{}
+	this.MaxTimeExponentAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath_UplinkToken) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_PingSlotInfoReq(r randyLorawan, easy bool) *MACCommand_PingSlotInfoReq {
+	this := &MACCommand_PingSlotInfoReq{}
+	this.Period = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath_UplinkToken{`,
-		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *DownlinkPath_Fixed) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_PingSlotChannelAns(r randyLorawan, easy bool) *MACCommand_PingSlotChannelAns {
+	this := &MACCommand_PingSlotChannelAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	this.DataRateIndexAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&DownlinkPath_Fixed{`,
-		`Fixed:` + strings.Replace(fmt.Sprintf("%v", this.Fixed), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *TxRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForDownlinkPaths := "[]*DownlinkPath{"
-	for _, f := range this.DownlinkPaths {
-		repeatedStringForDownlinkPaths += strings.Replace(f.String(), "DownlinkPath", "DownlinkPath", 1) + ","
+
+func NewPopulatedMACCommand_BeaconFreqAns(r randyLorawan, easy bool) *MACCommand_BeaconFreqAns {
+	this := &MACCommand_BeaconFreqAns{}
+	this.FrequencyAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForDownlinkPaths += "}"
-	s := strings.Join([]string{`&TxRequest{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`DownlinkPaths:` + repeatedStringForDownlinkPaths + `,`,
-		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
-		`Rx1DataRateIndex:` + fmt.Sprintf("%v", this.Rx1DataRateIndex) + `,`,
-		`Rx1Frequency:` + fmt.Sprintf("%v", this.Rx1Frequency) + `,`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
-		`AbsoluteTime:` + strings.Replace(fmt.Sprintf("%v", this.AbsoluteTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceModeInd(r randyLorawan, easy bool) *MACCommand_DeviceModeInd {
+	this := &MACCommand_DeviceModeInd{}
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand{`,
-		`CID:` + fmt.Sprintf("%v", this.CID) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RawPayload) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_DeviceModeConf(r randyLorawan, easy bool) *MACCommand_DeviceModeConf {
+	this := &MACCommand_DeviceModeConf{}
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RawPayload{`,
-		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_ResetInd_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayConfAns(r randyLorawan, easy bool) *MACCommand_RelayConfAns {
+	this := &MACCommand_RelayConfAns{}
+	this.SecondChannelFrequencyAck = bool(r.Intn(2) == 0)
+	this.SecondChannelAckOffsetAck = bool(r.Intn(2) == 0)
+	this.SecondChannelDataRateIndexAck = bool(r.Intn(2) == 0)
+	this.SecondChannelIndexAck = bool(r.Intn(2) == 0)
+	this.DefaultChannelIndexAck = bool(r.Intn(2) == 0)
+	this.CadPeriodicityAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_ResetInd_{`,
-		`ResetInd:` + strings.Replace(fmt.Sprintf("%v", this.ResetInd), "MACCommand_ResetInd", "MACCommand_ResetInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_ResetConf_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayEndDeviceConfAns(r randyLorawan, easy bool) *MACCommand_RelayEndDeviceConfAns {
+	this := &MACCommand_RelayEndDeviceConfAns{}
+	this.SecondChannelFrequencyAck = bool(r.Intn(2) == 0)
+	this.SecondChannelDataRateIndexAck = bool(r.Intn(2) == 0)
+	this.SecondChannelIndexAck = bool(r.Intn(2) == 0)
+	this.BackoffAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_ResetConf_{`,
-		`ResetConf:` + strings.Replace(fmt.Sprintf("%v", this.ResetConf), "MACCommand_ResetConf", "MACCommand_ResetConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_LinkCheckAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayFilterListAns(r randyLorawan, easy bool) *MACCommand_RelayFilterListAns {
+	this := &MACCommand_RelayFilterListAns{}
+	this.FilterListActionAck = bool(r.Intn(2) == 0)
+	this.FilterListLengthAck = bool(r.Intn(2) == 0)
+	this.CombinedRulesAck = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_LinkCheckAns_{`,
-		`LinkCheckAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkCheckAns), "MACCommand_LinkCheckAns", "MACCommand_LinkCheckAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_LinkADRReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedMACCommand_RelayCtrlUplinkListAns(r randyLorawan, easy bool) *MACCommand_RelayCtrlUplinkListAns {
+	this := &MACCommand_RelayCtrlUplinkListAns{}
+	this.IndexAck = bool(r.Intn(2) == 0)
+	this.WFCnt = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRReq_{`,
-		`LinkADRReq:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRReq), "MACCommand_LinkADRReq", "MACCommand_LinkADRReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_LinkADRAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedFrequencyValue(r randyLorawan, easy bool) *FrequencyValue {
+	this := &FrequencyValue{}
+	this.Value = uint64(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRAns_{`,
-		`LinkADRAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRAns), "MACCommand_LinkADRAns", "MACCommand_LinkADRAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DutyCycleReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRateOffsetValue(r randyLorawan, easy bool) *DataRateOffsetValue {
+	this := &DataRateOffsetValue{}
+	this.Value = DataRateOffset([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DutyCycleReq_{`,
-		`DutyCycleReq:` + strings.Replace(fmt.Sprintf("%v", this.DutyCycleReq), "MACCommand_DutyCycleReq", "MACCommand_DutyCycleReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RxParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDataRateIndexValue(r randyLorawan, easy bool) *DataRateIndexValue {
+	this := &DataRateIndexValue{}
+	this.Value = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupReq_{`,
-		`RxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupReq), "MACCommand_RxParamSetupReq", "MACCommand_RxParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RxParamSetupAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedPingSlotPeriodValue(r randyLorawan, easy bool) *PingSlotPeriodValue {
+	this := &PingSlotPeriodValue{}
+	this.Value = PingSlotPeriod([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupAns_{`,
-		`RxParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupAns), "MACCommand_RxParamSetupAns", "MACCommand_RxParamSetupAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DevStatusAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedAggregatedDutyCycleValue(r randyLorawan, easy bool) *AggregatedDutyCycleValue {
+	this := &AggregatedDutyCycleValue{}
+	this.Value = AggregatedDutyCycle([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DevStatusAns_{`,
-		`DevStatusAns:` + strings.Replace(fmt.Sprintf("%v", this.DevStatusAns), "MACCommand_DevStatusAns", "MACCommand_DevStatusAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_NewChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedRxDelayValue(r randyLorawan, easy bool) *RxDelayValue {
+	this := &RxDelayValue{}
+	this.Value = RxDelay([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelReq_{`,
-		`NewChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelReq), "MACCommand_NewChannelReq", "MACCommand_NewChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_NewChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRAckLimitExponentValue(r randyLorawan, easy bool) *ADRAckLimitExponentValue {
+	this := &ADRAckLimitExponentValue{}
+	this.Value = ADRAckLimitExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelAns_{`,
-		`NewChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelAns), "MACCommand_NewChannelAns", "MACCommand_NewChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DLChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedADRAckDelayExponentValue(r randyLorawan, easy bool) *ADRAckDelayExponentValue {
+	this := &ADRAckDelayExponentValue{}
+	this.Value = ADRAckDelayExponent([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelReq_{`,
-		`DLChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelReq), "MACCommand_DLChannelReq", "MACCommand_DLChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_DLChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedDeviceEIRPValue(r randyLorawan, easy bool) *DeviceEIRPValue {
+	this := &DeviceEIRPValue{}
+	this.Value = DeviceEIRP([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelAns_{`,
-		`DLChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelAns), "MACCommand_DLChannelAns", "MACCommand_DLChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACCommand_RxTimingSetupReq_) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq_{`,
-		`RxTimingSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxTimingSetupReq), "MACCommand_RxTimingSetupReq", "MACCommand_RxTimingSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type randyLorawan interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
 }
-func (this *MACCommand_TxParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func randUTF8RuneLorawan(r randyLorawan) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
 	}
-	s := strings.Join([]string{`&MACCommand_TxParamSetupReq_{`,
-		`TxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.TxParamSetupReq), "MACCommand_TxParamSetupReq", "MACCommand_TxParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return rune(ru + 61)
 }
-func (this *MACCommand_RekeyInd_) String() string {
-	if this == nil {
-		return "nil"
+func randStringLorawan(r randyLorawan) string {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
+		tmps[i] = randUTF8RuneLorawan(r)
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyInd_{`,
-		`RekeyInd:` + strings.Replace(fmt.Sprintf("%v", this.RekeyInd), "MACCommand_RekeyInd", "MACCommand_RekeyInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return string(tmps)
 }
-func (this *MACCommand_RekeyConf_) String() string {
-	if this == nil {
-		return "nil"
+func randUnrecognizedLorawan(r randyLorawan, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldLorawan(dAtA, r, fieldNumber, wire)
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyConf_{`,
-		`RekeyConf:` + strings.Replace(fmt.Sprintf("%v", this.RekeyConf), "MACCommand_RekeyConf", "MACCommand_RekeyConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *MACCommand_ADRParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+func randFieldLorawan(dAtA []byte, r randyLorawan, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		v7 := r.Int63()
+		if r.Intn(2) == 0 {
+			v7 *= -1
+		}
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(v7))
+	case 1:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateLorawan(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq_{`,
-		`ADRParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.ADRParamSetupReq), "MACCommand_ADRParamSetupReq", "MACCommand_ADRParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *MACCommand_DeviceTimeAns_) String() string {
-	if this == nil {
-		return "nil"
+func encodeVarintPopulateLorawan(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceTimeAns_{`,
-		`DeviceTimeAns:` + strings.Replace(fmt.Sprintf("%v", this.DeviceTimeAns), "MACCommand_DeviceTimeAns", "MACCommand_DeviceTimeAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
 }
-func (this *MACCommand_ForceRejoinReq_) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_ForceRejoinReq_{`,
-		`ForceRejoinReq:` + strings.Replace(fmt.Sprintf("%v", this.ForceRejoinReq), "MACCommand_ForceRejoinReq", "MACCommand_ForceRejoinReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RejoinParamSetupReq_) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.MHDR.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = len(m.MIC)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq_{`,
-		`RejoinParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupReq), "MACCommand_RejoinParamSetupReq", "MACCommand_RejoinParamSetupReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RejoinParamSetupAns_) String() string {
-	if this == nil {
-		return "nil"
+	if m.Payload != nil {
+		n += m.Payload.Size()
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns_{`,
-		`RejoinParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupAns), "MACCommand_RejoinParamSetupAns", "MACCommand_RejoinParamSetupAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_PingSlotInfoReq_) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Message_MACPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq_{`,
-		`PingSlotInfoReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotInfoReq), "MACCommand_PingSlotInfoReq", "MACCommand_PingSlotInfoReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotChannelReq_) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MACPayload != nil {
+		l = m.MACPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq_{`,
-		`PingSlotChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelReq), "MACCommand_PingSlotChannelReq", "MACCommand_PingSlotChannelReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_PingSlotChannelAns_) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_JoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns_{`,
-		`PingSlotChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelAns), "MACCommand_PingSlotChannelAns", "MACCommand_PingSlotChannelAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconTimingAns_) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.JoinRequestPayload != nil {
+		l = m.JoinRequestPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconTimingAns_{`,
-		`BeaconTimingAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconTimingAns), "MACCommand_BeaconTimingAns", "MACCommand_BeaconTimingAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_BeaconFreqReq_) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_JoinAcceptPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqReq_{`,
-		`BeaconFreqReq:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqReq), "MACCommand_BeaconFreqReq", "MACCommand_BeaconFreqReq", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconFreqAns_) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.JoinAcceptPayload != nil {
+		l = m.JoinAcceptPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqAns_{`,
-		`BeaconFreqAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqAns), "MACCommand_BeaconFreqAns", "MACCommand_BeaconFreqAns", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DeviceModeInd_) String() string {
-	if this == nil {
-		return "nil"
+func (m *Message_RejoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeInd_{`,
-		`DeviceModeInd:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeInd), "MACCommand_DeviceModeInd", "MACCommand_DeviceModeInd", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DeviceModeConf_) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.RejoinRequestPayload != nil {
+		l = m.RejoinRequestPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeConf_{`,
-		`DeviceModeConf:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeConf), "MACCommand_DeviceModeConf", "MACCommand_DeviceModeConf", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_ResetInd) String() string {
-	if this == nil {
-		return "nil"
+func (m *MHDR) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_ResetInd{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_ResetConf) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MType != 0 {
+		n += 1 + sovLorawan(uint64(m.MType))
 	}
-	s := strings.Join([]string{`&MACCommand_ResetConf{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_LinkCheckAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.Major != 0 {
+		n += 1 + sovLorawan(uint64(m.Major))
 	}
-	s := strings.Join([]string{`&MACCommand_LinkCheckAns{`,
-		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
-		`GatewayCount:` + fmt.Sprintf("%v", this.GatewayCount) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_LinkADRReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MACPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRReq{`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
-		`ChannelMask:` + fmt.Sprintf("%v", this.ChannelMask) + `,`,
-		`ChannelMaskControl:` + fmt.Sprintf("%v", this.ChannelMaskControl) + `,`,
-		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_LinkADRAns) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.FHDR.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.FPort != 0 {
+		n += 1 + sovLorawan(uint64(m.FPort))
 	}
-	s := strings.Join([]string{`&MACCommand_LinkADRAns{`,
-		`ChannelMaskAck:` + fmt.Sprintf("%v", this.ChannelMaskAck) + `,`,
-		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
-		`TxPowerIndexAck:` + fmt.Sprintf("%v", this.TxPowerIndexAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DutyCycleReq) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_DutyCycleReq{`,
-		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupReq{`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxParamSetupAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.FullFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.FullFCnt))
 	}
-	s := strings.Join([]string{`&MACCommand_RxParamSetupAns{`,
-		`Rx2DataRateIndexAck:` + fmt.Sprintf("%v", this.Rx2DataRateIndexAck) + `,`,
-		`Rx1DataRateOffsetAck:` + fmt.Sprintf("%v", this.Rx1DataRateOffsetAck) + `,`,
-		`Rx2FrequencyAck:` + fmt.Sprintf("%v", this.Rx2FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DevStatusAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FHDR) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DevStatusAns{`,
-		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
-		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_NewChannelReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.FCtrl.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.FCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.FCnt))
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelReq{`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
-		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_NewChannelAns) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.FOpts)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_NewChannelAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`DataRateAck:` + fmt.Sprintf("%v", this.DataRateAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_DLChannelReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FCtrl) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelReq{`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_DLChannelAns) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ADR {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_DLChannelAns{`,
-		`ChannelIndexAck:` + fmt.Sprintf("%v", this.ChannelIndexAck) + `,`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RxTimingSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRAckReq {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq{`,
-		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_TxParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.Ack {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_TxParamSetupReq{`,
-		`MaxEIRPIndex:` + fmt.Sprintf("%v", this.MaxEIRPIndex) + `,`,
-		`UplinkDwellTime:` + fmt.Sprintf("%v", this.UplinkDwellTime) + `,`,
-		`DownlinkDwellTime:` + fmt.Sprintf("%v", this.DownlinkDwellTime) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RekeyInd) String() string {
-	if this == nil {
-		return "nil"
+	if m.FPending {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyInd{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RekeyConf) String() string {
-	if this == nil {
-		return "nil"
+	if m.ClassB {
+		n += 2
 	}
-	s := strings.Join([]string{`&MACCommand_RekeyConf{`,
-		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_ADRParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq{`,
-		`ADRAckLimitExponent:` + fmt.Sprintf("%v", this.ADRAckLimitExponent) + `,`,
-		`ADRAckDelayExponent:` + fmt.Sprintf("%v", this.ADRAckDelayExponent) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.JoinEui.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevEui.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevNonce.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	return n
 }
-func (this *MACCommand_DeviceTimeAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RejoinRequestPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceTimeAns{`,
-		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_ForceRejoinReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.RejoinType != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinType))
 	}
-	s := strings.Join([]string{`&MACCommand_ForceRejoinReq{`,
-		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
-		`PeriodExponent:` + fmt.Sprintf("%v", this.PeriodExponent) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_RejoinParamSetupReq) String() string {
-	if this == nil {
-		return "nil"
+	l = m.NetID.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.JoinEui.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevEui.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.RejoinCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinCnt))
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq{`,
-		`MaxCountExponent:` + fmt.Sprintf("%v", this.MaxCountExponent) + `,`,
-		`MaxTimeExponent:` + fmt.Sprintf("%v", this.MaxTimeExponent) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_RejoinParamSetupAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JoinAcceptPayload) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns{`,
-		`MaxTimeExponentAck:` + fmt.Sprintf("%v", this.MaxTimeExponentAck) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotInfoReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Encrypted)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq{`,
-		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotChannelReq) String() string {
-	if this == nil {
-		return "nil"
+	l = m.JoinNonce.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	l = m.DLSettings.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.RxDelay != 0 {
+		n += 1 + sovLorawan(uint64(m.RxDelay))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq{`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_PingSlotChannelAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.CFList != nil {
+		l = m.CFList.Size()
+		n += 1 + l + sovLorawan(uint64(l))
 	}
-	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MACCommand_BeaconTimingAns) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DLSettings) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconTimingAns{`,
-		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
-		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconFreqReq) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Rx1DROffset != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DROffset))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqReq{`,
-		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACCommand_BeaconFreqAns) String() string {
-	if this == nil {
-		return "nil"
+	if m.Rx2DR != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DR))
 	}
-	s := strings.Join([]string{`&MACCommand_BeaconFreqAns{`,
-		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.OptNeg {
+		n += 2
+	}
+	return n
 }
-func (this *MACCommand_DeviceModeInd) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CFList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeInd{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLorawan(uint64(m.Type))
+	}
+	if len(m.Freq) > 0 {
+		l = 0
+		for _, e := range m.Freq {
+			l += sovLorawan(uint64(e))
+		}
+		n += 1 + sovLorawan(uint64(l)) + l
+	}
+	if len(m.ChMasks) > 0 {
+		n += 1 + sovLorawan(uint64(len(m.ChMasks))) + len(m.ChMasks)*1
+	}
+	return n
 }
-func (this *MACCommand_DeviceModeConf) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *LoRaDataRate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MACCommand_DeviceModeConf{`,
-		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Bandwidth != 0 {
+		n += 1 + sovLorawan(uint64(m.Bandwidth))
+	}
+	if m.SpreadingFactor != 0 {
+		n += 1 + sovLorawan(uint64(m.SpreadingFactor))
+	}
+	return n
 }
-func (this *FrequencyValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FSKDataRate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FrequencyValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.BitRate != 0 {
+		n += 1 + sovLorawan(uint64(m.BitRate))
+	}
+	return n
 }
-func (this *DataRateOffsetValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DataRate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DataRateOffsetValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Modulation != nil {
+		n += m.Modulation.Size()
+	}
+	return n
 }
-func (this *DataRateIndexValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DataRate_LoRa) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DataRateIndexValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.LoRa != nil {
+		l = m.LoRa.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
 }
-func (this *PingSlotPeriodValue) String() string {
-	if this == nil {
-		return "nil"
+func (m *DataRate_FSK) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PingSlotPeriodValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.FSK != nil {
+		l = m.FSK.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
 }
-func (this *AggregatedDutyCycleValue) String() string {
-	if this == nil {
-		return "nil"
+func (m *TxSettings) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AggregatedDutyCycleValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.DataRate.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	l = len(m.CodingRate)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.EnableCRC {
+		n += 2
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovLorawan(uint64(m.Timestamp))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
 }
-func (this *RxDelayValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TxSettings_Downlink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RxDelayValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.AntennaIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+	}
+	if m.TxPower != 0 {
+		n += 5
+	}
+	if m.InvertPolarization {
+		n += 2
+	}
+	return n
 }
-func (this *ADRAckLimitExponentValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GatewayAntennaIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ADRAckLimitExponentValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.AntennaIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+	}
+	return n
 }
-func (this *ADRAckDelayExponentValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UplinkToken) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ADRAckDelayExponentValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.GatewayAntennaIdentifiers.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovLorawan(uint64(m.Timestamp))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime)
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.ConcentratorTime != 0 {
+		n += 1 + sovLorawan(uint64(m.ConcentratorTime))
+	}
+	return n
 }
-func (this *DeviceEIRPValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DownlinkPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Path != nil {
+		n += m.Path.Size()
+	}
+	return n
+}
+
+func (m *DownlinkPath_UplinkToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UplinkToken != nil {
+		l = len(m.UplinkToken)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *DownlinkPath_Fixed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fixed != nil {
+		l = m.Fixed.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *TxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	if len(m.DownlinkPaths) > 0 {
+		for _, e := range m.DownlinkPaths {
+			l = e.Size()
+			n += 1 + l + sovLorawan(uint64(l))
+		}
+	}
+	if m.Rx1Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1Delay))
+	}
+	if m.Rx1DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DataRateIndex))
+	}
+	if m.Rx1Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx1Frequency)
+	}
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx2Frequency)
+	}
+	if m.Priority != 0 {
+		n += 1 + sovLorawan(uint64(m.Priority))
+	}
+	if m.AbsoluteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.Advanced != nil {
+		l = m.Advanced.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CID != 0 {
+		n += 1 + sovLorawan(uint64(m.CID))
+	}
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	return n
+}
+
+func (m *MACCommand_RawPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawPayload != nil {
+		l = len(m.RawPayload)
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetInd != nil {
+		l = m.ResetInd.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetConf != nil {
+		l = m.ResetConf.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkCheckAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkCheckAns != nil {
+		l = m.LinkCheckAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkADRReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkADRReq != nil {
+		l = m.LinkADRReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_LinkADRAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkADRAns != nil {
+		l = m.LinkADRAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DutyCycleReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DutyCycleReq != nil {
+		l = m.DutyCycleReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxParamSetupReq != nil {
+		l = m.RxParamSetupReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxParamSetupAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxParamSetupAns != nil {
+		l = m.RxParamSetupAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DevStatusAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevStatusAns != nil {
+		l = m.DevStatusAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_NewChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewChannelReq != nil {
+		l = m.NewChannelReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_NewChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewChannelAns != nil {
+		l = m.NewChannelAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DLChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DLChannelReq != nil {
+		l = m.DLChannelReq.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DLChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DLChannelAns != nil {
+		l = m.DLChannelAns.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RxTimingSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RxTimingSetupReq != nil {
+		l = m.RxTimingSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_TxParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxParamSetupReq != nil {
+		l = m.TxParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RekeyInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RekeyInd != nil {
+		l = m.RekeyInd.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RekeyConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RekeyConf != nil {
+		l = m.RekeyConf.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ADRParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ADRParamSetupReq != nil {
+		l = m.ADRParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceTimeAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceTimeAns != nil {
+		l = m.DeviceTimeAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ForceRejoinReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceRejoinReq != nil {
+		l = m.ForceRejoinReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RejoinParamSetupReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinParamSetupReq != nil {
+		l = m.RejoinParamSetupReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RejoinParamSetupAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinParamSetupAns != nil {
+		l = m.RejoinParamSetupAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotInfoReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotInfoReq != nil {
+		l = m.PingSlotInfoReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotChannelReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotChannelReq != nil {
+		l = m.PingSlotChannelReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_PingSlotChannelAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingSlotChannelAns != nil {
+		l = m.PingSlotChannelAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconTimingAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconTimingAns != nil {
+		l = m.BeaconTimingAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconFreqReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconFreqReq != nil {
+		l = m.BeaconFreqReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_BeaconFreqAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconFreqAns != nil {
+		l = m.BeaconFreqAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceModeInd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceModeInd != nil {
+		l = m.DeviceModeInd.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_DeviceModeConf_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceModeConf != nil {
+		l = m.DeviceModeConf.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfReq != nil {
+		l = m.RelayConfReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfAns != nil {
+		l = m.RelayConfAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayEndDeviceConfReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayEndDeviceConfReq != nil {
+		l = m.RelayEndDeviceConfReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayEndDeviceConfAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayEndDeviceConfAns != nil {
+		l = m.RelayEndDeviceConfAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayFilterListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayFilterListReq != nil {
+		l = m.RelayFilterListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayFilterListAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayFilterListAns != nil {
+		l = m.RelayFilterListAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayUpdateUplinkListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayUpdateUplinkListReq != nil {
+		l = m.RelayUpdateUplinkListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayCtrlUplinkListReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayCtrlUplinkListReq != nil {
+		l = m.RelayCtrlUplinkListReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayCtrlUplinkListAns_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayCtrlUplinkListAns != nil {
+		l = m.RelayCtrlUplinkListAns.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayConfigureFwdLimitReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayConfigureFwdLimitReq != nil {
+		l = m.RelayConfigureFwdLimitReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayNotifyNewEndDeviceReq != nil {
+		l = m.RelayNotifyNewEndDeviceReq.Size()
+		n += 2 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+func (m *MACCommand_ResetInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_ResetConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkCheckAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Margin != 0 {
+		n += 1 + sovLorawan(uint64(m.Margin))
+	}
+	if m.GatewayCount != 0 {
+		n += 1 + sovLorawan(uint64(m.GatewayCount))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkADRReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.TxPowerIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.TxPowerIndex))
+	}
+	if len(m.ChannelMask) > 0 {
+		n += 1 + sovLorawan(uint64(len(m.ChannelMask))) + len(m.ChannelMask)*1
+	}
+	if m.ChannelMaskControl != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelMaskControl))
+	}
+	if m.NbTrans != 0 {
+		n += 1 + sovLorawan(uint64(m.NbTrans))
+	}
+	return n
+}
+
+func (m *MACCommand_LinkADRAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelMaskAck {
+		n += 2
+	}
+	if m.DataRateIndexAck {
+		n += 2
+	}
+	if m.TxPowerIndexAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DutyCycleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDutyCycle != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxDutyCycle))
+	}
+	return n
+}
+
+func (m *MACCommand_RxParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx1DataRateOffset != 0 {
+		n += 1 + sovLorawan(uint64(m.Rx1DataRateOffset))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovLorawan(m.Rx2Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_RxParamSetupAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rx2DataRateIndexAck {
+		n += 2
+	}
+	if m.Rx1DataRateOffsetAck {
+		n += 2
+	}
+	if m.Rx2FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DevStatusAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		n += 1 + sovLorawan(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovLorawan(uint64(m.Margin))
+	}
+	return n
+}
+
+func (m *MACCommand_NewChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.MinDataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MinDataRateIndex))
+	}
+	if m.MaxDataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxDataRateIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_NewChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	if m.DataRateAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DLChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_DLChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelIndexAck {
+		n += 2
+	}
+	if m.FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RxTimingSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Delay))
+	}
+	return n
+}
+
+func (m *MACCommand_TxParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxEIRPIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxEIRPIndex))
+	}
+	if m.UplinkDwellTime {
+		n += 2
+	}
+	if m.DownlinkDwellTime {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RekeyInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_RekeyConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinorVersion != 0 {
+		n += 1 + sovLorawan(uint64(m.MinorVersion))
+	}
+	return n
+}
+
+func (m *MACCommand_ADRParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ADRAckLimitExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.ADRAckLimitExponent))
+	}
+	if m.ADRAckDelayExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.ADRAckDelayExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceTimeAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLorawan(uint64(l))
+	return n
+}
+
+func (m *MACCommand_ForceRejoinReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejoinType != 0 {
+		n += 1 + sovLorawan(uint64(m.RejoinType))
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxRetries))
+	}
+	if m.PeriodExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.PeriodExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_RejoinParamSetupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCountExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxCountExponent))
+	}
+	if m.MaxTimeExponent != 0 {
+		n += 1 + sovLorawan(uint64(m.MaxTimeExponent))
+	}
+	return n
+}
+
+func (m *MACCommand_RejoinParamSetupAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTimeExponentAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovLorawan(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotChannelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_PingSlotChannelAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	if m.DataRateIndexAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconTimingAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delay != 0 {
+		n += 1 + sovLorawan(uint64(m.Delay))
+	}
+	if m.ChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.ChannelIndex))
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconFreqReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	return n
+}
+
+func (m *MACCommand_BeaconFreqAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrequencyAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceModeInd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	return n
+}
+
+func (m *MACCommand_DeviceModeConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Class != 0 {
+		n += 1 + sovLorawan(uint64(m.Class))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.CadPeriodicity != 0 {
+		n += 1 + sovLorawan(uint64(m.CadPeriodicity))
+	}
+	if m.DefaultChannelIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DefaultChannelIndex))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecondChannelFrequencyAck {
+		n += 2
+	}
+	if m.SecondChannelAckOffsetAck {
+		n += 2
+	}
+	if m.SecondChannelDataRateIndexAck {
+		n += 2
+	}
+	if m.SecondChannelIndexAck {
+		n += 2
+	}
+	if m.DefaultChannelIndexAck {
+		n += 2
+	}
+	if m.CadPeriodicityAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovLorawan(uint64(m.Mode))
+	}
+	if m.SmartEnableLevel != 0 {
+		n += 1 + sovLorawan(uint64(m.SmartEnableLevel))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovLorawan(uint64(m.Backoff))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecondChannelFrequencyAck {
+		n += 2
+	}
+	if m.SecondChannelDataRateIndexAck {
+		n += 2
+	}
+	if m.SecondChannelIndexAck {
+		n += 2
+	}
+	if m.BackoffAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayFilterListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLorawan(uint64(m.Index))
+	}
+	if m.Action != 0 {
+		n += 1 + sovLorawan(uint64(m.Action))
+	}
+	l = len(m.RawJoinEuiDevEui)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayFilterListAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FilterListActionAck {
+		n += 2
+	}
+	if m.FilterListLengthAck {
+		n += 2
+	}
+	if m.CombinedRulesAck {
+		n += 2
+	}
+	return n
+}
+
+func (m *MACCommand_RelayUpdateUplinkListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLorawan(uint64(m.Index))
+	}
+	if m.UplinkLimitBucketSize != 0 {
+		n += 1 + sovLorawan(uint64(m.UplinkLimitBucketSize))
+	}
+	if m.UplinkLimitReloadRate != 0 {
+		n += 1 + sovLorawan(uint64(m.UplinkLimitReloadRate))
+	}
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.WFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.WFCnt))
+	}
+	if m.RootWorSKey != nil {
+		l = m.RootWorSKey.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayCtrlUplinkListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLorawan(uint64(m.Index))
+	}
+	if m.Action != 0 {
+		n += 1 + sovLorawan(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayCtrlUplinkListAns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexAck {
+		n += 2
+	}
+	if m.WFCnt != 0 {
+		n += 1 + sovLorawan(uint64(m.WFCnt))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayConfigureFwdLimitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetLimitCounter != 0 {
+		n += 1 + sovLorawan(uint64(m.ResetLimitCounter))
+	}
+	if m.JoinRequestLimits != nil {
+		l = m.JoinRequestLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.NotifyLimits != nil {
+		l = m.NotifyLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.GlobalUplinkLimits != nil {
+		l = m.GlobalUplinkLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	if m.OverallLimits != nil {
+		l = m.OverallLimits.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovLorawan(uint64(l))
+	if m.SNR != 0 {
+		n += 1 + sovLorawan(uint64(m.SNR))
+	}
+	if m.RSSI != 0 {
+		n += 1 + sovLorawan(uint64(m.RSSI))
+	}
+	return n
+}
+
+func (m *FrequencyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(m.Value)
+	}
+	return n
+}
+
+func (m *DataRateOffsetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *DataRateIndexValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *PingSlotPeriodValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AggregatedDutyCycleValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *RxDelayValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ADRAckLimitExponentValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ADRAckDelayExponentValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *DeviceEIRPValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovLorawan(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *RelaySecondChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovLorawan(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.DataRateIndex))
+	}
+	if m.AckOffset != 0 {
+		n += 1 + sovLorawan(uint64(m.AckOffset))
+	}
+	return n
+}
+
+func (m *RelayForwardLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketSize != 0 {
+		n += 1 + sovLorawan(uint64(m.BucketSize))
+	}
+	if m.ReloadRate != 0 {
+		n += 1 + sovLorawan(uint64(m.ReloadRate))
+	}
+	return n
+}
+
+func sovLorawan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLorawan(x uint64) (n int) {
+	return sovLorawan((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message{`,
+		`MHDR:` + strings.Replace(strings.Replace(this.MHDR.String(), "MHDR", "MHDR", 1), `&`, ``, 1) + `,`,
+		`MIC:` + fmt.Sprintf("%v", this.MIC) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_MACPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_MACPayload{`,
+		`MACPayload:` + strings.Replace(fmt.Sprintf("%v", this.MACPayload), "MACPayload", "MACPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_JoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_JoinRequestPayload{`,
+		`JoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinRequestPayload), "JoinRequestPayload", "JoinRequestPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_JoinAcceptPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_JoinAcceptPayload{`,
+		`JoinAcceptPayload:` + strings.Replace(fmt.Sprintf("%v", this.JoinAcceptPayload), "JoinAcceptPayload", "JoinAcceptPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message_RejoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message_RejoinRequestPayload{`,
+		`RejoinRequestPayload:` + strings.Replace(fmt.Sprintf("%v", this.RejoinRequestPayload), "RejoinRequestPayload", "RejoinRequestPayload", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MHDR) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MHDR{`,
+		`MType:` + fmt.Sprintf("%v", this.MType) + `,`,
+		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACPayload{`,
+		`FHDR:` + strings.Replace(strings.Replace(this.FHDR.String(), "FHDR", "FHDR", 1), `&`, ``, 1) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`FullFCnt:` + fmt.Sprintf("%v", this.FullFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FHDR) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FHDR{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`FCtrl:` + strings.Replace(strings.Replace(this.FCtrl.String(), "FCtrl", "FCtrl", 1), `&`, ``, 1) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`FOpts:` + fmt.Sprintf("%v", this.FOpts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FCtrl) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FCtrl{`,
+		`ADR:` + fmt.Sprintf("%v", this.ADR) + `,`,
+		`ADRAckReq:` + fmt.Sprintf("%v", this.ADRAckReq) + `,`,
+		`Ack:` + fmt.Sprintf("%v", this.Ack) + `,`,
+		`FPending:` + fmt.Sprintf("%v", this.FPending) + `,`,
+		`ClassB:` + fmt.Sprintf("%v", this.ClassB) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinRequestPayload{`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RejoinRequestPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RejoinRequestPayload{`,
+		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`RejoinCnt:` + fmt.Sprintf("%v", this.RejoinCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinAcceptPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinAcceptPayload{`,
+		`Encrypted:` + fmt.Sprintf("%v", this.Encrypted) + `,`,
+		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`DLSettings:` + strings.Replace(strings.Replace(this.DLSettings.String(), "DLSettings", "DLSettings", 1), `&`, ``, 1) + `,`,
+		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
+		`CFList:` + strings.Replace(this.CFList.String(), "CFList", "CFList", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DLSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DLSettings{`,
+		`Rx1DROffset:` + fmt.Sprintf("%v", this.Rx1DROffset) + `,`,
+		`Rx2DR:` + fmt.Sprintf("%v", this.Rx2DR) + `,`,
+		`OptNeg:` + fmt.Sprintf("%v", this.OptNeg) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CFList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CFList{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Freq:` + fmt.Sprintf("%v", this.Freq) + `,`,
+		`ChMasks:` + fmt.Sprintf("%v", this.ChMasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoRaDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoRaDataRate{`,
+		`Bandwidth:` + fmt.Sprintf("%v", this.Bandwidth) + `,`,
+		`SpreadingFactor:` + fmt.Sprintf("%v", this.SpreadingFactor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FSKDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FSKDataRate{`,
+		`BitRate:` + fmt.Sprintf("%v", this.BitRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate{`,
+		`Modulation:` + fmt.Sprintf("%v", this.Modulation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate_LoRa) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_LoRa{`,
+		`LoRa:` + strings.Replace(fmt.Sprintf("%v", this.LoRa), "LoRaDataRate", "LoRaDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRate_FSK) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_FSK{`,
+		`FSK:` + strings.Replace(fmt.Sprintf("%v", this.FSK), "FSKDataRate", "FSKDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxSettings{`,
+		`DataRate:` + strings.Replace(strings.Replace(this.DataRate.String(), "DataRate", "DataRate", 1), `&`, ``, 1) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`EnableCRC:` + fmt.Sprintf("%v", this.EnableCRC) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "TxSettings_Downlink", "TxSettings_Downlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxSettings_Downlink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxSettings_Downlink{`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
+		`TxPower:` + fmt.Sprintf("%v", this.TxPower) + `,`,
+		`InvertPolarization:` + fmt.Sprintf("%v", this.InvertPolarization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAntennaIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAntennaIdentifiers{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UplinkToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UplinkToken{`,
+		`GatewayAntennaIdentifiers:` + strings.Replace(strings.Replace(this.GatewayAntennaIdentifiers.String(), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ServerTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServerTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ConcentratorTime:` + fmt.Sprintf("%v", this.ConcentratorTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath_UplinkToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath_UplinkToken{`,
+		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPath_Fixed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPath_Fixed{`,
+		`Fixed:` + strings.Replace(fmt.Sprintf("%v", this.Fixed), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDownlinkPaths := "[]*DownlinkPath{"
+	for _, f := range this.DownlinkPaths {
+		repeatedStringForDownlinkPaths += strings.Replace(f.String(), "DownlinkPath", "DownlinkPath", 1) + ","
+	}
+	repeatedStringForDownlinkPaths += "}"
+	s := strings.Join([]string{`&TxRequest{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`DownlinkPaths:` + repeatedStringForDownlinkPaths + `,`,
+		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
+		`Rx1DataRateIndex:` + fmt.Sprintf("%v", this.Rx1DataRateIndex) + `,`,
+		`Rx1Frequency:` + fmt.Sprintf("%v", this.Rx1Frequency) + `,`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`AbsoluteTime:` + strings.Replace(fmt.Sprintf("%v", this.AbsoluteTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand{`,
+		`CID:` + fmt.Sprintf("%v", this.CID) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RawPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RawPayload{`,
+		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetInd_{`,
+		`ResetInd:` + strings.Replace(fmt.Sprintf("%v", this.ResetInd), "MACCommand_ResetInd", "MACCommand_ResetInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetConf_{`,
+		`ResetConf:` + strings.Replace(fmt.Sprintf("%v", this.ResetConf), "MACCommand_ResetConf", "MACCommand_ResetConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkCheckAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkCheckAns_{`,
+		`LinkCheckAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkCheckAns), "MACCommand_LinkCheckAns", "MACCommand_LinkCheckAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRReq_{`,
+		`LinkADRReq:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRReq), "MACCommand_LinkADRReq", "MACCommand_LinkADRReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRAns_{`,
+		`LinkADRAns:` + strings.Replace(fmt.Sprintf("%v", this.LinkADRAns), "MACCommand_LinkADRAns", "MACCommand_LinkADRAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DutyCycleReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DutyCycleReq_{`,
+		`DutyCycleReq:` + strings.Replace(fmt.Sprintf("%v", this.DutyCycleReq), "MACCommand_DutyCycleReq", "MACCommand_DutyCycleReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupReq_{`,
+		`RxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupReq), "MACCommand_RxParamSetupReq", "MACCommand_RxParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupAns_{`,
+		`RxParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RxParamSetupAns), "MACCommand_RxParamSetupAns", "MACCommand_RxParamSetupAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DevStatusAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DevStatusAns_{`,
+		`DevStatusAns:` + strings.Replace(fmt.Sprintf("%v", this.DevStatusAns), "MACCommand_DevStatusAns", "MACCommand_DevStatusAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelReq_{`,
+		`NewChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelReq), "MACCommand_NewChannelReq", "MACCommand_NewChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelAns_{`,
+		`NewChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.NewChannelAns), "MACCommand_NewChannelAns", "MACCommand_NewChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelReq_{`,
+		`DLChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelReq), "MACCommand_DLChannelReq", "MACCommand_DLChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelAns_{`,
+		`DLChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.DLChannelAns), "MACCommand_DLChannelAns", "MACCommand_DLChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxTimingSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq_{`,
+		`RxTimingSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RxTimingSetupReq), "MACCommand_RxTimingSetupReq", "MACCommand_RxTimingSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_TxParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_TxParamSetupReq_{`,
+		`TxParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.TxParamSetupReq), "MACCommand_TxParamSetupReq", "MACCommand_TxParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyInd_{`,
+		`RekeyInd:` + strings.Replace(fmt.Sprintf("%v", this.RekeyInd), "MACCommand_RekeyInd", "MACCommand_RekeyInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyConf_{`,
+		`RekeyConf:` + strings.Replace(fmt.Sprintf("%v", this.RekeyConf), "MACCommand_RekeyConf", "MACCommand_RekeyConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ADRParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq_{`,
+		`ADRParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.ADRParamSetupReq), "MACCommand_ADRParamSetupReq", "MACCommand_ADRParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceTimeAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceTimeAns_{`,
+		`DeviceTimeAns:` + strings.Replace(fmt.Sprintf("%v", this.DeviceTimeAns), "MACCommand_DeviceTimeAns", "MACCommand_DeviceTimeAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ForceRejoinReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ForceRejoinReq_{`,
+		`ForceRejoinReq:` + strings.Replace(fmt.Sprintf("%v", this.ForceRejoinReq), "MACCommand_ForceRejoinReq", "MACCommand_ForceRejoinReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq_{`,
+		`RejoinParamSetupReq:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupReq), "MACCommand_RejoinParamSetupReq", "MACCommand_RejoinParamSetupReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns_{`,
+		`RejoinParamSetupAns:` + strings.Replace(fmt.Sprintf("%v", this.RejoinParamSetupAns), "MACCommand_RejoinParamSetupAns", "MACCommand_RejoinParamSetupAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotInfoReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq_{`,
+		`PingSlotInfoReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotInfoReq), "MACCommand_PingSlotInfoReq", "MACCommand_PingSlotInfoReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq_{`,
+		`PingSlotChannelReq:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelReq), "MACCommand_PingSlotChannelReq", "MACCommand_PingSlotChannelReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns_{`,
+		`PingSlotChannelAns:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotChannelAns), "MACCommand_PingSlotChannelAns", "MACCommand_PingSlotChannelAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconTimingAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconTimingAns_{`,
+		`BeaconTimingAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconTimingAns), "MACCommand_BeaconTimingAns", "MACCommand_BeaconTimingAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqReq_{`,
+		`BeaconFreqReq:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqReq), "MACCommand_BeaconFreqReq", "MACCommand_BeaconFreqReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqAns_{`,
+		`BeaconFreqAns:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFreqAns), "MACCommand_BeaconFreqAns", "MACCommand_BeaconFreqAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeInd_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeInd_{`,
+		`DeviceModeInd:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeInd), "MACCommand_DeviceModeInd", "MACCommand_DeviceModeInd", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeConf_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeConf_{`,
+		`DeviceModeConf:` + strings.Replace(fmt.Sprintf("%v", this.DeviceModeConf), "MACCommand_DeviceModeConf", "MACCommand_DeviceModeConf", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq_{`,
+		`RelayConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfReq), "MACCommand_RelayConfReq", "MACCommand_RelayConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns_{`,
+		`RelayConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfAns), "MACCommand_RelayConfAns", "MACCommand_RelayConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_{`,
+		`RelayEndDeviceConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfReq), "MACCommand_RelayEndDeviceConfReq", "MACCommand_RelayEndDeviceConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns_{`,
+		`RelayEndDeviceConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfAns), "MACCommand_RelayEndDeviceConfAns", "MACCommand_RelayEndDeviceConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq_{`,
+		`RelayFilterListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListReq), "MACCommand_RelayFilterListReq", "MACCommand_RelayFilterListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns_{`,
+		`RelayFilterListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListAns), "MACCommand_RelayFilterListAns", "MACCommand_RelayFilterListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq_{`,
+		`RelayUpdateUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayUpdateUplinkListReq), "MACCommand_RelayUpdateUplinkListReq", "MACCommand_RelayUpdateUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq_{`,
+		`RelayCtrlUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListReq), "MACCommand_RelayCtrlUplinkListReq", "MACCommand_RelayCtrlUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns_{`,
+		`RelayCtrlUplinkListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListAns), "MACCommand_RelayCtrlUplinkListAns", "MACCommand_RelayCtrlUplinkListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitReq_{`,
+		`RelayConfigureFwdLimitReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfigureFwdLimitReq), "MACCommand_RelayConfigureFwdLimitReq", "MACCommand_RelayConfigureFwdLimitReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq_{`,
+		`RelayNotifyNewEndDeviceReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayNotifyNewEndDeviceReq), "MACCommand_RelayNotifyNewEndDeviceReq", "MACCommand_RelayNotifyNewEndDeviceReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetInd{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ResetConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ResetConf{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkCheckAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkCheckAns{`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`GatewayCount:` + fmt.Sprintf("%v", this.GatewayCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRReq{`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
+		`ChannelMask:` + fmt.Sprintf("%v", this.ChannelMask) + `,`,
+		`ChannelMaskControl:` + fmt.Sprintf("%v", this.ChannelMaskControl) + `,`,
+		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_LinkADRAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_LinkADRAns{`,
+		`ChannelMaskAck:` + fmt.Sprintf("%v", this.ChannelMaskAck) + `,`,
+		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
+		`TxPowerIndexAck:` + fmt.Sprintf("%v", this.TxPowerIndexAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DutyCycleReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DutyCycleReq{`,
+		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupReq{`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxParamSetupAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxParamSetupAns{`,
+		`Rx2DataRateIndexAck:` + fmt.Sprintf("%v", this.Rx2DataRateIndexAck) + `,`,
+		`Rx1DataRateOffsetAck:` + fmt.Sprintf("%v", this.Rx1DataRateOffsetAck) + `,`,
+		`Rx2FrequencyAck:` + fmt.Sprintf("%v", this.Rx2FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DevStatusAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DevStatusAns{`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelReq{`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
+		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_NewChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_NewChannelAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`DataRateAck:` + fmt.Sprintf("%v", this.DataRateAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelReq{`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DLChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DLChannelAns{`,
+		`ChannelIndexAck:` + fmt.Sprintf("%v", this.ChannelIndexAck) + `,`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RxTimingSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RxTimingSetupReq{`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_TxParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_TxParamSetupReq{`,
+		`MaxEIRPIndex:` + fmt.Sprintf("%v", this.MaxEIRPIndex) + `,`,
+		`UplinkDwellTime:` + fmt.Sprintf("%v", this.UplinkDwellTime) + `,`,
+		`DownlinkDwellTime:` + fmt.Sprintf("%v", this.DownlinkDwellTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyInd{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RekeyConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RekeyConf{`,
+		`MinorVersion:` + fmt.Sprintf("%v", this.MinorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ADRParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ADRParamSetupReq{`,
+		`ADRAckLimitExponent:` + fmt.Sprintf("%v", this.ADRAckLimitExponent) + `,`,
+		`ADRAckDelayExponent:` + fmt.Sprintf("%v", this.ADRAckDelayExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceTimeAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceTimeAns{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_ForceRejoinReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_ForceRejoinReq{`,
+		`RejoinType:` + fmt.Sprintf("%v", this.RejoinType) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
+		`PeriodExponent:` + fmt.Sprintf("%v", this.PeriodExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupReq{`,
+		`MaxCountExponent:` + fmt.Sprintf("%v", this.MaxCountExponent) + `,`,
+		`MaxTimeExponent:` + fmt.Sprintf("%v", this.MaxTimeExponent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RejoinParamSetupAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RejoinParamSetupAns{`,
+		`MaxTimeExponentAck:` + fmt.Sprintf("%v", this.MaxTimeExponentAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotInfoReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotInfoReq{`,
+		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelReq{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_PingSlotChannelAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_PingSlotChannelAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`DataRateIndexAck:` + fmt.Sprintf("%v", this.DataRateIndexAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconTimingAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconTimingAns{`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqReq{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_BeaconFreqAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_BeaconFreqAns{`,
+		`FrequencyAck:` + fmt.Sprintf("%v", this.FrequencyAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeInd) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeInd{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_DeviceModeConf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_DeviceModeConf{`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`CadPeriodicity:` + fmt.Sprintf("%v", this.CadPeriodicity) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelAckOffsetAck:` + fmt.Sprintf("%v", this.SecondChannelAckOffsetAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`SecondChannelIndexAck:` + fmt.Sprintf("%v", this.SecondChannelIndexAck) + `,`,
+		`DefaultChannelIndexAck:` + fmt.Sprintf("%v", this.DefaultChannelIndexAck) + `,`,
+		`CadPeriodicityAck:` + fmt.Sprintf("%v", this.CadPeriodicityAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`SmartEnableLevel:` + fmt.Sprintf("%v", this.SmartEnableLevel) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`SecondChannelIndexAck:` + fmt.Sprintf("%v", this.SecondChannelIndexAck) + `,`,
+		`BackoffAck:` + fmt.Sprintf("%v", this.BackoffAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`RawJoinEuiDevEui:` + fmt.Sprintf("%v", this.RawJoinEuiDevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns{`,
+		`FilterListActionAck:` + fmt.Sprintf("%v", this.FilterListActionAck) + `,`,
+		`FilterListLengthAck:` + fmt.Sprintf("%v", this.FilterListLengthAck) + `,`,
+		`CombinedRulesAck:` + fmt.Sprintf("%v", this.CombinedRulesAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`UplinkLimitBucketSize:` + fmt.Sprintf("%v", this.UplinkLimitBucketSize) + `,`,
+		`UplinkLimitReloadRate:` + fmt.Sprintf("%v", this.UplinkLimitReloadRate) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`RootWorSKey:` + strings.Replace(fmt.Sprintf("%v", this.RootWorSKey), "KeyEnvelope", "KeyEnvelope", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns{`,
+		`IndexAck:` + fmt.Sprintf("%v", this.IndexAck) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfigureFwdLimitReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfigureFwdLimitReq{`,
+		`ResetLimitCounter:` + fmt.Sprintf("%v", this.ResetLimitCounter) + `,`,
+		`JoinRequestLimits:` + strings.Replace(this.JoinRequestLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`NotifyLimits:` + strings.Replace(this.NotifyLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`GlobalUplinkLimits:` + strings.Replace(this.GlobalUplinkLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`OverallLimits:` + strings.Replace(this.OverallLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`SNR:` + fmt.Sprintf("%v", this.SNR) + `,`,
+		`RSSI:` + fmt.Sprintf("%v", this.RSSI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FrequencyValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FrequencyValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRateOffsetValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRateOffsetValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRateIndexValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRateIndexValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingSlotPeriodValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingSlotPeriodValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregatedDutyCycleValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregatedDutyCycleValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RxDelayValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RxDelayValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRAckLimitExponentValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRAckLimitExponentValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRAckDelayExponentValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRAckDelayExponentValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceEIRPValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceEIRPValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelaySecondChannel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelaySecondChannel{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`AckOffset:` + fmt.Sprintf("%v", this.AckOffset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayForwardLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayForwardLimits{`,
+		`BucketSize:` + fmt.Sprintf("%v", this.BucketSize) + `,`,
+		`ReloadRate:` + fmt.Sprintf("%v", this.ReloadRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLorawan(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MHDR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MIC", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MIC = append(m.MIC[:0], dAtA[iNdEx:postIndex]...)
+			if m.MIC == nil {
+				m.MIC = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_MACPayload{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JoinRequestPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_JoinRequestPayload{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JoinAcceptPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_JoinAcceptPayload{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinRequestPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RejoinRequestPayload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Message_RejoinRequestPayload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MHDR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MHDR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MType", wireType)
+			}
+			m.MType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MType |= MType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			}
+			m.Major = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Major |= Major(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FHDR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
+			}
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullFCnt", wireType)
+			}
+			m.FullFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullFCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FHDR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FHDR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCtrl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FCtrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FOpts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FOpts = append(m.FOpts[:0], dAtA[iNdEx:postIndex]...)
+			if m.FOpts == nil {
+				m.FOpts = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FCtrl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FCtrl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FCtrl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ADR = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckReq", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ADRAckReq = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ack = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FPending = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassB", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClassB = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRequestPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejoinRequestPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
+			}
+			m.RejoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinType |= RejoinRequestType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCnt", wireType)
+			}
+			m.RejoinCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAcceptPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAcceptPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encrypted = append(m.Encrypted[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encrypted == nil {
+				m.Encrypted = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DLSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DLSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+			}
+			m.RxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDelay |= RxDelay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CFList == nil {
+				m.CFList = &CFList{}
+			}
+			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DLSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DROffset", wireType)
+			}
+			m.Rx1DROffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DROffset |= DataRateOffset(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DR", wireType)
+			}
+			m.Rx2DR = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2DR |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptNeg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptNeg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CFListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Freq = append(m.Freq, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Freq) == 0 {
+					m.Freq = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Freq = append(m.Freq, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freq", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChMasks = append(m.ChMasks, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ChMasks) == 0 {
+					m.ChMasks = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChMasks = append(m.ChMasks, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChMasks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&DeviceEIRPValue{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringLorawan(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14418,17 +19683,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoRaDataRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoRaDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MHDR", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bandwidth", wireType)
 			}
-			var msglen int
+			m.Bandwidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14438,199 +19703,30 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Bandwidth |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MIC", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MIC = append(m.MIC[:0], dAtA[iNdEx:postIndex]...)
-			if m.MIC == nil {
-				m.MIC = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MACPayload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &Message_MACPayload{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestPayload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JoinRequestPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &Message_JoinRequestPayload{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinAcceptPayload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JoinAcceptPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &Message_JoinAcceptPayload{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinRequestPayload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadingFactor", wireType)
 			}
-			v := &RejoinRequestPayload{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SpreadingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadingFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Payload = &Message_RejoinRequestPayload{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -14655,7 +19751,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MHDR) Unmarshal(dAtA []byte) error {
+func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14678,36 +19774,17 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MHDR: wiretype end group for non-group")
+			return fmt.Errorf("proto: FSKDataRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FSKDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MType", wireType)
-			}
-			m.MType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MType |= MType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitRate", wireType)
 			}
-			m.Major = 0
+			m.BitRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14717,7 +19794,7 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Major |= Major(b&0x7F) << shift
+				m.BitRate |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14746,7 +19823,7 @@ func (m *MHDR) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACPayload) Unmarshal(dAtA []byte) error {
+func (m *DataRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14769,15 +19846,15 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MACPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FHDR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRa", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14804,66 +19881,15 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FHDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &LoRaDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Modulation = &DataRate_LoRa{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
-			}
-			m.FPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FPort |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
-			if m.FRMPayload == nil {
-				m.FRMPayload = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FSK", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14890,32 +19916,12 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecodedPayload == nil {
-				m.DecodedPayload = &types.Struct{}
-			}
-			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &FSKDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Modulation = &DataRate_FSK{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullFCnt", wireType)
-			}
-			m.FullFCnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FullFCnt |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -14940,7 +19946,7 @@ func (m *MACPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FHDR) Unmarshal(dAtA []byte) error {
+func (m *TxSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14963,17 +19969,17 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FHDR: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FHDR: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -14983,28 +19989,137 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DataRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCRC", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCRC = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCtrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15031,34 +20146,18 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FCtrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
-			}
-			m.FCnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FCnt |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FOpts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15068,24 +20167,26 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FOpts = append(m.FOpts[:0], dAtA[iNdEx:postIndex]...)
-			if m.FOpts == nil {
-				m.FOpts = []byte{}
+			if m.Downlink == nil {
+				m.Downlink = &TxSettings_Downlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -15112,7 +20213,7 @@ func (m *FHDR) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FCtrl) Unmarshal(dAtA []byte) error {
+func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15135,17 +20236,17 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FCtrl: wiretype end group for non-group")
+			return fmt.Errorf("proto: Downlink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FCtrl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Downlink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
 			}
-			var v int
+			m.AntennaIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15155,75 +20256,25 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AntennaIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ADR = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckReq", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPower", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ADRAckReq = bool(v != 0)
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.TxPower = float32(math.Float32frombits(v))
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Ack = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FPending", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FPending = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvertPolarization", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15240,7 +20291,7 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ClassB = bool(v != 0)
+			m.InvertPolarization = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -15265,7 +20316,7 @@ func (m *FCtrl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
+func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15288,17 +20339,17 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinRequestPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayAntennaIdentifiers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayAntennaIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15308,63 +20359,30 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
 			}
-			var byteLen int
+			m.AntennaIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15374,25 +20392,11 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.AntennaIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -15417,7 +20421,7 @@ func (m *JoinRequestPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
+func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15440,36 +20444,17 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinRequestPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: UplinkToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinRequestPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UplinkToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
-			}
-			m.RejoinType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RejoinType |= RejoinRequestType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAntennaIdentifiers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15479,30 +20464,30 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayAntennaIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEui", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15512,30 +20497,16 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Timestamp |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.JoinEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15545,30 +20516,30 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ServerTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratorTime", wireType)
 			}
-			m.RejoinCnt = 0
+			m.ConcentratorTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15578,7 +20549,7 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinCnt |= uint32(b&0x7F) << shift
+				m.ConcentratorTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15607,7 +20578,7 @@ func (m *RejoinRequestPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
+func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15630,15 +20601,15 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAcceptPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: DownlinkPath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAcceptPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DownlinkPath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkToken", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -15665,16 +20636,15 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encrypted = append(m.Encrypted[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encrypted == nil {
-				m.Encrypted = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Path = &DownlinkPath_UplinkToken{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15684,63 +20654,85 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &GatewayAntennaIdentifiers{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Path = &DownlinkPath_Fixed{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15750,28 +20742,14 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15798,15 +20776,16 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DLSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DownlinkPaths = append(m.DownlinkPaths, &DownlinkPath{})
+			if err := m.DownlinkPaths[len(m.DownlinkPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
 			}
-			m.RxDelay = 0
+			m.Rx1Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15816,16 +20795,16 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RxDelay |= RxDelay(b&0x7F) << shift
+				m.Rx1Delay |= RxDelay(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CFList", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateIndex", wireType)
 			}
-			var msglen int
+			m.Rx1DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15835,86 +20814,35 @@ func (m *JoinAcceptPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx1DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CFList == nil {
-				m.CFList = &CFList{}
-			}
-			if err := m.CFList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DLSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Frequency", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rx1Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DLSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DROffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
 			}
-			m.Rx1DROffset = 0
+			m.Rx2DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15924,16 +20852,16 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1DROffset |= DataRateOffset(b&0x7F) << shift
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
-			m.Rx2DR = 0
+			m.Rx2Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15943,16 +20871,16 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DR |= DataRateIndex(b&0x7F) << shift
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptNeg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var v int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -15962,70 +20890,52 @@ func (m *DLSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Priority |= TxSchedulePriority(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OptNeg = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteTime", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.AbsoluteTime == nil {
+				m.AbsoluteTime = new(time.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AbsoluteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16035,157 +20945,60 @@ func (m *CFList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= CFListType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Freq = append(m.Freq, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Freq) == 0 {
-					m.Freq = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Freq = append(m.Freq, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freq", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ChMasks = append(m.ChMasks, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ChMasks) == 0 {
-					m.ChMasks = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChMasks = append(m.ChMasks, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChMasks", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Advanced == nil {
+				m.Advanced = &types.Struct{}
+			}
+			if err := m.Advanced.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -16210,7 +21023,7 @@ func (m *CFList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
+func (m *MACCommand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16233,17 +21046,209 @@ func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoRaDataRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MACCommand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoRaDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MACCommand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bandwidth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
 			}
-			m.Bandwidth = 0
+			m.CID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CID |= MACCommandIdentifier(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &MACCommand_RawPayload{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetInd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_ResetInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_ResetInd_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetConf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_ResetConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_ResetConf_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkCheckAns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_LinkCheckAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_LinkCheckAns_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRReq", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_LinkADRReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_LinkADRReq_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRAns", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16253,16 +21258,32 @@ func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Bandwidth |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadingFactor", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.SpreadingFactor = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_LinkADRAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_LinkADRAns_{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16272,69 +21293,32 @@ func (m *LoRaDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpreadingFactor |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_DutyCycleReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FSKDataRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FSKDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitRate", wireType)
+			m.Payload = &MACCommand_DutyCycleReq_{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupReq", wireType)
 			}
-			m.BitRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16344,67 +21328,30 @@ func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitRate |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataRate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_RxParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataRate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_RxParamSetupReq_{v}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16431,15 +21378,15 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoRaDataRate{}
+			v := &MACCommand_RxParamSetupAns{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Modulation = &DataRate_LoRa{v}
+			m.Payload = &MACCommand_RxParamSetupAns_{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FSK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16466,68 +21413,15 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &FSKDataRate{}
+			v := &MACCommand_DevStatusAns{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Modulation = &DataRate_FSK{v}
+			m.Payload = &MACCommand_DevStatusAns_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16554,15 +21448,17 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DataRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_NewChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_NewChannelReq_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelAns", wireType)
 			}
-			m.DataRateIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16572,16 +21468,32 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_NewChannelAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_NewChannelAns_{v}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelReq", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16591,29 +21503,32 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			v := &MACCommand_DLChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DLChannelReq_{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelAns", wireType)
 			}
-			m.Frequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16623,36 +21538,32 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCRC", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.EnableCRC = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = 0
+			v := &MACCommand_DLChannelAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DLChannelAns_{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxTimingSetupReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16662,14 +21573,30 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RxTimingSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RxTimingSetupReq_{v}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxParamSetupReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16696,16 +21623,15 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_TxParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_TxParamSetupReq_{v}
 			iNdEx = postIndex
-		case 8:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RekeyInd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16732,71 +21658,17 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downlink == nil {
-				m.Downlink = &TxSettings_Downlink{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RekeyInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RekeyInd_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Downlink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Downlink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RekeyConf", wireType)
 			}
-			m.AntennaIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16806,27 +21678,32 @@ func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AntennaIndex |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPower", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
-			iNdEx += 4
-			m.TxPower = float32(math.Float32frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvertPolarization", wireType)
+			v := &MACCommand_RekeyConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int
+			m.Payload = &MACCommand_RekeyConf_{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRParamSetupReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16836,68 +21713,30 @@ func (m *TxSettings_Downlink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InvertPolarization = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_ADRParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayAntennaIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayAntennaIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_ADRParamSetupReq_{v}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceTimeAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16924,15 +21763,17 @@ func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_DeviceTimeAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_DeviceTimeAns_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRejoinReq", wireType)
 			}
-			m.AntennaIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -16942,67 +21783,30 @@ func (m *GatewayAntennaIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AntennaIndex |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UplinkToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_ForceRejoinReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UplinkToken: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UplinkToken: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_ForceRejoinReq_{v}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAntennaIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17029,32 +21833,15 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GatewayAntennaIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RejoinParamSetupReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RejoinParamSetupReq_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17081,15 +21868,17 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ServerTime, dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RejoinParamSetupAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RejoinParamSetupAns_{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratorTime", wireType)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotInfoReq", wireType)
 			}
-			m.ConcentratorTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17099,69 +21888,32 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratorTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MACCommand_PingSlotInfoReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DownlinkPath: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DownlinkPath: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &MACCommand_PingSlotInfoReq_{v}
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17171,28 +21923,30 @@ func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Path = &DownlinkPath_UplinkToken{v}
+			v := &MACCommand_PingSlotChannelReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_PingSlotChannelReq_{v}
 			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fixed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17219,70 +21973,17 @@ func (m *DownlinkPath) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GatewayAntennaIdentifiers{}
+			v := &MACCommand_PingSlotChannelAns{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Path = &DownlinkPath_Fixed{v}
+			m.Payload = &MACCommand_PingSlotChannelAns_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconTimingAns", wireType)
 			}
-			m.Class = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17292,14 +21993,30 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_BeaconTimingAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_BeaconTimingAns_{v}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17326,16 +22043,17 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownlinkPaths = append(m.DownlinkPaths, &DownlinkPath{})
-			if err := m.DownlinkPaths[len(m.DownlinkPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_BeaconFreqReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_BeaconFreqReq_{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqAns", wireType)
 			}
-			m.Rx1Delay = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17345,35 +22063,32 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1Delay |= RxDelay(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Rx1DataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1DataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Frequency", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Rx1Frequency = 0
+			v := &MACCommand_BeaconFreqAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_BeaconFreqAns_{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeInd", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17383,16 +22098,32 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Rx2DataRateIndex = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_DeviceModeInd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DeviceModeInd_{v}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeConf", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17402,16 +22133,32 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Rx2Frequency = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_DeviceModeConf{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_DeviceModeConf_{v}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfReq", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17421,16 +22168,32 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
 			}
-			m.Priority = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayConfReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayConfReq_{v}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfAns", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17440,14 +22203,30 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= TxSchedulePriority(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayConfAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayConfAns_{v}
+			iNdEx = postIndex
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayEndDeviceConfReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17474,18 +22253,17 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbsoluteTime == nil {
-				m.AbsoluteTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AbsoluteTime, dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayEndDeviceConfReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayEndDeviceConfReq_{v}
 			iNdEx = postIndex
-		case 10:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayEndDeviceConfAns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17495,27 +22273,30 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			v := &MACCommand_RelayEndDeviceConfAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayEndDeviceConfAns_{v}
 			iNdEx = postIndex
-		case 99:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFilterListReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17542,71 +22323,17 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Advanced == nil {
-				m.Advanced = &types.Struct{}
-			}
-			if err := m.Advanced.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MACCommand_RelayFilterListReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Payload = &MACCommand_RelayFilterListReq_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACCommand: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACCommand: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFilterListAns", wireType)
 			}
-			m.CID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17616,16 +22343,32 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CID |= MACCommandIdentifier(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MACCommand_RelayFilterListAns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayFilterListAns_{v}
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayUpdateUplinkListReq", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17635,28 +22378,30 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Payload = &MACCommand_RawPayload{v}
+			v := &MACCommand_RelayUpdateUplinkListReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &MACCommand_RelayUpdateUplinkListReq_{v}
 			iNdEx = postIndex
-		case 3:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetInd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCtrlUplinkListReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17683,15 +22428,15 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_ResetInd{}
+			v := &MACCommand_RelayCtrlUplinkListReq{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &MACCommand_ResetInd_{v}
+			m.Payload = &MACCommand_RelayCtrlUplinkListReq_{v}
 			iNdEx = postIndex
-		case 4:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetConf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCtrlUplinkListAns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17718,15 +22463,15 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_ResetConf{}
+			v := &MACCommand_RelayCtrlUplinkListAns{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &MACCommand_ResetConf_{v}
+			m.Payload = &MACCommand_RelayCtrlUplinkListAns_{v}
 			iNdEx = postIndex
-		case 5:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkCheckAns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConfigureFwdLimitReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17753,15 +22498,15 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_LinkCheckAns{}
+			v := &MACCommand_RelayConfigureFwdLimitReq{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &MACCommand_LinkCheckAns_{v}
+			m.Payload = &MACCommand_RelayConfigureFwdLimitReq_{v}
 			iNdEx = postIndex
-		case 6:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRReq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayNotifyNewEndDeviceReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17788,17 +22533,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_LinkADRReq{}
+			v := &MACCommand_RelayNotifyNewEndDeviceReq{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &MACCommand_LinkADRReq_{v}
+			m.Payload = &MACCommand_RelayNotifyNewEndDeviceReq_{v}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRAns", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetInd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetInd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+			}
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17808,32 +22606,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_LinkADRAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_LinkADRAns_{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetConf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetConf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+			}
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17843,32 +22678,88 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_DutyCycleReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_DutyCycleReq_{v}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkCheckAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkCheckAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
 			}
-			var msglen int
+			m.GatewayCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17878,32 +22769,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GatewayCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RxParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_RxParamSetupReq_{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxParamSetupAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkADRReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkADRReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17913,32 +22841,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RxParamSetupAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RxParamSetupAns_{v}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevStatusAns", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
 			}
-			var msglen int
+			m.TxPowerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17948,32 +22860,86 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxPowerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DevStatusAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChannelMask = append(m.ChannelMask, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLorawan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLorawan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ChannelMask) == 0 {
+					m.ChannelMask = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLorawan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChannelMask = append(m.ChannelMask, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMask", wireType)
 			}
-			m.Payload = &MACCommand_DevStatusAns_{v}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelReq", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskControl", wireType)
 			}
-			var msglen int
+			m.ChannelMaskControl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -17983,32 +22949,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChannelMaskControl |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_NewChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_NewChannelReq_{v}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChannelAns", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
 			}
-			var msglen int
+			m.NbTrans = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18018,32 +22968,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NbTrans |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_NewChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &MACCommand_NewChannelAns_{v}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelReq", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkADRAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkADRAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18053,32 +23040,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DLChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DLChannelReq_{v}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLChannelAns", wireType)
+			m.ChannelMaskAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18088,32 +23060,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DLChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DLChannelAns_{v}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxTimingSetupReq", wireType)
+			m.DataRateIndexAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndexAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18123,32 +23080,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.TxPowerIndexAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RxTimingSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_RxTimingSetupReq_{v}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxParamSetupReq", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutyCycleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutyCycleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+			}
+			m.MaxDutyCycle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18158,32 +23153,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_TxParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_TxParamSetupReq_{v}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RekeyInd", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RxParamSetupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			}
+			m.Rx2DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18193,32 +23225,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RekeyInd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RekeyInd_{v}
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RekeyConf", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
 			}
-			var msglen int
+			m.Rx1DataRateOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18228,32 +23244,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx1DataRateOffset |= DataRateOffset(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RekeyConf{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RekeyConf_{v}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRParamSetupReq", wireType)
+				}
 			}
-			var msglen int
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			}
+			m.Rx2Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18263,32 +23263,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_ADRParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_ADRParamSetupReq_{v}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceTimeAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RxParamSetupAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RxParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndexAck", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18298,32 +23335,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_DeviceTimeAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_DeviceTimeAns_{v}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceRejoinReq", wireType)
+			m.Rx2DataRateIndexAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffsetAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18333,32 +23355,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_ForceRejoinReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_ForceRejoinReq_{v}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupReq", wireType)
+			m.Rx1DataRateOffsetAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2FrequencyAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18368,32 +23375,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Rx2FrequencyAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_RejoinParamSetupReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_RejoinParamSetupReq_{v}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinParamSetupAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevStatusAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevStatusAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18403,32 +23448,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Battery |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_RejoinParamSetupAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_RejoinParamSetupAns_{v}
-			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotInfoReq", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			var msglen int
+			m.Margin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18438,32 +23467,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Margin |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_PingSlotInfoReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Payload = &MACCommand_PingSlotInfoReq_{v}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelReq", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewChannelReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+			}
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18473,32 +23539,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_PingSlotChannelReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_PingSlotChannelReq_{v}
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotChannelAns", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var msglen int
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18508,32 +23558,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_PingSlotChannelAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_PingSlotChannelAns_{v}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconTimingAns", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
 			}
-			var msglen int
+			m.MinDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18543,32 +23577,16 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_BeaconTimingAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_BeaconTimingAns_{v}
-			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqReq", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
 			}
-			var msglen int
+			m.MaxDataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18578,32 +23596,69 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_BeaconFreqReq{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_BeaconFreqReq_{v}
-			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFreqAns", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewChannelAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18613,32 +23668,17 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MACCommand_BeaconFreqAns{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &MACCommand_BeaconFreqAns_{v}
-			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeInd", wireType)
+			m.FrequencyAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateAck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18648,32 +23688,70 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.DataRateAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MACCommand_DeviceModeInd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
 			}
-			m.Payload = &MACCommand_DeviceModeInd_{v}
-			iNdEx = postIndex
-		case 32:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceModeConf", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DLChannelReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DLChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
 			}
-			var msglen int
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18683,27 +23761,30 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLorawan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			v := &MACCommand_DeviceModeConf{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Payload = &MACCommand_DeviceModeConf_{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18728,7 +23809,7 @@ func (m *MACCommand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18751,17 +23832,17 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetInd: wiretype end group for non-group")
+			return fmt.Errorf("proto: DLChannelAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetInd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DLChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndexAck", wireType)
 			}
-			m.MinorVersion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18771,11 +23852,32 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChannelIndexAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18800,7 +23902,7 @@ func (m *MACCommand_ResetInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18823,17 +23925,17 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: RxTimingSetupReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RxTimingSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			m.MinorVersion = 0
+			m.Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18843,7 +23945,7 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				m.Delay |= RxDelay(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18872,7 +23974,7 @@ func (m *MACCommand_ResetConf) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18895,17 +23997,17 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkCheckAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxParamSetupReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkCheckAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRPIndex", wireType)
 			}
-			m.Margin = 0
+			m.MaxEIRPIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18915,16 +24017,16 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Margin |= uint32(b&0x7F) << shift
+				m.MaxEIRPIndex |= DeviceEIRP(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
 			}
-			m.GatewayCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -18934,11 +24036,32 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GatewayCount |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UplinkDwellTime = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DownlinkDwellTime = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -18963,7 +24086,7 @@ func (m *MACCommand_LinkCheckAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18986,17 +24109,17 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkADRReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RekeyInd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkADRReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RekeyInd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
 			}
-			m.DataRateIndex = 0
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19006,124 +24129,69 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TxPowerIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxPowerIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ChannelMask = append(m.ChannelMask, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLorawan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLorawan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ChannelMask) == 0 {
-					m.ChannelMask = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLorawan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChannelMask = append(m.ChannelMask, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMask", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskControl", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChannelMaskControl = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelMaskControl |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RekeyConf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RekeyConf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
 			}
-			m.NbTrans = 0
+			m.MinorVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19133,7 +24201,7 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbTrans |= uint32(b&0x7F) << shift
+				m.MinorVersion |= Minor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19162,7 +24230,7 @@ func (m *MACCommand_LinkADRReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19185,17 +24253,17 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkADRAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: ADRParamSetupReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkADRAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ADRParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMaskAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
 			}
-			var v int
+			m.ADRAckLimitExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19205,37 +24273,16 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ADRAckLimitExponent |= ADRAckLimitExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChannelMaskAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DataRateIndexAck = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
 			}
-			var v int
+			m.ADRAckDelayExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19245,12 +24292,11 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ADRAckDelayExponent |= ADRAckDelayExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TxPowerIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19275,7 +24321,7 @@ func (m *MACCommand_LinkADRAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19298,17 +24344,17 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DutyCycleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceTimeAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DutyCycleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceTimeAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.MaxDutyCycle = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19318,11 +24364,25 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19347,7 +24407,7 @@ func (m *MACCommand_DutyCycleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19370,17 +24430,17 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceRejoinReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceRejoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
 			}
-			m.Rx2DataRateIndex = 0
+			m.RejoinType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19390,16 +24450,16 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.RejoinType |= RejoinRequestType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			m.Rx1DataRateOffset = 0
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19409,16 +24469,16 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1DataRateOffset |= DataRateOffset(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
 			}
-			m.Rx2Frequency = 0
+			m.MaxRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19428,7 +24488,26 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodExponent", wireType)
+			}
+			m.PeriodExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodExponent |= RejoinPeriodExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19457,7 +24536,7 @@ func (m *MACCommand_RxParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19480,17 +24559,17 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxParamSetupAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejoinParamSetupReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejoinParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCountExponent", wireType)
 			}
-			var v int
+			m.MaxCountExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19500,37 +24579,16 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxCountExponent |= RejoinCountExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Rx2DataRateIndexAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffsetAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Rx1DataRateOffsetAck = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponent", wireType)
 			}
-			var v int
+			m.MaxTimeExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19540,12 +24598,11 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxTimeExponent |= RejoinTimeExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Rx2FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19570,7 +24627,7 @@ func (m *MACCommand_RxParamSetupAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19593,17 +24650,17 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DevStatusAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RejoinParamSetupAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DevStatusAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RejoinParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponentAck", wireType)
 			}
-			m.Battery = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19613,16 +24670,70 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Battery |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.MaxTimeExponentAck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingSlotInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingSlotInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			m.Margin = 0
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19632,7 +24743,7 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Margin |= int32(b&0x7F) << shift
+				m.Period |= PingSlotPeriod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19661,7 +24772,7 @@ func (m *MACCommand_DevStatusAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19684,32 +24795,13 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewChannelReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingSlotChannelReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingSlotChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
-			}
-			m.ChannelIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
@@ -19728,30 +24820,11 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
-			}
-			m.MinDataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			m.MaxDataRateIndex = 0
+			m.DataRateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19761,7 +24834,7 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19790,7 +24863,7 @@ func (m *MACCommand_NewChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19813,10 +24886,10 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingSlotChannelAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingSlotChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19841,7 +24914,7 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 			m.FrequencyAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19858,7 +24931,7 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DataRateAck = bool(v != 0)
+			m.DataRateIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19883,7 +24956,7 @@ func (m *MACCommand_NewChannelAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19906,17 +24979,17 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DLChannelReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconTimingAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconTimingAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			m.ChannelIndex = 0
+			m.Delay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19926,16 +24999,16 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
+				m.Delay |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
 			}
-			m.Frequency = 0
+			m.ChannelIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -19945,7 +25018,7 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.ChannelIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19974,7 +25047,7 @@ func (m *MACCommand_DLChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19997,37 +25070,17 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DLChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconFreqReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DLChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconFreqReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndexAck", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChannelIndexAck = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var v int
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20037,12 +25090,11 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20067,7 +25119,7 @@ func (m *MACCommand_DLChannelAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20090,17 +25142,17 @@ func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxTimingSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconFreqAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxTimingSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconFreqAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
 			}
-			m.Delay = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20110,11 +25162,12 @@ func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delay |= RxDelay(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20139,7 +25192,7 @@ func (m *MACCommand_RxTimingSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20162,56 +25215,17 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceModeInd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceModeInd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRPIndex", wireType)
-			}
-			m.MaxEIRPIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEIRPIndex |= DeviceEIRP(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLorawan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UplinkDwellTime = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			var v int
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20221,12 +25235,11 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DownlinkDwellTime = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20251,7 +25264,7 @@ func (m *MACCommand_TxParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20274,17 +25287,17 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RekeyInd: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceModeConf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RekeyInd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceModeConf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			m.MinorVersion = 0
+			m.Class = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20294,7 +25307,7 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				m.Class |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20323,7 +25336,7 @@ func (m *MACCommand_RekeyInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20346,17 +25359,75 @@ func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RekeyConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RekeyConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.MinorVersion = 0
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CadPeriodicity", wireType)
+			}
+			m.CadPeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CadPeriodicity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndex", wireType)
+			}
+			m.DefaultChannelIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultChannelIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20366,11 +25437,28 @@ func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinorVersion |= Minor(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20395,7 +25483,7 @@ func (m *MACCommand_RekeyConf) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20418,17 +25506,17 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ADRParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ADRParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelFrequencyAck", wireType)
 			}
-			m.ADRAckLimitExponent = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20438,16 +25526,17 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckLimitExponent |= ADRAckLimitExponent(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SecondChannelFrequencyAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelAckOffsetAck", wireType)
 			}
-			m.ADRAckDelayExponent = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20457,11 +25546,92 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckDelayExponent |= ADRAckDelayExponent(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChannelAckOffsetAck = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelDataRateIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChannelDataRateIndexAck = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChannelIndexAck = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndexAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultChannelIndexAck = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CadPeriodicityAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CadPeriodicityAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20486,7 +25656,7 @@ func (m *MACCommand_ADRParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayEndDeviceConfReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20509,15 +25679,72 @@ func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceTimeAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayEndDeviceConfReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceTimeAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayEndDeviceConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 7:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartEnableLevel", wireType)
+			}
+			m.SmartEnableLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmartEnableLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20544,7 +25771,10 @@ func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20572,7 +25802,7 @@ func (m *MACCommand_DeviceTimeAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayEndDeviceConfAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20595,17 +25825,17 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceRejoinReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayEndDeviceConfAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceRejoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayEndDeviceConfAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelFrequencyAck", wireType)
 			}
-			m.RejoinType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20615,16 +25845,17 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejoinType |= RejoinRequestType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SecondChannelFrequencyAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelDataRateIndexAck", wireType)
 			}
-			m.DataRateIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20634,16 +25865,17 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SecondChannelDataRateIndexAck = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannelIndexAck", wireType)
 			}
-			m.MaxRetries = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20653,16 +25885,17 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRetries |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SecondChannelIndexAck = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffAck", wireType)
 			}
-			m.PeriodExponent = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20672,11 +25905,12 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodExponent |= RejoinPeriodExponent(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BackoffAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20701,7 +25935,7 @@ func (m *MACCommand_ForceRejoinReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayFilterListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20724,17 +25958,17 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinParamSetupReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayFilterListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinParamSetupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayFilterListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCountExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.MaxCountExponent = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20744,16 +25978,16 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCountExponent |= RejoinCountExponent(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.MaxTimeExponent = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20763,11 +25997,45 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTimeExponent |= RejoinTimeExponent(b&0x7F) << shift
+				m.Action |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawJoinEuiDevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawJoinEuiDevEui = append(m.RawJoinEuiDevEui[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawJoinEuiDevEui == nil {
+				m.RawJoinEuiDevEui = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20792,7 +26060,7 @@ func (m *MACCommand_RejoinParamSetupReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayFilterListAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20811,19 +26079,59 @@ func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RejoinParamSetupAns: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RejoinParamSetupAns: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayFilterListAns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayFilterListAns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterListActionAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterListActionAck = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterListLengthAck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterListLengthAck = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeExponentAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedRulesAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20840,7 +26148,7 @@ func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MaxTimeExponentAck = bool(v != 0)
+			m.CombinedRulesAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20865,7 +26173,7 @@ func (m *MACCommand_RejoinParamSetupAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayUpdateUplinkListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20888,17 +26196,17 @@ func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayUpdateUplinkListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayUpdateUplinkListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Period = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20908,11 +26216,137 @@ func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= PingSlotPeriod(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkLimitBucketSize", wireType)
+			}
+			m.UplinkLimitBucketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkLimitBucketSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkLimitReloadRate", wireType)
+			}
+			m.UplinkLimitReloadRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkLimitReloadRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WFCnt", wireType)
+			}
+			m.WFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WFCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootWorSKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RootWorSKey == nil {
+				m.RootWorSKey = &KeyEnvelope{}
+			}
+			if err := m.RootWorSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -20937,7 +26371,7 @@ func (m *MACCommand_PingSlotInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayCtrlUplinkListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20960,17 +26394,17 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotChannelReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayCtrlUplinkListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotChannelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayCtrlUplinkListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Frequency = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20980,16 +26414,16 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.DataRateIndex = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -20999,7 +26433,7 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				m.Action |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21028,7 +26462,7 @@ func (m *MACCommand_PingSlotChannelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayCtrlUplinkListAns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21051,15 +26485,15 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotChannelAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayCtrlUplinkListAns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotChannelAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayCtrlUplinkListAns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAck", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21076,12 +26510,12 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
+			m.IndexAck = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndexAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WFCnt", wireType)
 			}
-			var v int
+			m.WFCnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21091,12 +26525,11 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WFCnt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DataRateIndexAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -21121,7 +26554,7 @@ func (m *MACCommand_PingSlotChannelAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayConfigureFwdLimitReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21144,17 +26577,17 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconTimingAns: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayConfigureFwdLimitReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconTimingAns: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayConfigureFwdLimitReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetLimitCounter", wireType)
 			}
-			m.Delay = 0
+			m.ResetLimitCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21164,16 +26597,124 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delay |= uint32(b&0x7F) << shift
+				m.ResetLimitCounter |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinRequestLimits == nil {
+				m.JoinRequestLimits = &RelayForwardLimits{}
+			}
+			if err := m.JoinRequestLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifyLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotifyLimits == nil {
+				m.NotifyLimits = &RelayForwardLimits{}
+			}
+			if err := m.NotifyLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalUplinkLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalUplinkLimits == nil {
+				m.GlobalUplinkLimits = &RelayForwardLimits{}
 			}
-			m.ChannelIndex = 0
+			if err := m.GlobalUplinkLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverallLimits", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21183,11 +26724,28 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelIndex |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OverallLimits == nil {
+				m.OverallLimits = &RelayForwardLimits{}
+			}
+			if err := m.OverallLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -21212,7 +26770,7 @@ func (m *MACCommand_BeaconTimingAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21235,17 +26793,17 @@ func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconFreqReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayNotifyNewEndDeviceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconFreqReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayNotifyNewEndDeviceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
 			}
-			m.Frequency = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21255,69 +26813,49 @@ func (m *MACCommand_BeaconFreqReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLorawan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthLorawan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLorawan
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SNR = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SNR |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconFreqAns: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconFreqAns: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
 			}
-			var v int
+			m.RSSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21327,12 +26865,11 @@ func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RSSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FrequencyAck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -21357,7 +26894,7 @@ func (m *MACCommand_BeaconFreqAns) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
+func (m *FrequencyValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21380,17 +26917,17 @@ func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceModeInd: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrequencyValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceModeInd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrequencyValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Class = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21400,7 +26937,7 @@ func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21429,7 +26966,7 @@ func (m *MACCommand_DeviceModeInd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
+func (m *DataRateOffsetValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21452,17 +26989,17 @@ func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceModeConf: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRateOffsetValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceModeConf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRateOffsetValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Class = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -21472,7 +27009,7 @@ func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Class |= Class(b&0x7F) << shift
+				m.Value |= DataRateOffset(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21501,7 +27038,7 @@ func (m *MACCommand_DeviceModeConf) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FrequencyValue) Unmarshal(dAtA []byte) error {
+func (m *DataRateIndexValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21524,10 +27061,10 @@ func (m *FrequencyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FrequencyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRateIndexValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FrequencyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRateIndexValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21544,7 +27081,7 @@ func (m *FrequencyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				m.Value |= DataRateIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21573,7 +27110,7 @@ func (m *FrequencyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataRateOffsetValue) Unmarshal(dAtA []byte) error {
+func (m *PingSlotPeriodValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21596,10 +27133,10 @@ func (m *DataRateOffsetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataRateOffsetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingSlotPeriodValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRateOffsetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingSlotPeriodValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21616,7 +27153,7 @@ func (m *DataRateOffsetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= DataRateOffset(b&0x7F) << shift
+				m.Value |= PingSlotPeriod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21645,7 +27182,7 @@ func (m *DataRateOffsetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataRateIndexValue) Unmarshal(dAtA []byte) error {
+func (m *AggregatedDutyCycleValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21668,10 +27205,10 @@ func (m *DataRateIndexValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataRateIndexValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedDutyCycleValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRateIndexValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedDutyCycleValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21688,7 +27225,7 @@ func (m *DataRateIndexValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= DataRateIndex(b&0x7F) << shift
+				m.Value |= AggregatedDutyCycle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21717,7 +27254,7 @@ func (m *DataRateIndexValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingSlotPeriodValue) Unmarshal(dAtA []byte) error {
+func (m *RxDelayValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21740,10 +27277,10 @@ func (m *PingSlotPeriodValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingSlotPeriodValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RxDelayValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingSlotPeriodValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RxDelayValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21760,7 +27297,7 @@ func (m *PingSlotPeriodValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= PingSlotPeriod(b&0x7F) << shift
+				m.Value |= RxDelay(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21789,7 +27326,7 @@ func (m *PingSlotPeriodValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregatedDutyCycleValue) Unmarshal(dAtA []byte) error {
+func (m *ADRAckLimitExponentValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21812,10 +27349,10 @@ func (m *AggregatedDutyCycleValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregatedDutyCycleValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ADRAckLimitExponentValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregatedDutyCycleValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ADRAckLimitExponentValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21832,7 +27369,7 @@ func (m *AggregatedDutyCycleValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AggregatedDutyCycle(b&0x7F) << shift
+				m.Value |= ADRAckLimitExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21861,7 +27398,7 @@ func (m *AggregatedDutyCycleValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RxDelayValue) Unmarshal(dAtA []byte) error {
+func (m *ADRAckDelayExponentValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21884,10 +27421,10 @@ func (m *RxDelayValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RxDelayValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ADRAckDelayExponentValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RxDelayValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ADRAckDelayExponentValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21904,7 +27441,7 @@ func (m *RxDelayValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= RxDelay(b&0x7F) << shift
+				m.Value |= ADRAckDelayExponent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21933,7 +27470,7 @@ func (m *RxDelayValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ADRAckLimitExponentValue) Unmarshal(dAtA []byte) error {
+func (m *DeviceEIRPValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21956,10 +27493,10 @@ func (m *ADRAckLimitExponentValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ADRAckLimitExponentValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceEIRPValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ADRAckLimitExponentValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceEIRPValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21976,7 +27513,7 @@ func (m *ADRAckLimitExponentValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= ADRAckLimitExponent(b&0x7F) << shift
+				m.Value |= DeviceEIRP(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22005,7 +27542,7 @@ func (m *ADRAckLimitExponentValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ADRAckDelayExponentValue) Unmarshal(dAtA []byte) error {
+func (m *RelaySecondChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22028,17 +27565,17 @@ func (m *ADRAckDelayExponentValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ADRAckDelayExponentValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelaySecondChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ADRAckDelayExponentValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelaySecondChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			m.Value = 0
+			m.Frequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -22048,7 +27585,45 @@ func (m *ADRAckDelayExponentValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= ADRAckDelayExponent(b&0x7F) << shift
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckOffset", wireType)
+			}
+			m.AckOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckOffset |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22077,7 +27652,7 @@ func (m *ADRAckDelayExponentValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeviceEIRPValue) Unmarshal(dAtA []byte) error {
+func (m *RelayForwardLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22100,17 +27675,17 @@ func (m *DeviceEIRPValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceEIRPValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayForwardLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceEIRPValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayForwardLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
 			}
-			m.Value = 0
+			m.BucketSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLorawan
@@ -22120,7 +27695,26 @@ func (m *DeviceEIRPValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= DeviceEIRP(b&0x7F) << shift
+				m.BucketSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloadRate", wireType)
+			}
+			m.ReloadRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReloadRate |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -393,6 +393,76 @@ var MACCommandFieldPathsNested = []string{
 	"payload.rekey_conf.minor_version",
 	"payload.rekey_ind",
 	"payload.rekey_ind.minor_version",
+	"payload.relay_conf_ans",
+	"payload.relay_conf_ans.cad_periodicity_ack",
+	"payload.relay_conf_ans.default_channel_index_ack",
+	"payload.relay_conf_ans.second_channel_ack_offset_ack",
+	"payload.relay_conf_ans.second_channel_data_rate_index_ack",
+	"payload.relay_conf_ans.second_channel_frequency_ack",
+	"payload.relay_conf_ans.second_channel_index_ack",
+	"payload.relay_conf_req",
+	"payload.relay_conf_req.cad_periodicity",
+	"payload.relay_conf_req.default_channel_index",
+	"payload.relay_conf_req.enabled",
+	"payload.relay_conf_req.second_channel",
+	"payload.relay_conf_req.second_channel.ack_offset",
+	"payload.relay_conf_req.second_channel.data_rate_index",
+	"payload.relay_conf_req.second_channel.frequency",
+	"payload.relay_configure_fwd_limit_req",
+	"payload.relay_configure_fwd_limit_req.global_uplink_limits",
+	"payload.relay_configure_fwd_limit_req.global_uplink_limits.bucket_size",
+	"payload.relay_configure_fwd_limit_req.global_uplink_limits.reload_rate",
+	"payload.relay_configure_fwd_limit_req.join_request_limits",
+	"payload.relay_configure_fwd_limit_req.join_request_limits.bucket_size",
+	"payload.relay_configure_fwd_limit_req.join_request_limits.reload_rate",
+	"payload.relay_configure_fwd_limit_req.notify_limits",
+	"payload.relay_configure_fwd_limit_req.notify_limits.bucket_size",
+	"payload.relay_configure_fwd_limit_req.notify_limits.reload_rate",
+	"payload.relay_configure_fwd_limit_req.overall_limits",
+	"payload.relay_configure_fwd_limit_req.overall_limits.bucket_size",
+	"payload.relay_configure_fwd_limit_req.overall_limits.reload_rate",
+	"payload.relay_configure_fwd_limit_req.reset_limit_counter",
+	"payload.relay_ctrl_uplink_list_ans",
+	"payload.relay_ctrl_uplink_list_ans.index_ack",
+	"payload.relay_ctrl_uplink_list_ans.w_f_cnt",
+	"payload.relay_ctrl_uplink_list_req",
+	"payload.relay_ctrl_uplink_list_req.action",
+	"payload.relay_ctrl_uplink_list_req.index",
+	"payload.relay_end_device_conf_ans",
+	"payload.relay_end_device_conf_ans.backoff_ack",
+	"payload.relay_end_device_conf_ans.second_channel_data_rate_index_ack",
+	"payload.relay_end_device_conf_ans.second_channel_frequency_ack",
+	"payload.relay_end_device_conf_ans.second_channel_index_ack",
+	"payload.relay_end_device_conf_req",
+	"payload.relay_end_device_conf_req.backoff",
+	"payload.relay_end_device_conf_req.mode",
+	"payload.relay_end_device_conf_req.second_channel",
+	"payload.relay_end_device_conf_req.second_channel.ack_offset",
+	"payload.relay_end_device_conf_req.second_channel.data_rate_index",
+	"payload.relay_end_device_conf_req.second_channel.frequency",
+	"payload.relay_end_device_conf_req.smart_enable_level",
+	"payload.relay_filter_list_ans",
+	"payload.relay_filter_list_ans.combined_rules_ack",
+	"payload.relay_filter_list_ans.filter_list_action_ack",
+	"payload.relay_filter_list_ans.filter_list_length_ack",
+	"payload.relay_filter_list_req",
+	"payload.relay_filter_list_req.action",
+	"payload.relay_filter_list_req.index",
+	"payload.relay_filter_list_req.raw_join_eui_dev_eui",
+	"payload.relay_notify_new_end_device_req",
+	"payload.relay_notify_new_end_device_req.dev_addr",
+	"payload.relay_notify_new_end_device_req.rssi",
+	"payload.relay_notify_new_end_device_req.snr",
+	"payload.relay_update_uplink_list_req",
+	"payload.relay_update_uplink_list_req.dev_addr",
+	"payload.relay_update_uplink_list_req.index",
+	"payload.relay_update_uplink_list_req.root_wor_s_key",
+	"payload.relay_update_uplink_list_req.root_wor_s_key.encrypted_key",
+	"payload.relay_update_uplink_list_req.root_wor_s_key.kek_label",
+	"payload.relay_update_uplink_list_req.root_wor_s_key.key",
+	"payload.relay_update_uplink_list_req.uplink_limit_bucket_size",
+	"payload.relay_update_uplink_list_req.uplink_limit_reload_rate",
+	"payload.relay_update_uplink_list_req.w_f_cnt",
 	"payload.reset_conf",
 	"payload.reset_conf.minor_version",
 	"payload.reset_ind",
@@ -480,6 +550,28 @@ var DeviceEIRPValueFieldPathsNested = []string{
 var DeviceEIRPValueFieldPathsTopLevel = []string{
 	"value",
 }
+
+var RelaySecondChannelFieldPathsNested = []string{
+	"ack_offset",
+	"data_rate_index",
+	"frequency",
+}
+
+var RelaySecondChannelFieldPathsTopLevel = []string{
+	"ack_offset",
+	"data_rate_index",
+	"frequency",
+}
+
+var RelayForwardLimitsFieldPathsNested = []string{
+	"bucket_size",
+	"reload_rate",
+}
+
+var RelayForwardLimitsFieldPathsTopLevel = []string{
+	"bucket_size",
+	"reload_rate",
+}
 var TxSettings_DownlinkFieldPathsNested = []string{
 	"antenna_index",
 	"invert_polarization",
@@ -757,3 +849,170 @@ var MACCommand_DeviceModeConfFieldPathsNested = []string{
 var MACCommand_DeviceModeConfFieldPathsTopLevel = []string{
 	"class",
 }
+
+var MACCommand_RelayConfReqFieldPathsNested = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"enabled",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+}
+
+var MACCommand_RelayConfReqFieldPathsTopLevel = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"enabled",
+	"second_channel",
+}
+
+var MACCommand_RelayConfAnsFieldPathsNested = []string{
+	"cad_periodicity_ack",
+	"default_channel_index_ack",
+	"second_channel_ack_offset_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayConfAnsFieldPathsTopLevel = []string{
+	"cad_periodicity_ack",
+	"default_channel_index_ack",
+	"second_channel_ack_offset_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayEndDeviceConfReqFieldPathsNested = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"smart_enable_level",
+}
+
+var MACCommand_RelayEndDeviceConfReqFieldPathsTopLevel = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"smart_enable_level",
+}
+
+var MACCommand_RelayEndDeviceConfAnsFieldPathsNested = []string{
+	"backoff_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayEndDeviceConfAnsFieldPathsTopLevel = []string{
+	"backoff_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayFilterListReqFieldPathsNested = []string{
+	"action",
+	"index",
+	"raw_join_eui_dev_eui",
+}
+
+var MACCommand_RelayFilterListReqFieldPathsTopLevel = []string{
+	"action",
+	"index",
+	"raw_join_eui_dev_eui",
+}
+
+var MACCommand_RelayFilterListAnsFieldPathsNested = []string{
+	"combined_rules_ack",
+	"filter_list_action_ack",
+	"filter_list_length_ack",
+}
+
+var MACCommand_RelayFilterListAnsFieldPathsTopLevel = []string{
+	"combined_rules_ack",
+	"filter_list_action_ack",
+	"filter_list_length_ack",
+}
+
+var MACCommand_RelayUpdateUplinkListReqFieldPathsNested = []string{
+	"dev_addr",
+	"index",
+	"root_wor_s_key",
+	"root_wor_s_key.encrypted_key",
+	"root_wor_s_key.kek_label",
+	"root_wor_s_key.key",
+	"uplink_limit_bucket_size",
+	"uplink_limit_reload_rate",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayUpdateUplinkListReqFieldPathsTopLevel = []string{
+	"dev_addr",
+	"index",
+	"root_wor_s_key",
+	"uplink_limit_bucket_size",
+	"uplink_limit_reload_rate",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayCtrlUplinkListReqFieldPathsNested = []string{
+	"action",
+	"index",
+}
+
+var MACCommand_RelayCtrlUplinkListReqFieldPathsTopLevel = []string{
+	"action",
+	"index",
+}
+
+var MACCommand_RelayCtrlUplinkListAnsFieldPathsNested = []string{
+	"index_ack",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayCtrlUplinkListAnsFieldPathsTopLevel = []string{
+	"index_ack",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayConfigureFwdLimitReqFieldPathsNested = []string{
+	"global_uplink_limits",
+	"global_uplink_limits.bucket_size",
+	"global_uplink_limits.reload_rate",
+	"join_request_limits",
+	"join_request_limits.bucket_size",
+	"join_request_limits.reload_rate",
+	"notify_limits",
+	"notify_limits.bucket_size",
+	"notify_limits.reload_rate",
+	"overall_limits",
+	"overall_limits.bucket_size",
+	"overall_limits.reload_rate",
+	"reset_limit_counter",
+}
+
+var MACCommand_RelayConfigureFwdLimitReqFieldPathsTopLevel = []string{
+	"global_uplink_limits",
+	"join_request_limits",
+	"notify_limits",
+	"overall_limits",
+	"reset_limit_counter",
+}
+
+var MACCommand_RelayNotifyNewEndDeviceReqFieldPathsNested = []string{
+	"dev_addr",
+	"rssi",
+	"snr",
+}
+
+var MACCommand_RelayNotifyNewEndDeviceReqFieldPathsTopLevel = []string{
+	"dev_addr",
+	"rssi",
+	"snr",
+}
@@ -2299,6 +2299,369 @@ func (dst *MACCommand) SetFields(src *MACCommand, paths ...string) error {
 							dst.Payload = nil
 						}
 					}
+				case "relay_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						} else {
+							newDst = &MACCommand_RelayConfReq{}
+							dst.Payload = &MACCommand_RelayConfReq_{RelayConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						} else {
+							newDst = &MACCommand_RelayConfAns{}
+							dst.Payload = &MACCommand_RelayConfAns_{RelayConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfReq{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfReq_{RelayEndDeviceConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfAns{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfAns_{RelayEndDeviceConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						} else {
+							newDst = &MACCommand_RelayFilterListReq{}
+							dst.Payload = &MACCommand_RelayFilterListReq_{RelayFilterListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						} else {
+							newDst = &MACCommand_RelayFilterListAns{}
+							dst.Payload = &MACCommand_RelayFilterListAns_{RelayFilterListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_update_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayUpdateUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayUpdateUplinkListReq{}
+							dst.Payload = &MACCommand_RelayUpdateUplinkListReq_{RelayUpdateUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListReq{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListReq_{RelayCtrlUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListAns{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListAns_{RelayCtrlUplinkListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_configure_fwd_limit_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_configure_fwd_limit_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfigureFwdLimitReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfigureFwdLimitReq_).RelayConfigureFwdLimitReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfigureFwdLimitReq_).RelayConfigureFwdLimitReq
+						} else {
+							newDst = &MACCommand_RelayConfigureFwdLimitReq{}
+							dst.Payload = &MACCommand_RelayConfigureFwdLimitReq_{RelayConfigureFwdLimitReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_notify_new_end_device_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayNotifyNewEndDeviceReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						} else {
+							newDst = &MACCommand_RelayNotifyNewEndDeviceReq{}
+							dst.Payload = &MACCommand_RelayNotifyNewEndDeviceReq_{RelayNotifyNewEndDeviceReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -2501,10 +2864,82 @@ func (dst *DeviceEIRPValue) SetFields(src *DeviceEIRPValue, paths ...string) err
 	return nil
 }
 
-func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+func (dst *RelaySecondChannel) SetFields(src *RelaySecondChannel, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "antenna_index":
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "ack_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ack_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AckOffset = src.AckOffset
+			} else {
+				var zero uint32
+				dst.AckOffset = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayForwardLimits) SetFields(src *RelayForwardLimits, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "bucket_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'bucket_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BucketSize = src.BucketSize
+			} else {
+				var zero uint32
+				dst.BucketSize = zero
+			}
+		case "reload_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reload_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReloadRate = src.ReloadRate
+			} else {
+				var zero uint32
+				dst.ReloadRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "antenna_index":
 			if len(subs) > 0 {
 				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
 			}
@@ -3450,3 +3885,648 @@ func (dst *MACCommand_DeviceModeConf) SetFields(src *MACCommand_DeviceModeConf,
 	}
 	return nil
 }
+
+func (dst *MACCommand_RelayConfReq) SetFields(src *MACCommand_RelayConfReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "enabled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'enabled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Enabled = src.Enabled
+			} else {
+				var zero bool
+				dst.Enabled = zero
+			}
+		case "cad_periodicity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicity = src.CadPeriodicity
+			} else {
+				var zero uint32
+				dst.CadPeriodicity = zero
+			}
+		case "default_channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
+			} else {
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayConfAns) SetFields(src *MACCommand_RelayConfAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "second_channel_frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
+			} else {
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
+			}
+		case "second_channel_ack_offset_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_ack_offset_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelAckOffsetAck = src.SecondChannelAckOffsetAck
+			} else {
+				var zero bool
+				dst.SecondChannelAckOffsetAck = zero
+			}
+		case "second_channel_data_rate_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
+			} else {
+				var zero bool
+				dst.SecondChannelDataRateIndexAck = zero
+			}
+		case "second_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelIndexAck = src.SecondChannelIndexAck
+			} else {
+				var zero bool
+				dst.SecondChannelIndexAck = zero
+			}
+		case "default_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndexAck = src.DefaultChannelIndexAck
+			} else {
+				var zero bool
+				dst.DefaultChannelIndexAck = zero
+			}
+		case "cad_periodicity_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicityAck = src.CadPeriodicityAck
+			} else {
+				var zero bool
+				dst.CadPeriodicityAck = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayEndDeviceConfReq) SetFields(src *MACCommand_RelayEndDeviceConfReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mode":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mode' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mode = src.Mode
+			} else {
+				var zero uint32
+				dst.Mode = zero
+			}
+		case "smart_enable_level":
+			if len(subs) > 0 {
+				return fmt.Errorf("'smart_enable_level' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SmartEnableLevel = src.SmartEnableLevel
+			} else {
+				var zero uint32
+				dst.SmartEnableLevel = zero
+			}
+		case "backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Backoff = src.Backoff
+			} else {
+				var zero uint32
+				dst.Backoff = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayEndDeviceConfAns) SetFields(src *MACCommand_RelayEndDeviceConfAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "second_channel_frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
+			} else {
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
+			}
+		case "second_channel_data_rate_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
+			} else {
+				var zero bool
+				dst.SecondChannelDataRateIndexAck = zero
+			}
+		case "second_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelIndexAck = src.SecondChannelIndexAck
+			} else {
+				var zero bool
+				dst.SecondChannelIndexAck = zero
+			}
+		case "backoff_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'backoff_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BackoffAck = src.BackoffAck
+			} else {
+				var zero bool
+				dst.BackoffAck = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayFilterListReq) SetFields(src *MACCommand_RelayFilterListReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Index = src.Index
+			} else {
+				var zero uint32
+				dst.Index = zero
+			}
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero uint32
+				dst.Action = zero
+			}
+		case "raw_join_eui_dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_join_eui_dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawJoinEuiDevEui = src.RawJoinEuiDevEui
+			} else {
+				dst.RawJoinEuiDevEui = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayFilterListAns) SetFields(src *MACCommand_RelayFilterListAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "filter_list_action_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filter_list_action_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FilterListActionAck = src.FilterListActionAck
+			} else {
+				var zero bool
+				dst.FilterListActionAck = zero
+			}
+		case "filter_list_length_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filter_list_length_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FilterListLengthAck = src.FilterListLengthAck
+			} else {
+				var zero bool
+				dst.FilterListLengthAck = zero
+			}
+		case "combined_rules_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'combined_rules_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CombinedRulesAck = src.CombinedRulesAck
+			} else {
+				var zero bool
+				dst.CombinedRulesAck = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayUpdateUplinkListReq) SetFields(src *MACCommand_RelayUpdateUplinkListReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Index = src.Index
+			} else {
+				var zero uint32
+				dst.Index = zero
+			}
+		case "uplink_limit_bucket_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_limit_bucket_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkLimitBucketSize = src.UplinkLimitBucketSize
+			} else {
+				var zero uint32
+				dst.UplinkLimitBucketSize = zero
+			}
+		case "uplink_limit_reload_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_limit_reload_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkLimitReloadRate = src.UplinkLimitReloadRate
+			} else {
+				var zero uint32
+				dst.UplinkLimitReloadRate = zero
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WFCnt = src.WFCnt
+			} else {
+				var zero uint32
+				dst.WFCnt = zero
+			}
+		case "root_wor_s_key":
+			if len(subs) > 0 {
+				var newDst, newSrc *KeyEnvelope
+				if (src == nil || src.RootWorSKey == nil) && dst.RootWorSKey == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RootWorSKey
+				}
+				if dst.RootWorSKey != nil {
+					newDst = dst.RootWorSKey
+				} else {
+					newDst = &KeyEnvelope{}
+					dst.RootWorSKey = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RootWorSKey = src.RootWorSKey
+				} else {
+					dst.RootWorSKey = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayCtrlUplinkListReq) SetFields(src *MACCommand_RelayCtrlUplinkListReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Index = src.Index
+			} else {
+				var zero uint32
+				dst.Index = zero
+			}
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero uint32
+				dst.Action = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayCtrlUplinkListAns) SetFields(src *MACCommand_RelayCtrlUplinkListAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IndexAck = src.IndexAck
+			} else {
+				var zero bool
+				dst.IndexAck = zero
+			}
+		case "w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WFCnt = src.WFCnt
+			} else {
+				var zero uint32
+				dst.WFCnt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayConfigureFwdLimitReq) SetFields(src *MACCommand_RelayConfigureFwdLimitReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "reset_limit_counter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reset_limit_counter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResetLimitCounter = src.ResetLimitCounter
+			} else {
+				var zero uint32
+				dst.ResetLimitCounter = zero
+			}
+		case "join_request_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.JoinRequestLimits == nil) && dst.JoinRequestLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.JoinRequestLimits
+				}
+				if dst.JoinRequestLimits != nil {
+					newDst = dst.JoinRequestLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.JoinRequestLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinRequestLimits = src.JoinRequestLimits
+				} else {
+					dst.JoinRequestLimits = nil
+				}
+			}
+		case "notify_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.NotifyLimits == nil) && dst.NotifyLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.NotifyLimits
+				}
+				if dst.NotifyLimits != nil {
+					newDst = dst.NotifyLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.NotifyLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.NotifyLimits = src.NotifyLimits
+				} else {
+					dst.NotifyLimits = nil
+				}
+			}
+		case "global_uplink_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.GlobalUplinkLimits == nil) && dst.GlobalUplinkLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GlobalUplinkLimits
+				}
+				if dst.GlobalUplinkLimits != nil {
+					newDst = dst.GlobalUplinkLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.GlobalUplinkLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GlobalUplinkLimits = src.GlobalUplinkLimits
+				} else {
+					dst.GlobalUplinkLimits = nil
+				}
+			}
+		case "overall_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.OverallLimits == nil) && dst.OverallLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OverallLimits
+				}
+				if dst.OverallLimits != nil {
+					newDst = dst.OverallLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.OverallLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OverallLimits = src.OverallLimits
+				} else {
+					dst.OverallLimits = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_RelayNotifyNewEndDeviceReq) SetFields(src *MACCommand_RelayNotifyNewEndDeviceReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				var zero int32
+				dst.SNR = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RSSI = src.RSSI
+			} else {
+				var zero int32
+				dst.RSSI = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
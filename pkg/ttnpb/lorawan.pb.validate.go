@@ -2075,7 +2075,7 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 		case "payload":
 			if len(subs) == 0 {
 				subs = []string{
-					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf",
+					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf", "relay_conf_req", "relay_conf_ans", "relay_end_device_conf_req", "relay_end_device_conf_ans", "relay_filter_list_req", "relay_filter_list_ans", "relay_update_uplink_list_req", "relay_ctrl_uplink_list_req", "relay_ctrl_uplink_list_ans", "relay_configure_fwd_limit_req", "relay_notify_new_end_device_req",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -2567,6 +2567,182 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "relay_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_update_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayUpdateUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_update_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_configure_fwd_limit_req":
+					w, ok := m.Payload.(*MACCommand_RelayConfigureFwdLimitReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfigureFwdLimitReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_configure_fwd_limit_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_notify_new_end_device_req":
+					w, ok := m.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayNotifyNewEndDeviceReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_notify_new_end_device_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -3444,29 +3620,50 @@ var _ interface {
 	ErrorName() string
 } = DeviceEIRPValueValidationError{}
 
-// ValidateFields checks the field values on TxSettings_Downlink with the rules
+// ValidateFields checks the field values on RelaySecondChannel with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
+func (m *RelaySecondChannel) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = TxSettings_DownlinkFieldPathsNested
+		paths = RelaySecondChannelFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "antenna_index":
-			// no validation rules for AntennaIndex
-		case "tx_power":
-			// no validation rules for TxPower
-		case "invert_polarization":
-			// no validation rules for InvertPolarization
+		case "frequency":
+
+			if m.GetFrequency() < 100000 {
+				return RelaySecondChannelValidationError{
+					field:  "frequency",
+					reason: "value must be greater than or equal to 100000",
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return RelaySecondChannelValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "ack_offset":
+
+			if m.GetAckOffset() > 7 {
+				return RelaySecondChannelValidationError{
+					field:  "ack_offset",
+					reason: "value must be less than or equal to 7",
+				}
+			}
+
 		default:
-			return TxSettings_DownlinkValidationError{
+			return RelaySecondChannelValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3475,9 +3672,9 @@ func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// TxSettings_DownlinkValidationError is the validation error returned by
-// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
-type TxSettings_DownlinkValidationError struct {
+// RelaySecondChannelValidationError is the validation error returned by
+// RelaySecondChannel.ValidateFields if the designated constraints aren't met.
+type RelaySecondChannelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3485,24 +3682,24 @@ type TxSettings_DownlinkValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
+func (e RelaySecondChannelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
+func (e RelaySecondChannelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
+func (e RelaySecondChannelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
+func (e RelaySecondChannelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxSettings_DownlinkValidationError) ErrorName() string {
-	return "TxSettings_DownlinkValidationError"
+func (e RelaySecondChannelValidationError) ErrorName() string {
+	return "RelaySecondChannelValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TxSettings_DownlinkValidationError) Error() string {
+func (e RelaySecondChannelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3514,14 +3711,14 @@ func (e TxSettings_DownlinkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxSettings_Downlink.%s: %s%s",
+		"invalid %sRelaySecondChannel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxSettings_DownlinkValidationError{}
+var _ error = RelaySecondChannelValidationError{}
 
 var _ interface {
 	Field() string
@@ -3529,41 +3726,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxSettings_DownlinkValidationError{}
+} = RelaySecondChannelValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetInd with the rules
+// ValidateFields checks the field values on RelayForwardLimits with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+func (m *RelayForwardLimits) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetIndFieldPathsNested
+		paths = RelayForwardLimitsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
+		case "bucket_size":
 
-			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
+			if m.GetBucketSize() > 3 {
+				return RelayForwardLimitsValidationError{
+					field:  "bucket_size",
+					reason: "value must be less than or equal to 3",
 				}
 			}
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
-					reason: "value must be one of the defined enum values",
+		case "reload_rate":
+
+			if m.GetReloadRate() > 127 {
+				return RelayForwardLimitsValidationError{
+					field:  "reload_rate",
+					reason: "value must be less than or equal to 127",
 				}
 			}
 
 		default:
-			return MACCommand_ResetIndValidationError{
+			return RelayForwardLimitsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3572,9 +3771,9 @@ func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetIndValidationError is the validation error returned by
-// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetIndValidationError struct {
+// RelayForwardLimitsValidationError is the validation error returned by
+// RelayForwardLimits.ValidateFields if the designated constraints aren't met.
+type RelayForwardLimitsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3582,24 +3781,24 @@ type MACCommand_ResetIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+func (e RelayForwardLimitsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+func (e RelayForwardLimitsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+func (e RelayForwardLimitsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+func (e RelayForwardLimitsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetIndValidationError) ErrorName() string {
-	return "MACCommand_ResetIndValidationError"
+func (e RelayForwardLimitsValidationError) ErrorName() string {
+	return "RelayForwardLimitsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ResetIndValidationError) Error() string {
+func (e RelayForwardLimitsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3611,14 +3810,14 @@ func (e MACCommand_ResetIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		"invalid %sRelayForwardLimits.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ResetIndValidationError{}
+var _ error = RelayForwardLimitsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3626,45 +3825,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ResetIndValidationError{}
-
-var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
-	1: {},
-}
+} = RelayForwardLimitsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetConf with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on TxSettings_Downlink with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetConfFieldPathsNested
+		paths = TxSettings_DownlinkFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
-				}
-			}
-
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "antenna_index":
+			// no validation rules for AntennaIndex
+		case "tx_power":
+			// no validation rules for TxPower
+		case "invert_polarization":
+			// no validation rules for InvertPolarization
 		default:
-			return MACCommand_ResetConfValidationError{
+			return TxSettings_DownlinkValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3673,9 +3858,9 @@ func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetConfValidationError is the validation error returned by
-// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetConfValidationError struct {
+// TxSettings_DownlinkValidationError is the validation error returned by
+// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
+type TxSettings_DownlinkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3683,21 +3868,219 @@ type MACCommand_ResetConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetConfValidationError) ErrorName() string {
-	return "MACCommand_ResetConfValidationError"
-}
+func (e TxSettings_DownlinkValidationError) ErrorName() string {
+	return "TxSettings_DownlinkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxSettings_DownlinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxSettings_Downlink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxSettings_DownlinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxSettings_DownlinkValidationError{}
+
+// ValidateFields checks the field values on MACCommand_ResetInd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetIndFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetIndValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetIndValidationError is the validation error returned by
+// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetIndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetIndValidationError) ErrorName() string {
+	return "MACCommand_ResetIndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ResetIndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ResetIndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ResetIndValidationError{}
+
+var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
+	1: {},
+}
+
+// ValidateFields checks the field values on MACCommand_ResetConf with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetConfFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetConfValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetConfValidationError is the validation error returned by
+// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetConfValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetConfValidationError) ErrorName() string {
+	return "MACCommand_ResetConfValidationError"
+}
 
 // Error satisfies the builtin error interface
 func (e MACCommand_ResetConfValidationError) Error() string {
@@ -6404,3 +6787,1176 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MACCommand_DeviceModeConfValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayConfReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RelayConfReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayConfReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "enabled":
+			// no validation rules for Enabled
+		case "cad_periodicity":
+
+			if m.GetCadPeriodicity() > 5 {
+				return MACCommand_RelayConfReqValidationError{
+					field:  "cad_periodicity",
+					reason: "value must be less than or equal to 5",
+				}
+			}
+
+		case "default_channel_index":
+
+			if m.GetDefaultChannelIndex() > 1 {
+				return MACCommand_RelayConfReqValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 1",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfReqValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACCommand_RelayConfReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayConfReqValidationError is the validation error returned by
+// MACCommand_RelayConfReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_RelayConfReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayConfReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayConfReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayConfReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayConfReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayConfReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayConfReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayConfReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayConfReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayConfReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayConfAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RelayConfAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayConfAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_ack_offset_ack":
+			// no validation rules for SecondChannelAckOffsetAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "second_channel_index_ack":
+			// no validation rules for SecondChannelIndexAck
+		case "default_channel_index_ack":
+			// no validation rules for DefaultChannelIndexAck
+		case "cad_periodicity_ack":
+			// no validation rules for CadPeriodicityAck
+		default:
+			return MACCommand_RelayConfAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayConfAnsValidationError is the validation error returned by
+// MACCommand_RelayConfAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_RelayConfAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayConfAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayConfAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayConfAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayConfAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayConfAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayConfAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayConfAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayConfAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayConfAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfReq
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayEndDeviceConfReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayEndDeviceConfReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mode":
+
+			if m.GetMode() > 3 {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "mode",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "smart_enable_level":
+
+			if m.GetSmartEnableLevel() > 3 {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "smart_enable_level",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "backoff":
+
+			if m.GetBackoff() > 63 {
+				return MACCommand_RelayEndDeviceConfReqValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayEndDeviceConfReqValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACCommand_RelayEndDeviceConfReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayEndDeviceConfReqValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayEndDeviceConfReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayEndDeviceConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayEndDeviceConfReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayEndDeviceConfReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayEndDeviceConfReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfAns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayEndDeviceConfAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayEndDeviceConfAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "second_channel_index_ack":
+			// no validation rules for SecondChannelIndexAck
+		case "backoff_ack":
+			// no validation rules for BackoffAck
+		default:
+			return MACCommand_RelayEndDeviceConfAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayEndDeviceConfAnsValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayEndDeviceConfAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayEndDeviceConfAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayEndDeviceConfAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayEndDeviceConfAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayFilterListReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RelayFilterListReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayFilterListReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "index":
+
+			if m.GetIndex() > 15 {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "action":
+
+			if m.GetAction() > 2 {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "action",
+					reason: "value must be less than or equal to 2",
+				}
+			}
+
+		case "raw_join_eui_dev_eui":
+
+			if l := len(m.GetRawJoinEuiDevEui()); l < 1 || l > 16 {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "raw_join_eui_dev_eui",
+					reason: "value length must be between 1 and 16 bytes, inclusive",
+				}
+			}
+
+		default:
+			return MACCommand_RelayFilterListReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayFilterListReqValidationError is the validation error
+// returned by MACCommand_RelayFilterListReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RelayFilterListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayFilterListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayFilterListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayFilterListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayFilterListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayFilterListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayFilterListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayFilterListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayFilterListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayFilterListReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayFilterListAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RelayFilterListAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayFilterListAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "filter_list_action_ack":
+			// no validation rules for FilterListActionAck
+		case "filter_list_length_ack":
+			// no validation rules for FilterListLengthAck
+		case "combined_rules_ack":
+			// no validation rules for CombinedRulesAck
+		default:
+			return MACCommand_RelayFilterListAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayFilterListAnsValidationError is the validation error
+// returned by MACCommand_RelayFilterListAns.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RelayFilterListAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayFilterListAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayFilterListAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayFilterListAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayFilterListAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayFilterListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayFilterListAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayFilterListAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayFilterListAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayFilterListAnsValidationError{}
+
+// ValidateFields checks the field values on
+// MACCommand_RelayUpdateUplinkListReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayUpdateUplinkListReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayUpdateUplinkListReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "index":
+
+			if m.GetIndex() > 15 {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "uplink_limit_bucket_size":
+
+			if m.GetUplinkLimitBucketSize() > 3 {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "uplink_limit_bucket_size",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "uplink_limit_reload_rate":
+
+			if m.GetUplinkLimitReloadRate() > 63 {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "uplink_limit_reload_rate",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "w_f_cnt":
+			// no validation rules for WFCnt
+		case "root_wor_s_key":
+
+			if m.GetRootWorSKey() == nil {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "root_wor_s_key",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRootWorSKey()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayUpdateUplinkListReqValidationError{
+						field:  "root_wor_s_key",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACCommand_RelayUpdateUplinkListReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayUpdateUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayUpdateUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayUpdateUplinkListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayUpdateUplinkListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayUpdateUplinkListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayUpdateUplinkListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayUpdateUplinkListReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListReq
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayCtrlUplinkListReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayCtrlUplinkListReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "index":
+
+			if m.GetIndex() > 15 {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "action":
+
+			if m.GetAction() > 1 {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "action",
+					reason: "value must be less than or equal to 1",
+				}
+			}
+
+		default:
+			return MACCommand_RelayCtrlUplinkListReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayCtrlUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayCtrlUplinkListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayCtrlUplinkListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayCtrlUplinkListReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListAns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayCtrlUplinkListAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayCtrlUplinkListAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "index_ack":
+			// no validation rules for IndexAck
+		case "w_f_cnt":
+			// no validation rules for WFCnt
+		default:
+			return MACCommand_RelayCtrlUplinkListAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayCtrlUplinkListAnsValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayCtrlUplinkListAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayCtrlUplinkListAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayCtrlUplinkListAnsValidationError{}
+
+// ValidateFields checks the field values on
+// MACCommand_RelayConfigureFwdLimitReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayConfigureFwdLimitReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayConfigureFwdLimitReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "reset_limit_counter":
+
+			if m.GetResetLimitCounter() > 3 {
+				return MACCommand_RelayConfigureFwdLimitReqValidationError{
+					field:  "reset_limit_counter",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "join_request_limits":
+
+			if v, ok := interface{}(m.GetJoinRequestLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "join_request_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notify_limits":
+
+			if v, ok := interface{}(m.GetNotifyLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "notify_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "global_uplink_limits":
+
+			if v, ok := interface{}(m.GetGlobalUplinkLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "global_uplink_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "overall_limits":
+
+			if v, ok := interface{}(m.GetOverallLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfigureFwdLimitReqValidationError{
+						field:  "overall_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACCommand_RelayConfigureFwdLimitReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayConfigureFwdLimitReqValidationError is the validation error
+// returned by MACCommand_RelayConfigureFwdLimitReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayConfigureFwdLimitReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) ErrorName() string {
+	return "MACCommand_RelayConfigureFwdLimitReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayConfigureFwdLimitReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayConfigureFwdLimitReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayConfigureFwdLimitReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayConfigureFwdLimitReqValidationError{}
+
+// ValidateFields checks the field values on
+// MACCommand_RelayNotifyNewEndDeviceReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RelayNotifyNewEndDeviceReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "snr":
+
+			if val := m.GetSNR(); val < -20 || val > 11 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "snr",
+					reason: "value must be inside range [-20, 11]",
+				}
+			}
+
+		case "rssi":
+
+			if val := m.GetRSSI(); val < -127 || val > 0 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "rssi",
+					reason: "value must be inside range [-127, 0]",
+				}
+			}
+
+		default:
+			return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RelayNotifyNewEndDeviceReqValidationError is the validation error
+// returned by MACCommand_RelayNotifyNewEndDeviceReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayNotifyNewEndDeviceReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) ErrorName() string {
+	return "MACCommand_RelayNotifyNewEndDeviceReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RelayNotifyNewEndDeviceReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
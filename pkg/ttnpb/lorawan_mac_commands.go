@@ -328,3 +328,113 @@ func (pld *MACCommand_DeviceModeConf) MACCommand() *MACCommand {
 		},
 	}
 }
+
+// MACCommand returns the RelayConfReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayConfReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_CONF,
+		Payload: &MACCommand_RelayConfReq_{
+			RelayConfReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayConfAns MAC command as a *MACCommand.
+func (pld *MACCommand_RelayConfAns) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_CONF,
+		Payload: &MACCommand_RelayConfAns_{
+			RelayConfAns: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayEndDeviceConfReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayEndDeviceConfReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_END_DEVICE_CONF,
+		Payload: &MACCommand_RelayEndDeviceConfReq_{
+			RelayEndDeviceConfReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayEndDeviceConfAns MAC command as a *MACCommand.
+func (pld *MACCommand_RelayEndDeviceConfAns) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_END_DEVICE_CONF,
+		Payload: &MACCommand_RelayEndDeviceConfAns_{
+			RelayEndDeviceConfAns: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayFilterListReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayFilterListReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_FILTER_LIST,
+		Payload: &MACCommand_RelayFilterListReq_{
+			RelayFilterListReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayFilterListAns MAC command as a *MACCommand.
+func (pld *MACCommand_RelayFilterListAns) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_FILTER_LIST,
+		Payload: &MACCommand_RelayFilterListAns_{
+			RelayFilterListAns: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayUpdateUplinkListReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayUpdateUplinkListReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_UPDATE_UPLINK_LIST,
+		Payload: &MACCommand_RelayUpdateUplinkListReq_{
+			RelayUpdateUplinkListReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayCtrlUplinkListReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayCtrlUplinkListReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_CTRL_UPLINK_LIST,
+		Payload: &MACCommand_RelayCtrlUplinkListReq_{
+			RelayCtrlUplinkListReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayCtrlUplinkListAns MAC command as a *MACCommand.
+func (pld *MACCommand_RelayCtrlUplinkListAns) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_CTRL_UPLINK_LIST,
+		Payload: &MACCommand_RelayCtrlUplinkListAns_{
+			RelayCtrlUplinkListAns: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayConfigureFwdLimitReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayConfigureFwdLimitReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_CONFIGURE_FWD_LIMIT,
+		Payload: &MACCommand_RelayConfigureFwdLimitReq_{
+			RelayConfigureFwdLimitReq: pld,
+		},
+	}
+}
+
+// MACCommand returns the RelayNotifyNewEndDeviceReq MAC command as a *MACCommand.
+func (pld *MACCommand_RelayNotifyNewEndDeviceReq) MACCommand() *MACCommand {
+	return &MACCommand{
+		CID: CID_RELAY_NOTIFY_NEW_END_DEVICE,
+		Payload: &MACCommand_RelayNotifyNewEndDeviceReq_{
+			RelayNotifyNewEndDeviceReq: pld,
+		},
+	}
+}
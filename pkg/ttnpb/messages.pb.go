@@ -44,7 +44,7 @@ const (
 	PayloadFormatter_FORMATTER_NONE PayloadFormatter = 0
 	// Use payload formatter for the end device type from a repository.
 	PayloadFormatter_FORMATTER_REPOSITORY PayloadFormatter = 1
-	// gRPC service payload formatter. The parameter is the host:port of the service.
+	// gRPC service payload formatter. The parameter is the host:port of the service, prefixed with grpc:// to connect without TLS.
 	PayloadFormatter_FORMATTER_GRPC_SERVICE PayloadFormatter = 2
 	// Custom payload formatter that executes Javascript code. The parameter is a JavaScript filename.
 	PayloadFormatter_FORMATTER_JAVASCRIPT PayloadFormatter = 3
@@ -4,16 +4,21 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_v3_pkg_types "go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -28,6 +33,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -74,806 +80,7763 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
-	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+type SimulateADRRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The MAC settings to simulate the ADR algorithm with.
+	MACSettings *MACSettings `protobuf:"bytes,2,opt,name=mac_settings,json=macSettings,proto3" json:"mac_settings,omitempty"`
+	// The names of the MAC settings fields that override the MAC settings of the end device.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+func (m *SimulateADRRequest) Reset()      { *m = SimulateADRRequest{} }
+func (*SimulateADRRequest) ProtoMessage() {}
+func (*SimulateADRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+func (m *SimulateADRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x41, 0x48, 0x2b, 0x47,
-	0x18, 0xc7, 0x77, 0xa2, 0xd8, 0x32, 0x88, 0xa5, 0x53, 0xb1, 0x6d, 0xda, 0x4e, 0x25, 0xb6, 0xb4,
-	0x58, 0xdc, 0x85, 0x78, 0x28, 0xd8, 0x53, 0x24, 0x69, 0x2c, 0x54, 0xa9, 0xd1, 0x16, 0xea, 0x25,
-	0x4c, 0x76, 0x3f, 0x37, 0x4b, 0x36, 0x33, 0xeb, 0xce, 0x24, 0x69, 0x28, 0x82, 0xf4, 0x50, 0x3c,
-	0x0a, 0xa5, 0xd0, 0x63, 0x2f, 0x05, 0x8f, 0xd2, 0x4b, 0x3d, 0x94, 0xe2, 0xd1, 0x53, 0x11, 0xde,
-	0x45, 0xde, 0xe1, 0x61, 0x76, 0xdf, 0xc1, 0xa3, 0x47, 0x8f, 0x8f, 0x6c, 0x12, 0x63, 0xb2, 0xe6,
-	0xa1, 0xef, 0x3d, 0xde, 0x6d, 0x26, 0xdf, 0x7f, 0xfe, 0xf3, 0x9b, 0xff, 0xf7, 0x2d, 0xc1, 0x9f,
-	0xba, 0xc2, 0x67, 0x0d, 0xc6, 0x17, 0xa4, 0x62, 0x66, 0xc5, 0x60, 0x9e, 0x63, 0x70, 0x50, 0x0d,
-	0xe1, 0x57, 0x24, 0xf8, 0x75, 0xf0, 0x75, 0xcf, 0x17, 0x4a, 0x90, 0x29, 0xa5, 0xb8, 0xde, 0x95,
-	0xea, 0xf5, 0xc5, 0xe4, 0x82, 0xed, 0xa8, 0x72, 0xad, 0xa4, 0x9b, 0xa2, 0x6a, 0xd8, 0xc2, 0x16,
-	0x46, 0x24, 0x2b, 0xd5, 0xb6, 0xa3, 0x5d, 0xb4, 0x89, 0x56, 0x9d, 0xe3, 0xc9, 0x0f, 0x6d, 0x21,
-	0x6c, 0x17, 0x22, 0x7b, 0xc6, 0xb9, 0x50, 0x4c, 0x39, 0x82, 0xcb, 0x6e, 0xf5, 0x83, 0x6e, 0xf5,
-	0xc6, 0x03, 0xaa, 0x9e, 0x6a, 0x76, 0x8b, 0xa9, 0x38, 0x20, 0x70, 0xab, 0x68, 0x41, 0xdd, 0x31,
-	0xa1, 0xab, 0x99, 0x8b, 0x6b, 0x1c, 0x0b, 0xb8, 0x72, 0xb6, 0x1d, 0xf0, 0x7b, 0xb7, 0xcc, 0xc6,
-	0x45, 0x55, 0x90, 0x92, 0xd9, 0xd0, 0x55, 0xa4, 0x76, 0xf0, 0xbb, 0x79, 0xe0, 0xe0, 0x33, 0x05,
-	0x59, 0xa8, 0x67, 0x2c, 0xcb, 0x2f, 0x80, 0xf4, 0x04, 0x97, 0x40, 0x7e, 0xc0, 0x6f, 0x5a, 0x50,
-	0x2f, 0x32, 0xcb, 0xf2, 0xdf, 0x43, 0xb3, 0xe8, 0xf3, 0xc9, 0xe5, 0xaf, 0x1e, 0x3f, 0xf9, 0xf8,
-	0x4b, 0x5b, 0xe8, 0xaa, 0x0c, 0xaa, 0xec, 0x70, 0x5b, 0xea, 0xdd, 0xdc, 0x8c, 0xc1, 0x7b, 0xea,
-	0x8b, 0x86, 0x57, 0xb1, 0x0d, 0xd5, 0xf4, 0x40, 0xea, 0x3d, 0xdb, 0x37, 0xac, 0xce, 0x22, 0xcd,
-	0x71, 0x62, 0x4d, 0x92, 0x32, 0x7e, 0x6b, 0xe8, 0x62, 0x32, 0xa3, 0x77, 0x42, 0xd1, 0x7b, 0xa1,
-	0xe8, 0xb9, 0x76, 0x28, 0xc9, 0xcf, 0xf4, 0xc1, 0x4e, 0xe8, 0x23, 0x88, 0x53, 0xd3, 0xbf, 0x3c,
-	0x7a, 0xfa, 0x5b, 0x62, 0x8a, 0x4c, 0x1a, 0x5c, 0x1a, 0x3d, 0xf6, 0xf4, 0x41, 0x02, 0x8f, 0x67,
-	0xe4, 0x9a, 0x24, 0x9b, 0x78, 0x3a, 0x2b, 0x1a, 0xdc, 0x75, 0x78, 0x65, 0xbd, 0x06, 0x35, 0x28,
-	0x80, 0xe7, 0x32, 0x13, 0xc8, 0x27, 0xc3, 0xfe, 0x43, 0xaa, 0x9d, 0x1a, 0x48, 0x95, 0x1c, 0x41,
-	0x47, 0xd6, 0xf1, 0xdb, 0x03, 0xfa, 0xef, 0x6a, 0xb2, 0xfc, 0x92, 0x96, 0xc5, 0x21, 0xcb, 0x6f,
-	0x1d, 0xa9, 0xe2, 0x96, 0x39, 0x6e, 0x65, 0xa3, 0x89, 0xf8, 0xa6, 0xdf, 0xf7, 0x64, 0x4c, 0x95,
-	0xf1, 0x3c, 0xd7, 0x31, 0xa3, 0xd9, 0xeb, 0x79, 0xca, 0xf4, 0x21, 0xc2, 0xe3, 0xf9, 0x76, 0x24,
-	0x39, 0x3c, 0xb9, 0xc2, 0xb8, 0xe5, 0xc2, 0xf7, 0x5e, 0xbb, 0x42, 0x3e, 0x1a, 0x3e, 0xde, 0xf9,
-	0x7d, 0xb5, 0x33, 0x34, 0x23, 0x81, 0x7f, 0xc4, 0x33, 0x05, 0xf0, 0x84, 0xaf, 0x36, 0x7f, 0xca,
-	0x98, 0x15, 0x2e, 0x1a, 0x2e, 0x58, 0x76, 0x15, 0xb8, 0x22, 0xf1, 0xde, 0x31, 0x05, 0x0d, 0xd6,
-	0x1c, 0x16, 0x8e, 0xb2, 0x4e, 0xff, 0x3b, 0x81, 0xdf, 0x59, 0x93, 0x37, 0x6f, 0x2d, 0x80, 0xed,
-	0x48, 0xe5, 0x37, 0xc9, 0xdf, 0x08, 0x8f, 0xe5, 0x41, 0x91, 0xb9, 0xf8, 0x70, 0xa8, 0x5b, 0xea,
-	0x4e, 0xd0, 0xef, 0x8f, 0xcc, 0x2e, 0x55, 0x89, 0x66, 0x06, 0x88, 0xd9, 0x9e, 0x19, 0xd6, 0x0f,
-	0x4b, 0x1a, 0x3f, 0xf7, 0xbf, 0xb9, 0xa2, 0x63, 0x49, 0xfd, 0x56, 0xf1, 0x8e, 0xfd, 0xae, 0xd1,
-	0x91, 0xc6, 0xcf, 0xdd, 0x2c, 0x77, 0xc9, 0xaf, 0x09, 0x3c, 0xb6, 0x71, 0x17, 0xf4, 0xc6, 0xc3,
-	0xa0, 0xff, 0x43, 0x11, 0xf5, 0x3f, 0x28, 0xf9, 0x5c, 0x6c, 0xfd, 0x05, 0xb1, 0xf5, 0x41, 0xec,
-	0x25, 0x34, 0xbf, 0xb5, 0x9a, 0x5a, 0x79, 0x55, 0x37, 0x2d, 0xa1, 0x79, 0xf2, 0x3f, 0xc2, 0xd3,
-	0x05, 0x90, 0xa0, 0xbe, 0x66, 0xa6, 0x12, 0x7e, 0x33, 0x0b, 0xdb, 0xac, 0xe6, 0x2a, 0x49, 0xbe,
-	0x18, 0x7e, 0x74, 0xa4, 0xca, 0x70, 0xeb, 0x81, 0x6d, 0xe5, 0x51, 0x40, 0xe5, 0xf4, 0xeb, 0x68,
-	0x6b, 0xfb, 0x41, 0xbf, 0x23, 0x3c, 0x91, 0x05, 0x17, 0x14, 0xdc, 0xf3, 0x43, 0x1d, 0x31, 0xef,
-	0xa9, 0xd5, 0x08, 0x3c, 0x3f, 0x9f, 0x8b, 0x83, 0xdf, 0x9b, 0xb4, 0x8f, 0xb6, 0xfc, 0x17, 0x3a,
-	0x6d, 0x51, 0x74, 0xd6, 0xa2, 0xe8, 0xbc, 0x45, 0xb5, 0x8b, 0x16, 0xd5, 0x2e, 0x5b, 0x54, 0xbb,
-	0x6a, 0x51, 0xed, 0xba, 0x45, 0xd1, 0x5e, 0x40, 0xd1, 0x7e, 0x40, 0xb5, 0xc3, 0x80, 0xa2, 0xa3,
-	0x80, 0x6a, 0xc7, 0x01, 0xd5, 0x4e, 0x02, 0xaa, 0x9d, 0x06, 0x14, 0x9d, 0x05, 0x14, 0x9d, 0x07,
-	0x54, 0xbb, 0x08, 0x28, 0xba, 0x0c, 0xa8, 0x76, 0x15, 0x50, 0x74, 0x1d, 0x50, 0x6d, 0x2f, 0xa4,
-	0xda, 0x7e, 0x48, 0xd1, 0x41, 0x48, 0xb5, 0x3f, 0x42, 0x8a, 0xfe, 0x0c, 0xa9, 0x76, 0x18, 0x52,
-	0xed, 0x28, 0xa4, 0xe8, 0x38, 0xa4, 0xe8, 0x24, 0xa4, 0x68, 0xcb, 0x78, 0xc0, 0xbf, 0x84, 0xe2,
-	0x5e, 0xa9, 0x34, 0x11, 0xc5, 0xb0, 0xf8, 0x2c, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xe4, 0xa0, 0x43,
-	0x9c, 0x07, 0x00, 0x00,
+func (m *SimulateADRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateADRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateADRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateADRRequest.Merge(m, src)
+}
+func (m *SimulateADRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateADRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateADRRequest.DiscardUnknown(m)
 }
 
-func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_SimulateADRRequest proto.InternalMessageInfo
 
-	that1, ok := that.(*GenerateDevAddrResponse)
-	if !ok {
-		that2, ok := that.(GenerateDevAddrResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SimulateADRRequest) GetMACSettings() *MACSettings {
+	if m != nil {
+		return m.MACSettings
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *SimulateADRRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if that1.DevAddr == nil {
-		if this.DevAddr != nil {
-			return false
+	return types.FieldMask{}
+}
+
+// ADRSimulation is the result of replaying the recent uplinks of an end device through the ADR algorithm.
+// The SNR of the recent uplinks is assumed to be independent of the data rate, and is corrected for the
+// difference in TX output power.
+type ADRSimulation struct {
+	Steps []*ADRSimulation_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Total time-on-air of the recorded uplinks.
+	RecordedAirtime time.Duration `protobuf:"bytes,2,opt,name=recorded_airtime,json=recordedAirtime,proto3,stdduration" json:"recorded_airtime"`
+	// Total time-on-air of the simulated uplinks.
+	SimulatedAirtime time.Duration `protobuf:"bytes,3,opt,name=simulated_airtime,json=simulatedAirtime,proto3,stdduration" json:"simulated_airtime"`
+	// Total radiated energy (mJ) of the recorded uplinks.
+	// The current TX output power index and number of transmissions of the end device are assumed.
+	RecordedEnergy float32 `protobuf:"fixed32,4,opt,name=recorded_energy,json=recordedEnergy,proto3" json:"recorded_energy,omitempty"`
+	// Total radiated energy (mJ) of the simulated uplinks.
+	SimulatedEnergy      float32  `protobuf:"fixed32,5,opt,name=simulated_energy,json=simulatedEnergy,proto3" json:"simulated_energy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ADRSimulation) Reset()      { *m = ADRSimulation{} }
+func (*ADRSimulation) ProtoMessage() {}
+func (*ADRSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{2}
+}
+func (m *ADRSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.DevAddr.Equal(*that1.DevAddr) {
-		return false
+		return b[:n], nil
 	}
-	return true
+}
+func (m *ADRSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSimulation.Merge(m, src)
+}
+func (m *ADRSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSimulation.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ADRSimulation proto.InternalMessageInfo
 
-// NsClient is the client API for Ns service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsClient interface {
-	// GenerateDevAddr requests a device address assignment from the Network Server.
-	GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error)
+func (m *ADRSimulation) GetSteps() []*ADRSimulation_Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
 }
 
-type nsClient struct {
-	cc *grpc.ClientConn
+func (m *ADRSimulation) GetRecordedAirtime() time.Duration {
+	if m != nil {
+		return m.RecordedAirtime
+	}
+	return 0
 }
 
-func NewNsClient(cc *grpc.ClientConn) NsClient {
-	return &nsClient{cc}
+func (m *ADRSimulation) GetSimulatedAirtime() time.Duration {
+	if m != nil {
+		return m.SimulatedAirtime
+	}
+	return 0
 }
 
-func (c *nsClient) GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error) {
-	out := new(GenerateDevAddrResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/GenerateDevAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ADRSimulation) GetRecordedEnergy() float32 {
+	if m != nil {
+		return m.RecordedEnergy
 	}
-	return out, nil
+	return 0
 }
 
-// NsServer is the server API for Ns service.
-type NsServer interface {
-	// GenerateDevAddr requests a device address assignment from the Network Server.
-	GenerateDevAddr(context.Context, *types.Empty) (*GenerateDevAddrResponse, error)
+func (m *ADRSimulation) GetSimulatedEnergy() float32 {
+	if m != nil {
+		return m.SimulatedEnergy
+	}
+	return 0
 }
 
-// UnimplementedNsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsServer struct {
+type ADRSimulation_Step struct {
+	// Frame counter of the uplink.
+	FCnt uint32 `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// The data rate index used for the uplink.
+	DataRateIndex DataRateIndex `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// The TX output power index used for the uplink.
+	TxPowerIndex uint32 `protobuf:"varint,3,opt,name=tx_power_index,json=txPowerIndex,proto3" json:"tx_power_index,omitempty"`
+	// The number of transmissions of the uplink.
+	NbTrans uint32 `protobuf:"varint,4,opt,name=nb_trans,json=nbTrans,proto3" json:"nb_trans,omitempty"`
+	// Time-on-air of the uplink, including retransmissions.
+	Airtime time.Duration `protobuf:"bytes,5,opt,name=airtime,proto3,stdduration" json:"airtime"`
+	// Radiated energy (mJ) of the uplink, including retransmissions.
+	Energy float32 `protobuf:"fixed32,6,opt,name=energy,proto3" json:"energy,omitempty"`
+	// The ADR decision made after the uplink, if any.
+	Decision *ADRDecision `protobuf:"bytes,7,opt,name=decision,proto3" json:"decision,omitempty"`
+	// Whether the Network Server would send a LinkADRReq after the uplink.
+	LinkADRReq           bool     `protobuf:"varint,8,opt,name=link_adr_req,json=linkAdrReq,proto3" json:"link_adr_req,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedNsServer) GenerateDevAddr(ctx context.Context, req *types.Empty) (*GenerateDevAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+func (m *ADRSimulation_Step) Reset()      { *m = ADRSimulation_Step{} }
+func (*ADRSimulation_Step) ProtoMessage() {}
+func (*ADRSimulation_Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{2, 0}
+}
+func (m *ADRSimulation_Step) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ADRSimulation_Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ADRSimulation_Step.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ADRSimulation_Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRSimulation_Step.Merge(m, src)
+}
+func (m *ADRSimulation_Step) XXX_Size() int {
+	return m.Size()
+}
+func (m *ADRSimulation_Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRSimulation_Step.DiscardUnknown(m)
 }
 
-func RegisterNsServer(s *grpc.Server, srv NsServer) {
-	s.RegisterService(&_Ns_serviceDesc, srv)
+var xxx_messageInfo_ADRSimulation_Step proto.InternalMessageInfo
+
+func (m *ADRSimulation_Step) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
 }
 
-func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ADRSimulation_Step) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	if interceptor == nil {
-		return srv.(NsServer).GenerateDevAddr(ctx, in)
+	return DATA_RATE_0
+}
+
+func (m *ADRSimulation_Step) GetTxPowerIndex() uint32 {
+	if m != nil {
+		return m.TxPowerIndex
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Ns/GenerateDevAddr",
+	return 0
+}
+
+func (m *ADRSimulation_Step) GetNbTrans() uint32 {
+	if m != nil {
+		return m.NbTrans
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GenerateDevAddr(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *ADRSimulation_Step) GetAirtime() time.Duration {
+	if m != nil {
+		return m.Airtime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Ns_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Ns",
-	HandlerType: (*NsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenerateDevAddr",
-			Handler:    _Ns_GenerateDevAddr_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+func (m *ADRSimulation_Step) GetEnergy() float32 {
+	if m != nil {
+		return m.Energy
+	}
+	return 0
 }
 
-// AsNsClient is the client API for AsNs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsNsClient interface {
-	// Replace the entire downlink queue with the specified messages.
-	// This can also be used to empty the queue by specifying no messages.
-	// Note that this will trigger an immediate downlink if a downlink slot is available.
-	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// Push downlink messages to the end of the downlink queue.
-	// Note that this will trigger an immediate downlink if a downlink slot is available.
-	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// List the items currently in the downlink queue.
-	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+func (m *ADRSimulation_Step) GetDecision() *ADRDecision {
+	if m != nil {
+		return m.Decision
+	}
+	return nil
 }
 
-type asNsClient struct {
-	cc *grpc.ClientConn
+func (m *ADRSimulation_Step) GetLinkADRReq() bool {
+	if m != nil {
+		return m.LinkADRReq
+	}
+	return false
 }
 
-func NewAsNsClient(cc *grpc.ClientConn) AsNsClient {
-	return &asNsClient{cc}
+type GetDownlinkSchedulingAttemptsRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Maximum number of attempts to return, most recent first. If zero, all stored attempts are returned.
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *asNsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetDownlinkSchedulingAttemptsRequest) Reset()      { *m = GetDownlinkSchedulingAttemptsRequest{} }
+func (*GetDownlinkSchedulingAttemptsRequest) ProtoMessage() {}
+func (*GetDownlinkSchedulingAttemptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{3}
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDownlinkSchedulingAttemptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDownlinkSchedulingAttemptsRequest.Merge(m, src)
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDownlinkSchedulingAttemptsRequest.DiscardUnknown(m)
 }
 
-func (c *asNsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueuePush", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDownlinkSchedulingAttemptsRequest proto.InternalMessageInfo
+
+func (m *GetDownlinkSchedulingAttemptsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *asNsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
-	out := new(ApplicationDownlinks)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueList", in, out, opts...)
-	if err != nil {
-		return nil, err
+// DownlinkSchedulingAttempt describes an attempt of the Network Server to schedule a downlink message.
+type DownlinkSchedulingAttempt struct {
+	AttemptedAt time.Time `protobuf:"bytes,1,opt,name=attempted_at,json=attemptedAt,proto3,stdtime" json:"attempted_at"`
+	Class       Class     `protobuf:"varint,2,opt,name=class,proto3,enum=ttn.lorawan.v3.Class" json:"class,omitempty"`
+	// The time at which the Network Server intended the downlink message to be transmitted.
+	// For class A downlink messages, this is the start of the RX1 window.
+	Slot       *time.Time `protobuf:"bytes,3,opt,name=slot,proto3,stdtime" json:"slot,omitempty"`
+	AttemptRx1 bool       `protobuf:"varint,4,opt,name=attempt_rx1,json=attemptRx1,proto3" json:"attempt_rx1,omitempty"`
+	AttemptRx2 bool       `protobuf:"varint,5,opt,name=attempt_rx2,json=attemptRx2,proto3" json:"attempt_rx2,omitempty"`
+	// The candidate downlink paths, in order of preference.
+	Paths []*DownlinkSchedulingAttempt_Path `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
+	// The MAC commands piggybacked on the downlink message.
+	MACCommands []MACCommandIdentifier `protobuf:"varint,7,rep,packed,name=mac_commands,json=macCommands,proto3,enum=ttn.lorawan.v3.MACCommandIdentifier" json:"mac_commands,omitempty"`
+	// The RX window chosen by the Gateway Server, if scheduling succeeded. Zero if the downlink message is not a
+	// class A downlink message.
+	RxWindow uint32 `protobuf:"varint,8,opt,name=rx_window,json=rxWindow,proto3" json:"rx_window,omitempty"`
+	// The error of the attempt, if scheduling failed.
+	Error                *ErrorDetails `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CorrelationIDs       []string      `protobuf:"bytes,10,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DownlinkSchedulingAttempt) Reset()      { *m = DownlinkSchedulingAttempt{} }
+func (*DownlinkSchedulingAttempt) ProtoMessage() {}
+func (*DownlinkSchedulingAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{4}
+}
+func (m *DownlinkSchedulingAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkSchedulingAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkSchedulingAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DownlinkSchedulingAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkSchedulingAttempt.Merge(m, src)
+}
+func (m *DownlinkSchedulingAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkSchedulingAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkSchedulingAttempt.DiscardUnknown(m)
 }
 
-// AsNsServer is the server API for AsNs service.
-type AsNsServer interface {
-	// Replace the entire downlink queue with the specified messages.
-	// This can also be used to empty the queue by specifying no messages.
-	// Note that this will trigger an immediate downlink if a downlink slot is available.
-	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	// Push downlink messages to the end of the downlink queue.
-	// Note that this will trigger an immediate downlink if a downlink slot is available.
-	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	// List the items currently in the downlink queue.
-	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+var xxx_messageInfo_DownlinkSchedulingAttempt proto.InternalMessageInfo
+
+func (m *DownlinkSchedulingAttempt) GetAttemptedAt() time.Time {
+	if m != nil {
+		return m.AttemptedAt
+	}
+	return time.Time{}
 }
 
-// UnimplementedAsNsServer can be embedded to have forward compatible implementations.
-type UnimplementedAsNsServer struct {
+func (m *DownlinkSchedulingAttempt) GetClass() Class {
+	if m != nil {
+		return m.Class
+	}
+	return CLASS_A
 }
 
-func (*UnimplementedAsNsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+func (m *DownlinkSchedulingAttempt) GetSlot() *time.Time {
+	if m != nil {
+		return m.Slot
+	}
+	return nil
 }
-func (*UnimplementedAsNsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+
+func (m *DownlinkSchedulingAttempt) GetAttemptRx1() bool {
+	if m != nil {
+		return m.AttemptRx1
+	}
+	return false
 }
-func (*UnimplementedAsNsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+
+func (m *DownlinkSchedulingAttempt) GetAttemptRx2() bool {
+	if m != nil {
+		return m.AttemptRx2
+	}
+	return false
 }
 
-func RegisterAsNsServer(s *grpc.Server, srv AsNsServer) {
-	s.RegisterService(&_AsNs_serviceDesc, srv)
+func (m *DownlinkSchedulingAttempt) GetPaths() []*DownlinkSchedulingAttempt_Path {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
 }
 
-func _AsNs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueueReplace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+func (m *DownlinkSchedulingAttempt) GetMACCommands() []MACCommandIdentifier {
+	if m != nil {
+		return m.MACCommands
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AsNs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueuePush(ctx, in)
+func (m *DownlinkSchedulingAttempt) GetRxWindow() uint32 {
+	if m != nil {
+		return m.RxWindow
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueuePush",
+	return 0
+}
+
+func (m *DownlinkSchedulingAttempt) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+	return nil
+}
+
+func (m *DownlinkSchedulingAttempt) GetCorrelationIDs() []string {
+	if m != nil {
+		return m.CorrelationIDs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AsNs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+type DownlinkSchedulingAttempt_Path struct {
+	// The gateway through which the downlink message was scheduled.
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The relay through which the downlink message was scheduled.
+	// If neither the gateway nor the relay is set, the downlink message was scheduled through Packet Broker.
+	RelayIds *EndDeviceIdentifiers `protobuf:"bytes,2,opt,name=relay_ids,json=relayIds,proto3" json:"relay_ids,omitempty"`
+	// The error of the Gateway Server for the path, if scheduling failed.
+	Error                *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DownlinkSchedulingAttempt_Path) Reset()      { *m = DownlinkSchedulingAttempt_Path{} }
+func (*DownlinkSchedulingAttempt_Path) ProtoMessage() {}
+func (*DownlinkSchedulingAttempt_Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{4, 0}
+}
+func (m *DownlinkSchedulingAttempt_Path) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkSchedulingAttempt_Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkSchedulingAttempt_Path.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueueList(ctx, in)
+}
+func (m *DownlinkSchedulingAttempt_Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkSchedulingAttempt_Path.Merge(m, src)
+}
+func (m *DownlinkSchedulingAttempt_Path) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkSchedulingAttempt_Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkSchedulingAttempt_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkSchedulingAttempt_Path proto.InternalMessageInfo
+
+func (m *DownlinkSchedulingAttempt_Path) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueList",
+	return nil
+}
+
+func (m *DownlinkSchedulingAttempt_Path) GetRelayIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.RelayIds
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
+	return nil
+}
+
+func (m *DownlinkSchedulingAttempt_Path) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _AsNs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.AsNs",
-	HandlerType: (*AsNsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DownlinkQueueReplace",
-			Handler:    _AsNs_DownlinkQueueReplace_Handler,
-		},
-		{
-			MethodName: "DownlinkQueuePush",
-			Handler:    _AsNs_DownlinkQueuePush_Handler,
-		},
-		{
-			MethodName: "DownlinkQueueList",
-			Handler:    _AsNs_DownlinkQueueList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+type DownlinkSchedulingAttempts struct {
+	// The attempts, most recent first.
+	Attempts             []*DownlinkSchedulingAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-// GsNsClient is the client API for GsNs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsNsClient interface {
-	// Called by the Gateway Server when an uplink message arrives.
-	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
-	// Called by the Gateway Server when a Tx acknowledgment arrives.
-	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *DownlinkSchedulingAttempts) Reset()      { *m = DownlinkSchedulingAttempts{} }
+func (*DownlinkSchedulingAttempts) ProtoMessage() {}
+func (*DownlinkSchedulingAttempts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{5}
+}
+func (m *DownlinkSchedulingAttempts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkSchedulingAttempts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkSchedulingAttempts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DownlinkSchedulingAttempts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkSchedulingAttempts.Merge(m, src)
+}
+func (m *DownlinkSchedulingAttempts) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkSchedulingAttempts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkSchedulingAttempts.DiscardUnknown(m)
 }
 
-type gsNsClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_DownlinkSchedulingAttempts proto.InternalMessageInfo
+
+func (m *DownlinkSchedulingAttempts) GetAttempts() []*DownlinkSchedulingAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
 }
 
-func NewGsNsClient(cc *grpc.ClientConn) GsNsClient {
-	return &gsNsClient{cc}
+// RelayJoinRequestFilter is a rule of a relay, which filters the join-requests that the relay forwards.
+type RelayJoinRequestFilter struct {
+	// Action of the filter: 0 removes the filter, 1 forwards and 2 drops the matching join-requests.
+	Action uint32 `protobuf:"varint,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Prefix of the JoinEUI followed by the DevEUI of the matching join-requests, in the byte order of the join-request.
+	RawJoinEuiDevEui     []byte   `protobuf:"bytes,2,opt,name=raw_join_eui_dev_eui,json=rawJoinEuiDevEui,proto3" json:"raw_join_eui_dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/HandleUplink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RelayJoinRequestFilter) Reset()      { *m = RelayJoinRequestFilter{} }
+func (*RelayJoinRequestFilter) ProtoMessage() {}
+func (*RelayJoinRequestFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{6}
+}
+func (m *RelayJoinRequestFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayJoinRequestFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayJoinRequestFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RelayJoinRequestFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayJoinRequestFilter.Merge(m, src)
+}
+func (m *RelayJoinRequestFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayJoinRequestFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayJoinRequestFilter.DiscardUnknown(m)
 }
 
-func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RelayJoinRequestFilter proto.InternalMessageInfo
+
+func (m *RelayJoinRequestFilter) GetAction() uint32 {
+	if m != nil {
+		return m.Action
 	}
-	return out, nil
+	return 0
 }
 
-// GsNsServer is the server API for GsNs service.
-type GsNsServer interface {
-	// Called by the Gateway Server when an uplink message arrives.
-	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
-	// Called by the Gateway Server when a Tx acknowledgment arrives.
-	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*types.Empty, error)
+func (m *RelayJoinRequestFilter) GetRawJoinEuiDevEui() []byte {
+	if m != nil {
+		return m.RawJoinEuiDevEui
+	}
+	return nil
 }
 
-// UnimplementedGsNsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsNsServer struct {
+// ServingRelaySettings are the settings of an end device, which acts as a relay.
+type ServingRelaySettings struct {
+	// Periodicity of the channel activity detection of the relay: 0 is 1 s, 1 is 500 ms, 2 is 250 ms, 3 is 100 ms,
+	// 4 is 50 ms and 5 is 20 ms.
+	CadPeriodicity uint32 `protobuf:"varint,1,opt,name=cad_periodicity,json=cadPeriodicity,proto3" json:"cad_periodicity,omitempty"`
+	// Index of the default wake on radio channel.
+	DefaultChannelIndex uint32 `protobuf:"varint,2,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	// The second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel *RelaySecondChannel `protobuf:"bytes,3,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	// The join-request filters, indexed by their position.
+	JoinRequestFilters []*RelayJoinRequestFilter `protobuf:"bytes,4,rep,name=join_request_filters,json=joinRequestFilters,proto3" json:"join_request_filters,omitempty"`
+	// Limits of the forwarded join-requests. If not set, the limits are disabled.
+	JoinRequestLimits *RelayForwardLimits `protobuf:"bytes,5,opt,name=join_request_limits,json=joinRequestLimits,proto3" json:"join_request_limits,omitempty"`
+	// Limits of the notifications about unknown end devices. If not set, the limits are disabled.
+	NotifyLimits *RelayForwardLimits `protobuf:"bytes,6,opt,name=notify_limits,json=notifyLimits,proto3" json:"notify_limits,omitempty"`
+	// Limits of the forwarded uplinks. If not set, the limits are disabled.
+	GlobalUplinkLimits *RelayForwardLimits `protobuf:"bytes,7,opt,name=global_uplink_limits,json=globalUplinkLimits,proto3" json:"global_uplink_limits,omitempty"`
+	// Limits of all the forwarded messages. If not set, the limits are disabled.
+	OverallLimits        *RelayForwardLimits `protobuf:"bytes,8,opt,name=overall_limits,json=overallLimits,proto3" json:"overall_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
+func (m *ServingRelaySettings) Reset()      { *m = ServingRelaySettings{} }
+func (*ServingRelaySettings) ProtoMessage() {}
+func (*ServingRelaySettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{7}
 }
-func (*UnimplementedGsNsServer) ReportTxAcknowledgment(ctx context.Context, req *GatewayTxAcknowledgment) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportTxAcknowledgment not implemented")
+func (m *ServingRelaySettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
-	s.RegisterService(&_GsNs_serviceDesc, srv)
+func (m *ServingRelaySettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServingRelaySettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServingRelaySettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServingRelaySettings.Merge(m, src)
+}
+func (m *ServingRelaySettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServingRelaySettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServingRelaySettings.DiscardUnknown(m)
 }
 
-func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UplinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GsNsServer).HandleUplink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GsNs/HandleUplink",
+var xxx_messageInfo_ServingRelaySettings proto.InternalMessageInfo
+
+func (m *ServingRelaySettings) GetCadPeriodicity() uint32 {
+	if m != nil {
+		return m.CadPeriodicity
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsNsServer).HandleUplink(ctx, req.(*UplinkMessage))
+	return 0
+}
+
+func (m *ServingRelaySettings) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayTxAcknowledgment)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ServingRelaySettings) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
 	}
-	if interceptor == nil {
-		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, in)
+	return nil
+}
+
+func (m *ServingRelaySettings) GetJoinRequestFilters() []*RelayJoinRequestFilter {
+	if m != nil {
+		return m.JoinRequestFilters
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment",
+	return nil
+}
+
+func (m *ServingRelaySettings) GetJoinRequestLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.JoinRequestLimits
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, req.(*GatewayTxAcknowledgment))
+	return nil
+}
+
+func (m *ServingRelaySettings) GetNotifyLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.NotifyLimits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _GsNs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GsNs",
-	HandlerType: (*GsNsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleUplink",
-			Handler:    _GsNs_HandleUplink_Handler,
-		},
-		{
-			MethodName: "ReportTxAcknowledgment",
-			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+func (m *ServingRelaySettings) GetGlobalUplinkLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.GlobalUplinkLimits
+	}
+	return nil
 }
 
-// NsEndDeviceRegistryClient is the client API for NsEndDeviceRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsEndDeviceRegistryClient interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// ResetFactoryDefaults resets device state to factory defaults.
-	ResetFactoryDefaults(ctx context.Context, in *ResetAndGetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *ServingRelaySettings) GetOverallLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.OverallLimits
+	}
+	return nil
 }
 
-type nsEndDeviceRegistryClient struct {
-	cc *grpc.ClientConn
+// ServedRelaySettings are the settings of an end device, which is served by a relay.
+type ServedRelaySettings struct {
+	// Relay mode of the end device: 0 disables the relay, 1 always uses the relay, 2 uses the relay when uplinks are
+	// not acknowledged and 3 lets the end device decide.
+	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Number of unacknowledged uplinks after which the end device enables the relay in dynamic mode.
+	SmartEnableLevel uint32 `protobuf:"varint,2,opt,name=smart_enable_level,json=smartEnableLevel,proto3" json:"smart_enable_level,omitempty"`
+	// Number of wake on radio frames without acknowledgment after which the end device transmits without the relay.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// The second wake on radio channel. If not set, the second channel is disabled.
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,4,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func NewNsEndDeviceRegistryClient(cc *grpc.ClientConn) NsEndDeviceRegistryClient {
-	return &nsEndDeviceRegistryClient{cc}
+func (m *ServedRelaySettings) Reset()      { *m = ServedRelaySettings{} }
+func (*ServedRelaySettings) ProtoMessage() {}
+func (*ServedRelaySettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{8}
 }
-
-func (c *nsEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ServedRelaySettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServedRelaySettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServedRelaySettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ServedRelaySettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServedRelaySettings.Merge(m, src)
+}
+func (m *ServedRelaySettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServedRelaySettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServedRelaySettings.DiscardUnknown(m)
 }
 
-func (c *nsEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ServedRelaySettings proto.InternalMessageInfo
+
+func (m *ServedRelaySettings) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
 	}
-	return out, nil
+	return 0
 }
 
-func (c *nsEndDeviceRegistryClient) ResetFactoryDefaults(ctx context.Context, in *ResetAndGetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ServedRelaySettings) GetSmartEnableLevel() uint32 {
+	if m != nil {
+		return m.SmartEnableLevel
 	}
-	return out, nil
+	return 0
 }
 
-func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ServedRelaySettings) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
 	}
-	return out, nil
+	return 0
 }
 
-// NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
-type NsEndDeviceRegistryServer interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
-	// ResetFactoryDefaults resets device state to factory defaults.
-	ResetFactoryDefaults(context.Context, *ResetAndGetEndDeviceRequest) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+func (m *ServedRelaySettings) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
 }
 
-// UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
-type UnimplementedNsEndDeviceRegistryServer struct {
+type RelaySettings struct {
+	// If set, the end device acts as a relay.
+	Serving *ServingRelaySettings `protobuf:"bytes,1,opt,name=serving,proto3" json:"serving,omitempty"`
+	// If set, the end device is served by a relay.
+	Served               *ServedRelaySettings `protobuf:"bytes,2,opt,name=served,proto3" json:"served,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (*UnimplementedNsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *RelaySettings) Reset()      { *m = RelaySettings{} }
+func (*RelaySettings) ProtoMessage() {}
+func (*RelaySettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{9}
 }
-func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *RelaySettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedNsEndDeviceRegistryServer) ResetFactoryDefaults(ctx context.Context, req *ResetAndGetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetFactoryDefaults not implemented")
+func (m *RelaySettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelaySettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *RelaySettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySettings.Merge(m, src)
 }
-
-func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
-	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
+func (m *RelaySettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelaySettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySettings.DiscardUnknown(m)
 }
 
-func _NsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
+var xxx_messageInfo_RelaySettings proto.InternalMessageInfo
+
+func (m *RelaySettings) GetServing() *ServingRelaySettings {
+	if m != nil {
+		return m.Serving
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
+func (m *RelaySettings) GetServed() *ServedRelaySettings {
+	if m != nil {
+		return m.Served
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NsEndDeviceRegistry_ResetFactoryDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetAndGetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).ResetFactoryDefaults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).ResetFactoryDefaults(ctx, req.(*ResetAndGetEndDeviceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RelayUplinkListEntry is an end device in the trusted uplink list of a relay.
+type RelayUplinkListEntry struct {
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Index of the entry in the trusted uplink list of the relay.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Identifier of the session of the end device, which is known to the relay.
+	SessionKeyID []byte `protobuf:"bytes,3,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	// Whether the entry has been acknowledged by the relay.
+	Synchronized bool `protobuf:"varint,4,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
+	// Whether the entry is being removed from the relay.
+	Removing             bool     `protobuf:"varint,5,opt,name=removing,proto3" json:"removing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RelayUplinkListEntry) Reset()      { *m = RelayUplinkListEntry{} }
+func (*RelayUplinkListEntry) ProtoMessage() {}
+func (*RelayUplinkListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{10}
+}
+func (m *RelayUplinkListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayUplinkListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayUplinkListEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Delete(ctx, in)
+}
+func (m *RelayUplinkListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayUplinkListEntry.Merge(m, src)
+}
+func (m *RelayUplinkListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayUplinkListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayUplinkListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayUplinkListEntry proto.InternalMessageInfo
+
+func (m *RelayUplinkListEntry) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete",
+	return nil
+}
+
+func (m *RelayUplinkListEntry) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
+	return 0
+}
+
+func (m *RelayUplinkListEntry) GetSessionKeyID() []byte {
+	if m != nil {
+		return m.SessionKeyID
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
-	HandlerType: (*NsEndDeviceRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _NsEndDeviceRegistry_Get_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _NsEndDeviceRegistry_Set_Handler,
-		},
-		{
-			MethodName: "ResetFactoryDefaults",
-			Handler:    _NsEndDeviceRegistry_ResetFactoryDefaults_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _NsEndDeviceRegistry_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+func (m *RelayUplinkListEntry) GetSynchronized() bool {
+	if m != nil {
+		return m.Synchronized
+	}
+	return false
 }
 
-func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayUplinkListEntry) GetRemoving() bool {
+	if m != nil {
+		return m.Removing
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Relay is the relay state of an end device.
+type Relay struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The desired relay settings of the end device.
+	Settings *RelaySettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	// The relay settings acknowledged by the end device.
+	CurrentSettings *RelaySettings `protobuf:"bytes,3,opt,name=current_settings,json=currentSettings,proto3" json:"current_settings,omitempty"`
+	// The trusted uplink list of the relay.
+	UplinkList           []*RelayUplinkListEntry `protobuf:"bytes,4,rep,name=uplink_list,json=uplinkList,proto3" json:"uplink_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DevAddr != nil {
-		{
-			size := m.DevAddr.Size()
-			i -= size
-			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+func (m *Relay) Reset()      { *m = Relay{} }
+func (*Relay) ProtoMessage() {}
+func (*Relay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{11}
+}
+func (m *Relay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Relay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relay.Merge(m, src)
+}
+func (m *Relay) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relay) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relay.DiscardUnknown(m)
 }
 
-func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNetworkserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Relay proto.InternalMessageInfo
+
+func (m *Relay) GetSettings() *RelaySettings {
+	if m != nil {
+		return m.Settings
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *GenerateDevAddrResponse {
-	this := &GenerateDevAddrResponse{}
-	this.DevAddr = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
-	if !easy && r.Intn(10) != 0 {
+
+func (m *Relay) GetCurrentSettings() *RelaySettings {
+	if m != nil {
+		return m.CurrentSettings
 	}
-	return this
+	return nil
 }
 
-type randyNetworkserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
+func (m *Relay) GetUplinkList() []*RelayUplinkListEntry {
+	if m != nil {
+		return m.UplinkList
+	}
+	return nil
+}
+
+type GetRelayRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRelayRequest) Reset()      { *m = GetRelayRequest{} }
+func (*GetRelayRequest) ProtoMessage() {}
+func (*GetRelayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{12}
+}
+func (m *GetRelayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRelayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRelayRequest.Merge(m, src)
+}
+func (m *GetRelayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRelayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRelayRequest proto.InternalMessageInfo
+
+type SetRelaySettingsRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The desired relay settings of the end device. If not set, the relay settings are removed.
+	Settings             *RelaySettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetRelaySettingsRequest) Reset()      { *m = SetRelaySettingsRequest{} }
+func (*SetRelaySettingsRequest) ProtoMessage() {}
+func (*SetRelaySettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{13}
+}
+func (m *SetRelaySettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRelaySettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRelaySettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRelaySettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRelaySettingsRequest.Merge(m, src)
+}
+func (m *SetRelaySettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRelaySettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRelaySettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRelaySettingsRequest proto.InternalMessageInfo
+
+func (m *SetRelaySettingsRequest) GetSettings() *RelaySettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type RelayUplinkListEntryRequest struct {
+	RelayIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=relay_ids,json=relayIds,proto3" json:"relay_ids,omitempty"`
+	// The end device to add to or remove from the trusted uplink list of the relay.
+	// The end device must belong to the same application as the relay.
+	EndDeviceIds         *EndDeviceIdentifiers `protobuf:"bytes,2,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RelayUplinkListEntryRequest) Reset()      { *m = RelayUplinkListEntryRequest{} }
+func (*RelayUplinkListEntryRequest) ProtoMessage() {}
+func (*RelayUplinkListEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{14}
+}
+func (m *RelayUplinkListEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayUplinkListEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayUplinkListEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayUplinkListEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayUplinkListEntryRequest.Merge(m, src)
+}
+func (m *RelayUplinkListEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayUplinkListEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayUplinkListEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayUplinkListEntryRequest proto.InternalMessageInfo
+
+func (m *RelayUplinkListEntryRequest) GetRelayIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.RelayIds
+	}
+	return nil
+}
+
+func (m *RelayUplinkListEntryRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
+	}
+	return nil
+}
+
+type GetAirtimeUsageRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAirtimeUsageRequest) Reset()      { *m = GetAirtimeUsageRequest{} }
+func (*GetAirtimeUsageRequest) ProtoMessage() {}
+func (*GetAirtimeUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{15}
+}
+func (m *GetAirtimeUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAirtimeUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAirtimeUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAirtimeUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAirtimeUsageRequest.Merge(m, src)
+}
+func (m *GetAirtimeUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAirtimeUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAirtimeUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAirtimeUsageRequest proto.InternalMessageInfo
+
+// AirtimeUsage is the airtime usage of an end device within a UTC day.
+type AirtimeUsage struct {
+	// Start of the UTC day.
+	Day time.Time `protobuf:"bytes,1,opt,name=day,proto3,stdtime" json:"day"`
+	// Number of uplink messages received from the end device.
+	Uplinks uint32 `protobuf:"varint,2,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// Total time-on-air of the uplink messages.
+	UplinkAirtime time.Duration `protobuf:"bytes,3,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	// Number of downlink messages scheduled for the end device.
+	Downlinks uint32 `protobuf:"varint,4,opt,name=downlinks,proto3" json:"downlinks,omitempty"`
+	// Total time-on-air of the downlink messages.
+	DownlinkAirtime      time.Duration `protobuf:"bytes,5,opt,name=downlink_airtime,json=downlinkAirtime,proto3,stdduration" json:"downlink_airtime"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AirtimeUsage) Reset()      { *m = AirtimeUsage{} }
+func (*AirtimeUsage) ProtoMessage() {}
+func (*AirtimeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{16}
+}
+func (m *AirtimeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirtimeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirtimeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirtimeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirtimeUsage.Merge(m, src)
+}
+func (m *AirtimeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirtimeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirtimeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirtimeUsage proto.InternalMessageInfo
+
+func (m *AirtimeUsage) GetDay() time.Time {
+	if m != nil {
+		return m.Day
+	}
+	return time.Time{}
+}
+
+func (m *AirtimeUsage) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
+	}
+	return 0
+}
+
+func (m *AirtimeUsage) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
+	}
+	return 0
+}
+
+func (m *AirtimeUsage) GetDownlinks() uint32 {
+	if m != nil {
+		return m.Downlinks
+	}
+	return 0
+}
+
+func (m *AirtimeUsage) GetDownlinkAirtime() time.Duration {
+	if m != nil {
+		return m.DownlinkAirtime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	proto.RegisterType((*SimulateADRRequest)(nil), "ttn.lorawan.v3.SimulateADRRequest")
+	golang_proto.RegisterType((*SimulateADRRequest)(nil), "ttn.lorawan.v3.SimulateADRRequest")
+	proto.RegisterType((*ADRSimulation)(nil), "ttn.lorawan.v3.ADRSimulation")
+	golang_proto.RegisterType((*ADRSimulation)(nil), "ttn.lorawan.v3.ADRSimulation")
+	proto.RegisterType((*ADRSimulation_Step)(nil), "ttn.lorawan.v3.ADRSimulation.Step")
+	golang_proto.RegisterType((*ADRSimulation_Step)(nil), "ttn.lorawan.v3.ADRSimulation.Step")
+	proto.RegisterType((*GetDownlinkSchedulingAttemptsRequest)(nil), "ttn.lorawan.v3.GetDownlinkSchedulingAttemptsRequest")
+	golang_proto.RegisterType((*GetDownlinkSchedulingAttemptsRequest)(nil), "ttn.lorawan.v3.GetDownlinkSchedulingAttemptsRequest")
+	proto.RegisterType((*DownlinkSchedulingAttempt)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempt")
+	golang_proto.RegisterType((*DownlinkSchedulingAttempt)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempt")
+	proto.RegisterType((*DownlinkSchedulingAttempt_Path)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempt.Path")
+	golang_proto.RegisterType((*DownlinkSchedulingAttempt_Path)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempt.Path")
+	proto.RegisterType((*DownlinkSchedulingAttempts)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempts")
+	golang_proto.RegisterType((*DownlinkSchedulingAttempts)(nil), "ttn.lorawan.v3.DownlinkSchedulingAttempts")
+	proto.RegisterType((*RelayJoinRequestFilter)(nil), "ttn.lorawan.v3.RelayJoinRequestFilter")
+	golang_proto.RegisterType((*RelayJoinRequestFilter)(nil), "ttn.lorawan.v3.RelayJoinRequestFilter")
+	proto.RegisterType((*ServingRelaySettings)(nil), "ttn.lorawan.v3.ServingRelaySettings")
+	golang_proto.RegisterType((*ServingRelaySettings)(nil), "ttn.lorawan.v3.ServingRelaySettings")
+	proto.RegisterType((*ServedRelaySettings)(nil), "ttn.lorawan.v3.ServedRelaySettings")
+	golang_proto.RegisterType((*ServedRelaySettings)(nil), "ttn.lorawan.v3.ServedRelaySettings")
+	proto.RegisterType((*RelaySettings)(nil), "ttn.lorawan.v3.RelaySettings")
+	golang_proto.RegisterType((*RelaySettings)(nil), "ttn.lorawan.v3.RelaySettings")
+	proto.RegisterType((*RelayUplinkListEntry)(nil), "ttn.lorawan.v3.RelayUplinkListEntry")
+	golang_proto.RegisterType((*RelayUplinkListEntry)(nil), "ttn.lorawan.v3.RelayUplinkListEntry")
+	proto.RegisterType((*Relay)(nil), "ttn.lorawan.v3.Relay")
+	golang_proto.RegisterType((*Relay)(nil), "ttn.lorawan.v3.Relay")
+	proto.RegisterType((*GetRelayRequest)(nil), "ttn.lorawan.v3.GetRelayRequest")
+	golang_proto.RegisterType((*GetRelayRequest)(nil), "ttn.lorawan.v3.GetRelayRequest")
+	proto.RegisterType((*SetRelaySettingsRequest)(nil), "ttn.lorawan.v3.SetRelaySettingsRequest")
+	golang_proto.RegisterType((*SetRelaySettingsRequest)(nil), "ttn.lorawan.v3.SetRelaySettingsRequest")
+	proto.RegisterType((*RelayUplinkListEntryRequest)(nil), "ttn.lorawan.v3.RelayUplinkListEntryRequest")
+	golang_proto.RegisterType((*RelayUplinkListEntryRequest)(nil), "ttn.lorawan.v3.RelayUplinkListEntryRequest")
+	proto.RegisterType((*GetAirtimeUsageRequest)(nil), "ttn.lorawan.v3.GetAirtimeUsageRequest")
+	golang_proto.RegisterType((*GetAirtimeUsageRequest)(nil), "ttn.lorawan.v3.GetAirtimeUsageRequest")
+	proto.RegisterType((*AirtimeUsage)(nil), "ttn.lorawan.v3.AirtimeUsage")
+	golang_proto.RegisterType((*AirtimeUsage)(nil), "ttn.lorawan.v3.AirtimeUsage")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+}
+
+var fileDescriptor_c77e7504ad1081b8 = []byte{
+	// 2560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5d, 0x8c, 0x5b, 0xc5,
+	0xf5, 0xf7, 0xf8, 0x63, 0xd7, 0x3b, 0xeb, 0xf5, 0x9a, 0x49, 0x08, 0x8e, 0x43, 0xae, 0xf3, 0x37,
+	0xf9, 0x43, 0x58, 0x14, 0x1b, 0x16, 0x0a, 0x05, 0xd4, 0x22, 0x7b, 0xbd, 0x59, 0x16, 0x48, 0x08,
+	0x77, 0x43, 0x3f, 0x50, 0xa5, 0xab, 0xd9, 0x7b, 0x67, 0xbd, 0x17, 0x5f, 0xcf, 0x75, 0xee, 0x8c,
+	0xed, 0x35, 0x14, 0x09, 0x55, 0x15, 0x82, 0x3e, 0x45, 0x54, 0x95, 0x78, 0x44, 0xaa, 0xaa, 0xf2,
+	0x88, 0x2a, 0x55, 0xcd, 0x53, 0x45, 0xfb, 0x50, 0xf1, 0xd0, 0x56, 0x54, 0x55, 0x25, 0x54, 0xa9,
+	0x5b, 0xd6, 0xdb, 0x4a, 0x3c, 0x22, 0xf5, 0x05, 0xe5, 0xa9, 0x9a, 0xb9, 0x73, 0xfd, 0x75, 0xed,
+	0xe0, 0x24, 0x65, 0x9f, 0x7c, 0x67, 0xe6, 0x77, 0xce, 0x9c, 0xf3, 0x9b, 0x33, 0x67, 0xce, 0x8c,
+	0xe1, 0xff, 0x3b, 0xae, 0x87, 0x3b, 0x98, 0x9e, 0x67, 0x1c, 0x9b, 0xf5, 0x12, 0x6e, 0xda, 0x25,
+	0x4a, 0x78, 0xc7, 0xf5, 0xea, 0x8c, 0x78, 0x6d, 0xe2, 0x15, 0x9b, 0x9e, 0xcb, 0x5d, 0x94, 0xe6,
+	0x9c, 0x16, 0x15, 0xb4, 0xd8, 0x7e, 0x34, 0x57, 0xae, 0xd9, 0x7c, 0xb7, 0xb5, 0x5d, 0x34, 0xdd,
+	0x46, 0x89, 0xd0, 0xb6, 0xdb, 0x6d, 0x7a, 0xee, 0x5e, 0xb7, 0x24, 0xc1, 0xe6, 0xf9, 0x1a, 0xa1,
+	0xe7, 0xdb, 0xd8, 0xb1, 0x2d, 0xcc, 0x49, 0x29, 0xf4, 0xe1, 0xab, 0xcc, 0x9d, 0x1f, 0x52, 0x51,
+	0x73, 0x6b, 0xae, 0x2f, 0xbc, 0xdd, 0xda, 0x91, 0x2d, 0xd9, 0x90, 0x5f, 0x0a, 0x7e, 0x6f, 0xcd,
+	0x75, 0x6b, 0x0e, 0x91, 0x16, 0x62, 0x4a, 0x5d, 0x8e, 0xb9, 0xed, 0x52, 0xa6, 0x46, 0x35, 0x35,
+	0xda, 0xd7, 0x61, 0xb5, 0x3c, 0x09, 0x50, 0xe3, 0xa7, 0xc6, 0xc7, 0x49, 0xa3, 0xc9, 0xbb, 0x6a,
+	0xf0, 0xcc, 0xf8, 0xe0, 0x8e, 0x4d, 0x1c, 0xcb, 0x68, 0x60, 0x56, 0x57, 0x88, 0xfc, 0x38, 0x82,
+	0xdb, 0x0d, 0xc2, 0x38, 0x6e, 0x34, 0x15, 0xa0, 0x10, 0xa6, 0x91, 0x50, 0xcb, 0xb0, 0x48, 0xdb,
+	0x36, 0x03, 0x87, 0x4f, 0x4f, 0xc0, 0x78, 0x9e, 0xab, 0x28, 0xce, 0xdd, 0x17, 0x1e, 0xb6, 0x2d,
+	0x42, 0xb9, 0xbd, 0x63, 0x13, 0x2f, 0xf0, 0x33, 0x1f, 0x06, 0x05, 0xab, 0xa2, 0x7c, 0x09, 0x03,
+	0x1a, 0x84, 0x31, 0x5c, 0x23, 0x4a, 0x45, 0xe1, 0x2a, 0xbc, 0x67, 0x83, 0x50, 0xe2, 0x61, 0x4e,
+	0xaa, 0xa4, 0x5d, 0xb6, 0x2c, 0x4f, 0x27, 0xac, 0xe9, 0x52, 0x46, 0xd0, 0x77, 0x60, 0xd2, 0x22,
+	0x6d, 0x03, 0x5b, 0x96, 0x97, 0x05, 0x67, 0xc0, 0xb9, 0x54, 0xe5, 0xe9, 0xbf, 0xef, 0xe7, 0x9f,
+	0xa8, 0xb9, 0x45, 0xbe, 0x4b, 0xf8, 0xae, 0x4d, 0x6b, 0xac, 0xa8, 0xa2, 0xa3, 0x34, 0x3a, 0x4f,
+	0xfb, 0xd1, 0x52, 0xb3, 0x5e, 0x2b, 0xf1, 0x6e, 0x93, 0xb0, 0x62, 0xa0, 0x76, 0xde, 0xf2, 0x3f,
+	0x0a, 0x3f, 0x8e, 0x42, 0xb4, 0x65, 0x37, 0x5a, 0x0e, 0xe6, 0xa4, 0x5c, 0xd5, 0x75, 0x72, 0xb5,
+	0x45, 0x18, 0x47, 0x3f, 0x80, 0xe9, 0x01, 0x49, 0x86, 0x6d, 0x31, 0x39, 0xe9, 0xe2, 0xea, 0xd9,
+	0xe2, 0x68, 0xb4, 0x15, 0xd7, 0xa9, 0x55, 0x95, 0xa0, 0xcd, 0x01, 0x21, 0x95, 0xcc, 0x8d, 0x4a,
+	0xe2, 0x27, 0x20, 0x9a, 0x01, 0x1f, 0xef, 0xe7, 0x23, 0x9f, 0xec, 0xe7, 0x81, 0x9e, 0x22, 0x03,
+	0x1c, 0x43, 0x2f, 0xc2, 0x54, 0x03, 0x9b, 0x06, 0x23, 0x9c, 0x0b, 0xc3, 0xb3, 0x51, 0xa9, 0xfb,
+	0xd4, 0xb8, 0xee, 0x8b, 0xe5, 0xb5, 0x2d, 0x05, 0xa9, 0x2c, 0xf7, 0xf6, 0xf3, 0x8b, 0x43, 0x1d,
+	0xfa, 0x62, 0x03, 0x9b, 0x41, 0x03, 0x3d, 0x03, 0xe1, 0x20, 0x30, 0xb2, 0x31, 0xa9, 0x2e, 0x57,
+	0xf4, 0x23, 0xa3, 0x18, 0x44, 0x46, 0xf1, 0x82, 0x80, 0x5c, 0xc4, 0xac, 0x5e, 0x89, 0x0b, 0xc3,
+	0xf4, 0x85, 0x9d, 0xa0, 0xa3, 0xf0, 0xcb, 0x04, 0x5c, 0x2a, 0x57, 0x75, 0xc5, 0x84, 0xed, 0x52,
+	0xf4, 0x4d, 0x98, 0x60, 0x9c, 0x34, 0x85, 0xe3, 0xb1, 0x73, 0x8b, 0xab, 0x85, 0x71, 0xe3, 0x46,
+	0xd0, 0xc5, 0x2d, 0x4e, 0x9a, 0xba, 0x2f, 0x80, 0x2e, 0xc1, 0x8c, 0x47, 0x4c, 0xd7, 0xb3, 0x88,
+	0x65, 0x60, 0xdb, 0x13, 0xf1, 0xa8, 0x3c, 0x3c, 0x19, 0x32, 0xa9, 0xaa, 0xf6, 0x42, 0x25, 0x29,
+	0x2c, 0x7a, 0xef, 0x9f, 0x79, 0xa0, 0x2f, 0x07, 0xc2, 0x65, 0x5f, 0x16, 0x5d, 0x86, 0x77, 0x31,
+	0xb5, 0x42, 0x03, 0x85, 0xb1, 0xd9, 0x15, 0x66, 0xfa, 0xd2, 0x81, 0xc6, 0x07, 0x60, 0x7f, 0x12,
+	0x43, 0xc4, 0x5b, 0xad, 0x9b, 0x8d, 0x9f, 0x01, 0xe7, 0xa2, 0x7a, 0x3a, 0xe8, 0x5e, 0x97, 0xbd,
+	0xe8, 0x41, 0x38, 0x10, 0x0e, 0x90, 0x09, 0x89, 0x5c, 0xee, 0xf7, 0xfb, 0xd0, 0xdc, 0xbf, 0xa3,
+	0x30, 0x2e, 0x58, 0x40, 0xc7, 0x60, 0x62, 0xc7, 0x30, 0x29, 0x97, 0x11, 0xb3, 0xa4, 0xc7, 0x77,
+	0xd6, 0x28, 0x47, 0xeb, 0x70, 0xd9, 0xc2, 0x1c, 0x1b, 0x22, 0xb4, 0x0d, 0x9b, 0x5a, 0x64, 0x4f,
+	0x52, 0x92, 0x5e, 0x3d, 0x3d, 0xce, 0x6b, 0x15, 0x73, 0xac, 0x63, 0x4e, 0x36, 0x05, 0x48, 0x5f,
+	0xb2, 0x86, 0x9b, 0xe8, 0x2c, 0x4c, 0xf3, 0x3d, 0xa3, 0xe9, 0x76, 0x88, 0xa7, 0xb4, 0xc4, 0xe4,
+	0x24, 0x29, 0xbe, 0x77, 0x59, 0x74, 0xfa, 0xa8, 0x93, 0x30, 0x49, 0xb7, 0x0d, 0xee, 0x61, 0xca,
+	0xa4, 0x5f, 0x4b, 0xfa, 0x3c, 0xdd, 0xbe, 0x22, 0x9a, 0xe8, 0x5b, 0x70, 0x3e, 0x60, 0x30, 0x31,
+	0x3b, 0x83, 0x81, 0x0c, 0x3a, 0x01, 0xe7, 0x14, 0x0b, 0x73, 0x92, 0x05, 0xd5, 0x42, 0x4f, 0x88,
+	0xdd, 0x69, 0xda, 0xcc, 0x76, 0x69, 0x76, 0x7e, 0x72, 0x30, 0x97, 0xab, 0x7a, 0x55, 0x41, 0xf4,
+	0x3e, 0x18, 0x3d, 0x0c, 0x53, 0x8e, 0x4d, 0xeb, 0x06, 0xb6, 0x3c, 0xc3, 0x23, 0x57, 0xb3, 0xc9,
+	0x33, 0xe0, 0x5c, 0xb2, 0x92, 0xee, 0xed, 0xe7, 0xe1, 0x0b, 0x36, 0xad, 0xfb, 0x3b, 0x52, 0x87,
+	0x02, 0x53, 0x16, 0xd9, 0xe0, 0x6a, 0xe1, 0xe7, 0x00, 0x9e, 0xdd, 0x20, 0xbc, 0xea, 0x76, 0xa8,
+	0xe8, 0xdd, 0x32, 0x77, 0x89, 0xd5, 0x72, 0x6c, 0x5a, 0x2b, 0x73, 0x2e, 0x32, 0x27, 0x3b, 0x9a,
+	0x2d, 0x7c, 0x1a, 0x26, 0x1c, 0xbb, 0x61, 0x73, 0xb9, 0x8c, 0x4b, 0x95, 0xf9, 0x1b, 0x95, 0xf8,
+	0x4a, 0x34, 0x6b, 0xe9, 0x7e, 0x6f, 0xe1, 0xed, 0x39, 0x78, 0x72, 0xaa, 0x89, 0x68, 0x03, 0xa6,
+	0xb0, 0xff, 0x29, 0x22, 0x9a, 0x67, 0xc1, 0x94, 0x0d, 0x7b, 0x25, 0x48, 0xe5, 0xfe, 0x5a, 0x5c,
+	0x13, 0x6b, 0xb1, 0xd8, 0x97, 0x2c, 0x73, 0xf4, 0x10, 0x4c, 0x98, 0x0e, 0x66, 0x4c, 0x05, 0xd3,
+	0xdd, 0xe3, 0xae, 0xad, 0x89, 0x41, 0xdd, 0xc7, 0xa0, 0xc7, 0x60, 0x9c, 0x39, 0x2e, 0xcf, 0xc6,
+	0xbe, 0x72, 0xb6, 0xb8, 0x9c, 0x49, 0xa2, 0x51, 0x1e, 0x06, 0x33, 0x1a, 0xde, 0xde, 0x23, 0x32,
+	0x9e, 0x92, 0x3a, 0x54, 0x5d, 0xfa, 0xde, 0x23, 0xa3, 0x80, 0xd5, 0x6c, 0x62, 0x0c, 0xb0, 0x8a,
+	0xaa, 0x30, 0xd1, 0xc4, 0x7c, 0x97, 0x65, 0xe7, 0x64, 0x26, 0x29, 0x86, 0x22, 0x7e, 0x1a, 0x4f,
+	0xc5, 0xcb, 0x98, 0xef, 0xea, 0xbe, 0x30, 0xfa, 0x9e, 0x9f, 0x33, 0x4d, 0xb7, 0xd1, 0xc0, 0xd4,
+	0x62, 0xd9, 0xf9, 0x33, 0xb1, 0x73, 0xe9, 0xf0, 0x62, 0x5e, 0x2c, 0xaf, 0xad, 0xf9, 0x90, 0xc1,
+	0x6a, 0xf6, 0x93, 0xa7, 0x1a, 0xf1, 0x93, 0x67, 0xd0, 0x40, 0xa7, 0xe0, 0x82, 0xb7, 0x67, 0x74,
+	0x6c, 0x6a, 0xb9, 0x1d, 0x19, 0x80, 0x4b, 0x7a, 0xd2, 0xdb, 0xfb, 0xae, 0x6c, 0xa3, 0x55, 0x98,
+	0x90, 0x27, 0x61, 0x76, 0x41, 0xb2, 0x76, 0x6f, 0x28, 0x78, 0xc4, 0x60, 0x95, 0x70, 0x6c, 0x3b,
+	0x4c, 0xf7, 0xa1, 0x68, 0x03, 0x2e, 0x9b, 0xae, 0xe7, 0x11, 0x3f, 0x37, 0xca, 0xd0, 0x83, 0x67,
+	0x62, 0xe7, 0x16, 0x2a, 0xda, 0x8d, 0xca, 0xc2, 0xbb, 0x60, 0xae, 0x10, 0xf7, 0xa2, 0x59, 0xab,
+	0xb7, 0x9f, 0x4f, 0xaf, 0x0d, 0x60, 0x9b, 0x55, 0xa6, 0xa7, 0x87, 0xc4, 0x36, 0x2d, 0x96, 0xfb,
+	0x3d, 0x80, 0x71, 0xc1, 0x01, 0x5a, 0x83, 0x8b, 0x35, 0xcc, 0x49, 0x07, 0x77, 0x87, 0x02, 0x39,
+	0x94, 0x92, 0x37, 0x7c, 0xc8, 0x50, 0x18, 0xeb, 0xb0, 0x16, 0xf4, 0x31, 0x54, 0x86, 0x0b, 0x42,
+	0xb9, 0xaf, 0x22, 0x3a, 0xfb, 0x5e, 0xd0, 0x93, 0x52, 0x4c, 0xa8, 0xe8, 0xb3, 0x11, 0x9b, 0x99,
+	0x8d, 0x82, 0x09, 0x73, 0xd3, 0x37, 0x2b, 0x5a, 0x87, 0x49, 0x15, 0x2a, 0xc1, 0x49, 0xf3, 0xe0,
+	0xcc, 0xf1, 0xa1, 0xf7, 0x45, 0x0b, 0xaf, 0xc1, 0x13, 0xba, 0x30, 0xf2, 0x39, 0xd7, 0xa6, 0x2a,
+	0x01, 0x5c, 0xb0, 0x1d, 0x4e, 0x3c, 0x94, 0x87, 0x73, 0xd8, 0x14, 0x84, 0x66, 0xc1, 0xf0, 0x4e,
+	0x8d, 0xea, 0xaa, 0x1b, 0x3d, 0x09, 0x8f, 0x7b, 0xb8, 0x63, 0xbc, 0xea, 0xda, 0xd4, 0x20, 0x2d,
+	0x5b, 0x24, 0x0c, 0xf1, 0x2b, 0x19, 0x4a, 0x55, 0x16, 0x6e, 0x54, 0xe6, 0x5e, 0x8b, 0x67, 0x40,
+	0x36, 0xa3, 0x67, 0x3c, 0xdc, 0x11, 0xfa, 0xd7, 0x5b, 0x76, 0x95, 0xb4, 0xd7, 0x5b, 0xf6, 0x53,
+	0xf1, 0xeb, 0xef, 0xe7, 0x23, 0x85, 0x2f, 0xe3, 0xf0, 0xf8, 0x16, 0xf1, 0xda, 0x36, 0xad, 0x49,
+	0x1b, 0xfa, 0xa7, 0xf2, 0xc3, 0x70, 0xd9, 0xc4, 0x96, 0xd1, 0x24, 0x9e, 0xed, 0x5a, 0xb6, 0x69,
+	0xf3, 0xee, 0xa8, 0x0d, 0x09, 0x3d, 0x6d, 0x62, 0xeb, 0xf2, 0x60, 0x18, 0x3d, 0x0d, 0xef, 0xb6,
+	0xc8, 0x0e, 0x6e, 0x39, 0xdc, 0x30, 0x77, 0x31, 0xa5, 0xc4, 0x19, 0x3a, 0x2c, 0xfa, 0x72, 0x40,
+	0x3f, 0xa6, 0x50, 0x6b, 0x3e, 0xc8, 0x4f, 0xfb, 0x9b, 0x30, 0xcd, 0x88, 0xe9, 0x52, 0x2b, 0x90,
+	0xcd, 0xc6, 0x26, 0xc7, 0x89, 0xb2, 0x52, 0x40, 0x95, 0x02, 0x7d, 0x89, 0x0d, 0x37, 0xd1, 0x36,
+	0x3c, 0x2e, 0xf9, 0xf0, 0x7c, 0x2a, 0x8d, 0x1d, 0xc9, 0xa5, 0x38, 0x4d, 0xc4, 0x0a, 0xdd, 0x3f,
+	0x51, 0x61, 0x88, 0xfa, 0x4a, 0xf2, 0x46, 0x25, 0xf1, 0x2e, 0x88, 0x66, 0x32, 0x3a, 0x7a, 0x75,
+	0x7c, 0x90, 0x21, 0x1d, 0x1e, 0x1b, 0x99, 0x43, 0x26, 0x4e, 0x96, 0x4d, 0xdc, 0xc4, 0xe6, 0x0b,
+	0xae, 0xd7, 0xc1, 0x9e, 0xf5, 0x82, 0x44, 0xea, 0x77, 0x0d, 0x29, 0xf5, 0xbb, 0xd0, 0x06, 0x5c,
+	0xa2, 0x2e, 0xb7, 0x77, 0xba, 0x81, 0xb6, 0xb9, 0x99, 0xb5, 0xa5, 0x7c, 0x41, 0xa5, 0xe8, 0x0a,
+	0x3c, 0x5e, 0x73, 0xdc, 0x6d, 0xec, 0x18, 0xad, 0xa6, 0x3c, 0xa0, 0x94, 0xbe, 0xf9, 0x99, 0xf5,
+	0x21, 0x5f, 0xfe, 0x65, 0x29, 0xae, 0xb4, 0x6e, 0xc2, 0xb4, 0xdb, 0x26, 0x1e, 0x76, 0x9c, 0x40,
+	0x5f, 0x72, 0x66, 0x7d, 0x4b, 0x4a, 0xd2, 0x6f, 0xaa, 0xd0, 0xfb, 0x07, 0x80, 0xc7, 0x44, 0xe8,
+	0x11, 0x6b, 0x34, 0xf2, 0x4e, 0xc1, 0x78, 0xc3, 0xb5, 0xc8, 0x68, 0xb8, 0xc5, 0x74, 0xd9, 0x89,
+	0xbe, 0x01, 0x11, 0x6b, 0x60, 0x8f, 0x1b, 0x84, 0xe2, 0x6d, 0x87, 0x18, 0x0e, 0x69, 0x13, 0x67,
+	0x34, 0xc2, 0x62, 0x7a, 0x46, 0x42, 0xd6, 0x25, 0xe2, 0x05, 0x01, 0x40, 0xff, 0x07, 0xe7, 0xb7,
+	0xb1, 0x59, 0x77, 0x77, 0x76, 0xb2, 0xb1, 0x61, 0xec, 0x33, 0x7a, 0xd0, 0x3f, 0x21, 0x02, 0xe3,
+	0xb7, 0x19, 0x81, 0xca, 0xbf, 0x77, 0x01, 0x5c, 0x1a, 0xf5, 0xec, 0xdb, 0x70, 0x9e, 0xf9, 0x7b,
+	0x6d, 0xda, 0x71, 0x3e, 0x69, 0x2b, 0xea, 0x81, 0x10, 0x7a, 0x1a, 0xce, 0x31, 0x49, 0x98, 0xca,
+	0x80, 0xf7, 0x4d, 0x12, 0x1f, 0xa3, 0x53, 0x57, 0x22, 0xca, 0xa8, 0x77, 0xa2, 0xf0, 0xb8, 0x1c,
+	0x0f, 0xd6, 0x96, 0xf1, 0x75, 0xca, 0xbd, 0x2e, 0xba, 0x72, 0x47, 0x15, 0x47, 0x32, 0xa8, 0x38,
+	0xc2, 0x95, 0xc6, 0x84, 0x1c, 0xb0, 0xac, 0xfb, 0xbd, 0xe8, 0x71, 0xc1, 0x39, 0x13, 0xc5, 0x94,
+	0x51, 0x27, 0x22, 0xb7, 0xcb, 0xd5, 0x49, 0x55, 0x32, 0xbd, 0xfd, 0x7c, 0x6a, 0xcb, 0x1f, 0x79,
+	0x9e, 0x74, 0x37, 0xab, 0x7a, 0x8a, 0x0d, 0x5a, 0x16, 0x2a, 0xc0, 0x14, 0xeb, 0x52, 0x73, 0xd7,
+	0x73, 0xa9, 0xfd, 0x1a, 0xb1, 0xd4, 0xc1, 0x3e, 0xd2, 0x87, 0x72, 0x30, 0xe9, 0x91, 0x86, 0x2b,
+	0xd9, 0xf6, 0xcf, 0xf5, 0x7e, 0x5b, 0x71, 0xf1, 0xbb, 0x28, 0x4c, 0x48, 0x2e, 0xbe, 0xe6, 0x72,
+	0xeb, 0x49, 0x98, 0x1c, 0xbb, 0x2d, 0x9d, 0x9e, 0x12, 0x53, 0x6a, 0xc9, 0xfa, 0x70, 0xf4, 0x2c,
+	0xcc, 0x98, 0x2d, 0xcf, 0x23, 0x94, 0x0f, 0x2e, 0x5c, 0xb1, 0x59, 0x54, 0x2c, 0x2b, 0xb1, 0x7e,
+	0xec, 0xbd, 0x08, 0x17, 0xfb, 0xd9, 0x80, 0x71, 0x95, 0x0c, 0xcf, 0x4e, 0x54, 0x32, 0x16, 0x1a,
+	0x43, 0xa9, 0x10, 0xb6, 0xfa, 0x43, 0x8a, 0xc3, 0x16, 0x5c, 0xde, 0x20, 0x5c, 0x8a, 0x1d, 0x49,
+	0xed, 0xaa, 0xa6, 0xbd, 0x0e, 0xe0, 0x3d, 0x5b, 0x6a, 0xde, 0xbe, 0xcf, 0x47, 0x52, 0x3b, 0xdf,
+	0xfe, 0x62, 0x2a, 0xd3, 0xff, 0x00, 0xe0, 0xa9, 0x49, 0x34, 0x07, 0xe6, 0x3f, 0x3f, 0x5c, 0xe9,
+	0xdc, 0xde, 0x1e, 0x1c, 0xd4, 0x3c, 0xe1, 0x5d, 0x1d, 0xbd, 0xf3, 0x5d, 0xad, 0x1c, 0xf9, 0x21,
+	0x3c, 0xb1, 0x41, 0xb8, 0xba, 0x96, 0xbe, 0x2c, 0x9e, 0x42, 0x8e, 0x32, 0x02, 0xde, 0x8f, 0xc2,
+	0xd4, 0xf0, 0xdc, 0xe8, 0x71, 0x18, 0xb3, 0x70, 0xf7, 0x96, 0xae, 0x23, 0x42, 0x00, 0x65, 0xe1,
+	0xbc, 0x1f, 0xd5, 0x3e, 0x37, 0x4b, 0x7a, 0xd0, 0x44, 0xcf, 0xc1, 0xb4, 0xff, 0x79, 0x3b, 0x17,
+	0xf7, 0x25, 0x5f, 0x34, 0xb8, 0xb5, 0xdf, 0x0b, 0x17, 0x2c, 0x55, 0x0a, 0x06, 0xf7, 0xda, 0x41,
+	0x87, 0x78, 0x75, 0x08, 0x1a, 0xc6, 0x6d, 0x5c, 0x71, 0x97, 0x03, 0x61, 0x35, 0x9b, 0x4f, 0xd1,
+	0x2a, 0x85, 0xd1, 0x4b, 0x0c, 0xed, 0xc2, 0xe5, 0xb1, 0x77, 0x29, 0x74, 0x22, 0xa4, 0x74, 0x5d,
+	0x3c, 0xdb, 0xe5, 0x1e, 0x08, 0x15, 0xe5, 0x93, 0x1f, 0xb4, 0x0a, 0xc7, 0x7f, 0xf4, 0xd7, 0x7f,
+	0xfd, 0x34, 0x9a, 0x46, 0xa9, 0x12, 0x65, 0xa5, 0xe0, 0x69, 0x6b, 0xf5, 0x5a, 0x14, 0xc6, 0xcb,
+	0xec, 0x92, 0x2c, 0x40, 0x82, 0xba, 0xf7, 0xa5, 0x16, 0x69, 0x11, 0x9d, 0x34, 0x1d, 0x6c, 0x12,
+	0x74, 0x76, 0x5a, 0x75, 0xac, 0x50, 0x32, 0x7a, 0x72, 0x53, 0xac, 0x43, 0x2f, 0xc1, 0xbb, 0x46,
+	0xf0, 0x97, 0x5b, 0x6c, 0xf7, 0x0e, 0x55, 0x1a, 0x63, 0x2a, 0xc5, 0x66, 0x44, 0x33, 0x45, 0x69,
+	0x2e, 0x84, 0x2a, 0x37, 0x9b, 0x8e, 0x6d, 0xca, 0xa5, 0x09, 0x74, 0xb2, 0xd5, 0x0f, 0x00, 0x8c,
+	0x6f, 0x08, 0x4a, 0xd6, 0x61, 0xea, 0x59, 0x4c, 0x2d, 0x87, 0xf8, 0xbb, 0x1e, 0x85, 0x92, 0x86,
+	0xdf, 0x7f, 0xd1, 0x7f, 0x53, 0x9c, 0x6a, 0xf0, 0xf7, 0xc5, 0x55, 0xa1, 0xe9, 0x7a, 0xfc, 0xca,
+	0x5e, 0xd9, 0xac, 0x53, 0xb7, 0xe3, 0x10, 0xab, 0xd6, 0x20, 0x94, 0xa3, 0x07, 0xa6, 0x5c, 0xa8,
+	0xc6, 0x81, 0xd3, 0x54, 0xaf, 0x5e, 0x47, 0xf0, 0xd8, 0x25, 0xd6, 0xf7, 0x55, 0x27, 0x35, 0x9b,
+	0x89, 0xc2, 0xe0, 0x57, 0x00, 0xc6, 0x36, 0x08, 0x47, 0xf7, 0x85, 0x83, 0x83, 0x0f, 0xa1, 0x7d,
+	0xa2, 0x4f, 0x4e, 0xe5, 0xae, 0x50, 0x97, 0x31, 0x43, 0x90, 0x29, 0x62, 0x06, 0x0f, 0xc8, 0x62,
+	0xa5, 0xd7, 0x47, 0x93, 0x45, 0x71, 0x68, 0x70, 0x42, 0xfb, 0x8d, 0x92, 0x0f, 0x0d, 0xcb, 0xf5,
+	0x3f, 0xdf, 0x40, 0x6f, 0x45, 0x61, 0x6c, 0x6b, 0x92, 0xd1, 0x5b, 0xb7, 0x66, 0xf4, 0x6f, 0x81,
+	0xb4, 0xfa, 0x37, 0x20, 0x77, 0x53, 0xb3, 0x8b, 0xb7, 0x69, 0x76, 0x71, 0xd4, 0xec, 0xa7, 0xc0,
+	0xca, 0x2b, 0x17, 0x0b, 0xcf, 0xfe, 0xaf, 0x66, 0x7a, 0x0a, 0xac, 0xa0, 0x3f, 0x03, 0x51, 0xef,
+	0x31, 0xc2, 0x2f, 0x60, 0x93, 0xbb, 0x5e, 0xb7, 0xea, 0xdf, 0xbd, 0x18, 0x7a, 0x28, 0x7c, 0x6a,
+	0x31, 0xc2, 0xcb, 0xd4, 0xba, 0xc5, 0x65, 0xa5, 0x92, 0xa0, 0xdd, 0xd5, 0xa3, 0x58, 0x56, 0xe1,
+	0xd0, 0xcf, 0x00, 0x9c, 0xab, 0x12, 0x87, 0x70, 0x32, 0xe3, 0x46, 0x9d, 0x12, 0xef, 0x85, 0x8b,
+	0xd2, 0xf0, 0x8d, 0x95, 0xf5, 0xb0, 0xe1, 0x33, 0x5b, 0x3a, 0x14, 0x71, 0x7f, 0x02, 0x70, 0x71,
+	0xe8, 0x2d, 0x1e, 0x85, 0xae, 0x0d, 0xe1, 0x87, 0xfa, 0xdc, 0xe9, 0x9b, 0xbe, 0x4b, 0x17, 0x5e,
+	0x97, 0x16, 0xb6, 0x0a, 0xcd, 0x23, 0xa0, 0xb6, 0x84, 0x2d, 0xaf, 0x14, 0x3c, 0x0b, 0x0b, 0x9e,
+	0xdf, 0x8c, 0xc2, 0xd3, 0x37, 0x7d, 0xaa, 0x44, 0x8f, 0x4d, 0x48, 0x08, 0x5f, 0xf9, 0xb2, 0x99,
+	0x5b, 0x99, 0xf9, 0x85, 0x84, 0x15, 0xde, 0x90, 0x04, 0x74, 0x50, 0xeb, 0x28, 0x08, 0x08, 0xce,
+	0xd0, 0x52, 0xf0, 0x2e, 0x83, 0x7e, 0x0d, 0x60, 0x32, 0x28, 0x6e, 0x51, 0x7e, 0x82, 0xb7, 0xc3,
+	0x65, 0x6f, 0xee, 0xee, 0x89, 0x65, 0x60, 0xe1, 0xaa, 0xf4, 0xa1, 0x8e, 0xec, 0xa3, 0xf0, 0x41,
+	0xd6, 0x7d, 0xe8, 0x2f, 0x00, 0x66, 0xc6, 0x8b, 0xe3, 0xf0, 0xf9, 0x30, 0xa5, 0x7c, 0x9e, 0xe6,
+	0x87, 0x5a, 0x8b, 0x9c, 0x77, 0x64, 0x7e, 0x94, 0xfa, 0x85, 0x33, 0x58, 0x41, 0x7f, 0x03, 0xf0,
+	0x9e, 0xb2, 0x65, 0x4d, 0xbc, 0xba, 0x3e, 0x34, 0xcb, 0x2d, 0xe6, 0x2b, 0xdc, 0x6b, 0x4b, 0xf7,
+	0x9a, 0x85, 0x7a, 0xd8, 0xbd, 0x7e, 0x35, 0x3e, 0xbb, 0x67, 0x03, 0x91, 0x90, 0x53, 0x43, 0x97,
+	0x30, 0xe1, 0xd7, 0x7f, 0x00, 0xcc, 0xf9, 0xe9, 0xec, 0x6b, 0x73, 0xed, 0x9a, 0x7f, 0x86, 0xbd,
+	0x03, 0x56, 0xde, 0x02, 0x47, 0xe8, 0xdd, 0xcd, 0x8e, 0xe7, 0x3f, 0x02, 0x79, 0x6d, 0x1c, 0xa9,
+	0xdf, 0xef, 0x9f, 0xb0, 0xc1, 0x26, 0x5c, 0x2e, 0x72, 0xa1, 0x77, 0xdb, 0x61, 0x50, 0x81, 0x49,
+	0x5f, 0x1b, 0xa8, 0x7e, 0x24, 0x39, 0xd3, 0x9f, 0xb9, 0xf2, 0x0b, 0xf0, 0xf1, 0x81, 0x06, 0x3e,
+	0x39, 0xd0, 0xc0, 0xa7, 0x07, 0x5a, 0xe4, 0xb3, 0x03, 0x2d, 0xf2, 0xf9, 0x81, 0x16, 0xf9, 0xe2,
+	0x40, 0x8b, 0x7c, 0x79, 0xa0, 0x81, 0x37, 0x7b, 0x1a, 0x78, 0xbb, 0xa7, 0x45, 0x3e, 0xe8, 0x69,
+	0xe0, 0xc3, 0x9e, 0x16, 0xb9, 0xde, 0xd3, 0x22, 0x1f, 0xf5, 0xb4, 0xc8, 0xc7, 0x3d, 0x0d, 0x7c,
+	0xd2, 0xd3, 0xc0, 0xa7, 0x3d, 0x2d, 0xf2, 0x59, 0x4f, 0x03, 0x9f, 0xf7, 0xb4, 0xc8, 0x17, 0x3d,
+	0x0d, 0x7c, 0xd9, 0xd3, 0x22, 0x6f, 0x1e, 0x6a, 0x91, 0xb7, 0x0f, 0x35, 0x70, 0xed, 0x50, 0x8b,
+	0xbc, 0x77, 0xa8, 0x81, 0xf7, 0x0f, 0xb5, 0xc8, 0x07, 0x87, 0x5a, 0xe4, 0xc3, 0x43, 0x0d, 0x5c,
+	0x3f, 0xd4, 0xc0, 0x47, 0x87, 0x1a, 0x78, 0xa5, 0x74, 0x0b, 0x7f, 0x20, 0x73, 0xda, 0xdc, 0xde,
+	0x9e, 0x93, 0x47, 0xe0, 0xa3, 0xff, 0x1d, 0x00, 0x61, 0x8d, 0x34, 0xba, 0x9d, 0x20, 0x00, 0x00,
+}
+
+func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenerateDevAddrResponse)
+	if !ok {
+		that2, ok := that.(GenerateDevAddrResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.DevAddr == nil {
+		if this.DevAddr != nil {
+			return false
+		}
+	} else if !this.DevAddr.Equal(*that1.DevAddr) {
+		return false
+	}
+	return true
+}
+func (this *SimulateADRRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulateADRRequest)
+	if !ok {
+		that2, ok := that.(SimulateADRRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.MACSettings.Equal(that1.MACSettings) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ADRSimulation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRSimulation)
+	if !ok {
+		that2, ok := that.(ADRSimulation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Steps) != len(that1.Steps) {
+		return false
+	}
+	for i := range this.Steps {
+		if !this.Steps[i].Equal(that1.Steps[i]) {
+			return false
+		}
+	}
+	if this.RecordedAirtime != that1.RecordedAirtime {
+		return false
+	}
+	if this.SimulatedAirtime != that1.SimulatedAirtime {
+		return false
+	}
+	if this.RecordedEnergy != that1.RecordedEnergy {
+		return false
+	}
+	if this.SimulatedEnergy != that1.SimulatedEnergy {
+		return false
+	}
+	return true
+}
+func (this *ADRSimulation_Step) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ADRSimulation_Step)
+	if !ok {
+		that2, ok := that.(ADRSimulation_Step)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.TxPowerIndex != that1.TxPowerIndex {
+		return false
+	}
+	if this.NbTrans != that1.NbTrans {
+		return false
+	}
+	if this.Airtime != that1.Airtime {
+		return false
+	}
+	if this.Energy != that1.Energy {
+		return false
+	}
+	if !this.Decision.Equal(that1.Decision) {
+		return false
+	}
+	if this.LinkADRReq != that1.LinkADRReq {
+		return false
+	}
+	return true
+}
+func (this *GetDownlinkSchedulingAttemptsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetDownlinkSchedulingAttemptsRequest)
+	if !ok {
+		that2, ok := that.(GetDownlinkSchedulingAttemptsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *DownlinkSchedulingAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkSchedulingAttempt)
+	if !ok {
+		that2, ok := that.(DownlinkSchedulingAttempt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AttemptedAt.Equal(that1.AttemptedAt) {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	if that1.Slot == nil {
+		if this.Slot != nil {
+			return false
+		}
+	} else if !this.Slot.Equal(*that1.Slot) {
+		return false
+	}
+	if this.AttemptRx1 != that1.AttemptRx1 {
+		return false
+	}
+	if this.AttemptRx2 != that1.AttemptRx2 {
+		return false
+	}
+	if len(this.Paths) != len(that1.Paths) {
+		return false
+	}
+	for i := range this.Paths {
+		if !this.Paths[i].Equal(that1.Paths[i]) {
+			return false
+		}
+	}
+	if len(this.MACCommands) != len(that1.MACCommands) {
+		return false
+	}
+	for i := range this.MACCommands {
+		if this.MACCommands[i] != that1.MACCommands[i] {
+			return false
+		}
+	}
+	if this.RxWindow != that1.RxWindow {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+		return false
+	}
+	for i := range this.CorrelationIDs {
+		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DownlinkSchedulingAttempt_Path) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkSchedulingAttempt_Path)
+	if !ok {
+		that2, ok := that.(DownlinkSchedulingAttempt_Path)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIds.Equal(that1.GatewayIds) {
+		return false
+	}
+	if !this.RelayIds.Equal(that1.RelayIds) {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *DownlinkSchedulingAttempts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkSchedulingAttempts)
+	if !ok {
+		that2, ok := that.(DownlinkSchedulingAttempts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Attempts) != len(that1.Attempts) {
+		return false
+	}
+	for i := range this.Attempts {
+		if !this.Attempts[i].Equal(that1.Attempts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RelayJoinRequestFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayJoinRequestFilter)
+	if !ok {
+		that2, ok := that.(RelayJoinRequestFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !bytes.Equal(this.RawJoinEuiDevEui, that1.RawJoinEuiDevEui) {
+		return false
+	}
+	return true
+}
+func (this *ServingRelaySettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServingRelaySettings)
+	if !ok {
+		that2, ok := that.(ServingRelaySettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CadPeriodicity != that1.CadPeriodicity {
+		return false
+	}
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
+		return false
+	}
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
+	}
+	if len(this.JoinRequestFilters) != len(that1.JoinRequestFilters) {
+		return false
+	}
+	for i := range this.JoinRequestFilters {
+		if !this.JoinRequestFilters[i].Equal(that1.JoinRequestFilters[i]) {
+			return false
+		}
+	}
+	if !this.JoinRequestLimits.Equal(that1.JoinRequestLimits) {
+		return false
+	}
+	if !this.NotifyLimits.Equal(that1.NotifyLimits) {
+		return false
+	}
+	if !this.GlobalUplinkLimits.Equal(that1.GlobalUplinkLimits) {
+		return false
+	}
+	if !this.OverallLimits.Equal(that1.OverallLimits) {
+		return false
+	}
+	return true
+}
+func (this *ServedRelaySettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServedRelaySettings)
+	if !ok {
+		that2, ok := that.(ServedRelaySettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.SmartEnableLevel != that1.SmartEnableLevel {
+		return false
+	}
+	if this.Backoff != that1.Backoff {
+		return false
+	}
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
+	}
+	return true
+}
+func (this *RelaySettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelaySettings)
+	if !ok {
+		that2, ok := that.(RelaySettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Serving.Equal(that1.Serving) {
+		return false
+	}
+	if !this.Served.Equal(that1.Served) {
+		return false
+	}
+	return true
+}
+func (this *RelayUplinkListEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayUplinkListEntry)
+	if !ok {
+		that2, ok := that.(RelayUplinkListEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.SessionKeyID, that1.SessionKeyID) {
+		return false
+	}
+	if this.Synchronized != that1.Synchronized {
+		return false
+	}
+	if this.Removing != that1.Removing {
+		return false
+	}
+	return true
+}
+func (this *Relay) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Relay)
+	if !ok {
+		that2, ok := that.(Relay)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.Settings.Equal(that1.Settings) {
+		return false
+	}
+	if !this.CurrentSettings.Equal(that1.CurrentSettings) {
+		return false
+	}
+	if len(this.UplinkList) != len(that1.UplinkList) {
+		return false
+	}
+	for i := range this.UplinkList {
+		if !this.UplinkList[i].Equal(that1.UplinkList[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetRelayRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetRelayRequest)
+	if !ok {
+		that2, ok := that.(GetRelayRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	return true
+}
+func (this *SetRelaySettingsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetRelaySettingsRequest)
+	if !ok {
+		that2, ok := that.(SetRelaySettingsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.Settings.Equal(that1.Settings) {
+		return false
+	}
+	return true
+}
+func (this *RelayUplinkListEntryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayUplinkListEntryRequest)
+	if !ok {
+		that2, ok := that.(RelayUplinkListEntryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RelayIds.Equal(that1.RelayIds) {
+		return false
+	}
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+		return false
+	}
+	return true
+}
+func (this *GetAirtimeUsageRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetAirtimeUsageRequest)
+	if !ok {
+		that2, ok := that.(GetAirtimeUsageRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	return true
+}
+func (this *AirtimeUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AirtimeUsage)
+	if !ok {
+		that2, ok := that.(AirtimeUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Day.Equal(that1.Day) {
+		return false
+	}
+	if this.Uplinks != that1.Uplinks {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	if this.Downlinks != that1.Downlinks {
+		return false
+	}
+	if this.DownlinkAirtime != that1.DownlinkAirtime {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NsClient is the client API for Ns service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsClient interface {
+	// GenerateDevAddr requests a device address assignment from the Network Server.
+	GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error)
+}
+
+type nsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsClient(cc *grpc.ClientConn) NsClient {
+	return &nsClient{cc}
+}
+
+func (c *nsClient) GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error) {
+	out := new(GenerateDevAddrResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/GenerateDevAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsServer is the server API for Ns service.
+type NsServer interface {
+	// GenerateDevAddr requests a device address assignment from the Network Server.
+	GenerateDevAddr(context.Context, *types.Empty) (*GenerateDevAddrResponse, error)
+}
+
+// UnimplementedNsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsServer struct {
+}
+
+func (*UnimplementedNsServer) GenerateDevAddr(ctx context.Context, req *types.Empty) (*GenerateDevAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+}
+
+func RegisterNsServer(s *grpc.Server, srv NsServer) {
+	s.RegisterService(&_Ns_serviceDesc, srv)
+}
+
+func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GenerateDevAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Ns/GenerateDevAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GenerateDevAddr(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Ns_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Ns",
+	HandlerType: (*NsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateDevAddr",
+			Handler:    _Ns_GenerateDevAddr_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// AsNsClient is the client API for AsNs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsNsClient interface {
+	// Replace the entire downlink queue with the specified messages.
+	// This can also be used to empty the queue by specifying no messages.
+	// Note that this will trigger an immediate downlink if a downlink slot is available.
+	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Push downlink messages to the end of the downlink queue.
+	// Note that this will trigger an immediate downlink if a downlink slot is available.
+	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the items currently in the downlink queue.
+	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+}
+
+type asNsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsNsClient(cc *grpc.ClientConn) AsNsClient {
+	return &asNsClient{cc}
+}
+
+func (c *asNsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asNsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueuePush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asNsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
+	out := new(ApplicationDownlinks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsNsServer is the server API for AsNs service.
+type AsNsServer interface {
+	// Replace the entire downlink queue with the specified messages.
+	// This can also be used to empty the queue by specifying no messages.
+	// Note that this will trigger an immediate downlink if a downlink slot is available.
+	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	// Push downlink messages to the end of the downlink queue.
+	// Note that this will trigger an immediate downlink if a downlink slot is available.
+	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	// List the items currently in the downlink queue.
+	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+}
+
+// UnimplementedAsNsServer can be embedded to have forward compatible implementations.
+type UnimplementedAsNsServer struct {
+}
+
+func (*UnimplementedAsNsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+}
+func (*UnimplementedAsNsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+}
+func (*UnimplementedAsNsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+}
+
+func RegisterAsNsServer(s *grpc.Server, srv AsNsServer) {
+	s.RegisterService(&_AsNs_serviceDesc, srv)
+}
+
+func _AsNs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueueReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsNs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueuePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueuePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsNs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsNs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsNs",
+	HandlerType: (*AsNsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DownlinkQueueReplace",
+			Handler:    _AsNs_DownlinkQueueReplace_Handler,
+		},
+		{
+			MethodName: "DownlinkQueuePush",
+			Handler:    _AsNs_DownlinkQueuePush_Handler,
+		},
+		{
+			MethodName: "DownlinkQueueList",
+			Handler:    _AsNs_DownlinkQueueList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// GsNsClient is the client API for GsNs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsNsClient interface {
+	// Called by the Gateway Server when an uplink message arrives.
+	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	// Called by the Gateway Server when a Tx acknowledgment arrives.
+	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type gsNsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsNsClient(cc *grpc.ClientConn) GsNsClient {
+	return &gsNsClient{cc}
+}
+
+func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/HandleUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsNsServer is the server API for GsNs service.
+type GsNsServer interface {
+	// Called by the Gateway Server when an uplink message arrives.
+	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+	// Called by the Gateway Server when a Tx acknowledgment arrives.
+	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*types.Empty, error)
+}
+
+// UnimplementedGsNsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsNsServer struct {
+}
+
+func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
+}
+func (*UnimplementedGsNsServer) ReportTxAcknowledgment(ctx context.Context, req *GatewayTxAcknowledgment) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTxAcknowledgment not implemented")
+}
+
+func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
+	s.RegisterService(&_GsNs_serviceDesc, srv)
+}
+
+func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).HandleUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/HandleUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).HandleUplink(ctx, req.(*UplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayTxAcknowledgment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, req.(*GatewayTxAcknowledgment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GsNs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GsNs",
+	HandlerType: (*GsNsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleUplink",
+			Handler:    _GsNs_HandleUplink_Handler,
+		},
+		{
+			MethodName: "ReportTxAcknowledgment",
+			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// NsEndDeviceRegistryClient is the client API for NsEndDeviceRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsEndDeviceRegistryClient interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// ResetFactoryDefaults resets device state to factory defaults.
+	ResetFactoryDefaults(ctx context.Context, in *ResetAndGetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// SimulateADR replays the recent uplinks of the device through the ADR algorithm with the given MAC settings.
+	SimulateADR(ctx context.Context, in *SimulateADRRequest, opts ...grpc.CallOption) (*ADRSimulation, error)
+	// GetDownlinkSchedulingAttempts returns the recent downlink scheduling attempts of the device.
+	GetDownlinkSchedulingAttempts(ctx context.Context, in *GetDownlinkSchedulingAttemptsRequest, opts ...grpc.CallOption) (*DownlinkSchedulingAttempts, error)
+	// GetRelay returns the relay settings and the trusted uplink list of the device.
+	GetRelay(ctx context.Context, in *GetRelayRequest, opts ...grpc.CallOption) (*Relay, error)
+	// SetRelaySettings sets the desired relay settings of the device.
+	SetRelaySettings(ctx context.Context, in *SetRelaySettingsRequest, opts ...grpc.CallOption) (*Relay, error)
+	// AddRelayUplinkListEntry adds the end device to the trusted uplink list of the relay.
+	AddRelayUplinkListEntry(ctx context.Context, in *RelayUplinkListEntryRequest, opts ...grpc.CallOption) (*Relay, error)
+	// DeleteRelayUplinkListEntry removes the end device from the trusted uplink list of the relay.
+	DeleteRelayUplinkListEntry(ctx context.Context, in *RelayUplinkListEntryRequest, opts ...grpc.CallOption) (*Relay, error)
+	// GetAirtimeUsage returns the airtime usage of the device on the current UTC day.
+	GetAirtimeUsage(ctx context.Context, in *GetAirtimeUsageRequest, opts ...grpc.CallOption) (*AirtimeUsage, error)
+}
+
+type nsEndDeviceRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsEndDeviceRegistryClient(cc *grpc.ClientConn) NsEndDeviceRegistryClient {
+	return &nsEndDeviceRegistryClient{cc}
+}
+
+func (c *nsEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) ResetFactoryDefaults(ctx context.Context, in *ResetAndGetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) SimulateADR(ctx context.Context, in *SimulateADRRequest, opts ...grpc.CallOption) (*ADRSimulation, error) {
+	out := new(ADRSimulation)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/SimulateADR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetDownlinkSchedulingAttempts(ctx context.Context, in *GetDownlinkSchedulingAttemptsRequest, opts ...grpc.CallOption) (*DownlinkSchedulingAttempts, error) {
+	out := new(DownlinkSchedulingAttempts)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetDownlinkSchedulingAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetRelay(ctx context.Context, in *GetRelayRequest, opts ...grpc.CallOption) (*Relay, error) {
+	out := new(Relay)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) SetRelaySettings(ctx context.Context, in *SetRelaySettingsRequest, opts ...grpc.CallOption) (*Relay, error) {
+	out := new(Relay)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/SetRelaySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) AddRelayUplinkListEntry(ctx context.Context, in *RelayUplinkListEntryRequest, opts ...grpc.CallOption) (*Relay, error) {
+	out := new(Relay)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/AddRelayUplinkListEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) DeleteRelayUplinkListEntry(ctx context.Context, in *RelayUplinkListEntryRequest, opts ...grpc.CallOption) (*Relay, error) {
+	out := new(Relay)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/DeleteRelayUplinkListEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetAirtimeUsage(ctx context.Context, in *GetAirtimeUsageRequest, opts ...grpc.CallOption) (*AirtimeUsage, error) {
+	out := new(AirtimeUsage)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetAirtimeUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
+type NsEndDeviceRegistryServer interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
+	// ResetFactoryDefaults resets device state to factory defaults.
+	ResetFactoryDefaults(context.Context, *ResetAndGetEndDeviceRequest) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// SimulateADR replays the recent uplinks of the device through the ADR algorithm with the given MAC settings.
+	SimulateADR(context.Context, *SimulateADRRequest) (*ADRSimulation, error)
+	// GetDownlinkSchedulingAttempts returns the recent downlink scheduling attempts of the device.
+	GetDownlinkSchedulingAttempts(context.Context, *GetDownlinkSchedulingAttemptsRequest) (*DownlinkSchedulingAttempts, error)
+	// GetRelay returns the relay settings and the trusted uplink list of the device.
+	GetRelay(context.Context, *GetRelayRequest) (*Relay, error)
+	// SetRelaySettings sets the desired relay settings of the device.
+	SetRelaySettings(context.Context, *SetRelaySettingsRequest) (*Relay, error)
+	// AddRelayUplinkListEntry adds the end device to the trusted uplink list of the relay.
+	AddRelayUplinkListEntry(context.Context, *RelayUplinkListEntryRequest) (*Relay, error)
+	// DeleteRelayUplinkListEntry removes the end device from the trusted uplink list of the relay.
+	DeleteRelayUplinkListEntry(context.Context, *RelayUplinkListEntryRequest) (*Relay, error)
+	// GetAirtimeUsage returns the airtime usage of the device on the current UTC day.
+	GetAirtimeUsage(context.Context, *GetAirtimeUsageRequest) (*AirtimeUsage, error)
+}
+
+// UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedNsEndDeviceRegistryServer struct {
+}
+
+func (*UnimplementedNsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) ResetFactoryDefaults(ctx context.Context, req *ResetAndGetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetFactoryDefaults not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) SimulateADR(ctx context.Context, req *SimulateADRRequest) (*ADRSimulation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateADR not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetDownlinkSchedulingAttempts(ctx context.Context, req *GetDownlinkSchedulingAttemptsRequest) (*DownlinkSchedulingAttempts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownlinkSchedulingAttempts not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetRelay(ctx context.Context, req *GetRelayRequest) (*Relay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelay not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) SetRelaySettings(ctx context.Context, req *SetRelaySettingsRequest) (*Relay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelaySettings not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) AddRelayUplinkListEntry(ctx context.Context, req *RelayUplinkListEntryRequest) (*Relay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRelayUplinkListEntry not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) DeleteRelayUplinkListEntry(ctx context.Context, req *RelayUplinkListEntryRequest) (*Relay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelayUplinkListEntry not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetAirtimeUsage(ctx context.Context, req *GetAirtimeUsageRequest) (*AirtimeUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAirtimeUsage not implemented")
+}
+
+func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
+	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
+}
+
+func _NsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_ResetFactoryDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetAndGetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).ResetFactoryDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/ResetFactoryDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).ResetFactoryDefaults(ctx, req.(*ResetAndGetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_SimulateADR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateADRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).SimulateADR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/SimulateADR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).SimulateADR(ctx, req.(*SimulateADRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetDownlinkSchedulingAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownlinkSchedulingAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetDownlinkSchedulingAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetDownlinkSchedulingAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetDownlinkSchedulingAttempts(ctx, req.(*GetDownlinkSchedulingAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetRelay(ctx, req.(*GetRelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_SetRelaySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRelaySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).SetRelaySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/SetRelaySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).SetRelaySettings(ctx, req.(*SetRelaySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_AddRelayUplinkListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayUplinkListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).AddRelayUplinkListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/AddRelayUplinkListEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).AddRelayUplinkListEntry(ctx, req.(*RelayUplinkListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_DeleteRelayUplinkListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayUplinkListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).DeleteRelayUplinkListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/DeleteRelayUplinkListEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).DeleteRelayUplinkListEntry(ctx, req.(*RelayUplinkListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetAirtimeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAirtimeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetAirtimeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetAirtimeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetAirtimeUsage(ctx, req.(*GetAirtimeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
+	HandlerType: (*NsEndDeviceRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _NsEndDeviceRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _NsEndDeviceRegistry_Set_Handler,
+		},
+		{
+			MethodName: "ResetFactoryDefaults",
+			Handler:    _NsEndDeviceRegistry_ResetFactoryDefaults_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NsEndDeviceRegistry_Delete_Handler,
+		},
+		{
+			MethodName: "SimulateADR",
+			Handler:    _NsEndDeviceRegistry_SimulateADR_Handler,
+		},
+		{
+			MethodName: "GetDownlinkSchedulingAttempts",
+			Handler:    _NsEndDeviceRegistry_GetDownlinkSchedulingAttempts_Handler,
+		},
+		{
+			MethodName: "GetRelay",
+			Handler:    _NsEndDeviceRegistry_GetRelay_Handler,
+		},
+		{
+			MethodName: "SetRelaySettings",
+			Handler:    _NsEndDeviceRegistry_SetRelaySettings_Handler,
+		},
+		{
+			MethodName: "AddRelayUplinkListEntry",
+			Handler:    _NsEndDeviceRegistry_AddRelayUplinkListEntry_Handler,
+		},
+		{
+			MethodName: "DeleteRelayUplinkListEntry",
+			Handler:    _NsEndDeviceRegistry_DeleteRelayUplinkListEntry_Handler,
+		},
+		{
+			MethodName: "GetAirtimeUsage",
+			Handler:    _NsEndDeviceRegistry_GetAirtimeUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		{
+			size := m.DevAddr.Size()
+			i -= size
+			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateADRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateADRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateADRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MACSettings != nil {
+		{
+			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SimulatedEnergy != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.SimulatedEnergy)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.RecordedEnergy != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.RecordedEnergy)))
+		i--
+		dAtA[i] = 0x25
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SimulatedAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SimulatedAirtime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordedAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordedAirtime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ADRSimulation_Step) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRSimulation_Step) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ADRSimulation_Step) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkADRReq {
+		i--
+		if m.LinkADRReq {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Decision != nil {
+		{
+			size, err := m.Decision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Energy != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Energy)))
+		i--
+		dAtA[i] = 0x35
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Airtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.NbTrans != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.NbTrans))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxPowerIndex != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.TxPowerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FCnt != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.FCnt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDownlinkSchedulingAttemptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDownlinkSchedulingAttemptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDownlinkSchedulingAttemptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkSchedulingAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkSchedulingAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkSchedulingAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CorrelationIDs) > 0 {
+		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrelationIDs[iNdEx])
+			copy(dAtA[i:], m.CorrelationIDs[iNdEx])
+			i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.CorrelationIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RxWindow != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.RxWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MACCommands) > 0 {
+		dAtA11 := make([]byte, len(m.MACCommands)*10)
+		var j10 int
+		for _, num := range m.MACCommands {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintNetworkserver(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AttemptRx2 {
+		i--
+		if m.AttemptRx2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AttemptRx1 {
+		i--
+		if m.AttemptRx1 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Slot, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Slot):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Class != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x10
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AttemptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AttemptedAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkSchedulingAttempt_Path) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkSchedulingAttempt_Path) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkSchedulingAttempt_Path) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RelayIds != nil {
+		{
+			size, err := m.RelayIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GatewayIds != nil {
+		{
+			size, err := m.GatewayIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkSchedulingAttempts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkSchedulingAttempts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkSchedulingAttempts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayJoinRequestFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayJoinRequestFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayJoinRequestFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawJoinEuiDevEui) > 0 {
+		i -= len(m.RawJoinEuiDevEui)
+		copy(dAtA[i:], m.RawJoinEuiDevEui)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.RawJoinEuiDevEui)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServingRelaySettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServingRelaySettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServingRelaySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverallLimits != nil {
+		{
+			size, err := m.OverallLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GlobalUplinkLimits != nil {
+		{
+			size, err := m.GlobalUplinkLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NotifyLimits != nil {
+		{
+			size, err := m.NotifyLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.JoinRequestLimits != nil {
+		{
+			size, err := m.JoinRequestLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JoinRequestFilters) > 0 {
+		for iNdEx := len(m.JoinRequestFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JoinRequestFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DefaultChannelIndex != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DefaultChannelIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CadPeriodicity != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.CadPeriodicity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServedRelaySettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServedRelaySettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServedRelaySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondChannel != nil {
+		{
+			size, err := m.SecondChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SmartEnableLevel != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.SmartEnableLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelaySettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelaySettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelaySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Served != nil {
+		{
+			size, err := m.Served.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Serving != nil {
+		{
+			size, err := m.Serving.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayUplinkListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayUplinkListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayUplinkListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removing {
+		i--
+		if m.Removing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Synchronized {
+		i--
+		if m.Synchronized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionKeyID) > 0 {
+		i -= len(m.SessionKeyID)
+		copy(dAtA[i:], m.SessionKeyID)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.SessionKeyID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EndDeviceIds != nil {
+		{
+			size, err := m.EndDeviceIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Relay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UplinkList) > 0 {
+		for iNdEx := len(m.UplinkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CurrentSettings != nil {
+		{
+			size, err := m.CurrentSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Settings != nil {
+		{
+			size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRelaySettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRelaySettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRelaySettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Settings != nil {
+		{
+			size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayUplinkListEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayUplinkListEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayUplinkListEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndDeviceIds != nil {
+		{
+			size, err := m.EndDeviceIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RelayIds != nil {
+		{
+			size, err := m.RelayIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAirtimeUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAirtimeUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAirtimeUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AirtimeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirtimeUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirtimeUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DownlinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if m.Downlinks != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Downlinks))
+		i--
+		dAtA[i] = 0x20
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	if m.Uplinks != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Uplinks))
+		i--
+		dAtA[i] = 0x10
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Day, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Day):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNetworkserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *GenerateDevAddrResponse {
+	this := &GenerateDevAddrResponse{}
+	this.DevAddr = go_thethings_network_lorawan_stack_v3_pkg_types.NewPopulatedDevAddr(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSimulateADRRequest(r randyNetworkserver, easy bool) *SimulateADRRequest {
+	this := &SimulateADRRequest{}
+	v1 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v1
+	if r.Intn(5) != 0 {
+		this.MACSettings = NewPopulatedMACSettings(r, easy)
+	}
+	v2 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedADRSimulation(r randyNetworkserver, easy bool) *ADRSimulation {
+	this := &ADRSimulation{}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Steps = make([]*ADRSimulation_Step, v3)
+		for i := 0; i < v3; i++ {
+			this.Steps[i] = NewPopulatedADRSimulation_Step(r, easy)
+		}
+	}
+	v4 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.RecordedAirtime = *v4
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.SimulatedAirtime = *v5
+	this.RecordedEnergy = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.RecordedEnergy *= -1
+	}
+	this.SimulatedEnergy = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.SimulatedEnergy *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedADRSimulation_Step(r randyNetworkserver, easy bool) *ADRSimulation_Step {
+	this := &ADRSimulation_Step{}
+	this.FCnt = r.Uint32()
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.TxPowerIndex = r.Uint32()
+	this.NbTrans = r.Uint32()
+	v6 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Airtime = *v6
+	this.Energy = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Energy *= -1
+	}
+	if r.Intn(5) != 0 {
+		this.Decision = NewPopulatedADRDecision(r, easy)
+	}
+	this.LinkADRReq = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetDownlinkSchedulingAttemptsRequest(r randyNetworkserver, easy bool) *GetDownlinkSchedulingAttemptsRequest {
+	this := &GetDownlinkSchedulingAttemptsRequest{}
+	v7 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v7
+	this.Limit = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkSchedulingAttempt(r randyNetworkserver, easy bool) *DownlinkSchedulingAttempt {
+	this := &DownlinkSchedulingAttempt{}
+	v8 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.AttemptedAt = *v8
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(5) != 0 {
+		this.Slot = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.AttemptRx1 = bool(r.Intn(2) == 0)
+	this.AttemptRx2 = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.Paths = make([]*DownlinkSchedulingAttempt_Path, v9)
+		for i := 0; i < v9; i++ {
+			this.Paths[i] = NewPopulatedDownlinkSchedulingAttempt_Path(r, easy)
+		}
+	}
+	v10 := r.Intn(10)
+	this.MACCommands = make([]MACCommandIdentifier, v10)
+	for i := 0; i < v10; i++ {
+		this.MACCommands[i] = MACCommandIdentifier([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 32, 64, 65, 66, 67, 68, 69, 70}[r.Intn(28)])
+	}
+	this.RxWindow = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	v11 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.CorrelationIDs[i] = randStringNetworkserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkSchedulingAttempt_Path(r randyNetworkserver, easy bool) *DownlinkSchedulingAttempt_Path {
+	this := &DownlinkSchedulingAttempt_Path{}
+	if r.Intn(5) != 0 {
+		this.GatewayIds = NewPopulatedGatewayIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.RelayIds = NewPopulatedEndDeviceIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkSchedulingAttempts(r randyNetworkserver, easy bool) *DownlinkSchedulingAttempts {
+	this := &DownlinkSchedulingAttempts{}
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.Attempts = make([]*DownlinkSchedulingAttempt, v12)
+		for i := 0; i < v12; i++ {
+			this.Attempts[i] = NewPopulatedDownlinkSchedulingAttempt(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyNetworkserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringNetworkserver(r randyNetworkserver) string {
+	v13 := r.Intn(100)
+	tmps := make([]rune, v13)
+	for i := 0; i < v13; i++ {
+		tmps[i] = randUTF8RuneNetworkserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedNetworkserver(r randyNetworkserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldNetworkserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		v14 := r.Int63()
+		if r.Intn(2) == 0 {
+			v14 *= -1
+		}
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v14))
+	case 1:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateNetworkserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GenerateDevAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateADRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.MACSettings != nil {
+		l = m.MACSettings.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *ADRSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordedAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SimulatedAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.RecordedEnergy != 0 {
+		n += 5
+	}
+	if m.SimulatedEnergy != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *ADRSimulation_Step) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FCnt != 0 {
+		n += 1 + sovNetworkserver(uint64(m.FCnt))
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovNetworkserver(uint64(m.DataRateIndex))
+	}
+	if m.TxPowerIndex != 0 {
+		n += 1 + sovNetworkserver(uint64(m.TxPowerIndex))
+	}
+	if m.NbTrans != 0 {
+		n += 1 + sovNetworkserver(uint64(m.NbTrans))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Airtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Energy != 0 {
+		n += 5
+	}
+	if m.Decision != nil {
+		l = m.Decision.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.LinkADRReq {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetDownlinkSchedulingAttemptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *DownlinkSchedulingAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AttemptedAt)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Class != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Class))
+	}
+	if m.Slot != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Slot)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.AttemptRx1 {
+		n += 2
+	}
+	if m.AttemptRx2 {
+		n += 2
+	}
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	if len(m.MACCommands) > 0 {
+		l = 0
+		for _, e := range m.MACCommands {
+			l += sovNetworkserver(uint64(e))
+		}
+		n += 1 + sovNetworkserver(uint64(l)) + l
+	}
+	if m.RxWindow != 0 {
+		n += 1 + sovNetworkserver(uint64(m.RxWindow))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			l = len(s)
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DownlinkSchedulingAttempt_Path) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GatewayIds != nil {
+		l = m.GatewayIds.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.RelayIds != nil {
+		l = m.RelayIds.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DownlinkSchedulingAttempts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayJoinRequestFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Action))
+	}
+	l = len(m.RawJoinEuiDevEui)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ServingRelaySettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CadPeriodicity != 0 {
+		n += 1 + sovNetworkserver(uint64(m.CadPeriodicity))
+	}
+	if m.DefaultChannelIndex != 0 {
+		n += 1 + sovNetworkserver(uint64(m.DefaultChannelIndex))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if len(m.JoinRequestFilters) > 0 {
+		for _, e := range m.JoinRequestFilters {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	if m.JoinRequestLimits != nil {
+		l = m.JoinRequestLimits.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.NotifyLimits != nil {
+		l = m.NotifyLimits.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.GlobalUplinkLimits != nil {
+		l = m.GlobalUplinkLimits.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.OverallLimits != nil {
+		l = m.OverallLimits.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ServedRelaySettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Mode))
+	}
+	if m.SmartEnableLevel != 0 {
+		n += 1 + sovNetworkserver(uint64(m.SmartEnableLevel))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Backoff))
+	}
+	if m.SecondChannel != nil {
+		l = m.SecondChannel.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RelaySettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Serving != nil {
+		l = m.Serving.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.Served != nil {
+		l = m.Served.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayUplinkListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndDeviceIds != nil {
+		l = m.EndDeviceIds.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Index))
+	}
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.Synchronized {
+		n += 2
+	}
+	if m.Removing {
+		n += 2
+	}
+	return n
+}
+
+func (m *Relay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Settings != nil {
+		l = m.Settings.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.CurrentSettings != nil {
+		l = m.CurrentSettings.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if len(m.UplinkList) > 0 {
+		for _, e := range m.UplinkList {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *SetRelaySettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Settings != nil {
+		l = m.Settings.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayUplinkListEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayIds != nil {
+		l = m.RelayIds.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.EndDeviceIds != nil {
+		l = m.EndDeviceIds.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAirtimeUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *AirtimeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Day)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Uplinks != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Uplinks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Downlinks != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Downlinks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func sovNetworkserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNetworkserver(x uint64) (n int) {
+	return sovNetworkserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GenerateDevAddrResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GenerateDevAddrResponse{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulateADRRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SimulateADRRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`MACSettings:` + strings.Replace(fmt.Sprintf("%v", this.MACSettings), "MACSettings", "MACSettings", 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSimulation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]*ADRSimulation_Step{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(fmt.Sprintf("%v", f), "ADRSimulation_Step", "ADRSimulation_Step", 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&ADRSimulation{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`RecordedAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RecordedAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`SimulatedAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SimulatedAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`RecordedEnergy:` + fmt.Sprintf("%v", this.RecordedEnergy) + `,`,
+		`SimulatedEnergy:` + fmt.Sprintf("%v", this.SimulatedEnergy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ADRSimulation_Step) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ADRSimulation_Step{`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`TxPowerIndex:` + fmt.Sprintf("%v", this.TxPowerIndex) + `,`,
+		`NbTrans:` + fmt.Sprintf("%v", this.NbTrans) + `,`,
+		`Airtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Airtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Energy:` + fmt.Sprintf("%v", this.Energy) + `,`,
+		`Decision:` + strings.Replace(fmt.Sprintf("%v", this.Decision), "ADRDecision", "ADRDecision", 1) + `,`,
+		`LinkADRReq:` + fmt.Sprintf("%v", this.LinkADRReq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDownlinkSchedulingAttemptsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDownlinkSchedulingAttemptsRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkSchedulingAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPaths := "[]*DownlinkSchedulingAttempt_Path{"
+	for _, f := range this.Paths {
+		repeatedStringForPaths += strings.Replace(fmt.Sprintf("%v", f), "DownlinkSchedulingAttempt_Path", "DownlinkSchedulingAttempt_Path", 1) + ","
+	}
+	repeatedStringForPaths += "}"
+	s := strings.Join([]string{`&DownlinkSchedulingAttempt{`,
+		`AttemptedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AttemptedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`Slot:` + strings.Replace(fmt.Sprintf("%v", this.Slot), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AttemptRx1:` + fmt.Sprintf("%v", this.AttemptRx1) + `,`,
+		`AttemptRx2:` + fmt.Sprintf("%v", this.AttemptRx2) + `,`,
+		`Paths:` + repeatedStringForPaths + `,`,
+		`MACCommands:` + fmt.Sprintf("%v", this.MACCommands) + `,`,
+		`RxWindow:` + fmt.Sprintf("%v", this.RxWindow) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkSchedulingAttempt_Path) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkSchedulingAttempt_Path{`,
+		`GatewayIds:` + strings.Replace(fmt.Sprintf("%v", this.GatewayIds), "GatewayIdentifiers", "GatewayIdentifiers", 1) + `,`,
+		`RelayIds:` + strings.Replace(fmt.Sprintf("%v", this.RelayIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkSchedulingAttempts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAttempts := "[]*DownlinkSchedulingAttempt{"
+	for _, f := range this.Attempts {
+		repeatedStringForAttempts += strings.Replace(fmt.Sprintf("%v", f), "DownlinkSchedulingAttempt", "DownlinkSchedulingAttempt", 1) + ","
+	}
+	repeatedStringForAttempts += "}"
+	s := strings.Join([]string{`&DownlinkSchedulingAttempts{`,
+		`Attempts:` + repeatedStringForAttempts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayJoinRequestFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayJoinRequestFilter{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`RawJoinEuiDevEui:` + fmt.Sprintf("%v", this.RawJoinEuiDevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServingRelaySettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJoinRequestFilters := "[]*RelayJoinRequestFilter{"
+	for _, f := range this.JoinRequestFilters {
+		repeatedStringForJoinRequestFilters += strings.Replace(f.String(), "RelayJoinRequestFilter", "RelayJoinRequestFilter", 1) + ","
+	}
+	repeatedStringForJoinRequestFilters += "}"
+	s := strings.Join([]string{`&ServingRelaySettings{`,
+		`CadPeriodicity:` + fmt.Sprintf("%v", this.CadPeriodicity) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`JoinRequestFilters:` + repeatedStringForJoinRequestFilters + `,`,
+		`JoinRequestLimits:` + strings.Replace(fmt.Sprintf("%v", this.JoinRequestLimits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`NotifyLimits:` + strings.Replace(fmt.Sprintf("%v", this.NotifyLimits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`GlobalUplinkLimits:` + strings.Replace(fmt.Sprintf("%v", this.GlobalUplinkLimits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`OverallLimits:` + strings.Replace(fmt.Sprintf("%v", this.OverallLimits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelaySettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelaySettings{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`SmartEnableLevel:` + fmt.Sprintf("%v", this.SmartEnableLevel) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelaySettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelaySettings{`,
+		`Serving:` + strings.Replace(this.Serving.String(), "ServingRelaySettings", "ServingRelaySettings", 1) + `,`,
+		`Served:` + strings.Replace(this.Served.String(), "ServedRelaySettings", "ServedRelaySettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayUplinkListEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayUplinkListEntry{`,
+		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`Synchronized:` + fmt.Sprintf("%v", this.Synchronized) + `,`,
+		`Removing:` + fmt.Sprintf("%v", this.Removing) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Relay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkList := "[]*RelayUplinkListEntry{"
+	for _, f := range this.UplinkList {
+		repeatedStringForUplinkList += strings.Replace(f.String(), "RelayUplinkListEntry", "RelayUplinkListEntry", 1) + ","
+	}
+	repeatedStringForUplinkList += "}"
+	s := strings.Join([]string{`&Relay{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Settings:` + strings.Replace(this.Settings.String(), "RelaySettings", "RelaySettings", 1) + `,`,
+		`CurrentSettings:` + strings.Replace(this.CurrentSettings.String(), "RelaySettings", "RelaySettings", 1) + `,`,
+		`UplinkList:` + repeatedStringForUplinkList + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRelayRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRelayRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetRelaySettingsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetRelaySettingsRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Settings:` + strings.Replace(this.Settings.String(), "RelaySettings", "RelaySettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayUplinkListEntryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayUplinkListEntryRequest{`,
+		`RelayIds:` + strings.Replace(fmt.Sprintf("%v", this.RelayIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`EndDeviceIds:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetAirtimeUsageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetAirtimeUsageRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AirtimeUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AirtimeUsage{`,
+		`Day:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Day), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Uplinks:` + fmt.Sprintf("%v", this.Uplinks) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UplinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Downlinks:` + fmt.Sprintf("%v", this.Downlinks) + `,`,
+		`DownlinkAirtime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DownlinkAirtime), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNetworkserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateDevAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateDevAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+			m.DevAddr = &v
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateADRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateADRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateADRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MACSettings == nil {
+				m.MACSettings = &MACSettings{}
+			}
+			if err := m.MACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSimulation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRSimulation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, &ADRSimulation_Step{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RecordedAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SimulatedAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedEnergy", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.RecordedEnergy = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedEnergy", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.SimulatedEnergy = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ADRSimulation_Step) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Step: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Step: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPowerIndex", wireType)
+			}
+			m.TxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPowerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbTrans", wireType)
+			}
+			m.NbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbTrans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Airtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Energy", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.Energy = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decision == nil {
+				m.Decision = &ADRDecision{}
+			}
+			if err := m.Decision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkADRReq", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LinkADRReq = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDownlinkSchedulingAttemptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDownlinkSchedulingAttemptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDownlinkSchedulingAttemptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkSchedulingAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkSchedulingAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkSchedulingAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AttemptedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= Class(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slot == nil {
+				m.Slot = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Slot, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptRx1", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttemptRx1 = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptRx2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttemptRx2 = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, &DownlinkSchedulingAttempt_Path{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v MACCommandIdentifier
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetworkserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MACCommandIdentifier(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MACCommands = append(m.MACCommands, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetworkserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNetworkserver
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNetworkserver
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MACCommands) == 0 {
+					m.MACCommands = make([]MACCommandIdentifier, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MACCommandIdentifier
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNetworkserver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MACCommandIdentifier(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MACCommands = append(m.MACCommands, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACCommands", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindow", wireType)
+			}
+			m.RxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkSchedulingAttempt_Path) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Path: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Path: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayIds == nil {
+				m.GatewayIds = &GatewayIdentifiers{}
+			}
+			if err := m.GatewayIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayIds == nil {
+				m.RelayIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.RelayIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkSchedulingAttempts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkSchedulingAttempts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkSchedulingAttempts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attempts = append(m.Attempts, &DownlinkSchedulingAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayJoinRequestFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayJoinRequestFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayJoinRequestFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawJoinEuiDevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawJoinEuiDevEui = append(m.RawJoinEuiDevEui[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawJoinEuiDevEui == nil {
+				m.RawJoinEuiDevEui = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServingRelaySettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServingRelaySettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServingRelaySettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CadPeriodicity", wireType)
+			}
+			m.CadPeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CadPeriodicity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelIndex", wireType)
+			}
+			m.DefaultChannelIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultChannelIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinRequestFilters = append(m.JoinRequestFilters, &RelayJoinRequestFilter{})
+			if err := m.JoinRequestFilters[len(m.JoinRequestFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinRequestLimits == nil {
+				m.JoinRequestLimits = &RelayForwardLimits{}
+			}
+			if err := m.JoinRequestLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifyLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotifyLimits == nil {
+				m.NotifyLimits = &RelayForwardLimits{}
+			}
+			if err := m.NotifyLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalUplinkLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalUplinkLimits == nil {
+				m.GlobalUplinkLimits = &RelayForwardLimits{}
+			}
+			if err := m.GlobalUplinkLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverallLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OverallLimits == nil {
+				m.OverallLimits = &RelayForwardLimits{}
+			}
+			if err := m.OverallLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServedRelaySettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServedRelaySettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServedRelaySettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartEnableLevel", wireType)
+			}
+			m.SmartEnableLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmartEnableLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondChannel == nil {
+				m.SecondChannel = &RelaySecondChannel{}
+			}
+			if err := m.SecondChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelaySettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelaySettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelaySettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Serving", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Serving == nil {
+				m.Serving = &ServingRelaySettings{}
+			}
+			if err := m.Serving.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Served == nil {
+				m.Served = &ServedRelaySettings{}
+			}
+			if err := m.Served.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayUplinkListEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayUplinkListEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayUplinkListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synchronized = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Relay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Settings == nil {
+				m.Settings = &RelaySettings{}
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentSettings == nil {
+				m.CurrentSettings = &RelaySettings{}
+			}
+			if err := m.CurrentSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkList = append(m.UplinkList, &RelayUplinkListEntry{})
+			if err := m.UplinkList[len(m.UplinkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRelaySettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRelaySettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRelaySettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Settings == nil {
+				m.Settings = &RelaySettings{}
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayUplinkListEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayUplinkListEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayUplinkListEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayIds == nil {
+				m.RelayIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.RelayIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDeviceIds == nil {
+				m.EndDeviceIds = &EndDeviceIdentifiers{}
+			}
+			if err := m.EndDeviceIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringNetworkserver(r randyNetworkserver) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
-		tmps[i] = randUTF8RuneNetworkserver(r)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return string(tmps)
+	return nil
 }
-func randUnrecognizedNetworkserver(r randyNetworkserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *GetAirtimeUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldNetworkserver(dAtA, r, fieldNumber, wire)
-	}
-	return dAtA
-}
-func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		v2 := r.Int63()
-		if r.Intn(2) == 0 {
-			v2 *= -1
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAirtimeUsageRequest: wiretype end group for non-group")
 		}
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v2))
-	case 1:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAirtimeUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-	default:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
-}
-func encodeVarintPopulateNetworkserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *GenerateDevAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DevAddr != nil {
-		l = m.DevAddr.Size()
-		n += 1 + l + sovNetworkserver(uint64(l))
 	}
-	return n
-}
 
-func sovNetworkserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNetworkserver(x uint64) (n int) {
-	return sovNetworkserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GenerateDevAddrResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GenerateDevAddrResponse{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringNetworkserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *AirtimeUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -896,17 +7859,17 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateDevAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AirtimeUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateDevAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AirtimeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetworkserver
@@ -916,24 +7879,126 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetworkserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetworkserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
-			m.DevAddr = &v
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Day, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
+			}
+			m.Uplinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uplinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			m.Downlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downlinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DownlinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,3 +9,516 @@ var GenerateDevAddrResponseFieldPathsNested = []string{
 var GenerateDevAddrResponseFieldPathsTopLevel = []string{
 	"dev_addr",
 }
+var SimulateADRRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"field_mask",
+	"mac_settings",
+	"mac_settings.adr",
+	"mac_settings.adr.strategy",
+	"mac_settings.adr.strategy.dynamic",
+	"mac_settings.adr.strategy.dynamic.margin",
+	"mac_settings.adr.strategy.loss_based",
+	"mac_settings.adr.strategy.loss_based.margin",
+	"mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"mac_settings.adr.strategy.mobile",
+	"mac_settings.adr.strategy.mobile.margin",
+	"mac_settings.adr.strategy.static",
+	"mac_settings.adr.strategy.static.data_rate_index",
+	"mac_settings.adr.strategy.static.nb_trans",
+	"mac_settings.adr.strategy.static.tx_power_index",
+	"mac_settings.adr_margin",
+	"mac_settings.beacon_frequency",
+	"mac_settings.beacon_frequency.value",
+	"mac_settings.class_b_timeout",
+	"mac_settings.class_c_timeout",
+	"mac_settings.desired_adr_ack_delay_exponent",
+	"mac_settings.desired_adr_ack_delay_exponent.value",
+	"mac_settings.desired_adr_ack_limit_exponent",
+	"mac_settings.desired_adr_ack_limit_exponent.value",
+	"mac_settings.desired_beacon_frequency",
+	"mac_settings.desired_beacon_frequency.value",
+	"mac_settings.desired_max_duty_cycle",
+	"mac_settings.desired_max_duty_cycle.value",
+	"mac_settings.desired_max_eirp",
+	"mac_settings.desired_max_eirp.value",
+	"mac_settings.desired_ping_slot_data_rate_index",
+	"mac_settings.desired_ping_slot_data_rate_index.value",
+	"mac_settings.desired_ping_slot_frequency",
+	"mac_settings.desired_ping_slot_frequency.value",
+	"mac_settings.desired_rx1_data_rate_offset",
+	"mac_settings.desired_rx1_data_rate_offset.value",
+	"mac_settings.desired_rx1_delay",
+	"mac_settings.desired_rx1_delay.value",
+	"mac_settings.desired_rx2_data_rate_index",
+	"mac_settings.desired_rx2_data_rate_index.value",
+	"mac_settings.desired_rx2_frequency",
+	"mac_settings.desired_rx2_frequency.value",
+	"mac_settings.factory_preset_frequencies",
+	"mac_settings.max_duty_cycle",
+	"mac_settings.max_duty_cycle.value",
+	"mac_settings.ping_slot_data_rate_index",
+	"mac_settings.ping_slot_data_rate_index.value",
+	"mac_settings.ping_slot_frequency",
+	"mac_settings.ping_slot_frequency.value",
+	"mac_settings.ping_slot_periodicity",
+	"mac_settings.ping_slot_periodicity.value",
+	"mac_settings.resets_f_cnt",
+	"mac_settings.resets_f_cnt.value",
+	"mac_settings.rx1_data_rate_offset",
+	"mac_settings.rx1_data_rate_offset.value",
+	"mac_settings.rx1_delay",
+	"mac_settings.rx1_delay.value",
+	"mac_settings.rx2_data_rate_index",
+	"mac_settings.rx2_data_rate_index.value",
+	"mac_settings.rx2_frequency",
+	"mac_settings.rx2_frequency.value",
+	"mac_settings.status_count_periodicity",
+	"mac_settings.status_time_periodicity",
+	"mac_settings.supports_32_bit_f_cnt",
+	"mac_settings.supports_32_bit_f_cnt.value",
+	"mac_settings.use_adr",
+	"mac_settings.use_adr.value",
+}
+
+var SimulateADRRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"field_mask",
+	"mac_settings",
+}
+var ADRSimulationFieldPathsNested = []string{
+	"recorded_airtime",
+	"recorded_energy",
+	"simulated_airtime",
+	"simulated_energy",
+	"steps",
+}
+
+var ADRSimulationFieldPathsTopLevel = []string{
+	"recorded_airtime",
+	"recorded_energy",
+	"simulated_airtime",
+	"simulated_energy",
+	"steps",
+}
+var ADRSimulation_StepFieldPathsNested = []string{
+	"airtime",
+	"data_rate_index",
+	"decision",
+	"decision.data_rate_index",
+	"decision.demodulation_floor",
+	"decision.loss_rate",
+	"decision.margin",
+	"decision.nb_trans",
+	"decision.remaining_margin",
+	"decision.snr",
+	"decision.strategy",
+	"decision.tx_power_index",
+	"decision.uplink_count",
+	"energy",
+	"f_cnt",
+	"link_adr_req",
+	"nb_trans",
+	"tx_power_index",
+}
+
+var ADRSimulation_StepFieldPathsTopLevel = []string{
+	"airtime",
+	"data_rate_index",
+	"decision",
+	"energy",
+	"f_cnt",
+	"link_adr_req",
+	"nb_trans",
+	"tx_power_index",
+}
+var GetDownlinkSchedulingAttemptsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"limit",
+}
+
+var GetDownlinkSchedulingAttemptsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"limit",
+}
+var DownlinkSchedulingAttemptFieldPathsNested = []string{
+	"attempt_rx1",
+	"attempt_rx2",
+	"attempted_at",
+	"class",
+	"correlation_ids",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"mac_commands",
+	"paths",
+	"rx_window",
+	"slot",
+}
+
+var DownlinkSchedulingAttemptFieldPathsTopLevel = []string{
+	"attempt_rx1",
+	"attempt_rx2",
+	"attempted_at",
+	"class",
+	"correlation_ids",
+	"error",
+	"mac_commands",
+	"paths",
+	"rx_window",
+	"slot",
+}
+var DownlinkSchedulingAttempt_PathFieldPathsNested = []string{
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"relay_ids",
+	"relay_ids.application_ids",
+	"relay_ids.application_ids.application_id",
+	"relay_ids.dev_addr",
+	"relay_ids.dev_eui",
+	"relay_ids.device_id",
+	"relay_ids.join_eui",
+}
+
+var DownlinkSchedulingAttempt_PathFieldPathsTopLevel = []string{
+	"error",
+	"gateway_ids",
+	"relay_ids",
+}
+var DownlinkSchedulingAttemptsFieldPathsNested = []string{
+	"attempts",
+}
+
+var DownlinkSchedulingAttemptsFieldPathsTopLevel = []string{
+	"attempts",
+}
+var RelayJoinRequestFilterFieldPathsNested = []string{
+	"action",
+	"raw_join_eui_dev_eui",
+}
+
+var RelayJoinRequestFilterFieldPathsTopLevel = []string{
+	"action",
+	"raw_join_eui_dev_eui",
+}
+var ServingRelaySettingsFieldPathsNested = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"global_uplink_limits",
+	"global_uplink_limits.bucket_size",
+	"global_uplink_limits.reload_rate",
+	"join_request_filters",
+	"join_request_limits",
+	"join_request_limits.bucket_size",
+	"join_request_limits.reload_rate",
+	"notify_limits",
+	"notify_limits.bucket_size",
+	"notify_limits.reload_rate",
+	"overall_limits",
+	"overall_limits.bucket_size",
+	"overall_limits.reload_rate",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+}
+
+var ServingRelaySettingsFieldPathsTopLevel = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"global_uplink_limits",
+	"join_request_filters",
+	"join_request_limits",
+	"notify_limits",
+	"overall_limits",
+	"second_channel",
+}
+var ServedRelaySettingsFieldPathsNested = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"smart_enable_level",
+}
+
+var ServedRelaySettingsFieldPathsTopLevel = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"smart_enable_level",
+}
+var RelaySettingsFieldPathsNested = []string{
+	"served",
+	"served.backoff",
+	"served.mode",
+	"served.second_channel",
+	"served.second_channel.ack_offset",
+	"served.second_channel.data_rate_index",
+	"served.second_channel.frequency",
+	"served.smart_enable_level",
+	"serving",
+	"serving.cad_periodicity",
+	"serving.default_channel_index",
+	"serving.global_uplink_limits",
+	"serving.global_uplink_limits.bucket_size",
+	"serving.global_uplink_limits.reload_rate",
+	"serving.join_request_filters",
+	"serving.join_request_limits",
+	"serving.join_request_limits.bucket_size",
+	"serving.join_request_limits.reload_rate",
+	"serving.notify_limits",
+	"serving.notify_limits.bucket_size",
+	"serving.notify_limits.reload_rate",
+	"serving.overall_limits",
+	"serving.overall_limits.bucket_size",
+	"serving.overall_limits.reload_rate",
+	"serving.second_channel",
+	"serving.second_channel.ack_offset",
+	"serving.second_channel.data_rate_index",
+	"serving.second_channel.frequency",
+}
+
+var RelaySettingsFieldPathsTopLevel = []string{
+	"served",
+	"serving",
+}
+var RelayUplinkListEntryFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"index",
+	"removing",
+	"session_key_id",
+	"synchronized",
+}
+
+var RelayUplinkListEntryFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"index",
+	"removing",
+	"session_key_id",
+	"synchronized",
+}
+var RelayFieldPathsNested = []string{
+	"current_settings",
+	"current_settings.served",
+	"current_settings.served.backoff",
+	"current_settings.served.mode",
+	"current_settings.served.second_channel",
+	"current_settings.served.second_channel.ack_offset",
+	"current_settings.served.second_channel.data_rate_index",
+	"current_settings.served.second_channel.frequency",
+	"current_settings.served.smart_enable_level",
+	"current_settings.serving",
+	"current_settings.serving.cad_periodicity",
+	"current_settings.serving.default_channel_index",
+	"current_settings.serving.global_uplink_limits",
+	"current_settings.serving.global_uplink_limits.bucket_size",
+	"current_settings.serving.global_uplink_limits.reload_rate",
+	"current_settings.serving.join_request_filters",
+	"current_settings.serving.join_request_limits",
+	"current_settings.serving.join_request_limits.bucket_size",
+	"current_settings.serving.join_request_limits.reload_rate",
+	"current_settings.serving.notify_limits",
+	"current_settings.serving.notify_limits.bucket_size",
+	"current_settings.serving.notify_limits.reload_rate",
+	"current_settings.serving.overall_limits",
+	"current_settings.serving.overall_limits.bucket_size",
+	"current_settings.serving.overall_limits.reload_rate",
+	"current_settings.serving.second_channel",
+	"current_settings.serving.second_channel.ack_offset",
+	"current_settings.serving.second_channel.data_rate_index",
+	"current_settings.serving.second_channel.frequency",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"settings",
+	"settings.served",
+	"settings.served.backoff",
+	"settings.served.mode",
+	"settings.served.second_channel",
+	"settings.served.second_channel.ack_offset",
+	"settings.served.second_channel.data_rate_index",
+	"settings.served.second_channel.frequency",
+	"settings.served.smart_enable_level",
+	"settings.serving",
+	"settings.serving.cad_periodicity",
+	"settings.serving.default_channel_index",
+	"settings.serving.global_uplink_limits",
+	"settings.serving.global_uplink_limits.bucket_size",
+	"settings.serving.global_uplink_limits.reload_rate",
+	"settings.serving.join_request_filters",
+	"settings.serving.join_request_limits",
+	"settings.serving.join_request_limits.bucket_size",
+	"settings.serving.join_request_limits.reload_rate",
+	"settings.serving.notify_limits",
+	"settings.serving.notify_limits.bucket_size",
+	"settings.serving.notify_limits.reload_rate",
+	"settings.serving.overall_limits",
+	"settings.serving.overall_limits.bucket_size",
+	"settings.serving.overall_limits.reload_rate",
+	"settings.serving.second_channel",
+	"settings.serving.second_channel.ack_offset",
+	"settings.serving.second_channel.data_rate_index",
+	"settings.serving.second_channel.frequency",
+	"uplink_list",
+}
+
+var RelayFieldPathsTopLevel = []string{
+	"current_settings",
+	"end_device_ids",
+	"settings",
+	"uplink_list",
+}
+var GetRelayRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var GetRelayRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+}
+var SetRelaySettingsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"settings",
+	"settings.served",
+	"settings.served.backoff",
+	"settings.served.mode",
+	"settings.served.second_channel",
+	"settings.served.second_channel.ack_offset",
+	"settings.served.second_channel.data_rate_index",
+	"settings.served.second_channel.frequency",
+	"settings.served.smart_enable_level",
+	"settings.serving",
+	"settings.serving.cad_periodicity",
+	"settings.serving.default_channel_index",
+	"settings.serving.global_uplink_limits",
+	"settings.serving.global_uplink_limits.bucket_size",
+	"settings.serving.global_uplink_limits.reload_rate",
+	"settings.serving.join_request_filters",
+	"settings.serving.join_request_limits",
+	"settings.serving.join_request_limits.bucket_size",
+	"settings.serving.join_request_limits.reload_rate",
+	"settings.serving.notify_limits",
+	"settings.serving.notify_limits.bucket_size",
+	"settings.serving.notify_limits.reload_rate",
+	"settings.serving.overall_limits",
+	"settings.serving.overall_limits.bucket_size",
+	"settings.serving.overall_limits.reload_rate",
+	"settings.serving.second_channel",
+	"settings.serving.second_channel.ack_offset",
+	"settings.serving.second_channel.data_rate_index",
+	"settings.serving.second_channel.frequency",
+}
+
+var SetRelaySettingsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"settings",
+}
+var RelayUplinkListEntryRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"relay_ids",
+	"relay_ids.application_ids",
+	"relay_ids.application_ids.application_id",
+	"relay_ids.dev_addr",
+	"relay_ids.dev_eui",
+	"relay_ids.device_id",
+	"relay_ids.join_eui",
+}
+
+var RelayUplinkListEntryRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"relay_ids",
+}
+var GetAirtimeUsageRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var GetAirtimeUsageRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+}
+var AirtimeUsageFieldPathsNested = []string{
+	"day",
+	"downlink_airtime",
+	"downlinks",
+	"uplink_airtime",
+	"uplinks",
+}
+
+var AirtimeUsageFieldPathsTopLevel = []string{
+	"day",
+	"downlink_airtime",
+	"downlinks",
+	"uplink_airtime",
+	"uplinks",
+}
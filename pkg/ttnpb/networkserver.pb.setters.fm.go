@@ -2,7 +2,12 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+
+	types "github.com/gogo/protobuf/types"
+)
 
 func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
@@ -23,3 +28,1219 @@ func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, path
 	}
 	return nil
 }
+
+func (dst *SimulateADRRequest) SetFields(src *SimulateADRRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "mac_settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACSettings
+				if (src == nil || src.MACSettings == nil) && dst.MACSettings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.MACSettings
+				}
+				if dst.MACSettings != nil {
+					newDst = dst.MACSettings
+				} else {
+					newDst = &MACSettings{}
+					dst.MACSettings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.MACSettings = src.MACSettings
+				} else {
+					dst.MACSettings = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSimulation) SetFields(src *ADRSimulation, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "steps":
+			if len(subs) > 0 {
+				return fmt.Errorf("'steps' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Steps = src.Steps
+			} else {
+				dst.Steps = nil
+			}
+		case "recorded_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'recorded_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RecordedAirtime = src.RecordedAirtime
+			} else {
+				var zero time.Duration
+				dst.RecordedAirtime = zero
+			}
+		case "simulated_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'simulated_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SimulatedAirtime = src.SimulatedAirtime
+			} else {
+				var zero time.Duration
+				dst.SimulatedAirtime = zero
+			}
+		case "recorded_energy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'recorded_energy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RecordedEnergy = src.RecordedEnergy
+			} else {
+				var zero float32
+				dst.RecordedEnergy = zero
+			}
+		case "simulated_energy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'simulated_energy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SimulatedEnergy = src.SimulatedEnergy
+			} else {
+				var zero float32
+				dst.SimulatedEnergy = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRSimulation_Step) SetFields(src *ADRSimulation_Step, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndex = src.TxPowerIndex
+			} else {
+				var zero uint32
+				dst.TxPowerIndex = zero
+			}
+		case "nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NbTrans = src.NbTrans
+			} else {
+				var zero uint32
+				dst.NbTrans = zero
+			}
+		case "airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Airtime = src.Airtime
+			} else {
+				var zero time.Duration
+				dst.Airtime = zero
+			}
+		case "energy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'energy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Energy = src.Energy
+			} else {
+				var zero float32
+				dst.Energy = zero
+			}
+		case "decision":
+			if len(subs) > 0 {
+				var newDst, newSrc *ADRDecision
+				if (src == nil || src.Decision == nil) && dst.Decision == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Decision
+				}
+				if dst.Decision != nil {
+					newDst = dst.Decision
+				} else {
+					newDst = &ADRDecision{}
+					dst.Decision = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Decision = src.Decision
+				} else {
+					dst.Decision = nil
+				}
+			}
+		case "link_adr_req":
+			if len(subs) > 0 {
+				return fmt.Errorf("'link_adr_req' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LinkADRReq = src.LinkADRReq
+			} else {
+				var zero bool
+				dst.LinkADRReq = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetDownlinkSchedulingAttemptsRequest) SetFields(src *GetDownlinkSchedulingAttemptsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkSchedulingAttempt) SetFields(src *DownlinkSchedulingAttempt, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "attempted_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempted_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AttemptedAt = src.AttemptedAt
+			} else {
+				var zero time.Time
+				dst.AttemptedAt = zero
+			}
+		case "class":
+			if len(subs) > 0 {
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Class = src.Class
+			} else {
+				var zero Class
+				dst.Class = zero
+			}
+		case "slot":
+			if len(subs) > 0 {
+				return fmt.Errorf("'slot' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Slot = src.Slot
+			} else {
+				dst.Slot = nil
+			}
+		case "attempt_rx1":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempt_rx1' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AttemptRx1 = src.AttemptRx1
+			} else {
+				var zero bool
+				dst.AttemptRx1 = zero
+			}
+		case "attempt_rx2":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempt_rx2' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AttemptRx2 = src.AttemptRx2
+			} else {
+				var zero bool
+				dst.AttemptRx2 = zero
+			}
+		case "paths":
+			if len(subs) > 0 {
+				return fmt.Errorf("'paths' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Paths = src.Paths
+			} else {
+				dst.Paths = nil
+			}
+		case "mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MACCommands = src.MACCommands
+			} else {
+				dst.MACCommands = nil
+			}
+		case "rx_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RxWindow = src.RxWindow
+			} else {
+				var zero uint32
+				dst.RxWindow = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "correlation_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationIDs = src.CorrelationIDs
+			} else {
+				dst.CorrelationIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkSchedulingAttempt_Path) SetFields(src *DownlinkSchedulingAttempt_Path, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "relay_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.RelayIds == nil) && dst.RelayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RelayIds
+				}
+				if dst.RelayIds != nil {
+					newDst = dst.RelayIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.RelayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RelayIds = src.RelayIds
+				} else {
+					dst.RelayIds = nil
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkSchedulingAttempts) SetFields(src *DownlinkSchedulingAttempts, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				dst.Attempts = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayJoinRequestFilter) SetFields(src *RelayJoinRequestFilter, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero uint32
+				dst.Action = zero
+			}
+		case "raw_join_eui_dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_join_eui_dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawJoinEuiDevEui = src.RawJoinEuiDevEui
+			} else {
+				dst.RawJoinEuiDevEui = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServingRelaySettings) SetFields(src *ServingRelaySettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "cad_periodicity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicity = src.CadPeriodicity
+			} else {
+				var zero uint32
+				dst.CadPeriodicity = zero
+			}
+		case "default_channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
+			} else {
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "join_request_filters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_request_filters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinRequestFilters = src.JoinRequestFilters
+			} else {
+				dst.JoinRequestFilters = nil
+			}
+		case "join_request_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.JoinRequestLimits == nil) && dst.JoinRequestLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.JoinRequestLimits
+				}
+				if dst.JoinRequestLimits != nil {
+					newDst = dst.JoinRequestLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.JoinRequestLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinRequestLimits = src.JoinRequestLimits
+				} else {
+					dst.JoinRequestLimits = nil
+				}
+			}
+		case "notify_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.NotifyLimits == nil) && dst.NotifyLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.NotifyLimits
+				}
+				if dst.NotifyLimits != nil {
+					newDst = dst.NotifyLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.NotifyLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.NotifyLimits = src.NotifyLimits
+				} else {
+					dst.NotifyLimits = nil
+				}
+			}
+		case "global_uplink_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.GlobalUplinkLimits == nil) && dst.GlobalUplinkLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GlobalUplinkLimits
+				}
+				if dst.GlobalUplinkLimits != nil {
+					newDst = dst.GlobalUplinkLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.GlobalUplinkLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GlobalUplinkLimits = src.GlobalUplinkLimits
+				} else {
+					dst.GlobalUplinkLimits = nil
+				}
+			}
+		case "overall_limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.OverallLimits == nil) && dst.OverallLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OverallLimits
+				}
+				if dst.OverallLimits != nil {
+					newDst = dst.OverallLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.OverallLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OverallLimits = src.OverallLimits
+				} else {
+					dst.OverallLimits = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServedRelaySettings) SetFields(src *ServedRelaySettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mode":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mode' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mode = src.Mode
+			} else {
+				var zero uint32
+				dst.Mode = zero
+			}
+		case "smart_enable_level":
+			if len(subs) > 0 {
+				return fmt.Errorf("'smart_enable_level' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SmartEnableLevel = src.SmartEnableLevel
+			} else {
+				var zero uint32
+				dst.SmartEnableLevel = zero
+			}
+		case "backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Backoff = src.Backoff
+			} else {
+				var zero uint32
+				dst.Backoff = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelaySettings) SetFields(src *RelaySettings, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "serving":
+			if len(subs) > 0 {
+				var newDst, newSrc *ServingRelaySettings
+				if (src == nil || src.Serving == nil) && dst.Serving == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Serving
+				}
+				if dst.Serving != nil {
+					newDst = dst.Serving
+				} else {
+					newDst = &ServingRelaySettings{}
+					dst.Serving = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Serving = src.Serving
+				} else {
+					dst.Serving = nil
+				}
+			}
+		case "served":
+			if len(subs) > 0 {
+				var newDst, newSrc *ServedRelaySettings
+				if (src == nil || src.Served == nil) && dst.Served == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Served
+				}
+				if dst.Served != nil {
+					newDst = dst.Served
+				} else {
+					newDst = &ServedRelaySettings{}
+					dst.Served = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Served = src.Served
+				} else {
+					dst.Served = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayUplinkListEntry) SetFields(src *RelayUplinkListEntry, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Index = src.Index
+			} else {
+				var zero uint32
+				dst.Index = zero
+			}
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyID = src.SessionKeyID
+			} else {
+				dst.SessionKeyID = nil
+			}
+		case "synchronized":
+			if len(subs) > 0 {
+				return fmt.Errorf("'synchronized' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Synchronized = src.Synchronized
+			} else {
+				var zero bool
+				dst.Synchronized = zero
+			}
+		case "removing":
+			if len(subs) > 0 {
+				return fmt.Errorf("'removing' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Removing = src.Removing
+			} else {
+				var zero bool
+				dst.Removing = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *Relay) SetFields(src *Relay, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySettings
+				if (src == nil || src.Settings == nil) && dst.Settings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Settings
+				}
+				if dst.Settings != nil {
+					newDst = dst.Settings
+				} else {
+					newDst = &RelaySettings{}
+					dst.Settings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Settings = src.Settings
+				} else {
+					dst.Settings = nil
+				}
+			}
+		case "current_settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySettings
+				if (src == nil || src.CurrentSettings == nil) && dst.CurrentSettings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.CurrentSettings
+				}
+				if dst.CurrentSettings != nil {
+					newDst = dst.CurrentSettings
+				} else {
+					newDst = &RelaySettings{}
+					dst.CurrentSettings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.CurrentSettings = src.CurrentSettings
+				} else {
+					dst.CurrentSettings = nil
+				}
+			}
+		case "uplink_list":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_list' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkList = src.UplinkList
+			} else {
+				dst.UplinkList = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetRelayRequest) SetFields(src *GetRelayRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetRelaySettingsRequest) SetFields(src *SetRelaySettingsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySettings
+				if (src == nil || src.Settings == nil) && dst.Settings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Settings
+				}
+				if dst.Settings != nil {
+					newDst = dst.Settings
+				} else {
+					newDst = &RelaySettings{}
+					dst.Settings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Settings = src.Settings
+				} else {
+					dst.Settings = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayUplinkListEntryRequest) SetFields(src *RelayUplinkListEntryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "relay_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.RelayIds == nil) && dst.RelayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RelayIds
+				}
+				if dst.RelayIds != nil {
+					newDst = dst.RelayIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.RelayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RelayIds = src.RelayIds
+				} else {
+					dst.RelayIds = nil
+				}
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetAirtimeUsageRequest) SetFields(src *GetAirtimeUsageRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AirtimeUsage) SetFields(src *AirtimeUsage, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "day":
+			if len(subs) > 0 {
+				return fmt.Errorf("'day' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Day = src.Day
+			} else {
+				var zero time.Time
+				dst.Day = zero
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				var zero uint32
+				dst.Uplinks = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				var zero uint32
+				dst.Downlinks = zero
+			}
+		case "downlink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkAirtime = src.DownlinkAirtime
+			} else {
+				var zero time.Duration
+				dst.DownlinkAirtime = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -117,3 +117,2083 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateDevAddrResponseValidationError{}
+
+// ValidateFields checks the field values on SimulateADRRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SimulateADRRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SimulateADRRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateADRRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "mac_settings":
+
+			if v, ok := interface{}(m.GetMACSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateADRRequestValidationError{
+						field:  "mac_settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateADRRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SimulateADRRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SimulateADRRequestValidationError is the validation error returned by
+// SimulateADRRequest.ValidateFields if the designated constraints aren't met.
+type SimulateADRRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateADRRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateADRRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateADRRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateADRRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateADRRequestValidationError) ErrorName() string {
+	return "SimulateADRRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateADRRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateADRRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateADRRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateADRRequestValidationError{}
+
+// ValidateFields checks the field values on ADRSimulation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ADRSimulation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSimulationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "steps":
+
+			for idx, item := range m.GetSteps() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ADRSimulationValidationError{
+							field:  fmt.Sprintf("steps[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "recorded_airtime":
+			// no validation rules for RecordedAirtime
+		case "simulated_airtime":
+			// no validation rules for SimulatedAirtime
+		case "recorded_energy":
+			// no validation rules for RecordedEnergy
+		case "simulated_energy":
+			// no validation rules for SimulatedEnergy
+		default:
+			return ADRSimulationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSimulationValidationError is the validation error returned by
+// ADRSimulation.ValidateFields if the designated constraints aren't met.
+type ADRSimulationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSimulationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSimulationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSimulationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSimulationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSimulationValidationError) ErrorName() string {
+	return "ADRSimulationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSimulationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSimulation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSimulationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSimulationValidationError{}
+
+// ValidateFields checks the field values on ADRSimulation_Step with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ADRSimulation_Step) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ADRSimulation_StepFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "data_rate_index":
+			// no validation rules for DataRateIndex
+		case "tx_power_index":
+			// no validation rules for TxPowerIndex
+		case "nb_trans":
+			// no validation rules for NbTrans
+		case "airtime":
+			// no validation rules for Airtime
+		case "energy":
+			// no validation rules for Energy
+		case "decision":
+
+			if v, ok := interface{}(m.GetDecision()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ADRSimulation_StepValidationError{
+						field:  "decision",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "link_adr_req":
+			// no validation rules for LinkADRReq
+		default:
+			return ADRSimulation_StepValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ADRSimulation_StepValidationError is the validation error returned by
+// ADRSimulation_Step.ValidateFields if the designated constraints aren't met.
+type ADRSimulation_StepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ADRSimulation_StepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ADRSimulation_StepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ADRSimulation_StepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ADRSimulation_StepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ADRSimulation_StepValidationError) ErrorName() string {
+	return "ADRSimulation_StepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ADRSimulation_StepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sADRSimulation_Step.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ADRSimulation_StepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ADRSimulation_StepValidationError{}
+
+// ValidateFields checks the field values on GetDownlinkSchedulingAttemptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetDownlinkSchedulingAttemptsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetDownlinkSchedulingAttemptsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetDownlinkSchedulingAttemptsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 100 {
+				return GetDownlinkSchedulingAttemptsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 100",
+				}
+			}
+
+		default:
+			return GetDownlinkSchedulingAttemptsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetDownlinkSchedulingAttemptsRequestValidationError is the validation error returned by
+// GetDownlinkSchedulingAttemptsRequest.ValidateFields if the designated constraints aren't met.
+type GetDownlinkSchedulingAttemptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) ErrorName() string {
+	return "GetDownlinkSchedulingAttemptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownlinkSchedulingAttemptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownlinkSchedulingAttemptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownlinkSchedulingAttemptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownlinkSchedulingAttemptsRequestValidationError{}
+
+// ValidateFields checks the field values on DownlinkSchedulingAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkSchedulingAttempt) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkSchedulingAttemptFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "attempted_at":
+
+			if v, ok := interface{}(&m.AttemptedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttemptValidationError{
+						field:  "attempted_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "class":
+			// no validation rules for Class
+		case "slot":
+
+			if v, ok := interface{}(m.GetSlot()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttemptValidationError{
+						field:  "slot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "attempt_rx1":
+			// no validation rules for AttemptRx1
+		case "attempt_rx2":
+			// no validation rules for AttemptRx2
+		case "paths":
+
+			for idx, item := range m.GetPaths() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DownlinkSchedulingAttemptValidationError{
+							field:  fmt.Sprintf("paths[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "mac_commands":
+			// no validation rules for MACCommands
+		case "rx_window":
+			// no validation rules for RxWindow
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttemptValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "correlation_ids":
+
+			for idx, item := range m.GetCorrelationIDs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return DownlinkSchedulingAttemptValidationError{
+						field:  fmt.Sprintf("correlation_ids[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
+		default:
+			return DownlinkSchedulingAttemptValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkSchedulingAttemptValidationError is the validation error returned by
+// DownlinkSchedulingAttempt.ValidateFields if the designated constraints aren't met.
+type DownlinkSchedulingAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkSchedulingAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkSchedulingAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkSchedulingAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkSchedulingAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkSchedulingAttemptValidationError) ErrorName() string {
+	return "DownlinkSchedulingAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkSchedulingAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkSchedulingAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkSchedulingAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkSchedulingAttemptValidationError{}
+
+// ValidateFields checks the field values on DownlinkSchedulingAttempt_Path with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkSchedulingAttempt_Path) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkSchedulingAttempt_PathFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttempt_PathValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "relay_ids":
+
+			if v, ok := interface{}(m.GetRelayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttempt_PathValidationError{
+						field:  "relay_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkSchedulingAttempt_PathValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DownlinkSchedulingAttempt_PathValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkSchedulingAttempt_PathValidationError is the validation error returned by
+// DownlinkSchedulingAttempt_Path.ValidateFields if the designated constraints aren't met.
+type DownlinkSchedulingAttempt_PathValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkSchedulingAttempt_PathValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkSchedulingAttempt_PathValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkSchedulingAttempt_PathValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkSchedulingAttempt_PathValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkSchedulingAttempt_PathValidationError) ErrorName() string {
+	return "DownlinkSchedulingAttempt_PathValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkSchedulingAttempt_PathValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkSchedulingAttempt_Path.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkSchedulingAttempt_PathValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkSchedulingAttempt_PathValidationError{}
+
+// ValidateFields checks the field values on DownlinkSchedulingAttempts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkSchedulingAttempts) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkSchedulingAttemptsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "attempts":
+
+			for idx, item := range m.GetAttempts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DownlinkSchedulingAttemptsValidationError{
+							field:  fmt.Sprintf("attempts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return DownlinkSchedulingAttemptsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkSchedulingAttemptsValidationError is the validation error returned by
+// DownlinkSchedulingAttempts.ValidateFields if the designated constraints aren't met.
+type DownlinkSchedulingAttemptsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkSchedulingAttemptsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkSchedulingAttemptsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkSchedulingAttemptsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkSchedulingAttemptsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkSchedulingAttemptsValidationError) ErrorName() string {
+	return "DownlinkSchedulingAttemptsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkSchedulingAttemptsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkSchedulingAttempts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkSchedulingAttemptsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkSchedulingAttemptsValidationError{}
+
+// ValidateFields checks the field values on RelayJoinRequestFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayJoinRequestFilter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayJoinRequestFilterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "action":
+
+			if m.GetAction() > 2 {
+				return RelayJoinRequestFilterValidationError{
+					field:  "action",
+					reason: "value must be less than or equal to 2",
+				}
+			}
+
+		case "raw_join_eui_dev_eui":
+
+			if l := len(m.GetRawJoinEuiDevEui()); l < 1 || l > 16 {
+				return RelayJoinRequestFilterValidationError{
+					field:  "raw_join_eui_dev_eui",
+					reason: "value length must be between 1 and 16 bytes, inclusive",
+				}
+			}
+
+		default:
+			return RelayJoinRequestFilterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayJoinRequestFilterValidationError is the validation error returned by
+// RelayJoinRequestFilter.ValidateFields if the designated constraints aren't met.
+type RelayJoinRequestFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayJoinRequestFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayJoinRequestFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayJoinRequestFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayJoinRequestFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayJoinRequestFilterValidationError) ErrorName() string {
+	return "RelayJoinRequestFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayJoinRequestFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayJoinRequestFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayJoinRequestFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayJoinRequestFilterValidationError{}
+
+// ValidateFields checks the field values on ServingRelaySettings with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServingRelaySettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServingRelaySettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "cad_periodicity":
+
+			if m.GetCadPeriodicity() > 5 {
+				return ServingRelaySettingsValidationError{
+					field:  "cad_periodicity",
+					reason: "value must be less than or equal to 5",
+				}
+			}
+
+		case "default_channel_index":
+
+			if m.GetDefaultChannelIndex() > 1 {
+				return ServingRelaySettingsValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 1",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelaySettingsValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "join_request_filters":
+
+			if len(m.GetJoinRequestFilters()) > 16 {
+				return ServingRelaySettingsValidationError{
+					field:  "join_request_filters",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetJoinRequestFilters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ServingRelaySettingsValidationError{
+							field:  fmt.Sprintf("join_request_filters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "join_request_limits":
+
+			if v, ok := interface{}(m.GetJoinRequestLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelaySettingsValidationError{
+						field:  "join_request_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notify_limits":
+
+			if v, ok := interface{}(m.GetNotifyLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelaySettingsValidationError{
+						field:  "notify_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "global_uplink_limits":
+
+			if v, ok := interface{}(m.GetGlobalUplinkLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelaySettingsValidationError{
+						field:  "global_uplink_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "overall_limits":
+
+			if v, ok := interface{}(m.GetOverallLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelaySettingsValidationError{
+						field:  "overall_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ServingRelaySettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServingRelaySettingsValidationError is the validation error returned by
+// ServingRelaySettings.ValidateFields if the designated constraints aren't met.
+type ServingRelaySettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServingRelaySettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServingRelaySettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServingRelaySettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServingRelaySettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServingRelaySettingsValidationError) ErrorName() string {
+	return "ServingRelaySettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServingRelaySettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServingRelaySettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServingRelaySettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServingRelaySettingsValidationError{}
+
+// ValidateFields checks the field values on ServedRelaySettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServedRelaySettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServedRelaySettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mode":
+
+			if m.GetMode() > 3 {
+				return ServedRelaySettingsValidationError{
+					field:  "mode",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "smart_enable_level":
+
+			if m.GetSmartEnableLevel() > 3 {
+				return ServedRelaySettingsValidationError{
+					field:  "smart_enable_level",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "backoff":
+
+			if m.GetBackoff() > 63 {
+				return ServedRelaySettingsValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServedRelaySettingsValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ServedRelaySettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServedRelaySettingsValidationError is the validation error returned by
+// ServedRelaySettings.ValidateFields if the designated constraints aren't met.
+type ServedRelaySettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServedRelaySettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServedRelaySettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServedRelaySettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServedRelaySettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServedRelaySettingsValidationError) ErrorName() string {
+	return "ServedRelaySettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServedRelaySettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServedRelaySettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServedRelaySettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServedRelaySettingsValidationError{}
+
+// ValidateFields checks the field values on RelaySettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelaySettings) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelaySettingsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "serving":
+
+			if v, ok := interface{}(m.GetServing()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelaySettingsValidationError{
+						field:  "serving",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "served":
+
+			if v, ok := interface{}(m.GetServed()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelaySettingsValidationError{
+						field:  "served",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return RelaySettingsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelaySettingsValidationError is the validation error returned by
+// RelaySettings.ValidateFields if the designated constraints aren't met.
+type RelaySettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelaySettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelaySettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelaySettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelaySettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelaySettingsValidationError) ErrorName() string {
+	return "RelaySettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelaySettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelaySettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelaySettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelaySettingsValidationError{}
+
+// ValidateFields checks the field values on RelayUplinkListEntry with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayUplinkListEntry) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayUplinkListEntryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return RelayUplinkListEntryValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayUplinkListEntryValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "index":
+
+			if m.GetIndex() > 15 {
+				return RelayUplinkListEntryValidationError{
+					field:  "index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "session_key_id":
+			// no validation rules for SessionKeyID
+		case "synchronized":
+			// no validation rules for Synchronized
+		case "removing":
+			// no validation rules for Removing
+		default:
+			return RelayUplinkListEntryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayUplinkListEntryValidationError is the validation error returned by
+// RelayUplinkListEntry.ValidateFields if the designated constraints aren't met.
+type RelayUplinkListEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayUplinkListEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayUplinkListEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayUplinkListEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayUplinkListEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayUplinkListEntryValidationError) ErrorName() string {
+	return "RelayUplinkListEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayUplinkListEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayUplinkListEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayUplinkListEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayUplinkListEntryValidationError{}
+
+// ValidateFields checks the field values on Relay with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Relay) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "settings":
+
+			if v, ok := interface{}(m.GetSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayValidationError{
+						field:  "settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "current_settings":
+
+			if v, ok := interface{}(m.GetCurrentSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayValidationError{
+						field:  "current_settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_list":
+
+			if len(m.GetUplinkList()) > 16 {
+				return RelayValidationError{
+					field:  "uplink_list",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetUplinkList() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RelayValidationError{
+							field:  fmt.Sprintf("uplink_list[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RelayValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayValidationError is the validation error returned by
+// Relay.ValidateFields if the designated constraints aren't met.
+type RelayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayValidationError) ErrorName() string {
+	return "RelayValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayValidationError{}
+
+// ValidateFields checks the field values on GetRelayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRelayRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetRelayRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetRelayRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetRelayRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetRelayRequestValidationError is the validation error returned by
+// GetRelayRequest.ValidateFields if the designated constraints aren't met.
+type GetRelayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelayRequestValidationError) ErrorName() string {
+	return "GetRelayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelayRequestValidationError{}
+
+// ValidateFields checks the field values on SetRelaySettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetRelaySettingsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetRelaySettingsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetRelaySettingsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "settings":
+
+			if v, ok := interface{}(m.GetSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetRelaySettingsRequestValidationError{
+						field:  "settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetRelaySettingsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetRelaySettingsRequestValidationError is the validation error returned by
+// SetRelaySettingsRequest.ValidateFields if the designated constraints aren't met.
+type SetRelaySettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRelaySettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRelaySettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRelaySettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRelaySettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRelaySettingsRequestValidationError) ErrorName() string {
+	return "SetRelaySettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRelaySettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRelaySettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRelaySettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRelaySettingsRequestValidationError{}
+
+// ValidateFields checks the field values on RelayUplinkListEntryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RelayUplinkListEntryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayUplinkListEntryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "relay_ids":
+
+			if m.GetRelayIds() == nil {
+				return RelayUplinkListEntryRequestValidationError{
+					field:  "relay_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRelayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayUplinkListEntryRequestValidationError{
+						field:  "relay_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return RelayUplinkListEntryRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayUplinkListEntryRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return RelayUplinkListEntryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayUplinkListEntryRequestValidationError is the validation error returned
+// by RelayUplinkListEntryRequest.ValidateFields if the designated constraints
+// aren't met.
+type RelayUplinkListEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayUplinkListEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayUplinkListEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayUplinkListEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayUplinkListEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayUplinkListEntryRequestValidationError) ErrorName() string {
+	return "RelayUplinkListEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayUplinkListEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayUplinkListEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayUplinkListEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayUplinkListEntryRequestValidationError{}
+
+// ValidateFields checks the field values on GetAirtimeUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetAirtimeUsageRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetAirtimeUsageRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetAirtimeUsageRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetAirtimeUsageRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetAirtimeUsageRequestValidationError is the validation error returned by
+// GetAirtimeUsageRequest.ValidateFields if the designated constraints aren't met.
+type GetAirtimeUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAirtimeUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAirtimeUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAirtimeUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAirtimeUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAirtimeUsageRequestValidationError) ErrorName() string {
+	return "GetAirtimeUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAirtimeUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAirtimeUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAirtimeUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAirtimeUsageRequestValidationError{}
+
+// ValidateFields checks the field values on AirtimeUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AirtimeUsage) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AirtimeUsageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "day":
+
+			if v, ok := interface{}(&m.Day).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AirtimeUsageValidationError{
+						field:  "day",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplinks":
+			// no validation rules for Uplinks
+		case "uplink_airtime":
+			// no validation rules for UplinkAirtime
+		case "downlinks":
+			// no validation rules for Downlinks
+		case "downlink_airtime":
+			// no validation rules for DownlinkAirtime
+		default:
+			return AirtimeUsageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AirtimeUsageValidationError is the validation error returned by
+// AirtimeUsage.ValidateFields if the designated constraints aren't met.
+type AirtimeUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AirtimeUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AirtimeUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AirtimeUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AirtimeUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AirtimeUsageValidationError) ErrorName() string {
+	return "AirtimeUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AirtimeUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAirtimeUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AirtimeUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AirtimeUsageValidationError{}
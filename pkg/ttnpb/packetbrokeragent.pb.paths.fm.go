@@ -5,6 +5,12 @@ package ttnpb
 var UpdatePacketBrokerGatewayRequestFieldPathsNested = []string{
 	"field_mask",
 	"gateway",
+	"gateway.alert_settings",
+	"gateway.alert_settings.disabled",
+	"gateway.alert_settings.disconnect_threshold",
+	"gateway.alert_settings.max_round_trip_time",
+	"gateway.alert_settings.status_interval",
+	"gateway.alert_settings.uplink_silence_threshold",
 	"gateway.antennas",
 	"gateway.attributes",
 	"gateway.auto_update",
@@ -39,9 +45,22 @@ var UpdatePacketBrokerGatewayRequestFieldPathsNested = []string{
 	"gateway.target_cups_key.key_id",
 	"gateway.target_cups_key.value",
 	"gateway.target_cups_uri",
+	"gateway.udp_authentication_mode",
+	"gateway.udp_secret",
+	"gateway.udp_secret.key_id",
+	"gateway.udp_secret.value",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
+	"gateway.uplink_filters.allow_dev_addr_prefixes",
+	"gateway.uplink_filters.allow_join_eui_ranges",
+	"gateway.uplink_filters.deny_dev_addr_prefixes",
+	"gateway.uplink_filters.deny_join_eui_ranges",
+	"gateway.uplink_filters.dry_run",
+	"gateway.uplink_filters.frequencies",
+	"gateway.uplink_filters.min_rssi",
+	"gateway.uplink_filters.min_snr",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -64,6 +64,19 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr",
+	"end_device.mac_settings.adr.strategy",
+	"end_device.mac_settings.adr.strategy.dynamic",
+	"end_device.mac_settings.adr.strategy.dynamic.margin",
+	"end_device.mac_settings.adr.strategy.loss_based",
+	"end_device.mac_settings.adr.strategy.loss_based.margin",
+	"end_device.mac_settings.adr.strategy.loss_based.max_loss_rate",
+	"end_device.mac_settings.adr.strategy.mobile",
+	"end_device.mac_settings.adr.strategy.mobile.margin",
+	"end_device.mac_settings.adr.strategy.static",
+	"end_device.mac_settings.adr.strategy.static.data_rate_index",
+	"end_device.mac_settings.adr.strategy.static.nb_trans",
+	"end_device.mac_settings.adr.strategy.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
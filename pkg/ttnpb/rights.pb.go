@@ -130,6 +130,8 @@ const (
 	RIGHT_GATEWAY_WRITE_SECRETS Right = 57
 	// The right to retrieve secrets associated with this gateway.
 	RIGHT_GATEWAY_READ_SECRETS Right = 58
+	// The right to run commands and open remote shell sessions on the gateway.
+	RIGHT_GATEWAY_REMOTE_CONTROL Right = 59
 	// The pseudo-right for all (current and future) gateway rights.
 	RIGHT_GATEWAY_ALL Right = 40
 	// The right to view organization information.
@@ -209,6 +211,7 @@ var Right_name = map[int32]string{
 	39: "RIGHT_GATEWAY_LOCATION_READ",
 	57: "RIGHT_GATEWAY_WRITE_SECRETS",
 	58: "RIGHT_GATEWAY_READ_SECRETS",
+	59: "RIGHT_GATEWAY_REMOTE_CONTROL",
 	40: "RIGHT_GATEWAY_ALL",
 	41: "RIGHT_ORGANIZATION_INFO",
 	42: "RIGHT_ORGANIZATION_SETTINGS_BASIC",
@@ -271,6 +274,7 @@ var Right_value = map[string]int32{
 	"RIGHT_GATEWAY_LOCATION_READ":              39,
 	"RIGHT_GATEWAY_WRITE_SECRETS":              57,
 	"RIGHT_GATEWAY_READ_SECRETS":               58,
+	"RIGHT_GATEWAY_REMOTE_CONTROL":             59,
 	"RIGHT_GATEWAY_ALL":                        40,
 	"RIGHT_ORGANIZATION_INFO":                  41,
 	"RIGHT_ORGANIZATION_SETTINGS_BASIC":        42,
@@ -642,91 +646,92 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x3d, 0x6c, 0xdb, 0x46,
-	0x14, 0xe6, 0xc9, 0xfa, 0xf3, 0x39, 0x76, 0x2e, 0x97, 0xd8, 0x51, 0x64, 0xe7, 0xe4, 0xc8, 0xf9,
-	0x71, 0xd3, 0x48, 0x6a, 0xed, 0xfe, 0xa3, 0x28, 0x4a, 0x49, 0xb4, 0x43, 0x9b, 0x91, 0x5c, 0x92,
-	0x4e, 0x90, 0x2c, 0x02, 0x6d, 0x31, 0x32, 0x61, 0x9b, 0x14, 0x28, 0xc6, 0x89, 0x3b, 0x05, 0x9d,
-	0x82, 0x4e, 0x41, 0xa7, 0x8e, 0x05, 0x8a, 0x02, 0x01, 0xba, 0x04, 0x5d, 0x9a, 0x6e, 0x19, 0x3d,
-	0x66, 0xcc, 0xe4, 0x46, 0x54, 0x87, 0x8c, 0x19, 0x83, 0x4c, 0x85, 0xc8, 0x93, 0xf9, 0x23, 0x39,
-	0xae, 0xd1, 0xed, 0x74, 0xef, 0x7b, 0x1f, 0xdf, 0x7b, 0xdf, 0x77, 0x0f, 0x36, 0x24, 0x5b, 0x86,
-	0xa9, 0xdc, 0x57, 0xf4, 0x5c, 0xcb, 0x52, 0xd6, 0x37, 0x0b, 0x4a, 0x53, 0x2b, 0x98, 0x5a, 0x63,
-	0xc3, 0x6a, 0xe5, 0x9b, 0xa6, 0x61, 0x19, 0x78, 0xcc, 0xb2, 0xf4, 0x3c, 0xc5, 0xe4, 0x77, 0xe6,
-	0xd3, 0x6c, 0x43, 0xb3, 0x36, 0xee, 0xad, 0xe5, 0xd7, 0x8d, 0xed, 0x82, 0xaa, 0xef, 0x18, 0xbb,
-	0x4d, 0xd3, 0x78, 0xb0, 0x5b, 0x70, 0xc0, 0xeb, 0xb9, 0x86, 0xaa, 0xe7, 0x76, 0x94, 0x2d, 0xad,
-	0xae, 0x58, 0x6a, 0xa1, 0xef, 0xe0, 0x52, 0xa6, 0x73, 0x3e, 0x8a, 0x86, 0xd1, 0x30, 0xdc, 0xe4,
-	0xb5, 0x7b, 0x77, 0x9d, 0x5f, 0xce, 0x0f, 0xe7, 0x44, 0xe1, 0x99, 0x86, 0x61, 0x34, 0xb6, 0x54,
-	0x0f, 0x65, 0x69, 0xdb, 0x6a, 0xcb, 0x52, 0xb6, 0x9b, 0x14, 0x30, 0xd3, 0xdf, 0x82, 0x56, 0x57,
-	0x75, 0x4b, 0xbb, 0xab, 0xa9, 0x26, 0xed, 0x23, 0xbb, 0x00, 0xe3, 0xa2, 0xd3, 0x17, 0xfe, 0x1a,
-	0xc6, 0xdd, 0x0e, 0x53, 0x60, 0x7a, 0x68, 0x76, 0x6c, 0x6e, 0x3c, 0x1f, 0x6c, 0x31, 0xef, 0xe0,
-	0x8a, 0xa3, 0xef, 0x8a, 0xf0, 0x27, 0x90, 0xc8, 0xc6, 0x7e, 0x00, 0x11, 0x04, 0x44, 0x9a, 0x93,
-	0xfd, 0x27, 0x02, 0xe3, 0xec, 0x0a, 0xbf, 0xac, 0xee, 0xe2, 0x09, 0x18, 0xd1, 0xea, 0x29, 0x30,
-	0x0d, 0x66, 0x87, 0x8b, 0x71, 0x7b, 0x3f, 0x13, 0xe1, 0xcb, 0x62, 0x44, 0xab, 0x63, 0x04, 0x87,
-	0x36, 0xd5, 0xdd, 0x54, 0xa4, 0x1b, 0x10, 0xbb, 0x47, 0x3c, 0x09, 0xa3, 0xba, 0xb2, 0xad, 0xa6,
-	0x86, 0x1c, 0x6c, 0xe2, 0x5d, 0x31, 0x6a, 0x46, 0x52, 0x73, 0xa2, 0x73, 0xe9, 0xab, 0x27, 0x7a,
-	0xfc, 0x7a, 0x70, 0x09, 0xc2, 0x75, 0x53, 0x55, 0x2c, 0xb5, 0x5e, 0x53, 0xac, 0x54, 0x6c, 0x1a,
-	0xcc, 0x8e, 0xcc, 0xa5, 0xf3, 0xee, 0xc8, 0xf2, 0xbd, 0x91, 0xe5, 0xe5, 0xde, 0xc8, 0x8a, 0xc9,
-	0xbd, 0xfd, 0x0c, 0xf3, 0xf8, 0xef, 0x0c, 0x10, 0x87, 0x69, 0x1e, 0x6b, 0x75, 0x49, 0xee, 0x35,
-	0xeb, 0x3d, 0x92, 0xf8, 0x71, 0x48, 0x68, 0x1e, 0x6b, 0xe1, 0x65, 0x08, 0xd5, 0x07, 0x4d, 0xcd,
-	0x54, 0x5b, 0x5d, 0x92, 0xc4, 0x91, 0x24, 0xe8, 0x5d, 0x31, 0xf6, 0x07, 0x88, 0x7c, 0x0b, 0xf6,
-	0xf6, 0x33, 0xc0, 0x25, 0xa3, 0xf9, 0xac, 0x95, 0xe5, 0x61, 0xc2, 0x9d, 0x72, 0x0b, 0x7f, 0x03,
-	0x93, 0x4a, 0x53, 0xab, 0x6d, 0xaa, 0xbb, 0xae, 0x62, 0x23, 0x73, 0x13, 0xe1, 0x09, 0xb9, 0xd0,
-	0xe2, 0x88, 0xbd, 0x9f, 0xe9, 0xa5, 0x89, 0x09, 0xa5, 0xa9, 0x75, 0x0f, 0xd9, 0x3f, 0x01, 0x3c,
-	0x51, 0x32, 0xb6, 0xb6, 0x94, 0x35, 0xc3, 0x54, 0x2c, 0xc3, 0xc4, 0xdf, 0xc1, 0x21, 0xad, 0xde,
-	0x72, 0x84, 0x1b, 0x99, 0xcb, 0x85, 0xb9, 0xaa, 0x66, 0x43, 0xd1, 0xb5, 0xef, 0x15, 0x4b, 0x33,
-	0xf4, 0xaa, 0xb9, 0xda, 0x52, 0x4d, 0xde, 0x33, 0x93, 0x53, 0xf4, 0x8f, 0x5d, 0x01, 0xba, 0x13,
-	0x78, 0xb1, 0x9f, 0x01, 0x62, 0x97, 0xcb, 0xa7, 0x61, 0xe4, 0xf8, 0x1a, 0x2e, 0x45, 0x93, 0x43,
-	0x28, 0xba, 0x14, 0x4d, 0x46, 0x51, 0x6c, 0x29, 0x9a, 0x8c, 0xa1, 0xf8, 0x52, 0x34, 0x19, 0x47,
-	0x89, 0xec, 0xef, 0x00, 0x9e, 0x5d, 0x54, 0x2d, 0x7f, 0xf1, 0xa2, 0xda, 0x6a, 0x1a, 0x7a, 0x4b,
-	0xc5, 0xfc, 0xff, 0x68, 0x22, 0x19, 0x2c, 0x3e, 0xf7, 0x9f, 0x8a, 0x3f, 0xb2, 0x5a, 0x09, 0x8e,
-	0xfa, 0x2b, 0x6d, 0xe1, 0x22, 0x1c, 0x5d, 0xf7, 0x5f, 0x50, 0xf5, 0xa6, 0xc2, 0xf4, 0x81, 0xfe,
-	0x82, 0x29, 0x57, 0xff, 0x3a, 0x09, 0x63, 0xce, 0xe7, 0xf1, 0x29, 0x38, 0xea, 0x14, 0x50, 0xd3,
-	0x74, 0x67, 0x9f, 0x20, 0x06, 0x9f, 0x86, 0x27, 0x45, 0x7e, 0xf1, 0xba, 0x5c, 0x5b, 0x95, 0x38,
-	0xb1, 0xc6, 0x57, 0x16, 0xaa, 0x08, 0xe0, 0xf3, 0xf0, 0x9c, 0xef, 0x52, 0xe2, 0x64, 0x99, 0xaf,
-	0x2c, 0x4a, 0xb5, 0x22, 0x2b, 0xf1, 0x25, 0x14, 0xc1, 0xd3, 0x70, 0x6a, 0x50, 0x98, 0x5d, 0xe1,
-	0x6b, 0xcb, 0xdc, 0x6d, 0x09, 0x0d, 0xe1, 0x71, 0x78, 0xca, 0x87, 0x28, 0x73, 0x02, 0x27, 0x73,
-	0x28, 0x8a, 0x2f, 0xc0, 0xf3, 0xbe, 0x6b, 0x76, 0x55, 0xbe, 0x5e, 0x15, 0xf9, 0x3b, 0x5c, 0xb9,
-	0x56, 0x12, 0x78, 0xae, 0x22, 0x4b, 0x28, 0x16, 0xe2, 0x66, 0x57, 0x56, 0x04, 0xbe, 0xc4, 0xca,
-	0x7c, 0xb5, 0x22, 0xd5, 0x04, 0x5e, 0x92, 0x51, 0x1c, 0x67, 0x21, 0x39, 0x0c, 0x51, 0x12, 0x39,
-	0x56, 0xe6, 0x50, 0x02, 0x4f, 0xc1, 0x94, 0x0f, 0xb3, 0xc8, 0xca, 0xdc, 0x2d, 0xf6, 0x36, 0x65,
-	0x48, 0x62, 0x02, 0xd3, 0x83, 0xa2, 0x34, 0x7b, 0x18, 0x4f, 0xc2, 0xb3, 0xbe, 0x38, 0xad, 0xcd,
-	0x4d, 0x86, 0xa1, 0xd9, 0xf4, 0x82, 0x34, 0x77, 0x24, 0xd4, 0x62, 0x55, 0x5c, 0x64, 0x2b, 0xfc,
-	0x1d, 0x7f, 0x03, 0x27, 0xf0, 0x0c, 0xcc, 0x1c, 0x0a, 0xa1, 0x3c, 0xa3, 0x18, 0xc3, 0x31, 0x7f,
-	0x97, 0x82, 0x80, 0xc6, 0x70, 0x1a, 0x4e, 0xb8, 0x77, 0xbe, 0xa6, 0x5d, 0xc9, 0x4e, 0xe2, 0x8b,
-	0x70, 0xba, 0x3f, 0x16, 0x52, 0x0e, 0xe1, 0x2b, 0x70, 0xe6, 0x3d, 0xa8, 0x03, 0x01, 0x4f, 0xe1,
-	0x6b, 0x70, 0xf6, 0x3d, 0xc0, 0x52, 0x55, 0x10, 0xd8, 0x62, 0x55, 0x64, 0xe5, 0xaa, 0x28, 0x21,
-	0x7c, 0x04, 0xed, 0x0a, 0x5b, 0x5a, 0x66, 0x17, 0x39, 0x09, 0x7d, 0xe1, 0xe9, 0xe2, 0x07, 0x52,
-	0x7b, 0x9c, 0xf6, 0x94, 0x0d, 0x46, 0x6f, 0xf2, 0x25, 0x4e, 0xaa, 0x89, 0x1c, 0x5b, 0x46, 0x67,
-	0xbc, 0xe1, 0x0d, 0xc2, 0xdc, 0x12, 0x79, 0x99, 0x43, 0xe3, 0x83, 0xeb, 0xf1, 0x13, 0xb9, 0x6d,
-	0x4e, 0xe0, 0x59, 0x78, 0xf1, 0x08, 0x36, 0x17, 0x79, 0x76, 0x70, 0x6d, 0xb2, 0xc8, 0x2e, 0x2c,
-	0xf0, 0x25, 0xb7, 0xb6, 0x14, 0xbe, 0x0c, 0xb3, 0x87, 0x63, 0x56, 0x57, 0x68, 0x79, 0xe7, 0x06,
-	0x7f, 0xb5, 0x87, 0x2b, 0x57, 0x6f, 0x55, 0x28, 0x32, 0x3d, 0x58, 0x71, 0x81, 0xaf, 0x2c, 0xa3,
-	0x49, 0x7c, 0x0e, 0x8e, 0xf7, 0xc7, 0xba, 0x46, 0x99, 0xc2, 0x67, 0x20, 0x72, 0x43, 0xae, 0x3d,
-	0x9d, 0xdb, 0xf3, 0x78, 0x02, 0x62, 0xf7, 0x96, 0x3a, 0xde, 0xb5, 0x0e, 0xf1, 0x9e, 0x5c, 0xef,
-	0x3e, 0x64, 0x9b, 0x8c, 0x37, 0xf4, 0x3e, 0xc4, 0x81, 0x65, 0xa6, 0xbd, 0xae, 0xfa, 0x40, 0x41,
-	0xbb, 0x5c, 0xc0, 0x29, 0x78, 0x26, 0x88, 0xa4, 0x0e, 0xc8, 0x7a, 0x2f, 0xb3, 0x17, 0x09, 0x4c,
-	0x78, 0xc6, 0x73, 0x79, 0x38, 0xee, 0x9b, 0xda, 0xc5, 0xfe, 0x46, 0x9d, 0x89, 0x5d, 0xf2, 0x9e,
-	0xee, 0x41, 0x85, 0x32, 0x2b, 0xaf, 0x52, 0x6b, 0x5d, 0xc6, 0x19, 0x38, 0x19, 0x4a, 0xab, 0xd2,
-	0xa9, 0x3a, 0x80, 0x2b, 0xfd, 0x00, 0xd7, 0x21, 0x12, 0x57, 0x12, 0x39, 0x59, 0x42, 0x5f, 0xf6,
-	0x97, 0xef, 0x78, 0xad, 0x17, 0xff, 0xca, 0x5b, 0x8b, 0xbd, 0x78, 0x57, 0x98, 0x59, 0x6f, 0xdf,
-	0xf8, 0x77, 0x81, 0xab, 0xce, 0x07, 0xf8, 0x12, 0xbc, 0x30, 0x20, 0x18, 0x92, 0xe8, 0xaa, 0x37,
-	0xfd, 0xc1, 0xb0, 0x03, 0x9d, 0x3e, 0xf4, 0x1e, 0xc7, 0x60, 0xe4, 0x0d, 0xee, 0x46, 0x91, 0x13,
-	0x25, 0x74, 0xcd, 0x1b, 0x57, 0x00, 0x48, 0xb5, 0xca, 0x1d, 0xf2, 0xc5, 0xfe, 0x8d, 0x9d, 0xc7,
-	0x57, 0xe1, 0xe5, 0xa3, 0x90, 0x74, 0xef, 0x15, 0x3c, 0x85, 0x03, 0xd8, 0xe0, 0x06, 0xff, 0xc8,
-	0x7b, 0x69, 0x83, 0x51, 0x94, 0xed, 0x63, 0xcf, 0xb8, 0x01, 0x5c, 0x60, 0xa3, 0xcf, 0x1d, 0x32,
-	0xe1, 0xd0, 0x66, 0x9f, 0x3f, 0xac, 0x8b, 0x72, 0xb9, 0xc6, 0x06, 0x2d, 0x8e, 0x3e, 0xf1, 0xde,
-	0x6d, 0x10, 0x2b, 0x08, 0xe8, 0x53, 0xcf, 0x9d, 0x12, 0x57, 0x29, 0xd7, 0xf8, 0xca, 0x4d, 0x5e,
-	0xe6, 0x24, 0xf4, 0x19, 0x1e, 0x85, 0xc3, 0xf4, 0x3d, 0x0b, 0x02, 0xfa, 0x3c, 0x1d, 0x7d, 0xf4,
-	0x2b, 0x61, 0x8a, 0xbf, 0x81, 0xbd, 0x36, 0x01, 0x2f, 0xda, 0x04, 0xbc, 0x6c, 0x13, 0xe6, 0x55,
-	0x9b, 0x30, 0xaf, 0xdb, 0x84, 0x79, 0xd3, 0x26, 0xcc, 0xdb, 0x36, 0x01, 0x0f, 0x6d, 0x02, 0x1e,
-	0xd9, 0x84, 0x79, 0x62, 0x13, 0xf0, 0xd4, 0x26, 0xcc, 0x33, 0x9b, 0x30, 0xcf, 0x6d, 0xc2, 0xec,
-	0xd9, 0x04, 0xbc, 0xb0, 0x09, 0x78, 0x69, 0x13, 0xe6, 0x95, 0x4d, 0xc0, 0x6b, 0x9b, 0x30, 0x6f,
-	0x6c, 0x02, 0xde, 0xda, 0x84, 0x79, 0xd8, 0x21, 0xcc, 0xa3, 0x0e, 0x01, 0x8f, 0x3b, 0x84, 0xf9,
-	0xb9, 0x43, 0xc0, 0x2f, 0x1d, 0xc2, 0x3c, 0xe9, 0x10, 0xe6, 0x69, 0x87, 0x80, 0x67, 0x1d, 0x02,
-	0x9e, 0x77, 0x08, 0xb8, 0x53, 0x68, 0x18, 0x79, 0x6b, 0x43, 0xb5, 0x36, 0x34, 0xbd, 0xd1, 0xca,
-	0xeb, 0xaa, 0x75, 0xdf, 0x30, 0x37, 0x0b, 0xc1, 0xff, 0x11, 0x76, 0xe6, 0x0b, 0xcd, 0xcd, 0x46,
-	0xc1, 0xb2, 0xf4, 0xe6, 0xda, 0x5a, 0xdc, 0xf9, 0xe3, 0x74, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x93, 0xe8, 0x08, 0xb7, 0x0b, 0x0d, 0x00, 0x00,
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x3f, 0x6c, 0xdb, 0xc6,
+	0x17, 0xc7, 0x79, 0xb2, 0xfe, 0xf9, 0x1c, 0x3b, 0x97, 0x4b, 0xec, 0x28, 0xb2, 0x73, 0x72, 0xe4,
+	0xfc, 0xf1, 0x2f, 0xbf, 0x48, 0x6a, 0xed, 0xfe, 0x6f, 0x51, 0x94, 0x92, 0x68, 0x87, 0xb6, 0x22,
+	0xba, 0x24, 0x9d, 0x20, 0x59, 0x08, 0xda, 0x62, 0x64, 0xc2, 0x36, 0x29, 0x50, 0x8c, 0x13, 0x77,
+	0x0a, 0x3a, 0x05, 0x9d, 0x82, 0x4e, 0x1d, 0x0b, 0x14, 0x05, 0x02, 0x74, 0x09, 0xba, 0x34, 0x63,
+	0x46, 0x8f, 0x19, 0x03, 0x14, 0x70, 0x23, 0xaa, 0x43, 0xc6, 0x8c, 0x41, 0xa6, 0x42, 0xe4, 0xc9,
+	0x24, 0x25, 0x39, 0xae, 0xd1, 0xed, 0x74, 0xef, 0xf3, 0x1e, 0xdf, 0x7b, 0xdf, 0x77, 0x0f, 0x36,
+	0x24, 0x5b, 0xa6, 0xa5, 0xde, 0x57, 0x8d, 0x5c, 0xd3, 0x56, 0xd7, 0x37, 0x0b, 0x6a, 0x43, 0x2f,
+	0x58, 0x7a, 0x7d, 0xc3, 0x6e, 0xe6, 0x1b, 0x96, 0x69, 0x9b, 0x78, 0xcc, 0xb6, 0x8d, 0x3c, 0x65,
+	0xf2, 0x3b, 0xf3, 0x69, 0xb6, 0xae, 0xdb, 0x1b, 0xf7, 0xd6, 0xf2, 0xeb, 0xe6, 0x76, 0x41, 0x33,
+	0x76, 0xcc, 0xdd, 0x86, 0x65, 0x3e, 0xd8, 0x2d, 0xb8, 0xf0, 0x7a, 0xae, 0xae, 0x19, 0xb9, 0x1d,
+	0x75, 0x4b, 0xaf, 0xa9, 0xb6, 0x56, 0xe8, 0x3b, 0x78, 0x21, 0xd3, 0xb9, 0x40, 0x88, 0xba, 0x59,
+	0x37, 0x3d, 0xe7, 0xb5, 0x7b, 0x77, 0xdd, 0x5f, 0xee, 0x0f, 0xf7, 0x44, 0xf1, 0x4c, 0xdd, 0x34,
+	0xeb, 0x5b, 0x9a, 0x4f, 0xd9, 0xfa, 0xb6, 0xd6, 0xb4, 0xd5, 0xed, 0x06, 0x05, 0x66, 0xfa, 0x4b,
+	0xd0, 0x6b, 0x9a, 0x61, 0xeb, 0x77, 0x75, 0xcd, 0xa2, 0x75, 0x64, 0x17, 0x60, 0x5c, 0x74, 0xeb,
+	0xc2, 0x5f, 0xc1, 0xb8, 0x57, 0x61, 0x0a, 0x4c, 0x0f, 0xcd, 0x8e, 0xcd, 0x8d, 0xe7, 0xc3, 0x25,
+	0xe6, 0x5d, 0xae, 0x38, 0xfa, 0xae, 0x08, 0x7f, 0x04, 0x89, 0x6c, 0xec, 0x7b, 0x10, 0x41, 0x40,
+	0xa4, 0x3e, 0xd9, 0xbf, 0x23, 0x30, 0xce, 0xae, 0xf0, 0xcb, 0xda, 0x2e, 0x9e, 0x80, 0x11, 0xbd,
+	0x96, 0x02, 0xd3, 0x60, 0x76, 0xb8, 0x18, 0x77, 0xf6, 0x33, 0x11, 0xbe, 0x2c, 0x46, 0xf4, 0x1a,
+	0x46, 0x70, 0x68, 0x53, 0xdb, 0x4d, 0x45, 0x3a, 0x06, 0xb1, 0x73, 0xc4, 0x93, 0x30, 0x6a, 0xa8,
+	0xdb, 0x5a, 0x6a, 0xc8, 0x65, 0x13, 0xef, 0x8a, 0x51, 0x2b, 0x92, 0x9a, 0x13, 0xdd, 0xcb, 0x40,
+	0x3e, 0xd1, 0xe3, 0xe7, 0x83, 0x4b, 0x10, 0xae, 0x5b, 0x9a, 0x6a, 0x6b, 0x35, 0x45, 0xb5, 0x53,
+	0xb1, 0x69, 0x30, 0x3b, 0x32, 0x97, 0xce, 0x7b, 0x2d, 0xcb, 0x77, 0x5b, 0x96, 0x97, 0xbb, 0x2d,
+	0x2b, 0x26, 0xf7, 0xf6, 0x33, 0xcc, 0xe3, 0xbf, 0x32, 0x40, 0x1c, 0xa6, 0x7e, 0xac, 0xdd, 0x09,
+	0x72, 0xaf, 0x51, 0xeb, 0x06, 0x89, 0x1f, 0x27, 0x08, 0xf5, 0x63, 0x6d, 0xbc, 0x0c, 0xa1, 0xf6,
+	0xa0, 0xa1, 0x5b, 0x5a, 0xb3, 0x13, 0x24, 0x71, 0x64, 0x10, 0xf4, 0xae, 0x18, 0xfb, 0x1d, 0x44,
+	0xbe, 0x01, 0x7b, 0xfb, 0x19, 0xe0, 0x05, 0xa3, 0xfe, 0xac, 0x9d, 0xe5, 0x61, 0xc2, 0xeb, 0x72,
+	0x13, 0x7f, 0x0d, 0x93, 0x6a, 0x43, 0x57, 0x36, 0xb5, 0x5d, 0x4f, 0xb1, 0x91, 0xb9, 0x89, 0xde,
+	0x0e, 0x79, 0x68, 0x71, 0xc4, 0xd9, 0xcf, 0x74, 0xdd, 0xc4, 0x84, 0xda, 0xd0, 0x3b, 0x87, 0xec,
+	0x1f, 0x00, 0x9e, 0x28, 0x99, 0x5b, 0x5b, 0xea, 0x9a, 0x69, 0xa9, 0xb6, 0x69, 0xe1, 0x6f, 0xe1,
+	0x90, 0x5e, 0x6b, 0xba, 0xc2, 0x8d, 0xcc, 0xe5, 0x7a, 0x63, 0x09, 0x56, 0x5d, 0x35, 0xf4, 0xef,
+	0x54, 0x5b, 0x37, 0x0d, 0xc1, 0x5a, 0x6d, 0x6a, 0x16, 0xef, 0x0f, 0x93, 0x9b, 0xf4, 0x0f, 0x1d,
+	0x01, 0x3a, 0x1d, 0x78, 0xb1, 0x9f, 0x01, 0x62, 0x27, 0x56, 0x40, 0xc3, 0xc8, 0xf1, 0x35, 0x5c,
+	0x8a, 0x26, 0x87, 0x50, 0x74, 0x29, 0x9a, 0x8c, 0xa2, 0xd8, 0x52, 0x34, 0x19, 0x43, 0xf1, 0xa5,
+	0x68, 0x32, 0x8e, 0x12, 0xd9, 0xdf, 0x00, 0x3c, 0xbb, 0xa8, 0xd9, 0xc1, 0xe4, 0x45, 0xad, 0xd9,
+	0x30, 0x8d, 0xa6, 0x86, 0xf9, 0xff, 0x50, 0x44, 0x32, 0x9c, 0x7c, 0xee, 0x5f, 0x25, 0x7f, 0x64,
+	0xb6, 0x12, 0x1c, 0x0d, 0x66, 0xda, 0xc4, 0x45, 0x38, 0xba, 0x1e, 0xbc, 0xa0, 0xea, 0x4d, 0xf5,
+	0x86, 0x0f, 0xd5, 0x17, 0x76, 0xb9, 0xfa, 0xe7, 0x49, 0x18, 0x73, 0x3f, 0x8f, 0x4f, 0xc1, 0x51,
+	0x37, 0x01, 0x45, 0x37, 0xdc, 0x7d, 0x82, 0x18, 0x7c, 0x1a, 0x9e, 0x14, 0xf9, 0xc5, 0xeb, 0xb2,
+	0xb2, 0x2a, 0x71, 0xa2, 0xc2, 0x57, 0x17, 0x04, 0x04, 0xf0, 0x79, 0x78, 0x2e, 0x70, 0x29, 0x71,
+	0xb2, 0xcc, 0x57, 0x17, 0x25, 0xa5, 0xc8, 0x4a, 0x7c, 0x09, 0x45, 0xf0, 0x34, 0x9c, 0x1a, 0x64,
+	0x66, 0x57, 0x78, 0x65, 0x99, 0xbb, 0x2d, 0xa1, 0x21, 0x3c, 0x0e, 0x4f, 0x05, 0x88, 0x32, 0x57,
+	0xe1, 0x64, 0x0e, 0x45, 0xf1, 0x05, 0x78, 0x3e, 0x70, 0xcd, 0xae, 0xca, 0xd7, 0x05, 0x91, 0xbf,
+	0xc3, 0x95, 0x95, 0x52, 0x85, 0xe7, 0xaa, 0xb2, 0x84, 0x62, 0x3d, 0xb1, 0xd9, 0x95, 0x95, 0x0a,
+	0x5f, 0x62, 0x65, 0x5e, 0xa8, 0x4a, 0x4a, 0x85, 0x97, 0x64, 0x14, 0xc7, 0x59, 0x48, 0x0e, 0x23,
+	0x4a, 0x22, 0xc7, 0xca, 0x1c, 0x4a, 0xe0, 0x29, 0x98, 0x0a, 0x30, 0x8b, 0xac, 0xcc, 0xdd, 0x62,
+	0x6f, 0xd3, 0x08, 0x49, 0x4c, 0x60, 0x7a, 0x90, 0x95, 0x7a, 0x0f, 0xe3, 0x49, 0x78, 0x36, 0x60,
+	0xa7, 0xb9, 0x79, 0xce, 0xb0, 0xa7, 0x37, 0x5d, 0x23, 0xf5, 0x1d, 0xe9, 0x29, 0x51, 0x10, 0x17,
+	0xd9, 0x2a, 0x7f, 0x27, 0x58, 0xc0, 0x09, 0x3c, 0x03, 0x33, 0x87, 0x22, 0x34, 0xce, 0x28, 0xc6,
+	0x70, 0x2c, 0x58, 0x65, 0xa5, 0x82, 0xc6, 0x70, 0x1a, 0x4e, 0x78, 0x77, 0x81, 0xa2, 0x3d, 0xc9,
+	0x4e, 0xe2, 0x8b, 0x70, 0xba, 0xdf, 0xd6, 0xa3, 0x1c, 0xc2, 0x57, 0xe0, 0xcc, 0x7b, 0xa8, 0x03,
+	0x01, 0x4f, 0xe1, 0x6b, 0x70, 0xf6, 0x3d, 0x60, 0x49, 0xa8, 0x54, 0xd8, 0xa2, 0x20, 0xb2, 0xb2,
+	0x20, 0x4a, 0x08, 0x1f, 0x11, 0x76, 0x85, 0x2d, 0x2d, 0xb3, 0x8b, 0x9c, 0x84, 0x3e, 0xf3, 0x75,
+	0x09, 0x82, 0x74, 0x3c, 0x4e, 0xfb, 0xca, 0x86, 0xad, 0x37, 0xf9, 0x12, 0x27, 0x29, 0x22, 0xc7,
+	0x96, 0xd1, 0x19, 0xbf, 0x79, 0x83, 0x98, 0x5b, 0x22, 0x2f, 0x73, 0x68, 0x7c, 0x70, 0x3e, 0xc1,
+	0x40, 0x5e, 0x99, 0x13, 0x78, 0x16, 0x5e, 0x3c, 0x22, 0x9a, 0x47, 0x9e, 0x1d, 0x9c, 0x9b, 0x2c,
+	0xb2, 0x0b, 0x0b, 0x7c, 0xc9, 0xcb, 0x2d, 0x85, 0x2f, 0xc3, 0xec, 0xe1, 0xcc, 0xea, 0x0a, 0x4d,
+	0xef, 0xdc, 0xe0, 0xaf, 0x76, 0xb9, 0xb2, 0x70, 0xab, 0x4a, 0xc9, 0xf4, 0x60, 0xc5, 0x2b, 0x7c,
+	0x75, 0x19, 0x4d, 0xe2, 0x73, 0x70, 0xbc, 0xdf, 0xd6, 0x19, 0x94, 0x29, 0x7c, 0x06, 0x22, 0xcf,
+	0xe4, 0x8d, 0xa7, 0x7b, 0x7b, 0x1e, 0x4f, 0x40, 0xec, 0xdd, 0xd2, 0x89, 0xf7, 0x46, 0x87, 0xf8,
+	0x4f, 0xae, 0x7b, 0xdf, 0x33, 0x36, 0x19, 0xbf, 0xe9, 0x7d, 0xc4, 0xc1, 0xc8, 0x4c, 0xfb, 0x55,
+	0xf5, 0x41, 0xe1, 0x71, 0xb9, 0x80, 0x53, 0xf0, 0x4c, 0x98, 0xa4, 0x13, 0x90, 0xf5, 0x5f, 0x66,
+	0xd7, 0x12, 0xea, 0xf0, 0x8c, 0x3f, 0xe5, 0xbd, 0xf6, 0x40, 0xd7, 0x2e, 0xf6, 0x17, 0xea, 0x76,
+	0xec, 0x92, 0xff, 0x74, 0x0f, 0x32, 0x94, 0x59, 0x79, 0x95, 0x8e, 0xd6, 0x65, 0x9c, 0x81, 0x93,
+	0x3d, 0x6e, 0x02, 0xed, 0xaa, 0x0b, 0x5c, 0xe9, 0x07, 0xbc, 0x09, 0x91, 0xb8, 0x92, 0xc8, 0xc9,
+	0x12, 0xfa, 0xbc, 0x3f, 0xfd, 0x8e, 0xe3, 0x81, 0xfd, 0x8b, 0xfe, 0x4e, 0x8b, 0xdc, 0x0d, 0x41,
+	0xe6, 0x94, 0x92, 0x50, 0x95, 0x45, 0xa1, 0x82, 0xbe, 0xf4, 0x17, 0x67, 0x97, 0xe8, 0x48, 0x37,
+	0xeb, 0x6f, 0xa4, 0xe0, 0xb6, 0xf0, 0xf4, 0xfb, 0x1f, 0xbe, 0x04, 0x2f, 0x0c, 0x30, 0xf6, 0x88,
+	0x78, 0xd5, 0xd7, 0x67, 0x30, 0x76, 0xa0, 0xe4, 0xff, 0xfd, 0xe7, 0x33, 0x98, 0xbc, 0xc1, 0xdd,
+	0x28, 0x72, 0xa2, 0x84, 0xae, 0xf9, 0x0d, 0x0d, 0x81, 0x54, 0xcd, 0xdc, 0x21, 0x5f, 0xec, 0xdf,
+	0xe9, 0x79, 0x7c, 0x15, 0x5e, 0x3e, 0x8a, 0xa4, 0x9b, 0xb1, 0xe0, 0xcf, 0x40, 0x88, 0x0d, 0xef,
+	0xf8, 0x0f, 0xfc, 0xb7, 0x38, 0x98, 0xa2, 0xd1, 0x3e, 0xf4, 0x47, 0x3b, 0xc4, 0x85, 0x76, 0xfe,
+	0xdc, 0x21, 0x1d, 0xee, 0xd9, 0xfd, 0xf3, 0x87, 0x55, 0x51, 0x2e, 0x2b, 0x6c, 0xf8, 0x11, 0xa0,
+	0x8f, 0xfc, 0x97, 0x1d, 0x66, 0x2b, 0x15, 0xf4, 0xb1, 0x3f, 0xbf, 0x12, 0x57, 0x2d, 0x2b, 0x7c,
+	0xf5, 0x26, 0x2f, 0x73, 0x12, 0xfa, 0x04, 0x8f, 0xc2, 0x61, 0xef, 0xbe, 0x83, 0x7d, 0x9a, 0x8e,
+	0x3e, 0xfa, 0x85, 0x30, 0xc5, 0x5f, 0xc1, 0x5e, 0x8b, 0x80, 0x17, 0x2d, 0x02, 0x5e, 0xb6, 0x08,
+	0xf3, 0xaa, 0x45, 0x98, 0xd7, 0x2d, 0xc2, 0xbc, 0x69, 0x11, 0xe6, 0x6d, 0x8b, 0x80, 0x87, 0x0e,
+	0x01, 0x8f, 0x1c, 0xc2, 0x3c, 0x71, 0x08, 0x78, 0xea, 0x10, 0xe6, 0x99, 0x43, 0x98, 0xe7, 0x0e,
+	0x61, 0xf6, 0x1c, 0x02, 0x5e, 0x38, 0x04, 0xbc, 0x74, 0x08, 0xf3, 0xca, 0x21, 0xe0, 0xb5, 0x43,
+	0x98, 0x37, 0x0e, 0x01, 0x6f, 0x1d, 0xc2, 0x3c, 0x6c, 0x13, 0xe6, 0x51, 0x9b, 0x80, 0xc7, 0x6d,
+	0xc2, 0xfc, 0xd4, 0x26, 0xe0, 0xe7, 0x36, 0x61, 0x9e, 0xb4, 0x09, 0xf3, 0xb4, 0x4d, 0xc0, 0xb3,
+	0x36, 0x01, 0xcf, 0xdb, 0x04, 0xdc, 0x29, 0xd4, 0xcd, 0xbc, 0xbd, 0xa1, 0xd9, 0x1b, 0xba, 0x51,
+	0x6f, 0xe6, 0x0d, 0xcd, 0xbe, 0x6f, 0x5a, 0x9b, 0x85, 0xf0, 0x7f, 0x11, 0x3b, 0xf3, 0x85, 0xc6,
+	0x66, 0xbd, 0x60, 0xdb, 0x46, 0x63, 0x6d, 0x2d, 0xee, 0xfe, 0xf9, 0x3a, 0xff, 0xcf, 0x00, 0x5d,
+	0x10, 0x41, 0x42, 0x2d, 0x0d, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -1259,7 +1264,7 @@ func NewPopulatedRights(r randyRights, easy bool) *Rights {
 	v1 := r.Intn(10)
 	this.Rights = make([]Right, v1)
 	for i := 0; i < v1; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1274,7 +1279,7 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	v2 := r.Intn(10)
 	this.Rights = make([]Right, v2)
 	for i := 0; i < v2; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	this.CreatedAt = *v3
@@ -1309,7 +1314,7 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	v7 := r.Intn(10)
 	this.Rights = make([]Right, v7)
 	for i := 0; i < v7; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1323,7 +1328,7 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 	v9 := r.Intn(10)
 	this.Rights = make([]Right, v9)
 	for i := 0; i < v9; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(59)])
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 57, 58, 59, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(60)])
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
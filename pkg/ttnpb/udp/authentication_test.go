@@ -0,0 +1,95 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAuthentication(t *testing.T) {
+	a := assertions.New(t)
+
+	key := []byte("my very secret key")
+	p := Packet{
+		ProtocolVersion: Version2,
+		Token:           [2]byte{0x01, 0x02},
+		PacketType:      PushData,
+		GatewayEUI:      &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01},
+		Data:            &Data{},
+	}
+	b, err := p.MarshalBinary()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Packets without authentication tag are not authenticated.
+	var unauthenticated Packet
+	if !a.So(unauthenticated.UnmarshalBinary(b), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(unauthenticated.AuthenticationTag, should.BeNil)
+	a.So(unauthenticated.AuthenticationTimestamp.IsZero(), should.BeTrue)
+	a.So(unauthenticated.VerifyAuthenticationTag(key), should.BeFalse)
+
+	// Packets with authentication tag are authenticated with the same key only.
+	timestamp := time.Unix(1600000000, 123456000)
+	authenticated := Authenticate(b, key, timestamp)
+	a.So(authenticated, should.HaveLength, len(b)+AuthenticationTimestampLength+AuthenticationTagLength)
+	a.So(b[0], should.Equal, byte(Version2))
+	var verified Packet
+	if !a.So(verified.UnmarshalBinary(authenticated), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(verified.ProtocolVersion, should.Equal, Version2)
+	a.So(verified.Token, should.Equal, p.Token)
+	a.So(verified.PacketType, should.Equal, PushData)
+	a.So(verified.GatewayEUI, should.Resemble, p.GatewayEUI)
+	a.So(verified.AuthenticationTimestamp, should.Equal, timestamp)
+	a.So(verified.AuthenticationTag, should.HaveLength, AuthenticationTagLength)
+	a.So(verified.VerifyAuthenticationTag(key), should.BeTrue)
+	a.So(verified.VerifyAuthenticationTag([]byte("my other secret key")), should.BeFalse)
+	a.So(verified.VerifyAuthenticationTag(nil), should.BeFalse)
+
+	// Acknowledgments do not have the authentication flag.
+	ack, err := verified.BuildAck()
+	if a.So(err, should.BeNil) {
+		a.So(ack.ProtocolVersion, should.Equal, Version2)
+	}
+
+	// Modified packets are not authenticated.
+	modified := append([]byte{}, authenticated...)
+	modified[1] ^= 0xff
+	var tampered Packet
+	if a.So(tampered.UnmarshalBinary(modified), should.BeNil) {
+		a.So(tampered.VerifyAuthenticationTag(key), should.BeFalse)
+	}
+
+	// Packets with a modified authentication timestamp are not authenticated.
+	modified = append([]byte{}, authenticated...)
+	modified[len(modified)-AuthenticationTagLength-1] ^= 0xff
+	if a.So(tampered.UnmarshalBinary(modified), should.BeNil) {
+		a.So(tampered.AuthenticationTimestamp, should.NotEqual, timestamp)
+		a.So(tampered.VerifyAuthenticationTag(key), should.BeFalse)
+	}
+
+	// Packets with the authentication flag must be long enough to contain the authentication timestamp and tag.
+	var short Packet
+	a.So(short.UnmarshalBinary([]byte{byte(Version2 | AuthenticationFlag), 0x01, 0x02, byte(PullData)}), should.NotBeNil)
+}
@@ -27,4 +27,6 @@ var (
 	errTimestamp       = errors.DefineInvalidArgument("timestamp", "failed to parse timestamp")
 	errModulation      = errors.DefineInvalidArgument("modulation", "invalid modulation `{modulation}`")
 	errNotScheduled    = errors.DefineInvalidArgument("not_scheduled", "downlink message not scheduled")
+
+	errNoAuthenticationTag = errors.DefineInvalidArgument("no_authentication_tag", "packet is not long enough to contain the authentication timestamp and tag")
 )
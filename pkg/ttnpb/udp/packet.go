@@ -93,6 +93,14 @@ type Packet struct {
 	PacketType      PacketType
 	GatewayEUI      *types.EUI64
 	Data            *Data
+
+	// AuthenticationTimestamp is the authentication timestamp of the packet. It is zero if the packet is not
+	// authenticated.
+	AuthenticationTimestamp time.Time
+	// AuthenticationTag is the authentication tag of the packet. It is nil if the packet is not authenticated.
+	AuthenticationTag []byte
+	// authenticatedData is the part of the packet that is authenticated by the AuthenticationTag.
+	authenticatedData []byte
 }
 
 var errInvalidPacketType = errors.DefineInvalidArgument("packet_type", "invalid packet type")
@@ -121,6 +129,16 @@ func (p *Packet) UnmarshalBinary(b []byte) error {
 		return io.EOF
 	}
 	p.ProtocolVersion = ProtocolVersion(b[0])
+	if p.ProtocolVersion&AuthenticationFlag != 0 {
+		if len(b) < 4+AuthenticationTimestampLength+AuthenticationTagLength {
+			return errNoAuthenticationTag.New()
+		}
+		p.ProtocolVersion &^= AuthenticationFlag
+		n := len(b) - AuthenticationTagLength
+		p.AuthenticationTag, p.authenticatedData = b[n:], b[:n]
+		b = b[:n-AuthenticationTimestampLength]
+		p.AuthenticationTimestamp = decodeAuthenticationTimestamp(p.authenticatedData[len(b):])
+	}
 	copy(p.Token[:], b[1:3])
 	p.PacketType = PacketType(b[3])
 	i := 4
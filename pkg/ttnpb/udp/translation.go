@@ -331,9 +331,12 @@ func ToDownlinkMessage(tx *TxPacket) (*ttnpb.DownlinkMessage, error) {
 	if lora := scheduled.DataRate.GetLoRa(); lora != nil {
 		scheduled.CodingRate = tx.CodR
 	}
-	if tx.Time != nil {
+	if tx.Tmms != nil {
 		t := gpstime.Parse(time.Duration(*tx.Tmms) * time.Millisecond)
 		scheduled.Time = &t
+	} else if tx.Time != nil {
+		t := time.Time(*tx.Time)
+		scheduled.Time = &t
 	}
 	buf, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(tx.Data, "="))
 	if err != nil {
@@ -390,6 +390,38 @@ func TestDownlinkRoundtrip(t *testing.T) {
 	a.So(actual, should.HaveEmptyDiff, expected)
 }
 
+func TestToDownlinkMessageTime(t *testing.T) {
+	a := assertions.New(t)
+	dr := datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000}}}}
+
+	compact := udp.CompactTime(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+	msg, err := udp.ToDownlinkMessage(&udp.TxPacket{
+		Time: &compact,
+		Freq: 868.1,
+		Modu: "LORA",
+		DatR: dr,
+		CodR: "4/5",
+		Data: "ffOO",
+	})
+	if a.So(err, should.BeNil) {
+		a.So(*msg.GetScheduled().Time, should.Equal, time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+	}
+
+	tmms := uint64(1000000000000)
+	msg, err = udp.ToDownlinkMessage(&udp.TxPacket{
+		Tmms: &tmms,
+		Time: &compact,
+		Freq: 868.1,
+		Modu: "LORA",
+		DatR: dr,
+		CodR: "4/5",
+		Data: "ffOO",
+	})
+	if a.So(err, should.BeNil) {
+		a.So(*msg.GetScheduled().Time, should.Equal, time.Date(2011, 9, 14, 1, 46, 25, 0, time.UTC))
+	}
+}
+
 func TestFromDownlinkMessageDummy(t *testing.T) {
 	a := assertions.New(t)
 
@@ -30,7 +30,7 @@ import Yup from '@ttn-lw/lib/yup'
 import sharedMessages from '@ttn-lw/lib/shared-messages'
 import PropTypes from '@ttn-lw/lib/prop-types'
 
-import { address as addressRegexp } from '@console/lib/regexp'
+import { grpcServiceAddress as grpcServiceAddressRegexp } from '@console/lib/regexp'
 
 import { getDefaultGrpcServiceFormatter, getDefaultJavascriptFormatter } from './formatter-values'
 import TestForm from './test-form'
@@ -73,7 +73,7 @@ const validationSchema = Yup.object().shape({
     then: Yup.string().required(sharedMessages.validateRequired),
   }),
   [FIELD_NAMES.GRPC]: Yup.string()
-    .matches(addressRegexp, Yup.passValues(sharedMessages.validateAddressFormat))
+    .matches(grpcServiceAddressRegexp, Yup.passValues(sharedMessages.validateAddressFormat))
     .when(FIELD_NAMES.SELECT, {
       is: TYPES.GRPC,
       then: Yup.string().required(sharedMessages.validateRequired),